@@ -0,0 +1,496 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bill
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"hcm/pkg/api/core"
+	billcore "hcm/pkg/api/core/bill"
+	dsbillapi "hcm/pkg/api/data-service/bill"
+	"hcm/pkg/client"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/runtime/filter"
+	"hcm/pkg/thirdparty/api-gateway/cmsi"
+	cvt "hcm/pkg/tools/converter"
+	"hcm/pkg/tools/json"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// minForecastElapsedDays 本月已出账天数少于该值时预测误差过大，不评估预测费用
+	minForecastElapsedDays = 3
+	budgetWebhookTimeout   = 10 * time.Second
+	// maxBudgetAlertNotifyCount 告警最多通知次数，超过后不再重试
+	maxBudgetAlertNotifyCount = 5
+	// budgetAlertRetryInterval 通知失败的告警距上次尝试超过该间隔才重试，避免与正在通知的控制器重复通知
+	budgetAlertRetryInterval  = 30 * time.Minute
+	maxBudgetWebhookRedirects = 3
+)
+
+// NewBudgetEvaluator create budget evaluator, cmsiCli can be nil and mail notification will be skipped
+func NewBudgetEvaluator(cli *client.ClientSet, cmsiCli cmsi.Client) *BudgetEvaluator {
+	return &BudgetEvaluator{
+		Client:     cli,
+		CmsiCli:    cmsiCli,
+		HttpClient: newBudgetWebhookClient(),
+	}
+}
+
+// newBudgetWebhookClient 回调地址由用户配置，建立连接时校验实际解析出的地址，禁止访问内部网络
+func newBudgetWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: budgetWebhookTimeout,
+		Control: budgetWebhookDialControl,
+	}
+	return &http.Client{
+		Timeout: budgetWebhookTimeout,
+		Transport: &http.Transport{
+			// 不使用代理，保证连接的地址即为校验过的地址
+			Proxy:       nil,
+			DialContext: dialer.DialContext,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxBudgetWebhookRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxBudgetWebhookRedirects)
+			}
+			return billcore.ValidateBudgetWebhookUrl(req.URL.String())
+		},
+	}
+}
+
+// budgetWebhookDialControl 在域名解析后、建立连接前校验目标地址，防止通过域名解析绕过回调地址校验
+func budgetWebhookDialControl(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("invalid webhook address %s", address)
+	}
+	if !billcore.IsBudgetWebhookIPAllowed(ip) {
+		return fmt.Errorf("webhook address %s is not a public ip address", address)
+	}
+	return nil
+}
+
+// BudgetEvaluator 预算评估，在日账单汇总后计算预算作用范围内本月的实际及预测费用，
+// 费用首次超过阈值时记录告警并通过邮件和回调地址通知，通知失败的告警在后续评估时重试
+type BudgetEvaluator struct {
+	Client     *client.ClientSet
+	CmsiCli    cmsi.Client
+	HttpClient *http.Client
+}
+
+// BudgetAlertNotice 预算告警通知内容，同时作为回调地址的请求体
+type BudgetAlertNotice struct {
+	BudgetID     string                     `json:"budget_id"`
+	BudgetName   string                     `json:"budget_name"`
+	Scope        enumor.BillBudgetScope     `json:"scope"`
+	ScopeID      string                     `json:"scope_id"`
+	BillYear     int                        `json:"bill_year"`
+	BillMonth    int                        `json:"bill_month"`
+	AlertType    enumor.BillBudgetAlertType `json:"alert_type"`
+	Threshold    int                        `json:"threshold"`
+	Amount       decimal.Decimal            `json:"amount"`
+	ActualCost   decimal.Decimal            `json:"actual_cost"`
+	ForecastCost decimal.Decimal            `json:"forecast_cost"`
+}
+
+// EvaluateMainAccount 评估作用范围覆盖该二级账号的所有预算
+func (e *BudgetEvaluator) EvaluateMainAccount(kt *kit.Kit, opt *MainAccountControllerOption,
+	billYear, billMonth int) error {
+
+	scopeIDs := map[enumor.BillBudgetScope]string{
+		enumor.BillBudgetScopeMainAccount: opt.MainAccountID,
+		enumor.BillBudgetScopeRootAccount: opt.RootAccountID,
+	}
+	if opt.BkBizID > 0 {
+		scopeIDs[enumor.BillBudgetScopeBiz] = strconv.FormatInt(opt.BkBizID, 10)
+	}
+	if opt.ProductID > 0 {
+		scopeIDs[enumor.BillBudgetScopeProduct] = strconv.FormatInt(opt.ProductID, 10)
+	}
+
+	budgets, err := e.listBudgets(kt, scopeIDs)
+	if err != nil {
+		return err
+	}
+	for _, budget := range budgets {
+		if err := e.evaluateBudget(kt, budget, billYear, billMonth, time.Now().UTC()); err != nil {
+			logs.Warnf("evaluate budget %s(%s) for %d-%d failed, err: %v, rid: %s",
+				budget.ID, budget.Name, billYear, billMonth, err, kt.Rid)
+		}
+	}
+	return nil
+}
+
+func (e *BudgetEvaluator) listBudgets(kt *kit.Kit, scopeIDs map[enumor.BillBudgetScope]string) (
+	[]*billcore.Budget, error) {
+
+	ids := make([]string, 0, len(scopeIDs))
+	for _, id := range scopeIDs {
+		ids = append(ids, id)
+	}
+	req := &core.ListReq{
+		Filter: tools.ContainersExpression("scope_id", ids),
+		Page:   core.NewDefaultBasePage(),
+	}
+	budgets := make([]*billcore.Budget, 0)
+	for {
+		result, err := e.Client.DataService().Global.Bill.ListBillBudget(kt, req)
+		if err != nil {
+			return nil, fmt.Errorf("list bill budget failed, err: %v", err)
+		}
+		for _, budget := range result.Details {
+			// 业务ID与账号ID可能重复，需同时匹配作用范围
+			if scopeIDs[budget.Scope] == budget.ScopeID {
+				budgets = append(budgets, budget)
+			}
+		}
+		if len(result.Details) < int(req.Page.Limit) {
+			break
+		}
+		req.Page.Start += uint32(req.Page.Limit)
+	}
+	return budgets, nil
+}
+
+func (e *BudgetEvaluator) evaluateBudget(kt *kit.Kit, budget *billcore.Budget, billYear, billMonth int,
+	now time.Time) error {
+
+	actual, err := e.sumScopeCost(kt, budget, billYear, billMonth)
+	if err != nil {
+		return err
+	}
+	forecast := forecastMonthCost(actual, billYear, billMonth, now)
+
+	alerts, err := e.listAlerts(kt, budget.ID, billYear, billMonth)
+	if err != nil {
+		return err
+	}
+
+	newNotice := func(alertType enumor.BillBudgetAlertType, threshold int) *BudgetAlertNotice {
+		return &BudgetAlertNotice{
+			BudgetID:     budget.ID,
+			BudgetName:   budget.Name,
+			Scope:        budget.Scope,
+			ScopeID:      budget.ScopeID,
+			BillYear:     billYear,
+			BillMonth:    billMonth,
+			AlertType:    alertType,
+			Threshold:    threshold,
+			Amount:       budget.Amount,
+			ActualCost:   actual,
+			ForecastCost: forecast,
+		}
+	}
+
+	alerted := make(map[billcore.BudgetThreshold]struct{}, len(alerts))
+	for _, alert := range alerts {
+		alerted[billcore.BudgetThreshold{Type: alert.AlertType, Percent: alert.Threshold}] = struct{}{}
+		if !needRetryAlert(alert, now) {
+			continue
+		}
+		// 抢占告警后再重试，多个控制器并发评估时只有一个能重试成功
+		if !e.claimAlert(kt, alert) {
+			continue
+		}
+		alert.NotifyCount++
+		e.notifyAlert(kt, budget, alert, newNotice(alert.AlertType, alert.Threshold))
+	}
+
+	for _, threshold := range reachedThresholds(budget, actual, forecast) {
+		if _, ok := alerted[threshold]; ok {
+			continue
+		}
+		cost := actual
+		if threshold.Type == enumor.BillBudgetAlertForecast {
+			cost = forecast
+		}
+		// 先记录告警再通知，告警记录的唯一索引保证多个控制器并发评估时同一阈值只由一个控制器首次通知
+		createReq := &dsbillapi.BatchBillBudgetAlertCreateReq{
+			Items: []dsbillapi.BillBudgetAlertCreateReq{{
+				BudgetID:  budget.ID,
+				BillYear:  billYear,
+				BillMonth: billMonth,
+				AlertType: threshold.Type,
+				Threshold: threshold.Percent,
+				Amount:    budget.Amount,
+				Cost:      cost,
+			}},
+		}
+		result, err := e.Client.DataService().Global.Bill.BatchCreateBillBudgetAlert(kt, createReq)
+		if err != nil {
+			logs.Warnf("create budget %s alert %v failed, skip notify, err: %v, rid: %s",
+				budget.ID, threshold, err, kt.Rid)
+			continue
+		}
+		if len(result.IDs) == 0 {
+			logs.Errorf("create budget %s alert %v but no id returned, rid: %s", budget.ID, threshold, kt.Rid)
+			continue
+		}
+
+		alert := &billcore.BudgetAlert{
+			ID:          result.IDs[0],
+			BudgetID:    budget.ID,
+			BillYear:    billYear,
+			BillMonth:   billMonth,
+			AlertType:   threshold.Type,
+			Threshold:   threshold.Percent,
+			NotifyState: enumor.BillBudgetNotifyPending,
+			NotifyCount: 1,
+		}
+		e.notifyAlert(kt, budget, alert, newNotice(threshold.Type, threshold.Percent))
+	}
+	return nil
+}
+
+// needRetryAlert 通知未成功且未达到最大通知次数，并且距上次尝试已超过重试间隔的告警需要重试
+func needRetryAlert(alert *billcore.BudgetAlert, now time.Time) bool {
+	if alert.NotifyState != enumor.BillBudgetNotifyPending || alert.NotifyCount >= maxBudgetAlertNotifyCount {
+		return false
+	}
+	updatedAt, err := time.Parse(time.RFC3339, alert.UpdatedAt)
+	if err != nil {
+		return false
+	}
+	return now.Sub(updatedAt) >= budgetAlertRetryInterval
+}
+
+// claimAlert 按通知次数乐观锁抢占告警，返回是否抢占成功
+func (e *BudgetEvaluator) claimAlert(kt *kit.Kit, alert *billcore.BudgetAlert) bool {
+	req := &dsbillapi.BillBudgetAlertUpdateReq{
+		ID:             alert.ID,
+		NotifyState:    enumor.BillBudgetNotifyPending,
+		NotifyCount:    cvt.ValToPtr(alert.NotifyCount + 1),
+		PreNotifyCount: cvt.ValToPtr(alert.NotifyCount),
+	}
+	err := e.Client.DataService().Global.Bill.UpdateBillBudgetAlert(kt, req)
+	if err == nil {
+		return true
+	}
+	if ef := errf.Error(err); ef == nil || ef.Code != errf.RecordNotFound {
+		logs.Warnf("claim budget alert %s for retry failed, err: %v, rid: %s", alert.ID, err, kt.Rid)
+	}
+	return false
+}
+
+// notifyAlert 通知告警并记录通知结果，alert.NotifyCount 为包含本次在内的通知次数
+func (e *BudgetEvaluator) notifyAlert(kt *kit.Kit, budget *billcore.Budget, alert *billcore.BudgetAlert,
+	notice *BudgetAlertNotice) {
+
+	state := enumor.BillBudgetNotifySuccess
+	if err := e.notify(kt, budget, notice); err != nil {
+		state = enumor.BillBudgetNotifyPending
+		if alert.NotifyCount >= maxBudgetAlertNotifyCount {
+			state = enumor.BillBudgetNotifyFailed
+		}
+		logs.Errorf("notify budget %s alert %s failed, times: %d, state: %s, err: %v, rid: %s", budget.ID,
+			alert.ID, alert.NotifyCount, state, err, kt.Rid)
+	}
+
+	req := &dsbillapi.BillBudgetAlertUpdateReq{
+		ID:          alert.ID,
+		NotifyState: state,
+		NotifyCount: cvt.ValToPtr(alert.NotifyCount),
+	}
+	if err := e.Client.DataService().Global.Bill.UpdateBillBudgetAlert(kt, req); err != nil {
+		logs.Errorf("update budget alert %s notify state to %s failed, err: %v, rid: %s", alert.ID, state, err,
+			kt.Rid)
+	}
+}
+
+// sumScopeCost 汇总预算作用范围内各二级账号本月的人民币费用
+func (e *BudgetEvaluator) sumScopeCost(kt *kit.Kit, budget *billcore.Budget, billYear, billMonth int) (
+	decimal.Decimal, error) {
+
+	var scopeRule *filter.AtomRule
+	switch budget.Scope {
+	case enumor.BillBudgetScopeBiz, enumor.BillBudgetScopeProduct:
+		id, err := strconv.ParseInt(budget.ScopeID, 10, 64)
+		if err != nil {
+			return decimal.Zero, fmt.Errorf("invalid scope_id %s of budget %s", budget.ScopeID, budget.ID)
+		}
+		field := "bk_biz_id"
+		if budget.Scope == enumor.BillBudgetScopeProduct {
+			field = "product_id"
+		}
+		scopeRule = tools.RuleEqual(field, id)
+	case enumor.BillBudgetScopeMainAccount:
+		scopeRule = tools.RuleEqual("main_account_id", budget.ScopeID)
+	case enumor.BillBudgetScopeRootAccount:
+		scopeRule = tools.RuleEqual("root_account_id", budget.ScopeID)
+	default:
+		return decimal.Zero, fmt.Errorf("unsupported scope %s of budget %s", budget.Scope, budget.ID)
+	}
+
+	req := &dsbillapi.BillSummaryMainListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("bill_year", billYear),
+			tools.RuleEqual("bill_month", billMonth),
+			scopeRule,
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	total := decimal.Zero
+	for {
+		result, err := e.Client.DataService().Global.Bill.ListBillSummaryMain(kt, req)
+		if err != nil {
+			return decimal.Zero, fmt.Errorf("list main account bill summary failed, err: %v", err)
+		}
+		for _, summary := range result.Details {
+			total = total.Add(summary.CurrentMonthRMBCost)
+		}
+		if len(result.Details) < int(req.Page.Limit) {
+			break
+		}
+		req.Page.Start += uint32(req.Page.Limit)
+	}
+	return total, nil
+}
+
+func (e *BudgetEvaluator) listAlerts(kt *kit.Kit, budgetID string, billYear, billMonth int) (
+	[]*billcore.BudgetAlert, error) {
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("budget_id", budgetID),
+			tools.RuleEqual("bill_year", billYear),
+			tools.RuleEqual("bill_month", billMonth),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := e.Client.DataService().Global.Bill.ListBillBudgetAlert(kt, req)
+	if err != nil {
+		return nil, fmt.Errorf("list budget %s alert failed, err: %v", budgetID, err)
+	}
+	return result.Details, nil
+}
+
+// notify 通过邮件和回调地址通知告警，任一方式失败时返回错误
+func (e *BudgetEvaluator) notify(kt *kit.Kit, budget *billcore.Budget, notice *BudgetAlertNotice) error {
+	var notifyErrs []error
+	if e.CmsiCli != nil && len(budget.Receivers) != 0 {
+		mail := &cmsi.CmsiMail{
+			ReceiverUserName: strings.Join(budget.Receivers, ","),
+			Title:            buildBudgetAlertTitle(notice),
+			Content:          buildBudgetAlertContent(notice),
+		}
+		if err := e.CmsiCli.SendMail(kt, mail); err != nil {
+			notifyErrs = append(notifyErrs, fmt.Errorf("send mail failed, err: %v", err))
+		}
+	}
+
+	if budget.WebhookUrl != nil && len(*budget.WebhookUrl) != 0 {
+		if err := e.callWebhook(kt, *budget.WebhookUrl, notice); err != nil {
+			notifyErrs = append(notifyErrs, fmt.Errorf("call webhook failed, err: %v", err))
+		}
+	}
+	if len(notifyErrs) != 0 {
+		return errors.Join(notifyErrs...)
+	}
+	logs.Infof("budget %s alert %s %d%% of %d-%d notified, actual: %s, forecast: %s, rid: %s",
+		budget.ID, notice.AlertType, notice.Threshold, notice.BillYear, notice.BillMonth,
+		notice.ActualCost.String(), notice.ForecastCost.String(), kt.Rid)
+	return nil
+}
+
+func (e *BudgetEvaluator) callWebhook(kt *kit.Kit, url string, notice *BudgetAlertNotice) error {
+	// 预算创建时已校验回调地址，此处再次校验以防历史数据绕过
+	if err := billcore.ValidateBudgetWebhookUrl(url); err != nil {
+		return err
+	}
+	body, err := json.Marshal(notice)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(kt.Ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := e.HttpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook %s return status %d", url, resp.StatusCode)
+	}
+	return nil
+}
+
+// forecastMonthCost 按本月已出账天数的日均费用线性预测全月费用，非当前月份或已出账天数过少时返回实际费用
+func forecastMonthCost(actual decimal.Decimal, billYear, billMonth int, now time.Time) decimal.Decimal {
+	if now.Year() != billYear || int(now.Month()) != billMonth || now.Day() < minForecastElapsedDays {
+		return actual
+	}
+	daysInMonth := time.Date(billYear, time.Month(billMonth)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	return actual.Div(decimal.NewFromInt(int64(now.Day()))).Mul(decimal.NewFromInt(int64(daysInMonth)))
+}
+
+// reachedThresholds 返回实际或预测费用已达到的阈值
+func reachedThresholds(budget *billcore.Budget, actual, forecast decimal.Decimal) []billcore.BudgetThreshold {
+	reached := make([]billcore.BudgetThreshold, 0)
+	for _, threshold := range budget.Thresholds {
+		cost := actual
+		if threshold.Type == enumor.BillBudgetAlertForecast {
+			cost = forecast
+		}
+		limit := budget.Amount.Mul(decimal.NewFromInt(int64(threshold.Percent))).Div(decimal.NewFromInt(100))
+		if cost.GreaterThanOrEqual(limit) {
+			reached = append(reached, threshold)
+		}
+	}
+	return reached
+}
+
+func buildBudgetAlertTitle(notice *BudgetAlertNotice) string {
+	typeName := "实际费用"
+	if notice.AlertType == enumor.BillBudgetAlertForecast {
+		typeName = "预测费用"
+	}
+	return fmt.Sprintf("【HCM】预算告警：%s %d年%d月%s已达预算的%d%%", notice.BudgetName, notice.BillYear,
+		notice.BillMonth, typeName, notice.Threshold)
+}
+
+func buildBudgetAlertContent(notice *BudgetAlertNotice) string {
+	return fmt.Sprintf("预算名称：%s<br/>作用范围：%s %s<br/>账单月份：%d-%02d<br/>"+
+		"预算金额（人民币）：%s<br/>本月实际费用（人民币）：%s<br/>本月预测费用（人民币）：%s<br/>"+
+		"触发阈值：%s %d%%",
+		notice.BudgetName, notice.Scope, notice.ScopeID, notice.BillYear, notice.BillMonth,
+		notice.Amount.StringFixed(2), notice.ActualCost.StringFixed(2), notice.ForecastCost.StringFixed(2),
+		notice.AlertType, notice.Threshold)
+}
@@ -50,6 +50,8 @@ type MainAccountControllerOption struct {
 	BkBizID       int64
 	Client        *client.ClientSet
 	Sd            serviced.ServiceDiscover
+	// BudgetEvaluator 日账单汇总后评估预算，为空时不评估
+	BudgetEvaluator *BudgetEvaluator
 }
 
 // NewMainAccountController create new main account controller
@@ -37,6 +37,7 @@ type BillManager struct {
 	CurrentMainControllers map[string]*MainAccountController
 	CurrentRootControllers map[string]*RootAccountController
 	AccountList            AccountLister
+	BudgetEvaluator        *BudgetEvaluator
 }
 
 // Run bill manager
@@ -118,13 +119,14 @@ func (bm *BillManager) syncMainControllers() error {
 		_, ok := bm.CurrentMainControllers[item.Key()]
 		if !ok {
 			opt := MainAccountControllerOption{
-				RootAccountID: item.BaseMainAccount.ParentAccountID,
-				MainAccountID: item.BaseMainAccount.ID,
-				BkBizID:       item.BkBizID,
-				ProductID:     item.OpProductID,
-				Vendor:        item.Vendor,
-				Client:        bm.Client,
-				Sd:            bm.Sd,
+				RootAccountID:   item.BaseMainAccount.ParentAccountID,
+				MainAccountID:   item.BaseMainAccount.ID,
+				BkBizID:         item.BkBizID,
+				ProductID:       item.OpProductID,
+				Vendor:          item.Vendor,
+				Client:          bm.Client,
+				Sd:              bm.Sd,
+				BudgetEvaluator: bm.BudgetEvaluator,
 			}
 			ctrl, err := NewMainAccountController(&opt)
 			if err != nil {
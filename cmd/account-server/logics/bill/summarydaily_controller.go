@@ -73,6 +73,7 @@ func NewMainSummaryDailyController(opt *MainAccountControllerOption) (*MainSumma
 		ProductID:     opt.ProductID,
 		BkBizID:       opt.BkBizID,
 		Vendor:        opt.Vendor,
+		opt:           opt,
 	}, nil
 }
 
@@ -87,6 +88,7 @@ type MainSummaryDailyController struct {
 	BkBizID       int64
 	Vendor        enumor.Vendor
 
+	opt        *MainAccountControllerOption
 	kt         *kit.Kit
 	cancelFunc context.CancelFunc
 }
@@ -133,6 +135,12 @@ func (msdc *MainSummaryDailyController) doSync(kt *kit.Kit) error {
 	if err := msdc.syncDailySummary(kt.NewSubKit(), lastBillYear, lastBillMonth); err != nil {
 		return fmt.Errorf("ensure bill summary for %d %d failed, err %s", lastBillYear, lastBillMonth, err.Error())
 	}
+	if msdc.opt.BudgetEvaluator != nil {
+		if err := msdc.opt.BudgetEvaluator.EvaluateMainAccount(kt.NewSubKit(), msdc.opt, curBillYear,
+			curBillMonth); err != nil {
+			return fmt.Errorf("evaluate budget for %d %d failed, err %s", curBillYear, curBillMonth, err.Error())
+		}
+	}
 	return nil
 }
 
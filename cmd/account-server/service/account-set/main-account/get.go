@@ -44,6 +44,12 @@ func (s *service) Get(cts *rest.Contexts) (interface{}, error) {
 	}
 
 	switch baseInfo.Vendor {
+	case enumor.TCloud:
+		account, err := s.client.DataService().TCloud.MainAccount.Get(cts.Kit, accountID)
+		if account != nil {
+			account.Extension.CloudInitPassword = ""
+		}
+		return account, err
 	case enumor.Aws:
 		account, err := s.client.DataService().Aws.MainAccount.Get(cts.Kit, accountID)
 		if account != nil {
@@ -63,6 +63,8 @@ func (s *service) Add(cts *rest.Contexts) (interface{}, error) {
 	var accountID string
 	var err error
 	switch req.Vendor {
+	case enumor.TCloud:
+		accountID, err = s.addForTCloud(cts, req)
 	case enumor.Aws:
 		accountID, err = s.addForAws(cts, req)
 	case enumor.Gcp:
@@ -107,6 +109,33 @@ func (s *service) isDuplicateName(cts *rest.Contexts, name string) error {
 	return nil
 }
 
+func (s *service) addForTCloud(cts *rest.Contexts, req *proto.RootAccountAddReq) (string, error) {
+	result, err := s.client.DataService().TCloud.RootAccount.Create(
+		cts.Kit,
+		&dataproto.RootAccountCreateReq[dataproto.TCloudRootAccountExtensionCreateReq]{
+			Name:        req.Name,
+			CloudID:     req.Extension["cloud_main_account_id"],
+			Email:       req.Email,
+			Managers:    req.Managers,
+			BakManagers: req.BakManagers,
+			Site:        req.Site,
+			DeptID:      req.DeptID,
+			Memo:        req.Memo,
+			Extension: &dataproto.TCloudRootAccountExtensionCreateReq{
+				CloudMainAccountID: req.Extension["cloud_main_account_id"],
+				CloudSubAccountID:  req.Extension["cloud_sub_account_id"],
+				CloudSecretID:      req.Extension["cloud_secret_id"],
+				CloudSecretKey:     req.Extension["cloud_secret_key"],
+			},
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return result.ID, nil
+}
+
 func (s *service) addForAws(cts *rest.Contexts, req *proto.RootAccountAddReq) (string, error) {
 	result, err := s.client.DataService().Aws.RootAccount.Create(
 		cts.Kit,
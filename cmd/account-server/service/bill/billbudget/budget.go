@@ -0,0 +1,169 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package billbudget
+
+import (
+	"fmt"
+
+	asbill "hcm/pkg/api/account-server/bill"
+	"hcm/pkg/api/core"
+	dataservice "hcm/pkg/api/data-service"
+	dsbill "hcm/pkg/api/data-service/bill"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/iam/meta"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// ListBillBudget 查询预算
+func (s *service) ListBillBudget(cts *rest.Contexts) (any, error) {
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	err := s.authorizer.AuthorizeWithPerm(cts.Kit,
+		meta.ResourceAttribute{Basic: &meta.Basic{Type: meta.AccountBill, Action: meta.Find}})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.DataService().Global.Bill.ListBillBudget(cts.Kit, req)
+}
+
+// CreateBillBudget 创建预算，同一作用范围只能有一个预算，预算在下一次日账单汇总后开始评估
+func (s *service) CreateBillBudget(cts *rest.Contexts) (any, error) {
+	req := new(asbill.BillBudgetCreateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	err := s.authorizer.AuthorizeWithPerm(cts.Kit,
+		meta.ResourceAttribute{Basic: &meta.Basic{Type: meta.AccountBill, Action: meta.Create}})
+	if err != nil {
+		return nil, err
+	}
+
+	createReq := &dsbill.BatchBillBudgetCreateReq{
+		Items: []dsbill.BillBudgetCreateReq{{
+			Name:       req.Name,
+			Scope:      req.Scope,
+			ScopeID:    req.ScopeID,
+			Amount:     req.Amount,
+			Thresholds: req.Thresholds,
+			Receivers:  req.Receivers,
+			WebhookUrl: req.WebhookUrl,
+			Memo:       req.Memo,
+		}},
+	}
+	result, err := s.client.DataService().Global.Bill.BatchCreateBillBudget(cts.Kit, createReq)
+	if err != nil {
+		logs.Errorf("fail to create bill budget, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+	if len(result.IDs) != 1 {
+		return nil, fmt.Errorf("create bill budget return invalid ids: %v", result.IDs)
+	}
+
+	return core.CreateResult{ID: result.IDs[0]}, nil
+}
+
+// UpdateBillBudget 更新预算
+func (s *service) UpdateBillBudget(cts *rest.Contexts) (any, error) {
+	id := cts.PathParameter("id").String()
+	if len(id) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "id is required")
+	}
+	req := new(asbill.BillBudgetUpdateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	err := s.authorizer.AuthorizeWithPerm(cts.Kit,
+		meta.ResourceAttribute{Basic: &meta.Basic{Type: meta.AccountBill, Action: meta.Update}})
+	if err != nil {
+		return nil, err
+	}
+
+	updateReq := &dsbill.BillBudgetUpdateReq{
+		ID:         id,
+		Name:       req.Name,
+		Amount:     req.Amount,
+		Thresholds: req.Thresholds,
+		Receivers:  req.Receivers,
+		WebhookUrl: req.WebhookUrl,
+		Memo:       req.Memo,
+	}
+	if err = s.client.DataService().Global.Bill.UpdateBillBudget(cts.Kit, updateReq); err != nil {
+		logs.Errorf("fail to update bill budget %s, err: %v, rid: %s", id, err, cts.Kit.Rid)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// DeleteBillBudget 删除预算及其告警记录
+func (s *service) DeleteBillBudget(cts *rest.Contexts) (any, error) {
+	id := cts.PathParameter("id").String()
+	if len(id) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "id is required")
+	}
+
+	err := s.authorizer.AuthorizeWithPerm(cts.Kit,
+		meta.ResourceAttribute{Basic: &meta.Basic{Type: meta.AccountBill, Action: meta.Delete}})
+	if err != nil {
+		return nil, err
+	}
+
+	delReq := &dataservice.BatchDeleteReq{Filter: tools.EqualExpression("id", id)}
+	if err = s.client.DataService().Global.Bill.BatchDeleteBillBudget(cts.Kit, delReq); err != nil {
+		logs.Errorf("fail to delete bill budget %s, err: %v, rid: %s", id, err, cts.Kit.Rid)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ListBillBudgetAlert 查询预算告警记录
+func (s *service) ListBillBudgetAlert(cts *rest.Contexts) (any, error) {
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	err := s.authorizer.AuthorizeWithPerm(cts.Kit,
+		meta.ResourceAttribute{Basic: &meta.Basic{Type: meta.AccountBill, Action: meta.Find}})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.DataService().Global.Bill.ListBillBudgetAlert(cts.Kit, req)
+}
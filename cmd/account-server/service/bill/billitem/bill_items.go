@@ -21,9 +21,14 @@ package billitem
 
 import (
 	"fmt"
+	"io"
 
+	"hcm/cmd/task-server/logics/action/bill/itemexport"
 	"hcm/pkg/api/account-server/bill"
 	"hcm/pkg/api/core"
+	corebill "hcm/pkg/api/core/bill"
+	taskserver "hcm/pkg/api/task-server"
+	"hcm/pkg/criteria/constant"
 	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/criteria/errf"
 	"hcm/pkg/dal/dao/tools"
@@ -32,6 +37,9 @@ import (
 	"hcm/pkg/logs"
 	"hcm/pkg/rest"
 	"hcm/pkg/runtime/filter"
+	"hcm/pkg/tools/export"
+	"hcm/pkg/tools/json"
+	"hcm/pkg/tools/times"
 )
 
 // ListBillItems 查询账单明细
@@ -74,7 +82,42 @@ func (b *billItemSvc) ListBillItems(cts *rest.Contexts) (any, error) {
 
 }
 
-// ExportBillItems 导出账单明细
+// ListBillItemCostByResource 按云资源汇总账单明细费用，默认按费用降序排列，用于查看各资源的实际成本
+func (b *billItemSvc) ListBillItemCostByResource(cts *rest.Contexts) (any, error) {
+	req := new(bill.ListBillItemReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	err := b.authorizer.AuthorizeWithPerm(cts.Kit,
+		meta.ResourceAttribute{Basic: &meta.Basic{Type: meta.AccountBill, Action: meta.Find}})
+	if err != nil {
+		return nil, err
+	}
+	expressions := []filter.RuleFactory{
+		tools.RuleEqual("bill_year", req.BillYear),
+		tools.RuleEqual("bill_month", req.BillMonth),
+	}
+	if req.Filter != nil {
+		expressions = append(expressions, req.Filter)
+	}
+	mergedFilter, err := tools.And(expressions...)
+	if err != nil {
+		logs.Errorf("fail merge filter for listing cost by resource, err: %v, req: %+v, rid: %s",
+			err, req, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return b.client.DataService().Global.Bill.ListBillItemCostByResource(cts.Kit,
+		&core.ListReq{Filter: mergedFilter, Page: req.Page})
+}
+
+// ExportBillItems 导出账单明细，数据量不超过constant.ExcelExportLimit时直接以文件流返回，
+// 否则创建异步导出任务，导出文件写入对象存储，通过GetBillItemExportResult获取下载链接
 func (b *billItemSvc) ExportBillItems(cts *rest.Contexts) (any, error) {
 	vendor := enumor.Vendor(cts.PathParameter("vendor").String())
 	if len(vendor) == 0 {
@@ -89,6 +132,9 @@ func (b *billItemSvc) ExportBillItems(cts *rest.Contexts) (any, error) {
 	if err := req.Validate(); err != nil {
 		return nil, errf.NewFromErr(errf.InvalidParameter, err)
 	}
+	if len(req.Format) == 0 {
+		req.Format = enumor.BillExportCSV
+	}
 
 	err := b.authorizer.AuthorizeWithPerm(cts.Kit,
 		meta.ResourceAttribute{Basic: &meta.Basic{Type: meta.AccountBill, Action: meta.Find}})
@@ -96,36 +142,128 @@ func (b *billItemSvc) ExportBillItems(cts *rest.Contexts) (any, error) {
 		return nil, err
 	}
 
-	mergedFilter, err := tools.And(
+	exporter, err := corebill.NewBillItemExporter(vendor)
+	if err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	expressions := []filter.RuleFactory{
 		tools.RuleEqual("vendor", vendor),
 		tools.RuleEqual("bill_year", req.BillYear),
 		tools.RuleEqual("bill_month", req.BillMonth),
-		req.Filter)
+	}
+	if req.Filter != nil {
+		expressions = append(expressions, req.Filter)
+	}
+	mergedFilter, err := tools.And(expressions...)
 	if err != nil {
 		logs.Errorf("fail merge filter for exporting bill items, err: %v, req: %+v, rid: %s", err, req, cts.Kit.Rid)
 		return nil, err
 	}
 
-	switch vendor {
-	case enumor.HuaWei:
-		return exportHuaweiBillItems(cts.Kit, b, mergedFilter, req.ExportLimit)
-	default:
-		return nil, fmt.Errorf("unsupport %s vendor", vendor)
+	exportCount := req.ExportLimit
+	if exportCount == 0 {
+		countReq := &core.ListReq{Filter: mergedFilter, Page: core.NewCountPage()}
+		countResult, err := b.client.DataService().Global.Bill.ListBillItemRaw(cts.Kit, countReq)
+		if err != nil {
+			logs.Errorf("fail to count bill item for export, err: %v, req: %+v, rid: %s", err, req, cts.Kit.Rid)
+			return nil, err
+		}
+		exportCount = countResult.Count
+	}
+
+	fileName := fmt.Sprintf("bill_items_%s_%d%02d_%s%s", vendor, req.BillYear, req.BillMonth,
+		times.ConvStdTimeNow().Format("20060102150405"), export.FileExtension(req.Format))
+	if exportCount > constant.ExcelExportLimit {
+		return b.createExportBillItemFlow(cts.Kit, vendor, req, mergedFilter, fileName)
 	}
+
+	lister := b.client.DataService().Global.Bill.BillItemRawLister(cts.Kit, mergedFilter)
+	return &rest.FileResp{
+		FileName:    fileName,
+		ContentType: export.ContentType(req.Format),
+		WriteTo: func(w io.Writer) error {
+			fileWriter, err := export.NewWriter(req.Format, w, exporter.Header())
+			if err != nil {
+				return err
+			}
+			if _, err := exporter.Export(fileWriter, req.ExportLimit, lister); err != nil {
+				return err
+			}
+			return fileWriter.Close()
+		},
+	}, nil
 }
 
-func exportHuaweiBillItems(kt *kit.Kit, b *billItemSvc, filter *filter.Expression, requireCount uint64) (
-	any, error) {
+func (b *billItemSvc) createExportBillItemFlow(kt *kit.Kit, vendor enumor.Vendor, req *bill.ExportBillItemReq,
+	expr *filter.Expression, fileName string) (*bill.ExportBillItemAsyncResult, error) {
 
-	billListReq := &core.ListReq{Filter: filter, Page: core.NewDefaultBasePage()}
-	_, err := b.client.DataService().HuaWei.Bill.ListBillItem(kt, billListReq)
+	filePath := fmt.Sprintf("exports/bill_items/%s/%d/%02d/%s", vendor, req.BillYear, req.BillMonth, fileName)
+	result, err := b.client.TaskServer().CreateCustomFlow(kt, &taskserver.AddCustomFlowReq{
+		Name: enumor.FlowExportBillItem,
+		Memo: "export bill items",
+		Tasks: []taskserver.CustomFlowTask{
+			itemexport.BuildExportBillItemTask(vendor, req.Format, expr, req.ExportLimit, filePath),
+		},
+	})
 	if err != nil {
-		logs.Errorf("fail to list bill item for export, err: %v, req: %+v, rid: %s", err, billListReq, kt.Rid)
+		logs.Errorf("fail to create bill item export flow, err: %v, req: %+v, rid: %s", err, req, kt.Rid)
 		return nil, err
 	}
+	return &bill.ExportBillItemAsyncResult{FlowID: result.ID}, nil
+}
+
+// GetBillItemExportResult 查询账单明细异步导出任务结果
+func (b *billItemSvc) GetBillItemExportResult(cts *rest.Contexts) (any, error) {
+	flowID := cts.PathParameter("flow_id").String()
+	if len(flowID) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "flow_id is required")
+	}
 
-	// TODO
-	// export as csv
+	err := b.authorizer.AuthorizeWithPerm(cts.Kit,
+		meta.ResourceAttribute{Basic: &meta.Basic{Type: meta.AccountBill, Action: meta.Find}})
+	if err != nil {
+		return nil, err
+	}
 
-	return nil, nil
+	flow, err := b.client.TaskServer().GetFlow(cts.Kit, flowID)
+	if err != nil {
+		return nil, err
+	}
+	if flow.Name != enumor.FlowExportBillItem {
+		return nil, errf.Newf(errf.InvalidParameter, "flow %s is not bill item export flow", flowID)
+	}
+
+	result := &bill.ExportBillItemFlowResult{State: flow.State}
+	if flow.Reason != nil {
+		result.Reason = flow.Reason.Message
+	}
+	if flow.State != enumor.FlowSuccess {
+		return result, nil
+	}
+
+	taskReq := &core.ListReq{
+		Filter: tools.EqualExpression("flow_id", flowID),
+		Page:   core.NewDefaultBasePage(),
+	}
+	tasks, err := b.client.TaskServer().ListTask(cts.Kit, taskReq)
+	if err != nil {
+		logs.Errorf("fail to list bill item export task, err: %v, flow: %s, rid: %s", err, flowID, cts.Kit.Rid)
+		return nil, err
+	}
+	if len(tasks.Details) != 1 {
+		return nil, fmt.Errorf("bill item export flow %s should have exactly one task, got %d",
+			flowID, len(tasks.Details))
+	}
+
+	exportResult := new(itemexport.ExportBillItemResult)
+	if err := json.UnmarshalFromString(string(tasks.Details[0].Result), exportResult); err != nil {
+		logs.Errorf("fail to unmarshal bill item export result, err: %v, flow: %s, rid: %s", err, flowID,
+			cts.Kit.Rid)
+		return nil, err
+	}
+	result.Count = exportResult.Count
+	result.DownloadURL = exportResult.DownloadURL
+	result.ExpiredAt = exportResult.ExpiredAt
+	return result, nil
 }
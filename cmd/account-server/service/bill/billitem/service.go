@@ -40,6 +40,9 @@ func InitBillItemService(c *capability.Capability) {
 
 	h.Add("ListBillItems", "POST", "/vendors/{vendor}/bills/items/list", svc.ListBillItems)
 	h.Add("ExportBillItems", "POST", "/vendors/{vendor}/bills/items/export", svc.ExportBillItems)
+	h.Add("GetBillItemExportResult", "GET", "/bills/items/export/{flow_id}", svc.GetBillItemExportResult)
+	h.Add("ListBillItemCostByResource", "POST", "/bills/items/cost_by_resource/list",
+		svc.ListBillItemCostByResource)
 
 	h.Load(c.WebService)
 }
@@ -0,0 +1,158 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package billsplitrule
+
+import (
+	"fmt"
+
+	asbill "hcm/pkg/api/account-server/bill"
+	"hcm/pkg/api/core"
+	dataservice "hcm/pkg/api/data-service"
+	dsbill "hcm/pkg/api/data-service/bill"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/iam/meta"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// ListBillSplitRule 查询分账规则
+func (s *service) ListBillSplitRule(cts *rest.Contexts) (any, error) {
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	err := s.authorizer.AuthorizeWithPerm(cts.Kit,
+		meta.ResourceAttribute{Basic: &meta.Basic{Type: meta.AccountBill, Action: meta.Find}})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.DataService().Global.Bill.ListBillSplitRule(cts.Kit, req)
+}
+
+// CreateBillSplitRule 创建分账规则，规则在下一次分账（含重新核算）时生效
+func (s *service) CreateBillSplitRule(cts *rest.Contexts) (any, error) {
+	req := new(asbill.BillSplitRuleCreateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	err := s.authorizer.AuthorizeWithPerm(cts.Kit,
+		meta.ResourceAttribute{Basic: &meta.Basic{Type: meta.AccountBill, Action: meta.Create}})
+	if err != nil {
+		return nil, err
+	}
+
+	mainAccount, err := s.client.DataService().Global.MainAccount.GetBasicInfo(cts.Kit, req.MainAccountID)
+	if err != nil {
+		logs.Errorf("fail to get main account %s for create split rule, err: %v, rid: %s",
+			req.MainAccountID, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	createReq := &dsbill.BatchBillSplitRuleCreateReq{
+		Items: []dsbill.BillSplitRuleCreateReq{{
+			Name:          req.Name,
+			Vendor:        mainAccount.Vendor,
+			RootAccountID: mainAccount.ParentAccountID,
+			MainAccountID: mainAccount.ID,
+			Priority:      req.Priority,
+			SplitType:     req.SplitType,
+			Match:         req.Match,
+			Targets:       req.Targets,
+			Memo:          req.Memo,
+		}},
+	}
+	result, err := s.client.DataService().Global.Bill.BatchCreateBillSplitRule(cts.Kit, createReq)
+	if err != nil {
+		logs.Errorf("fail to create bill split rule, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+	if len(result.IDs) != 1 {
+		return nil, fmt.Errorf("create bill split rule return invalid ids: %v", result.IDs)
+	}
+
+	return core.CreateResult{ID: result.IDs[0]}, nil
+}
+
+// UpdateBillSplitRule 更新分账规则
+func (s *service) UpdateBillSplitRule(cts *rest.Contexts) (any, error) {
+	id := cts.PathParameter("id").String()
+	if len(id) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "id is required")
+	}
+	req := new(asbill.BillSplitRuleUpdateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	err := s.authorizer.AuthorizeWithPerm(cts.Kit,
+		meta.ResourceAttribute{Basic: &meta.Basic{Type: meta.AccountBill, Action: meta.Update}})
+	if err != nil {
+		return nil, err
+	}
+
+	updateReq := &dsbill.BillSplitRuleUpdateReq{
+		ID:        id,
+		Name:      req.Name,
+		Priority:  req.Priority,
+		SplitType: req.SplitType,
+		Match:     req.Match,
+		Targets:   req.Targets,
+		Memo:      req.Memo,
+	}
+	if err = s.client.DataService().Global.Bill.UpdateBillSplitRule(cts.Kit, updateReq); err != nil {
+		logs.Errorf("fail to update bill split rule %s, err: %v, rid: %s", id, err, cts.Kit.Rid)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// DeleteBillSplitRule 删除分账规则
+func (s *service) DeleteBillSplitRule(cts *rest.Contexts) (any, error) {
+	id := cts.PathParameter("id").String()
+	if len(id) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "id is required")
+	}
+
+	err := s.authorizer.AuthorizeWithPerm(cts.Kit,
+		meta.ResourceAttribute{Basic: &meta.Basic{Type: meta.AccountBill, Action: meta.Delete}})
+	if err != nil {
+		return nil, err
+	}
+
+	delReq := &dataservice.BatchDeleteReq{Filter: tools.EqualExpression("id", id)}
+	if err = s.client.DataService().Global.Bill.BatchDeleteBillSplitRule(cts.Kit, delReq); err != nil {
+		logs.Errorf("fail to delete bill split rule %s, err: %v, rid: %s", id, err, cts.Kit.Rid)
+		return nil, err
+	}
+	return nil, nil
+}
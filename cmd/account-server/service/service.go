@@ -33,7 +33,9 @@ import (
 	mainaccount "hcm/cmd/account-server/service/account-set/main-account"
 	rootaccount "hcm/cmd/account-server/service/account-set/root-account"
 	"hcm/cmd/account-server/service/bill/billadjustment"
+	"hcm/cmd/account-server/service/bill/billbudget"
 	"hcm/cmd/account-server/service/bill/billitem"
+	"hcm/cmd/account-server/service/bill/billsplitrule"
 	"hcm/cmd/account-server/service/bill/billsummarymain"
 	"hcm/cmd/account-server/service/bill/billsummaryroot"
 	"hcm/cmd/account-server/service/bill/billsyncrecord"
@@ -45,10 +47,12 @@ import (
 	"hcm/pkg/handler"
 	"hcm/pkg/iam/auth"
 	"hcm/pkg/logs"
+	"hcm/pkg/metrics"
 	"hcm/pkg/rest"
 	restcli "hcm/pkg/rest/client"
 	"hcm/pkg/runtime/shutdown"
 	"hcm/pkg/serviced"
+	"hcm/pkg/thirdparty/api-gateway/cmsi"
 	"hcm/pkg/tools/ssl"
 
 	"github.com/emicklei/go-restful/v3"
@@ -91,6 +95,15 @@ func NewService(sd serviced.ServiceDiscover) (*Service, error) {
 		return nil, err
 	}
 
+	var cmsiCli cmsi.Client
+	if cmsiCfg := cc.AccountServer().Cmsi; len(cmsiCfg.Endpoints) != 0 {
+		cmsiCli, err = cmsi.NewClient(&cmsiCfg, metrics.Register())
+		if err != nil {
+			logs.Errorf("failed to create cmsi client, err: %v", err)
+			return nil, err
+		}
+	}
+
 	// start bill manager
 	newBillManager := &bill.BillManager{
 		Sd:     sd,
@@ -100,6 +113,7 @@ func NewService(sd serviced.ServiceDiscover) (*Service, error) {
 		},
 		CurrentMainControllers: make(map[string]*bill.MainAccountController),
 		CurrentRootControllers: make(map[string]*bill.RootAccountController),
+		BudgetEvaluator:        bill.NewBudgetEvaluator(apiClientSet, cmsiCli),
 	}
 
 	svr := &Service{
@@ -197,6 +211,8 @@ func (s *Service) apiSet() *restful.Container {
 	billitem.InitBillItemService(c)
 	billadjustment.InitBillAdjustmentService(c)
 	billsyncrecord.InitService(c)
+	billsplitrule.InitService(c)
+	billbudget.InitService(c)
 
 	return restful.NewContainer().Add(c.WebService)
 }
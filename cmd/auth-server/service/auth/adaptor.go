@@ -92,6 +92,18 @@ func AdaptAuthOptions(a *meta.ResourceAttribute) (client.ActionID, []client.Reso
 		return genArgumentTemplateResource(a)
 	case meta.Cert:
 		return genCertResource(a)
+	case meta.Bucket:
+		return genBucketResource(a)
+	case meta.DatabaseInstance:
+		return genDatabaseInstanceResource(a)
+	case meta.K8sCluster:
+		return genK8sClusterResource(a)
+	case meta.KeyPair:
+		return genKeyPairResource(a)
+	case meta.PrivateDnsZone:
+		return genPrivateDnsZoneResource(a)
+	case meta.IpamPool:
+		return genIpamPoolResource(a)
 	case meta.LoadBalancer:
 		return genLoadBalancerResource(a)
 	case meta.Listener:
@@ -572,6 +572,59 @@ func genCertResource(a *meta.ResourceAttribute) (client.ActionID, []client.Resou
 	}
 }
 
+// genBucketResource generate bucket related iam resource, bucket only supports find and assign to biz.
+func genBucketResource(a *meta.ResourceAttribute) (client.ActionID, []client.Resource, error) {
+	switch a.Basic.Action {
+	case meta.Find, meta.Assign:
+		return genIaaSResourceResource(a)
+	default:
+		return "", nil, errf.Newf(errf.InvalidParameter, "unsupported hcm action: %s", a.Basic.Action)
+	}
+}
+
+// genDatabaseInstanceResource generate database instance related iam resource.
+func genDatabaseInstanceResource(a *meta.ResourceAttribute) (client.ActionID, []client.Resource, error) {
+	return genIaaSResourceResource(a)
+}
+
+// genK8sClusterResource generate k8s cluster related iam resource, k8s cluster only supports find and assign to biz.
+func genK8sClusterResource(a *meta.ResourceAttribute) (client.ActionID, []client.Resource, error) {
+	switch a.Basic.Action {
+	case meta.Find, meta.Assign:
+		return genIaaSResourceResource(a)
+	default:
+		return "", nil, errf.Newf(errf.InvalidParameter, "unsupported hcm action: %s", a.Basic.Action)
+	}
+}
+
+// genKeyPairResource generate key pair related iam resource.
+func genKeyPairResource(a *meta.ResourceAttribute) (client.ActionID, []client.Resource, error) {
+	return genIaaSResourceResource(a)
+}
+
+// genPrivateDnsZoneResource generate private dns zone related iam resource.
+func genPrivateDnsZoneResource(a *meta.ResourceAttribute) (client.ActionID, []client.Resource, error) {
+	return genIaaSResourceResource(a)
+}
+
+// genIpamPoolResource generate ipam pool related iam resource. ipam pool does not belong to any account,
+// platform management uses global configuration permission, biz only can find pools assigned to it.
+func genIpamPoolResource(a *meta.ResourceAttribute) (client.ActionID, []client.Resource, error) {
+	if a.BizID > 0 {
+		if a.Basic.Action != meta.Find {
+			return "", nil, errf.Newf(errf.InvalidParameter, "unsupported biz hcm action: %s", a.Basic.Action)
+		}
+		return genBizIaaSResResource(a)
+	}
+
+	switch a.Basic.Action {
+	case meta.Find, meta.Create, meta.Update, meta.Delete:
+		return sys.GlobalConfiguration, make([]client.Resource, 0), nil
+	default:
+		return "", nil, errf.Newf(errf.InvalidParameter, "unsupported hcm action: %s", a.Basic.Action)
+	}
+}
+
 // genLoadBalancerResource generate load balancer related iam resource.
 func genLoadBalancerResource(a *meta.ResourceAttribute) (client.ActionID, []client.Resource, error) {
 	res := client.Resource{
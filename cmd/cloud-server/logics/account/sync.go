@@ -28,14 +28,18 @@ import (
 	"hcm/cmd/cloud-server/service/sync/azure"
 	"hcm/cmd/cloud-server/service/sync/gcp"
 	"hcm/cmd/cloud-server/service/sync/huawei"
+	"hcm/cmd/cloud-server/service/sync/kaopu"
 	"hcm/cmd/cloud-server/service/sync/lock"
 	"hcm/cmd/cloud-server/service/sync/tcloud"
+	"hcm/cmd/cloud-server/service/sync/zenlayer"
+	proto "hcm/pkg/api/cloud-server/account"
 	"hcm/pkg/api/core"
 	protocloud "hcm/pkg/api/data-service/cloud/zone"
 	"hcm/pkg/client"
 	dataservice "hcm/pkg/client/data-service"
 	"hcm/pkg/criteria/constant"
 	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
 	"hcm/pkg/dal/dao/tools"
 	"hcm/pkg/kit"
 	"hcm/pkg/logs"
@@ -90,6 +94,26 @@ func Sync(kt *kit.Kit, cli *client.ClientSet, vendor enumor.Vendor, accountID st
 	return nil
 }
 
+// SyncByEvents 根据资源变更事件同步事件中指定的资源，不占用账号同步锁，可以和账号全量同步并行执行。
+func SyncByEvents(kt *kit.Kit, cli *client.ClientSet, vendor enumor.Vendor, accountID string,
+	events []proto.ResSyncEvent) error {
+
+	syncer, ok := vendorSyncerMap[vendor].(TargetSyncer)
+	if !ok {
+		return errf.Newf(errf.InvalidParameter, "vendor: %s not support sync by event", vendor)
+	}
+
+	for idx := range events {
+		if err := syncer.SyncTargetResource(kt, cli, accountID, &events[idx]); err != nil {
+			logs.Errorf("[%s] sync account %s by event failed, err: %v, event: %+v, rid: %s", vendor, accountID, err,
+				events[idx], kt.Rid)
+			return err
+		}
+	}
+
+	return nil
+}
+
 // check is there any tree types of public resources, if one of that type does not exist, we sync all public resources
 func isNeedSyncPublicResource(kt *kit.Kit, dataCli *dataservice.Client, syncer VendorSyncer) (
 	bool, error) {
@@ -113,7 +137,10 @@ func isNeedSyncPublicResource(kt *kit.Kit, dataCli *dataservice.Client, syncer V
 		return true, nil
 	}
 
-	// 3. check image list
+	// 3. check image list, vendors without public image skip this check
+	if !syncer.SyncPublicImage() {
+		return false, nil
+	}
 	imageNum, err := syncer.CountImage(kt, dataCli)
 	if err != nil {
 		return false, err
@@ -131,12 +158,25 @@ type VendorSyncer interface {
 	CountRegion(kt *kit.Kit, dataCli *dataservice.Client) (uint64, error)
 	CountZone(kt *kit.Kit, dataCli *dataservice.Client) (uint64, error)
 	CountImage(kt *kit.Kit, dataCli *dataservice.Client) (uint64, error)
+	SyncPublicImage() bool
 	SyncAllResource(kt *kit.Kit, cli *client.ClientSet, account string,
 		syncPubRes bool) (resType enumor.CloudResourceType, err error)
 }
 
+// IncrementalSyncer 支持增量同步的云厂商同步器，只同步同步水位之后发生变更的资源。
+type IncrementalSyncer interface {
+	SyncIncrementalResource(kt *kit.Kit, cli *client.ClientSet, account string) (enumor.CloudResourceType, error)
+}
+
+// TargetSyncer 支持同步指定云ID资源的云厂商同步器，用于资源变更事件触发的同步。
+type TargetSyncer interface {
+	SyncTargetResource(kt *kit.Kit, cli *client.ClientSet, account string, event *proto.ResSyncEvent) error
+}
+
 type generalSyncer struct {
 	vendor enumor.Vendor
+	// withoutPublicImage 云厂商不提供公共镜像同步，如 zenlayer、kaopu
+	withoutPublicImage bool
 }
 
 func newAzureSyncer() azureSyncer {
@@ -159,6 +199,14 @@ func newTCloudSyncer() tcloudSyncer {
 	return tcloudSyncer{generalSyncer{vendor: enumor.TCloud}}
 }
 
+func newZenlayerSyncer() zenlayerSyncer {
+	return zenlayerSyncer{generalSyncer{vendor: enumor.Zenlayer, withoutPublicImage: true}}
+}
+
+func newKaopuSyncer() kaopuSyncer {
+	return kaopuSyncer{generalSyncer{vendor: enumor.Kaopu, withoutPublicImage: true}}
+}
+
 // GetAvailableVendorSyncers ...
 func GetAvailableVendorSyncers() []VendorSyncer {
 	return availableVendorSyncer
@@ -170,15 +218,19 @@ var availableVendorSyncer = []VendorSyncer{
 	newHuaweiSyncer(),
 	newGcpSyncer(),
 	newAzureSyncer(),
+	newZenlayerSyncer(),
+	newKaopuSyncer(),
 }
 
 // vendorSyncerMap
 var vendorSyncerMap = map[enumor.Vendor]VendorSyncer{
-	enumor.TCloud: newTCloudSyncer(),
-	enumor.Aws:    newAwsSyncer(),
-	enumor.HuaWei: newHuaweiSyncer(),
-	enumor.Gcp:    newGcpSyncer(),
-	enumor.Azure:  newAzureSyncer(),
+	enumor.TCloud:   newTCloudSyncer(),
+	enumor.Aws:      newAwsSyncer(),
+	enumor.HuaWei:   newHuaweiSyncer(),
+	enumor.Gcp:      newGcpSyncer(),
+	enumor.Azure:    newAzureSyncer(),
+	enumor.Zenlayer: newZenlayerSyncer(),
+	enumor.Kaopu:    newKaopuSyncer(),
 }
 
 // CountZone ...
@@ -213,6 +265,11 @@ func (c generalSyncer) Vendor() enumor.Vendor {
 	return c.vendor
 }
 
+// SyncPublicImage 是否同步公共镜像，不同步公共镜像的云厂商判断是否需要同步公共资源时不检查镜像数量
+func (c generalSyncer) SyncPublicImage() bool {
+	return !c.withoutPublicImage
+}
+
 // tcloudSyncer ...
 type tcloudSyncer struct {
 	generalSyncer
@@ -242,6 +299,19 @@ func (t tcloudSyncer) SyncAllResource(kt *kit.Kit, cli *client.ClientSet, accoun
 	return tcloud.SyncAllResource(kt, cli, opt)
 }
 
+// SyncTargetResource ...
+func (t tcloudSyncer) SyncTargetResource(kt *kit.Kit, cli *client.ClientSet, account string,
+	event *proto.ResSyncEvent) error {
+
+	opt := &tcloud.SyncTargetResourceOption{
+		AccountID: account,
+		Region:    event.Region,
+		ResType:   event.ResType,
+		CloudIDs:  event.CloudIDs,
+	}
+	return tcloud.SyncTargetResource(kt, cli, opt)
+}
+
 // awsSyncer ...
 type awsSyncer struct {
 	generalSyncer
@@ -271,6 +341,29 @@ func (t awsSyncer) SyncAllResource(kt *kit.Kit, cli *client.ClientSet, account s
 	return aws.SyncAllResource(kt, cli, opt)
 }
 
+// SyncIncrementalResource ...
+func (t awsSyncer) SyncIncrementalResource(kt *kit.Kit, cli *client.ClientSet, account string) (
+	enumor.CloudResourceType, error) {
+
+	opt := &aws.SyncIncrementalResourceOption{
+		AccountID: account,
+	}
+	return aws.SyncIncrementalResource(kt, cli, opt)
+}
+
+// SyncTargetResource ...
+func (t awsSyncer) SyncTargetResource(kt *kit.Kit, cli *client.ClientSet, account string,
+	event *proto.ResSyncEvent) error {
+
+	opt := &aws.SyncTargetResourceOption{
+		AccountID: account,
+		Region:    event.Region,
+		ResType:   event.ResType,
+		CloudIDs:  event.CloudIDs,
+	}
+	return aws.SyncTargetResource(kt, cli, opt)
+}
+
 // huaweiSyncer ...
 type huaweiSyncer struct {
 	generalSyncer
@@ -363,3 +456,61 @@ func (t azureSyncer) SyncAllResource(kt *kit.Kit, cli *client.ClientSet, account
 	}
 	return azure.SyncAllResource(kt, cli, opt)
 }
+
+// zenlayerSyncer ...
+type zenlayerSyncer struct {
+	generalSyncer
+}
+
+// CountRegion ...
+func (t zenlayerSyncer) CountRegion(kt *kit.Kit, dataCli *dataservice.Client) (uint64, error) {
+	req := &core.ListReq{
+		Filter: tools.AllExpression(),
+		Page:   core.NewCountPage(),
+	}
+	result, err := dataCli.Zenlayer.Region.ListRegion(kt.Ctx, kt.Header(), req)
+	if err != nil {
+		return 0, err
+	}
+	return result.Count, nil
+}
+
+// SyncAllResource ...
+func (t zenlayerSyncer) SyncAllResource(kt *kit.Kit, cli *client.ClientSet, account string,
+	syncPubRes bool) (reType enumor.CloudResourceType, err error) {
+
+	opt := &zenlayer.SyncAllResourceOption{
+		AccountID:          account,
+		SyncPublicResource: syncPubRes,
+	}
+	return zenlayer.SyncAllResource(kt, cli, opt)
+}
+
+// kaopuSyncer ...
+type kaopuSyncer struct {
+	generalSyncer
+}
+
+// CountRegion ...
+func (t kaopuSyncer) CountRegion(kt *kit.Kit, dataCli *dataservice.Client) (uint64, error) {
+	req := &core.ListReq{
+		Filter: tools.AllExpression(),
+		Page:   core.NewCountPage(),
+	}
+	result, err := dataCli.Kaopu.Region.ListRegion(kt.Ctx, kt.Header(), req)
+	if err != nil {
+		return 0, err
+	}
+	return result.Count, nil
+}
+
+// SyncAllResource ...
+func (t kaopuSyncer) SyncAllResource(kt *kit.Kit, cli *client.ClientSet, account string,
+	syncPubRes bool) (reType enumor.CloudResourceType, err error) {
+
+	opt := &kaopu.SyncAllResourceOption{
+		AccountID:          account,
+		SyncPublicResource: syncPubRes,
+	}
+	return kaopu.SyncAllResource(kt, cli, opt)
+}
@@ -0,0 +1,135 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package bucket ...
+package bucket
+
+import (
+	"fmt"
+
+	"hcm/cmd/cloud-server/logics/audit"
+	"hcm/pkg/api/core"
+	corebucket "hcm/pkg/api/core/cloud/bucket"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/runtime/filter"
+	"hcm/pkg/tools/slice"
+)
+
+// Interface define bucket interface.
+type Interface interface {
+	Assign(kt *kit.Kit, ids []string, bizID int64) error
+	ListPublicBucket(kt *kit.Kit, expr *filter.Expression) ([]corebucket.BaseBucket, error)
+}
+
+type bucket struct {
+	client *client.ClientSet
+	audit  audit.Interface
+}
+
+// NewBucket new bucket.
+func NewBucket(client *client.ClientSet, audit audit.Interface) Interface {
+	return &bucket{
+		client: client,
+		audit:  audit,
+	}
+}
+
+// Assign 分配存储桶到业务下，已分配到其他业务的存储桶不允许再次分配
+func (b *bucket) Assign(kt *kit.Kit, ids []string, bizID int64) error {
+	if len(ids) == 0 {
+		return fmt.Errorf("ids is required")
+	}
+
+	listReq := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleIn("id", ids),
+			tools.RuleNotIn("bk_biz_id", []int64{constant.UnassignedBiz, bizID}),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	listResp, err := b.client.DataService().Global.Bucket.List(kt, listReq)
+	if err != nil {
+		logs.Errorf("list bucket failed, err: %v, req: %+v, rid: %s", err, listReq, kt.Rid)
+		return err
+	}
+
+	if len(listResp.Details) != 0 {
+		return fmt.Errorf("bucket(ids=%v) already assigned", slice.Map(listResp.Details,
+			func(one corebucket.BaseBucket) string { return one.ID }))
+	}
+
+	// create assign audit
+	if err = b.audit.ResBizAssignAudit(kt, enumor.BucketAuditResType, ids, bizID); err != nil {
+		logs.Errorf("create assign bucket audit failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	req := &protocloud.BucketBatchUpdateReq{
+		IDs:     ids,
+		BkBizID: bizID,
+	}
+	if err = b.client.DataService().Global.Bucket.BatchUpdate(kt, req); err != nil {
+		logs.Errorf("batch update bucket failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// ListPublicBucket 查询满足条件且公开可读（公有读或公有读写）的存储桶
+func (b *bucket) ListPublicBucket(kt *kit.Kit, expr *filter.Expression) ([]corebucket.BaseBucket, error) {
+	publicRule := tools.RuleIn("public_access",
+		[]enumor.BucketPublicAccess{enumor.BucketPublicRead, enumor.BucketPublicReadWrite})
+	publicExpr := tools.ExpressionAnd(publicRule)
+	if expr != nil {
+		var err error
+		if publicExpr, err = tools.And(expr, publicRule); err != nil {
+			return nil, err
+		}
+	}
+
+	listReq := &core.ListReq{
+		Filter: publicExpr,
+		Page:   core.NewDefaultBasePage(),
+	}
+	result := make([]corebucket.BaseBucket, 0)
+	for {
+		resp, err := b.client.DataService().Global.Bucket.List(kt, listReq)
+		if err != nil {
+			logs.Errorf("list public bucket failed, err: %v, req: %+v, rid: %s", err, listReq, kt.Rid)
+			return nil, err
+		}
+
+		result = append(result, resp.Details...)
+
+		if len(resp.Details) < int(core.DefaultMaxPageLimit) {
+			break
+		}
+
+		listReq.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return result, nil
+}
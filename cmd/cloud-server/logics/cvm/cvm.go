@@ -24,6 +24,7 @@ import (
 	"hcm/cmd/cloud-server/logics/audit"
 	"hcm/cmd/cloud-server/logics/disk"
 	"hcm/cmd/cloud-server/logics/eip"
+	"hcm/pkg/api/cloud-server/recycle"
 	"hcm/pkg/api/core"
 	rr "hcm/pkg/api/core/recycle-record"
 	"hcm/pkg/client"
@@ -38,9 +39,11 @@ type Interface interface {
 	BatchStopCvm(kt *kit.Kit, basicInfoMap map[string]types.CloudResourceBasicInfo) (*core.BatchOperateAllResult, error)
 	BatchDeleteCvm(kt *kit.Kit, basicInfoMap map[string]types.CloudResourceBasicInfo) (*core.BatchOperateResult, error)
 	DestroyRecycledCvm(kt *kit.Kit, infoMap map[string]types.CloudResourceBasicInfo,
-		records []rr.CvmRecycleRecord) (*core.BatchOperateResult, error)
+		records []rr.CvmRecycleRecord) (*core.BatchOperateAllResult, error)
 	GetNotCmdbRecyclableHosts(kt *kit.Kit, bizHostsIds map[int64][]string) ([]string, error)
 	RecyclePreCheck(kt *kit.Kit, infoMap map[string]types.CloudResourceBasicInfo) error
+	SplitK8sNodeCvm(kt *kit.Kit, infoMap map[string]types.CloudResourceBasicInfo) (
+		map[string]types.CloudResourceBasicInfo, []recycle.RecycleFailedInfo, error)
 	BatchFinalizeRelRecord(kt *kit.Kit, resType enumor.CloudResourceType,
 		status enumor.RecycleRecordStatus, resIds []string) error
 }
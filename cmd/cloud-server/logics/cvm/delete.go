@@ -22,6 +22,7 @@ package cvm
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	"hcm/cmd/hc-service/logics/res-sync/common"
 	"hcm/pkg/api/cloud-server/recycle"
@@ -176,7 +177,7 @@ func (c *cvm) batchDeleteCvm(kt *kit.Kit, vendor enumor.Vendor, infoMap []types.
 //  1. 用户手动发起
 //  2. 由定时回收任务触发
 func (c *cvm) DestroyRecycledCvm(kt *kit.Kit, cvmBasicInfo map[string]types.CloudResourceBasicInfo,
-	records []corerecord.CvmRecycleRecord) (*core.BatchOperateResult, error) {
+	records []corerecord.CvmRecycleRecord) (*core.BatchOperateAllResult, error) {
 
 	if len(cvmBasicInfo) == 0 {
 		return nil, nil
@@ -190,8 +191,21 @@ func (c *cvm) DestroyRecycledCvm(kt *kit.Kit, cvmBasicInfo map[string]types.Clou
 		cvmRecycleDetails[record.ResID] = record.Detail
 	}
 
+	destroyResult := new(core.BatchOperateAllResult)
+
+	// 0. 容器集群节点不销毁，只跳过对应的主机
+	cvmBasicInfo, rejected, err := c.SplitK8sNodeCvm(kt, cvmBasicInfo)
+	if err != nil {
+		return nil, err
+	}
+	for _, one := range rejected {
+		destroyResult.Failed = append(destroyResult.Failed,
+			core.FailedInfo{ID: one.ID, Error: errf.New(errf.InvalidParameter, one.Reason)})
+	}
+	if len(cvmBasicInfo) == 0 {
+		return destroyResult, batchFailedError(destroyResult.Failed)
+	}
 	leftCvmInfo := maps.Clone(cvmBasicInfo)
-	destroyResult := new(core.BatchOperateResult)
 
 	// 1. 检查cmdb模块和机器状态
 	if err := c.RecyclePreCheck(kt, cvmBasicInfo); err != nil {
@@ -208,7 +222,7 @@ func (c *cvm) DestroyRecycledCvm(kt *kit.Kit, cvmBasicInfo map[string]types.Clou
 
 	// 全部失败
 	if len(cvmStatus) == 0 {
-		return destroyResult, destroyResult.Failed.Error
+		return destroyResult, batchFailedError(destroyResult.Failed)
 	}
 	defer func(c *cvm, kt *kit.Kit, cvmStatus map[string]*recycle.CvmDetail) {
 		err := c.destroyCleanUp(kt, cvmStatus)
@@ -217,18 +231,41 @@ func (c *cvm) DestroyRecycledCvm(kt *kit.Kit, cvmBasicInfo map[string]types.Clou
 		}
 	}(c, kt, cvmStatus)
 	// 4. 销毁主机
-	delRes, err := c.BatchDeleteCvm(kt, maps.FilterByValue(leftCvmInfo, func(info types.CloudResourceBasicInfo) bool {
+	toDelete := maps.FilterByValue(leftCvmInfo, func(info types.CloudResourceBasicInfo) bool {
 		return cvmStatus[info.ID] != nil && cvmStatus[info.ID].FailedAt == ""
-	}))
+	})
+	delRes, err := c.BatchDeleteCvm(kt, toDelete)
 	if err != nil {
 		logs.Errorf("Fail to delete cvm, err: %v, cvmIds: %v, rid: %s", err, delRes, kt.Rid)
-		for _, cvmId := range delRes.Succeeded {
-			delete(cvmStatus, cvmId)
+		failed := core.FailedInfo{Error: err}
+		if delRes != nil {
+			for _, cvmId := range delRes.Succeeded {
+				delete(cvmStatus, cvmId)
+			}
+			destroyResult.Succeeded = append(destroyResult.Succeeded, delRes.Succeeded...)
+			if delRes.Failed != nil {
+				failed = *delRes.Failed
+			}
 		}
+		destroyResult.Failed = append(destroyResult.Failed, failed)
+	} else {
+		destroyResult.Succeeded = append(destroyResult.Succeeded, maps.Keys(toDelete)...)
 	}
 	// 销毁关联资源
 	c.destroyRelatedRes(kt, cvmStatus)
-	return destroyResult, nil
+	return destroyResult, batchFailedError(destroyResult.Failed)
+}
+
+// batchFailedError 将批量操作中所有失败的资源合并成一个错误，没有失败时返回nil
+func batchFailedError(failed []core.FailedInfo) error {
+	if len(failed) == 0 {
+		return nil
+	}
+	errs := make([]error, 0, len(failed))
+	for _, one := range failed {
+		errs = append(errs, fmt.Errorf("%s: %v", one.ID, one.Error))
+	}
+	return errors.Join(errs...)
 }
 
 func (c *cvm) destroyRelatedRes(kt *kit.Kit, cvmStatus map[string]*recycle.CvmDetail) {
@@ -545,7 +582,7 @@ func (c *cvm) getCmdbHostId(kt *kit.Kit, bizID int64,
 	return hostIDs, nil
 }
 
-// RecyclePreCheck  回收预校验，包含主机状态和CC待回收模块检查
+// RecyclePreCheck  回收预校验，包含主机状态和CC待回收模块检查，容器集群节点需要先通过 SplitK8sNodeCvm 剔除
 func (c *cvm) RecyclePreCheck(kt *kit.Kit, basicInfoMap map[string]types.CloudResourceBasicInfo) error {
 
 	leftInfo := maps.Clone(basicInfoMap)
@@ -579,6 +616,49 @@ func (c *cvm) RecyclePreCheck(kt *kit.Kit, basicInfoMap map[string]types.CloudRe
 	return nil
 }
 
+// SplitK8sNodeCvm 从主机中剔除容器集群节点，返回剩余的主机和被拒绝的节点主机。容器集群节点由集群管理生命周期，不允许回收
+func (c *cvm) SplitK8sNodeCvm(kt *kit.Kit, infoMap map[string]types.CloudResourceBasicInfo) (
+	map[string]types.CloudResourceBasicInfo, []recycle.RecycleFailedInfo, error) {
+
+	nodeCvmIDs := make(map[string]struct{})
+	for _, batch := range slice.Split(maps.Keys(infoMap), int(core.DefaultMaxPageLimit)) {
+		listReq := &core.ListReq{
+			Fields: []string{"cvm_id"},
+			Filter: tools.ContainersExpression("cvm_id", batch),
+			Page:   core.NewDefaultBasePage(),
+		}
+		rels, err := c.client.DataService().Global.K8sNodePoolCvmRel.List(kt, listReq)
+		if err != nil {
+			logs.Errorf("list k8s node pool cvm rel failed, err: %v, cvm ids: %v, rid: %s", err, batch, kt.Rid)
+			return nil, nil, err
+		}
+		for _, rel := range rels.Details {
+			nodeCvmIDs[rel.CvmID] = struct{}{}
+		}
+	}
+
+	left, rejected := splitK8sNodeCvm(infoMap, nodeCvmIDs)
+	return left, rejected, nil
+}
+
+func splitK8sNodeCvm(infoMap map[string]types.CloudResourceBasicInfo, nodeCvmIDs map[string]struct{}) (
+	map[string]types.CloudResourceBasicInfo, []recycle.RecycleFailedInfo) {
+
+	left := make(map[string]types.CloudResourceBasicInfo, len(infoMap))
+	rejected := make([]recycle.RecycleFailedInfo, 0)
+	for id, info := range infoMap {
+		if _, exists := nodeCvmIDs[id]; exists {
+			rejected = append(rejected, recycle.RecycleFailedInfo{ID: id,
+				Reason: "cvm is k8s cluster node, can not be recycled"})
+			continue
+		}
+		left[id] = info
+	}
+	sort.Slice(rejected, func(i, j int) bool { return rejected[i].ID < rejected[j].ID })
+
+	return left, rejected
+}
+
 // BatchFinalizeRelRecord 批量更改关联资源的状态
 func (c *cvm) BatchFinalizeRelRecord(kt *kit.Kit, resType enumor.CloudResourceType,
 	status enumor.RecycleRecordStatus, resIds []string) error {
@@ -0,0 +1,89 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package cvm
+
+import (
+	"reflect"
+	"testing"
+
+	"hcm/pkg/api/cloud-server/recycle"
+	"hcm/pkg/dal/dao/types"
+)
+
+func TestSplitK8sNodeCvm(t *testing.T) {
+	infoMap := map[string]types.CloudResourceBasicInfo{
+		"cvm-1": {ID: "cvm-1"},
+		"cvm-2": {ID: "cvm-2"},
+		"cvm-3": {ID: "cvm-3"},
+	}
+	reason := "cvm is k8s cluster node, can not be recycled"
+
+	cases := []struct {
+		name         string
+		nodeCvmIDs   map[string]struct{}
+		wantLeft     []string
+		wantRejected []recycle.RecycleFailedInfo
+	}{
+		{
+			name:         "no k8s node",
+			nodeCvmIDs:   map[string]struct{}{},
+			wantLeft:     []string{"cvm-1", "cvm-2", "cvm-3"},
+			wantRejected: []recycle.RecycleFailedInfo{},
+		},
+		{
+			name:         "part of cvm are k8s nodes",
+			nodeCvmIDs:   map[string]struct{}{"cvm-3": {}, "cvm-1": {}},
+			wantLeft:     []string{"cvm-2"},
+			wantRejected: []recycle.RecycleFailedInfo{{ID: "cvm-1", Reason: reason}, {ID: "cvm-3", Reason: reason}},
+		},
+		{
+			name:       "all cvm are k8s nodes",
+			nodeCvmIDs: map[string]struct{}{"cvm-1": {}, "cvm-2": {}, "cvm-3": {}},
+			wantLeft:   []string{},
+			wantRejected: []recycle.RecycleFailedInfo{{ID: "cvm-1", Reason: reason}, {ID: "cvm-2", Reason: reason},
+				{ID: "cvm-3", Reason: reason}},
+		},
+		{
+			name:         "node not in request",
+			nodeCvmIDs:   map[string]struct{}{"cvm-4": {}},
+			wantLeft:     []string{"cvm-1", "cvm-2", "cvm-3"},
+			wantRejected: []recycle.RecycleFailedInfo{},
+		},
+	}
+
+	for _, c := range cases {
+		left, rejected := splitK8sNodeCvm(infoMap, c.nodeCvmIDs)
+		if len(left) != len(c.wantLeft) {
+			t.Errorf("%s: expected left %v, got %v", c.name, c.wantLeft, left)
+		}
+		for _, id := range c.wantLeft {
+			if _, exists := left[id]; !exists {
+				t.Errorf("%s: expected %s left, got %v", c.name, id, left)
+			}
+		}
+		if !reflect.DeepEqual(rejected, c.wantRejected) {
+			t.Errorf("%s: expected rejected %+v, got %+v", c.name, c.wantRejected, rejected)
+		}
+	}
+
+	if len(infoMap) != 3 {
+		t.Errorf("split should not modify the input map, got %v", infoMap)
+	}
+}
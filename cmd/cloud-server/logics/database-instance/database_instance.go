@@ -0,0 +1,155 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package dbinstance ...
+package dbinstance
+
+import (
+	"fmt"
+
+	"hcm/cmd/cloud-server/logics/audit"
+	"hcm/pkg/api/core"
+	coredbinstance "hcm/pkg/api/core/cloud/database-instance"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	hcdbinstance "hcm/pkg/api/hc-service/database-instance"
+	"hcm/pkg/client"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/dal/dao/types"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/slice"
+)
+
+// Interface define database instance interface.
+type Interface interface {
+	Assign(kt *kit.Kit, ids []string, bizID int64) error
+	DeleteDatabaseInstance(kt *kit.Kit, vendor enumor.Vendor, id string) error
+	DeleteRecycledDatabaseInstance(kt *kit.Kit, infoMap map[string]types.CloudResourceBasicInfo) (
+		*core.BatchOperateResult, error)
+}
+
+type dbInstance struct {
+	client *client.ClientSet
+	audit  audit.Interface
+}
+
+// NewDatabaseInstance new database instance.
+func NewDatabaseInstance(client *client.ClientSet, audit audit.Interface) Interface {
+	return &dbInstance{
+		client: client,
+		audit:  audit,
+	}
+}
+
+// Assign 分配云数据库实例到业务下，已分配到其他业务的实例不允许再次分配
+func (d *dbInstance) Assign(kt *kit.Kit, ids []string, bizID int64) error {
+	if len(ids) == 0 {
+		return fmt.Errorf("ids is required")
+	}
+
+	listReq := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleIn("id", ids),
+			tools.RuleNotIn("bk_biz_id", []int64{constant.UnassignedBiz, bizID}),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	listResp, err := d.client.DataService().Global.DatabaseInstance.List(kt, listReq)
+	if err != nil {
+		logs.Errorf("list database instance failed, err: %v, req: %+v, rid: %s", err, listReq, kt.Rid)
+		return err
+	}
+
+	if len(listResp.Details) != 0 {
+		return fmt.Errorf("database instance(ids=%v) already assigned", slice.Map(listResp.Details,
+			func(one coredbinstance.BaseDatabaseInstance) string { return one.ID }))
+	}
+
+	// create assign audit
+	if err = d.audit.ResBizAssignAudit(kt, enumor.DatabaseInstanceAuditResType, ids, bizID); err != nil {
+		logs.Errorf("create assign database instance audit failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	req := &protocloud.DatabaseInstanceBatchUpdateReq{
+		IDs:     ids,
+		BkBizID: bizID,
+	}
+	if err = d.client.DataService().Global.DatabaseInstance.BatchUpdate(kt, req); err != nil {
+		logs.Errorf("batch update database instance failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteDatabaseInstance delete database instance.
+func (d *dbInstance) DeleteDatabaseInstance(kt *kit.Kit, vendor enumor.Vendor, id string) error {
+	// create delete audit.
+	err := d.audit.ResDeleteAudit(kt, enumor.DatabaseInstanceAuditResType, []string{id})
+	if err != nil {
+		logs.Errorf("create delete audit failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	deleteReq := &hcdbinstance.DatabaseInstanceDeleteReq{ID: id}
+
+	switch vendor {
+	case enumor.TCloud:
+		return d.client.HCService().TCloud.DatabaseInstance.DeleteDatabaseInstance(kt, deleteReq)
+	case enumor.Aws:
+		return d.client.HCService().Aws.DatabaseInstance.DeleteDatabaseInstance(kt, deleteReq)
+	case enumor.HuaWei:
+		return d.client.HCService().HuaWei.DatabaseInstance.DeleteDatabaseInstance(kt, deleteReq)
+	case enumor.Gcp:
+		return d.client.HCService().Gcp.DatabaseInstance.DeleteDatabaseInstance(kt, deleteReq)
+	case enumor.Azure:
+		return d.client.HCService().Azure.DatabaseInstance.DeleteDatabaseInstance(kt, deleteReq)
+	default:
+		return errf.NewFromErr(errf.InvalidParameter, fmt.Errorf("no support vendor: %s", vendor))
+	}
+}
+
+// DeleteRecycledDatabaseInstance batch delete recycled database instance.
+func (d *dbInstance) DeleteRecycledDatabaseInstance(kt *kit.Kit, basicInfoMap map[string]types.CloudResourceBasicInfo) (
+	*core.BatchOperateResult, error) {
+
+	if len(basicInfoMap) == 0 {
+		return nil, nil
+	}
+
+	if len(basicInfoMap) > constant.BatchOperationMaxLimit {
+		return nil, errf.Newf(errf.InvalidParameter, "database instance length should <= %d",
+			constant.BatchOperationMaxLimit)
+	}
+
+	res := new(core.BatchOperateResult)
+	for id, info := range basicInfoMap {
+		if err := d.DeleteDatabaseInstance(kt, info.Vendor, id); err != nil {
+			res.Failed = &core.FailedInfo{ID: id, Error: err}
+			return res, err
+		}
+		res.Succeeded = append(res.Succeeded, id)
+	}
+
+	return res, nil
+}
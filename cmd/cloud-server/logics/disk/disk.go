@@ -30,6 +30,8 @@ import (
 	protoaudit "hcm/pkg/api/data-service/audit"
 	"hcm/pkg/api/data-service/cloud"
 	hcproto "hcm/pkg/api/hc-service/disk"
+	hcsnapshot "hcm/pkg/api/hc-service/snapshot"
+	"hcm/pkg/api/hc-service/sync"
 	"hcm/pkg/client"
 	"hcm/pkg/criteria/constant"
 	"hcm/pkg/criteria/enumor"
@@ -38,6 +40,7 @@ import (
 	"hcm/pkg/dal/dao/types"
 	"hcm/pkg/kit"
 	"hcm/pkg/logs"
+	"hcm/pkg/runtime/filter"
 	"hcm/pkg/tools/classifier"
 	"hcm/pkg/tools/converter"
 	"hcm/pkg/tools/slice"
@@ -48,6 +51,9 @@ type Interface interface {
 	DetachDisk(kt *kit.Kit, vendor enumor.Vendor, cvmID, diskID string) error
 	DeleteDisk(kt *kit.Kit, vendor enumor.Vendor, diskID string) error
 	DeleteRecycledDisk(kt *kit.Kit, infoMap map[string]types.CloudResourceBasicInfo) (*core.BatchOperateResult, error)
+	CreateFinalSnapshot(kt *kit.Kit, vendor enumor.Vendor, diskID, snapshotCloudID string) (string, error)
+	GetFinalSnapshotState(kt *kit.Kit, info *types.CloudResourceBasicInfo, snapshotCloudID string) (
+		FinalSnapshotState, error)
 
 	BatchGetDiskInfo(kt *kit.Kit, cvmDetail map[string]*recycle.CvmDetail) (err error)
 	BatchDetach(kt *kit.Kit, cvmRecycleMap map[string]*recycle.CvmDetail) (failed []string, err error)
@@ -182,6 +188,150 @@ func (d *disk) DeleteRecycledDisk(kt *kit.Kit, basicInfoMap map[string]types.Clo
 	return nil, nil
 }
 
+// CreateFinalSnapshot 删除云盘前为云盘创建最终快照，返回最终快照的云ID。snapshotCloudID 为回收记录中保存的
+// 最终快照云ID，不为空时说明快照已在云上创建，直接复用；否则按名称查询已同步到本地的最终快照，都不存在时才创建
+func (d *disk) CreateFinalSnapshot(kt *kit.Kit, vendor enumor.Vendor, diskID, snapshotCloudID string) (
+	string, error) {
+
+	if len(snapshotCloudID) != 0 {
+		return snapshotCloudID, nil
+	}
+
+	name := fmt.Sprintf("final-%s", diskID)
+	listReq := &core.ListReq{
+		Fields: []string{"cloud_id"},
+		Filter: tools.EqualWithOpExpression(filter.And, map[string]interface{}{"disk_id": diskID, "name": name}),
+		Page:   core.NewDefaultBasePage(),
+	}
+	listRes, err := d.client.DataService().Global.Snapshot.List(kt, listReq)
+	if err != nil {
+		logs.Errorf("list disk final snapshot failed, err: %v, disk: %s, rid: %s", err, diskID, kt.Rid)
+		return "", err
+	}
+
+	if len(listRes.Details) > 0 {
+		return listRes.Details[0].CloudID, nil
+	}
+
+	createReq := &hcsnapshot.SnapshotCreateReq{
+		DiskID: diskID,
+		Name:   name,
+		Memo:   converter.ValToPtr("final snapshot before recycled disk deleted"),
+	}
+
+	var result *hcsnapshot.SnapshotCreateResult
+	switch vendor {
+	case enumor.TCloud:
+		result, err = d.client.HCService().TCloud.Snapshot.CreateSnapshot(kt, createReq)
+	case enumor.Aws:
+		result, err = d.client.HCService().Aws.Snapshot.CreateSnapshot(kt, createReq)
+	case enumor.HuaWei:
+		result, err = d.client.HCService().HuaWei.Snapshot.CreateSnapshot(kt, createReq)
+	case enumor.Gcp:
+		result, err = d.client.HCService().Gcp.Snapshot.CreateSnapshot(kt, createReq)
+	case enumor.Azure:
+		result, err = d.client.HCService().Azure.Snapshot.CreateSnapshot(kt, createReq)
+	default:
+		return "", errf.NewFromErr(errf.InvalidParameter, fmt.Errorf("no support vendor: %s", vendor))
+	}
+	if err != nil {
+		logs.Errorf("create disk final snapshot failed, err: %v, disk: %s, rid: %s", err, diskID, kt.Rid)
+		return "", err
+	}
+
+	return result.CloudID, nil
+}
+
+// FinalSnapshotState 最终快照状态
+type FinalSnapshotState string
+
+const (
+	// FinalSnapshotPending 快照创建中或尚未同步到本地
+	FinalSnapshotPending FinalSnapshotState = "pending"
+	// FinalSnapshotReady 快照已创建完成
+	FinalSnapshotReady FinalSnapshotState = "ready"
+	// FinalSnapshotFailed 快照创建失败
+	FinalSnapshotFailed FinalSnapshotState = "failed"
+)
+
+// finalSnapshotReadyStatus 各云快照创建完成后的状态，Azure 创建快照时会等待创建完成，同步到本地即可用
+var finalSnapshotReadyStatus = map[enumor.Vendor]string{
+	enumor.TCloud: "NORMAL",
+	enumor.Aws:    "completed",
+	enumor.HuaWei: "available",
+	enumor.Gcp:    "READY",
+}
+
+// finalSnapshotFailedStatus 各云快照创建失败的状态，腾讯云快照创建失败时会被直接删除，依赖超时处理
+var finalSnapshotFailedStatus = map[enumor.Vendor][]string{
+	enumor.Aws:    {"error"},
+	enumor.HuaWei: {"error"},
+	enumor.Gcp:    {"FAILED"},
+}
+
+// judgeFinalSnapshotState 根据本地快照记录的状态判断最终快照状态，exists 为本地是否存在该快照记录
+func judgeFinalSnapshotState(vendor enumor.Vendor, status string, exists bool) FinalSnapshotState {
+	if !exists {
+		return FinalSnapshotPending
+	}
+
+	readyStatus, ok := finalSnapshotReadyStatus[vendor]
+	if !ok || status == readyStatus {
+		return FinalSnapshotReady
+	}
+
+	if slice.IsItemInSlice(finalSnapshotFailedStatus[vendor], status) {
+		return FinalSnapshotFailed
+	}
+
+	return FinalSnapshotPending
+}
+
+// GetFinalSnapshotState 获取最终快照状态，未完成时触发快照同步刷新本地状态，由调用方稍后再次检查。
+// Gcp、Azure 的快照同步不支持按地域或云ID同步，依赖定时同步刷新状态
+func (d *disk) GetFinalSnapshotState(kt *kit.Kit, info *types.CloudResourceBasicInfo, snapshotCloudID string) (
+	FinalSnapshotState, error) {
+
+	listReq := &core.ListReq{
+		Fields: []string{"status"},
+		Filter: tools.EqualWithOpExpression(filter.And,
+			map[string]interface{}{"vendor": info.Vendor, "cloud_id": snapshotCloudID}),
+		Page: core.NewDefaultBasePage(),
+	}
+	listRes, err := d.client.DataService().Global.Snapshot.List(kt, listReq)
+	if err != nil {
+		logs.Errorf("list disk final snapshot failed, err: %v, cloudID: %s, rid: %s", err, snapshotCloudID, kt.Rid)
+		return "", err
+	}
+
+	var status string
+	if len(listRes.Details) > 0 {
+		status = listRes.Details[0].Status
+	}
+	state := judgeFinalSnapshotState(info.Vendor, status, len(listRes.Details) > 0)
+	if state != FinalSnapshotPending {
+		return state, nil
+	}
+
+	switch info.Vendor {
+	case enumor.TCloud:
+		err = d.client.HCService().TCloud.Snapshot.SyncSnapshot(kt, &sync.TCloudSyncReq{
+			AccountID: info.AccountID, Region: info.Region, CloudIDs: []string{snapshotCloudID}})
+	case enumor.Aws:
+		err = d.client.HCService().Aws.Snapshot.SyncSnapshot(kt, &sync.AwsSyncReq{
+			AccountID: info.AccountID, Region: info.Region, CloudIDs: []string{snapshotCloudID}})
+	case enumor.HuaWei:
+		err = d.client.HCService().HuaWei.Snapshot.SyncSnapshot(kt, &sync.HuaWeiSyncReq{
+			AccountID: info.AccountID, Region: info.Region})
+	}
+	if err != nil {
+		logs.Errorf("sync disk final snapshot failed, err: %v, cloudID: %s, rid: %s", err, snapshotCloudID, kt.Rid)
+		return "", err
+	}
+
+	return FinalSnapshotPending, nil
+}
+
 func (d *disk) fillAwsDisks(kt *kit.Kit, cvmDetails []*recycle.CvmDetail) error {
 
 	cvmDiskMap := map[string][]recyclerecord.DiskAttachInfo{}
@@ -0,0 +1,55 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package disk
+
+import (
+	"testing"
+
+	"hcm/pkg/criteria/enumor"
+)
+
+func TestJudgeFinalSnapshotState(t *testing.T) {
+	cases := []struct {
+		vendor enumor.Vendor
+		status string
+		exists bool
+		expect FinalSnapshotState
+	}{
+		{vendor: enumor.TCloud, status: "", exists: false, expect: FinalSnapshotPending},
+		{vendor: enumor.TCloud, status: "CREATING", exists: true, expect: FinalSnapshotPending},
+		{vendor: enumor.TCloud, status: "NORMAL", exists: true, expect: FinalSnapshotReady},
+		{vendor: enumor.Aws, status: "pending", exists: true, expect: FinalSnapshotPending},
+		{vendor: enumor.Aws, status: "completed", exists: true, expect: FinalSnapshotReady},
+		{vendor: enumor.Aws, status: "error", exists: true, expect: FinalSnapshotFailed},
+		{vendor: enumor.HuaWei, status: "creating", exists: true, expect: FinalSnapshotPending},
+		{vendor: enumor.HuaWei, status: "error", exists: true, expect: FinalSnapshotFailed},
+		{vendor: enumor.Gcp, status: "READY", exists: true, expect: FinalSnapshotReady},
+		{vendor: enumor.Gcp, status: "FAILED", exists: true, expect: FinalSnapshotFailed},
+		{vendor: enumor.Azure, status: "Succeeded", exists: true, expect: FinalSnapshotReady},
+		{vendor: enumor.Azure, status: "", exists: false, expect: FinalSnapshotPending},
+	}
+
+	for _, c := range cases {
+		if got := judgeFinalSnapshotState(c.vendor, c.status, c.exists); got != c.expect {
+			t.Errorf("judgeFinalSnapshotState(%s, %s, %v) = %s, expect: %s", c.vendor, c.status, c.exists, got,
+				c.expect)
+		}
+	}
+}
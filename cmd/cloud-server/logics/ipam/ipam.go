@@ -0,0 +1,202 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package ipam ...
+package ipam
+
+import (
+	"fmt"
+	"sort"
+
+	"hcm/cmd/cloud-server/logics/audit"
+	csipam "hcm/pkg/api/cloud-server/ipam"
+	"hcm/pkg/api/core"
+	corecloud "hcm/pkg/api/core/cloud"
+	coreipam "hcm/pkg/api/core/cloud/ipam"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/runtime/filter"
+	"hcm/pkg/tools/cidr"
+)
+
+// Interface define ipam interface.
+type Interface interface {
+	AllocateCidr(kt *kit.Kit, poolID string, req *csipam.AllocateCidrReq) (*csipam.AllocateCidrResult, error)
+	BindAllocation(kt *kit.Kit, allocationID, cidr, resID string) error
+	ReleaseAllocation(kt *kit.Kit, allocationIDs []string) error
+	ListVpcCidrOverlap(kt *kit.Kit, vendors []enumor.Vendor) ([]csipam.VpcCidrOverlap, error)
+	ListSubnetUtilization(kt *kit.Kit, subnets []corecloud.BaseSubnet) ([]csipam.SubnetUtilization, error)
+}
+
+type ipam struct {
+	client *client.ClientSet
+	audit  audit.Interface
+}
+
+// NewIpam new ipam.
+func NewIpam(client *client.ClientSet, audit audit.Interface) Interface {
+	return &ipam{
+		client: client,
+		audit:  audit,
+	}
+}
+
+// AllocateCidr 从地址池中分配一个与所有已同步VPC网段及已分配网段均不重叠的网段，分配记录在资源创建成功后回填资源ID，
+// 已分配网段的读取和分配记录的创建在data-service中对地址池加锁后执行，保证并发分配时网段不重叠
+func (i *ipam) AllocateCidr(kt *kit.Kit, poolID string, req *csipam.AllocateCidrReq) (
+	*csipam.AllocateCidrResult, error) {
+
+	vpcCidrs, err := i.listVpcCidr(kt, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	used := make([]string, 0, len(vpcCidrs))
+	for _, one := range vpcCidrs {
+		used = append(used, one.Cidr)
+	}
+
+	allocateReq := &protocloud.IpamAllocationAllocateReq{
+		PoolID:    poolID,
+		MaskLen:   req.MaskLen,
+		ResType:   req.ResType,
+		Memo:      req.Memo,
+		UsedCidrs: used,
+	}
+	result, err := i.client.DataService().Global.IpamAllocation.Allocate(kt, allocateReq)
+	if err != nil {
+		logs.Errorf("allocate cidr from ipam pool failed, err: %v, pool: %s, mask_len: %d, rid: %s", err, poolID,
+			req.MaskLen, kt.Rid)
+		return nil, err
+	}
+
+	return &csipam.AllocateCidrResult{ID: result.ID, Cidr: result.Cidr}, nil
+}
+
+// BindAllocation 资源创建成功后回填分配记录的资源ID，只允许绑定网段一致且未绑定资源的分配记录
+func (i *ipam) BindAllocation(kt *kit.Kit, allocationID, cidr, resID string) error {
+	allocations, err := i.listAllocation(kt, tools.EqualExpression("id", allocationID))
+	if err != nil {
+		return err
+	}
+
+	if len(allocations) == 0 {
+		return errf.Newf(errf.RecordNotFound, "ipam allocation: %s not found", allocationID)
+	}
+
+	allocation := allocations[0]
+	if allocation.Cidr != cidr {
+		return fmt.Errorf("ipam allocation: %s cidr %s not match %s", allocationID, allocation.Cidr, cidr)
+	}
+
+	if len(allocation.ResID) != 0 && allocation.ResID != resID {
+		return fmt.Errorf("ipam allocation: %s already bound to %s", allocationID, allocation.ResID)
+	}
+
+	updateReq := &protocloud.IpamAllocationBatchUpdateReq{
+		Allocations: []protocloud.IpamAllocationUpdateReq{{ID: allocationID, ResID: resID}},
+	}
+	if err = i.client.DataService().Global.IpamAllocation.BatchUpdate(kt, updateReq); err != nil {
+		logs.Errorf("bind ipam allocation failed, err: %v, id: %s, res_id: %s, rid: %s", err, allocationID, resID,
+			kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// ReleaseAllocation 释放分配记录，释放后网段可以被再次分配
+func (i *ipam) ReleaseAllocation(kt *kit.Kit, allocationIDs []string) error {
+	if len(allocationIDs) == 0 {
+		return nil
+	}
+
+	deleteReq := &protocloud.IpamAllocationBatchDeleteReq{Filter: tools.ContainersExpression("id", allocationIDs)}
+	if err := i.client.DataService().Global.IpamAllocation.BatchDelete(kt, deleteReq); err != nil {
+		logs.Errorf("release ipam allocation failed, err: %v, ids: %v, rid: %s", err, allocationIDs, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+func (i *ipam) listAllocation(kt *kit.Kit, expr *filter.Expression) ([]coreipam.Allocation, error) {
+	listReq := &core.ListReq{
+		Filter: expr,
+		Page:   core.NewDefaultBasePage(),
+	}
+
+	allocations := make([]coreipam.Allocation, 0)
+	for {
+		result, err := i.client.DataService().Global.IpamAllocation.List(kt, listReq)
+		if err != nil {
+			logs.Errorf("list ipam allocation failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, err
+		}
+
+		allocations = append(allocations, result.Details...)
+		if uint(len(result.Details)) < core.DefaultMaxPageLimit {
+			break
+		}
+		listReq.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return allocations, nil
+}
+
+// ListVpcCidrOverlap 检测所有已同步VPC的IPv4网段重叠情况，vendors为空时检测全部云厂商
+func (i *ipam) ListVpcCidrOverlap(kt *kit.Kit, vendors []enumor.Vendor) ([]csipam.VpcCidrOverlap, error) {
+	vpcCidrs, err := i.listVpcCidr(kt, vendors)
+	if err != nil {
+		return nil, err
+	}
+
+	type cidrRange struct {
+		start, end uint32
+		cidr       csipam.VpcCidr
+	}
+	ranges := make([]cidrRange, 0, len(vpcCidrs))
+	for _, one := range vpcCidrs {
+		start, end, err := cidr.Ipv4CidrRange(one.Cidr)
+		if err != nil {
+			continue
+		}
+		ranges = append(ranges, cidrRange{start: start, end: end, cidr: one})
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].start < ranges[j].start })
+
+	// 按起始地址排序后，后面的网段起始地址不大于当前网段末地址时即存在重叠
+	overlaps := make([]csipam.VpcCidrOverlap, 0)
+	for idx := range ranges {
+		for next := idx + 1; next < len(ranges) && ranges[next].start <= ranges[idx].end; next++ {
+			// 同一VPC的多个网段之间云上不会重叠，无需提示
+			if ranges[idx].cidr.VpcID == ranges[next].cidr.VpcID {
+				continue
+			}
+			overlaps = append(overlaps, csipam.VpcCidrOverlap{Source: ranges[idx].cidr, Target: ranges[next].cidr})
+		}
+	}
+
+	return overlaps, nil
+}
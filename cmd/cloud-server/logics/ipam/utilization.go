@@ -0,0 +1,191 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package ipam
+
+import (
+	"math"
+	"net"
+
+	csipam "hcm/pkg/api/cloud-server/ipam"
+	"hcm/pkg/api/core"
+	corecloud "hcm/pkg/api/core/cloud"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/runtime/filter"
+	"hcm/pkg/tools/cidr"
+	"hcm/pkg/tools/converter"
+)
+
+// ListSubnetUtilization 根据已同步的主机及网络接口的内网IPv4地址统计子网IP使用率
+func (i *ipam) ListSubnetUtilization(kt *kit.Kit, subnets []corecloud.BaseSubnet) (
+	[]csipam.SubnetUtilization, error) {
+
+	if len(subnets) == 0 {
+		return make([]csipam.SubnetUtilization, 0), nil
+	}
+
+	subnetNets := make(map[string][]*net.IPNet, len(subnets))
+	subnetIDs := make([]string, 0, len(subnets))
+	for _, subnet := range subnets {
+		subnetIDs = append(subnetIDs, subnet.ID)
+		for _, one := range subnet.Ipv4Cidr {
+			_, ipNet, err := net.ParseCIDR(one)
+			if err != nil {
+				logs.Errorf("parse subnet cidr failed, err: %v, subnet: %s, cidr: %s, rid: %s", err, subnet.ID, one,
+					kt.Rid)
+				continue
+			}
+			subnetNets[subnet.ID] = append(subnetNets[subnet.ID], ipNet)
+		}
+	}
+
+	usedIPs := make(map[string]map[string]struct{}, len(subnets))
+	addIP := func(subnetID, ip string) {
+		parsed := net.ParseIP(ip)
+		if parsed == nil {
+			return
+		}
+		for _, ipNet := range subnetNets[subnetID] {
+			if !ipNet.Contains(parsed) {
+				continue
+			}
+			if _, exists := usedIPs[subnetID]; !exists {
+				usedIPs[subnetID] = make(map[string]struct{})
+			}
+			usedIPs[subnetID][ip] = struct{}{}
+			return
+		}
+	}
+
+	if err := i.collectCvmIP(kt, subnetIDs, addIP); err != nil {
+		return nil, err
+	}
+
+	if err := i.collectNetworkInterfaceIP(kt, subnetIDs, addIP); err != nil {
+		return nil, err
+	}
+
+	result := make([]csipam.SubnetUtilization, 0, len(subnets))
+	for _, subnet := range subnets {
+		var total uint64
+		for _, one := range subnet.Ipv4Cidr {
+			count, err := cidr.CidrIPCounts(one)
+			if err != nil || count < 0 {
+				continue
+			}
+			total += uint64(count)
+		}
+
+		used := uint64(len(usedIPs[subnet.ID]))
+		one := csipam.SubnetUtilization{
+			SubnetID:     subnet.ID,
+			CloudID:      subnet.CloudID,
+			Name:         subnet.Name,
+			Vendor:       subnet.Vendor,
+			AccountID:    subnet.AccountID,
+			Region:       subnet.Region,
+			VpcID:        subnet.VpcID,
+			BkBizID:      subnet.BkBizID,
+			Ipv4Cidr:     subnet.Ipv4Cidr,
+			TotalIPCount: total,
+			UsedIPCount:  used,
+		}
+		if total > used {
+			one.AvailableIPCount = total - used
+		}
+		if total > 0 {
+			one.UsageRate = math.Round(float64(used)/float64(total)*10000) / 10000
+		}
+		result = append(result, one)
+	}
+
+	return result, nil
+}
+
+func (i *ipam) collectCvmIP(kt *kit.Kit, subnetIDs []string, addIP func(subnetID, ip string)) error {
+	listReq := &core.ListReq{
+		Filter: &filter.Expression{
+			Op: filter.And,
+			Rules: []filter.RuleFactory{
+				filter.AtomRule{Field: "subnet_ids", Op: filter.JSONOverlaps.Factory(), Value: subnetIDs},
+			},
+		},
+		Page:   core.NewDefaultBasePage(),
+		Fields: []string{"id", "subnet_ids", "private_ipv4_addresses"},
+	}
+
+	subnetIDMap := converter.StringSliceToMap(subnetIDs)
+	for {
+		resp, err := i.client.DataService().Global.Cvm.ListCvm(kt, listReq)
+		if err != nil {
+			logs.Errorf("list cvm by subnet failed, err: %v, subnet ids: %v, rid: %s", err, subnetIDs, kt.Rid)
+			return err
+		}
+
+		for _, cvm := range resp.Details {
+			for _, subnetID := range cvm.SubnetIDs {
+				if _, exists := subnetIDMap[subnetID]; !exists {
+					continue
+				}
+				for _, ip := range cvm.PrivateIPv4Addresses {
+					addIP(subnetID, ip)
+				}
+			}
+		}
+
+		if uint(len(resp.Details)) < core.DefaultMaxPageLimit {
+			break
+		}
+		listReq.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return nil
+}
+
+func (i *ipam) collectNetworkInterfaceIP(kt *kit.Kit, subnetIDs []string, addIP func(subnetID, ip string)) error {
+	listReq := &core.ListReq{
+		Filter: tools.ContainersExpression("subnet_id", subnetIDs),
+		Page:   core.NewDefaultBasePage(),
+		Fields: []string{"id", "subnet_id", "private_ipv4"},
+	}
+
+	for {
+		resp, err := i.client.DataService().Global.NetworkInterface.List(kt, listReq)
+		if err != nil {
+			logs.Errorf("list network interface by subnet failed, err: %v, subnet ids: %v, rid: %s", err, subnetIDs,
+				kt.Rid)
+			return err
+		}
+
+		for _, ni := range resp.Details {
+			for _, ip := range ni.PrivateIPv4 {
+				addIP(ni.SubnetID, ip)
+			}
+		}
+
+		if uint(len(resp.Details)) < core.DefaultMaxPageLimit {
+			break
+		}
+		listReq.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package ipam
+
+import (
+	"fmt"
+
+	csipam "hcm/pkg/api/cloud-server/ipam"
+	"hcm/pkg/api/core"
+	corecloud "hcm/pkg/api/core/cloud"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/cidr"
+)
+
+// vpcCidrVendors 支持VPC网段检测的云厂商
+var vpcCidrVendors = []enumor.Vendor{enumor.TCloud, enumor.Aws, enumor.HuaWei, enumor.Gcp, enumor.Azure,
+	enumor.Zenlayer, enumor.Kaopu}
+
+// listVpcCidr 查询已同步VPC的IPv4网段，vendors为空时查询全部云厂商
+func (i *ipam) listVpcCidr(kt *kit.Kit, vendors []enumor.Vendor) ([]csipam.VpcCidr, error) {
+	if len(vendors) == 0 {
+		vendors = vpcCidrVendors
+	}
+
+	dataCli := i.client.DataService()
+	result := make([]csipam.VpcCidr, 0)
+	for _, vendor := range vendors {
+		var cidrs []csipam.VpcCidr
+		var err error
+
+		switch vendor {
+		case enumor.TCloud:
+			cidrs, err = listVendorVpcCidr(kt, func(req *core.ListReq) (
+				*protocloud.VpcExtListResult[corecloud.TCloudVpcExtension], error) {
+				return dataCli.TCloud.Vpc.ListVpcExt(kt.Ctx, kt.Header(), req)
+			}, func(ext *corecloud.TCloudVpcExtension) []string {
+				cidrs := make([]string, 0, len(ext.Cidr))
+				for _, one := range ext.Cidr {
+					cidrs = append(cidrs, one.Cidr)
+				}
+				return cidrs
+			})
+		case enumor.Aws:
+			cidrs, err = listVendorVpcCidr(kt, func(req *core.ListReq) (
+				*protocloud.VpcExtListResult[corecloud.AwsVpcExtension], error) {
+				return dataCli.Aws.Vpc.ListVpcExt(kt.Ctx, kt.Header(), req)
+			}, func(ext *corecloud.AwsVpcExtension) []string {
+				cidrs := make([]string, 0, len(ext.Cidr))
+				for _, one := range ext.Cidr {
+					cidrs = append(cidrs, one.Cidr)
+				}
+				return cidrs
+			})
+		case enumor.HuaWei:
+			cidrs, err = listVendorVpcCidr(kt, func(req *core.ListReq) (
+				*protocloud.VpcExtListResult[corecloud.HuaWeiVpcExtension], error) {
+				return dataCli.HuaWei.Vpc.ListVpcExt(kt.Ctx, kt.Header(), req)
+			}, func(ext *corecloud.HuaWeiVpcExtension) []string {
+				cidrs := make([]string, 0, len(ext.Cidr))
+				for _, one := range ext.Cidr {
+					cidrs = append(cidrs, one.Cidr)
+				}
+				return cidrs
+			})
+		case enumor.Azure:
+			cidrs, err = listVendorVpcCidr(kt, func(req *core.ListReq) (
+				*protocloud.VpcExtListResult[corecloud.AzureVpcExtension], error) {
+				return dataCli.Azure.Vpc.ListVpcExt(kt.Ctx, kt.Header(), req)
+			}, func(ext *corecloud.AzureVpcExtension) []string {
+				cidrs := make([]string, 0, len(ext.Cidr))
+				for _, one := range ext.Cidr {
+					cidrs = append(cidrs, one.Cidr)
+				}
+				return cidrs
+			})
+		case enumor.Zenlayer:
+			cidrs, err = listVendorVpcCidr(kt, func(req *core.ListReq) (
+				*protocloud.VpcExtListResult[corecloud.ZenlayerVpcExtension], error) {
+				return dataCli.Zenlayer.Vpc.ListVpcExt(kt.Ctx, kt.Header(), req)
+			}, func(ext *corecloud.ZenlayerVpcExtension) []string {
+				cidrs := make([]string, 0, len(ext.Cidr))
+				for _, one := range ext.Cidr {
+					cidrs = append(cidrs, one.Cidr)
+				}
+				return cidrs
+			})
+		case enumor.Kaopu:
+			cidrs, err = listVendorVpcCidr(kt, func(req *core.ListReq) (
+				*protocloud.VpcExtListResult[corecloud.KaopuVpcExtension], error) {
+				return dataCli.Kaopu.Vpc.ListVpcExt(kt.Ctx, kt.Header(), req)
+			}, func(ext *corecloud.KaopuVpcExtension) []string {
+				cidrs := make([]string, 0, len(ext.Cidr))
+				for _, one := range ext.Cidr {
+					cidrs = append(cidrs, one.Cidr)
+				}
+				return cidrs
+			})
+		case enumor.Gcp:
+			// gcp vpc没有网段，使用子网网段
+			cidrs, err = i.listGcpSubnetCidr(kt)
+		default:
+			return nil, fmt.Errorf("vendor: %s not support list vpc cidr", vendor)
+		}
+		if err != nil {
+			logs.Errorf("list %s vpc cidr failed, err: %v, rid: %s", vendor, err, kt.Rid)
+			return nil, err
+		}
+
+		result = append(result, cidrs...)
+	}
+
+	return result, nil
+}
+
+func listVendorVpcCidr[T corecloud.VpcExtension](kt *kit.Kit,
+	listFn func(req *core.ListReq) (*protocloud.VpcExtListResult[T], error), cidrFn func(ext *T) []string) (
+	[]csipam.VpcCidr, error) {
+
+	listReq := &core.ListReq{
+		Filter: tools.AllExpression(),
+		Page:   core.NewDefaultBasePage(),
+	}
+
+	result := make([]csipam.VpcCidr, 0)
+	for {
+		resp, err := listFn(listReq)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, vpc := range resp.Details {
+			if vpc.Extension == nil {
+				continue
+			}
+
+			for _, one := range cidrFn(vpc.Extension) {
+				// 仅处理IPv4网段
+				if _, _, err := cidr.Ipv4CidrRange(one); err != nil {
+					continue
+				}
+				result = append(result, csipam.VpcCidr{
+					VpcID:     vpc.ID,
+					CloudID:   vpc.CloudID,
+					Name:      vpc.Name,
+					Vendor:    vpc.Vendor,
+					AccountID: vpc.AccountID,
+					Region:    vpc.Region,
+					BkBizID:   vpc.BkBizID,
+					Cidr:      one,
+				})
+			}
+		}
+
+		if uint(len(resp.Details)) < core.DefaultMaxPageLimit {
+			break
+		}
+		listReq.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return result, nil
+}
+
+func (i *ipam) listGcpSubnetCidr(kt *kit.Kit) ([]csipam.VpcCidr, error) {
+	listReq := &core.ListReq{
+		Filter: tools.EqualExpression("vendor", enumor.Gcp),
+		Page:   core.NewDefaultBasePage(),
+	}
+
+	result := make([]csipam.VpcCidr, 0)
+	for {
+		resp, err := i.client.DataService().Global.Subnet.List(kt.Ctx, kt.Header(), listReq)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, subnet := range resp.Details {
+			for _, one := range subnet.Ipv4Cidr {
+				result = append(result, csipam.VpcCidr{
+					VpcID:     subnet.VpcID,
+					CloudID:   subnet.CloudVpcID,
+					Name:      subnet.Name,
+					Vendor:    subnet.Vendor,
+					AccountID: subnet.AccountID,
+					Region:    subnet.Region,
+					BkBizID:   subnet.BkBizID,
+					Cidr:      one,
+				})
+			}
+		}
+
+		if uint(len(resp.Details)) < core.DefaultMaxPageLimit {
+			break
+		}
+		listReq.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,187 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package k8scluster ...
+package k8scluster
+
+import (
+	"fmt"
+
+	"hcm/cmd/cloud-server/logics/audit"
+	csk8s "hcm/pkg/api/cloud-server/k8s-cluster"
+	"hcm/pkg/api/core"
+	corek8s "hcm/pkg/api/core/cloud/k8s"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/slice"
+)
+
+// Interface define k8s cluster interface.
+type Interface interface {
+	Assign(kt *kit.Kit, ids []string, bizID int64) error
+	ListCvmK8sCluster(kt *kit.Kit, cvmIDs []string) ([]csk8s.CvmK8sCluster, error)
+}
+
+type k8sCluster struct {
+	client *client.ClientSet
+	audit  audit.Interface
+}
+
+// NewK8sCluster new k8s cluster.
+func NewK8sCluster(client *client.ClientSet, audit audit.Interface) Interface {
+	return &k8sCluster{
+		client: client,
+		audit:  audit,
+	}
+}
+
+// Assign 分配容器集群到业务下，已分配到其他业务的容器集群不允许再次分配
+func (k *k8sCluster) Assign(kt *kit.Kit, ids []string, bizID int64) error {
+	if len(ids) == 0 {
+		return fmt.Errorf("ids is required")
+	}
+
+	listReq := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleIn("id", ids),
+			tools.RuleNotIn("bk_biz_id", []int64{constant.UnassignedBiz, bizID}),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	listResp, err := k.client.DataService().Global.K8sCluster.List(kt, listReq)
+	if err != nil {
+		logs.Errorf("list k8s cluster failed, err: %v, req: %+v, rid: %s", err, listReq, kt.Rid)
+		return err
+	}
+
+	if len(listResp.Details) != 0 {
+		return fmt.Errorf("k8s cluster(ids=%v) already assigned", slice.Map(listResp.Details,
+			func(one corek8s.BaseK8sCluster) string { return one.ID }))
+	}
+
+	// create assign audit
+	if err = k.audit.ResBizAssignAudit(kt, enumor.K8sClusterAuditResType, ids, bizID); err != nil {
+		logs.Errorf("create assign k8s cluster audit failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	req := &protocloud.K8sClusterBatchUpdateReq{
+		IDs:     ids,
+		BkBizID: bizID,
+	}
+	if err = k.client.DataService().Global.K8sCluster.BatchUpdate(kt, req); err != nil {
+		logs.Errorf("batch update k8s cluster failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// ListCvmK8sCluster 查询主机所属的容器集群及节点池，不是集群节点的主机不返回
+func (k *k8sCluster) ListCvmK8sCluster(kt *kit.Kit, cvmIDs []string) ([]csk8s.CvmK8sCluster, error) {
+	rels, err := k.listNodePoolCvmRel(kt, cvmIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]csk8s.CvmK8sCluster, 0, len(rels))
+	if len(rels) == 0 {
+		return result, nil
+	}
+
+	clusterIDs := make([]string, 0, len(rels))
+	nodePoolIDs := make([]string, 0, len(rels))
+	for _, rel := range rels {
+		clusterIDs = append(clusterIDs, rel.ClusterID)
+		if len(rel.NodePoolID) != 0 {
+			nodePoolIDs = append(nodePoolIDs, rel.NodePoolID)
+		}
+	}
+
+	clusterMap := make(map[string]corek8s.BaseK8sCluster)
+	for _, batch := range slice.Split(slice.Unique(clusterIDs), int(core.DefaultMaxPageLimit)) {
+		req := &core.ListReq{
+			Fields: []string{"id", "cloud_id", "name"},
+			Filter: tools.ContainersExpression("id", batch),
+			Page:   core.NewDefaultBasePage(),
+		}
+		resp, err := k.client.DataService().Global.K8sCluster.List(kt, req)
+		if err != nil {
+			logs.Errorf("list k8s cluster failed, err: %v, ids: %v, rid: %s", err, batch, kt.Rid)
+			return nil, err
+		}
+		for _, one := range resp.Details {
+			clusterMap[one.ID] = one
+		}
+	}
+
+	nodePoolMap := make(map[string]string)
+	for _, batch := range slice.Split(slice.Unique(nodePoolIDs), int(core.DefaultMaxPageLimit)) {
+		req := &core.ListReq{
+			Fields: []string{"id", "name"},
+			Filter: tools.ContainersExpression("id", batch),
+			Page:   core.NewDefaultBasePage(),
+		}
+		resp, err := k.client.DataService().Global.K8sNodePool.List(kt, req)
+		if err != nil {
+			logs.Errorf("list k8s node pool failed, err: %v, ids: %v, rid: %s", err, batch, kt.Rid)
+			return nil, err
+		}
+		for _, one := range resp.Details {
+			nodePoolMap[one.ID] = one.Name
+		}
+	}
+
+	for _, rel := range rels {
+		cluster := clusterMap[rel.ClusterID]
+		result = append(result, csk8s.CvmK8sCluster{
+			CvmID:          rel.CvmID,
+			ClusterID:      rel.ClusterID,
+			CloudClusterID: cluster.CloudID,
+			ClusterName:    cluster.Name,
+			NodePoolID:     rel.NodePoolID,
+			NodePoolName:   nodePoolMap[rel.NodePoolID],
+		})
+	}
+
+	return result, nil
+}
+
+func (k *k8sCluster) listNodePoolCvmRel(kt *kit.Kit, cvmIDs []string) ([]corek8s.K8sNodePoolCvmRel, error) {
+	result := make([]corek8s.K8sNodePoolCvmRel, 0)
+	for _, batch := range slice.Split(slice.Unique(cvmIDs), int(core.DefaultMaxPageLimit)) {
+		req := &core.ListReq{
+			Filter: tools.ContainersExpression("cvm_id", batch),
+			Page:   core.NewDefaultBasePage(),
+		}
+		resp, err := k.client.DataService().Global.K8sNodePoolCvmRel.List(kt, req)
+		if err != nil {
+			logs.Errorf("list k8s node pool cvm rel failed, err: %v, cvm ids: %v, rid: %s", err, batch, kt.Rid)
+			return nil, err
+		}
+		result = append(result, resp.Details...)
+	}
+
+	return result, nil
+}
@@ -22,9 +22,15 @@ package logics
 
 import (
 	"hcm/cmd/cloud-server/logics/audit"
+	"hcm/cmd/cloud-server/logics/bucket"
 	"hcm/cmd/cloud-server/logics/cvm"
+	dbinstance "hcm/cmd/cloud-server/logics/database-instance"
 	"hcm/cmd/cloud-server/logics/disk"
 	"hcm/cmd/cloud-server/logics/eip"
+	"hcm/cmd/cloud-server/logics/ipam"
+	k8scluster "hcm/cmd/cloud-server/logics/k8s-cluster"
+	keypair "hcm/cmd/cloud-server/logics/key-pair"
+	privatedns "hcm/cmd/cloud-server/logics/private-dns"
 	"hcm/pkg/client"
 	"hcm/pkg/thirdparty/esb"
 )
@@ -35,6 +41,13 @@ type Logics struct {
 	Disk  disk.Interface
 	Cvm   cvm.Interface
 	Eip   eip.Interface
+
+	DatabaseInstance dbinstance.Interface
+	Bucket           bucket.Interface
+	K8sCluster       k8scluster.Interface
+	KeyPair          keypair.Interface
+	PrivateDns       privatedns.Interface
+	Ipam             ipam.Interface
 }
 
 // NewLogics create a new cloud server logics.
@@ -47,5 +60,12 @@ func NewLogics(c *client.ClientSet, esbClient esb.Client) *Logics {
 		Disk:  disk.NewDisk(c, auditLogics),
 		Cvm:   cvm.NewCvm(c, auditLogics, eipLogics, diskLogics, esbClient),
 		Eip:   eip.NewEip(c, auditLogics),
+
+		DatabaseInstance: dbinstance.NewDatabaseInstance(c, auditLogics),
+		Bucket:           bucket.NewBucket(c, auditLogics),
+		K8sCluster:       k8scluster.NewK8sCluster(c, auditLogics),
+		KeyPair:          keypair.NewKeyPair(c, auditLogics),
+		PrivateDns:       privatedns.NewPrivateDns(c, auditLogics),
+		Ipam:             ipam.NewIpam(c, auditLogics),
 	}
 }
@@ -0,0 +1,97 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package privatedns ...
+package privatedns
+
+import (
+	"fmt"
+
+	"hcm/cmd/cloud-server/logics/audit"
+	"hcm/pkg/api/core"
+	coreprivatedns "hcm/pkg/api/core/cloud/private-dns"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/slice"
+)
+
+// Interface define private dns interface.
+type Interface interface {
+	Assign(kt *kit.Kit, ids []string, bizID int64) error
+}
+
+type privateDns struct {
+	client *client.ClientSet
+	audit  audit.Interface
+}
+
+// NewPrivateDns new private dns.
+func NewPrivateDns(client *client.ClientSet, audit audit.Interface) Interface {
+	return &privateDns{
+		client: client,
+		audit:  audit,
+	}
+}
+
+// Assign 分配私有域到业务下，私有域下的解析记录跟随私有域，已分配到其他业务的私有域不允许再次分配
+func (p *privateDns) Assign(kt *kit.Kit, ids []string, bizID int64) error {
+	if len(ids) == 0 {
+		return fmt.Errorf("ids is required")
+	}
+
+	listReq := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleIn("id", ids),
+			tools.RuleNotIn("bk_biz_id", []int64{constant.UnassignedBiz, bizID}),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	listResp, err := p.client.DataService().Global.PrivateDnsZone.List(kt, listReq)
+	if err != nil {
+		logs.Errorf("list private dns zone failed, err: %v, req: %+v, rid: %s", err, listReq, kt.Rid)
+		return err
+	}
+
+	if len(listResp.Details) != 0 {
+		return fmt.Errorf("private dns zone(ids=%v) already assigned", slice.Map(listResp.Details,
+			func(one coreprivatedns.BaseZone) string { return one.ID }))
+	}
+
+	// create assign audit
+	if err = p.audit.ResBizAssignAudit(kt, enumor.PrivateDnsZoneAuditResType, ids, bizID); err != nil {
+		logs.Errorf("create assign private dns zone audit failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	req := &protocloud.PrivateDnsZoneBatchUpdateReq{
+		IDs:     ids,
+		BkBizID: bizID,
+	}
+	if err = p.client.DataService().Global.PrivateDnsZone.BatchUpdate(kt, req); err != nil {
+		logs.Errorf("batch update private dns zone failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
@@ -62,6 +62,10 @@ func (a *accountSvc) Check(cts *rest.Contexts) (interface{}, error) {
 		_, err = ParseAndCheckGcpExtension(cts, a.client, req.Type, req.Extension)
 	case enumor.Azure:
 		_, err = ParseAndCheckAzureExtension(cts, a.client, req.Type, req.Extension)
+	case enumor.Zenlayer:
+		_, err = ParseAndCheckZenlayerExtension(cts, a.client, req.Type, req.Extension)
+	case enumor.Kaopu:
+		_, err = ParseAndCheckKaopuExtension(cts, a.client, req.Type, req.Extension)
 	default:
 		err = fmt.Errorf("no support vendor: %s", req.Vendor)
 	}
@@ -247,6 +251,74 @@ func ParseAndCheckAzureExtension(
 	return extension, nil
 }
 
+// ParseAndCheckZenlayerExtension  联通性校验，并检查字段是否匹配
+func ParseAndCheckZenlayerExtension(
+	cts *rest.Contexts, client *client.ClientSet, accountType enumor.AccountType, reqExtension json.RawMessage,
+) (*proto.ZenlayerAccountExtensionCreateReq, error) {
+	// 解析Extension
+	extension := new(proto.ZenlayerAccountExtensionCreateReq)
+	if err := common.DecodeExtension(cts.Kit, reqExtension, extension); err != nil {
+		return nil, err
+	}
+	// 校验Extension
+	if err := extension.Validate(accountType); err != nil {
+		return nil, err
+	}
+
+	// 检查联通性，账号是否正确
+	if accountType != enumor.RegistrationAccount || extension.IsFull() {
+		err := client.HCService().Zenlayer.Account.Check(
+			cts.Kit.Ctx,
+			cts.Kit.Header(),
+			&hcproto.ZenlayerAccountCheckReq{
+				CloudMainAccountID: extension.CloudMainAccountID,
+				CloudSubAccountID:  extension.CloudSubAccountID,
+				CloudSecretID:      extension.CloudSecretID,
+				CloudSecretKey:     extension.CloudSecretKey,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return extension, nil
+}
+
+// ParseAndCheckKaopuExtension  联通性校验，并检查字段是否匹配
+func ParseAndCheckKaopuExtension(
+	cts *rest.Contexts, client *client.ClientSet, accountType enumor.AccountType, reqExtension json.RawMessage,
+) (*proto.KaopuAccountExtensionCreateReq, error) {
+	// 解析Extension
+	extension := new(proto.KaopuAccountExtensionCreateReq)
+	if err := common.DecodeExtension(cts.Kit, reqExtension, extension); err != nil {
+		return nil, err
+	}
+	// 校验Extension
+	if err := extension.Validate(accountType); err != nil {
+		return nil, err
+	}
+
+	// 检查联通性，账号是否正确
+	if accountType != enumor.RegistrationAccount || extension.IsFull() {
+		err := client.HCService().Kaopu.Account.Check(
+			cts.Kit.Ctx,
+			cts.Kit.Header(),
+			&hcproto.KaopuAccountCheckReq{
+				CloudMainAccountID: extension.CloudMainAccountID,
+				CloudSubAccountID:  extension.CloudSubAccountID,
+				CloudSecretID:      extension.CloudSecretID,
+				CloudSecretKey:     extension.CloudSecretKey,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return extension, nil
+}
+
 // CheckByID 更新秘钥信息的时候，重新获取一次信息覆盖并比较，和录入账号逻辑基本相同，但是判断账号唯一的id不能变
 func (a *accountSvc) CheckByID(cts *rest.Contexts) (interface{}, error) {
 	req := new(proto.AccountCheckByIDReq)
@@ -281,6 +353,10 @@ func (a *accountSvc) CheckByID(cts *rest.Contexts) (interface{}, error) {
 		_, err = a.parseAndCheckGcpExtensionByID(cts, accountID, req.Extension)
 	case enumor.Azure:
 		_, err = a.parseAndCheckAzureExtensionByID(cts, accountID, req.Extension)
+	case enumor.Zenlayer:
+		_, err = a.parseAndCheckZenlayerExtensionByID(cts, accountID, req.Extension)
+	case enumor.Kaopu:
+		_, err = a.parseAndCheckKaopuExtensionByID(cts, accountID, req.Extension)
 	default:
 		err = fmt.Errorf("no support vendor: %s", baseInfo.Vendor)
 	}
@@ -506,6 +582,90 @@ func (a *accountSvc) parseAndCheckAzureExtensionByID(
 	return extension, nil
 }
 
+func (a *accountSvc) parseAndCheckZenlayerExtensionByID(
+	cts *rest.Contexts, accountID string, reqExtension json.RawMessage,
+) (*proto.ZenlayerAccountExtensionUpdateReq, error) {
+	// 解析Extension
+	extension := new(proto.ZenlayerAccountExtensionUpdateReq)
+	if err := common.DecodeExtension(cts.Kit, reqExtension, extension); err != nil {
+		return nil, err
+	}
+
+	// 查询账号其他信息
+	account, err := a.client.DataService().Zenlayer.Account.Get(cts.Kit.Ctx, cts.Kit.Header(), accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验Extension
+	err = extension.Validate(account.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	// 检查联通性，账号是否正确
+	if account.Type != enumor.RegistrationAccount || extension.IsFull() {
+		err = a.client.HCService().Zenlayer.Account.Check(
+			cts.Kit.Ctx,
+			cts.Kit.Header(),
+			&hcproto.ZenlayerAccountCheckReq{
+				// 传入数据库中的主账号信息，如果发生变更会报错
+				CloudMainAccountID: account.Extension.CloudMainAccountID,
+				CloudSubAccountID:  extension.CloudSubAccountID,
+				CloudSecretID:      extension.CloudSecretID,
+				CloudSecretKey:     extension.CloudSecretKey,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return extension, nil
+}
+
+func (a *accountSvc) parseAndCheckKaopuExtensionByID(
+	cts *rest.Contexts, accountID string, reqExtension json.RawMessage,
+) (*proto.KaopuAccountExtensionUpdateReq, error) {
+	// 解析Extension
+	extension := new(proto.KaopuAccountExtensionUpdateReq)
+	if err := common.DecodeExtension(cts.Kit, reqExtension, extension); err != nil {
+		return nil, err
+	}
+
+	// 查询账号其他信息
+	account, err := a.client.DataService().Kaopu.Account.Get(cts.Kit.Ctx, cts.Kit.Header(), accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验Extension
+	err = extension.Validate(account.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	// 检查联通性，账号是否正确
+	if account.Type != enumor.RegistrationAccount || extension.IsFull() {
+		err = a.client.HCService().Kaopu.Account.Check(
+			cts.Kit.Ctx,
+			cts.Kit.Header(),
+			&hcproto.KaopuAccountCheckReq{
+				// 传入数据库中的主账号信息，如果发生变更会报错
+				CloudMainAccountID: account.Extension.CloudMainAccountID,
+				CloudSubAccountID:  extension.CloudSubAccountID,
+				CloudSecretID:      extension.CloudSecretID,
+				CloudSecretKey:     extension.CloudSecretKey,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return extension, nil
+}
+
 // CheckDuplicateMainAccount 检查主账号是否重复
 func CheckDuplicateMainAccount(cts *rest.Contexts, client *client.ClientSet, vendor enumor.Vendor,
 	accountType enumor.AccountType, mainAccountIDFieldValue string) error {
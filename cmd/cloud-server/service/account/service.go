@@ -54,6 +54,7 @@ func InitAccountService(c *capability.Capability) {
 	h.Add("GetSyncDetail", http.MethodGet, "/accounts/sync_details/{account_id}", svc.GetSyncDetail)
 	h.Add("Update", http.MethodPatch, "/accounts/{account_id}", svc.Update)
 	h.Add("SyncCloudResource", http.MethodPost, "/accounts/{account_id}/sync", svc.SyncCloudResource)
+	h.Add("SyncByEvent", http.MethodPost, "/accounts/{account_id}/sync/by_events", svc.SyncByEvent)
 	h.Add("DeleteAccount", http.MethodDelete, "/accounts/{account_id}", svc.DeleteAccount)
 	h.Add("DeleteValidate", http.MethodPost, "/accounts/{account_id}/delete/validate", svc.DeleteValidate)
 
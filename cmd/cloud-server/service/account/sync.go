@@ -21,6 +21,7 @@ package account
 
 import (
 	"hcm/cmd/cloud-server/logics/account"
+	proto "hcm/pkg/api/cloud-server/account"
 	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/criteria/errf"
 	"hcm/pkg/iam/meta"
@@ -49,3 +50,35 @@ func (a *accountSvc) SyncCloudResource(cts *rest.Contexts) (interface{}, error)
 
 	return nil, nil
 }
+
+// SyncByEvent 根据资源变更事件同步事件中指定的资源，用于接收云上操作审计等推送的资源变更事件。
+func (a *accountSvc) SyncByEvent(cts *rest.Contexts) (interface{}, error) {
+	accountID := cts.PathParameter("account_id").String()
+
+	req := new(proto.SyncByEventReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	// 校验用户有该账号的更新权限
+	if err := a.checkPermission(cts, meta.Update, accountID); err != nil {
+		return nil, err
+	}
+
+	// 查询该账号对应的Vendor
+	baseInfo, err := a.client.DataService().Global.Cloud.GetResBasicInfo(cts.Kit,
+		enumor.AccountCloudResType, accountID)
+	if err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	if err = account.SyncByEvents(cts.Kit, a.client, baseInfo.Vendor, accountID, req.Events); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -57,6 +57,10 @@ func (a *ApplicationOfAddAccount) CheckReq() error {
 		_, err = accountsvc.ParseAndCheckGcpExtension(a.Cts, a.Client, a.req.Type, extensionJson)
 	case enumor.Azure:
 		_, err = accountsvc.ParseAndCheckAzureExtension(a.Cts, a.Client, a.req.Type, extensionJson)
+	case enumor.Zenlayer:
+		_, err = accountsvc.ParseAndCheckZenlayerExtension(a.Cts, a.Client, a.req.Type, extensionJson)
+	case enumor.Kaopu:
+		_, err = accountsvc.ParseAndCheckKaopuExtension(a.Cts, a.Client, a.req.Type, extensionJson)
 	default:
 		err = fmt.Errorf("no support vendor: %s", a.req.Vendor)
 	}
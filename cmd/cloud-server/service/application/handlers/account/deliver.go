@@ -48,6 +48,12 @@ func (a *ApplicationOfAddAccount) Deliver() (enumor.ApplicationStatus, map[strin
 		accountID, err = a.createForGcp()
 	case enumor.Azure:
 		accountID, err = a.createForAzure()
+	case enumor.Zenlayer:
+		accountID, err = a.createForZenlayer()
+	case enumor.Kaopu:
+		accountID, err = a.createForKaopu()
+	default:
+		err = fmt.Errorf("no support vendor: %s", a.req.Vendor)
 	}
 	// 交付失败
 	if err != nil {
@@ -211,3 +217,53 @@ func (a *ApplicationOfAddAccount) createForAzure() (string, error) {
 	}
 	return result.ID, err
 }
+
+func (a *ApplicationOfAddAccount) createForZenlayer() (string, error) {
+	result, err := a.Client.DataService().Zenlayer.Account.Create(
+		a.Cts.Kit.Ctx,
+		a.Cts.Kit.Header(),
+		&dataprotocloud.AccountCreateReq[dataprotocloud.ZenlayerAccountExtensionCreateReq]{
+			Name:     a.req.Name,
+			Managers: a.req.Managers,
+			Type:     a.req.Type,
+			Site:     a.req.Site,
+			Memo:     a.req.Memo,
+			BkBizIDs: a.req.BkBizIDs,
+			Extension: &dataprotocloud.ZenlayerAccountExtensionCreateReq{
+				CloudMainAccountID: a.req.Extension["cloud_main_account_id"],
+				CloudSubAccountID:  a.req.Extension["cloud_sub_account_id"],
+				CloudSecretID:      a.req.Extension["cloud_secret_id"],
+				CloudSecretKey:     a.req.Extension["cloud_secret_key"],
+			},
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return result.ID, err
+}
+
+func (a *ApplicationOfAddAccount) createForKaopu() (string, error) {
+	result, err := a.Client.DataService().Kaopu.Account.Create(
+		a.Cts.Kit.Ctx,
+		a.Cts.Kit.Header(),
+		&dataprotocloud.AccountCreateReq[dataprotocloud.KaopuAccountExtensionCreateReq]{
+			Name:     a.req.Name,
+			Managers: a.req.Managers,
+			Type:     a.req.Type,
+			Site:     a.req.Site,
+			Memo:     a.req.Memo,
+			BkBizIDs: a.req.BkBizIDs,
+			Extension: &dataprotocloud.KaopuAccountExtensionCreateReq{
+				CloudMainAccountID: a.req.Extension["cloud_main_account_id"],
+				CloudSubAccountID:  a.req.Extension["cloud_sub_account_id"],
+				CloudSecretID:      a.req.Extension["cloud_secret_id"],
+				CloudSecretKey:     a.req.Extension["cloud_secret_key"],
+			},
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return result.ID, err
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 
 	"hcm/cmd/cloud-server/logics/audit"
+	"hcm/cmd/cloud-server/logics/ipam"
 	"hcm/pkg/api/core"
 	"hcm/pkg/client"
 	"hcm/pkg/criteria/enumor"
@@ -42,6 +43,7 @@ type HandlerOption struct {
 	Audit     audit.Interface
 	ItsmCli   itsm2.Client
 	CmsiCli   cmsi.Client
+	Ipam      ipam.Interface
 }
 
 // BaseApplicationHandler 基础的Handler 一些公共函数和属性处理，可以给到其他具体Handler组合
@@ -55,6 +57,7 @@ type BaseApplicationHandler struct {
 	Cipher     cryptography.Crypto
 	Audit      audit.Interface
 	CmsiClient cmsi.Client
+	Ipam       ipam.Interface
 }
 
 // NewBaseApplicationHandler ...
@@ -70,6 +73,7 @@ func NewBaseApplicationHandler(
 		Cipher:          opt.Cipher,
 		Audit:           opt.Audit,
 		CmsiClient:      opt.CmsiCli,
+		Ipam:            opt.Ipam,
 	}
 }
 
@@ -0,0 +1,61 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package handlers
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	corekeypair "hcm/pkg/api/core/cloud/key-pair"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/runtime/filter"
+)
+
+// GetKeyPair 查询密钥对，并校验其所属云厂商、账号与业务
+func (a *BaseApplicationHandler) GetKeyPair(vendor enumor.Vendor, accountID string, bizID int64, id string) (
+	*corekeypair.BaseKeyPair, error) {
+
+	reqFilter := &filter.Expression{
+		Op: filter.And,
+		Rules: []filter.RuleFactory{
+			filter.AtomRule{Field: "id", Op: filter.Equal.Factory(), Value: id},
+			filter.AtomRule{Field: "vendor", Op: filter.Equal.Factory(), Value: vendor},
+			filter.AtomRule{Field: "account_id", Op: filter.Equal.Factory(), Value: accountID},
+		},
+	}
+	// 查询
+	listReq := &core.ListReq{
+		Filter: reqFilter,
+		Page:   a.getPageOfOneLimit(),
+	}
+	resp, err := a.Client.DataService().Global.KeyPair.List(a.Cts.Kit, listReq)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil || len(resp.Details) == 0 {
+		return nil, fmt.Errorf("not found %s key pair(%s) in account(%s)", vendor, id, accountID)
+	}
+
+	keyPair := resp.Details[0]
+	if bizID != 0 && keyPair.BkBizID != bizID {
+		return nil, fmt.Errorf("key pair(%s) not belongs to biz(%d)", id, bizID)
+	}
+
+	return &keyPair, nil
+}
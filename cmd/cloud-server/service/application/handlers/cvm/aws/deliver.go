@@ -48,6 +48,12 @@ func (a *ApplicationOfCreateAwsCvm) Deliver() (enumor.ApplicationStatus, map[str
 				},
 			}
 		})
+
+	if a.req.DnsRecord != nil {
+		tasks = actioncvm.AppendRegisterDnsTask(tasks, &actioncvm.RegisterDnsRecordOption{Vendor: enumor.Aws,
+			AccountID: a.req.AccountID, ZoneID: a.req.DnsRecord.ZoneID, TTL: a.req.DnsRecord.TTL})
+	}
+
 	addReq := &ts.AddCustomFlowReq{
 		Name:  enumor.FlowCreateCvm,
 		Tasks: tasks,
@@ -31,5 +31,11 @@ func (a *ApplicationOfCreateAzureCvm) CheckReq() error {
 		return err
 	}
 
+	if len(a.req.KeyPairID) != 0 {
+		if _, err := a.GetKeyPair(a.Vendor(), a.req.AccountID, a.req.BkBizID, a.req.KeyPairID); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -48,6 +48,11 @@ func (a *ApplicationOfCreateAzureCvm) Deliver() (enumor.ApplicationStatus, map[s
 			}
 		})
 
+	if a.req.DnsRecord != nil {
+		tasks = actioncvm.AppendRegisterDnsTask(tasks, &actioncvm.RegisterDnsRecordOption{Vendor: enumor.Azure,
+			AccountID: a.req.AccountID, ZoneID: a.req.DnsRecord.ZoneID, TTL: a.req.DnsRecord.TTL})
+	}
+
 	addReq := &ts.AddCustomFlowReq{
 		Name:  enumor.FlowCreateCvm,
 		Tasks: tasks,
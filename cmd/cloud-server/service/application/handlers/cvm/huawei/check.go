@@ -35,6 +35,12 @@ func (a *ApplicationOfCreateHuaWeiCvm) CheckReq() error {
 		return err
 	}
 
+	if len(a.req.KeyPairID) != 0 {
+		if _, err := a.GetKeyPair(a.Vendor(), a.req.AccountID, a.req.BkBizID, a.req.KeyPairID); err != nil {
+			return err
+		}
+	}
+
 	// TCloud 支持 DryRun，可预校验
 	result, err := a.Client.HCService().HuaWei.Cvm.BatchCreateCvm(a.Cts.Kit, a.toHcProtoHuaWeiBatchCreateReq(true))
 	if err != nil {
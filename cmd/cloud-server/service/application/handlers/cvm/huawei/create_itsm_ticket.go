@@ -133,6 +133,15 @@ func (a *ApplicationOfCreateHuaWeiCvm) renderBaseInfo() ([]formItem, error) {
 	}
 	formItems = append(formItems, formItem{Label: "镜像", Value: imageInfo.Name})
 
+	// 登录方式
+	if len(req.KeyPairID) != 0 {
+		keyPair, err := a.GetKeyPair(a.Vendor(), req.AccountID, req.BkBizID, req.KeyPairID)
+		if err != nil {
+			return formItems, err
+		}
+		formItems = append(formItems, formItem{Label: "密钥对", Value: keyPair.Name})
+	}
+
 	return formItems, nil
 }
 
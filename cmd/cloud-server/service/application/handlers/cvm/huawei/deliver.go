@@ -50,6 +50,12 @@ func (a *ApplicationOfCreateHuaWeiCvm) Deliver() (enumor.ApplicationStatus, map[
 				},
 			}
 		})
+
+	if a.req.DnsRecord != nil {
+		tasks = actioncvm.AppendRegisterDnsTask(tasks, &actioncvm.RegisterDnsRecordOption{Vendor: enumor.HuaWei,
+			AccountID: a.req.AccountID, ZoneID: a.req.DnsRecord.ZoneID, TTL: a.req.DnsRecord.TTL})
+	}
+
 	addReq := &ts.AddCustomFlowReq{
 		Name:  enumor.FlowCreateCvm,
 		Tasks: tasks,
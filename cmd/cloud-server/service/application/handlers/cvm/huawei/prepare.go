@@ -29,10 +29,12 @@ import (
 
 // PrepareReq 预处理请求参数，比如敏感数据加密
 func (a *ApplicationOfCreateHuaWeiCvm) PrepareReq() error {
-	// 密码加密
-	encryptedPassword := a.Cipher.EncryptToBase64(a.req.Password)
-	a.req.Password = encryptedPassword
-	a.req.ConfirmedPassword = encryptedPassword
+	// 密码加密，使用密钥对登录时无密码
+	if len(a.req.Password) != 0 {
+		encryptedPassword := a.Cipher.EncryptToBase64(a.req.Password)
+		a.req.Password = encryptedPassword
+		a.req.ConfirmedPassword = encryptedPassword
+	}
 
 	return nil
 }
@@ -52,6 +54,9 @@ func (a *ApplicationOfCreateHuaWeiCvm) GenerateApplicationContent() interface{}
 // PrepareReqFromContent 预处理请求参数，对于申请内容来着DB，其实入库前是加密了的
 func (a *ApplicationOfCreateHuaWeiCvm) PrepareReqFromContent() error {
 	// 解密密码
+	if len(a.req.Password) == 0 {
+		return nil
+	}
 	password, err := a.Cipher.DecryptFromBase64(a.req.Password)
 	if err != nil {
 		return fmt.Errorf("decrypt password failed, err: %w", err)
@@ -49,6 +49,11 @@ func (a *ApplicationOfCreateTCloudCvm) Deliver() (enumor.ApplicationStatus, map[
 			}
 		})
 
+	if a.req.DnsRecord != nil {
+		tasks = actioncvm.AppendRegisterDnsTask(tasks, &actioncvm.RegisterDnsRecordOption{Vendor: enumor.TCloud,
+			AccountID: a.req.AccountID, ZoneID: a.req.DnsRecord.ZoneID, TTL: a.req.DnsRecord.TTL})
+	}
+
 	addReq := &ts.AddCustomFlowReq{
 		Name:  enumor.FlowCreateCvm,
 		Tasks: tasks,
@@ -43,6 +43,7 @@ func (a *ApplicationOfCreateMainAccount) CheckReq() error {
 
 	// 检查vendor
 	switch a.req.Vendor {
+	case enumor.TCloud:
 	case enumor.Aws:
 	case enumor.Gcp:
 	case enumor.HuaWei:
@@ -67,6 +67,8 @@ func (a *ApplicationOfCreateMainAccount) Complete() (enumor.ApplicationStatus, m
 	)
 
 	switch a.req.Vendor {
+	case enumor.TCloud:
+		accountID, err = a.createForTCloud(&rootAccount.BaseRootAccount)
 	case enumor.Aws:
 		accountID, err = a.createForAws(&rootAccount.BaseRootAccount)
 	case enumor.Gcp:
@@ -294,6 +296,44 @@ func (a *ApplicationOfCreateMainAccount) createForHuaWei(rootAccount *protocore.
 	return result.ID, nil
 }
 
+func (a *ApplicationOfCreateMainAccount) createForTCloud(rootAccount *protocore.BaseRootAccount) (string, error) {
+	req := a.req
+	comReq := a.completeReq
+
+	extension := &dataproto.TCloudMainAccountExtensionCreateReq{
+		CloudMainAccountID:   comReq.Extension[a.Vendor().GetMainAccountIDFieldName()],
+		CloudMainAccountName: comReq.Extension[a.Vendor().GetMainAccountNameFieldName()],
+		CloudInitPassword:    comReq.Extension[a.Vendor().GetMainAccountInitPasswordFieldName()],
+	}
+	extension.EncryptSecretKey(a.Cipher)
+
+	result, err := a.Client.DataService().TCloud.MainAccount.Create(
+		a.Cts.Kit,
+		&dataproto.MainAccountCreateReq[dataproto.TCloudMainAccountExtensionCreateReq]{
+			Name:              a.completeReq.Extension[a.Vendor().GetMainAccountNameFieldName()],
+			CloudID:           a.completeReq.Extension[a.Vendor().GetMainAccountIDFieldName()],
+			Email:             req.Email,
+			Managers:          req.Managers,
+			BakManagers:       req.BakManagers,
+			Site:              req.Site,
+			BusinessType:      req.BusinessType,
+			Status:            enumor.MainAccountStatusRUNNING,
+			ParentAccountName: rootAccount.Name,
+			ParentAccountID:   rootAccount.ID,
+			DeptID:            req.DeptID,
+			BkBizID:           req.BkBizID,
+			OpProductID:       req.OpProductID,
+			Memo:              req.Memo,
+			Extension:         extension,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return result.ID, nil
+}
+
 func (a *ApplicationOfCreateMainAccount) createForZenlayer(rootAccount *protocore.BaseRootAccount) (string, error) {
 	req := a.req
 	comReq := a.completeReq
@@ -381,6 +421,8 @@ func (a *ApplicationOfCreateMainAccount) sendMail(account *dataproto.MainAccount
 	)
 
 	switch account.Vendor {
+	case enumor.TCloud:
+		loginUrl = TCloudLoginAddress
 	case enumor.Aws:
 		loginUrl = AwsLoginAddress
 	case enumor.Gcp:
@@ -20,6 +20,7 @@
 package mainaccount
 
 const (
+	TCloudLoginAddress   = "https://cloud.tencent.com/login/subAccount"
 	GcpLoginAddress      = "https://console.cloud.google.com/welcome?project=%s"
 	AwsLoginAddress      = "https://signin.aws.amazon.com/"
 	HuaweiLoginAddress   = "https://auth.huaweicloud.com/authui/login.html?service=https://console.huaweicloud.com"
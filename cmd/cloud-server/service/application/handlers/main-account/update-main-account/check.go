@@ -33,6 +33,7 @@ func (a *ApplicationOfUpdateMainAccount) CheckReq() error {
 
 	// 检查vendor
 	switch a.req.Vendor {
+	case enumor.TCloud:
 	case enumor.Aws:
 	case enumor.Gcp:
 	case enumor.HuaWei:
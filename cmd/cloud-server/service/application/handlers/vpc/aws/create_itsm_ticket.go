@@ -22,6 +22,8 @@ package aws
 import (
 	"fmt"
 	"strings"
+
+	"hcm/cmd/cloud-server/service/application/handlers/vpc/logics"
 )
 
 type formItem struct {
@@ -106,7 +108,7 @@ func (a *ApplicationOfCreateAwsVpc) renderVpc() ([]formItem, error) {
 	formItems = append(formItems, formItem{Label: "名称", Value: req.Name})
 
 	// IPv4 CIDR
-	formItems = append(formItems, formItem{Label: "IPv4 CIDR", Value: req.IPv4Cidr})
+	formItems = append(formItems, formItem{Label: "IPv4 CIDR", Value: logics.IpamCidrDisplay(req.IpamOption, req.IPv4Cidr)})
 
 	// 所属的蓝鲸云区域
 	bkCloudAreaName, err := a.GetCloudAreaName(req.BkCloudID)
@@ -19,7 +19,9 @@
 
 package azure
 
-import logicsaccount "hcm/cmd/cloud-server/logics/account"
+import (
+	logicsaccount "hcm/cmd/cloud-server/logics/account"
+)
 
 // CheckReq 检查申请单的数据是否正确
 func (a *ApplicationOfCreateAzureVpc) CheckReq() error {
@@ -22,6 +22,8 @@ package azure
 import (
 	"fmt"
 	"strings"
+
+	"hcm/cmd/cloud-server/service/application/handlers/vpc/logics"
 )
 
 type formItem struct {
@@ -116,7 +118,7 @@ func (a *ApplicationOfCreateAzureVpc) renderVpc() ([]formItem, error) {
 	formItems = append(formItems, formItem{Label: "名称", Value: req.Name})
 
 	// IPv4 CIDR
-	formItems = append(formItems, formItem{Label: "IPv4 CIDR", Value: req.IPv4Cidr})
+	formItems = append(formItems, formItem{Label: "IPv4 CIDR", Value: logics.IpamCidrDisplay(req.IpamOption, req.IPv4Cidr)})
 
 	// 所属的蓝鲸云区域
 	bkCloudAreaName, err := a.GetCloudAreaName(req.BkCloudID)
@@ -136,7 +138,7 @@ func (a *ApplicationOfCreateAzureVpc) renderSubnet() ([]formItem, error) {
 	formItems = append(formItems, formItem{Label: "子网名称", Value: req.Subnet.Name})
 
 	// IPv4 CIDR
-	formItems = append(formItems, formItem{Label: "子网IPv4 CIDR", Value: req.Subnet.IPv4Cidr})
+	formItems = append(formItems, formItem{Label: "子网IPv4 CIDR", Value: logics.IpamCidrDisplay(req.IpamOption, req.Subnet.IPv4Cidr)})
 
 	return formItems, nil
 }
@@ -27,6 +27,12 @@ import (
 
 // Deliver 执行资源交付
 func (a *ApplicationOfCreateAzureVpc) Deliver() (enumor.ApplicationStatus, map[string]interface{}, error) {
+	// 设置了IP地址池时在交付时从地址池分配网段，避免审批未通过时占用网段
+	if err := logics.AllocateVpcCidr(a.Cts.Kit, a.Ipam, &a.req.IpamOption, a.req.Name, &a.req.IPv4Cidr,
+		&a.req.Subnet.IPv4Cidr); err != nil {
+		return enumor.DeliverError, map[string]interface{}{"error": err.Error()}, err
+	}
+
 	// 创建vpc
 	result, err := a.Client.HCService().Azure.Vpc.Create(
 		a.Cts.Kit.Ctx,
@@ -34,9 +40,14 @@ func (a *ApplicationOfCreateAzureVpc) Deliver() (enumor.ApplicationStatus, map[s
 		common.ConvAzureVpcCreateReq(a.req),
 	)
 	if err != nil || result == nil {
+		// 创建失败时释放从地址池分配的网段
+		logics.ReleaseIpamAllocation(a.Cts.Kit, a.Ipam, &a.req.IpamOption)
 		return enumor.DeliverError, map[string]interface{}{"error": err.Error()}, err
 	}
 
+	// 回填地址池分配记录
+	logics.BindIpamAllocation(a.Cts.Kit, a.Ipam, &a.req.IpamOption, a.req.IPv4Cidr, result.ID)
+
 	// 交付vpc到业务下
 	deliverVpcResult, err := logics.DeliverVpc(a.Cts.Kit, a.req.BkBizID,
 		a.Client.DataService(), a.Audit, result.ID)
@@ -90,4 +101,3 @@ func (a *ApplicationOfCreateAzureVpc) Deliver() (enumor.ApplicationStatus, map[s
 
 	return enumor.Completed, map[string]interface{}{"vpc_id": result.ID}, nil
 }
-
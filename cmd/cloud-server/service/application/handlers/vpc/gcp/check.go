@@ -19,7 +19,9 @@
 
 package gcp
 
-import logicsaccount "hcm/cmd/cloud-server/logics/account"
+import (
+	logicsaccount "hcm/cmd/cloud-server/logics/account"
+)
 
 // CheckReq 检查申请单的数据是否正确
 func (a *ApplicationOfCreateGcpVpc) CheckReq() error {
@@ -22,6 +22,8 @@ package gcp
 import (
 	"fmt"
 	"strings"
+
+	"hcm/cmd/cloud-server/service/application/handlers/vpc/logics"
 )
 
 type formItem struct {
@@ -134,7 +136,7 @@ func (a *ApplicationOfCreateGcpVpc) renderSubnet() ([]formItem, error) {
 	formItems = append(formItems, formItem{Label: "子网名称", Value: req.Subnet.Name})
 
 	// IPv4 CIDR
-	formItems = append(formItems, formItem{Label: "子网IPv4 CIDR", Value: req.Subnet.IPv4Cidr})
+	formItems = append(formItems, formItem{Label: "子网IPv4 CIDR", Value: logics.IpamCidrDisplay(req.IpamOption, req.Subnet.IPv4Cidr)})
 
 	// 专用 Google 访问通道
 	PrivateIPGoogleAccessNameMap := map[bool]string{true: "启用", false: "禁用"}
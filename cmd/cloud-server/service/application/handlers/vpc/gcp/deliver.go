@@ -27,6 +27,12 @@ import (
 
 // Deliver 执行资源交付
 func (a *ApplicationOfCreateGcpVpc) Deliver() (enumor.ApplicationStatus, map[string]interface{}, error) {
+	// 设置了IP地址池时在交付时从地址池分配子网网段，避免审批未通过时占用网段
+	if err := logics.AllocateSubnetCidr(a.Cts.Kit, a.Ipam, &a.req.IpamOption, a.req.Subnet.Name,
+		&a.req.Subnet.IPv4Cidr); err != nil {
+		return enumor.DeliverError, map[string]interface{}{"error": err.Error()}, err
+	}
+
 	// 创建vpc
 	result, err := a.Client.HCService().Gcp.Vpc.Create(
 		a.Cts.Kit.Ctx,
@@ -34,6 +40,8 @@ func (a *ApplicationOfCreateGcpVpc) Deliver() (enumor.ApplicationStatus, map[str
 		common.ConvGcpVpcCreateReq(a.req),
 	)
 	if err != nil || result == nil {
+		// 创建失败时释放从地址池分配的网段
+		logics.ReleaseIpamAllocation(a.Cts.Kit, a.Ipam, &a.req.IpamOption)
 		return enumor.DeliverError, map[string]interface{}{"error": err.Error()}, err
 	}
 
@@ -61,6 +69,11 @@ func (a *ApplicationOfCreateGcpVpc) Deliver() (enumor.ApplicationStatus, map[str
 		subnetIDs := make([]string, 0, len(subnetsInfo))
 		for _, one := range subnetsInfo {
 			subnetIDs = append(subnetIDs, one.ID)
+
+			// 回填地址池分配记录，gcp的网段在子网上
+			if one.Name == a.req.Subnet.Name {
+				logics.BindIpamAllocation(a.Cts.Kit, a.Ipam, &a.req.IpamOption, a.req.Subnet.IPv4Cidr, one.ID)
+			}
 		}
 		deliverSubnetResult, err := logics.DeliverSubnet(a.Cts.Kit, a.req.BkBizID,
 			a.Client.DataService(), a.Audit, subnetIDs)
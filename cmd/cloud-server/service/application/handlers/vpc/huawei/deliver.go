@@ -27,6 +27,21 @@ import (
 
 // Deliver 执行资源交付
 func (a *ApplicationOfCreateHuaWeiVpc) Deliver() (enumor.ApplicationStatus, map[string]interface{}, error) {
+	// 设置了IP地址池时在交付时从地址池分配网段，避免审批未通过时占用网段
+	if err := logics.AllocateVpcCidr(a.Cts.Kit, a.Ipam, &a.req.IpamOption, a.req.Name, &a.req.IPv4Cidr,
+		&a.req.Subnet.IPv4Cidr); err != nil {
+		return enumor.DeliverError, map[string]interface{}{"error": err.Error()}, err
+	}
+
+	if len(a.req.IpamAllocationID) != 0 && len(a.req.Subnet.GatewayIP) == 0 {
+		gatewayIP, err := logics.FirstHostIP(a.req.Subnet.IPv4Cidr)
+		if err != nil {
+			logics.ReleaseIpamAllocation(a.Cts.Kit, a.Ipam, &a.req.IpamOption)
+			return enumor.DeliverError, map[string]interface{}{"error": err.Error()}, err
+		}
+		a.req.Subnet.GatewayIP = gatewayIP
+	}
+
 	// 创建vpc
 	result, err := a.Client.HCService().HuaWei.Vpc.Create(
 		a.Cts.Kit.Ctx,
@@ -34,9 +49,14 @@ func (a *ApplicationOfCreateHuaWeiVpc) Deliver() (enumor.ApplicationStatus, map[
 		common.ConvHuaWeiVpcCreateReq(a.req),
 	)
 	if err != nil || result == nil {
+		// 创建失败时释放从地址池分配的网段
+		logics.ReleaseIpamAllocation(a.Cts.Kit, a.Ipam, &a.req.IpamOption)
 		return enumor.DeliverError, map[string]interface{}{"error": err.Error()}, err
 	}
 
+	// 回填地址池分配记录
+	logics.BindIpamAllocation(a.Cts.Kit, a.Ipam, &a.req.IpamOption, a.req.IPv4Cidr, result.ID)
+
 	// 交付vpc到业务下
 	deliverVpcResult, err := logics.DeliverVpc(a.Cts.Kit, a.req.BkBizID,
 		a.Client.DataService(), a.Audit, result.ID)
@@ -0,0 +1,165 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package logics
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"hcm/cmd/cloud-server/logics/ipam"
+	csipam "hcm/pkg/api/cloud-server/ipam"
+	csvpc "hcm/pkg/api/cloud-server/vpc"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/cidr"
+)
+
+// defaultSubnetMaskLen 未指定子网掩码长度时使用的默认值
+const defaultSubnetMaskLen = 24
+
+// AllocateVpcCidr 设置了IP地址池且未指定VPC网段时，从地址池分配VPC网段，subnetCidr不为nil且未指定时在VPC网段中分配子网网段
+func AllocateVpcCidr(kt *kit.Kit, ipamLgc ipam.Interface, opt *csvpc.IpamOption, name string, vpcCidr *string,
+	subnetCidr *string) error {
+
+	if len(opt.IpamPoolID) == 0 || len(*vpcCidr) != 0 {
+		return nil
+	}
+
+	if opt.IpamMaskLen == 0 {
+		return errors.New("ipam_mask_len is required when ipam_pool_id is set")
+	}
+
+	memo := fmt.Sprintf("application create vpc: %s", name)
+	result, err := ipamLgc.AllocateCidr(kt, opt.IpamPoolID, &csipam.AllocateCidrReq{
+		ResType: enumor.VpcCloudResType,
+		MaskLen: opt.IpamMaskLen,
+		Memo:    &memo,
+	})
+	if err != nil {
+		logs.Errorf("allocate vpc cidr from ipam pool failed, err: %v, pool: %s, rid: %s", err, opt.IpamPoolID,
+			kt.Rid)
+		return err
+	}
+
+	*vpcCidr = result.Cidr
+	opt.IpamAllocationID = result.ID
+
+	if subnetCidr == nil || len(*subnetCidr) != 0 {
+		return nil
+	}
+
+	subnetMaskLen := opt.SubnetMaskLen
+	if subnetMaskLen == 0 {
+		subnetMaskLen = max(opt.IpamMaskLen, defaultSubnetMaskLen)
+	}
+
+	*subnetCidr, err = cidr.FirstAvailableIpv4Net(result.Cidr, nil, subnetMaskLen)
+	if err != nil {
+		return fmt.Errorf("allocate /%d subnet cidr in vpc cidr %s failed, err: %v", subnetMaskLen, result.Cidr, err)
+	}
+
+	return nil
+}
+
+// AllocateSubnetCidr 设置了IP地址池且未指定子网网段时，从地址池分配子网网段，用于没有VPC网段的云厂商
+func AllocateSubnetCidr(kt *kit.Kit, ipamLgc ipam.Interface, opt *csvpc.IpamOption, name string,
+	subnetCidr *string) error {
+
+	if len(opt.IpamPoolID) == 0 || len(*subnetCidr) != 0 {
+		return nil
+	}
+
+	maskLen := opt.SubnetMaskLen
+	if maskLen == 0 {
+		maskLen = opt.IpamMaskLen
+	}
+	if maskLen == 0 {
+		return errors.New("subnet_mask_len is required when ipam_pool_id is set")
+	}
+
+	memo := fmt.Sprintf("application create subnet: %s", name)
+	result, err := ipamLgc.AllocateCidr(kt, opt.IpamPoolID, &csipam.AllocateCidrReq{
+		ResType: enumor.SubnetCloudResType,
+		MaskLen: maskLen,
+		Memo:    &memo,
+	})
+	if err != nil {
+		logs.Errorf("allocate subnet cidr from ipam pool failed, err: %v, pool: %s, rid: %s", err, opt.IpamPoolID,
+			kt.Rid)
+		return err
+	}
+
+	*subnetCidr = result.Cidr
+	opt.IpamAllocationID = result.ID
+	return nil
+}
+
+// IpamCidrDisplay 申请单中展示的网段，未指定网段时交付时从地址池分配
+func IpamCidrDisplay(opt csvpc.IpamOption, value string) string {
+	if opt.IsIpamAllocate(value) {
+		return "从IP地址池分配"
+	}
+
+	return value
+}
+
+// FirstHostIP 返回网段中第一个主机地址，用于网关地址
+func FirstHostIP(ipv4Cidr string) (string, error) {
+	_, ipNet, err := net.ParseCIDR(ipv4Cidr)
+	if err != nil {
+		return "", err
+	}
+
+	ip := ipNet.IP.To4()
+	if ip == nil {
+		return "", fmt.Errorf("cidr %s is not ipv4", ipv4Cidr)
+	}
+
+	gateway := make(net.IP, len(ip))
+	copy(gateway, ip)
+	gateway[3]++
+	return gateway.String(), nil
+}
+
+// BindIpamAllocation 交付成功后回填地址池分配记录的资源ID，回填失败不影响交付结果
+func BindIpamAllocation(kt *kit.Kit, ipamLgc ipam.Interface, opt *csvpc.IpamOption, allocatedCidr, resID string) {
+	if len(opt.IpamAllocationID) == 0 {
+		return
+	}
+
+	if err := ipamLgc.BindAllocation(kt, opt.IpamAllocationID, allocatedCidr, resID); err != nil {
+		logs.Errorf("bind ipam allocation failed, err: %v, allocation: %s, res_id: %s, rid: %s", err,
+			opt.IpamAllocationID, resID, kt.Rid)
+	}
+}
+
+// ReleaseIpamAllocation 交付创建资源失败时释放已从地址池分配的网段
+func ReleaseIpamAllocation(kt *kit.Kit, ipamLgc ipam.Interface, opt *csvpc.IpamOption) {
+	if len(opt.IpamAllocationID) == 0 {
+		return
+	}
+
+	if err := ipamLgc.ReleaseAllocation(kt, []string{opt.IpamAllocationID}); err != nil {
+		logs.Errorf("release ipam allocation failed, err: %v, allocation: %s, rid: %s", err, opt.IpamAllocationID,
+			kt.Rid)
+	}
+}
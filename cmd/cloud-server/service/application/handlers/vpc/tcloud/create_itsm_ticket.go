@@ -22,6 +22,8 @@ package tcloud
 import (
 	"fmt"
 	"strings"
+
+	"hcm/cmd/cloud-server/service/application/handlers/vpc/logics"
 )
 
 type formItem struct {
@@ -113,7 +115,7 @@ func (a *ApplicationOfCreateTCloudVpc) renderVpc() ([]formItem, error) {
 	formItems = append(formItems, formItem{Label: "名称", Value: req.Name})
 
 	// IPv4 CIDR
-	formItems = append(formItems, formItem{Label: "IPv4 CIDR", Value: req.IPv4Cidr})
+	formItems = append(formItems, formItem{Label: "IPv4 CIDR", Value: logics.IpamCidrDisplay(req.IpamOption, req.IPv4Cidr)})
 
 	// 所属的蓝鲸云区域
 	bkCloudAreaName, err := a.GetCloudAreaName(req.BkCloudID)
@@ -133,7 +135,7 @@ func (a *ApplicationOfCreateTCloudVpc) renderSubnet() ([]formItem, error) {
 	formItems = append(formItems, formItem{Label: "子网名称", Value: req.Subnet.Name})
 
 	// IPv4 CIDR
-	formItems = append(formItems, formItem{Label: "子网IPv4 CIDR", Value: req.Subnet.IPv4Cidr})
+	formItems = append(formItems, formItem{Label: "子网IPv4 CIDR", Value: logics.IpamCidrDisplay(req.IpamOption, req.Subnet.IPv4Cidr)})
 
 	// 可用区
 	zoneInfo, err := a.GetZone(a.Vendor(), req.Region, req.Subnet.Zone)
@@ -27,6 +27,7 @@ import (
 	"github.com/tidwall/gjson"
 
 	"hcm/cmd/cloud-server/logics/audit"
+	"hcm/cmd/cloud-server/logics/ipam"
 	"hcm/cmd/cloud-server/service/application/handlers"
 	"hcm/cmd/cloud-server/service/capability"
 	"hcm/pkg/api/core"
@@ -56,6 +57,7 @@ func InitApplicationService(c *capability.Capability, bkHcmUrl string) {
 		esbCli:     c.EsbClient,
 		bkHcmUrl:   bkHcmUrl,
 		cmsiCli:    c.CmsiCli,
+		ipamLgc:    c.Logics.Ipam,
 	}
 	h := rest.NewHandler()
 	h.Add("List", "POST", "/applications/list", svc.List)
@@ -87,6 +89,7 @@ type applicationSvc struct {
 	esbCli     esb.Client
 	bkHcmUrl   string
 	cmsiCli    cmsi.Client
+	ipamLgc    ipam.Interface
 }
 
 func (a *applicationSvc) getCallbackUrl() string {
@@ -102,6 +105,7 @@ func (a *applicationSvc) getHandlerOption(cts *rest.Contexts) *handlers.HandlerO
 		Cipher:    a.cipher,
 		Audit:     a.audit,
 		CmsiCli:   a.cmsiCli,
+		Ipam:      a.ipamLgc,
 	}
 }
 
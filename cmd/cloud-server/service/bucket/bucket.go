@@ -0,0 +1,106 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package bucket ...
+package bucket
+
+import (
+	"net/http"
+
+	"hcm/cmd/cloud-server/logics/audit"
+	bucketlogics "hcm/cmd/cloud-server/logics/bucket"
+	"hcm/cmd/cloud-server/service/capability"
+	proto "hcm/pkg/api/cloud-server"
+	"hcm/pkg/api/core"
+	"hcm/pkg/client"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/iam/auth"
+	"hcm/pkg/iam/meta"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/hooks/handler"
+)
+
+// InitService initialize the bucket service.
+func InitService(c *capability.Capability) {
+	svc := &bucketSvc{
+		client:     c.ApiClient,
+		authorizer: c.Authorizer,
+		audit:      c.Audit,
+		bucketLgc:  c.Logics.Bucket,
+	}
+
+	h := rest.NewHandler()
+
+	h.Add("ListBucket", http.MethodPost, "/buckets/list", svc.ListBucket)
+	h.Add("AssignBucketToBiz", http.MethodPost, "/buckets/assign/bizs", svc.AssignBucketToBiz)
+	h.Add("CheckPublicBucket", http.MethodPost, "/buckets/public/check", svc.CheckPublicBucket)
+
+	// 业务下的接口
+	h.Add("ListBizBucket", http.MethodPost, "/bizs/{bk_biz_id}/buckets/list", svc.ListBizBucket)
+	h.Add("CheckBizPublicBucket", http.MethodPost, "/bizs/{bk_biz_id}/buckets/public/check",
+		svc.CheckBizPublicBucket)
+
+	h.Load(c.WebService)
+}
+
+type bucketSvc struct {
+	client     *client.ClientSet
+	authorizer auth.Authorizer
+	audit      audit.Interface
+	bucketLgc  bucketlogics.Interface
+}
+
+// ListBucket list resource bucket.
+func (svc *bucketSvc) ListBucket(cts *rest.Contexts) (interface{}, error) {
+	return svc.listBucket(cts, handler.ListResourceAuthRes)
+}
+
+// ListBizBucket list biz bucket.
+func (svc *bucketSvc) ListBizBucket(cts *rest.Contexts) (interface{}, error) {
+	return svc.listBucket(cts, handler.ListBizAuthRes)
+}
+
+func (svc *bucketSvc) listBucket(cts *rest.Contexts, authHandler handler.ListAuthResHandler) (interface{}, error) {
+	req := new(proto.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	expr, noPermFlag, err := authHandler(cts, &handler.ListAuthResOption{Authorizer: svc.authorizer,
+		ResType: meta.Bucket, Action: meta.Find, Filter: req.Filter})
+	if err != nil {
+		logs.Errorf("list bucket auth failed, noPermFlag: %v, err: %v, rid: %s", noPermFlag, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if noPermFlag {
+		return &core.ListResult{Count: 0, Details: make([]interface{}, 0)}, nil
+	}
+
+	listReq := &core.ListReq{
+		Filter: expr,
+		Page:   req.Page,
+	}
+	return svc.client.DataService().Global.Bucket.List(cts.Kit, listReq)
+}
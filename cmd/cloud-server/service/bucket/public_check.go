@@ -0,0 +1,97 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bucket
+
+import (
+	csbucket "hcm/pkg/api/cloud-server/bucket"
+	corebucket "hcm/pkg/api/core/cloud/bucket"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/iam/meta"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/runtime/filter"
+	"hcm/pkg/tools/hooks/handler"
+)
+
+// CheckPublicBucket 检查公开可读的存储桶，按业务分组返回
+func (svc *bucketSvc) CheckPublicBucket(cts *rest.Contexts) (interface{}, error) {
+	req := new(csbucket.PublicBucketCheckReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	expr := tools.AllExpression()
+	if len(req.BkBizIDs) != 0 {
+		expr = tools.ContainersExpression("bk_biz_id", req.BkBizIDs)
+	}
+
+	return svc.checkPublicBucket(cts, handler.ListResourceAuthRes, expr)
+}
+
+// CheckBizPublicBucket 检查业务下公开可读的存储桶
+func (svc *bucketSvc) CheckBizPublicBucket(cts *rest.Contexts) (interface{}, error) {
+	return svc.checkPublicBucket(cts, handler.ListBizAuthRes, tools.AllExpression())
+}
+
+func (svc *bucketSvc) checkPublicBucket(cts *rest.Contexts, authHandler handler.ListAuthResHandler,
+	expr *filter.Expression) (*csbucket.PublicBucketCheckResult, error) {
+
+	authExpr, noPermFlag, err := authHandler(cts, &handler.ListAuthResOption{Authorizer: svc.authorizer,
+		ResType: meta.Bucket, Action: meta.Find, Filter: expr})
+	if err != nil {
+		logs.Errorf("check public bucket auth failed, noPermFlag: %v, err: %v, rid: %s", noPermFlag, err,
+			cts.Kit.Rid)
+		return nil, err
+	}
+
+	result := &csbucket.PublicBucketCheckResult{Details: make([]csbucket.BizPublicBucket, 0)}
+	if noPermFlag {
+		return result, nil
+	}
+
+	buckets, err := svc.bucketLgc.ListPublicBucket(cts.Kit, authExpr)
+	if err != nil {
+		return nil, err
+	}
+
+	bizBucketMap := make(map[int64][]corebucket.BaseBucket)
+	bizIDs := make([]int64, 0)
+	for _, one := range buckets {
+		if _, exist := bizBucketMap[one.BkBizID]; !exist {
+			bizIDs = append(bizIDs, one.BkBizID)
+		}
+		bizBucketMap[one.BkBizID] = append(bizBucketMap[one.BkBizID], one)
+	}
+
+	for _, bizID := range bizIDs {
+		result.Details = append(result.Details, csbucket.BizPublicBucket{
+			BkBizID: bizID,
+			Count:   uint64(len(bizBucketMap[bizID])),
+			Buckets: bizBucketMap[bizID],
+		})
+	}
+
+	return result, nil
+}
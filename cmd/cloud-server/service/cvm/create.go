@@ -118,6 +118,11 @@ func (svc *cvmSvc) buildCreateAzureCvmTasks(body json.RawMessage) ([]ts.CustomFl
 			}
 		})
 
+	if req.DnsRecord != nil {
+		tasks = actioncvm.AppendRegisterDnsTask(tasks, &actioncvm.RegisterDnsRecordOption{Vendor: enumor.Azure,
+			AccountID: req.AccountID, ZoneID: req.DnsRecord.ZoneID, TTL: req.DnsRecord.TTL})
+	}
+
 	return tasks, nil
 }
 
@@ -146,6 +151,11 @@ func (svc *cvmSvc) buildCreateHuaWeiCvmTasks(body json.RawMessage) ([]ts.CustomF
 			}
 		})
 
+	if req.DnsRecord != nil {
+		tasks = actioncvm.AppendRegisterDnsTask(tasks, &actioncvm.RegisterDnsRecordOption{Vendor: enumor.HuaWei,
+			AccountID: req.AccountID, ZoneID: req.DnsRecord.ZoneID, TTL: req.DnsRecord.TTL})
+	}
+
 	return tasks, nil
 }
 
@@ -174,6 +184,11 @@ func (svc *cvmSvc) buildCreateGcpCvmTasks(body json.RawMessage) ([]ts.CustomFlow
 			}
 		})
 
+	if req.DnsRecord != nil {
+		tasks = actioncvm.AppendRegisterDnsTask(tasks, &actioncvm.RegisterDnsRecordOption{Vendor: enumor.Gcp,
+			AccountID: req.AccountID, ZoneID: req.DnsRecord.ZoneID, TTL: req.DnsRecord.TTL})
+	}
+
 	return tasks, nil
 }
 
@@ -202,6 +217,11 @@ func (svc *cvmSvc) buildCreateAwsCvmTasks(body json.RawMessage) ([]ts.CustomFlow
 			}
 		})
 
+	if req.DnsRecord != nil {
+		tasks = actioncvm.AppendRegisterDnsTask(tasks, &actioncvm.RegisterDnsRecordOption{Vendor: enumor.Aws,
+			AccountID: req.AccountID, ZoneID: req.DnsRecord.ZoneID, TTL: req.DnsRecord.TTL})
+	}
+
 	return tasks, nil
 }
 
@@ -230,5 +250,10 @@ func (svc *cvmSvc) buildCreateTCloudCvmTasks(body json.RawMessage) ([]ts.CustomF
 			}
 		})
 
+	if req.DnsRecord != nil {
+		tasks = actioncvm.AppendRegisterDnsTask(tasks, &actioncvm.RegisterDnsRecordOption{Vendor: enumor.TCloud,
+			AccountID: req.AccountID, ZoneID: req.DnsRecord.ZoneID, TTL: req.DnsRecord.TTL})
+	}
+
 	return tasks, nil
 }
@@ -81,6 +81,19 @@ func (svc *cvmSvc) recycleCvmSvc(cts *rest.Contexts, validHandler handler.ValidW
 		return nil, err
 	}
 
+	// 0. 容器集群节点不允许回收，只拒绝其中的节点主机，其余主机继续回收
+	basicInfoMap, rejected, err := svc.cvmLgc.SplitK8sNodeCvm(cts.Kit, basicInfoMap)
+	if err != nil {
+		return nil, err
+	}
+	if len(basicInfoMap) == 0 {
+		return recycle.RecycleResult{Failed: rejected}, nil
+	}
+	req.Infos = slice.Filter(req.Infos, func(info proto.CvmRecycleInfo) bool {
+		_, exists := basicInfoMap[info.ID]
+		return exists
+	})
+
 	// 1. 预检，有一个失败则全部失败，且不进审计
 	if err := svc.cvmLgc.RecyclePreCheck(cts.Kit, basicInfoMap); err != nil {
 		logs.Errorf("recycle precheck fail, err: %v, rid: %s", err, cts.Kit.Rid)
@@ -120,7 +133,7 @@ func (svc *cvmSvc) recycleCvmSvc(cts *rest.Contexts, validHandler handler.ValidW
 	if err != nil {
 		return nil, err
 	}
-	return recycle.RecycleResult{TaskID: taskID}, nil
+	return recycle.RecycleResult{TaskID: taskID, Failed: rejected}, nil
 }
 
 // recycleCvm  回收核心逻辑（创建recycle record）
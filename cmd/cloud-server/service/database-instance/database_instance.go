@@ -0,0 +1,119 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package dbinstance ...
+package dbinstance
+
+import (
+	"net/http"
+
+	"hcm/cmd/cloud-server/logics/audit"
+	dblogics "hcm/cmd/cloud-server/logics/database-instance"
+	"hcm/cmd/cloud-server/service/capability"
+	proto "hcm/pkg/api/cloud-server"
+	"hcm/pkg/api/core"
+	"hcm/pkg/client"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/iam/auth"
+	"hcm/pkg/iam/meta"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/hooks/handler"
+)
+
+// InitService initialize the database instance service.
+func InitService(c *capability.Capability) {
+	svc := &dbInstanceSvc{
+		client:     c.ApiClient,
+		authorizer: c.Authorizer,
+		audit:      c.Audit,
+		dbLgc:      c.Logics.DatabaseInstance,
+	}
+
+	h := rest.NewHandler()
+
+	h.Add("ListDatabaseInstance", http.MethodPost, "/database_instances/list", svc.ListDatabaseInstance)
+	h.Add("AssignDatabaseInstanceToBiz", http.MethodPost, "/database_instances/assign/bizs",
+		svc.AssignDatabaseInstanceToBiz)
+	h.Add("RecycleDatabaseInstance", http.MethodPost, "/database_instances/recycle", svc.RecycleDatabaseInstance)
+	h.Add("RecoverDatabaseInstance", http.MethodPost, "/database_instances/recover", svc.RecoverDatabaseInstance)
+	h.Add("BatchDeleteRecycledDatabaseInstance", http.MethodDelete, "/recycled/database_instances/batch",
+		svc.BatchDeleteRecycledDatabaseInstance)
+
+	// 业务下的接口
+	h.Add("ListBizDatabaseInstance", http.MethodPost, "/bizs/{bk_biz_id}/database_instances/list",
+		svc.ListBizDatabaseInstance)
+	h.Add("RecycleBizDatabaseInstance", http.MethodPost, "/bizs/{bk_biz_id}/database_instances/recycle",
+		svc.RecycleBizDatabaseInstance)
+	h.Add("RecoverBizDatabaseInstance", http.MethodPost, "/bizs/{bk_biz_id}/database_instances/recover",
+		svc.RecoverBizDatabaseInstance)
+	h.Add("BatchDeleteBizRecycledDatabaseInstance", http.MethodDelete,
+		"/bizs/{bk_biz_id}/recycled/database_instances/batch", svc.BatchDeleteBizRecycledDatabaseInstance)
+
+	h.Load(c.WebService)
+}
+
+type dbInstanceSvc struct {
+	client     *client.ClientSet
+	authorizer auth.Authorizer
+	audit      audit.Interface
+	dbLgc      dblogics.Interface
+}
+
+// ListDatabaseInstance list resource database instance.
+func (svc *dbInstanceSvc) ListDatabaseInstance(cts *rest.Contexts) (interface{}, error) {
+	return svc.listDatabaseInstance(cts, handler.ListResourceAuthRes)
+}
+
+// ListBizDatabaseInstance list biz database instance.
+func (svc *dbInstanceSvc) ListBizDatabaseInstance(cts *rest.Contexts) (interface{}, error) {
+	return svc.listDatabaseInstance(cts, handler.ListBizAuthRes)
+}
+
+func (svc *dbInstanceSvc) listDatabaseInstance(cts *rest.Contexts, authHandler handler.ListAuthResHandler) (
+	interface{}, error) {
+
+	req := new(proto.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	// 云数据库实例没有单独的权限模型，跟随主机鉴权
+	expr, noPermFlag, err := authHandler(cts, &handler.ListAuthResOption{Authorizer: svc.authorizer,
+		ResType: meta.DatabaseInstance, Action: meta.Find, Filter: req.Filter})
+	if err != nil {
+		logs.Errorf("list database instance auth failed, noPermFlag: %v, err: %v, rid: %s", noPermFlag, err,
+			cts.Kit.Rid)
+		return nil, err
+	}
+
+	if noPermFlag {
+		return &core.ListResult{Count: 0, Details: make([]interface{}, 0)}, nil
+	}
+
+	listReq := &core.ListReq{
+		Filter: expr,
+		Page:   req.Page,
+	}
+	return svc.client.DataService().Global.DatabaseInstance.List(cts.Kit, listReq)
+}
@@ -0,0 +1,298 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dbinstance
+
+import (
+	"fmt"
+
+	logicsrecycle "hcm/cmd/cloud-server/logics/recycle"
+	csdbinstance "hcm/pkg/api/cloud-server/database-instance"
+	csrecycle "hcm/pkg/api/cloud-server/recycle"
+	"hcm/pkg/api/core"
+	corerr "hcm/pkg/api/core/recycle-record"
+	protoaudit "hcm/pkg/api/data-service/audit"
+	"hcm/pkg/api/data-service/cloud"
+	dsrr "hcm/pkg/api/data-service/recycle-record"
+	"hcm/pkg/cc"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/dal/dao/types"
+	"hcm/pkg/iam/meta"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/hooks/handler"
+)
+
+// RecycleDatabaseInstance recycle database instance.
+func (svc *dbInstanceSvc) RecycleDatabaseInstance(cts *rest.Contexts) (interface{}, error) {
+	return svc.recycleDatabaseInstance(cts, handler.ResOperateAuth)
+}
+
+// RecycleBizDatabaseInstance recycle biz database instance.
+func (svc *dbInstanceSvc) RecycleBizDatabaseInstance(cts *rest.Contexts) (interface{}, error) {
+	return svc.recycleDatabaseInstance(cts, handler.BizOperateAuth)
+}
+
+func (svc *dbInstanceSvc) recycleDatabaseInstance(cts *rest.Contexts, validHandler handler.ValidWithAuthHandler) (
+	interface{}, error) {
+
+	req := new(csdbinstance.DatabaseInstanceRecycleReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	basicInfoMap, err := svc.listBasicInfo(cts.Kit, req.IDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// validate biz and authorize
+	err = validHandler(cts, &handler.ValidWithAuthOption{Authorizer: svc.authorizer, ResType: meta.DatabaseInstance,
+		Action: meta.Recycle, BasicInfos: basicInfoMap})
+	if err != nil {
+		return nil, err
+	}
+
+	// create recycle audit
+	auditInfos := make([]protoaudit.CloudResRecycleAuditInfo, 0, len(req.IDs))
+	for _, id := range req.IDs {
+		auditInfos = append(auditInfos, protoaudit.CloudResRecycleAuditInfo{ResID: id})
+	}
+	auditReq := &protoaudit.CloudResourceRecycleAuditReq{
+		ResType: enumor.DatabaseInstanceAuditResType,
+		Action:  protoaudit.Recycle,
+		Infos:   auditInfos,
+	}
+	if err = svc.audit.ResRecycleAudit(cts.Kit, auditReq); err != nil {
+		logs.Errorf("create recycle audit failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	// create recycle record
+	opt := &dsrr.BatchRecycleReq{
+		ResType:            enumor.DatabaseInstanceCloudResType,
+		DefaultRecycleTime: cc.CloudServer().Recycle.AutoDeleteTime,
+		Infos:              make([]dsrr.RecycleReq, 0, len(req.IDs)),
+	}
+	for _, id := range req.IDs {
+		opt.Infos = append(opt.Infos, dsrr.RecycleReq{ID: id})
+	}
+
+	taskID, err := svc.client.DataService().Global.RecycleRecord.BatchRecycleCloudRes(cts.Kit, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &csrecycle.RecycleResult{TaskID: taskID}, nil
+}
+
+// RecoverDatabaseInstance recover database instance.
+func (svc *dbInstanceSvc) RecoverDatabaseInstance(cts *rest.Contexts) (interface{}, error) {
+	return svc.recoverDatabaseInstance(cts, handler.ResOperateAuth)
+}
+
+// RecoverBizDatabaseInstance recover biz database instance.
+func (svc *dbInstanceSvc) RecoverBizDatabaseInstance(cts *rest.Contexts) (interface{}, error) {
+	return svc.recoverDatabaseInstance(cts, handler.BizOperateAuth)
+}
+
+func (svc *dbInstanceSvc) recoverDatabaseInstance(cts *rest.Contexts, validHandler handler.ValidWithAuthHandler) (
+	interface{}, error) {
+
+	req := new(csdbinstance.DatabaseInstanceRecoverReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	records, err := svc.listRecycleRecord(cts.Kit, req.RecordIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(records.Details))
+	auditInfos := make([]protoaudit.CloudResRecycleAuditInfo, 0, len(records.Details))
+	for _, record := range records.Details {
+		ids = append(ids, record.ResID)
+		auditInfos = append(auditInfos, protoaudit.CloudResRecycleAuditInfo{ResID: record.ResID, Data: record.Detail})
+	}
+
+	basicInfoMap, err := svc.listBasicInfo(cts.Kit, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// validate biz and authorize
+	err = validHandler(cts, &handler.ValidWithAuthOption{Authorizer: svc.authorizer, ResType: meta.DatabaseInstance,
+		Action: meta.Recover, BasicInfos: basicInfoMap})
+	if err != nil {
+		return nil, err
+	}
+
+	// create recover audit
+	auditReq := &protoaudit.CloudResourceRecycleAuditReq{
+		ResType: enumor.DatabaseInstanceAuditResType,
+		Action:  protoaudit.Recover,
+		Infos:   auditInfos,
+	}
+	if err = svc.audit.ResRecycleAudit(cts.Kit, auditReq); err != nil {
+		logs.Errorf("create recover audit failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	opt := &dsrr.BatchRecoverReq{
+		ResType:   enumor.DatabaseInstanceCloudResType,
+		RecordIDs: req.RecordIDs,
+	}
+	if err = svc.client.DataService().Global.RecycleRecord.BatchRecoverCloudResource(cts.Kit, opt); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// BatchDeleteRecycledDatabaseInstance batch delete recycled database instances.
+func (svc *dbInstanceSvc) BatchDeleteRecycledDatabaseInstance(cts *rest.Contexts) (interface{}, error) {
+	return svc.batchDeleteRecycledDatabaseInstance(cts, handler.ResOperateAuth)
+}
+
+// BatchDeleteBizRecycledDatabaseInstance batch delete biz recycled database instances.
+func (svc *dbInstanceSvc) BatchDeleteBizRecycledDatabaseInstance(cts *rest.Contexts) (interface{}, error) {
+	return svc.batchDeleteRecycledDatabaseInstance(cts, handler.BizOperateAuth)
+}
+
+func (svc *dbInstanceSvc) batchDeleteRecycledDatabaseInstance(cts *rest.Contexts,
+	validHandler handler.ValidWithAuthHandler) (interface{}, error) {
+
+	req := new(csdbinstance.DatabaseInstanceDeleteRecycleReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	records, err := svc.listRecycleRecord(cts.Kit, req.RecordIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	opRet := new(core.BatchOperateResult)
+	var recycleErr error
+	for _, record := range records.Details {
+		recycleErr = svc.destroyOneRecord(cts, validHandler, record)
+		if recycleErr != nil {
+			logs.Errorf("fail to destroy database instance recycle record(%s), err: %v, rid:%s", record.ID,
+				recycleErr, cts.Kit.Rid)
+
+			opRet.Failed = &core.FailedInfo{ID: record.ID, Error: recycleErr}
+			if ef := errf.Error(recycleErr); ef != nil && ef.Code == errf.RecordNotFound {
+				logicsrecycle.MarkRecordFailed(cts.Kit, svc.client.DataService(), recycleErr, []string{record.ID})
+			}
+			break
+		}
+		opRet.Succeeded = append(opRet.Succeeded, record.ID)
+	}
+	// 标记成功
+	if len(opRet.Succeeded) > 0 {
+		logicsrecycle.MarkRecordSuccess(cts.Kit, svc.client.DataService(), opRet.Succeeded)
+	}
+	return opRet, recycleErr
+}
+
+func (svc *dbInstanceSvc) destroyOneRecord(cts *rest.Contexts, validHandler handler.ValidWithAuthHandler,
+	record corerr.RecycleRecord) error {
+
+	basicInfoMap, err := svc.listBasicInfo(cts.Kit, []string{record.ResID})
+	if err != nil {
+		return err
+	}
+
+	// validate biz and authorize
+	err = validHandler(cts, &handler.ValidWithAuthOption{Authorizer: svc.authorizer, ResType: meta.DatabaseInstance,
+		Action: meta.Destroy, BasicInfos: basicInfoMap})
+	if err != nil {
+		return err
+	}
+
+	if _, err = svc.dbLgc.DeleteRecycledDatabaseInstance(cts.Kit, basicInfoMap); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// listRecycleRecord 查询回收记录，只能批量处理处于同一个回收任务的且是等待回收的记录
+func (svc *dbInstanceSvc) listRecycleRecord(kt *kit.Kit, recordIDs []string) (*dsrr.ListResult, error) {
+	listReq := &core.ListReq{
+		Filter: tools.ContainersExpression("id", recordIDs),
+		Page:   &core.BasePage{Limit: constant.BatchOperationMaxLimit},
+	}
+	records, err := svc.client.DataService().Global.RecycleRecord.ListRecycleRecord(kt, listReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records.Details) != len(recordIDs) {
+		return nil, errf.New(errf.InvalidParameter, "some record_ids are not in recycle bin")
+	}
+
+	taskID := ""
+	for _, one := range records.Details {
+		if len(taskID) == 0 {
+			taskID = one.TaskID
+		} else if taskID != one.TaskID {
+			return nil, errf.New(errf.InvalidParameter,
+				"only database instances in one task can be reclaimed at the same time")
+		}
+
+		if one.Status != enumor.WaitingRecycleRecordStatus {
+			return nil, errf.NewFromErr(errf.InvalidParameter,
+				fmt.Errorf("record: %s not is wait_recycle status", one.ID))
+		}
+
+		if one.ResType != enumor.DatabaseInstanceCloudResType {
+			return nil, errf.NewFromErr(errf.InvalidParameter,
+				fmt.Errorf("record: %s not is database instance recycle record", one.ID))
+		}
+	}
+
+	return records, nil
+}
+
+func (svc *dbInstanceSvc) listBasicInfo(kt *kit.Kit, ids []string) (map[string]types.CloudResourceBasicInfo, error) {
+	basicInfoReq := cloud.ListResourceBasicInfoReq{
+		ResourceType: enumor.DatabaseInstanceCloudResType,
+		IDs:          ids,
+		Fields:       append(types.CommonBasicInfoFields, "recycle_status"),
+	}
+	return svc.client.DataService().Global.Cloud.ListResBasicInfo(kt, basicInfoReq)
+}
@@ -0,0 +1,111 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package ipam
+
+import (
+	proto "hcm/pkg/api/cloud-server"
+	csipam "hcm/pkg/api/cloud-server/ipam"
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/iam/meta"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/hooks/handler"
+)
+
+// ListVpcCidrOverlap list overlapped cidr of all synced vpc.
+func (svc *ipamSvc) ListVpcCidrOverlap(cts *rest.Contexts) (interface{}, error) {
+	req := new(csipam.ListVpcCidrOverlapReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	authRes := meta.ResourceAttribute{Basic: &meta.Basic{Type: meta.IpamPool, Action: meta.Find}}
+	if err := svc.authorizer.AuthorizeWithPerm(cts.Kit, authRes); err != nil {
+		return nil, err
+	}
+
+	overlaps, err := svc.ipamLgc.ListVpcCidrOverlap(cts.Kit, req.Vendors)
+	if err != nil {
+		return nil, err
+	}
+
+	return &csipam.VpcCidrOverlapResult{Details: overlaps}, nil
+}
+
+// ListSubnetUtilization list resource subnet ip utilization.
+func (svc *ipamSvc) ListSubnetUtilization(cts *rest.Contexts) (interface{}, error) {
+	return svc.listSubnetUtilization(cts, handler.ListResourceAuthRes)
+}
+
+// ListBizSubnetUtilization list biz subnet ip utilization.
+func (svc *ipamSvc) ListBizSubnetUtilization(cts *rest.Contexts) (interface{}, error) {
+	return svc.listSubnetUtilization(cts, handler.ListBizAuthRes)
+}
+
+func (svc *ipamSvc) listSubnetUtilization(cts *rest.Contexts, authHandler handler.ListAuthResHandler) (
+	interface{}, error) {
+
+	req := new(proto.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	expr, noPermFlag, err := authHandler(cts, &handler.ListAuthResOption{Authorizer: svc.authorizer,
+		ResType: meta.Subnet, Action: meta.Find, Filter: req.Filter})
+	if err != nil {
+		logs.Errorf("list subnet utilization auth failed, noPermFlag: %v, err: %v, rid: %s", noPermFlag, err,
+			cts.Kit.Rid)
+		return nil, err
+	}
+
+	if noPermFlag {
+		return &csipam.SubnetUtilizationResult{Count: 0, Details: make([]csipam.SubnetUtilization, 0)}, nil
+	}
+
+	listReq := &core.ListReq{
+		Filter: expr,
+		Page:   req.Page,
+	}
+	subnets, err := svc.client.DataService().Global.Subnet.List(cts.Kit.Ctx, cts.Kit.Header(), listReq)
+	if err != nil {
+		logs.Errorf("list subnet failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if req.Page.Count {
+		return &csipam.SubnetUtilizationResult{Count: subnets.Count}, nil
+	}
+
+	details, err := svc.ipamLgc.ListSubnetUtilization(cts.Kit, subnets.Details)
+	if err != nil {
+		return nil, err
+	}
+
+	return &csipam.SubnetUtilizationResult{Details: details}, nil
+}
@@ -0,0 +1,65 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package ipam ...
+package ipam
+
+import (
+	"net/http"
+
+	ipamlogics "hcm/cmd/cloud-server/logics/ipam"
+	"hcm/cmd/cloud-server/service/capability"
+	"hcm/pkg/client"
+	"hcm/pkg/iam/auth"
+	"hcm/pkg/rest"
+)
+
+// InitService initialize the ipam service.
+func InitService(c *capability.Capability) {
+	svc := &ipamSvc{
+		client:     c.ApiClient,
+		authorizer: c.Authorizer,
+		ipamLgc:    c.Logics.Ipam,
+	}
+
+	h := rest.NewHandler()
+
+	h.Add("CreateIpamPool", http.MethodPost, "/ipam/pools/create", svc.CreateIpamPool)
+	h.Add("ListIpamPool", http.MethodPost, "/ipam/pools/list", svc.ListIpamPool)
+	h.Add("UpdateIpamPool", http.MethodPatch, "/ipam/pools/{id}", svc.UpdateIpamPool)
+	h.Add("BatchDeleteIpamPool", http.MethodDelete, "/ipam/pools/batch", svc.BatchDeleteIpamPool)
+	h.Add("AllocateIpamCidr", http.MethodPost, "/ipam/pools/{id}/allocate", svc.AllocateIpamCidr)
+	h.Add("ListIpamAllocation", http.MethodPost, "/ipam/pools/{id}/allocations/list", svc.ListIpamAllocation)
+	h.Add("ReleaseIpamAllocation", http.MethodDelete, "/ipam/allocations/batch", svc.ReleaseIpamAllocation)
+	h.Add("ListVpcCidrOverlap", http.MethodPost, "/ipam/vpcs/cidr_overlaps/list", svc.ListVpcCidrOverlap)
+	h.Add("ListSubnetUtilization", http.MethodPost, "/ipam/subnets/utilization/list", svc.ListSubnetUtilization)
+
+	// 业务下的接口
+	h.Add("ListBizIpamPool", http.MethodPost, "/bizs/{bk_biz_id}/ipam/pools/list", svc.ListBizIpamPool)
+	h.Add("ListBizSubnetUtilization", http.MethodPost, "/bizs/{bk_biz_id}/ipam/subnets/utilization/list",
+		svc.ListBizSubnetUtilization)
+
+	h.Load(c.WebService)
+}
+
+type ipamSvc struct {
+	client     *client.ClientSet
+	authorizer auth.Authorizer
+	ipamLgc    ipamlogics.Interface
+}
@@ -0,0 +1,269 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package ipam
+
+import (
+	"fmt"
+
+	proto "hcm/pkg/api/cloud-server"
+	csipam "hcm/pkg/api/cloud-server/ipam"
+	"hcm/pkg/api/core"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/iam/meta"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/hooks/handler"
+)
+
+// 地址池没有单独的权限模型，跟随VPC鉴权
+
+// CreateIpamPool create ipam pool.
+func (svc *ipamSvc) CreateIpamPool(cts *rest.Contexts) (interface{}, error) {
+	req := new(csipam.CreatePoolReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	authRes := meta.ResourceAttribute{Basic: &meta.Basic{Type: meta.IpamPool, Action: meta.Create}}
+	if err := svc.authorizer.AuthorizeWithPerm(cts.Kit, authRes); err != nil {
+		return nil, err
+	}
+
+	createReq := &protocloud.IpamPoolBatchCreateReq{
+		Pools: []protocloud.IpamPoolCreate{{
+			Name:    req.Name,
+			Vendor:  req.Vendor,
+			Region:  req.Region,
+			BkBizID: req.BkBizID,
+			Cidr:    req.Cidr,
+			Memo:    req.Memo,
+		}},
+	}
+	result, err := svc.client.DataService().Global.IpamPool.BatchCreate(cts.Kit, createReq)
+	if err != nil {
+		logs.Errorf("create ipam pool failed, err: %v, req: %+v, rid: %s", err, req, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if len(result.IDs) != 1 {
+		return nil, fmt.Errorf("create ipam pool return ids count %d is invalid", len(result.IDs))
+	}
+
+	return &core.CreateResult{ID: result.IDs[0]}, nil
+}
+
+// ListIpamPool list resource ipam pool.
+func (svc *ipamSvc) ListIpamPool(cts *rest.Contexts) (interface{}, error) {
+	req := new(proto.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	authRes := meta.ResourceAttribute{Basic: &meta.Basic{Type: meta.IpamPool, Action: meta.Find}}
+	if err := svc.authorizer.AuthorizeWithPerm(cts.Kit, authRes); err != nil {
+		return nil, err
+	}
+
+	listReq := &core.ListReq{
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	return svc.client.DataService().Global.IpamPool.List(cts.Kit, listReq)
+}
+
+// ListBizIpamPool list biz ipam pool.
+func (svc *ipamSvc) ListBizIpamPool(cts *rest.Contexts) (interface{}, error) {
+	req := new(proto.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	expr, noPermFlag, err := handler.ListBizAuthRes(cts, &handler.ListAuthResOption{Authorizer: svc.authorizer,
+		ResType: meta.IpamPool, Action: meta.Find, Filter: req.Filter})
+	if err != nil {
+		logs.Errorf("list biz ipam pool auth failed, noPermFlag: %v, err: %v, rid: %s", noPermFlag, err,
+			cts.Kit.Rid)
+		return nil, err
+	}
+
+	if noPermFlag {
+		return &core.ListResult{Count: 0, Details: make([]interface{}, 0)}, nil
+	}
+
+	listReq := &core.ListReq{
+		Filter: expr,
+		Page:   req.Page,
+	}
+	return svc.client.DataService().Global.IpamPool.List(cts.Kit, listReq)
+}
+
+// UpdateIpamPool update ipam pool.
+func (svc *ipamSvc) UpdateIpamPool(cts *rest.Contexts) (interface{}, error) {
+	id := cts.PathParameter("id").String()
+	if len(id) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "id is required")
+	}
+
+	req := new(csipam.UpdatePoolReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	authRes := meta.ResourceAttribute{Basic: &meta.Basic{Type: meta.IpamPool, Action: meta.Update}}
+	if err := svc.authorizer.AuthorizeWithPerm(cts.Kit, authRes); err != nil {
+		return nil, err
+	}
+
+	updateReq := &protocloud.IpamPoolBatchUpdateReq{
+		Pools: []protocloud.IpamPoolUpdateReq{{ID: id, Name: req.Name, Memo: req.Memo}},
+	}
+	return nil, svc.client.DataService().Global.IpamPool.BatchUpdate(cts.Kit, updateReq)
+}
+
+// BatchDeleteIpamPool batch delete ipam pool, pool with allocations can not be deleted.
+func (svc *ipamSvc) BatchDeleteIpamPool(cts *rest.Contexts) (interface{}, error) {
+	req := new(csipam.BatchDeleteReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	authRes := meta.ResourceAttribute{Basic: &meta.Basic{Type: meta.IpamPool, Action: meta.Delete}}
+	if err := svc.authorizer.AuthorizeWithPerm(cts.Kit, authRes); err != nil {
+		return nil, err
+	}
+
+	listReq := &core.ListReq{
+		Filter: tools.ContainersExpression("pool_id", req.IDs),
+		Page:   core.NewCountPage(),
+	}
+	result, err := svc.client.DataService().Global.IpamAllocation.List(cts.Kit, listReq)
+	if err != nil {
+		logs.Errorf("count ipam allocation failed, err: %v, pool ids: %v, rid: %s", err, req.IDs, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if result.Count != 0 {
+		return nil, errf.Newf(errf.InvalidParameter, "ipam pool has %d allocations, release them first",
+			result.Count)
+	}
+
+	deleteReq := &protocloud.IpamPoolBatchDeleteReq{Filter: tools.ContainersExpression("id", req.IDs)}
+	return nil, svc.client.DataService().Global.IpamPool.BatchDelete(cts.Kit, deleteReq)
+}
+
+// AllocateIpamCidr allocate cidr from ipam pool.
+func (svc *ipamSvc) AllocateIpamCidr(cts *rest.Contexts) (interface{}, error) {
+	id := cts.PathParameter("id").String()
+	if len(id) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "id is required")
+	}
+
+	req := new(csipam.AllocateCidrReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	authRes := meta.ResourceAttribute{Basic: &meta.Basic{Type: meta.IpamPool, Action: meta.Create}}
+	if err := svc.authorizer.AuthorizeWithPerm(cts.Kit, authRes); err != nil {
+		return nil, err
+	}
+
+	return svc.ipamLgc.AllocateCidr(cts.Kit, id, req)
+}
+
+// ListIpamAllocation list allocations of ipam pool.
+func (svc *ipamSvc) ListIpamAllocation(cts *rest.Contexts) (interface{}, error) {
+	id := cts.PathParameter("id").String()
+	if len(id) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "id is required")
+	}
+
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	authRes := meta.ResourceAttribute{Basic: &meta.Basic{Type: meta.IpamPool, Action: meta.Find}}
+	if err := svc.authorizer.AuthorizeWithPerm(cts.Kit, authRes); err != nil {
+		return nil, err
+	}
+
+	filterWithPool, err := tools.And(tools.RuleEqual("pool_id", id), req.Filter)
+	if err != nil {
+		logs.Errorf("fail to merge pool id rule into request filter, err: %v, req.Filter: %+v, rid: %s", err,
+			req.Filter, cts.Kit.Rid)
+		return nil, err
+	}
+
+	listReq := &core.ListReq{
+		Filter: filterWithPool,
+		Page:   req.Page,
+	}
+	return svc.client.DataService().Global.IpamAllocation.List(cts.Kit, listReq)
+}
+
+// ReleaseIpamAllocation release ipam allocation.
+func (svc *ipamSvc) ReleaseIpamAllocation(cts *rest.Contexts) (interface{}, error) {
+	req := new(csipam.BatchDeleteReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	authRes := meta.ResourceAttribute{Basic: &meta.Basic{Type: meta.IpamPool, Action: meta.Delete}}
+	if err := svc.authorizer.AuthorizeWithPerm(cts.Kit, authRes); err != nil {
+		return nil, err
+	}
+
+	return nil, svc.ipamLgc.ReleaseAllocation(cts.Kit, req.IDs)
+}
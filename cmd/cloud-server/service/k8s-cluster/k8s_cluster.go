@@ -0,0 +1,157 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package k8scluster ...
+package k8scluster
+
+import (
+	"net/http"
+
+	"hcm/cmd/cloud-server/logics/audit"
+	k8slogics "hcm/cmd/cloud-server/logics/k8s-cluster"
+	"hcm/cmd/cloud-server/service/capability"
+	proto "hcm/pkg/api/cloud-server"
+	csk8s "hcm/pkg/api/cloud-server/k8s-cluster"
+	"hcm/pkg/api/core"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/iam/auth"
+	"hcm/pkg/iam/meta"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/hooks/handler"
+)
+
+// InitService initialize the k8s cluster service.
+func InitService(c *capability.Capability) {
+	svc := &k8sClusterSvc{
+		client:        c.ApiClient,
+		authorizer:    c.Authorizer,
+		audit:         c.Audit,
+		k8sClusterLgc: c.Logics.K8sCluster,
+	}
+
+	h := rest.NewHandler()
+
+	h.Add("ListK8sCluster", http.MethodPost, "/k8s_clusters/list", svc.ListK8sCluster)
+	h.Add("AssignK8sClusterToBiz", http.MethodPost, "/k8s_clusters/assign/bizs", svc.AssignK8sClusterToBiz)
+	h.Add("ListCvmK8sCluster", http.MethodPost, "/cvms/k8s_clusters/list", svc.ListCvmK8sCluster)
+
+	// 业务下的接口
+	h.Add("ListBizK8sCluster", http.MethodPost, "/bizs/{bk_biz_id}/k8s_clusters/list", svc.ListBizK8sCluster)
+	h.Add("ListBizCvmK8sCluster", http.MethodPost, "/bizs/{bk_biz_id}/cvms/k8s_clusters/list",
+		svc.ListBizCvmK8sCluster)
+
+	h.Load(c.WebService)
+}
+
+type k8sClusterSvc struct {
+	client        *client.ClientSet
+	authorizer    auth.Authorizer
+	audit         audit.Interface
+	k8sClusterLgc k8slogics.Interface
+}
+
+// ListK8sCluster list resource k8s cluster.
+func (svc *k8sClusterSvc) ListK8sCluster(cts *rest.Contexts) (interface{}, error) {
+	return svc.listK8sCluster(cts, handler.ListResourceAuthRes)
+}
+
+// ListBizK8sCluster list biz k8s cluster.
+func (svc *k8sClusterSvc) ListBizK8sCluster(cts *rest.Contexts) (interface{}, error) {
+	return svc.listK8sCluster(cts, handler.ListBizAuthRes)
+}
+
+func (svc *k8sClusterSvc) listK8sCluster(cts *rest.Contexts, authHandler handler.ListAuthResHandler) (
+	interface{}, error) {
+
+	req := new(proto.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	// 容器集群没有单独的权限模型，跟随主机鉴权
+	expr, noPermFlag, err := authHandler(cts, &handler.ListAuthResOption{Authorizer: svc.authorizer,
+		ResType: meta.K8sCluster, Action: meta.Find, Filter: req.Filter})
+	if err != nil {
+		logs.Errorf("list k8s cluster auth failed, noPermFlag: %v, err: %v, rid: %s", noPermFlag, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if noPermFlag {
+		return &core.ListResult{Count: 0, Details: make([]interface{}, 0)}, nil
+	}
+
+	listReq := &core.ListReq{
+		Filter: expr,
+		Page:   req.Page,
+	}
+	return svc.client.DataService().Global.K8sCluster.List(cts.Kit, listReq)
+}
+
+// ListCvmK8sCluster list the k8s cluster which cvm belongs to.
+func (svc *k8sClusterSvc) ListCvmK8sCluster(cts *rest.Contexts) (interface{}, error) {
+	return svc.listCvmK8sCluster(cts, handler.ResOperateAuth)
+}
+
+// ListBizCvmK8sCluster list the k8s cluster which biz cvm belongs to.
+func (svc *k8sClusterSvc) ListBizCvmK8sCluster(cts *rest.Contexts) (interface{}, error) {
+	return svc.listCvmK8sCluster(cts, handler.BizOperateAuth)
+}
+
+func (svc *k8sClusterSvc) listCvmK8sCluster(cts *rest.Contexts, validHandler handler.ValidWithAuthHandler) (
+	interface{}, error) {
+
+	req := new(csk8s.CvmK8sClusterListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	basicInfoReq := dataproto.ListResourceBasicInfoReq{
+		ResourceType: enumor.CvmCloudResType,
+		IDs:          req.CvmIDs,
+	}
+	basicInfoMap, err := svc.client.DataService().Global.Cloud.ListResBasicInfo(cts.Kit, basicInfoReq)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validHandler(cts, &handler.ValidWithAuthOption{Authorizer: svc.authorizer, ResType: meta.K8sCluster,
+		Action: meta.Find, BasicInfos: basicInfoMap})
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := svc.k8sClusterLgc.ListCvmK8sCluster(cts.Kit, req.CvmIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &csk8s.CvmK8sClusterListResult{Details: details}, nil
+}
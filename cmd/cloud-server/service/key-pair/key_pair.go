@@ -0,0 +1,159 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package keypair ...
+package keypair
+
+import (
+	"net/http"
+
+	"hcm/cmd/cloud-server/logics/audit"
+	keypairlogics "hcm/cmd/cloud-server/logics/key-pair"
+	"hcm/cmd/cloud-server/service/capability"
+	proto "hcm/pkg/api/cloud-server"
+	"hcm/pkg/api/core"
+	hckeypair "hcm/pkg/api/hc-service/key-pair"
+	"hcm/pkg/client"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/iam/auth"
+	"hcm/pkg/iam/meta"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/hooks/handler"
+)
+
+// InitService initialize the key pair service.
+func InitService(c *capability.Capability) {
+	svc := &keyPairSvc{
+		client:     c.ApiClient,
+		authorizer: c.Authorizer,
+		audit:      c.Audit,
+		keyPairLgc: c.Logics.KeyPair,
+	}
+
+	h := rest.NewHandler()
+
+	h.Add("ListKeyPair", http.MethodPost, "/key_pairs/list", svc.ListKeyPair)
+	h.Add("ImportKeyPair", http.MethodPost, "/key_pairs/import", svc.ImportKeyPair)
+	h.Add("DeleteKeyPair", http.MethodDelete, "/key_pairs/{id}", svc.DeleteKeyPair)
+	h.Add("AssignKeyPairToBiz", http.MethodPost, "/key_pairs/assign/bizs", svc.AssignKeyPairToBiz)
+	h.Add("BindKeyPair", http.MethodPost, "/key_pairs/{id}/bind", svc.BindKeyPair)
+	h.Add("UnbindKeyPair", http.MethodPost, "/key_pairs/{id}/unbind", svc.UnbindKeyPair)
+
+	// 业务下的接口
+	h.Add("ListBizKeyPair", http.MethodPost, "/bizs/{bk_biz_id}/key_pairs/list", svc.ListBizKeyPair)
+	h.Add("ImportBizKeyPair", http.MethodPost, "/bizs/{bk_biz_id}/key_pairs/import", svc.ImportBizKeyPair)
+	h.Add("DeleteBizKeyPair", http.MethodDelete, "/bizs/{bk_biz_id}/key_pairs/{id}", svc.DeleteBizKeyPair)
+	h.Add("BindBizKeyPair", http.MethodPost, "/bizs/{bk_biz_id}/key_pairs/{id}/bind", svc.BindBizKeyPair)
+	h.Add("UnbindBizKeyPair", http.MethodPost, "/bizs/{bk_biz_id}/key_pairs/{id}/unbind", svc.UnbindBizKeyPair)
+
+	h.Load(c.WebService)
+}
+
+type keyPairSvc struct {
+	client     *client.ClientSet
+	authorizer auth.Authorizer
+	audit      audit.Interface
+	keyPairLgc keypairlogics.Interface
+}
+
+// keyPairCli 各云厂商 hc-service 密钥对客户端的公共方法
+type keyPairCli interface {
+	ImportKeyPair(kt *kit.Kit, req *hckeypair.KeyPairImportReq) (*hckeypair.KeyPairImportResult, error)
+	DeleteKeyPair(kt *kit.Kit, req *hckeypair.KeyPairDeleteReq) error
+}
+
+func (svc *keyPairSvc) getKeyPairCli(vendor enumor.Vendor) (keyPairCli, error) {
+	cli := svc.client.HCService()
+	switch vendor {
+	case enumor.TCloud:
+		return cli.TCloud.KeyPair, nil
+	case enumor.Aws:
+		return cli.Aws.KeyPair, nil
+	case enumor.HuaWei:
+		return cli.HuaWei.KeyPair, nil
+	case enumor.Gcp:
+		return cli.Gcp.KeyPair, nil
+	case enumor.Azure:
+		return cli.Azure.KeyPair, nil
+	default:
+		return nil, errf.Newf(errf.InvalidParameter, "vendor: %s not support key pair", vendor)
+	}
+}
+
+// keyPairBindCli 支持已有主机绑定/解绑密钥对的 hc-service 客户端，aws、azure 只能在创建主机时指定密钥对
+type keyPairBindCli interface {
+	BindKeyPair(kt *kit.Kit, req *hckeypair.KeyPairBindReq) error
+	UnbindKeyPair(kt *kit.Kit, req *hckeypair.KeyPairBindReq) error
+}
+
+func (svc *keyPairSvc) getKeyPairBindCli(vendor enumor.Vendor) (keyPairBindCli, error) {
+	cli := svc.client.HCService()
+	switch vendor {
+	case enumor.TCloud:
+		return cli.TCloud.KeyPair, nil
+	case enumor.HuaWei:
+		return cli.HuaWei.KeyPair, nil
+	case enumor.Gcp:
+		return cli.Gcp.KeyPair, nil
+	default:
+		return nil, errf.Newf(errf.InvalidParameter, "vendor: %s not support bind key pair", vendor)
+	}
+}
+
+// ListKeyPair list resource key pair.
+func (svc *keyPairSvc) ListKeyPair(cts *rest.Contexts) (interface{}, error) {
+	return svc.listKeyPair(cts, handler.ListResourceAuthRes)
+}
+
+// ListBizKeyPair list biz key pair.
+func (svc *keyPairSvc) ListBizKeyPair(cts *rest.Contexts) (interface{}, error) {
+	return svc.listKeyPair(cts, handler.ListBizAuthRes)
+}
+
+func (svc *keyPairSvc) listKeyPair(cts *rest.Contexts, authHandler handler.ListAuthResHandler) (interface{}, error) {
+	req := new(proto.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	// 密钥对没有单独的权限模型，跟随主机鉴权
+	expr, noPermFlag, err := authHandler(cts, &handler.ListAuthResOption{Authorizer: svc.authorizer,
+		ResType: meta.KeyPair, Action: meta.Find, Filter: req.Filter})
+	if err != nil {
+		logs.Errorf("list key pair auth failed, noPermFlag: %v, err: %v, rid: %s", noPermFlag, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if noPermFlag {
+		return &core.ListResult{Count: 0, Details: make([]interface{}, 0)}, nil
+	}
+
+	listReq := &core.ListReq{
+		Filter: expr,
+		Page:   req.Page,
+	}
+	return svc.client.DataService().Global.KeyPair.List(cts.Kit, listReq)
+}
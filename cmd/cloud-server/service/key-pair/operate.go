@@ -0,0 +1,237 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package keypair
+
+import (
+	"hcm/cmd/cloud-server/service/common"
+	cskeypair "hcm/pkg/api/cloud-server/key-pair"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	hckeypair "hcm/pkg/api/hc-service/key-pair"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/types"
+	"hcm/pkg/iam/meta"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/hooks/handler"
+)
+
+// ImportKeyPair import resource key pair.
+func (svc *keyPairSvc) ImportKeyPair(cts *rest.Contexts) (interface{}, error) {
+	return svc.importKeyPair(cts, constant.UnassignedBiz, handler.ResOperateAuth)
+}
+
+// ImportBizKeyPair import biz key pair.
+func (svc *keyPairSvc) ImportBizKeyPair(cts *rest.Contexts) (interface{}, error) {
+	bizID, err := cts.PathParameter("bk_biz_id").Int64()
+	if err != nil {
+		return nil, err
+	}
+	return svc.importKeyPair(cts, bizID, handler.BizOperateAuth)
+}
+
+func (svc *keyPairSvc) importKeyPair(cts *rest.Contexts, bizID int64, validHandler handler.ValidWithAuthHandler) (
+	interface{}, error) {
+
+	req := new(cskeypair.ImportKeyPairReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	err := validHandler(cts, &handler.ValidWithAuthOption{Authorizer: svc.authorizer, ResType: meta.KeyPair,
+		Action: meta.Create, BasicInfo: common.GetCloudResourceBasicInfo(req.AccountID, bizID)})
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := svc.getKeyPairCli(req.Vendor)
+	if err != nil {
+		return nil, err
+	}
+
+	importReq := &hckeypair.KeyPairImportReq{
+		AccountID:         req.AccountID,
+		Region:            req.Region,
+		ResourceGroupName: req.ResourceGroupName,
+		Name:              req.Name,
+		PublicKey:         req.PublicKey,
+	}
+	result, err := cli.ImportKeyPair(cts.Kit, importReq)
+	if err != nil {
+		logs.Errorf("[%s] request hcservice to import key pair failed, err: %v, rid: %s", req.Vendor, err,
+			cts.Kit.Rid)
+		return nil, err
+	}
+
+	// 业务下导入的密钥对直接分配到该业务
+	if bizID != constant.UnassignedBiz && len(result.ID) != 0 {
+		if err = svc.keyPairLgc.Assign(cts.Kit, []string{result.ID}, bizID); err != nil {
+			logs.Errorf("assign key pair to biz failed, id: %s, biz: %d, err: %v, rid: %s", result.ID, bizID, err,
+				cts.Kit.Rid)
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// DeleteKeyPair delete resource key pair.
+func (svc *keyPairSvc) DeleteKeyPair(cts *rest.Contexts) (interface{}, error) {
+	return svc.deleteKeyPair(cts, handler.ResOperateAuth)
+}
+
+// DeleteBizKeyPair delete biz key pair.
+func (svc *keyPairSvc) DeleteBizKeyPair(cts *rest.Contexts) (interface{}, error) {
+	return svc.deleteKeyPair(cts, handler.BizOperateAuth)
+}
+
+func (svc *keyPairSvc) deleteKeyPair(cts *rest.Contexts, validHandler handler.ValidWithAuthHandler) (
+	interface{}, error) {
+
+	id := cts.PathParameter("id").String()
+
+	basicInfoReq := dataproto.ListResourceBasicInfoReq{
+		ResourceType: enumor.KeyPairCloudResType,
+		IDs:          []string{id},
+		Fields:       types.CommonBasicInfoFields,
+	}
+	basicInfoMap, err := svc.client.DataService().Global.Cloud.ListResBasicInfo(cts.Kit, basicInfoReq)
+	if err != nil {
+		logs.Errorf("list key pair basic info failed, req: %+v, err: %v, rid: %s", basicInfoReq, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	err = validHandler(cts, &handler.ValidWithAuthOption{Authorizer: svc.authorizer, ResType: meta.KeyPair,
+		Action: meta.Delete, BasicInfos: basicInfoMap})
+	if err != nil {
+		logs.Errorf("delete key pair auth failed, id: %s, err: %v, rid: %s", id, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	info, exist := basicInfoMap[id]
+	if !exist {
+		return nil, errf.Newf(errf.RecordNotFound, "key pair: %s not found", id)
+	}
+
+	if err = svc.audit.ResDeleteAudit(cts.Kit, enumor.KeyPairAuditResType, basicInfoReq.IDs); err != nil {
+		logs.Errorf("create delete audit failed, ids: %v, err: %v, rid: %s", basicInfoReq.IDs, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	cli, err := svc.getKeyPairCli(info.Vendor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = cli.DeleteKeyPair(cts.Kit, &hckeypair.KeyPairDeleteReq{ID: id}); err != nil {
+		logs.Errorf("[%s] request hcservice to delete key pair failed, id: %s, err: %v, rid: %s", info.Vendor, id,
+			err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// BindKeyPair bind resource key pair with cvm.
+func (svc *keyPairSvc) BindKeyPair(cts *rest.Contexts) (interface{}, error) {
+	return nil, svc.bindKeyPair(cts, handler.ResOperateAuth, true)
+}
+
+// BindBizKeyPair bind biz key pair with cvm.
+func (svc *keyPairSvc) BindBizKeyPair(cts *rest.Contexts) (interface{}, error) {
+	return nil, svc.bindKeyPair(cts, handler.BizOperateAuth, true)
+}
+
+// UnbindKeyPair unbind resource key pair with cvm.
+func (svc *keyPairSvc) UnbindKeyPair(cts *rest.Contexts) (interface{}, error) {
+	return nil, svc.bindKeyPair(cts, handler.ResOperateAuth, false)
+}
+
+// UnbindBizKeyPair unbind biz key pair with cvm.
+func (svc *keyPairSvc) UnbindBizKeyPair(cts *rest.Contexts) (interface{}, error) {
+	return nil, svc.bindKeyPair(cts, handler.BizOperateAuth, false)
+}
+
+func (svc *keyPairSvc) bindKeyPair(cts *rest.Contexts, validHandler handler.ValidWithAuthHandler, bind bool) error {
+	id := cts.PathParameter("id").String()
+
+	req := new(cskeypair.BindKeyPairReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	info, err := svc.client.DataService().Global.Cloud.GetResBasicInfo(cts.Kit, enumor.KeyPairCloudResType, id,
+		types.CommonBasicInfoFields...)
+	if err != nil {
+		logs.Errorf("get key pair basic info failed, id: %s, err: %v, rid: %s", id, err, cts.Kit.Rid)
+		return err
+	}
+
+	cvmInfoReq := dataproto.ListResourceBasicInfoReq{
+		ResourceType: enumor.CvmCloudResType,
+		IDs:          req.CvmIDs,
+		Fields:       types.CommonBasicInfoFields,
+	}
+	basicInfoMap, err := svc.client.DataService().Global.Cloud.ListResBasicInfo(cts.Kit, cvmInfoReq)
+	if err != nil {
+		logs.Errorf("list cvm basic info failed, req: %+v, err: %v, rid: %s", cvmInfoReq, err, cts.Kit.Rid)
+		return err
+	}
+
+	// 绑定/解绑会修改主机的登录方式，需要同时具备密钥对和主机的编辑权限
+	basicInfoMap[id] = *info
+	err = validHandler(cts, &handler.ValidWithAuthOption{Authorizer: svc.authorizer, ResType: meta.KeyPair,
+		Action: meta.Update, BasicInfos: basicInfoMap})
+	if err != nil {
+		return err
+	}
+
+	cli, err := svc.getKeyPairBindCli(info.Vendor)
+	if err != nil {
+		return err
+	}
+
+	bindReq := &hckeypair.KeyPairBindReq{
+		ID:        id,
+		CvmIDs:    req.CvmIDs,
+		ForceStop: req.ForceStop,
+	}
+	if bind {
+		err = cli.BindKeyPair(cts.Kit, bindReq)
+	} else {
+		err = cli.UnbindKeyPair(cts.Kit, bindReq)
+	}
+	if err != nil {
+		logs.Errorf("[%s] request hcservice to bind or unbind key pair failed, bind: %v, id: %s, err: %v, rid: %s",
+			info.Vendor, bind, id, err, cts.Kit.Rid)
+		return err
+	}
+
+	return nil
+}
@@ -86,6 +86,8 @@ func (svc *lbSvc) batchAddBizTarget(cts *rest.Contexts, authHandler handler.Vali
 	switch accountInfo.Vendor {
 	case enumor.TCloud:
 		return svc.buildAddTCloudTarget(cts.Kit, req.Data, accountInfo.AccountID)
+	case enumor.Aws:
+		return svc.buildAddAwsTarget(cts.Kit, req.Data, accountInfo.AccountID)
 	default:
 		return nil, fmt.Errorf("vendor: %s not support", accountInfo.Vendor)
 	}
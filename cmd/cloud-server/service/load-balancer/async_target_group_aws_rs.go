@@ -0,0 +1,204 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package loadbalancer
+
+import (
+	"encoding/json"
+
+	actionlb "hcm/cmd/task-server/logics/action/load-balancer"
+	cslb "hcm/pkg/api/cloud-server/load-balancer"
+	"hcm/pkg/api/core"
+	corelb "hcm/pkg/api/core/cloud/load-balancer"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	hcproto "hcm/pkg/api/hc-service/load-balancer"
+	ts "hcm/pkg/api/task-server"
+	"hcm/pkg/async/action"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	tableasync "hcm/pkg/dal/table/async"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/counter"
+	"hcm/pkg/tools/slice"
+)
+
+// buildAddAwsTarget aws 目标组独立于负载均衡存在，且可以被多个负载均衡使用，
+// 因此RS的注册、注销直接按目标组下发任务，不锁定负载均衡
+func (svc *lbSvc) buildAddAwsTarget(kt *kit.Kit, body json.RawMessage, accountID string) (any, error) {
+	req := new(cslb.AwsTargetBatchCreateReq)
+	if err := json.Unmarshal(body, req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	tgMap := make(map[string][]*dataproto.TargetBaseReq, len(req.TargetGroups))
+	for _, group := range req.TargetGroups {
+		if err := svc.checkAwsTargetGroup(kt, group.TargetGroupID, accountID); err != nil {
+			return nil, err
+		}
+		tgMap[group.TargetGroupID] = append(tgMap[group.TargetGroupID], group.Targets...)
+	}
+
+	tasks := make([]ts.CustomFlowTask, 0)
+	getActionID := counter.NewNumStringCounter(1, 10)
+	var lastActionID action.ActIDType
+	for tgID, rsList := range tgMap {
+		for _, parts := range slice.Split(rsList, constant.BatchAddRSCloudMaxLimit) {
+			for _, rs := range parts {
+				rs.AccountID = accountID
+				rs.TargetGroupID = tgID
+			}
+			actionID := action.ActIDType(getActionID())
+			task := ts.CustomFlowTask{
+				ActionID:   actionID,
+				ActionName: enumor.ActionTargetGroupAddRS,
+				Params: &actionlb.OperateRsOption{
+					Vendor: enumor.Aws,
+					AwsBatchOperateTargetReq: hcproto.AwsBatchOperateTargetReq{
+						TargetGroupID: tgID,
+						RsList:        parts,
+					},
+				},
+				Retry: tableasync.NewRetryWithPolicy(3, 100, 200),
+			}
+			if len(lastActionID) > 0 {
+				task.DependOn = []action.ActIDType{lastActionID}
+			}
+			tasks = append(tasks, task)
+			lastActionID = actionID
+		}
+	}
+
+	return svc.createAwsTargetFlow(kt, enumor.FlowTargetGroupAddRS, tasks)
+}
+
+func (svc *lbSvc) buildRemoveAwsTarget(kt *kit.Kit, body json.RawMessage, accountID string) (any, error) {
+	req := new(cslb.AwsTargetBatchRemoveReq)
+	if err := json.Unmarshal(body, req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	tasks := make([]ts.CustomFlowTask, 0)
+	getActionID := counter.NewNumStringCounter(1, 10)
+	var lastActionID action.ActIDType
+	for _, group := range req.TargetGroups {
+		if err := svc.checkAwsTargetGroup(kt, group.TargetGroupID, accountID); err != nil {
+			return nil, err
+		}
+
+		listReq := &core.ListReq{
+			Filter: tools.ExpressionAnd(
+				tools.RuleIn("id", group.TargetIDs),
+				tools.RuleEqual("account_id", accountID),
+				tools.RuleEqual("target_group_id", group.TargetGroupID),
+			),
+			Page: core.NewDefaultBasePage(),
+		}
+		rsResp, err := svc.client.DataService().Global.LoadBalancer.ListTarget(kt, listReq)
+		if err != nil {
+			logs.Errorf("list aws target failed, tgID: %s, err: %v, rid: %s", group.TargetGroupID, err, kt.Rid)
+			return nil, err
+		}
+		if len(rsResp.Details) == 0 {
+			continue
+		}
+
+		rsList := slice.Map(rsResp.Details, func(rs corelb.BaseTarget) *dataproto.TargetBaseReq {
+			return &dataproto.TargetBaseReq{
+				IP:            rs.IP,
+				InstType:      rs.InstType,
+				Port:          rs.Port,
+				Weight:        rs.Weight,
+				AccountID:     accountID,
+				TargetGroupID: group.TargetGroupID,
+				CloudInstID:   rs.CloudInstID,
+			}
+		})
+		for _, parts := range slice.Split(rsList, constant.BatchRemoveRSCloudMaxLimit) {
+			actionID := action.ActIDType(getActionID())
+			task := ts.CustomFlowTask{
+				ActionID:   actionID,
+				ActionName: enumor.ActionTargetGroupRemoveRS,
+				Params: &actionlb.OperateRsOption{
+					Vendor: enumor.Aws,
+					AwsBatchOperateTargetReq: hcproto.AwsBatchOperateTargetReq{
+						TargetGroupID: group.TargetGroupID,
+						RsList:        parts,
+					},
+				},
+				Retry: tableasync.NewRetryWithPolicy(3, 100, 200),
+			}
+			if len(lastActionID) > 0 {
+				task.DependOn = []action.ActIDType{lastActionID}
+			}
+			tasks = append(tasks, task)
+			lastActionID = actionID
+		}
+	}
+
+	if len(tasks) == 0 {
+		return &corelb.TargetOperateResult{TargetIDs: []string{}}, nil
+	}
+
+	return svc.createAwsTargetFlow(kt, enumor.FlowTargetGroupRemoveRS, tasks)
+}
+
+// checkAwsTargetGroup 校验目标组存在、属于该账号且已在云上创建
+func (svc *lbSvc) checkAwsTargetGroup(kt *kit.Kit, tgID, accountID string) error {
+	tg, err := svc.getTargetGroupByID(kt, tgID)
+	if err != nil {
+		return err
+	}
+	if tg == nil || tg.Vendor != enumor.Aws || tg.AccountID != accountID {
+		return errf.Newf(errf.RecordNotFound, "aws target group: %s not found", tgID)
+	}
+	if len(tg.CloudID) == 0 {
+		return errf.Newf(errf.InvalidParameter, "target group: %s has not been created in cloud", tgID)
+	}
+	return nil
+}
+
+func (svc *lbSvc) createAwsTargetFlow(kt *kit.Kit, name enumor.FlowName, tasks []ts.CustomFlowTask) (
+	*core.FlowStateResult, error) {
+
+	addReq := &ts.AddCustomFlowReq{
+		Name:        name,
+		ShareData:   tableasync.NewShareData(nil),
+		Tasks:       tasks,
+		IsInitState: false,
+	}
+	result, err := svc.client.TaskServer().CreateCustomFlow(kt, addReq)
+	if err != nil {
+		logs.Errorf("call taskserver to create aws target custom flow failed, err: %v, name: %s, rid: %s",
+			err, name, kt.Rid)
+		return nil, err
+	}
+
+	return &core.FlowStateResult{FlowID: result.ID}, nil
+}
@@ -85,6 +85,8 @@ func (svc *lbSvc) batchRemoveBizTarget(cts *rest.Contexts, authHandler handler.V
 	switch accountInfo.Vendor {
 	case enumor.TCloud:
 		return svc.buildRemoveTCloudTarget(cts.Kit, req.Data, accountInfo.AccountID)
+	case enumor.Aws:
+		return svc.buildRemoveAwsTarget(cts.Kit, req.Data, accountInfo.AccountID)
 	default:
 		return nil, fmt.Errorf("vendor: %s not support", accountInfo.Vendor)
 	}
@@ -71,6 +71,8 @@ func (svc *lbSvc) BatchCreateLB(cts *rest.Contexts) (any, error) {
 	switch accountInfo.Vendor {
 	case enumor.TCloud:
 		return svc.batchCreateTCloudLB(cts.Kit, req.Data)
+	case enumor.Aws:
+		return svc.createAwsLB(cts.Kit, req.Data)
 	default:
 		return nil, fmt.Errorf("vendor: %s not support", accountInfo.Vendor)
 	}
@@ -89,6 +91,19 @@ func (svc *lbSvc) batchCreateTCloudLB(kt *kit.Kit, rawReq json.RawMessage) (any,
 	return svc.client.HCService().TCloud.Clb.BatchCreate(kt, req)
 }
 
+func (svc *lbSvc) createAwsLB(kt *kit.Kit, rawReq json.RawMessage) (any, error) {
+	req := new(hcproto.AwsLoadBalancerCreateReq)
+	if err := json.Unmarshal(rawReq, req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	// 参数校验
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+	req.BkBizID = constant.UnassignedBiz
+	return svc.client.HCService().Aws.LoadBalancer.Create(kt, req)
+}
+
 // CreateBizTargetGroup create biz target group.
 func (svc *lbSvc) CreateBizTargetGroup(cts *rest.Contexts) (any, error) {
 	bkBizID, err := cts.PathParameter("bk_biz_id").Int64()
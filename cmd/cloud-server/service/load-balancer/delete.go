@@ -21,14 +21,12 @@
 package loadbalancer
 
 import (
-	"errors"
 	"fmt"
 
 	"hcm/cmd/cloud-server/logics/async"
 	actionlb "hcm/cmd/task-server/logics/action/load-balancer"
 	"hcm/pkg/api/core"
 	dataproto "hcm/pkg/api/data-service/cloud"
-	hcproto "hcm/pkg/api/hc-service/load-balancer"
 	ts "hcm/pkg/api/task-server"
 	"hcm/pkg/async/action"
 	"hcm/pkg/criteria/enumor"
@@ -187,8 +185,10 @@ func (svc *lbSvc) batchDeleteLoadBalancer(cts *rest.Contexts, validHandler handl
 		if !exist {
 			return nil, fmt.Errorf("load balancer(%s) not found", lbID)
 		}
-		if info.Vendor != enumor.TCloud {
-			return nil, errors.New("only supports tcloud")
+		switch info.Vendor {
+		case enumor.TCloud, enumor.Aws, enumor.Azure, enumor.HuaWei, enumor.Gcp:
+		default:
+			return nil, fmt.Errorf("load balancer(%s) vendor: %s not support", lbID, info.Vendor)
 		}
 
 	}
@@ -215,7 +215,7 @@ func (svc *lbSvc) batchDeleteLoadBalancer(cts *rest.Contexts, validHandler handl
 	}
 
 	// 按账号+地域分列表
-	tasks := buildTCloudLBDeletionTasks(lbInfoMap)
+	tasks := buildLBDeletionTasks(lbInfoMap)
 	flowReq := &ts.AddCustomFlowReq{
 		Name:        enumor.FlowDeleteLoadBalancer,
 		ShareData:   nil,
@@ -267,39 +267,53 @@ func (svc *lbSvc) loadBalancerDeleteCheck(kt *kit.Kit, lbIDs []string) error {
 	return nil
 }
 
-func buildTCloudLBDeletionTasks(infoMap map[string]types.CloudResourceBasicInfo) (tasks []ts.CustomFlowTask) {
+func buildLBDeletionTasks(infoMap map[string]types.CloudResourceBasicInfo) (tasks []ts.CustomFlowTask) {
 
-	tcloudReqMap := make(map[string]*hcproto.TCloudBatchDeleteLoadbalancerReq, len(infoMap))
-	// TODO: 后期支持多vendor
+	reqMap := make(map[string]*actionlb.DeleteLoadBalancerOption, len(infoMap))
 	for id, info := range infoMap {
 		key := genAccountRegionKey(info)
-		if tcloudReqMap[key] == nil {
-			tcloudReqMap[key] = &hcproto.TCloudBatchDeleteLoadbalancerReq{
-				AccountID: info.AccountID,
-				Region:    info.Region,
-				IDs:       []string{},
-			}
-
+		if reqMap[key] == nil {
+			reqMap[key] = &actionlb.DeleteLoadBalancerOption{Vendor: info.Vendor}
 		}
-		req := tcloudReqMap[key]
-		req.IDs = append(req.IDs, id)
+		appendLBDeletionID(reqMap[key], info, id)
 	}
 	getNextID := counter.NewNumStringCounter(1, 10)
-	for _, req := range tcloudReqMap {
+	for _, req := range reqMap {
 		tasks = append(tasks, ts.CustomFlowTask{
 			ActionID:   action.ActIDType(getNextID()),
 			ActionName: enumor.ActionDeleteLoadBalancer,
-			Params: actionlb.DeleteLoadBalancerOption{
-				Vendor:                           enumor.TCloud,
-				TCloudBatchDeleteLoadbalancerReq: cvt.PtrToVal(req),
-			},
-			Retry: tableasync.NewRetryWithPolicy(3, 1000, 5000),
+			Params:     cvt.PtrToVal(req),
+			Retry:      tableasync.NewRetryWithPolicy(3, 1000, 5000),
 		})
 
 	}
 	return tasks
 }
 
+// appendLBDeletionID 按云厂商将待删除的负载均衡ID加入对应的删除请求中
+func appendLBDeletionID(opt *actionlb.DeleteLoadBalancerOption, info types.CloudResourceBasicInfo, id string) {
+	switch info.Vendor {
+	case enumor.TCloud:
+		opt.TCloudBatchDeleteLoadbalancerReq.AccountID = info.AccountID
+		opt.TCloudBatchDeleteLoadbalancerReq.Region = info.Region
+		opt.TCloudBatchDeleteLoadbalancerReq.IDs = append(opt.TCloudBatchDeleteLoadbalancerReq.IDs, id)
+	case enumor.Aws:
+		opt.AwsBatchDeleteLoadBalancerReq.AccountID = info.AccountID
+		opt.AwsBatchDeleteLoadBalancerReq.Region = info.Region
+		opt.AwsBatchDeleteLoadBalancerReq.IDs = append(opt.AwsBatchDeleteLoadBalancerReq.IDs, id)
+	case enumor.Azure:
+		opt.AzureBatchDeleteLoadBalancerReq.AccountID = info.AccountID
+		opt.AzureBatchDeleteLoadBalancerReq.IDs = append(opt.AzureBatchDeleteLoadBalancerReq.IDs, id)
+	case enumor.Gcp:
+		opt.GcpBatchDeleteLoadBalancerReq.AccountID = info.AccountID
+		opt.GcpBatchDeleteLoadBalancerReq.IDs = append(opt.GcpBatchDeleteLoadBalancerReq.IDs, id)
+	case enumor.HuaWei:
+		opt.HuaWeiBatchDeleteLoadBalancerReq.AccountID = info.AccountID
+		opt.HuaWeiBatchDeleteLoadBalancerReq.Region = info.Region
+		opt.HuaWeiBatchDeleteLoadBalancerReq.IDs = append(opt.HuaWeiBatchDeleteLoadBalancerReq.IDs, id)
+	}
+}
+
 func genAccountRegionKey(info types.CloudResourceBasicInfo) string {
 	return info.AccountID + "_" + info.Region
 }
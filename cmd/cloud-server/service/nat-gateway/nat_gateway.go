@@ -0,0 +1,97 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package natgateway ...
+package natgateway
+
+import (
+	"net/http"
+
+	"hcm/cmd/cloud-server/service/capability"
+	proto "hcm/pkg/api/cloud-server"
+	"hcm/pkg/api/core"
+	"hcm/pkg/client"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/iam/auth"
+	"hcm/pkg/iam/meta"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/hooks/handler"
+)
+
+// InitService initialize the nat gateway service.
+func InitService(c *capability.Capability) {
+	svc := &natGatewaySvc{
+		client:     c.ApiClient,
+		authorizer: c.Authorizer,
+	}
+
+	h := rest.NewHandler()
+
+	h.Add("ListNatGateway", http.MethodPost, "/nat_gateways/list", svc.ListNatGateway)
+	h.Add("ListBizNatGateway", http.MethodPost, "/bizs/{bk_biz_id}/nat_gateways/list", svc.ListBizNatGateway)
+
+	h.Load(c.WebService)
+}
+
+type natGatewaySvc struct {
+	client     *client.ClientSet
+	authorizer auth.Authorizer
+}
+
+// ListNatGateway list resource nat gateway.
+func (svc *natGatewaySvc) ListNatGateway(cts *rest.Contexts) (interface{}, error) {
+	return svc.listNatGateway(cts, handler.ListResourceAuthRes)
+}
+
+// ListBizNatGateway list biz nat gateway.
+func (svc *natGatewaySvc) ListBizNatGateway(cts *rest.Contexts) (interface{}, error) {
+	return svc.listNatGateway(cts, handler.ListBizAuthRes)
+}
+
+func (svc *natGatewaySvc) listNatGateway(cts *rest.Contexts, authHandler handler.ListAuthResHandler) (interface{},
+	error) {
+
+	req := new(proto.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	// NAT网关跟随VPC鉴权
+	expr, noPermFlag, err := authHandler(cts, &handler.ListAuthResOption{Authorizer: svc.authorizer,
+		ResType: meta.Vpc, Action: meta.Find, Filter: req.Filter})
+	if err != nil {
+		logs.Errorf("list nat gateway auth failed, noPermFlag: %v, err: %v, rid: %s", noPermFlag, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if noPermFlag {
+		return &core.ListResult{Count: 0, Details: make([]interface{}, 0)}, nil
+	}
+
+	listReq := &core.ListReq{
+		Filter: expr,
+		Page:   req.Page,
+	}
+	return svc.client.DataService().Global.NatGateway.List(cts.Kit, listReq)
+}
@@ -0,0 +1,242 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package privatedns
+
+import (
+	"hcm/cmd/cloud-server/logics/async"
+	actionprivatedns "hcm/cmd/task-server/logics/action/private-dns"
+	csprivatedns "hcm/pkg/api/cloud-server/private-dns"
+	"hcm/pkg/api/core"
+	coreprivatedns "hcm/pkg/api/core/cloud/private-dns"
+	hcprivatedns "hcm/pkg/api/hc-service/private-dns"
+	ts "hcm/pkg/api/task-server"
+	"hcm/pkg/async/action"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/iam/meta"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/counter"
+	"hcm/pkg/tools/hooks/handler"
+)
+
+// CreatePrivateDnsRecord create resource private dns record.
+func (svc *privateDnsSvc) CreatePrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	return svc.createPrivateDnsRecord(cts, handler.ResOperateAuth)
+}
+
+// CreateBizPrivateDnsRecord create biz private dns record.
+func (svc *privateDnsSvc) CreateBizPrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	return svc.createPrivateDnsRecord(cts, handler.BizOperateAuth)
+}
+
+func (svc *privateDnsSvc) createPrivateDnsRecord(cts *rest.Contexts, validHandler handler.ValidWithAuthHandler) (
+	interface{}, error) {
+
+	zoneID := cts.PathParameter("id").String()
+	if len(zoneID) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "id is required")
+	}
+
+	req := new(csprivatedns.CreatePrivateDnsRecordReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	info, err := svc.validZoneAuth(cts, zoneID, meta.Update, validHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := svc.getRecordCli(info.Vendor)
+	if err != nil {
+		return nil, err
+	}
+
+	createReq := &hcprivatedns.RecordCreateReq{
+		ZoneID:     zoneID,
+		RecordSpec: req.RecordSpec,
+	}
+	result, err := cli.CreateRecord(cts.Kit, createReq)
+	if err != nil {
+		logs.Errorf("[%s] request hcservice to create private dns record failed, zone: %s, err: %v, rid: %s",
+			info.Vendor, zoneID, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UpdatePrivateDnsRecord update resource private dns record.
+func (svc *privateDnsSvc) UpdatePrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	return nil, svc.updatePrivateDnsRecord(cts, handler.ResOperateAuth)
+}
+
+// UpdateBizPrivateDnsRecord update biz private dns record.
+func (svc *privateDnsSvc) UpdateBizPrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	return nil, svc.updatePrivateDnsRecord(cts, handler.BizOperateAuth)
+}
+
+func (svc *privateDnsSvc) updatePrivateDnsRecord(cts *rest.Contexts, validHandler handler.ValidWithAuthHandler) error {
+	zoneID := cts.PathParameter("id").String()
+	if len(zoneID) == 0 {
+		return errf.New(errf.InvalidParameter, "id is required")
+	}
+
+	recordID := cts.PathParameter("record_id").String()
+	if len(recordID) == 0 {
+		return errf.New(errf.InvalidParameter, "record_id is required")
+	}
+
+	req := new(csprivatedns.UpdatePrivateDnsRecordReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	info, err := svc.validZoneAuth(cts, zoneID, meta.Update, validHandler)
+	if err != nil {
+		return err
+	}
+
+	if _, err = svc.listZoneRecords(cts, zoneID, []string{recordID}); err != nil {
+		return err
+	}
+
+	cli, err := svc.getRecordCli(info.Vendor)
+	if err != nil {
+		return err
+	}
+
+	updateReq := &hcprivatedns.RecordUpdateReq{
+		ID:    recordID,
+		Value: req.Value,
+		TTL:   req.TTL,
+	}
+	if err = cli.UpdateRecord(cts.Kit, updateReq); err != nil {
+		logs.Errorf("[%s] request hcservice to update private dns record failed, id: %s, err: %v, rid: %s",
+			info.Vendor, recordID, err, cts.Kit.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// BatchDeletePrivateDnsRecord batch delete resource private dns record.
+func (svc *privateDnsSvc) BatchDeletePrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	return svc.batchDeletePrivateDnsRecord(cts, handler.ResOperateAuth)
+}
+
+// BatchDeleteBizPrivateDnsRecord batch delete biz private dns record.
+func (svc *privateDnsSvc) BatchDeleteBizPrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	return svc.batchDeletePrivateDnsRecord(cts, handler.BizOperateAuth)
+}
+
+// batchDeletePrivateDnsRecord 通过异步任务逐条删除解析记录
+func (svc *privateDnsSvc) batchDeletePrivateDnsRecord(cts *rest.Contexts, validHandler handler.ValidWithAuthHandler) (
+	interface{}, error) {
+
+	zoneID := cts.PathParameter("id").String()
+	if len(zoneID) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "id is required")
+	}
+
+	req := new(csprivatedns.BatchDeletePrivateDnsRecordReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	info, err := svc.validZoneAuth(cts, zoneID, meta.Update, validHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := svc.listZoneRecords(cts, zoneID, req.IDs)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]ts.CustomFlowTask, 0, len(records))
+	nextID := counter.NewNumStringCounter(1, 10)
+	for _, one := range records {
+		tasks = append(tasks, ts.CustomFlowTask{
+			ActionID:   action.ActIDType(nextID()),
+			ActionName: enumor.ActionDeletePrivateDnsRecord,
+			Params: actionprivatedns.DeleteRecordOption{
+				Vendor: info.Vendor,
+				ID:     one.ID,
+			},
+			DependOn: nil,
+		})
+	}
+	flowReq := &ts.AddCustomFlowReq{
+		Name:  enumor.FlowDeletePrivateDnsRecord,
+		Tasks: tasks,
+	}
+
+	result, err := svc.client.TaskServer().CreateCustomFlow(cts.Kit, flowReq)
+	if err != nil {
+		logs.Errorf("call taskserver to create custom flow failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if err = async.WaitTaskToEnd(cts.Kit, svc.client.TaskServer(), result.ID); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// listZoneRecords 查询私有域下的解析记录，记录不存在或不属于该私有域时返回错误
+func (svc *privateDnsSvc) listZoneRecords(cts *rest.Contexts, zoneID string, ids []string) (
+	[]coreprivatedns.Record, error) {
+
+	listReq := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("zone_id", zoneID),
+			tools.RuleIn("id", ids),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := svc.client.DataService().Global.PrivateDnsRecord.List(cts.Kit, listReq)
+	if err != nil {
+		logs.Errorf("list private dns record failed, zone: %s, ids: %v, err: %v, rid: %s", zoneID, ids, err,
+			cts.Kit.Rid)
+		return nil, err
+	}
+
+	if len(result.Details) != len(ids) {
+		return nil, errf.Newf(errf.RecordNotFound, "some records not found in private dns zone: %s", zoneID)
+	}
+
+	return result.Details, nil
+}
@@ -0,0 +1,218 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package privatedns ...
+package privatedns
+
+import (
+	"net/http"
+
+	"hcm/cmd/cloud-server/logics/audit"
+	privatednslogics "hcm/cmd/cloud-server/logics/private-dns"
+	"hcm/cmd/cloud-server/service/capability"
+	proto "hcm/pkg/api/cloud-server"
+	"hcm/pkg/api/core"
+	hcprivatedns "hcm/pkg/api/hc-service/private-dns"
+	"hcm/pkg/client"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/dal/dao/types"
+	"hcm/pkg/iam/auth"
+	"hcm/pkg/iam/meta"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/hooks/handler"
+)
+
+// InitService initialize the private dns service.
+func InitService(c *capability.Capability) {
+	svc := &privateDnsSvc{
+		client:        c.ApiClient,
+		authorizer:    c.Authorizer,
+		audit:         c.Audit,
+		privateDnsLgc: c.Logics.PrivateDns,
+	}
+
+	h := rest.NewHandler()
+
+	h.Add("ListPrivateDnsZone", http.MethodPost, "/private_dns_zones/list", svc.ListPrivateDnsZone)
+	h.Add("AssignPrivateDnsZoneToBiz", http.MethodPost, "/private_dns_zones/assign/bizs",
+		svc.AssignPrivateDnsZoneToBiz)
+	h.Add("ListPrivateDnsRecord", http.MethodPost, "/private_dns_zones/{id}/records/list",
+		svc.ListPrivateDnsRecord)
+	h.Add("CreatePrivateDnsRecord", http.MethodPost, "/private_dns_zones/{id}/records/create",
+		svc.CreatePrivateDnsRecord)
+	h.Add("UpdatePrivateDnsRecord", http.MethodPatch, "/private_dns_zones/{id}/records/{record_id}",
+		svc.UpdatePrivateDnsRecord)
+	h.Add("BatchDeletePrivateDnsRecord", http.MethodDelete, "/private_dns_zones/{id}/records/batch",
+		svc.BatchDeletePrivateDnsRecord)
+
+	// 业务下的接口
+	h.Add("ListBizPrivateDnsZone", http.MethodPost, "/bizs/{bk_biz_id}/private_dns_zones/list",
+		svc.ListBizPrivateDnsZone)
+	h.Add("ListBizPrivateDnsRecord", http.MethodPost, "/bizs/{bk_biz_id}/private_dns_zones/{id}/records/list",
+		svc.ListBizPrivateDnsRecord)
+	h.Add("CreateBizPrivateDnsRecord", http.MethodPost, "/bizs/{bk_biz_id}/private_dns_zones/{id}/records/create",
+		svc.CreateBizPrivateDnsRecord)
+	h.Add("UpdateBizPrivateDnsRecord", http.MethodPatch,
+		"/bizs/{bk_biz_id}/private_dns_zones/{id}/records/{record_id}", svc.UpdateBizPrivateDnsRecord)
+	h.Add("BatchDeleteBizPrivateDnsRecord", http.MethodDelete,
+		"/bizs/{bk_biz_id}/private_dns_zones/{id}/records/batch", svc.BatchDeleteBizPrivateDnsRecord)
+
+	h.Load(c.WebService)
+}
+
+type privateDnsSvc struct {
+	client        *client.ClientSet
+	authorizer    auth.Authorizer
+	audit         audit.Interface
+	privateDnsLgc privatednslogics.Interface
+}
+
+// recordCli 各云厂商 hc-service 解析记录客户端的公共方法
+type recordCli interface {
+	CreateRecord(kt *kit.Kit, req *hcprivatedns.RecordCreateReq) (*hcprivatedns.RecordCreateResult, error)
+	UpdateRecord(kt *kit.Kit, req *hcprivatedns.RecordUpdateReq) error
+}
+
+func (svc *privateDnsSvc) getRecordCli(vendor enumor.Vendor) (recordCli, error) {
+	cli := svc.client.HCService()
+	switch vendor {
+	case enumor.TCloud:
+		return cli.TCloud.PrivateDns, nil
+	case enumor.Aws:
+		return cli.Aws.PrivateDns, nil
+	case enumor.HuaWei:
+		return cli.HuaWei.PrivateDns, nil
+	case enumor.Gcp:
+		return cli.Gcp.PrivateDns, nil
+	case enumor.Azure:
+		return cli.Azure.PrivateDns, nil
+	default:
+		return nil, errf.Newf(errf.InvalidParameter, "vendor: %s not support private dns", vendor)
+	}
+}
+
+// validZoneAuth 解析记录没有单独的权限模型，按所属私有域校验权限
+func (svc *privateDnsSvc) validZoneAuth(cts *rest.Contexts, zoneID string, action meta.Action,
+	validHandler handler.ValidWithAuthHandler) (*types.CloudResourceBasicInfo, error) {
+
+	info, err := svc.client.DataService().Global.Cloud.GetResBasicInfo(cts.Kit, enumor.PrivateDnsZoneCloudResType,
+		zoneID, types.CommonBasicInfoFields...)
+	if err != nil {
+		logs.Errorf("get private dns zone basic info failed, id: %s, err: %v, rid: %s", zoneID, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	err = validHandler(cts, &handler.ValidWithAuthOption{Authorizer: svc.authorizer, ResType: meta.PrivateDnsZone,
+		Action: action, BasicInfo: info})
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// ListPrivateDnsZone list resource private dns zone.
+func (svc *privateDnsSvc) ListPrivateDnsZone(cts *rest.Contexts) (interface{}, error) {
+	return svc.listPrivateDnsZone(cts, handler.ListResourceAuthRes)
+}
+
+// ListBizPrivateDnsZone list biz private dns zone.
+func (svc *privateDnsSvc) ListBizPrivateDnsZone(cts *rest.Contexts) (interface{}, error) {
+	return svc.listPrivateDnsZone(cts, handler.ListBizAuthRes)
+}
+
+func (svc *privateDnsSvc) listPrivateDnsZone(cts *rest.Contexts, authHandler handler.ListAuthResHandler) (
+	interface{}, error) {
+
+	req := new(proto.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	expr, noPermFlag, err := authHandler(cts, &handler.ListAuthResOption{Authorizer: svc.authorizer,
+		ResType: meta.PrivateDnsZone, Action: meta.Find, Filter: req.Filter})
+	if err != nil {
+		logs.Errorf("list private dns zone auth failed, noPermFlag: %v, err: %v, rid: %s", noPermFlag, err,
+			cts.Kit.Rid)
+		return nil, err
+	}
+
+	if noPermFlag {
+		return &core.ListResult{Count: 0, Details: make([]interface{}, 0)}, nil
+	}
+
+	listReq := &core.ListReq{
+		Filter: expr,
+		Page:   req.Page,
+	}
+	return svc.client.DataService().Global.PrivateDnsZone.List(cts.Kit, listReq)
+}
+
+// ListPrivateDnsRecord list resource private dns record.
+func (svc *privateDnsSvc) ListPrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	return svc.listPrivateDnsRecord(cts, handler.ResOperateAuth)
+}
+
+// ListBizPrivateDnsRecord list biz private dns record.
+func (svc *privateDnsSvc) ListBizPrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	return svc.listPrivateDnsRecord(cts, handler.BizOperateAuth)
+}
+
+func (svc *privateDnsSvc) listPrivateDnsRecord(cts *rest.Contexts, validHandler handler.ValidWithAuthHandler) (
+	interface{}, error) {
+
+	zoneID := cts.PathParameter("id").String()
+	if len(zoneID) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "id is required")
+	}
+
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	if _, err := svc.validZoneAuth(cts, zoneID, meta.Find, validHandler); err != nil {
+		return nil, err
+	}
+
+	filterWithZone, err := tools.And(tools.RuleEqual("zone_id", zoneID), req.Filter)
+	if err != nil {
+		logs.Errorf("fail to merge zone id rule into request filter, err: %v, req.Filter: %+v, rid: %s", err,
+			req.Filter, cts.Kit.Rid)
+		return nil, err
+	}
+
+	listReq := &core.ListReq{
+		Filter: filterWithZone,
+		Page:   req.Page,
+	}
+	return svc.client.DataService().Global.PrivateDnsRecord.List(cts.Kit, listReq)
+}
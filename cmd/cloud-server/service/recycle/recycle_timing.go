@@ -20,15 +20,20 @@
 package recycle
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"hcm/cmd/cloud-server/logics"
+	"hcm/cmd/cloud-server/logics/disk"
 	"hcm/cmd/cloud-server/logics/recycle"
 	"hcm/pkg/api/core"
 	recyclerecord "hcm/pkg/api/core/recycle-record"
 	dataproto "hcm/pkg/api/data-service/cloud"
+	dsrecycle "hcm/pkg/api/data-service/recycle-record"
 	"hcm/pkg/cc"
 	"hcm/pkg/client"
+	"hcm/pkg/criteria/constant"
 	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/criteria/errf"
 	"hcm/pkg/dal/dao/tools"
@@ -38,6 +43,7 @@ import (
 	"hcm/pkg/runtime/filter"
 	"hcm/pkg/serviced"
 	"hcm/pkg/thirdparty/esb"
+	"hcm/pkg/tools/json"
 	"hcm/pkg/tools/retry"
 	"hcm/pkg/tools/slice"
 	"hcm/pkg/tools/times"
@@ -47,6 +53,7 @@ type recycle struct {
 	client *client.ClientSet
 	logics *logics.Logics
 	state  serviced.State
+	conf   cc.Recycle
 }
 
 // RecycleTiming timing recycle all resource.
@@ -54,14 +61,22 @@ func RecycleTiming(c *client.ClientSet, state serviced.State, conf cc.Recycle, e
 	r := &recycle{
 		client: c,
 		state:  state,
+		conf:   conf,
 		logics: logics.NewLogics(c, esbClient),
 	}
 
 	go r.recycleTiming(enumor.DiskCloudResType, r.recycleDiskWorker, conf)
 	go r.recycleTiming(enumor.CvmCloudResType, r.recycleCvmWorker, conf)
+	go r.recycleTiming(enumor.DatabaseInstanceCloudResType, r.recycleDatabaseInstanceWorker, conf)
 }
 
-type recycleWorker func(kt *kit.Kit, info *types.CloudResourceBasicInfo) error
+type recycleWorker func(kt *kit.Kit, record *recyclerecord.RecycleRecord, info *types.CloudResourceBasicInfo) error
+
+// errRecyclePostponed 资源暂时不满足回收条件，保持待回收状态，由下一轮定时任务重新处理
+var errRecyclePostponed = errors.New("recycle postponed")
+
+// errRecycleAborted 资源无法满足回收条件且重试无意义，worker 已自行将回收记录标记为失败
+var errRecycleAborted = errors.New("recycle aborted")
 
 func (r *recycle) recycleTiming(resType enumor.CloudResourceType, worker recycleWorker, conf cc.Recycle) {
 	for {
@@ -89,7 +104,7 @@ func (r *recycle) recycleTiming(resType enumor.CloudResourceType, worker recycle
 		listReq := &core.ListReq{
 			Filter: expr,
 			Page:   core.NewDefaultBasePage(),
-			Fields: []string{"id", "res_id", "bk_biz_id"},
+			Fields: []string{"id", "res_type", "res_id", "bk_biz_id", "detail"},
 		}
 		recordRes, err := r.client.DataService().Global.RecycleRecord.ListRecycleRecord(kt, listReq)
 		if err != nil {
@@ -130,23 +145,32 @@ func (r *recycle) recycleTiming(resType enumor.CloudResourceType, worker recycle
 		}
 
 		// recycle resources one by one
+		postponed := false
 		for _, record := range recordRes.Details {
 			if !r.state.IsMaster() {
 				logs.Infof("recycle %s res(id: %s), but is not master, skip, rid: %s", resType, record.ResID, kt.Rid)
 				time.Sleep(time.Minute)
 				break
 			}
-			r.execWorker(kt, worker, record, basicInfoMap)
+			if r.execWorker(kt, worker, record, basicInfoMap) {
+				postponed = true
+			}
 		}
 
 		logs.Infof("finished recycle %s, count: %d, rid: %s", resType, len(recordRes.Details), kt.Rid)
+
+		// 存在延后回收的资源时等待一段时间再处理，避免反复查询
+		if postponed {
+			time.Sleep(time.Minute)
+		}
 	}
 }
 
 const maxRetryCount = 3
 
+// execWorker 执行资源回收，返回资源是否被延后回收
 func (r *recycle) execWorker(kt *kit.Kit, worker recycleWorker, record recyclerecord.RecycleRecord,
-	basicInfoMap map[string]types.CloudResourceBasicInfo) {
+	basicInfoMap map[string]types.CloudResourceBasicInfo) bool {
 
 	basicInfo, exists := basicInfoMap[record.ResID]
 	if !exists {
@@ -154,7 +178,7 @@ func (r *recycle) execWorker(kt *kit.Kit, worker recycleWorker, record recyclere
 			kt.Rid)
 		logicsrecycle.MarkRecordFailed(kt, r.client.DataService(),
 			errf.New(errf.RecordNotFound, "Recourse Not Found"), []string{record.ID})
-		return
+		return false
 	}
 
 	rty := retry.NewRetryPolicy(maxRetryCount, [2]uint{500, 15000})
@@ -163,21 +187,96 @@ func (r *recycle) execWorker(kt *kit.Kit, worker recycleWorker, record recyclere
 	// 类型为cvm且在业务下回收的，需要检查是否在cmdb 待回收模块中
 	// 因为cvm记录中的BkBizID已经在加入业务的时候被清掉了，所以要以recycle_record中的为准
 	basicInfo.BkBizID = record.BkBizID
+	postponed, aborted := false, false
 	err = rty.BaseExec(kt, func() error {
-		return worker(kt, &basicInfo)
+		err := worker(kt, &record, &basicInfo)
+		if errors.Is(err, errRecyclePostponed) {
+			postponed = true
+			return nil
+		}
+		if errors.Is(err, errRecycleAborted) {
+			aborted = true
+			return nil
+		}
+		return err
 	})
 	if err != nil {
 		// Failed after retry
 		logicsrecycle.MarkRecordFailed(kt, r.client.DataService(), err, []string{record.ID})
-		return
+		return false
+	}
+	if aborted {
+		logs.Errorf("[%s]recycle res(id: %s) aborted, rid: %s", record.ResType, record.ID, kt.Rid)
+		return false
+	}
+	if postponed {
+		logs.Infof("[%s]recycle res(id: %s) postponed, rid: %s", record.ResType, record.ID, kt.Rid)
+		return true
 	}
 	// Success
 	logs.V(3).Infof("[%s]recycle res(id: %s) success,  rid: %s", record.ResType, record.ID, kt.Rid)
 
 	logicsrecycle.MarkRecordSuccess(kt, r.client.DataService(), []string{record.ID})
+	return false
 }
 
-func (r *recycle) recycleDiskWorker(kt *kit.Kit, info *types.CloudResourceBasicInfo) error {
+func (r *recycle) recycleDiskWorker(kt *kit.Kit, record *recyclerecord.RecycleRecord,
+	info *types.CloudResourceBasicInfo) error {
+
+	// 最终快照创建失败或未创建完成时不删除云盘，避免数据无法找回
+	if r.conf.DiskFinalSnapshot {
+		detail, err := parseDiskRecycleDetail(record.Detail)
+		if err != nil {
+			logs.Errorf("parse disk recycle detail failed, err: %v, record: %s, rid: %s", err, record.ID, kt.Rid)
+			return err
+		}
+
+		snapshotCloudID, err := r.logics.Disk.CreateFinalSnapshot(kt, info.Vendor, info.ID,
+			detail.FinalSnapshotCloudID)
+		if err != nil {
+			logs.Errorf("create final snapshot failed, err: %v, disk: %s, rid: %s", err, info.ID, kt.Rid)
+			return err
+		}
+
+		// 记录最终快照云ID及创建时间，重试时不再重复创建快照
+		if detail.FinalSnapshotCloudID != snapshotCloudID || len(detail.FinalSnapshotCreatedAt) == 0 {
+			detail.FinalSnapshotCloudID = snapshotCloudID
+			detail.FinalSnapshotCreatedAt = times.ConvStdTimeFormat(time.Now())
+			if err = r.updateDiskRecycleRecord(kt, record.ID, "", detail); err != nil {
+				return err
+			}
+			record.Detail = detail
+		}
+
+		state, err := r.logics.Disk.GetFinalSnapshotState(kt, info, snapshotCloudID)
+		if err != nil {
+			logs.Errorf("check final snapshot status failed, err: %v, disk: %s, rid: %s", err, info.ID, kt.Rid)
+			return err
+		}
+
+		timeout := time.Duration(r.conf.DiskFinalSnapshotTimeoutHour) * time.Hour
+		reason, err := finalSnapshotAbortReason(state, snapshotCloudID, detail.FinalSnapshotCreatedAt, timeout,
+			time.Now())
+		if err != nil {
+			logs.Errorf("check final snapshot timeout failed, err: %v, disk: %s, rid: %s", err, info.ID, kt.Rid)
+			return err
+		}
+		if len(reason) != 0 {
+			logs.Errorf("%s, disk: %s, mark recycle record failed, rid: %s", reason, info.ID, kt.Rid)
+			detail.ErrorMessage = reason
+			if err = r.updateDiskRecycleRecord(kt, record.ID, enumor.FailedRecycleRecordStatus, detail); err != nil {
+				return err
+			}
+			return errRecycleAborted
+		}
+
+		if state != disk.FinalSnapshotReady {
+			logs.Infof("final snapshot(%s) of disk(%s) is not ready, postpone recycle, rid: %s", snapshotCloudID,
+				info.ID, kt.Rid)
+			return errRecyclePostponed
+		}
+	}
+
 	res, err := r.logics.Disk.DeleteRecycledDisk(kt, map[string]types.CloudResourceBasicInfo{info.ID: *info})
 	if err != nil {
 		logs.Errorf("delete disk failed, err: %v, res: %+v, disk: %s, rid: %s", err, res, info.ID, kt.Rid)
@@ -186,7 +285,60 @@ func (r *recycle) recycleDiskWorker(kt *kit.Kit, info *types.CloudResourceBasicI
 	return nil
 }
 
-func (r *recycle) recycleCvmWorker(kt *kit.Kit, info *types.CloudResourceBasicInfo) error {
+// finalSnapshotAbortReason 最终快照创建失败或等待超时时返回回收失败原因，为空时表示可以继续回收或等待
+func finalSnapshotAbortReason(state disk.FinalSnapshotState, snapshotCloudID, createdAt string,
+	timeout time.Duration, now time.Time) (string, error) {
+
+	switch state {
+	case disk.FinalSnapshotReady:
+		return "", nil
+	case disk.FinalSnapshotFailed:
+		return fmt.Sprintf("final snapshot(%s) create failed", snapshotCloudID), nil
+	}
+
+	created, err := time.Parse(constant.TimeStdFormat, createdAt)
+	if err != nil {
+		return "", err
+	}
+	if now.Sub(created) > timeout {
+		return fmt.Sprintf("final snapshot(%s) is not ready after %s", snapshotCloudID, timeout), nil
+	}
+
+	return "", nil
+}
+
+func (r *recycle) updateDiskRecycleRecord(kt *kit.Kit, id string, status enumor.RecycleRecordStatus,
+	detail *recyclerecord.DiskRecycleDetail) error {
+
+	updateReq := &dsrecycle.BatchUpdateReq{Data: []dsrecycle.UpdateReq{{ID: id, Status: status, Detail: detail}}}
+	if err := r.client.DataService().Global.RecycleRecord.BatchUpdateRecycleRecord(kt, updateReq); err != nil {
+		logs.Errorf("update disk recycle record failed, err: %v, record: %s, status: %s, rid: %s", err, id, status,
+			kt.Rid)
+		return err
+	}
+	return nil
+}
+
+// parseDiskRecycleDetail 解析云盘回收记录详情
+func parseDiskRecycleDetail(raw interface{}) (*recyclerecord.DiskRecycleDetail, error) {
+	detail := new(recyclerecord.DiskRecycleDetail)
+	if raw == nil {
+		return detail, nil
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, detail); err != nil {
+		return nil, err
+	}
+	return detail, nil
+}
+
+func (r *recycle) recycleCvmWorker(kt *kit.Kit, _ *recyclerecord.RecycleRecord,
+	info *types.CloudResourceBasicInfo) error {
+
 	// 实际销毁CVM
 	res, err := r.logics.Cvm.DestroyRecycledCvm(kt, map[string]types.CloudResourceBasicInfo{info.ID: *info}, nil)
 	if err != nil {
@@ -195,3 +347,16 @@ func (r *recycle) recycleCvmWorker(kt *kit.Kit, info *types.CloudResourceBasicIn
 	}
 	return nil
 }
+
+func (r *recycle) recycleDatabaseInstanceWorker(kt *kit.Kit, _ *recyclerecord.RecycleRecord,
+	info *types.CloudResourceBasicInfo) error {
+
+	res, err := r.logics.DatabaseInstance.DeleteRecycledDatabaseInstance(kt,
+		map[string]types.CloudResourceBasicInfo{info.ID: *info})
+	if err != nil {
+		logs.Errorf("delete database instance failed, err: %v, res: %+v, id: %s, rid: %s", err, res, info.ID,
+			kt.Rid)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package recycle
+
+import (
+	"testing"
+	"time"
+
+	"hcm/cmd/cloud-server/logics/disk"
+	recyclerecord "hcm/pkg/api/core/recycle-record"
+	"hcm/pkg/tools/times"
+)
+
+func TestFinalSnapshotAbortReason(t *testing.T) {
+	now := time.Now()
+	createdAt := times.ConvStdTimeFormat(now.Add(-2 * time.Hour))
+	cases := []struct {
+		name       string
+		state      disk.FinalSnapshotState
+		createdAt  string
+		timeout    time.Duration
+		wantReason bool
+		wantErr    bool
+	}{
+		{name: "ready", state: disk.FinalSnapshotReady, createdAt: createdAt, timeout: time.Hour},
+		{name: "failed", state: disk.FinalSnapshotFailed, createdAt: createdAt, timeout: 24 * time.Hour,
+			wantReason: true},
+		{name: "pending within timeout", state: disk.FinalSnapshotPending, createdAt: createdAt,
+			timeout: 24 * time.Hour},
+		{name: "pending timeout", state: disk.FinalSnapshotPending, createdAt: createdAt, timeout: time.Hour,
+			wantReason: true},
+		{name: "invalid created at", state: disk.FinalSnapshotPending, createdAt: "yesterday",
+			timeout: time.Hour, wantErr: true},
+	}
+
+	for _, c := range cases {
+		reason, err := finalSnapshotAbortReason(c.state, "snap-1", c.createdAt, c.timeout, now)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: err = %v, wantErr: %v", c.name, err, c.wantErr)
+			continue
+		}
+		if (len(reason) != 0) != c.wantReason {
+			t.Errorf("%s: reason = %q, wantReason: %v", c.name, reason, c.wantReason)
+		}
+	}
+}
+
+func TestParseDiskRecycleDetail(t *testing.T) {
+	cases := []struct {
+		name   string
+		raw    interface{}
+		expect recyclerecord.DiskRecycleDetail
+	}{
+		{name: "nil", raw: nil},
+		{name: "empty", raw: map[string]interface{}{}},
+		{name: "recorded", raw: map[string]interface{}{"final_snapshot_cloud_id": "snap-1",
+			"final_snapshot_created_at": "2024-03-01T10:00:00+08:00"},
+			expect: recyclerecord.DiskRecycleDetail{FinalSnapshotCloudID: "snap-1",
+				FinalSnapshotCreatedAt: "2024-03-01T10:00:00+08:00"}},
+		{name: "struct", raw: &recyclerecord.DiskRecycleDetail{FinalSnapshotCloudID: "snap-2"},
+			expect: recyclerecord.DiskRecycleDetail{FinalSnapshotCloudID: "snap-2"}},
+	}
+
+	for _, c := range cases {
+		got, err := parseDiskRecycleDetail(c.raw)
+		if err != nil {
+			t.Errorf("%s: parseDiskRecycleDetail failed, err: %v", c.name, err)
+			continue
+		}
+		if *got != c.expect {
+			t.Errorf("%s: parseDiskRecycleDetail = %+v, expect: %+v", c.name, *got, c.expect)
+		}
+	}
+}
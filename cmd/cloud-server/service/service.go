@@ -40,28 +40,37 @@ import (
 	"hcm/cmd/cloud-server/service/audit"
 	bandwidthpackage "hcm/cmd/cloud-server/service/bandwidth-package"
 	"hcm/cmd/cloud-server/service/bill"
+	"hcm/cmd/cloud-server/service/bucket"
 	"hcm/cmd/cloud-server/service/capability"
 	"hcm/cmd/cloud-server/service/cert"
 	cloudselection "hcm/cmd/cloud-server/service/cloud-selection"
 	"hcm/cmd/cloud-server/service/cvm"
+	dbinstance "hcm/cmd/cloud-server/service/database-instance"
 	"hcm/cmd/cloud-server/service/disk"
 	"hcm/cmd/cloud-server/service/eip"
 	"hcm/cmd/cloud-server/service/firewall"
 	"hcm/cmd/cloud-server/service/image"
 	instancetype "hcm/cmd/cloud-server/service/instance-type"
+	"hcm/cmd/cloud-server/service/ipam"
+	k8scluster "hcm/cmd/cloud-server/service/k8s-cluster"
+	keypair "hcm/cmd/cloud-server/service/key-pair"
 	loadbalancer "hcm/cmd/cloud-server/service/load-balancer"
+	natgateway "hcm/cmd/cloud-server/service/nat-gateway"
 	networkinterface "hcm/cmd/cloud-server/service/network-interface"
+	privatedns "hcm/cmd/cloud-server/service/private-dns"
 	"hcm/cmd/cloud-server/service/recycle"
 	"hcm/cmd/cloud-server/service/region"
 	resourcegroup "hcm/cmd/cloud-server/service/resource-group"
 	routetable "hcm/cmd/cloud-server/service/route-table"
 	securitygroup "hcm/cmd/cloud-server/service/security-group"
+	"hcm/cmd/cloud-server/service/snapshot"
 	subaccount "hcm/cmd/cloud-server/service/sub-account"
 	"hcm/cmd/cloud-server/service/subnet"
 	"hcm/cmd/cloud-server/service/sync"
 	"hcm/cmd/cloud-server/service/sync/lock"
 	"hcm/cmd/cloud-server/service/user"
 	"hcm/cmd/cloud-server/service/vpc"
+	vpcpeering "hcm/cmd/cloud-server/service/vpc-peering"
 	"hcm/cmd/cloud-server/service/zone"
 	"hcm/pkg/cc"
 	"hcm/pkg/client"
@@ -116,8 +125,7 @@ func NewService(sd serviced.ServiceDiscover) (*Service, error) {
 	}
 
 	if cc.CloudServer().CloudResource.Sync.Enable {
-		interval := time.Duration(cc.CloudServer().CloudResource.Sync.SyncIntervalMin) * time.Minute
-		go sync.CloudResourceSync(interval, sd, apiClientSet)
+		go sync.CloudResourceSync(cc.CloudServer().CloudResource.Sync, sd, apiClientSet)
 	}
 
 	if cc.CloudServer().BillConfig.Enable {
@@ -291,9 +299,18 @@ func (s *Service) apiSet(bkHcmUrl string) *restful.Container {
 	firewall.InitFirewallService(c)
 	vpc.InitVpcService(c)
 	disk.InitDiskService(c)
+	snapshot.InitService(c)
 	subnet.InitSubnetService(c)
 	image.InitImageService(c)
 	routetable.InitRouteTableService(c)
+	natgateway.InitService(c)
+	vpcpeering.InitService(c)
+	dbinstance.InitService(c)
+	bucket.InitService(c)
+	k8scluster.InitService(c)
+	keypair.InitService(c)
+	privatedns.InitService(c)
+	ipam.InitService(c)
 	cvm.InitCvmService(c)
 	resourcegroup.InitResourceGroupService(c)
 	zone.InitZoneService(c)
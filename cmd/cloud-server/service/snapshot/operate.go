@@ -0,0 +1,202 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package snapshot
+
+import (
+	cssnapshot "hcm/pkg/api/cloud-server/snapshot"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	hcsnapshot "hcm/pkg/api/hc-service/snapshot"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/types"
+	"hcm/pkg/iam/meta"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/hooks/handler"
+)
+
+// CreateSnapshot create resource snapshot.
+func (svc *snapshotSvc) CreateSnapshot(cts *rest.Contexts) (interface{}, error) {
+	return svc.createSnapshot(cts, handler.ResOperateAuth)
+}
+
+// CreateBizSnapshot create biz snapshot.
+func (svc *snapshotSvc) CreateBizSnapshot(cts *rest.Contexts) (interface{}, error) {
+	return svc.createSnapshot(cts, handler.BizOperateAuth)
+}
+
+func (svc *snapshotSvc) createSnapshot(cts *rest.Contexts, validHandler handler.ValidWithAuthHandler) (
+	interface{}, error) {
+
+	req := new(cssnapshot.CreateSnapshotReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	diskInfo, err := svc.client.DataService().Global.Cloud.GetResBasicInfo(cts.Kit, enumor.DiskCloudResType,
+		req.DiskID, types.ResWithRecycleBasicFields...)
+	if err != nil {
+		logs.Errorf("get disk basic info failed, id: %s, err: %v, rid: %s", req.DiskID, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	// 基于云盘创建快照，按云盘的编辑权限鉴权
+	err = validHandler(cts, &handler.ValidWithAuthOption{Authorizer: svc.authorizer, ResType: meta.Disk,
+		Action: meta.Update, BasicInfo: diskInfo})
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := svc.getSnapshotCli(diskInfo.Vendor)
+	if err != nil {
+		return nil, err
+	}
+
+	createReq := &hcsnapshot.SnapshotCreateReq{
+		DiskID: req.DiskID,
+		Name:   req.Name,
+		Memo:   req.Memo,
+	}
+	result, err := cli.CreateSnapshot(cts.Kit, createReq)
+	if err != nil {
+		logs.Errorf("[%s] request hcservice to create snapshot failed, disk: %s, err: %v, rid: %s",
+			diskInfo.Vendor, req.DiskID, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DeleteSnapshot delete resource snapshot.
+func (svc *snapshotSvc) DeleteSnapshot(cts *rest.Contexts) (interface{}, error) {
+	return svc.deleteSnapshot(cts, handler.ResOperateAuth)
+}
+
+// DeleteBizSnapshot delete biz snapshot.
+func (svc *snapshotSvc) DeleteBizSnapshot(cts *rest.Contexts) (interface{}, error) {
+	return svc.deleteSnapshot(cts, handler.BizOperateAuth)
+}
+
+func (svc *snapshotSvc) deleteSnapshot(cts *rest.Contexts, validHandler handler.ValidWithAuthHandler) (
+	interface{}, error) {
+
+	id := cts.PathParameter("id").String()
+
+	basicInfoReq := dataproto.ListResourceBasicInfoReq{
+		ResourceType: enumor.SnapshotCloudResType,
+		IDs:          []string{id},
+		Fields:       types.CommonBasicInfoFields,
+	}
+	basicInfoMap, err := svc.client.DataService().Global.Cloud.ListResBasicInfo(cts.Kit, basicInfoReq)
+	if err != nil {
+		logs.Errorf("list snapshot basic info failed, req: %+v, err: %v, rid: %s", basicInfoReq, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	err = validHandler(cts, &handler.ValidWithAuthOption{Authorizer: svc.authorizer, ResType: meta.Disk,
+		Action: meta.Delete, BasicInfos: basicInfoMap})
+	if err != nil {
+		logs.Errorf("delete snapshot auth failed, id: %s, err: %v, rid: %s", id, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	info, exist := basicInfoMap[id]
+	if !exist {
+		return nil, errf.Newf(errf.RecordNotFound, "snapshot: %s not found", id)
+	}
+
+	if err = svc.audit.ResDeleteAudit(cts.Kit, enumor.SnapshotAuditResType, basicInfoReq.IDs); err != nil {
+		logs.Errorf("create delete audit failed, ids: %v, err: %v, rid: %s", basicInfoReq.IDs, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	cli, err := svc.getSnapshotCli(info.Vendor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = cli.DeleteSnapshot(cts.Kit, &hcsnapshot.SnapshotDeleteReq{ID: id}); err != nil {
+		logs.Errorf("[%s] request hcservice to delete snapshot failed, id: %s, err: %v, rid: %s", info.Vendor, id,
+			err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// RollbackSnapshot rollback resource snapshot.
+func (svc *snapshotSvc) RollbackSnapshot(cts *rest.Contexts) (interface{}, error) {
+	return svc.rollbackSnapshot(cts, handler.ResOperateAuth)
+}
+
+// RollbackBizSnapshot rollback biz snapshot.
+func (svc *snapshotSvc) RollbackBizSnapshot(cts *rest.Contexts) (interface{}, error) {
+	return svc.rollbackSnapshot(cts, handler.BizOperateAuth)
+}
+
+func (svc *snapshotSvc) rollbackSnapshot(cts *rest.Contexts, validHandler handler.ValidWithAuthHandler) (
+	interface{}, error) {
+
+	id := cts.PathParameter("id").String()
+
+	req := new(cssnapshot.RollbackSnapshotReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	info, err := svc.client.DataService().Global.Cloud.GetResBasicInfo(cts.Kit, enumor.SnapshotCloudResType, id,
+		types.CommonBasicInfoFields...)
+	if err != nil {
+		logs.Errorf("get snapshot basic info failed, id: %s, err: %v, rid: %s", id, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	// 快照回滚会覆盖云盘数据，按云盘的编辑权限鉴权
+	err = validHandler(cts, &handler.ValidWithAuthOption{Authorizer: svc.authorizer, ResType: meta.Disk,
+		Action: meta.Update, BasicInfo: info})
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := svc.getSnapshotCli(info.Vendor)
+	if err != nil {
+		return nil, err
+	}
+
+	rollbackReq := &hcsnapshot.SnapshotRollbackReq{
+		ID:       id,
+		DiskName: req.DiskName,
+	}
+	if err = cli.RollbackSnapshot(cts.Kit, rollbackReq); err != nil {
+		logs.Errorf("[%s] request hcservice to rollback snapshot failed, id: %s, err: %v, rid: %s", info.Vendor, id,
+			err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,340 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package snapshot
+
+import (
+	"fmt"
+
+	actionsnapshot "hcm/cmd/task-server/logics/action/snapshot"
+	cssnapshot "hcm/pkg/api/cloud-server/snapshot"
+	"hcm/pkg/api/core"
+	coresnapshot "hcm/pkg/api/core/cloud/snapshot"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/async/action"
+	"hcm/pkg/async/producer"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/dal/dao/types"
+	tabletypes "hcm/pkg/dal/table/types"
+	"hcm/pkg/iam/meta"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/hooks/handler"
+)
+
+// CreateSnapshotPolicy create resource snapshot policy.
+func (svc *snapshotSvc) CreateSnapshotPolicy(cts *rest.Contexts) (interface{}, error) {
+	return svc.createPolicy(cts, handler.ResOperateAuth)
+}
+
+// CreateBizSnapshotPolicy create biz snapshot policy.
+func (svc *snapshotSvc) CreateBizSnapshotPolicy(cts *rest.Contexts) (interface{}, error) {
+	return svc.createPolicy(cts, handler.BizOperateAuth)
+}
+
+func (svc *snapshotSvc) createPolicy(cts *rest.Contexts, validHandler handler.ValidWithAuthHandler) (
+	interface{}, error) {
+
+	req := new(cssnapshot.CreatePolicyReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	diskInfos, err := svc.listPolicyDiskInfo(cts.Kit, req.DiskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// 快照策略按云盘的编辑权限鉴权
+	err = validHandler(cts, &handler.ValidWithAuthOption{Authorizer: svc.authorizer, ResType: meta.Disk,
+		Action: meta.Update, BasicInfos: diskInfos})
+	if err != nil {
+		return nil, err
+	}
+
+	info := diskInfos[req.DiskIDs[0]]
+	createReq := &dataproto.SnapshotPolicyCreateReq{
+		Vendor:        info.Vendor,
+		AccountID:     info.AccountID,
+		BkBizID:       info.BkBizID,
+		Name:          req.Name,
+		Spec:          req.Spec,
+		RetentionDays: req.RetentionDays,
+		DiskIDs:       req.DiskIDs,
+		Enabled:       req.Enabled,
+		Memo:          req.Memo,
+	}
+	result, err := svc.client.DataService().Global.Snapshot.CreatePolicy(cts.Kit, createReq)
+	if err != nil {
+		logs.Errorf("create snapshot policy failed, err: %v, req: %+v, rid: %s", err, createReq, cts.Kit.Rid)
+		return nil, err
+	}
+
+	cronFlowID, err := svc.createPolicyCronFlow(cts.Kit, result.ID, req.Spec, *req.Enabled)
+	if err != nil {
+		// 周期任务创建失败时删除策略，避免残留无法执行的策略
+		delReq := &core.BatchDeleteReq{IDs: []string{result.ID}}
+		if delErr := svc.client.DataService().Global.Snapshot.BatchDeletePolicy(cts.Kit, delReq); delErr != nil {
+			logs.Errorf("delete snapshot policy failed, id: %s, err: %v, rid: %s", result.ID, delErr, cts.Kit.Rid)
+		}
+		return nil, err
+	}
+
+	updateReq := &dataproto.SnapshotPolicyUpdateReq{ID: result.ID, CronFlowID: cronFlowID}
+	if err = svc.client.DataService().Global.Snapshot.UpdatePolicy(cts.Kit, updateReq); err != nil {
+		logs.Errorf("update snapshot policy cron flow id failed, id: %s, err: %v, rid: %s", result.ID, err,
+			cts.Kit.Rid)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// createPolicyCronFlow 创建快照策略对应的周期任务流，先创建快照再清理过期快照
+func (svc *snapshotSvc) createPolicyCronFlow(kt *kit.Kit, policyID, spec string, enabled bool) (string, error) {
+	params, err := tabletypes.NewJsonField(actionsnapshot.PolicyOption{PolicyID: policyID})
+	if err != nil {
+		return "", err
+	}
+
+	opt := &producer.AddCronFlowOption{
+		Name: fmt.Sprintf("snapshot_policy_%s", policyID),
+		Spec: spec,
+		Memo: fmt.Sprintf("snapshot policy: %s", policyID),
+		CustomFlow: &producer.AddCustomFlowOption{
+			Name: enumor.FlowExecuteSnapshotPolicy,
+			Tasks: []producer.CustomFlowTask{
+				{
+					ActionID:   "1",
+					ActionName: enumor.ActionCreatePolicySnapshot,
+					Params:     params,
+				},
+				{
+					ActionID:   "2",
+					ActionName: enumor.ActionCleanExpiredSnapshot,
+					Params:     params,
+					DependOn:   []action.ActIDType{"1"},
+				},
+			},
+		},
+	}
+	result, err := svc.client.TaskServer().CreateCronFlow(kt, opt)
+	if err != nil {
+		logs.Errorf("create snapshot policy cron flow failed, policy: %s, err: %v, rid: %s", policyID, err, kt.Rid)
+		return "", err
+	}
+
+	if !enabled {
+		updateOpt := &producer.UpdateCronFlowOption{Enabled: converter.ValToPtr(false)}
+		if err = svc.client.TaskServer().UpdateCronFlow(kt, result.ID, updateOpt); err != nil {
+			logs.Errorf("disable snapshot policy cron flow failed, id: %s, err: %v, rid: %s", result.ID, err,
+				kt.Rid)
+			return "", err
+		}
+	}
+
+	return result.ID, nil
+}
+
+// UpdateSnapshotPolicy update resource snapshot policy.
+func (svc *snapshotSvc) UpdateSnapshotPolicy(cts *rest.Contexts) (interface{}, error) {
+	return svc.updatePolicy(cts, handler.ResOperateAuth)
+}
+
+// UpdateBizSnapshotPolicy update biz snapshot policy.
+func (svc *snapshotSvc) UpdateBizSnapshotPolicy(cts *rest.Contexts) (interface{}, error) {
+	return svc.updatePolicy(cts, handler.BizOperateAuth)
+}
+
+func (svc *snapshotSvc) updatePolicy(cts *rest.Contexts, validHandler handler.ValidWithAuthHandler) (
+	interface{}, error) {
+
+	id := cts.PathParameter("id").String()
+
+	req := new(cssnapshot.UpdatePolicyReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	policy, err := svc.getPolicy(cts.Kit, id)
+	if err != nil {
+		return nil, err
+	}
+
+	basicInfos := map[string]types.CloudResourceBasicInfo{id: convPolicyBasicInfo(policy)}
+	if len(req.DiskIDs) != 0 {
+		diskInfos, err := svc.listPolicyDiskInfo(cts.Kit, req.DiskIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		for diskID, info := range diskInfos {
+			if info.AccountID != policy.AccountID {
+				return nil, errf.Newf(errf.InvalidParameter, "disk: %s not belongs to account: %s", diskID,
+					policy.AccountID)
+			}
+			basicInfos[diskID] = info
+		}
+	}
+
+	err = validHandler(cts, &handler.ValidWithAuthOption{Authorizer: svc.authorizer, ResType: meta.Disk,
+		Action: meta.Update, BasicInfos: basicInfos})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(policy.CronFlowID) != 0 && (len(req.Spec) != 0 || req.Enabled != nil) {
+		opt := &producer.UpdateCronFlowOption{Spec: req.Spec, Enabled: req.Enabled}
+		if err = svc.client.TaskServer().UpdateCronFlow(cts.Kit, policy.CronFlowID, opt); err != nil {
+			logs.Errorf("update snapshot policy cron flow failed, id: %s, err: %v, rid: %s", policy.CronFlowID,
+				err, cts.Kit.Rid)
+			return nil, err
+		}
+	}
+
+	updateReq := &dataproto.SnapshotPolicyUpdateReq{
+		ID:            id,
+		Name:          req.Name,
+		Spec:          req.Spec,
+		RetentionDays: req.RetentionDays,
+		DiskIDs:       req.DiskIDs,
+		Enabled:       req.Enabled,
+		Memo:          req.Memo,
+	}
+	if err = svc.client.DataService().Global.Snapshot.UpdatePolicy(cts.Kit, updateReq); err != nil {
+		logs.Errorf("update snapshot policy failed, id: %s, err: %v, rid: %s", id, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// DeleteSnapshotPolicy delete resource snapshot policy.
+func (svc *snapshotSvc) DeleteSnapshotPolicy(cts *rest.Contexts) (interface{}, error) {
+	return svc.deletePolicy(cts, handler.ResOperateAuth)
+}
+
+// DeleteBizSnapshotPolicy delete biz snapshot policy.
+func (svc *snapshotSvc) DeleteBizSnapshotPolicy(cts *rest.Contexts) (interface{}, error) {
+	return svc.deletePolicy(cts, handler.BizOperateAuth)
+}
+
+func (svc *snapshotSvc) deletePolicy(cts *rest.Contexts, validHandler handler.ValidWithAuthHandler) (
+	interface{}, error) {
+
+	id := cts.PathParameter("id").String()
+
+	policy, err := svc.getPolicy(cts.Kit, id)
+	if err != nil {
+		return nil, err
+	}
+
+	info := convPolicyBasicInfo(policy)
+	err = validHandler(cts, &handler.ValidWithAuthOption{Authorizer: svc.authorizer, ResType: meta.Disk,
+		Action: meta.Update, BasicInfo: &info})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(policy.CronFlowID) != 0 {
+		if err = svc.client.TaskServer().DeleteCronFlow(cts.Kit, policy.CronFlowID); err != nil {
+			logs.Errorf("delete snapshot policy cron flow failed, id: %s, err: %v, rid: %s", policy.CronFlowID,
+				err, cts.Kit.Rid)
+			return nil, err
+		}
+	}
+
+	delReq := &core.BatchDeleteReq{IDs: []string{id}}
+	if err = svc.client.DataService().Global.Snapshot.BatchDeletePolicy(cts.Kit, delReq); err != nil {
+		logs.Errorf("delete snapshot policy failed, id: %s, err: %v, rid: %s", id, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// listPolicyDiskInfo 查询策略关联云盘的基础信息，要求云盘属于同一账号
+func (svc *snapshotSvc) listPolicyDiskInfo(kt *kit.Kit, diskIDs []string) (
+	map[string]types.CloudResourceBasicInfo, error) {
+
+	basicInfoReq := dataproto.ListResourceBasicInfoReq{
+		ResourceType: enumor.DiskCloudResType,
+		IDs:          diskIDs,
+		Fields:       types.ResWithRecycleBasicFields,
+	}
+	infos, err := svc.client.DataService().Global.Cloud.ListResBasicInfo(kt, basicInfoReq)
+	if err != nil {
+		logs.Errorf("list disk basic info failed, ids: %v, err: %v, rid: %s", diskIDs, err, kt.Rid)
+		return nil, err
+	}
+
+	accountID := ""
+	for _, diskID := range diskIDs {
+		info, exist := infos[diskID]
+		if !exist {
+			return nil, errf.Newf(errf.RecordNotFound, "disk: %s not found", diskID)
+		}
+
+		if len(accountID) != 0 && info.AccountID != accountID {
+			return nil, errf.New(errf.InvalidParameter, "disks of snapshot policy should belong to one account")
+		}
+		accountID = info.AccountID
+	}
+
+	return infos, nil
+}
+
+func (svc *snapshotSvc) getPolicy(kt *kit.Kit, id string) (*coresnapshot.Policy, error) {
+	req := &core.ListReq{
+		Filter: tools.EqualExpression("id", id),
+		Page:   core.NewDefaultBasePage(),
+	}
+	result, err := svc.client.DataService().Global.Snapshot.ListPolicy(kt, req)
+	if err != nil {
+		logs.Errorf("list snapshot policy failed, id: %s, err: %v, rid: %s", id, err, kt.Rid)
+		return nil, err
+	}
+
+	if len(result.Details) == 0 {
+		return nil, errf.Newf(errf.RecordNotFound, "snapshot policy: %s not found", id)
+	}
+
+	return &result.Details[0], nil
+}
+
+func convPolicyBasicInfo(policy *coresnapshot.Policy) types.CloudResourceBasicInfo {
+	return types.CloudResourceBasicInfo{
+		ID:        policy.ID,
+		Vendor:    policy.Vendor,
+		AccountID: policy.AccountID,
+		BkBizID:   policy.BkBizID,
+	}
+}
@@ -0,0 +1,110 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package snapshot
+
+import (
+	proto "hcm/pkg/api/cloud-server"
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/iam/meta"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/hooks/handler"
+)
+
+// ListSnapshot list resource snapshot.
+func (svc *snapshotSvc) ListSnapshot(cts *rest.Contexts) (interface{}, error) {
+	return svc.listSnapshot(cts, handler.ListResourceAuthRes)
+}
+
+// ListBizSnapshot list biz snapshot.
+func (svc *snapshotSvc) ListBizSnapshot(cts *rest.Contexts) (interface{}, error) {
+	return svc.listSnapshot(cts, handler.ListBizAuthRes)
+}
+
+func (svc *snapshotSvc) listSnapshot(cts *rest.Contexts, authHandler handler.ListAuthResHandler) (interface{},
+	error) {
+
+	req := new(proto.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	// 快照跟随云盘鉴权
+	expr, noPermFlag, err := authHandler(cts, &handler.ListAuthResOption{Authorizer: svc.authorizer,
+		ResType: meta.Disk, Action: meta.Find, Filter: req.Filter})
+	if err != nil {
+		logs.Errorf("list snapshot auth failed, noPermFlag: %v, err: %v, rid: %s", noPermFlag, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if noPermFlag {
+		return &core.ListResult{Count: 0, Details: make([]interface{}, 0)}, nil
+	}
+
+	listReq := &core.ListReq{
+		Filter: expr,
+		Page:   req.Page,
+	}
+	return svc.client.DataService().Global.Snapshot.List(cts.Kit, listReq)
+}
+
+// ListSnapshotPolicy list resource snapshot policy.
+func (svc *snapshotSvc) ListSnapshotPolicy(cts *rest.Contexts) (interface{}, error) {
+	return svc.listPolicy(cts, handler.ListResourceAuthRes)
+}
+
+// ListBizSnapshotPolicy list biz snapshot policy.
+func (svc *snapshotSvc) ListBizSnapshotPolicy(cts *rest.Contexts) (interface{}, error) {
+	return svc.listPolicy(cts, handler.ListBizAuthRes)
+}
+
+func (svc *snapshotSvc) listPolicy(cts *rest.Contexts, authHandler handler.ListAuthResHandler) (interface{}, error) {
+	req := new(proto.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	expr, noPermFlag, err := authHandler(cts, &handler.ListAuthResOption{Authorizer: svc.authorizer,
+		ResType: meta.Disk, Action: meta.Find, Filter: req.Filter})
+	if err != nil {
+		logs.Errorf("list snapshot policy auth failed, noPermFlag: %v, err: %v, rid: %s", noPermFlag, err,
+			cts.Kit.Rid)
+		return nil, err
+	}
+
+	if noPermFlag {
+		return &core.ListResult{Count: 0, Details: make([]interface{}, 0)}, nil
+	}
+
+	listReq := &core.ListReq{
+		Filter: expr,
+		Page:   req.Page,
+	}
+	return svc.client.DataService().Global.Snapshot.ListPolicy(cts.Kit, listReq)
+}
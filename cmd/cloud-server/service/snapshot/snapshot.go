@@ -0,0 +1,108 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package snapshot ...
+package snapshot
+
+import (
+	"net/http"
+
+	"hcm/cmd/cloud-server/logics/audit"
+	"hcm/cmd/cloud-server/service/capability"
+	hcsnapshot "hcm/pkg/api/hc-service/snapshot"
+	"hcm/pkg/client"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/iam/auth"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// InitService initialize the snapshot service.
+func InitService(c *capability.Capability) {
+	svc := &snapshotSvc{
+		client:     c.ApiClient,
+		authorizer: c.Authorizer,
+		audit:      c.Audit,
+	}
+
+	h := rest.NewHandler()
+
+	// snapshot apis in biz
+	h.Add("ListBizSnapshot", http.MethodPost, "/bizs/{bk_biz_id}/snapshots/list", svc.ListBizSnapshot)
+	h.Add("CreateBizSnapshot", http.MethodPost, "/bizs/{bk_biz_id}/snapshots/create", svc.CreateBizSnapshot)
+	h.Add("DeleteBizSnapshot", http.MethodDelete, "/bizs/{bk_biz_id}/snapshots/{id}", svc.DeleteBizSnapshot)
+	h.Add("RollbackBizSnapshot", http.MethodPost, "/bizs/{bk_biz_id}/snapshots/{id}/rollback",
+		svc.RollbackBizSnapshot)
+
+	// snapshot apis in resource
+	h.Add("ListSnapshot", http.MethodPost, "/snapshots/list", svc.ListSnapshot)
+	h.Add("CreateSnapshot", http.MethodPost, "/snapshots/create", svc.CreateSnapshot)
+	h.Add("DeleteSnapshot", http.MethodDelete, "/snapshots/{id}", svc.DeleteSnapshot)
+	h.Add("RollbackSnapshot", http.MethodPost, "/snapshots/{id}/rollback", svc.RollbackSnapshot)
+
+	// snapshot policy apis in biz
+	h.Add("ListBizSnapshotPolicy", http.MethodPost, "/bizs/{bk_biz_id}/snapshot_policies/list",
+		svc.ListBizSnapshotPolicy)
+	h.Add("CreateBizSnapshotPolicy", http.MethodPost, "/bizs/{bk_biz_id}/snapshot_policies/create",
+		svc.CreateBizSnapshotPolicy)
+	h.Add("UpdateBizSnapshotPolicy", http.MethodPatch, "/bizs/{bk_biz_id}/snapshot_policies/{id}",
+		svc.UpdateBizSnapshotPolicy)
+	h.Add("DeleteBizSnapshotPolicy", http.MethodDelete, "/bizs/{bk_biz_id}/snapshot_policies/{id}",
+		svc.DeleteBizSnapshotPolicy)
+
+	// snapshot policy apis in resource
+	h.Add("ListSnapshotPolicy", http.MethodPost, "/snapshot_policies/list", svc.ListSnapshotPolicy)
+	h.Add("CreateSnapshotPolicy", http.MethodPost, "/snapshot_policies/create", svc.CreateSnapshotPolicy)
+	h.Add("UpdateSnapshotPolicy", http.MethodPatch, "/snapshot_policies/{id}", svc.UpdateSnapshotPolicy)
+	h.Add("DeleteSnapshotPolicy", http.MethodDelete, "/snapshot_policies/{id}", svc.DeleteSnapshotPolicy)
+
+	h.Load(c.WebService)
+}
+
+type snapshotSvc struct {
+	client     *client.ClientSet
+	authorizer auth.Authorizer
+	audit      audit.Interface
+}
+
+// snapshotCli 各云厂商 hc-service 快照客户端的公共方法
+type snapshotCli interface {
+	CreateSnapshot(kt *kit.Kit, req *hcsnapshot.SnapshotCreateReq) (*hcsnapshot.SnapshotCreateResult, error)
+	DeleteSnapshot(kt *kit.Kit, req *hcsnapshot.SnapshotDeleteReq) error
+	RollbackSnapshot(kt *kit.Kit, req *hcsnapshot.SnapshotRollbackReq) error
+}
+
+func (svc *snapshotSvc) getSnapshotCli(vendor enumor.Vendor) (snapshotCli, error) {
+	cli := svc.client.HCService()
+	switch vendor {
+	case enumor.TCloud:
+		return cli.TCloud.Snapshot, nil
+	case enumor.Aws:
+		return cli.Aws.Snapshot, nil
+	case enumor.HuaWei:
+		return cli.HuaWei.Snapshot, nil
+	case enumor.Gcp:
+		return cli.Gcp.Snapshot, nil
+	case enumor.Azure:
+		return cli.Azure.Snapshot, nil
+	default:
+		return nil, errf.Newf(errf.InvalidParameter, "vendor: %s not support snapshot", vendor)
+	}
+}
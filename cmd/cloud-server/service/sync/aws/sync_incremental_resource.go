@@ -0,0 +1,175 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"time"
+
+	"hcm/cmd/cloud-server/service/sync/detail"
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/client"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+)
+
+// auditEventDelay CloudTrail 事件最多延迟15分钟才能查询到，查询变更资源时从水位向前多查一段时间避免遗漏
+const auditEventDelay = 15 * time.Minute
+
+// SyncIncrementalResourceOption ...
+type SyncIncrementalResourceOption struct {
+	AccountID string `json:"account_id" validate:"required"`
+}
+
+// Validate SyncIncrementalResourceOption
+func (opt *SyncIncrementalResourceOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// SyncIncrementalResource 增量同步资源。通过操作审计事件查询各地域同步水位之后发生变更的资源，只同步变更的资源，
+// 没有同步水位的地域先全量同步该地域的资源，同步完成后记录新的同步水位。
+func SyncIncrementalResource(kt *kit.Kit, cliSet *client.ClientSet,
+	opt *SyncIncrementalResourceOption) (enumor.CloudResourceType, error) {
+
+	if err := opt.Validate(); err != nil {
+		return "", err
+	}
+
+	start := time.Now()
+	logs.V(3).Infof("aws account[%s] sync incremental resource start, time: %v, rid: %s", opt.AccountID, start,
+		kt.Rid)
+	defer func() {
+		logs.V(3).Infof("aws account[%s] sync incremental resource end, cost: %v, rid: %s", opt.AccountID,
+			time.Since(start), kt.Rid)
+	}()
+
+	regions, err := ListRegion(kt, cliSet.DataService(), opt.AccountID)
+	if err != nil {
+		return "", err
+	}
+
+	sd := &detail.SyncDetail{
+		Kt:        kt,
+		DataCli:   cliSet.DataService(),
+		AccountID: opt.AccountID,
+		Vendor:    string(enumor.Aws),
+	}
+
+	watermarks := make(map[enumor.CloudResourceType]map[string]time.Time)
+	for _, resType := range getIncrementalSyncOrder() {
+		if watermarks[resType], err = sd.ResWatermark(resType); err != nil {
+			logs.Errorf("get aws %s watermark failed, err: %v, account: %s, rid: %s", resType, err, opt.AccountID,
+				kt.Rid)
+			return resType, err
+		}
+	}
+
+	for _, region := range regions {
+		if resType, err := syncRegionIncrementalResource(kt, cliSet, opt.AccountID, region, watermarks); err != nil {
+			logs.Errorf("%s: aws sync region incremental resource failed, err: %v, account: %s, region: %s, rid: %s",
+				constant.AccountSyncFailed, err, opt.AccountID, region, kt.Rid)
+			return resType, err
+		}
+	}
+
+	for _, resType := range getIncrementalSyncOrder() {
+		if err = sd.ResSyncStatusSuccessWithWatermark(resType, watermarks[resType]); err != nil {
+			return resType, err
+		}
+	}
+
+	return "", nil
+}
+
+// syncRegionIncrementalResource 增量同步地域下的资源，同步成功的资源会更新 watermarks 中该地域的水位
+func syncRegionIncrementalResource(kt *kit.Kit, cliSet *client.ClientSet, accountID, region string,
+	watermarks map[enumor.CloudResourceType]map[string]time.Time) (enumor.CloudResourceType, error) {
+
+	end := time.Now()
+	begin := earliestWatermark(watermarks, region)
+
+	changed := make(map[enumor.CloudResourceType][]string)
+	if !begin.IsZero() {
+		req := &sync.AwsChangedResListReq{
+			AccountID: accountID,
+			Region:    region,
+			StartTime: begin.Add(-auditEventDelay),
+			EndTime:   end,
+		}
+		result, err := cliSet.HCService().Aws.Sync.ListChangedResource(kt, req)
+		if err != nil {
+			logs.Errorf("list aws changed resource failed, err: %v, req: %+v, rid: %s", err, req, kt.Rid)
+			return "", err
+		}
+		changed = result.Details
+	}
+
+	for _, resType := range getIncrementalSyncOrder() {
+		if _, exists := watermarks[resType][region]; !exists {
+			// 没有水位说明该地域的资源还未进行过增量同步，需要先全量同步一次
+			req := &sync.AwsSyncReq{AccountID: accountID, Region: region}
+			if err := targetResSyncFuncMap[resType](kt, cliSet, req); err != nil {
+				logs.Errorf("sync aws %s failed, err: %v, req: %v, rid: %s", resType, err, req, kt.Rid)
+				return resType, err
+			}
+		} else if len(changed[resType]) != 0 {
+			opt := &SyncTargetResourceOption{
+				AccountID: accountID,
+				Region:    region,
+				ResType:   resType,
+				CloudIDs:  changed[resType],
+			}
+			if err := SyncTargetResource(kt, cliSet, opt); err != nil {
+				return resType, err
+			}
+		}
+
+		watermarks[resType][region] = end
+	}
+
+	return "", nil
+}
+
+// earliestWatermark 取地域下各资源中最早的水位作为变更事件查询的起始时间，都没有水位时返回零值
+func earliestWatermark(watermarks map[enumor.CloudResourceType]map[string]time.Time, region string) time.Time {
+	var begin time.Time
+	for _, resType := range getIncrementalSyncOrder() {
+		mark, exists := watermarks[resType][region]
+		if exists && (begin.IsZero() || mark.Before(begin)) {
+			begin = mark
+		}
+	}
+
+	return begin
+}
+
+// getIncrementalSyncOrder 支持增量同步的资源类型，按资源依赖顺序同步
+func getIncrementalSyncOrder() []enumor.CloudResourceType {
+	return []enumor.CloudResourceType{
+		enumor.DiskCloudResType,
+		enumor.VpcCloudResType,
+		enumor.SubnetCloudResType,
+		enumor.EipCloudResType,
+		enumor.SecurityGroupCloudResType,
+		enumor.CvmCloudResType,
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"hcm/pkg/criteria/enumor"
+)
+
+func TestEarliestWatermark(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+
+	cases := []struct {
+		name       string
+		watermarks map[enumor.CloudResourceType]map[string]time.Time
+		want       time.Time
+	}{
+		{
+			name:       "no watermark",
+			watermarks: map[enumor.CloudResourceType]map[string]time.Time{},
+			want:       time.Time{},
+		},
+		{
+			name: "other region only",
+			watermarks: map[enumor.CloudResourceType]map[string]time.Time{
+				enumor.CvmCloudResType: {"us-east-1": now},
+			},
+			want: time.Time{},
+		},
+		{
+			name: "earliest of resources",
+			watermarks: map[enumor.CloudResourceType]map[string]time.Time{
+				enumor.CvmCloudResType:  {"ap-east-1": now},
+				enumor.DiskCloudResType: {"ap-east-1": earlier},
+				enumor.VpcCloudResType:  {"us-east-1": earlier.Add(-time.Hour)},
+			},
+			want: earlier,
+		},
+	}
+
+	for _, c := range cases {
+		if got := earliestWatermark(c.watermarks, "ap-east-1"); !got.Equal(c.want) {
+			t.Errorf("%s: want: %v, got: %v", c.name, c.want, got)
+		}
+	}
+}
@@ -127,6 +127,20 @@ func SyncAllResource(kt *kit.Kit, cliSet *client.ClientSet,
 		return enumor.CvmCloudResType, hitErr
 	}
 
+	// 快照依赖云盘和主机的关联关系，在主机之后同步
+	if hitErr = SyncSnapshot(kt, cliSet, opt.AccountID, resourceGroupNames, sd); hitErr != nil {
+		return enumor.SnapshotCloudResType, hitErr
+	}
+
+	// 路由下一跳依赖NAT网关和对等连接，在路由表之前同步
+	if hitErr = SyncNatGateway(kt, cliSet, opt.AccountID, resourceGroupNames, sd); hitErr != nil {
+		return enumor.NatGatewayCloudResType, hitErr
+	}
+
+	if hitErr = SyncVpcPeering(kt, cliSet, opt.AccountID, resourceGroupNames, sd); hitErr != nil {
+		return enumor.VpcPeeringCloudResType, hitErr
+	}
+
 	if hitErr = SyncRouteTable(kt, cliSet, opt.AccountID, resourceGroupNames, sd); hitErr != nil {
 		return enumor.RouteTableCloudResType, hitErr
 	}
@@ -135,6 +149,33 @@ func SyncAllResource(kt *kit.Kit, cliSet *client.ClientSet,
 		return enumor.NetworkInterfaceCloudResType, hitErr
 	}
 
+	if hitErr = SyncLoadBalancer(kt, cliSet, opt.AccountID, resourceGroupNames, sd); hitErr != nil {
+		return enumor.LoadBalancerCloudResType, hitErr
+	}
+
+	// 云数据库实例依赖VPC、子网和安全组
+	if hitErr = SyncDatabaseInstance(kt, cliSet, opt.AccountID, resourceGroupNames, sd); hitErr != nil {
+		return enumor.DatabaseInstanceCloudResType, hitErr
+	}
+
+	if hitErr = SyncBucket(kt, cliSet, opt.AccountID, resourceGroupNames, sd); hitErr != nil {
+		return enumor.BucketCloudResType, hitErr
+	}
+
+	// 容器集群节点依赖主机
+	if hitErr = SyncK8sCluster(kt, cliSet, opt.AccountID, resourceGroupNames, sd); hitErr != nil {
+		return enumor.K8sClusterCloudResType, hitErr
+	}
+
+	if hitErr = SyncKeyPair(kt, cliSet, opt.AccountID, resourceGroupNames, sd); hitErr != nil {
+		return enumor.KeyPairCloudResType, hitErr
+	}
+
+	// 私有域关联VPC，在VPC之后同步
+	if hitErr = SyncPrivateDnsZone(kt, cliSet, opt.AccountID, resourceGroupNames, sd); hitErr != nil {
+		return enumor.PrivateDnsZoneCloudResType, hitErr
+	}
+
 	if hitErr = SyncSubAccount(kt, cliSet, opt.AccountID, sd); hitErr != nil {
 		return enumor.SubAccountCloudResType, hitErr
 	}
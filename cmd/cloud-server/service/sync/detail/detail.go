@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"hcm/pkg/api/core"
+	coresync "hcm/pkg/api/core/cloud/sync"
 	dssync "hcm/pkg/api/data-service/cloud/sync"
 	dataservice "hcm/pkg/client/data-service"
 	"hcm/pkg/criteria/enumor"
@@ -32,6 +33,7 @@ import (
 	"hcm/pkg/dal/table/types"
 	"hcm/pkg/kit"
 	"hcm/pkg/runtime/filter"
+	"hcm/pkg/tools/json"
 	ttimes "hcm/pkg/tools/times"
 )
 
@@ -66,6 +68,52 @@ func (s *SyncDetail) ResSyncStatusSuccess(resName enumor.CloudResourceType) erro
 	return nil
 }
 
+// ResSyncStatusSuccessWithWatermark 资源同步成功，并记录资源在各地域的增量同步水位
+func (s *SyncDetail) ResSyncStatusSuccessWithWatermark(resName enumor.CloudResourceType,
+	watermark map[string]time.Time) error {
+
+	marks := make(map[string]string, len(watermark))
+	for region, mark := range watermark {
+		marks[region] = mark.UTC().Format(time.RFC3339)
+	}
+
+	field, err := types.NewJsonField(marks)
+	if err != nil {
+		return err
+	}
+
+	s.ResStatus = string(enumor.SyncSuccess)
+	return s.saveResSyncDetail(resName, nil, field)
+}
+
+// ResWatermark 查询资源在各地域的增量同步水位，未记录过水位的地域不在返回结果中
+func (s *SyncDetail) ResWatermark(resName enumor.CloudResourceType) (map[string]time.Time, error) {
+	syncDetail, err := s.getResSyncDetail(resName)
+	if err != nil {
+		return nil, err
+	}
+
+	watermark := make(map[string]time.Time)
+	if syncDetail == nil || syncDetail.ResWatermark.IsEmpty() {
+		return watermark, nil
+	}
+
+	marks := make(map[string]string)
+	if err = json.UnmarshalFromString(string(syncDetail.ResWatermark), &marks); err != nil {
+		return nil, fmt.Errorf("unmarshal %s watermark failed, err: %v", resName, err)
+	}
+
+	for region, mark := range marks {
+		t, err := time.Parse(time.RFC3339, mark)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s watermark of region %s failed, err: %v", resName, region, err)
+		}
+		watermark[region] = t
+	}
+
+	return watermark, nil
+}
+
 // ResSyncStatusSyncing ...
 func (s *SyncDetail) ResSyncStatusSyncing(resName enumor.CloudResourceType) error {
 
@@ -78,17 +126,10 @@ func (s *SyncDetail) ResSyncStatusSyncing(resName enumor.CloudResourceType) erro
 }
 
 func (s *SyncDetail) changeResSyncStatus(resName enumor.CloudResourceType, failedErr error) error {
+	return s.saveResSyncDetail(resName, failedErr, "")
+}
 
-	failedString := types.JsonField("")
-	if failedErr != nil {
-		if ef := errf.Error(failedErr); ef != nil && ef.Code == errf.Unknown {
-			// 对于未知错误，直接给Message
-			failedString, _ = types.NewJsonField(ef.Message)
-		} else {
-			failedString, _ = types.NewJsonField(failedErr)
-		}
-	}
-
+func (s *SyncDetail) getResSyncDetail(resName enumor.CloudResourceType) (*coresync.AccountSyncDetailTable, error) {
 	listReq := &core.ListReq{
 		Filter: &filter.Expression{
 			Op: filter.And,
@@ -114,14 +155,40 @@ func (s *SyncDetail) changeResSyncStatus(resName enumor.CloudResourceType, faile
 	}
 	accountSyncDetail, err := s.DataCli.Global.AccountSyncDetail.List(s.Kt, listReq)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if len(accountSyncDetail.Details) > 1 {
-		return fmt.Errorf("%s sync detail can not big than 1", s.AccountID)
+		return nil, fmt.Errorf("%s sync detail can not big than 1", s.AccountID)
 	}
 
 	if len(accountSyncDetail.Details) == 0 {
+		return nil, nil
+	}
+
+	return &accountSyncDetail.Details[0], nil
+}
+
+// saveResSyncDetail 保存资源同步详情，watermark 为空时不更新同步水位
+func (s *SyncDetail) saveResSyncDetail(resName enumor.CloudResourceType, failedErr error,
+	watermark types.JsonField) error {
+
+	failedString := types.JsonField("")
+	if failedErr != nil {
+		if ef := errf.Error(failedErr); ef != nil && ef.Code == errf.Unknown {
+			// 对于未知错误，直接给Message
+			failedString, _ = types.NewJsonField(ef.Message)
+		} else {
+			failedString, _ = types.NewJsonField(failedErr)
+		}
+	}
+
+	syncDetail, err := s.getResSyncDetail(resName)
+	if err != nil {
+		return err
+	}
+
+	if syncDetail == nil {
 		// 不存在则新增
 		createReq := &dssync.CreateReq{
 			Items: []dssync.CreateField{
@@ -132,6 +199,7 @@ func (s *SyncDetail) changeResSyncStatus(resName enumor.CloudResourceType, faile
 					ResStatus:       s.ResStatus,
 					ResEndTime:      ttimes.ConvStdTimeFormat(time.Now()),
 					ResFailedReason: failedString,
+					ResWatermark:    watermark,
 				},
 			},
 		}
@@ -144,10 +212,11 @@ func (s *SyncDetail) changeResSyncStatus(resName enumor.CloudResourceType, faile
 		updateReq := &dssync.UpdateReq{
 			Items: []dssync.UpdateField{
 				{
-					ID:              accountSyncDetail.Details[0].ID,
+					ID:              syncDetail.ID,
 					ResStatus:       s.ResStatus,
 					ResEndTime:      ttimes.ConvStdTimeFormat(time.Now()),
 					ResFailedReason: failedString,
+					ResWatermark:    watermark,
 				},
 			},
 		}
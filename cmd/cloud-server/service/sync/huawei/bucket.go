@@ -0,0 +1,77 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package huawei
+
+import (
+	"time"
+
+	"hcm/cmd/cloud-server/service/sync/detail"
+	"hcm/pkg/adaptor/huawei"
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/client"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+)
+
+// SyncBucket 同步存储桶，存储桶不区分地域，按账号同步，使用账号可用的任一地域查询存储桶列表
+func SyncBucket(kt *kit.Kit, cliSet *client.ClientSet, accountID string, sd *detail.SyncDetail) error {
+
+	// 重新设置rid方便定位
+	kt = kt.NewSubKit()
+
+	start := time.Now()
+	logs.V(3).Infof("huawei account[%s] sync bucket start, time: %v, rid: %s", accountID, start, kt.Rid)
+
+	// 同步中
+	if err := sd.ResSyncStatusSyncing(enumor.BucketCloudResType); err != nil {
+		return err
+	}
+
+	defer func() {
+		logs.V(3).Infof("huawei account[%s] sync bucket end, cost: %v, rid: %s", accountID, time.Since(start), kt.Rid)
+	}()
+
+	regions, err := ListRegionByService(kt, cliSet.DataService(), huawei.Ecs)
+	if err != nil {
+		logs.Errorf("sync huawei list region failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	if len(regions) == 0 {
+		return errf.Newf(errf.RecordNotFound, "huawei account: %s has no available region", accountID)
+	}
+
+	req := &sync.HuaWeiSyncReq{
+		AccountID: accountID,
+		Region:    regions[0],
+	}
+	if err := cliSet.HCService().HuaWei.Bucket.SyncBucket(kt, req); err != nil {
+		logs.Errorf("sync huawei bucket failed, err: %v, req: %v, rid: %s", err, req, kt.Rid)
+		return err
+	}
+
+	// 同步成功
+	if err := sd.ResSyncStatusSuccess(enumor.BucketCloudResType); err != nil {
+		return err
+	}
+
+	return nil
+}
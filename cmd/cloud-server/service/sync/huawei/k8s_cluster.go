@@ -0,0 +1,77 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package huawei
+
+import (
+	"time"
+
+	"hcm/cmd/cloud-server/service/sync/detail"
+	"hcm/pkg/adaptor/huawei"
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/client"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+)
+
+// SyncK8sCluster 同步容器集群
+func SyncK8sCluster(kt *kit.Kit, cliSet *client.ClientSet, accountID string, sd *detail.SyncDetail) error {
+
+	// 重新设置rid方便定位
+	kt = kt.NewSubKit()
+
+	start := time.Now()
+	logs.V(3).Infof("huawei account[%s] sync k8s cluster start, time: %v, rid: %s", accountID, start, kt.Rid)
+
+	// 同步中
+	if err := sd.ResSyncStatusSyncing(enumor.K8sClusterCloudResType); err != nil {
+		return err
+	}
+
+	defer func() {
+		logs.V(3).Infof("huawei account[%s] sync k8s cluster end, cost: %v, rid: %s", accountID,
+			time.Since(start), kt.Rid)
+	}()
+
+	// 容器集群节点依赖主机，与VPC同步使用相同的地域
+	regions, err := ListRegionByService(kt, cliSet.DataService(), huawei.Vpc)
+	if err != nil {
+		logs.Errorf("sync huawei list region failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	for _, region := range regions {
+		req := &sync.HuaWeiSyncReq{
+			AccountID: accountID,
+			Region:    region,
+		}
+		if err = cliSet.HCService().HuaWei.K8sCluster.SyncK8sCluster(kt, req); err != nil {
+			logs.Errorf("sync huawei k8s cluster failed, err: %v, req: %v, rid: %s", err, req, kt.Rid)
+			return err
+		}
+	}
+
+	// 同步成功
+	if err = sd.ResSyncStatusSuccess(enumor.K8sClusterCloudResType); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -91,17 +91,25 @@ func SyncAllResource(kt *kit.Kit, cliSet *client.ClientSet,
 	}
 
 	syncFuncMap := map[enumor.CloudResourceType]ResSyncFunc{
-		enumor.DiskCloudResType:          SyncDisk,
-		enumor.VpcCloudResType:           SyncVpc,
-		enumor.SubnetCloudResType:        SyncSubnet,
-		enumor.EipCloudResType:           SyncEip,
-		enumor.ArgumentTemplateResType:   SyncArgsTpl,
-		enumor.SecurityGroupCloudResType: SyncSG,
-		enumor.CvmCloudResType:           SyncCvm,
-		enumor.CertCloudResType:          SyncCert,
-		enumor.LoadBalancerCloudResType:  SyncLoadBalancer,
-		enumor.RouteTableCloudResType:    SyncRouteTable,
-		enumor.SubAccountCloudResType:    SyncSubAccount,
+		enumor.DiskCloudResType:             SyncDisk,
+		enumor.VpcCloudResType:              SyncVpc,
+		enumor.SubnetCloudResType:           SyncSubnet,
+		enumor.EipCloudResType:              SyncEip,
+		enumor.ArgumentTemplateResType:      SyncArgsTpl,
+		enumor.SecurityGroupCloudResType:    SyncSG,
+		enumor.CvmCloudResType:              SyncCvm,
+		enumor.SnapshotCloudResType:         SyncSnapshot,
+		enumor.CertCloudResType:             SyncCert,
+		enumor.LoadBalancerCloudResType:     SyncLoadBalancer,
+		enumor.NatGatewayCloudResType:       SyncNatGateway,
+		enumor.VpcPeeringCloudResType:       SyncVpcPeering,
+		enumor.RouteTableCloudResType:       SyncRouteTable,
+		enumor.DatabaseInstanceCloudResType: SyncDatabaseInstance,
+		enumor.BucketCloudResType:           SyncBucket,
+		enumor.K8sClusterCloudResType:       SyncK8sCluster,
+		enumor.KeyPairCloudResType:          SyncKeyPair,
+		enumor.PrivateDnsZoneCloudResType:   SyncPrivateDnsZone,
+		enumor.SubAccountCloudResType:       SyncSubAccount,
 	}
 
 	for _, resType := range getSyncOrder() {
@@ -122,9 +130,22 @@ func getSyncOrder() []enumor.CloudResourceType {
 		enumor.ArgumentTemplateResType,
 		enumor.SecurityGroupCloudResType,
 		enumor.CvmCloudResType,
+		// 快照依赖云盘和主机的关联关系，在主机之后同步
+		enumor.SnapshotCloudResType,
 		enumor.CertCloudResType,
 		enumor.LoadBalancerCloudResType,
+		// 路由下一跳依赖NAT网关和对等连接，在路由表之前同步
+		enumor.NatGatewayCloudResType,
+		enumor.VpcPeeringCloudResType,
 		enumor.RouteTableCloudResType,
+		// 云数据库实例依赖VPC、子网和安全组
+		enumor.DatabaseInstanceCloudResType,
+		enumor.BucketCloudResType,
+		// 容器集群节点依赖主机
+		enumor.K8sClusterCloudResType,
+		enumor.KeyPairCloudResType,
+		// 私有域关联VPC，在VPC之后同步
+		enumor.PrivateDnsZoneCloudResType,
 		enumor.SubAccountCloudResType,
 	}
 }
@@ -0,0 +1,105 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tcloud
+
+import (
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/client"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncTargetResourceOption ...
+type SyncTargetResourceOption struct {
+	AccountID string                   `json:"account_id" validate:"required"`
+	Region    string                   `json:"region" validate:"required"`
+	ResType   enumor.CloudResourceType `json:"res_type" validate:"required"`
+	CloudIDs  []string                 `json:"cloud_ids" validate:"required,min=1"`
+}
+
+// Validate SyncTargetResourceOption
+func (opt *SyncTargetResourceOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// targetResSyncFunc 指定云ID的资源同步函数，请求中云ID为空时同步该地域下全量资源
+type targetResSyncFunc func(kt *kit.Kit, cliSet *client.ClientSet, req *sync.TCloudSyncReq) error
+
+// targetResSyncFuncMap 支持指定云ID同步的资源类型
+var targetResSyncFuncMap = map[enumor.CloudResourceType]targetResSyncFunc{
+	enumor.CvmCloudResType: func(kt *kit.Kit, cliSet *client.ClientSet, req *sync.TCloudSyncReq) error {
+		return cliSet.HCService().TCloud.Cvm.SyncCvmWithRelResource(kt.Ctx, kt.Header(), req)
+	},
+	enumor.DiskCloudResType: func(kt *kit.Kit, cliSet *client.ClientSet, req *sync.TCloudSyncReq) error {
+		return cliSet.HCService().TCloud.Disk.SyncDisk(kt.Ctx, kt.Header(), req)
+	},
+	enumor.EipCloudResType: func(kt *kit.Kit, cliSet *client.ClientSet, req *sync.TCloudSyncReq) error {
+		return cliSet.HCService().TCloud.Eip.SyncEip(kt.Ctx, kt.Header(), req)
+	},
+	enumor.VpcCloudResType: func(kt *kit.Kit, cliSet *client.ClientSet, req *sync.TCloudSyncReq) error {
+		return cliSet.HCService().TCloud.Vpc.SyncVpc(kt.Ctx, kt.Header(), req)
+	},
+	enumor.SubnetCloudResType: func(kt *kit.Kit, cliSet *client.ClientSet, req *sync.TCloudSyncReq) error {
+		return cliSet.HCService().TCloud.Subnet.SyncSubnet(kt.Ctx, kt.Header(), req)
+	},
+	enumor.SecurityGroupCloudResType: func(kt *kit.Kit, cliSet *client.ClientSet, req *sync.TCloudSyncReq) error {
+		return cliSet.HCService().TCloud.SecurityGroup.SyncSecurityGroup(kt.Ctx, kt.Header(), req)
+	},
+	enumor.RouteTableCloudResType: func(kt *kit.Kit, cliSet *client.ClientSet, req *sync.TCloudSyncReq) error {
+		return cliSet.HCService().TCloud.RouteTable.SyncRouteTable(kt.Ctx, kt.Header(), req)
+	},
+	enumor.SnapshotCloudResType: func(kt *kit.Kit, cliSet *client.ClientSet, req *sync.TCloudSyncReq) error {
+		return cliSet.HCService().TCloud.Snapshot.SyncSnapshot(kt, req)
+	},
+	enumor.LoadBalancerCloudResType: func(kt *kit.Kit, cliSet *client.ClientSet, req *sync.TCloudSyncReq) error {
+		return cliSet.HCService().TCloud.Clb.SyncLoadBalancer(kt, req)
+	},
+}
+
+// SyncTargetResource 同步指定云ID的资源，用于资源变更事件触发的增量同步。
+func SyncTargetResource(kt *kit.Kit, cliSet *client.ClientSet, opt *SyncTargetResourceOption) error {
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	syncFunc, exists := targetResSyncFuncMap[opt.ResType]
+	if !exists {
+		return errf.Newf(errf.InvalidParameter, "tcloud %s not support sync by cloud ids", opt.ResType)
+	}
+
+	for _, cloudIDs := range slice.Split(opt.CloudIDs, constant.CloudResourceSyncMaxLimit) {
+		req := &sync.TCloudSyncReq{
+			AccountID: opt.AccountID,
+			Region:    opt.Region,
+			CloudIDs:  cloudIDs,
+		}
+		if err := syncFunc(kt, cliSet, req); err != nil {
+			logs.Errorf("sync tcloud target %s failed, err: %v, req: %v, rid: %s", opt.ResType, err, req, kt.Rid)
+			return err
+		}
+	}
+
+	return nil
+}
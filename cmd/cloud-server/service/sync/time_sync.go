@@ -29,6 +29,7 @@ import (
 	"hcm/pkg/api/core"
 	corecloud "hcm/pkg/api/core/cloud"
 	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/cc"
 	"hcm/pkg/client"
 	dataservice "hcm/pkg/client/data-service"
 	"hcm/pkg/criteria/enumor"
@@ -39,19 +40,27 @@ import (
 	"hcm/pkg/tools/retry"
 )
 
-// CloudResourceSync 定时同步云资源
-func CloudResourceSync(intervalMin time.Duration, sd serviced.ServiceDiscover, cliSet *client.ClientSet) {
-	logs.Infof("cloud resource sync enable, syncIntervalMin: %v", intervalMin)
+// CloudResourceSync 定时同步云资源。开启增量同步后，支持增量同步的云厂商在两次全量同步之间只进行增量同步，
+// 其他云厂商仍然每次都进行全量同步。
+func CloudResourceSync(opt cc.CloudResourceSync, sd serviced.ServiceDiscover, cliSet *client.ClientSet) {
+	intervalMin := time.Duration(opt.SyncIntervalMin) * time.Minute
+	fullSyncInterval := time.Duration(opt.FullSyncIntervalHour) * time.Hour
+	logs.Infof("cloud resource sync enable, syncIntervalMin: %v, incremental: %v, fullSyncInterval: %v",
+		intervalMin, opt.Incremental, fullSyncInterval)
 
+	// 上次全量同步的开始时间，进程重启或者重新成为主节点后先进行一次全量同步
+	var lastFullSyncTime time.Time
 	for {
 		time.Sleep(intervalMin)
 
 		if !sd.IsMaster() {
+			lastFullSyncTime = time.Time{}
 			continue
 		}
 
 		start := time.Now()
-		logs.Infof("cloud resource all sync start, time: %v", start)
+		incremental := opt.Incremental && !lastFullSyncTime.IsZero() && start.Sub(lastFullSyncTime) < fullSyncInterval
+		logs.Infof("cloud resource all sync start, incremental: %v, time: %v", incremental, start)
 
 		waitGroup := new(sync.WaitGroup)
 		syncers := account.GetAvailableVendorSyncers()
@@ -59,14 +68,23 @@ func CloudResourceSync(intervalMin time.Duration, sd serviced.ServiceDiscover, c
 		waitGroup.Add(len(syncers))
 		for _, vendorSyncer := range syncers {
 			go func(vendor account.VendorSyncer) {
+				defer waitGroup.Done()
+
+				if incrSyncer, ok := vendor.(account.IncrementalSyncer); incremental && ok {
+					allAccountIncrementalSync(core.NewBackendKit(), cliSet, vendor.Vendor(), incrSyncer)
+					return
+				}
 				allAccountSync(core.NewBackendKit(), cliSet, vendor)
-				waitGroup.Done()
 			}(vendorSyncer)
 		}
 
 		waitGroup.Wait()
 
-		logs.Infof("cloud resource all sync end, time: %v", start)
+		if !incremental {
+			lastFullSyncTime = start
+		}
+
+		logs.Infof("cloud resource all sync end, incremental: %v, time: %v", incremental, start)
 	}
 }
 
@@ -128,6 +146,61 @@ func allAccountSync(kt *kit.Kit, cliSet *client.ClientSet, syncer account.Vendor
 	}
 }
 
+// allAccountIncrementalSync all account incremental sync.
+func allAccountIncrementalSync(kt *kit.Kit, cliSet *client.ClientSet, vendor enumor.Vendor,
+	syncer account.IncrementalSyncer) {
+
+	startTime := time.Now()
+	logs.Infof("%s start incremental sync all cloud resource, time: %v, rid: %s", vendor, startTime, kt.Rid)
+
+	defer func() {
+		logs.Infof("%s incremental sync all cloud resource end, cost: %v, rid: %s", vendor, time.Since(startTime),
+			kt.Rid)
+	}()
+
+	listReq := &protocloud.AccountListReq{
+		Filter: &filter.Expression{Op: filter.And, Rules: []filter.RuleFactory{
+			&filter.AtomRule{Field: "vendor", Op: filter.Equal.Factory(), Value: vendor},
+			&filter.AtomRule{Field: "type", Op: filter.Equal.Factory(), Value: enumor.ResourceAccount}}},
+		Page: &core.BasePage{Start: 0, Limit: core.DefaultMaxPageLimit},
+	}
+	start := uint32(0)
+	for {
+		listReq.Page.Start = start
+		accounts, err := listAccountWithRetry(kt, cliSet.DataService(), listReq)
+		if err != nil {
+			logs.Errorf("list account failed, err: %v, rid: %s", err, kt.Rid)
+			break
+		}
+
+		for _, acc := range accounts {
+			resName, err := syncer.SyncIncrementalResource(kt, cliSet, acc.ID)
+			if err != nil {
+				if resName != "" {
+					sd := &detail.SyncDetail{
+						Kt:        kt,
+						DataCli:   cliSet.DataService(),
+						AccountID: acc.ID,
+						Vendor:    string(acc.Vendor),
+					}
+					if err := sd.ResSyncStatusFailed(resName, err); err != nil {
+						logs.Errorf("%s sync %s res detail failed, err: %v, accountID: %s, rid: %s", vendor, resName,
+							err, acc.ID, kt.Rid)
+					}
+				}
+				logs.Errorf("incremental sync %s all resource failed, err: %v, accountID: %s, rid: %s", vendor, err,
+					acc.ID, kt.Rid)
+				// 跳过当前账号
+				continue
+			}
+		}
+		if len(accounts) < int(core.DefaultMaxPageLimit) {
+			break
+		}
+		start += uint32(core.DefaultMaxPageLimit)
+	}
+}
+
 const maxRetryCount = 3
 
 // listAccountWithRetry 查询账号列表，最多重试3次，每次等待
@@ -134,6 +134,11 @@ func (svc *vpcSvc) createTCloudVpc(kt *kit.Kit, body json.RawMessage) (interface
 	if err := req.Validate(false); err != nil {
 		return nil, errf.NewFromErr(errf.InvalidParameter, err)
 	}
+
+	// 从IP地址池分配网段需要在申请单交付时进行，直接创建不支持
+	if len(req.IpamPoolID) != 0 {
+		return nil, errf.New(errf.InvalidParameter, "ipam_pool_id is only supported in application")
+	}
 	// 转换参数并调用HCService进行创建流程
 	result, err := svc.client.HCService().TCloud.Vpc.Create(kt.Ctx, kt.Header(), common.ConvTCloudVpcCreateReq(req))
 	if err != nil {
@@ -155,6 +160,11 @@ func (svc *vpcSvc) createAzureVpc(kt *kit.Kit, body json.RawMessage) (interface{
 		return nil, errf.NewFromErr(errf.InvalidParameter, err)
 	}
 
+	// 从IP地址池分配网段需要在申请单交付时进行，直接创建不支持
+	if len(req.IpamPoolID) != 0 {
+		return nil, errf.New(errf.InvalidParameter, "ipam_pool_id is only supported in application")
+	}
+
 	result, err := svc.client.HCService().Azure.Vpc.Create(kt.Ctx, kt.Header(), common.ConvAzureVpcCreateReq(req))
 	if err != nil {
 		logs.Errorf("batch create azure vpc failed, err: %v, result: %v, rid: %s", err, result, kt.Rid)
@@ -175,6 +185,11 @@ func (svc *vpcSvc) createHuaWeiVpc(kt *kit.Kit, body json.RawMessage) (interface
 		return nil, errf.NewFromErr(errf.InvalidParameter, err)
 	}
 
+	// 从IP地址池分配网段需要在申请单交付时进行，直接创建不支持
+	if len(req.IpamPoolID) != 0 {
+		return nil, errf.New(errf.InvalidParameter, "ipam_pool_id is only supported in application")
+	}
+
 	result, err := svc.client.HCService().HuaWei.Vpc.Create(kt.Ctx, kt.Header(),
 		common.ConvHuaWeiVpcCreateReq(req))
 	if err != nil {
@@ -196,6 +211,11 @@ func (svc *vpcSvc) createGcpVpc(kt *kit.Kit, body json.RawMessage) (interface{},
 		return nil, errf.NewFromErr(errf.InvalidParameter, err)
 	}
 
+	// 从IP地址池分配网段需要在申请单交付时进行，直接创建不支持
+	if len(req.IpamPoolID) != 0 {
+		return nil, errf.New(errf.InvalidParameter, "ipam_pool_id is only supported in application")
+	}
+
 	result, err := svc.client.HCService().Gcp.Vpc.Create(kt.Ctx, kt.Header(), common.ConvGcpVpcCreateReq(req))
 	if err != nil {
 		logs.Errorf("batch create gcp vpc failed, err: %v, result: %v, rid: %s", err, result, kt.Rid)
@@ -216,6 +236,11 @@ func (svc *vpcSvc) createAwsVpc(kt *kit.Kit, body json.RawMessage) (interface{},
 		return nil, errf.NewFromErr(errf.InvalidParameter, err)
 	}
 
+	// 从IP地址池分配网段需要在申请单交付时进行，直接创建不支持
+	if len(req.IpamPoolID) != 0 {
+		return nil, errf.New(errf.InvalidParameter, "ipam_pool_id is only supported in application")
+	}
+
 	result, err := svc.client.HCService().Aws.Vpc.Create(kt.Ctx, kt.Header(), common.ConvAwsVpcCreateReq(req))
 	if err != nil {
 		logs.Errorf("batch create aws vpc failed, err: %v, result: %v, rid: %s", err, result, kt.Rid)
@@ -49,6 +49,8 @@ func (svc *service) CreateMainAccount(cts *rest.Contexts) (interface{}, error) {
 		err    error
 	)
 	switch vendor {
+	case enumor.TCloud:
+		result, err = createAccount[dataproto.TCloudMainAccountExtensionCreateReq](vendor, svc, cts)
 	case enumor.Aws:
 		result, err = createAccount[dataproto.AwsMainAccountExtensionCreateReq](vendor, svc, cts)
 	case enumor.Gcp:
@@ -119,6 +119,8 @@ func (svc *service) GetMainAccount(cts *rest.Contexts) (interface{}, error) {
 	// 转换为最终的数据结构
 	var account interface{}
 	switch vendor {
+	case enumor.TCloud:
+		account, err = convertToMainAccountResult[protocore.TCloudMainAccountExtension](baseAccount, dbAccount.Extension, svc)
 	case enumor.Aws:
 		account, err = convertToMainAccountResult[protocore.AwsMainAccountExtension](baseAccount, dbAccount.Extension, svc)
 	case enumor.Gcp:
@@ -49,6 +49,8 @@ func (svc *service) CreateRootAccount(cts *rest.Contexts) (interface{}, error) {
 		err    error
 	)
 	switch vendor {
+	case enumor.TCloud:
+		result, err = createAccount[dataproto.TCloudRootAccountExtensionCreateReq](vendor, svc, cts)
 	case enumor.Aws:
 		result, err = createAccount[dataproto.AwsRootAccountExtensionCreateReq](vendor, svc, cts)
 	case enumor.Gcp:
@@ -107,6 +107,8 @@ func (svc *service) GetRootAccount(cts *rest.Contexts) (interface{}, error) {
 	// 转换为最终的数据结构
 	var account interface{}
 	switch vendor {
+	case enumor.TCloud:
+		account, err = convertToRootAccountResult[protocore.TCloudRootAccountExtension](baseAccount, dbAccount.Extension, svc)
 	case enumor.Aws:
 		account, err = convertToRootAccountResult[protocore.AwsRootAccountExtension](baseAccount, dbAccount.Extension, svc)
 	case enumor.Gcp:
@@ -44,6 +44,8 @@ func (svc *service) UpdateRootAccount(cts *rest.Contexts) (interface{}, error) {
 	accountID := cts.PathParameter("account_id").String()
 
 	switch vendor {
+	case enumor.TCloud:
+		return updateRootAccount[dataproto.TCloudRootAccountExtensionUpdateReq](accountID, svc, cts)
 	case enumor.Aws:
 		return updateRootAccount[dataproto.AwsRootAccountExtensionUpdateReq](accountID, svc, cts)
 	case enumor.Gcp:
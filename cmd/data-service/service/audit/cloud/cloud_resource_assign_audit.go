@@ -118,6 +118,16 @@ func (ad Audit) buildAssignAuditInfo(kt *kit.Kit, resType enumor.AuditResourceTy
 		audits, err = ad.certAssignAuditBuild(kt, assigns)
 	case enumor.LoadBalancerAuditResType:
 		audits, err = ad.loadBalancer.LoadBalancerAssignAuditBuild(kt, assigns)
+	case enumor.DatabaseInstanceAuditResType:
+		audits, err = ad.databaseInstanceAssignAuditBuild(kt, assigns)
+	case enumor.BucketAuditResType:
+		audits, err = ad.bucketAssignAuditBuild(kt, assigns)
+	case enumor.K8sClusterAuditResType:
+		audits, err = ad.k8sClusterAssignAuditBuild(kt, assigns)
+	case enumor.KeyPairAuditResType:
+		audits, err = ad.keyPairAssignAuditBuild(kt, assigns)
+	case enumor.PrivateDnsZoneAuditResType:
+		audits, err = ad.privateDnsZoneAssignAuditBuild(kt, assigns)
 	default:
 		return nil, fmt.Errorf("cloud resource type: %s not support", resType)
 	}
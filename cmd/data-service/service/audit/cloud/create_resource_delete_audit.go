@@ -95,6 +95,10 @@ func (ad Audit) buildDeleteAuditInfo(kt *kit.Kit, resType enumor.AuditResourceTy
 		audits, err = ad.eipDeleteAuditBuild(kt, deletes)
 	case enumor.DiskAuditResType:
 		audits, err = ad.diskDeleteAuditBuild(kt, deletes)
+	case enumor.SnapshotAuditResType:
+		audits, err = ad.snapshotDeleteAuditBuild(kt, deletes)
+	case enumor.KeyPairAuditResType:
+		audits, err = ad.keyPairDeleteAuditBuild(kt, deletes)
 	case enumor.ArgumentTemplateAuditResType:
 		audits, err = ad.argsTplDeleteAuditBuild(kt, deletes)
 	case enumor.SslCertAuditResType:
@@ -109,6 +113,8 @@ func (ad Audit) buildDeleteAuditInfo(kt *kit.Kit, resType enumor.AuditResourceTy
 		audits, err = ad.listenerDeleteAuditBuild(kt, deletes)
 	case enumor.LoadBalancerAuditResType:
 		audits, err = ad.loadBalancer.LoadBalancerDeleteAuditBuild(kt, deletes)
+	case enumor.DatabaseInstanceAuditResType:
+		audits, err = ad.databaseInstanceDeleteAuditBuild(kt, deletes)
 
 	default:
 		return nil, fmt.Errorf("build delete audit cloud resource type: %s not support", resType)
@@ -0,0 +1,139 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package billbudget
+
+import (
+	"fmt"
+	"reflect"
+
+	"hcm/pkg/api/core"
+	dsbill "hcm/pkg/api/data-service/bill"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/orm"
+	tablebill "hcm/pkg/dal/table/bill"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/rest"
+	cvt "hcm/pkg/tools/converter"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// CreateBillBudget create account bill budget with options
+func (svc *service) CreateBillBudget(cts *rest.Contexts) (interface{}, error) {
+	req := new(dsbill.BatchBillBudgetCreateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	budgetList := make([]tablebill.AccountBillBudget, 0, len(req.Items))
+	for _, item := range req.Items {
+		thresholds, err := types.NewJsonField(item.Thresholds)
+		if err != nil {
+			return nil, errf.NewFromErr(errf.InvalidParameter, err)
+		}
+		receivers := item.Receivers
+		if receivers == nil {
+			receivers = make([]string, 0)
+		}
+		receiverField, err := types.NewJsonField(receivers)
+		if err != nil {
+			return nil, errf.NewFromErr(errf.InvalidParameter, err)
+		}
+		budgetList = append(budgetList, tablebill.AccountBillBudget{
+			Name:       item.Name,
+			Scope:      item.Scope,
+			ScopeID:    item.ScopeID,
+			Amount:     &types.Decimal{Decimal: item.Amount},
+			Thresholds: thresholds,
+			Receivers:  receiverField,
+			WebhookUrl: item.WebhookUrl,
+			Memo:       item.Memo,
+			Creator:    cts.Kit.User,
+			Reviser:    cts.Kit.User,
+		})
+	}
+
+	idList, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		ids, err := svc.dao.AccountBillBudget().CreateWithTx(cts.Kit, txn, budgetList)
+		if err != nil {
+			return nil, fmt.Errorf("create account bill budget failed, err: %v", err)
+		}
+		return ids, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	retList, ok := idList.([]string)
+	if !ok {
+		return nil, fmt.Errorf("create account bill budget but return ids type not []string, ids type: %v",
+			reflect.TypeOf(idList).String())
+	}
+
+	return &core.BatchCreateResult{IDs: retList}, nil
+}
+
+// CreateBillBudgetAlert create account bill budget alert with options
+func (svc *service) CreateBillBudgetAlert(cts *rest.Contexts) (interface{}, error) {
+	req := new(dsbill.BatchBillBudgetAlertCreateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	alertList := make([]tablebill.AccountBillBudgetAlert, 0, len(req.Items))
+	for _, item := range req.Items {
+		alertList = append(alertList, tablebill.AccountBillBudgetAlert{
+			BudgetID:    item.BudgetID,
+			BillYear:    item.BillYear,
+			BillMonth:   item.BillMonth,
+			AlertType:   item.AlertType,
+			Threshold:   item.Threshold,
+			Amount:      &types.Decimal{Decimal: item.Amount},
+			Cost:        &types.Decimal{Decimal: item.Cost},
+			NotifyState: enumor.BillBudgetNotifyPending,
+			NotifyCount: cvt.ValToPtr(0),
+			Creator:     cts.Kit.User,
+		})
+	}
+
+	idList, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		ids, err := svc.dao.AccountBillBudgetAlert().CreateWithTx(cts.Kit, txn, alertList)
+		if err != nil {
+			return nil, fmt.Errorf("create account bill budget alert failed, err: %v", err)
+		}
+		return ids, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	retList, ok := idList.([]string)
+	if !ok {
+		return nil, fmt.Errorf("create account bill budget alert but return ids type not []string, ids type: %v",
+			reflect.TypeOf(idList).String())
+	}
+
+	return &core.BatchCreateResult{IDs: retList}, nil
+}
@@ -0,0 +1,138 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package billbudget
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/api/core/bill"
+	dataproto "hcm/pkg/api/data-service/bill"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/types"
+	tablebill "hcm/pkg/dal/table/bill"
+	"hcm/pkg/rest"
+	cvt "hcm/pkg/tools/converter"
+	"hcm/pkg/tools/json"
+)
+
+// ListBillBudget list account bill budget with options
+func (svc *service) ListBillBudget(cts *rest.Contexts) (interface{}, error) {
+	req := new(dataproto.BillBudgetListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+	opt := &types.ListOption{
+		Filter: req.Filter,
+		Page:   req.Page,
+		Fields: req.Fields,
+	}
+
+	data, err := svc.dao.AccountBillBudget().List(cts.Kit, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]*bill.Budget, len(data.Details))
+	for indx := range data.Details {
+		details[indx], err = convBillBudget(&data.Details[indx])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &dataproto.BillBudgetListResult{Details: details, Count: data.Count}, nil
+}
+
+func convBillBudget(m *tablebill.AccountBillBudget) (*bill.Budget, error) {
+	budget := &bill.Budget{
+		ID:         m.ID,
+		Name:       m.Name,
+		Scope:      m.Scope,
+		ScopeID:    m.ScopeID,
+		Amount:     cvt.PtrToVal(m.Amount).Decimal,
+		WebhookUrl: m.WebhookUrl,
+		Memo:       m.Memo,
+		Revision: core.Revision{
+			Creator:   m.Creator,
+			Reviser:   m.Reviser,
+			CreatedAt: m.CreatedAt.String(),
+			UpdatedAt: m.UpdatedAt.String(),
+		},
+	}
+	if len(m.Thresholds) != 0 {
+		if err := json.UnmarshalFromString(string(m.Thresholds), &budget.Thresholds); err != nil {
+			return nil, fmt.Errorf("unmarshal budget %s thresholds failed, err: %v", m.ID, err)
+		}
+	}
+	if len(m.Receivers) != 0 {
+		if err := json.UnmarshalFromString(string(m.Receivers), &budget.Receivers); err != nil {
+			return nil, fmt.Errorf("unmarshal budget %s receivers failed, err: %v", m.ID, err)
+		}
+	}
+	return budget, nil
+}
+
+// ListBillBudgetAlert list account bill budget alert with options
+func (svc *service) ListBillBudgetAlert(cts *rest.Contexts) (interface{}, error) {
+	req := new(dataproto.BillBudgetAlertListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+	opt := &types.ListOption{
+		Filter: req.Filter,
+		Page:   req.Page,
+		Fields: req.Fields,
+	}
+
+	data, err := svc.dao.AccountBillBudgetAlert().List(cts.Kit, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]*bill.BudgetAlert, len(data.Details))
+	for indx, m := range data.Details {
+		details[indx] = &bill.BudgetAlert{
+			ID:          m.ID,
+			BudgetID:    m.BudgetID,
+			BillYear:    m.BillYear,
+			BillMonth:   m.BillMonth,
+			AlertType:   m.AlertType,
+			Threshold:   m.Threshold,
+			Amount:      cvt.PtrToVal(m.Amount).Decimal,
+			Cost:        cvt.PtrToVal(m.Cost).Decimal,
+			NotifyState: m.NotifyState,
+			NotifyCount: cvt.PtrToVal(m.NotifyCount),
+			Creator:     m.Creator,
+			CreatedAt:   m.CreatedAt.String(),
+			UpdatedAt:   m.UpdatedAt.String(),
+		}
+	}
+
+	return &dataproto.BillBudgetAlertListResult{Details: details, Count: data.Count}, nil
+}
@@ -0,0 +1,115 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package billbudget
+
+import (
+	"fmt"
+
+	dataservice "hcm/pkg/api/data-service/bill"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/orm"
+	"hcm/pkg/dal/dao/tools"
+	tablebill "hcm/pkg/dal/table/bill"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/rest"
+	"hcm/pkg/runtime/filter"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// UpdateBillBudget update account bill budget with options
+func (svc *service) UpdateBillBudget(cts *rest.Contexts) (interface{}, error) {
+	req := new(dataservice.BillBudgetUpdateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	budget := &tablebill.AccountBillBudget{
+		ID:         req.ID,
+		Name:       req.Name,
+		WebhookUrl: req.WebhookUrl,
+		Memo:       req.Memo,
+		Reviser:    cts.Kit.User,
+	}
+	if req.Amount != nil {
+		budget.Amount = &types.Decimal{Decimal: *req.Amount}
+	}
+	if len(req.Thresholds) != 0 {
+		thresholds, err := types.NewJsonField(req.Thresholds)
+		if err != nil {
+			return nil, errf.NewFromErr(errf.InvalidParameter, err)
+		}
+		budget.Thresholds = thresholds
+	}
+	if req.Receivers != nil {
+		receivers, err := types.NewJsonField(req.Receivers)
+		if err != nil {
+			return nil, errf.NewFromErr(errf.InvalidParameter, err)
+		}
+		budget.Receivers = receivers
+	}
+
+	_, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		if err := svc.dao.AccountBillBudget().UpdateByIDWithTx(cts.Kit, txn, budget.ID, budget); err != nil {
+			return nil, fmt.Errorf("update bill budget failed, err: %v", err)
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// UpdateBillBudgetAlert update notify state of account bill budget alert
+func (svc *service) UpdateBillBudgetAlert(cts *rest.Contexts) (interface{}, error) {
+	req := new(dataservice.BillBudgetAlertUpdateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	rules := []*filter.AtomRule{tools.RuleEqual("id", req.ID)}
+	if req.PreNotifyCount != nil {
+		rules = append(rules, tools.RuleEqual("notify_count", *req.PreNotifyCount))
+	}
+	alert := &tablebill.AccountBillBudgetAlert{
+		NotifyState: req.NotifyState,
+		NotifyCount: req.NotifyCount,
+	}
+	_, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		// 直接返回错误以保留记录不存在的错误码，调用方据此判断告警是否已被其他控制器抢占
+		return nil, svc.dao.AccountBillBudgetAlert().UpdateWithTx(cts.Kit, txn, tools.ExpressionAnd(rules...),
+			alert)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
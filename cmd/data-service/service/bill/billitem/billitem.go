@@ -38,6 +38,8 @@ func InitService(cap *capability.Capability) {
 	h.Add("ListBillItemExt", http.MethodPost, "/vendors/{vendor}/bills/items/list", svc.ListBillItemExt)
 	h.Add("ListBillItem", http.MethodPost, "/bills/items/list", svc.ListBillItem)
 	h.Add("ListBillItemRaw", http.MethodPost, "/bills/items/list_with_extension", svc.ListBillItemRaw)
+	h.Add("ListBillItemCostByResource", http.MethodPost, "/bills/items/cost_by_resource/list",
+		svc.ListBillItemCostByResource)
 
 	h.Add("CreateBillItem", http.MethodPost, "/vendors/{vendor}/bills/items/create", svc.CreateBillItem)
 	h.Add("CreateBillItemRaw", http.MethodPost, "/vendors/{vendor}/bills/rawitems/create", svc.CreateBillItemRaw)
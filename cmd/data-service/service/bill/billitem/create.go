@@ -56,6 +56,8 @@ func (svc *service) CreateBillItem(cts *rest.Contexts) (interface{}, error) {
 	}
 
 	switch vendor {
+	case enumor.TCloud:
+		return createBillItem[bill.TCloudBillItemExtension](cts, svc, vendor)
 	case enumor.Aws:
 		return createBillItem[bill.AwsBillItemExtension](cts, svc, vendor)
 	case enumor.HuaWei:
@@ -108,6 +110,10 @@ func createBillItem[E bill.BillItemExtension](cts *rest.Contexts, svc *service,
 				HcProductName: item.HcProductName,
 				ResAmount:     &types.Decimal{Decimal: item.ResAmount},
 				ResAmountUnit: item.ResAmountUnit,
+				CloudResID:    item.CloudResID,
+				ResType:       item.ResType,
+				ResID:         item.ResID,
+				ResBkBizID:    item.ResBkBizID,
 				Extension:     types.JsonField(extJson),
 			}
 			billItemTables = append(billItemTables, &billItem)
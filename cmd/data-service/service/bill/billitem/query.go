@@ -32,6 +32,7 @@ import (
 	tablebill "hcm/pkg/dal/table/bill"
 	"hcm/pkg/logs"
 	"hcm/pkg/rest"
+	cvt "hcm/pkg/tools/converter"
 	"hcm/pkg/tools/json"
 )
 
@@ -96,6 +97,43 @@ func (svc *service) ListBillItem(cts *rest.Contexts) (interface{}, error) {
 	return &dataproto.BillItemBaseListResult{Details: details, Count: data.Count}, nil
 }
 
+// ListBillItemCostByResource 按云资源汇总账单明细费用
+func (svc *service) ListBillItemCostByResource(cts *rest.Contexts) (any, error) {
+	req := new(dataproto.BillItemListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+	opt := &types.ListOption{
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+
+	data, err := svc.dao.AccountBillItem().ListCostByResource(cts.Kit, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]*bill.ResourceCost, len(data.Details))
+	for idx, d := range data.Details {
+		details[idx] = &bill.ResourceCost{
+			Vendor:     d.Vendor,
+			CloudResID: d.CloudResID,
+			ResType:    d.ResType,
+			ResID:      d.ResID,
+			ResBkBizID: d.ResBkBizID,
+			Currency:   d.Currency,
+			Cost:       cvt.PtrToVal(d.Cost).Decimal,
+			ItemCount:  d.ItemCount,
+		}
+	}
+
+	return &dataproto.BillItemResourceCostListResult{Details: details, Count: data.Count}, nil
+}
+
 // ListBillItemExt ...
 func (svc *service) ListBillItemExt(cts *rest.Contexts) (any, error) {
 
@@ -105,6 +143,8 @@ func (svc *service) ListBillItemExt(cts *rest.Contexts) (any, error) {
 	}
 
 	switch vendor {
+	case enumor.TCloud:
+		return listBillItemExt[bill.TCloudBillItemExtension](cts, svc, vendor)
 	case enumor.Aws:
 		return listBillItemExt[bill.AwsBillItemExtension](cts, svc, vendor)
 	case enumor.HuaWei:
@@ -187,6 +227,10 @@ func convBillItem(m *tablebill.AccountBillItem) *bill.BaseBillItem {
 		HcProductName: m.HcProductName,
 		ResAmount:     m.ResAmount.Decimal,
 		ResAmountUnit: m.ResAmountUnit,
+		CloudResID:    m.CloudResID,
+		ResType:       m.ResType,
+		ResID:         m.ResID,
+		ResBkBizID:    m.ResBkBizID,
 		Revision: &core.Revision{
 			CreatedAt: m.CreatedAt.String(),
 			UpdatedAt: m.UpdatedAt.String(),
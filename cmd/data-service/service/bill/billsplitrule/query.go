@@ -0,0 +1,97 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package billsplitrule
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/api/core/bill"
+	dataproto "hcm/pkg/api/data-service/bill"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/types"
+	tablebill "hcm/pkg/dal/table/bill"
+	"hcm/pkg/rest"
+	cvt "hcm/pkg/tools/converter"
+	"hcm/pkg/tools/json"
+)
+
+// ListBillSplitRule list account bill split rule with options
+func (svc *service) ListBillSplitRule(cts *rest.Contexts) (interface{}, error) {
+	req := new(dataproto.BillSplitRuleListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+	opt := &types.ListOption{
+		Filter: req.Filter,
+		Page:   req.Page,
+		Fields: req.Fields,
+	}
+
+	data, err := svc.dao.AccountBillSplitRule().List(cts.Kit, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]*bill.SplitRule, len(data.Details))
+	for indx := range data.Details {
+		details[indx], err = convBillSplitRule(&data.Details[indx])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &dataproto.BillSplitRuleListResult{Details: details, Count: data.Count}, nil
+}
+
+func convBillSplitRule(m *tablebill.AccountBillSplitRule) (*bill.SplitRule, error) {
+	rule := &bill.SplitRule{
+		ID:            m.ID,
+		Name:          m.Name,
+		Vendor:        m.Vendor,
+		RootAccountID: m.RootAccountID,
+		MainAccountID: m.MainAccountID,
+		Priority:      cvt.PtrToVal(m.Priority),
+		SplitType:     m.SplitType,
+		Memo:          m.Memo,
+		Revision: core.Revision{
+			Creator:   m.Creator,
+			Reviser:   m.Reviser,
+			CreatedAt: m.CreatedAt.String(),
+			UpdatedAt: m.UpdatedAt.String(),
+		},
+	}
+	if !m.MatchRule.IsEmpty() {
+		rule.Match = new(bill.SplitRuleMatch)
+		if err := json.UnmarshalFromString(string(m.MatchRule), rule.Match); err != nil {
+			return nil, fmt.Errorf("unmarshal split rule %s match failed, err: %v", m.ID, err)
+		}
+	}
+	if len(m.Targets) != 0 {
+		if err := json.UnmarshalFromString(string(m.Targets), &rule.Targets); err != nil {
+			return nil, fmt.Errorf("unmarshal split rule %s targets failed, err: %v", m.ID, err)
+		}
+	}
+	return rule, nil
+}
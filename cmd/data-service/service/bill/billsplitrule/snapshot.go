@@ -0,0 +1,164 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package billsplitrule
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/api/core/bill"
+	dsbill "hcm/pkg/api/data-service/bill"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/orm"
+	daotypes "hcm/pkg/dal/dao/types"
+	tablebill "hcm/pkg/dal/table/bill"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/json"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// CreateBillSplitSnapshot create account bill split snapshot
+func (svc *service) CreateBillSplitSnapshot(cts *rest.Contexts) (interface{}, error) {
+	req := new(dsbill.BillSplitSnapshotCreateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	cvmCounts, err := types.NewJsonField(req.CvmCounts)
+	if err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+	snapshot := tablebill.AccountBillSplitSnapshot{
+		RootAccountID: req.RootAccountID,
+		MainAccountID: req.MainAccountID,
+		Vendor:        req.Vendor,
+		BillYear:      req.BillYear,
+		BillMonth:     req.BillMonth,
+		BillDay:       req.BillDay,
+		CvmCounts:     cvmCounts,
+		Creator:       cts.Kit.User,
+		Reviser:       cts.Kit.User,
+	}
+
+	idList, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		ids, err := svc.dao.AccountBillSplitSnapshot().CreateWithTx(cts.Kit, txn,
+			[]tablebill.AccountBillSplitSnapshot{snapshot})
+		if err != nil {
+			return nil, fmt.Errorf("create account bill split snapshot failed, err: %v", err)
+		}
+		return ids, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids, ok := idList.([]string)
+	if !ok || len(ids) != 1 {
+		return nil, fmt.Errorf("create account bill split snapshot but return ids is invalid, ids: %v", idList)
+	}
+
+	return &core.CreateResult{ID: ids[0]}, nil
+}
+
+// UpdateBillSplitSnapshot update account bill split snapshot
+func (svc *service) UpdateBillSplitSnapshot(cts *rest.Contexts) (interface{}, error) {
+	req := new(dsbill.BillSplitSnapshotUpdateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	cvmCounts, err := types.NewJsonField(req.CvmCounts)
+	if err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+	snapshot := &tablebill.AccountBillSplitSnapshot{
+		ID:        req.ID,
+		CvmCounts: cvmCounts,
+		Reviser:   cts.Kit.User,
+	}
+
+	_, err = svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		if err := svc.dao.AccountBillSplitSnapshot().UpdateByIDWithTx(cts.Kit, txn, req.ID, snapshot); err != nil {
+			return nil, fmt.Errorf("update bill split snapshot failed, err: %v", err)
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ListBillSplitSnapshot list account bill split snapshot
+func (svc *service) ListBillSplitSnapshot(cts *rest.Contexts) (interface{}, error) {
+	req := new(dsbill.BillSplitSnapshotListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &daotypes.ListOption{
+		Filter: req.Filter,
+		Page:   req.Page,
+		Fields: req.Fields,
+	}
+	data, err := svc.dao.AccountBillSplitSnapshot().List(cts.Kit, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]*bill.SplitSnapshot, len(data.Details))
+	for idx := range data.Details {
+		m := &data.Details[idx]
+		details[idx] = &bill.SplitSnapshot{
+			ID:            m.ID,
+			RootAccountID: m.RootAccountID,
+			MainAccountID: m.MainAccountID,
+			Vendor:        m.Vendor,
+			BillYear:      m.BillYear,
+			BillMonth:     m.BillMonth,
+			BillDay:       m.BillDay,
+			CvmCounts:     make(map[string]int64),
+			Revision: core.Revision{
+				Creator:   m.Creator,
+				Reviser:   m.Reviser,
+				CreatedAt: m.CreatedAt.String(),
+				UpdatedAt: m.UpdatedAt.String(),
+			},
+		}
+		if !m.CvmCounts.IsEmpty() {
+			if err := json.UnmarshalFromString(string(m.CvmCounts), &details[idx].CvmCounts); err != nil {
+				return nil, fmt.Errorf("unmarshal split snapshot %s cvm counts failed, err: %v", m.ID, err)
+			}
+		}
+	}
+
+	return &dsbill.BillSplitSnapshotListResult{Details: details, Count: data.Count}, nil
+}
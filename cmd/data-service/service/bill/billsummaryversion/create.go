@@ -45,17 +45,22 @@ func (svc *service) CreateBillSummaryVersion(cts *rest.Contexts) (interface{}, e
 	}
 	id, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
 		summary := tablebill.AccountBillSummaryVersion{
-			FirstAccountID:  string(req.FirstAccountID),
-			SecondAccountID: string(req.SecondAccountID),
-			Vendor:          req.Vendor,
-			ProductID:       req.ProductID,
-			BkBizID:         req.BkBizID,
-			BillYear:        req.BillYear,
-			BillMonth:       req.BillMonth,
-			VersionID:       req.VersionID,
-			Currency:        req.Currency,
-			Cost:            &types.Decimal{Decimal: req.Cost},
-			RMBCost:         &types.Decimal{Decimal: req.RMBCost},
+			FirstAccountID:    string(req.FirstAccountID),
+			SecondAccountID:   string(req.SecondAccountID),
+			Vendor:            req.Vendor,
+			ProductID:         req.ProductID,
+			BkBizID:           req.BkBizID,
+			BillYear:          req.BillYear,
+			BillMonth:         req.BillMonth,
+			VersionID:         req.VersionID,
+			Currency:          req.Currency,
+			Cost:              &types.Decimal{Decimal: req.Cost},
+			RMBCost:           &types.Decimal{Decimal: req.RMBCost},
+			SplitRuleSnapshot: req.SplitRuleSnapshot,
+		}
+		// 快照为空时写入空数组，保证json字段合法
+		if summary.SplitRuleSnapshot.IsEmpty() {
+			summary.SplitRuleSnapshot = "[]"
 		}
 		ids, err := svc.dao.AccountBillSummaryVersion().CreateWithTx(
 			cts.Kit, txn, []tablebill.AccountBillSummaryVersion{
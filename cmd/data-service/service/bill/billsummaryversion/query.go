@@ -58,19 +58,20 @@ func (svc *service) ListBillSummaryVersion(cts *rest.Contexts) (interface{}, err
 
 func toProtoPullerResult(m *tablebill.AccountBillSummaryVersion) *dataproto.BillSummaryVersionResult {
 	return &dataproto.BillSummaryVersionResult{
-		ID:              m.ID,
-		FirstAccountID:  m.FirstAccountID,
-		SecondAccountID: m.SecondAccountID,
-		Vendor:          m.Vendor,
-		ProductID:       m.ProductID,
-		BkBizID:         m.BkBizID,
-		BillYear:        m.BillYear,
-		BillMonth:       m.BillMonth,
-		VersionID:       m.VersionID,
-		Currency:        m.Currency,
-		Cost:            m.Cost.Decimal,
-		RMBCost:         m.RMBCost.Decimal,
-		CreatedAt:       m.CreatedAt,
-		UpdatedAt:       m.UpdatedAt,
+		ID:                m.ID,
+		FirstAccountID:    m.FirstAccountID,
+		SecondAccountID:   m.SecondAccountID,
+		Vendor:            m.Vendor,
+		ProductID:         m.ProductID,
+		BkBizID:           m.BkBizID,
+		BillYear:          m.BillYear,
+		BillMonth:         m.BillMonth,
+		VersionID:         m.VersionID,
+		Currency:          m.Currency,
+		Cost:              m.Cost.Decimal,
+		RMBCost:           m.RMBCost.Decimal,
+		SplitRuleSnapshot: m.SplitRuleSnapshot,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
 	}
 }
@@ -54,6 +54,10 @@ func (svc *service) CreateAccount(cts *rest.Contexts) (interface{}, error) {
 		return createAccount[protocloud.GcpAccountExtensionCreateReq](vendor, svc, cts)
 	case enumor.Azure:
 		return createAccount[protocloud.AzureAccountExtensionCreateReq](vendor, svc, cts)
+	case enumor.Zenlayer:
+		return createAccount[protocloud.ZenlayerAccountExtensionCreateReq](vendor, svc, cts)
+	case enumor.Kaopu:
+		return createAccount[protocloud.KaopuAccountExtensionCreateReq](vendor, svc, cts)
 	default:
 		return nil, fmt.Errorf("unsupport %s vendor for now", vendor)
 	}
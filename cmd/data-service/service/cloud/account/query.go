@@ -126,6 +126,10 @@ func (svc *service) GetAccount(cts *rest.Contexts) (interface{}, error) {
 		account, err = convertToAccountResult[protocore.GcpAccountExtension](baseAccount, dbAccount.Extension, svc)
 	case enumor.Azure:
 		account, err = convertToAccountResult[protocore.AzureAccountExtension](baseAccount, dbAccount.Extension, svc)
+	case enumor.Zenlayer:
+		account, err = convertToAccountResult[protocore.ZenlayerAccountExtension](baseAccount, dbAccount.Extension, svc)
+	case enumor.Kaopu:
+		account, err = convertToAccountResult[protocore.KaopuAccountExtension](baseAccount, dbAccount.Extension, svc)
 	}
 
 	if err != nil {
@@ -357,6 +361,10 @@ func (svc *service) ListAccountWithExtension(cts *rest.Contexts) (interface{}, e
 			extension, err = convertToAccountExtension[protocore.GcpAccountExtension](account.Extension, svc)
 		case enumor.Azure:
 			extension, err = convertToAccountExtension[protocore.AzureAccountExtension](account.Extension, svc)
+		case enumor.Zenlayer:
+			extension, err = convertToAccountExtension[protocore.ZenlayerAccountExtension](account.Extension, svc)
+		case enumor.Kaopu:
+			extension, err = convertToAccountExtension[protocore.KaopuAccountExtension](account.Extension, svc)
 		}
 		if err != nil {
 			return nil, fmt.Errorf("json unmarshal extension to vendor extension failed, err: %v", err)
@@ -56,6 +56,10 @@ func (svc *service) UpdateAccount(cts *rest.Contexts) (interface{}, error) {
 		return updateAccount[protocloud.GcpAccountExtensionUpdateReq](accountID, svc, cts)
 	case enumor.Azure:
 		return updateAccount[protocloud.AzureAccountExtensionUpdateReq](accountID, svc, cts)
+	case enumor.Zenlayer:
+		return updateAccount[protocloud.ZenlayerAccountExtensionUpdateReq](accountID, svc, cts)
+	case enumor.Kaopu:
+		return updateAccount[protocloud.KaopuAccountExtensionUpdateReq](accountID, svc, cts)
 	}
 
 	return nil, nil
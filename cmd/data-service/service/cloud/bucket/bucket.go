@@ -0,0 +1,58 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package bucket 存储桶的DB接口
+package bucket
+
+import (
+	"net/http"
+
+	"hcm/cmd/data-service/service/capability"
+	"hcm/pkg/dal/dao"
+	"hcm/pkg/rest"
+)
+
+var svc *bucketSvc
+
+// InitService initial the bucket service
+func InitService(cap *capability.Capability) {
+	svc = &bucketSvc{
+		dao: cap.Dao,
+	}
+
+	h := rest.NewHandler()
+
+	h.Add("BatchCreateBucket", http.MethodPost, "/vendors/{vendor}/buckets/batch/create",
+		svc.BatchCreateBucket)
+	h.Add("ListBucket", http.MethodPost, "/buckets/list", svc.ListBucket)
+	h.Add("ListBucketExt", http.MethodPost, "/vendors/{vendor}/buckets/list",
+		svc.ListBucketExt)
+	h.Add("BatchUpdateBucketExt", http.MethodPatch, "/vendors/{vendor}/buckets",
+		svc.BatchUpdateBucketExt)
+	h.Add("BatchUpdateBucket", http.MethodPatch, "/buckets/batch/update",
+		svc.BatchUpdateBucket)
+	h.Add("BatchDeleteBucket", http.MethodDelete, "/buckets/batch",
+		svc.BatchDeleteBucket)
+
+	h.Load(cap.WebService)
+}
+
+type bucketSvc struct {
+	dao dao.Set
+}
@@ -0,0 +1,119 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bucket
+
+import (
+	"fmt"
+	"reflect"
+
+	"hcm/pkg/api/core"
+	corebucket "hcm/pkg/api/core/cloud/bucket"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/orm"
+	tablebucket "hcm/pkg/dal/table/cloud/bucket"
+	tabletype "hcm/pkg/dal/table/types"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/json"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// BatchCreateBucket batch create bucket.
+func (svc *bucketSvc) BatchCreateBucket(cts *rest.Contexts) (interface{}, error) {
+	vendor := enumor.Vendor(cts.PathParameter("vendor").String())
+	if err := vendor.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	switch vendor {
+	case enumor.TCloud:
+		return batchCreateBucket[corebucket.TCloudBucketExtension](cts, svc, vendor)
+	case enumor.Aws:
+		return batchCreateBucket[corebucket.AwsBucketExtension](cts, svc, vendor)
+	case enumor.Azure:
+		return batchCreateBucket[corebucket.AzureBucketExtension](cts, svc, vendor)
+	case enumor.Gcp:
+		return batchCreateBucket[corebucket.GcpBucketExtension](cts, svc, vendor)
+	case enumor.HuaWei:
+		return batchCreateBucket[corebucket.HuaWeiBucketExtension](cts, svc, vendor)
+	default:
+		return nil, errf.Newf(errf.InvalidParameter, "unsupported vendor: %s", vendor)
+	}
+}
+
+func batchCreateBucket[T corebucket.Extension](cts *rest.Contexts, svc *bucketSvc, vendor enumor.Vendor) (
+	interface{}, error) {
+
+	req := new(protocloud.BucketBatchCreateReq[T])
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	result, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		models := make([]*tablebucket.BucketTable, 0, len(req.Buckets))
+		for _, one := range req.Buckets {
+			extension, err := json.MarshalToString(one.Extension)
+			if err != nil {
+				return nil, errf.NewFromErr(errf.InvalidParameter, err)
+			}
+
+			models = append(models, &tablebucket.BucketTable{
+				Vendor:           vendor,
+				AccountID:        one.AccountID,
+				CloudID:          one.CloudID,
+				BkBizID:          one.BkBizID,
+				Name:             one.Name,
+				Region:           one.Region,
+				StorageClass:     one.StorageClass,
+				PublicAccess:     one.PublicAccess,
+				Encryption:       one.Encryption,
+				CloudCreatedTime: one.CloudCreatedTime,
+				Memo:             one.Memo,
+				Extension:        tabletype.JsonField(extension),
+				Creator:          cts.Kit.User,
+				Reviser:          cts.Kit.User,
+			})
+		}
+
+		ids, err := svc.dao.Bucket().BatchCreateWithTx(cts.Kit, txn, models)
+		if err != nil {
+			return nil, fmt.Errorf("batch create bucket failed, err: %v", err)
+		}
+
+		return ids, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids, ok := result.([]string)
+	if !ok {
+		return nil, fmt.Errorf("batch create bucket but return id type is not []string, id type: %v",
+			reflect.TypeOf(result).String())
+	}
+
+	return &core.BatchCreateResult{IDs: ids}, nil
+}
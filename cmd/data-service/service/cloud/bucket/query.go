@@ -0,0 +1,162 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bucket
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	corebucket "hcm/pkg/api/core/cloud/bucket"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/types"
+	tablebucket "hcm/pkg/dal/table/cloud/bucket"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/json"
+)
+
+// ListBucket list bucket.
+func (svc *bucketSvc) ListBucket(cts *rest.Contexts) (interface{}, error) {
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: req.Fields,
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	result, err := svc.dao.Bucket().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list bucket failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, fmt.Errorf("list bucket failed, err: %v", err)
+	}
+
+	if req.Page.Count {
+		return &protocloud.BucketListResult{Count: result.Count}, nil
+	}
+
+	details := make([]corebucket.BaseBucket, 0, len(result.Details))
+	for _, one := range result.Details {
+		details = append(details, *convTableToBaseBucket(&one))
+	}
+
+	return &protocloud.BucketListResult{Details: details}, nil
+}
+
+func convTableToBaseBucket(one *tablebucket.BucketTable) *corebucket.BaseBucket {
+	return &corebucket.BaseBucket{
+		ID:               one.ID,
+		Vendor:           one.Vendor,
+		AccountID:        one.AccountID,
+		CloudID:          one.CloudID,
+		BkBizID:          one.BkBizID,
+		Name:             one.Name,
+		Region:           one.Region,
+		StorageClass:     one.StorageClass,
+		PublicAccess:     one.PublicAccess,
+		Encryption:       one.Encryption,
+		CloudCreatedTime: one.CloudCreatedTime,
+		Memo:             one.Memo,
+		Revision: &core.Revision{
+			Creator:   one.Creator,
+			Reviser:   one.Reviser,
+			CreatedAt: one.CreatedAt.String(),
+			UpdatedAt: one.UpdatedAt.String(),
+		},
+	}
+}
+
+// ListBucketExt list bucket with extension.
+func (svc *bucketSvc) ListBucketExt(cts *rest.Contexts) (interface{}, error) {
+	vendor := enumor.Vendor(cts.Request.PathParameter("vendor"))
+	if err := vendor.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: req.Fields,
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	data, err := svc.dao.Bucket().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list bucket ext failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if req.Page.Count {
+		return &protocloud.BucketListResult{Count: data.Count}, nil
+	}
+
+	switch vendor {
+	case enumor.TCloud:
+		return convBucketExtListResult[corebucket.TCloudBucketExtension](cts.Kit, data.Details)
+	case enumor.Aws:
+		return convBucketExtListResult[corebucket.AwsBucketExtension](cts.Kit, data.Details)
+	case enumor.Azure:
+		return convBucketExtListResult[corebucket.AzureBucketExtension](cts.Kit, data.Details)
+	case enumor.Gcp:
+		return convBucketExtListResult[corebucket.GcpBucketExtension](cts.Kit, data.Details)
+	case enumor.HuaWei:
+		return convBucketExtListResult[corebucket.HuaWeiBucketExtension](cts.Kit, data.Details)
+	default:
+		return nil, errf.Newf(errf.InvalidParameter, "unsupported vendor: %s", vendor)
+	}
+}
+
+func convBucketExtListResult[T corebucket.Extension](kt *kit.Kit, tables []tablebucket.BucketTable) (
+	*protocloud.BucketExtListResult[T], error) {
+
+	details := make([]corebucket.Bucket[T], 0, len(tables))
+	for _, one := range tables {
+		extension := new(T)
+		if len(one.Extension) != 0 {
+			if err := json.UnmarshalFromString(string(one.Extension), extension); err != nil {
+				logs.Errorf("unmarshal bucket extension failed, err: %v, id: %s, rid: %s", err, one.ID, kt.Rid)
+				return nil, fmt.Errorf("unmarshal bucket extension failed, err: %v", err)
+			}
+		}
+
+		details = append(details, corebucket.Bucket[T]{
+			BaseBucket: *convTableToBaseBucket(&one),
+			Extension:  extension,
+		})
+	}
+
+	return &protocloud.BucketExtListResult[T]{Details: details}, nil
+}
@@ -0,0 +1,130 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bucket
+
+import (
+	"fmt"
+
+	corebucket "hcm/pkg/api/core/cloud/bucket"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/orm"
+	"hcm/pkg/dal/dao/tools"
+	tablebucket "hcm/pkg/dal/table/cloud/bucket"
+	tabletype "hcm/pkg/dal/table/types"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/json"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// BatchUpdateBucketExt batch update bucket with extension.
+func (svc *bucketSvc) BatchUpdateBucketExt(cts *rest.Contexts) (interface{}, error) {
+	vendor := enumor.Vendor(cts.Request.PathParameter("vendor"))
+	if err := vendor.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	switch vendor {
+	case enumor.TCloud:
+		return batchUpdateBucketExt[corebucket.TCloudBucketExtension](cts, svc)
+	case enumor.Aws:
+		return batchUpdateBucketExt[corebucket.AwsBucketExtension](cts, svc)
+	case enumor.Azure:
+		return batchUpdateBucketExt[corebucket.AzureBucketExtension](cts, svc)
+	case enumor.Gcp:
+		return batchUpdateBucketExt[corebucket.GcpBucketExtension](cts, svc)
+	case enumor.HuaWei:
+		return batchUpdateBucketExt[corebucket.HuaWeiBucketExtension](cts, svc)
+	default:
+		return nil, errf.Newf(errf.InvalidParameter, "unsupported vendor: %s", vendor)
+	}
+}
+
+func batchUpdateBucketExt[T corebucket.Extension](cts *rest.Contexts, svc *bucketSvc) (interface{}, error) {
+	req := new(protocloud.BucketExtBatchUpdateReq[T])
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	_, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		for _, item := range *req {
+			updateData := &tablebucket.BucketTable{
+				Name:         item.Name,
+				Region:       item.Region,
+				StorageClass: item.StorageClass,
+				PublicAccess: item.PublicAccess,
+				Encryption:   item.Encryption,
+				Memo:         item.Memo,
+				Reviser:      cts.Kit.User,
+			}
+
+			// 扩展字段全部来自云上配置，直接覆盖而不是合并，避免已关闭的公共访问、加密配置残留
+			if item.Extension != nil {
+				extension, err := json.MarshalToString(item.Extension)
+				if err != nil {
+					return nil, errf.NewFromErr(errf.InvalidParameter, err)
+				}
+				updateData.Extension = tabletype.JsonField(extension)
+			}
+
+			if err := svc.dao.Bucket().UpdateByIDWithTx(cts.Kit, txn, item.ID, updateData); err != nil {
+				return nil, fmt.Errorf("update bucket db failed, err: %v", err)
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		logs.Errorf("batch update bucket ext db failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// BatchUpdateBucket batch update bucket common fields.
+func (svc *bucketSvc) BatchUpdateBucket(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.BucketBatchUpdateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	updateData := &tablebucket.BucketTable{
+		BkBizID: req.BkBizID,
+		Reviser: cts.Kit.User,
+	}
+	if err := svc.dao.Bucket().Update(cts.Kit, tools.ContainersExpression("id", req.IDs),
+		updateData); err != nil {
+		logs.Errorf("batch update bucket failed, err: %v, ids: %v, rid: %s", err, req.IDs, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -177,6 +177,11 @@ var assignResAuditTypeMap = map[enumor.CloudResourceType]enumor.AuditResourceTyp
 	enumor.RouteTableCloudResType:       enumor.RouteTableAuditResType,
 	enumor.GcpFirewallRuleCloudResType:  enumor.GcpFirewallRuleAuditResType,
 	enumor.NetworkInterfaceCloudResType: enumor.NetworkInterfaceAuditResType,
+	enumor.DatabaseInstanceCloudResType: enumor.DatabaseInstanceAuditResType,
+	enumor.BucketCloudResType:           enumor.BucketAuditResType,
+	enumor.K8sClusterCloudResType:       enumor.K8sClusterAuditResType,
+	enumor.KeyPairCloudResType:          enumor.KeyPairAuditResType,
+	enumor.PrivateDnsZoneCloudResType:   enumor.PrivateDnsZoneAuditResType,
 }
 
 // AssignResourceToBiz assign an account's cloud resource to biz, **only for ui**.
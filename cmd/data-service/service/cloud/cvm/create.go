@@ -55,6 +55,10 @@ func (svc *cvmSvc) BatchCreateCvm(cts *rest.Contexts) (interface{}, error) {
 		return batchCreateCvm[corecvm.HuaWeiCvmExtension](cts, svc, vendor)
 	case enumor.Azure:
 		return batchCreateCvm[corecvm.AzureCvmExtension](cts, svc, vendor)
+	case enumor.Zenlayer:
+		return batchCreateCvm[corecvm.ZenlayerCvmExtension](cts, svc, vendor)
+	case enumor.Kaopu:
+		return batchCreateCvm[corecvm.KaopuCvmExtension](cts, svc, vendor)
 	case enumor.Gcp:
 		return batchCreateCvm[corecvm.GcpCvmExtension](cts, svc, vendor)
 	default:
@@ -81,6 +81,12 @@ func (svc *cvmSvc) BatchDeleteCvm(cts *rest.Contexts) (interface{}, error) {
 			}
 		}
 
+		// 主机删除后不再是集群节点，需要同步删除节点关联关系
+		relFilter := tools.ContainersExpression("cvm_id", delIDs)
+		if err := svc.dao.K8sNodePoolCvmRel().DeleteWithTx(cts.Kit, txn, relFilter); err != nil {
+			return nil, err
+		}
+
 		delFilter := tools.ContainersExpression("id", delIDs)
 		if err := svc.dao.Cvm().DeleteWithTx(cts.Kit, txn, delFilter); err != nil {
 			return nil, err
@@ -101,6 +101,10 @@ func (svc *cvmSvc) GetCvm(cts *rest.Contexts) (interface{}, error) {
 		return convCvmGetResult[corecvm.HuaWeiCvmExtension](base, cvmTable.Extension)
 	case enumor.Azure:
 		return convCvmGetResult[corecvm.AzureCvmExtension](base, cvmTable.Extension)
+	case enumor.Zenlayer:
+		return convCvmGetResult[corecvm.ZenlayerCvmExtension](base, cvmTable.Extension)
+	case enumor.Kaopu:
+		return convCvmGetResult[corecvm.KaopuCvmExtension](base, cvmTable.Extension)
 	case enumor.Gcp:
 		return convCvmGetResult[corecvm.GcpCvmExtension](base, cvmTable.Extension)
 
@@ -223,6 +227,10 @@ func (svc *cvmSvc) ListCvmExt(cts *rest.Contexts) (interface{}, error) {
 		return convCvmListResult[corecvm.HuaWeiCvmExtension](result.Details)
 	case enumor.Azure:
 		return convCvmListResult[corecvm.AzureCvmExtension](result.Details)
+	case enumor.Zenlayer:
+		return convCvmListResult[corecvm.ZenlayerCvmExtension](result.Details)
+	case enumor.Kaopu:
+		return convCvmListResult[corecvm.KaopuCvmExtension](result.Details)
 	case enumor.Gcp:
 		return convCvmListResult[corecvm.GcpCvmExtension](result.Details)
 
@@ -92,6 +92,12 @@ func SyncCvmToCmdb(kt *kit.Kit, accountID string, bkBizID int64) error {
 		case enumor.Azure:
 			err = upsertCmdbHosts[corecvm.AzureCvmExtension](svc, kt, enumor.Azure,
 				converter.SliceToPtr(result.Details))
+		case enumor.Zenlayer:
+			err = upsertCmdbHosts[corecvm.ZenlayerCvmExtension](svc, kt, enumor.Zenlayer,
+				converter.SliceToPtr(result.Details))
+		case enumor.Kaopu:
+			err = upsertCmdbHosts[corecvm.KaopuCvmExtension](svc, kt, enumor.Kaopu,
+				converter.SliceToPtr(result.Details))
 		}
 		if err != nil {
 			logs.Errorf("upsertCmdbHosts failed, err: %v, rid; %s", err, kt.Rid)
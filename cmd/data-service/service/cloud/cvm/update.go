@@ -56,6 +56,10 @@ func (svc *cvmSvc) BatchUpdateCvm(cts *rest.Contexts) (interface{}, error) {
 		return batchUpdateCvm[corecvm.HuaWeiCvmExtension](cts, svc, vendor)
 	case enumor.Azure:
 		return batchUpdateCvm[corecvm.AzureCvmExtension](cts, svc, vendor)
+	case enumor.Zenlayer:
+		return batchUpdateCvm[corecvm.ZenlayerCvmExtension](cts, svc, vendor)
+	case enumor.Kaopu:
+		return batchUpdateCvm[corecvm.KaopuCvmExtension](cts, svc, vendor)
 	case enumor.Gcp:
 		return batchUpdateCvm[corecvm.GcpCvmExtension](cts, svc, vendor)
 	default:
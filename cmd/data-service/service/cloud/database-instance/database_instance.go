@@ -0,0 +1,58 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package dbinstance 云数据库实例的DB接口
+package dbinstance
+
+import (
+	"net/http"
+
+	"hcm/cmd/data-service/service/capability"
+	"hcm/pkg/dal/dao"
+	"hcm/pkg/rest"
+)
+
+var svc *databaseInstanceSvc
+
+// InitService initial the database instance service
+func InitService(cap *capability.Capability) {
+	svc = &databaseInstanceSvc{
+		dao: cap.Dao,
+	}
+
+	h := rest.NewHandler()
+
+	h.Add("BatchCreateDatabaseInstance", http.MethodPost, "/vendors/{vendor}/database_instances/batch/create",
+		svc.BatchCreateDatabaseInstance)
+	h.Add("ListDatabaseInstance", http.MethodPost, "/database_instances/list", svc.ListDatabaseInstance)
+	h.Add("ListDatabaseInstanceExt", http.MethodPost, "/vendors/{vendor}/database_instances/list",
+		svc.ListDatabaseInstanceExt)
+	h.Add("BatchUpdateDatabaseInstanceExt", http.MethodPatch, "/vendors/{vendor}/database_instances",
+		svc.BatchUpdateDatabaseInstanceExt)
+	h.Add("BatchUpdateDatabaseInstance", http.MethodPatch, "/database_instances/batch/update",
+		svc.BatchUpdateDatabaseInstance)
+	h.Add("BatchDeleteDatabaseInstance", http.MethodDelete, "/database_instances/batch",
+		svc.BatchDeleteDatabaseInstance)
+
+	h.Load(cap.WebService)
+}
+
+type databaseInstanceSvc struct {
+	dao dao.Set
+}
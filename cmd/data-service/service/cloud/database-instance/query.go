@@ -0,0 +1,175 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dbinstance
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	coredbinstance "hcm/pkg/api/core/cloud/database-instance"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/types"
+	tabledbinstance "hcm/pkg/dal/table/cloud/database-instance"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/json"
+)
+
+// ListDatabaseInstance list database instance.
+func (svc *databaseInstanceSvc) ListDatabaseInstance(cts *rest.Contexts) (interface{}, error) {
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: req.Fields,
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	result, err := svc.dao.DatabaseInstance().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list database instance failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, fmt.Errorf("list database instance failed, err: %v", err)
+	}
+
+	if req.Page.Count {
+		return &protocloud.DatabaseInstanceListResult{Count: result.Count}, nil
+	}
+
+	details := make([]coredbinstance.BaseDatabaseInstance, 0, len(result.Details))
+	for _, one := range result.Details {
+		details = append(details, *convTableToBaseDatabaseInstance(&one))
+	}
+
+	return &protocloud.DatabaseInstanceListResult{Details: details}, nil
+}
+
+func convTableToBaseDatabaseInstance(one *tabledbinstance.DatabaseInstanceTable) *coredbinstance.BaseDatabaseInstance {
+	return &coredbinstance.BaseDatabaseInstance{
+		ID:               one.ID,
+		Vendor:           one.Vendor,
+		AccountID:        one.AccountID,
+		CloudID:          one.CloudID,
+		BkBizID:          one.BkBizID,
+		Name:             one.Name,
+		Region:           one.Region,
+		Zone:             one.Zone,
+		Status:           one.Status,
+		Engine:           one.Engine,
+		EngineVersion:    one.EngineVersion,
+		InstanceClass:    one.InstanceClass,
+		StorageSize:      one.StorageSize,
+		ChargeType:       one.ChargeType,
+		VpcID:            one.VpcID,
+		CloudVpcID:       one.CloudVpcID,
+		SubnetID:         one.SubnetID,
+		CloudSubnetID:    one.CloudSubnetID,
+		PrivateAddress:   one.PrivateAddress,
+		PublicAddress:    one.PublicAddress,
+		Port:             one.Port,
+		CloudCreatedTime: one.CloudCreatedTime,
+		CloudExpiredTime: one.CloudExpiredTime,
+		RecycleStatus:    one.RecycleStatus,
+		Memo:             one.Memo,
+		Revision: &core.Revision{
+			Creator:   one.Creator,
+			Reviser:   one.Reviser,
+			CreatedAt: one.CreatedAt.String(),
+			UpdatedAt: one.UpdatedAt.String(),
+		},
+	}
+}
+
+// ListDatabaseInstanceExt list database instance with extension.
+func (svc *databaseInstanceSvc) ListDatabaseInstanceExt(cts *rest.Contexts) (interface{}, error) {
+	vendor := enumor.Vendor(cts.Request.PathParameter("vendor"))
+	if err := vendor.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: req.Fields,
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	data, err := svc.dao.DatabaseInstance().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list database instance ext failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if req.Page.Count {
+		return &protocloud.DatabaseInstanceListResult{Count: data.Count}, nil
+	}
+
+	switch vendor {
+	case enumor.TCloud:
+		return convDatabaseInstanceExtListResult[coredbinstance.TCloudDBInstanceExtension](cts.Kit, data.Details)
+	case enumor.Aws:
+		return convDatabaseInstanceExtListResult[coredbinstance.AwsDBInstanceExtension](cts.Kit, data.Details)
+	case enumor.Azure:
+		return convDatabaseInstanceExtListResult[coredbinstance.AzureDBInstanceExtension](cts.Kit, data.Details)
+	case enumor.Gcp:
+		return convDatabaseInstanceExtListResult[coredbinstance.GcpDBInstanceExtension](cts.Kit, data.Details)
+	case enumor.HuaWei:
+		return convDatabaseInstanceExtListResult[coredbinstance.HuaWeiDBInstanceExtension](cts.Kit, data.Details)
+	default:
+		return nil, errf.Newf(errf.InvalidParameter, "unsupported vendor: %s", vendor)
+	}
+}
+
+func convDatabaseInstanceExtListResult[T coredbinstance.Extension](kt *kit.Kit, tables []tabledbinstance.DatabaseInstanceTable) (
+	*protocloud.DatabaseInstanceExtListResult[T], error) {
+
+	details := make([]coredbinstance.DatabaseInstance[T], 0, len(tables))
+	for _, one := range tables {
+		extension := new(T)
+		if len(one.Extension) != 0 {
+			if err := json.UnmarshalFromString(string(one.Extension), extension); err != nil {
+				logs.Errorf("unmarshal database instance extension failed, err: %v, id: %s, rid: %s", err, one.ID, kt.Rid)
+				return nil, fmt.Errorf("unmarshal database instance extension failed, err: %v", err)
+			}
+		}
+
+		details = append(details, coredbinstance.DatabaseInstance[T]{
+			BaseDatabaseInstance: *convTableToBaseDatabaseInstance(&one),
+			Extension:            extension,
+		})
+	}
+
+	return &protocloud.DatabaseInstanceExtListResult[T]{Details: details}, nil
+}
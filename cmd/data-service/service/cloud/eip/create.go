@@ -49,6 +49,10 @@ func (svc *eipSvc) BatchCreateEipExt(cts *rest.Contexts) (interface{}, error) {
 		return batchCreateEipExt[dataproto.GcpEipExtensionCreateReq](cts, svc, vendor)
 	case enumor.HuaWei:
 		return batchCreateEipExt[dataproto.HuaWeiEipExtensionCreateReq](cts, svc, vendor)
+	case enumor.Zenlayer:
+		return batchCreateEipExt[dataproto.ZenlayerEipExtensionCreateReq](cts, svc, vendor)
+	case enumor.Kaopu:
+		return batchCreateEipExt[dataproto.KaopuEipExtensionCreateReq](cts, svc, vendor)
 	case enumor.Azure:
 		return batchCreateEipExt[dataproto.AzureEipExtensionCreateReq](cts, svc, vendor)
 	default:
@@ -69,6 +69,10 @@ func (svc *eipSvc) RetrieveEipExt(cts *rest.Contexts) (interface{}, error) {
 		return toProtoEipExtResult[dataproto.AzureEipExtensionResult](eipData)
 	case enumor.HuaWei:
 		return toProtoEipExtResult[dataproto.HuaWeiEipExtensionResult](eipData)
+	case enumor.Zenlayer:
+		return toProtoEipExtResult[dataproto.ZenlayerEipExtensionResult](eipData)
+	case enumor.Kaopu:
+		return toProtoEipExtResult[dataproto.KaopuEipExtensionResult](eipData)
 	default:
 		return nil, errf.Newf(errf.InvalidParameter, "unsupported vendor: %s", vendor)
 	}
@@ -139,6 +143,10 @@ func (svc *eipSvc) ListEipExt(cts *rest.Contexts) (interface{}, error) {
 		return toProtoEipExtListResult[dataproto.GcpEipExtensionResult](data)
 	case enumor.HuaWei:
 		return toProtoEipExtListResult[dataproto.HuaWeiEipExtensionResult](data)
+	case enumor.Zenlayer:
+		return toProtoEipExtListResult[dataproto.ZenlayerEipExtensionResult](data)
+	case enumor.Kaopu:
+		return toProtoEipExtListResult[dataproto.KaopuEipExtensionResult](data)
 	case enumor.Azure:
 		return toProtoEipExtListResult[dataproto.AzureEipExtensionResult](data)
 	default:
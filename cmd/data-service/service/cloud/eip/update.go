@@ -55,6 +55,10 @@ func (svc *eipSvc) BatchUpdateEipExt(cts *rest.Contexts) (interface{}, error) {
 		return batchUpdateEipExt[dataproto.AzureEipExtensionUpdateReq](cts, svc)
 	case enumor.HuaWei:
 		return batchUpdateEipExt[dataproto.HuaWeiEipExtensionUpdateReq](cts, svc)
+	case enumor.Zenlayer:
+		return batchUpdateEipExt[dataproto.ZenlayerEipExtensionUpdateReq](cts, svc)
+	case enumor.Kaopu:
+		return batchUpdateEipExt[dataproto.KaopuEipExtensionUpdateReq](cts, svc)
 	default:
 		return nil, errf.Newf(errf.InvalidParameter, "unsupported vendor: %s", vendor)
 	}
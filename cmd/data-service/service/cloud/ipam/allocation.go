@@ -0,0 +1,287 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package ipam
+
+import (
+	"fmt"
+	"reflect"
+
+	"hcm/pkg/api/core"
+	coreipam "hcm/pkg/api/core/cloud/ipam"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao"
+	"hcm/pkg/dal/dao/orm"
+	"hcm/pkg/dal/dao/types"
+	tableipam "hcm/pkg/dal/table/cloud/ipam"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/cidr"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// BatchCreateIpamAllocation batch create ipam allocation.
+func (svc *ipamSvc) BatchCreateIpamAllocation(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.IpamAllocationBatchCreateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	result, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		models := make([]*tableipam.AllocationTable, 0, len(req.Allocations))
+		for _, one := range req.Allocations {
+			models = append(models, &tableipam.AllocationTable{
+				PoolID:  one.PoolID,
+				Cidr:    one.Cidr,
+				ResType: one.ResType,
+				ResID:   one.ResID,
+				Memo:    one.Memo,
+				Creator: cts.Kit.User,
+				Reviser: cts.Kit.User,
+			})
+		}
+
+		return svc.dao.IpamAllocation().BatchCreateWithTx(cts.Kit, txn, models)
+	})
+	if err != nil {
+		logs.Errorf("batch create ipam allocation failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	ids, ok := result.([]string)
+	if !ok {
+		return nil, fmt.Errorf("batch create ipam allocation but return id type is not []string, id type: %v",
+			reflect.TypeOf(result).String())
+	}
+
+	return &core.BatchCreateResult{IDs: ids}, nil
+}
+
+// AllocateIpamAllocation 从地址池分配网段，对地址池加行锁后读取已分配网段并创建分配记录，避免并发分配出重叠网段
+func (svc *ipamSvc) AllocateIpamAllocation(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.IpamAllocationAllocateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	result, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		store := &txnAllocateStore{kt: cts.Kit, txn: txn, dao: svc.dao}
+		return allocateCidr(store, req, cts.Kit.User)
+	})
+	if err != nil {
+		logs.Errorf("allocate ipam allocation failed, err: %v, req: %+v, rid: %s", err, req, cts.Kit.Rid)
+		return nil, err
+	}
+
+	allocateResult, ok := result.(*protocloud.IpamAllocationAllocateResult)
+	if !ok {
+		return nil, fmt.Errorf("allocate ipam allocation but return type is not *IpamAllocationAllocateResult, "+
+			"type: %v", reflect.TypeOf(result).String())
+	}
+
+	return allocateResult, nil
+}
+
+// allocateStore 分配网段依赖的存储操作，LockPool 加的锁需要保持到事务结束
+type allocateStore interface {
+	LockPool(poolID string) (*tableipam.PoolTable, error)
+	ListAllocatedCidr() ([]string, error)
+	CreateAllocation(model *tableipam.AllocationTable) (string, error)
+}
+
+// txnAllocateStore 基于事务的 allocateStore，通过 SELECT ... FOR UPDATE 对地址池加行锁
+type txnAllocateStore struct {
+	kt  *kit.Kit
+	txn *sqlx.Tx
+	dao dao.Set
+}
+
+// LockPool ...
+func (s *txnAllocateStore) LockPool(poolID string) (*tableipam.PoolTable, error) {
+	return s.dao.IpamPool().LockByIDWithTx(s.kt, s.txn, poolID)
+}
+
+// ListAllocatedCidr ...
+func (s *txnAllocateStore) ListAllocatedCidr() ([]string, error) {
+	return s.dao.IpamAllocation().ListCidrWithTx(s.kt, s.txn)
+}
+
+// CreateAllocation ...
+func (s *txnAllocateStore) CreateAllocation(model *tableipam.AllocationTable) (string, error) {
+	ids, err := s.dao.IpamAllocation().BatchCreateWithTx(s.kt, s.txn, []*tableipam.AllocationTable{model})
+	if err != nil {
+		return "", err
+	}
+
+	if len(ids) != 1 {
+		return "", fmt.Errorf("create ipam allocation return ids count %d is invalid", len(ids))
+	}
+
+	return ids[0], nil
+}
+
+// allocateCidr 先锁定地址池，再读取已分配网段计算可用网段并创建分配记录，必须在锁定后读取已分配网段，
+// 否则并发分配会读到相同的已分配网段而分配出重叠网段
+func allocateCidr(store allocateStore, req *protocloud.IpamAllocationAllocateReq, user string) (
+	*protocloud.IpamAllocationAllocateResult, error) {
+
+	pool, err := store.LockPool(req.PoolID)
+	if err != nil {
+		return nil, err
+	}
+
+	allocatedCidrs, err := store.ListAllocatedCidr()
+	if err != nil {
+		return nil, err
+	}
+
+	used := append(allocatedCidrs, req.UsedCidrs...)
+	allocated, err := cidr.FirstAvailableIpv4Net(pool.Cidr, used, req.MaskLen)
+	if err != nil {
+		return nil, errf.Newf(errf.InvalidParameter, "ipam pool %s has no available /%d cidr: %v", pool.Cidr,
+			req.MaskLen, err)
+	}
+
+	model := &tableipam.AllocationTable{
+		PoolID:  pool.ID,
+		Cidr:    allocated,
+		ResType: req.ResType,
+		Memo:    req.Memo,
+		Creator: user,
+		Reviser: user,
+	}
+	id, err := store.CreateAllocation(model)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protocloud.IpamAllocationAllocateResult{ID: id, Cidr: allocated}, nil
+}
+
+// ListIpamAllocation list ipam allocation.
+func (svc *ipamSvc) ListIpamAllocation(cts *rest.Contexts) (interface{}, error) {
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: req.Fields,
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	result, err := svc.dao.IpamAllocation().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list ipam allocation failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, fmt.Errorf("list ipam allocation failed, err: %v", err)
+	}
+
+	if req.Page.Count {
+		return &protocloud.IpamAllocationListResult{Count: result.Count}, nil
+	}
+
+	details := make([]coreipam.Allocation, 0, len(result.Details))
+	for _, one := range result.Details {
+		details = append(details, coreipam.Allocation{
+			ID:      one.ID,
+			PoolID:  one.PoolID,
+			Cidr:    one.Cidr,
+			ResType: one.ResType,
+			ResID:   one.ResID,
+			Memo:    one.Memo,
+			Revision: &core.Revision{
+				Creator:   one.Creator,
+				Reviser:   one.Reviser,
+				CreatedAt: one.CreatedAt.String(),
+				UpdatedAt: one.UpdatedAt.String(),
+			},
+		})
+	}
+
+	return &protocloud.IpamAllocationListResult{Details: details}, nil
+}
+
+// BatchUpdateIpamAllocation batch update ipam allocation.
+func (svc *ipamSvc) BatchUpdateIpamAllocation(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.IpamAllocationBatchUpdateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	_, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		for _, item := range req.Allocations {
+			updateData := &tableipam.AllocationTable{
+				ResID:   item.ResID,
+				Memo:    item.Memo,
+				Reviser: cts.Kit.User,
+			}
+			if err := svc.dao.IpamAllocation().UpdateByIDWithTx(cts.Kit, txn, item.ID, updateData); err != nil {
+				return nil, fmt.Errorf("update ipam allocation db failed, err: %v", err)
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		logs.Errorf("batch update ipam allocation failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// BatchDeleteIpamAllocation batch delete ipam allocation.
+func (svc *ipamSvc) BatchDeleteIpamAllocation(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.IpamAllocationBatchDeleteReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	_, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		return nil, svc.dao.IpamAllocation().DeleteWithTx(cts.Kit, txn, req.Filter)
+	})
+	if err != nil {
+		logs.Errorf("delete ipam allocation failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
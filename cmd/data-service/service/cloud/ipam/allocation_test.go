@@ -0,0 +1,210 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package ipam
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	tableipam "hcm/pkg/dal/table/cloud/ipam"
+	"hcm/pkg/tools/cidr"
+)
+
+// fakeDB 模拟地址池表和分配记录表，rowLocks 模拟地址池的行锁
+type fakeDB struct {
+	mu       sync.Mutex
+	pools    map[string]*tableipam.PoolTable
+	rowLocks map[string]*sync.Mutex
+	cidrs    []string
+}
+
+func newFakeDB(pools ...*tableipam.PoolTable) *fakeDB {
+	db := &fakeDB{
+		pools:    make(map[string]*tableipam.PoolTable),
+		rowLocks: make(map[string]*sync.Mutex),
+	}
+	for _, one := range pools {
+		db.pools[one.ID] = one
+		db.rowLocks[one.ID] = new(sync.Mutex)
+	}
+	return db
+}
+
+// fakeTxn 模拟一个事务，持有的行锁在 commit 时释放
+type fakeTxn struct {
+	db    *fakeDB
+	held  *sync.Mutex
+	calls []string
+}
+
+func (t *fakeTxn) LockPool(poolID string) (*tableipam.PoolTable, error) {
+	t.calls = append(t.calls, "lock")
+	lock, exists := t.db.rowLocks[poolID]
+	if !exists {
+		return nil, errf.Newf(errf.RecordNotFound, "ipam pool: %s not found", poolID)
+	}
+	lock.Lock()
+	t.held = lock
+	return t.db.pools[poolID], nil
+}
+
+func (t *fakeTxn) ListAllocatedCidr() ([]string, error) {
+	t.calls = append(t.calls, "list")
+	t.db.mu.Lock()
+	defer t.db.mu.Unlock()
+	return append([]string(nil), t.db.cidrs...), nil
+}
+
+func (t *fakeTxn) CreateAllocation(model *tableipam.AllocationTable) (string, error) {
+	t.calls = append(t.calls, "create")
+	t.db.mu.Lock()
+	defer t.db.mu.Unlock()
+	t.db.cidrs = append(t.db.cidrs, model.Cidr)
+	return fmt.Sprintf("%08d", len(t.db.cidrs)), nil
+}
+
+func (t *fakeTxn) commit() {
+	if t.held != nil {
+		t.held.Unlock()
+	}
+}
+
+func TestAllocateCidr(t *testing.T) {
+	pool := &tableipam.PoolTable{ID: "pool", Cidr: "10.0.0.0/24"}
+	cases := []struct {
+		name      string
+		poolID    string
+		allocated []string
+		used      []string
+		maskLen   int
+		expect    string
+		errCode   int32
+		calls     []string
+	}{
+		{name: "empty pool", poolID: "pool", maskLen: 26, expect: "10.0.0.0/26",
+			calls: []string{"lock", "list", "create"}},
+		{name: "skip allocated", poolID: "pool", allocated: []string{"10.0.0.0/26"}, maskLen: 26,
+			expect: "10.0.0.64/26", calls: []string{"lock", "list", "create"}},
+		{name: "skip used vpc cidr", poolID: "pool", allocated: []string{"10.0.0.0/26"},
+			used: []string{"10.0.0.64/27"}, maskLen: 26, expect: "10.0.0.128/26",
+			calls: []string{"lock", "list", "create"}},
+		{name: "pool covered by used vpc cidr", poolID: "pool", used: []string{"10.0.0.0/16"}, maskLen: 26,
+			errCode: errf.InvalidParameter, calls: []string{"lock", "list"}},
+		{name: "exhausted", poolID: "pool",
+			allocated: []string{"10.0.0.0/25", "10.0.0.128/26", "10.0.0.192/26"}, maskLen: 28,
+			errCode: errf.InvalidParameter, calls: []string{"lock", "list"}},
+		{name: "pool not found", poolID: "unknown", maskLen: 26, errCode: errf.RecordNotFound,
+			calls: []string{"lock"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			db := newFakeDB(pool)
+			db.cidrs = c.allocated
+			txn := &fakeTxn{db: db}
+			req := &protocloud.IpamAllocationAllocateReq{PoolID: c.poolID, MaskLen: c.maskLen,
+				ResType: enumor.VpcCloudResType, UsedCidrs: c.used}
+
+			result, err := allocateCidr(txn, req, "tester")
+			txn.commit()
+
+			if fmt.Sprint(txn.calls) != fmt.Sprint(c.calls) {
+				t.Errorf("got calls: %v, expect: %v", txn.calls, c.calls)
+			}
+			if c.errCode != 0 {
+				if ef := errf.Error(err); ef == nil || ef.Code != c.errCode {
+					t.Fatalf("got err: %v, expect code: %d", err, c.errCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("allocate cidr failed, err: %v", err)
+			}
+			if result.Cidr != c.expect {
+				t.Errorf("got cidr: %s, expect: %s", result.Cidr, c.expect)
+			}
+		})
+	}
+}
+
+// TestAllocateCidrConcurrent 并发分配时地址池行锁保证每次分配都能读到之前的分配结果，分配出的网段互不重叠
+func TestAllocateCidrConcurrent(t *testing.T) {
+	db := newFakeDB(&tableipam.PoolTable{ID: "pool", Cidr: "10.0.0.0/24"})
+
+	const workers = 20
+	results := make(chan string, workers)
+	failed := make(chan error, workers)
+	wg := sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			txn := &fakeTxn{db: db}
+			defer txn.commit()
+
+			req := &protocloud.IpamAllocationAllocateReq{PoolID: "pool", MaskLen: 28,
+				ResType: enumor.VpcCloudResType}
+			// 让出调度，放大读取已分配网段和创建分配记录之间的并发窗口
+			runtime.Gosched()
+			result, err := allocateCidr(txn, req, "tester")
+			if err != nil {
+				failed <- err
+				return
+			}
+			results <- result.Cidr
+		}()
+	}
+	wg.Wait()
+	close(results)
+	close(failed)
+
+	allocated := make([]string, 0, workers)
+	for one := range results {
+		allocated = append(allocated, one)
+	}
+	// /24 地址池只能分配16个 /28 网段，其余请求返回地址池耗尽
+	if len(allocated) != 16 || len(failed) != workers-16 {
+		t.Fatalf("got %d allocated, %d failed, expect 16 allocated, %d failed", len(allocated), len(failed),
+			workers-16)
+	}
+	for err := range failed {
+		if ef := errf.Error(err); ef == nil || ef.Code != errf.InvalidParameter {
+			t.Errorf("got err: %v, expect exhausted error", err)
+		}
+	}
+
+	for i := range allocated {
+		for j := i + 1; j < len(allocated); j++ {
+			overlap, err := cidr.IsCidrOverlap(allocated[i], allocated[j])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if overlap {
+				t.Errorf("allocated cidr %s overlaps with %s", allocated[i], allocated[j])
+			}
+		}
+	}
+}
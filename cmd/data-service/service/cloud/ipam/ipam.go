@@ -0,0 +1,60 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package ipam IP地址池及网段分配的DB接口
+package ipam
+
+import (
+	"net/http"
+
+	"hcm/cmd/data-service/service/capability"
+	"hcm/pkg/dal/dao"
+	"hcm/pkg/rest"
+)
+
+var svc *ipamSvc
+
+// InitService initial the ipam pool and allocation service
+func InitService(cap *capability.Capability) {
+	svc = &ipamSvc{
+		dao: cap.Dao,
+	}
+
+	h := rest.NewHandler()
+
+	h.Add("BatchCreateIpamPool", http.MethodPost, "/ipam/pools/batch/create", svc.BatchCreateIpamPool)
+	h.Add("ListIpamPool", http.MethodPost, "/ipam/pools/list", svc.ListIpamPool)
+	h.Add("BatchUpdateIpamPool", http.MethodPatch, "/ipam/pools/batch/update", svc.BatchUpdateIpamPool)
+	h.Add("BatchDeleteIpamPool", http.MethodDelete, "/ipam/pools/batch", svc.BatchDeleteIpamPool)
+
+	h.Add("BatchCreateIpamAllocation", http.MethodPost, "/ipam/allocations/batch/create",
+		svc.BatchCreateIpamAllocation)
+	h.Add("AllocateIpamAllocation", http.MethodPost, "/ipam/allocations/allocate", svc.AllocateIpamAllocation)
+	h.Add("ListIpamAllocation", http.MethodPost, "/ipam/allocations/list", svc.ListIpamAllocation)
+	h.Add("BatchUpdateIpamAllocation", http.MethodPatch, "/ipam/allocations/batch/update",
+		svc.BatchUpdateIpamAllocation)
+	h.Add("BatchDeleteIpamAllocation", http.MethodDelete, "/ipam/allocations/batch",
+		svc.BatchDeleteIpamAllocation)
+
+	h.Load(cap.WebService)
+}
+
+type ipamSvc struct {
+	dao dao.Set
+}
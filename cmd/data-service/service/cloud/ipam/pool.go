@@ -0,0 +1,207 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package ipam
+
+import (
+	"fmt"
+	"reflect"
+
+	"hcm/pkg/api/core"
+	coreipam "hcm/pkg/api/core/cloud/ipam"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/orm"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/dal/dao/types"
+	tableipam "hcm/pkg/dal/table/cloud/ipam"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// BatchCreateIpamPool batch create ipam pool.
+func (svc *ipamSvc) BatchCreateIpamPool(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.IpamPoolBatchCreateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	result, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		models := make([]*tableipam.PoolTable, 0, len(req.Pools))
+		for _, one := range req.Pools {
+			models = append(models, &tableipam.PoolTable{
+				Name:    one.Name,
+				Vendor:  one.Vendor,
+				Region:  one.Region,
+				BkBizID: one.BkBizID,
+				Cidr:    one.Cidr,
+				Memo:    one.Memo,
+				Creator: cts.Kit.User,
+				Reviser: cts.Kit.User,
+			})
+		}
+
+		return svc.dao.IpamPool().BatchCreateWithTx(cts.Kit, txn, models)
+	})
+	if err != nil {
+		logs.Errorf("batch create ipam pool failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	ids, ok := result.([]string)
+	if !ok {
+		return nil, fmt.Errorf("batch create ipam pool but return id type is not []string, id type: %v",
+			reflect.TypeOf(result).String())
+	}
+
+	return &core.BatchCreateResult{IDs: ids}, nil
+}
+
+// ListIpamPool list ipam pool.
+func (svc *ipamSvc) ListIpamPool(cts *rest.Contexts) (interface{}, error) {
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: req.Fields,
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	result, err := svc.dao.IpamPool().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list ipam pool failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, fmt.Errorf("list ipam pool failed, err: %v", err)
+	}
+
+	if req.Page.Count {
+		return &protocloud.IpamPoolListResult{Count: result.Count}, nil
+	}
+
+	details := make([]coreipam.Pool, 0, len(result.Details))
+	for _, one := range result.Details {
+		details = append(details, coreipam.Pool{
+			ID:      one.ID,
+			Name:    one.Name,
+			Vendor:  one.Vendor,
+			Region:  one.Region,
+			BkBizID: one.BkBizID,
+			Cidr:    one.Cidr,
+			Memo:    one.Memo,
+			Revision: &core.Revision{
+				Creator:   one.Creator,
+				Reviser:   one.Reviser,
+				CreatedAt: one.CreatedAt.String(),
+				UpdatedAt: one.UpdatedAt.String(),
+			},
+		})
+	}
+
+	return &protocloud.IpamPoolListResult{Details: details}, nil
+}
+
+// BatchUpdateIpamPool batch update ipam pool.
+func (svc *ipamSvc) BatchUpdateIpamPool(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.IpamPoolBatchUpdateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	_, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		for _, item := range req.Pools {
+			updateData := &tableipam.PoolTable{
+				Name:    item.Name,
+				Memo:    item.Memo,
+				Reviser: cts.Kit.User,
+			}
+			if err := svc.dao.IpamPool().UpdateByIDWithTx(cts.Kit, txn, item.ID, updateData); err != nil {
+				return nil, fmt.Errorf("update ipam pool db failed, err: %v", err)
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		logs.Errorf("batch update ipam pool failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// BatchDeleteIpamPool batch delete ipam pool, the allocations of the pool are deleted together.
+func (svc *ipamSvc) BatchDeleteIpamPool(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.IpamPoolBatchDeleteReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Filter: req.Filter,
+		Page:   core.NewDefaultBasePage(),
+		Fields: []string{"id"},
+	}
+	listResp, err := svc.dao.IpamPool().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list ipam pool failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, fmt.Errorf("list ipam pool failed, err: %v", err)
+	}
+
+	if len(listResp.Details) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, len(listResp.Details))
+	for index, one := range listResp.Details {
+		ids[index] = one.ID
+	}
+
+	_, err = svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		if err := svc.dao.IpamAllocation().DeleteWithTx(cts.Kit, txn, tools.ContainersExpression("pool_id",
+			ids)); err != nil {
+			return nil, err
+		}
+
+		return nil, svc.dao.IpamPool().DeleteWithTx(cts.Kit, txn, tools.ContainersExpression("id", ids))
+	})
+	if err != nil {
+		logs.Errorf("delete ipam pool failed, err: %v, ids: %v, rid: %s", err, ids, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
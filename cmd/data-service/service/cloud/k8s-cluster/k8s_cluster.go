@@ -0,0 +1,58 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package k8scluster 容器集群的DB接口
+package k8scluster
+
+import (
+	"net/http"
+
+	"hcm/cmd/data-service/service/capability"
+	"hcm/pkg/dal/dao"
+	"hcm/pkg/rest"
+)
+
+var svc *k8sClusterSvc
+
+// InitService initial the k8s cluster service
+func InitService(cap *capability.Capability) {
+	svc = &k8sClusterSvc{
+		dao: cap.Dao,
+	}
+
+	h := rest.NewHandler()
+
+	h.Add("BatchCreateK8sCluster", http.MethodPost, "/vendors/{vendor}/k8s_clusters/batch/create",
+		svc.BatchCreateK8sCluster)
+	h.Add("ListK8sCluster", http.MethodPost, "/k8s_clusters/list", svc.ListK8sCluster)
+	h.Add("ListK8sClusterExt", http.MethodPost, "/vendors/{vendor}/k8s_clusters/list",
+		svc.ListK8sClusterExt)
+	h.Add("BatchUpdateK8sClusterExt", http.MethodPatch, "/vendors/{vendor}/k8s_clusters",
+		svc.BatchUpdateK8sClusterExt)
+	h.Add("BatchUpdateK8sCluster", http.MethodPatch, "/k8s_clusters/batch/update",
+		svc.BatchUpdateK8sCluster)
+	h.Add("BatchDeleteK8sCluster", http.MethodDelete, "/k8s_clusters/batch",
+		svc.BatchDeleteK8sCluster)
+
+	h.Load(cap.WebService)
+}
+
+type k8sClusterSvc struct {
+	dao dao.Set
+}
@@ -0,0 +1,162 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package k8scluster
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	corek8s "hcm/pkg/api/core/cloud/k8s"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/types"
+	tablek8s "hcm/pkg/dal/table/cloud/k8s"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/json"
+)
+
+// ListK8sCluster list k8s cluster.
+func (svc *k8sClusterSvc) ListK8sCluster(cts *rest.Contexts) (interface{}, error) {
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: req.Fields,
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	result, err := svc.dao.K8sCluster().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list k8s cluster failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, fmt.Errorf("list k8s cluster failed, err: %v", err)
+	}
+
+	if req.Page.Count {
+		return &protocloud.K8sClusterListResult{Count: result.Count}, nil
+	}
+
+	details := make([]corek8s.BaseK8sCluster, 0, len(result.Details))
+	for _, one := range result.Details {
+		details = append(details, *convTableToBaseK8sCluster(&one))
+	}
+
+	return &protocloud.K8sClusterListResult{Details: details}, nil
+}
+
+func convTableToBaseK8sCluster(one *tablek8s.K8sClusterTable) *corek8s.BaseK8sCluster {
+	return &corek8s.BaseK8sCluster{
+		ID:               one.ID,
+		Vendor:           one.Vendor,
+		AccountID:        one.AccountID,
+		CloudID:          one.CloudID,
+		BkBizID:          one.BkBizID,
+		Name:             one.Name,
+		Region:           one.Region,
+		Status:           one.Status,
+		Version:          one.Version,
+		CloudVpcID:       one.CloudVpcID,
+		CloudCreatedTime: one.CloudCreatedTime,
+		Memo:             one.Memo,
+		Revision: &core.Revision{
+			Creator:   one.Creator,
+			Reviser:   one.Reviser,
+			CreatedAt: one.CreatedAt.String(),
+			UpdatedAt: one.UpdatedAt.String(),
+		},
+	}
+}
+
+// ListK8sClusterExt list k8s cluster with extension.
+func (svc *k8sClusterSvc) ListK8sClusterExt(cts *rest.Contexts) (interface{}, error) {
+	vendor := enumor.Vendor(cts.Request.PathParameter("vendor"))
+	if err := vendor.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: req.Fields,
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	data, err := svc.dao.K8sCluster().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list k8s cluster ext failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if req.Page.Count {
+		return &protocloud.K8sClusterListResult{Count: data.Count}, nil
+	}
+
+	switch vendor {
+	case enumor.TCloud:
+		return convK8sClusterExtListResult[corek8s.TCloudK8sClusterExtension](cts.Kit, data.Details)
+	case enumor.Aws:
+		return convK8sClusterExtListResult[corek8s.AwsK8sClusterExtension](cts.Kit, data.Details)
+	case enumor.Azure:
+		return convK8sClusterExtListResult[corek8s.AzureK8sClusterExtension](cts.Kit, data.Details)
+	case enumor.Gcp:
+		return convK8sClusterExtListResult[corek8s.GcpK8sClusterExtension](cts.Kit, data.Details)
+	case enumor.HuaWei:
+		return convK8sClusterExtListResult[corek8s.HuaWeiK8sClusterExtension](cts.Kit, data.Details)
+	default:
+		return nil, errf.Newf(errf.InvalidParameter, "unsupported vendor: %s", vendor)
+	}
+}
+
+func convK8sClusterExtListResult[T corek8s.Extension](kt *kit.Kit, tables []tablek8s.K8sClusterTable) (
+	*protocloud.K8sClusterExtListResult[T], error) {
+
+	details := make([]corek8s.K8sCluster[T], 0, len(tables))
+	for _, one := range tables {
+		extension := new(T)
+		if len(one.Extension) != 0 {
+			if err := json.UnmarshalFromString(string(one.Extension), extension); err != nil {
+				logs.Errorf("unmarshal k8s cluster extension failed, err: %v, id: %s, rid: %s", err, one.ID, kt.Rid)
+				return nil, fmt.Errorf("unmarshal k8s cluster extension failed, err: %v", err)
+			}
+		}
+
+		details = append(details, corek8s.K8sCluster[T]{
+			BaseK8sCluster: *convTableToBaseK8sCluster(&one),
+			Extension:      extension,
+		})
+	}
+
+	return &protocloud.K8sClusterExtListResult[T]{Details: details}, nil
+}
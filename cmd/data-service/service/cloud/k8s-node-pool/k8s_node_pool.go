@@ -0,0 +1,257 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package k8snodepool 容器集群节点池及节点关联关系的DB接口
+package k8snodepool
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"hcm/cmd/data-service/service/capability"
+	"hcm/pkg/api/core"
+	corek8s "hcm/pkg/api/core/cloud/k8s"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao"
+	"hcm/pkg/dal/dao/orm"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/dal/dao/types"
+	tablek8s "hcm/pkg/dal/table/cloud/k8s"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/converter"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var svc *nodePoolSvc
+
+// InitService initial the k8s node pool service
+func InitService(cap *capability.Capability) {
+	svc = &nodePoolSvc{
+		dao: cap.Dao,
+	}
+
+	h := rest.NewHandler()
+
+	h.Add("BatchCreateK8sNodePool", http.MethodPost, "/k8s_node_pools/batch/create", svc.BatchCreateK8sNodePool)
+	h.Add("ListK8sNodePool", http.MethodPost, "/k8s_node_pools/list", svc.ListK8sNodePool)
+	h.Add("BatchUpdateK8sNodePool", http.MethodPatch, "/k8s_node_pools/batch/update", svc.BatchUpdateK8sNodePool)
+	h.Add("BatchDeleteK8sNodePool", http.MethodDelete, "/k8s_node_pools/batch", svc.BatchDeleteK8sNodePool)
+
+	h.Add("BatchCreateK8sNodePoolCvmRel", http.MethodPost, "/k8s_node_pool_cvm_rels/batch/create",
+		svc.BatchCreateK8sNodePoolCvmRel)
+	h.Add("ListK8sNodePoolCvmRel", http.MethodPost, "/k8s_node_pool_cvm_rels/list", svc.ListK8sNodePoolCvmRel)
+	h.Add("BatchDeleteK8sNodePoolCvmRel", http.MethodDelete, "/k8s_node_pool_cvm_rels/batch",
+		svc.BatchDeleteK8sNodePoolCvmRel)
+
+	h.Load(cap.WebService)
+}
+
+type nodePoolSvc struct {
+	dao dao.Set
+}
+
+// BatchCreateK8sNodePool batch create k8s node pool.
+func (svc *nodePoolSvc) BatchCreateK8sNodePool(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.K8sNodePoolBatchCreateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	result, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		models := make([]*tablek8s.K8sNodePoolTable, 0, len(req.NodePools))
+		for _, one := range req.NodePools {
+			models = append(models, &tablek8s.K8sNodePoolTable{
+				Vendor:         one.Vendor,
+				AccountID:      one.AccountID,
+				ClusterID:      one.ClusterID,
+				CloudClusterID: one.CloudClusterID,
+				CloudID:        one.CloudID,
+				Name:           one.Name,
+				Region:         one.Region,
+				Status:         one.Status,
+				InstanceType:   one.InstanceType,
+				NodeCount:      converter.ValToPtr(one.NodeCount),
+				MinSize:        converter.ValToPtr(one.MinSize),
+				MaxSize:        converter.ValToPtr(one.MaxSize),
+				Creator:        cts.Kit.User,
+				Reviser:        cts.Kit.User,
+			})
+		}
+
+		return svc.dao.K8sNodePool().BatchCreateWithTx(cts.Kit, txn, models)
+	})
+	if err != nil {
+		logs.Errorf("batch create k8s node pool failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	ids, ok := result.([]string)
+	if !ok {
+		return nil, fmt.Errorf("batch create k8s node pool but return id type is not []string, id type: %v",
+			reflect.TypeOf(result).String())
+	}
+
+	return &core.BatchCreateResult{IDs: ids}, nil
+}
+
+// ListK8sNodePool list k8s node pool.
+func (svc *nodePoolSvc) ListK8sNodePool(cts *rest.Contexts) (interface{}, error) {
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: req.Fields,
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	result, err := svc.dao.K8sNodePool().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list k8s node pool failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, fmt.Errorf("list k8s node pool failed, err: %v", err)
+	}
+
+	if req.Page.Count {
+		return &protocloud.K8sNodePoolListResult{Count: result.Count}, nil
+	}
+
+	details := make([]corek8s.K8sNodePool, 0, len(result.Details))
+	for _, one := range result.Details {
+		details = append(details, corek8s.K8sNodePool{
+			ID:             one.ID,
+			Vendor:         one.Vendor,
+			AccountID:      one.AccountID,
+			ClusterID:      one.ClusterID,
+			CloudClusterID: one.CloudClusterID,
+			CloudID:        one.CloudID,
+			Name:           one.Name,
+			Region:         one.Region,
+			Status:         one.Status,
+			InstanceType:   one.InstanceType,
+			NodeCount:      converter.PtrToVal(one.NodeCount),
+			MinSize:        converter.PtrToVal(one.MinSize),
+			MaxSize:        converter.PtrToVal(one.MaxSize),
+			Revision: &core.Revision{
+				Creator:   one.Creator,
+				Reviser:   one.Reviser,
+				CreatedAt: one.CreatedAt.String(),
+				UpdatedAt: one.UpdatedAt.String(),
+			},
+		})
+	}
+
+	return &protocloud.K8sNodePoolListResult{Details: details}, nil
+}
+
+// BatchUpdateK8sNodePool batch update k8s node pool.
+func (svc *nodePoolSvc) BatchUpdateK8sNodePool(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.K8sNodePoolBatchUpdateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	_, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		for _, item := range req.NodePools {
+			updateData := &tablek8s.K8sNodePoolTable{
+				Name:         item.Name,
+				Status:       item.Status,
+				InstanceType: item.InstanceType,
+				NodeCount:    converter.ValToPtr(item.NodeCount),
+				MinSize:      converter.ValToPtr(item.MinSize),
+				MaxSize:      converter.ValToPtr(item.MaxSize),
+				Reviser:      cts.Kit.User,
+			}
+			if err := svc.dao.K8sNodePool().UpdateByIDWithTx(cts.Kit, txn, item.ID, updateData); err != nil {
+				return nil, fmt.Errorf("update k8s node pool db failed, err: %v", err)
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		logs.Errorf("batch update k8s node pool failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// BatchDeleteK8sNodePool batch delete k8s node pool and node pool cvm rels.
+func (svc *nodePoolSvc) BatchDeleteK8sNodePool(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.K8sNodePoolBatchDeleteReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: []string{"id"},
+		Filter: req.Filter,
+		Page:   core.NewDefaultBasePage(),
+	}
+	listResp, err := svc.dao.K8sNodePool().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list k8s node pool failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, fmt.Errorf("list k8s node pool failed, err: %v", err)
+	}
+
+	if len(listResp.Details) == 0 {
+		return nil, nil
+	}
+
+	delIDs := make([]string, len(listResp.Details))
+	for index, one := range listResp.Details {
+		delIDs[index] = one.ID
+	}
+
+	_, err = svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		err := svc.dao.K8sNodePoolCvmRel().DeleteWithTx(cts.Kit, txn, tools.ContainersExpression("node_pool_id",
+			delIDs))
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, svc.dao.K8sNodePool().DeleteWithTx(cts.Kit, txn, tools.ContainersExpression("id", delIDs))
+	})
+	if err != nil {
+		logs.Errorf("delete k8s node pool failed, ids: %v, err: %v, rid: %s", delIDs, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
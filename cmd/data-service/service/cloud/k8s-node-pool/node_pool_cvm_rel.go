@@ -0,0 +1,130 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package k8snodepool
+
+import (
+	"hcm/pkg/api/core"
+	corek8s "hcm/pkg/api/core/cloud/k8s"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/orm"
+	"hcm/pkg/dal/dao/types"
+	tablek8s "hcm/pkg/dal/table/cloud/k8s"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// BatchCreateK8sNodePoolCvmRel batch create k8s node pool cvm rels.
+func (svc *nodePoolSvc) BatchCreateK8sNodePoolCvmRel(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.K8sNodePoolCvmRelBatchCreateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	_, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		rels := make([]*tablek8s.K8sNodePoolCvmRelTable, 0, len(req.Rels))
+		for _, one := range req.Rels {
+			rels = append(rels, &tablek8s.K8sNodePoolCvmRelTable{
+				ClusterID:  one.ClusterID,
+				NodePoolID: one.NodePoolID,
+				CvmID:      one.CvmID,
+				Creator:    cts.Kit.User,
+			})
+		}
+
+		return nil, svc.dao.K8sNodePoolCvmRel().BatchCreateWithTx(cts.Kit, txn, rels)
+	})
+	if err != nil {
+		logs.Errorf("batch create k8s node pool cvm rel failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ListK8sNodePoolCvmRel list k8s node pool cvm rels.
+func (svc *nodePoolSvc) ListK8sNodePoolCvmRel(cts *rest.Contexts) (interface{}, error) {
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: req.Fields,
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	result, err := svc.dao.K8sNodePoolCvmRel().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list k8s node pool cvm rel failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if req.Page.Count {
+		return &protocloud.K8sNodePoolCvmRelListResult{Count: result.Count}, nil
+	}
+
+	details := make([]corek8s.K8sNodePoolCvmRel, 0, len(result.Details))
+	for _, one := range result.Details {
+		details = append(details, corek8s.K8sNodePoolCvmRel{
+			ID:         one.ID,
+			ClusterID:  one.ClusterID,
+			NodePoolID: one.NodePoolID,
+			CvmID:      one.CvmID,
+			Creator:    one.Creator,
+			CreatedAt:  one.CreatedAt.String(),
+		})
+	}
+
+	return &protocloud.K8sNodePoolCvmRelListResult{Details: details}, nil
+}
+
+// BatchDeleteK8sNodePoolCvmRel batch delete k8s node pool cvm rels.
+func (svc *nodePoolSvc) BatchDeleteK8sNodePoolCvmRel(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.K8sNodePoolCvmRelBatchDeleteReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	_, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		return nil, svc.dao.K8sNodePoolCvmRel().DeleteWithTx(cts.Kit, txn, req.Filter)
+	})
+	if err != nil {
+		logs.Errorf("delete k8s node pool cvm rel failed, err: %v, filter: %s, rid: %s", err, req.Filter,
+			cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,161 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package keypair
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	corekeypair "hcm/pkg/api/core/cloud/key-pair"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/types"
+	tablekeypair "hcm/pkg/dal/table/cloud/key-pair"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/json"
+)
+
+// ListKeyPair list key pair.
+func (svc *keyPairSvc) ListKeyPair(cts *rest.Contexts) (interface{}, error) {
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: req.Fields,
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	result, err := svc.dao.KeyPair().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list key pair failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, fmt.Errorf("list key pair failed, err: %v", err)
+	}
+
+	if req.Page.Count {
+		return &protocloud.KeyPairListResult{Count: result.Count}, nil
+	}
+
+	details := make([]corekeypair.BaseKeyPair, 0, len(result.Details))
+	for _, one := range result.Details {
+		details = append(details, *convTableToBaseKeyPair(&one))
+	}
+
+	return &protocloud.KeyPairListResult{Details: details}, nil
+}
+
+func convTableToBaseKeyPair(one *tablekeypair.KeyPairTable) *corekeypair.BaseKeyPair {
+	return &corekeypair.BaseKeyPair{
+		ID:               one.ID,
+		Vendor:           one.Vendor,
+		AccountID:        one.AccountID,
+		CloudID:          one.CloudID,
+		BkBizID:          one.BkBizID,
+		Name:             one.Name,
+		Region:           one.Region,
+		Fingerprint:      one.Fingerprint,
+		PublicKey:        one.PublicKey,
+		CloudCreatedTime: one.CloudCreatedTime,
+		Memo:             one.Memo,
+		Revision: &core.Revision{
+			Creator:   one.Creator,
+			Reviser:   one.Reviser,
+			CreatedAt: one.CreatedAt.String(),
+			UpdatedAt: one.UpdatedAt.String(),
+		},
+	}
+}
+
+// ListKeyPairExt list key pair with extension.
+func (svc *keyPairSvc) ListKeyPairExt(cts *rest.Contexts) (interface{}, error) {
+	vendor := enumor.Vendor(cts.Request.PathParameter("vendor"))
+	if err := vendor.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: req.Fields,
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	data, err := svc.dao.KeyPair().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list key pair ext failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if req.Page.Count {
+		return &protocloud.KeyPairListResult{Count: data.Count}, nil
+	}
+
+	switch vendor {
+	case enumor.TCloud:
+		return convKeyPairExtListResult[corekeypair.TCloudKeyPairExtension](cts.Kit, data.Details)
+	case enumor.Aws:
+		return convKeyPairExtListResult[corekeypair.AwsKeyPairExtension](cts.Kit, data.Details)
+	case enumor.Azure:
+		return convKeyPairExtListResult[corekeypair.AzureKeyPairExtension](cts.Kit, data.Details)
+	case enumor.Gcp:
+		return convKeyPairExtListResult[corekeypair.GcpKeyPairExtension](cts.Kit, data.Details)
+	case enumor.HuaWei:
+		return convKeyPairExtListResult[corekeypair.HuaWeiKeyPairExtension](cts.Kit, data.Details)
+	default:
+		return nil, errf.Newf(errf.InvalidParameter, "unsupported vendor: %s", vendor)
+	}
+}
+
+func convKeyPairExtListResult[T corekeypair.Extension](kt *kit.Kit, tables []tablekeypair.KeyPairTable) (
+	*protocloud.KeyPairExtListResult[T], error) {
+
+	details := make([]corekeypair.KeyPair[T], 0, len(tables))
+	for _, one := range tables {
+		extension := new(T)
+		if len(one.Extension) != 0 {
+			if err := json.UnmarshalFromString(string(one.Extension), extension); err != nil {
+				logs.Errorf("unmarshal key pair extension failed, err: %v, id: %s, rid: %s", err, one.ID, kt.Rid)
+				return nil, fmt.Errorf("unmarshal key pair extension failed, err: %v", err)
+			}
+		}
+
+		details = append(details, corekeypair.KeyPair[T]{
+			BaseKeyPair: *convTableToBaseKeyPair(&one),
+			Extension:   extension,
+		})
+	}
+
+	return &protocloud.KeyPairExtListResult[T]{Details: details}, nil
+}
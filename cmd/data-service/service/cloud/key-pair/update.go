@@ -0,0 +1,151 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package keypair
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	corekeypair "hcm/pkg/api/core/cloud/key-pair"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/orm"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/dal/dao/types"
+	tablekeypair "hcm/pkg/dal/table/cloud/key-pair"
+	tabletype "hcm/pkg/dal/table/types"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/json"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// BatchUpdateKeyPair batch update key pair local attribute, such as biz and memo.
+func (svc *keyPairSvc) BatchUpdateKeyPair(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.KeyPairBatchUpdateExprReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	updateData := &tablekeypair.KeyPairTable{
+		BkBizID: req.BkBizID,
+		Memo:    req.Memo,
+		Reviser: cts.Kit.User,
+	}
+	if err := svc.dao.KeyPair().Update(cts.Kit, tools.ContainersExpression("id", req.IDs), updateData); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// BatchUpdateKeyPairExt batch update key pair with extension.
+func (svc *keyPairSvc) BatchUpdateKeyPairExt(cts *rest.Contexts) (interface{}, error) {
+	vendor := enumor.Vendor(cts.Request.PathParameter("vendor"))
+	if err := vendor.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	switch vendor {
+	case enumor.TCloud:
+		return batchUpdateKeyPairExt[corekeypair.TCloudKeyPairExtension](cts, svc)
+	case enumor.Aws:
+		return batchUpdateKeyPairExt[corekeypair.AwsKeyPairExtension](cts, svc)
+	case enumor.Azure:
+		return batchUpdateKeyPairExt[corekeypair.AzureKeyPairExtension](cts, svc)
+	case enumor.Gcp:
+		return batchUpdateKeyPairExt[corekeypair.GcpKeyPairExtension](cts, svc)
+	case enumor.HuaWei:
+		return batchUpdateKeyPairExt[corekeypair.HuaWeiKeyPairExtension](cts, svc)
+	default:
+		return nil, errf.Newf(errf.InvalidParameter, "unsupported vendor: %s", vendor)
+	}
+}
+
+func batchUpdateKeyPairExt[T corekeypair.Extension](cts *rest.Contexts, svc *keyPairSvc) (interface{}, error) {
+	req := new(protocloud.KeyPairExtBatchUpdateReq[T])
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	ids := make([]string, 0, len(*req))
+	for _, one := range *req {
+		ids = append(ids, one.ID)
+	}
+	opt := &types.ListOption{
+		Fields: []string{"id", "extension"},
+		Filter: tools.ContainersExpression("id", ids),
+		Page:   core.NewDefaultBasePage(),
+	}
+	listResp, err := svc.dao.KeyPair().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list key pair extension failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+	rawExtensions := make(map[string]tabletype.JsonField, len(listResp.Details))
+	for _, one := range listResp.Details {
+		rawExtensions[one.ID] = one.Extension
+	}
+
+	_, err = svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		for _, item := range *req {
+			updateData := &tablekeypair.KeyPairTable{
+				Name:        item.Name,
+				Fingerprint: item.Fingerprint,
+				PublicKey:   item.PublicKey,
+				Memo:        item.Memo,
+				Reviser:     cts.Kit.User,
+			}
+
+			if item.Extension != nil {
+				rawExtension, exist := rawExtensions[item.ID]
+				if !exist {
+					return nil, fmt.Errorf("key pair id (%s) not exist", item.ID)
+				}
+				merged, err := json.UpdateMerge(item.Extension, string(rawExtension))
+				if err != nil {
+					return nil, fmt.Errorf("key pair id (%s) merge extension failed, err: %v", item.ID, err)
+				}
+				updateData.Extension = tabletype.JsonField(merged)
+			}
+
+			if err := svc.dao.KeyPair().UpdateByIDWithTx(cts.Kit, txn, item.ID, updateData); err != nil {
+				return nil, fmt.Errorf("update key pair db failed, err: %v", err)
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		logs.Errorf("batch update key pair ext db failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
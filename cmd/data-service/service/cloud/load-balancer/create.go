@@ -57,6 +57,14 @@ func (svc *lbSvc) BatchCreateLoadBalancer(cts *rest.Contexts) (any, error) {
 	switch vendor {
 	case enumor.TCloud:
 		return batchCreateLoadBalancer[corelb.TCloudClbExtension](cts, svc, vendor)
+	case enumor.Aws:
+		return batchCreateLoadBalancer[corelb.AwsLoadBalancerExtension](cts, svc, vendor)
+	case enumor.Azure:
+		return batchCreateLoadBalancer[corelb.AzureLoadBalancerExtension](cts, svc, vendor)
+	case enumor.HuaWei:
+		return batchCreateLoadBalancer[corelb.HuaWeiLoadBalancerExtension](cts, svc, vendor)
+	case enumor.Gcp:
+		return batchCreateLoadBalancer[corelb.GcpLoadBalancerExtension](cts, svc, vendor)
 	default:
 		return nil, errf.New(errf.InvalidParameter, "unsupported vendor: "+string(vendor))
 	}
@@ -151,6 +159,8 @@ func (svc *lbSvc) BatchCreateTargetGroup(cts *rest.Contexts) (any, error) {
 	switch vendor {
 	case enumor.TCloud:
 		return batchCreateTargetGroup[corelb.TCloudTargetGroupExtension](cts, svc, vendor)
+	case enumor.Aws:
+		return batchCreateTargetGroup[corelb.AwsTargetGroupExtension](cts, svc, vendor)
 	default:
 		return nil, errf.New(errf.InvalidParameter, "unsupported vendor: "+string(vendor))
 	}
@@ -233,6 +243,7 @@ func convTargetGroupCreateReqToTable[T corelb.TargetGroupExtension](kt *kit.Kit,
 	}
 
 	targetGroup := &tablelb.LoadBalancerTargetGroupTable{
+		CloudID:         tg.CloudID,
 		Name:            tg.Name,
 		Vendor:          vendor,
 		AccountID:       tg.AccountID,
@@ -550,6 +561,8 @@ func (svc *lbSvc) BatchCreateListener(cts *rest.Contexts) (any, error) {
 	switch vendor {
 	case enumor.TCloud:
 		return batchCreateListener[corelb.TCloudListenerExtension](cts, svc)
+	case enumor.Aws:
+		return batchCreateListener[corelb.AwsListenerExtension](cts, svc)
 	default:
 		return nil, errf.New(errf.InvalidParameter, "unsupported vendor: "+string(vendor))
 	}
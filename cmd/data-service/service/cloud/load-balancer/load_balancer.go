@@ -53,7 +53,8 @@ func InitService(cap *capability.Capability) {
 	// 监听器
 	h.Add("GetListener", http.MethodGet, "/vendors/{vendor}/listeners/{id}", svc.GetListener)
 	h.Add("ListListener", http.MethodPost, "/load_balancers/listeners/list", svc.ListListener)
-	h.Add("ListListenerExt", http.MethodPost, "/vendors/tcloud/load_balancers/listeners/list", svc.ListListenerExt)
+	h.Add("ListListenerExt", http.MethodPost, "/vendors/{vendor}/load_balancers/listeners/list",
+		svc.ListListenerExt)
 	h.Add("BatchCreateListener", http.MethodPost, "/vendors/{vendor}/listeners/batch/create", svc.BatchCreateListener)
 	h.Add("BatchCreateListenerWithRule", http.MethodPost, "/vendors/{vendor}/listeners/rules/batch/create",
 		svc.BatchCreateListenerWithRule)
@@ -79,7 +80,11 @@ func InitService(cap *capability.Capability) {
 		"/vendors/{vendor}/target_groups/with/rels/batch/create", svc.BatchCreateTargetGroupWithRel)
 	h.Add("GetTargetGroup", http.MethodGet, "/vendors/{vendor}/target_groups/{id}", svc.GetTargetGroup)
 	h.Add("ListTargetGroup", http.MethodPost, "/load_balancers/target_groups/list", svc.ListTargetGroup)
+	h.Add("ListTargetGroupExt", http.MethodPost, "/vendors/{vendor}/load_balancers/target_groups/list",
+		svc.ListTargetGroupExt)
 	h.Add("UpdateTargetGroup", http.MethodPatch, "/vendors/{vendor}/target_groups", svc.UpdateTargetGroup)
+	h.Add("BatchUpdateTargetGroupExt", http.MethodPatch, "/vendors/{vendor}/target_groups/batch/update",
+		svc.BatchUpdateTargetGroupExt)
 	h.Add("BatchDeleteTargetGroup", http.MethodDelete, "/target_groups/batch", svc.BatchDeleteTargetGroup)
 	h.Add("BatchUpdateListenerBizInfo", http.MethodPatch,
 		"/load_balancers/target_groups/bizs/batch/update", svc.BatchUpdateTargetGroupBizInfo)
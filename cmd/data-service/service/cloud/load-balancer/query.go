@@ -178,6 +178,14 @@ func (svc *lbSvc) ListLoadBalancerExt(cts *rest.Contexts) (interface{}, error) {
 	switch vendor {
 	case enumor.TCloud:
 		return convLbListResult[corelb.TCloudClbExtension](data.Details)
+	case enumor.Aws:
+		return convLbListResult[corelb.AwsLoadBalancerExtension](data.Details)
+	case enumor.Azure:
+		return convLbListResult[corelb.AzureLoadBalancerExtension](data.Details)
+	case enumor.HuaWei:
+		return convLbListResult[corelb.HuaWeiLoadBalancerExtension](data.Details)
+	case enumor.Gcp:
+		return convLbListResult[corelb.GcpLoadBalancerExtension](data.Details)
 	default:
 		return nil, errf.Newf(errf.InvalidParameter, "unsupported vendor: %s", vendor)
 	}
@@ -212,7 +220,15 @@ func (svc *lbSvc) GetLoadBalancer(cts *rest.Contexts) (any, error) {
 	lbTable := result.Details[0]
 	switch lbTable.Vendor {
 	case enumor.TCloud:
-		return convLoadBalancerWithExt(&lbTable)
+		return convLoadBalancerWithExt[corelb.TCloudClbExtension](&lbTable)
+	case enumor.Aws:
+		return convLoadBalancerWithExt[corelb.AwsLoadBalancerExtension](&lbTable)
+	case enumor.Azure:
+		return convLoadBalancerWithExt[corelb.AzureLoadBalancerExtension](&lbTable)
+	case enumor.HuaWei:
+		return convLoadBalancerWithExt[corelb.HuaWeiLoadBalancerExtension](&lbTable)
+	case enumor.Gcp:
+		return convLoadBalancerWithExt[corelb.GcpLoadBalancerExtension](&lbTable)
 	default:
 		return nil, fmt.Errorf("unsupport vendor: %s", vendor)
 	}
@@ -291,6 +307,22 @@ func (svc *lbSvc) ListListener(cts *rest.Contexts) (interface{}, error) {
 
 // ListListenerExt list listener with extension.
 func (svc *lbSvc) ListListenerExt(cts *rest.Contexts) (any, error) {
+	vendor := enumor.Vendor(cts.PathParameter("vendor").String())
+	if err := vendor.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	switch vendor {
+	case enumor.TCloud:
+		return listListenerExt[corelb.TCloudListenerExtension](cts, svc)
+	case enumor.Aws:
+		return listListenerExt[corelb.AwsListenerExtension](cts, svc)
+	default:
+		return nil, errf.Newf(errf.InvalidParameter, "unsupported vendor: %s", vendor)
+	}
+}
+
+func listListenerExt[T corelb.ListenerExtension](cts *rest.Contexts, svc *lbSvc) (any, error) {
 	req := new(core.ListReq)
 	if err := cts.DecodeInto(req); err != nil {
 		return nil, err
@@ -312,19 +344,20 @@ func (svc *lbSvc) ListListenerExt(cts *rest.Contexts) (any, error) {
 	}
 
 	if req.Page.Count {
-		return &protocloud.ListenerListResult{Count: result.Count}, nil
+		return &core.ListResultT[corelb.Listener[T]]{Count: result.Count}, nil
 	}
 
-	details := make([]corelb.Listener[corelb.TCloudListenerExtension], 0, len(result.Details))
+	details := make([]corelb.Listener[T], 0, len(result.Details))
 	for _, one := range result.Details {
-		tmpOne, err := convTableToListener[corelb.TCloudListenerExtension](&one)
+		tmpOne, err := convTableToListener[T](&one)
 		if err != nil {
 			logs.Errorf("fail to conv listener with extension, err: %v, rid: %s", err, cts.Kit.Rid)
+			continue
 		}
 		details = append(details, *tmpOne)
 	}
 
-	return &protocloud.TCloudListenerListResult{Details: details}, nil
+	return &core.ListResultT[corelb.Listener[T]]{Details: details}, nil
 }
 
 func convTableToBaseListener(one *tablelb.LoadBalancerListenerTable) *corelb.BaseListener {
@@ -580,11 +613,86 @@ func (svc *lbSvc) GetTargetGroup(cts *rest.Contexts) (any, error) {
 	switch tgInfo.Vendor {
 	case enumor.TCloud:
 		return convTableToBaseTargetGroup(cts.Kit, &tgInfo)
+	case enumor.Aws:
+		return convTableToTargetGroup[corelb.AwsTargetGroupExtension](cts.Kit, &tgInfo)
 	default:
 		return nil, fmt.Errorf("unsupport vendor: %s", vendor)
 	}
 }
 
+// ListTargetGroupExt list target group with extension.
+func (svc *lbSvc) ListTargetGroupExt(cts *rest.Contexts) (any, error) {
+	vendor := enumor.Vendor(cts.PathParameter("vendor").String())
+	if err := vendor.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	switch vendor {
+	case enumor.TCloud:
+		return listTargetGroupExt[corelb.TCloudTargetGroupExtension](cts, svc)
+	case enumor.Aws:
+		return listTargetGroupExt[corelb.AwsTargetGroupExtension](cts, svc)
+	default:
+		return nil, errf.Newf(errf.InvalidParameter, "unsupported vendor: %s", vendor)
+	}
+}
+
+func listTargetGroupExt[T corelb.TargetGroupExtension](cts *rest.Contexts, svc *lbSvc) (any, error) {
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: req.Fields,
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	result, err := svc.dao.LoadBalancerTargetGroup().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list target group failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, fmt.Errorf("list target group failed, err: %v", err)
+	}
+
+	if req.Page.Count {
+		return &protocloud.TargetGroupExtListResult[T]{Count: result.Count}, nil
+	}
+
+	details := make([]corelb.TargetGroup[T], 0, len(result.Details))
+	for _, one := range result.Details {
+		tmpOne, err := convTableToTargetGroup[T](cts.Kit, &one)
+		if err != nil {
+			continue
+		}
+		details = append(details, *tmpOne)
+	}
+
+	return &protocloud.TargetGroupExtListResult[T]{Details: details}, nil
+}
+
+func convTableToTargetGroup[T corelb.TargetGroupExtension](kt *kit.Kit, one *tablelb.LoadBalancerTargetGroupTable) (
+	*corelb.TargetGroup[T], error) {
+
+	base, err := convTableToBaseTargetGroup(kt, one)
+	if err != nil {
+		return nil, err
+	}
+
+	extension := new(T)
+	if len(one.Extension) != 0 {
+		if err = json.UnmarshalFromString(string(one.Extension), extension); err != nil {
+			logs.Errorf("unmarshal target group extension failed, id: %s, err: %v, rid: %s", one.ID, err, kt.Rid)
+			return nil, err
+		}
+	}
+
+	return &corelb.TargetGroup[T]{BaseTargetGroup: *base, Extension: extension}, nil
+}
+
 func convTableToBaseTargetGroup(kt *kit.Kit, one *tablelb.LoadBalancerTargetGroupTable) (
 	*corelb.BaseTargetGroup, error) {
 
@@ -658,6 +766,13 @@ func (svc *lbSvc) GetListener(cts *rest.Contexts) (any, error) {
 			return nil, err
 		}
 		return newLblInfo, nil
+	case enumor.Aws:
+		newLblInfo, err := convTableToListener[corelb.AwsListenerExtension](&lblInfo)
+		if err != nil {
+			logs.Errorf("fail to conv listener with extension, lblID: %s, err: %v, rid: %s", id, err, cts.Kit.Rid)
+			return nil, err
+		}
+		return newLblInfo, nil
 	default:
 		return nil, fmt.Errorf("unsupport vendor: %s", vendor)
 	}
@@ -54,6 +54,14 @@ func (svc *lbSvc) BatchUpdateLoadBalancer(cts *rest.Contexts) (any, error) {
 	switch vendor {
 	case enumor.TCloud:
 		return batchUpdateLoadBalancer[corelb.TCloudClbExtension](cts, svc)
+	case enumor.Aws:
+		return batchUpdateLoadBalancer[corelb.AwsLoadBalancerExtension](cts, svc)
+	case enumor.Azure:
+		return batchUpdateLoadBalancer[corelb.AzureLoadBalancerExtension](cts, svc)
+	case enumor.HuaWei:
+		return batchUpdateLoadBalancer[corelb.HuaWeiLoadBalancerExtension](cts, svc)
+	case enumor.Gcp:
+		return batchUpdateLoadBalancer[corelb.GcpLoadBalancerExtension](cts, svc)
 
 	default:
 		return nil, fmt.Errorf("unsupport  vendor %s", vendor)
@@ -275,6 +283,94 @@ func (svc *lbSvc) UpdateTargetGroup(cts *rest.Contexts) (interface{}, error) {
 	return nil, nil
 }
 
+// BatchUpdateTargetGroupExt 批量更新目标组，包括拓展字段
+func (svc *lbSvc) BatchUpdateTargetGroupExt(cts *rest.Contexts) (any, error) {
+	vendor := enumor.Vendor(cts.PathParameter("vendor").String())
+	if err := vendor.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	switch vendor {
+	case enumor.TCloud:
+		return batchUpdateTargetGroupExt[corelb.TCloudTargetGroupExtension](cts, svc)
+	case enumor.Aws:
+		return batchUpdateTargetGroupExt[corelb.AwsTargetGroupExtension](cts, svc)
+	default:
+		return nil, errf.New(errf.InvalidParameter, "unsupported vendor: "+string(vendor))
+	}
+}
+
+func batchUpdateTargetGroupExt[T corelb.TargetGroupExtension](cts *rest.Contexts, svc *lbSvc) (any, error) {
+	req := new(dataproto.TargetGroupBatchUpdateReq[T])
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	if len(*req) == 0 {
+		return nil, nil
+	}
+
+	tgIDs := slice.Map(*req, func(one *dataproto.TargetGroupExtUpdateReq[T]) string { return one.ID })
+	opt := &types.ListOption{
+		Filter: tools.ContainersExpression("id", tgIDs),
+		Page:   &core.BasePage{Limit: core.DefaultMaxPageLimit},
+	}
+	tgList, err := svc.dao.LoadBalancerTargetGroup().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list target group failed, ids: %v, err: %v, rid: %s", tgIDs, err, cts.Kit.Rid)
+		return nil, err
+	}
+	extensionMap := converter.SliceToMap(tgList.Details,
+		func(t tablelb.LoadBalancerTargetGroupTable) (string, tabletype.JsonField) { return t.ID, t.Extension })
+
+	_, err = svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (any, error) {
+		for _, tg := range *req {
+			update := &tablelb.LoadBalancerTargetGroupTable{
+				Name:            tg.Name,
+				BkBizID:         tg.BkBizID,
+				TargetGroupType: tg.TargetGroupType,
+				VpcID:           tg.VpcID,
+				CloudVpcID:      tg.CloudVpcID,
+				Region:          tg.Region,
+				Protocol:        tg.Protocol,
+				Port:            tg.Port,
+				HealthCheck:     tg.HealthCheck,
+				Memo:            tg.Memo,
+				Reviser:         cts.Kit.User,
+			}
+
+			if tg.Extension != nil {
+				extension, exist := extensionMap[tg.ID]
+				if !exist {
+					continue
+				}
+
+				merge, err := json.UpdateMerge(tg.Extension, string(extension))
+				if err != nil {
+					return nil, fmt.Errorf("json UpdateMerge extension failed, err: %v", err)
+				}
+				update.Extension = tabletype.JsonField(merge)
+			}
+
+			if err := svc.dao.LoadBalancerTargetGroup().UpdateByIDWithTx(cts.Kit, txn, tg.ID, update); err != nil {
+				logs.Errorf("update target group by id failed, err: %v, id: %s, rid: %s", err, tg.ID, cts.Kit.Rid)
+				return nil, fmt.Errorf("update target group failed, err: %v", err)
+			}
+		}
+
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
 // BatchUpdateTCloudUrlRule ..
 func (svc *lbSvc) BatchUpdateTCloudUrlRule(cts *rest.Contexts) (any, error) {
 	req := new(dataproto.TCloudUrlRuleBatchUpdateReq)
@@ -382,6 +478,8 @@ func (svc *lbSvc) BatchUpdateListener(cts *rest.Contexts) (any, error) {
 	switch vendor {
 	case enumor.TCloud:
 		return batchUpdateListener[corelb.TCloudListenerExtension](cts)
+	case enumor.Aws:
+		return batchUpdateListener[corelb.AwsListenerExtension](cts)
 	default:
 		return nil, errf.New(errf.InvalidParameter, "unsupported vendor: "+string(vendor))
 	}
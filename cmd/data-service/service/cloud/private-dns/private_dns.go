@@ -0,0 +1,73 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package privatedns 私有域及解析记录的DB接口
+package privatedns
+
+import (
+	"net/http"
+
+	"hcm/cmd/data-service/service/capability"
+	"hcm/pkg/dal/dao"
+	"hcm/pkg/rest"
+)
+
+var svc *privateDnsSvc
+
+// InitService initial the private dns zone and record service
+func InitService(cap *capability.Capability) {
+	svc = &privateDnsSvc{
+		dao: cap.Dao,
+	}
+
+	h := rest.NewHandler()
+
+	h.Add("BatchCreatePrivateDnsZone", http.MethodPost, "/vendors/{vendor}/private_dns_zones/batch/create",
+		svc.BatchCreatePrivateDnsZone)
+	h.Add("ListPrivateDnsZone", http.MethodPost, "/private_dns_zones/list", svc.ListPrivateDnsZone)
+	h.Add("ListPrivateDnsZoneExt", http.MethodPost, "/vendors/{vendor}/private_dns_zones/list",
+		svc.ListPrivateDnsZoneExt)
+	h.Add("BatchUpdatePrivateDnsZoneExt", http.MethodPatch, "/vendors/{vendor}/private_dns_zones",
+		svc.BatchUpdatePrivateDnsZoneExt)
+	h.Add("BatchUpdatePrivateDnsZone", http.MethodPatch, "/private_dns_zones/batch/update",
+		svc.BatchUpdatePrivateDnsZone)
+	h.Add("BatchDeletePrivateDnsZone", http.MethodDelete, "/private_dns_zones/batch",
+		svc.BatchDeletePrivateDnsZone)
+
+	h.Add("BatchCreatePrivateDnsZoneVpcRel", http.MethodPost, "/private_dns_zone_vpc_rels/batch/create",
+		svc.BatchCreatePrivateDnsZoneVpcRel)
+	h.Add("ListPrivateDnsZoneVpcRel", http.MethodPost, "/private_dns_zone_vpc_rels/list",
+		svc.ListPrivateDnsZoneVpcRel)
+	h.Add("BatchDeletePrivateDnsZoneVpcRel", http.MethodDelete, "/private_dns_zone_vpc_rels/batch",
+		svc.BatchDeletePrivateDnsZoneVpcRel)
+
+	h.Add("BatchCreatePrivateDnsRecord", http.MethodPost, "/private_dns_records/batch/create",
+		svc.BatchCreatePrivateDnsRecord)
+	h.Add("ListPrivateDnsRecord", http.MethodPost, "/private_dns_records/list", svc.ListPrivateDnsRecord)
+	h.Add("BatchUpdatePrivateDnsRecord", http.MethodPatch, "/private_dns_records/batch/update",
+		svc.BatchUpdatePrivateDnsRecord)
+	h.Add("BatchDeletePrivateDnsRecord", http.MethodDelete, "/private_dns_records/batch",
+		svc.BatchDeletePrivateDnsRecord)
+
+	h.Load(cap.WebService)
+}
+
+type privateDnsSvc struct {
+	dao dao.Set
+}
@@ -0,0 +1,189 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package privatedns
+
+import (
+	"fmt"
+	"reflect"
+
+	"hcm/pkg/api/core"
+	coreprivatedns "hcm/pkg/api/core/cloud/private-dns"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/orm"
+	"hcm/pkg/dal/dao/types"
+	tableprivatedns "hcm/pkg/dal/table/cloud/private-dns"
+	tabletype "hcm/pkg/dal/table/types"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// BatchCreatePrivateDnsRecord batch create private dns record.
+func (svc *privateDnsSvc) BatchCreatePrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.PrivateDnsRecordBatchCreateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	result, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		models := make([]*tableprivatedns.RecordTable, 0, len(req.Records))
+		for _, one := range req.Records {
+			models = append(models, &tableprivatedns.RecordTable{
+				Vendor:      one.Vendor,
+				AccountID:   one.AccountID,
+				ZoneID:      one.ZoneID,
+				CloudZoneID: one.CloudZoneID,
+				CloudID:     one.CloudID,
+				Name:        one.Name,
+				Type:        string(one.Type),
+				Value:       one.Value,
+				TTL:         one.TTL,
+				Creator:     cts.Kit.User,
+				Reviser:     cts.Kit.User,
+			})
+		}
+
+		return svc.dao.PrivateDnsRecord().BatchCreateWithTx(cts.Kit, txn, models)
+	})
+	if err != nil {
+		logs.Errorf("batch create private dns record failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	ids, ok := result.([]string)
+	if !ok {
+		return nil, fmt.Errorf("batch create private dns record but return id type is not []string, id type: %v",
+			reflect.TypeOf(result).String())
+	}
+
+	return &core.BatchCreateResult{IDs: ids}, nil
+}
+
+// ListPrivateDnsRecord list private dns record.
+func (svc *privateDnsSvc) ListPrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: req.Fields,
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	result, err := svc.dao.PrivateDnsRecord().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list private dns record failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, fmt.Errorf("list private dns record failed, err: %v", err)
+	}
+
+	if req.Page.Count {
+		return &protocloud.PrivateDnsRecordListResult{Count: result.Count}, nil
+	}
+
+	details := make([]coreprivatedns.Record, 0, len(result.Details))
+	for _, one := range result.Details {
+		details = append(details, coreprivatedns.Record{
+			ID:          one.ID,
+			Vendor:      one.Vendor,
+			AccountID:   one.AccountID,
+			ZoneID:      one.ZoneID,
+			CloudZoneID: one.CloudZoneID,
+			CloudID:     one.CloudID,
+			Name:        one.Name,
+			Type:        enumor.PrivateDnsRecordType(one.Type),
+			Value:       one.Value,
+			TTL:         one.TTL,
+			Revision: &core.Revision{
+				Creator:   one.Creator,
+				Reviser:   one.Reviser,
+				CreatedAt: one.CreatedAt.String(),
+				UpdatedAt: one.UpdatedAt.String(),
+			},
+		})
+	}
+
+	return &protocloud.PrivateDnsRecordListResult{Details: details}, nil
+}
+
+// BatchUpdatePrivateDnsRecord batch update private dns record.
+func (svc *privateDnsSvc) BatchUpdatePrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.PrivateDnsRecordBatchUpdateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	_, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		for _, item := range req.Records {
+			updateData := &tableprivatedns.RecordTable{
+				Value:   tabletype.StringArray(item.Value),
+				TTL:     item.TTL,
+				Reviser: cts.Kit.User,
+			}
+			if err := svc.dao.PrivateDnsRecord().UpdateByIDWithTx(cts.Kit, txn, item.ID, updateData); err != nil {
+				return nil, fmt.Errorf("update private dns record db failed, err: %v", err)
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		logs.Errorf("batch update private dns record failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// BatchDeletePrivateDnsRecord batch delete private dns record.
+func (svc *privateDnsSvc) BatchDeletePrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.PrivateDnsRecordBatchDeleteReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	_, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		return nil, svc.dao.PrivateDnsRecord().DeleteWithTx(cts.Kit, txn, req.Filter)
+	})
+	if err != nil {
+		logs.Errorf("delete private dns record failed, err: %v, filter: %s, rid: %s", err, req.Filter,
+			cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,127 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package privatedns
+
+import (
+	"hcm/pkg/api/core"
+	coreprivatedns "hcm/pkg/api/core/cloud/private-dns"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/orm"
+	"hcm/pkg/dal/dao/types"
+	tableprivatedns "hcm/pkg/dal/table/cloud/private-dns"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// BatchCreatePrivateDnsZoneVpcRel batch create private dns zone vpc rels.
+func (svc *privateDnsSvc) BatchCreatePrivateDnsZoneVpcRel(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.PrivateDnsZoneVpcRelBatchCreateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	_, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		rels := make([]*tableprivatedns.ZoneVpcRelTable, 0, len(req.Rels))
+		for _, one := range req.Rels {
+			rels = append(rels, &tableprivatedns.ZoneVpcRelTable{
+				ZoneID:  one.ZoneID,
+				VpcID:   one.VpcID,
+				Creator: cts.Kit.User,
+			})
+		}
+
+		return nil, svc.dao.PrivateDnsZoneVpcRel().BatchCreateWithTx(cts.Kit, txn, rels)
+	})
+	if err != nil {
+		logs.Errorf("batch create private dns zone vpc rel failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// ListPrivateDnsZoneVpcRel list private dns zone vpc rels.
+func (svc *privateDnsSvc) ListPrivateDnsZoneVpcRel(cts *rest.Contexts) (interface{}, error) {
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: req.Fields,
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	result, err := svc.dao.PrivateDnsZoneVpcRel().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list private dns zone vpc rel failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if req.Page.Count {
+		return &protocloud.PrivateDnsZoneVpcRelListResult{Count: result.Count}, nil
+	}
+
+	details := make([]coreprivatedns.ZoneVpcRel, 0, len(result.Details))
+	for _, one := range result.Details {
+		details = append(details, coreprivatedns.ZoneVpcRel{
+			ID:        one.ID,
+			ZoneID:    one.ZoneID,
+			VpcID:     one.VpcID,
+			Creator:   one.Creator,
+			CreatedAt: one.CreatedAt.String(),
+		})
+	}
+
+	return &protocloud.PrivateDnsZoneVpcRelListResult{Details: details}, nil
+}
+
+// BatchDeletePrivateDnsZoneVpcRel batch delete private dns zone vpc rels.
+func (svc *privateDnsSvc) BatchDeletePrivateDnsZoneVpcRel(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.PrivateDnsZoneVpcRelBatchDeleteReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	_, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		return nil, svc.dao.PrivateDnsZoneVpcRel().DeleteWithTx(cts.Kit, txn, req.Filter)
+	})
+	if err != nil {
+		logs.Errorf("delete private dns zone vpc rel failed, err: %v, filter: %s, rid: %s", err, req.Filter,
+			cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
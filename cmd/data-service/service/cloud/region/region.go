@@ -65,6 +65,10 @@ func (svc *regionSvc) BatchCreateRegion(cts *rest.Contexts) (interface{}, error)
 		return svc.BatchCreateHuaWeiRegion(cts)
 	case enumor.Azure:
 		return svc.BatchCreateAzureRegion(cts)
+	case enumor.Zenlayer:
+		return svc.BatchCreateZenlayerRegion(cts)
+	case enumor.Kaopu:
+		return svc.BatchCreateKaopuRegion(cts)
 	}
 
 	return nil, nil
@@ -89,6 +93,10 @@ func (svc *regionSvc) BatchUpdateRegion(cts *rest.Contexts) (interface{}, error)
 		return svc.BatchUpdateHuaWeiRegion(cts)
 	case enumor.Azure:
 		return svc.BatchUpdateAzureRegion(cts)
+	case enumor.Zenlayer:
+		err = svc.BatchUpdateZenlayerRegion(cts)
+	case enumor.Kaopu:
+		err = svc.BatchUpdateKaopuRegion(cts)
 	}
 
 	return nil, err
@@ -112,6 +120,10 @@ func (svc *regionSvc) ListRegion(cts *rest.Contexts) (interface{}, error) {
 		return svc.ListHuaWeiRegion(cts)
 	case enumor.Azure:
 		return svc.ListAzureRegion(cts)
+	case enumor.Zenlayer:
+		return svc.ListZenlayerRegion(cts)
+	case enumor.Kaopu:
+		return svc.ListKaopuRegion(cts)
 	}
 
 	return nil, nil
@@ -136,6 +148,10 @@ func (svc *regionSvc) BatchDeleteRegion(cts *rest.Contexts) (interface{}, error)
 		return svc.BatchDeleteHuaWeiRegion(cts)
 	case enumor.Azure:
 		return svc.BatchDeleteAzureRegion(cts)
+	case enumor.Zenlayer:
+		err = svc.BatchDeleteZenlayerRegion(cts)
+	case enumor.Kaopu:
+		err = svc.BatchDeleteKaopuRegion(cts)
 	}
 
 	return nil, err
@@ -121,6 +121,8 @@ func (svc *routeTableSvc) BatchCreateAwsRoute(cts *rest.Contexts) (interface{},
 				CloudNetworkInterfaceID:          createReq.CloudNetworkInterfaceID,
 				CloudTransitGatewayID:            createReq.CloudTransitGatewayID,
 				CloudVpcPeeringConnectionID:      createReq.CloudVpcPeeringConnectionID,
+				NextHopResType:                   createReq.NextHopResType,
+				NextHopResID:                     createReq.NextHopResID,
 				State:                            createReq.State,
 				Propagated:                       &createReq.Propagated,
 				Creator:                          cts.Kit.User,
@@ -213,6 +215,8 @@ func (svc *routeTableSvc) BatchUpdateAwsRoute(cts *rest.Contexts) (interface{},
 		route.CloudNetworkInterfaceID = updateReq.CloudNetworkInterfaceID
 		route.CloudTransitGatewayID = updateReq.CloudTransitGatewayID
 		route.CloudVpcPeeringConnectionID = updateReq.CloudVpcPeeringConnectionID
+		route.NextHopResType = updateReq.NextHopResType
+		route.NextHopResID = updateReq.NextHopResID
 		route.State = updateReq.State
 		route.Propagated = updateReq.Propagated
 
@@ -282,6 +286,8 @@ func (svc *routeTableSvc) ListAwsRoute(cts *rest.Contexts) (interface{}, error)
 			CloudNetworkInterfaceID:          route.CloudNetworkInterfaceID,
 			CloudTransitGatewayID:            route.CloudTransitGatewayID,
 			CloudVpcPeeringConnectionID:      route.CloudVpcPeeringConnectionID,
+			NextHopResType:                   route.NextHopResType,
+			NextHopResID:                     route.NextHopResID,
 			State:                            route.State,
 			Propagated:                       converter.PtrToVal(route.Propagated),
 			Revision: &core.Revision{
@@ -352,6 +358,8 @@ func (svc *routeTableSvc) ListAllAwsRoute(cts *rest.Contexts) (interface{}, erro
 			CloudNetworkInterfaceID:          route.CloudNetworkInterfaceID,
 			CloudTransitGatewayID:            route.CloudTransitGatewayID,
 			CloudVpcPeeringConnectionID:      route.CloudVpcPeeringConnectionID,
+			NextHopResType:                   route.NextHopResType,
+			NextHopResID:                     route.NextHopResID,
 			State:                            route.State,
 			Propagated:                       converter.PtrToVal(route.Propagated),
 			Revision: &core.Revision{
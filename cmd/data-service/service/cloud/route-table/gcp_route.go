@@ -106,6 +106,8 @@ func (svc *routeTableSvc) BatchCreateGcpRoute(cts *rest.Contexts) (interface{},
 				NextHopIp:        createReq.NextHopIp,
 				NextHopNetwork:   createReq.NextHopNetwork,
 				NextHopPeering:   createReq.NextHopPeering,
+				NextHopResType:   createReq.NextHopResType,
+				NextHopResID:     createReq.NextHopResID,
 				NextHopVpnTunnel: createReq.NextHopVpnTunnel,
 				Priority:         createReq.Priority,
 				RouteStatus:      createReq.RouteStatus,
@@ -323,6 +325,8 @@ func (svc *routeTableSvc) ListGcpRoute(cts *rest.Contexts) (interface{}, error)
 			NextHopIp:        route.NextHopIp,
 			NextHopNetwork:   route.NextHopNetwork,
 			NextHopPeering:   route.NextHopPeering,
+			NextHopResType:   route.NextHopResType,
+			NextHopResID:     route.NextHopResID,
 			NextHopVpnTunnel: route.NextHopVpnTunnel,
 			Priority:         route.Priority,
 			RouteStatus:      route.RouteStatus,
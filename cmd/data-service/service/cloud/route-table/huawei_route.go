@@ -109,6 +109,8 @@ func (svc *routeTableSvc) BatchCreateHuaWeiRoute(cts *rest.Contexts) (interface{
 				Type:              createReq.Type,
 				Destination:       createReq.Destination,
 				NextHop:           createReq.NextHop,
+				NextHopResType:    createReq.NextHopResType,
+				NextHopResID:      createReq.NextHopResID,
 				Memo:              createReq.Memo,
 				Creator:           cts.Kit.User,
 				Reviser:           cts.Kit.User,
@@ -188,6 +190,8 @@ func (svc *routeTableSvc) BatchUpdateHuaWeiRoute(cts *rest.Contexts) (interface{
 		route.Type = updateReq.Type
 		route.Destination = updateReq.Destination
 		route.NextHop = updateReq.NextHop
+		route.NextHopResType = updateReq.NextHopResType
+		route.NextHopResID = updateReq.NextHopResID
 		route.Memo = updateReq.Memo
 
 		err = svc.dao.Route().HuaWei().Update(cts.Kit, tools.EqualExpression("id", updateReq.ID), route)
@@ -245,6 +249,8 @@ func (svc *routeTableSvc) ListHuaWeiRoute(cts *rest.Contexts) (interface{}, erro
 			Type:              route.Type,
 			Destination:       route.Destination,
 			NextHop:           route.NextHop,
+			NextHopResType:    route.NextHopResType,
+			NextHopResID:      route.NextHopResID,
 			Memo:              route.Memo,
 			Revision: &core.Revision{
 				Creator:   route.Creator,
@@ -303,6 +309,8 @@ func (svc *routeTableSvc) ListAllHuaWeiRoute(cts *rest.Contexts) (interface{}, e
 			Type:              route.Type,
 			Destination:       route.Destination,
 			NextHop:           route.NextHop,
+			NextHopResType:    route.NextHopResType,
+			NextHopResID:      route.NextHopResID,
 			Memo:              route.Memo,
 			Revision: &core.Revision{
 				Creator:   route.Creator,
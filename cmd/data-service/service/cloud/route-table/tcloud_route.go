@@ -112,6 +112,8 @@ func (svc *routeTableSvc) BatchCreateTCloudRoute(cts *rest.Contexts) (interface{
 				DestinationIpv6CidrBlock: createReq.DestinationIpv6CidrBlock,
 				GatewayType:              createReq.GatewayType,
 				CloudGatewayID:           createReq.CloudGatewayID,
+				NextHopResType:           createReq.NextHopResType,
+				NextHopResID:             createReq.NextHopResID,
 				Enabled:                  &createReq.Enabled,
 				RouteType:                createReq.RouteType,
 				PublishedToVbc:           &createReq.PublishedToVbc,
@@ -195,6 +197,8 @@ func (svc *routeTableSvc) BatchUpdateTCloudRoute(cts *rest.Contexts) (interface{
 		route.DestinationIpv6CidrBlock = updateReq.DestinationIpv6CidrBlock
 		route.GatewayType = updateReq.GatewayType
 		route.CloudGatewayID = updateReq.CloudGatewayID
+		route.NextHopResType = updateReq.NextHopResType
+		route.NextHopResID = updateReq.NextHopResID
 		route.Enabled = updateReq.Enabled
 		route.RouteType = updateReq.RouteType
 		route.PublishedToVbc = updateReq.PublishedToVbc
@@ -257,6 +261,8 @@ func (svc *routeTableSvc) ListTCloudRoute(cts *rest.Contexts) (interface{}, erro
 			DestinationIpv6CidrBlock: route.DestinationIpv6CidrBlock,
 			GatewayType:              route.GatewayType,
 			CloudGatewayID:           route.CloudGatewayID,
+			NextHopResType:           route.NextHopResType,
+			NextHopResID:             route.NextHopResID,
 			Enabled:                  converter.PtrToVal(route.Enabled),
 			RouteType:                route.RouteType,
 			PublishedToVbc:           converter.PtrToVal(route.PublishedToVbc),
@@ -320,6 +326,8 @@ func (svc *routeTableSvc) ListAllTCloudRoute(cts *rest.Contexts) (interface{}, e
 			DestinationIpv6CidrBlock: route.DestinationIpv6CidrBlock,
 			GatewayType:              route.GatewayType,
 			CloudGatewayID:           route.CloudGatewayID,
+			NextHopResType:           route.NextHopResType,
+			NextHopResID:             route.NextHopResID,
 			Enabled:                  converter.PtrToVal(route.Enabled),
 			RouteType:                route.RouteType,
 			PublishedToVbc:           converter.PtrToVal(route.PublishedToVbc),
@@ -0,0 +1,188 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package snapshot
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	coresnapshot "hcm/pkg/api/core/cloud/snapshot"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/orm"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/dal/dao/types"
+	tablesnapshot "hcm/pkg/dal/table/cloud/snapshot"
+	tabletype "hcm/pkg/dal/table/types"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/converter"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// CreateSnapshotPolicy create snapshot policy.
+func (svc *snapshotSvc) CreateSnapshotPolicy(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.SnapshotPolicyCreateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	model := &tablesnapshot.SnapshotPolicyTable{
+		Vendor:        req.Vendor,
+		AccountID:     req.AccountID,
+		BkBizID:       req.BkBizID,
+		Name:          req.Name,
+		Spec:          req.Spec,
+		RetentionDays: converter.ValToPtr(req.RetentionDays),
+		DiskIDs:       tabletype.StringArray(req.DiskIDs),
+		Enabled:       req.Enabled,
+		CronFlowID:    req.CronFlowID,
+		Memo:          req.Memo,
+		Creator:       cts.Kit.User,
+		Reviser:       cts.Kit.User,
+	}
+	result, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		return svc.dao.SnapshotPolicy().BatchCreateWithTx(cts.Kit, txn,
+			[]*tablesnapshot.SnapshotPolicyTable{model})
+	})
+	if err != nil {
+		logs.Errorf("create snapshot policy failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	ids, ok := result.([]string)
+	if !ok || len(ids) != 1 {
+		return nil, fmt.Errorf("create snapshot policy but return ids is invalid, ids: %v", result)
+	}
+
+	return &core.CreateResult{ID: ids[0]}, nil
+}
+
+// ListSnapshotPolicy list snapshot policy.
+func (svc *snapshotSvc) ListSnapshotPolicy(cts *rest.Contexts) (interface{}, error) {
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: req.Fields,
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	result, err := svc.dao.SnapshotPolicy().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list snapshot policy failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if req.Page.Count {
+		return &protocloud.SnapshotPolicyListResult{Count: result.Count}, nil
+	}
+
+	details := make([]coresnapshot.Policy, 0, len(result.Details))
+	for _, one := range result.Details {
+		details = append(details, coresnapshot.Policy{
+			ID:            one.ID,
+			Vendor:        one.Vendor,
+			AccountID:     one.AccountID,
+			BkBizID:       one.BkBizID,
+			Name:          one.Name,
+			Spec:          one.Spec,
+			RetentionDays: converter.PtrToVal(one.RetentionDays),
+			DiskIDs:       one.DiskIDs,
+			Enabled:       converter.PtrToVal(one.Enabled),
+			CronFlowID:    one.CronFlowID,
+			Memo:          one.Memo,
+			Revision: &core.Revision{
+				Creator:   one.Creator,
+				Reviser:   one.Reviser,
+				CreatedAt: one.CreatedAt.String(),
+				UpdatedAt: one.UpdatedAt.String(),
+			},
+		})
+	}
+
+	return &protocloud.SnapshotPolicyListResult{Details: details}, nil
+}
+
+// UpdateSnapshotPolicy update snapshot policy.
+func (svc *snapshotSvc) UpdateSnapshotPolicy(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.SnapshotPolicyUpdateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	updateData := &tablesnapshot.SnapshotPolicyTable{
+		Name:          req.Name,
+		Spec:          req.Spec,
+		RetentionDays: req.RetentionDays,
+		Enabled:       req.Enabled,
+		CronFlowID:    req.CronFlowID,
+		Memo:          req.Memo,
+		Reviser:       cts.Kit.User,
+	}
+	if req.DiskIDs != nil {
+		updateData.DiskIDs = tabletype.StringArray(req.DiskIDs)
+	}
+
+	err := svc.dao.SnapshotPolicy().Update(cts.Kit, tools.EqualExpression("id", req.ID), updateData)
+	if err != nil {
+		logs.Errorf("update snapshot policy failed, err: %v, id: %s, rid: %s", err, req.ID, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// BatchDeleteSnapshotPolicy batch delete snapshot policy.
+func (svc *snapshotSvc) BatchDeleteSnapshotPolicy(cts *rest.Contexts) (interface{}, error) {
+	req := new(core.BatchDeleteReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	_, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		return nil, svc.dao.SnapshotPolicy().DeleteWithTx(cts.Kit, txn, tools.ContainersExpression("id", req.IDs))
+	})
+	if err != nil {
+		logs.Errorf("delete snapshot policy failed, ids: %v, err: %v, rid: %s", req.IDs, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
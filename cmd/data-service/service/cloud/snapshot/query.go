@@ -0,0 +1,167 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package snapshot
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	coresnapshot "hcm/pkg/api/core/cloud/snapshot"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/types"
+	tablesnapshot "hcm/pkg/dal/table/cloud/snapshot"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/json"
+)
+
+// ListSnapshot list snapshot.
+func (svc *snapshotSvc) ListSnapshot(cts *rest.Contexts) (interface{}, error) {
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: req.Fields,
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	result, err := svc.dao.Snapshot().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list snapshot failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, fmt.Errorf("list snapshot failed, err: %v", err)
+	}
+
+	if req.Page.Count {
+		return &protocloud.SnapshotListResult{Count: result.Count}, nil
+	}
+
+	details := make([]coresnapshot.BaseSnapshot, 0, len(result.Details))
+	for _, one := range result.Details {
+		details = append(details, *convTableToBaseSnapshot(&one))
+	}
+
+	return &protocloud.SnapshotListResult{Details: details}, nil
+}
+
+func convTableToBaseSnapshot(one *tablesnapshot.SnapshotTable) *coresnapshot.BaseSnapshot {
+	return &coresnapshot.BaseSnapshot{
+		ID:               one.ID,
+		Vendor:           one.Vendor,
+		AccountID:        one.AccountID,
+		CloudID:          one.CloudID,
+		BkBizID:          one.BkBizID,
+		Name:             one.Name,
+		Region:           one.Region,
+		Zone:             one.Zone,
+		Status:           one.Status,
+		Size:             one.Size,
+		DiskID:           one.DiskID,
+		CloudDiskID:      one.CloudDiskID,
+		CvmID:            one.CvmID,
+		CloudCvmID:       one.CloudCvmID,
+		PolicyID:         one.PolicyID,
+		CloudCreatedTime: one.CloudCreatedTime,
+		Memo:             one.Memo,
+		Revision: &core.Revision{
+			Creator:   one.Creator,
+			Reviser:   one.Reviser,
+			CreatedAt: one.CreatedAt.String(),
+			UpdatedAt: one.UpdatedAt.String(),
+		},
+	}
+}
+
+// ListSnapshotExt list snapshot with extension.
+func (svc *snapshotSvc) ListSnapshotExt(cts *rest.Contexts) (interface{}, error) {
+	vendor := enumor.Vendor(cts.Request.PathParameter("vendor"))
+	if err := vendor.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: req.Fields,
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	data, err := svc.dao.Snapshot().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list snapshot ext failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if req.Page.Count {
+		return &protocloud.SnapshotListResult{Count: data.Count}, nil
+	}
+
+	switch vendor {
+	case enumor.TCloud:
+		return convSnapshotExtListResult[coresnapshot.TCloudSnapshotExtension](cts.Kit, data.Details)
+	case enumor.Aws:
+		return convSnapshotExtListResult[coresnapshot.AwsSnapshotExtension](cts.Kit, data.Details)
+	case enumor.Azure:
+		return convSnapshotExtListResult[coresnapshot.AzureSnapshotExtension](cts.Kit, data.Details)
+	case enumor.Gcp:
+		return convSnapshotExtListResult[coresnapshot.GcpSnapshotExtension](cts.Kit, data.Details)
+	case enumor.HuaWei:
+		return convSnapshotExtListResult[coresnapshot.HuaWeiSnapshotExtension](cts.Kit, data.Details)
+	default:
+		return nil, errf.Newf(errf.InvalidParameter, "unsupported vendor: %s", vendor)
+	}
+}
+
+func convSnapshotExtListResult[T coresnapshot.Extension](kt *kit.Kit, tables []tablesnapshot.SnapshotTable) (
+	*protocloud.SnapshotExtListResult[T], error) {
+
+	details := make([]coresnapshot.Snapshot[T], 0, len(tables))
+	for _, one := range tables {
+		extension := new(T)
+		if len(one.Extension) != 0 {
+			if err := json.UnmarshalFromString(string(one.Extension), extension); err != nil {
+				logs.Errorf("unmarshal snapshot extension failed, err: %v, id: %s, rid: %s", err, one.ID, kt.Rid)
+				return nil, fmt.Errorf("unmarshal snapshot extension failed, err: %v", err)
+			}
+		}
+
+		details = append(details, coresnapshot.Snapshot[T]{
+			BaseSnapshot: *convTableToBaseSnapshot(&one),
+			Extension:    extension,
+		})
+	}
+
+	return &protocloud.SnapshotExtListResult[T]{Details: details}, nil
+}
@@ -0,0 +1,156 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package snapshot
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	coresnapshot "hcm/pkg/api/core/cloud/snapshot"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/orm"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/dal/dao/types"
+	tablesnapshot "hcm/pkg/dal/table/cloud/snapshot"
+	tabletype "hcm/pkg/dal/table/types"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/json"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// BatchUpdateSnapshot batch update snapshot local attribute, such as biz and policy.
+func (svc *snapshotSvc) BatchUpdateSnapshot(cts *rest.Contexts) (interface{}, error) {
+	req := new(protocloud.SnapshotBatchUpdateExprReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	updateData := &tablesnapshot.SnapshotTable{
+		BkBizID:  req.BkBizID,
+		PolicyID: req.PolicyID,
+		Memo:     req.Memo,
+		Reviser:  cts.Kit.User,
+	}
+	if err := svc.dao.Snapshot().Update(cts.Kit, tools.ContainersExpression("id", req.IDs), updateData); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// BatchUpdateSnapshotExt batch update snapshot with extension.
+func (svc *snapshotSvc) BatchUpdateSnapshotExt(cts *rest.Contexts) (interface{}, error) {
+	vendor := enumor.Vendor(cts.Request.PathParameter("vendor"))
+	if err := vendor.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	switch vendor {
+	case enumor.TCloud:
+		return batchUpdateSnapshotExt[coresnapshot.TCloudSnapshotExtension](cts, svc)
+	case enumor.Aws:
+		return batchUpdateSnapshotExt[coresnapshot.AwsSnapshotExtension](cts, svc)
+	case enumor.Azure:
+		return batchUpdateSnapshotExt[coresnapshot.AzureSnapshotExtension](cts, svc)
+	case enumor.Gcp:
+		return batchUpdateSnapshotExt[coresnapshot.GcpSnapshotExtension](cts, svc)
+	case enumor.HuaWei:
+		return batchUpdateSnapshotExt[coresnapshot.HuaWeiSnapshotExtension](cts, svc)
+	default:
+		return nil, errf.Newf(errf.InvalidParameter, "unsupported vendor: %s", vendor)
+	}
+}
+
+func batchUpdateSnapshotExt[T coresnapshot.Extension](cts *rest.Contexts, svc *snapshotSvc) (interface{}, error) {
+	req := new(protocloud.SnapshotExtBatchUpdateReq[T])
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	ids := make([]string, 0, len(*req))
+	for _, one := range *req {
+		ids = append(ids, one.ID)
+	}
+	opt := &types.ListOption{
+		Fields: []string{"id", "extension"},
+		Filter: tools.ContainersExpression("id", ids),
+		Page:   core.NewDefaultBasePage(),
+	}
+	listResp, err := svc.dao.Snapshot().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list snapshot extension failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+	rawExtensions := make(map[string]tabletype.JsonField, len(listResp.Details))
+	for _, one := range listResp.Details {
+		rawExtensions[one.ID] = one.Extension
+	}
+
+	_, err = svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		for _, item := range *req {
+			updateData := &tablesnapshot.SnapshotTable{
+				Name:        item.Name,
+				Status:      item.Status,
+				Size:        item.Size,
+				DiskID:      item.DiskID,
+				CloudDiskID: item.CloudDiskID,
+				CvmID:       item.CvmID,
+				CloudCvmID:  item.CloudCvmID,
+				Memo:        item.Memo,
+				Reviser:     cts.Kit.User,
+			}
+
+			if item.Extension != nil {
+				rawExtension, exist := rawExtensions[item.ID]
+				if !exist {
+					return nil, fmt.Errorf("snapshot id (%s) not exist", item.ID)
+				}
+				merged, err := json.UpdateMerge(item.Extension, string(rawExtension))
+				if err != nil {
+					return nil, fmt.Errorf("snapshot id (%s) merge extension failed, err: %v", item.ID, err)
+				}
+				updateData.Extension = tabletype.JsonField(merged)
+			}
+
+			if err := svc.dao.Snapshot().UpdateByIDWithTx(cts.Kit, txn, item.ID, updateData); err != nil {
+				return nil, fmt.Errorf("update snapshot db failed, err: %v", err)
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		logs.Errorf("batch update snapshot ext db failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
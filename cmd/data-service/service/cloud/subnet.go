@@ -84,6 +84,10 @@ func (svc *subnetSvc) BatchCreateSubnet(cts *rest.Contexts) (interface{}, error)
 		return batchCreateSubnet[protocloud.GcpSubnetCreateExt](cts, vendor, svc)
 	case enumor.HuaWei:
 		return batchCreateSubnet[protocloud.HuaWeiSubnetCreateExt](cts, vendor, svc)
+	case enumor.Zenlayer:
+		return batchCreateSubnet[protocloud.ZenlayerSubnetCreateExt](cts, vendor, svc)
+	case enumor.Kaopu:
+		return batchCreateSubnet[protocloud.KaopuSubnetCreateExt](cts, vendor, svc)
 	case enumor.Azure:
 		return batchCreateSubnet[protocloud.AzureSubnetCreateExt](cts, vendor, svc)
 	}
@@ -182,6 +186,10 @@ func (svc *subnetSvc) BatchUpdateSubnet(cts *rest.Contexts) (interface{}, error)
 		return batchUpdateSubnet[protocloud.GcpSubnetUpdateExt](cts, svc)
 	case enumor.HuaWei:
 		return batchUpdateSubnet[protocloud.HuaWeiSubnetUpdateExt](cts, svc)
+	case enumor.Zenlayer:
+		return batchUpdateSubnet[protocloud.ZenlayerSubnetUpdateExt](cts, svc)
+	case enumor.Kaopu:
+		return batchUpdateSubnet[protocloud.KaopuSubnetUpdateExt](cts, svc)
 	case enumor.Azure:
 		return batchUpdateSubnet[protocloud.AzureSubnetUpdateExt](cts, svc)
 	}
@@ -343,6 +351,10 @@ func (svc *subnetSvc) GetSubnet(cts *rest.Contexts) (interface{}, error) {
 		return convertToSubnetResult[protocore.GcpSubnetExtension](base, dbSubnet.Extension)
 	case enumor.HuaWei:
 		return convertToSubnetResult[protocore.HuaWeiSubnetExtension](base, dbSubnet.Extension)
+	case enumor.Zenlayer:
+		return convertToSubnetResult[protocore.ZenlayerSubnetExtension](base, dbSubnet.Extension)
+	case enumor.Kaopu:
+		return convertToSubnetResult[protocore.KaopuSubnetExtension](base, dbSubnet.Extension)
 	case enumor.Azure:
 		return convertToSubnetResult[protocore.AzureSubnetExtension](base, dbSubnet.Extension)
 	}
@@ -531,6 +543,10 @@ func (svc *subnetSvc) ListSubnetExt(cts *rest.Contexts) (interface{}, error) {
 		return conSubnetExtListResult[protocore.AzureSubnetExtension](listResp.Details)
 	case enumor.HuaWei:
 		return conSubnetExtListResult[protocore.HuaWeiSubnetExtension](listResp.Details)
+	case enumor.Zenlayer:
+		return conSubnetExtListResult[protocore.ZenlayerSubnetExtension](listResp.Details)
+	case enumor.Kaopu:
+		return conSubnetExtListResult[protocore.KaopuSubnetExtension](listResp.Details)
 	case enumor.Gcp:
 		return conSubnetExtListResult[protocore.GcpSubnetExtension](listResp.Details)
 	default:
@@ -55,6 +55,7 @@ func (svc *service) BatchCreateAccountSD(cts *rest.Contexts) (interface{}, error
 				ResStatus:       item.ResStatus,
 				ResEndTime:      item.ResEndTime,
 				ResFailedReason: item.ResFailedReason,
+				ResWatermark:    item.ResWatermark,
 				Creator:         cts.Kit.User,
 				Reviser:         cts.Kit.User,
 			})
@@ -0,0 +1,154 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package sync
+
+import (
+	"fmt"
+	"reflect"
+
+	"hcm/pkg/api/core"
+	coresync "hcm/pkg/api/core/cloud/sync"
+	dssync "hcm/pkg/api/data-service/cloud/sync"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/orm"
+	"hcm/pkg/dal/dao/types"
+	tablesync "hcm/pkg/dal/table/cloud/sync"
+	tabletypes "hcm/pkg/dal/table/types"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/json"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// BatchCreateDriftReport create sync drift report.
+func (svc *service) BatchCreateDriftReport(cts *rest.Contexts) (interface{}, error) {
+	req := new(dssync.CreateDriftReportReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	models := make([]tablesync.SyncDriftReportTable, 0, len(req.Items))
+	for _, item := range req.Items {
+		summary, err := tabletypes.NewJsonField(item.Summary)
+		if err != nil {
+			return nil, errf.NewFromErr(errf.InvalidParameter, err)
+		}
+
+		changes, err := tabletypes.NewJsonField(item.Changes)
+		if err != nil {
+			return nil, errf.NewFromErr(errf.InvalidParameter, err)
+		}
+
+		models = append(models, tablesync.SyncDriftReportTable{
+			Vendor:    item.Vendor,
+			AccountID: item.AccountID,
+			Region:    item.Region,
+			ResType:   item.ResType,
+			Summary:   summary,
+			Changes:   changes,
+			Creator:   cts.Kit.User,
+		})
+	}
+
+	reportIDs, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		ids, err := svc.dao.SyncDriftReport().BatchCreateWithTx(cts.Kit, txn, models)
+		if err != nil {
+			return nil, fmt.Errorf("batch create sync drift report failed, err: %v", err)
+		}
+
+		return ids, nil
+	})
+	if err != nil {
+		logs.Errorf("batch create sync drift report commit txn failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	ids, ok := reportIDs.([]string)
+	if !ok {
+		return nil, fmt.Errorf("create sync drift report but return id type not string, id type: %v",
+			reflect.TypeOf(reportIDs).String())
+	}
+
+	return &core.BatchCreateResult{IDs: ids}, nil
+}
+
+// ListDriftReport list sync drift report.
+func (svc *service) ListDriftReport(cts *rest.Contexts) (interface{}, error) {
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Filter: req.Filter,
+		Page:   req.Page,
+		Fields: req.Fields,
+	}
+	daoResp, err := svc.dao.SyncDriftReport().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list sync drift report failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, fmt.Errorf("list sync drift report failed, err: %v", err)
+	}
+	if req.Page.Count {
+		return &dssync.ListDriftReportResult{Count: daoResp.Count}, nil
+	}
+
+	details := make([]coresync.SyncDriftReport, 0, len(daoResp.Details))
+	for _, one := range daoResp.Details {
+		report := coresync.SyncDriftReport{
+			ID:        one.ID,
+			Vendor:    one.Vendor,
+			AccountID: one.AccountID,
+			Region:    one.Region,
+			ResType:   one.ResType,
+			Creator:   one.Creator,
+			CreatedAt: one.CreatedAt,
+		}
+
+		if len(one.Summary) != 0 {
+			if err = json.UnmarshalFromString(string(one.Summary), &report.Summary); err != nil {
+				logs.Errorf("unmarshal sync drift report summary failed, err: %v, id: %s, rid: %s", err, one.ID,
+					cts.Kit.Rid)
+				return nil, err
+			}
+		}
+
+		if len(one.Changes) != 0 {
+			if err = json.UnmarshalFromString(string(one.Changes), &report.Changes); err != nil {
+				logs.Errorf("unmarshal sync drift report changes failed, err: %v, id: %s, rid: %s", err, one.ID,
+					cts.Kit.Rid)
+				return nil, err
+			}
+		}
+
+		details = append(details, report)
+	}
+
+	return &dssync.ListDriftReportResult{Details: details}, nil
+}
@@ -40,6 +40,9 @@ func InitService(cap *capability.Capability) {
 	h.Add("BatchCreateAccountSD", http.MethodPost, "/account_sync_details/batch/create", svc.BatchCreateAccountSD)
 	h.Add("BatchUpdateAccountSD", http.MethodPatch, "/account_sync_details/batch/update", svc.BatchUpdateAccountSD)
 
+	h.Add("ListDriftReport", http.MethodPost, "/sync_drift_reports/list", svc.ListDriftReport)
+	h.Add("BatchCreateDriftReport", http.MethodPost, "/sync_drift_reports/batch/create", svc.BatchCreateDriftReport)
+
 	h.Load(cap.WebService)
 }
 
@@ -47,6 +47,7 @@ func (svc *service) BatchUpdateAccountSD(cts *rest.Contexts) (interface{}, error
 				ResStatus:       item.ResStatus,
 				ResEndTime:      item.ResEndTime,
 				ResFailedReason: item.ResFailedReason,
+				ResWatermark:    item.ResWatermark,
 				Reviser:         cts.Kit.User,
 			}
 
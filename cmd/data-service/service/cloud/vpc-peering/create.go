@@ -0,0 +1,123 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package vpcpeering
+
+import (
+	"fmt"
+	"reflect"
+
+	"hcm/pkg/api/core"
+	corevpcpeering "hcm/pkg/api/core/cloud/vpc-peering"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/orm"
+	tablevpcpeering "hcm/pkg/dal/table/cloud/vpc-peering"
+	tabletype "hcm/pkg/dal/table/types"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/json"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// BatchCreateVpcPeering batch create vpc peering.
+func (svc *vpcPeeringSvc) BatchCreateVpcPeering(cts *rest.Contexts) (interface{}, error) {
+	vendor := enumor.Vendor(cts.PathParameter("vendor").String())
+	if err := vendor.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	switch vendor {
+	case enumor.TCloud:
+		return batchCreateVpcPeering[corevpcpeering.TCloudVpcPeeringExtension](cts, svc, vendor)
+	case enumor.Aws:
+		return batchCreateVpcPeering[corevpcpeering.AwsVpcPeeringExtension](cts, svc, vendor)
+	case enumor.Azure:
+		return batchCreateVpcPeering[corevpcpeering.AzureVpcPeeringExtension](cts, svc, vendor)
+	case enumor.Gcp:
+		return batchCreateVpcPeering[corevpcpeering.GcpVpcPeeringExtension](cts, svc, vendor)
+	case enumor.HuaWei:
+		return batchCreateVpcPeering[corevpcpeering.HuaWeiVpcPeeringExtension](cts, svc, vendor)
+	default:
+		return nil, errf.Newf(errf.InvalidParameter, "unsupported vendor: %s", vendor)
+	}
+}
+
+func batchCreateVpcPeering[T corevpcpeering.Extension](cts *rest.Contexts, svc *vpcPeeringSvc, vendor enumor.Vendor) (
+	interface{}, error) {
+
+	req := new(protocloud.VpcPeeringBatchCreateReq[T])
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	result, err := svc.dao.Txn().AutoTxn(cts.Kit, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		models := make([]*tablevpcpeering.VpcPeeringTable, 0, len(req.VpcPeerings))
+		for _, one := range req.VpcPeerings {
+			extension, err := json.MarshalToString(one.Extension)
+			if err != nil {
+				return nil, errf.NewFromErr(errf.InvalidParameter, err)
+			}
+
+			models = append(models, &tablevpcpeering.VpcPeeringTable{
+				Vendor:           vendor,
+				AccountID:        one.AccountID,
+				CloudID:          one.CloudID,
+				BkBizID:          one.BkBizID,
+				Name:             one.Name,
+				Region:           one.Region,
+				Status:           one.Status,
+				VpcID:            one.VpcID,
+				CloudVpcID:       one.CloudVpcID,
+				PeerVpcID:        one.PeerVpcID,
+				CloudPeerVpcID:   one.CloudPeerVpcID,
+				PeerRegion:       one.PeerRegion,
+				PeerAccount:      one.PeerAccount,
+				CloudCreatedTime: one.CloudCreatedTime,
+				Memo:             one.Memo,
+				Extension:        tabletype.JsonField(extension),
+				Creator:          cts.Kit.User,
+				Reviser:          cts.Kit.User,
+			})
+		}
+
+		ids, err := svc.dao.VpcPeering().BatchCreateWithTx(cts.Kit, txn, models)
+		if err != nil {
+			return nil, fmt.Errorf("batch create vpc peering failed, err: %v", err)
+		}
+
+		return ids, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids, ok := result.([]string)
+	if !ok {
+		return nil, fmt.Errorf("batch create vpc peering but return id type is not []string, id type: %v",
+			reflect.TypeOf(result).String())
+	}
+
+	return &core.BatchCreateResult{IDs: ids}, nil
+}
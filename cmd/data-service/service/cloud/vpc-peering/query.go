@@ -0,0 +1,166 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package vpcpeering
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	corevpcpeering "hcm/pkg/api/core/cloud/vpc-peering"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/types"
+	tablevpcpeering "hcm/pkg/dal/table/cloud/vpc-peering"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/json"
+)
+
+// ListVpcPeering list vpc peering.
+func (svc *vpcPeeringSvc) ListVpcPeering(cts *rest.Contexts) (interface{}, error) {
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: req.Fields,
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	result, err := svc.dao.VpcPeering().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list vpc peering failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, fmt.Errorf("list vpc peering failed, err: %v", err)
+	}
+
+	if req.Page.Count {
+		return &protocloud.VpcPeeringListResult{Count: result.Count}, nil
+	}
+
+	details := make([]corevpcpeering.BaseVpcPeering, 0, len(result.Details))
+	for _, one := range result.Details {
+		details = append(details, *convTableToBaseVpcPeering(&one))
+	}
+
+	return &protocloud.VpcPeeringListResult{Details: details}, nil
+}
+
+func convTableToBaseVpcPeering(one *tablevpcpeering.VpcPeeringTable) *corevpcpeering.BaseVpcPeering {
+	return &corevpcpeering.BaseVpcPeering{
+		ID:               one.ID,
+		Vendor:           one.Vendor,
+		AccountID:        one.AccountID,
+		CloudID:          one.CloudID,
+		BkBizID:          one.BkBizID,
+		Name:             one.Name,
+		Region:           one.Region,
+		Status:           one.Status,
+		VpcID:            one.VpcID,
+		CloudVpcID:       one.CloudVpcID,
+		PeerVpcID:        one.PeerVpcID,
+		CloudPeerVpcID:   one.CloudPeerVpcID,
+		PeerRegion:       one.PeerRegion,
+		PeerAccount:      one.PeerAccount,
+		CloudCreatedTime: one.CloudCreatedTime,
+		Memo:             one.Memo,
+		Revision: &core.Revision{
+			Creator:   one.Creator,
+			Reviser:   one.Reviser,
+			CreatedAt: one.CreatedAt.String(),
+			UpdatedAt: one.UpdatedAt.String(),
+		},
+	}
+}
+
+// ListVpcPeeringExt list vpc peering with extension.
+func (svc *vpcPeeringSvc) ListVpcPeeringExt(cts *rest.Contexts) (interface{}, error) {
+	vendor := enumor.Vendor(cts.Request.PathParameter("vendor"))
+	if err := vendor.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := new(core.ListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &types.ListOption{
+		Fields: req.Fields,
+		Filter: req.Filter,
+		Page:   req.Page,
+	}
+	data, err := svc.dao.VpcPeering().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list vpc peering ext failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if req.Page.Count {
+		return &protocloud.VpcPeeringListResult{Count: data.Count}, nil
+	}
+
+	switch vendor {
+	case enumor.TCloud:
+		return convVpcPeeringExtListResult[corevpcpeering.TCloudVpcPeeringExtension](cts.Kit, data.Details)
+	case enumor.Aws:
+		return convVpcPeeringExtListResult[corevpcpeering.AwsVpcPeeringExtension](cts.Kit, data.Details)
+	case enumor.Azure:
+		return convVpcPeeringExtListResult[corevpcpeering.AzureVpcPeeringExtension](cts.Kit, data.Details)
+	case enumor.Gcp:
+		return convVpcPeeringExtListResult[corevpcpeering.GcpVpcPeeringExtension](cts.Kit, data.Details)
+	case enumor.HuaWei:
+		return convVpcPeeringExtListResult[corevpcpeering.HuaWeiVpcPeeringExtension](cts.Kit, data.Details)
+	default:
+		return nil, errf.Newf(errf.InvalidParameter, "unsupported vendor: %s", vendor)
+	}
+}
+
+func convVpcPeeringExtListResult[T corevpcpeering.Extension](kt *kit.Kit, tables []tablevpcpeering.VpcPeeringTable) (
+	*protocloud.VpcPeeringExtListResult[T], error) {
+
+	details := make([]corevpcpeering.VpcPeering[T], 0, len(tables))
+	for _, one := range tables {
+		extension := new(T)
+		if len(one.Extension) != 0 {
+			if err := json.UnmarshalFromString(string(one.Extension), extension); err != nil {
+				logs.Errorf("unmarshal vpc peering extension failed, err: %v, id: %s, rid: %s", err, one.ID, kt.Rid)
+				return nil, fmt.Errorf("unmarshal vpc peering extension failed, err: %v", err)
+			}
+		}
+
+		details = append(details, corevpcpeering.VpcPeering[T]{
+			BaseVpcPeering: *convTableToBaseVpcPeering(&one),
+			Extension:      extension,
+		})
+	}
+
+	return &protocloud.VpcPeeringExtListResult[T]{Details: details}, nil
+}
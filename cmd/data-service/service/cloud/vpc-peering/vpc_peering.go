@@ -0,0 +1,54 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package vpcpeering 对等连接的DB接口
+package vpcpeering
+
+import (
+	"net/http"
+
+	"hcm/cmd/data-service/service/capability"
+	"hcm/pkg/dal/dao"
+	"hcm/pkg/rest"
+)
+
+var svc *vpcPeeringSvc
+
+// InitService initial the vpc peering service
+func InitService(cap *capability.Capability) {
+	svc = &vpcPeeringSvc{
+		dao: cap.Dao,
+	}
+
+	h := rest.NewHandler()
+
+	h.Add("BatchCreateVpcPeering", http.MethodPost, "/vendors/{vendor}/vpc_peerings/batch/create",
+		svc.BatchCreateVpcPeering)
+	h.Add("ListVpcPeering", http.MethodPost, "/vpc_peerings/list", svc.ListVpcPeering)
+	h.Add("ListVpcPeeringExt", http.MethodPost, "/vendors/{vendor}/vpc_peerings/list", svc.ListVpcPeeringExt)
+	h.Add("BatchUpdateVpcPeeringExt", http.MethodPatch, "/vendors/{vendor}/vpc_peerings",
+		svc.BatchUpdateVpcPeeringExt)
+	h.Add("BatchDeleteVpcPeering", http.MethodDelete, "/vpc_peerings/batch", svc.BatchDeleteVpcPeering)
+
+	h.Load(cap.WebService)
+}
+
+type vpcPeeringSvc struct {
+	dao dao.Set
+}
@@ -86,6 +86,10 @@ func (svc *vpcSvc) BatchCreateVpc(cts *rest.Contexts) (interface{}, error) {
 		return batchCreateVpc[protocloud.GcpVpcCreateExt](cts, vendor, svc)
 	case enumor.HuaWei:
 		return batchCreateVpc[protocloud.HuaWeiVpcCreateExt](cts, vendor, svc)
+	case enumor.Zenlayer:
+		return batchCreateVpc[protocloud.ZenlayerVpcCreateExt](cts, vendor, svc)
+	case enumor.Kaopu:
+		return batchCreateVpc[protocloud.KaopuVpcCreateExt](cts, vendor, svc)
 	case enumor.Azure:
 		return batchCreateVpc[protocloud.AzureVpcCreateExt](cts, vendor, svc)
 	}
@@ -169,6 +173,10 @@ func (svc *vpcSvc) BatchUpdateVpc(cts *rest.Contexts) (interface{}, error) {
 		return batchUpdateVpc[protocloud.GcpVpcUpdateExt](cts, svc)
 	case enumor.HuaWei:
 		return batchUpdateVpc[protocloud.HuaWeiVpcUpdateExt](cts, svc)
+	case enumor.Zenlayer:
+		return batchUpdateVpc[protocloud.ZenlayerVpcUpdateExt](cts, svc)
+	case enumor.Kaopu:
+		return batchUpdateVpc[protocloud.KaopuVpcUpdateExt](cts, svc)
 	case enumor.Azure:
 		return batchUpdateVpc[protocloud.AzureVpcUpdateExt](cts, svc)
 	}
@@ -349,6 +357,10 @@ func (svc *vpcSvc) GetVpc(cts *rest.Contexts) (interface{}, error) {
 		return convertToVpcResult[protocore.GcpVpcExtension](base, dbVpc.Extension)
 	case enumor.HuaWei:
 		return convertToVpcResult[protocore.HuaWeiVpcExtension](base, dbVpc.Extension)
+	case enumor.Zenlayer:
+		return convertToVpcResult[protocore.ZenlayerVpcExtension](base, dbVpc.Extension)
+	case enumor.Kaopu:
+		return convertToVpcResult[protocore.KaopuVpcExtension](base, dbVpc.Extension)
 	case enumor.Azure:
 		return convertToVpcResult[protocore.AzureVpcExtension](base, dbVpc.Extension)
 	}
@@ -537,6 +549,10 @@ func (svc *vpcSvc) ListVpcExt(cts *rest.Contexts) (interface{}, error) {
 		return conVpcExtListResult[protocore.AzureVpcExtension](listResp.Details)
 	case enumor.HuaWei:
 		return conVpcExtListResult[protocore.HuaWeiVpcExtension](listResp.Details)
+	case enumor.Zenlayer:
+		return conVpcExtListResult[protocore.ZenlayerVpcExtension](listResp.Details)
+	case enumor.Kaopu:
+		return conVpcExtListResult[protocore.KaopuVpcExtension](listResp.Details)
 	case enumor.Gcp:
 		return conVpcExtListResult[protocore.GcpVpcExtension](listResp.Details)
 	default:
@@ -81,6 +81,10 @@ func (svc *zoneSvc) BatchCreateZone(cts *rest.Contexts) (interface{}, error) {
 		return batchCreateZone[zone.HuaWeiZoneExtension](vendor, svc, cts)
 	case enumor.Gcp:
 		return batchCreateZone[zone.GcpZoneExtension](vendor, svc, cts)
+	case enumor.Zenlayer:
+		return batchCreateZone[zone.ZenlayerZoneExtension](vendor, svc, cts)
+	case enumor.Kaopu:
+		return batchCreateZone[zone.KaopuZoneExtension](vendor, svc, cts)
 	default:
 		return nil, fmt.Errorf("unsupport %s vendor for now", vendor)
 	}
@@ -102,6 +106,10 @@ func (svc *zoneSvc) BatchUpdateZone(cts *rest.Contexts) (interface{}, error) {
 		return batchUpdateZone[zone.HuaWeiZoneExtension](cts, svc)
 	case enumor.Azure:
 		return batchUpdateZone[zone.GcpZoneExtension](cts, svc)
+	case enumor.Zenlayer:
+		return batchUpdateZone[zone.ZenlayerZoneExtension](cts, svc)
+	case enumor.Kaopu:
+		return batchUpdateZone[zone.KaopuZoneExtension](cts, svc)
 	default:
 		return nil, fmt.Errorf("unsupport %s vendor for now", vendor)
 	}
@@ -34,10 +34,12 @@ import (
 	"hcm/cmd/data-service/service/audit"
 	"hcm/cmd/data-service/service/auth"
 	"hcm/cmd/data-service/service/bill/billadjustmentitem"
+	"hcm/cmd/data-service/service/bill/billbudget"
 	"hcm/cmd/data-service/service/bill/billdailytask"
 	"hcm/cmd/data-service/service/bill/billexchangerate"
 	"hcm/cmd/data-service/service/bill/billitem"
 	"hcm/cmd/data-service/service/bill/billpuller"
+	"hcm/cmd/data-service/service/bill/billsplitrule"
 	"hcm/cmd/data-service/service/bill/billsummarydaily"
 	"hcm/cmd/data-service/service/bill/billsummarymain"
 	"hcm/cmd/data-service/service/bill/billsummaryroot"
@@ -52,24 +54,34 @@ import (
 	accountbizrel "hcm/cmd/data-service/service/cloud/account-biz-rel"
 	argstpl "hcm/cmd/data-service/service/cloud/argument-template"
 	"hcm/cmd/data-service/service/cloud/bill"
+	"hcm/cmd/data-service/service/cloud/bucket"
 	"hcm/cmd/data-service/service/cloud/cert"
 	"hcm/cmd/data-service/service/cloud/cvm"
+	dbinstance "hcm/cmd/data-service/service/cloud/database-instance"
 	"hcm/cmd/data-service/service/cloud/disk"
 	diskcvmrel "hcm/cmd/data-service/service/cloud/disk-cvm-rel"
 	"hcm/cmd/data-service/service/cloud/eip"
 	eipcvmrel "hcm/cmd/data-service/service/cloud/eip-cvm-rel"
 	"hcm/cmd/data-service/service/cloud/image"
+	"hcm/cmd/data-service/service/cloud/ipam"
+	k8scluster "hcm/cmd/data-service/service/cloud/k8s-cluster"
+	k8snodepool "hcm/cmd/data-service/service/cloud/k8s-node-pool"
+	keypair "hcm/cmd/data-service/service/cloud/key-pair"
 	loadbalancer "hcm/cmd/data-service/service/cloud/load-balancer"
+	natgateway "hcm/cmd/data-service/service/cloud/nat-gateway"
 	networkinterface "hcm/cmd/data-service/service/cloud/network-interface"
 	networkcvmrel "hcm/cmd/data-service/service/cloud/network-interface-cvm-rel"
+	privatedns "hcm/cmd/data-service/service/cloud/private-dns"
 	"hcm/cmd/data-service/service/cloud/region"
 	resourcegroup "hcm/cmd/data-service/service/cloud/resource-group"
 	routetable "hcm/cmd/data-service/service/cloud/route-table"
 	securitygroup "hcm/cmd/data-service/service/cloud/security-group"
 	sgcomrel "hcm/cmd/data-service/service/cloud/security-group-common-rel"
 	sgcvmrel "hcm/cmd/data-service/service/cloud/security-group-cvm-rel"
+	"hcm/cmd/data-service/service/cloud/snapshot"
 	subaccount "hcm/cmd/data-service/service/cloud/sub-account"
 	sync "hcm/cmd/data-service/service/cloud/sync"
+	vpcpeering "hcm/cmd/data-service/service/cloud/vpc-peering"
 	"hcm/cmd/data-service/service/cloud/zone"
 	recyclerecord "hcm/cmd/data-service/service/recycle-record"
 	"hcm/cmd/data-service/service/user"
@@ -248,6 +260,16 @@ func (s *Service) apiSet() *restful.Container {
 	sgcomrel.InitService(capability)
 	mainaccount.InitService(capability)
 	rootaccount.InitService(capability)
+	snapshot.InitService(capability)
+	natgateway.InitService(capability)
+	vpcpeering.InitService(capability)
+	dbinstance.InitService(capability)
+	bucket.InitService(capability)
+	k8scluster.InitService(capability)
+	k8snodepool.InitService(capability)
+	keypair.InitService(capability)
+	privatedns.InitService(capability)
+	ipam.InitService(capability)
 
 	billpuller.InitService(capability)
 	billsummarymain.InitService(capability)
@@ -267,6 +289,8 @@ func (s *Service) apiSet() *restful.Container {
 
 	billexchangerate.InitService(capability)
 	billsyncrecord.InitService(capability)
+	billsplitrule.InitService(capability)
+	billbudget.InitService(capability)
 
 	return restful.NewContainer().Add(capability.WebService)
 }
@@ -25,7 +25,9 @@ import (
 	"hcm/pkg/adaptor/azure"
 	"hcm/pkg/adaptor/gcp"
 	"hcm/pkg/adaptor/huawei"
+	"hcm/pkg/adaptor/kaopu"
 	"hcm/pkg/adaptor/tcloud"
+	"hcm/pkg/adaptor/zenlayer"
 	dataservice "hcm/pkg/client/data-service"
 	"hcm/pkg/kit"
 )
@@ -60,7 +62,7 @@ func (cli *CloudAdaptorClient) TCloud(kt *kit.Kit, accountID string) (tcloud.TCl
 }
 
 // Aws return aws client.
-func (cli *CloudAdaptorClient) Aws(kt *kit.Kit, accountID string) (*aws.Aws, error) {
+func (cli *CloudAdaptorClient) Aws(kt *kit.Kit, accountID string) (aws.Aws, error) {
 	secret, cloudAccountID, err := cli.secretCli.AwsSecret(kt, accountID)
 	if err != nil {
 		return nil, err
@@ -70,7 +72,7 @@ func (cli *CloudAdaptorClient) Aws(kt *kit.Kit, accountID string) (*aws.Aws, err
 }
 
 // HuaWei return huawei client.
-func (cli *CloudAdaptorClient) HuaWei(kt *kit.Kit, accountID string) (*huawei.HuaWei, error) {
+func (cli *CloudAdaptorClient) HuaWei(kt *kit.Kit, accountID string) (huawei.HuaWei, error) {
 	secret, err := cli.secretCli.HuaWeiSecret(kt, accountID)
 	if err != nil {
 		return nil, err
@@ -79,8 +81,28 @@ func (cli *CloudAdaptorClient) HuaWei(kt *kit.Kit, accountID string) (*huawei.Hu
 	return cli.adaptor.HuaWei(secret)
 }
 
+// Zenlayer return zenlayer client.
+func (cli *CloudAdaptorClient) Zenlayer(kt *kit.Kit, accountID string) (zenlayer.Zenlayer, error) {
+	secret, err := cli.secretCli.ZenlayerSecret(kt, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cli.adaptor.Zenlayer(secret)
+}
+
+// Kaopu return kaopu client.
+func (cli *CloudAdaptorClient) Kaopu(kt *kit.Kit, accountID string) (kaopu.Kaopu, error) {
+	secret, err := cli.secretCli.KaopuSecret(kt, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cli.adaptor.Kaopu(secret)
+}
+
 // Gcp return gcp client.
-func (cli *CloudAdaptorClient) Gcp(kt *kit.Kit, accountID string) (*gcp.Gcp, error) {
+func (cli *CloudAdaptorClient) Gcp(kt *kit.Kit, accountID string) (gcp.Gcp, error) {
 	cred, err := cli.secretCli.GcpCredential(kt, accountID)
 	if err != nil {
 		return nil, err
@@ -90,7 +112,7 @@ func (cli *CloudAdaptorClient) Gcp(kt *kit.Kit, accountID string) (*gcp.Gcp, err
 }
 
 // GcpProxy return gcp proxy client.
-func (cli *CloudAdaptorClient) GcpProxy(kt *kit.Kit, accountID string) (*gcp.Gcp, error) {
+func (cli *CloudAdaptorClient) GcpProxy(kt *kit.Kit, accountID string) (gcp.Gcp, error) {
 	cred, err := cli.secretCli.GcpRegisterCredential(kt, accountID)
 	if err != nil {
 		return nil, err
@@ -100,7 +122,7 @@ func (cli *CloudAdaptorClient) GcpProxy(kt *kit.Kit, accountID string) (*gcp.Gcp
 }
 
 // Azure return azure client.
-func (cli *CloudAdaptorClient) Azure(kt *kit.Kit, accountID string) (*azure.Azure, error) {
+func (cli *CloudAdaptorClient) Azure(kt *kit.Kit, accountID string) (azure.Azure, error) {
 	cred, err := cli.secretCli.AzureCredential(kt, accountID)
 	if err != nil {
 		return nil, err
@@ -109,8 +131,18 @@ func (cli *CloudAdaptorClient) Azure(kt *kit.Kit, accountID string) (*azure.Azur
 	return cli.adaptor.Azure(cred)
 }
 
+// TCloudRoot return tcloud root client.
+func (cli *CloudAdaptorClient) TCloudRoot(kt *kit.Kit, accountID string) (tcloud.TCloud, error) {
+	secret, err := cli.secretCli.TCloudRootSecret(kt, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cli.adaptor.TCloud(secret)
+}
+
 // AwsRoot return aws root client.
-func (cli *CloudAdaptorClient) AwsRoot(kt *kit.Kit, accountID string) (*aws.Aws, error) {
+func (cli *CloudAdaptorClient) AwsRoot(kt *kit.Kit, accountID string) (aws.Aws, error) {
 	secret, cloudAccountID, err := cli.secretCli.AwsRootSecret(kt, accountID)
 	if err != nil {
 		return nil, err
@@ -120,7 +152,7 @@ func (cli *CloudAdaptorClient) AwsRoot(kt *kit.Kit, accountID string) (*aws.Aws,
 }
 
 // GcpRoot return gcp client.
-func (cli *CloudAdaptorClient) GcpRoot(kt *kit.Kit, accountID string) (*gcp.Gcp, error) {
+func (cli *CloudAdaptorClient) GcpRoot(kt *kit.Kit, accountID string) (gcp.Gcp, error) {
 	cred, err := cli.secretCli.GcpRootCredential(kt, accountID)
 	if err != nil {
 		return nil, err
@@ -130,7 +162,7 @@ func (cli *CloudAdaptorClient) GcpRoot(kt *kit.Kit, accountID string) (*gcp.Gcp,
 }
 
 // HuaWeiRoot return huawei client.
-func (cli *CloudAdaptorClient) HuaWeiRoot(kt *kit.Kit, accountID string) (*huawei.HuaWei, error) {
+func (cli *CloudAdaptorClient) HuaWeiRoot(kt *kit.Kit, accountID string) (huawei.HuaWei, error) {
 	secret, err := cli.secretCli.HuaWeiRootSecret(kt, accountID)
 	if err != nil {
 		return nil, err
@@ -140,7 +172,7 @@ func (cli *CloudAdaptorClient) HuaWeiRoot(kt *kit.Kit, accountID string) (*huawe
 }
 
 // AzureRoot return azure client.
-func (cli *CloudAdaptorClient) AzureRoot(kt *kit.Kit, accountID string) (*azure.Azure, error) {
+func (cli *CloudAdaptorClient) AzureRoot(kt *kit.Kit, accountID string) (azure.Azure, error) {
 	cred, err := cli.secretCli.AzureRootCredential(kt, accountID)
 	if err != nil {
 		return nil, err
@@ -24,6 +24,7 @@ import (
 	"fmt"
 
 	"hcm/pkg/adaptor/types"
+	"hcm/pkg/cc"
 	dataservice "hcm/pkg/client/data-service"
 	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/kit"
@@ -57,6 +58,7 @@ func (cli *SecretClient) TCloudSecret(kt *kit.Kit, accountID string) (*types.Bas
 	secret := &types.BaseSecret{
 		CloudSecretID:  account.Extension.CloudSecretID,
 		CloudSecretKey: account.Extension.CloudSecretKey,
+		Endpoint:       cc.HCService().CloudEndpoint.TCloud,
 	}
 
 	if err := secret.Validate(); err != nil {
@@ -120,6 +122,64 @@ func (cli *SecretClient) HuaWeiSecret(kt *kit.Kit, accountID string) (*types.Bas
 	return secret, nil
 }
 
+// ZenlayerSecret get zenlayer secret and validate secret.
+func (cli *SecretClient) ZenlayerSecret(kt *kit.Kit, accountID string) (*types.BaseSecret, error) {
+	account, err := cli.data.Zenlayer.Account.Get(kt.Ctx, kt.Header(), accountID)
+	if err != nil {
+		return nil, fmt.Errorf("get zenlayer account failed, err: %v", err)
+	}
+
+	if account.Type != enumor.ResourceAccount {
+		return nil, fmt.Errorf("account: %s not resource account type", accountID)
+	}
+
+	if account.Extension == nil {
+		return nil, errors.New("zenlayer account extension is nil")
+	}
+
+	secret := &types.BaseSecret{
+		CloudSecretID:  account.Extension.CloudSecretID,
+		CloudSecretKey: account.Extension.CloudSecretKey,
+		CloudAccountID: account.Extension.CloudMainAccountID,
+		Endpoint:       cc.HCService().CloudEndpoint.Zenlayer,
+	}
+
+	if err := secret.Validate(); err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
+// KaopuSecret get kaopu secret and validate secret.
+func (cli *SecretClient) KaopuSecret(kt *kit.Kit, accountID string) (*types.BaseSecret, error) {
+	account, err := cli.data.Kaopu.Account.Get(kt.Ctx, kt.Header(), accountID)
+	if err != nil {
+		return nil, fmt.Errorf("get kaopu account failed, err: %v", err)
+	}
+
+	if account.Type != enumor.ResourceAccount {
+		return nil, fmt.Errorf("account: %s not resource account type", accountID)
+	}
+
+	if account.Extension == nil {
+		return nil, errors.New("kaopu account extension is nil")
+	}
+
+	secret := &types.BaseSecret{
+		CloudSecretID:  account.Extension.CloudSecretID,
+		CloudSecretKey: account.Extension.CloudSecretKey,
+		CloudAccountID: account.Extension.CloudMainAccountID,
+		Endpoint:       cc.HCService().CloudEndpoint.Kaopu,
+	}
+
+	if err := secret.Validate(); err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
 // AzureCredential get azure credential and validate credential.
 func (cli *SecretClient) AzureCredential(kt *kit.Kit, accountID string) (*types.AzureCredential, error) {
 	account, err := cli.data.Azure.Account.Get(kt.Ctx, kt.Header(), accountID)
@@ -199,6 +259,30 @@ func (cli *SecretClient) GcpRegisterCredential(kt *kit.Kit, accountID string) (*
 	return cred, nil
 }
 
+// TCloudRootSecret get tcloud root account secret and validate secret.
+func (cli *SecretClient) TCloudRootSecret(kt *kit.Kit, accountID string) (*types.BaseSecret, error) {
+	account, err := cli.data.TCloud.RootAccount.Get(kt, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("get tcloud root account failed, err: %v", err)
+	}
+
+	if account.Extension == nil {
+		return nil, errors.New("tcloud root account extension is nil")
+	}
+
+	secret := &types.BaseSecret{
+		CloudSecretID:  account.Extension.CloudSecretID,
+		CloudSecretKey: account.Extension.CloudSecretKey,
+		Endpoint:       cc.HCService().CloudEndpoint.TCloud,
+	}
+
+	if err := secret.Validate(); err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
 // AwsRootSecret get aws secret and validate secret.
 func (cli *SecretClient) AwsRootSecret(kt *kit.Kit, accountID string) (*types.BaseSecret, string, error) {
 	account, err := cli.data.Aws.RootAccount.Get(kt, accountID)
@@ -0,0 +1,468 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typesdbinstance "hcm/pkg/adaptor/types/database-instance"
+	"hcm/pkg/api/core"
+	coredbinstance "hcm/pkg/api/core/cloud/database-instance"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/assert"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+	"hcm/pkg/tools/times"
+)
+
+// SyncDatabaseInstanceOption ...
+type SyncDatabaseInstanceOption struct {
+}
+
+// Validate ...
+func (opt SyncDatabaseInstanceOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// DatabaseInstance 同步RDS实例，业务由分配操作决定，同步不覆盖
+func (cli *client) DatabaseInstance(kt *kit.Kit, params *SyncBaseParams, opt *SyncDatabaseInstanceOption) (
+	*SyncResult, error) {
+
+	if err := validator.ValidateTool(params, opt); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	dbFromCloud, err := cli.listDBInstanceFromCloud(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	dbFromDB, err := cli.listDBInstanceFromDB(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dbFromCloud) == 0 && len(dbFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.DiffPaaS[typesdbinstance.AwsDBInstance,
+		coredbinstance.DatabaseInstance[coredbinstance.AwsDBInstanceExtension]](dbFromCloud, dbFromDB,
+		isDBInstanceChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteDBInstance(kt, params.AccountID, params.Region, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	cloudVpcIDs := make([]string, 0, len(addSlice)+len(updateMap))
+	cloudSubnetIDs := make([]string, 0, len(addSlice)+len(updateMap))
+	for _, one := range addSlice {
+		cloudVpcIDs = append(cloudVpcIDs, one.GetCloudVpcID())
+		cloudSubnetIDs = append(cloudSubnetIDs, one.GetCloudSubnetIDs()...)
+	}
+	for _, one := range updateMap {
+		cloudVpcIDs = append(cloudVpcIDs, one.GetCloudVpcID())
+		cloudSubnetIDs = append(cloudSubnetIDs, one.GetCloudSubnetIDs()...)
+	}
+	vpcMap, err := common.GetNetworkVpcRelMap(kt, cli.dbCli, enumor.Aws, params.AccountID, cloudVpcIDs)
+	if err != nil {
+		return nil, err
+	}
+	_, subnetMap, err := common.GetLoadBalancerVpcSubnetMap(kt, cli.dbCli, enumor.Aws, params.AccountID, nil,
+		cloudSubnetIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createDBInstance(kt, params.AccountID, params.Region, addSlice, vpcMap, subnetMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateDBInstance(kt, updateMap, vpcMap, subnetMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = cli.dbInstanceSgRel(kt, params, dbFromCloud); err != nil {
+		return nil, err
+	}
+
+	return new(SyncResult), nil
+}
+
+func (cli *client) createDBInstance(kt *kit.Kit, accountID, region string,
+	addSlice []typesdbinstance.AwsDBInstance, vpcMap map[string]common.NetworkVpcRel,
+	subnetMap map[string]string) error {
+
+	createReq := new(protocloud.DatabaseInstanceBatchCreateReq[coredbinstance.AwsDBInstanceExtension])
+	for _, one := range addSlice {
+		cloudVpcID := one.GetCloudVpcID()
+		cloudSubnetID := getAwsDBInstanceSubnet(one)
+		privateAddress, publicAddress, port := getAwsDBInstanceEndpoint(one)
+		db := protocloud.DatabaseInstanceBatchCreate[coredbinstance.AwsDBInstanceExtension]{
+			CloudID:          one.GetCloudID(),
+			AccountID:        accountID,
+			BkBizID:          constant.UnassignedBiz,
+			Name:             converter.PtrToVal(one.DBInstanceIdentifier),
+			Region:           region,
+			Zone:             converter.PtrToVal(one.AvailabilityZone),
+			Status:           converter.PtrToVal(one.DBInstanceStatus),
+			Engine:           converter.PtrToVal(one.Engine),
+			EngineVersion:    converter.PtrToVal(one.EngineVersion),
+			InstanceClass:    converter.PtrToVal(one.DBInstanceClass),
+			StorageSize:      converter.PtrToVal(one.AllocatedStorage),
+			VpcID:            vpcMap[cloudVpcID].VpcID,
+			CloudVpcID:       cloudVpcID,
+			SubnetID:         subnetMap[cloudSubnetID],
+			CloudSubnetID:    cloudSubnetID,
+			PrivateAddress:   privateAddress,
+			PublicAddress:    publicAddress,
+			Port:             port,
+			CloudCreatedTime: times.ConvStdTimeFormat(converter.PtrToVal(one.InstanceCreateTime)),
+			Extension:        convAwsDBInstanceExtension(one),
+		}
+		createReq.DatabaseInstances = append(createReq.DatabaseInstances, db)
+	}
+
+	for _, batch := range slice.Split(createReq.DatabaseInstances, constant.BatchOperationMaxLimit) {
+		req := &protocloud.DatabaseInstanceBatchCreateReq[coredbinstance.AwsDBInstanceExtension]{
+			DatabaseInstances: batch,
+		}
+		if _, err := cli.dbCli.Aws.DatabaseInstance.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create database instance failed, err: %v, rid: %s",
+				enumor.Aws, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync database instance to create database instance success, accountID: %s, count: %d, "+
+		"rid: %s", enumor.Aws, accountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateDBInstance(kt *kit.Kit, updateMap map[string]typesdbinstance.AwsDBInstance,
+	vpcMap map[string]common.NetworkVpcRel, subnetMap map[string]string) error {
+
+	updateReq := make(protocloud.DatabaseInstanceExtBatchUpdateReq[coredbinstance.AwsDBInstanceExtension], 0,
+		len(updateMap))
+	for id, one := range updateMap {
+		cloudVpcID := one.GetCloudVpcID()
+		cloudSubnetID := getAwsDBInstanceSubnet(one)
+		privateAddress, publicAddress, port := getAwsDBInstanceEndpoint(one)
+		db := &protocloud.DatabaseInstanceExtUpdateReq[coredbinstance.AwsDBInstanceExtension]{
+			ID:             id,
+			Name:           converter.PtrToVal(one.DBInstanceIdentifier),
+			Zone:           converter.PtrToVal(one.AvailabilityZone),
+			Status:         converter.PtrToVal(one.DBInstanceStatus),
+			EngineVersion:  converter.PtrToVal(one.EngineVersion),
+			InstanceClass:  converter.PtrToVal(one.DBInstanceClass),
+			StorageSize:    converter.PtrToVal(one.AllocatedStorage),
+			VpcID:          vpcMap[cloudVpcID].VpcID,
+			CloudVpcID:     cloudVpcID,
+			SubnetID:       subnetMap[cloudSubnetID],
+			CloudSubnetID:  cloudSubnetID,
+			PrivateAddress: privateAddress,
+			PublicAddress:  publicAddress,
+			Port:           port,
+			Extension:      convAwsDBInstanceExtension(one),
+		}
+		updateReq = append(updateReq, db)
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.DatabaseInstanceExtBatchUpdateReq[coredbinstance.AwsDBInstanceExtension](batch)
+		if err := cli.dbCli.Aws.DatabaseInstance.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update database instance failed, err: %v, rid: %s",
+				enumor.Aws, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync database instance to update database instance success, count: %d, rid: %s",
+		enumor.Aws, len(updateMap), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) deleteDBInstance(kt *kit.Kit, accountID, region string, delCloudIDs []string) error {
+	checkParams := &SyncBaseParams{AccountID: accountID, Region: region, CloudIDs: delCloudIDs}
+	delFromCloud, err := cli.listDBInstanceFromCloud(kt, checkParams)
+	if err != nil {
+		return err
+	}
+
+	if len(delFromCloud) > 0 {
+		logs.Errorf("[%s] validate database instance not exist failed, before delete opt: %v, failed_count: %d, "+
+			"rid: %s", enumor.Aws, checkParams, len(delFromCloud), kt.Rid)
+		return errf.Newf(errf.Aborted, "validate database instance not exist failed, before delete")
+	}
+
+	req := &protocloud.DatabaseInstanceBatchDeleteReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Aws),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleIn("cloud_id", delCloudIDs),
+		),
+	}
+	if err = cli.dbCli.Global.DatabaseInstance.BatchDelete(kt, req); err != nil {
+		logs.Errorf("[%s] request dataservice to batch delete database instance failed, err: %v, rid: %s",
+			enumor.Aws, err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync database instance to delete database instance success, accountID: %s, count: %d, "+
+		"rid: %s", enumor.Aws, accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+// dbInstanceSgRel 同步RDS实例与安全组的关联关系
+func (cli *client) dbInstanceSgRel(kt *kit.Kit, params *SyncBaseParams,
+	dbFromCloud []typesdbinstance.AwsDBInstance) error {
+
+	dbFromDB, err := cli.listDBInstanceFromDB(kt, params)
+	if err != nil {
+		return err
+	}
+
+	cloudLocalMap := make(map[string]string, len(dbFromDB))
+	for _, one := range dbFromDB {
+		cloudLocalMap[one.CloudID] = one.ID
+	}
+
+	dbSgCloudMap := make(map[string][]string, len(dbFromCloud))
+	for _, one := range dbFromCloud {
+		if id, exist := cloudLocalMap[one.GetCloudID()]; exist {
+			dbSgCloudMap[id] = one.GetCloudSecurityGroupIDs()
+		}
+	}
+
+	return common.SyncDatabaseInstanceSGRel(kt, cli.dbCli, enumor.Aws, dbSgCloudMap)
+}
+
+func (cli *client) listDBInstanceFromCloud(kt *kit.Kit, params *SyncBaseParams) (
+	[]typesdbinstance.AwsDBInstance, error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	result := make([]typesdbinstance.AwsDBInstance, 0, len(params.CloudIDs))
+	for _, batch := range slice.Split(params.CloudIDs, typesdbinstance.AwsDBInstanceMaxRecords) {
+		opt := &typesdbinstance.AwsDBInstanceListOption{
+			Region:   params.Region,
+			CloudIDs: batch,
+		}
+		dbs, _, err := cli.cloudCli.ListDatabaseInstance(kt, opt)
+		if err != nil {
+			logs.Errorf("[%s] list database instance from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+				enumor.Aws, err, params.AccountID, opt, kt.Rid)
+			return nil, err
+		}
+		result = append(result, dbs...)
+	}
+
+	return result, nil
+}
+
+func (cli *client) listDBInstanceFromDB(kt *kit.Kit, params *SyncBaseParams) (
+	[]coredbinstance.DatabaseInstance[coredbinstance.AwsDBInstanceExtension], error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", params.AccountID),
+			tools.RuleEqual("region", params.Region),
+			tools.RuleIn("cloud_id", params.CloudIDs),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := cli.dbCli.Aws.DatabaseInstance.ListDatabaseInstanceExt(kt, req)
+	if err != nil {
+		logs.Errorf("[%s] list database instance from db failed, err: %v, account: %s, req: %v, rid: %s",
+			enumor.Aws, err, params.AccountID, req, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+// RemoveDatabaseInstanceDeleteFromCloud 删除本地存在但云上已被删除的RDS实例
+func (cli *client) RemoveDatabaseInstanceDeleteFromCloud(kt *kit.Kit, accountID string, region string) error {
+	req := &core.ListReq{
+		Fields: []string{"id", "cloud_id"},
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Aws),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleEqual("region", region),
+		),
+		Page: &core.BasePage{Start: 0, Limit: constant.BatchOperationMaxLimit},
+	}
+	for {
+		resultFromDB, err := cli.dbCli.Global.DatabaseInstance.List(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] request dataservice to list database instance failed, err: %v, req: %v, rid: %s",
+				enumor.Aws, err, req, kt.Rid)
+			return err
+		}
+
+		cloudIDs := make([]string, 0, len(resultFromDB.Details))
+		for _, one := range resultFromDB.Details {
+			cloudIDs = append(cloudIDs, one.CloudID)
+		}
+
+		if len(cloudIDs) == 0 {
+			break
+		}
+
+		params := &SyncBaseParams{AccountID: accountID, Region: region, CloudIDs: cloudIDs}
+		resultFromCloud, err := cli.listDBInstanceFromCloud(kt, params)
+		if err != nil {
+			return err
+		}
+
+		// 如果有资源没有查询出来，说明数据被从云上删除
+		if len(resultFromCloud) != len(cloudIDs) {
+			cloudIDMap := converter.StringSliceToMap(cloudIDs)
+			for _, one := range resultFromCloud {
+				delete(cloudIDMap, one.GetCloudID())
+			}
+
+			err = cli.deleteDBInstance(kt, accountID, region, converter.MapKeyToStringSlice(cloudIDMap))
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(resultFromDB.Details) < constant.BatchOperationMaxLimit {
+			break
+		}
+
+		req.Page.Start += constant.BatchOperationMaxLimit
+	}
+
+	return nil
+}
+
+// getAwsDBInstanceSubnet 实例所在子网取子网组中与实例可用区相同的子网，无法确定时取第一个子网
+func getAwsDBInstanceSubnet(one typesdbinstance.AwsDBInstance) string {
+	if one.DBSubnetGroup == nil {
+		return ""
+	}
+
+	first := ""
+	for _, subnet := range one.DBSubnetGroup.Subnets {
+		if subnet == nil || subnet.SubnetIdentifier == nil {
+			continue
+		}
+		if len(first) == 0 {
+			first = *subnet.SubnetIdentifier
+		}
+		if subnet.SubnetAvailabilityZone != nil &&
+			converter.PtrToVal(subnet.SubnetAvailabilityZone.Name) == converter.PtrToVal(one.AvailabilityZone) {
+			return *subnet.SubnetIdentifier
+		}
+	}
+
+	return first
+}
+
+// getAwsDBInstanceEndpoint 返回实例的内网地址、公网地址及端口，RDS 实例仅有一个连接地址，开启公网访问时同时作为公网地址
+func getAwsDBInstanceEndpoint(one typesdbinstance.AwsDBInstance) (string, string, int64) {
+	if one.Endpoint == nil {
+		return "", "", 0
+	}
+
+	address := converter.PtrToVal(one.Endpoint.Address)
+	if converter.PtrToVal(one.PubliclyAccessible) {
+		return address, address, converter.PtrToVal(one.Endpoint.Port)
+	}
+	return address, "", converter.PtrToVal(one.Endpoint.Port)
+}
+
+func convAwsDBInstanceExtension(one typesdbinstance.AwsDBInstance) *coredbinstance.AwsDBInstanceExtension {
+	ext := &coredbinstance.AwsDBInstanceExtension{
+		Identifier:         converter.PtrToVal(one.DBInstanceIdentifier),
+		MultiAZ:            one.MultiAZ,
+		StorageType:        one.StorageType,
+		DeletionProtection: one.DeletionProtection,
+		CloudSubnetIDs:     one.GetCloudSubnetIDs(),
+	}
+	if one.DBSubnetGroup != nil {
+		ext.DBSubnetGroupName = one.DBSubnetGroup.DBSubnetGroupName
+	}
+	return ext
+}
+
+func isDBInstanceChange(cloud typesdbinstance.AwsDBInstance,
+	db coredbinstance.DatabaseInstance[coredbinstance.AwsDBInstanceExtension]) bool {
+
+	if converter.PtrToVal(cloud.DBInstanceIdentifier) != db.Name ||
+		converter.PtrToVal(cloud.DBInstanceStatus) != db.Status ||
+		converter.PtrToVal(cloud.AvailabilityZone) != db.Zone {
+		return true
+	}
+
+	if converter.PtrToVal(cloud.EngineVersion) != db.EngineVersion ||
+		converter.PtrToVal(cloud.DBInstanceClass) != db.InstanceClass ||
+		converter.PtrToVal(cloud.AllocatedStorage) != db.StorageSize {
+		return true
+	}
+
+	if cloud.GetCloudVpcID() != db.CloudVpcID || getAwsDBInstanceSubnet(cloud) != db.CloudSubnetID {
+		return true
+	}
+
+	privateAddress, publicAddress, port := getAwsDBInstanceEndpoint(cloud)
+	if privateAddress != db.PrivateAddress || publicAddress != db.PublicAddress || port != db.Port {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	if !assert.IsPtrBoolEqual(cloud.MultiAZ, db.Extension.MultiAZ) ||
+		!assert.IsPtrStringEqual(cloud.StorageType, db.Extension.StorageType) ||
+		!assert.IsPtrBoolEqual(cloud.DeletionProtection, db.Extension.DeletionProtection) {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(cloud.GetCloudSubnetIDs(), db.Extension.CloudSubnetIDs) {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,163 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	mockaws "hcm/pkg/adaptor/mock/aws"
+	typesdbinstance "hcm/pkg/adaptor/types/database-instance"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/kit"
+	"hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListDBInstanceFromCloud(t *testing.T) {
+	params := &SyncBaseParams{AccountID: "00000001", Region: "ap-east-1", CloudIDs: []string{"db-1", "db-2"}}
+	dbs := []typesdbinstance.AwsDBInstance{
+		{DBInstance: &rds.DBInstance{DbiResourceId: converter.ValToPtr("db-1")}},
+		{DBInstance: &rds.DBInstance{DbiResourceId: converter.ValToPtr("db-2")}},
+	}
+
+	cases := []struct {
+		name    string
+		result  []typesdbinstance.AwsDBInstance
+		err     error
+		wantLen int
+		wantErr bool
+	}{
+		{name: "found", result: dbs, wantLen: 2},
+		{name: "not found", result: nil, wantLen: 0},
+		{name: "cloud error", err: errors.New("Throttling"), wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cloudCli := mockaws.NewMockAws(gomock.NewController(t))
+			cloudCli.EXPECT().ListDatabaseInstance(gomock.Any(), &typesdbinstance.AwsDBInstanceListOption{
+				Region: params.Region, CloudIDs: params.CloudIDs}).Return(c.result, nil, c.err)
+
+			cli := &client{cloudCli: cloudCli}
+			got, err := cli.listDBInstanceFromCloud(kit.New(), params)
+			if (err != nil) != c.wantErr {
+				t.Fatalf("want err: %v, got: %v", c.wantErr, err)
+			}
+			if len(got) != c.wantLen {
+				t.Errorf("want %d db instances, got: %d", c.wantLen, len(got))
+			}
+		})
+	}
+
+	t.Run("too many cloud ids", func(t *testing.T) {
+		cloudIDs := make([]string, 0, constant.CloudResourceSyncMaxLimit+1)
+		for i := 0; i <= constant.CloudResourceSyncMaxLimit; i++ {
+			cloudIDs = append(cloudIDs, fmt.Sprintf("db-%d", i))
+		}
+		cli := &client{cloudCli: mockaws.NewMockAws(gomock.NewController(t))}
+		_, err := cli.listDBInstanceFromCloud(kit.New(), &SyncBaseParams{AccountID: params.AccountID,
+			Region: params.Region, CloudIDs: cloudIDs})
+		if err == nil {
+			t.Errorf("want error, got nil")
+		}
+	})
+}
+
+func TestGetAwsDBInstanceSubnet(t *testing.T) {
+	subnet := func(id, zone string) *rds.Subnet {
+		return &rds.Subnet{SubnetIdentifier: converter.ValToPtr(id),
+			SubnetAvailabilityZone: &rds.AvailabilityZone{Name: converter.ValToPtr(zone)}}
+	}
+
+	cases := []struct {
+		name     string
+		db       *rds.DBInstance
+		expected string
+	}{
+		{
+			name:     "no subnet group",
+			db:       &rds.DBInstance{},
+			expected: "",
+		},
+		{
+			name: "subnet in same zone",
+			db: &rds.DBInstance{
+				AvailabilityZone: converter.ValToPtr("ap-east-1b"),
+				DBSubnetGroup: &rds.DBSubnetGroup{Subnets: []*rds.Subnet{
+					subnet("subnet-a", "ap-east-1a"), subnet("subnet-b", "ap-east-1b")}},
+			},
+			expected: "subnet-b",
+		},
+		{
+			name: "no subnet in same zone",
+			db: &rds.DBInstance{
+				AvailabilityZone: converter.ValToPtr("ap-east-1c"),
+				DBSubnetGroup: &rds.DBSubnetGroup{Subnets: []*rds.Subnet{
+					nil, subnet("subnet-a", "ap-east-1a"), subnet("subnet-b", "ap-east-1b")}},
+			},
+			expected: "subnet-a",
+		},
+	}
+
+	for _, c := range cases {
+		if got := getAwsDBInstanceSubnet(typesdbinstance.AwsDBInstance{DBInstance: c.db}); got != c.expected {
+			t.Errorf("%s: expected %s, got %s", c.name, c.expected, got)
+		}
+	}
+}
+
+func TestGetAwsDBInstanceEndpoint(t *testing.T) {
+	endpoint := &rds.Endpoint{Address: converter.ValToPtr("db.rds.amazonaws.com"), Port: converter.ValToPtr(int64(3306))}
+
+	cases := []struct {
+		name        string
+		db          *rds.DBInstance
+		wantPrivate string
+		wantPublic  string
+		wantPort    int64
+	}{
+		{name: "no endpoint", db: &rds.DBInstance{}},
+		{
+			name:        "private",
+			db:          &rds.DBInstance{Endpoint: endpoint},
+			wantPrivate: "db.rds.amazonaws.com",
+			wantPort:    3306,
+		},
+		{
+			name:        "publicly accessible",
+			db:          &rds.DBInstance{Endpoint: endpoint, PubliclyAccessible: converter.ValToPtr(true)},
+			wantPrivate: "db.rds.amazonaws.com",
+			wantPublic:  "db.rds.amazonaws.com",
+			wantPort:    3306,
+		},
+	}
+
+	for _, c := range cases {
+		private, public, port := getAwsDBInstanceEndpoint(typesdbinstance.AwsDBInstance{DBInstance: c.db})
+		if private != c.wantPrivate || public != c.wantPublic || port != c.wantPort {
+			t.Errorf("%s: expected (%s, %s, %d), got (%s, %s, %d)", c.name, c.wantPrivate, c.wantPublic,
+				c.wantPort, private, public, port)
+		}
+	}
+}
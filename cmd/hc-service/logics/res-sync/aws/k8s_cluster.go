@@ -0,0 +1,302 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typesk8s "hcm/pkg/adaptor/types/k8s-cluster"
+	"hcm/pkg/api/core"
+	corek8s "hcm/pkg/api/core/cloud/k8s"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/assert"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+	"hcm/pkg/tools/times"
+)
+
+// SyncK8sClusterOption ...
+type SyncK8sClusterOption struct {
+	AccountID string `json:"account_id" validate:"required"`
+	Region    string `json:"region" validate:"required"`
+}
+
+// Validate ...
+func (opt SyncK8sClusterOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// K8sCluster 同步地域下的EKS集群，以及集群下的节点池和节点与主机的关联关系，业务由分配操作决定，同步不覆盖
+func (cli *client) K8sCluster(kt *kit.Kit, opt *SyncK8sClusterOption) (*SyncResult, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	clusterFromCloud, err := cli.listK8sClusterFromCloud(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterFromDB, err := cli.listK8sClusterFromDB(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(clusterFromCloud) == 0 && len(clusterFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.DiffPaaS[typesk8s.AwsCluster,
+		corek8s.K8sCluster[corek8s.AwsK8sClusterExtension]](clusterFromCloud, clusterFromDB, isK8sClusterChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteK8sCluster(kt, opt.AccountID, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createK8sCluster(kt, opt, addSlice); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateK8sCluster(kt, opt, updateMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = cli.k8sNodePoolAndNode(kt, opt); err != nil {
+		return nil, err
+	}
+
+	return new(SyncResult), nil
+}
+
+// k8sNodePoolAndNode 同步本地全部集群的节点池，以及节点和主机的关联关系
+func (cli *client) k8sNodePoolAndNode(kt *kit.Kit, opt *SyncK8sClusterOption) error {
+	clusterFromDB, err := cli.listK8sClusterFromDB(kt, opt)
+	if err != nil {
+		return err
+	}
+
+	for _, cluster := range clusterFromDB {
+		nodeOpt := &typesk8s.AwsK8sNodeListOption{Region: opt.Region, ClusterName: cluster.Name}
+		pools, err := cli.cloudCli.ListK8sNodePool(kt, nodeOpt)
+		if err != nil {
+			logs.Errorf("[%s] list k8s node pool from cloud failed, err: %v, opt: %v, rid: %s", enumor.Aws, err,
+				nodeOpt, kt.Rid)
+			return err
+		}
+
+		nodes, err := cli.cloudCli.ListK8sNode(kt, nodeOpt)
+		if err != nil {
+			logs.Errorf("[%s] list k8s node from cloud failed, err: %v, opt: %v, rid: %s", enumor.Aws, err,
+				nodeOpt, kt.Rid)
+			return err
+		}
+
+		if err = common.SyncK8sNodePoolAndNode(kt, cli.dbCli, cluster.BaseK8sCluster, pools, nodes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cli *client) createK8sCluster(kt *kit.Kit, opt *SyncK8sClusterOption,
+	addSlice []typesk8s.AwsCluster) error {
+
+	clusters := make([]protocloud.K8sClusterBatchCreate[corek8s.AwsK8sClusterExtension], 0, len(addSlice))
+	for _, one := range addSlice {
+		clusters = append(clusters, protocloud.K8sClusterBatchCreate[corek8s.AwsK8sClusterExtension]{
+			CloudID:          one.GetCloudID(),
+			AccountID:        opt.AccountID,
+			BkBizID:          constant.UnassignedBiz,
+			Name:             converter.PtrToVal(one.Name),
+			Region:           opt.Region,
+			Status:           converter.PtrToVal(one.Status),
+			Version:          converter.PtrToVal(one.Version),
+			CloudVpcID:       one.GetCloudVpcID(),
+			CloudCreatedTime: times.ConvStdTimeFormat(converter.PtrToVal(one.CreatedAt)),
+			Extension:        convAwsK8sClusterExtension(one),
+		})
+	}
+
+	for _, batch := range slice.Split(clusters, constant.BatchOperationMaxLimit) {
+		req := &protocloud.K8sClusterBatchCreateReq[corek8s.AwsK8sClusterExtension]{K8sClusters: batch}
+		if _, err := cli.dbCli.Aws.K8sCluster.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create k8s cluster failed, err: %v, rid: %s",
+				enumor.Aws, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync k8s cluster to create k8s cluster success, accountID: %s, count: %d, rid: %s",
+		enumor.Aws, opt.AccountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateK8sCluster(kt *kit.Kit, opt *SyncK8sClusterOption,
+	updateMap map[string]typesk8s.AwsCluster) error {
+
+	updateReq := make(protocloud.K8sClusterExtBatchUpdateReq[corek8s.AwsK8sClusterExtension], 0, len(updateMap))
+	for id, one := range updateMap {
+		updateReq = append(updateReq, &protocloud.K8sClusterExtUpdateReq[corek8s.AwsK8sClusterExtension]{
+			ID:         id,
+			Name:       converter.PtrToVal(one.Name),
+			Region:     opt.Region,
+			Status:     converter.PtrToVal(one.Status),
+			Version:    converter.PtrToVal(one.Version),
+			CloudVpcID: one.GetCloudVpcID(),
+			Extension:  convAwsK8sClusterExtension(one),
+		})
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.K8sClusterExtBatchUpdateReq[corek8s.AwsK8sClusterExtension](batch)
+		if err := cli.dbCli.Aws.K8sCluster.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update k8s cluster failed, err: %v, rid: %s",
+				enumor.Aws, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync k8s cluster to update k8s cluster success, count: %d, rid: %s", enumor.Aws,
+		len(updateMap), kt.Rid)
+
+	return nil
+}
+
+// deleteK8sCluster 删除容器集群时，data-service 会同时删除集群下的节点池和节点关联关系
+func (cli *client) deleteK8sCluster(kt *kit.Kit, accountID string, delCloudIDs []string) error {
+	for _, batch := range slice.Split(delCloudIDs, constant.BatchOperationMaxLimit) {
+		req := &protocloud.K8sClusterBatchDeleteReq{
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("vendor", enumor.Aws),
+				tools.RuleEqual("account_id", accountID),
+				tools.RuleIn("cloud_id", batch),
+			),
+		}
+		if err := cli.dbCli.Global.K8sCluster.BatchDelete(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch delete k8s cluster failed, err: %v, rid: %s",
+				enumor.Aws, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync k8s cluster to delete k8s cluster success, accountID: %s, count: %d, rid: %s",
+		enumor.Aws, accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) listK8sClusterFromCloud(kt *kit.Kit, opt *SyncK8sClusterOption) ([]typesk8s.AwsCluster,
+	error) {
+
+	listOpt := &typesk8s.AwsK8sClusterListOption{Region: opt.Region}
+	result, err := cli.cloudCli.ListK8sCluster(kt, listOpt)
+	if err != nil {
+		logs.Errorf("[%s] list k8s cluster from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+			enumor.Aws, err, opt.AccountID, listOpt, kt.Rid)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (cli *client) listK8sClusterFromDB(kt *kit.Kit, opt *SyncK8sClusterOption) (
+	[]corek8s.K8sCluster[corek8s.AwsK8sClusterExtension], error) {
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Aws),
+			tools.RuleEqual("account_id", opt.AccountID),
+			tools.RuleEqual("region", opt.Region),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result := make([]corek8s.K8sCluster[corek8s.AwsK8sClusterExtension], 0)
+	for {
+		resp, err := cli.dbCli.Aws.K8sCluster.ListK8sClusterExt(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] list k8s cluster from db failed, err: %v, account: %s, req: %v, rid: %s",
+				enumor.Aws, err, opt.AccountID, req, kt.Rid)
+			return nil, err
+		}
+
+		result = append(result, resp.Details...)
+
+		if len(resp.Details) < int(core.DefaultMaxPageLimit) {
+			break
+		}
+
+		req.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return result, nil
+}
+
+func convAwsK8sClusterExtension(one typesk8s.AwsCluster) *corek8s.AwsK8sClusterExtension {
+	ext := &corek8s.AwsK8sClusterExtension{
+		Arn:             converter.PtrToVal(one.Arn),
+		PlatformVersion: converter.PtrToVal(one.PlatformVersion),
+		RoleArn:         converter.PtrToVal(one.RoleArn),
+		Endpoint:        converter.PtrToVal(one.Endpoint),
+	}
+	if one.ResourcesVpcConfig != nil {
+		ext.CloudSubnetIDs = converter.PtrToSlice(one.ResourcesVpcConfig.SubnetIds)
+		ext.CloudSecurityGroupIDs = converter.PtrToSlice(one.ResourcesVpcConfig.SecurityGroupIds)
+	}
+
+	return ext
+}
+
+func isK8sClusterChange(cloud typesk8s.AwsCluster, db corek8s.K8sCluster[corek8s.AwsK8sClusterExtension]) bool {
+	if converter.PtrToVal(cloud.Name) != db.Name || converter.PtrToVal(cloud.Status) != db.Status ||
+		converter.PtrToVal(cloud.Version) != db.Version || cloud.GetCloudVpcID() != db.CloudVpcID {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	ext := convAwsK8sClusterExtension(cloud)
+	if ext.Arn != db.Extension.Arn || ext.PlatformVersion != db.Extension.PlatformVersion ||
+		ext.RoleArn != db.Extension.RoleArn || ext.Endpoint != db.Extension.Endpoint {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(ext.CloudSubnetIDs, db.Extension.CloudSubnetIDs) ||
+		!assert.IsStringSliceEqual(ext.CloudSecurityGroupIDs, db.Extension.CloudSecurityGroupIDs) {
+		return true
+	}
+
+	return false
+}
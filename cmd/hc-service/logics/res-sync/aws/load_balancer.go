@@ -0,0 +1,552 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"fmt"
+
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typeslb "hcm/pkg/adaptor/types/load-balancer"
+	"hcm/pkg/api/core"
+	corelb "hcm/pkg/api/core/cloud/load-balancer"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/assert"
+	cvt "hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+	"hcm/pkg/tools/times"
+)
+
+// SyncLBOption ...
+type SyncLBOption struct {
+}
+
+// Validate ...
+func (o *SyncLBOption) Validate() error {
+	return validator.Validate.Struct(o)
+}
+
+// LoadBalancerWithListener 同步指定负载均衡及下属监听器
+func (cli *client) LoadBalancerWithListener(kt *kit.Kit, params *SyncBaseParams, opt *SyncLBOption) (*SyncResult,
+	error) {
+
+	if _, err := cli.LoadBalancer(kt, params, opt); err != nil {
+		logs.Errorf("fail to sync aws load balancer, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	lbList, err := cli.listLBFromDB(kt, params)
+	if err != nil {
+		logs.Errorf("fail to get lb from db after lb layer sync, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	for _, lb := range lbList {
+		if err = cli.listenerByLb(kt, params.AccountID, params.Region, lb); err != nil {
+			logs.Errorf("fail to sync listener of aws lb, err: %v, lb: %s, rid: %s", err, lb.CloudID, kt.Rid)
+			return nil, err
+		}
+	}
+
+	return new(SyncResult), nil
+}
+
+// LoadBalancer 同步指定负载均衡自身属性，不同步关联资源
+func (cli *client) LoadBalancer(kt *kit.Kit, params *SyncBaseParams, opt *SyncLBOption) (*SyncResult, error) {
+	if err := validator.ValidateTool(params, opt); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	lbFromCloud, err := cli.listLBFromCloud(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	lbFromDB, err := cli.listLBFromDB(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lbFromCloud) == 0 && len(lbFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.Diff[typeslb.AwsLoadBalancer, corelb.AwsLoadBalancer](
+		lbFromCloud, lbFromDB, isLBChange)
+
+	// 删除云上已经删除的负载均衡实例
+	if err = cli.deleteLoadBalancer(kt, params.AccountID, params.Region, delCloudIDs); err != nil {
+		return nil, err
+	}
+
+	// 创建云上新增负载均衡实例
+	if err = cli.createLoadBalancer(kt, params.AccountID, params.Region, addSlice); err != nil {
+		return nil, err
+	}
+
+	// 更新变更负载均衡
+	if err = cli.updateLoadBalancer(kt, params.AccountID, params.Region, updateMap); err != nil {
+		return nil, err
+	}
+
+	return new(SyncResult), nil
+}
+
+// RemoveLoadBalancerDeleteFromCloud 删除存在本地但是在云上被删除的数据
+func (cli *client) RemoveLoadBalancerDeleteFromCloud(kt *kit.Kit, accountID string, region string) error {
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleEqual("region", region),
+		),
+		Page: &core.BasePage{
+			Start: 0,
+			Limit: constant.BatchOperationMaxLimit,
+		},
+	}
+
+	for {
+		lbFromDB, err := cli.dbCli.Global.LoadBalancer.ListLoadBalancer(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] request dataservice to list lb failed, err: %v, req: %v, rid: %s",
+				enumor.Aws, err, req, kt.Rid)
+			return err
+		}
+
+		cloudIDs := slice.Map(lbFromDB.Details, func(lb corelb.BaseLoadBalancer) string { return lb.CloudID })
+		if len(cloudIDs) == 0 {
+			break
+		}
+
+		params := &SyncBaseParams{AccountID: accountID, Region: region, CloudIDs: cloudIDs}
+		lbFromCloud, err := cli.listLBFromCloud(kt, params)
+		if err != nil {
+			return err
+		}
+
+		cloudIDMap := cvt.StringSliceToMap(cloudIDs)
+		for _, lb := range lbFromCloud {
+			delete(cloudIDMap, lb.GetCloudID())
+		}
+
+		if len(cloudIDMap) != 0 {
+			if err = cli.deleteLoadBalancer(kt, accountID, region, cvt.MapKeyToSlice(cloudIDMap)); err != nil {
+				return err
+			}
+		}
+
+		if len(lbFromDB.Details) < constant.BatchOperationMaxLimit {
+			break
+		}
+
+		req.Page.Start += constant.BatchOperationMaxLimit
+	}
+
+	return nil
+}
+
+// createLoadBalancer call data service to create lb
+func (cli *client) createLoadBalancer(kt *kit.Kit, accountID string, region string,
+	addSlice []typeslb.AwsLoadBalancer) error {
+
+	if len(addSlice) <= 0 {
+		return nil
+	}
+
+	vpcMap, subnetMap, err := cli.getLoadBalancerRelatedRes(kt, accountID, region, addSlice)
+	if err != nil {
+		return err
+	}
+
+	lbCreateReq := new(protocloud.AwsLoadBalancerCreateReq)
+	for _, cloud := range addSlice {
+		lbCreateReq.Lbs = append(lbCreateReq.Lbs, convCloudToDBCreate(cloud, accountID, region, vpcMap, subnetMap))
+	}
+
+	if _, err = cli.dbCli.Aws.LoadBalancer.BatchCreate(kt, lbCreateReq); err != nil {
+		logs.Errorf("[%s] call data service to create aws load balancer failed, err: %v, rid: %s",
+			enumor.Aws, err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync load balancer to create lb success, accountID: %s, count: %d, rid: %s",
+		enumor.Aws, accountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+// updateLoadBalancer call data service to update lb
+func (cli *client) updateLoadBalancer(kt *kit.Kit, accountID string, region string,
+	updateMap map[string]typeslb.AwsLoadBalancer) error {
+
+	if len(updateMap) == 0 {
+		return nil
+	}
+
+	vpcMap, subnetMap, err := cli.getLoadBalancerRelatedRes(kt, accountID, region, cvt.MapValueToSlice(updateMap))
+	if err != nil {
+		return err
+	}
+
+	updateReq := new(protocloud.AwsLoadBalancerBatchUpdateReq)
+	for id, cloud := range updateMap {
+		updateReq.Lbs = append(updateReq.Lbs, convCloudToDBUpdate(id, cloud, vpcMap, subnetMap))
+	}
+
+	if err = cli.dbCli.Aws.LoadBalancer.BatchUpdate(kt, updateReq); err != nil {
+		logs.Errorf("[%s] call data service to update aws load balancer failed, err: %v, rid: %s",
+			enumor.Aws, err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// deleteLoadBalancer call data service to delete lb
+func (cli *client) deleteLoadBalancer(kt *kit.Kit, accountID string, region string, delCloudIDs []string) error {
+	if len(delCloudIDs) <= 0 {
+		return nil
+	}
+
+	checkParams := &SyncBaseParams{
+		AccountID: accountID,
+		Region:    region,
+		CloudIDs:  delCloudIDs,
+	}
+	delLBFromCloud, err := cli.listLBFromCloud(kt, checkParams)
+	if err != nil {
+		return err
+	}
+
+	if len(delLBFromCloud) > 0 {
+		logs.Errorf("[%s] lb not exist before sync deletion, opt: %v, failed_count: %d, rid: %s",
+			enumor.Aws, checkParams, len(delLBFromCloud), kt.Rid)
+		return fmt.Errorf("lb not exist before sync deletion")
+	}
+
+	deleteReq := &protocloud.LoadBalancerBatchDeleteReq{
+		Filter: tools.ContainersExpression("cloud_id", delCloudIDs),
+	}
+	if err = cli.dbCli.Global.LoadBalancer.BatchDelete(kt, deleteReq); err != nil {
+		logs.Errorf("[%s] call data service to batch delete lb failed, err: %v, rid: %s", enumor.Aws, err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync to delete lb success, accountID: %s, count: %d, rid: %s",
+		enumor.Aws, accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+// getLoadBalancerRelatedRes return vpc map and subnet map of given load balancers
+func (cli *client) getLoadBalancerRelatedRes(kt *kit.Kit, accountID string, region string,
+	lbs []typeslb.AwsLoadBalancer) (map[string]*common.VpcDB, map[string]string, error) {
+
+	cloudVpcIDs := make([]string, 0, len(lbs))
+	cloudSubnetIDs := make([]string, 0, len(lbs))
+	for _, lb := range lbs {
+		cloudVpcIDs = append(cloudVpcIDs, cvt.PtrToVal(lb.VpcId))
+		_, subnetIDs := lb.GetZones()
+		cloudSubnetIDs = append(cloudSubnetIDs, subnetIDs...)
+	}
+
+	vpcMap, err := cli.getVpcMap(kt, accountID, region, slice.Unique(cloudVpcIDs))
+	if err != nil {
+		logs.Errorf("fail to get vpc of load balancer during syncing, err: %v, account: %s, vpcIDs: %v, rid: %s",
+			err, accountID, cloudVpcIDs, kt.Rid)
+		return nil, nil, err
+	}
+
+	subnetMap, err := cli.getSubnetMap(kt, accountID, region, slice.Unique(cloudSubnetIDs))
+	if err != nil {
+		logs.Errorf("fail to get subnet of load balancer during syncing, err: %v, account: %s, subnetIDs: %v, "+
+			"rid: %s", err, accountID, cloudSubnetIDs, kt.Rid)
+		return nil, nil, err
+	}
+
+	return vpcMap, subnetMap, nil
+}
+
+// listLBFromCloud list load balancer from cloud vendor
+func (cli *client) listLBFromCloud(kt *kit.Kit, params *SyncBaseParams) ([]typeslb.AwsLoadBalancer, error) {
+	result := make([]typeslb.AwsLoadBalancer, 0, len(params.CloudIDs))
+	// 指定ARN时一次最多查询20个
+	for _, cloudIDs := range slice.Split(params.CloudIDs, typeslb.AwsElbQueryLimit) {
+		opt := &typeslb.AwsListOption{
+			Region:   params.Region,
+			CloudIDs: cloudIDs,
+		}
+		lbResult, err := cli.cloudCli.ListLoadBalancer(kt, opt)
+		if err != nil {
+			logs.Errorf("[%s] list lb from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+				enumor.Aws, err, params.AccountID, opt, kt.Rid)
+			return nil, err
+		}
+
+		if len(lbResult.Details) == len(cloudIDs) || len(cloudIDs) == 1 {
+			result = append(result, lbResult.Details...)
+			continue
+		}
+
+		// 批量查询时只要有一个ARN不存在云上就会返回NotFound，需要逐个查询来确认哪些负载均衡存在
+		for _, cloudID := range cloudIDs {
+			opt.CloudIDs = []string{cloudID}
+			oneResult, err := cli.cloudCli.ListLoadBalancer(kt, opt)
+			if err != nil {
+				logs.Errorf("[%s] list lb from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+					enumor.Aws, err, params.AccountID, opt, kt.Rid)
+				return nil, err
+			}
+			result = append(result, oneResult.Details...)
+		}
+	}
+
+	return result, nil
+}
+
+// listLBFromDB list load balancer from database
+func (cli *client) listLBFromDB(kt *kit.Kit, params *SyncBaseParams) ([]corelb.AwsLoadBalancer, error) {
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", params.AccountID),
+			tools.RuleEqual("region", params.Region),
+			tools.RuleIn("cloud_id", params.CloudIDs),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := cli.dbCli.Aws.LoadBalancer.ListLoadBalancer(kt, req)
+	if err != nil {
+		logs.Errorf("[%s] list lb from db failed, err: %v, account: %s, req: %v, rid: %s",
+			enumor.Aws, err, params.AccountID, req, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+// getLBAddresses 获取负载均衡的IP地址，NLB 才会返回具体的IP地址，ALB 只能通过DNS访问
+func getLBAddresses(cloud typeslb.AwsLoadBalancer) (privateIPv4, publicIPv4, privateIPv6, publicIPv6 []string) {
+	internetFacing := cvt.PtrToVal(cloud.Scheme) == string(typeslb.AwsInternetFacingScheme)
+	for _, zone := range cloud.AvailabilityZones {
+		if zone == nil {
+			continue
+		}
+		for _, addr := range zone.LoadBalancerAddresses {
+			if addr == nil {
+				continue
+			}
+			if ip := cvt.PtrToVal(addr.PrivateIPv4Address); len(ip) != 0 {
+				privateIPv4 = append(privateIPv4, ip)
+			}
+			if ip := cvt.PtrToVal(addr.IpAddress); len(ip) != 0 {
+				if internetFacing {
+					publicIPv4 = append(publicIPv4, ip)
+				} else {
+					privateIPv4 = append(privateIPv4, ip)
+				}
+			}
+			if ip := cvt.PtrToVal(addr.IPv6Address); len(ip) != 0 {
+				if internetFacing {
+					publicIPv6 = append(publicIPv6, ip)
+				} else {
+					privateIPv6 = append(privateIPv6, ip)
+				}
+			}
+		}
+	}
+
+	return slice.Unique(privateIPv4), slice.Unique(publicIPv4), slice.Unique(privateIPv6), slice.Unique(publicIPv6)
+}
+
+// getLBType 与腾讯云保持一致，负载均衡类型记录网络属性(公网/内网)，ALB/NLB 类型记录在拓展字段中
+func getLBType(cloud typeslb.AwsLoadBalancer) typeslb.TCloudLoadBalancerType {
+	if cvt.PtrToVal(cloud.Scheme) == string(typeslb.AwsInternetFacingScheme) {
+		return typeslb.OpenLoadBalancerType
+	}
+	return typeslb.InternalLoadBalancerType
+}
+
+// getLBIPVersion ipv4 或 dualstack
+func getLBIPVersion(cloud typeslb.AwsLoadBalancer) enumor.IPAddressType {
+	if cvt.PtrToVal(cloud.IpAddressType) == "ipv4" {
+		return enumor.Ipv4
+	}
+	return enumor.Ipv6DualStack
+}
+
+// getLBStatus 负载均衡状态: active、provisioning、active_impaired、failed
+func getLBStatus(cloud typeslb.AwsLoadBalancer) (status string, reason *string) {
+	if cloud.State == nil {
+		return "", nil
+	}
+	return cvt.PtrToVal(cloud.State.Code), cloud.State.Reason
+}
+
+func convertAwsExtension(cloud typeslb.AwsLoadBalancer) *corelb.AwsLoadBalancerExtension {
+	_, cloudSubnetIDs := cloud.GetZones()
+	_, reason := getLBStatus(cloud)
+	return &corelb.AwsLoadBalancerExtension{
+		Type:                  cloud.Type,
+		Scheme:                cloud.Scheme,
+		CanonicalHostedZoneID: cloud.CanonicalHostedZoneId,
+		CloudSubnetIDs:        cloudSubnetIDs,
+		SecurityGroups:        cvt.PtrToSlice(cloud.SecurityGroups),
+		StateReason:           reason,
+	}
+}
+
+func convCloudToDBCreate(cloud typeslb.AwsLoadBalancer, accountID string, region string,
+	vpcMap map[string]*common.VpcDB, subnetMap map[string]string) protocloud.LbBatchCreate[corelb.AwsLoadBalancerExtension] {
+
+	zones, cloudSubnetIDs := cloud.GetZones()
+	cloudVpcID := cvt.PtrToVal(cloud.VpcId)
+	status, _ := getLBStatus(cloud)
+	lb := protocloud.LbBatchCreate[corelb.AwsLoadBalancerExtension]{
+		CloudID:          cloud.GetCloudID(),
+		Name:             cvt.PtrToVal(cloud.LoadBalancerName),
+		Vendor:           enumor.Aws,
+		AccountID:        accountID,
+		BkBizID:          constant.UnassignedBiz,
+		LoadBalancerType: string(getLBType(cloud)),
+		IPVersion:        getLBIPVersion(cloud),
+		Region:           region,
+		Zones:            zones,
+		VpcID:            cvt.PtrToVal(vpcMap[cloudVpcID]).VpcID,
+		CloudVpcID:       cloudVpcID,
+		Domain:           cvt.PtrToVal(cloud.DNSName),
+		Status:           status,
+		CloudCreatedTime: times.ConvStdTimeFormat(cvt.PtrToVal(cloud.CreatedTime)),
+		// 备注字段云上没有
+		Memo:      nil,
+		Extension: convertAwsExtension(cloud),
+	}
+	// aws 负载均衡可以跨多个子网，主表仅记录第一个子网，全部子网记录在拓展字段中
+	if len(cloudSubnetIDs) != 0 {
+		lb.CloudSubnetID = cloudSubnetIDs[0]
+		lb.SubnetID = subnetMap[cloudSubnetIDs[0]]
+	}
+	lb.PrivateIPv4Addresses, lb.PublicIPv4Addresses, lb.PrivateIPv6Addresses, lb.PublicIPv6Addresses =
+		getLBAddresses(cloud)
+
+	return lb
+}
+
+func convCloudToDBUpdate(id string, cloud typeslb.AwsLoadBalancer, vpcMap map[string]*common.VpcDB,
+	subnetMap map[string]string) *protocloud.LoadBalancerExtUpdateReq[corelb.AwsLoadBalancerExtension] {
+
+	_, cloudSubnetIDs := cloud.GetZones()
+	cloudVpcID := cvt.PtrToVal(cloud.VpcId)
+	status, _ := getLBStatus(cloud)
+	lb := &protocloud.LoadBalancerExtUpdateReq[corelb.AwsLoadBalancerExtension]{
+		ID:               id,
+		Name:             cvt.PtrToVal(cloud.LoadBalancerName),
+		IPVersion:        getLBIPVersion(cloud),
+		VpcID:            cvt.PtrToVal(vpcMap[cloudVpcID]).VpcID,
+		CloudVpcID:       cloudVpcID,
+		Domain:           cvt.PtrToVal(cloud.DNSName),
+		Status:           status,
+		CloudCreatedTime: times.ConvStdTimeFormat(cvt.PtrToVal(cloud.CreatedTime)),
+		Extension:        convertAwsExtension(cloud),
+	}
+	if len(cloudSubnetIDs) != 0 {
+		lb.CloudSubnetID = cloudSubnetIDs[0]
+		lb.SubnetID = subnetMap[cloudSubnetIDs[0]]
+	}
+	lb.PrivateIPv4Addresses, lb.PublicIPv4Addresses, lb.PrivateIPv6Addresses, lb.PublicIPv6Addresses =
+		getLBAddresses(cloud)
+
+	return lb
+}
+
+func isLBChange(cloud typeslb.AwsLoadBalancer, db corelb.AwsLoadBalancer) bool {
+	if db.Name != cvt.PtrToVal(cloud.LoadBalancerName) {
+		return true
+	}
+
+	if db.IPVersion != getLBIPVersion(cloud) {
+		return true
+	}
+
+	if db.Domain != cvt.PtrToVal(cloud.DNSName) {
+		return true
+	}
+
+	if status, _ := getLBStatus(cloud); db.Status != status {
+		return true
+	}
+
+	if db.CloudVpcID != cvt.PtrToVal(cloud.VpcId) {
+		return true
+	}
+
+	zones, _ := cloud.GetZones()
+	if !assert.IsStringSliceEqual(db.Zones, zones) {
+		return true
+	}
+
+	privateIPv4, publicIPv4, privateIPv6, publicIPv6 := getLBAddresses(cloud)
+	if !assert.IsStringSliceEqual(db.PrivateIPv4Addresses, privateIPv4) ||
+		!assert.IsStringSliceEqual(db.PublicIPv4Addresses, publicIPv4) ||
+		!assert.IsStringSliceEqual(db.PrivateIPv6Addresses, privateIPv6) ||
+		!assert.IsStringSliceEqual(db.PublicIPv6Addresses, publicIPv6) {
+		return true
+	}
+
+	return isLBExtensionChange(cloud, db)
+}
+
+func isLBExtensionChange(cloud typeslb.AwsLoadBalancer, db corelb.AwsLoadBalancer) bool {
+	if db.Extension == nil {
+		return true
+	}
+
+	ext := convertAwsExtension(cloud)
+	if !assert.IsPtrStringEqual(db.Extension.Type, ext.Type) {
+		return true
+	}
+
+	if !assert.IsPtrStringEqual(db.Extension.Scheme, ext.Scheme) {
+		return true
+	}
+
+	if !assert.IsPtrStringEqual(db.Extension.CanonicalHostedZoneID, ext.CanonicalHostedZoneID) {
+		return true
+	}
+
+	if !assert.IsPtrStringEqual(db.Extension.StateReason, ext.StateReason) {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(db.Extension.CloudSubnetIDs, ext.CloudSubnetIDs) {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(db.Extension.SecurityGroups, ext.SecurityGroups) {
+		return true
+	}
+
+	return false
+}
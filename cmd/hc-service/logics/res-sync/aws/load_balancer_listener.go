@@ -0,0 +1,272 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"fmt"
+
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typeslb "hcm/pkg/adaptor/types/load-balancer"
+	"hcm/pkg/api/core"
+	corelb "hcm/pkg/api/core/cloud/load-balancer"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/assert"
+	cvt "hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// listenerByLb 同步指定负载均衡下的全部监听器，aws 监听器只能按负载均衡查询
+func (cli *client) listenerByLb(kt *kit.Kit, accountID string, region string, lb corelb.AwsLoadBalancer) error {
+	listenerFromCloud, err := cli.listListenerFromCloud(kt, region, lb.CloudID)
+	if err != nil {
+		return err
+	}
+
+	listenerFromDB, err := cli.listListenerFromDB(kt, lb.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(listenerFromCloud) == 0 && len(listenerFromDB) == 0 {
+		return nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.Diff[typeslb.AwsListener, corelb.AwsListener](
+		listenerFromCloud, listenerFromDB, isListenerChange)
+
+	if err = cli.deleteListener(kt, accountID, delCloudIDs); err != nil {
+		return err
+	}
+
+	if err = cli.createListener(kt, accountID, lb, addSlice); err != nil {
+		return err
+	}
+
+	if err = cli.updateListener(kt, updateMap); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cli *client) createListener(kt *kit.Kit, accountID string, lb corelb.AwsLoadBalancer,
+	addSlice []typeslb.AwsListener) error {
+
+	if len(addSlice) == 0 {
+		return nil
+	}
+
+	createReq := new(protocloud.AwsListenerBatchCreateReq)
+	for _, cloud := range addSlice {
+		createReq.Listeners = append(createReq.Listeners, protocloud.ListenersCreateReq[corelb.AwsListenerExtension]{
+			CloudID:   cloud.GetCloudID(),
+			Name:      getListenerName(cloud),
+			Vendor:    enumor.Aws,
+			AccountID: accountID,
+			BkBizID:   lb.BkBizID,
+			LbID:      lb.ID,
+			CloudLbID: lb.CloudID,
+			Protocol:  enumor.ProtocolType(cvt.PtrToVal(cloud.Protocol)),
+			Port:      cvt.PtrToVal(cloud.Port),
+			Extension: convListenerExtension(cloud),
+		})
+	}
+
+	if _, err := cli.dbCli.Aws.LoadBalancer.BatchCreateListener(kt, createReq); err != nil {
+		logs.Errorf("[%s] call data service to create listener failed, err: %v, lb: %s, rid: %s",
+			enumor.Aws, err, lb.CloudID, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync listener to create success, lb: %s, count: %d, rid: %s", enumor.Aws, lb.CloudID,
+		len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateListener(kt *kit.Kit, updateMap map[string]typeslb.AwsListener) error {
+	if len(updateMap) == 0 {
+		return nil
+	}
+
+	updateReq := new(protocloud.AwsListenerUpdateReq)
+	for id, cloud := range updateMap {
+		updateReq.Listeners = append(updateReq.Listeners, &protocloud.ListenerUpdateReq[corelb.AwsListenerExtension]{
+			ID:        id,
+			Name:      getListenerName(cloud),
+			Extension: convListenerExtension(cloud),
+		})
+	}
+
+	if err := cli.dbCli.Aws.LoadBalancer.BatchUpdateListener(kt, updateReq); err != nil {
+		logs.Errorf("[%s] call data service to update listener failed, err: %v, rid: %s", enumor.Aws, err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+func (cli *client) deleteListener(kt *kit.Kit, accountID string, delCloudIDs []string) error {
+	if len(delCloudIDs) == 0 {
+		return nil
+	}
+
+	deleteReq := &protocloud.LoadBalancerBatchDeleteReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleIn("cloud_id", delCloudIDs),
+		),
+	}
+	if err := cli.dbCli.Global.LoadBalancer.DeleteListener(kt, deleteReq); err != nil {
+		logs.Errorf("[%s] call data service to delete listener failed, err: %v, rid: %s", enumor.Aws, err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync to delete listener success, accountID: %s, count: %d, rid: %s", enumor.Aws, accountID,
+		len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+// listListenerFromCloud 分页查询负载均衡下的全部监听器
+func (cli *client) listListenerFromCloud(kt *kit.Kit, region string, cloudLbID string) ([]typeslb.AwsListener,
+	error) {
+
+	opt := &typeslb.AwsListListenerOption{
+		Region:         region,
+		LoadBalancerID: cloudLbID,
+		Page:           &typeslb.AwsElbPage{PageSize: cvt.ValToPtr(int64(typeslb.AwsElbPageSizeLimit))},
+	}
+
+	result := make([]typeslb.AwsListener, 0)
+	for {
+		listenerResult, err := cli.cloudCli.ListListener(kt, opt)
+		if err != nil {
+			logs.Errorf("[%s] list listener from cloud failed, err: %v, opt: %v, rid: %s", enumor.Aws, err, opt,
+				kt.Rid)
+			return nil, err
+		}
+		result = append(result, listenerResult.Details...)
+
+		if len(cvt.PtrToVal(listenerResult.NextMarker)) == 0 {
+			break
+		}
+		opt.Page.Marker = listenerResult.NextMarker
+	}
+
+	return result, nil
+}
+
+func (cli *client) listListenerFromDB(kt *kit.Kit, lbID string) ([]corelb.AwsListener, error) {
+	req := &core.ListReq{
+		Filter: tools.EqualExpression("lb_id", lbID),
+		Page:   core.NewDefaultBasePage(),
+	}
+
+	result := make([]corelb.AwsListener, 0)
+	for {
+		listenerResult, err := cli.dbCli.Aws.LoadBalancer.ListListener(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] list listener from db failed, err: %v, lbID: %s, rid: %s", enumor.Aws, err, lbID,
+				kt.Rid)
+			return nil, err
+		}
+		result = append(result, listenerResult.Details...)
+
+		if uint(len(listenerResult.Details)) < req.Page.Limit {
+			break
+		}
+		req.Page.Start += uint32(req.Page.Limit)
+	}
+
+	return result, nil
+}
+
+// getListenerName aws 监听器没有名称，使用 协议:端口 作为名称
+func getListenerName(cloud typeslb.AwsListener) string {
+	return fmt.Sprintf("%s:%d", cvt.PtrToVal(cloud.Protocol), cvt.PtrToVal(cloud.Port))
+}
+
+func convListenerExtension(cloud typeslb.AwsListener) *corelb.AwsListenerExtension {
+	ext := &corelb.AwsListenerExtension{
+		SslPolicy:  cloud.SslPolicy,
+		AlpnPolicy: cvt.PtrToSlice(cloud.AlpnPolicy),
+	}
+
+	for _, cert := range cloud.Certificates {
+		if cert == nil || cert.CertificateArn == nil {
+			continue
+		}
+		ext.Certificates = append(ext.Certificates, *cert.CertificateArn)
+	}
+
+	for _, action := range cloud.DefaultActions {
+		if action == nil {
+			continue
+		}
+		if action.TargetGroupArn != nil {
+			ext.DefaultTargetGroups = append(ext.DefaultTargetGroups, *action.TargetGroupArn)
+		}
+		if action.ForwardConfig == nil {
+			continue
+		}
+		for _, tg := range action.ForwardConfig.TargetGroups {
+			if tg != nil && tg.TargetGroupArn != nil {
+				ext.DefaultTargetGroups = append(ext.DefaultTargetGroups, *tg.TargetGroupArn)
+			}
+		}
+	}
+	ext.DefaultTargetGroups = slice.Unique(ext.DefaultTargetGroups)
+
+	return ext
+}
+
+func isListenerChange(cloud typeslb.AwsListener, db corelb.AwsListener) bool {
+	if db.Name != getListenerName(cloud) {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	ext := convListenerExtension(cloud)
+	if !assert.IsPtrStringEqual(db.Extension.SslPolicy, ext.SslPolicy) {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(db.Extension.Certificates, ext.Certificates) {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(db.Extension.AlpnPolicy, ext.AlpnPolicy) {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(db.Extension.DefaultTargetGroups, ext.DefaultTargetGroups) {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,409 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typeslb "hcm/pkg/adaptor/types/load-balancer"
+	"hcm/pkg/api/core"
+	corelb "hcm/pkg/api/core/cloud/load-balancer"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/assert"
+	cvt "hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncTargetGroupOption ...
+type SyncTargetGroupOption struct {
+}
+
+// Validate ...
+func (o *SyncTargetGroupOption) Validate() error {
+	return validator.Validate.Struct(o)
+}
+
+// TargetGroup 同步指定目标组，aws 目标组为云上资源，不同步目标组下的RS
+func (cli *client) TargetGroup(kt *kit.Kit, params *SyncBaseParams, opt *SyncTargetGroupOption) (*SyncResult,
+	error) {
+
+	if err := validator.ValidateTool(params, opt); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	tgFromCloud, err := cli.listTargetGroupFromCloud(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	tgFromDB, err := cli.listTargetGroupFromDB(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tgFromCloud) == 0 && len(tgFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.Diff[typeslb.AwsTargetGroup, corelb.AwsTargetGroup](
+		tgFromCloud, tgFromDB, isTargetGroupChange)
+
+	if err = cli.deleteTargetGroup(kt, params.AccountID, delCloudIDs); err != nil {
+		return nil, err
+	}
+
+	if err = cli.createTargetGroup(kt, params.AccountID, params.Region, addSlice); err != nil {
+		return nil, err
+	}
+
+	if err = cli.updateTargetGroup(kt, params.AccountID, params.Region, updateMap); err != nil {
+		return nil, err
+	}
+
+	return new(SyncResult), nil
+}
+
+// RemoveTargetGroupDeleteFromCloud 删除存在本地但是在云上被删除的目标组
+func (cli *client) RemoveTargetGroupDeleteFromCloud(kt *kit.Kit, accountID string, region string) error {
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Aws),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleEqual("region", region),
+		),
+		Page: &core.BasePage{
+			Start: 0,
+			Limit: constant.BatchOperationMaxLimit,
+		},
+	}
+
+	for {
+		tgFromDB, err := cli.dbCli.Global.LoadBalancer.ListTargetGroup(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] request dataservice to list target group failed, err: %v, req: %v, rid: %s",
+				enumor.Aws, err, req, kt.Rid)
+			return err
+		}
+
+		cloudIDs := slice.Map(tgFromDB.Details, func(tg corelb.BaseTargetGroup) string { return tg.CloudID })
+		if len(cloudIDs) == 0 {
+			break
+		}
+
+		params := &SyncBaseParams{AccountID: accountID, Region: region, CloudIDs: cloudIDs}
+		tgFromCloud, err := cli.listTargetGroupFromCloud(kt, params)
+		if err != nil {
+			return err
+		}
+
+		cloudIDMap := cvt.StringSliceToMap(cloudIDs)
+		for _, tg := range tgFromCloud {
+			delete(cloudIDMap, tg.GetCloudID())
+		}
+
+		if len(cloudIDMap) != 0 {
+			if err = cli.deleteTargetGroup(kt, accountID, cvt.MapKeyToSlice(cloudIDMap)); err != nil {
+				return err
+			}
+		}
+
+		if len(tgFromDB.Details) < constant.BatchOperationMaxLimit {
+			break
+		}
+
+		req.Page.Start += constant.BatchOperationMaxLimit
+	}
+
+	return nil
+}
+
+func (cli *client) createTargetGroup(kt *kit.Kit, accountID string, region string,
+	addSlice []typeslb.AwsTargetGroup) error {
+
+	if len(addSlice) == 0 {
+		return nil
+	}
+
+	cloudVpcIDs := slice.Map(addSlice, func(tg typeslb.AwsTargetGroup) string { return cvt.PtrToVal(tg.VpcId) })
+	vpcMap, err := cli.getVpcMap(kt, accountID, region, slice.Unique(cloudVpcIDs))
+	if err != nil {
+		logs.Errorf("fail to get vpc of target group during syncing, err: %v, account: %s, vpcIDs: %v, rid: %s",
+			err, accountID, cloudVpcIDs, kt.Rid)
+		return err
+	}
+
+	createReq := new(protocloud.AwsTargetGroupCreateReq)
+	for _, cloud := range addSlice {
+		cloudVpcID := cvt.PtrToVal(cloud.VpcId)
+		// lambda 类型的目标组没有vpc，本地依赖vpc的目标组需要vpc先同步
+		if _, exist := vpcMap[cloudVpcID]; !exist {
+			logs.Warnf("[%s] vpc of target group not found in db, skip sync, tg: %s, vpc: %s, rid: %s",
+				enumor.Aws, cloud.GetCloudID(), cloudVpcID, kt.Rid)
+			continue
+		}
+
+		createReq.TargetGroups = append(createReq.TargetGroups,
+			protocloud.TargetGroupBatchCreate[corelb.AwsTargetGroupExtension]{
+				CloudID:         cloud.GetCloudID(),
+				Name:            cvt.PtrToVal(cloud.TargetGroupName),
+				Vendor:          enumor.Aws,
+				AccountID:       accountID,
+				BkBizID:         constant.UnassignedBiz,
+				Region:          region,
+				Protocol:        enumor.ProtocolType(cvt.PtrToVal(cloud.Protocol)),
+				Port:            cvt.PtrToVal(cloud.Port),
+				CloudVpcID:      cloudVpcID,
+				TargetGroupType: enumor.CloudTargetGroupType,
+				Extension:       convTargetGroupExtension(cloud),
+			})
+	}
+
+	for _, batch := range slice.Split(createReq.TargetGroups, constant.BatchOperationMaxLimit) {
+		batchReq := &protocloud.AwsTargetGroupCreateReq{TargetGroups: batch}
+		if _, err = cli.dbCli.Aws.LoadBalancer.BatchCreateTargetGroup(kt, batchReq); err != nil {
+			logs.Errorf("[%s] call data service to create target group failed, err: %v, rid: %s",
+				enumor.Aws, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync target group to create success, accountID: %s, count: %d, rid: %s", enumor.Aws,
+		accountID, len(createReq.TargetGroups), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateTargetGroup(kt *kit.Kit, accountID string, region string,
+	updateMap map[string]typeslb.AwsTargetGroup) error {
+
+	if len(updateMap) == 0 {
+		return nil
+	}
+
+	cloudVpcIDs := make([]string, 0, len(updateMap))
+	for _, tg := range updateMap {
+		cloudVpcIDs = append(cloudVpcIDs, cvt.PtrToVal(tg.VpcId))
+	}
+	vpcMap, err := cli.getVpcMap(kt, accountID, region, slice.Unique(cloudVpcIDs))
+	if err != nil {
+		logs.Errorf("fail to get vpc of target group during syncing, err: %v, account: %s, vpcIDs: %v, rid: %s",
+			err, accountID, cloudVpcIDs, kt.Rid)
+		return err
+	}
+
+	updateReq := make(protocloud.AwsTargetGroupBatchUpdateReq, 0, len(updateMap))
+	for id, cloud := range updateMap {
+		cloudVpcID := cvt.PtrToVal(cloud.VpcId)
+		updateReq = append(updateReq, &protocloud.TargetGroupExtUpdateReq[corelb.AwsTargetGroupExtension]{
+			ID:         id,
+			Name:       cvt.PtrToVal(cloud.TargetGroupName),
+			VpcID:      cvt.PtrToVal(vpcMap[cloudVpcID]).VpcID,
+			CloudVpcID: cloudVpcID,
+			Protocol:   enumor.ProtocolType(cvt.PtrToVal(cloud.Protocol)),
+			Port:       cvt.PtrToVal(cloud.Port),
+			Extension:  convTargetGroupExtension(cloud),
+		})
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		batchReq := protocloud.AwsTargetGroupBatchUpdateReq(batch)
+		if err = cli.dbCli.Aws.LoadBalancer.BatchUpdateTargetGroup(kt, &batchReq); err != nil {
+			logs.Errorf("[%s] call data service to update target group failed, err: %v, rid: %s",
+				enumor.Aws, err, kt.Rid)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cli *client) deleteTargetGroup(kt *kit.Kit, accountID string, delCloudIDs []string) error {
+	if len(delCloudIDs) == 0 {
+		return nil
+	}
+
+	deleteReq := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Aws),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleIn("cloud_id", delCloudIDs),
+		),
+	}
+	if err := cli.dbCli.Global.LoadBalancer.DeleteTargetGroup(kt, deleteReq); err != nil {
+		logs.Errorf("[%s] call data service to delete target group failed, err: %v, rid: %s", enumor.Aws, err,
+			kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync to delete target group success, accountID: %s, count: %d, rid: %s", enumor.Aws,
+		accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+// listTargetGroupFromCloud 按ARN查询目标组，只要有一个ARN不存在，批量查询就会返回NotFound，此时需要逐个查询
+func (cli *client) listTargetGroupFromCloud(kt *kit.Kit, params *SyncBaseParams) ([]typeslb.AwsTargetGroup,
+	error) {
+
+	result := make([]typeslb.AwsTargetGroup, 0, len(params.CloudIDs))
+	for _, cloudIDs := range slice.Split(params.CloudIDs, typeslb.AwsElbQueryLimit) {
+		opt := &typeslb.AwsListTargetGroupOption{
+			Region:   params.Region,
+			CloudIDs: cloudIDs,
+		}
+		tgResult, err := cli.cloudCli.ListTargetGroup(kt, opt)
+		if err != nil {
+			logs.Errorf("[%s] list target group from cloud failed, err: %v, opt: %v, rid: %s", enumor.Aws, err,
+				opt, kt.Rid)
+			return nil, err
+		}
+
+		if len(tgResult.Details) == len(cloudIDs) || len(cloudIDs) == 1 {
+			result = append(result, tgResult.Details...)
+			continue
+		}
+
+		for _, cloudID := range cloudIDs {
+			opt.CloudIDs = []string{cloudID}
+			oneResult, err := cli.cloudCli.ListTargetGroup(kt, opt)
+			if err != nil {
+				logs.Errorf("[%s] list target group from cloud failed, err: %v, opt: %v, rid: %s", enumor.Aws,
+					err, opt, kt.Rid)
+				return nil, err
+			}
+			result = append(result, oneResult.Details...)
+		}
+	}
+
+	return result, nil
+}
+
+func (cli *client) listTargetGroupFromDB(kt *kit.Kit, params *SyncBaseParams) ([]corelb.AwsTargetGroup, error) {
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", params.AccountID),
+			tools.RuleEqual("region", params.Region),
+			tools.RuleIn("cloud_id", params.CloudIDs),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := cli.dbCli.Aws.LoadBalancer.ListTargetGroup(kt, req)
+	if err != nil {
+		logs.Errorf("[%s] list target group from db failed, err: %v, account: %s, req: %v, rid: %s",
+			enumor.Aws, err, params.AccountID, req, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+func convTargetGroupExtension(cloud typeslb.AwsTargetGroup) *corelb.AwsTargetGroupExtension {
+	ext := &corelb.AwsTargetGroupExtension{
+		TargetType:      cloud.TargetType,
+		ProtocolVersion: cloud.ProtocolVersion,
+		IpAddressType:   cloud.IpAddressType,
+		CloudLbIDs:      cvt.PtrToSlice(cloud.LoadBalancerArns),
+		HealthCheck: &corelb.AwsHealthCheck{
+			Enabled:            cloud.HealthCheckEnabled,
+			Protocol:           cloud.HealthCheckProtocol,
+			Port:               cloud.HealthCheckPort,
+			Path:               cloud.HealthCheckPath,
+			IntervalSeconds:    cloud.HealthCheckIntervalSeconds,
+			TimeoutSeconds:     cloud.HealthCheckTimeoutSeconds,
+			HealthyThreshold:   cloud.HealthyThresholdCount,
+			UnhealthyThreshold: cloud.UnhealthyThresholdCount,
+		},
+	}
+	if cloud.Matcher != nil {
+		if cloud.Matcher.HttpCode != nil {
+			ext.HealthCheck.Matcher = cloud.Matcher.HttpCode
+		} else {
+			ext.HealthCheck.Matcher = cloud.Matcher.GrpcCode
+		}
+	}
+
+	return ext
+}
+
+func isTargetGroupChange(cloud typeslb.AwsTargetGroup, db corelb.AwsTargetGroup) bool {
+	if db.Name != cvt.PtrToVal(cloud.TargetGroupName) {
+		return true
+	}
+
+	if db.CloudVpcID != cvt.PtrToVal(cloud.VpcId) {
+		return true
+	}
+
+	if string(db.Protocol) != cvt.PtrToVal(cloud.Protocol) || db.Port != cvt.PtrToVal(cloud.Port) {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	ext := convTargetGroupExtension(cloud)
+	if !assert.IsPtrStringEqual(db.Extension.TargetType, ext.TargetType) {
+		return true
+	}
+
+	if !assert.IsPtrStringEqual(db.Extension.ProtocolVersion, ext.ProtocolVersion) {
+		return true
+	}
+
+	if !assert.IsPtrStringEqual(db.Extension.IpAddressType, ext.IpAddressType) {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(db.Extension.CloudLbIDs, ext.CloudLbIDs) {
+		return true
+	}
+
+	return isAwsHealthCheckChange(ext.HealthCheck, db.Extension.HealthCheck)
+}
+
+func isAwsHealthCheckChange(cloud, db *corelb.AwsHealthCheck) bool {
+	if db == nil {
+		return true
+	}
+
+	if !assert.IsPtrBoolEqual(db.Enabled, cloud.Enabled) ||
+		!assert.IsPtrStringEqual(db.Protocol, cloud.Protocol) ||
+		!assert.IsPtrStringEqual(db.Port, cloud.Port) ||
+		!assert.IsPtrStringEqual(db.Path, cloud.Path) ||
+		!assert.IsPtrStringEqual(db.Matcher, cloud.Matcher) {
+		return true
+	}
+
+	if !assert.IsPtrInt64Equal(db.IntervalSeconds, cloud.IntervalSeconds) ||
+		!assert.IsPtrInt64Equal(db.TimeoutSeconds, cloud.TimeoutSeconds) ||
+		!assert.IsPtrInt64Equal(db.HealthyThreshold, cloud.HealthyThreshold) ||
+		!assert.IsPtrInt64Equal(db.UnhealthyThreshold, cloud.UnhealthyThreshold) {
+		return true
+	}
+
+	return false
+}
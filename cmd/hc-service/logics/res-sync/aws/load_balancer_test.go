@@ -0,0 +1,185 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"errors"
+	"testing"
+
+	mockaws "hcm/pkg/adaptor/mock/aws"
+	typeslb "hcm/pkg/adaptor/types/load-balancer"
+	corelb "hcm/pkg/api/core/cloud/load-balancer"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/kit"
+	"hcm/pkg/tools/assert"
+	cvt "hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestAwsLB(arn, scheme string) typeslb.AwsLoadBalancer {
+	return typeslb.AwsLoadBalancer{LoadBalancer: &elbv2.LoadBalancer{
+		LoadBalancerArn:  cvt.ValToPtr(arn),
+		LoadBalancerName: cvt.ValToPtr("lb"),
+		Scheme:           cvt.ValToPtr(scheme),
+		IpAddressType:    cvt.ValToPtr("ipv4"),
+		DNSName:          cvt.ValToPtr("lb.elb.amazonaws.com"),
+		VpcId:            cvt.ValToPtr("vpc-1"),
+		Type:             cvt.ValToPtr("network"),
+		State:            &elbv2.LoadBalancerState{Code: cvt.ValToPtr("active")},
+		AvailabilityZones: []*elbv2.AvailabilityZone{{
+			ZoneName: cvt.ValToPtr("ap-east-1a"),
+			SubnetId: cvt.ValToPtr("subnet-1"),
+			LoadBalancerAddresses: []*elbv2.LoadBalancerAddress{{
+				IpAddress:          cvt.ValToPtr("1.1.1.1"),
+				PrivateIPv4Address: cvt.ValToPtr("10.0.0.1"),
+			}},
+		}},
+	}}
+}
+
+func TestListLBFromCloud(t *testing.T) {
+	params := &SyncBaseParams{AccountID: "00000001", Region: "ap-east-1", CloudIDs: []string{"arn-1", "arn-2"}}
+
+	t.Run("batch", func(t *testing.T) {
+		cloudCli := mockaws.NewMockAws(gomock.NewController(t))
+		cloudCli.EXPECT().ListLoadBalancer(gomock.Any(), gomock.Any()).Return(&typeslb.AwsListResult{
+			Details: []typeslb.AwsLoadBalancer{newTestAwsLB("arn-1", "internal"), newTestAwsLB("arn-2", "internal")},
+		}, nil).Times(1)
+
+		got, err := (&client{cloudCli: cloudCli}).listLBFromCloud(kit.New(), params)
+		if err != nil {
+			t.Fatalf("list lb from cloud failed, err: %v", err)
+		}
+		if len(got) != 2 {
+			t.Errorf("want 2 lb, got: %d", len(got))
+		}
+	})
+
+	t.Run("fallback to one by one when some not exist", func(t *testing.T) {
+		cloudCli := mockaws.NewMockAws(gomock.NewController(t))
+		cloudCli.EXPECT().ListLoadBalancer(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ *kit.Kit, opt *typeslb.AwsListOption) (*typeslb.AwsListResult, error) {
+				if len(opt.CloudIDs) != 1 {
+					return &typeslb.AwsListResult{}, nil
+				}
+				if opt.CloudIDs[0] == "arn-2" {
+					return &typeslb.AwsListResult{}, nil
+				}
+				return &typeslb.AwsListResult{Details: []typeslb.AwsLoadBalancer{newTestAwsLB(opt.CloudIDs[0],
+					"internal")}}, nil
+			}).Times(3)
+
+		got, err := (&client{cloudCli: cloudCli}).listLBFromCloud(kit.New(), params)
+		if err != nil {
+			t.Fatalf("list lb from cloud failed, err: %v", err)
+		}
+		if len(got) != 1 || got[0].GetCloudID() != "arn-1" {
+			t.Errorf("want only arn-1, got: %v", got)
+		}
+	})
+
+	t.Run("cloud error", func(t *testing.T) {
+		cloudCli := mockaws.NewMockAws(gomock.NewController(t))
+		cloudCli.EXPECT().ListLoadBalancer(gomock.Any(), gomock.Any()).Return(nil, errors.New("Throttling"))
+
+		if _, err := (&client{cloudCli: cloudCli}).listLBFromCloud(kit.New(), params); err == nil {
+			t.Errorf("cloud error should be returned")
+		}
+	})
+}
+
+func TestGetLBAddresses(t *testing.T) {
+	cases := []struct {
+		scheme                  string
+		privateIPv4, publicIPv4 []string
+		wantType                typeslb.TCloudLoadBalancerType
+	}{
+		{scheme: "internet-facing", privateIPv4: []string{"10.0.0.1"}, publicIPv4: []string{"1.1.1.1"},
+			wantType: typeslb.OpenLoadBalancerType},
+		{scheme: "internal", privateIPv4: []string{"10.0.0.1", "1.1.1.1"},
+			wantType: typeslb.InternalLoadBalancerType},
+	}
+
+	for _, c := range cases {
+		lb := newTestAwsLB("arn-1", c.scheme)
+		privateIPv4, publicIPv4, _, _ := getLBAddresses(lb)
+		if !assert.IsStringSliceEqual(privateIPv4, c.privateIPv4) || !assert.IsStringSliceEqual(publicIPv4, c.publicIPv4) {
+			t.Errorf("%s: want private: %v, public: %v, got private: %v, public: %v", c.scheme, c.privateIPv4,
+				c.publicIPv4, privateIPv4, publicIPv4)
+		}
+		if got := getLBType(lb); got != c.wantType {
+			t.Errorf("%s: want lb type: %s, got: %s", c.scheme, c.wantType, got)
+		}
+	}
+}
+
+func TestIsLBChange(t *testing.T) {
+	cloud := newTestAwsLB("arn-1", "internet-facing")
+	db := func(modify func(db *corelb.AwsLoadBalancer)) corelb.AwsLoadBalancer {
+		create := convCloudToDBCreate(cloud, "00000001", "ap-east-1", nil, nil)
+		one := corelb.AwsLoadBalancer{
+			BaseLoadBalancer: corelb.BaseLoadBalancer{
+				CloudID:              create.CloudID,
+				Name:                 create.Name,
+				IPVersion:            create.IPVersion,
+				Zones:                create.Zones,
+				CloudVpcID:           create.CloudVpcID,
+				PrivateIPv4Addresses: create.PrivateIPv4Addresses,
+				PublicIPv4Addresses:  create.PublicIPv4Addresses,
+				PrivateIPv6Addresses: create.PrivateIPv6Addresses,
+				PublicIPv6Addresses:  create.PublicIPv6Addresses,
+				Domain:               create.Domain,
+				Status:               create.Status,
+			},
+			Extension: create.Extension,
+		}
+		if modify != nil {
+			modify(&one)
+		}
+		return one
+	}
+
+	cases := []struct {
+		name string
+		db   corelb.AwsLoadBalancer
+		want bool
+	}{
+		{name: "same", db: db(nil), want: false},
+		{name: "status changed", db: db(func(db *corelb.AwsLoadBalancer) { db.Status = "provisioning" }),
+			want: true},
+		{name: "ip version changed", db: db(func(db *corelb.AwsLoadBalancer) { db.IPVersion = enumor.Ipv6DualStack }),
+			want: true},
+		{name: "public ip changed", db: db(func(db *corelb.AwsLoadBalancer) { db.PublicIPv4Addresses = nil }),
+			want: true},
+		{name: "extension missing", db: db(func(db *corelb.AwsLoadBalancer) { db.Extension = nil }), want: true},
+		{name: "security group changed", db: db(func(db *corelb.AwsLoadBalancer) {
+			db.Extension = &corelb.AwsLoadBalancerExtension{Type: db.Extension.Type, Scheme: db.Extension.Scheme,
+				CloudSubnetIDs: db.Extension.CloudSubnetIDs, SecurityGroups: []string{"sg-1"}}
+		}), want: true},
+	}
+
+	for _, c := range cases {
+		if got := isLBChange(cloud, c.db); got != c.want {
+			t.Errorf("%s: want change: %v, got: %v", c.name, c.want, got)
+		}
+	}
+}
@@ -125,8 +125,17 @@ func (cli *client) route(kt *kit.Kit, opt *syncRouteOption) (*SyncResult, error)
 		return new(SyncResult), nil
 	}
 
+	hopMap, err := cli.getRouteNextHopMap(kt, opt.AccountID, routeFromCloud)
+	if err != nil {
+		return nil, err
+	}
+
 	addSlice, updateMap, delCloudIDs := common.Diff[typesroutetable.AwsRoute,
-		routetable.AwsRoute](routeFromCloud, routeFromDB, isRouteChange)
+		routetable.AwsRoute](routeFromCloud, routeFromDB,
+		func(cloud typesroutetable.AwsRoute, db routetable.AwsRoute) bool {
+			hop := getRouteNextHop(cloud, hopMap)
+			return isRouteChange(cloud, db) || hop.ResType != db.NextHopResType || hop.ResID != db.NextHopResID
+		})
 
 	if len(delCloudIDs) > 0 {
 		if err = cli.deleteRoute(kt, opt.AccountID, opt.Region, opt.CloudRouteTableID, routeTable.ID,
@@ -136,14 +145,14 @@ func (cli *client) route(kt *kit.Kit, opt *syncRouteOption) (*SyncResult, error)
 	}
 
 	if len(addSlice) > 0 {
-		err := cli.createRoute(kt, opt.AccountID, opt.Region, routeTable.ID, addSlice)
+		err := cli.createRoute(kt, opt.AccountID, opt.Region, routeTable.ID, addSlice, hopMap)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	if len(updateMap) > 0 {
-		err := cli.updateRoute(kt, opt.AccountID, opt.Region, routeTable.ID, updateMap)
+		err := cli.updateRoute(kt, opt.AccountID, opt.Region, routeTable.ID, updateMap, hopMap)
 		if err != nil {
 			return nil, err
 		}
@@ -152,8 +161,34 @@ func (cli *client) route(kt *kit.Kit, opt *syncRouteOption) (*SyncResult, error)
 	return new(SyncResult), nil
 }
 
+// getRouteNextHopMap 获取路由下一跳为NAT网关、对等连接时对应的本地资源，返回 云上ID -> 本地资源 的映射
+func (cli *client) getRouteNextHopMap(kt *kit.Kit, accountID string, routes []typesroutetable.AwsRoute) (
+	map[string]common.RouteNextHop, error) {
+
+	cloudNatIDs := make([]string, 0)
+	cloudPeeringIDs := make([]string, 0)
+	for _, one := range routes {
+		if one.CloudNatGatewayID != nil {
+			cloudNatIDs = append(cloudNatIDs, *one.CloudNatGatewayID)
+		}
+		if one.CloudVpcPeeringConnectionID != nil {
+			cloudPeeringIDs = append(cloudPeeringIDs, *one.CloudVpcPeeringConnectionID)
+		}
+	}
+
+	return common.GetRouteNextHopMap(kt, cli.dbCli, enumor.Aws, accountID, cloudNatIDs, cloudPeeringIDs)
+}
+
+func getRouteNextHop(route typesroutetable.AwsRoute, hopMap map[string]common.RouteNextHop) common.RouteNextHop {
+	if route.CloudNatGatewayID != nil {
+		return hopMap[*route.CloudNatGatewayID]
+	}
+
+	return hopMap[converter.PtrToVal(route.CloudVpcPeeringConnectionID)]
+}
+
 func (cli *client) createRoute(kt *kit.Kit, accountID string, region string, routeTableID string,
-	addSlice []typesroutetable.AwsRoute) error {
+	addSlice []typesroutetable.AwsRoute, hopMap map[string]common.RouteNextHop) error {
 
 	if len(addSlice) <= 0 {
 		return fmt.Errorf("route addSlice is <= 0, not create")
@@ -180,6 +215,9 @@ func (cli *client) createRoute(kt *kit.Kit, accountID string, region string, rou
 			State:                            one.State,
 			Propagated:                       one.Propagated,
 		}
+		hop := getRouteNextHop(one, hopMap)
+		tmpRes.NextHopResType = hop.ResType
+		tmpRes.NextHopResID = hop.ResID
 		createResources = append(createResources, tmpRes)
 	}
 
@@ -200,7 +238,7 @@ func (cli *client) createRoute(kt *kit.Kit, accountID string, region string, rou
 }
 
 func (cli *client) updateRoute(kt *kit.Kit, accountID, region, routeTableID string,
-	updateMap map[string]typesroutetable.AwsRoute) error {
+	updateMap map[string]typesroutetable.AwsRoute, hopMap map[string]common.RouteNextHop) error {
 
 	if len(updateMap) <= 0 {
 		return fmt.Errorf("route updateMap is <= 0, not update")
@@ -224,6 +262,9 @@ func (cli *client) updateRoute(kt *kit.Kit, accountID, region, routeTableID stri
 		tmpRes.CloudVpcPeeringConnectionID = one.CloudVpcPeeringConnectionID
 		tmpRes.State = one.State
 		tmpRes.Propagated = converter.ValToPtr(one.Propagated)
+		hop := getRouteNextHop(one, hopMap)
+		tmpRes.NextHopResType = hop.ResType
+		tmpRes.NextHopResID = hop.ResID
 
 		updateResources = append(updateResources, tmpRes)
 	}
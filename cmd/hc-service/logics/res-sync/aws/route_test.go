@@ -0,0 +1,69 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"testing"
+
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typesroutetable "hcm/pkg/adaptor/types/route-table"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/tools/converter"
+)
+
+func TestGetRouteNextHop(t *testing.T) {
+	hopMap := map[string]common.RouteNextHop{
+		"nat-1": {ResType: enumor.NatGatewayCloudResType, ResID: "n1"},
+		"pcx-1": {ResType: enumor.VpcPeeringCloudResType, ResID: "p1"},
+	}
+
+	cases := []struct {
+		name     string
+		route    typesroutetable.AwsRoute
+		expected common.RouteNextHop
+	}{
+		{
+			name:     "nat gateway",
+			route:    typesroutetable.AwsRoute{CloudNatGatewayID: converter.ValToPtr("nat-1")},
+			expected: common.RouteNextHop{ResType: enumor.NatGatewayCloudResType, ResID: "n1"},
+		},
+		{
+			name:     "vpc peering",
+			route:    typesroutetable.AwsRoute{CloudVpcPeeringConnectionID: converter.ValToPtr("pcx-1")},
+			expected: common.RouteNextHop{ResType: enumor.VpcPeeringCloudResType, ResID: "p1"},
+		},
+		{
+			name:     "nat gateway not synced",
+			route:    typesroutetable.AwsRoute{CloudNatGatewayID: converter.ValToPtr("nat-2")},
+			expected: common.RouteNextHop{},
+		},
+		{
+			name:     "other next hop",
+			route:    typesroutetable.AwsRoute{CloudGatewayID: converter.ValToPtr("igw-1")},
+			expected: common.RouteNextHop{},
+		},
+	}
+
+	for _, c := range cases {
+		if got := getRouteNextHop(c.route, hopMap); got != c.expected {
+			t.Errorf("%s: expected %+v, got %+v", c.name, c.expected, got)
+		}
+	}
+}
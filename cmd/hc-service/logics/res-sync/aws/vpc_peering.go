@@ -0,0 +1,399 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typesvpcpeering "hcm/pkg/adaptor/types/vpc-peering"
+	"hcm/pkg/api/core"
+	corevpcpeering "hcm/pkg/api/core/cloud/vpc-peering"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/assert"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+	"hcm/pkg/tools/times"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// SyncVpcPeeringOption ...
+type SyncVpcPeeringOption struct {
+}
+
+// Validate ...
+func (opt SyncVpcPeeringOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// VpcPeering 同步对等连接
+func (cli *client) VpcPeering(kt *kit.Kit, params *SyncBaseParams, opt *SyncVpcPeeringOption) (*SyncResult, error) {
+	if err := validator.ValidateTool(params, opt); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	peeringFromCloud, err := cli.listVpcPeeringFromCloud(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	peeringFromDB, err := cli.listVpcPeeringFromDB(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(peeringFromCloud) == 0 && len(peeringFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.Diff[typesvpcpeering.AwsVpcPeering,
+		corevpcpeering.VpcPeering[corevpcpeering.AwsVpcPeeringExtension]](peeringFromCloud, peeringFromDB,
+		isVpcPeeringChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteVpcPeering(kt, params.AccountID, params.Region, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	// 对端VPC可能属于其他账号，按厂商查询不限制账号
+	cloudVpcIDs := make([]string, 0, 2*(len(addSlice)+len(updateMap)))
+	for _, one := range addSlice {
+		cloudVpcIDs = append(cloudVpcIDs, getAwsVpcID(one.RequesterVpcInfo), getAwsVpcID(one.AccepterVpcInfo))
+	}
+	for _, one := range updateMap {
+		cloudVpcIDs = append(cloudVpcIDs, getAwsVpcID(one.RequesterVpcInfo), getAwsVpcID(one.AccepterVpcInfo))
+	}
+	vpcMap, err := common.GetNetworkVpcRelMap(kt, cli.dbCli, enumor.Aws, "", cloudVpcIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createVpcPeering(kt, params.AccountID, params.Region, addSlice, vpcMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateVpcPeering(kt, updateMap, vpcMap); err != nil {
+			return nil, err
+		}
+	}
+
+	return new(SyncResult), nil
+}
+
+func (cli *client) createVpcPeering(kt *kit.Kit, accountID, region string,
+	addSlice []typesvpcpeering.AwsVpcPeering, vpcMap map[string]common.NetworkVpcRel) error {
+
+	createReq := new(protocloud.VpcPeeringBatchCreateReq[corevpcpeering.AwsVpcPeeringExtension])
+	for _, one := range addSlice {
+		cloudVpcID := getAwsVpcID(one.RequesterVpcInfo)
+		cloudPeerVpcID := getAwsVpcID(one.AccepterVpcInfo)
+		peering := protocloud.VpcPeeringBatchCreate[corevpcpeering.AwsVpcPeeringExtension]{
+			CloudID:        one.GetCloudID(),
+			AccountID:      accountID,
+			BkBizID:        constant.UnassignedBiz,
+			Name:           one.GetName(),
+			Region:         region,
+			Status:         one.GetStatus(),
+			VpcID:          vpcMap[cloudVpcID].VpcID,
+			CloudVpcID:     cloudVpcID,
+			PeerVpcID:      vpcMap[cloudPeerVpcID].VpcID,
+			CloudPeerVpcID: cloudPeerVpcID,
+			PeerRegion:     getAwsVpcRegion(one.AccepterVpcInfo),
+			PeerAccount:    getAwsVpcOwner(one.AccepterVpcInfo),
+			Extension:      convAwsVpcPeeringExtension(one),
+		}
+		if rel, exist := vpcMap[cloudVpcID]; exist {
+			peering.BkBizID = rel.BkBizID
+		}
+		createReq.VpcPeerings = append(createReq.VpcPeerings, peering)
+	}
+
+	for _, batch := range slice.Split(createReq.VpcPeerings, constant.BatchOperationMaxLimit) {
+		req := &protocloud.VpcPeeringBatchCreateReq[corevpcpeering.AwsVpcPeeringExtension]{VpcPeerings: batch}
+		if _, err := cli.dbCli.Aws.VpcPeering.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create vpc peering failed, err: %v, rid: %s",
+				enumor.Aws, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync vpc peering to create vpc peering success, accountID: %s, count: %d, rid: %s",
+		enumor.Aws, accountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateVpcPeering(kt *kit.Kit, updateMap map[string]typesvpcpeering.AwsVpcPeering,
+	vpcMap map[string]common.NetworkVpcRel) error {
+
+	updateReq := make(protocloud.VpcPeeringExtBatchUpdateReq[corevpcpeering.AwsVpcPeeringExtension], 0,
+		len(updateMap))
+	for id, one := range updateMap {
+		cloudVpcID := getAwsVpcID(one.RequesterVpcInfo)
+		cloudPeerVpcID := getAwsVpcID(one.AccepterVpcInfo)
+		peering := &protocloud.VpcPeeringExtUpdateReq[corevpcpeering.AwsVpcPeeringExtension]{
+			ID:             id,
+			Name:           one.GetName(),
+			Status:         one.GetStatus(),
+			VpcID:          vpcMap[cloudVpcID].VpcID,
+			CloudVpcID:     cloudVpcID,
+			PeerVpcID:      vpcMap[cloudPeerVpcID].VpcID,
+			CloudPeerVpcID: cloudPeerVpcID,
+			PeerRegion:     getAwsVpcRegion(one.AccepterVpcInfo),
+			PeerAccount:    getAwsVpcOwner(one.AccepterVpcInfo),
+			Extension:      convAwsVpcPeeringExtension(one),
+		}
+		if rel, exist := vpcMap[cloudVpcID]; exist {
+			peering.BkBizID = rel.BkBizID
+		}
+		updateReq = append(updateReq, peering)
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.VpcPeeringExtBatchUpdateReq[corevpcpeering.AwsVpcPeeringExtension](batch)
+		if err := cli.dbCli.Aws.VpcPeering.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update vpc peering failed, err: %v, rid: %s",
+				enumor.Aws, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync vpc peering to update vpc peering success, count: %d, rid: %s", enumor.Aws,
+		len(updateMap), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) deleteVpcPeering(kt *kit.Kit, accountID, region string, delCloudIDs []string) error {
+	checkParams := &SyncBaseParams{AccountID: accountID, Region: region, CloudIDs: delCloudIDs}
+	delFromCloud, err := cli.listVpcPeeringFromCloud(kt, checkParams)
+	if err != nil {
+		return err
+	}
+
+	if len(delFromCloud) > 0 {
+		logs.Errorf("[%s] validate vpc peering not exist failed, before delete opt: %v, failed_count: %d, rid: %s",
+			enumor.Aws, checkParams, len(delFromCloud), kt.Rid)
+		return errf.Newf(errf.Aborted, "validate vpc peering not exist failed, before delete")
+	}
+
+	req := &protocloud.VpcPeeringBatchDeleteReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Aws),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleIn("cloud_id", delCloudIDs),
+		),
+	}
+	if err = cli.dbCli.Global.VpcPeering.BatchDelete(kt, req); err != nil {
+		logs.Errorf("[%s] request dataservice to batch delete vpc peering failed, err: %v, rid: %s", enumor.Aws,
+			err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync vpc peering to delete vpc peering success, accountID: %s, count: %d, rid: %s",
+		enumor.Aws, accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) listVpcPeeringFromCloud(kt *kit.Kit, params *SyncBaseParams) (
+	[]typesvpcpeering.AwsVpcPeering, error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &typesvpcpeering.AwsVpcPeeringListOption{
+		Region:   params.Region,
+		CloudIDs: params.CloudIDs,
+	}
+	result, _, err := cli.cloudCli.ListVpcPeering(kt, opt)
+	if err != nil {
+		logs.Errorf("[%s] list vpc peering from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+			enumor.Aws, err, params.AccountID, opt, kt.Rid)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (cli *client) listVpcPeeringFromDB(kt *kit.Kit, params *SyncBaseParams) (
+	[]corevpcpeering.VpcPeering[corevpcpeering.AwsVpcPeeringExtension], error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", params.AccountID),
+			tools.RuleEqual("region", params.Region),
+			tools.RuleIn("cloud_id", params.CloudIDs),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := cli.dbCli.Aws.VpcPeering.ListVpcPeeringExt(kt, req)
+	if err != nil {
+		logs.Errorf("[%s] list vpc peering from db failed, err: %v, account: %s, req: %v, rid: %s", enumor.Aws,
+			err, params.AccountID, req, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+// RemoveVpcPeeringDeleteFromCloud 删除本地存在但云上已被删除的对等连接
+func (cli *client) RemoveVpcPeeringDeleteFromCloud(kt *kit.Kit, accountID string, region string) error {
+	req := &core.ListReq{
+		Fields: []string{"id", "cloud_id"},
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Aws),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleEqual("region", region),
+		),
+		Page: &core.BasePage{Start: 0, Limit: constant.BatchOperationMaxLimit},
+	}
+	for {
+		resultFromDB, err := cli.dbCli.Global.VpcPeering.List(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] request dataservice to list vpc peering failed, err: %v, req: %v, rid: %s",
+				enumor.Aws, err, req, kt.Rid)
+			return err
+		}
+
+		cloudIDs := make([]string, 0, len(resultFromDB.Details))
+		for _, one := range resultFromDB.Details {
+			cloudIDs = append(cloudIDs, one.CloudID)
+		}
+
+		if len(cloudIDs) == 0 {
+			break
+		}
+
+		params := &SyncBaseParams{AccountID: accountID, Region: region, CloudIDs: cloudIDs}
+		resultFromCloud, err := cli.listVpcPeeringFromCloud(kt, params)
+		if err != nil {
+			return err
+		}
+
+		// 如果有资源没有查询出来，说明数据被从云上删除
+		if len(resultFromCloud) != len(cloudIDs) {
+			cloudIDMap := converter.StringSliceToMap(cloudIDs)
+			for _, one := range resultFromCloud {
+				delete(cloudIDMap, one.GetCloudID())
+			}
+
+			err = cli.deleteVpcPeering(kt, accountID, region, converter.MapKeyToStringSlice(cloudIDMap))
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(resultFromDB.Details) < constant.BatchOperationMaxLimit {
+			break
+		}
+
+		req.Page.Start += constant.BatchOperationMaxLimit
+	}
+
+	return nil
+}
+
+func getAwsVpcID(info *ec2.VpcPeeringConnectionVpcInfo) string {
+	if info == nil {
+		return ""
+	}
+
+	return converter.PtrToVal(info.VpcId)
+}
+
+func getAwsVpcRegion(info *ec2.VpcPeeringConnectionVpcInfo) string {
+	if info == nil {
+		return ""
+	}
+
+	return converter.PtrToVal(info.Region)
+}
+
+func getAwsVpcOwner(info *ec2.VpcPeeringConnectionVpcInfo) string {
+	if info == nil {
+		return ""
+	}
+
+	return converter.PtrToVal(info.OwnerId)
+}
+
+func convAwsVpcPeeringExtension(one typesvpcpeering.AwsVpcPeering) *corevpcpeering.AwsVpcPeeringExtension {
+	ext := new(corevpcpeering.AwsVpcPeeringExtension)
+	if one.RequesterVpcInfo != nil {
+		ext.RequesterOwnerID = one.RequesterVpcInfo.OwnerId
+	}
+	if one.AccepterVpcInfo != nil {
+		ext.AccepterOwnerID = one.AccepterVpcInfo.OwnerId
+	}
+	if one.Status != nil {
+		ext.StatusMessage = one.Status.Message
+	}
+	if one.ExpirationTime != nil {
+		ext.ExpirationTime = converter.ValToPtr(times.ConvStdTimeFormat(*one.ExpirationTime))
+	}
+
+	return ext
+}
+
+func isVpcPeeringChange(cloud typesvpcpeering.AwsVpcPeering,
+	db corevpcpeering.VpcPeering[corevpcpeering.AwsVpcPeeringExtension]) bool {
+
+	if cloud.GetName() != db.Name {
+		return true
+	}
+
+	if cloud.GetStatus() != db.Status {
+		return true
+	}
+
+	if getAwsVpcID(cloud.RequesterVpcInfo) != db.CloudVpcID || getAwsVpcID(cloud.AccepterVpcInfo) != db.CloudPeerVpcID {
+		return true
+	}
+
+	if getAwsVpcRegion(cloud.AccepterVpcInfo) != db.PeerRegion || getAwsVpcOwner(cloud.AccepterVpcInfo) != db.PeerAccount {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	if cloud.Status != nil && !assert.IsPtrStringEqual(cloud.Status.Message, db.Extension.StatusMessage) {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,121 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"errors"
+	"testing"
+
+	"hcm/pkg/adaptor/aws"
+	mockaws "hcm/pkg/adaptor/mock/aws"
+	"hcm/pkg/adaptor/types"
+	adcore "hcm/pkg/adaptor/types/core"
+	cloudcore "hcm/pkg/api/core/cloud"
+	"hcm/pkg/kit"
+	"hcm/pkg/tools/converter"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestListVpcFromCloud(t *testing.T) {
+	params := &SyncBaseParams{AccountID: "00000001", Region: "ap-east-1", CloudIDs: []string{"vpc-1", "vpc-2"}}
+	vpcs := []types.AwsVpc{{CloudID: "vpc-1"}, {CloudID: "vpc-2"}}
+
+	cases := []struct {
+		name    string
+		result  *types.AwsVpcListResult
+		err     error
+		wantLen int
+		wantErr bool
+	}{
+		{name: "found", result: &types.AwsVpcListResult{Details: vpcs}, wantLen: 2},
+		{name: "not found", err: errors.New(aws.ErrVpcNotFound + ": vpc not exist"), wantLen: 0},
+		{name: "cloud error", err: errors.New("RequestLimitExceeded"), wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			cloudCli := mockaws.NewMockAws(ctrl)
+			cloudCli.EXPECT().ListVpc(gomock.Any(), &adcore.AwsListOption{Region: params.Region,
+				CloudIDs: params.CloudIDs}).Return(c.result, c.err)
+
+			cli := &client{cloudCli: cloudCli}
+			got, err := cli.listVpcFromCloud(kit.New(), params)
+			if (err != nil) != c.wantErr {
+				t.Fatalf("want err: %v, got: %v", c.wantErr, err)
+			}
+			if len(got) != c.wantLen {
+				t.Errorf("want %d vpcs, got: %d", c.wantLen, len(got))
+			}
+		})
+	}
+}
+
+func TestIsAwsVpcChange(t *testing.T) {
+	cloud := types.AwsVpc{
+		Name:   "vpc",
+		Region: "ap-east-1",
+		Memo:   converter.ValToPtr("memo"),
+		Extension: &cloudcore.AwsVpcExtension{
+			Cidr:             []cloudcore.AwsCidr{{Type: "ipv4", Cidr: "10.0.0.0/16", State: "associated"}},
+			EnableDnsSupport: true,
+		},
+	}
+	db := func(modify func(vpc *cloudcore.Vpc[cloudcore.AwsVpcExtension])) cloudcore.Vpc[cloudcore.AwsVpcExtension] {
+		ext := *cloud.Extension
+		ext.Cidr = append([]cloudcore.AwsCidr(nil), cloud.Extension.Cidr...)
+		vpc := cloudcore.Vpc[cloudcore.AwsVpcExtension]{
+			BaseVpc:   cloudcore.BaseVpc{Name: cloud.Name, Region: cloud.Region, Memo: converter.ValToPtr("memo")},
+			Extension: &ext,
+		}
+		if modify != nil {
+			modify(&vpc)
+		}
+		return vpc
+	}
+
+	cases := []struct {
+		name string
+		db   cloudcore.Vpc[cloudcore.AwsVpcExtension]
+		want bool
+	}{
+		{name: "same", db: db(nil), want: false},
+		{name: "name changed", db: db(func(v *cloudcore.Vpc[cloudcore.AwsVpcExtension]) { v.Name = "old" }),
+			want: true},
+		{name: "memo changed", db: db(func(v *cloudcore.Vpc[cloudcore.AwsVpcExtension]) { v.Memo = nil }),
+			want: true},
+		{name: "cidr state changed", db: db(func(v *cloudcore.Vpc[cloudcore.AwsVpcExtension]) {
+			v.Extension.Cidr[0].State = "associating"
+		}), want: true},
+		{name: "cidr removed from cloud", db: db(func(v *cloudcore.Vpc[cloudcore.AwsVpcExtension]) {
+			v.Extension.Cidr = append(v.Extension.Cidr, cloudcore.AwsCidr{Cidr: "10.1.0.0/16"})
+		}), want: true},
+		{name: "dns support changed", db: db(func(v *cloudcore.Vpc[cloudcore.AwsVpcExtension]) {
+			v.Extension.EnableDnsSupport = false
+		}), want: true},
+	}
+
+	for _, c := range cases {
+		if got := isAwsVpcChange(cloud, c.db); got != c.want {
+			t.Errorf("%s: want change: %v, got: %v", c.name, c.want, got)
+		}
+	}
+}
@@ -0,0 +1,304 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typesbucket "hcm/pkg/adaptor/types/bucket"
+	"hcm/pkg/api/core"
+	corebucket "hcm/pkg/api/core/cloud/bucket"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/assert"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+	"hcm/pkg/tools/times"
+)
+
+// SyncBucketOption ...
+type SyncBucketOption struct {
+	AccountID         string `json:"account_id" validate:"required"`
+	ResourceGroupName string `json:"resource_group_name" validate:"required"`
+}
+
+// Validate ...
+func (opt SyncBucketOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// Bucket 同步资源组下存储账户的Blob容器，业务由分配操作决定，同步不覆盖
+func (cli *client) Bucket(kt *kit.Kit, opt *SyncBucketOption) (*SyncResult, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	bucketFromCloud, err := cli.listBucketFromCloud(kt, opt, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketFromDB, err := cli.listBucketFromDB(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bucketFromCloud) == 0 && len(bucketFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.DiffPaaS[typesbucket.AzureBucket,
+		corebucket.Bucket[corebucket.AzureBucketExtension]](bucketFromCloud, bucketFromDB, isBucketChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteBucket(kt, opt, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createBucket(kt, opt, addSlice); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateBucket(kt, opt, updateMap); err != nil {
+			return nil, err
+		}
+	}
+
+	return new(SyncResult), nil
+}
+
+func (cli *client) createBucket(kt *kit.Kit, opt *SyncBucketOption, addSlice []typesbucket.AzureBucket) error {
+	buckets := make([]protocloud.BucketBatchCreate[corebucket.AzureBucketExtension], 0, len(addSlice))
+	for _, one := range addSlice {
+		buckets = append(buckets, protocloud.BucketBatchCreate[corebucket.AzureBucketExtension]{
+			CloudID:          one.GetCloudID(),
+			AccountID:        opt.AccountID,
+			BkBizID:          constant.UnassignedBiz,
+			Name:             converter.PtrToVal(one.Name),
+			Region:           one.GetRegion(),
+			PublicAccess:     one.GetPublicAccess(),
+			Encryption:       one.GetEncryption(),
+			CloudCreatedTime: getAzureBucketCreatedTime(one),
+			Extension:        convAzureBucketExtension(opt.ResourceGroupName, one),
+		})
+	}
+
+	for _, batch := range slice.Split(buckets, constant.BatchOperationMaxLimit) {
+		req := &protocloud.BucketBatchCreateReq[corebucket.AzureBucketExtension]{Buckets: batch}
+		if _, err := cli.dbCli.Azure.Bucket.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create bucket failed, err: %v, rid: %s", enumor.Azure,
+				err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync bucket to create bucket success, accountID: %s, count: %d, rid: %s", enumor.Azure,
+		opt.AccountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateBucket(kt *kit.Kit, opt *SyncBucketOption,
+	updateMap map[string]typesbucket.AzureBucket) error {
+
+	updateReq := make(protocloud.BucketExtBatchUpdateReq[corebucket.AzureBucketExtension], 0, len(updateMap))
+	for id, one := range updateMap {
+		updateReq = append(updateReq, &protocloud.BucketExtUpdateReq[corebucket.AzureBucketExtension]{
+			ID:           id,
+			Name:         converter.PtrToVal(one.Name),
+			Region:       one.GetRegion(),
+			PublicAccess: one.GetPublicAccess(),
+			Encryption:   one.GetEncryption(),
+			Extension:    convAzureBucketExtension(opt.ResourceGroupName, one),
+		})
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.BucketExtBatchUpdateReq[corebucket.AzureBucketExtension](batch)
+		if err := cli.dbCli.Azure.Bucket.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update bucket failed, err: %v, rid: %s", enumor.Azure,
+				err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync bucket to update bucket success, count: %d, rid: %s", enumor.Azure, len(updateMap),
+		kt.Rid)
+
+	return nil
+}
+
+func (cli *client) deleteBucket(kt *kit.Kit, opt *SyncBucketOption, delCloudIDs []string) error {
+	delFromCloud, err := cli.listBucketFromCloud(kt, opt, delCloudIDs)
+	if err != nil {
+		return err
+	}
+
+	if len(delFromCloud) > 0 {
+		logs.Errorf("[%s] validate bucket not exist failed, before delete, opt: %v, failed_count: %d, rid: %s",
+			enumor.Azure, opt, len(delFromCloud), kt.Rid)
+		return errf.Newf(errf.Aborted, "validate bucket not exist failed, before delete")
+	}
+
+	for _, batch := range slice.Split(delCloudIDs, constant.BatchOperationMaxLimit) {
+		req := &protocloud.BucketBatchDeleteReq{
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("vendor", enumor.Azure),
+				tools.RuleEqual("account_id", opt.AccountID),
+				tools.RuleJSONEqual("extension.resource_group_name", opt.ResourceGroupName),
+				tools.RuleIn("cloud_id", batch),
+			),
+		}
+		if err = cli.dbCli.Global.Bucket.BatchDelete(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch delete bucket failed, err: %v, rid: %s", enumor.Azure,
+				err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync bucket to delete bucket success, accountID: %s, count: %d, rid: %s", enumor.Azure,
+		opt.AccountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+// listBucketFromCloud cloudIDs 为空时查询资源组下全部Blob容器
+func (cli *client) listBucketFromCloud(kt *kit.Kit, opt *SyncBucketOption, cloudIDs []string) (
+	[]typesbucket.AzureBucket, error) {
+
+	if len(cloudIDs) == 0 {
+		listOpt := &typesbucket.AzureBucketListOption{ResourceGroupName: opt.ResourceGroupName}
+		result, err := cli.cloudCli.ListBucket(kt, listOpt)
+		if err != nil {
+			logs.Errorf("[%s] list bucket from cloud failed, err: %v, opt: %v, rid: %s", enumor.Azure, err, opt,
+				kt.Rid)
+			return nil, err
+		}
+		return result, nil
+	}
+
+	result := make([]typesbucket.AzureBucket, 0, len(cloudIDs))
+	for _, batch := range slice.Split(cloudIDs, typesbucket.BucketListMaxLimit) {
+		listOpt := &typesbucket.AzureBucketListOption{ResourceGroupName: opt.ResourceGroupName, CloudIDs: batch}
+		buckets, err := cli.cloudCli.ListBucket(kt, listOpt)
+		if err != nil {
+			logs.Errorf("[%s] list bucket from cloud failed, err: %v, account: %s, opt: %v, rid: %s", enumor.Azure,
+				err, opt.AccountID, listOpt, kt.Rid)
+			return nil, err
+		}
+		result = append(result, buckets...)
+	}
+
+	return result, nil
+}
+
+func (cli *client) listBucketFromDB(kt *kit.Kit, opt *SyncBucketOption) (
+	[]corebucket.Bucket[corebucket.AzureBucketExtension], error) {
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Azure),
+			tools.RuleEqual("account_id", opt.AccountID),
+			tools.RuleJSONEqual("extension.resource_group_name", opt.ResourceGroupName),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result := make([]corebucket.Bucket[corebucket.AzureBucketExtension], 0)
+	for {
+		resp, err := cli.dbCli.Azure.Bucket.ListBucketExt(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] list bucket from db failed, err: %v, account: %s, req: %v, rid: %s", enumor.Azure,
+				err, opt.AccountID, req, kt.Rid)
+			return nil, err
+		}
+
+		result = append(result, resp.Details...)
+
+		if len(resp.Details) < int(core.DefaultMaxPageLimit) {
+			break
+		}
+
+		req.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return result, nil
+}
+
+// getAzureBucketCreatedTime Blob容器没有创建时间，使用存储账户的创建时间
+func getAzureBucketCreatedTime(one typesbucket.AzureBucket) string {
+	if one.Account == nil || one.Account.Properties == nil || one.Account.Properties.CreationTime == nil {
+		return ""
+	}
+	return times.ConvStdTimeFormat(*one.Account.Properties.CreationTime)
+}
+
+func convAzureBucketExtension(resGroupName string, one typesbucket.AzureBucket) *corebucket.AzureBucketExtension {
+	ext := &corebucket.AzureBucketExtension{
+		ResourceGroupName:     resGroupName,
+		StorageAccountName:    one.GetAccountName(),
+		AllowBlobPublicAccess: one.AllowBlobPublicAccess(),
+	}
+	if one.Properties != nil {
+		ext.ContainerPublicAccess = string(converter.PtrToVal(one.Properties.PublicAccess))
+		ext.DefaultEncryptionScope = one.Properties.DefaultEncryptionScope
+	}
+	if one.Account != nil && one.Account.SKU != nil && one.Account.SKU.Name != nil {
+		ext.SKUName = converter.ValToPtr(string(*one.Account.SKU.Name))
+	}
+
+	return ext
+}
+
+func isBucketChange(cloud typesbucket.AzureBucket, db corebucket.Bucket[corebucket.AzureBucketExtension]) bool {
+	if converter.PtrToVal(cloud.Name) != db.Name || cloud.GetRegion() != db.Region {
+		return true
+	}
+
+	if cloud.GetPublicAccess() != db.PublicAccess || cloud.GetEncryption() != db.Encryption {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	ext := convAzureBucketExtension(db.Extension.ResourceGroupName, cloud)
+	if ext.StorageAccountName != db.Extension.StorageAccountName ||
+		ext.ContainerPublicAccess != db.Extension.ContainerPublicAccess ||
+		ext.AllowBlobPublicAccess != db.Extension.AllowBlobPublicAccess {
+		return true
+	}
+
+	if !assert.IsPtrStringEqual(ext.DefaultEncryptionScope, db.Extension.DefaultEncryptionScope) ||
+		!assert.IsPtrStringEqual(ext.SKUName, db.Extension.SKUName) {
+		return true
+	}
+
+	return false
+}
@@ -27,7 +27,7 @@ import (
 
 // Interface support resource sync.
 type Interface interface {
-	CloudCli() *azure.Azure
+	CloudCli() azure.Azure
 
 	Cvm(kt *kit.Kit, params *SyncBaseParams, opt *SyncCvmOption) (*SyncResult, error)
 	CvmWithRelRes(kt *kit.Kit, params *SyncBaseParams, opt *SyncCvmWithRelResOption) (*SyncResult, error)
@@ -65,12 +65,32 @@ type Interface interface {
 	Region(kt *kit.Kit, opt *SyncRegionOption) (*SyncResult, error)
 
 	SubAccount(kt *kit.Kit, opt *SyncSubAccountOption) (*SyncResult, error)
+
+	Bucket(kt *kit.Kit, opt *SyncBucketOption) (*SyncResult, error)
+	K8sCluster(kt *kit.Kit, opt *SyncK8sClusterOption) (*SyncResult, error)
+	KeyPair(kt *kit.Kit, opt *SyncKeyPairOption) (*SyncResult, error)
+	PrivateDnsZone(kt *kit.Kit, opt *SyncPrivateDnsZoneOption) (*SyncResult, error)
+
+	LoadBalancer(kt *kit.Kit, params *SyncBaseParams, opt *SyncLBOption) (*SyncResult, error)
+	RemoveLoadBalancerDeleteFromCloud(kt *kit.Kit, accountID string, resGroupName string) error
+
+	Snapshot(kt *kit.Kit, params *SyncBaseParams, opt *SyncSnapshotOption) (*SyncResult, error)
+	RemoveSnapshotDeleteFromCloud(kt *kit.Kit, accountID string, resGroupName string) error
+
+	DatabaseInstance(kt *kit.Kit, params *SyncBaseParams, opt *SyncDatabaseInstanceOption) (*SyncResult, error)
+	RemoveDatabaseInstanceDeleteFromCloud(kt *kit.Kit, accountID string, resGroupName string) error
+
+	NatGateway(kt *kit.Kit, params *SyncBaseParams, opt *SyncNatGatewayOption) (*SyncResult, error)
+	RemoveNatGatewayDeleteFromCloud(kt *kit.Kit, accountID string, resGroupName string) error
+
+	VpcPeering(kt *kit.Kit, params *SyncBaseParams, opt *SyncVpcPeeringOption) (*SyncResult, error)
+	RemoveVpcPeeringDeleteFromCloud(kt *kit.Kit, accountID string, resGroupName string) error
 }
 
 var _ Interface = new(client)
 
 // NewClient new client.
-func NewClient(dbCli *dataservice.Client, cloudCli *azure.Azure) Interface {
+func NewClient(dbCli *dataservice.Client, cloudCli azure.Azure) Interface {
 	return &client{
 		dbCli:    dbCli,
 		cloudCli: cloudCli,
@@ -79,11 +99,11 @@ func NewClient(dbCli *dataservice.Client, cloudCli *azure.Azure) Interface {
 
 type client struct {
 	accountID string
-	cloudCli  *azure.Azure
+	cloudCli  azure.Azure
 	dbCli     *dataservice.Client
 }
 
 // CloudCli ...
-func (cli *client) CloudCli() *azure.Azure {
+func (cli *client) CloudCli() azure.Azure {
 	return cli.cloudCli
 }
@@ -0,0 +1,399 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typesdbinstance "hcm/pkg/adaptor/types/database-instance"
+	"hcm/pkg/api/core"
+	coredbinstance "hcm/pkg/api/core/cloud/database-instance"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/assert"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// azureDBInstanceDefaultPort 灵活服务器不返回端口，按引擎使用默认端口
+var azureDBInstanceDefaultPort = map[string]int64{
+	"mysql":      3306,
+	"postgresql": 5432,
+}
+
+// SyncDatabaseInstanceOption ...
+type SyncDatabaseInstanceOption struct {
+}
+
+// Validate ...
+func (opt SyncDatabaseInstanceOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// DatabaseInstance 同步数据库灵活服务器，业务由分配操作决定，同步不覆盖。
+// 灵活服务器通过防火墙规则或VNet集成控制访问，不关联安全组
+func (cli *client) DatabaseInstance(kt *kit.Kit, params *SyncBaseParams, opt *SyncDatabaseInstanceOption) (
+	*SyncResult, error) {
+
+	if err := validator.ValidateTool(params, opt); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	dbFromCloud, err := cli.listDBInstanceFromCloud(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	dbFromDB, err := cli.listDBInstanceFromDB(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dbFromCloud) == 0 && len(dbFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.DiffPaaS[typesdbinstance.AzureDBInstance,
+		coredbinstance.DatabaseInstance[coredbinstance.AzureDBInstanceExtension]](dbFromCloud, dbFromDB,
+		isDBInstanceChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteDBInstance(kt, params.AccountID, params.ResourceGroupName, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	cloudSubnetIDs := make([]string, 0, len(addSlice)+len(updateMap))
+	for _, one := range addSlice {
+		cloudSubnetIDs = append(cloudSubnetIDs, converter.PtrToVal(one.CloudSubnetID))
+	}
+	for _, one := range updateMap {
+		cloudSubnetIDs = append(cloudSubnetIDs, converter.PtrToVal(one.CloudSubnetID))
+	}
+	subnetMap, err := common.GetNetworkSubnetRelMap(kt, cli.dbCli, enumor.Azure, params.AccountID, cloudSubnetIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(addSlice) > 0 {
+		err = cli.createDBInstance(kt, params.AccountID, params.ResourceGroupName, addSlice, subnetMap)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateDBInstance(kt, params.ResourceGroupName, updateMap, subnetMap); err != nil {
+			return nil, err
+		}
+	}
+
+	return new(SyncResult), nil
+}
+
+func (cli *client) createDBInstance(kt *kit.Kit, accountID, resGroupName string,
+	addSlice []typesdbinstance.AzureDBInstance, subnetMap map[string]common.NetworkSubnetRel) error {
+
+	createReq := new(protocloud.DatabaseInstanceBatchCreateReq[coredbinstance.AzureDBInstanceExtension])
+	for _, one := range addSlice {
+		cloudSubnetID := converter.PtrToVal(one.CloudSubnetID)
+		subnet := subnetMap[cloudSubnetID]
+		db := protocloud.DatabaseInstanceBatchCreate[coredbinstance.AzureDBInstanceExtension]{
+			CloudID:        one.GetCloudID(),
+			AccountID:      accountID,
+			BkBizID:        constant.UnassignedBiz,
+			Name:           converter.PtrToVal(one.Name),
+			Region:         converter.PtrToVal(one.Location),
+			Zone:           converter.PtrToVal(one.AvailabilityZone),
+			Status:         converter.PtrToVal(one.State),
+			Engine:         one.Engine,
+			EngineVersion:  converter.PtrToVal(one.Version),
+			InstanceClass:  converter.PtrToVal(one.SKUName),
+			StorageSize:    converter.PtrToVal(one.StorageSizeGB),
+			VpcID:          subnet.VpcID,
+			CloudVpcID:     subnet.CloudVpcID,
+			SubnetID:       subnet.SubnetID,
+			CloudSubnetID:  cloudSubnetID,
+			PrivateAddress: getAzureDBInstancePrivateAddress(one),
+			PublicAddress:  getAzureDBInstancePublicAddress(one),
+			Port:           azureDBInstanceDefaultPort[one.Engine],
+			Extension:      convAzureDBInstanceExtension(resGroupName, one),
+		}
+		createReq.DatabaseInstances = append(createReq.DatabaseInstances, db)
+	}
+
+	for _, batch := range slice.Split(createReq.DatabaseInstances, constant.BatchOperationMaxLimit) {
+		req := &protocloud.DatabaseInstanceBatchCreateReq[coredbinstance.AzureDBInstanceExtension]{
+			DatabaseInstances: batch,
+		}
+		if _, err := cli.dbCli.Azure.DatabaseInstance.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create database instance failed, err: %v, rid: %s",
+				enumor.Azure, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync database instance to create database instance success, accountID: %s, count: %d, "+
+		"rid: %s", enumor.Azure, accountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateDBInstance(kt *kit.Kit, resGroupName string,
+	updateMap map[string]typesdbinstance.AzureDBInstance, subnetMap map[string]common.NetworkSubnetRel) error {
+
+	updateReq := make(protocloud.DatabaseInstanceExtBatchUpdateReq[coredbinstance.AzureDBInstanceExtension], 0,
+		len(updateMap))
+	for id, one := range updateMap {
+		cloudSubnetID := converter.PtrToVal(one.CloudSubnetID)
+		subnet := subnetMap[cloudSubnetID]
+		db := &protocloud.DatabaseInstanceExtUpdateReq[coredbinstance.AzureDBInstanceExtension]{
+			ID:             id,
+			Name:           converter.PtrToVal(one.Name),
+			Zone:           converter.PtrToVal(one.AvailabilityZone),
+			Status:         converter.PtrToVal(one.State),
+			EngineVersion:  converter.PtrToVal(one.Version),
+			InstanceClass:  converter.PtrToVal(one.SKUName),
+			StorageSize:    converter.PtrToVal(one.StorageSizeGB),
+			VpcID:          subnet.VpcID,
+			CloudVpcID:     subnet.CloudVpcID,
+			SubnetID:       subnet.SubnetID,
+			CloudSubnetID:  cloudSubnetID,
+			PrivateAddress: getAzureDBInstancePrivateAddress(one),
+			PublicAddress:  getAzureDBInstancePublicAddress(one),
+			Port:           azureDBInstanceDefaultPort[one.Engine],
+			Extension:      convAzureDBInstanceExtension(resGroupName, one),
+		}
+		updateReq = append(updateReq, db)
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.DatabaseInstanceExtBatchUpdateReq[coredbinstance.AzureDBInstanceExtension](batch)
+		if err := cli.dbCli.Azure.DatabaseInstance.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update database instance failed, err: %v, rid: %s",
+				enumor.Azure, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync database instance to update database instance success, count: %d, rid: %s",
+		enumor.Azure, len(updateMap), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) deleteDBInstance(kt *kit.Kit, accountID, resGroupName string, delCloudIDs []string) error {
+	checkParams := &SyncBaseParams{AccountID: accountID, ResourceGroupName: resGroupName, CloudIDs: delCloudIDs}
+	delFromCloud, err := cli.listDBInstanceFromCloud(kt, checkParams)
+	if err != nil {
+		return err
+	}
+
+	if len(delFromCloud) > 0 {
+		logs.Errorf("[%s] validate database instance not exist failed, before delete opt: %v, failed_count: %d, "+
+			"rid: %s", enumor.Azure, checkParams, len(delFromCloud), kt.Rid)
+		return errf.Newf(errf.Aborted, "validate database instance not exist failed, before delete")
+	}
+
+	req := &protocloud.DatabaseInstanceBatchDeleteReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Azure),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleIn("cloud_id", delCloudIDs),
+		),
+	}
+	if err = cli.dbCli.Global.DatabaseInstance.BatchDelete(kt, req); err != nil {
+		logs.Errorf("[%s] request dataservice to batch delete database instance failed, err: %v, rid: %s",
+			enumor.Azure, err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync database instance to delete database instance success, accountID: %s, count: %d, "+
+		"rid: %s", enumor.Azure, accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) listDBInstanceFromCloud(kt *kit.Kit, params *SyncBaseParams) (
+	[]typesdbinstance.AzureDBInstance, error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &typesdbinstance.AzureDBInstanceListOption{
+		ResourceGroupName: params.ResourceGroupName,
+		CloudIDs:          params.CloudIDs,
+	}
+	result, err := cli.cloudCli.ListDatabaseInstance(kt, opt)
+	if err != nil {
+		logs.Errorf("[%s] list database instance from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+			enumor.Azure, err, params.AccountID, opt, kt.Rid)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (cli *client) listDBInstanceFromDB(kt *kit.Kit, params *SyncBaseParams) (
+	[]coredbinstance.DatabaseInstance[coredbinstance.AzureDBInstanceExtension], error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", params.AccountID),
+			tools.RuleIn("cloud_id", params.CloudIDs),
+			tools.RuleJSONEqual("extension.resource_group_name", params.ResourceGroupName),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := cli.dbCli.Azure.DatabaseInstance.ListDatabaseInstanceExt(kt, req)
+	if err != nil {
+		logs.Errorf("[%s] list database instance from db failed, err: %v, account: %s, req: %v, rid: %s",
+			enumor.Azure, err, params.AccountID, req, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+// RemoveDatabaseInstanceDeleteFromCloud 删除本地存在但云上已被删除的数据库灵活服务器
+func (cli *client) RemoveDatabaseInstanceDeleteFromCloud(kt *kit.Kit, accountID string, resGroupName string) error {
+	req := &core.ListReq{
+		Fields: []string{"id", "cloud_id"},
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Azure),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleJSONEqual("extension.resource_group_name", resGroupName),
+		),
+		Page: &core.BasePage{Start: 0, Limit: constant.BatchOperationMaxLimit},
+	}
+	for {
+		resultFromDB, err := cli.dbCli.Global.DatabaseInstance.List(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] request dataservice to list database instance failed, err: %v, req: %v, rid: %s",
+				enumor.Azure, err, req, kt.Rid)
+			return err
+		}
+
+		cloudIDs := make([]string, 0, len(resultFromDB.Details))
+		for _, one := range resultFromDB.Details {
+			cloudIDs = append(cloudIDs, one.CloudID)
+		}
+
+		if len(cloudIDs) == 0 {
+			break
+		}
+
+		params := &SyncBaseParams{AccountID: accountID, ResourceGroupName: resGroupName, CloudIDs: cloudIDs}
+		resultFromCloud, err := cli.listDBInstanceFromCloud(kt, params)
+		if err != nil {
+			return err
+		}
+
+		// 如果有资源没有查询出来，说明数据被从云上删除
+		if len(resultFromCloud) != len(cloudIDs) {
+			cloudIDMap := converter.StringSliceToMap(cloudIDs)
+			for _, one := range resultFromCloud {
+				delete(cloudIDMap, one.GetCloudID())
+			}
+
+			delCloudIDs := converter.MapKeyToStringSlice(cloudIDMap)
+			if err = cli.deleteDBInstance(kt, accountID, resGroupName, delCloudIDs); err != nil {
+				return err
+			}
+		}
+
+		if len(resultFromDB.Details) < constant.BatchOperationMaxLimit {
+			break
+		}
+
+		req.Page.Start += constant.BatchOperationMaxLimit
+	}
+
+	return nil
+}
+
+// getAzureDBInstancePrivateAddress VNet集成的实例通过FQDN在虚拟网络内访问
+func getAzureDBInstancePrivateAddress(one typesdbinstance.AzureDBInstance) string {
+	if len(converter.PtrToVal(one.CloudSubnetID)) == 0 {
+		return ""
+	}
+	return converter.PtrToVal(one.FullyQualifiedDomainName)
+}
+
+// getAzureDBInstancePublicAddress 公网访问模式的实例通过FQDN在公网访问
+func getAzureDBInstancePublicAddress(one typesdbinstance.AzureDBInstance) string {
+	if len(converter.PtrToVal(one.CloudSubnetID)) != 0 {
+		return ""
+	}
+	return converter.PtrToVal(one.FullyQualifiedDomainName)
+}
+
+func convAzureDBInstanceExtension(resGroupName string,
+	one typesdbinstance.AzureDBInstance) *coredbinstance.AzureDBInstanceExtension {
+
+	return &coredbinstance.AzureDBInstanceExtension{
+		ResourceGroupName: resGroupName,
+		ResourceType:      converter.PtrToVal(one.Type),
+		SKUTier:           one.SKUTier,
+	}
+}
+
+func isDBInstanceChange(cloud typesdbinstance.AzureDBInstance,
+	db coredbinstance.DatabaseInstance[coredbinstance.AzureDBInstanceExtension]) bool {
+
+	if converter.PtrToVal(cloud.Name) != db.Name || converter.PtrToVal(cloud.State) != db.Status ||
+		converter.PtrToVal(cloud.AvailabilityZone) != db.Zone {
+		return true
+	}
+
+	if converter.PtrToVal(cloud.Version) != db.EngineVersion || converter.PtrToVal(cloud.SKUName) != db.InstanceClass ||
+		converter.PtrToVal(cloud.StorageSizeGB) != db.StorageSize {
+		return true
+	}
+
+	if converter.PtrToVal(cloud.CloudSubnetID) != db.CloudSubnetID {
+		return true
+	}
+
+	if getAzureDBInstancePrivateAddress(cloud) != db.PrivateAddress ||
+		getAzureDBInstancePublicAddress(cloud) != db.PublicAddress {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	if !assert.IsPtrStringEqual(cloud.SKUTier, db.Extension.SKUTier) {
+		return true
+	}
+
+	return false
+}
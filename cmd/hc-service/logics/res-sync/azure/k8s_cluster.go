@@ -0,0 +1,285 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typesk8s "hcm/pkg/adaptor/types/k8s-cluster"
+	"hcm/pkg/api/core"
+	corek8s "hcm/pkg/api/core/cloud/k8s"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncK8sClusterOption ...
+type SyncK8sClusterOption struct {
+	AccountID         string `json:"account_id" validate:"required"`
+	ResourceGroupName string `json:"resource_group_name" validate:"required"`
+}
+
+// Validate ...
+func (opt SyncK8sClusterOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// K8sCluster 同步资源组下的AKS集群及集群下的节点池，业务由分配操作决定，同步不覆盖。AKS节点为节点资源组下的
+// 虚拟机规模集实例，不在主机中管理，因此不同步节点和主机的关联关系
+func (cli *client) K8sCluster(kt *kit.Kit, opt *SyncK8sClusterOption) (*SyncResult, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	clusterFromCloud, err := cli.listK8sClusterFromCloud(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterFromDB, err := cli.listK8sClusterFromDB(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(clusterFromCloud) == 0 && len(clusterFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.DiffPaaS[typesk8s.AzureCluster,
+		corek8s.K8sCluster[corek8s.AzureK8sClusterExtension]](clusterFromCloud, clusterFromDB, isK8sClusterChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteK8sCluster(kt, opt.AccountID, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createK8sCluster(kt, opt, addSlice); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateK8sCluster(kt, opt, updateMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = cli.k8sNodePool(kt, opt, clusterFromCloud); err != nil {
+		return nil, err
+	}
+
+	return new(SyncResult), nil
+}
+
+// k8sNodePool 同步本地全部集群的节点池，AKS节点池在集群详情中返回
+func (cli *client) k8sNodePool(kt *kit.Kit, opt *SyncK8sClusterOption,
+	clusterFromCloud []typesk8s.AzureCluster) error {
+
+	clusterFromDB, err := cli.listK8sClusterFromDB(kt, opt)
+	if err != nil {
+		return err
+	}
+
+	cloudMap := make(map[string]typesk8s.AzureCluster, len(clusterFromCloud))
+	for _, one := range clusterFromCloud {
+		cloudMap[one.GetCloudID()] = one
+	}
+
+	for _, cluster := range clusterFromDB {
+		one, exist := cloudMap[cluster.CloudID]
+		if !exist {
+			continue
+		}
+
+		err = common.SyncK8sNodePoolAndNode(kt, cli.dbCli, cluster.BaseK8sCluster, one.GetNodePools(), nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cli *client) createK8sCluster(kt *kit.Kit, opt *SyncK8sClusterOption,
+	addSlice []typesk8s.AzureCluster) error {
+
+	clusters := make([]protocloud.K8sClusterBatchCreate[corek8s.AzureK8sClusterExtension], 0, len(addSlice))
+	for _, one := range addSlice {
+		clusters = append(clusters, protocloud.K8sClusterBatchCreate[corek8s.AzureK8sClusterExtension]{
+			CloudID:          one.GetCloudID(),
+			AccountID:        opt.AccountID,
+			BkBizID:          constant.UnassignedBiz,
+			Name:             converter.PtrToVal(one.Name),
+			Region:           converter.PtrToVal(one.Location),
+			Status:           one.GetStatus(),
+			Version:          converter.PtrToVal(one.KubernetesVersion),
+			CloudVpcID:       one.GetCloudVpcID(),
+			CloudCreatedTime: converter.PtrToVal(one.CreatedTime),
+			Extension:        convAzureK8sClusterExtension(opt.ResourceGroupName, one),
+		})
+	}
+
+	for _, batch := range slice.Split(clusters, constant.BatchOperationMaxLimit) {
+		req := &protocloud.K8sClusterBatchCreateReq[corek8s.AzureK8sClusterExtension]{K8sClusters: batch}
+		if _, err := cli.dbCli.Azure.K8sCluster.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create k8s cluster failed, err: %v, rid: %s",
+				enumor.Azure, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync k8s cluster to create k8s cluster success, accountID: %s, count: %d, rid: %s",
+		enumor.Azure, opt.AccountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateK8sCluster(kt *kit.Kit, opt *SyncK8sClusterOption,
+	updateMap map[string]typesk8s.AzureCluster) error {
+
+	updateReq := make(protocloud.K8sClusterExtBatchUpdateReq[corek8s.AzureK8sClusterExtension], 0, len(updateMap))
+	for id, one := range updateMap {
+		updateReq = append(updateReq, &protocloud.K8sClusterExtUpdateReq[corek8s.AzureK8sClusterExtension]{
+			ID:         id,
+			Name:       converter.PtrToVal(one.Name),
+			Region:     converter.PtrToVal(one.Location),
+			Status:     one.GetStatus(),
+			Version:    converter.PtrToVal(one.KubernetesVersion),
+			CloudVpcID: one.GetCloudVpcID(),
+			Extension:  convAzureK8sClusterExtension(opt.ResourceGroupName, one),
+		})
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.K8sClusterExtBatchUpdateReq[corek8s.AzureK8sClusterExtension](batch)
+		if err := cli.dbCli.Azure.K8sCluster.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update k8s cluster failed, err: %v, rid: %s",
+				enumor.Azure, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync k8s cluster to update k8s cluster success, count: %d, rid: %s", enumor.Azure,
+		len(updateMap), kt.Rid)
+
+	return nil
+}
+
+// deleteK8sCluster 删除容器集群时，data-service 会同时删除集群下的节点池和节点关联关系
+func (cli *client) deleteK8sCluster(kt *kit.Kit, accountID string, delCloudIDs []string) error {
+	for _, batch := range slice.Split(delCloudIDs, constant.BatchOperationMaxLimit) {
+		req := &protocloud.K8sClusterBatchDeleteReq{
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("vendor", enumor.Azure),
+				tools.RuleEqual("account_id", accountID),
+				tools.RuleIn("cloud_id", batch),
+			),
+		}
+		if err := cli.dbCli.Global.K8sCluster.BatchDelete(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch delete k8s cluster failed, err: %v, rid: %s",
+				enumor.Azure, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync k8s cluster to delete k8s cluster success, accountID: %s, count: %d, rid: %s",
+		enumor.Azure, accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) listK8sClusterFromCloud(kt *kit.Kit, opt *SyncK8sClusterOption) ([]typesk8s.AzureCluster,
+	error) {
+
+	listOpt := &typesk8s.AzureK8sClusterListOption{ResourceGroupName: opt.ResourceGroupName}
+	result, err := cli.cloudCli.ListK8sCluster(kt, listOpt)
+	if err != nil {
+		logs.Errorf("[%s] list k8s cluster from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+			enumor.Azure, err, opt.AccountID, listOpt, kt.Rid)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (cli *client) listK8sClusterFromDB(kt *kit.Kit, opt *SyncK8sClusterOption) (
+	[]corek8s.K8sCluster[corek8s.AzureK8sClusterExtension], error) {
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Azure),
+			tools.RuleEqual("account_id", opt.AccountID),
+			tools.RuleJSONEqual("extension.resource_group_name", opt.ResourceGroupName),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result := make([]corek8s.K8sCluster[corek8s.AzureK8sClusterExtension], 0)
+	for {
+		resp, err := cli.dbCli.Azure.K8sCluster.ListK8sClusterExt(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] list k8s cluster from db failed, err: %v, account: %s, req: %v, rid: %s",
+				enumor.Azure, err, opt.AccountID, req, kt.Rid)
+			return nil, err
+		}
+
+		result = append(result, resp.Details...)
+
+		if len(resp.Details) < int(core.DefaultMaxPageLimit) {
+			break
+		}
+
+		req.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return result, nil
+}
+
+func convAzureK8sClusterExtension(resGroupName string,
+	one typesk8s.AzureCluster) *corek8s.AzureK8sClusterExtension {
+
+	return &corek8s.AzureK8sClusterExtension{
+		ResourceGroupName: resGroupName,
+		DnsPrefix:         converter.PtrToVal(one.DnsPrefix),
+		Fqdn:              converter.PtrToVal(one.Fqdn),
+		NodeResourceGroup: converter.PtrToVal(one.NodeResourceGroup),
+		NetworkPlugin:     converter.PtrToVal(one.NetworkPlugin),
+	}
+}
+
+func isK8sClusterChange(cloud typesk8s.AzureCluster, db corek8s.K8sCluster[corek8s.AzureK8sClusterExtension]) bool {
+	if converter.PtrToVal(cloud.Name) != db.Name || cloud.GetStatus() != db.Status ||
+		converter.PtrToVal(cloud.KubernetesVersion) != db.Version || cloud.GetCloudVpcID() != db.CloudVpcID {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	return *convAzureK8sClusterExtension(db.Extension.ResourceGroupName, cloud) != *db.Extension
+}
@@ -0,0 +1,378 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	"fmt"
+
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typecore "hcm/pkg/adaptor/types/core"
+	typeslb "hcm/pkg/adaptor/types/load-balancer"
+	"hcm/pkg/api/core"
+	corelb "hcm/pkg/api/core/cloud/load-balancer"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/assert"
+	cvt "hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncLBOption ...
+type SyncLBOption struct {
+}
+
+// Validate ...
+func (o *SyncLBOption) Validate() error {
+	return validator.Validate.Struct(o)
+}
+
+// LoadBalancer 同步指定负载均衡自身属性
+func (cli *client) LoadBalancer(kt *kit.Kit, params *SyncBaseParams, opt *SyncLBOption) (*SyncResult, error) {
+	if err := validator.ValidateTool(params, opt); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	lbFromCloud, err := cli.listLBFromCloud(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	lbFromDB, err := cli.listLBFromDB(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lbFromCloud) == 0 && len(lbFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.Diff[typeslb.AzureLoadBalancer, corelb.AzureLoadBalancer](
+		lbFromCloud, lbFromDB, isLBChange)
+
+	if err = cli.deleteLoadBalancer(kt, params.AccountID, params.ResourceGroupName, delCloudIDs); err != nil {
+		return nil, err
+	}
+
+	if err = cli.createLoadBalancer(kt, params.AccountID, addSlice); err != nil {
+		return nil, err
+	}
+
+	if err = cli.updateLoadBalancer(kt, params.AccountID, updateMap); err != nil {
+		return nil, err
+	}
+
+	return new(SyncResult), nil
+}
+
+// RemoveLoadBalancerDeleteFromCloud 删除存在本地但是在云上被删除的数据
+func (cli *client) RemoveLoadBalancerDeleteFromCloud(kt *kit.Kit, accountID string, resGroupName string) error {
+	req := &core.ListReq{
+		Fields: []string{"id", "cloud_id"},
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Azure),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleJSONEqual("extension.resource_group_name", resGroupName),
+		),
+		Page: &core.BasePage{
+			Start: 0,
+			Limit: constant.BatchOperationMaxLimit,
+		},
+	}
+
+	for {
+		lbFromDB, err := cli.dbCli.Global.LoadBalancer.ListLoadBalancer(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] request dataservice to list lb failed, err: %v, req: %v, rid: %s", enumor.Azure,
+				err, req, kt.Rid)
+			return err
+		}
+
+		cloudIDs := slice.Map(lbFromDB.Details, func(lb corelb.BaseLoadBalancer) string { return lb.CloudID })
+		if len(cloudIDs) == 0 {
+			break
+		}
+
+		params := &SyncBaseParams{AccountID: accountID, ResourceGroupName: resGroupName, CloudIDs: cloudIDs}
+		lbFromCloud, err := cli.listLBFromCloud(kt, params)
+		if err != nil {
+			return err
+		}
+
+		// 如果有资源没有查询出来，说明数据被从云上删除
+		if len(lbFromCloud) != len(cloudIDs) {
+			cloudIDMap := cvt.StringSliceToMap(cloudIDs)
+			for _, one := range lbFromCloud {
+				delete(cloudIDMap, one.CloudID)
+			}
+
+			delCloudIDs := cvt.MapKeyToSlice(cloudIDMap)
+			if err = cli.deleteLoadBalancer(kt, accountID, resGroupName, delCloudIDs); err != nil {
+				return err
+			}
+		}
+
+		if len(lbFromDB.Details) < constant.BatchOperationMaxLimit {
+			break
+		}
+
+		req.Page.Start += constant.BatchOperationMaxLimit
+	}
+
+	return nil
+}
+
+func (cli *client) createLoadBalancer(kt *kit.Kit, accountID string, addSlice []typeslb.AzureLoadBalancer) error {
+	if len(addSlice) == 0 {
+		return nil
+	}
+
+	vpcMap, subnetMap, err := cli.getLoadBalancerRelatedRes(kt, accountID, addSlice)
+	if err != nil {
+		return err
+	}
+
+	createReq := new(protocloud.AzureLoadBalancerCreateReq)
+	for _, cloud := range addSlice {
+		lb := protocloud.LbBatchCreate[corelb.AzureLoadBalancerExtension]{
+			CloudID:              cloud.CloudID,
+			Name:                 cloud.Name,
+			Vendor:               enumor.Azure,
+			AccountID:            accountID,
+			BkBizID:              constant.UnassignedBiz,
+			LoadBalancerType:     string(getLBType(cloud)),
+			IPVersion:            getLBIPVersion(cloud),
+			Region:               cloud.Region,
+			Zones:                cloud.Zones,
+			VpcID:                vpcMap[cloud.CloudVpcID],
+			CloudVpcID:           cloud.CloudVpcID,
+			SubnetID:             subnetMap[cloud.CloudSubnetID],
+			CloudSubnetID:        cloud.CloudSubnetID,
+			PrivateIPv4Addresses: cloud.PrivateIPv4Addresses,
+			PrivateIPv6Addresses: cloud.PrivateIPv6Addresses,
+			Status:               cloud.ProvisioningState,
+			Extension:            convLBExtension(cloud),
+		}
+		createReq.Lbs = append(createReq.Lbs, lb)
+	}
+
+	if _, err = cli.dbCli.Azure.LoadBalancer.BatchCreate(kt, createReq); err != nil {
+		logs.Errorf("[%s] call data service to create load balancer failed, err: %v, rid: %s", enumor.Azure, err,
+			kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync load balancer to create lb success, accountID: %s, count: %d, rid: %s", enumor.Azure,
+		accountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateLoadBalancer(kt *kit.Kit, accountID string,
+	updateMap map[string]typeslb.AzureLoadBalancer) error {
+
+	if len(updateMap) == 0 {
+		return nil
+	}
+
+	vpcMap, subnetMap, err := cli.getLoadBalancerRelatedRes(kt, accountID, cvt.MapValueToSlice(updateMap))
+	if err != nil {
+		return err
+	}
+
+	updateReq := new(protocloud.AzureLoadBalancerBatchUpdateReq)
+	for id, cloud := range updateMap {
+		updateReq.Lbs = append(updateReq.Lbs, &protocloud.LoadBalancerExtUpdateReq[corelb.AzureLoadBalancerExtension]{
+			ID:                   id,
+			Name:                 cloud.Name,
+			IPVersion:            getLBIPVersion(cloud),
+			VpcID:                vpcMap[cloud.CloudVpcID],
+			CloudVpcID:           cloud.CloudVpcID,
+			SubnetID:             subnetMap[cloud.CloudSubnetID],
+			CloudSubnetID:        cloud.CloudSubnetID,
+			PrivateIPv4Addresses: cloud.PrivateIPv4Addresses,
+			PrivateIPv6Addresses: cloud.PrivateIPv6Addresses,
+			Status:               cloud.ProvisioningState,
+			Extension:            convLBExtension(cloud),
+		})
+	}
+
+	if err = cli.dbCli.Azure.LoadBalancer.BatchUpdate(kt, updateReq); err != nil {
+		logs.Errorf("[%s] call data service to update load balancer failed, err: %v, rid: %s", enumor.Azure, err,
+			kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+func (cli *client) deleteLoadBalancer(kt *kit.Kit, accountID string, resGroupName string,
+	delCloudIDs []string) error {
+
+	if len(delCloudIDs) == 0 {
+		return nil
+	}
+
+	checkParams := &SyncBaseParams{
+		AccountID:         accountID,
+		ResourceGroupName: resGroupName,
+		CloudIDs:          delCloudIDs,
+	}
+	delLBFromCloud, err := cli.listLBFromCloud(kt, checkParams)
+	if err != nil {
+		return err
+	}
+
+	if len(delLBFromCloud) > 0 {
+		logs.Errorf("[%s] lb not exist before sync deletion, opt: %v, failed_count: %d, rid: %s", enumor.Azure,
+			checkParams, len(delLBFromCloud), kt.Rid)
+		return fmt.Errorf("lb not exist before sync deletion")
+	}
+
+	deleteReq := &protocloud.LoadBalancerBatchDeleteReq{
+		Filter: tools.ContainersExpression("cloud_id", delCloudIDs),
+	}
+	if err = cli.dbCli.Global.LoadBalancer.BatchDelete(kt, deleteReq); err != nil {
+		logs.Errorf("[%s] call data service to batch delete lb failed, err: %v, rid: %s", enumor.Azure, err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync to delete lb success, accountID: %s, count: %d, rid: %s", enumor.Azure, accountID,
+		len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) getLoadBalancerRelatedRes(kt *kit.Kit, accountID string, lbs []typeslb.AzureLoadBalancer) (
+	map[string]string, map[string]string, error) {
+
+	cloudVpcIDs := make([]string, 0, len(lbs))
+	cloudSubnetIDs := make([]string, 0, len(lbs))
+	for _, lb := range lbs {
+		if len(lb.CloudVpcID) != 0 {
+			cloudVpcIDs = append(cloudVpcIDs, lb.CloudVpcID)
+		}
+		if len(lb.CloudSubnetID) != 0 {
+			cloudSubnetIDs = append(cloudSubnetIDs, lb.CloudSubnetID)
+		}
+	}
+
+	return common.GetLoadBalancerVpcSubnetMap(kt, cli.dbCli, enumor.Azure, accountID, cloudVpcIDs, cloudSubnetIDs)
+}
+
+func (cli *client) listLBFromCloud(kt *kit.Kit, params *SyncBaseParams) ([]typeslb.AzureLoadBalancer, error) {
+	opt := &typecore.AzureListByIDOption{
+		ResourceGroupName: params.ResourceGroupName,
+		CloudIDs:          params.CloudIDs,
+	}
+	result, err := cli.cloudCli.ListLoadBalancerByID(kt, opt)
+	if err != nil {
+		logs.Errorf("[%s] list lb from cloud failed, err: %v, account: %s, opt: %v, rid: %s", enumor.Azure, err,
+			params.AccountID, opt, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+func (cli *client) listLBFromDB(kt *kit.Kit, params *SyncBaseParams) ([]corelb.AzureLoadBalancer, error) {
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", params.AccountID),
+			tools.RuleIn("cloud_id", params.CloudIDs),
+			tools.RuleJSONEqual("extension.resource_group_name", params.ResourceGroupName),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := cli.dbCli.Azure.LoadBalancer.ListLoadBalancer(kt, req)
+	if err != nil {
+		logs.Errorf("[%s] list lb from db failed, err: %v, account: %s, req: %v, rid: %s", enumor.Azure, err,
+			params.AccountID, req, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+// getLBType 前端IP配置关联公网IP的为公网负载均衡，否则为内网负载均衡
+func getLBType(cloud typeslb.AzureLoadBalancer) typeslb.TCloudLoadBalancerType {
+	if len(cloud.CloudPublicIPIDs) != 0 {
+		return typeslb.OpenLoadBalancerType
+	}
+	return typeslb.InternalLoadBalancerType
+}
+
+func getLBIPVersion(cloud typeslb.AzureLoadBalancer) enumor.IPAddressType {
+	if len(cloud.PrivateIPv6Addresses) != 0 {
+		return enumor.Ipv6DualStack
+	}
+	return enumor.Ipv4
+}
+
+func convLBExtension(cloud typeslb.AzureLoadBalancer) *corelb.AzureLoadBalancerExtension {
+	return &corelb.AzureLoadBalancerExtension{
+		ResourceGroupName:        cloud.ResourceGroupName,
+		SkuName:                  cvt.ValToPtr(cloud.SkuName),
+		SkuTier:                  cvt.ValToPtr(cloud.SkuTier),
+		CloudPublicIPIDs:         cloud.CloudPublicIPIDs,
+		FrontendIPConfigurations: cloud.FrontendIPConfigurations,
+		BackendAddressPools:      cloud.BackendAddressPools,
+		LoadBalancingRules:       cloud.LoadBalancingRules,
+		Probes:                   cloud.Probes,
+	}
+}
+
+func isLBChange(cloud typeslb.AzureLoadBalancer, db corelb.AzureLoadBalancer) bool {
+	if db.Name != cloud.Name || db.Status != cloud.ProvisioningState {
+		return true
+	}
+
+	if db.CloudVpcID != cloud.CloudVpcID || db.CloudSubnetID != cloud.CloudSubnetID {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(db.PrivateIPv4Addresses, cloud.PrivateIPv4Addresses) ||
+		!assert.IsStringSliceEqual(db.PrivateIPv6Addresses, cloud.PrivateIPv6Addresses) {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	if cvt.PtrToVal(db.Extension.SkuName) != cloud.SkuName || cvt.PtrToVal(db.Extension.SkuTier) != cloud.SkuTier {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(db.Extension.CloudPublicIPIDs, cloud.CloudPublicIPIDs) ||
+		!assert.IsStringSliceEqual(db.Extension.FrontendIPConfigurations, cloud.FrontendIPConfigurations) ||
+		!assert.IsStringSliceEqual(db.Extension.BackendAddressPools, cloud.BackendAddressPools) ||
+		!assert.IsStringSliceEqual(db.Extension.LoadBalancingRules, cloud.LoadBalancingRules) ||
+		!assert.IsStringSliceEqual(db.Extension.Probes, cloud.Probes) {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,393 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typesnatgateway "hcm/pkg/adaptor/types/nat-gateway"
+	"hcm/pkg/api/core"
+	corenatgateway "hcm/pkg/api/core/cloud/nat-gateway"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/assert"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncNatGatewayOption ...
+type SyncNatGatewayOption struct {
+}
+
+// Validate ...
+func (opt SyncNatGatewayOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// NatGateway 同步NAT网关
+func (cli *client) NatGateway(kt *kit.Kit, params *SyncBaseParams, opt *SyncNatGatewayOption) (*SyncResult, error) {
+	if err := validator.ValidateTool(params, opt); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	natFromCloud, err := cli.listNatGatewayFromCloud(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	natFromDB, err := cli.listNatGatewayFromDB(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(natFromCloud) == 0 && len(natFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.Diff[typesnatgateway.AzureNatGateway,
+		corenatgateway.NatGateway[corenatgateway.AzureNatGatewayExtension]](natFromCloud, natFromDB,
+		isNatGatewayChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteNatGateway(kt, params.AccountID, params.ResourceGroupName, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	// Azure NAT网关通过子网关联到虚拟网络，取第一个子网所属的VPC作为NAT网关的VPC
+	cloudSubnetIDs := make([]string, 0, len(addSlice)+len(updateMap))
+	for _, one := range addSlice {
+		cloudSubnetIDs = append(cloudSubnetIDs, getAzureNatGatewaySubnetID(one))
+	}
+	for _, one := range updateMap {
+		cloudSubnetIDs = append(cloudSubnetIDs, getAzureNatGatewaySubnetID(one))
+	}
+	subnetMap, err := common.GetNetworkSubnetRelMap(kt, cli.dbCli, enumor.Azure, params.AccountID, cloudSubnetIDs)
+	if err != nil {
+		return nil, err
+	}
+	cloudVpcIDs := make([]string, 0, len(subnetMap))
+	for _, rel := range subnetMap {
+		cloudVpcIDs = append(cloudVpcIDs, rel.CloudVpcID)
+	}
+	vpcMap, err := common.GetNetworkVpcRelMap(kt, cli.dbCli, enumor.Azure, params.AccountID, cloudVpcIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(addSlice) > 0 {
+		err = cli.createNatGateway(kt, params.AccountID, params.ResourceGroupName, addSlice, subnetMap, vpcMap)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateNatGateway(kt, params.ResourceGroupName, updateMap, subnetMap, vpcMap); err != nil {
+			return nil, err
+		}
+	}
+
+	return new(SyncResult), nil
+}
+
+func (cli *client) createNatGateway(kt *kit.Kit, accountID, resGroupName string,
+	addSlice []typesnatgateway.AzureNatGateway, subnetMap map[string]common.NetworkSubnetRel,
+	vpcMap map[string]common.NetworkVpcRel) error {
+
+	createReq := new(protocloud.NatGatewayBatchCreateReq[corenatgateway.AzureNatGatewayExtension])
+	for _, one := range addSlice {
+		cloudSubnetID := getAzureNatGatewaySubnetID(one)
+		subnet := subnetMap[cloudSubnetID]
+		nat := protocloud.NatGatewayBatchCreate[corenatgateway.AzureNatGatewayExtension]{
+			CloudID:       one.GetCloudID(),
+			AccountID:     accountID,
+			BkBizID:       constant.UnassignedBiz,
+			Name:          converter.PtrToVal(one.Name),
+			Region:        converter.PtrToVal(one.Location),
+			Zone:          getAzureNatGatewayZone(one),
+			Status:        converter.PtrToVal(one.ProvisioningState),
+			VpcID:         subnet.VpcID,
+			CloudVpcID:    subnet.CloudVpcID,
+			SubnetID:      subnet.SubnetID,
+			CloudSubnetID: cloudSubnetID,
+			Extension:     convAzureNatGatewayExtension(resGroupName, one),
+		}
+		if rel, exist := vpcMap[subnet.CloudVpcID]; exist {
+			nat.BkBizID = rel.BkBizID
+		}
+		createReq.NatGateways = append(createReq.NatGateways, nat)
+	}
+
+	for _, batch := range slice.Split(createReq.NatGateways, constant.BatchOperationMaxLimit) {
+		req := &protocloud.NatGatewayBatchCreateReq[corenatgateway.AzureNatGatewayExtension]{NatGateways: batch}
+		if _, err := cli.dbCli.Azure.NatGateway.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create nat gateway failed, err: %v, rid: %s",
+				enumor.Azure, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync nat gateway to create nat gateway success, accountID: %s, count: %d, rid: %s",
+		enumor.Azure, accountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateNatGateway(kt *kit.Kit, resGroupName string,
+	updateMap map[string]typesnatgateway.AzureNatGateway, subnetMap map[string]common.NetworkSubnetRel,
+	vpcMap map[string]common.NetworkVpcRel) error {
+
+	updateReq := make(protocloud.NatGatewayExtBatchUpdateReq[corenatgateway.AzureNatGatewayExtension], 0,
+		len(updateMap))
+	for id, one := range updateMap {
+		cloudSubnetID := getAzureNatGatewaySubnetID(one)
+		subnet := subnetMap[cloudSubnetID]
+		nat := &protocloud.NatGatewayExtUpdateReq[corenatgateway.AzureNatGatewayExtension]{
+			ID:            id,
+			Name:          converter.PtrToVal(one.Name),
+			Zone:          getAzureNatGatewayZone(one),
+			Status:        converter.PtrToVal(one.ProvisioningState),
+			VpcID:         subnet.VpcID,
+			CloudVpcID:    subnet.CloudVpcID,
+			SubnetID:      subnet.SubnetID,
+			CloudSubnetID: cloudSubnetID,
+			Extension:     convAzureNatGatewayExtension(resGroupName, one),
+		}
+		if rel, exist := vpcMap[subnet.CloudVpcID]; exist {
+			nat.BkBizID = rel.BkBizID
+		}
+		updateReq = append(updateReq, nat)
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.NatGatewayExtBatchUpdateReq[corenatgateway.AzureNatGatewayExtension](batch)
+		if err := cli.dbCli.Azure.NatGateway.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update nat gateway failed, err: %v, rid: %s",
+				enumor.Azure, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync nat gateway to update nat gateway success, count: %d, rid: %s", enumor.Azure,
+		len(updateMap), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) deleteNatGateway(kt *kit.Kit, accountID, resGroupName string, delCloudIDs []string) error {
+	checkParams := &SyncBaseParams{AccountID: accountID, ResourceGroupName: resGroupName, CloudIDs: delCloudIDs}
+	delFromCloud, err := cli.listNatGatewayFromCloud(kt, checkParams)
+	if err != nil {
+		return err
+	}
+
+	if len(delFromCloud) > 0 {
+		logs.Errorf("[%s] validate nat gateway not exist failed, before delete opt: %v, failed_count: %d, rid: %s",
+			enumor.Azure, checkParams, len(delFromCloud), kt.Rid)
+		return errf.Newf(errf.Aborted, "validate nat gateway not exist failed, before delete")
+	}
+
+	req := &protocloud.NatGatewayBatchDeleteReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Azure),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleIn("cloud_id", delCloudIDs),
+		),
+	}
+	if err = cli.dbCli.Global.NatGateway.BatchDelete(kt, req); err != nil {
+		logs.Errorf("[%s] request dataservice to batch delete nat gateway failed, err: %v, rid: %s", enumor.Azure,
+			err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync nat gateway to delete nat gateway success, accountID: %s, count: %d, rid: %s",
+		enumor.Azure, accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) listNatGatewayFromCloud(kt *kit.Kit, params *SyncBaseParams) (
+	[]typesnatgateway.AzureNatGateway, error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &typesnatgateway.AzureNatGatewayListOption{
+		ResourceGroupName: params.ResourceGroupName,
+		CloudIDs:          params.CloudIDs,
+	}
+	result, err := cli.cloudCli.ListNatGateway(kt, opt)
+	if err != nil {
+		logs.Errorf("[%s] list nat gateway from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+			enumor.Azure, err, params.AccountID, opt, kt.Rid)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (cli *client) listNatGatewayFromDB(kt *kit.Kit, params *SyncBaseParams) (
+	[]corenatgateway.NatGateway[corenatgateway.AzureNatGatewayExtension], error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", params.AccountID),
+			tools.RuleIn("cloud_id", params.CloudIDs),
+			tools.RuleJSONEqual("extension.resource_group_name", params.ResourceGroupName),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := cli.dbCli.Azure.NatGateway.ListNatGatewayExt(kt, req)
+	if err != nil {
+		logs.Errorf("[%s] list nat gateway from db failed, err: %v, account: %s, req: %v, rid: %s", enumor.Azure,
+			err, params.AccountID, req, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+// RemoveNatGatewayDeleteFromCloud 删除本地存在但云上已被删除的NAT网关
+func (cli *client) RemoveNatGatewayDeleteFromCloud(kt *kit.Kit, accountID string, resGroupName string) error {
+	req := &core.ListReq{
+		Fields: []string{"id", "cloud_id"},
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Azure),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleJSONEqual("extension.resource_group_name", resGroupName),
+		),
+		Page: &core.BasePage{Start: 0, Limit: constant.BatchOperationMaxLimit},
+	}
+	for {
+		resultFromDB, err := cli.dbCli.Global.NatGateway.List(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] request dataservice to list nat gateway failed, err: %v, req: %v, rid: %s",
+				enumor.Azure, err, req, kt.Rid)
+			return err
+		}
+
+		cloudIDs := make([]string, 0, len(resultFromDB.Details))
+		for _, one := range resultFromDB.Details {
+			cloudIDs = append(cloudIDs, one.CloudID)
+		}
+
+		if len(cloudIDs) == 0 {
+			break
+		}
+
+		params := &SyncBaseParams{AccountID: accountID, ResourceGroupName: resGroupName, CloudIDs: cloudIDs}
+		resultFromCloud, err := cli.listNatGatewayFromCloud(kt, params)
+		if err != nil {
+			return err
+		}
+
+		// 如果有资源没有查询出来，说明数据被从云上删除
+		if len(resultFromCloud) != len(cloudIDs) {
+			cloudIDMap := converter.StringSliceToMap(cloudIDs)
+			for _, one := range resultFromCloud {
+				delete(cloudIDMap, one.GetCloudID())
+			}
+
+			delCloudIDs := converter.MapKeyToStringSlice(cloudIDMap)
+			if err = cli.deleteNatGateway(kt, accountID, resGroupName, delCloudIDs); err != nil {
+				return err
+			}
+		}
+
+		if len(resultFromDB.Details) < constant.BatchOperationMaxLimit {
+			break
+		}
+
+		req.Page.Start += constant.BatchOperationMaxLimit
+	}
+
+	return nil
+}
+
+func getAzureNatGatewaySubnetID(one typesnatgateway.AzureNatGateway) string {
+	if len(one.CloudSubnetIDs) == 0 {
+		return ""
+	}
+
+	return one.CloudSubnetIDs[0]
+}
+
+func getAzureNatGatewayZone(one typesnatgateway.AzureNatGateway) string {
+	if len(one.Zones) == 0 {
+		return ""
+	}
+
+	return one.Zones[0]
+}
+
+func convAzureNatGatewayExtension(resGroupName string,
+	one typesnatgateway.AzureNatGateway) *corenatgateway.AzureNatGatewayExtension {
+
+	return &corenatgateway.AzureNatGatewayExtension{
+		ResourceGroupName:    resGroupName,
+		SKUName:              one.SKUName,
+		ProvisioningState:    one.ProvisioningState,
+		IdleTimeoutInMinutes: one.IdleTimeoutInMinutes,
+		CloudPublicIPIDs:     one.CloudPublicIPIDs,
+		CloudSubnetIDs:       one.CloudSubnetIDs,
+	}
+}
+
+func isNatGatewayChange(cloud typesnatgateway.AzureNatGateway,
+	db corenatgateway.NatGateway[corenatgateway.AzureNatGatewayExtension]) bool {
+
+	if converter.PtrToVal(cloud.Name) != db.Name {
+		return true
+	}
+
+	if converter.PtrToVal(cloud.ProvisioningState) != db.Status {
+		return true
+	}
+
+	if getAzureNatGatewaySubnetID(cloud) != db.CloudSubnetID || getAzureNatGatewayZone(cloud) != db.Zone {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	if !assert.IsPtrInt32Equal(cloud.IdleTimeoutInMinutes, db.Extension.IdleTimeoutInMinutes) {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(cloud.CloudPublicIPIDs, db.Extension.CloudPublicIPIDs) ||
+		!assert.IsStringSliceEqual(cloud.CloudSubnetIDs, db.Extension.CloudSubnetIDs) {
+		return true
+	}
+
+	return false
+}
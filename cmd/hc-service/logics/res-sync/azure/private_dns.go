@@ -0,0 +1,285 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package azure
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typesdns "hcm/pkg/adaptor/types/private-dns"
+	"hcm/pkg/api/core"
+	coreprivatedns "hcm/pkg/api/core/cloud/private-dns"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/runtime/filter"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncPrivateDnsZoneOption 同步资源组下的私有 DNS 区域；CloudIDs 不为空时只同步指定的私有 DNS 区域
+type SyncPrivateDnsZoneOption struct {
+	AccountID         string   `json:"account_id" validate:"required"`
+	ResourceGroupName string   `json:"resource_group_name" validate:"required"`
+	CloudIDs          []string `json:"cloud_ids" validate:"omitempty,max=100"`
+}
+
+// Validate ...
+func (opt SyncPrivateDnsZoneOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// PrivateDnsZone 同步私有域，以及私有域下的解析记录和关联的VPC，业务由分配操作决定，同步不覆盖
+func (cli *client) PrivateDnsZone(kt *kit.Kit, opt *SyncPrivateDnsZoneOption) (*SyncResult, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	zoneFromCloud, err := cli.listPrivateDnsZoneFromCloud(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	zoneFromDB, err := cli.listPrivateDnsZoneFromDB(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(zoneFromCloud) == 0 && len(zoneFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.DiffPaaS[typesdns.AzureZone,
+		coreprivatedns.Zone[coreprivatedns.AzureZoneExtension]](zoneFromCloud, zoneFromDB, isPrivateDnsZoneChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deletePrivateDnsZone(kt, opt.AccountID, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createPrivateDnsZone(kt, opt, addSlice); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updatePrivateDnsZone(kt, updateMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = cli.privateDnsZoneRes(kt, opt, zoneFromCloud); err != nil {
+		return nil, err
+	}
+
+	return new(SyncResult), nil
+}
+
+// privateDnsZoneRes 同步本地私有域的解析记录和关联的VPC
+func (cli *client) privateDnsZoneRes(kt *kit.Kit, opt *SyncPrivateDnsZoneOption,
+	zoneFromCloud []typesdns.AzureZone) error {
+
+	zoneFromDB, err := cli.listPrivateDnsZoneFromDB(kt, opt)
+	if err != nil {
+		return err
+	}
+
+	cloudMap := make(map[string]typesdns.AzureZone, len(zoneFromCloud))
+	for _, one := range zoneFromCloud {
+		cloudMap[one.GetCloudID()] = one
+	}
+
+	for _, zone := range zoneFromDB {
+		one, exist := cloudMap[zone.CloudID]
+		if !exist {
+			continue
+		}
+
+		listOpt := &typesdns.AzureRecordListOption{CloudZoneID: zone.CloudID}
+		records, err := cli.cloudCli.ListPrivateDnsRecord(kt, listOpt)
+		if err != nil {
+			logs.Errorf("[%s] list private dns record from cloud failed, err: %v, opt: %v, rid: %s", enumor.Azure,
+				err, listOpt, kt.Rid)
+			return err
+		}
+
+		res := &common.PrivateDnsZoneRes{Records: records, CloudVpcIDs: one.CloudVpcIDs}
+		if err = common.SyncPrivateDnsZoneRes(kt, cli.dbCli, zone.BaseZone, res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cli *client) createPrivateDnsZone(kt *kit.Kit, opt *SyncPrivateDnsZoneOption,
+	addSlice []typesdns.AzureZone) error {
+
+	zones := make([]protocloud.PrivateDnsZoneBatchCreate[coreprivatedns.AzureZoneExtension], 0, len(addSlice))
+	for _, one := range addSlice {
+		zones = append(zones, protocloud.PrivateDnsZoneBatchCreate[coreprivatedns.AzureZoneExtension]{
+			CloudID:     one.GetCloudID(),
+			AccountID:   opt.AccountID,
+			BkBizID:     constant.UnassignedBiz,
+			Name:        one.Name,
+			RecordCount: one.NumberOfRecordSets,
+			Extension:   convAzurePrivateDnsZoneExtension(one),
+		})
+	}
+
+	for _, batch := range slice.Split(zones, constant.BatchOperationMaxLimit) {
+		req := &protocloud.PrivateDnsZoneBatchCreateReq[coreprivatedns.AzureZoneExtension]{Zones: batch}
+		if _, err := cli.dbCli.Azure.PrivateDnsZone.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create private dns zone failed, err: %v, rid: %s",
+				enumor.Azure, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync private dns zone to create private dns zone success, accountID: %s, count: %d, rid: %s",
+		enumor.Azure, opt.AccountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updatePrivateDnsZone(kt *kit.Kit, updateMap map[string]typesdns.AzureZone) error {
+	updateReq := make(protocloud.PrivateDnsZoneExtBatchUpdateReq[coreprivatedns.AzureZoneExtension], 0,
+		len(updateMap))
+	for id, one := range updateMap {
+		updateReq = append(updateReq, &protocloud.PrivateDnsZoneExtUpdateReq[coreprivatedns.AzureZoneExtension]{
+			ID:          id,
+			Name:        one.Name,
+			RecordCount: one.NumberOfRecordSets,
+			Extension:   convAzurePrivateDnsZoneExtension(one),
+		})
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.PrivateDnsZoneExtBatchUpdateReq[coreprivatedns.AzureZoneExtension](batch)
+		if err := cli.dbCli.Azure.PrivateDnsZone.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update private dns zone failed, err: %v, rid: %s",
+				enumor.Azure, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync private dns zone to update private dns zone success, count: %d, rid: %s", enumor.Azure,
+		len(updateMap), kt.Rid)
+
+	return nil
+}
+
+// deletePrivateDnsZone 删除私有域时，data-service 会同时删除私有域下的解析记录和VPC关联关系
+func (cli *client) deletePrivateDnsZone(kt *kit.Kit, accountID string, delCloudIDs []string) error {
+	for _, batch := range slice.Split(delCloudIDs, constant.BatchOperationMaxLimit) {
+		req := &protocloud.PrivateDnsZoneBatchDeleteReq{
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("vendor", enumor.Azure),
+				tools.RuleEqual("account_id", accountID),
+				tools.RuleIn("cloud_id", batch),
+			),
+		}
+		if err := cli.dbCli.Global.PrivateDnsZone.BatchDelete(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch delete private dns zone failed, err: %v, rid: %s",
+				enumor.Azure, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync private dns zone to delete private dns zone success, accountID: %s, count: %d, rid: %s",
+		enumor.Azure, accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) listPrivateDnsZoneFromCloud(kt *kit.Kit, opt *SyncPrivateDnsZoneOption) (
+	[]typesdns.AzureZone, error) {
+
+	listOpt := &typesdns.AzureZoneListOption{ResourceGroupName: opt.ResourceGroupName, CloudIDs: opt.CloudIDs}
+	zones, err := cli.cloudCli.ListPrivateDnsZone(kt, listOpt)
+	if err != nil {
+		logs.Errorf("[%s] list private dns zone from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+			enumor.Azure, err, opt.AccountID, listOpt, kt.Rid)
+		return nil, err
+	}
+
+	return zones, nil
+}
+
+func (cli *client) listPrivateDnsZoneFromDB(kt *kit.Kit, opt *SyncPrivateDnsZoneOption) (
+	[]coreprivatedns.Zone[coreprivatedns.AzureZoneExtension], error) {
+
+	rules := []*filter.AtomRule{
+		tools.RuleEqual("vendor", enumor.Azure),
+		tools.RuleEqual("account_id", opt.AccountID),
+		tools.RuleJSONEqual("extension.resource_group_name", opt.ResourceGroupName),
+	}
+	if len(opt.CloudIDs) != 0 {
+		rules = append(rules, tools.RuleIn("cloud_id", opt.CloudIDs))
+	}
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(rules...),
+		Page:   core.NewDefaultBasePage(),
+	}
+	result := make([]coreprivatedns.Zone[coreprivatedns.AzureZoneExtension], 0)
+	for {
+		resp, err := cli.dbCli.Azure.PrivateDnsZone.ListPrivateDnsZoneExt(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] list private dns zone from db failed, err: %v, account: %s, req: %v, rid: %s",
+				enumor.Azure, err, opt.AccountID, req, kt.Rid)
+			return nil, err
+		}
+
+		result = append(result, resp.Details...)
+
+		if len(resp.Details) < int(core.DefaultMaxPageLimit) {
+			break
+		}
+
+		req.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return result, nil
+}
+
+func convAzurePrivateDnsZoneExtension(one typesdns.AzureZone) *coreprivatedns.AzureZoneExtension {
+	return &coreprivatedns.AzureZoneExtension{
+		ResourceGroupName: one.ResourceGroupName,
+		ProvisioningState: one.ProvisioningState,
+	}
+}
+
+func isPrivateDnsZoneChange(cloud typesdns.AzureZone,
+	db coreprivatedns.Zone[coreprivatedns.AzureZoneExtension]) bool {
+
+	if cloud.Name != db.Name || cloud.NumberOfRecordSets != db.RecordCount {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	return *convAzurePrivateDnsZoneExtension(cloud) != *db.Extension
+}
@@ -0,0 +1,369 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typessnapshot "hcm/pkg/adaptor/types/snapshot"
+	"hcm/pkg/api/core"
+	coresnapshot "hcm/pkg/api/core/cloud/snapshot"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/assert"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncSnapshotOption ...
+type SyncSnapshotOption struct {
+}
+
+// Validate ...
+func (opt SyncSnapshotOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// Snapshot 同步快照
+func (cli *client) Snapshot(kt *kit.Kit, params *SyncBaseParams, opt *SyncSnapshotOption) (*SyncResult, error) {
+	if err := validator.ValidateTool(params, opt); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	snapshotFromCloud, err := cli.listSnapshotFromCloud(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotFromDB, err := cli.listSnapshotFromDB(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(snapshotFromCloud) == 0 && len(snapshotFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.Diff[typessnapshot.AzureSnapshot,
+		coresnapshot.Snapshot[coresnapshot.AzureSnapshotExtension]](snapshotFromCloud, snapshotFromDB,
+		isSnapshotChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteSnapshot(kt, params.AccountID, params.ResourceGroupName, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	cloudDiskIDs := make([]string, 0, len(addSlice)+len(updateMap))
+	for _, one := range addSlice {
+		cloudDiskIDs = append(cloudDiskIDs, converter.PtrToVal(one.SourceResourceID))
+	}
+	for _, one := range updateMap {
+		cloudDiskIDs = append(cloudDiskIDs, converter.PtrToVal(one.SourceResourceID))
+	}
+	relMap, err := common.GetSnapshotDiskRelMap(kt, cli.dbCli, enumor.Azure, params.AccountID, cloudDiskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createSnapshot(kt, params.AccountID, params.ResourceGroupName, addSlice, relMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateSnapshot(kt, params.ResourceGroupName, updateMap, relMap); err != nil {
+			return nil, err
+		}
+	}
+
+	return new(SyncResult), nil
+}
+
+func (cli *client) createSnapshot(kt *kit.Kit, accountID, resGroupName string, addSlice []typessnapshot.AzureSnapshot,
+	relMap map[string]common.SnapshotDiskRel) error {
+
+	createReq := new(protocloud.SnapshotBatchCreateReq[coresnapshot.AzureSnapshotExtension])
+	for _, one := range addSlice {
+		rel := relMap[converter.PtrToVal(one.SourceResourceID)]
+		snapshot := protocloud.SnapshotBatchCreate[coresnapshot.AzureSnapshotExtension]{
+			CloudID:          one.GetCloudID(),
+			AccountID:        accountID,
+			BkBizID:          constant.UnassignedBiz,
+			Name:             converter.PtrToVal(one.Name),
+			Region:           converter.PtrToVal(one.Location),
+			Status:           converter.PtrToVal(one.Status),
+			Size:             convAzureSnapshotSize(one.DiskSize),
+			DiskID:           rel.DiskID,
+			CloudDiskID:      converter.PtrToVal(one.SourceResourceID),
+			CvmID:            rel.CvmID,
+			CloudCvmID:       rel.CloudCvmID,
+			CloudCreatedTime: converter.PtrToVal(one.TimeCreated),
+			Extension:        convAzureSnapshotExtension(resGroupName, one),
+		}
+		if len(rel.DiskID) != 0 {
+			snapshot.BkBizID = rel.BkBizID
+		}
+		snapshot.Zone = rel.Zone
+		createReq.Snapshots = append(createReq.Snapshots, snapshot)
+	}
+
+	if _, err := cli.dbCli.Azure.Snapshot.BatchCreate(kt, createReq); err != nil {
+		logs.Errorf("[%s] request dataservice to batch create snapshot failed, err: %v, rid: %s", enumor.Azure,
+			err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync snapshot to create snapshot success, accountID: %s, count: %d, rid: %s", enumor.Azure,
+		accountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateSnapshot(kt *kit.Kit, resGroupName string, updateMap map[string]typessnapshot.AzureSnapshot,
+	relMap map[string]common.SnapshotDiskRel) error {
+
+	updateReq := make(protocloud.SnapshotExtBatchUpdateReq[coresnapshot.AzureSnapshotExtension], 0,
+		len(updateMap))
+	for id, one := range updateMap {
+		rel := relMap[converter.PtrToVal(one.SourceResourceID)]
+		updateReq = append(updateReq, &protocloud.SnapshotExtUpdateReq[coresnapshot.AzureSnapshotExtension]{
+			ID:          id,
+			Name:        converter.PtrToVal(one.Name),
+			Status:      converter.PtrToVal(one.Status),
+			Size:        convAzureSnapshotSize(one.DiskSize),
+			DiskID:      rel.DiskID,
+			CloudDiskID: converter.PtrToVal(one.SourceResourceID),
+			CvmID:       rel.CvmID,
+			CloudCvmID:  rel.CloudCvmID,
+			Extension:   convAzureSnapshotExtension(resGroupName, one),
+		})
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.SnapshotExtBatchUpdateReq[coresnapshot.AzureSnapshotExtension](batch)
+		if err := cli.dbCli.Azure.Snapshot.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update snapshot failed, err: %v, rid: %s",
+				enumor.Azure, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync snapshot to update snapshot success, count: %d, rid: %s", enumor.Azure,
+		len(updateMap), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) deleteSnapshot(kt *kit.Kit, accountID, resGroupName string, delCloudIDs []string) error {
+	checkParams := &SyncBaseParams{AccountID: accountID, ResourceGroupName: resGroupName, CloudIDs: delCloudIDs}
+	delFromCloud, err := cli.listSnapshotFromCloud(kt, checkParams)
+	if err != nil {
+		return err
+	}
+
+	if len(delFromCloud) > 0 {
+		logs.Errorf("[%s] validate snapshot not exist failed, before delete opt: %v, failed_count: %d, rid: %s",
+			enumor.Azure, checkParams, len(delFromCloud), kt.Rid)
+		return errf.Newf(errf.Aborted, "validate snapshot not exist failed, before delete")
+	}
+
+	req := &protocloud.SnapshotBatchDeleteReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Azure),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleIn("cloud_id", delCloudIDs),
+		),
+	}
+	if err = cli.dbCli.Global.Snapshot.BatchDelete(kt, req); err != nil {
+		logs.Errorf("[%s] request dataservice to batch delete snapshot failed, err: %v, rid: %s", enumor.Azure,
+			err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync snapshot to delete snapshot success, accountID: %s, count: %d, rid: %s", enumor.Azure,
+		accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) listSnapshotFromCloud(kt *kit.Kit, params *SyncBaseParams) ([]typessnapshot.AzureSnapshot,
+	error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &typessnapshot.AzureSnapshotListOption{
+		ResourceGroupName: params.ResourceGroupName,
+		CloudIDs:          params.CloudIDs,
+	}
+	result, err := cli.cloudCli.ListSnapshot(kt, opt)
+	if err != nil {
+		logs.Errorf("[%s] list snapshot from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+			enumor.Azure, err, params.AccountID, opt, kt.Rid)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (cli *client) listSnapshotFromDB(kt *kit.Kit, params *SyncBaseParams) (
+	[]coresnapshot.Snapshot[coresnapshot.AzureSnapshotExtension], error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", params.AccountID),
+			tools.RuleIn("cloud_id", params.CloudIDs),
+			tools.RuleJSONEqual("extension.resource_group_name", params.ResourceGroupName),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := cli.dbCli.Azure.Snapshot.ListSnapshotExt(kt, req)
+	if err != nil {
+		logs.Errorf("[%s] list snapshot from db failed, err: %v, account: %s, req: %v, rid: %s", enumor.Azure,
+			err, params.AccountID, req, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+// RemoveSnapshotDeleteFromCloud 删除本地存在但云上已被删除的快照
+func (cli *client) RemoveSnapshotDeleteFromCloud(kt *kit.Kit, accountID string, resGroupName string) error {
+	req := &core.ListReq{
+		Fields: []string{"id", "cloud_id"},
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Azure),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleJSONEqual("extension.resource_group_name", resGroupName),
+		),
+		Page: &core.BasePage{Start: 0, Limit: constant.BatchOperationMaxLimit},
+	}
+	for {
+		resultFromDB, err := cli.dbCli.Global.Snapshot.List(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] request dataservice to list snapshot failed, err: %v, req: %v, rid: %s",
+				enumor.Azure, err, req, kt.Rid)
+			return err
+		}
+
+		cloudIDs := make([]string, 0, len(resultFromDB.Details))
+		for _, one := range resultFromDB.Details {
+			cloudIDs = append(cloudIDs, one.CloudID)
+		}
+
+		if len(cloudIDs) == 0 {
+			break
+		}
+
+		params := &SyncBaseParams{AccountID: accountID, ResourceGroupName: resGroupName, CloudIDs: cloudIDs}
+		resultFromCloud, err := cli.listSnapshotFromCloud(kt, params)
+		if err != nil {
+			return err
+		}
+
+		// 如果有资源没有查询出来，说明数据被从云上删除
+		if len(resultFromCloud) != len(cloudIDs) {
+			cloudIDMap := converter.StringSliceToMap(cloudIDs)
+			for _, one := range resultFromCloud {
+				delete(cloudIDMap, one.GetCloudID())
+			}
+
+			delCloudIDs := converter.MapKeyToStringSlice(cloudIDMap)
+			if err = cli.deleteSnapshot(kt, accountID, resGroupName, delCloudIDs); err != nil {
+				return err
+			}
+		}
+
+		if len(resultFromDB.Details) < constant.BatchOperationMaxLimit {
+			break
+		}
+
+		req.Page.Start += constant.BatchOperationMaxLimit
+	}
+
+	return nil
+}
+
+func convAzureSnapshotExtension(resGroupName string,
+	one typessnapshot.AzureSnapshot) *coresnapshot.AzureSnapshotExtension {
+
+	return &coresnapshot.AzureSnapshotExtension{
+		ResourceGroupName: resGroupName,
+		Incremental:       one.Incremental,
+		OSType:            one.OSType,
+		SKUName:           one.SKUName,
+	}
+}
+
+// convAzureSnapshotSize 云上返回的快照大小单位为字节，转换为GB
+func convAzureSnapshotSize(diskSize *int64) uint64 {
+	return uint64(converter.PtrToVal(diskSize)) / 1024 / 1024 / 1024
+}
+
+func isSnapshotChange(cloud typessnapshot.AzureSnapshot,
+	db coresnapshot.Snapshot[coresnapshot.AzureSnapshotExtension]) bool {
+
+	if converter.PtrToVal(cloud.Name) != db.Name {
+		return true
+	}
+
+	if converter.PtrToVal(cloud.Status) != db.Status {
+		return true
+	}
+
+	if convAzureSnapshotSize(cloud.DiskSize) != db.Size {
+		return true
+	}
+
+	if converter.PtrToVal(cloud.SourceResourceID) != db.CloudDiskID {
+		return true
+	}
+
+	// 来源云盘晚于快照同步到本地时，需要补充云盘关联
+	if len(db.CloudDiskID) != 0 && len(db.DiskID) == 0 {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	if !assert.IsPtrBoolEqual(cloud.Incremental, db.Extension.Incremental) {
+		return true
+	}
+
+	if !assert.IsPtrStringEqual(cloud.SKUName, db.Extension.SKUName) {
+		return true
+	}
+
+	return false
+}
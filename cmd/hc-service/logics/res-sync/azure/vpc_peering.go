@@ -0,0 +1,366 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typesvpcpeering "hcm/pkg/adaptor/types/vpc-peering"
+	"hcm/pkg/api/core"
+	corevpcpeering "hcm/pkg/api/core/cloud/vpc-peering"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/assert"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncVpcPeeringOption ...
+type SyncVpcPeeringOption struct {
+}
+
+// Validate ...
+func (opt SyncVpcPeeringOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// VpcPeering 同步对等连接
+func (cli *client) VpcPeering(kt *kit.Kit, params *SyncBaseParams, opt *SyncVpcPeeringOption) (*SyncResult, error) {
+	if err := validator.ValidateTool(params, opt); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	peeringFromCloud, err := cli.listVpcPeeringFromCloud(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	peeringFromDB, err := cli.listVpcPeeringFromDB(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(peeringFromCloud) == 0 && len(peeringFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.Diff[typesvpcpeering.AzureVpcPeering,
+		corevpcpeering.VpcPeering[corevpcpeering.AzureVpcPeeringExtension]](peeringFromCloud, peeringFromDB,
+		isVpcPeeringChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteVpcPeering(kt, params.AccountID, params.ResourceGroupName, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	// 对端VPC可能属于其他账号，按厂商查询不限制账号
+	cloudVpcIDs := make([]string, 0, 2*(len(addSlice)+len(updateMap)))
+	for _, one := range addSlice {
+		cloudVpcIDs = append(cloudVpcIDs, converter.PtrToVal(one.CloudVpcID), converter.PtrToVal(one.CloudRemoteVpcID))
+	}
+	for _, one := range updateMap {
+		cloudVpcIDs = append(cloudVpcIDs, converter.PtrToVal(one.CloudVpcID), converter.PtrToVal(one.CloudRemoteVpcID))
+	}
+	vpcMap, err := common.GetNetworkVpcRelMap(kt, cli.dbCli, enumor.Azure, "", cloudVpcIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createVpcPeering(kt, params.AccountID, params.ResourceGroupName, addSlice, vpcMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateVpcPeering(kt, params.ResourceGroupName, updateMap, vpcMap); err != nil {
+			return nil, err
+		}
+	}
+
+	return new(SyncResult), nil
+}
+
+func (cli *client) createVpcPeering(kt *kit.Kit, accountID, resGroupName string,
+	addSlice []typesvpcpeering.AzureVpcPeering, vpcMap map[string]common.NetworkVpcRel) error {
+
+	createReq := new(protocloud.VpcPeeringBatchCreateReq[corevpcpeering.AzureVpcPeeringExtension])
+	for _, one := range addSlice {
+		cloudVpcID := converter.PtrToVal(one.CloudVpcID)
+		cloudPeerVpcID := converter.PtrToVal(one.CloudRemoteVpcID)
+		peering := protocloud.VpcPeeringBatchCreate[corevpcpeering.AzureVpcPeeringExtension]{
+			CloudID:        one.GetCloudID(),
+			AccountID:      accountID,
+			BkBizID:        constant.UnassignedBiz,
+			Name:           converter.PtrToVal(one.Name),
+			Region:         converter.PtrToVal(one.Location),
+			Status:         converter.PtrToVal(one.PeeringState),
+			VpcID:          vpcMap[cloudVpcID].VpcID,
+			CloudVpcID:     cloudVpcID,
+			PeerVpcID:      vpcMap[cloudPeerVpcID].VpcID,
+			CloudPeerVpcID: cloudPeerVpcID,
+			Extension:      convAzureVpcPeeringExtension(resGroupName, one),
+		}
+		if rel, exist := vpcMap[cloudVpcID]; exist {
+			peering.BkBizID = rel.BkBizID
+		}
+		createReq.VpcPeerings = append(createReq.VpcPeerings, peering)
+	}
+
+	for _, batch := range slice.Split(createReq.VpcPeerings, constant.BatchOperationMaxLimit) {
+		req := &protocloud.VpcPeeringBatchCreateReq[corevpcpeering.AzureVpcPeeringExtension]{VpcPeerings: batch}
+		if _, err := cli.dbCli.Azure.VpcPeering.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create vpc peering failed, err: %v, rid: %s",
+				enumor.Azure, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync vpc peering to create vpc peering success, accountID: %s, count: %d, rid: %s",
+		enumor.Azure, accountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateVpcPeering(kt *kit.Kit, resGroupName string,
+	updateMap map[string]typesvpcpeering.AzureVpcPeering, vpcMap map[string]common.NetworkVpcRel) error {
+
+	updateReq := make(protocloud.VpcPeeringExtBatchUpdateReq[corevpcpeering.AzureVpcPeeringExtension], 0,
+		len(updateMap))
+	for id, one := range updateMap {
+		cloudVpcID := converter.PtrToVal(one.CloudVpcID)
+		cloudPeerVpcID := converter.PtrToVal(one.CloudRemoteVpcID)
+		peering := &protocloud.VpcPeeringExtUpdateReq[corevpcpeering.AzureVpcPeeringExtension]{
+			ID:             id,
+			Name:           converter.PtrToVal(one.Name),
+			Status:         converter.PtrToVal(one.PeeringState),
+			VpcID:          vpcMap[cloudVpcID].VpcID,
+			CloudVpcID:     cloudVpcID,
+			PeerVpcID:      vpcMap[cloudPeerVpcID].VpcID,
+			CloudPeerVpcID: cloudPeerVpcID,
+			Extension:      convAzureVpcPeeringExtension(resGroupName, one),
+		}
+		if rel, exist := vpcMap[cloudVpcID]; exist {
+			peering.BkBizID = rel.BkBizID
+		}
+		updateReq = append(updateReq, peering)
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.VpcPeeringExtBatchUpdateReq[corevpcpeering.AzureVpcPeeringExtension](batch)
+		if err := cli.dbCli.Azure.VpcPeering.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update vpc peering failed, err: %v, rid: %s",
+				enumor.Azure, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync vpc peering to update vpc peering success, count: %d, rid: %s", enumor.Azure,
+		len(updateMap), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) deleteVpcPeering(kt *kit.Kit, accountID, resGroupName string, delCloudIDs []string) error {
+	checkParams := &SyncBaseParams{AccountID: accountID, ResourceGroupName: resGroupName, CloudIDs: delCloudIDs}
+	delFromCloud, err := cli.listVpcPeeringFromCloud(kt, checkParams)
+	if err != nil {
+		return err
+	}
+
+	if len(delFromCloud) > 0 {
+		logs.Errorf("[%s] validate vpc peering not exist failed, before delete opt: %v, failed_count: %d, rid: %s",
+			enumor.Azure, checkParams, len(delFromCloud), kt.Rid)
+		return errf.Newf(errf.Aborted, "validate vpc peering not exist failed, before delete")
+	}
+
+	req := &protocloud.VpcPeeringBatchDeleteReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Azure),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleIn("cloud_id", delCloudIDs),
+		),
+	}
+	if err = cli.dbCli.Global.VpcPeering.BatchDelete(kt, req); err != nil {
+		logs.Errorf("[%s] request dataservice to batch delete vpc peering failed, err: %v, rid: %s", enumor.Azure,
+			err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync vpc peering to delete vpc peering success, accountID: %s, count: %d, rid: %s",
+		enumor.Azure, accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) listVpcPeeringFromCloud(kt *kit.Kit, params *SyncBaseParams) (
+	[]typesvpcpeering.AzureVpcPeering, error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &typesvpcpeering.AzureVpcPeeringListOption{
+		ResourceGroupName: params.ResourceGroupName,
+		CloudIDs:          params.CloudIDs,
+	}
+	result, err := cli.cloudCli.ListVpcPeering(kt, opt)
+	if err != nil {
+		logs.Errorf("[%s] list vpc peering from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+			enumor.Azure, err, params.AccountID, opt, kt.Rid)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (cli *client) listVpcPeeringFromDB(kt *kit.Kit, params *SyncBaseParams) (
+	[]corevpcpeering.VpcPeering[corevpcpeering.AzureVpcPeeringExtension], error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", params.AccountID),
+			tools.RuleIn("cloud_id", params.CloudIDs),
+			tools.RuleJSONEqual("extension.resource_group_name", params.ResourceGroupName),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := cli.dbCli.Azure.VpcPeering.ListVpcPeeringExt(kt, req)
+	if err != nil {
+		logs.Errorf("[%s] list vpc peering from db failed, err: %v, account: %s, req: %v, rid: %s", enumor.Azure,
+			err, params.AccountID, req, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+// RemoveVpcPeeringDeleteFromCloud 删除本地存在但云上已被删除的对等连接
+func (cli *client) RemoveVpcPeeringDeleteFromCloud(kt *kit.Kit, accountID string, resGroupName string) error {
+	req := &core.ListReq{
+		Fields: []string{"id", "cloud_id"},
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Azure),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleJSONEqual("extension.resource_group_name", resGroupName),
+		),
+		Page: &core.BasePage{Start: 0, Limit: constant.BatchOperationMaxLimit},
+	}
+	for {
+		resultFromDB, err := cli.dbCli.Global.VpcPeering.List(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] request dataservice to list vpc peering failed, err: %v, req: %v, rid: %s",
+				enumor.Azure, err, req, kt.Rid)
+			return err
+		}
+
+		cloudIDs := make([]string, 0, len(resultFromDB.Details))
+		for _, one := range resultFromDB.Details {
+			cloudIDs = append(cloudIDs, one.CloudID)
+		}
+
+		if len(cloudIDs) == 0 {
+			break
+		}
+
+		params := &SyncBaseParams{AccountID: accountID, ResourceGroupName: resGroupName, CloudIDs: cloudIDs}
+		resultFromCloud, err := cli.listVpcPeeringFromCloud(kt, params)
+		if err != nil {
+			return err
+		}
+
+		// 如果有资源没有查询出来，说明数据被从云上删除
+		if len(resultFromCloud) != len(cloudIDs) {
+			cloudIDMap := converter.StringSliceToMap(cloudIDs)
+			for _, one := range resultFromCloud {
+				delete(cloudIDMap, one.GetCloudID())
+			}
+
+			delCloudIDs := converter.MapKeyToStringSlice(cloudIDMap)
+			if err = cli.deleteVpcPeering(kt, accountID, resGroupName, delCloudIDs); err != nil {
+				return err
+			}
+		}
+
+		if len(resultFromDB.Details) < constant.BatchOperationMaxLimit {
+			break
+		}
+
+		req.Page.Start += constant.BatchOperationMaxLimit
+	}
+
+	return nil
+}
+
+func convAzureVpcPeeringExtension(resGroupName string,
+	one typesvpcpeering.AzureVpcPeering) *corevpcpeering.AzureVpcPeeringExtension {
+
+	return &corevpcpeering.AzureVpcPeeringExtension{
+		ResourceGroupName:         resGroupName,
+		ProvisioningState:         one.ProvisioningState,
+		AllowVirtualNetworkAccess: one.AllowVirtualNetworkAccess,
+		AllowForwardedTraffic:     one.AllowForwardedTraffic,
+		AllowGatewayTransit:       one.AllowGatewayTransit,
+		UseRemoteGateways:         one.UseRemoteGateways,
+	}
+}
+
+func isVpcPeeringChange(cloud typesvpcpeering.AzureVpcPeering,
+	db corevpcpeering.VpcPeering[corevpcpeering.AzureVpcPeeringExtension]) bool {
+
+	if converter.PtrToVal(cloud.Name) != db.Name {
+		return true
+	}
+
+	if converter.PtrToVal(cloud.PeeringState) != db.Status {
+		return true
+	}
+
+	if converter.PtrToVal(cloud.CloudVpcID) != db.CloudVpcID ||
+		converter.PtrToVal(cloud.CloudRemoteVpcID) != db.CloudPeerVpcID {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	if !assert.IsPtrStringEqual(cloud.ProvisioningState, db.Extension.ProvisioningState) {
+		return true
+	}
+
+	if !assert.IsPtrBoolEqual(cloud.AllowForwardedTraffic, db.Extension.AllowForwardedTraffic) ||
+		!assert.IsPtrBoolEqual(cloud.AllowGatewayTransit, db.Extension.AllowGatewayTransit) ||
+		!assert.IsPtrBoolEqual(cloud.UseRemoteGateways, db.Extension.UseRemoteGateways) {
+		return true
+	}
+
+	return false
+}
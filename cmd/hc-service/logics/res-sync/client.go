@@ -23,10 +23,15 @@ import (
 	cloudclient "hcm/cmd/hc-service/logics/cloud-adaptor"
 	"hcm/cmd/hc-service/logics/res-sync/aws"
 	"hcm/cmd/hc-service/logics/res-sync/azure"
+	dryrun "hcm/cmd/hc-service/logics/res-sync/dry-run"
 	"hcm/cmd/hc-service/logics/res-sync/gcp"
 	"hcm/cmd/hc-service/logics/res-sync/huawei"
+	"hcm/cmd/hc-service/logics/res-sync/kaopu"
 	"hcm/cmd/hc-service/logics/res-sync/tcloud"
+	"hcm/cmd/hc-service/logics/res-sync/zenlayer"
+	apiclient "hcm/pkg/client"
 	dataservice "hcm/pkg/client/data-service"
+	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/kit"
 )
 
@@ -37,24 +42,47 @@ type Interface interface {
 	HuaWei(kt *kit.Kit, accountID string) (huawei.Interface, error)
 	Gcp(kt *kit.Kit, accountID string) (gcp.Interface, error)
 	Azure(kt *kit.Kit, accountID string) (azure.Interface, error)
+	Zenlayer(kt *kit.Kit, accountID string) (zenlayer.Interface, error)
+	Kaopu(kt *kit.Kit, accountID string) (kaopu.Interface, error)
+
+	// DryRunRecorder 创建 dry-run 同步记录器，通过 dryrun.WithRecorder 设置到 kit 后，
+	// 使用该 kit 构建的同步客户端对 data-service 的写操作都只记录不执行。
+	DryRunRecorder(vendor enumor.Vendor, accountID, region string) *dryrun.Recorder
 }
 
 var _ Interface = new(client)
 
 // NewClient new client.
-func NewClient(ad *cloudclient.CloudAdaptorClient, dataCli *dataservice.Client) Interface {
+func NewClient(ad *cloudclient.CloudAdaptorClient, cliSet *apiclient.ClientSet) Interface {
 	return &client{
 		ad:      ad,
-		dataCli: dataCli,
+		cliSet:  cliSet,
+		dataCli: cliSet.DataService(),
 	}
 }
 
 // client sync client.
 type client struct {
 	ad      *cloudclient.CloudAdaptorClient
+	cliSet  *apiclient.ClientSet
 	dataCli *dataservice.Client
 }
 
+// dataService 返回同步使用的 data-service 客户端，dry-run 同步时返回拦截写操作的客户端。
+func (cli *client) dataService(kt *kit.Kit) *dataservice.Client {
+	rec, ok := dryrun.FromKit(kt)
+	if !ok {
+		return cli.dataCli
+	}
+
+	return cli.cliSet.DataServiceWithWrapper(rec.WrapHTTPClient)
+}
+
+// DryRunRecorder ...
+func (cli *client) DryRunRecorder(vendor enumor.Vendor, accountID, region string) *dryrun.Recorder {
+	return dryrun.NewRecorder(cli.dataCli, vendor, accountID, region)
+}
+
 // TCloud ...
 func (cli *client) TCloud(kt *kit.Kit, accountID string) (tcloud.Interface, error) {
 	cloudCli, err := cli.ad.TCloud(kt, accountID)
@@ -62,7 +90,7 @@ func (cli *client) TCloud(kt *kit.Kit, accountID string) (tcloud.Interface, erro
 		return nil, err
 	}
 
-	return tcloud.NewClient(cli.dataCli, cloudCli), nil
+	return tcloud.NewClient(cli.dataService(kt), cloudCli), nil
 }
 
 // Aws ...
@@ -72,7 +100,7 @@ func (cli *client) Aws(kt *kit.Kit, accountID string) (aws.Interface, error) {
 		return nil, err
 	}
 
-	return aws.NewClient(cli.dataCli, cloudCli), nil
+	return aws.NewClient(cli.dataService(kt), cloudCli), nil
 }
 
 // Gcp ...
@@ -82,7 +110,7 @@ func (cli *client) Gcp(kt *kit.Kit, accountID string) (gcp.Interface, error) {
 		return nil, err
 	}
 
-	return gcp.NewClient(cli.dataCli, cloudCli), nil
+	return gcp.NewClient(cli.dataService(kt), cloudCli), nil
 }
 
 // HuaWei ...
@@ -92,7 +120,7 @@ func (cli *client) HuaWei(kt *kit.Kit, accountID string) (huawei.Interface, erro
 		return nil, err
 	}
 
-	return huawei.NewClient(cli.dataCli, cloudCli), nil
+	return huawei.NewClient(cli.dataService(kt), cloudCli), nil
 }
 
 // Azure ...
@@ -102,5 +130,25 @@ func (cli *client) Azure(kt *kit.Kit, accountID string) (azure.Interface, error)
 		return nil, err
 	}
 
-	return azure.NewClient(cli.dataCli, cloudCli), nil
+	return azure.NewClient(cli.dataService(kt), cloudCli), nil
+}
+
+// Zenlayer ...
+func (cli *client) Zenlayer(kt *kit.Kit, accountID string) (zenlayer.Interface, error) {
+	cloudCli, err := cli.ad.Zenlayer(kt, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return zenlayer.NewClient(cli.dataService(kt), cloudCli), nil
+}
+
+// Kaopu ...
+func (cli *client) Kaopu(kt *kit.Kit, accountID string) (kaopu.Interface, error) {
+	cloudCli, err := cli.ad.Kaopu(kt, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return kaopu.NewClient(cli.dataService(kt), cloudCli), nil
 }
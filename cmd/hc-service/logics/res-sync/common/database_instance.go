@@ -0,0 +1,177 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package common
+
+import (
+	"sort"
+
+	"hcm/pkg/api/core"
+	dataservice "hcm/pkg/api/data-service"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	dataclient "hcm/pkg/client/data-service"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncDatabaseInstanceSGRel 同步云数据库实例与安全组的有序关联关系，dbSgCloudMap 为 实例本地ID -> 云上安全组ID列表，
+// 安全组在本地不存在时跳过该安全组，待安全组同步后再补齐
+func SyncDatabaseInstanceSGRel(kt *kit.Kit, dataCli *dataclient.Client, vendor enumor.Vendor,
+	dbSgCloudMap map[string][]string) error {
+
+	if len(dbSgCloudMap) == 0 {
+		return nil
+	}
+
+	dbIDs := make([]string, 0, len(dbSgCloudMap))
+	allSgCloudIDs := make([]string, 0)
+	for id, sgCloudIDs := range dbSgCloudMap {
+		dbIDs = append(dbIDs, id)
+		allSgCloudIDs = append(allSgCloudIDs, sgCloudIDs...)
+	}
+
+	sgCloudLocalMap, err := getSecurityGroupCloudLocalMap(kt, dataCli, vendor, allSgCloudIDs)
+	if err != nil {
+		return err
+	}
+
+	localRelMap := make(map[string][]OrderedRel, len(dbIDs))
+	for _, batch := range slice.Split(dbIDs, int(core.DefaultMaxPageLimit)) {
+		relReq := &protocloud.SGCommonRelWithSecurityGroupListReq{
+			ResIDs:  batch,
+			ResType: enumor.DatabaseInstanceCloudResType,
+		}
+		relResp, err := dataCli.Global.SGCommonRel.ListWithSecurityGroup(kt, relReq)
+		if err != nil {
+			logs.Errorf("[%s] list sg rel of database instance failed, err: %v, ids: %v, rid: %s", vendor, err,
+				batch, kt.Rid)
+			return err
+		}
+		for _, rel := range *relResp {
+			localRelMap[rel.ResID] = append(localRelMap[rel.ResID],
+				OrderedRel{CloudResID: rel.CloudID, ResID: rel.ResID, Priority: rel.Priority})
+		}
+	}
+
+	for dbID, sgCloudIDs := range dbSgCloudMap {
+		cloudSgIDs := make([]string, 0, len(sgCloudIDs))
+		for _, cloudID := range sgCloudIDs {
+			if _, exist := sgCloudLocalMap[cloudID]; exist {
+				cloudSgIDs = append(cloudSgIDs, cloudID)
+			}
+		}
+
+		localRels := localRelMap[dbID]
+		// 按优先级从小到大排序
+		sort.Slice(localRels, func(i, j int) bool {
+			return localRels[i].Priority < localRels[j].Priority
+		})
+		if isDatabaseInstanceSGRelEqual(localRels, cloudSgIDs) {
+			continue
+		}
+
+		if err = upsertDatabaseInstanceSGRel(kt, dataCli, vendor, dbID, cloudSgIDs, sgCloudLocalMap); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isDatabaseInstanceSGRelEqual(localRels []OrderedRel, cloudSgIDs []string) bool {
+	if len(localRels) != len(cloudSgIDs) {
+		return false
+	}
+
+	for idx, cloudID := range cloudSgIDs {
+		if localRels[idx].CloudResID != cloudID || localRels[idx].Priority != int64(idx+1) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// upsertDatabaseInstanceSGRel 删除实例的全部关联关系，并按云上顺序重新创建
+func upsertDatabaseInstanceSGRel(kt *kit.Kit, dataCli *dataclient.Client, vendor enumor.Vendor, dbID string,
+	cloudSgIDs []string, sgCloudLocalMap map[string]string) error {
+
+	deleteReq := &dataservice.BatchDeleteReq{Filter: tools.ExpressionAnd(
+		tools.RuleEqual("res_type", enumor.DatabaseInstanceCloudResType),
+		tools.RuleEqual("res_id", dbID),
+	)}
+
+	if len(cloudSgIDs) == 0 {
+		if err := dataCli.Global.SGCommonRel.BatchDelete(kt, deleteReq); err != nil {
+			logs.Errorf("[%s] delete database instance(%s) sg rel failed, err: %v, rid: %s", vendor, dbID, err,
+				kt.Rid)
+			return err
+		}
+		return nil
+	}
+
+	upsertReq := &protocloud.SGCommonRelBatchUpsertReq{
+		Rels:      make([]protocloud.SGCommonRelCreate, 0, len(cloudSgIDs)),
+		DeleteReq: deleteReq,
+	}
+	for idx, cloudID := range cloudSgIDs {
+		upsertReq.Rels = append(upsertReq.Rels, protocloud.SGCommonRelCreate{
+			SecurityGroupID: sgCloudLocalMap[cloudID],
+			Vendor:          vendor,
+			ResID:           dbID,
+			ResType:         enumor.DatabaseInstanceCloudResType,
+			Priority:        int64(idx + 1),
+		})
+	}
+	if err := dataCli.Global.SGCommonRel.BatchUpsert(kt, upsertReq); err != nil {
+		logs.Errorf("[%s] upsert database instance(%s) sg rel failed, err: %v, req: %+v, rid: %s", vendor, dbID,
+			err, upsertReq, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// getSecurityGroupCloudLocalMap 返回 安全组云上ID -> 本地ID 的映射，本地不存在的不返回
+func getSecurityGroupCloudLocalMap(kt *kit.Kit, dataCli *dataclient.Client, vendor enumor.Vendor,
+	cloudIDs []string) (map[string]string, error) {
+
+	result := make(map[string]string)
+	for _, batch := range slice.Split(slice.Unique(cloudIDs), int(core.DefaultMaxPageLimit)) {
+		req := &protocloud.SecurityGroupListReq{
+			Field:  []string{"id", "cloud_id"},
+			Filter: tools.ExpressionAnd(tools.RuleEqual("vendor", vendor), tools.RuleIn("cloud_id", batch)),
+			Page:   core.NewDefaultBasePage(),
+		}
+		resp, err := dataCli.Global.SecurityGroup.ListSecurityGroup(kt.Ctx, kt.Header(), req)
+		if err != nil {
+			logs.Errorf("[%s] list security group of database instance failed, err: %v, rid: %s", vendor, err,
+				kt.Rid)
+			return nil, err
+		}
+		for _, one := range resp.Details {
+			result[one.CloudID] = one.ID
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,63 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package common
+
+import "testing"
+
+func TestIsDatabaseInstanceSGRelEqual(t *testing.T) {
+	cases := []struct {
+		name       string
+		localRels  []OrderedRel
+		cloudSgIDs []string
+		expected   bool
+	}{
+		{name: "both empty", expected: true},
+		{
+			name:       "equal",
+			localRels:  []OrderedRel{{CloudResID: "sg-1", Priority: 1}, {CloudResID: "sg-2", Priority: 2}},
+			cloudSgIDs: []string{"sg-1", "sg-2"},
+			expected:   true,
+		},
+		{
+			name:       "length differs",
+			localRels:  []OrderedRel{{CloudResID: "sg-1", Priority: 1}},
+			cloudSgIDs: []string{"sg-1", "sg-2"},
+			expected:   false,
+		},
+		{
+			name:       "order differs",
+			localRels:  []OrderedRel{{CloudResID: "sg-2", Priority: 1}, {CloudResID: "sg-1", Priority: 2}},
+			cloudSgIDs: []string{"sg-1", "sg-2"},
+			expected:   false,
+		},
+		{
+			name:       "priority differs",
+			localRels:  []OrderedRel{{CloudResID: "sg-1", Priority: 2}, {CloudResID: "sg-2", Priority: 3}},
+			cloudSgIDs: []string{"sg-1", "sg-2"},
+			expected:   false,
+		},
+	}
+
+	for _, c := range cases {
+		if got := isDatabaseInstanceSGRelEqual(c.localRels, c.cloudSgIDs); got != c.expected {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, got)
+		}
+	}
+}
@@ -23,33 +23,49 @@ import (
 	"hcm/pkg/adaptor/types"
 	"hcm/pkg/adaptor/types/account"
 	typeargstpl "hcm/pkg/adaptor/types/argument-template"
+	typesbucket "hcm/pkg/adaptor/types/bucket"
 	"hcm/pkg/adaptor/types/cert"
 	typescvm "hcm/pkg/adaptor/types/cvm"
+	typesdbinstance "hcm/pkg/adaptor/types/database-instance"
 	typesdisk "hcm/pkg/adaptor/types/disk"
 	typeseip "hcm/pkg/adaptor/types/eip"
 	firewallrule "hcm/pkg/adaptor/types/firewall-rule"
 	typesimage "hcm/pkg/adaptor/types/image"
+	typesk8s "hcm/pkg/adaptor/types/k8s-cluster"
+	typekeypair "hcm/pkg/adaptor/types/key-pair"
 	typeslb "hcm/pkg/adaptor/types/load-balancer"
+	typesnatgateway "hcm/pkg/adaptor/types/nat-gateway"
 	typesni "hcm/pkg/adaptor/types/network-interface"
+	typesdns "hcm/pkg/adaptor/types/private-dns"
 	typesregion "hcm/pkg/adaptor/types/region"
 	typesresourcegroup "hcm/pkg/adaptor/types/resource-group"
 	typesroutetable "hcm/pkg/adaptor/types/route-table"
 	securitygroup "hcm/pkg/adaptor/types/security-group"
 	typessecuritygrouprule "hcm/pkg/adaptor/types/security-group-rule"
+	typessnapshot "hcm/pkg/adaptor/types/snapshot"
 	adtysubnet "hcm/pkg/adaptor/types/subnet"
+	typesvpcpeering "hcm/pkg/adaptor/types/vpc-peering"
 	typeszone "hcm/pkg/adaptor/types/zone"
 	cloudcore "hcm/pkg/api/core/cloud"
 	coreargstpl "hcm/pkg/api/core/cloud/argument-template"
+	corebucket "hcm/pkg/api/core/cloud/bucket"
 	corecert "hcm/pkg/api/core/cloud/cert"
 	corecvm "hcm/pkg/api/core/cloud/cvm"
+	coredbinstance "hcm/pkg/api/core/cloud/database-instance"
 	coredisk "hcm/pkg/api/core/cloud/disk"
 	coreimage "hcm/pkg/api/core/cloud/image"
+	corek8s "hcm/pkg/api/core/cloud/k8s"
+	corekeypair "hcm/pkg/api/core/cloud/key-pair"
 	corelb "hcm/pkg/api/core/cloud/load-balancer"
+	corenatgateway "hcm/pkg/api/core/cloud/nat-gateway"
 	corecloudni "hcm/pkg/api/core/cloud/network-interface"
+	coreprivatedns "hcm/pkg/api/core/cloud/private-dns"
 	coreregion "hcm/pkg/api/core/cloud/region"
 	coreresourcegroup "hcm/pkg/api/core/cloud/resource-group"
 	cloudcoreroutetable "hcm/pkg/api/core/cloud/route-table"
+	coresnapshot "hcm/pkg/api/core/cloud/snapshot"
 	coresubaccount "hcm/pkg/api/core/cloud/sub-account"
+	corevpcpeering "hcm/pkg/api/core/cloud/vpc-peering"
 	corezone "hcm/pkg/api/core/cloud/zone"
 	corerecyclerecord "hcm/pkg/api/core/recycle-record"
 	dataeip "hcm/pkg/api/data-service/cloud/eip"
@@ -152,7 +168,29 @@ type CloudResType interface {
 		typeslb.TCloudClb |
 		typeslb.TCloudListener |
 		typeslb.TCloudUrlRule |
-		typeslb.Backend
+		typeslb.Backend |
+		typeslb.AwsLoadBalancer |
+		typeslb.AwsListener |
+		typeslb.AwsTargetGroup |
+		typeslb.AzureLoadBalancer |
+		typeslb.HuaWeiLoadBalancer |
+		typeslb.GcpLoadBalancer |
+
+		typessnapshot.TCloudSnapshot |
+		typessnapshot.AwsSnapshot |
+		typessnapshot.AzureSnapshot |
+		typessnapshot.GcpSnapshot |
+		typessnapshot.HuaWeiSnapshot |
+		typesnatgateway.TCloudNatGateway |
+		typesnatgateway.AwsNatGateway |
+		typesnatgateway.AzureNatGateway |
+		typesnatgateway.GcpNatGateway |
+		typesnatgateway.HuaWeiNatGateway |
+		typesvpcpeering.TCloudVpcPeering |
+		typesvpcpeering.AwsVpcPeering |
+		typesvpcpeering.AzureVpcPeering |
+		typesvpcpeering.GcpVpcPeering |
+		typesvpcpeering.HuaWeiVpcPeering
 }
 
 // DBResType 本地资源类型
@@ -248,13 +286,157 @@ type DBResType interface {
 		corelb.TCloudLoadBalancer |
 		corelb.TCloudLbUrlRule |
 		corelb.TCloudListener |
-		corelb.BaseTarget
+		corelb.BaseTarget |
+		corelb.AwsLoadBalancer |
+		corelb.AwsListener |
+		corelb.AwsTargetGroup |
+		corelb.AzureLoadBalancer |
+		corelb.HuaWeiLoadBalancer |
+		corelb.GcpLoadBalancer |
+
+		coresnapshot.Snapshot[coresnapshot.TCloudSnapshotExtension] |
+		coresnapshot.Snapshot[coresnapshot.AwsSnapshotExtension] |
+		coresnapshot.Snapshot[coresnapshot.AzureSnapshotExtension] |
+		coresnapshot.Snapshot[coresnapshot.GcpSnapshotExtension] |
+		coresnapshot.Snapshot[coresnapshot.HuaWeiSnapshotExtension] |
+		corenatgateway.NatGateway[corenatgateway.TCloudNatGatewayExtension] |
+		corenatgateway.NatGateway[corenatgateway.AwsNatGatewayExtension] |
+		corenatgateway.NatGateway[corenatgateway.AzureNatGatewayExtension] |
+		corenatgateway.NatGateway[corenatgateway.GcpNatGatewayExtension] |
+		corenatgateway.NatGateway[corenatgateway.HuaWeiNatGatewayExtension] |
+		corevpcpeering.VpcPeering[corevpcpeering.TCloudVpcPeeringExtension] |
+		corevpcpeering.VpcPeering[corevpcpeering.AwsVpcPeeringExtension] |
+		corevpcpeering.VpcPeering[corevpcpeering.AzureVpcPeeringExtension] |
+		corevpcpeering.VpcPeering[corevpcpeering.GcpVpcPeeringExtension] |
+		corevpcpeering.VpcPeering[corevpcpeering.HuaWeiVpcPeeringExtension]
+}
+
+// CloudPaaSResType 云数据库、对象存储等PaaS云资源及密钥对、私有域类型，Go 泛型约束的联合类型最多支持100项，CloudResType 已达上限，单独定义
+type CloudPaaSResType interface {
+	GetCloudID() string
+
+	typesdbinstance.TCloudDBInstance |
+		typesdbinstance.AwsDBInstance |
+		typesdbinstance.AzureDBInstance |
+		typesdbinstance.GcpDBInstance |
+		typesdbinstance.HuaWeiDBInstance |
+		typesbucket.TCloudBucket |
+		typesbucket.AwsBucket |
+		typesbucket.AzureBucket |
+		typesbucket.GcpBucket |
+		typesbucket.HuaWeiBucket |
+		typesk8s.TCloudCluster |
+		typesk8s.AwsCluster |
+		typesk8s.AzureCluster |
+		typesk8s.GcpCluster |
+		typesk8s.HuaWeiCluster |
+		typekeypair.TCloudKeyPair |
+		typekeypair.AwsKeyPair |
+		typekeypair.AzureKeyPair |
+		typekeypair.GcpKeyPair |
+		typekeypair.HuaWeiKeyPair |
+		typesdns.TCloudZone |
+		typesdns.AwsZone |
+		typesdns.AzureZone |
+		typesdns.GcpZone |
+		typesdns.HuaWeiZone
+}
+
+// DBPaaSResType 云数据库、对象存储等PaaS本地资源及密钥对、私有域类型
+type DBPaaSResType interface {
+	GetID() string
+	GetCloudID() string
+
+	coredbinstance.DatabaseInstance[coredbinstance.TCloudDBInstanceExtension] |
+		coredbinstance.DatabaseInstance[coredbinstance.AwsDBInstanceExtension] |
+		coredbinstance.DatabaseInstance[coredbinstance.AzureDBInstanceExtension] |
+		coredbinstance.DatabaseInstance[coredbinstance.GcpDBInstanceExtension] |
+		coredbinstance.DatabaseInstance[coredbinstance.HuaWeiDBInstanceExtension] |
+		corebucket.Bucket[corebucket.TCloudBucketExtension] |
+		corebucket.Bucket[corebucket.AwsBucketExtension] |
+		corebucket.Bucket[corebucket.AzureBucketExtension] |
+		corebucket.Bucket[corebucket.GcpBucketExtension] |
+		corebucket.Bucket[corebucket.HuaWeiBucketExtension] |
+		corek8s.K8sCluster[corek8s.TCloudK8sClusterExtension] |
+		corek8s.K8sCluster[corek8s.AwsK8sClusterExtension] |
+		corek8s.K8sCluster[corek8s.AzureK8sClusterExtension] |
+		corek8s.K8sCluster[corek8s.GcpK8sClusterExtension] |
+		corek8s.K8sCluster[corek8s.HuaWeiK8sClusterExtension] |
+		corekeypair.KeyPair[corekeypair.TCloudKeyPairExtension] |
+		corekeypair.KeyPair[corekeypair.AwsKeyPairExtension] |
+		corekeypair.KeyPair[corekeypair.AzureKeyPairExtension] |
+		corekeypair.KeyPair[corekeypair.GcpKeyPairExtension] |
+		corekeypair.KeyPair[corekeypair.HuaWeiKeyPairExtension] |
+		coreprivatedns.Zone[coreprivatedns.TCloudZoneExtension] |
+		coreprivatedns.Zone[coreprivatedns.AwsZoneExtension] |
+		coreprivatedns.Zone[coreprivatedns.AzureZoneExtension] |
+		coreprivatedns.Zone[coreprivatedns.GcpZoneExtension] |
+		coreprivatedns.Zone[coreprivatedns.HuaWeiZoneExtension]
+}
+
+// CloudExtraVendorResType zenlayer、靠谱云等扩展云厂商的云资源类型，CloudResType 已达联合类型上限，单独定义
+type CloudExtraVendorResType interface {
+	GetCloudID() string
+
+	typesregion.ZenlayerRegion |
+		typesregion.KaopuRegion |
+		typeszone.ZenlayerZone |
+		typeszone.KaopuZone |
+		types.ZenlayerVpc |
+		types.KaopuVpc |
+		adtysubnet.ZenlayerSubnet |
+		adtysubnet.KaopuSubnet |
+		typescvm.ZenlayerCvm |
+		typescvm.KaopuCvm |
+		typeseip.ZenlayerEip |
+		typeseip.KaopuEip
+}
+
+// DBExtraVendorResType zenlayer、靠谱云等扩展云厂商的本地资源类型
+type DBExtraVendorResType interface {
+	GetID() string
+	GetCloudID() string
+
+	coreregion.ZenlayerRegion |
+		coreregion.KaopuRegion |
+		corezone.BaseZone |
+		cloudcore.Vpc[cloudcore.ZenlayerVpcExtension] |
+		cloudcore.Vpc[cloudcore.KaopuVpcExtension] |
+		cloudcore.Subnet[cloudcore.ZenlayerSubnetExtension] |
+		cloudcore.Subnet[cloudcore.KaopuSubnetExtension] |
+		corecvm.Cvm[corecvm.ZenlayerCvmExtension] |
+		corecvm.Cvm[corecvm.KaopuCvmExtension] |
+		*dataeip.EipExtResult[dataeip.ZenlayerEipExtensionResult] |
+		*dataeip.EipExtResult[dataeip.KaopuEipExtensionResult]
 }
 
 // Diff 对比云和db资源，划分出新增数据，更新数据，删除数据。
 func Diff[CloudType CloudResType, DBType DBResType](dataFromCloud []CloudType, dataFromDB []DBType,
 	isChange func(CloudType, DBType) bool) ([]CloudType, map[string]CloudType, []string) {
 
+	return diff(dataFromCloud, dataFromDB, isChange)
+}
+
+// DiffPaaS 对比PaaS云资源和db资源，划分出新增数据，更新数据，删除数据。
+func DiffPaaS[CloudType CloudPaaSResType, DBType DBPaaSResType](dataFromCloud []CloudType, dataFromDB []DBType,
+	isChange func(CloudType, DBType) bool) ([]CloudType, map[string]CloudType, []string) {
+
+	return diff(dataFromCloud, dataFromDB, isChange)
+}
+
+// DiffExtraVendor 对比扩展云厂商的云资源和db资源，划分出新增数据，更新数据，删除数据。
+func DiffExtraVendor[CloudType CloudExtraVendorResType, DBType DBExtraVendorResType](dataFromCloud []CloudType,
+	dataFromDB []DBType, isChange func(CloudType, DBType) bool) ([]CloudType, map[string]CloudType, []string) {
+
+	return diff(dataFromCloud, dataFromDB, isChange)
+}
+
+func diff[CloudType interface{ GetCloudID() string }, DBType interface {
+	GetID() string
+	GetCloudID() string
+}](dataFromCloud []CloudType, dataFromDB []DBType, isChange func(CloudType, DBType) bool) ([]CloudType,
+	map[string]CloudType, []string) {
+
 	dbMap := make(map[string]DBType, len(dataFromDB))
 	for _, one := range dataFromDB {
 		dbMap[one.GetCloudID()] = one
@@ -0,0 +1,310 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package common
+
+import (
+	typesk8s "hcm/pkg/adaptor/types/k8s-cluster"
+	"hcm/pkg/api/core"
+	corek8s "hcm/pkg/api/core/cloud/k8s"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	dataclient "hcm/pkg/client/data-service"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncK8sNodePoolAndNode 同步容器集群下的节点池，以及集群节点和主机的关联关系。节点对应的主机在本地不存在时跳过，
+// 待主机同步后再补齐；不属于任何节点池的节点只关联到集群
+func SyncK8sNodePoolAndNode(kt *kit.Kit, dataCli *dataclient.Client, cluster corek8s.BaseK8sCluster,
+	pools []typesk8s.K8sNodePool, nodes []typesk8s.K8sNode) error {
+
+	poolFromDB, err := listK8sNodePoolFromDB(kt, dataCli, cluster.ID)
+	if err != nil {
+		return err
+	}
+
+	if err = syncK8sNodePool(kt, dataCli, cluster, pools, poolFromDB); err != nil {
+		return err
+	}
+
+	// 节点池新增后需要重新查询，获取节点池的本地ID
+	poolFromDB, err = listK8sNodePoolFromDB(kt, dataCli, cluster.ID)
+	if err != nil {
+		return err
+	}
+
+	return syncK8sNodePoolCvmRel(kt, dataCli, cluster, nodes, poolFromDB)
+}
+
+func syncK8sNodePool(kt *kit.Kit, dataCli *dataclient.Client, cluster corek8s.BaseK8sCluster,
+	pools []typesk8s.K8sNodePool, poolFromDB []corek8s.K8sNodePool) error {
+
+	cloudMap := make(map[string]typesk8s.K8sNodePool, len(pools))
+	for _, one := range pools {
+		cloudMap[one.CloudID] = one
+	}
+
+	delIDs := make([]string, 0)
+	updates := make([]protocloud.K8sNodePoolUpdateReq, 0)
+	dbCloudIDs := make(map[string]struct{}, len(poolFromDB))
+	for _, db := range poolFromDB {
+		dbCloudIDs[db.CloudID] = struct{}{}
+		one, exist := cloudMap[db.CloudID]
+		if !exist {
+			delIDs = append(delIDs, db.ID)
+			continue
+		}
+
+		if !isK8sNodePoolChange(one, db) {
+			continue
+		}
+		updates = append(updates, protocloud.K8sNodePoolUpdateReq{
+			ID:           db.ID,
+			Name:         one.Name,
+			Status:       one.Status,
+			InstanceType: one.InstanceType,
+			NodeCount:    one.NodeCount,
+			MinSize:      one.MinSize,
+			MaxSize:      one.MaxSize,
+		})
+	}
+
+	creates := make([]protocloud.K8sNodePoolCreate, 0)
+	for _, one := range pools {
+		if _, exist := dbCloudIDs[one.CloudID]; exist {
+			continue
+		}
+		creates = append(creates, protocloud.K8sNodePoolCreate{
+			Vendor:         cluster.Vendor,
+			AccountID:      cluster.AccountID,
+			ClusterID:      cluster.ID,
+			CloudClusterID: cluster.CloudID,
+			CloudID:        one.CloudID,
+			Name:           one.Name,
+			Region:         cluster.Region,
+			Status:         one.Status,
+			InstanceType:   one.InstanceType,
+			NodeCount:      one.NodeCount,
+			MinSize:        one.MinSize,
+			MaxSize:        one.MaxSize,
+		})
+	}
+
+	for _, batch := range slice.Split(delIDs, constant.BatchOperationMaxLimit) {
+		req := &protocloud.K8sNodePoolBatchDeleteReq{Filter: tools.ContainersExpression("id", batch)}
+		if err := dataCli.Global.K8sNodePool.BatchDelete(kt, req); err != nil {
+			logs.Errorf("[%s] batch delete k8s node pool failed, err: %v, cluster: %s, rid: %s", cluster.Vendor,
+				err, cluster.ID, kt.Rid)
+			return err
+		}
+	}
+
+	for _, batch := range slice.Split(creates, constant.BatchOperationMaxLimit) {
+		req := &protocloud.K8sNodePoolBatchCreateReq{NodePools: batch}
+		if _, err := dataCli.Global.K8sNodePool.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] batch create k8s node pool failed, err: %v, cluster: %s, rid: %s", cluster.Vendor,
+				err, cluster.ID, kt.Rid)
+			return err
+		}
+	}
+
+	for _, batch := range slice.Split(updates, constant.BatchOperationMaxLimit) {
+		req := &protocloud.K8sNodePoolBatchUpdateReq{NodePools: batch}
+		if err := dataCli.Global.K8sNodePool.BatchUpdate(kt, req); err != nil {
+			logs.Errorf("[%s] batch update k8s node pool failed, err: %v, cluster: %s, rid: %s", cluster.Vendor,
+				err, cluster.ID, kt.Rid)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isK8sNodePoolChange(cloud typesk8s.K8sNodePool, db corek8s.K8sNodePool) bool {
+	return cloud.Name != db.Name || cloud.Status != db.Status || cloud.InstanceType != db.InstanceType ||
+		cloud.NodeCount != db.NodeCount || cloud.MinSize != db.MinSize || cloud.MaxSize != db.MaxSize
+}
+
+func syncK8sNodePoolCvmRel(kt *kit.Kit, dataCli *dataclient.Client, cluster corek8s.BaseK8sCluster,
+	nodes []typesk8s.K8sNode, poolFromDB []corek8s.K8sNodePool) error {
+
+	poolIDMap := make(map[string]string, len(poolFromDB))
+	for _, one := range poolFromDB {
+		poolIDMap[one.CloudID] = one.ID
+	}
+
+	cloudCvmIDs := make([]string, 0, len(nodes))
+	for _, one := range nodes {
+		cloudCvmIDs = append(cloudCvmIDs, one.CloudCvmID)
+	}
+	cvmIDMap, err := getK8sNodeCvmIDMap(kt, dataCli, cluster, cloudCvmIDs)
+	if err != nil {
+		return err
+	}
+
+	// cvmID -> 节点池本地ID
+	expectRels := make(map[string]string, len(nodes))
+	for _, one := range nodes {
+		cvmID, exist := cvmIDMap[one.CloudCvmID]
+		if !exist {
+			continue
+		}
+		expectRels[cvmID] = poolIDMap[one.CloudNodePoolID]
+	}
+
+	relFromDB, err := listK8sNodePoolCvmRelFromDB(kt, dataCli, cluster.ID)
+	if err != nil {
+		return err
+	}
+
+	delCvmIDs := make([]string, 0)
+	existCvmIDs := make(map[string]struct{}, len(relFromDB))
+	for _, rel := range relFromDB {
+		poolID, exist := expectRels[rel.CvmID]
+		if exist && poolID == rel.NodePoolID {
+			existCvmIDs[rel.CvmID] = struct{}{}
+			continue
+		}
+		delCvmIDs = append(delCvmIDs, rel.CvmID)
+	}
+
+	creates := make([]protocloud.K8sNodePoolCvmRelCreate, 0)
+	for cvmID, poolID := range expectRels {
+		if _, exist := existCvmIDs[cvmID]; exist {
+			continue
+		}
+		creates = append(creates, protocloud.K8sNodePoolCvmRelCreate{
+			ClusterID:  cluster.ID,
+			NodePoolID: poolID,
+			CvmID:      cvmID,
+		})
+	}
+
+	for _, batch := range slice.Split(delCvmIDs, constant.BatchOperationMaxLimit) {
+		req := &protocloud.K8sNodePoolCvmRelBatchDeleteReq{
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("cluster_id", cluster.ID),
+				tools.RuleIn("cvm_id", batch),
+			),
+		}
+		if err = dataCli.Global.K8sNodePoolCvmRel.BatchDelete(kt, req); err != nil {
+			logs.Errorf("[%s] batch delete k8s node pool cvm rel failed, err: %v, cluster: %s, rid: %s",
+				cluster.Vendor, err, cluster.ID, kt.Rid)
+			return err
+		}
+	}
+
+	for _, batch := range slice.Split(creates, constant.BatchOperationMaxLimit) {
+		req := &protocloud.K8sNodePoolCvmRelBatchCreateReq{Rels: batch}
+		if err = dataCli.Global.K8sNodePoolCvmRel.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] batch create k8s node pool cvm rel failed, err: %v, cluster: %s, rid: %s",
+				cluster.Vendor, err, cluster.ID, kt.Rid)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getK8sNodeCvmIDMap 返回 主机云上ID -> 本地ID 的映射，本地不存在的不返回
+func getK8sNodeCvmIDMap(kt *kit.Kit, dataCli *dataclient.Client, cluster corek8s.BaseK8sCluster,
+	cloudIDs []string) (map[string]string, error) {
+
+	result := make(map[string]string)
+	for _, batch := range slice.Split(slice.Unique(cloudIDs), int(core.DefaultMaxPageLimit)) {
+		req := &core.ListReq{
+			Fields: []string{"id", "cloud_id"},
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("vendor", cluster.Vendor),
+				tools.RuleEqual("account_id", cluster.AccountID),
+				tools.RuleIn("cloud_id", batch),
+			),
+			Page: core.NewDefaultBasePage(),
+		}
+		resp, err := dataCli.Global.Cvm.ListCvm(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] list cvm of k8s node failed, err: %v, cluster: %s, rid: %s", cluster.Vendor, err,
+				cluster.ID, kt.Rid)
+			return nil, err
+		}
+		for _, one := range resp.Details {
+			result[one.CloudID] = one.ID
+		}
+	}
+
+	return result, nil
+}
+
+func listK8sNodePoolFromDB(kt *kit.Kit, dataCli *dataclient.Client, clusterID string) ([]corek8s.K8sNodePool,
+	error) {
+
+	req := &core.ListReq{
+		Filter: tools.EqualExpression("cluster_id", clusterID),
+		Page:   core.NewDefaultBasePage(),
+	}
+	result := make([]corek8s.K8sNodePool, 0)
+	for {
+		resp, err := dataCli.Global.K8sNodePool.List(kt, req)
+		if err != nil {
+			logs.Errorf("list k8s node pool from db failed, err: %v, cluster: %s, rid: %s", err, clusterID, kt.Rid)
+			return nil, err
+		}
+
+		result = append(result, resp.Details...)
+
+		if len(resp.Details) < int(core.DefaultMaxPageLimit) {
+			break
+		}
+
+		req.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return result, nil
+}
+
+func listK8sNodePoolCvmRelFromDB(kt *kit.Kit, dataCli *dataclient.Client, clusterID string) (
+	[]corek8s.K8sNodePoolCvmRel, error) {
+
+	req := &core.ListReq{
+		Filter: tools.EqualExpression("cluster_id", clusterID),
+		Page:   core.NewDefaultBasePage(),
+	}
+	result := make([]corek8s.K8sNodePoolCvmRel, 0)
+	for {
+		resp, err := dataCli.Global.K8sNodePoolCvmRel.List(kt, req)
+		if err != nil {
+			logs.Errorf("list k8s node pool cvm rel from db failed, err: %v, cluster: %s, rid: %s", err,
+				clusterID, kt.Rid)
+			return nil, err
+		}
+
+		result = append(result, resp.Details...)
+
+		if len(resp.Details) < int(core.DefaultMaxPageLimit) {
+			break
+		}
+
+		req.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,81 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package common
+
+import (
+	"hcm/pkg/api/core"
+	dataclient "hcm/pkg/client/data-service"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/slice"
+)
+
+// GetLoadBalancerVpcSubnetMap 获取负载均衡关联的vpc、子网的本地ID，返回 云上ID -> 本地ID 的映射，本地不存在的不返回
+func GetLoadBalancerVpcSubnetMap(kt *kit.Kit, dataCli *dataclient.Client, vendor enumor.Vendor, accountID string,
+	cloudVpcIDs []string, cloudSubnetIDs []string) (map[string]string, map[string]string, error) {
+
+	vpcMap := make(map[string]string)
+	for _, batch := range slice.Split(slice.Unique(cloudVpcIDs), int(core.DefaultMaxPageLimit)) {
+		req := &core.ListReq{
+			Fields: []string{"id", "cloud_id"},
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("vendor", vendor),
+				tools.RuleEqual("account_id", accountID),
+				tools.RuleIn("cloud_id", batch),
+			),
+			Page: core.NewDefaultBasePage(),
+		}
+		result, err := dataCli.Global.Vpc.List(kt.Ctx, kt.Header(), req)
+		if err != nil {
+			logs.Errorf("[%s] list vpc of load balancer failed, err: %v, cloudIDs: %v, rid: %s", vendor, err, batch,
+				kt.Rid)
+			return nil, nil, err
+		}
+		for _, one := range result.Details {
+			vpcMap[one.CloudID] = one.ID
+		}
+	}
+
+	subnetMap := make(map[string]string)
+	for _, batch := range slice.Split(slice.Unique(cloudSubnetIDs), int(core.DefaultMaxPageLimit)) {
+		req := &core.ListReq{
+			Fields: []string{"id", "cloud_id"},
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("vendor", vendor),
+				tools.RuleEqual("account_id", accountID),
+				tools.RuleIn("cloud_id", batch),
+			),
+			Page: core.NewDefaultBasePage(),
+		}
+		result, err := dataCli.Global.Subnet.List(kt.Ctx, kt.Header(), req)
+		if err != nil {
+			logs.Errorf("[%s] list subnet of load balancer failed, err: %v, cloudIDs: %v, rid: %s", vendor, err,
+				batch, kt.Rid)
+			return nil, nil, err
+		}
+		for _, one := range result.Details {
+			subnetMap[one.CloudID] = one.ID
+		}
+	}
+
+	return vpcMap, subnetMap, nil
+}
@@ -0,0 +1,176 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package common
+
+import (
+	"hcm/pkg/api/core"
+	vpcpeering "hcm/pkg/api/core/cloud/vpc-peering"
+	dataclient "hcm/pkg/client/data-service"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/runtime/filter"
+	"hcm/pkg/tools/slice"
+)
+
+// NetworkVpcRel NAT网关、对等连接所属VPC在本地的关联信息
+type NetworkVpcRel struct {
+	VpcID   string
+	BkBizID int64
+}
+
+// GetNetworkVpcRelMap 获取VPC的本地ID及业务，返回 云上ID -> 关联信息 的映射，本地不存在的不返回。
+// accountID 为空时不限制账号，用于查询对等连接的对端VPC
+func GetNetworkVpcRelMap(kt *kit.Kit, dataCli *dataclient.Client, vendor enumor.Vendor, accountID string,
+	cloudVpcIDs []string) (map[string]NetworkVpcRel, error) {
+
+	relMap := make(map[string]NetworkVpcRel)
+	for _, batch := range slice.Split(slice.Unique(cloudVpcIDs), int(core.DefaultMaxPageLimit)) {
+		rules := []*filter.AtomRule{tools.RuleEqual("vendor", vendor), tools.RuleIn("cloud_id", batch)}
+		if len(accountID) != 0 {
+			rules = append(rules, tools.RuleEqual("account_id", accountID))
+		}
+		req := &core.ListReq{
+			Fields: []string{"id", "cloud_id", "bk_biz_id"},
+			Filter: tools.ExpressionAnd(rules...),
+			Page:   core.NewDefaultBasePage(),
+		}
+		result, err := dataCli.Global.Vpc.List(kt.Ctx, kt.Header(), req)
+		if err != nil {
+			logs.Errorf("[%s] list vpc of network resource failed, err: %v, cloudIDs: %v, rid: %s", vendor, err,
+				batch, kt.Rid)
+			return nil, err
+		}
+		for _, one := range result.Details {
+			relMap[one.CloudID] = NetworkVpcRel{VpcID: one.ID, BkBizID: one.BkBizID}
+		}
+	}
+
+	return relMap, nil
+}
+
+// NetworkSubnetRel 子网在本地的关联信息
+type NetworkSubnetRel struct {
+	SubnetID   string
+	VpcID      string
+	CloudVpcID string
+}
+
+// GetNetworkSubnetRelMap 获取子网的本地ID及所属VPC，返回 云上ID -> 关联信息 的映射，本地不存在的不返回
+func GetNetworkSubnetRelMap(kt *kit.Kit, dataCli *dataclient.Client, vendor enumor.Vendor, accountID string,
+	cloudSubnetIDs []string) (map[string]NetworkSubnetRel, error) {
+
+	relMap := make(map[string]NetworkSubnetRel)
+	for _, batch := range slice.Split(slice.Unique(cloudSubnetIDs), int(core.DefaultMaxPageLimit)) {
+		req := &core.ListReq{
+			Fields: []string{"id", "cloud_id", "vpc_id", "cloud_vpc_id"},
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("vendor", vendor),
+				tools.RuleEqual("account_id", accountID),
+				tools.RuleIn("cloud_id", batch),
+			),
+			Page: core.NewDefaultBasePage(),
+		}
+		result, err := dataCli.Global.Subnet.List(kt.Ctx, kt.Header(), req)
+		if err != nil {
+			logs.Errorf("[%s] list subnet of network resource failed, err: %v, cloudIDs: %v, rid: %s", vendor, err,
+				batch, kt.Rid)
+			return nil, err
+		}
+		for _, one := range result.Details {
+			relMap[one.CloudID] = NetworkSubnetRel{SubnetID: one.ID, VpcID: one.VpcID, CloudVpcID: one.CloudVpcID}
+		}
+	}
+
+	return relMap, nil
+}
+
+// RouteNextHop 路由下一跳对应的本地资源
+type RouteNextHop struct {
+	ResType enumor.CloudResourceType
+	ResID   string
+}
+
+// GetRouteNextHopMap 根据路由下一跳的云上ID获取对应的本地NAT网关、对等连接，返回 云上ID -> 本地资源 的映射，
+// 本地不存在的不返回。对等连接可能由对端账号发起，所以不按账号过滤，同时被两端账号同步时优先使用当前账号下的记录
+func GetRouteNextHopMap(kt *kit.Kit, dataCli *dataclient.Client, vendor enumor.Vendor, accountID string,
+	cloudNatIDs []string, cloudPeeringIDs []string) (map[string]RouteNextHop, error) {
+
+	hopMap := make(map[string]RouteNextHop)
+	for _, batch := range slice.Split(slice.Unique(cloudNatIDs), int(core.DefaultMaxPageLimit)) {
+		req := &core.ListReq{
+			Fields: []string{"id", "cloud_id"},
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("vendor", vendor),
+				tools.RuleEqual("account_id", accountID),
+				tools.RuleIn("cloud_id", batch),
+			),
+			Page: core.NewDefaultBasePage(),
+		}
+		result, err := dataCli.Global.NatGateway.List(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] list nat gateway of route failed, err: %v, cloudIDs: %v, rid: %s", vendor, err, batch,
+				kt.Rid)
+			return nil, err
+		}
+		for _, one := range result.Details {
+			hopMap[one.CloudID] = RouteNextHop{ResType: enumor.NatGatewayCloudResType, ResID: one.ID}
+		}
+	}
+
+	for _, batch := range slice.Split(slice.Unique(cloudPeeringIDs), int(core.DefaultMaxPageLimit)) {
+		req := &core.ListReq{
+			Fields: []string{"id", "cloud_id", "account_id"},
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("vendor", vendor),
+				tools.RuleIn("cloud_id", batch),
+			),
+			Page: core.NewDefaultBasePage(),
+		}
+		result, err := dataCli.Global.VpcPeering.List(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] list vpc peering of route failed, err: %v, cloudIDs: %v, rid: %s", vendor, err,
+				batch, kt.Rid)
+			return nil, err
+		}
+		for cloudID, hop := range peeringNextHopMap(accountID, result.Details) {
+			hopMap[cloudID] = hop
+		}
+	}
+
+	return hopMap, nil
+}
+
+// peeringNextHopMap 返回 对等连接云上ID -> 本地资源 的映射，同一对等连接存在多条本地记录时优先使用当前账号下的记录
+func peeringNextHopMap(accountID string, peerings []vpcpeering.BaseVpcPeering) map[string]RouteNextHop {
+	hopMap := make(map[string]RouteNextHop, len(peerings))
+	ownedMap := make(map[string]bool, len(peerings))
+	for _, one := range peerings {
+		owned := one.AccountID == accountID
+		if _, exists := hopMap[one.CloudID]; exists && (ownedMap[one.CloudID] || !owned) {
+			continue
+		}
+		hopMap[one.CloudID] = RouteNextHop{ResType: enumor.VpcPeeringCloudResType, ResID: one.ID}
+		ownedMap[one.CloudID] = owned
+	}
+
+	return hopMap
+}
@@ -0,0 +1,87 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package common
+
+import (
+	"reflect"
+	"testing"
+
+	vpcpeering "hcm/pkg/api/core/cloud/vpc-peering"
+	"hcm/pkg/criteria/enumor"
+)
+
+func TestPeeringNextHopMap(t *testing.T) {
+	cases := []struct {
+		name     string
+		peerings []vpcpeering.BaseVpcPeering
+		expected map[string]RouteNextHop
+	}{
+		{
+			name:     "no peering",
+			peerings: nil,
+			expected: map[string]RouteNextHop{},
+		},
+		{
+			name: "peering of current account",
+			peerings: []vpcpeering.BaseVpcPeering{
+				{ID: "p1", CloudID: "pcx-1", AccountID: "acc-1"},
+			},
+			expected: map[string]RouteNextHop{
+				"pcx-1": {ResType: enumor.VpcPeeringCloudResType, ResID: "p1"},
+			},
+		},
+		{
+			name: "peering only synced by peer account",
+			peerings: []vpcpeering.BaseVpcPeering{
+				{ID: "p2", CloudID: "pcx-2", AccountID: "acc-2"},
+			},
+			expected: map[string]RouteNextHop{
+				"pcx-2": {ResType: enumor.VpcPeeringCloudResType, ResID: "p2"},
+			},
+		},
+		{
+			name: "peering synced by both accounts, peer account first",
+			peerings: []vpcpeering.BaseVpcPeering{
+				{ID: "p3-peer", CloudID: "pcx-3", AccountID: "acc-2"},
+				{ID: "p3", CloudID: "pcx-3", AccountID: "acc-1"},
+			},
+			expected: map[string]RouteNextHop{
+				"pcx-3": {ResType: enumor.VpcPeeringCloudResType, ResID: "p3"},
+			},
+		},
+		{
+			name: "peering synced by both accounts, current account first",
+			peerings: []vpcpeering.BaseVpcPeering{
+				{ID: "p4", CloudID: "pcx-4", AccountID: "acc-1"},
+				{ID: "p4-peer", CloudID: "pcx-4", AccountID: "acc-2"},
+			},
+			expected: map[string]RouteNextHop{
+				"pcx-4": {ResType: enumor.VpcPeeringCloudResType, ResID: "p4"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		got := peeringNextHopMap("acc-1", c.peerings)
+		if !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%s: expected %+v, got %+v", c.name, c.expected, got)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package common
+
+import (
+	typesdns "hcm/pkg/adaptor/types/private-dns"
+	"hcm/pkg/api/core"
+	coreprivatedns "hcm/pkg/api/core/cloud/private-dns"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	dataclient "hcm/pkg/client/data-service"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/runtime/filter"
+	"hcm/pkg/tools/assert"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// PrivateDnsZoneRes 私有域下需要同步的云上资源
+type PrivateDnsZoneRes struct {
+	Records []typesdns.Record
+	// CloudVpcIDs 私有域关联的VPC云上ID，gcp 为VPC的 self link
+	CloudVpcIDs []string
+}
+
+// SyncPrivateDnsZoneRes 同步私有域下的解析记录，以及私有域和VPC的关联关系。关联的VPC在本地不存在时跳过，
+// 待VPC同步后再补齐
+func SyncPrivateDnsZoneRes(kt *kit.Kit, dataCli *dataclient.Client, zone coreprivatedns.BaseZone,
+	res *PrivateDnsZoneRes) error {
+
+	if err := syncPrivateDnsRecord(kt, dataCli, zone, res.Records); err != nil {
+		return err
+	}
+
+	return syncPrivateDnsZoneVpcRel(kt, dataCli, zone, res.CloudVpcIDs)
+}
+
+func syncPrivateDnsRecord(kt *kit.Kit, dataCli *dataclient.Client, zone coreprivatedns.BaseZone,
+	records []typesdns.Record) error {
+
+	recordFromDB, err := listPrivateDnsRecordFromDB(kt, dataCli, zone.ID)
+	if err != nil {
+		return err
+	}
+
+	cloudMap := make(map[string]typesdns.Record, len(records))
+	for _, one := range records {
+		cloudMap[one.CloudID] = one
+	}
+
+	delIDs := make([]string, 0)
+	updates := make([]protocloud.PrivateDnsRecordUpdateReq, 0)
+	dbCloudIDs := make(map[string]struct{}, len(recordFromDB))
+	for _, db := range recordFromDB {
+		one, exist := cloudMap[db.CloudID]
+		// 主机记录或类型变化时重新创建记录
+		if !exist || one.Name != db.Name || one.Type != db.Type {
+			delIDs = append(delIDs, db.ID)
+			continue
+		}
+
+		dbCloudIDs[db.CloudID] = struct{}{}
+		if len(one.Value) == len(db.Value) && assert.IsStringSliceEqual(one.Value, db.Value) && one.TTL == db.TTL {
+			continue
+		}
+		updates = append(updates, protocloud.PrivateDnsRecordUpdateReq{ID: db.ID, Value: one.Value, TTL: one.TTL})
+	}
+
+	creates := make([]protocloud.PrivateDnsRecordCreate, 0)
+	for _, one := range records {
+		if _, exist := dbCloudIDs[one.CloudID]; exist {
+			continue
+		}
+		creates = append(creates, protocloud.PrivateDnsRecordCreate{
+			Vendor:      zone.Vendor,
+			AccountID:   zone.AccountID,
+			ZoneID:      zone.ID,
+			CloudZoneID: zone.CloudID,
+			CloudID:     one.CloudID,
+			Name:        one.Name,
+			Type:        one.Type,
+			Value:       one.Value,
+			TTL:         one.TTL,
+		})
+	}
+
+	for _, batch := range slice.Split(delIDs, constant.BatchOperationMaxLimit) {
+		req := &protocloud.PrivateDnsRecordBatchDeleteReq{Filter: tools.ContainersExpression("id", batch)}
+		if err = dataCli.Global.PrivateDnsRecord.BatchDelete(kt, req); err != nil {
+			logs.Errorf("[%s] batch delete private dns record failed, err: %v, zone: %s, rid: %s", zone.Vendor, err,
+				zone.ID, kt.Rid)
+			return err
+		}
+	}
+
+	for _, batch := range slice.Split(creates, constant.BatchOperationMaxLimit) {
+		req := &protocloud.PrivateDnsRecordBatchCreateReq{Records: batch}
+		if _, err = dataCli.Global.PrivateDnsRecord.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] batch create private dns record failed, err: %v, zone: %s, rid: %s", zone.Vendor, err,
+				zone.ID, kt.Rid)
+			return err
+		}
+	}
+
+	for _, batch := range slice.Split(updates, constant.BatchOperationMaxLimit) {
+		req := &protocloud.PrivateDnsRecordBatchUpdateReq{Records: batch}
+		if err = dataCli.Global.PrivateDnsRecord.BatchUpdate(kt, req); err != nil {
+			logs.Errorf("[%s] batch update private dns record failed, err: %v, zone: %s, rid: %s", zone.Vendor, err,
+				zone.ID, kt.Rid)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func syncPrivateDnsZoneVpcRel(kt *kit.Kit, dataCli *dataclient.Client, zone coreprivatedns.BaseZone,
+	cloudVpcIDs []string) error {
+
+	vpcIDs, err := getPrivateDnsZoneVpcIDs(kt, dataCli, zone, cloudVpcIDs)
+	if err != nil {
+		return err
+	}
+
+	relFromDB, err := listPrivateDnsZoneVpcRelFromDB(kt, dataCli, zone.ID)
+	if err != nil {
+		return err
+	}
+
+	expectVpcIDs := converter.StringSliceToMap(vpcIDs)
+	delVpcIDs := make([]string, 0)
+	existVpcIDs := make(map[string]struct{}, len(relFromDB))
+	for _, rel := range relFromDB {
+		if _, exist := expectVpcIDs[rel.VpcID]; !exist {
+			delVpcIDs = append(delVpcIDs, rel.VpcID)
+			continue
+		}
+		existVpcIDs[rel.VpcID] = struct{}{}
+	}
+
+	creates := make([]protocloud.PrivateDnsZoneVpcRelCreate, 0)
+	for _, vpcID := range vpcIDs {
+		if _, exist := existVpcIDs[vpcID]; exist {
+			continue
+		}
+		creates = append(creates, protocloud.PrivateDnsZoneVpcRelCreate{ZoneID: zone.ID, VpcID: vpcID})
+	}
+
+	for _, batch := range slice.Split(delVpcIDs, constant.BatchOperationMaxLimit) {
+		req := &protocloud.PrivateDnsZoneVpcRelBatchDeleteReq{
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("zone_id", zone.ID),
+				tools.RuleIn("vpc_id", batch),
+			),
+		}
+		if err = dataCli.Global.PrivateDnsZoneVpcRel.BatchDelete(kt, req); err != nil {
+			logs.Errorf("[%s] batch delete private dns zone vpc rel failed, err: %v, zone: %s, rid: %s",
+				zone.Vendor, err, zone.ID, kt.Rid)
+			return err
+		}
+	}
+
+	for _, batch := range slice.Split(creates, constant.BatchOperationMaxLimit) {
+		req := &protocloud.PrivateDnsZoneVpcRelBatchCreateReq{Rels: batch}
+		if err = dataCli.Global.PrivateDnsZoneVpcRel.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] batch create private dns zone vpc rel failed, err: %v, zone: %s, rid: %s",
+				zone.Vendor, err, zone.ID, kt.Rid)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getPrivateDnsZoneVpcIDs 返回私有域关联的VPC本地ID，gcp 通过 self link 匹配VPC，本地不存在的不返回
+func getPrivateDnsZoneVpcIDs(kt *kit.Kit, dataCli *dataclient.Client, zone coreprivatedns.BaseZone,
+	cloudVpcIDs []string) ([]string, error) {
+
+	result := make([]string, 0)
+	for _, batch := range slice.Split(slice.Unique(cloudVpcIDs), int(core.DefaultMaxPageLimit)) {
+		vpcRule := tools.RuleIn("cloud_id", batch)
+		if zone.Vendor == enumor.Gcp {
+			vpcRule = &filter.AtomRule{Field: "extension.self_link", Op: filter.JSONIn.Factory(), Value: batch}
+		}
+
+		req := &core.ListReq{
+			Fields: []string{"id"},
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("vendor", zone.Vendor),
+				tools.RuleEqual("account_id", zone.AccountID),
+				vpcRule,
+			),
+			Page: core.NewDefaultBasePage(),
+		}
+		resp, err := dataCli.Global.Vpc.List(kt.Ctx, kt.Header(), req)
+		if err != nil {
+			logs.Errorf("[%s] list vpc of private dns zone failed, err: %v, zone: %s, rid: %s", zone.Vendor, err,
+				zone.ID, kt.Rid)
+			return nil, err
+		}
+		for _, one := range resp.Details {
+			result = append(result, one.ID)
+		}
+	}
+
+	return result, nil
+}
+
+func listPrivateDnsRecordFromDB(kt *kit.Kit, dataCli *dataclient.Client, zoneID string) (
+	[]coreprivatedns.Record, error) {
+
+	req := &core.ListReq{
+		Filter: tools.EqualExpression("zone_id", zoneID),
+		Page:   core.NewDefaultBasePage(),
+	}
+	result := make([]coreprivatedns.Record, 0)
+	for {
+		resp, err := dataCli.Global.PrivateDnsRecord.List(kt, req)
+		if err != nil {
+			logs.Errorf("list private dns record from db failed, err: %v, zone: %s, rid: %s", err, zoneID, kt.Rid)
+			return nil, err
+		}
+
+		result = append(result, resp.Details...)
+
+		if len(resp.Details) < int(core.DefaultMaxPageLimit) {
+			break
+		}
+
+		req.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return result, nil
+}
+
+func listPrivateDnsZoneVpcRelFromDB(kt *kit.Kit, dataCli *dataclient.Client, zoneID string) (
+	[]coreprivatedns.ZoneVpcRel, error) {
+
+	req := &core.ListReq{
+		Filter: tools.EqualExpression("zone_id", zoneID),
+		Page:   core.NewDefaultBasePage(),
+	}
+	result := make([]coreprivatedns.ZoneVpcRel, 0)
+	for {
+		resp, err := dataCli.Global.PrivateDnsZoneVpcRel.List(kt, req)
+		if err != nil {
+			logs.Errorf("list private dns zone vpc rel from db failed, err: %v, zone: %s, rid: %s", err, zoneID,
+				kt.Rid)
+			return nil, err
+		}
+
+		result = append(result, resp.Details...)
+
+		if len(resp.Details) < int(core.DefaultMaxPageLimit) {
+			break
+		}
+
+		req.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,123 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package common
+
+import (
+	"hcm/pkg/api/core"
+	dataclient "hcm/pkg/client/data-service"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/slice"
+)
+
+// SnapshotDiskRel 快照来源云盘在本地的关联信息
+type SnapshotDiskRel struct {
+	DiskID     string
+	BkBizID    int64
+	Zone       string
+	CvmID      string
+	CloudCvmID string
+}
+
+// GetSnapshotDiskRelMap 根据快照来源云盘的云上ID获取本地云盘及其挂载主机信息，返回 云盘云上ID -> 关联信息 的映射，
+// 本地不存在的云盘不返回
+func GetSnapshotDiskRelMap(kt *kit.Kit, dataCli *dataclient.Client, vendor enumor.Vendor, accountID string,
+	cloudDiskIDs []string) (map[string]SnapshotDiskRel, error) {
+
+	relMap := make(map[string]SnapshotDiskRel)
+	diskCloudIDMap := make(map[string]string)
+	for _, batch := range slice.Split(slice.Unique(cloudDiskIDs), int(core.DefaultMaxPageLimit)) {
+		req := &core.ListReq{
+			Fields: []string{"id", "cloud_id", "bk_biz_id", "zone"},
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("vendor", vendor),
+				tools.RuleEqual("account_id", accountID),
+				tools.RuleIn("cloud_id", batch),
+			),
+			Page: core.NewDefaultBasePage(),
+		}
+		result, err := dataCli.Global.ListDisk(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] list disk of snapshot failed, err: %v, cloudIDs: %v, rid: %s", vendor, err, batch,
+				kt.Rid)
+			return nil, err
+		}
+		for _, one := range result.Details {
+			relMap[one.CloudID] = SnapshotDiskRel{DiskID: one.ID, BkBizID: one.BkBizID, Zone: one.Zone}
+			diskCloudIDMap[one.ID] = one.CloudID
+		}
+	}
+
+	if len(diskCloudIDMap) == 0 {
+		return relMap, nil
+	}
+
+	diskIDs := make([]string, 0, len(diskCloudIDMap))
+	for id := range diskCloudIDMap {
+		diskIDs = append(diskIDs, id)
+	}
+	cvmDiskMap := make(map[string][]string)
+	for _, batch := range slice.Split(diskIDs, int(core.DefaultMaxPageLimit)) {
+		req := &core.ListReq{
+			Fields: []string{"disk_id", "cvm_id"},
+			Filter: tools.ContainersExpression("disk_id", batch),
+			Page:   core.NewDefaultBasePage(),
+		}
+		result, err := dataCli.Global.ListDiskCvmRel(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] list disk cvm rel of snapshot failed, err: %v, diskIDs: %v, rid: %s", vendor, err,
+				batch, kt.Rid)
+			return nil, err
+		}
+		for _, one := range result.Details {
+			cvmDiskMap[one.CvmID] = append(cvmDiskMap[one.CvmID], one.DiskID)
+		}
+	}
+
+	cvmIDs := make([]string, 0, len(cvmDiskMap))
+	for id := range cvmDiskMap {
+		cvmIDs = append(cvmIDs, id)
+	}
+	for _, batch := range slice.Split(cvmIDs, int(core.DefaultMaxPageLimit)) {
+		req := &core.ListReq{
+			Fields: []string{"id", "cloud_id"},
+			Filter: tools.ContainersExpression("id", batch),
+			Page:   core.NewDefaultBasePage(),
+		}
+		result, err := dataCli.Global.Cvm.ListCvm(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] list cvm of snapshot failed, err: %v, ids: %v, rid: %s", vendor, err, batch, kt.Rid)
+			return nil, err
+		}
+		for _, cvm := range result.Details {
+			for _, diskID := range cvmDiskMap[cvm.ID] {
+				cloudDiskID := diskCloudIDMap[diskID]
+				rel := relMap[cloudDiskID]
+				rel.CvmID = cvm.ID
+				rel.CloudCvmID = cvm.CloudID
+				relMap[cloudDiskID] = rel
+			}
+		}
+	}
+
+	return relMap, nil
+}
@@ -0,0 +1,143 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package dryrun 资源同步的 dry-run 模式，拦截同步过程中对 data-service 的写操作并生成同步漂移报告。
+package dryrun
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	coresync "hcm/pkg/api/core/cloud/sync"
+	dssync "hcm/pkg/api/data-service/cloud/sync"
+	dataservice "hcm/pkg/client/data-service"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+)
+
+type recorderKey struct{}
+
+// Recorder 记录一次 dry-run 同步中被拦截的 data-service 写操作。
+type Recorder struct {
+	// dataCli 未被拦截的 data-service 客户端，用于保存同步漂移报告
+	dataCli   *dataservice.Client
+	vendor    enumor.Vendor
+	accountID string
+	region    string
+
+	lock    sync.Mutex
+	changes []coresync.DriftChange
+	// dbRecords 同步过程中从 data-service 查询到的资源，按资源、ID索引，用于对比更新操作的字段级差异
+	dbRecords map[string]map[string]map[string]json.RawMessage
+}
+
+// NewRecorder new dry-run recorder.
+func NewRecorder(dataCli *dataservice.Client, vendor enumor.Vendor, accountID, region string) *Recorder {
+	return &Recorder{
+		dataCli:   dataCli,
+		vendor:    vendor,
+		accountID: accountID,
+		region:    region,
+		changes:   make([]coresync.DriftChange, 0),
+		dbRecords: make(map[string]map[string]map[string]json.RawMessage),
+	}
+}
+
+// WithRecorder 将记录器设置到 kit 上下文中，之后使用该 kit 构建的同步客户端对 data-service 的写操作都会被拦截。
+func WithRecorder(kt *kit.Kit, rec *Recorder) {
+	kt.Ctx = context.WithValue(kt.Ctx, recorderKey{}, rec)
+}
+
+// FromKit 获取 kit 上下文中的记录器，不存在说明不是 dry-run 同步。
+func FromKit(kt *kit.Kit) (*Recorder, bool) {
+	if kt == nil || kt.Ctx == nil {
+		return nil, false
+	}
+
+	rec, ok := kt.Ctx.Value(recorderKey{}).(*Recorder)
+	return rec, ok
+}
+
+func (r *Recorder) record(change coresync.DriftChange) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.changes = append(r.changes, change)
+}
+
+// Report 生成并保存同步漂移报告，已记录的写操作会被清空，同一请求中的多次同步分别生成报告。
+func (r *Recorder) Report(kt *kit.Kit, resType enumor.CloudResourceType) (*coresync.SyncDriftReport, error) {
+	r.lock.Lock()
+	changes := r.changes
+	r.changes = make([]coresync.DriftChange, 0)
+	r.lock.Unlock()
+
+	report := &coresync.SyncDriftReport{
+		Vendor:    r.vendor,
+		AccountID: r.accountID,
+		Region:    r.region,
+		ResType:   resType,
+		Summary:   summarize(changes),
+		Changes:   changes,
+		Creator:   kt.User,
+	}
+
+	req := &dssync.CreateDriftReportReq{
+		Items: []dssync.CreateDriftReportField{{
+			Vendor:    report.Vendor,
+			AccountID: report.AccountID,
+			Region:    report.Region,
+			ResType:   report.ResType,
+			Summary:   report.Summary,
+			Changes:   report.Changes,
+		}},
+	}
+	result, err := r.dataCli.Global.SyncDriftReport.BatchCreate(kt, req)
+	if err != nil {
+		logs.Errorf("create sync drift report failed, err: %v, account: %s, res: %s, rid: %s", err, r.accountID,
+			resType, kt.Rid)
+		return nil, err
+	}
+
+	if len(result.IDs) != 0 {
+		report.ID = result.IDs[0]
+	}
+
+	return report, nil
+}
+
+// summarize 按资源和操作类型统计发生漂移的资源数量，一次批量写操作按其涉及的资源数计数，未解析出资源的写操作计为1。
+func summarize(changes []coresync.DriftChange) coresync.DriftSummary {
+	summary := make(coresync.DriftSummary)
+	for _, one := range changes {
+		if _, exist := summary[one.Resource]; !exist {
+			summary[one.Resource] = make(map[coresync.DriftAction]uint64)
+		}
+
+		count := uint64(len(one.Items))
+		if count == 0 {
+			count = 1
+		}
+		summary[one.Resource][one.Action] += count
+	}
+
+	return summary
+}
@@ -0,0 +1,55 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dryrun
+
+import (
+	"testing"
+
+	coresync "hcm/pkg/api/core/cloud/sync"
+)
+
+func TestSummarize(t *testing.T) {
+	items := func(n int) []coresync.DriftItem {
+		return make([]coresync.DriftItem, n)
+	}
+	changes := []coresync.DriftChange{
+		{Resource: "vpcs", Action: coresync.DriftDelete, Items: items(100)},
+		{Resource: "vpcs", Action: coresync.DriftDelete, Items: items(2)},
+		{Resource: "vpcs", Action: coresync.DriftUpdate, Items: items(3)},
+		{Resource: "disk_cvm_rels", Action: coresync.DriftCreate},
+	}
+
+	summary := summarize(changes)
+	cases := []struct {
+		resource string
+		action   coresync.DriftAction
+		want     uint64
+	}{
+		{"vpcs", coresync.DriftDelete, 102},
+		{"vpcs", coresync.DriftUpdate, 3},
+		{"vpcs", coresync.DriftCreate, 0},
+		{"disk_cvm_rels", coresync.DriftCreate, 1},
+	}
+	for _, c := range cases {
+		if got := summary[c.resource][c.action]; got != c.want {
+			t.Errorf("%s %s count should be %d, but got %d", c.resource, c.action, c.want, got)
+		}
+	}
+}
@@ -0,0 +1,397 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dryrun
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	coresync "hcm/pkg/api/core/cloud/sync"
+	"hcm/pkg/rest/client"
+)
+
+// readRoute data-service 查询接口的路由规则
+type readRoute struct {
+	method string
+	path   *regexp.Regexp
+}
+
+// readRoutes data-service 查询接口白名单，不在白名单中的请求都视为写操作，会被拦截不发送到 data-service。
+var readRoutes = []readRoute{
+	{method: http.MethodGet, path: regexp.MustCompile(`.*`)},
+	{method: http.MethodHead, path: regexp.MustCompile(`.*`)},
+	{method: http.MethodPost, path: regexp.MustCompile(`/(list|list_with_extension|list/all|count)$`)},
+	{method: http.MethodPost, path: regexp.MustCompile(`/cloud/resources/basics/[^/]+/id/[^/]+$`)},
+}
+
+// WrapHTTPClient 包装 http 客户端，查询请求正常发送，写请求只记录到记录器中，不发送到 data-service。
+func (r *Recorder) WrapHTTPClient(cli client.HTTPClient) client.HTTPClient {
+	return &httpClient{
+		rec:    r,
+		client: cli,
+	}
+}
+
+type httpClient struct {
+	rec    *Recorder
+	client client.HTTPClient
+}
+
+// Do ...
+func (c *httpClient) Do(req *http.Request) (*http.Response, error) {
+	if isReadRequest(req) {
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = c.rec.cacheDBRecords(parseResource(req.URL.Path), resp); err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+	}
+
+	change := coresync.DriftChange{
+		Resource: parseResource(req.URL.Path),
+		Action:   parseAction(req.Method, req.URL.Path),
+		Method:   req.Method,
+		Path:     req.URL.Path,
+	}
+	change.Items = c.rec.parseDriftItems(change.Resource, change.Action, body)
+	c.rec.record(change)
+
+	return &http.Response{
+		Status:     http.StatusText(http.StatusOK),
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(buildDryRunResp(change))),
+		Request:    req,
+	}, nil
+}
+
+// isReadRequest 判断请求是否命中 data-service 查询接口白名单。
+func isReadRequest(req *http.Request) bool {
+	for _, route := range readRoutes {
+		if req.Method == route.method && route.path.MatchString(req.URL.Path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// buildDryRunResp 构造被拦截的写操作的响应。新增操作按照 data-service 创建接口的响应格式返回模拟的资源ID，
+// 同步逻辑会使用创建结果中的ID继续创建关联关系，其他写操作返回的 data 为空。
+func buildDryRunResp(change coresync.DriftChange) []byte {
+	resp := map[string]interface{}{"code": 0, "message": "", "data": nil}
+	if change.Action == coresync.DriftCreate {
+		ids := make([]string, 0, len(change.Items))
+		for idx := range change.Items {
+			ids = append(ids, fmt.Sprintf("dry-run-%s-%d", change.Resource, idx+1))
+		}
+
+		data := map[string]interface{}{"ids": ids}
+		if len(ids) != 0 {
+			data["id"] = ids[0]
+		}
+		resp["data"] = data
+	}
+
+	result, _ := json.Marshal(resp)
+	return result
+}
+
+// listResp data-service 查询接口的响应
+type listResp struct {
+	Data *struct {
+		Details []map[string]json.RawMessage `json:"details"`
+	} `json:"data"`
+}
+
+// cacheDBRecords 缓存同步过程中从 data-service 查询到的资源，用于对比更新操作中字段的变更。
+func (r *Recorder) cacheDBRecords(resource string, resp *http.Response) error {
+	if resp.Body == nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	result := new(listResp)
+	if err = json.Unmarshal(body, result); err != nil || result.Data == nil {
+		// 非列表结构的查询结果不需要缓存
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, exist := r.dbRecords[resource]; !exist {
+		r.dbRecords[resource] = make(map[string]map[string]json.RawMessage)
+	}
+	for _, one := range result.Data.Details {
+		if id := rawString(one["id"]); len(id) != 0 {
+			r.dbRecords[resource][id] = one
+		}
+	}
+
+	return nil
+}
+
+// parseDriftItems 解析写操作涉及的资源，更新操作与缓存的db数据对比出字段级差异。
+func (r *Recorder) parseDriftItems(resource string, action coresync.DriftAction, body []byte) []coresync.DriftItem {
+	if action == coresync.DriftDelete {
+		return parseDeleteItems(body)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	objects := findObjectArray(body)
+	items := make([]coresync.DriftItem, 0, len(objects))
+	for _, one := range objects {
+		item := coresync.DriftItem{
+			ID:      rawString(one["id"]),
+			CloudID: rawString(one["cloud_id"]),
+		}
+
+		switch {
+		case action == coresync.DriftUpdate && len(item.ID) != 0:
+			old := r.dbRecords[resource][item.ID]
+			if len(item.CloudID) == 0 {
+				item.CloudID = rawString(old["cloud_id"])
+			}
+			item.Fields = diffFields("", old, one)
+		case len(item.ID) == 0 && len(item.CloudID) == 0:
+			// 关联关系等没有ID的资源，记录全部字段
+			item.Fields = diffFields("", nil, one)
+		}
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// diffFields 对比资源字段，返回值发生变化的字段，extension 等对象字段展开对比其中的字段。
+func diffFields(prefix string, old, cur map[string]json.RawMessage) []coresync.DriftField {
+	keys := make([]string, 0, len(cur))
+	for key := range cur {
+		if len(prefix) == 0 && key == "id" {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fields := make([]coresync.DriftField, 0)
+	for _, key := range keys {
+		oldObj, oldIsObj := rawObject(old[key])
+		curObj, curIsObj := rawObject(cur[key])
+		if oldIsObj && curIsObj {
+			fields = append(fields, diffFields(prefix+key+".", oldObj, curObj)...)
+			continue
+		}
+
+		if rawEqual(old[key], cur[key]) {
+			continue
+		}
+		fields = append(fields, coresync.DriftField{Field: prefix + key, Old: rawOrNull(old[key]), New: cur[key]})
+	}
+
+	return fields
+}
+
+// parseDeleteItems 解析删除操作的过滤条件中指定的资源ID和云ID。
+func parseDeleteItems(body []byte) []coresync.DriftItem {
+	req := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(body, &req); err != nil {
+		return make([]coresync.DriftItem, 0)
+	}
+
+	items := make([]coresync.DriftItem, 0)
+	for _, id := range rawStrings(req["ids"]) {
+		items = append(items, coresync.DriftItem{ID: id})
+	}
+
+	var walk func(raw json.RawMessage)
+	walk = func(raw json.RawMessage) {
+		rule := struct {
+			Field string            `json:"field"`
+			Value json.RawMessage   `json:"value"`
+			Rules []json.RawMessage `json:"rules"`
+		}{}
+		if err := json.Unmarshal(raw, &rule); err != nil {
+			return
+		}
+
+		for _, one := range rule.Rules {
+			walk(one)
+		}
+
+		switch rule.Field {
+		case "id":
+			for _, id := range rawStrings(rule.Value) {
+				items = append(items, coresync.DriftItem{ID: id})
+			}
+		case "cloud_id":
+			for _, cloudID := range rawStrings(rule.Value) {
+				items = append(items, coresync.DriftItem{CloudID: cloudID})
+			}
+		}
+	}
+	if filter, exist := req["filter"]; exist {
+		walk(filter)
+	}
+
+	return items
+}
+
+// findObjectArray 查找请求体中的资源数组，data-service 批量写接口的请求体为 {"xxx": [{...}, {...}]}。
+func findObjectArray(body []byte) []map[string]json.RawMessage {
+	req := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(req))
+	for key := range req {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		objects := make([]map[string]json.RawMessage, 0)
+		if err := json.Unmarshal(req[key], &objects); err == nil && len(objects) != 0 {
+			return objects
+		}
+	}
+
+	// 单个资源的写操作请求体即为资源本身
+	return []map[string]json.RawMessage{req}
+}
+
+func rawString(raw json.RawMessage) string {
+	var val string
+	if err := json.Unmarshal(raw, &val); err != nil {
+		return ""
+	}
+	return val
+}
+
+func rawStrings(raw json.RawMessage) []string {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	vals := make([]string, 0)
+	if err := json.Unmarshal(raw, &vals); err == nil {
+		return vals
+	}
+
+	if val := rawString(raw); len(val) != 0 {
+		return []string{val}
+	}
+	return nil
+}
+
+func rawObject(raw json.RawMessage) (map[string]json.RawMessage, bool) {
+	if len(raw) == 0 {
+		return nil, false
+	}
+
+	obj := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return nil, false
+	}
+	return obj, true
+}
+
+func rawEqual(a, b json.RawMessage) bool {
+	var va, vb interface{}
+	if len(a) != 0 {
+		if err := json.Unmarshal(a, &va); err != nil {
+			return false
+		}
+	}
+	if len(b) != 0 {
+		if err := json.Unmarshal(b, &vb); err != nil {
+			return false
+		}
+	}
+
+	return reflect.DeepEqual(va, vb)
+}
+
+func rawOrNull(raw json.RawMessage) json.RawMessage {
+	if len(raw) == 0 {
+		return json.RawMessage("null")
+	}
+	return raw
+}
+
+// parseResource 解析 data-service 接口路径对应的资源，如 /api/v1/data/vendors/tcloud/vpcs/batch/create 为 vpcs。
+func parseResource(path string) string {
+	if idx := strings.Index(path, "/data/"); idx != -1 {
+		path = path[idx+len("/data/"):]
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) > 2 && segments[0] == "vendors" {
+		segments = segments[2:]
+	}
+
+	return segments[0]
+}
+
+func parseAction(method, path string) coresync.DriftAction {
+	switch method {
+	case http.MethodDelete:
+		return coresync.DriftDelete
+	case http.MethodPatch, http.MethodPut:
+		return coresync.DriftUpdate
+	}
+
+	last := path[strings.LastIndex(path, "/")+1:]
+	if strings.Contains(last, "update") || strings.Contains(last, "upsert") {
+		return coresync.DriftUpdate
+	}
+
+	return coresync.DriftCreate
+}
@@ -0,0 +1,139 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dryrun
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	coresync "hcm/pkg/api/core/cloud/sync"
+	"hcm/pkg/criteria/enumor"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeHTTPClient struct {
+	paths []string
+	resp  string
+}
+
+func (c *fakeHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	c.paths = append(c.paths, req.URL.Path)
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte(c.resp)))}, nil
+}
+
+func doRequest(t *testing.T, cli interface {
+	Do(req *http.Request) (*http.Response, error)
+}, method, path, body string) []byte {
+	req, err := http.NewRequest(method, "http://127.0.0.1"+path, bytes.NewReader([]byte(body)))
+	assert.NoError(t, err)
+
+	resp, err := cli.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	data, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	return data
+}
+
+func TestIsReadRequest(t *testing.T) {
+	cases := []struct {
+		method string
+		path   string
+		want   bool
+	}{
+		{http.MethodGet, "/api/v1/data/vendors/tcloud/vpcs/00000001", true},
+		{http.MethodPost, "/api/v1/data/vendors/tcloud/vpcs/list", true},
+		{http.MethodPost, "/api/v1/data/load_balancers/list_with_extension", true},
+		{http.MethodPost, "/api/v1/data/vendors/tcloud/route_tables/routes/list/all", true},
+		{http.MethodPost, "/api/v1/data/disks/count", true},
+		{http.MethodPost, "/api/v1/data/cloud/resources/basics/vpc/id/00000001", true},
+		{http.MethodPost, "/api/v1/data/cloud/resources/basics/list", true},
+		{http.MethodPost, "/api/v1/data/vendors/tcloud/vpcs/batch/create", false},
+		{http.MethodPost, "/api/v1/data/security_group_common_rels/batch/upsert", false},
+		// 名称中包含 list 的写接口不能被当作查询请求
+		{http.MethodPost, "/api/v1/data/vendors/tcloud/listeners/batch/create", false},
+		{http.MethodPost, "/api/v1/data/account_sync_details/batch/create", false},
+		{http.MethodPatch, "/api/v1/data/vendors/tcloud/vpcs/list", false},
+		{http.MethodDelete, "/api/v1/data/vpcs/batch", false},
+	}
+
+	for _, c := range cases {
+		req, err := http.NewRequest(c.method, "http://127.0.0.1"+c.path, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, c.want, isReadRequest(req), "%s %s", c.method, c.path)
+	}
+}
+
+func TestHTTPClientInterceptWrite(t *testing.T) {
+	fake := &fakeHTTPClient{resp: `{"code":0,"data":{"details":[{"id":"1","cloud_id":"vpc-1","name":"old",` +
+		`"extension":{"cidr":"10.0.0.0/16","is_default":false}}]}}`}
+	rec := NewRecorder(nil, enumor.TCloud, "account", "ap-guangzhou")
+	cli := rec.WrapHTTPClient(fake)
+
+	doRequest(t, cli, http.MethodPost, "/api/v1/data/vendors/tcloud/vpcs/list", `{}`)
+	created := doRequest(t, cli, http.MethodPost, "/api/v1/data/vendors/tcloud/vpcs/batch/create",
+		`{"vpcs":[{"cloud_id":"vpc-2","name":"a"},{"cloud_id":"vpc-3","name":"b"}]}`)
+	updated := doRequest(t, cli, http.MethodPatch, "/api/v1/data/vendors/tcloud/vpcs/batch",
+		`{"vpcs":[{"id":"1","name":"new","extension":{"cidr":"10.0.0.0/16","is_default":true}}]}`)
+	doRequest(t, cli, http.MethodDelete, "/api/v1/data/vpcs/batch",
+		`{"filter":{"op":"and","rules":[{"field":"cloud_id","op":"in","value":["vpc-4","vpc-5"]}]}}`)
+	doRequest(t, cli, http.MethodPost, "/api/v1/data/security_group_common_rels/batch/upsert",
+		`{"rels":[{"security_group_id":"sg-1","res_id":"cvm-1"}]}`)
+
+	assert.Equal(t, []string{"/api/v1/data/vendors/tcloud/vpcs/list"}, fake.paths)
+
+	// 新增操作返回与请求中资源数量一致的模拟ID，避免调用方使用创建结果时出现空指针
+	createResp := struct {
+		Data struct {
+			IDs []string `json:"ids"`
+		} `json:"data"`
+	}{}
+	assert.NoError(t, json.Unmarshal(created, &createResp))
+	assert.Equal(t, []string{"dry-run-vpcs-1", "dry-run-vpcs-2"}, createResp.Data.IDs)
+	assert.JSONEq(t, `{"code":0,"message":"","data":null}`, string(updated))
+
+	assert.Len(t, rec.changes, 4)
+	assert.Equal(t, coresync.DriftCreate, rec.changes[0].Action)
+	assert.Equal(t, []coresync.DriftItem{{CloudID: "vpc-2"}, {CloudID: "vpc-3"}}, rec.changes[0].Items)
+
+	assert.Equal(t, coresync.DriftUpdate, rec.changes[1].Action)
+	assert.Equal(t, "vpcs", rec.changes[1].Resource)
+	assert.Len(t, rec.changes[1].Items, 1)
+	assert.Equal(t, "vpc-1", rec.changes[1].Items[0].CloudID)
+	fields := rec.changes[1].Items[0].Fields
+	assert.Len(t, fields, 2)
+	assert.Equal(t, "extension.is_default", fields[0].Field)
+	assert.JSONEq(t, `false`, string(fields[0].Old))
+	assert.JSONEq(t, `true`, string(fields[0].New))
+	assert.Equal(t, "name", fields[1].Field)
+	assert.JSONEq(t, `"old"`, string(fields[1].Old))
+	assert.JSONEq(t, `"new"`, string(fields[1].New))
+
+	assert.Equal(t, coresync.DriftDelete, rec.changes[2].Action)
+	assert.Equal(t, []coresync.DriftItem{{CloudID: "vpc-4"}, {CloudID: "vpc-5"}}, rec.changes[2].Items)
+
+	assert.Equal(t, "security_group_common_rels", rec.changes[3].Resource)
+	assert.Len(t, rec.changes[3].Items[0].Fields, 2)
+}
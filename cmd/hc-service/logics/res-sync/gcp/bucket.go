@@ -0,0 +1,301 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gcp
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typesbucket "hcm/pkg/adaptor/types/bucket"
+	adcore "hcm/pkg/adaptor/types/core"
+	"hcm/pkg/api/core"
+	corebucket "hcm/pkg/api/core/cloud/bucket"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/assert"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncBucketOption ...
+type SyncBucketOption struct {
+	AccountID string `json:"account_id" validate:"required"`
+}
+
+// Validate ...
+func (opt SyncBucketOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// Bucket 同步账号下全部GCS存储桶，业务由分配操作决定，同步不覆盖
+func (cli *client) Bucket(kt *kit.Kit, opt *SyncBucketOption) (*SyncResult, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	bucketFromCloud, err := cli.listBucketFromCloud(kt, opt.AccountID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketFromDB, err := cli.listBucketFromDB(kt, opt.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bucketFromCloud) == 0 && len(bucketFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.DiffPaaS[typesbucket.GcpBucket,
+		corebucket.Bucket[corebucket.GcpBucketExtension]](bucketFromCloud, bucketFromDB, isBucketChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteBucket(kt, opt.AccountID, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createBucket(kt, opt.AccountID, addSlice); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateBucket(kt, updateMap); err != nil {
+			return nil, err
+		}
+	}
+
+	return new(SyncResult), nil
+}
+
+func (cli *client) createBucket(kt *kit.Kit, accountID string, addSlice []typesbucket.GcpBucket) error {
+	buckets := make([]protocloud.BucketBatchCreate[corebucket.GcpBucketExtension], 0, len(addSlice))
+	for _, one := range addSlice {
+		buckets = append(buckets, protocloud.BucketBatchCreate[corebucket.GcpBucketExtension]{
+			CloudID:          one.GetCloudID(),
+			AccountID:        accountID,
+			BkBizID:          constant.UnassignedBiz,
+			Name:             one.Name,
+			Region:           one.GetRegion(),
+			StorageClass:     one.StorageClass,
+			PublicAccess:     one.GetPublicAccess(),
+			Encryption:       one.GetEncryption(),
+			CloudCreatedTime: one.TimeCreated,
+			Extension:        convGcpBucketExtension(one),
+		})
+	}
+
+	for _, batch := range slice.Split(buckets, constant.BatchOperationMaxLimit) {
+		req := &protocloud.BucketBatchCreateReq[corebucket.GcpBucketExtension]{Buckets: batch}
+		if _, err := cli.dbCli.Gcp.Bucket.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create bucket failed, err: %v, rid: %s", enumor.Gcp,
+				err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync bucket to create bucket success, accountID: %s, count: %d, rid: %s", enumor.Gcp,
+		accountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateBucket(kt *kit.Kit, updateMap map[string]typesbucket.GcpBucket) error {
+	updateReq := make(protocloud.BucketExtBatchUpdateReq[corebucket.GcpBucketExtension], 0, len(updateMap))
+	for id, one := range updateMap {
+		updateReq = append(updateReq, &protocloud.BucketExtUpdateReq[corebucket.GcpBucketExtension]{
+			ID:           id,
+			Name:         one.Name,
+			Region:       one.GetRegion(),
+			StorageClass: one.StorageClass,
+			PublicAccess: one.GetPublicAccess(),
+			Encryption:   one.GetEncryption(),
+			Extension:    convGcpBucketExtension(one),
+		})
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.BucketExtBatchUpdateReq[corebucket.GcpBucketExtension](batch)
+		if err := cli.dbCli.Gcp.Bucket.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update bucket failed, err: %v, rid: %s", enumor.Gcp,
+				err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync bucket to update bucket success, count: %d, rid: %s", enumor.Gcp, len(updateMap),
+		kt.Rid)
+
+	return nil
+}
+
+func (cli *client) deleteBucket(kt *kit.Kit, accountID string, delCloudIDs []string) error {
+	delFromCloud, err := cli.listBucketFromCloud(kt, accountID, delCloudIDs)
+	if err != nil {
+		return err
+	}
+
+	if len(delFromCloud) > 0 {
+		logs.Errorf("[%s] validate bucket not exist failed, before delete, account: %s, failed_count: %d, rid: %s",
+			enumor.Gcp, accountID, len(delFromCloud), kt.Rid)
+		return errf.Newf(errf.Aborted, "validate bucket not exist failed, before delete")
+	}
+
+	for _, batch := range slice.Split(delCloudIDs, constant.BatchOperationMaxLimit) {
+		req := &protocloud.BucketBatchDeleteReq{
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("vendor", enumor.Gcp),
+				tools.RuleEqual("account_id", accountID),
+				tools.RuleIn("cloud_id", batch),
+			),
+		}
+		if err = cli.dbCli.Global.Bucket.BatchDelete(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch delete bucket failed, err: %v, rid: %s", enumor.Gcp,
+				err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync bucket to delete bucket success, accountID: %s, count: %d, rid: %s", enumor.Gcp,
+		accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+// listBucketFromCloud cloudIDs 为空时查询项目下全部存储桶
+func (cli *client) listBucketFromCloud(kt *kit.Kit, accountID string, cloudIDs []string) (
+	[]typesbucket.GcpBucket, error) {
+
+	if len(cloudIDs) != 0 {
+		result := make([]typesbucket.GcpBucket, 0, len(cloudIDs))
+		for _, batch := range slice.Split(cloudIDs, typesbucket.BucketListMaxLimit) {
+			opt := &typesbucket.GcpBucketListOption{CloudIDs: batch}
+			buckets, _, err := cli.cloudCli.ListBucket(kt, opt)
+			if err != nil {
+				logs.Errorf("[%s] list bucket from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+					enumor.Gcp, err, accountID, opt, kt.Rid)
+				return nil, err
+			}
+			result = append(result, buckets...)
+		}
+		return result, nil
+	}
+
+	result := make([]typesbucket.GcpBucket, 0)
+	opt := &typesbucket.GcpBucketListOption{Page: &adcore.GcpPage{PageSize: adcore.GcpQueryLimit}}
+	for {
+		buckets, nextToken, err := cli.cloudCli.ListBucket(kt, opt)
+		if err != nil {
+			logs.Errorf("[%s] list bucket from cloud failed, err: %v, account: %s, opt: %v, rid: %s", enumor.Gcp,
+				err, accountID, opt, kt.Rid)
+			return nil, err
+		}
+		result = append(result, buckets...)
+
+		if len(nextToken) == 0 {
+			break
+		}
+		opt.Page.PageToken = nextToken
+	}
+
+	return result, nil
+}
+
+func (cli *client) listBucketFromDB(kt *kit.Kit, accountID string) (
+	[]corebucket.Bucket[corebucket.GcpBucketExtension], error) {
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Gcp),
+			tools.RuleEqual("account_id", accountID),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result := make([]corebucket.Bucket[corebucket.GcpBucketExtension], 0)
+	for {
+		resp, err := cli.dbCli.Gcp.Bucket.ListBucketExt(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] list bucket from db failed, err: %v, account: %s, req: %v, rid: %s", enumor.Gcp,
+				err, accountID, req, kt.Rid)
+			return nil, err
+		}
+
+		result = append(result, resp.Details...)
+
+		if len(resp.Details) < int(core.DefaultMaxPageLimit) {
+			break
+		}
+
+		req.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return result, nil
+}
+
+func convGcpBucketExtension(one typesbucket.GcpBucket) *corebucket.GcpBucketExtension {
+	ext := &corebucket.GcpBucketExtension{
+		SelfLink:               one.SelfLink,
+		LocationType:           one.LocationType,
+		PublicAccessPrevention: one.GetPublicAccessPrevention(),
+		PublicRoles:            one.PublicRoles,
+	}
+	if one.IamConfiguration != nil && one.IamConfiguration.UniformBucketLevelAccess != nil {
+		ext.UniformBucketLevelAccess = one.IamConfiguration.UniformBucketLevelAccess.Enabled
+	}
+	if one.Encryption != nil {
+		ext.DefaultKmsKeyName = one.Encryption.DefaultKmsKeyName
+	}
+
+	return ext
+}
+
+func isBucketChange(cloud typesbucket.GcpBucket, db corebucket.Bucket[corebucket.GcpBucketExtension]) bool {
+	if cloud.Name != db.Name || cloud.GetRegion() != db.Region || cloud.StorageClass != db.StorageClass {
+		return true
+	}
+
+	if cloud.GetPublicAccess() != db.PublicAccess || cloud.GetEncryption() != db.Encryption {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	ext := convGcpBucketExtension(cloud)
+	if ext.LocationType != db.Extension.LocationType ||
+		ext.PublicAccessPrevention != db.Extension.PublicAccessPrevention ||
+		ext.UniformBucketLevelAccess != db.Extension.UniformBucketLevelAccess ||
+		ext.DefaultKmsKeyName != db.Extension.DefaultKmsKeyName {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(ext.PublicRoles, db.Extension.PublicRoles) {
+		return true
+	}
+
+	return false
+}
@@ -27,7 +27,7 @@ import (
 
 // Interface support resource sync.
 type Interface interface {
-	CloudCli() *gcp.Gcp
+	CloudCli() gcp.Gcp
 
 	Cvm(kt *kit.Kit, params *SyncBaseParams, opt *SyncCvmOption) (*SyncResult, error)
 	CvmWithRelRes(kt *kit.Kit, params *SyncBaseParams, opt *SyncCvmWithRelResOption) (*SyncResult, error)
@@ -62,12 +62,32 @@ type Interface interface {
 	RemoveRegionDeleteFromCloud(kt *kit.Kit, accountID string) error
 
 	SubAccount(kt *kit.Kit, opt *SyncSubAccountOption) (*SyncResult, error)
+
+	Bucket(kt *kit.Kit, opt *SyncBucketOption) (*SyncResult, error)
+	K8sCluster(kt *kit.Kit, opt *SyncK8sClusterOption) (*SyncResult, error)
+	KeyPair(kt *kit.Kit, opt *SyncKeyPairOption) (*SyncResult, error)
+	PrivateDnsZone(kt *kit.Kit, opt *SyncPrivateDnsZoneOption) (*SyncResult, error)
+
+	LoadBalancer(kt *kit.Kit, params *SyncBaseParams, opt *SyncLBOption) (*SyncResult, error)
+	RemoveLoadBalancerDeleteFromCloud(kt *kit.Kit, accountID string, region string) error
+
+	Snapshot(kt *kit.Kit, params *SyncBaseParams, opt *SyncSnapshotOption) (*SyncResult, error)
+	RemoveSnapshotDeleteFromCloud(kt *kit.Kit, accountID string) error
+
+	DatabaseInstance(kt *kit.Kit, params *SyncBaseParams, opt *SyncDatabaseInstanceOption) (*SyncResult, error)
+	RemoveDatabaseInstanceDeleteFromCloud(kt *kit.Kit, accountID string, region string) error
+
+	NatGateway(kt *kit.Kit, params *SyncBaseParams, opt *SyncNatGatewayOption) (*SyncResult, error)
+	RemoveNatGatewayDeleteFromCloud(kt *kit.Kit, accountID string, region string) error
+
+	VpcPeering(kt *kit.Kit, params *SyncBaseParams, opt *SyncVpcPeeringOption) (*SyncResult, error)
+	RemoveVpcPeeringDeleteFromCloud(kt *kit.Kit, accountID string) error
 }
 
 var _ Interface = new(client)
 
 // NewClient new client.
-func NewClient(dbCli *dataservice.Client, cloudCli *gcp.Gcp) Interface {
+func NewClient(dbCli *dataservice.Client, cloudCli gcp.Gcp) Interface {
 	return &client{
 		dbCli:    dbCli,
 		cloudCli: cloudCli,
@@ -76,11 +96,11 @@ func NewClient(dbCli *dataservice.Client, cloudCli *gcp.Gcp) Interface {
 
 type client struct {
 	accountID string
-	cloudCli  *gcp.Gcp
+	cloudCli  gcp.Gcp
 	dbCli     *dataservice.Client
 }
 
 // CloudCli ...
-func (cli *client) CloudCli() *gcp.Gcp {
+func (cli *client) CloudCli() gcp.Gcp {
 	return cli.cloudCli
 }
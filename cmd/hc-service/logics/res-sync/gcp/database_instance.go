@@ -0,0 +1,413 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gcp
+
+import (
+	"strings"
+
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typesdbinstance "hcm/pkg/adaptor/types/database-instance"
+	"hcm/pkg/api/core"
+	coredbinstance "hcm/pkg/api/core/cloud/database-instance"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// gcpDBInstanceDefaultPort Cloud SQL 不返回端口，按引擎使用默认端口
+var gcpDBInstanceDefaultPort = map[string]int64{
+	"mysql":     3306,
+	"postgres":  5432,
+	"sqlserver": 1433,
+}
+
+// SyncDatabaseInstanceOption ...
+type SyncDatabaseInstanceOption struct {
+	Region string `json:"region" validate:"required"`
+}
+
+// Validate ...
+func (opt SyncDatabaseInstanceOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// DatabaseInstance 同步 Cloud SQL 实例，业务由分配操作决定，同步不覆盖。
+// Cloud SQL 通过授权网络控制访问，不关联安全组
+func (cli *client) DatabaseInstance(kt *kit.Kit, params *SyncBaseParams, opt *SyncDatabaseInstanceOption) (
+	*SyncResult, error) {
+
+	if err := validator.ValidateTool(params, opt); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	dbFromCloud, err := cli.listDBInstanceFromCloud(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	dbFromDB, err := cli.listDBInstanceFromDB(kt, params, opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dbFromCloud) == 0 && len(dbFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.DiffPaaS[typesdbinstance.GcpDBInstance,
+		coredbinstance.DatabaseInstance[coredbinstance.GcpDBInstanceExtension]](dbFromCloud, dbFromDB,
+		isDBInstanceChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteDBInstance(kt, params.AccountID, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	vpcSelfLinks := make([]string, 0, len(addSlice)+len(updateMap))
+	for _, one := range addSlice {
+		vpcSelfLinks = append(vpcSelfLinks, one.GetCloudVpcSelfLink())
+	}
+	for _, one := range updateMap {
+		vpcSelfLinks = append(vpcSelfLinks, one.GetCloudVpcSelfLink())
+	}
+	vpcMap, err := cli.getVpcMap(kt, params.AccountID, slice.Unique(vpcSelfLinks))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createDBInstance(kt, params.AccountID, addSlice, vpcMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateDBInstance(kt, updateMap, vpcMap); err != nil {
+			return nil, err
+		}
+	}
+
+	return new(SyncResult), nil
+}
+
+func (cli *client) createDBInstance(kt *kit.Kit, accountID string, addSlice []typesdbinstance.GcpDBInstance,
+	vpcMap map[string]*common.VpcDB) error {
+
+	createReq := new(protocloud.DatabaseInstanceBatchCreateReq[coredbinstance.GcpDBInstanceExtension])
+	for _, one := range addSlice {
+		publicIPs, privateIPs := one.GetIPs()
+		engine := getGcpDBInstanceEngine(one)
+		db := protocloud.DatabaseInstanceBatchCreate[coredbinstance.GcpDBInstanceExtension]{
+			CloudID:          one.GetCloudID(),
+			AccountID:        accountID,
+			BkBizID:          constant.UnassignedBiz,
+			Name:             one.Name,
+			Region:           one.Region,
+			Zone:             one.GceZone,
+			Status:           one.State,
+			Engine:           engine,
+			EngineVersion:    one.DatabaseVersion,
+			InstanceClass:    getGcpDBInstanceTier(one),
+			StorageSize:      getGcpDBInstanceStorageSize(one),
+			ChargeType:       getGcpDBInstancePricingPlan(one),
+			PrivateAddress:   strings.Join(privateIPs, ","),
+			PublicAddress:    strings.Join(publicIPs, ","),
+			Port:             gcpDBInstanceDefaultPort[engine],
+			CloudCreatedTime: one.CreateTime,
+			Extension:        convGcpDBInstanceExtension(one),
+		}
+		if vpc, exist := vpcMap[one.GetCloudVpcSelfLink()]; exist {
+			db.VpcID = vpc.VpcID
+			db.CloudVpcID = vpc.VpcCloudID
+		}
+		createReq.DatabaseInstances = append(createReq.DatabaseInstances, db)
+	}
+
+	for _, batch := range slice.Split(createReq.DatabaseInstances, constant.BatchOperationMaxLimit) {
+		req := &protocloud.DatabaseInstanceBatchCreateReq[coredbinstance.GcpDBInstanceExtension]{
+			DatabaseInstances: batch,
+		}
+		if _, err := cli.dbCli.Gcp.DatabaseInstance.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create database instance failed, err: %v, rid: %s",
+				enumor.Gcp, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync database instance to create database instance success, accountID: %s, count: %d, "+
+		"rid: %s", enumor.Gcp, accountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateDBInstance(kt *kit.Kit, updateMap map[string]typesdbinstance.GcpDBInstance,
+	vpcMap map[string]*common.VpcDB) error {
+
+	updateReq := make(protocloud.DatabaseInstanceExtBatchUpdateReq[coredbinstance.GcpDBInstanceExtension], 0,
+		len(updateMap))
+	for id, one := range updateMap {
+		publicIPs, privateIPs := one.GetIPs()
+		db := &protocloud.DatabaseInstanceExtUpdateReq[coredbinstance.GcpDBInstanceExtension]{
+			ID:             id,
+			Name:           one.Name,
+			Zone:           one.GceZone,
+			Status:         one.State,
+			EngineVersion:  one.DatabaseVersion,
+			InstanceClass:  getGcpDBInstanceTier(one),
+			StorageSize:    getGcpDBInstanceStorageSize(one),
+			ChargeType:     getGcpDBInstancePricingPlan(one),
+			PrivateAddress: strings.Join(privateIPs, ","),
+			PublicAddress:  strings.Join(publicIPs, ","),
+			Port:           gcpDBInstanceDefaultPort[getGcpDBInstanceEngine(one)],
+			Extension:      convGcpDBInstanceExtension(one),
+		}
+		if vpc, exist := vpcMap[one.GetCloudVpcSelfLink()]; exist {
+			db.VpcID = vpc.VpcID
+			db.CloudVpcID = vpc.VpcCloudID
+		}
+		updateReq = append(updateReq, db)
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.DatabaseInstanceExtBatchUpdateReq[coredbinstance.GcpDBInstanceExtension](batch)
+		if err := cli.dbCli.Gcp.DatabaseInstance.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update database instance failed, err: %v, rid: %s",
+				enumor.Gcp, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync database instance to update database instance success, count: %d, rid: %s",
+		enumor.Gcp, len(updateMap), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) deleteDBInstance(kt *kit.Kit, accountID string, delCloudIDs []string) error {
+	checkParams := &SyncBaseParams{AccountID: accountID, CloudIDs: delCloudIDs}
+	delFromCloud, err := cli.listDBInstanceFromCloud(kt, checkParams)
+	if err != nil {
+		return err
+	}
+
+	if len(delFromCloud) > 0 {
+		logs.Errorf("[%s] validate database instance not exist failed, before delete opt: %v, failed_count: %d, "+
+			"rid: %s", enumor.Gcp, checkParams, len(delFromCloud), kt.Rid)
+		return errf.Newf(errf.Aborted, "validate database instance not exist failed, before delete")
+	}
+
+	req := &protocloud.DatabaseInstanceBatchDeleteReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Gcp),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleIn("cloud_id", delCloudIDs),
+		),
+	}
+	if err = cli.dbCli.Global.DatabaseInstance.BatchDelete(kt, req); err != nil {
+		logs.Errorf("[%s] request dataservice to batch delete database instance failed, err: %v, rid: %s",
+			enumor.Gcp, err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync database instance to delete database instance success, accountID: %s, count: %d, "+
+		"rid: %s", enumor.Gcp, accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+// listDBInstanceFromCloud 连接名中已包含地域，按连接名查询即可
+func (cli *client) listDBInstanceFromCloud(kt *kit.Kit, params *SyncBaseParams) (
+	[]typesdbinstance.GcpDBInstance, error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &typesdbinstance.GcpDBInstanceListOption{CloudIDs: params.CloudIDs}
+	result, _, err := cli.cloudCli.ListDatabaseInstance(kt, opt)
+	if err != nil {
+		logs.Errorf("[%s] list database instance from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+			enumor.Gcp, err, params.AccountID, opt, kt.Rid)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (cli *client) listDBInstanceFromDB(kt *kit.Kit, params *SyncBaseParams, region string) (
+	[]coredbinstance.DatabaseInstance[coredbinstance.GcpDBInstanceExtension], error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", params.AccountID),
+			tools.RuleEqual("region", region),
+			tools.RuleIn("cloud_id", params.CloudIDs),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := cli.dbCli.Gcp.DatabaseInstance.ListDatabaseInstanceExt(kt, req)
+	if err != nil {
+		logs.Errorf("[%s] list database instance from db failed, err: %v, account: %s, req: %v, rid: %s",
+			enumor.Gcp, err, params.AccountID, req, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+// RemoveDatabaseInstanceDeleteFromCloud 删除本地存在但云上已被删除的 Cloud SQL 实例
+func (cli *client) RemoveDatabaseInstanceDeleteFromCloud(kt *kit.Kit, accountID string, region string) error {
+	req := &core.ListReq{
+		Fields: []string{"id", "cloud_id"},
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Gcp),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleEqual("region", region),
+		),
+		Page: &core.BasePage{Start: 0, Limit: constant.BatchOperationMaxLimit},
+	}
+	for {
+		resultFromDB, err := cli.dbCli.Global.DatabaseInstance.List(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] request dataservice to list database instance failed, err: %v, req: %v, rid: %s",
+				enumor.Gcp, err, req, kt.Rid)
+			return err
+		}
+
+		cloudIDs := make([]string, 0, len(resultFromDB.Details))
+		for _, one := range resultFromDB.Details {
+			cloudIDs = append(cloudIDs, one.CloudID)
+		}
+
+		if len(cloudIDs) == 0 {
+			break
+		}
+
+		params := &SyncBaseParams{AccountID: accountID, CloudIDs: cloudIDs}
+		resultFromCloud, err := cli.listDBInstanceFromCloud(kt, params)
+		if err != nil {
+			return err
+		}
+
+		// 如果有资源没有查询出来，说明数据被从云上删除
+		if len(resultFromCloud) != len(cloudIDs) {
+			cloudIDMap := converter.StringSliceToMap(cloudIDs)
+			for _, one := range resultFromCloud {
+				delete(cloudIDMap, one.GetCloudID())
+			}
+
+			err = cli.deleteDBInstance(kt, accountID, converter.MapKeyToStringSlice(cloudIDMap))
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(resultFromDB.Details) < constant.BatchOperationMaxLimit {
+			break
+		}
+
+		req.Page.Start += constant.BatchOperationMaxLimit
+	}
+
+	return nil
+}
+
+// getGcpDBInstanceEngine 从数据库版本中解析引擎，如 MYSQL_8_0 -> mysql
+func getGcpDBInstanceEngine(one typesdbinstance.GcpDBInstance) string {
+	return strings.ToLower(strings.SplitN(one.DatabaseVersion, "_", 2)[0])
+}
+
+func getGcpDBInstanceTier(one typesdbinstance.GcpDBInstance) string {
+	if one.Settings == nil {
+		return ""
+	}
+	return one.Settings.Tier
+}
+
+func getGcpDBInstanceStorageSize(one typesdbinstance.GcpDBInstance) int64 {
+	if one.Settings == nil {
+		return 0
+	}
+	return one.Settings.DataDiskSizeGb
+}
+
+func getGcpDBInstancePricingPlan(one typesdbinstance.GcpDBInstance) string {
+	if one.Settings == nil {
+		return ""
+	}
+	return one.Settings.PricingPlan
+}
+
+func convGcpDBInstanceExtension(one typesdbinstance.GcpDBInstance) *coredbinstance.GcpDBInstanceExtension {
+	ext := &coredbinstance.GcpDBInstanceExtension{
+		InstanceName:     one.Name,
+		SelfLink:         one.SelfLink,
+		BackendType:      one.BackendType,
+		CloudVpcSelfLink: one.GetCloudVpcSelfLink(),
+	}
+	if one.Settings != nil {
+		ext.AvailabilityType = one.Settings.AvailabilityType
+	}
+	return ext
+}
+
+func isDBInstanceChange(cloud typesdbinstance.GcpDBInstance,
+	db coredbinstance.DatabaseInstance[coredbinstance.GcpDBInstanceExtension]) bool {
+
+	if cloud.Name != db.Name || cloud.State != db.Status || cloud.GceZone != db.Zone {
+		return true
+	}
+
+	if cloud.DatabaseVersion != db.EngineVersion || getGcpDBInstanceTier(cloud) != db.InstanceClass ||
+		getGcpDBInstanceStorageSize(cloud) != db.StorageSize || getGcpDBInstancePricingPlan(cloud) != db.ChargeType {
+		return true
+	}
+
+	publicIPs, privateIPs := cloud.GetIPs()
+	if strings.Join(privateIPs, ",") != db.PrivateAddress || strings.Join(publicIPs, ",") != db.PublicAddress {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	if cloud.GetCloudVpcSelfLink() != db.Extension.CloudVpcSelfLink {
+		return true
+	}
+
+	if cloud.Settings != nil && cloud.Settings.AvailabilityType != db.Extension.AvailabilityType {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,224 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gcp
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typekeypair "hcm/pkg/adaptor/types/key-pair"
+	"hcm/pkg/api/core"
+	corekeypair "hcm/pkg/api/core/cloud/key-pair"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncKeyPairOption ...
+type SyncKeyPairOption struct {
+	AccountID string `json:"account_id" validate:"required"`
+}
+
+// Validate ...
+func (opt SyncKeyPairOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// KeyPair 同步项目元数据中的 SSH 公钥，业务和备注由本地操作决定，同步不覆盖
+func (cli *client) KeyPair(kt *kit.Kit, opt *SyncKeyPairOption) (*SyncResult, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	keyPairFromCloud, err := cli.listKeyPairFromCloud(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	keyPairFromDB, err := cli.listKeyPairFromDB(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keyPairFromCloud) == 0 && len(keyPairFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.DiffPaaS[typekeypair.GcpKeyPair,
+		corekeypair.KeyPair[corekeypair.GcpKeyPairExtension]](keyPairFromCloud, keyPairFromDB, isKeyPairChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteKeyPair(kt, opt.AccountID, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createKeyPair(kt, opt, addSlice); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateKeyPair(kt, updateMap); err != nil {
+			return nil, err
+		}
+	}
+
+	return new(SyncResult), nil
+}
+
+func (cli *client) createKeyPair(kt *kit.Kit, opt *SyncKeyPairOption, addSlice []typekeypair.GcpKeyPair) error {
+	keyPairs := make([]protocloud.KeyPairBatchCreate[corekeypair.GcpKeyPairExtension], 0, len(addSlice))
+	for _, one := range addSlice {
+		keyPairs = append(keyPairs, protocloud.KeyPairBatchCreate[corekeypair.GcpKeyPairExtension]{
+			CloudID:     one.GetCloudID(),
+			AccountID:   opt.AccountID,
+			BkBizID:     constant.UnassignedBiz,
+			Name:        one.Name,
+			Region:      typekeypair.GcpGlobalRegion,
+			Fingerprint: one.Fingerprint,
+			PublicKey:   one.PublicKey,
+			Extension:   &corekeypair.GcpKeyPairExtension{Comment: one.Comment},
+		})
+	}
+
+	for _, batch := range slice.Split(keyPairs, constant.BatchOperationMaxLimit) {
+		req := &protocloud.KeyPairBatchCreateReq[corekeypair.GcpKeyPairExtension]{KeyPairs: batch}
+		if _, err := cli.dbCli.Gcp.KeyPair.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create key pair failed, err: %v, rid: %s",
+				enumor.Gcp, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync key pair to create key pair success, accountID: %s, count: %d, rid: %s",
+		enumor.Gcp, opt.AccountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateKeyPair(kt *kit.Kit, updateMap map[string]typekeypair.GcpKeyPair) error {
+	updateReq := make(protocloud.KeyPairExtBatchUpdateReq[corekeypair.GcpKeyPairExtension], 0, len(updateMap))
+	for id, one := range updateMap {
+		updateReq = append(updateReq, &protocloud.KeyPairExtUpdateReq[corekeypair.GcpKeyPairExtension]{
+			ID:          id,
+			Name:        one.Name,
+			Fingerprint: one.Fingerprint,
+			PublicKey:   one.PublicKey,
+			Extension:   &corekeypair.GcpKeyPairExtension{Comment: one.Comment},
+		})
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.KeyPairExtBatchUpdateReq[corekeypair.GcpKeyPairExtension](batch)
+		if err := cli.dbCli.Gcp.KeyPair.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update key pair failed, err: %v, rid: %s",
+				enumor.Gcp, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync key pair to update key pair success, count: %d, rid: %s", enumor.Gcp,
+		len(updateMap), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) deleteKeyPair(kt *kit.Kit, accountID string, delCloudIDs []string) error {
+	for _, batch := range slice.Split(delCloudIDs, constant.BatchOperationMaxLimit) {
+		req := &protocloud.KeyPairBatchDeleteReq{
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("vendor", enumor.Gcp),
+				tools.RuleEqual("account_id", accountID),
+				tools.RuleIn("cloud_id", batch),
+			),
+		}
+		if err := cli.dbCli.Global.KeyPair.BatchDelete(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch delete key pair failed, err: %v, rid: %s",
+				enumor.Gcp, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync key pair to delete key pair success, accountID: %s, count: %d, rid: %s",
+		enumor.Gcp, accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) listKeyPairFromCloud(kt *kit.Kit, opt *SyncKeyPairOption) ([]typekeypair.GcpKeyPair, error) {
+	listOpt := new(typekeypair.GcpKeyPairListOption)
+	result, err := cli.cloudCli.ListKeyPair(kt, listOpt)
+	if err != nil {
+		logs.Errorf("[%s] list key pair from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+			enumor.Gcp, err, opt.AccountID, listOpt, kt.Rid)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (cli *client) listKeyPairFromDB(kt *kit.Kit, opt *SyncKeyPairOption) (
+	[]corekeypair.KeyPair[corekeypair.GcpKeyPairExtension], error) {
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Gcp),
+			tools.RuleEqual("account_id", opt.AccountID),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result := make([]corekeypair.KeyPair[corekeypair.GcpKeyPairExtension], 0)
+	for {
+		resp, err := cli.dbCli.Gcp.KeyPair.ListKeyPairExt(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] list key pair from db failed, err: %v, account: %s, req: %v, rid: %s",
+				enumor.Gcp, err, opt.AccountID, req, kt.Rid)
+			return nil, err
+		}
+
+		result = append(result, resp.Details...)
+
+		if len(resp.Details) < int(core.DefaultMaxPageLimit) {
+			break
+		}
+
+		req.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return result, nil
+}
+
+func isKeyPairChange(cloud typekeypair.GcpKeyPair, db corekeypair.KeyPair[corekeypair.GcpKeyPairExtension]) bool {
+	if cloud.Name != db.Name || cloud.Fingerprint != db.Fingerprint || cloud.PublicKey != db.PublicKey {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	return cloud.Comment != db.Extension.Comment
+}
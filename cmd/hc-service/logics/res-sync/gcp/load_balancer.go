@@ -0,0 +1,456 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gcp
+
+import (
+	"fmt"
+	"strings"
+
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typecore "hcm/pkg/adaptor/types/core"
+	typeslb "hcm/pkg/adaptor/types/load-balancer"
+	"hcm/pkg/api/core"
+	corelb "hcm/pkg/api/core/cloud/load-balancer"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/assert"
+	cvt "hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncLBOption ...
+type SyncLBOption struct {
+	// Region 全局转发规则传 global
+	Region string `json:"region" validate:"required"`
+}
+
+// Validate ...
+func (opt SyncLBOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// LoadBalancer 同步负载均衡(转发规则)
+func (cli *client) LoadBalancer(kt *kit.Kit, params *SyncBaseParams, opt *SyncLBOption) (*SyncResult, error) {
+	if err := validator.ValidateTool(params, opt); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	lbFromCloud, err := cli.listLBFromCloud(kt, params, opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	lbFromDB, err := cli.listLBFromDB(kt, params, opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lbFromCloud) == 0 && len(lbFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.Diff[typeslb.GcpLoadBalancer, corelb.GcpLoadBalancer](
+		lbFromCloud, lbFromDB, isLBChange)
+
+	if err = cli.deleteLoadBalancer(kt, params.AccountID, opt.Region, delCloudIDs); err != nil {
+		return nil, err
+	}
+
+	if err = cli.createLoadBalancer(kt, params.AccountID, opt.Region, addSlice); err != nil {
+		return nil, err
+	}
+
+	if err = cli.updateLoadBalancer(kt, params.AccountID, opt.Region, updateMap); err != nil {
+		return nil, err
+	}
+
+	return new(SyncResult), nil
+}
+
+// RemoveLoadBalancerDeleteFromCloud 删除存在本地但是在云上被删除的数据
+func (cli *client) RemoveLoadBalancerDeleteFromCloud(kt *kit.Kit, accountID string, region string) error {
+	req := &core.ListReq{
+		Fields: []string{"id", "cloud_id"},
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Gcp),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleEqual("region", region),
+		),
+		Page: &core.BasePage{
+			Start: 0,
+			Limit: constant.BatchOperationMaxLimit,
+		},
+	}
+
+	for {
+		lbFromDB, err := cli.dbCli.Global.LoadBalancer.ListLoadBalancer(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] request dataservice to list lb failed, err: %v, req: %v, rid: %s", enumor.Gcp,
+				err, req, kt.Rid)
+			return err
+		}
+
+		cloudIDs := slice.Map(lbFromDB.Details, func(lb corelb.BaseLoadBalancer) string { return lb.CloudID })
+		if len(cloudIDs) == 0 {
+			break
+		}
+
+		params := &SyncBaseParams{AccountID: accountID, CloudIDs: cloudIDs}
+		lbFromCloud, err := cli.listLBFromCloud(kt, params, region)
+		if err != nil {
+			return err
+		}
+
+		// 如果有资源没有查询出来，说明数据被从云上删除
+		if len(lbFromCloud) != len(cloudIDs) {
+			cloudIDMap := cvt.StringSliceToMap(cloudIDs)
+			for _, one := range lbFromCloud {
+				delete(cloudIDMap, one.GetCloudID())
+			}
+
+			delCloudIDs := cvt.MapKeyToSlice(cloudIDMap)
+			if err = cli.deleteLoadBalancer(kt, accountID, region, delCloudIDs); err != nil {
+				return err
+			}
+		}
+
+		if len(lbFromDB.Details) < constant.BatchOperationMaxLimit {
+			break
+		}
+
+		req.Page.Start += constant.BatchOperationMaxLimit
+	}
+
+	return nil
+}
+
+func (cli *client) createLoadBalancer(kt *kit.Kit, accountID string, region string,
+	addSlice []typeslb.GcpLoadBalancer) error {
+
+	if len(addSlice) == 0 {
+		return nil
+	}
+
+	vpcMap, subnetMap, err := cli.getLoadBalancerRelatedRes(kt, accountID, region, addSlice)
+	if err != nil {
+		return err
+	}
+
+	createReq := new(protocloud.GcpLoadBalancerCreateReq)
+	for _, cloud := range addSlice {
+		lb := protocloud.LbBatchCreate[corelb.GcpLoadBalancerExtension]{
+			CloudID:          cloud.GetCloudID(),
+			Name:             cloud.Name,
+			Vendor:           enumor.Gcp,
+			AccountID:        accountID,
+			BkBizID:          constant.UnassignedBiz,
+			LoadBalancerType: string(getLBType(cloud)),
+			IPVersion:        getLBIPVersion(cloud),
+			Region:           region,
+			CloudCreatedTime: cloud.CreationTimestamp,
+			Memo:             cvt.ValToPtr(cloud.Description),
+			Extension:        convLBExtension(cloud),
+		}
+		if vpc, exist := vpcMap[cloud.Network]; exist {
+			lb.VpcID, lb.CloudVpcID = vpc.VpcID, vpc.VpcCloudID
+		}
+		if subnet, exist := subnetMap[cloud.Subnetwork]; exist {
+			lb.SubnetID, lb.CloudSubnetID = subnet.SubnetID, subnet.SubnetCloudID
+		}
+		lb.PrivateIPv4Addresses, lb.PrivateIPv6Addresses, lb.PublicIPv4Addresses, lb.PublicIPv6Addresses =
+			getLBAddresses(cloud)
+		createReq.Lbs = append(createReq.Lbs, lb)
+	}
+
+	if _, err = cli.dbCli.Gcp.LoadBalancer.BatchCreate(kt, createReq); err != nil {
+		logs.Errorf("[%s] call data service to create load balancer failed, err: %v, rid: %s", enumor.Gcp, err,
+			kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync load balancer to create lb success, accountID: %s, count: %d, rid: %s", enumor.Gcp,
+		accountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateLoadBalancer(kt *kit.Kit, accountID string, region string,
+	updateMap map[string]typeslb.GcpLoadBalancer) error {
+
+	if len(updateMap) == 0 {
+		return nil
+	}
+
+	vpcMap, subnetMap, err := cli.getLoadBalancerRelatedRes(kt, accountID, region, cvt.MapValueToSlice(updateMap))
+	if err != nil {
+		return err
+	}
+
+	updateReq := new(protocloud.GcpLoadBalancerBatchUpdateReq)
+	for id, cloud := range updateMap {
+		lb := &protocloud.LoadBalancerExtUpdateReq[corelb.GcpLoadBalancerExtension]{
+			ID:               id,
+			Name:             cloud.Name,
+			IPVersion:        getLBIPVersion(cloud),
+			CloudCreatedTime: cloud.CreationTimestamp,
+			Memo:             cvt.ValToPtr(cloud.Description),
+			Extension:        convLBExtension(cloud),
+		}
+		if vpc, exist := vpcMap[cloud.Network]; exist {
+			lb.VpcID, lb.CloudVpcID = vpc.VpcID, vpc.VpcCloudID
+		}
+		if subnet, exist := subnetMap[cloud.Subnetwork]; exist {
+			lb.SubnetID, lb.CloudSubnetID = subnet.SubnetID, subnet.SubnetCloudID
+		}
+		lb.PrivateIPv4Addresses, lb.PrivateIPv6Addresses, lb.PublicIPv4Addresses, lb.PublicIPv6Addresses =
+			getLBAddresses(cloud)
+		updateReq.Lbs = append(updateReq.Lbs, lb)
+	}
+
+	if err = cli.dbCli.Gcp.LoadBalancer.BatchUpdate(kt, updateReq); err != nil {
+		logs.Errorf("[%s] call data service to update load balancer failed, err: %v, rid: %s", enumor.Gcp, err,
+			kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+func (cli *client) deleteLoadBalancer(kt *kit.Kit, accountID string, region string, delCloudIDs []string) error {
+	if len(delCloudIDs) == 0 {
+		return nil
+	}
+
+	checkParams := &SyncBaseParams{
+		AccountID: accountID,
+		CloudIDs:  delCloudIDs,
+	}
+	delLBFromCloud, err := cli.listLBFromCloud(kt, checkParams, region)
+	if err != nil {
+		return err
+	}
+
+	if len(delLBFromCloud) > 0 {
+		logs.Errorf("[%s] lb not exist before sync deletion, opt: %v, failed_count: %d, rid: %s", enumor.Gcp,
+			checkParams, len(delLBFromCloud), kt.Rid)
+		return fmt.Errorf("lb not exist before sync deletion")
+	}
+
+	deleteReq := &protocloud.LoadBalancerBatchDeleteReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Gcp),
+			tools.RuleIn("cloud_id", delCloudIDs),
+		),
+	}
+	if err = cli.dbCli.Global.LoadBalancer.BatchDelete(kt, deleteReq); err != nil {
+		logs.Errorf("[%s] call data service to batch delete lb failed, err: %v, rid: %s", enumor.Gcp, err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync to delete lb success, accountID: %s, count: %d, rid: %s", enumor.Gcp, accountID,
+		len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) getLoadBalancerRelatedRes(kt *kit.Kit, accountID string, region string,
+	lbs []typeslb.GcpLoadBalancer) (map[string]*common.VpcDB, map[string]*SubnetDB, error) {
+
+	vpcSelfLinks := make([]string, 0, len(lbs))
+	subnetSelfLinks := make([]string, 0, len(lbs))
+	for _, lb := range lbs {
+		if len(lb.Network) != 0 {
+			vpcSelfLinks = append(vpcSelfLinks, lb.Network)
+		}
+		if len(lb.Subnetwork) != 0 {
+			subnetSelfLinks = append(subnetSelfLinks, lb.Subnetwork)
+		}
+	}
+
+	vpcMap, err := cli.getVpcMap(kt, accountID, slice.Unique(vpcSelfLinks))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 全局转发规则不会关联子网
+	subnetMap := make(map[string]*SubnetDB)
+	if region != typeslb.GcpGlobalRegion && len(subnetSelfLinks) != 0 {
+		subnetMap, err = cli.getSubnetMap(kt, accountID, region, slice.Unique(subnetSelfLinks))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return vpcMap, subnetMap, nil
+}
+
+func (cli *client) listLBFromCloud(kt *kit.Kit, params *SyncBaseParams, region string) (
+	[]typeslb.GcpLoadBalancer, error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	result := make([]typeslb.GcpLoadBalancer, 0, len(params.CloudIDs))
+	for _, parts := range slice.Split(params.CloudIDs, constant.CloudResourceSyncMaxLimit) {
+		opt := &typeslb.GcpListOption{
+			Region:   region,
+			CloudIDs: parts,
+			Page:     &typecore.GcpPage{PageSize: int64(constant.CloudResourceSyncMaxLimit)},
+		}
+		for {
+			lbResult, err := cli.cloudCli.ListLoadBalancer(kt, opt)
+			if err != nil {
+				logs.Errorf("[%s] list lb from cloud failed, err: %v, account: %s, opt: %v, rid: %s", enumor.Gcp,
+					err, params.AccountID, opt, kt.Rid)
+				return nil, err
+			}
+
+			result = append(result, lbResult.Details...)
+
+			if len(lbResult.NextPageToken) == 0 {
+				break
+			}
+			opt.Page.PageToken = lbResult.NextPageToken
+		}
+	}
+
+	return result, nil
+}
+
+func (cli *client) listLBFromDB(kt *kit.Kit, params *SyncBaseParams, region string) (
+	[]corelb.GcpLoadBalancer, error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", params.AccountID),
+			tools.RuleEqual("region", region),
+			tools.RuleIn("cloud_id", params.CloudIDs),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := cli.dbCli.Gcp.LoadBalancer.ListLoadBalancer(kt, req)
+	if err != nil {
+		logs.Errorf("[%s] list lb from db failed, err: %v, account: %s, req: %v, rid: %s", enumor.Gcp, err,
+			params.AccountID, req, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+// getLBType 外部负载均衡方案为公网负载均衡，否则为内网负载均衡
+func getLBType(cloud typeslb.GcpLoadBalancer) typeslb.TCloudLoadBalancerType {
+	if strings.HasPrefix(cloud.LoadBalancingScheme, "EXTERNAL") {
+		return typeslb.OpenLoadBalancerType
+	}
+	return typeslb.InternalLoadBalancerType
+}
+
+func getLBIPVersion(cloud typeslb.GcpLoadBalancer) enumor.IPAddressType {
+	if cloud.IpVersion == "IPV6" || strings.Contains(cloud.IPAddress, ":") {
+		return enumor.Ipv6
+	}
+	return enumor.Ipv4
+}
+
+func getLBAddresses(cloud typeslb.GcpLoadBalancer) (privateIPv4, privateIPv6, publicIPv4, publicIPv6 []string) {
+	if len(cloud.IPAddress) == 0 {
+		return
+	}
+
+	isIPv6 := getLBIPVersion(cloud) == enumor.Ipv6
+	switch {
+	case getLBType(cloud) == typeslb.OpenLoadBalancerType && isIPv6:
+		publicIPv6 = []string{cloud.IPAddress}
+	case getLBType(cloud) == typeslb.OpenLoadBalancerType:
+		publicIPv4 = []string{cloud.IPAddress}
+	case isIPv6:
+		privateIPv6 = []string{cloud.IPAddress}
+	default:
+		privateIPv4 = []string{cloud.IPAddress}
+	}
+
+	return
+}
+
+func convLBExtension(cloud typeslb.GcpLoadBalancer) *corelb.GcpLoadBalancerExtension {
+	return &corelb.GcpLoadBalancerExtension{
+		SelfLink:            cvt.ValToPtr(cloud.SelfLink),
+		LoadBalancingScheme: cvt.ValToPtr(cloud.LoadBalancingScheme),
+		IPProtocol:          cvt.ValToPtr(cloud.IPProtocol),
+		PortRange:           cvt.ValToPtr(cloud.PortRange),
+		Ports:               cloud.Ports,
+		AllPorts:            cvt.ValToPtr(cloud.AllPorts),
+		Target:              cvt.ValToPtr(cloud.Target),
+		BackendService:      cvt.ValToPtr(cloud.BackendService),
+		NetworkTier:         cvt.ValToPtr(cloud.NetworkTier),
+	}
+}
+
+func isLBChange(cloud typeslb.GcpLoadBalancer, db corelb.GcpLoadBalancer) bool {
+	if db.Name != cloud.Name || db.IPVersion != getLBIPVersion(cloud) {
+		return true
+	}
+
+	if cvt.PtrToVal(db.Memo) != cloud.Description {
+		return true
+	}
+
+	privateIPv4, privateIPv6, publicIPv4, publicIPv6 := getLBAddresses(cloud)
+	if !assert.IsStringSliceEqual(db.PrivateIPv4Addresses, privateIPv4) ||
+		!assert.IsStringSliceEqual(db.PrivateIPv6Addresses, privateIPv6) ||
+		!assert.IsStringSliceEqual(db.PublicIPv4Addresses, publicIPv4) ||
+		!assert.IsStringSliceEqual(db.PublicIPv6Addresses, publicIPv6) {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	ext := convLBExtension(cloud)
+	if !assert.IsPtrStringEqual(db.Extension.SelfLink, ext.SelfLink) ||
+		!assert.IsPtrStringEqual(db.Extension.LoadBalancingScheme, ext.LoadBalancingScheme) ||
+		!assert.IsPtrStringEqual(db.Extension.IPProtocol, ext.IPProtocol) ||
+		!assert.IsPtrStringEqual(db.Extension.PortRange, ext.PortRange) ||
+		!assert.IsPtrStringEqual(db.Extension.Target, ext.Target) ||
+		!assert.IsPtrStringEqual(db.Extension.BackendService, ext.BackendService) ||
+		!assert.IsPtrStringEqual(db.Extension.NetworkTier, ext.NetworkTier) {
+		return true
+	}
+
+	if !assert.IsPtrBoolEqual(db.Extension.AllPorts, ext.AllPorts) ||
+		!assert.IsStringSliceEqual(db.Extension.Ports, ext.Ports) {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,388 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gcp
+
+import (
+	"strconv"
+	"strings"
+
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	adcore "hcm/pkg/adaptor/types/core"
+	typesnatgateway "hcm/pkg/adaptor/types/nat-gateway"
+	"hcm/pkg/api/core"
+	corenatgateway "hcm/pkg/api/core/cloud/nat-gateway"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/assert"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncNatGatewayOption ...
+type SyncNatGatewayOption struct {
+	Region string `json:"region" validate:"required"`
+}
+
+// Validate ...
+func (opt SyncNatGatewayOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// NatGateway 同步NAT网关
+func (cli *client) NatGateway(kt *kit.Kit, params *SyncBaseParams, opt *SyncNatGatewayOption) (*SyncResult, error) {
+	if err := validator.ValidateTool(params, opt); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	natFromCloud, err := cli.listNatGatewayFromCloud(kt, params, opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	natFromDB, err := cli.listNatGatewayFromDB(kt, params, opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(natFromCloud) == 0 && len(natFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.Diff[typesnatgateway.GcpNatGateway,
+		corenatgateway.NatGateway[corenatgateway.GcpNatGatewayExtension]](natFromCloud, natFromDB,
+		isNatGatewayChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteNatGateway(kt, params.AccountID, opt.Region, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	// Cloud NAT 所属的VPC即其 Cloud Router 所在的VPC网络
+	vpcSelfLinks := make([]string, 0, len(addSlice)+len(updateMap))
+	for _, one := range addSlice {
+		vpcSelfLinks = append(vpcSelfLinks, one.Router.Network)
+	}
+	for _, one := range updateMap {
+		vpcSelfLinks = append(vpcSelfLinks, one.Router.Network)
+	}
+	vpcMap, err := cli.getVpcMap(kt, params.AccountID, slice.Unique(vpcSelfLinks))
+	if err != nil {
+		return nil, err
+	}
+	cloudVpcIDs := make([]string, 0, len(vpcMap))
+	for _, vpc := range vpcMap {
+		cloudVpcIDs = append(cloudVpcIDs, vpc.VpcCloudID)
+	}
+	relMap, err := common.GetNetworkVpcRelMap(kt, cli.dbCli, enumor.Gcp, params.AccountID, cloudVpcIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createNatGateway(kt, params.AccountID, opt.Region, addSlice, vpcMap, relMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateNatGateway(kt, updateMap, vpcMap, relMap); err != nil {
+			return nil, err
+		}
+	}
+
+	return new(SyncResult), nil
+}
+
+func (cli *client) createNatGateway(kt *kit.Kit, accountID, region string, addSlice []typesnatgateway.GcpNatGateway,
+	vpcMap map[string]*common.VpcDB, relMap map[string]common.NetworkVpcRel) error {
+
+	createReq := new(protocloud.NatGatewayBatchCreateReq[corenatgateway.GcpNatGatewayExtension])
+	for _, one := range addSlice {
+		nat := protocloud.NatGatewayBatchCreate[corenatgateway.GcpNatGatewayExtension]{
+			CloudID:          one.GetCloudID(),
+			AccountID:        accountID,
+			BkBizID:          constant.UnassignedBiz,
+			Name:             one.Name,
+			Region:           region,
+			PublicIPs:        one.NatIps,
+			CloudCreatedTime: one.Router.CreationTimestamp,
+			Extension:        convGcpNatGatewayExtension(one),
+		}
+		if vpc, exist := vpcMap[one.Router.Network]; exist {
+			nat.VpcID = vpc.VpcID
+			nat.CloudVpcID = vpc.VpcCloudID
+			if rel, ok := relMap[vpc.VpcCloudID]; ok {
+				nat.BkBizID = rel.BkBizID
+			}
+		}
+		createReq.NatGateways = append(createReq.NatGateways, nat)
+	}
+
+	for _, batch := range slice.Split(createReq.NatGateways, constant.BatchOperationMaxLimit) {
+		req := &protocloud.NatGatewayBatchCreateReq[corenatgateway.GcpNatGatewayExtension]{NatGateways: batch}
+		if _, err := cli.dbCli.Gcp.NatGateway.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create nat gateway failed, err: %v, rid: %s",
+				enumor.Gcp, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync nat gateway to create nat gateway success, accountID: %s, count: %d, rid: %s",
+		enumor.Gcp, accountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateNatGateway(kt *kit.Kit, updateMap map[string]typesnatgateway.GcpNatGateway,
+	vpcMap map[string]*common.VpcDB, relMap map[string]common.NetworkVpcRel) error {
+
+	updateReq := make(protocloud.NatGatewayExtBatchUpdateReq[corenatgateway.GcpNatGatewayExtension], 0,
+		len(updateMap))
+	for id, one := range updateMap {
+		nat := &protocloud.NatGatewayExtUpdateReq[corenatgateway.GcpNatGatewayExtension]{
+			ID:        id,
+			Name:      one.Name,
+			PublicIPs: one.NatIps,
+			Extension: convGcpNatGatewayExtension(one),
+		}
+		if vpc, exist := vpcMap[one.Router.Network]; exist {
+			nat.VpcID = vpc.VpcID
+			nat.CloudVpcID = vpc.VpcCloudID
+			if rel, ok := relMap[vpc.VpcCloudID]; ok {
+				nat.BkBizID = rel.BkBizID
+			}
+		}
+		updateReq = append(updateReq, nat)
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.NatGatewayExtBatchUpdateReq[corenatgateway.GcpNatGatewayExtension](batch)
+		if err := cli.dbCli.Gcp.NatGateway.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update nat gateway failed, err: %v, rid: %s",
+				enumor.Gcp, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync nat gateway to update nat gateway success, count: %d, rid: %s", enumor.Gcp,
+		len(updateMap), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) deleteNatGateway(kt *kit.Kit, accountID, region string, delCloudIDs []string) error {
+	checkParams := &SyncBaseParams{AccountID: accountID, CloudIDs: delCloudIDs}
+	delFromCloud, err := cli.listNatGatewayFromCloud(kt, checkParams, region)
+	if err != nil {
+		return err
+	}
+
+	if len(delFromCloud) > 0 {
+		logs.Errorf("[%s] validate nat gateway not exist failed, before delete opt: %v, failed_count: %d, rid: %s",
+			enumor.Gcp, checkParams, len(delFromCloud), kt.Rid)
+		return errf.Newf(errf.Aborted, "validate nat gateway not exist failed, before delete")
+	}
+
+	req := &protocloud.NatGatewayBatchDeleteReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Gcp),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleIn("cloud_id", delCloudIDs),
+		),
+	}
+	if err = cli.dbCli.Global.NatGateway.BatchDelete(kt, req); err != nil {
+		logs.Errorf("[%s] request dataservice to batch delete nat gateway failed, err: %v, rid: %s", enumor.Gcp,
+			err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync nat gateway to delete nat gateway success, accountID: %s, count: %d, rid: %s",
+		enumor.Gcp, accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+// listNatGatewayFromCloud Cloud NAT 只能按路由器查询，查询出路由器下全部NAT后再按云上ID过滤
+func (cli *client) listNatGatewayFromCloud(kt *kit.Kit, params *SyncBaseParams, region string) (
+	[]typesnatgateway.GcpNatGateway, error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	cloudIDMap := converter.StringSliceToMap(params.CloudIDs)
+	routerIDs := make([]string, 0, len(params.CloudIDs))
+	for _, cloudID := range params.CloudIDs {
+		routerIDs = append(routerIDs, strings.SplitN(cloudID, "/", 2)[0])
+	}
+
+	result := make([]typesnatgateway.GcpNatGateway, 0, len(params.CloudIDs))
+	for _, batch := range slice.Split(slice.Unique(routerIDs), adcore.GcpQueryLimit) {
+		opt := &typesnatgateway.GcpNatGatewayListOption{
+			Region:         region,
+			CloudRouterIDs: batch,
+			Page:           &adcore.GcpPage{PageSize: adcore.GcpQueryLimit},
+		}
+		for {
+			nats, nextToken, err := cli.cloudCli.ListNatGateway(kt, opt)
+			if err != nil {
+				logs.Errorf("[%s] list nat gateway from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+					enumor.Gcp, err, params.AccountID, opt, kt.Rid)
+				return nil, err
+			}
+
+			for _, one := range nats {
+				if _, exist := cloudIDMap[one.GetCloudID()]; exist {
+					result = append(result, one)
+				}
+			}
+
+			if len(nextToken) == 0 {
+				break
+			}
+			opt.Page.PageToken = nextToken
+		}
+	}
+
+	return result, nil
+}
+
+func (cli *client) listNatGatewayFromDB(kt *kit.Kit, params *SyncBaseParams, region string) (
+	[]corenatgateway.NatGateway[corenatgateway.GcpNatGatewayExtension], error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", params.AccountID),
+			tools.RuleEqual("region", region),
+			tools.RuleIn("cloud_id", params.CloudIDs),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := cli.dbCli.Gcp.NatGateway.ListNatGatewayExt(kt, req)
+	if err != nil {
+		logs.Errorf("[%s] list nat gateway from db failed, err: %v, account: %s, req: %v, rid: %s", enumor.Gcp,
+			err, params.AccountID, req, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+// RemoveNatGatewayDeleteFromCloud 删除本地存在但云上已被删除的NAT网关
+func (cli *client) RemoveNatGatewayDeleteFromCloud(kt *kit.Kit, accountID string, region string) error {
+	req := &core.ListReq{
+		Fields: []string{"id", "cloud_id"},
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Gcp),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleEqual("region", region),
+		),
+		Page: &core.BasePage{Start: 0, Limit: constant.BatchOperationMaxLimit},
+	}
+	for {
+		resultFromDB, err := cli.dbCli.Global.NatGateway.List(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] request dataservice to list nat gateway failed, err: %v, req: %v, rid: %s",
+				enumor.Gcp, err, req, kt.Rid)
+			return err
+		}
+
+		cloudIDs := make([]string, 0, len(resultFromDB.Details))
+		for _, one := range resultFromDB.Details {
+			cloudIDs = append(cloudIDs, one.CloudID)
+		}
+
+		if len(cloudIDs) == 0 {
+			break
+		}
+
+		params := &SyncBaseParams{AccountID: accountID, CloudIDs: cloudIDs}
+		resultFromCloud, err := cli.listNatGatewayFromCloud(kt, params, region)
+		if err != nil {
+			return err
+		}
+
+		// 如果有资源没有查询出来，说明数据被从云上删除
+		if len(resultFromCloud) != len(cloudIDs) {
+			cloudIDMap := converter.StringSliceToMap(cloudIDs)
+			for _, one := range resultFromCloud {
+				delete(cloudIDMap, one.GetCloudID())
+			}
+
+			err = cli.deleteNatGateway(kt, accountID, region, converter.MapKeyToStringSlice(cloudIDMap))
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(resultFromDB.Details) < constant.BatchOperationMaxLimit {
+			break
+		}
+
+		req.Page.Start += constant.BatchOperationMaxLimit
+	}
+
+	return nil
+}
+
+func convGcpNatGatewayExtension(one typesnatgateway.GcpNatGateway) *corenatgateway.GcpNatGatewayExtension {
+	return &corenatgateway.GcpNatGatewayExtension{
+		CloudRouterID:                 strconv.FormatUint(one.Router.Id, 10),
+		RouterName:                    one.Router.Name,
+		RouterSelfLink:                one.Router.SelfLink,
+		NatIPAllocateOption:           one.NatIpAllocateOption,
+		SourceSubnetworkIPRangesToNat: one.SourceSubnetworkIpRangesToNat,
+		NatIPs:                        one.NatIps,
+	}
+}
+
+func isNatGatewayChange(cloud typesnatgateway.GcpNatGateway,
+	db corenatgateway.NatGateway[corenatgateway.GcpNatGatewayExtension]) bool {
+
+	if cloud.Name != db.Name {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(cloud.NatIps, db.PublicIPs) {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	if cloud.NatIpAllocateOption != db.Extension.NatIPAllocateOption ||
+		cloud.SourceSubnetworkIpRangesToNat != db.Extension.SourceSubnetworkIPRangesToNat {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,307 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package gcp
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typesdns "hcm/pkg/adaptor/types/private-dns"
+	"hcm/pkg/api/core"
+	coreprivatedns "hcm/pkg/api/core/cloud/private-dns"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/runtime/filter"
+	"hcm/pkg/tools/assert"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncPrivateDnsZoneOption 托管区域为全局资源，不区分地域；CloudIDs 不为空时只同步指定的私有托管区域
+type SyncPrivateDnsZoneOption struct {
+	AccountID string   `json:"account_id" validate:"required"`
+	CloudIDs  []string `json:"cloud_ids" validate:"omitempty,max=100"`
+}
+
+// Validate ...
+func (opt SyncPrivateDnsZoneOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// PrivateDnsZone 同步私有托管区域，以及区域下的记录集和关联的VPC，业务由分配操作决定，同步不覆盖
+// gcp 托管区域不返回记录数，需要先查询区域下的记录集，再以记录集数量作为记录数比对
+func (cli *client) PrivateDnsZone(kt *kit.Kit, opt *SyncPrivateDnsZoneOption) (*SyncResult, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	zoneFromCloud, err := cli.listPrivateDnsZoneFromCloud(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	recordMap, err := cli.listPrivateDnsRecordFromCloud(kt, zoneFromCloud)
+	if err != nil {
+		return nil, err
+	}
+
+	zoneFromDB, err := cli.listPrivateDnsZoneFromDB(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(zoneFromCloud) == 0 && len(zoneFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	isChange := func(cloud typesdns.GcpZone, db coreprivatedns.Zone[coreprivatedns.GcpZoneExtension]) bool {
+		return isPrivateDnsZoneChange(cloud, int64(len(recordMap[cloud.GetCloudID()])), db)
+	}
+	addSlice, updateMap, delCloudIDs := common.DiffPaaS[typesdns.GcpZone,
+		coreprivatedns.Zone[coreprivatedns.GcpZoneExtension]](zoneFromCloud, zoneFromDB, isChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deletePrivateDnsZone(kt, opt.AccountID, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createPrivateDnsZone(kt, opt, addSlice, recordMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updatePrivateDnsZone(kt, updateMap, recordMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = cli.privateDnsZoneRes(kt, opt, zoneFromCloud, recordMap); err != nil {
+		return nil, err
+	}
+
+	return new(SyncResult), nil
+}
+
+// privateDnsZoneRes 同步本地私有托管区域的记录集和关联的VPC
+func (cli *client) privateDnsZoneRes(kt *kit.Kit, opt *SyncPrivateDnsZoneOption, zoneFromCloud []typesdns.GcpZone,
+	recordMap map[string][]typesdns.Record) error {
+
+	zoneFromDB, err := cli.listPrivateDnsZoneFromDB(kt, opt)
+	if err != nil {
+		return err
+	}
+
+	cloudMap := make(map[string]typesdns.GcpZone, len(zoneFromCloud))
+	for _, one := range zoneFromCloud {
+		cloudMap[one.GetCloudID()] = one
+	}
+
+	for _, zone := range zoneFromDB {
+		one, exist := cloudMap[zone.CloudID]
+		if !exist {
+			continue
+		}
+
+		res := &common.PrivateDnsZoneRes{Records: recordMap[zone.CloudID], CloudVpcIDs: one.GetNetworkUrls()}
+		if err = common.SyncPrivateDnsZoneRes(kt, cli.dbCli, zone.BaseZone, res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cli *client) createPrivateDnsZone(kt *kit.Kit, opt *SyncPrivateDnsZoneOption, addSlice []typesdns.GcpZone,
+	recordMap map[string][]typesdns.Record) error {
+
+	zones := make([]protocloud.PrivateDnsZoneBatchCreate[coreprivatedns.GcpZoneExtension], 0, len(addSlice))
+	for _, one := range addSlice {
+		zones = append(zones, protocloud.PrivateDnsZoneBatchCreate[coreprivatedns.GcpZoneExtension]{
+			CloudID:          one.GetCloudID(),
+			AccountID:        opt.AccountID,
+			BkBizID:          constant.UnassignedBiz,
+			Name:             typesdns.TrimZoneName(one.DnsName),
+			RecordCount:      int64(len(recordMap[one.GetCloudID()])),
+			CloudCreatedTime: one.CreationTime,
+			Memo:             converter.ValToPtr(one.Description),
+			Extension:        convGcpPrivateDnsZoneExtension(one),
+		})
+	}
+
+	for _, batch := range slice.Split(zones, constant.BatchOperationMaxLimit) {
+		req := &protocloud.PrivateDnsZoneBatchCreateReq[coreprivatedns.GcpZoneExtension]{Zones: batch}
+		if _, err := cli.dbCli.Gcp.PrivateDnsZone.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create private dns zone failed, err: %v, rid: %s",
+				enumor.Gcp, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync private dns zone to create private dns zone success, accountID: %s, count: %d, rid: %s",
+		enumor.Gcp, opt.AccountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updatePrivateDnsZone(kt *kit.Kit, updateMap map[string]typesdns.GcpZone,
+	recordMap map[string][]typesdns.Record) error {
+
+	updateReq := make(protocloud.PrivateDnsZoneExtBatchUpdateReq[coreprivatedns.GcpZoneExtension], 0, len(updateMap))
+	for id, one := range updateMap {
+		updateReq = append(updateReq, &protocloud.PrivateDnsZoneExtUpdateReq[coreprivatedns.GcpZoneExtension]{
+			ID:          id,
+			Name:        typesdns.TrimZoneName(one.DnsName),
+			RecordCount: int64(len(recordMap[one.GetCloudID()])),
+			Memo:        converter.ValToPtr(one.Description),
+			Extension:   convGcpPrivateDnsZoneExtension(one),
+		})
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.PrivateDnsZoneExtBatchUpdateReq[coreprivatedns.GcpZoneExtension](batch)
+		if err := cli.dbCli.Gcp.PrivateDnsZone.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update private dns zone failed, err: %v, rid: %s",
+				enumor.Gcp, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync private dns zone to update private dns zone success, count: %d, rid: %s", enumor.Gcp,
+		len(updateMap), kt.Rid)
+
+	return nil
+}
+
+// deletePrivateDnsZone 删除私有域时，data-service 会同时删除私有域下的解析记录和VPC关联关系
+func (cli *client) deletePrivateDnsZone(kt *kit.Kit, accountID string, delCloudIDs []string) error {
+	for _, batch := range slice.Split(delCloudIDs, constant.BatchOperationMaxLimit) {
+		req := &protocloud.PrivateDnsZoneBatchDeleteReq{
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("vendor", enumor.Gcp),
+				tools.RuleEqual("account_id", accountID),
+				tools.RuleIn("cloud_id", batch),
+			),
+		}
+		if err := cli.dbCli.Global.PrivateDnsZone.BatchDelete(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch delete private dns zone failed, err: %v, rid: %s",
+				enumor.Gcp, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync private dns zone to delete private dns zone success, accountID: %s, count: %d, rid: %s",
+		enumor.Gcp, accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) listPrivateDnsZoneFromCloud(kt *kit.Kit, opt *SyncPrivateDnsZoneOption) (
+	[]typesdns.GcpZone, error) {
+
+	listOpt := &typesdns.GcpZoneListOption{CloudIDs: opt.CloudIDs}
+	zones, err := cli.cloudCli.ListPrivateDnsZone(kt, listOpt)
+	if err != nil {
+		logs.Errorf("[%s] list private dns zone from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+			enumor.Gcp, err, opt.AccountID, listOpt, kt.Rid)
+		return nil, err
+	}
+
+	return zones, nil
+}
+
+// listPrivateDnsRecordFromCloud 查询托管区域下的记录集，key 为托管区域云上ID
+func (cli *client) listPrivateDnsRecordFromCloud(kt *kit.Kit, zones []typesdns.GcpZone) (
+	map[string][]typesdns.Record, error) {
+
+	recordMap := make(map[string][]typesdns.Record, len(zones))
+	for _, one := range zones {
+		listOpt := &typesdns.GcpRecordListOption{ZoneName: one.Name, DnsName: one.DnsName}
+		records, err := cli.cloudCli.ListPrivateDnsRecord(kt, listOpt)
+		if err != nil {
+			logs.Errorf("[%s] list private dns record from cloud failed, err: %v, opt: %v, rid: %s", enumor.Gcp,
+				err, listOpt, kt.Rid)
+			return nil, err
+		}
+		recordMap[one.GetCloudID()] = records
+	}
+
+	return recordMap, nil
+}
+
+func (cli *client) listPrivateDnsZoneFromDB(kt *kit.Kit, opt *SyncPrivateDnsZoneOption) (
+	[]coreprivatedns.Zone[coreprivatedns.GcpZoneExtension], error) {
+
+	rules := []*filter.AtomRule{
+		tools.RuleEqual("vendor", enumor.Gcp),
+		tools.RuleEqual("account_id", opt.AccountID),
+	}
+	if len(opt.CloudIDs) != 0 {
+		rules = append(rules, tools.RuleIn("cloud_id", opt.CloudIDs))
+	}
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(rules...),
+		Page:   core.NewDefaultBasePage(),
+	}
+	result := make([]coreprivatedns.Zone[coreprivatedns.GcpZoneExtension], 0)
+	for {
+		resp, err := cli.dbCli.Gcp.PrivateDnsZone.ListPrivateDnsZoneExt(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] list private dns zone from db failed, err: %v, account: %s, req: %v, rid: %s",
+				enumor.Gcp, err, opt.AccountID, req, kt.Rid)
+			return nil, err
+		}
+
+		result = append(result, resp.Details...)
+
+		if len(resp.Details) < int(core.DefaultMaxPageLimit) {
+			break
+		}
+
+		req.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return result, nil
+}
+
+func convGcpPrivateDnsZoneExtension(one typesdns.GcpZone) *coreprivatedns.GcpZoneExtension {
+	return &coreprivatedns.GcpZoneExtension{ZoneName: one.Name}
+}
+
+func isPrivateDnsZoneChange(cloud typesdns.GcpZone, recordCount int64,
+	db coreprivatedns.Zone[coreprivatedns.GcpZoneExtension]) bool {
+
+	if typesdns.TrimZoneName(cloud.DnsName) != db.Name || recordCount != db.RecordCount ||
+		!assert.IsPtrStringEqual(converter.ValToPtr(cloud.Description), db.Memo) {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	return *convGcpPrivateDnsZoneExtension(cloud) != *db.Extension
+}
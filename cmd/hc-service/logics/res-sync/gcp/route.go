@@ -25,6 +25,7 @@ import (
 	"hcm/cmd/hc-service/logics/res-sync/common"
 	adcore "hcm/pkg/adaptor/types/core"
 	typesroutetable "hcm/pkg/adaptor/types/route-table"
+	typesvpcpeering "hcm/pkg/adaptor/types/vpc-peering"
 	"hcm/pkg/api/core"
 	cloudcoreroutetable "hcm/pkg/api/core/cloud/route-table"
 	dataservice "hcm/pkg/api/data-service"
@@ -38,6 +39,7 @@ import (
 	"hcm/pkg/logs"
 	"hcm/pkg/runtime/filter"
 	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
 )
 
 // SyncRouteOption ...
@@ -100,6 +102,11 @@ func (cli *client) createRoute(kt *kit.Kit, accountID string,
 		return fmt.Errorf("route addSlice is <= 0, not create")
 	}
 
+	hopMap, err := cli.getRouteNextHopMap(kt, accountID, addSlice)
+	if err != nil {
+		return err
+	}
+
 	createResources := make([]routetable.GcpRouteCreateReq, 0, len(addSlice))
 
 	for _, one := range addSlice {
@@ -122,6 +129,10 @@ func (cli *client) createRoute(kt *kit.Kit, accountID string,
 			Tags:             one.Tags,
 			Memo:             one.Memo,
 		}
+		if hop, exist := hopMap[one.CloudID]; exist {
+			tmpRes.NextHopResType = hop.ResType
+			tmpRes.NextHopResID = hop.ResID
+		}
 		createResources = append(createResources, tmpRes)
 	}
 
@@ -140,6 +151,51 @@ func (cli *client) createRoute(kt *kit.Kit, accountID string,
 	return nil
 }
 
+// getRouteNextHopMap 获取下一跳为对等连接的路由对应的本地对等连接，返回 路由云上ID -> 本地资源 的映射。
+// gcp路由中只记录了对等连接名称，需要结合路由所属VPC拼出对等连接的云上ID
+func (cli *client) getRouteNextHopMap(kt *kit.Kit, accountID string, routes []typesroutetable.GcpRoute) (
+	map[string]common.RouteNextHop, error) {
+
+	selfLinks := make([]string, 0)
+	for _, one := range routes {
+		if len(converter.PtrToVal(one.NextHopPeering)) != 0 {
+			selfLinks = append(selfLinks, one.Network)
+		}
+	}
+	if len(selfLinks) == 0 {
+		return make(map[string]common.RouteNextHop), nil
+	}
+
+	vpcMap, err := cli.getVpcMap(kt, accountID, slice.Unique(selfLinks))
+	if err != nil {
+		return nil, err
+	}
+
+	routePeeringMap := make(map[string]string)
+	for _, one := range routes {
+		vpc, exist := vpcMap[one.Network]
+		if !exist || len(converter.PtrToVal(one.NextHopPeering)) == 0 {
+			continue
+		}
+		routePeeringMap[one.CloudID] = typesvpcpeering.GcpVpcPeeringCloudID(vpc.VpcCloudID, *one.NextHopPeering)
+	}
+
+	peeringMap, err := common.GetRouteNextHopMap(kt, cli.dbCli, enumor.Gcp, accountID, nil,
+		converter.MapValueToSlice(routePeeringMap))
+	if err != nil {
+		return nil, err
+	}
+
+	hopMap := make(map[string]common.RouteNextHop, len(routePeeringMap))
+	for routeCloudID, peeringCloudID := range routePeeringMap {
+		if hop, exist := peeringMap[peeringCloudID]; exist {
+			hopMap[routeCloudID] = hop
+		}
+	}
+
+	return hopMap, nil
+}
+
 // gcp路由不支持更新，所以目前不适配更新操作
 func (cli *client) updateRoute(kt *kit.Kit, accountID string,
 	updateMap map[string]typesroutetable.GcpRoute) error {
@@ -0,0 +1,397 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gcp
+
+import (
+	"strings"
+
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	adcore "hcm/pkg/adaptor/types/core"
+	typesvpcpeering "hcm/pkg/adaptor/types/vpc-peering"
+	"hcm/pkg/api/core"
+	corevpcpeering "hcm/pkg/api/core/cloud/vpc-peering"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncVpcPeeringOption ...
+type SyncVpcPeeringOption struct {
+}
+
+// Validate ...
+func (opt SyncVpcPeeringOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// VpcPeering 同步对等连接
+func (cli *client) VpcPeering(kt *kit.Kit, params *SyncBaseParams, opt *SyncVpcPeeringOption) (*SyncResult, error) {
+	if err := validator.ValidateTool(params, opt); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	peeringFromCloud, err := cli.listVpcPeeringFromCloud(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	peeringFromDB, err := cli.listVpcPeeringFromDB(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(peeringFromCloud) == 0 && len(peeringFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.Diff[typesvpcpeering.GcpVpcPeering,
+		corevpcpeering.VpcPeering[corevpcpeering.GcpVpcPeeringExtension]](peeringFromCloud, peeringFromDB,
+		isVpcPeeringChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteVpcPeering(kt, params.AccountID, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	// 对端VPC网络只记录了URL，仅能关联到同一账号下已同步的VPC
+	cloudVpcIDs := make([]string, 0, len(addSlice)+len(updateMap))
+	peerSelfLinks := make([]string, 0, len(addSlice)+len(updateMap))
+	for _, one := range addSlice {
+		cloudVpcIDs = append(cloudVpcIDs, one.CloudVpcID)
+		peerSelfLinks = append(peerSelfLinks, one.Network)
+	}
+	for _, one := range updateMap {
+		cloudVpcIDs = append(cloudVpcIDs, one.CloudVpcID)
+		peerSelfLinks = append(peerSelfLinks, one.Network)
+	}
+	vpcMap, err := common.GetNetworkVpcRelMap(kt, cli.dbCli, enumor.Gcp, params.AccountID, cloudVpcIDs)
+	if err != nil {
+		return nil, err
+	}
+	peerVpcMap, err := cli.getVpcMap(kt, params.AccountID, slice.Unique(peerSelfLinks))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createVpcPeering(kt, params.AccountID, addSlice, vpcMap, peerVpcMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateVpcPeering(kt, updateMap, vpcMap, peerVpcMap); err != nil {
+			return nil, err
+		}
+	}
+
+	return new(SyncResult), nil
+}
+
+func (cli *client) createVpcPeering(kt *kit.Kit, accountID string, addSlice []typesvpcpeering.GcpVpcPeering,
+	vpcMap map[string]common.NetworkVpcRel, peerVpcMap map[string]*common.VpcDB) error {
+
+	createReq := new(protocloud.VpcPeeringBatchCreateReq[corevpcpeering.GcpVpcPeeringExtension])
+	for _, one := range addSlice {
+		peering := protocloud.VpcPeeringBatchCreate[corevpcpeering.GcpVpcPeeringExtension]{
+			CloudID:     one.GetCloudID(),
+			AccountID:   accountID,
+			BkBizID:     constant.UnassignedBiz,
+			Name:        one.Name,
+			Status:      one.State,
+			VpcID:       vpcMap[one.CloudVpcID].VpcID,
+			CloudVpcID:  one.CloudVpcID,
+			PeerAccount: getGcpProjectFromSelfLink(one.Network),
+			Extension:   convGcpVpcPeeringExtension(one),
+		}
+		if peerVpc, exist := peerVpcMap[one.Network]; exist {
+			peering.PeerVpcID = peerVpc.VpcID
+			peering.CloudPeerVpcID = peerVpc.VpcCloudID
+		}
+		if rel, exist := vpcMap[one.CloudVpcID]; exist {
+			peering.BkBizID = rel.BkBizID
+		}
+		createReq.VpcPeerings = append(createReq.VpcPeerings, peering)
+	}
+
+	for _, batch := range slice.Split(createReq.VpcPeerings, constant.BatchOperationMaxLimit) {
+		req := &protocloud.VpcPeeringBatchCreateReq[corevpcpeering.GcpVpcPeeringExtension]{VpcPeerings: batch}
+		if _, err := cli.dbCli.Gcp.VpcPeering.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create vpc peering failed, err: %v, rid: %s",
+				enumor.Gcp, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync vpc peering to create vpc peering success, accountID: %s, count: %d, rid: %s",
+		enumor.Gcp, accountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateVpcPeering(kt *kit.Kit, updateMap map[string]typesvpcpeering.GcpVpcPeering,
+	vpcMap map[string]common.NetworkVpcRel, peerVpcMap map[string]*common.VpcDB) error {
+
+	updateReq := make(protocloud.VpcPeeringExtBatchUpdateReq[corevpcpeering.GcpVpcPeeringExtension], 0,
+		len(updateMap))
+	for id, one := range updateMap {
+		peering := &protocloud.VpcPeeringExtUpdateReq[corevpcpeering.GcpVpcPeeringExtension]{
+			ID:          id,
+			Name:        one.Name,
+			Status:      one.State,
+			VpcID:       vpcMap[one.CloudVpcID].VpcID,
+			CloudVpcID:  one.CloudVpcID,
+			PeerAccount: getGcpProjectFromSelfLink(one.Network),
+			Extension:   convGcpVpcPeeringExtension(one),
+		}
+		if peerVpc, exist := peerVpcMap[one.Network]; exist {
+			peering.PeerVpcID = peerVpc.VpcID
+			peering.CloudPeerVpcID = peerVpc.VpcCloudID
+		}
+		if rel, exist := vpcMap[one.CloudVpcID]; exist {
+			peering.BkBizID = rel.BkBizID
+		}
+		updateReq = append(updateReq, peering)
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.VpcPeeringExtBatchUpdateReq[corevpcpeering.GcpVpcPeeringExtension](batch)
+		if err := cli.dbCli.Gcp.VpcPeering.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update vpc peering failed, err: %v, rid: %s",
+				enumor.Gcp, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync vpc peering to update vpc peering success, count: %d, rid: %s", enumor.Gcp,
+		len(updateMap), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) deleteVpcPeering(kt *kit.Kit, accountID string, delCloudIDs []string) error {
+	checkParams := &SyncBaseParams{AccountID: accountID, CloudIDs: delCloudIDs}
+	delFromCloud, err := cli.listVpcPeeringFromCloud(kt, checkParams)
+	if err != nil {
+		return err
+	}
+
+	if len(delFromCloud) > 0 {
+		logs.Errorf("[%s] validate vpc peering not exist failed, before delete opt: %v, failed_count: %d, rid: %s",
+			enumor.Gcp, checkParams, len(delFromCloud), kt.Rid)
+		return errf.Newf(errf.Aborted, "validate vpc peering not exist failed, before delete")
+	}
+
+	req := &protocloud.VpcPeeringBatchDeleteReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Gcp),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleIn("cloud_id", delCloudIDs),
+		),
+	}
+	if err = cli.dbCli.Global.VpcPeering.BatchDelete(kt, req); err != nil {
+		logs.Errorf("[%s] request dataservice to batch delete vpc peering failed, err: %v, rid: %s", enumor.Gcp,
+			err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync vpc peering to delete vpc peering success, accountID: %s, count: %d, rid: %s",
+		enumor.Gcp, accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+// listVpcPeeringFromCloud 对等连接只能按VPC网络查询，查询出VPC网络下全部对等连接后再按云上ID过滤
+func (cli *client) listVpcPeeringFromCloud(kt *kit.Kit, params *SyncBaseParams) ([]typesvpcpeering.GcpVpcPeering,
+	error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	cloudIDMap := converter.StringSliceToMap(params.CloudIDs)
+	cloudVpcIDs := make([]string, 0, len(params.CloudIDs))
+	for _, cloudID := range params.CloudIDs {
+		cloudVpcIDs = append(cloudVpcIDs, strings.SplitN(cloudID, "/", 2)[0])
+	}
+
+	result := make([]typesvpcpeering.GcpVpcPeering, 0, len(params.CloudIDs))
+	for _, batch := range slice.Split(slice.Unique(cloudVpcIDs), adcore.GcpQueryLimit) {
+		opt := &typesvpcpeering.GcpVpcPeeringListOption{
+			CloudVpcIDs: batch,
+			Page:        &adcore.GcpPage{PageSize: adcore.GcpQueryLimit},
+		}
+		for {
+			peerings, nextToken, err := cli.cloudCli.ListVpcPeering(kt, opt)
+			if err != nil {
+				logs.Errorf("[%s] list vpc peering from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+					enumor.Gcp, err, params.AccountID, opt, kt.Rid)
+				return nil, err
+			}
+
+			for _, one := range peerings {
+				if _, exist := cloudIDMap[one.GetCloudID()]; exist {
+					result = append(result, one)
+				}
+			}
+
+			if len(nextToken) == 0 {
+				break
+			}
+			opt.Page.PageToken = nextToken
+		}
+	}
+
+	return result, nil
+}
+
+func (cli *client) listVpcPeeringFromDB(kt *kit.Kit, params *SyncBaseParams) (
+	[]corevpcpeering.VpcPeering[corevpcpeering.GcpVpcPeeringExtension], error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", params.AccountID),
+			tools.RuleIn("cloud_id", params.CloudIDs),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := cli.dbCli.Gcp.VpcPeering.ListVpcPeeringExt(kt, req)
+	if err != nil {
+		logs.Errorf("[%s] list vpc peering from db failed, err: %v, account: %s, req: %v, rid: %s", enumor.Gcp,
+			err, params.AccountID, req, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+// RemoveVpcPeeringDeleteFromCloud 删除本地存在但云上已被删除的对等连接
+func (cli *client) RemoveVpcPeeringDeleteFromCloud(kt *kit.Kit, accountID string) error {
+	req := &core.ListReq{
+		Fields: []string{"id", "cloud_id"},
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.Gcp),
+			tools.RuleEqual("account_id", accountID),
+		),
+		Page: &core.BasePage{Start: 0, Limit: constant.BatchOperationMaxLimit},
+	}
+	for {
+		resultFromDB, err := cli.dbCli.Global.VpcPeering.List(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] request dataservice to list vpc peering failed, err: %v, req: %v, rid: %s",
+				enumor.Gcp, err, req, kt.Rid)
+			return err
+		}
+
+		cloudIDs := make([]string, 0, len(resultFromDB.Details))
+		for _, one := range resultFromDB.Details {
+			cloudIDs = append(cloudIDs, one.CloudID)
+		}
+
+		if len(cloudIDs) == 0 {
+			break
+		}
+
+		params := &SyncBaseParams{AccountID: accountID, CloudIDs: cloudIDs}
+		resultFromCloud, err := cli.listVpcPeeringFromCloud(kt, params)
+		if err != nil {
+			return err
+		}
+
+		// 如果有资源没有查询出来，说明数据被从云上删除
+		if len(resultFromCloud) != len(cloudIDs) {
+			cloudIDMap := converter.StringSliceToMap(cloudIDs)
+			for _, one := range resultFromCloud {
+				delete(cloudIDMap, one.GetCloudID())
+			}
+
+			if err = cli.deleteVpcPeering(kt, accountID, converter.MapKeyToStringSlice(cloudIDMap)); err != nil {
+				return err
+			}
+		}
+
+		if len(resultFromDB.Details) < constant.BatchOperationMaxLimit {
+			break
+		}
+
+		req.Page.Start += constant.BatchOperationMaxLimit
+	}
+
+	return nil
+}
+
+// getGcpProjectFromSelfLink 从VPC网络URL中解析项目ID，
+// 格式为 https://www.googleapis.com/compute/v1/projects/{project}/global/networks/{network}
+func getGcpProjectFromSelfLink(selfLink string) string {
+	parts := strings.Split(selfLink, "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] == "projects" {
+			return parts[i+1]
+		}
+	}
+
+	return ""
+}
+
+func convGcpVpcPeeringExtension(one typesvpcpeering.GcpVpcPeering) *corevpcpeering.GcpVpcPeeringExtension {
+	return &corevpcpeering.GcpVpcPeeringExtension{
+		VpcSelfLink:          one.VpcSelfLink,
+		PeerVpcSelfLink:      one.Network,
+		StateDetails:         one.StateDetails,
+		ExchangeSubnetRoutes: one.ExchangeSubnetRoutes,
+		ExportCustomRoutes:   one.ExportCustomRoutes,
+		ImportCustomRoutes:   one.ImportCustomRoutes,
+	}
+}
+
+func isVpcPeeringChange(cloud typesvpcpeering.GcpVpcPeering,
+	db corevpcpeering.VpcPeering[corevpcpeering.GcpVpcPeeringExtension]) bool {
+
+	if cloud.State != db.Status {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	if cloud.Network != db.Extension.PeerVpcSelfLink || cloud.StateDetails != db.Extension.StateDetails {
+		return true
+	}
+
+	if cloud.ExportCustomRoutes != db.Extension.ExportCustomRoutes ||
+		cloud.ImportCustomRoutes != db.Extension.ImportCustomRoutes {
+		return true
+	}
+
+	return false
+}
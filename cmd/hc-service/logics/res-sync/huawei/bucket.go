@@ -0,0 +1,282 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package huawei
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typesbucket "hcm/pkg/adaptor/types/bucket"
+	"hcm/pkg/api/core"
+	corebucket "hcm/pkg/api/core/cloud/bucket"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/slice"
+	"hcm/pkg/tools/times"
+)
+
+// SyncBucketOption ...
+type SyncBucketOption struct {
+	AccountID string `json:"account_id" validate:"required"`
+	// Region 查询存储桶列表使用的地域，存储桶详情使用存储桶所在地域查询
+	Region string `json:"region" validate:"required"`
+}
+
+// Validate ...
+func (opt SyncBucketOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// Bucket 同步账号下全部OBS存储桶，存储桶不区分地域查询，业务由分配操作决定，同步不覆盖
+func (cli *client) Bucket(kt *kit.Kit, opt *SyncBucketOption) (*SyncResult, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	bucketFromCloud, err := cli.listBucketFromCloud(kt, opt.AccountID, opt.Region, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketFromDB, err := cli.listBucketFromDB(kt, opt.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bucketFromCloud) == 0 && len(bucketFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.DiffPaaS[typesbucket.HuaWeiBucket,
+		corebucket.Bucket[corebucket.HuaWeiBucketExtension]](bucketFromCloud, bucketFromDB, isBucketChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteBucket(kt, opt.AccountID, opt.Region, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createBucket(kt, opt.AccountID, addSlice); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateBucket(kt, updateMap); err != nil {
+			return nil, err
+		}
+	}
+
+	return new(SyncResult), nil
+}
+
+func (cli *client) createBucket(kt *kit.Kit, accountID string, addSlice []typesbucket.HuaWeiBucket) error {
+	buckets := make([]protocloud.BucketBatchCreate[corebucket.HuaWeiBucketExtension], 0, len(addSlice))
+	for _, one := range addSlice {
+		buckets = append(buckets, protocloud.BucketBatchCreate[corebucket.HuaWeiBucketExtension]{
+			CloudID:          one.GetCloudID(),
+			AccountID:        accountID,
+			BkBizID:          constant.UnassignedBiz,
+			Name:             one.Name,
+			Region:           one.Location,
+			StorageClass:     one.StorageClass,
+			PublicAccess:     one.GetPublicAccess(),
+			Encryption:       one.GetEncryption(),
+			CloudCreatedTime: times.ConvStdTimeFormat(one.CreationDate),
+			Extension:        convHuaWeiBucketExtension(one),
+		})
+	}
+
+	for _, batch := range slice.Split(buckets, constant.BatchOperationMaxLimit) {
+		req := &protocloud.BucketBatchCreateReq[corebucket.HuaWeiBucketExtension]{Buckets: batch}
+		if _, err := cli.dbCli.HuaWei.Bucket.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create bucket failed, err: %v, rid: %s", enumor.HuaWei,
+				err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync bucket to create bucket success, accountID: %s, count: %d, rid: %s", enumor.HuaWei,
+		accountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateBucket(kt *kit.Kit, updateMap map[string]typesbucket.HuaWeiBucket) error {
+	updateReq := make(protocloud.BucketExtBatchUpdateReq[corebucket.HuaWeiBucketExtension], 0, len(updateMap))
+	for id, one := range updateMap {
+		updateReq = append(updateReq, &protocloud.BucketExtUpdateReq[corebucket.HuaWeiBucketExtension]{
+			ID:           id,
+			Name:         one.Name,
+			Region:       one.Location,
+			StorageClass: one.StorageClass,
+			PublicAccess: one.GetPublicAccess(),
+			Encryption:   one.GetEncryption(),
+			Extension:    convHuaWeiBucketExtension(one),
+		})
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.BucketExtBatchUpdateReq[corebucket.HuaWeiBucketExtension](batch)
+		if err := cli.dbCli.HuaWei.Bucket.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update bucket failed, err: %v, rid: %s", enumor.HuaWei,
+				err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync bucket to update bucket success, count: %d, rid: %s", enumor.HuaWei, len(updateMap),
+		kt.Rid)
+
+	return nil
+}
+
+func (cli *client) deleteBucket(kt *kit.Kit, accountID, region string, delCloudIDs []string) error {
+	delFromCloud, err := cli.listBucketFromCloud(kt, accountID, region, delCloudIDs)
+	if err != nil {
+		return err
+	}
+
+	if len(delFromCloud) > 0 {
+		logs.Errorf("[%s] validate bucket not exist failed, before delete, account: %s, failed_count: %d, rid: %s",
+			enumor.HuaWei, accountID, len(delFromCloud), kt.Rid)
+		return errf.Newf(errf.Aborted, "validate bucket not exist failed, before delete")
+	}
+
+	for _, batch := range slice.Split(delCloudIDs, constant.BatchOperationMaxLimit) {
+		req := &protocloud.BucketBatchDeleteReq{
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("vendor", enumor.HuaWei),
+				tools.RuleEqual("account_id", accountID),
+				tools.RuleIn("cloud_id", batch),
+			),
+		}
+		if err = cli.dbCli.Global.Bucket.BatchDelete(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch delete bucket failed, err: %v, rid: %s", enumor.HuaWei,
+				err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync bucket to delete bucket success, accountID: %s, count: %d, rid: %s", enumor.HuaWei,
+		accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+// listBucketFromCloud cloudIDs 为空时查询账号下全部存储桶
+func (cli *client) listBucketFromCloud(kt *kit.Kit, accountID, region string, cloudIDs []string) (
+	[]typesbucket.HuaWeiBucket, error) {
+
+	if len(cloudIDs) == 0 {
+		result, err := cli.cloudCli.ListBucket(kt, &typesbucket.HuaWeiBucketListOption{Region: region})
+		if err != nil {
+			logs.Errorf("[%s] list bucket from cloud failed, err: %v, account: %s, rid: %s", enumor.HuaWei, err,
+				accountID, kt.Rid)
+			return nil, err
+		}
+		return result, nil
+	}
+
+	result := make([]typesbucket.HuaWeiBucket, 0, len(cloudIDs))
+	for _, batch := range slice.Split(cloudIDs, typesbucket.BucketListMaxLimit) {
+		opt := &typesbucket.HuaWeiBucketListOption{Region: region, CloudIDs: batch}
+		buckets, err := cli.cloudCli.ListBucket(kt, opt)
+		if err != nil {
+			logs.Errorf("[%s] list bucket from cloud failed, err: %v, account: %s, opt: %v, rid: %s", enumor.HuaWei,
+				err, accountID, opt, kt.Rid)
+			return nil, err
+		}
+		result = append(result, buckets...)
+	}
+
+	return result, nil
+}
+
+func (cli *client) listBucketFromDB(kt *kit.Kit, accountID string) (
+	[]corebucket.Bucket[corebucket.HuaWeiBucketExtension], error) {
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.HuaWei),
+			tools.RuleEqual("account_id", accountID),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result := make([]corebucket.Bucket[corebucket.HuaWeiBucketExtension], 0)
+	for {
+		resp, err := cli.dbCli.HuaWei.Bucket.ListBucketExt(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] list bucket from db failed, err: %v, account: %s, req: %v, rid: %s", enumor.HuaWei,
+				err, accountID, req, kt.Rid)
+			return nil, err
+		}
+
+		result = append(result, resp.Details...)
+
+		if len(resp.Details) < int(core.DefaultMaxPageLimit) {
+			break
+		}
+
+		req.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return result, nil
+}
+
+func convHuaWeiBucketExtension(one typesbucket.HuaWeiBucket) *corebucket.HuaWeiBucketExtension {
+	ext := &corebucket.HuaWeiBucketExtension{
+		BucketType:         one.BucketType,
+		ACLPublicAccess:    one.ACLPublicAccess,
+		PolicyPublicAccess: one.PolicyPublicAccess,
+	}
+	if one.Encryption != nil {
+		ext.KMSMasterKeyID = one.Encryption.KMSMasterKeyID
+	}
+
+	return ext
+}
+
+func isBucketChange(cloud typesbucket.HuaWeiBucket, db corebucket.Bucket[corebucket.HuaWeiBucketExtension]) bool {
+	if cloud.Name != db.Name || cloud.Location != db.Region || cloud.StorageClass != db.StorageClass {
+		return true
+	}
+
+	if cloud.GetPublicAccess() != db.PublicAccess || cloud.GetEncryption() != db.Encryption {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	ext := convHuaWeiBucketExtension(cloud)
+	if ext.BucketType != db.Extension.BucketType || ext.ACLPublicAccess != db.Extension.ACLPublicAccess ||
+		ext.PolicyPublicAccess != db.Extension.PolicyPublicAccess || ext.KMSMasterKeyID != db.Extension.KMSMasterKeyID {
+		return true
+	}
+
+	return false
+}
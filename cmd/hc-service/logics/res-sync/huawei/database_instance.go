@@ -0,0 +1,450 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package huawei
+
+import (
+	"strings"
+
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typesdbinstance "hcm/pkg/adaptor/types/database-instance"
+	"hcm/pkg/api/core"
+	coredbinstance "hcm/pkg/api/core/cloud/database-instance"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncDatabaseInstanceOption ...
+type SyncDatabaseInstanceOption struct {
+}
+
+// Validate ...
+func (opt SyncDatabaseInstanceOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// DatabaseInstance 同步RDS实例，业务由分配操作决定，同步不覆盖
+func (cli *client) DatabaseInstance(kt *kit.Kit, params *SyncBaseParams, opt *SyncDatabaseInstanceOption) (
+	*SyncResult, error) {
+
+	if err := validator.ValidateTool(params, opt); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	dbFromCloud, err := cli.listDBInstanceFromCloud(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	dbFromDB, err := cli.listDBInstanceFromDB(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dbFromCloud) == 0 && len(dbFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.DiffPaaS[typesdbinstance.HuaWeiDBInstance,
+		coredbinstance.DatabaseInstance[coredbinstance.HuaWeiDBInstanceExtension]](dbFromCloud, dbFromDB,
+		isDBInstanceChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteDBInstance(kt, params.AccountID, params.Region, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	cloudVpcIDs := make([]string, 0, len(addSlice)+len(updateMap))
+	cloudSubnetIDs := make([]string, 0, len(addSlice)+len(updateMap))
+	for _, one := range addSlice {
+		cloudVpcIDs = append(cloudVpcIDs, one.VpcId)
+		cloudSubnetIDs = append(cloudSubnetIDs, one.SubnetId)
+	}
+	for _, one := range updateMap {
+		cloudVpcIDs = append(cloudVpcIDs, one.VpcId)
+		cloudSubnetIDs = append(cloudSubnetIDs, one.SubnetId)
+	}
+	vpcMap, err := common.GetNetworkVpcRelMap(kt, cli.dbCli, enumor.HuaWei, params.AccountID, cloudVpcIDs)
+	if err != nil {
+		return nil, err
+	}
+	_, subnetMap, err := common.GetLoadBalancerVpcSubnetMap(kt, cli.dbCli, enumor.HuaWei, params.AccountID, nil,
+		cloudSubnetIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createDBInstance(kt, params.AccountID, params.Region, addSlice, vpcMap, subnetMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateDBInstance(kt, updateMap, vpcMap, subnetMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = cli.dbInstanceSgRel(kt, params, dbFromCloud); err != nil {
+		return nil, err
+	}
+
+	return new(SyncResult), nil
+}
+
+func (cli *client) createDBInstance(kt *kit.Kit, accountID, region string,
+	addSlice []typesdbinstance.HuaWeiDBInstance, vpcMap map[string]common.NetworkVpcRel,
+	subnetMap map[string]string) error {
+
+	createReq := new(protocloud.DatabaseInstanceBatchCreateReq[coredbinstance.HuaWeiDBInstanceExtension])
+	for _, one := range addSlice {
+		engine, engineVersion := getHuaWeiDBInstanceDatastore(one)
+		db := protocloud.DatabaseInstanceBatchCreate[coredbinstance.HuaWeiDBInstanceExtension]{
+			CloudID:          one.GetCloudID(),
+			AccountID:        accountID,
+			BkBizID:          constant.UnassignedBiz,
+			Name:             one.Name,
+			Region:           region,
+			Zone:             one.GetZone(),
+			Status:           one.Status,
+			Engine:           engine,
+			EngineVersion:    engineVersion,
+			InstanceClass:    one.FlavorRef,
+			StorageSize:      getHuaWeiDBInstanceStorageSize(one),
+			ChargeType:       getHuaWeiDBInstanceChargeType(one),
+			VpcID:            vpcMap[one.VpcId].VpcID,
+			CloudVpcID:       one.VpcId,
+			SubnetID:         subnetMap[one.SubnetId],
+			CloudSubnetID:    one.SubnetId,
+			PrivateAddress:   strings.Join(one.PrivateIps, ","),
+			PublicAddress:    strings.Join(one.PublicIps, ","),
+			Port:             int64(one.Port),
+			CloudCreatedTime: one.Created,
+			CloudExpiredTime: converter.PtrToVal(one.ExpirationTime),
+			Extension:        convHuaWeiDBInstanceExtension(one),
+		}
+		createReq.DatabaseInstances = append(createReq.DatabaseInstances, db)
+	}
+
+	for _, batch := range slice.Split(createReq.DatabaseInstances, constant.BatchOperationMaxLimit) {
+		req := &protocloud.DatabaseInstanceBatchCreateReq[coredbinstance.HuaWeiDBInstanceExtension]{
+			DatabaseInstances: batch,
+		}
+		if _, err := cli.dbCli.HuaWei.DatabaseInstance.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create database instance failed, err: %v, rid: %s",
+				enumor.HuaWei, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync database instance to create database instance success, accountID: %s, count: %d, "+
+		"rid: %s", enumor.HuaWei, accountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateDBInstance(kt *kit.Kit, updateMap map[string]typesdbinstance.HuaWeiDBInstance,
+	vpcMap map[string]common.NetworkVpcRel, subnetMap map[string]string) error {
+
+	updateReq := make(protocloud.DatabaseInstanceExtBatchUpdateReq[coredbinstance.HuaWeiDBInstanceExtension], 0,
+		len(updateMap))
+	for id, one := range updateMap {
+		_, engineVersion := getHuaWeiDBInstanceDatastore(one)
+		db := &protocloud.DatabaseInstanceExtUpdateReq[coredbinstance.HuaWeiDBInstanceExtension]{
+			ID:               id,
+			Name:             one.Name,
+			Zone:             one.GetZone(),
+			Status:           one.Status,
+			EngineVersion:    engineVersion,
+			InstanceClass:    one.FlavorRef,
+			StorageSize:      getHuaWeiDBInstanceStorageSize(one),
+			ChargeType:       getHuaWeiDBInstanceChargeType(one),
+			VpcID:            vpcMap[one.VpcId].VpcID,
+			CloudVpcID:       one.VpcId,
+			SubnetID:         subnetMap[one.SubnetId],
+			CloudSubnetID:    one.SubnetId,
+			PrivateAddress:   strings.Join(one.PrivateIps, ","),
+			PublicAddress:    strings.Join(one.PublicIps, ","),
+			Port:             int64(one.Port),
+			CloudExpiredTime: converter.PtrToVal(one.ExpirationTime),
+			Extension:        convHuaWeiDBInstanceExtension(one),
+		}
+		updateReq = append(updateReq, db)
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.DatabaseInstanceExtBatchUpdateReq[coredbinstance.HuaWeiDBInstanceExtension](batch)
+		if err := cli.dbCli.HuaWei.DatabaseInstance.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update database instance failed, err: %v, rid: %s",
+				enumor.HuaWei, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync database instance to update database instance success, count: %d, rid: %s",
+		enumor.HuaWei, len(updateMap), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) deleteDBInstance(kt *kit.Kit, accountID, region string, delCloudIDs []string) error {
+	checkParams := &SyncBaseParams{AccountID: accountID, Region: region, CloudIDs: delCloudIDs}
+	delFromCloud, err := cli.listDBInstanceFromCloud(kt, checkParams)
+	if err != nil {
+		return err
+	}
+
+	if len(delFromCloud) > 0 {
+		logs.Errorf("[%s] validate database instance not exist failed, before delete opt: %v, failed_count: %d, "+
+			"rid: %s", enumor.HuaWei, checkParams, len(delFromCloud), kt.Rid)
+		return errf.Newf(errf.Aborted, "validate database instance not exist failed, before delete")
+	}
+
+	req := &protocloud.DatabaseInstanceBatchDeleteReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.HuaWei),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleIn("cloud_id", delCloudIDs),
+		),
+	}
+	if err = cli.dbCli.Global.DatabaseInstance.BatchDelete(kt, req); err != nil {
+		logs.Errorf("[%s] request dataservice to batch delete database instance failed, err: %v, rid: %s",
+			enumor.HuaWei, err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync database instance to delete database instance success, accountID: %s, count: %d, "+
+		"rid: %s", enumor.HuaWei, accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+// dbInstanceSgRel 同步RDS实例与安全组的关联关系
+func (cli *client) dbInstanceSgRel(kt *kit.Kit, params *SyncBaseParams,
+	dbFromCloud []typesdbinstance.HuaWeiDBInstance) error {
+
+	dbFromDB, err := cli.listDBInstanceFromDB(kt, params)
+	if err != nil {
+		return err
+	}
+
+	cloudLocalMap := make(map[string]string, len(dbFromDB))
+	for _, one := range dbFromDB {
+		cloudLocalMap[one.CloudID] = one.ID
+	}
+
+	dbSgCloudMap := make(map[string][]string, len(dbFromCloud))
+	for _, one := range dbFromCloud {
+		if id, exist := cloudLocalMap[one.GetCloudID()]; exist {
+			dbSgCloudMap[id] = one.GetCloudSecurityGroupIDs()
+		}
+	}
+
+	return common.SyncDatabaseInstanceSGRel(kt, cli.dbCli, enumor.HuaWei, dbSgCloudMap)
+}
+
+// listDBInstanceFromCloud 华为云按ID过滤RDS实例时只支持单个ID，需逐个查询
+func (cli *client) listDBInstanceFromCloud(kt *kit.Kit, params *SyncBaseParams) (
+	[]typesdbinstance.HuaWeiDBInstance, error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	result := make([]typesdbinstance.HuaWeiDBInstance, 0, len(params.CloudIDs))
+	for _, cloudID := range params.CloudIDs {
+		opt := &typesdbinstance.HuaWeiDBInstanceListOption{
+			Region:  params.Region,
+			CloudID: converter.ValToPtr(cloudID),
+		}
+		dbs, err := cli.cloudCli.ListDatabaseInstance(kt, opt)
+		if err != nil {
+			logs.Errorf("[%s] list database instance from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+				enumor.HuaWei, err, params.AccountID, opt, kt.Rid)
+			return nil, err
+		}
+		result = append(result, dbs...)
+	}
+
+	return result, nil
+}
+
+func (cli *client) listDBInstanceFromDB(kt *kit.Kit, params *SyncBaseParams) (
+	[]coredbinstance.DatabaseInstance[coredbinstance.HuaWeiDBInstanceExtension], error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", params.AccountID),
+			tools.RuleEqual("region", params.Region),
+			tools.RuleIn("cloud_id", params.CloudIDs),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := cli.dbCli.HuaWei.DatabaseInstance.ListDatabaseInstanceExt(kt, req)
+	if err != nil {
+		logs.Errorf("[%s] list database instance from db failed, err: %v, account: %s, req: %v, rid: %s",
+			enumor.HuaWei, err, params.AccountID, req, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+// RemoveDatabaseInstanceDeleteFromCloud 删除本地存在但云上已被删除的RDS实例
+func (cli *client) RemoveDatabaseInstanceDeleteFromCloud(kt *kit.Kit, accountID string, region string) error {
+	req := &core.ListReq{
+		Fields: []string{"id", "cloud_id"},
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.HuaWei),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleEqual("region", region),
+		),
+		Page: &core.BasePage{Start: 0, Limit: constant.BatchOperationMaxLimit},
+	}
+	for {
+		resultFromDB, err := cli.dbCli.Global.DatabaseInstance.List(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] request dataservice to list database instance failed, err: %v, req: %v, rid: %s",
+				enumor.HuaWei, err, req, kt.Rid)
+			return err
+		}
+
+		cloudIDs := make([]string, 0, len(resultFromDB.Details))
+		for _, one := range resultFromDB.Details {
+			cloudIDs = append(cloudIDs, one.CloudID)
+		}
+
+		if len(cloudIDs) == 0 {
+			break
+		}
+
+		params := &SyncBaseParams{AccountID: accountID, Region: region, CloudIDs: cloudIDs}
+		resultFromCloud, err := cli.listDBInstanceFromCloud(kt, params)
+		if err != nil {
+			return err
+		}
+
+		// 如果有资源没有查询出来，说明数据被从云上删除
+		if len(resultFromCloud) != len(cloudIDs) {
+			cloudIDMap := converter.StringSliceToMap(cloudIDs)
+			for _, one := range resultFromCloud {
+				delete(cloudIDMap, one.GetCloudID())
+			}
+
+			err = cli.deleteDBInstance(kt, accountID, region, converter.MapKeyToStringSlice(cloudIDMap))
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(resultFromDB.Details) < constant.BatchOperationMaxLimit {
+			break
+		}
+
+		req.Page.Start += constant.BatchOperationMaxLimit
+	}
+
+	return nil
+}
+
+// getHuaWeiDBInstanceDatastore 返回数据库引擎及版本，引擎统一转为小写，如 mysql、postgresql、sqlserver
+func getHuaWeiDBInstanceDatastore(one typesdbinstance.HuaWeiDBInstance) (string, string) {
+	if one.Datastore == nil {
+		return "", ""
+	}
+	return strings.ToLower(one.Datastore.Type.Value()), one.Datastore.Version
+}
+
+func getHuaWeiDBInstanceStorageSize(one typesdbinstance.HuaWeiDBInstance) int64 {
+	if one.Volume == nil {
+		return 0
+	}
+	return int64(one.Volume.Size)
+}
+
+func getHuaWeiDBInstanceChargeType(one typesdbinstance.HuaWeiDBInstance) string {
+	if one.ChargeInfo == nil {
+		return ""
+	}
+	return one.ChargeInfo.ChargeMode.Value()
+}
+
+func convHuaWeiDBInstanceExtension(one typesdbinstance.HuaWeiDBInstance) *coredbinstance.HuaWeiDBInstanceExtension {
+	ext := &coredbinstance.HuaWeiDBInstanceExtension{
+		Type: one.Type,
+	}
+	if one.Volume != nil {
+		ext.VolumeType = one.Volume.Type.Value()
+	}
+	if len(one.EnterpriseProjectId) != 0 {
+		ext.EnterpriseProjectID = converter.ValToPtr(one.EnterpriseProjectId)
+	}
+	return ext
+}
+
+func isDBInstanceChange(cloud typesdbinstance.HuaWeiDBInstance,
+	db coredbinstance.DatabaseInstance[coredbinstance.HuaWeiDBInstanceExtension]) bool {
+
+	if cloud.Name != db.Name || cloud.Status != db.Status || cloud.GetZone() != db.Zone {
+		return true
+	}
+
+	_, engineVersion := getHuaWeiDBInstanceDatastore(cloud)
+	if engineVersion != db.EngineVersion || cloud.FlavorRef != db.InstanceClass ||
+		getHuaWeiDBInstanceStorageSize(cloud) != db.StorageSize || getHuaWeiDBInstanceChargeType(cloud) != db.ChargeType {
+		return true
+	}
+
+	if cloud.VpcId != db.CloudVpcID || cloud.SubnetId != db.CloudSubnetID {
+		return true
+	}
+
+	if strings.Join(cloud.PrivateIps, ",") != db.PrivateAddress ||
+		strings.Join(cloud.PublicIps, ",") != db.PublicAddress || int64(cloud.Port) != db.Port {
+		return true
+	}
+
+	if converter.PtrToVal(cloud.ExpirationTime) != db.CloudExpiredTime {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	if cloud.Type != db.Extension.Type {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,295 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package huawei
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typesk8s "hcm/pkg/adaptor/types/k8s-cluster"
+	"hcm/pkg/api/core"
+	corek8s "hcm/pkg/api/core/cloud/k8s"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncK8sClusterOption ...
+type SyncK8sClusterOption struct {
+	AccountID string `json:"account_id" validate:"required"`
+	Region    string `json:"region" validate:"required"`
+}
+
+// Validate ...
+func (opt SyncK8sClusterOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// K8sCluster 同步地域下的CCE集群，以及集群下的节点池和节点与主机的关联关系，业务由分配操作决定，同步不覆盖
+func (cli *client) K8sCluster(kt *kit.Kit, opt *SyncK8sClusterOption) (*SyncResult, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	clusterFromCloud, err := cli.listK8sClusterFromCloud(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterFromDB, err := cli.listK8sClusterFromDB(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(clusterFromCloud) == 0 && len(clusterFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.DiffPaaS[typesk8s.HuaWeiCluster,
+		corek8s.K8sCluster[corek8s.HuaWeiK8sClusterExtension]](clusterFromCloud, clusterFromDB, isK8sClusterChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteK8sCluster(kt, opt.AccountID, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createK8sCluster(kt, opt, addSlice); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateK8sCluster(kt, opt, updateMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = cli.k8sNodePoolAndNode(kt, opt); err != nil {
+		return nil, err
+	}
+
+	return new(SyncResult), nil
+}
+
+// k8sNodePoolAndNode 同步本地全部集群的节点池，以及节点和主机的关联关系
+func (cli *client) k8sNodePoolAndNode(kt *kit.Kit, opt *SyncK8sClusterOption) error {
+	clusterFromDB, err := cli.listK8sClusterFromDB(kt, opt)
+	if err != nil {
+		return err
+	}
+
+	for _, cluster := range clusterFromDB {
+		nodeOpt := &typesk8s.HuaWeiK8sNodeListOption{Region: opt.Region, ClusterCloudID: cluster.CloudID}
+		pools, err := cli.cloudCli.ListK8sNodePool(kt, nodeOpt)
+		if err != nil {
+			logs.Errorf("[%s] list k8s node pool from cloud failed, err: %v, opt: %v, rid: %s", enumor.HuaWei, err,
+				nodeOpt, kt.Rid)
+			return err
+		}
+
+		nodes, err := cli.cloudCli.ListK8sNode(kt, nodeOpt)
+		if err != nil {
+			logs.Errorf("[%s] list k8s node from cloud failed, err: %v, opt: %v, rid: %s", enumor.HuaWei, err,
+				nodeOpt, kt.Rid)
+			return err
+		}
+
+		if err = common.SyncK8sNodePoolAndNode(kt, cli.dbCli, cluster.BaseK8sCluster, pools, nodes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cli *client) createK8sCluster(kt *kit.Kit, opt *SyncK8sClusterOption,
+	addSlice []typesk8s.HuaWeiCluster) error {
+
+	clusters := make([]protocloud.K8sClusterBatchCreate[corek8s.HuaWeiK8sClusterExtension], 0, len(addSlice))
+	for _, one := range addSlice {
+		clusters = append(clusters, protocloud.K8sClusterBatchCreate[corek8s.HuaWeiK8sClusterExtension]{
+			CloudID:          one.GetCloudID(),
+			AccountID:        opt.AccountID,
+			BkBizID:          constant.UnassignedBiz,
+			Name:             one.GetName(),
+			Region:           opt.Region,
+			Status:           one.GetStatus(),
+			Version:          one.GetVersion(),
+			CloudVpcID:       one.GetCloudVpcID(),
+			CloudCreatedTime: one.GetCreatedTime(),
+			Extension:        convHuaWeiK8sClusterExtension(one),
+		})
+	}
+
+	for _, batch := range slice.Split(clusters, constant.BatchOperationMaxLimit) {
+		req := &protocloud.K8sClusterBatchCreateReq[corek8s.HuaWeiK8sClusterExtension]{K8sClusters: batch}
+		if _, err := cli.dbCli.HuaWei.K8sCluster.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create k8s cluster failed, err: %v, rid: %s",
+				enumor.HuaWei, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync k8s cluster to create k8s cluster success, accountID: %s, count: %d, rid: %s",
+		enumor.HuaWei, opt.AccountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateK8sCluster(kt *kit.Kit, opt *SyncK8sClusterOption,
+	updateMap map[string]typesk8s.HuaWeiCluster) error {
+
+	updateReq := make(protocloud.K8sClusterExtBatchUpdateReq[corek8s.HuaWeiK8sClusterExtension], 0, len(updateMap))
+	for id, one := range updateMap {
+		updateReq = append(updateReq, &protocloud.K8sClusterExtUpdateReq[corek8s.HuaWeiK8sClusterExtension]{
+			ID:         id,
+			Name:       one.GetName(),
+			Region:     opt.Region,
+			Status:     one.GetStatus(),
+			Version:    one.GetVersion(),
+			CloudVpcID: one.GetCloudVpcID(),
+			Extension:  convHuaWeiK8sClusterExtension(one),
+		})
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.K8sClusterExtBatchUpdateReq[corek8s.HuaWeiK8sClusterExtension](batch)
+		if err := cli.dbCli.HuaWei.K8sCluster.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update k8s cluster failed, err: %v, rid: %s",
+				enumor.HuaWei, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync k8s cluster to update k8s cluster success, count: %d, rid: %s", enumor.HuaWei,
+		len(updateMap), kt.Rid)
+
+	return nil
+}
+
+// deleteK8sCluster 删除容器集群时，data-service 会同时删除集群下的节点池和节点关联关系
+func (cli *client) deleteK8sCluster(kt *kit.Kit, accountID string, delCloudIDs []string) error {
+	for _, batch := range slice.Split(delCloudIDs, constant.BatchOperationMaxLimit) {
+		req := &protocloud.K8sClusterBatchDeleteReq{
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("vendor", enumor.HuaWei),
+				tools.RuleEqual("account_id", accountID),
+				tools.RuleIn("cloud_id", batch),
+			),
+		}
+		if err := cli.dbCli.Global.K8sCluster.BatchDelete(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch delete k8s cluster failed, err: %v, rid: %s",
+				enumor.HuaWei, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync k8s cluster to delete k8s cluster success, accountID: %s, count: %d, rid: %s",
+		enumor.HuaWei, accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) listK8sClusterFromCloud(kt *kit.Kit, opt *SyncK8sClusterOption) ([]typesk8s.HuaWeiCluster,
+	error) {
+
+	listOpt := &typesk8s.HuaWeiK8sClusterListOption{Region: opt.Region}
+	result, err := cli.cloudCli.ListK8sCluster(kt, listOpt)
+	if err != nil {
+		logs.Errorf("[%s] list k8s cluster from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+			enumor.HuaWei, err, opt.AccountID, listOpt, kt.Rid)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (cli *client) listK8sClusterFromDB(kt *kit.Kit, opt *SyncK8sClusterOption) (
+	[]corek8s.K8sCluster[corek8s.HuaWeiK8sClusterExtension], error) {
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.HuaWei),
+			tools.RuleEqual("account_id", opt.AccountID),
+			tools.RuleEqual("region", opt.Region),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result := make([]corek8s.K8sCluster[corek8s.HuaWeiK8sClusterExtension], 0)
+	for {
+		resp, err := cli.dbCli.HuaWei.K8sCluster.ListK8sClusterExt(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] list k8s cluster from db failed, err: %v, account: %s, req: %v, rid: %s",
+				enumor.HuaWei, err, opt.AccountID, req, kt.Rid)
+			return nil, err
+		}
+
+		result = append(result, resp.Details...)
+
+		if len(resp.Details) < int(core.DefaultMaxPageLimit) {
+			break
+		}
+
+		req.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return result, nil
+}
+
+func convHuaWeiK8sClusterExtension(one typesk8s.HuaWeiCluster) *corek8s.HuaWeiK8sClusterExtension {
+	ext := new(corek8s.HuaWeiK8sClusterExtension)
+	if one.Spec == nil {
+		return ext
+	}
+
+	ext.Flavor = one.Spec.Flavor
+	if one.Spec.Type != nil {
+		ext.ClusterType = one.Spec.Type.Value()
+	}
+	if one.Spec.HostNetwork != nil {
+		ext.CloudSubnetID = one.Spec.HostNetwork.Subnet
+	}
+	if one.Spec.ContainerNetwork != nil {
+		ext.ContainerNetworkMode = one.Spec.ContainerNetwork.Mode.Value()
+	}
+
+	return ext
+}
+
+func isK8sClusterChange(cloud typesk8s.HuaWeiCluster,
+	db corek8s.K8sCluster[corek8s.HuaWeiK8sClusterExtension]) bool {
+
+	if cloud.GetName() != db.Name || cloud.GetStatus() != db.Status || cloud.GetVersion() != db.Version ||
+		cloud.GetCloudVpcID() != db.CloudVpcID {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	return *convHuaWeiK8sClusterExtension(cloud) != *db.Extension
+}
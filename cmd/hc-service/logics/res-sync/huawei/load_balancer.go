@@ -0,0 +1,443 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package huawei
+
+import (
+	"fmt"
+
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typecore "hcm/pkg/adaptor/types/core"
+	typeslb "hcm/pkg/adaptor/types/load-balancer"
+	"hcm/pkg/api/core"
+	corelb "hcm/pkg/api/core/cloud/load-balancer"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/assert"
+	cvt "hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncLBOption ...
+type SyncLBOption struct {
+}
+
+// Validate ...
+func (o *SyncLBOption) Validate() error {
+	return validator.Validate.Struct(o)
+}
+
+// LoadBalancer 同步指定负载均衡自身属性
+func (cli *client) LoadBalancer(kt *kit.Kit, params *SyncBaseParams, opt *SyncLBOption) (*SyncResult, error) {
+	if err := validator.ValidateTool(params, opt); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	lbFromCloud, err := cli.listLBFromCloud(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	lbFromDB, err := cli.listLBFromDB(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lbFromCloud) == 0 && len(lbFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.Diff[typeslb.HuaWeiLoadBalancer, corelb.HuaWeiLoadBalancer](
+		lbFromCloud, lbFromDB, isLBChange)
+
+	if err = cli.deleteLoadBalancer(kt, params.AccountID, params.Region, delCloudIDs); err != nil {
+		return nil, err
+	}
+
+	if err = cli.createLoadBalancer(kt, params.AccountID, params.Region, addSlice); err != nil {
+		return nil, err
+	}
+
+	if err = cli.updateLoadBalancer(kt, params.AccountID, updateMap); err != nil {
+		return nil, err
+	}
+
+	return new(SyncResult), nil
+}
+
+// RemoveLoadBalancerDeleteFromCloud 删除存在本地但是在云上被删除的数据
+func (cli *client) RemoveLoadBalancerDeleteFromCloud(kt *kit.Kit, accountID string, region string) error {
+	req := &core.ListReq{
+		Fields: []string{"id", "cloud_id"},
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleEqual("region", region),
+		),
+		Page: &core.BasePage{
+			Start: 0,
+			Limit: constant.BatchOperationMaxLimit,
+		},
+	}
+
+	for {
+		lbFromDB, err := cli.dbCli.Global.LoadBalancer.ListLoadBalancer(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] request dataservice to list lb failed, err: %v, req: %v, rid: %s", enumor.HuaWei,
+				err, req, kt.Rid)
+			return err
+		}
+
+		cloudIDs := slice.Map(lbFromDB.Details, func(lb corelb.BaseLoadBalancer) string { return lb.CloudID })
+		if len(cloudIDs) == 0 {
+			break
+		}
+
+		params := &SyncBaseParams{AccountID: accountID, Region: region, CloudIDs: cloudIDs}
+		lbFromCloud, err := cli.listLBFromCloud(kt, params)
+		if err != nil {
+			return err
+		}
+
+		// 如果有资源没有查询出来，说明数据被从云上删除
+		if len(lbFromCloud) != len(cloudIDs) {
+			cloudIDMap := cvt.StringSliceToMap(cloudIDs)
+			for _, one := range lbFromCloud {
+				delete(cloudIDMap, one.GetCloudID())
+			}
+
+			delCloudIDs := cvt.MapKeyToSlice(cloudIDMap)
+			if err = cli.deleteLoadBalancer(kt, accountID, region, delCloudIDs); err != nil {
+				return err
+			}
+		}
+
+		if len(lbFromDB.Details) < constant.BatchOperationMaxLimit {
+			break
+		}
+
+		req.Page.Start += constant.BatchOperationMaxLimit
+	}
+
+	return nil
+}
+
+func (cli *client) createLoadBalancer(kt *kit.Kit, accountID string, region string,
+	addSlice []typeslb.HuaWeiLoadBalancer) error {
+
+	if len(addSlice) == 0 {
+		return nil
+	}
+
+	vpcMap, subnetMap, err := cli.getLoadBalancerRelatedRes(kt, accountID, addSlice)
+	if err != nil {
+		return err
+	}
+
+	createReq := new(protocloud.HuaWeiLoadBalancerCreateReq)
+	for _, cloud := range addSlice {
+		cloudSubnetID := getLBCloudSubnetID(cloud)
+		lb := protocloud.LbBatchCreate[corelb.HuaWeiLoadBalancerExtension]{
+			CloudID:          cloud.Id,
+			Name:             cloud.Name,
+			Vendor:           enumor.HuaWei,
+			AccountID:        accountID,
+			BkBizID:          constant.UnassignedBiz,
+			LoadBalancerType: string(getLBType(cloud)),
+			IPVersion:        getLBIPVersion(cloud),
+			Region:           region,
+			Zones:            cloud.AvailabilityZoneList,
+			VpcID:            vpcMap[cloud.VpcId],
+			CloudVpcID:       cloud.VpcId,
+			SubnetID:         subnetMap[cloudSubnetID],
+			CloudSubnetID:    cloudSubnetID,
+			Status:           cloud.ProvisioningStatus,
+			CloudCreatedTime: cloud.CreatedAt,
+			Memo:             cvt.ValToPtr(cloud.Description),
+			Extension:        convLBExtension(cloud),
+		}
+		lb.PrivateIPv4Addresses, lb.PrivateIPv6Addresses, lb.PublicIPv4Addresses, lb.PublicIPv6Addresses =
+			getLBAddresses(cloud)
+		createReq.Lbs = append(createReq.Lbs, lb)
+	}
+
+	if _, err = cli.dbCli.HuaWei.LoadBalancer.BatchCreate(kt, createReq); err != nil {
+		logs.Errorf("[%s] call data service to create load balancer failed, err: %v, rid: %s", enumor.HuaWei, err,
+			kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync load balancer to create lb success, accountID: %s, count: %d, rid: %s", enumor.HuaWei,
+		accountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateLoadBalancer(kt *kit.Kit, accountID string,
+	updateMap map[string]typeslb.HuaWeiLoadBalancer) error {
+
+	if len(updateMap) == 0 {
+		return nil
+	}
+
+	vpcMap, subnetMap, err := cli.getLoadBalancerRelatedRes(kt, accountID, cvt.MapValueToSlice(updateMap))
+	if err != nil {
+		return err
+	}
+
+	updateReq := new(protocloud.HuaWeiLoadBalancerBatchUpdateReq)
+	for id, cloud := range updateMap {
+		cloudSubnetID := getLBCloudSubnetID(cloud)
+		lb := &protocloud.LoadBalancerExtUpdateReq[corelb.HuaWeiLoadBalancerExtension]{
+			ID:               id,
+			Name:             cloud.Name,
+			IPVersion:        getLBIPVersion(cloud),
+			VpcID:            vpcMap[cloud.VpcId],
+			CloudVpcID:       cloud.VpcId,
+			SubnetID:         subnetMap[cloudSubnetID],
+			CloudSubnetID:    cloudSubnetID,
+			Status:           cloud.ProvisioningStatus,
+			CloudCreatedTime: cloud.CreatedAt,
+			Memo:             cvt.ValToPtr(cloud.Description),
+			Extension:        convLBExtension(cloud),
+		}
+		lb.PrivateIPv4Addresses, lb.PrivateIPv6Addresses, lb.PublicIPv4Addresses, lb.PublicIPv6Addresses =
+			getLBAddresses(cloud)
+		updateReq.Lbs = append(updateReq.Lbs, lb)
+	}
+
+	if err = cli.dbCli.HuaWei.LoadBalancer.BatchUpdate(kt, updateReq); err != nil {
+		logs.Errorf("[%s] call data service to update load balancer failed, err: %v, rid: %s", enumor.HuaWei, err,
+			kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+func (cli *client) deleteLoadBalancer(kt *kit.Kit, accountID string, region string, delCloudIDs []string) error {
+	if len(delCloudIDs) == 0 {
+		return nil
+	}
+
+	checkParams := &SyncBaseParams{
+		AccountID: accountID,
+		Region:    region,
+		CloudIDs:  delCloudIDs,
+	}
+	delLBFromCloud, err := cli.listLBFromCloud(kt, checkParams)
+	if err != nil {
+		return err
+	}
+
+	if len(delLBFromCloud) > 0 {
+		logs.Errorf("[%s] lb not exist before sync deletion, opt: %v, failed_count: %d, rid: %s", enumor.HuaWei,
+			checkParams, len(delLBFromCloud), kt.Rid)
+		return fmt.Errorf("lb not exist before sync deletion")
+	}
+
+	deleteReq := &protocloud.LoadBalancerBatchDeleteReq{
+		Filter: tools.ContainersExpression("cloud_id", delCloudIDs),
+	}
+	if err = cli.dbCli.Global.LoadBalancer.BatchDelete(kt, deleteReq); err != nil {
+		logs.Errorf("[%s] call data service to batch delete lb failed, err: %v, rid: %s", enumor.HuaWei, err,
+			kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync to delete lb success, accountID: %s, count: %d, rid: %s", enumor.HuaWei, accountID,
+		len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) getLoadBalancerRelatedRes(kt *kit.Kit, accountID string, lbs []typeslb.HuaWeiLoadBalancer) (
+	map[string]string, map[string]string, error) {
+
+	cloudVpcIDs := make([]string, 0, len(lbs))
+	cloudSubnetIDs := make([]string, 0, len(lbs))
+	for _, lb := range lbs {
+		cloudVpcIDs = append(cloudVpcIDs, lb.VpcId)
+		if subnetID := getLBCloudSubnetID(lb); len(subnetID) != 0 {
+			cloudSubnetIDs = append(cloudSubnetIDs, subnetID)
+		}
+	}
+
+	return common.GetLoadBalancerVpcSubnetMap(kt, cli.dbCli, enumor.HuaWei, accountID, cloudVpcIDs, cloudSubnetIDs)
+}
+
+func (cli *client) listLBFromCloud(kt *kit.Kit, params *SyncBaseParams) ([]typeslb.HuaWeiLoadBalancer, error) {
+	opt := &typecore.HuaWeiListOption{
+		Region:   params.Region,
+		CloudIDs: params.CloudIDs,
+		Page: &typecore.HuaWeiPage{
+			Limit: cvt.ValToPtr(int32(constant.CloudResourceSyncMaxLimit)),
+		},
+	}
+	result, err := cli.cloudCli.ListLoadBalancer(kt, opt)
+	if err != nil {
+		logs.Errorf("[%s] list lb from cloud failed, err: %v, account: %s, opt: %v, rid: %s", enumor.HuaWei, err,
+			params.AccountID, opt, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+func (cli *client) listLBFromDB(kt *kit.Kit, params *SyncBaseParams) ([]corelb.HuaWeiLoadBalancer, error) {
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", params.AccountID),
+			tools.RuleEqual("region", params.Region),
+			tools.RuleIn("cloud_id", params.CloudIDs),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := cli.dbCli.HuaWei.LoadBalancer.ListLoadBalancer(kt, req)
+	if err != nil {
+		logs.Errorf("[%s] list lb from db failed, err: %v, account: %s, req: %v, rid: %s", enumor.HuaWei, err,
+			params.AccountID, req, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+// getLBCloudSubnetID 华为云子网ID即下联面子网的网络ID，VipSubnetCidrID 是neutron子网ID，与本地子网ID不一致
+func getLBCloudSubnetID(cloud typeslb.HuaWeiLoadBalancer) string {
+	if len(cloud.ElbVirsubnetIds) == 0 {
+		return ""
+	}
+	return cloud.ElbVirsubnetIds[0]
+}
+
+// getLBType 绑定了公网IP的为公网负载均衡，否则为内网负载均衡
+func getLBType(cloud typeslb.HuaWeiLoadBalancer) typeslb.TCloudLoadBalancerType {
+	if len(cloud.Eips) != 0 || len(cloud.Publicips) != 0 {
+		return typeslb.OpenLoadBalancerType
+	}
+	return typeslb.InternalLoadBalancerType
+}
+
+func getLBIPVersion(cloud typeslb.HuaWeiLoadBalancer) enumor.IPAddressType {
+	if len(cloud.Ipv6VipAddress) != 0 {
+		return enumor.Ipv6DualStack
+	}
+	return enumor.Ipv4
+}
+
+func getLBAddresses(cloud typeslb.HuaWeiLoadBalancer) (privateIPv4, privateIPv6, publicIPv4, publicIPv6 []string) {
+	if len(cloud.VipAddress) != 0 {
+		privateIPv4 = append(privateIPv4, cloud.VipAddress)
+	}
+	if len(cloud.Ipv6VipAddress) != 0 {
+		privateIPv6 = append(privateIPv6, cloud.Ipv6VipAddress)
+	}
+
+	for _, eip := range cloud.Eips {
+		if eip.EipAddress == nil {
+			continue
+		}
+		if cvt.PtrToVal(eip.IpVersion) == 6 {
+			publicIPv6 = append(publicIPv6, *eip.EipAddress)
+		} else {
+			publicIPv4 = append(publicIPv4, *eip.EipAddress)
+		}
+	}
+
+	return privateIPv4, privateIPv6, slice.Unique(publicIPv4), slice.Unique(publicIPv6)
+}
+
+func convLBExtension(cloud typeslb.HuaWeiLoadBalancer) *corelb.HuaWeiLoadBalancerExtension {
+	ext := &corelb.HuaWeiLoadBalancerExtension{
+		Provider:                 cvt.ValToPtr(cloud.Provider),
+		Guaranteed:               cvt.ValToPtr(cloud.Guaranteed),
+		VipSubnetCidrID:          cvt.ValToPtr(cloud.VipSubnetCidrId),
+		ElbVirsubnetIDs:          cloud.ElbVirsubnetIds,
+		L4FlavorID:               cvt.ValToPtr(cloud.L4FlavorId),
+		L7FlavorID:               cvt.ValToPtr(cloud.L7FlavorId),
+		BillingInfo:              cvt.ValToPtr(cloud.BillingInfo),
+		EnterpriseProjectID:      cvt.ValToPtr(cloud.EnterpriseProjectId),
+		OperatingStatus:          cvt.ValToPtr(cloud.OperatingStatus),
+		DeletionProtectionEnable: cloud.DeletionProtectionEnable,
+	}
+	for _, eip := range cloud.Eips {
+		if eip.EipId != nil {
+			ext.CloudEipIDs = append(ext.CloudEipIDs, *eip.EipId)
+		}
+	}
+
+	return ext
+}
+
+func isLBChange(cloud typeslb.HuaWeiLoadBalancer, db corelb.HuaWeiLoadBalancer) bool {
+	if db.Name != cloud.Name || db.Status != cloud.ProvisioningStatus {
+		return true
+	}
+
+	if db.IPVersion != getLBIPVersion(cloud) || db.CloudVpcID != cloud.VpcId ||
+		db.CloudSubnetID != getLBCloudSubnetID(cloud) {
+		return true
+	}
+
+	if cvt.PtrToVal(db.Memo) != cloud.Description {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(db.Zones, cloud.AvailabilityZoneList) {
+		return true
+	}
+
+	privateIPv4, privateIPv6, publicIPv4, publicIPv6 := getLBAddresses(cloud)
+	if !assert.IsStringSliceEqual(db.PrivateIPv4Addresses, privateIPv4) ||
+		!assert.IsStringSliceEqual(db.PrivateIPv6Addresses, privateIPv6) ||
+		!assert.IsStringSliceEqual(db.PublicIPv4Addresses, publicIPv4) ||
+		!assert.IsStringSliceEqual(db.PublicIPv6Addresses, publicIPv6) {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	ext := convLBExtension(cloud)
+	if !assert.IsPtrStringEqual(db.Extension.L4FlavorID, ext.L4FlavorID) ||
+		!assert.IsPtrStringEqual(db.Extension.L7FlavorID, ext.L7FlavorID) ||
+		!assert.IsPtrStringEqual(db.Extension.BillingInfo, ext.BillingInfo) ||
+		!assert.IsPtrStringEqual(db.Extension.EnterpriseProjectID, ext.EnterpriseProjectID) ||
+		!assert.IsPtrStringEqual(db.Extension.OperatingStatus, ext.OperatingStatus) {
+		return true
+	}
+
+	if !assert.IsPtrBoolEqual(db.Extension.DeletionProtectionEnable, ext.DeletionProtectionEnable) {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(db.Extension.ElbVirsubnetIDs, ext.ElbVirsubnetIDs) ||
+		!assert.IsStringSliceEqual(db.Extension.CloudEipIDs, ext.CloudEipIDs) {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,290 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package huawei
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typesdns "hcm/pkg/adaptor/types/private-dns"
+	"hcm/pkg/api/core"
+	coreprivatedns "hcm/pkg/api/core/cloud/private-dns"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/runtime/filter"
+	"hcm/pkg/tools/assert"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncPrivateDnsZoneOption 同步地域下的内网域名；CloudIDs 不为空时只同步指定的内网域名
+type SyncPrivateDnsZoneOption struct {
+	AccountID string   `json:"account_id" validate:"required"`
+	Region    string   `json:"region" validate:"required"`
+	CloudIDs  []string `json:"cloud_ids" validate:"omitempty,max=100"`
+}
+
+// Validate ...
+func (opt SyncPrivateDnsZoneOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// PrivateDnsZone 同步私有域，以及私有域下的解析记录和关联的VPC，业务由分配操作决定，同步不覆盖
+func (cli *client) PrivateDnsZone(kt *kit.Kit, opt *SyncPrivateDnsZoneOption) (*SyncResult, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	zoneFromCloud, err := cli.listPrivateDnsZoneFromCloud(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	zoneFromDB, err := cli.listPrivateDnsZoneFromDB(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(zoneFromCloud) == 0 && len(zoneFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.DiffPaaS[typesdns.HuaWeiZone,
+		coreprivatedns.Zone[coreprivatedns.HuaWeiZoneExtension]](zoneFromCloud, zoneFromDB, isPrivateDnsZoneChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deletePrivateDnsZone(kt, opt.AccountID, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createPrivateDnsZone(kt, opt, addSlice); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updatePrivateDnsZone(kt, updateMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = cli.privateDnsZoneRes(kt, opt, zoneFromCloud); err != nil {
+		return nil, err
+	}
+
+	return new(SyncResult), nil
+}
+
+// privateDnsZoneRes 同步本地私有域的解析记录和关联的VPC
+func (cli *client) privateDnsZoneRes(kt *kit.Kit, opt *SyncPrivateDnsZoneOption,
+	zoneFromCloud []typesdns.HuaWeiZone) error {
+
+	zoneFromDB, err := cli.listPrivateDnsZoneFromDB(kt, opt)
+	if err != nil {
+		return err
+	}
+
+	cloudMap := make(map[string]typesdns.HuaWeiZone, len(zoneFromCloud))
+	for _, one := range zoneFromCloud {
+		cloudMap[one.GetCloudID()] = one
+	}
+
+	for _, zone := range zoneFromDB {
+		one, exist := cloudMap[zone.CloudID]
+		if !exist {
+			continue
+		}
+
+		listOpt := &typesdns.HuaWeiRecordListOption{Region: opt.Region, CloudZoneID: zone.CloudID, ZoneName: zone.Name}
+		records, err := cli.cloudCli.ListPrivateDnsRecord(kt, listOpt)
+		if err != nil {
+			logs.Errorf("[%s] list private dns record from cloud failed, err: %v, opt: %v, rid: %s", enumor.HuaWei,
+				err, listOpt, kt.Rid)
+			return err
+		}
+
+		res := &common.PrivateDnsZoneRes{Records: records, CloudVpcIDs: one.GetCloudVpcIDs()}
+		if err = common.SyncPrivateDnsZoneRes(kt, cli.dbCli, zone.BaseZone, res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cli *client) createPrivateDnsZone(kt *kit.Kit, opt *SyncPrivateDnsZoneOption,
+	addSlice []typesdns.HuaWeiZone) error {
+
+	zones := make([]protocloud.PrivateDnsZoneBatchCreate[coreprivatedns.HuaWeiZoneExtension], 0, len(addSlice))
+	for _, one := range addSlice {
+		zones = append(zones, protocloud.PrivateDnsZoneBatchCreate[coreprivatedns.HuaWeiZoneExtension]{
+			CloudID:          one.GetCloudID(),
+			AccountID:        opt.AccountID,
+			BkBizID:          constant.UnassignedBiz,
+			Name:             typesdns.TrimZoneName(converter.PtrToVal(one.Name)),
+			Region:           opt.Region,
+			RecordCount:      int64(converter.PtrToVal(one.RecordNum)),
+			CloudCreatedTime: converter.PtrToVal(one.CreatedAt),
+			Memo:             one.Description,
+			Extension:        convHuaWeiPrivateDnsZoneExtension(one),
+		})
+	}
+
+	for _, batch := range slice.Split(zones, constant.BatchOperationMaxLimit) {
+		req := &protocloud.PrivateDnsZoneBatchCreateReq[coreprivatedns.HuaWeiZoneExtension]{Zones: batch}
+		if _, err := cli.dbCli.HuaWei.PrivateDnsZone.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create private dns zone failed, err: %v, rid: %s",
+				enumor.HuaWei, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync private dns zone to create private dns zone success, accountID: %s, count: %d, rid: %s",
+		enumor.HuaWei, opt.AccountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updatePrivateDnsZone(kt *kit.Kit, updateMap map[string]typesdns.HuaWeiZone) error {
+	updateReq := make(protocloud.PrivateDnsZoneExtBatchUpdateReq[coreprivatedns.HuaWeiZoneExtension], 0,
+		len(updateMap))
+	for id, one := range updateMap {
+		updateReq = append(updateReq, &protocloud.PrivateDnsZoneExtUpdateReq[coreprivatedns.HuaWeiZoneExtension]{
+			ID:          id,
+			Name:        typesdns.TrimZoneName(converter.PtrToVal(one.Name)),
+			RecordCount: int64(converter.PtrToVal(one.RecordNum)),
+			Memo:        one.Description,
+			Extension:   convHuaWeiPrivateDnsZoneExtension(one),
+		})
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.PrivateDnsZoneExtBatchUpdateReq[coreprivatedns.HuaWeiZoneExtension](batch)
+		if err := cli.dbCli.HuaWei.PrivateDnsZone.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update private dns zone failed, err: %v, rid: %s",
+				enumor.HuaWei, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync private dns zone to update private dns zone success, count: %d, rid: %s", enumor.HuaWei,
+		len(updateMap), kt.Rid)
+
+	return nil
+}
+
+// deletePrivateDnsZone 删除私有域时，data-service 会同时删除私有域下的解析记录和VPC关联关系
+func (cli *client) deletePrivateDnsZone(kt *kit.Kit, accountID string, delCloudIDs []string) error {
+	for _, batch := range slice.Split(delCloudIDs, constant.BatchOperationMaxLimit) {
+		req := &protocloud.PrivateDnsZoneBatchDeleteReq{
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("vendor", enumor.HuaWei),
+				tools.RuleEqual("account_id", accountID),
+				tools.RuleIn("cloud_id", batch),
+			),
+		}
+		if err := cli.dbCli.Global.PrivateDnsZone.BatchDelete(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch delete private dns zone failed, err: %v, rid: %s",
+				enumor.HuaWei, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync private dns zone to delete private dns zone success, accountID: %s, count: %d, rid: %s",
+		enumor.HuaWei, accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) listPrivateDnsZoneFromCloud(kt *kit.Kit, opt *SyncPrivateDnsZoneOption) (
+	[]typesdns.HuaWeiZone, error) {
+
+	listOpt := &typesdns.HuaWeiZoneListOption{Region: opt.Region, CloudIDs: opt.CloudIDs}
+	zones, err := cli.cloudCli.ListPrivateDnsZone(kt, listOpt)
+	if err != nil {
+		logs.Errorf("[%s] list private dns zone from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+			enumor.HuaWei, err, opt.AccountID, listOpt, kt.Rid)
+		return nil, err
+	}
+
+	return zones, nil
+}
+
+func (cli *client) listPrivateDnsZoneFromDB(kt *kit.Kit, opt *SyncPrivateDnsZoneOption) (
+	[]coreprivatedns.Zone[coreprivatedns.HuaWeiZoneExtension], error) {
+
+	rules := []*filter.AtomRule{
+		tools.RuleEqual("vendor", enumor.HuaWei),
+		tools.RuleEqual("account_id", opt.AccountID),
+		tools.RuleEqual("region", opt.Region),
+	}
+	if len(opt.CloudIDs) != 0 {
+		rules = append(rules, tools.RuleIn("cloud_id", opt.CloudIDs))
+	}
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(rules...),
+		Page:   core.NewDefaultBasePage(),
+	}
+	result := make([]coreprivatedns.Zone[coreprivatedns.HuaWeiZoneExtension], 0)
+	for {
+		resp, err := cli.dbCli.HuaWei.PrivateDnsZone.ListPrivateDnsZoneExt(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] list private dns zone from db failed, err: %v, account: %s, req: %v, rid: %s",
+				enumor.HuaWei, err, opt.AccountID, req, kt.Rid)
+			return nil, err
+		}
+
+		result = append(result, resp.Details...)
+
+		if len(resp.Details) < int(core.DefaultMaxPageLimit) {
+			break
+		}
+
+		req.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return result, nil
+}
+
+func convHuaWeiPrivateDnsZoneExtension(one typesdns.HuaWeiZone) *coreprivatedns.HuaWeiZoneExtension {
+	return &coreprivatedns.HuaWeiZoneExtension{Status: converter.PtrToVal(one.Status)}
+}
+
+func isPrivateDnsZoneChange(cloud typesdns.HuaWeiZone,
+	db coreprivatedns.Zone[coreprivatedns.HuaWeiZoneExtension]) bool {
+
+	if typesdns.TrimZoneName(converter.PtrToVal(cloud.Name)) != db.Name ||
+		int64(converter.PtrToVal(cloud.RecordNum)) != db.RecordCount ||
+		!assert.IsPtrStringEqual(cloud.Description, db.Memo) {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	return *convHuaWeiPrivateDnsZoneExtension(cloud) != *db.Extension
+}
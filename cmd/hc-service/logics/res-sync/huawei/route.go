@@ -121,8 +121,17 @@ func (cli *client) route(kt *kit.Kit, opt *syncRouteOption) (*SyncResult, error)
 		return new(SyncResult), nil
 	}
 
+	hopMap, err := cli.getRouteNextHopMap(kt, opt.AccountID, routeFromCloud)
+	if err != nil {
+		return nil, err
+	}
+
 	addSlice, updateMap, delCloudIDs := common.Diff[typesroutetable.HuaWeiRoute,
-		routetable.HuaWeiRoute](routeFromCloud, routeFromDB, isRouteChange)
+		routetable.HuaWeiRoute](routeFromCloud, routeFromDB,
+		func(cloud typesroutetable.HuaWeiRoute, db routetable.HuaWeiRoute) bool {
+			hop := hopMap[cloud.NextHop]
+			return isRouteChange(cloud, db) || hop.ResType != db.NextHopResType || hop.ResID != db.NextHopResID
+		})
 
 	if len(delCloudIDs) > 0 {
 		if err = cli.deleteRoute(kt, opt.AccountID, opt.Region, opt.CloudRouteTableID, routeTable.ID, delCloudIDs,
@@ -133,14 +142,14 @@ func (cli *client) route(kt *kit.Kit, opt *syncRouteOption) (*SyncResult, error)
 	}
 
 	if len(addSlice) > 0 {
-		err := cli.createRoute(kt, opt.AccountID, opt.Region, routeTable.ID, addSlice)
+		err := cli.createRoute(kt, opt.AccountID, opt.Region, routeTable.ID, addSlice, hopMap)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	if len(updateMap) > 0 {
-		err := cli.updateRoute(kt, opt.AccountID, opt.Region, routeTable.ID, updateMap)
+		err := cli.updateRoute(kt, opt.AccountID, opt.Region, routeTable.ID, updateMap, hopMap)
 		if err != nil {
 			return nil, err
 		}
@@ -149,8 +158,26 @@ func (cli *client) route(kt *kit.Kit, opt *syncRouteOption) (*SyncResult, error)
 	return new(SyncResult), nil
 }
 
+// getRouteNextHopMap 获取路由下一跳为NAT网关、对等连接时对应的本地资源，返回 云上ID -> 本地资源 的映射
+func (cli *client) getRouteNextHopMap(kt *kit.Kit, accountID string, routes []typesroutetable.HuaWeiRoute) (
+	map[string]common.RouteNextHop, error) {
+
+	cloudNatIDs := make([]string, 0)
+	cloudPeeringIDs := make([]string, 0)
+	for _, one := range routes {
+		switch one.Type {
+		case "nat":
+			cloudNatIDs = append(cloudNatIDs, one.NextHop)
+		case "peering":
+			cloudPeeringIDs = append(cloudPeeringIDs, one.NextHop)
+		}
+	}
+
+	return common.GetRouteNextHopMap(kt, cli.dbCli, enumor.HuaWei, accountID, cloudNatIDs, cloudPeeringIDs)
+}
+
 func (cli *client) createRoute(kt *kit.Kit, accountID string, region string, routeTableID string,
-	addSlice []typesroutetable.HuaWeiRoute) error {
+	addSlice []typesroutetable.HuaWeiRoute, hopMap map[string]common.RouteNextHop) error {
 
 	if len(addSlice) <= 0 {
 		return fmt.Errorf("route addSlice is <= 0, not create")
@@ -164,6 +191,8 @@ func (cli *client) createRoute(kt *kit.Kit, accountID string, region string, rou
 			Destination:       one.Destination,
 			NextHop:           one.NextHop,
 			Memo:              one.Memo,
+			NextHopResType:    hopMap[one.NextHop].ResType,
+			NextHopResID:      hopMap[one.NextHop].ResID,
 		}
 		createResources = append(createResources, tmpRes)
 	}
@@ -185,7 +214,7 @@ func (cli *client) createRoute(kt *kit.Kit, accountID string, region string, rou
 }
 
 func (cli *client) updateRoute(kt *kit.Kit, accountID, region, routeTableID string,
-	updateMap map[string]typesroutetable.HuaWeiRoute) error {
+	updateMap map[string]typesroutetable.HuaWeiRoute, hopMap map[string]common.RouteNextHop) error {
 
 	if len(updateMap) <= 0 {
 		return fmt.Errorf("route updateMap is <= 0, not update")
@@ -200,6 +229,8 @@ func (cli *client) updateRoute(kt *kit.Kit, accountID, region, routeTableID stri
 		tmpRes.Destination = one.Destination
 		tmpRes.NextHop = one.NextHop
 		tmpRes.Memo = one.Memo
+		tmpRes.NextHopResType = hopMap[one.NextHop].ResType
+		tmpRes.NextHopResID = hopMap[one.NextHop].ResID
 
 		updateResources = append(updateResources, tmpRes)
 	}
@@ -0,0 +1,367 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package huawei
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typessnapshot "hcm/pkg/adaptor/types/snapshot"
+	"hcm/pkg/api/core"
+	coresnapshot "hcm/pkg/api/core/cloud/snapshot"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/assert"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncSnapshotOption ...
+type SyncSnapshotOption struct {
+}
+
+// Validate ...
+func (opt SyncSnapshotOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// Snapshot 同步快照
+func (cli *client) Snapshot(kt *kit.Kit, params *SyncBaseParams, opt *SyncSnapshotOption) (*SyncResult, error) {
+	if err := validator.ValidateTool(params, opt); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	snapshotFromCloud, err := cli.listSnapshotFromCloud(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotFromDB, err := cli.listSnapshotFromDB(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(snapshotFromCloud) == 0 && len(snapshotFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.Diff[typessnapshot.HuaWeiSnapshot,
+		coresnapshot.Snapshot[coresnapshot.HuaWeiSnapshotExtension]](snapshotFromCloud, snapshotFromDB,
+		isSnapshotChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteSnapshot(kt, params.AccountID, params.Region, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	cloudDiskIDs := make([]string, 0, len(addSlice)+len(updateMap))
+	for _, one := range addSlice {
+		cloudDiskIDs = append(cloudDiskIDs, one.VolumeId)
+	}
+	for _, one := range updateMap {
+		cloudDiskIDs = append(cloudDiskIDs, one.VolumeId)
+	}
+	relMap, err := common.GetSnapshotDiskRelMap(kt, cli.dbCli, enumor.HuaWei, params.AccountID, cloudDiskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createSnapshot(kt, params.AccountID, params.Region, addSlice, relMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateSnapshot(kt, updateMap, relMap); err != nil {
+			return nil, err
+		}
+	}
+
+	return new(SyncResult), nil
+}
+
+func (cli *client) createSnapshot(kt *kit.Kit, accountID, region string, addSlice []typessnapshot.HuaWeiSnapshot,
+	relMap map[string]common.SnapshotDiskRel) error {
+
+	createReq := new(protocloud.SnapshotBatchCreateReq[coresnapshot.HuaWeiSnapshotExtension])
+	for _, one := range addSlice {
+		rel := relMap[one.VolumeId]
+		snapshot := protocloud.SnapshotBatchCreate[coresnapshot.HuaWeiSnapshotExtension]{
+			CloudID:          one.GetCloudID(),
+			AccountID:        accountID,
+			BkBizID:          constant.UnassignedBiz,
+			Name:             converter.PtrToVal(one.Name),
+			Region:           region,
+			Status:           one.Status,
+			Size:             uint64(one.Size),
+			DiskID:           rel.DiskID,
+			CloudDiskID:      one.VolumeId,
+			CvmID:            rel.CvmID,
+			CloudCvmID:       rel.CloudCvmID,
+			CloudCreatedTime: one.CreatedAt,
+			Memo:             one.Description,
+			Extension:        convHuaWeiSnapshotExtension(one),
+		}
+		if len(rel.DiskID) != 0 {
+			snapshot.BkBizID = rel.BkBizID
+		}
+		snapshot.Zone = rel.Zone
+		createReq.Snapshots = append(createReq.Snapshots, snapshot)
+	}
+
+	if _, err := cli.dbCli.HuaWei.Snapshot.BatchCreate(kt, createReq); err != nil {
+		logs.Errorf("[%s] request dataservice to batch create snapshot failed, err: %v, rid: %s", enumor.HuaWei,
+			err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync snapshot to create snapshot success, accountID: %s, count: %d, rid: %s", enumor.HuaWei,
+		accountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateSnapshot(kt *kit.Kit, updateMap map[string]typessnapshot.HuaWeiSnapshot,
+	relMap map[string]common.SnapshotDiskRel) error {
+
+	updateReq := make(protocloud.SnapshotExtBatchUpdateReq[coresnapshot.HuaWeiSnapshotExtension], 0,
+		len(updateMap))
+	for id, one := range updateMap {
+		rel := relMap[one.VolumeId]
+		updateReq = append(updateReq, &protocloud.SnapshotExtUpdateReq[coresnapshot.HuaWeiSnapshotExtension]{
+			ID:          id,
+			Name:        converter.PtrToVal(one.Name),
+			Status:      one.Status,
+			Size:        uint64(one.Size),
+			DiskID:      rel.DiskID,
+			CloudDiskID: one.VolumeId,
+			CvmID:       rel.CvmID,
+			CloudCvmID:  rel.CloudCvmID,
+			Memo:        one.Description,
+			Extension:   convHuaWeiSnapshotExtension(one),
+		})
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.SnapshotExtBatchUpdateReq[coresnapshot.HuaWeiSnapshotExtension](batch)
+		if err := cli.dbCli.HuaWei.Snapshot.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update snapshot failed, err: %v, rid: %s",
+				enumor.HuaWei, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync snapshot to update snapshot success, count: %d, rid: %s", enumor.HuaWei,
+		len(updateMap), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) deleteSnapshot(kt *kit.Kit, accountID, region string, delCloudIDs []string) error {
+	checkParams := &SyncBaseParams{AccountID: accountID, Region: region, CloudIDs: delCloudIDs}
+	delFromCloud, err := cli.listSnapshotFromCloud(kt, checkParams)
+	if err != nil {
+		return err
+	}
+
+	if len(delFromCloud) > 0 {
+		logs.Errorf("[%s] validate snapshot not exist failed, before delete opt: %v, failed_count: %d, rid: %s",
+			enumor.HuaWei, checkParams, len(delFromCloud), kt.Rid)
+		return errf.Newf(errf.Aborted, "validate snapshot not exist failed, before delete")
+	}
+
+	req := &protocloud.SnapshotBatchDeleteReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.HuaWei),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleIn("cloud_id", delCloudIDs),
+		),
+	}
+	if err = cli.dbCli.Global.Snapshot.BatchDelete(kt, req); err != nil {
+		logs.Errorf("[%s] request dataservice to batch delete snapshot failed, err: %v, rid: %s", enumor.HuaWei,
+			err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync snapshot to delete snapshot success, accountID: %s, count: %d, rid: %s", enumor.HuaWei,
+		accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) listSnapshotFromCloud(kt *kit.Kit, params *SyncBaseParams) ([]typessnapshot.HuaWeiSnapshot,
+	error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	// 华为云按ID查询快照只支持单个ID
+	result := make([]typessnapshot.HuaWeiSnapshot, 0, len(params.CloudIDs))
+	for _, cloudID := range params.CloudIDs {
+		opt := &typessnapshot.HuaWeiSnapshotListOption{
+			Region:  params.Region,
+			CloudID: converter.ValToPtr(cloudID),
+		}
+		snapshots, err := cli.cloudCli.ListSnapshot(kt, opt)
+		if err != nil {
+			logs.Errorf("[%s] list snapshot from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+				enumor.HuaWei, err, params.AccountID, opt, kt.Rid)
+			return nil, err
+		}
+		result = append(result, snapshots...)
+	}
+
+	return result, nil
+}
+
+func (cli *client) listSnapshotFromDB(kt *kit.Kit, params *SyncBaseParams) (
+	[]coresnapshot.Snapshot[coresnapshot.HuaWeiSnapshotExtension], error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", params.AccountID),
+			tools.RuleEqual("region", params.Region),
+			tools.RuleIn("cloud_id", params.CloudIDs),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := cli.dbCli.HuaWei.Snapshot.ListSnapshotExt(kt, req)
+	if err != nil {
+		logs.Errorf("[%s] list snapshot from db failed, err: %v, account: %s, req: %v, rid: %s", enumor.HuaWei,
+			err, params.AccountID, req, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+// RemoveSnapshotDeleteFromCloud 删除本地存在但云上已被删除的快照
+func (cli *client) RemoveSnapshotDeleteFromCloud(kt *kit.Kit, accountID string, region string) error {
+	req := &core.ListReq{
+		Fields: []string{"id", "cloud_id"},
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.HuaWei),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleEqual("region", region),
+		),
+		Page: &core.BasePage{Start: 0, Limit: constant.BatchOperationMaxLimit},
+	}
+	for {
+		resultFromDB, err := cli.dbCli.Global.Snapshot.List(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] request dataservice to list snapshot failed, err: %v, req: %v, rid: %s",
+				enumor.HuaWei, err, req, kt.Rid)
+			return err
+		}
+
+		cloudIDs := make([]string, 0, len(resultFromDB.Details))
+		for _, one := range resultFromDB.Details {
+			cloudIDs = append(cloudIDs, one.CloudID)
+		}
+
+		if len(cloudIDs) == 0 {
+			break
+		}
+
+		params := &SyncBaseParams{AccountID: accountID, Region: region, CloudIDs: cloudIDs}
+		resultFromCloud, err := cli.listSnapshotFromCloud(kt, params)
+		if err != nil {
+			return err
+		}
+
+		// 如果有资源没有查询出来，说明数据被从云上删除
+		if len(resultFromCloud) != len(cloudIDs) {
+			cloudIDMap := converter.StringSliceToMap(cloudIDs)
+			for _, one := range resultFromCloud {
+				delete(cloudIDMap, one.GetCloudID())
+			}
+
+			if err = cli.deleteSnapshot(kt, accountID, region, converter.MapKeyToStringSlice(cloudIDMap)); err != nil {
+				return err
+			}
+		}
+
+		if len(resultFromDB.Details) < constant.BatchOperationMaxLimit {
+			break
+		}
+
+		req.Page.Start += constant.BatchOperationMaxLimit
+	}
+
+	return nil
+}
+
+func convHuaWeiSnapshotExtension(one typessnapshot.HuaWeiSnapshot) *coresnapshot.HuaWeiSnapshotExtension {
+	return &coresnapshot.HuaWeiSnapshotExtension{
+		Description: one.Description,
+		UpdatedAt:   one.UpdatedAt,
+		Metadata:    one.Metadata,
+	}
+}
+
+func isSnapshotChange(cloud typessnapshot.HuaWeiSnapshot,
+	db coresnapshot.Snapshot[coresnapshot.HuaWeiSnapshotExtension]) bool {
+
+	if converter.PtrToVal(cloud.Name) != db.Name {
+		return true
+	}
+
+	if cloud.Status != db.Status {
+		return true
+	}
+
+	if uint64(cloud.Size) != db.Size {
+		return true
+	}
+
+	if cloud.VolumeId != db.CloudDiskID {
+		return true
+	}
+
+	// 来源云盘晚于快照同步到本地时，需要补充云盘关联
+	if len(db.CloudDiskID) != 0 && len(db.DiskID) == 0 {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	if !assert.IsPtrStringEqual(cloud.Description, db.Extension.Description) {
+		return true
+	}
+
+	if !assert.IsPtrStringEqual(cloud.UpdatedAt, db.Extension.UpdatedAt) {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,67 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+// Package kaopu 靠谱云资源同步
+package kaopu
+
+import (
+	"hcm/pkg/adaptor/kaopu"
+	dataservice "hcm/pkg/client/data-service"
+	"hcm/pkg/kit"
+)
+
+// Interface support resource sync.
+type Interface interface {
+	CloudCli() kaopu.Kaopu
+
+	Region(kt *kit.Kit, opt *SyncRegionOption) (*SyncResult, error)
+
+	Zone(kt *kit.Kit, opt *SyncZoneOption) (*SyncResult, error)
+
+	Vpc(kt *kit.Kit, params *SyncBaseParams, opt *SyncVpcOption) (*SyncResult, error)
+	RemoveVpcDeleteFromCloud(kt *kit.Kit, accountID string, region string) error
+
+	Subnet(kt *kit.Kit, params *SyncBaseParams, opt *SyncSubnetOption) (*SyncResult, error)
+	RemoveSubnetDeleteFromCloud(kt *kit.Kit, accountID string, region string) error
+
+	Cvm(kt *kit.Kit, params *SyncBaseParams, opt *SyncCvmOption) (*SyncResult, error)
+	RemoveCvmDeleteFromCloud(kt *kit.Kit, accountID string, region string) error
+
+	Eip(kt *kit.Kit, params *SyncBaseParams, opt *SyncEipOption) (*SyncResult, error)
+	RemoveEipDeleteFromCloud(kt *kit.Kit, accountID string, region string) error
+}
+
+var _ Interface = new(client)
+
+// NewClient new client.
+func NewClient(dbCli *dataservice.Client, cloudCli kaopu.Kaopu) Interface {
+	return &client{
+		dbCli:    dbCli,
+		cloudCli: cloudCli,
+	}
+}
+
+type client struct {
+	cloudCli kaopu.Kaopu
+	dbCli    *dataservice.Client
+}
+
+// CloudCli ...
+func (cli *client) CloudCli() kaopu.Kaopu {
+	return cli.cloudCli
+}
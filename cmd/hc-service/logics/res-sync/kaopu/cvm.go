@@ -0,0 +1,511 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package kaopu
+
+import (
+	"fmt"
+
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	adcore "hcm/pkg/adaptor/types/core"
+	typescvm "hcm/pkg/adaptor/types/cvm"
+	"hcm/pkg/api/core"
+	corecvm "hcm/pkg/api/core/cloud/cvm"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/runtime/filter"
+	"hcm/pkg/tools/assert"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncCvmOption ...
+type SyncCvmOption struct {
+}
+
+// Validate ...
+func (opt SyncCvmOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// Cvm ...
+func (cli *client) Cvm(kt *kit.Kit, params *SyncBaseParams, opt *SyncCvmOption) (*SyncResult, error) {
+	if err := validator.ValidateTool(params, opt); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	cvmFromCloud, err := cli.listCvmFromCloud(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	cvmFromDB, err := cli.listCvmFromDB(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cvmFromCloud) == 0 && len(cvmFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.DiffExtraVendor[typescvm.KaopuCvm,
+		corecvm.Cvm[corecvm.KaopuCvmExtension]](cvmFromCloud, cvmFromDB, isCvmChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteCvm(kt, params.AccountID, params.Region, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createCvm(kt, params.AccountID, params.Region, addSlice); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateCvm(kt, params.AccountID, params.Region, updateMap); err != nil {
+			return nil, err
+		}
+	}
+
+	return new(SyncResult), nil
+}
+
+func (cli *client) createCvm(kt *kit.Kit, accountID string, region string, addSlice []typescvm.KaopuCvm) error {
+	if len(addSlice) <= 0 {
+		return fmt.Errorf("cvm addSlice is <= 0, not create")
+	}
+
+	vpcMap, subnetMap, err := cli.getCvmRelMap(kt, accountID, region, addSlice)
+	if err != nil {
+		return err
+	}
+
+	lists := make([]dataproto.CvmBatchCreate[corecvm.KaopuCvmExtension], 0, len(addSlice))
+	for _, one := range addSlice {
+		vpc, exist := vpcMap[one.VpcID]
+		if !exist {
+			return fmt.Errorf("cvm %s can not find vpc", one.InstanceID)
+		}
+
+		subnetID, exist := subnetMap[one.SubnetID]
+		if !exist {
+			return fmt.Errorf("cvm %s can not find subnet", one.InstanceID)
+		}
+
+		lists = append(lists, dataproto.CvmBatchCreate[corecvm.KaopuCvmExtension]{
+			CloudID:        one.InstanceID,
+			Name:           one.InstanceName,
+			BkBizID:        constant.UnassignedBiz,
+			BkCloudID:      vpc.BkCloudID,
+			AccountID:      accountID,
+			Region:         region,
+			Zone:           one.ZoneID,
+			CloudVpcIDs:    []string{one.VpcID},
+			VpcIDs:         []string{vpc.VpcID},
+			CloudSubnetIDs: []string{one.SubnetID},
+			SubnetIDs:      []string{subnetID},
+			CloudImageID:   one.ImageID,
+			OsName:         one.OSName,
+			// 备注字段云上没有，仅限hcm内部使用
+			Memo:                 nil,
+			Status:               one.Status,
+			PrivateIPv4Addresses: one.PrivateIPAddresses,
+			PublicIPv4Addresses:  one.PublicIPAddresses,
+			MachineType:          one.InstanceType,
+			CloudCreatedTime:     one.CreationTime,
+			CloudExpiredTime:     one.ExpiredTime,
+			Extension:            convertCvmExtension(one),
+		})
+	}
+
+	createReq := &dataproto.CvmBatchCreateReq[corecvm.KaopuCvmExtension]{
+		Cvms: lists,
+	}
+	if _, err = cli.dbCli.Kaopu.Cvm.BatchCreateCvm(kt.Ctx, kt.Header(), createReq); err != nil {
+		logs.Errorf("[%s] request dataservice to create kaopu cvm failed, err: %v, rid: %s", enumor.Kaopu,
+			err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync cvm to create cvm success, accountID: %s, count: %d, rid: %s", enumor.Kaopu,
+		accountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateCvm(kt *kit.Kit, accountID string, region string,
+	updateMap map[string]typescvm.KaopuCvm) error {
+
+	if len(updateMap) <= 0 {
+		return fmt.Errorf("cvm updateMap is <= 0, not update")
+	}
+
+	cvms := make([]typescvm.KaopuCvm, 0, len(updateMap))
+	for _, one := range updateMap {
+		cvms = append(cvms, one)
+	}
+	vpcMap, subnetMap, err := cli.getCvmRelMap(kt, accountID, region, cvms)
+	if err != nil {
+		return err
+	}
+
+	lists := make([]dataproto.CvmBatchUpdate[corecvm.KaopuCvmExtension], 0, len(updateMap))
+	for id, one := range updateMap {
+		vpc, exist := vpcMap[one.VpcID]
+		if !exist {
+			return fmt.Errorf("cvm %s can not find vpc", one.InstanceID)
+		}
+
+		subnetID, exist := subnetMap[one.SubnetID]
+		if !exist {
+			return fmt.Errorf("cvm %s can not find subnet", one.InstanceID)
+		}
+
+		lists = append(lists, dataproto.CvmBatchUpdate[corecvm.KaopuCvmExtension]{
+			ID:             id,
+			Name:           one.InstanceName,
+			BkCloudID:      vpc.BkCloudID,
+			CloudVpcIDs:    []string{one.VpcID},
+			VpcIDs:         []string{vpc.VpcID},
+			CloudSubnetIDs: []string{one.SubnetID},
+			SubnetIDs:      []string{subnetID},
+			CloudImageID:   one.ImageID,
+			// 备注字段云上没有，仅限hcm内部使用
+			Memo:                 nil,
+			Status:               one.Status,
+			PrivateIPv4Addresses: one.PrivateIPAddresses,
+			PublicIPv4Addresses:  one.PublicIPAddresses,
+			CloudExpiredTime:     one.ExpiredTime,
+			Extension:            convertCvmExtension(one),
+		})
+	}
+
+	updateReq := &dataproto.CvmBatchUpdateReq[corecvm.KaopuCvmExtension]{
+		Cvms: lists,
+	}
+	if err = cli.dbCli.Kaopu.Cvm.BatchUpdateCvm(kt.Ctx, kt.Header(), updateReq); err != nil {
+		logs.Errorf("[%s] request dataservice to update kaopu cvm failed, err: %v, rid: %s", enumor.Kaopu,
+			err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync cvm to update cvm success, accountID: %s, count: %d, rid: %s", enumor.Kaopu,
+		accountID, len(updateMap), kt.Rid)
+
+	return nil
+}
+
+func convertCvmExtension(one typescvm.KaopuCvm) *corecvm.KaopuCvmExtension {
+	return &corecvm.KaopuCvmExtension{
+		InstanceType:          one.InstanceType,
+		Cpu:                   one.Cpu,
+		Memory:                one.Memory,
+		ChargeType:            one.ChargeType,
+		CloudSecurityGroupIDs: one.SecurityGroupIDs,
+		Bandwidth:             one.Bandwidth,
+	}
+}
+
+// getCvmRelMap 查询主机关联的 vpc、子网在 db 中的信息。
+func (cli *client) getCvmRelMap(kt *kit.Kit, accountID string, region string, cvms []typescvm.KaopuCvm) (
+	map[string]*common.VpcDB, map[string]string, error) {
+
+	cloudVpcIDs := make([]string, 0, len(cvms))
+	cloudSubnetIDs := make([]string, 0, len(cvms))
+	for _, one := range cvms {
+		cloudVpcIDs = append(cloudVpcIDs, one.VpcID)
+		cloudSubnetIDs = append(cloudSubnetIDs, one.SubnetID)
+	}
+
+	vpcMap, err := cli.getVpcMap(kt, accountID, region, cloudVpcIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subnetMap, err := cli.getSubnetMap(kt, accountID, region, cloudSubnetIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return vpcMap, subnetMap, nil
+}
+
+func (cli *client) getVpcMap(kt *kit.Kit, accountID string, region string,
+	cloudVpcIDs []string) (map[string]*common.VpcDB, error) {
+
+	vpcMap := make(map[string]*common.VpcDB)
+
+	elems := slice.Split(slice.Unique(cloudVpcIDs), constant.CloudResourceSyncMaxLimit)
+	for _, parts := range elems {
+		vpcParams := &SyncBaseParams{
+			AccountID: accountID,
+			Region:    region,
+			CloudIDs:  parts,
+		}
+		vpcFromDB, err := cli.listVpcFromDB(kt, vpcParams)
+		if err != nil {
+			return vpcMap, err
+		}
+
+		for _, vpc := range vpcFromDB {
+			vpcMap[vpc.CloudID] = &common.VpcDB{
+				VpcID:     vpc.ID,
+				BkCloudID: vpc.BkCloudID,
+			}
+		}
+	}
+
+	return vpcMap, nil
+}
+
+func (cli *client) getSubnetMap(kt *kit.Kit, accountID string, region string,
+	cloudSubnetIDs []string) (map[string]string, error) {
+
+	subnetMap := make(map[string]string)
+
+	elems := slice.Split(slice.Unique(cloudSubnetIDs), constant.CloudResourceSyncMaxLimit)
+	for _, parts := range elems {
+		subnetParams := &SyncBaseParams{
+			AccountID: accountID,
+			Region:    region,
+			CloudIDs:  parts,
+		}
+		subnetFromDB, err := cli.listSubnetFromDB(kt, subnetParams)
+		if err != nil {
+			return subnetMap, err
+		}
+
+		for _, subnet := range subnetFromDB {
+			subnetMap[subnet.CloudID] = subnet.ID
+		}
+	}
+
+	return subnetMap, nil
+}
+
+func (cli *client) deleteCvm(kt *kit.Kit, accountID string, region string, delCloudIDs []string) error {
+	if len(delCloudIDs) <= 0 {
+		return fmt.Errorf("cvm delCloudIDs is <= 0, not delete")
+	}
+
+	checkParams := &SyncBaseParams{
+		AccountID: accountID,
+		Region:    region,
+		CloudIDs:  delCloudIDs,
+	}
+	delCvmFromCloud, err := cli.listCvmFromCloud(kt, checkParams)
+	if err != nil {
+		return err
+	}
+
+	if len(delCvmFromCloud) > 0 {
+		logs.Errorf("[%s] validate cvm not exist failed, before delete, opt: %v, failed_count: %d, rid: %s",
+			enumor.Kaopu, checkParams, len(delCvmFromCloud), kt.Rid)
+		return fmt.Errorf("validate cvm not exist failed, before delete")
+	}
+
+	deleteReq := &dataproto.CvmBatchDeleteReq{
+		Filter: tools.ContainersExpression("cloud_id", delCloudIDs),
+	}
+	if err = cli.dbCli.Global.Cvm.BatchDeleteCvm(kt.Ctx, kt.Header(), deleteReq); err != nil {
+		logs.Errorf("[%s] request dataservice to batch delete cvm failed, err: %v, rid: %s", enumor.Kaopu,
+			err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync cvm to delete cvm success, accountID: %s, count: %d, rid: %s", enumor.Kaopu,
+		accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) listCvmFromCloud(kt *kit.Kit, params *SyncBaseParams) ([]typescvm.KaopuCvm, error) {
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	opt := &adcore.KaopuListOption{
+		Region:   params.Region,
+		CloudIDs: params.CloudIDs,
+		Page: &adcore.KaopuPage{
+			PageNumber: 1,
+			PageSize:   adcore.KaopuQueryLimit,
+		},
+	}
+	result, err := cli.cloudCli.ListCvm(kt, opt)
+	if err != nil {
+		logs.Errorf("[%s] list cvm from cloud failed, err: %v, account: %s, opt: %v, rid: %s", enumor.Kaopu,
+			err, params.AccountID, opt, kt.Rid)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (cli *client) listCvmFromDB(kt *kit.Kit, params *SyncBaseParams) (
+	[]corecvm.Cvm[corecvm.KaopuCvmExtension], error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := &dataproto.CvmListReq{
+		Filter: &filter.Expression{
+			Op: filter.And,
+			Rules: []filter.RuleFactory{
+				&filter.AtomRule{Field: "account_id", Op: filter.Equal.Factory(), Value: params.AccountID},
+				&filter.AtomRule{Field: "cloud_id", Op: filter.In.Factory(), Value: params.CloudIDs},
+				&filter.AtomRule{Field: "region", Op: filter.Equal.Factory(), Value: params.Region},
+			},
+		},
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := cli.dbCli.Kaopu.Cvm.ListCvmExt(kt.Ctx, kt.Header(), req)
+	if err != nil {
+		logs.Errorf("[%s] list cvm from db failed, err: %v, account: %s, req: %v, rid: %s", enumor.Kaopu,
+			err, params.AccountID, req, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+// RemoveCvmDeleteFromCloud ...
+func (cli *client) RemoveCvmDeleteFromCloud(kt *kit.Kit, accountID string, region string) error {
+	req := &dataproto.CvmListReq{
+		Field: []string{"id", "cloud_id"},
+		Filter: &filter.Expression{
+			Op: filter.And,
+			Rules: []filter.RuleFactory{
+				&filter.AtomRule{Field: "account_id", Op: filter.Equal.Factory(), Value: accountID},
+				&filter.AtomRule{Field: "region", Op: filter.Equal.Factory(), Value: region},
+			},
+		},
+		Page: &core.BasePage{
+			Start: 0,
+			Limit: constant.CloudResourceSyncMaxLimit,
+		},
+	}
+	for {
+		resultFromDB, err := cli.dbCli.Kaopu.Cvm.ListCvmExt(kt.Ctx, kt.Header(), req)
+		if err != nil {
+			logs.Errorf("[%s] request dataservice to list cvm failed, err: %v, req: %v, rid: %s", enumor.Kaopu,
+				err, req, kt.Rid)
+			return err
+		}
+
+		cloudIDs := make([]string, 0, len(resultFromDB.Details))
+		for _, one := range resultFromDB.Details {
+			cloudIDs = append(cloudIDs, one.CloudID)
+		}
+
+		if len(cloudIDs) == 0 {
+			break
+		}
+
+		params := &SyncBaseParams{
+			AccountID: accountID,
+			Region:    region,
+			CloudIDs:  cloudIDs,
+		}
+		resultFromCloud, err := cli.listCvmFromCloud(kt, params)
+		if err != nil {
+			return err
+		}
+
+		// 如果有资源没有查询出来，说明数据被从云上删除
+		if len(resultFromCloud) != len(cloudIDs) {
+			cloudIDMap := converter.StringSliceToMap(cloudIDs)
+			for _, one := range resultFromCloud {
+				delete(cloudIDMap, one.InstanceID)
+			}
+
+			delCloudIDs := converter.MapKeyToStringSlice(cloudIDMap)
+			if err = cli.deleteCvm(kt, accountID, region, delCloudIDs); err != nil {
+				return err
+			}
+		}
+
+		if len(resultFromDB.Details) < constant.CloudResourceSyncMaxLimit {
+			break
+		}
+
+		req.Page.Start += constant.CloudResourceSyncMaxLimit
+	}
+
+	return nil
+}
+
+func isCvmChange(cloud typescvm.KaopuCvm, db corecvm.Cvm[corecvm.KaopuCvmExtension]) bool {
+	if db.Name != cloud.InstanceName {
+		return true
+	}
+
+	if db.Status != cloud.Status {
+		return true
+	}
+
+	if db.CloudImageID != cloud.ImageID {
+		return true
+	}
+
+	if db.CloudExpiredTime != cloud.ExpiredTime {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(db.CloudVpcIDs, []string{cloud.VpcID}) {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(db.CloudSubnetIDs, []string{cloud.SubnetID}) {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(db.PrivateIPv4Addresses, cloud.PrivateIPAddresses) {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(db.PublicIPv4Addresses, cloud.PublicIPAddresses) {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	ext := convertCvmExtension(cloud)
+	if db.Extension.InstanceType != ext.InstanceType || db.Extension.Cpu != ext.Cpu ||
+		db.Extension.Memory != ext.Memory || db.Extension.ChargeType != ext.ChargeType ||
+		db.Extension.Bandwidth != ext.Bandwidth {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(db.Extension.CloudSecurityGroupIDs, ext.CloudSecurityGroupIDs) {
+		return true
+	}
+
+	return false
+}
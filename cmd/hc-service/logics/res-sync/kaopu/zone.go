@@ -0,0 +1,251 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package kaopu
+
+import (
+	"errors"
+
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	typeszone "hcm/pkg/adaptor/types/zone"
+	"hcm/pkg/api/core"
+	corezone "hcm/pkg/api/core/cloud/zone"
+	datazone "hcm/pkg/api/data-service/cloud/zone"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/runtime/filter"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// zoneAvailableState 靠谱云可用区没有状态字段，云上查询到的可用区均为可用状态
+const zoneAvailableState = "AVAILABLE"
+
+// SyncZoneOption ...
+type SyncZoneOption struct {
+	AccountID string `json:"account_id" validate:"required"`
+	Region    string `json:"region" validate:"required"`
+}
+
+// Validate ...
+func (opt SyncZoneOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// Zone ...
+func (cli *client) Zone(kt *kit.Kit, opt *SyncZoneOption) (*SyncResult, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	zoneFromCloud, err := cli.listZoneFromCloud(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	zoneFromDB, err := cli.listZoneFromDB(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(zoneFromCloud) == 0 && len(zoneFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.DiffExtraVendor[typeszone.KaopuZone, corezone.BaseZone](
+		zoneFromCloud, zoneFromDB, isZoneChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteZone(kt, opt, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createZone(kt, opt, addSlice); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateZone(kt, opt, updateMap); err != nil {
+			return nil, err
+		}
+	}
+
+	return new(SyncResult), nil
+}
+
+func (cli *client) createZone(kt *kit.Kit, opt *SyncZoneOption, addSlice []typeszone.KaopuZone) error {
+	if len(addSlice) <= 0 {
+		return errors.New("zone addSlice is <= 0, not create")
+	}
+
+	list := make([]datazone.ZoneBatchCreate[corezone.KaopuZoneExtension], 0, len(addSlice))
+	for _, one := range addSlice {
+		list = append(list, datazone.ZoneBatchCreate[corezone.KaopuZoneExtension]{
+			CloudID:   one.ZoneID,
+			Name:      one.ZoneID,
+			State:     zoneAvailableState,
+			Region:    opt.Region,
+			NameCn:    one.LocalName,
+			Extension: new(corezone.KaopuZoneExtension),
+		})
+	}
+
+	createReq := &datazone.ZoneBatchCreateReq[corezone.KaopuZoneExtension]{
+		Zones: list,
+	}
+	if _, err := cli.dbCli.Kaopu.Zone.BatchCreateZone(kt.Ctx, kt.Header(), createReq); err != nil {
+		logs.Errorf("[%s] create zone failed, err: %v, account: %s, opt: %v, rid: %s", enumor.Kaopu,
+			err, opt.AccountID, opt, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync zone to create zone success, accountID: %s, count: %d, rid: %s", enumor.Kaopu,
+		opt.AccountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateZone(kt *kit.Kit, opt *SyncZoneOption, updateMap map[string]typeszone.KaopuZone) error {
+	if len(updateMap) <= 0 {
+		return errors.New("zone updateMap is <= 0, not update")
+	}
+
+	list := make([]datazone.ZoneBatchUpdate[corezone.KaopuZoneExtension], 0, len(updateMap))
+	for id := range updateMap {
+		list = append(list, datazone.ZoneBatchUpdate[corezone.KaopuZoneExtension]{
+			ID:    id,
+			State: zoneAvailableState,
+		})
+	}
+
+	updateReq := &datazone.ZoneBatchUpdateReq[corezone.KaopuZoneExtension]{
+		Zones: list,
+	}
+	if err := cli.dbCli.Kaopu.Zone.BatchUpdateZone(kt.Ctx, kt.Header(), updateReq); err != nil {
+		logs.Errorf("[%s] update zone failed, err: %v, account: %s, opt: %v, rid: %s", enumor.Kaopu,
+			err, opt.AccountID, opt, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync zone to update zone success, accountID: %s, count: %d, rid: %s", enumor.Kaopu,
+		opt.AccountID, len(updateMap), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) deleteZone(kt *kit.Kit, opt *SyncZoneOption, delCloudIDs []string) error {
+	if len(delCloudIDs) <= 0 {
+		return errors.New("zone delCloudIDs is <= 0, not delete")
+	}
+
+	delZoneFromCloud, err := cli.listZoneFromCloud(kt, opt)
+	if err != nil {
+		return err
+	}
+
+	delCloudMap := converter.StringSliceToMap(delCloudIDs)
+	for _, one := range delZoneFromCloud {
+		if _, exist := delCloudMap[one.ZoneID]; exist {
+			logs.Errorf("[%s] validate zone not exist failed, before delete, opt: %v, failed_count: %d, rid: %s",
+				enumor.Kaopu, opt, len(delZoneFromCloud), kt.Rid)
+			return errors.New("validate zone not exist failed, before delete")
+		}
+	}
+
+	elems := slice.Split(delCloudIDs, constant.CloudResourceSyncMaxLimit)
+	for _, parts := range elems {
+		deleteReq := &datazone.ZoneBatchDeleteReq{
+			Filter: tools.ContainersExpression("cloud_id", parts),
+		}
+		if err = cli.dbCli.Global.Zone.BatchDeleteZone(kt.Ctx, kt.Header(), deleteReq); err != nil {
+			logs.Errorf("[%s] delete zone failed, err: %v, account: %s, opt: %v, rid: %s", enumor.Kaopu,
+				err, opt.AccountID, opt, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync zone to delete zone success, accountID: %s, count: %d, rid: %s", enumor.Kaopu,
+		opt.AccountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) listZoneFromCloud(kt *kit.Kit, opt *SyncZoneOption) ([]typeszone.KaopuZone, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	zoneOpt := &typeszone.KaopuZoneListOption{
+		Region: opt.Region,
+	}
+	results, err := cli.cloudCli.ListZone(kt, zoneOpt)
+	if err != nil {
+		logs.Errorf("[%s] list zone from cloud failed, err: %v, account: %s, opt: %v, rid: %s", enumor.Kaopu,
+			err, opt.AccountID, opt, kt.Rid)
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (cli *client) listZoneFromDB(kt *kit.Kit, opt *SyncZoneOption) ([]corezone.BaseZone, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := &datazone.ZoneListReq{
+		Filter: &filter.Expression{
+			Op: filter.And,
+			Rules: []filter.RuleFactory{
+				&filter.AtomRule{Field: "vendor", Op: filter.Equal.Factory(), Value: enumor.Kaopu},
+				&filter.AtomRule{Field: "region", Op: filter.Equal.Factory(), Value: opt.Region},
+			},
+		},
+		Page: core.NewDefaultBasePage(),
+	}
+	results := make([]corezone.BaseZone, 0)
+	for {
+		zones, err := cli.dbCli.Global.Zone.ListZone(kt.Ctx, kt.Header(), req)
+		if err != nil {
+			logs.Errorf("[%s] list zone from db failed, err: %v, account: %s, req: %v, rid: %s", enumor.Kaopu,
+				err, opt.AccountID, req, kt.Rid)
+			return nil, err
+		}
+		results = append(results, zones.Details...)
+
+		if len(zones.Details) < int(core.DefaultMaxPageLimit) {
+			break
+		}
+
+		req.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return results, nil
+}
+
+func isZoneChange(cloud typeszone.KaopuZone, db corezone.BaseZone) bool {
+	return db.State != zoneAvailableState
+}
@@ -64,6 +64,11 @@ type Interface interface {
 
 	SubAccount(kt *kit.Kit, opt *SyncSubAccountOption) (*SyncResult, error)
 
+	Bucket(kt *kit.Kit, opt *SyncBucketOption) (*SyncResult, error)
+	K8sCluster(kt *kit.Kit, opt *SyncK8sClusterOption) (*SyncResult, error)
+	KeyPair(kt *kit.Kit, opt *SyncKeyPairOption) (*SyncResult, error)
+	PrivateDnsZone(kt *kit.Kit, opt *SyncPrivateDnsZoneOption) (*SyncResult, error)
+
 	ArgsTplAddress(kt *kit.Kit, params *SyncBaseParams, opt *SyncArgsTplOption) (*SyncResult, error)
 	RemoveArgsTplAddressDeleteFromCloud(kt *kit.Kit, accountID string, region string) error
 	ArgsTplAddressGroup(kt *kit.Kit, params *SyncBaseParams, opt *SyncArgsTplOption) (*SyncResult, error)
@@ -84,6 +89,18 @@ type Interface interface {
 
 	// Listener 同步指定负载均衡下的指定云id 负载均衡
 	Listener(kt *kit.Kit, params *SyncBaseParams, opt *SyncListenerOption) (*SyncResult, error)
+
+	Snapshot(kt *kit.Kit, params *SyncBaseParams, opt *SyncSnapshotOption) (*SyncResult, error)
+	RemoveSnapshotDeleteFromCloud(kt *kit.Kit, accountID string, region string) error
+
+	DatabaseInstance(kt *kit.Kit, params *SyncBaseParams, opt *SyncDatabaseInstanceOption) (*SyncResult, error)
+	RemoveDatabaseInstanceDeleteFromCloud(kt *kit.Kit, accountID string, region string) error
+
+	NatGateway(kt *kit.Kit, params *SyncBaseParams, opt *SyncNatGatewayOption) (*SyncResult, error)
+	RemoveNatGatewayDeleteFromCloud(kt *kit.Kit, accountID string, region string) error
+
+	VpcPeering(kt *kit.Kit, params *SyncBaseParams, opt *SyncVpcPeeringOption) (*SyncResult, error)
+	RemoveVpcPeeringDeleteFromCloud(kt *kit.Kit, accountID string, region string) error
 }
 
 var _ Interface = new(client)
@@ -0,0 +1,429 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tcloud
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	adcore "hcm/pkg/adaptor/types/core"
+	typesdbinstance "hcm/pkg/adaptor/types/database-instance"
+	"hcm/pkg/api/core"
+	coredbinstance "hcm/pkg/api/core/cloud/database-instance"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/assert"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+const tcloudDBInstanceEngine = "mysql"
+
+// SyncDatabaseInstanceOption ...
+type SyncDatabaseInstanceOption struct {
+}
+
+// Validate ...
+func (opt SyncDatabaseInstanceOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// DatabaseInstance 同步云数据库MySQL实例，业务由分配操作决定，同步不覆盖
+func (cli *client) DatabaseInstance(kt *kit.Kit, params *SyncBaseParams, opt *SyncDatabaseInstanceOption) (
+	*SyncResult, error) {
+
+	if err := validator.ValidateTool(params, opt); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	dbFromCloud, err := cli.listDBInstanceFromCloud(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	dbFromDB, err := cli.listDBInstanceFromDB(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dbFromCloud) == 0 && len(dbFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.DiffPaaS[typesdbinstance.TCloudDBInstance,
+		coredbinstance.DatabaseInstance[coredbinstance.TCloudDBInstanceExtension]](dbFromCloud, dbFromDB,
+		isDBInstanceChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteDBInstance(kt, params.AccountID, params.Region, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	cloudVpcIDs := make([]string, 0, len(addSlice)+len(updateMap))
+	cloudSubnetIDs := make([]string, 0, len(addSlice)+len(updateMap))
+	for _, one := range addSlice {
+		cloudVpcIDs = append(cloudVpcIDs, converter.PtrToVal(one.UniqVpcId))
+		cloudSubnetIDs = append(cloudSubnetIDs, converter.PtrToVal(one.UniqSubnetId))
+	}
+	for _, one := range updateMap {
+		cloudVpcIDs = append(cloudVpcIDs, converter.PtrToVal(one.UniqVpcId))
+		cloudSubnetIDs = append(cloudSubnetIDs, converter.PtrToVal(one.UniqSubnetId))
+	}
+	vpcMap, err := common.GetNetworkVpcRelMap(kt, cli.dbCli, enumor.TCloud, params.AccountID, cloudVpcIDs)
+	if err != nil {
+		return nil, err
+	}
+	_, subnetMap, err := common.GetLoadBalancerVpcSubnetMap(kt, cli.dbCli, enumor.TCloud, params.AccountID, nil,
+		cloudSubnetIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createDBInstance(kt, params.AccountID, params.Region, addSlice, vpcMap, subnetMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateDBInstance(kt, updateMap, vpcMap, subnetMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = cli.dbInstanceSgRel(kt, params); err != nil {
+		return nil, err
+	}
+
+	return new(SyncResult), nil
+}
+
+func (cli *client) createDBInstance(kt *kit.Kit, accountID, region string,
+	addSlice []typesdbinstance.TCloudDBInstance, vpcMap map[string]common.NetworkVpcRel,
+	subnetMap map[string]string) error {
+
+	createReq := new(protocloud.DatabaseInstanceBatchCreateReq[coredbinstance.TCloudDBInstanceExtension])
+	for _, one := range addSlice {
+		cloudVpcID := converter.PtrToVal(one.UniqVpcId)
+		cloudSubnetID := converter.PtrToVal(one.UniqSubnetId)
+		db := protocloud.DatabaseInstanceBatchCreate[coredbinstance.TCloudDBInstanceExtension]{
+			CloudID:          one.GetCloudID(),
+			AccountID:        accountID,
+			BkBizID:          constant.UnassignedBiz,
+			Name:             converter.PtrToVal(one.InstanceName),
+			Region:           region,
+			Zone:             converter.PtrToVal(one.Zone),
+			Status:           one.GetStatus(),
+			Engine:           tcloudDBInstanceEngine,
+			EngineVersion:    converter.PtrToVal(one.EngineVersion),
+			InstanceClass:    converter.PtrToVal(one.DeviceType),
+			StorageSize:      converter.PtrToVal(one.Volume),
+			ChargeType:       one.GetChargeType(),
+			VpcID:            vpcMap[cloudVpcID].VpcID,
+			CloudVpcID:       cloudVpcID,
+			SubnetID:         subnetMap[cloudSubnetID],
+			CloudSubnetID:    cloudSubnetID,
+			PrivateAddress:   converter.PtrToVal(one.Vip),
+			PublicAddress:    converter.PtrToVal(one.WanDomain),
+			Port:             converter.PtrToVal(one.Vport),
+			CloudCreatedTime: converter.PtrToVal(one.CreateTime),
+			CloudExpiredTime: converter.PtrToVal(one.DeadlineTime),
+			Extension:        convTCloudDBInstanceExtension(one),
+		}
+		createReq.DatabaseInstances = append(createReq.DatabaseInstances, db)
+	}
+
+	for _, batch := range slice.Split(createReq.DatabaseInstances, constant.BatchOperationMaxLimit) {
+		req := &protocloud.DatabaseInstanceBatchCreateReq[coredbinstance.TCloudDBInstanceExtension]{
+			DatabaseInstances: batch,
+		}
+		if _, err := cli.dbCli.TCloud.DatabaseInstance.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create database instance failed, err: %v, rid: %s",
+				enumor.TCloud, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync database instance to create database instance success, accountID: %s, count: %d, "+
+		"rid: %s", enumor.TCloud, accountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateDBInstance(kt *kit.Kit, updateMap map[string]typesdbinstance.TCloudDBInstance,
+	vpcMap map[string]common.NetworkVpcRel, subnetMap map[string]string) error {
+
+	updateReq := make(protocloud.DatabaseInstanceExtBatchUpdateReq[coredbinstance.TCloudDBInstanceExtension], 0,
+		len(updateMap))
+	for id, one := range updateMap {
+		cloudVpcID := converter.PtrToVal(one.UniqVpcId)
+		cloudSubnetID := converter.PtrToVal(one.UniqSubnetId)
+		db := &protocloud.DatabaseInstanceExtUpdateReq[coredbinstance.TCloudDBInstanceExtension]{
+			ID:               id,
+			Name:             converter.PtrToVal(one.InstanceName),
+			Zone:             converter.PtrToVal(one.Zone),
+			Status:           one.GetStatus(),
+			EngineVersion:    converter.PtrToVal(one.EngineVersion),
+			InstanceClass:    converter.PtrToVal(one.DeviceType),
+			StorageSize:      converter.PtrToVal(one.Volume),
+			ChargeType:       one.GetChargeType(),
+			VpcID:            vpcMap[cloudVpcID].VpcID,
+			CloudVpcID:       cloudVpcID,
+			SubnetID:         subnetMap[cloudSubnetID],
+			CloudSubnetID:    cloudSubnetID,
+			PrivateAddress:   converter.PtrToVal(one.Vip),
+			PublicAddress:    converter.PtrToVal(one.WanDomain),
+			Port:             converter.PtrToVal(one.Vport),
+			CloudExpiredTime: converter.PtrToVal(one.DeadlineTime),
+			Extension:        convTCloudDBInstanceExtension(one),
+		}
+		updateReq = append(updateReq, db)
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.DatabaseInstanceExtBatchUpdateReq[coredbinstance.TCloudDBInstanceExtension](batch)
+		if err := cli.dbCli.TCloud.DatabaseInstance.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update database instance failed, err: %v, rid: %s",
+				enumor.TCloud, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync database instance to update database instance success, count: %d, rid: %s",
+		enumor.TCloud, len(updateMap), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) deleteDBInstance(kt *kit.Kit, accountID, region string, delCloudIDs []string) error {
+	checkParams := &SyncBaseParams{AccountID: accountID, Region: region, CloudIDs: delCloudIDs}
+	delFromCloud, err := cli.listDBInstanceFromCloud(kt, checkParams)
+	if err != nil {
+		return err
+	}
+
+	if len(delFromCloud) > 0 {
+		logs.Errorf("[%s] validate database instance not exist failed, before delete opt: %v, failed_count: %d, "+
+			"rid: %s", enumor.TCloud, checkParams, len(delFromCloud), kt.Rid)
+		return errf.Newf(errf.Aborted, "validate database instance not exist failed, before delete")
+	}
+
+	req := &protocloud.DatabaseInstanceBatchDeleteReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.TCloud),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleIn("cloud_id", delCloudIDs),
+		),
+	}
+	if err = cli.dbCli.Global.DatabaseInstance.BatchDelete(kt, req); err != nil {
+		logs.Errorf("[%s] request dataservice to batch delete database instance failed, err: %v, rid: %s",
+			enumor.TCloud, err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync database instance to delete database instance success, accountID: %s, count: %d, "+
+		"rid: %s", enumor.TCloud, accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+// dbInstanceSgRel 同步云数据库实例与安全组的关联关系，腾讯云需逐个实例查询绑定的安全组
+func (cli *client) dbInstanceSgRel(kt *kit.Kit, params *SyncBaseParams) error {
+	dbFromDB, err := cli.listDBInstanceFromDB(kt, params)
+	if err != nil {
+		return err
+	}
+
+	dbSgCloudMap := make(map[string][]string, len(dbFromDB))
+	for _, one := range dbFromDB {
+		sgCloudIDs, err := cli.cloudCli.ListDatabaseInstanceSecurityGroup(kt, params.Region, one.CloudID)
+		if err != nil {
+			logs.Errorf("[%s] list database instance security group from cloud failed, err: %v, id: %s, rid: %s",
+				enumor.TCloud, err, one.CloudID, kt.Rid)
+			return err
+		}
+		dbSgCloudMap[one.ID] = sgCloudIDs
+	}
+
+	return common.SyncDatabaseInstanceSGRel(kt, cli.dbCli, enumor.TCloud, dbSgCloudMap)
+}
+
+func (cli *client) listDBInstanceFromCloud(kt *kit.Kit, params *SyncBaseParams) (
+	[]typesdbinstance.TCloudDBInstance, error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	result := make([]typesdbinstance.TCloudDBInstance, 0, len(params.CloudIDs))
+	for _, batch := range slice.Split(params.CloudIDs, adcore.TCloudQueryLimit) {
+		opt := &adcore.TCloudListOption{
+			Region:   params.Region,
+			CloudIDs: batch,
+			Page:     &adcore.TCloudPage{Offset: 0, Limit: adcore.TCloudQueryLimit},
+		}
+		dbs, err := cli.cloudCli.ListDatabaseInstance(kt, opt)
+		if err != nil {
+			logs.Errorf("[%s] list database instance from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+				enumor.TCloud, err, params.AccountID, opt, kt.Rid)
+			return nil, err
+		}
+		result = append(result, dbs...)
+	}
+
+	return result, nil
+}
+
+func (cli *client) listDBInstanceFromDB(kt *kit.Kit, params *SyncBaseParams) (
+	[]coredbinstance.DatabaseInstance[coredbinstance.TCloudDBInstanceExtension], error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", params.AccountID),
+			tools.RuleEqual("region", params.Region),
+			tools.RuleIn("cloud_id", params.CloudIDs),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := cli.dbCli.TCloud.DatabaseInstance.ListDatabaseInstanceExt(kt, req)
+	if err != nil {
+		logs.Errorf("[%s] list database instance from db failed, err: %v, account: %s, req: %v, rid: %s",
+			enumor.TCloud, err, params.AccountID, req, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+// RemoveDatabaseInstanceDeleteFromCloud 删除本地存在但云上已被删除的云数据库实例
+func (cli *client) RemoveDatabaseInstanceDeleteFromCloud(kt *kit.Kit, accountID string, region string) error {
+	req := &core.ListReq{
+		Fields: []string{"id", "cloud_id"},
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.TCloud),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleEqual("region", region),
+		),
+		Page: &core.BasePage{Start: 0, Limit: constant.BatchOperationMaxLimit},
+	}
+	for {
+		resultFromDB, err := cli.dbCli.Global.DatabaseInstance.List(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] request dataservice to list database instance failed, err: %v, req: %v, rid: %s",
+				enumor.TCloud, err, req, kt.Rid)
+			return err
+		}
+
+		cloudIDs := make([]string, 0, len(resultFromDB.Details))
+		for _, one := range resultFromDB.Details {
+			cloudIDs = append(cloudIDs, one.CloudID)
+		}
+
+		if len(cloudIDs) == 0 {
+			break
+		}
+
+		params := &SyncBaseParams{AccountID: accountID, Region: region, CloudIDs: cloudIDs}
+		resultFromCloud, err := cli.listDBInstanceFromCloud(kt, params)
+		if err != nil {
+			return err
+		}
+
+		// 如果有资源没有查询出来，说明数据被从云上删除
+		if len(resultFromCloud) != len(cloudIDs) {
+			cloudIDMap := converter.StringSliceToMap(cloudIDs)
+			for _, one := range resultFromCloud {
+				delete(cloudIDMap, one.GetCloudID())
+			}
+
+			err = cli.deleteDBInstance(kt, accountID, region, converter.MapKeyToStringSlice(cloudIDMap))
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(resultFromDB.Details) < constant.BatchOperationMaxLimit {
+			break
+		}
+
+		req.Page.Start += constant.BatchOperationMaxLimit
+	}
+
+	return nil
+}
+
+func convTCloudDBInstanceExtension(one typesdbinstance.TCloudDBInstance) *coredbinstance.TCloudDBInstanceExtension {
+	return &coredbinstance.TCloudDBInstanceExtension{
+		InstanceType: one.InstanceType,
+		ProjectID:    one.ProjectId,
+		DeviceType:   one.DeviceType,
+		EngineType:   one.EngineType,
+		Cpu:          one.Cpu,
+		Memory:       one.Memory,
+	}
+}
+
+func isDBInstanceChange(cloud typesdbinstance.TCloudDBInstance,
+	db coredbinstance.DatabaseInstance[coredbinstance.TCloudDBInstanceExtension]) bool {
+
+	if converter.PtrToVal(cloud.InstanceName) != db.Name || cloud.GetStatus() != db.Status {
+		return true
+	}
+
+	if converter.PtrToVal(cloud.EngineVersion) != db.EngineVersion ||
+		converter.PtrToVal(cloud.Volume) != db.StorageSize || cloud.GetChargeType() != db.ChargeType {
+		return true
+	}
+
+	if converter.PtrToVal(cloud.UniqVpcId) != db.CloudVpcID ||
+		converter.PtrToVal(cloud.UniqSubnetId) != db.CloudSubnetID {
+		return true
+	}
+
+	if converter.PtrToVal(cloud.Vip) != db.PrivateAddress || converter.PtrToVal(cloud.WanDomain) != db.PublicAddress ||
+		converter.PtrToVal(cloud.Vport) != db.Port {
+		return true
+	}
+
+	if converter.PtrToVal(cloud.DeadlineTime) != db.CloudExpiredTime {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	if !assert.IsPtrInt64Equal(cloud.Cpu, db.Extension.Cpu) ||
+		!assert.IsPtrInt64Equal(cloud.Memory, db.Extension.Memory) ||
+		!assert.IsPtrStringEqual(cloud.DeviceType, db.Extension.DeviceType) {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,300 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tcloud
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	adcore "hcm/pkg/adaptor/types/core"
+	typesk8s "hcm/pkg/adaptor/types/k8s-cluster"
+	"hcm/pkg/api/core"
+	corek8s "hcm/pkg/api/core/cloud/k8s"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncK8sClusterOption ...
+type SyncK8sClusterOption struct {
+	AccountID string `json:"account_id" validate:"required"`
+	Region    string `json:"region" validate:"required"`
+}
+
+// Validate ...
+func (opt SyncK8sClusterOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// K8sCluster 同步地域下的TKE集群，以及集群下的节点池和节点与主机的关联关系，业务由分配操作决定，同步不覆盖
+func (cli *client) K8sCluster(kt *kit.Kit, opt *SyncK8sClusterOption) (*SyncResult, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	clusterFromCloud, err := cli.listK8sClusterFromCloud(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterFromDB, err := cli.listK8sClusterFromDB(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(clusterFromCloud) == 0 && len(clusterFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.DiffPaaS[typesk8s.TCloudCluster,
+		corek8s.K8sCluster[corek8s.TCloudK8sClusterExtension]](clusterFromCloud, clusterFromDB, isK8sClusterChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteK8sCluster(kt, opt.AccountID, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createK8sCluster(kt, opt, addSlice); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateK8sCluster(kt, opt, updateMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = cli.k8sNodePoolAndNode(kt, opt); err != nil {
+		return nil, err
+	}
+
+	return new(SyncResult), nil
+}
+
+// k8sNodePoolAndNode 同步本地全部集群的节点池，以及节点和主机的关联关系
+func (cli *client) k8sNodePoolAndNode(kt *kit.Kit, opt *SyncK8sClusterOption) error {
+	clusterFromDB, err := cli.listK8sClusterFromDB(kt, opt)
+	if err != nil {
+		return err
+	}
+
+	for _, cluster := range clusterFromDB {
+		nodeOpt := &typesk8s.TCloudK8sNodeListOption{Region: opt.Region, ClusterCloudID: cluster.CloudID}
+		pools, err := cli.cloudCli.ListK8sNodePool(kt, nodeOpt)
+		if err != nil {
+			logs.Errorf("[%s] list k8s node pool from cloud failed, err: %v, opt: %v, rid: %s", enumor.TCloud, err,
+				nodeOpt, kt.Rid)
+			return err
+		}
+
+		nodes, err := cli.cloudCli.ListK8sNode(kt, nodeOpt)
+		if err != nil {
+			logs.Errorf("[%s] list k8s node from cloud failed, err: %v, opt: %v, rid: %s", enumor.TCloud, err,
+				nodeOpt, kt.Rid)
+			return err
+		}
+
+		if err = common.SyncK8sNodePoolAndNode(kt, cli.dbCli, cluster.BaseK8sCluster, pools, nodes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cli *client) createK8sCluster(kt *kit.Kit, opt *SyncK8sClusterOption,
+	addSlice []typesk8s.TCloudCluster) error {
+
+	clusters := make([]protocloud.K8sClusterBatchCreate[corek8s.TCloudK8sClusterExtension], 0, len(addSlice))
+	for _, one := range addSlice {
+		clusters = append(clusters, protocloud.K8sClusterBatchCreate[corek8s.TCloudK8sClusterExtension]{
+			CloudID:          one.GetCloudID(),
+			AccountID:        opt.AccountID,
+			BkBizID:          constant.UnassignedBiz,
+			Name:             converter.PtrToVal(one.ClusterName),
+			Region:           opt.Region,
+			Status:           converter.PtrToVal(one.ClusterStatus),
+			Version:          converter.PtrToVal(one.ClusterVersion),
+			CloudVpcID:       one.GetCloudVpcID(),
+			CloudCreatedTime: converter.PtrToVal(one.CreatedTime),
+			Extension:        convTCloudK8sClusterExtension(one),
+		})
+	}
+
+	for _, batch := range slice.Split(clusters, constant.BatchOperationMaxLimit) {
+		req := &protocloud.K8sClusterBatchCreateReq[corek8s.TCloudK8sClusterExtension]{K8sClusters: batch}
+		if _, err := cli.dbCli.TCloud.K8sCluster.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create k8s cluster failed, err: %v, rid: %s",
+				enumor.TCloud, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync k8s cluster to create k8s cluster success, accountID: %s, count: %d, rid: %s",
+		enumor.TCloud, opt.AccountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateK8sCluster(kt *kit.Kit, opt *SyncK8sClusterOption,
+	updateMap map[string]typesk8s.TCloudCluster) error {
+
+	updateReq := make(protocloud.K8sClusterExtBatchUpdateReq[corek8s.TCloudK8sClusterExtension], 0, len(updateMap))
+	for id, one := range updateMap {
+		updateReq = append(updateReq, &protocloud.K8sClusterExtUpdateReq[corek8s.TCloudK8sClusterExtension]{
+			ID:         id,
+			Name:       converter.PtrToVal(one.ClusterName),
+			Region:     opt.Region,
+			Status:     converter.PtrToVal(one.ClusterStatus),
+			Version:    converter.PtrToVal(one.ClusterVersion),
+			CloudVpcID: one.GetCloudVpcID(),
+			Extension:  convTCloudK8sClusterExtension(one),
+		})
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.K8sClusterExtBatchUpdateReq[corek8s.TCloudK8sClusterExtension](batch)
+		if err := cli.dbCli.TCloud.K8sCluster.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update k8s cluster failed, err: %v, rid: %s",
+				enumor.TCloud, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync k8s cluster to update k8s cluster success, count: %d, rid: %s", enumor.TCloud,
+		len(updateMap), kt.Rid)
+
+	return nil
+}
+
+// deleteK8sCluster 删除容器集群时，data-service 会同时删除集群下的节点池和节点关联关系
+func (cli *client) deleteK8sCluster(kt *kit.Kit, accountID string, delCloudIDs []string) error {
+	for _, batch := range slice.Split(delCloudIDs, constant.BatchOperationMaxLimit) {
+		req := &protocloud.K8sClusterBatchDeleteReq{
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("vendor", enumor.TCloud),
+				tools.RuleEqual("account_id", accountID),
+				tools.RuleIn("cloud_id", batch),
+			),
+		}
+		if err := cli.dbCli.Global.K8sCluster.BatchDelete(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch delete k8s cluster failed, err: %v, rid: %s",
+				enumor.TCloud, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync k8s cluster to delete k8s cluster success, accountID: %s, count: %d, rid: %s",
+		enumor.TCloud, accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) listK8sClusterFromCloud(kt *kit.Kit, opt *SyncK8sClusterOption) ([]typesk8s.TCloudCluster,
+	error) {
+
+	listOpt := &adcore.TCloudListOption{
+		Region: opt.Region,
+		Page:   &adcore.TCloudPage{Offset: 0, Limit: adcore.TCloudQueryLimit},
+	}
+	result := make([]typesk8s.TCloudCluster, 0)
+	for {
+		clusters, err := cli.cloudCli.ListK8sCluster(kt, listOpt)
+		if err != nil {
+			logs.Errorf("[%s] list k8s cluster from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+				enumor.TCloud, err, opt.AccountID, listOpt, kt.Rid)
+			return nil, err
+		}
+		result = append(result, clusters...)
+
+		if len(clusters) < int(adcore.TCloudQueryLimit) {
+			break
+		}
+
+		listOpt.Page.Offset += adcore.TCloudQueryLimit
+	}
+
+	return result, nil
+}
+
+func (cli *client) listK8sClusterFromDB(kt *kit.Kit, opt *SyncK8sClusterOption) (
+	[]corek8s.K8sCluster[corek8s.TCloudK8sClusterExtension], error) {
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.TCloud),
+			tools.RuleEqual("account_id", opt.AccountID),
+			tools.RuleEqual("region", opt.Region),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result := make([]corek8s.K8sCluster[corek8s.TCloudK8sClusterExtension], 0)
+	for {
+		resp, err := cli.dbCli.TCloud.K8sCluster.ListK8sClusterExt(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] list k8s cluster from db failed, err: %v, account: %s, req: %v, rid: %s",
+				enumor.TCloud, err, opt.AccountID, req, kt.Rid)
+			return nil, err
+		}
+
+		result = append(result, resp.Details...)
+
+		if len(resp.Details) < int(core.DefaultMaxPageLimit) {
+			break
+		}
+
+		req.Page.Start += uint32(core.DefaultMaxPageLimit)
+	}
+
+	return result, nil
+}
+
+func convTCloudK8sClusterExtension(one typesk8s.TCloudCluster) *corek8s.TCloudK8sClusterExtension {
+	return &corek8s.TCloudK8sClusterExtension{
+		ClusterType:      converter.PtrToVal(one.ClusterType),
+		ClusterOs:        converter.PtrToVal(one.ClusterOs),
+		ContainerRuntime: converter.PtrToVal(one.ContainerRuntime),
+		ClusterCIDR:      one.GetClusterCIDR(),
+		ProjectID:        converter.PtrToVal(one.ProjectId),
+	}
+}
+
+func isK8sClusterChange(cloud typesk8s.TCloudCluster,
+	db corek8s.K8sCluster[corek8s.TCloudK8sClusterExtension]) bool {
+
+	if converter.PtrToVal(cloud.ClusterName) != db.Name || converter.PtrToVal(cloud.ClusterStatus) != db.Status ||
+		converter.PtrToVal(cloud.ClusterVersion) != db.Version || cloud.GetCloudVpcID() != db.CloudVpcID {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	return *convTCloudK8sClusterExtension(cloud) != *db.Extension
+}
@@ -0,0 +1,382 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tcloud
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/common"
+	adcore "hcm/pkg/adaptor/types/core"
+	typesnatgateway "hcm/pkg/adaptor/types/nat-gateway"
+	"hcm/pkg/api/core"
+	corenatgateway "hcm/pkg/api/core/cloud/nat-gateway"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/assert"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// SyncNatGatewayOption ...
+type SyncNatGatewayOption struct {
+}
+
+// Validate ...
+func (opt SyncNatGatewayOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// NatGateway 同步NAT网关
+func (cli *client) NatGateway(kt *kit.Kit, params *SyncBaseParams, opt *SyncNatGatewayOption) (*SyncResult, error) {
+	if err := validator.ValidateTool(params, opt); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	natFromCloud, err := cli.listNatGatewayFromCloud(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	natFromDB, err := cli.listNatGatewayFromDB(kt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(natFromCloud) == 0 && len(natFromDB) == 0 {
+		return new(SyncResult), nil
+	}
+
+	addSlice, updateMap, delCloudIDs := common.Diff[typesnatgateway.TCloudNatGateway,
+		corenatgateway.NatGateway[corenatgateway.TCloudNatGatewayExtension]](natFromCloud, natFromDB,
+		isNatGatewayChange)
+
+	if len(delCloudIDs) > 0 {
+		if err = cli.deleteNatGateway(kt, params.AccountID, params.Region, delCloudIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	cloudVpcIDs := make([]string, 0, len(addSlice)+len(updateMap))
+	cloudSubnetIDs := make([]string, 0, len(addSlice)+len(updateMap))
+	for _, one := range addSlice {
+		cloudVpcIDs = append(cloudVpcIDs, converter.PtrToVal(one.VpcId))
+		cloudSubnetIDs = append(cloudSubnetIDs, converter.PtrToVal(one.SubnetId))
+	}
+	for _, one := range updateMap {
+		cloudVpcIDs = append(cloudVpcIDs, converter.PtrToVal(one.VpcId))
+		cloudSubnetIDs = append(cloudSubnetIDs, converter.PtrToVal(one.SubnetId))
+	}
+	vpcMap, err := common.GetNetworkVpcRelMap(kt, cli.dbCli, enumor.TCloud, params.AccountID, cloudVpcIDs)
+	if err != nil {
+		return nil, err
+	}
+	_, subnetMap, err := common.GetLoadBalancerVpcSubnetMap(kt, cli.dbCli, enumor.TCloud, params.AccountID, nil,
+		cloudSubnetIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(addSlice) > 0 {
+		if err = cli.createNatGateway(kt, params.AccountID, params.Region, addSlice, vpcMap, subnetMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateMap) > 0 {
+		if err = cli.updateNatGateway(kt, updateMap, vpcMap, subnetMap); err != nil {
+			return nil, err
+		}
+	}
+
+	return new(SyncResult), nil
+}
+
+func (cli *client) createNatGateway(kt *kit.Kit, accountID, region string,
+	addSlice []typesnatgateway.TCloudNatGateway, vpcMap map[string]common.NetworkVpcRel,
+	subnetMap map[string]string) error {
+
+	createReq := new(protocloud.NatGatewayBatchCreateReq[corenatgateway.TCloudNatGatewayExtension])
+	for _, one := range addSlice {
+		cloudVpcID := converter.PtrToVal(one.VpcId)
+		cloudSubnetID := converter.PtrToVal(one.SubnetId)
+		nat := protocloud.NatGatewayBatchCreate[corenatgateway.TCloudNatGatewayExtension]{
+			CloudID:          one.GetCloudID(),
+			AccountID:        accountID,
+			BkBizID:          constant.UnassignedBiz,
+			Name:             converter.PtrToVal(one.NatGatewayName),
+			Region:           region,
+			Zone:             converter.PtrToVal(one.Zone),
+			Status:           converter.PtrToVal(one.State),
+			VpcID:            vpcMap[cloudVpcID].VpcID,
+			CloudVpcID:       cloudVpcID,
+			SubnetID:         subnetMap[cloudSubnetID],
+			CloudSubnetID:    cloudSubnetID,
+			PublicIPs:        one.GetPublicIPs(),
+			CloudCreatedTime: converter.PtrToVal(one.CreatedTime),
+			Extension:        convTCloudNatGatewayExtension(one),
+		}
+		if rel, exist := vpcMap[cloudVpcID]; exist {
+			nat.BkBizID = rel.BkBizID
+		}
+		createReq.NatGateways = append(createReq.NatGateways, nat)
+	}
+
+	for _, batch := range slice.Split(createReq.NatGateways, constant.BatchOperationMaxLimit) {
+		req := &protocloud.NatGatewayBatchCreateReq[corenatgateway.TCloudNatGatewayExtension]{NatGateways: batch}
+		if _, err := cli.dbCli.TCloud.NatGateway.BatchCreate(kt, req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch create nat gateway failed, err: %v, rid: %s",
+				enumor.TCloud, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync nat gateway to create nat gateway success, accountID: %s, count: %d, rid: %s",
+		enumor.TCloud, accountID, len(addSlice), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) updateNatGateway(kt *kit.Kit, updateMap map[string]typesnatgateway.TCloudNatGateway,
+	vpcMap map[string]common.NetworkVpcRel, subnetMap map[string]string) error {
+
+	updateReq := make(protocloud.NatGatewayExtBatchUpdateReq[corenatgateway.TCloudNatGatewayExtension], 0,
+		len(updateMap))
+	for id, one := range updateMap {
+		cloudVpcID := converter.PtrToVal(one.VpcId)
+		cloudSubnetID := converter.PtrToVal(one.SubnetId)
+		nat := &protocloud.NatGatewayExtUpdateReq[corenatgateway.TCloudNatGatewayExtension]{
+			ID:            id,
+			Name:          converter.PtrToVal(one.NatGatewayName),
+			Zone:          converter.PtrToVal(one.Zone),
+			Status:        converter.PtrToVal(one.State),
+			VpcID:         vpcMap[cloudVpcID].VpcID,
+			CloudVpcID:    cloudVpcID,
+			SubnetID:      subnetMap[cloudSubnetID],
+			CloudSubnetID: cloudSubnetID,
+			PublicIPs:     one.GetPublicIPs(),
+			Extension:     convTCloudNatGatewayExtension(one),
+		}
+		if rel, exist := vpcMap[cloudVpcID]; exist {
+			nat.BkBizID = rel.BkBizID
+		}
+		updateReq = append(updateReq, nat)
+	}
+
+	for _, batch := range slice.Split(updateReq, constant.BatchOperationMaxLimit) {
+		req := protocloud.NatGatewayExtBatchUpdateReq[corenatgateway.TCloudNatGatewayExtension](batch)
+		if err := cli.dbCli.TCloud.NatGateway.BatchUpdate(kt, &req); err != nil {
+			logs.Errorf("[%s] request dataservice to batch update nat gateway failed, err: %v, rid: %s",
+				enumor.TCloud, err, kt.Rid)
+			return err
+		}
+	}
+
+	logs.Infof("[%s] sync nat gateway to update nat gateway success, count: %d, rid: %s", enumor.TCloud,
+		len(updateMap), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) deleteNatGateway(kt *kit.Kit, accountID, region string, delCloudIDs []string) error {
+	checkParams := &SyncBaseParams{AccountID: accountID, Region: region, CloudIDs: delCloudIDs}
+	delFromCloud, err := cli.listNatGatewayFromCloud(kt, checkParams)
+	if err != nil {
+		return err
+	}
+
+	if len(delFromCloud) > 0 {
+		logs.Errorf("[%s] validate nat gateway not exist failed, before delete opt: %v, failed_count: %d, rid: %s",
+			enumor.TCloud, checkParams, len(delFromCloud), kt.Rid)
+		return errf.Newf(errf.Aborted, "validate nat gateway not exist failed, before delete")
+	}
+
+	req := &protocloud.NatGatewayBatchDeleteReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.TCloud),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleIn("cloud_id", delCloudIDs),
+		),
+	}
+	if err = cli.dbCli.Global.NatGateway.BatchDelete(kt, req); err != nil {
+		logs.Errorf("[%s] request dataservice to batch delete nat gateway failed, err: %v, rid: %s", enumor.TCloud,
+			err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("[%s] sync nat gateway to delete nat gateway success, accountID: %s, count: %d, rid: %s",
+		enumor.TCloud, accountID, len(delCloudIDs), kt.Rid)
+
+	return nil
+}
+
+func (cli *client) listNatGatewayFromCloud(kt *kit.Kit, params *SyncBaseParams) (
+	[]typesnatgateway.TCloudNatGateway, error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	result := make([]typesnatgateway.TCloudNatGateway, 0, len(params.CloudIDs))
+	for _, batch := range slice.Split(params.CloudIDs, adcore.TCloudQueryLimit) {
+		opt := &adcore.TCloudListOption{
+			Region:   params.Region,
+			CloudIDs: batch,
+			Page:     &adcore.TCloudPage{Offset: 0, Limit: adcore.TCloudQueryLimit},
+		}
+		nats, err := cli.cloudCli.ListNatGateway(kt, opt)
+		if err != nil {
+			logs.Errorf("[%s] list nat gateway from cloud failed, err: %v, account: %s, opt: %v, rid: %s",
+				enumor.TCloud, err, params.AccountID, opt, kt.Rid)
+			return nil, err
+		}
+		result = append(result, nats...)
+	}
+
+	return result, nil
+}
+
+func (cli *client) listNatGatewayFromDB(kt *kit.Kit, params *SyncBaseParams) (
+	[]corenatgateway.NatGateway[corenatgateway.TCloudNatGatewayExtension], error) {
+
+	if err := params.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", params.AccountID),
+			tools.RuleEqual("region", params.Region),
+			tools.RuleIn("cloud_id", params.CloudIDs),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := cli.dbCli.TCloud.NatGateway.ListNatGatewayExt(kt, req)
+	if err != nil {
+		logs.Errorf("[%s] list nat gateway from db failed, err: %v, account: %s, req: %v, rid: %s", enumor.TCloud,
+			err, params.AccountID, req, kt.Rid)
+		return nil, err
+	}
+
+	return result.Details, nil
+}
+
+// RemoveNatGatewayDeleteFromCloud 删除本地存在但云上已被删除的NAT网关
+func (cli *client) RemoveNatGatewayDeleteFromCloud(kt *kit.Kit, accountID string, region string) error {
+	req := &core.ListReq{
+		Fields: []string{"id", "cloud_id"},
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", enumor.TCloud),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleEqual("region", region),
+		),
+		Page: &core.BasePage{Start: 0, Limit: constant.BatchOperationMaxLimit},
+	}
+	for {
+		resultFromDB, err := cli.dbCli.Global.NatGateway.List(kt, req)
+		if err != nil {
+			logs.Errorf("[%s] request dataservice to list nat gateway failed, err: %v, req: %v, rid: %s",
+				enumor.TCloud, err, req, kt.Rid)
+			return err
+		}
+
+		cloudIDs := make([]string, 0, len(resultFromDB.Details))
+		for _, one := range resultFromDB.Details {
+			cloudIDs = append(cloudIDs, one.CloudID)
+		}
+
+		if len(cloudIDs) == 0 {
+			break
+		}
+
+		params := &SyncBaseParams{AccountID: accountID, Region: region, CloudIDs: cloudIDs}
+		resultFromCloud, err := cli.listNatGatewayFromCloud(kt, params)
+		if err != nil {
+			return err
+		}
+
+		// 如果有资源没有查询出来，说明数据被从云上删除
+		if len(resultFromCloud) != len(cloudIDs) {
+			cloudIDMap := converter.StringSliceToMap(cloudIDs)
+			for _, one := range resultFromCloud {
+				delete(cloudIDMap, one.GetCloudID())
+			}
+
+			err = cli.deleteNatGateway(kt, accountID, region, converter.MapKeyToStringSlice(cloudIDMap))
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(resultFromDB.Details) < constant.BatchOperationMaxLimit {
+			break
+		}
+
+		req.Page.Start += constant.BatchOperationMaxLimit
+	}
+
+	return nil
+}
+
+func convTCloudNatGatewayExtension(one typesnatgateway.TCloudNatGateway) *corenatgateway.TCloudNatGatewayExtension {
+	return &corenatgateway.TCloudNatGatewayExtension{
+		InternetMaxBandwidthOut: one.InternetMaxBandwidthOut,
+		MaxConcurrentConnection: one.MaxConcurrentConnection,
+		NetworkState:            one.NetworkState,
+		IsExclusive:             one.IsExclusive,
+	}
+}
+
+func isNatGatewayChange(cloud typesnatgateway.TCloudNatGateway,
+	db corenatgateway.NatGateway[corenatgateway.TCloudNatGatewayExtension]) bool {
+
+	if converter.PtrToVal(cloud.NatGatewayName) != db.Name {
+		return true
+	}
+
+	if converter.PtrToVal(cloud.State) != db.Status {
+		return true
+	}
+
+	if converter.PtrToVal(cloud.VpcId) != db.CloudVpcID || converter.PtrToVal(cloud.SubnetId) != db.CloudSubnetID {
+		return true
+	}
+
+	if !assert.IsStringSliceEqual(cloud.GetPublicIPs(), db.PublicIPs) {
+		return true
+	}
+
+	if db.Extension == nil {
+		return true
+	}
+
+	if !assert.IsPtrUint64Equal(cloud.InternetMaxBandwidthOut, db.Extension.InternetMaxBandwidthOut) {
+		return true
+	}
+
+	if !assert.IsPtrStringEqual(cloud.NetworkState, db.Extension.NetworkState) {
+		return true
+	}
+
+	return false
+}
@@ -125,8 +125,17 @@ func (cli *client) route(kt *kit.Kit, opt *syncRouteOption) (*SyncResult, error)
 		return new(SyncResult), nil
 	}
 
+	hopMap, err := cli.getRouteNextHopMap(kt, opt.AccountID, routeFromCloud)
+	if err != nil {
+		return nil, err
+	}
+
 	addSlice, updateMap, delCloudIDs := common.Diff[typesroutetable.TCloudRoute,
-		routetable.TCloudRoute](routeFromCloud, routeFromDB, isRouteChange)
+		routetable.TCloudRoute](routeFromCloud, routeFromDB,
+		func(cloud typesroutetable.TCloudRoute, db routetable.TCloudRoute) bool {
+			hop := hopMap[cloud.CloudGatewayID]
+			return isRouteChange(cloud, db) || hop.ResType != db.NextHopResType || hop.ResID != db.NextHopResID
+		})
 
 	if len(delCloudIDs) > 0 {
 		if err = cli.deleteRoute(kt, opt.AccountID, opt.Region, opt.CloudRouteTableID, routeTable.ID,
@@ -137,14 +146,14 @@ func (cli *client) route(kt *kit.Kit, opt *syncRouteOption) (*SyncResult, error)
 	}
 
 	if len(addSlice) > 0 {
-		err := cli.createRoute(kt, opt.AccountID, opt.Region, routeTable.ID, addSlice)
+		err := cli.createRoute(kt, opt.AccountID, opt.Region, routeTable.ID, addSlice, hopMap)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	if len(updateMap) > 0 {
-		err := cli.updateRoute(kt, opt.AccountID, opt.Region, routeTable.ID, updateMap)
+		err := cli.updateRoute(kt, opt.AccountID, opt.Region, routeTable.ID, updateMap, hopMap)
 		if err != nil {
 			return nil, err
 		}
@@ -153,8 +162,26 @@ func (cli *client) route(kt *kit.Kit, opt *syncRouteOption) (*SyncResult, error)
 	return new(SyncResult), nil
 }
 
+// getRouteNextHopMap 获取路由下一跳为NAT网关、对等连接时对应的本地资源，返回 云上ID -> 本地资源 的映射
+func (cli *client) getRouteNextHopMap(kt *kit.Kit, accountID string, routes []typesroutetable.TCloudRoute) (
+	map[string]common.RouteNextHop, error) {
+
+	cloudNatIDs := make([]string, 0)
+	cloudPeeringIDs := make([]string, 0)
+	for _, one := range routes {
+		switch one.GatewayType {
+		case "NAT":
+			cloudNatIDs = append(cloudNatIDs, one.CloudGatewayID)
+		case "PEERCONNECTION":
+			cloudPeeringIDs = append(cloudPeeringIDs, one.CloudGatewayID)
+		}
+	}
+
+	return common.GetRouteNextHopMap(kt, cli.dbCli, enumor.TCloud, accountID, cloudNatIDs, cloudPeeringIDs)
+}
+
 func (cli *client) createRoute(kt *kit.Kit, accountID string, region string, routeTableID string,
-	addSlice []typesroutetable.TCloudRoute) error {
+	addSlice []typesroutetable.TCloudRoute, hopMap map[string]common.RouteNextHop) error {
 
 	if len(addSlice) <= 0 {
 		return fmt.Errorf("route addSlice is <= 0, not create")
@@ -177,6 +204,8 @@ func (cli *client) createRoute(kt *kit.Kit, accountID string, region string, rou
 					RouteType:                route.RouteType,
 					PublishedToVbc:           route.PublishedToVbc,
 					Memo:                     route.Memo,
+					NextHopResType:           hopMap[route.CloudGatewayID].ResType,
+					NextHopResID:             hopMap[route.CloudGatewayID].ResID,
 				}
 			})
 		createReq := &dataproto.TCloudRouteBatchCreateReq{
@@ -196,7 +225,7 @@ func (cli *client) createRoute(kt *kit.Kit, accountID string, region string, rou
 }
 
 func (cli *client) updateRoute(kt *kit.Kit, accountID, region, routeTableID string,
-	updateMap map[string]typesroutetable.TCloudRoute) error {
+	updateMap map[string]typesroutetable.TCloudRoute, hopMap map[string]common.RouteNextHop) error {
 
 	if len(updateMap) <= 0 {
 		return fmt.Errorf("route updateMap is <= 0, not update")
@@ -215,6 +244,8 @@ func (cli *client) updateRoute(kt *kit.Kit, accountID, region, routeTableID stri
 		tmpRes.RouteType = one.RouteType
 		tmpRes.PublishedToVbc = converter.ValToPtr(one.PublishedToVbc)
 		tmpRes.Memo = one.Memo
+		tmpRes.NextHopResType = hopMap[one.CloudGatewayID].ResType
+		tmpRes.NextHopResID = hopMap[one.CloudGatewayID].ResID
 
 		updateResources = append(updateResources, tmpRes)
 	}
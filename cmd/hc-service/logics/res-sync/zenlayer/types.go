@@ -0,0 +1,51 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package zenlayer
+
+import (
+	"fmt"
+
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/validator"
+)
+
+// globalRegion zenlayer vpc 为全局资源，入库时地域为空
+const globalRegion = ""
+
+// SyncBaseParams ...
+type SyncBaseParams struct {
+	AccountID string `json:"account_id" validate:"required"`
+	// Region 同步 vpc 时为空，vpc 为全局资源
+	Region   string   `json:"region" validate:"omitempty"`
+	CloudIDs []string `json:"cloud_ids" validate:"required,min=1"`
+}
+
+// Validate ...
+func (opt SyncBaseParams) Validate() error {
+
+	if len(opt.CloudIDs) > constant.CloudResourceSyncMaxLimit {
+		return fmt.Errorf("cloudIDs should <= %d", constant.CloudResourceSyncMaxLimit)
+	}
+
+	return validator.Validate.Struct(opt)
+}
+
+// SyncResult sync result.
+type SyncResult struct {
+}
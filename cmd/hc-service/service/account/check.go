@@ -22,6 +22,7 @@ package account
 import (
 	"hcm/pkg/adaptor/types"
 	proto "hcm/pkg/api/hc-service/account"
+	"hcm/pkg/cc"
 	"hcm/pkg/criteria/errf"
 	"hcm/pkg/rest"
 )
@@ -233,3 +234,57 @@ func (svc *service) AzureAccountCheck(cts *rest.Contexts) (interface{}, error) {
 
 	return nil, err
 }
+
+// ZenlayerAccountCheck zenlayer 没有通过秘钥查询账号信息的接口，只通过查询地域校验秘钥的联通性
+func (svc *service) ZenlayerAccountCheck(cts *rest.Contexts) (interface{}, error) {
+	req := new(proto.ZenlayerAccountCheckReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := svc.ad.Adaptor().Zenlayer(
+		&types.BaseSecret{
+			CloudSecretID:  req.CloudSecretID,
+			CloudSecretKey: req.CloudSecretKey,
+			Endpoint:       cc.HCService().CloudEndpoint.Zenlayer,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = client.ListRegion(cts.Kit); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	return nil, nil
+}
+
+// KaopuAccountCheck 靠谱云没有通过秘钥查询账号信息的接口，只通过查询地域校验秘钥的联通性
+func (svc *service) KaopuAccountCheck(cts *rest.Contexts) (interface{}, error) {
+	req := new(proto.KaopuAccountCheckReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := svc.ad.Adaptor().Kaopu(
+		&types.BaseSecret{
+			CloudSecretID:  req.CloudSecretID,
+			CloudSecretKey: req.CloudSecretKey,
+			Endpoint:       cc.HCService().CloudEndpoint.Kaopu,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = client.ListRegion(cts.Kit); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	return nil, nil
+}
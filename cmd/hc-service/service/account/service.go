@@ -41,6 +41,8 @@ func InitAccountService(cap *capability.Capability) {
 	h.Add("HuaWeiAccountCheck", http.MethodPost, "/vendors/huawei/accounts/check", svc.HuaWeiAccountCheck)
 	h.Add("GcpAccountCheck", http.MethodPost, "/vendors/gcp/accounts/check", svc.GcpAccountCheck)
 	h.Add("AzureAccountCheck", http.MethodPost, "/vendors/azure/accounts/check", svc.AzureAccountCheck)
+	h.Add("ZenlayerAccountCheck", http.MethodPost, "/vendors/zenlayer/accounts/check", svc.ZenlayerAccountCheck)
+	h.Add("KaopuAccountCheck", http.MethodPost, "/vendors/kaopu/accounts/check", svc.KaopuAccountCheck)
 
 	// 获取账号配额
 	h.Add("GetTCloudAccountZoneQuota", http.MethodPost, "/vendors/tcloud/accounts/zones/quotas",
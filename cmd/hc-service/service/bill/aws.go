@@ -29,6 +29,7 @@ import (
 	"hcm/pkg/adaptor/aws"
 	typesBill "hcm/pkg/adaptor/types/bill"
 	"hcm/pkg/api/core"
+	billcore "hcm/pkg/api/core/bill"
 	"hcm/pkg/api/core/cloud"
 	dataservice "hcm/pkg/api/data-service"
 	protocloud "hcm/pkg/api/data-service/cloud"
@@ -100,6 +101,66 @@ func (b bill) AwsGetBillList(cts *rest.Contexts) (interface{}, error) {
 	}, nil
 }
 
+// AwsGetRootAccountBillList get aws bill list of main account from cur data of root account.
+func (b bill) AwsGetRootAccountBillList(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcbillservice.AwsRootBillListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	// 查询aws一级账号账单配置，CUR文件所在存储桶及athena库表信息
+	billInfo, err := getRootAccountBillConfigInfo[billcore.AwsBillConfigExtension](
+		cts.Kit, req.RootAccountID, b.cs.DataService())
+	if err != nil {
+		logs.Errorf("aws root account bill config get base info db failed, rootAccID: %s, err: %+v, rid: %s",
+			req.RootAccountID, err, cts.Kit.Rid)
+		return nil, err
+	}
+	if billInfo == nil {
+		return nil, errf.Newf(errf.RecordNotFound, "bill config for root_account_id: %s is not found",
+			req.RootAccountID)
+	}
+	if billInfo.Status != constant.StatusSuccess {
+		return nil, errf.Newf(errf.Aborted, "bill config for root_account_id: %s has not ready yet",
+			req.RootAccountID)
+	}
+
+	cli, err := b.ad.AwsRoot(cts.Kit, req.RootAccountID)
+	if err != nil {
+		logs.Errorf("aws root account bill get cloud client failed, req: %+v, err: %+v, rid: %s",
+			req, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	opt := &typesBill.AwsRootBillListOption{
+		RootAccountID: req.RootAccountID,
+		MainAccountID: req.MainAccountCloudID,
+		BeginDate:     req.BeginDate,
+		EndDate:       req.EndDate,
+	}
+	if req.Page != nil {
+		opt.Page = &typesBill.AwsBillPage{
+			Offset: req.Page.Offset,
+			Limit:  req.Page.Limit,
+		}
+	}
+	total, list, err := cli.GetRootAccountBillList(cts.Kit, opt, billInfo)
+	if err != nil {
+		logs.Errorf("request adaptor list aws root account bill failed, req: %+v, err: %v, rid: %s",
+			req, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return &hcbillservice.AwsBillListResult{
+		Count:   total,
+		Details: list,
+	}, nil
+}
+
 // AwsBillPipeline aws bill pipeline
 func (b bill) AwsBillPipeline(cts *rest.Contexts) (interface{}, error) {
 	req := new(hcbillservice.BillPipelineReq)
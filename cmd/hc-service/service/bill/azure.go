@@ -64,3 +64,40 @@ func (b bill) AzureGetBillList(cts *rest.Contexts) (interface{}, error) {
 		Details:  list.Value,
 	}, nil
 }
+
+// AzureGetRootAccountBillList get azure bill list of main account subscription by root account.
+func (b bill) AzureGetRootAccountBillList(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcbillservice.AzureRootBillListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	cli, err := b.ad.AzureRoot(cts.Kit, req.RootAccountID)
+	if err != nil {
+		logs.Errorf("azure request adaptor client err, req: %+v, err: %+v, rid: %s", req, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	opt := &typesBill.AzureBillListOption{
+		AccountID:      req.RootAccountID,
+		BeginDate:      req.BeginDate,
+		EndDate:        req.EndDate,
+		Page:           req.Page,
+		SubscriptionID: req.SubscriptionID,
+	}
+	list, err := cli.GetBillList(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("azure request adaptor list root account bill failed, err: %v, req: %+v, rid: %s",
+			err, req, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return &hcbillservice.AzureBillListResult{
+		NextLink: converter.PtrToVal(list.NextLink),
+		Details:  list.Value,
+	}, nil
+}
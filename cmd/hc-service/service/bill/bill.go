@@ -47,12 +47,17 @@ func InitBillService(cap *capability.Capability) {
 	h := rest.NewHandler()
 
 	h.Add("AwsGetBillList", "POST", "/vendors/aws/bills/list", v.AwsGetBillList)
+	h.Add("AwsGetRootAccountBillList", "POST", "/vendors/aws/root-account-bills/list", v.AwsGetRootAccountBillList)
 	h.Add("AwsBillsPipeline", "POST", "/vendors/aws/bills/pipeline", v.AwsBillPipeline)
 	h.Add("AwsBillConfigDelete", "DELETE", "/vendors/aws/bills/{id}", v.AwsBillConfigDelete)
 	h.Add("TCloudGetBillList", "POST", "/vendors/tcloud/bills/list", v.TCloudGetBillList)
+	h.Add("TCloudGetRootAccountBillList", "POST", "/vendors/tcloud/root-account-bills/list",
+		v.TCloudGetRootAccountBillList)
 	h.Add("HuaWeiGetBillList", "POST", "/vendors/huawei/bills/list", v.HuaWeiGetBillList)
 	h.Add("HuaWeiGetFeeRecordList", "POST", "/vendors/huawei/feerecords/list", v.HuaWeiGetFeeRecordList)
 	h.Add("AzureGetBillList", "POST", "/vendors/azure/bills/list", v.AzureGetBillList)
+	h.Add("AzureGetRootAccountBillList", "POST", "/vendors/azure/root-account-bills/list",
+		v.AzureGetRootAccountBillList)
 	h.Add("GcpGetBillList", "POST", "/vendors/gcp/bills/list", v.GcpGetBillList)
 	h.Add("GcpGetRootAccountBillList", "POST", "/vendors/gcp/root-account-bills/list", v.GcpGetRootAccountBillList)
 
@@ -28,6 +28,7 @@ import (
 	"hcm/pkg/api/core"
 	dataproto "hcm/pkg/api/data-service/cloud"
 	protocvm "hcm/pkg/api/hc-service/cvm"
+	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/criteria/errf"
 	"hcm/pkg/dal/dao/tools"
 	"hcm/pkg/logs"
@@ -76,6 +77,13 @@ func (svc *cvmSvc) BatchCreateAwsCvm(cts *rest.Contexts) (interface{}, error) {
 		BlockDeviceMapping:    req.BlockDeviceMapping,
 		PublicIPAssigned:      req.PublicIPAssigned,
 	}
+	if len(req.KeyPairID) != 0 {
+		keyPair, err := svc.getCvmKeyPair(cts.Kit, enumor.Aws, req.KeyPairID, req.AccountID, req.Region)
+		if err != nil {
+			return nil, err
+		}
+		createOpt.KeyName = keyPair.Name
+	}
 	result, err := awsCli.CreateCvm(cts.Kit, createOpt)
 	if err != nil {
 		logs.Errorf("create aws cvm failed, err: %v, rid: %s", err, cts.Kit.Rid)
@@ -89,7 +89,7 @@ func (svc *cvmSvc) CreateAzureCvm(cts *rest.Contexts) (interface{}, error) {
 	return &protocvm.AzureCreateResp{CloudID: cloudID}, nil
 }
 
-func (svc *cvmSvc) createAzureCvm(kt *kit.Kit, azureCli *azure.Azure, req *protocvm.AzureCreateReq) (
+func (svc *cvmSvc) createAzureCvm(kt *kit.Kit, azureCli azure.Azure, req *protocvm.AzureCreateReq) (
 	string, error) {
 
 	listImageReq := &core.ListReq{
@@ -151,6 +151,13 @@ func (svc *cvmSvc) createAzureCvm(kt *kit.Kit, azureCli *azure.Azure, req *proto
 			Type:   one.Type,
 		}
 	}
+	if len(req.KeyPairID) != 0 {
+		keyPair, err := svc.getCvmKeyPair(kt, enumor.Azure, req.KeyPairID, req.AccountID, req.Region)
+		if err != nil {
+			return "", err
+		}
+		createOpt.SSHPublicKey = keyPair.PublicKey
+	}
 	cloudID, err := azureCli.CreateCvm(kt, createOpt)
 	if err != nil {
 		logs.Errorf("create cvm failed, err: %v, rid: %s", err, kt.Rid)
@@ -23,8 +23,15 @@ package cvm
 import (
 	"hcm/cmd/hc-service/logics/cloud-adaptor"
 	"hcm/cmd/hc-service/service/capability"
+	"hcm/pkg/api/core"
+	corekeypair "hcm/pkg/api/core/cloud/key-pair"
 	"hcm/pkg/client"
 	dataservice "hcm/pkg/client/data-service"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
 )
 
 // InitCvmService initial the cvm service.
@@ -46,3 +53,33 @@ type cvmSvc struct {
 	dataCli *dataservice.Client
 	client  *client.ClientSet
 }
+
+// getCvmKeyPair 查询创建主机使用的密钥对，密钥对需要与主机属于同一账号，region 不为空时需要属于同一地域
+func (svc *cvmSvc) getCvmKeyPair(kt *kit.Kit, vendor enumor.Vendor, id, accountID, region string) (
+	*corekeypair.BaseKeyPair, error) {
+
+	req := &core.ListReq{
+		Filter: tools.EqualExpression("id", id),
+		Page:   core.NewDefaultBasePage(),
+	}
+	result, err := svc.dataCli.Global.KeyPair.List(kt, req)
+	if err != nil {
+		logs.Errorf("list key pair failed, err: %v, id: %s, rid: %s", err, id, kt.Rid)
+		return nil, err
+	}
+
+	if len(result.Details) == 0 {
+		return nil, errf.Newf(errf.RecordNotFound, "key pair: %s not found", id)
+	}
+
+	keyPair := result.Details[0]
+	if keyPair.Vendor != vendor || keyPair.AccountID != accountID {
+		return nil, errf.Newf(errf.InvalidParameter, "key pair: %s not belong to account: %s", id, accountID)
+	}
+
+	if len(region) != 0 && keyPair.Region != region {
+		return nil, errf.Newf(errf.InvalidParameter, "key pair: %s not in region: %s", id, region)
+	}
+
+	return &keyPair, nil
+}
@@ -27,10 +27,12 @@ import (
 	"hcm/cmd/hc-service/service/capability"
 	"hcm/pkg/adaptor/gcp"
 	typecvm "hcm/pkg/adaptor/types/cvm"
+	typekeypair "hcm/pkg/adaptor/types/key-pair"
 	"hcm/pkg/api/core"
 	coreimage "hcm/pkg/api/core/cloud/image"
 	dataproto "hcm/pkg/api/data-service/cloud"
 	protocvm "hcm/pkg/api/hc-service/cvm"
+	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/criteria/errf"
 	"hcm/pkg/dal/dao/tools"
 	"hcm/pkg/kit"
@@ -101,6 +103,13 @@ func (svc *cvmSvc) BatchCreateGcpCvm(cts *rest.Contexts) (interface{}, error) {
 		SystemDisk:          req.SystemDisk,
 		DataDisk:            req.DataDisk,
 	}
+	if len(req.KeyPairID) != 0 {
+		keyPair, err := svc.getCvmKeyPair(cts.Kit, enumor.Gcp, req.KeyPairID, req.AccountID, "")
+		if err != nil {
+			return nil, err
+		}
+		createOpt.SSHKey = typekeypair.GcpKeyPair{Name: keyPair.Name, PublicKey: keyPair.PublicKey}.MetadataLine()
+	}
 	result, err := gcpCli.CreateCvm(cts.Kit, createOpt)
 	if err != nil {
 		logs.Errorf("create cvm failed, err: %v, rid: %s", err, cts.Kit.Rid)
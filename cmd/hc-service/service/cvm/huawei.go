@@ -87,6 +87,13 @@ func (svc *cvmSvc) InquiryPriceHuaWeiCvm(cts *rest.Contexts) (interface{}, error
 		DataVolume:            req.DataVolume,
 		InstanceCharge:        req.InstanceCharge,
 	}
+	if len(req.KeyPairID) != 0 {
+		keyPair, err := svc.getCvmKeyPair(cts.Kit, enumor.HuaWei, req.KeyPairID, req.AccountID, req.Region)
+		if err != nil {
+			return nil, err
+		}
+		opt.KeyName = keyPair.CloudID
+	}
 	result, err := huawei.InquiryPriceCvm(cts.Kit, opt)
 	if err != nil {
 		logs.Errorf("inquiry price huawei cvm failed, err: %v, rid: %s", err, cts.Kit.Rid)
@@ -134,6 +141,13 @@ func (svc *cvmSvc) BatchCreateHuaWeiCvm(cts *rest.Contexts) (interface{}, error)
 		PublicIPAssigned:      req.PublicIPAssigned,
 		Eip:                   req.Eip,
 	}
+	if len(req.KeyPairID) != 0 {
+		keyPair, err := svc.getCvmKeyPair(cts.Kit, enumor.HuaWei, req.KeyPairID, req.AccountID, req.Region)
+		if err != nil {
+			return nil, err
+		}
+		createOpt.KeyName = keyPair.CloudID
+	}
 	result, err := huawei.CreateCvm(cts.Kit, createOpt)
 	if err != nil {
 		logs.Errorf("create huawei cvm failed, err: %v, rid: %s", err, cts.Kit.Rid)
@@ -0,0 +1,64 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dbinstance
+
+import (
+	typesdbinstance "hcm/pkg/adaptor/types/database-instance"
+	hcdbinstance "hcm/pkg/api/hc-service/database-instance"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// DeleteAzureDatabaseInstance ...
+func (svc *service) DeleteAzureDatabaseInstance(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcdbinstance.DatabaseInstanceDeleteReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	instance, err := getDatabaseInstance(cts.Kit, svc.DataCli.Azure.DatabaseInstance.ListDatabaseInstanceExt, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if instance.Extension == nil {
+		return nil, errf.Newf(errf.InvalidParameter, "database instance: %s extension is empty", req.ID)
+	}
+
+	client, err := svc.Adaptor.Azure(cts.Kit, instance.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typesdbinstance.AzureDBInstanceDeleteOption{
+		CloudID:      instance.CloudID,
+		ResourceType: instance.Extension.ResourceType,
+	}
+	if err = client.DeleteDatabaseInstance(cts.Kit, opt); err != nil {
+		logs.Errorf("delete azure database instance failed, err: %v, id: %s, rid: %s", err, req.ID, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, svc.deleteDatabaseInstanceFromDB(cts.Kit, req.ID)
+}
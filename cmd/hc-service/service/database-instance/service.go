@@ -0,0 +1,99 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package dbinstance ...
+package dbinstance
+
+import (
+	"net/http"
+
+	cloudclient "hcm/cmd/hc-service/logics/cloud-adaptor"
+	"hcm/cmd/hc-service/service/capability"
+	"hcm/pkg/api/core"
+	coredbinstance "hcm/pkg/api/core/cloud/database-instance"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	dataservice "hcm/pkg/client/data-service"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// InitDatabaseInstanceService initial the database instance service
+func InitDatabaseInstanceService(cap *capability.Capability) {
+	svc := &service{
+		Adaptor: cap.CloudAdaptor,
+		DataCli: cap.ClientSet.DataService(),
+	}
+
+	h := rest.NewHandler()
+
+	// 删除云数据库实例
+	h.Add("DeleteTCloudDatabaseInstance", http.MethodDelete, "/vendors/tcloud/database_instances",
+		svc.DeleteTCloudDatabaseInstance)
+	h.Add("DeleteAwsDatabaseInstance", http.MethodDelete, "/vendors/aws/database_instances",
+		svc.DeleteAwsDatabaseInstance)
+	h.Add("DeleteAzureDatabaseInstance", http.MethodDelete, "/vendors/azure/database_instances",
+		svc.DeleteAzureDatabaseInstance)
+	h.Add("DeleteGcpDatabaseInstance", http.MethodDelete, "/vendors/gcp/database_instances",
+		svc.DeleteGcpDatabaseInstance)
+	h.Add("DeleteHuaWeiDatabaseInstance", http.MethodDelete, "/vendors/huawei/database_instances",
+		svc.DeleteHuaWeiDatabaseInstance)
+
+	h.Load(cap.WebService)
+}
+
+type service struct {
+	DataCli *dataservice.Client
+	Adaptor *cloudclient.CloudAdaptorClient
+}
+
+// getDatabaseInstance 查询带扩展字段的云数据库实例详情
+func getDatabaseInstance[T coredbinstance.Extension](kt *kit.Kit,
+	listFunc func(*kit.Kit, *core.ListReq) (*protocloud.DatabaseInstanceExtListResult[T], error), id string) (
+	*coredbinstance.DatabaseInstance[T], error) {
+
+	req := &core.ListReq{
+		Filter: tools.EqualExpression("id", id),
+		Page:   core.NewDefaultBasePage(),
+	}
+	result, err := listFunc(kt, req)
+	if err != nil {
+		logs.Errorf("list database instance failed, err: %v, id: %s, rid: %s", err, id, kt.Rid)
+		return nil, err
+	}
+
+	if len(result.Details) == 0 {
+		return nil, errf.Newf(errf.RecordNotFound, "database instance: %s not found", id)
+	}
+
+	return &result.Details[0], nil
+}
+
+// deleteDatabaseInstanceFromDB 云上删除成功后删除本地云数据库实例及其安全组关联关系
+func (svc *service) deleteDatabaseInstanceFromDB(kt *kit.Kit, id string) error {
+	req := &protocloud.DatabaseInstanceBatchDeleteReq{Filter: tools.EqualExpression("id", id)}
+	if err := svc.DataCli.Global.DatabaseInstance.BatchDelete(kt, req); err != nil {
+		logs.Errorf("delete database instance from db failed, err: %v, id: %s, rid: %s", err, id, kt.Rid)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package keypair
+
+import (
+	syncazure "hcm/cmd/hc-service/logics/res-sync/azure"
+	typekeypair "hcm/pkg/adaptor/types/key-pair"
+	hckeypair "hcm/pkg/api/hc-service/key-pair"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// ImportAzureKeyPair ...
+func (svc *service) ImportAzureKeyPair(cts *rest.Contexts) (interface{}, error) {
+	req := new(hckeypair.KeyPairImportReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	if len(req.Region) == 0 || len(req.ResourceGroupName) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "region and resource_group_name are required")
+	}
+
+	client, err := svc.Adaptor.Azure(cts.Kit, req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typekeypair.AzureKeyPairImportOption{
+		ResourceGroupName: req.ResourceGroupName,
+		Region:            req.Region,
+		Name:              req.Name,
+		PublicKey:         req.PublicKey,
+	}
+	cloudID, err := client.ImportKeyPair(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("import azure key pair failed, err: %v, name: %s, rid: %s", err, req.Name, cts.Kit.Rid)
+		return nil, err
+	}
+
+	syncClient := syncazure.NewClient(svc.DataCli, client)
+	syncOpt := &syncazure.SyncKeyPairOption{AccountID: req.AccountID, ResourceGroupName: req.ResourceGroupName}
+	if _, err = syncClient.KeyPair(cts.Kit, syncOpt); err != nil {
+		logs.Errorf("sync azure key pair failed, err: %v, opt: %v, rid: %s", err, syncOpt, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return svc.afterImport(cts.Kit, enumor.Azure, req.AccountID, cloudID)
+}
+
+// DeleteAzureKeyPair ...
+func (svc *service) DeleteAzureKeyPair(cts *rest.Contexts) (interface{}, error) {
+	req := new(hckeypair.KeyPairDeleteReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	keyPair, err := getKeyPair(cts.Kit, svc.DataCli.Azure.KeyPair.ListKeyPairExt, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if keyPair.Extension == nil {
+		return nil, errf.Newf(errf.InvalidParameter, "key pair: %s extension is empty", req.ID)
+	}
+
+	client, err := svc.Adaptor.Azure(cts.Kit, keyPair.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typekeypair.AzureKeyPairDeleteOption{
+		ResourceGroupName: keyPair.Extension.ResourceGroupName,
+		Name:              keyPair.Name,
+	}
+	if err = client.DeleteKeyPair(cts.Kit, opt); err != nil {
+		logs.Errorf("delete azure key pair failed, err: %v, id: %s, rid: %s", err, req.ID, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, svc.deleteKeyPairFromDB(cts.Kit, req.ID)
+}
@@ -0,0 +1,166 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package keypair
+
+import (
+	syncgcp "hcm/cmd/hc-service/logics/res-sync/gcp"
+	typekeypair "hcm/pkg/adaptor/types/key-pair"
+	hckeypair "hcm/pkg/api/hc-service/key-pair"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// ImportGcpKeyPair gcp 将公钥写入项目元数据，密钥对名称即登录主机的用户名
+func (svc *service) ImportGcpKeyPair(cts *rest.Contexts) (interface{}, error) {
+	req := new(hckeypair.KeyPairImportReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := svc.Adaptor.Gcp(cts.Kit, req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typekeypair.GcpKeyPairImportOption{Name: req.Name, PublicKey: req.PublicKey}
+	cloudID, err := client.ImportKeyPair(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("import gcp key pair failed, err: %v, name: %s, rid: %s", err, req.Name, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if err = svc.syncGcpKeyPair(cts.Kit, req.AccountID); err != nil {
+		return nil, err
+	}
+
+	return svc.afterImport(cts.Kit, enumor.Gcp, req.AccountID, cloudID)
+}
+
+// DeleteGcpKeyPair 从项目元数据中删除公钥，已写入主机元数据的公钥不受影响
+func (svc *service) DeleteGcpKeyPair(cts *rest.Contexts) (interface{}, error) {
+	req := new(hckeypair.KeyPairDeleteReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	keyPair, err := getKeyPair(cts.Kit, svc.DataCli.Gcp.KeyPair.ListKeyPairExt, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := svc.Adaptor.Gcp(cts.Kit, keyPair.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typekeypair.GcpKeyPairDeleteOption{CloudID: keyPair.CloudID}
+	if err = client.DeleteKeyPair(cts.Kit, opt); err != nil {
+		logs.Errorf("delete gcp key pair failed, err: %v, id: %s, rid: %s", err, req.ID, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, svc.deleteKeyPairFromDB(cts.Kit, req.ID)
+}
+
+// BindGcpKeyPair 将公钥写入主机实例元数据
+func (svc *service) BindGcpKeyPair(cts *rest.Contexts) (interface{}, error) {
+	return nil, svc.bindGcpKeyPair(cts, true)
+}
+
+// UnbindGcpKeyPair 从主机实例元数据中删除公钥
+func (svc *service) UnbindGcpKeyPair(cts *rest.Contexts) (interface{}, error) {
+	return nil, svc.bindGcpKeyPair(cts, false)
+}
+
+func (svc *service) bindGcpKeyPair(cts *rest.Contexts, bind bool) error {
+	req := new(hckeypair.KeyPairBindReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	keyPair, err := getKeyPair(cts.Kit, svc.DataCli.Gcp.KeyPair.ListKeyPairExt, req.ID)
+	if err != nil {
+		return err
+	}
+
+	cvms, err := svc.listBindCvm(cts.Kit, keyPair.BaseKeyPair, req.CvmIDs)
+	if err != nil {
+		return err
+	}
+
+	client, err := svc.Adaptor.Gcp(cts.Kit, keyPair.AccountID)
+	if err != nil {
+		return err
+	}
+
+	// gcp 主机实例按可用区操作，需要按可用区分组处理
+	zoneCvmMap := make(map[string][]string)
+	for _, one := range cvms {
+		zoneCvmMap[one.Zone] = append(zoneCvmMap[one.Zone], one.CloudID)
+	}
+
+	for zone, cloudCvmIDs := range zoneCvmMap {
+		opt := &typekeypair.GcpKeyPairBindOption{
+			Zone:        zone,
+			CloudIDs:    []string{keyPair.CloudID},
+			CloudCvmIDs: cloudCvmIDs,
+		}
+		if bind {
+			err = client.BindKeyPair(cts.Kit, opt)
+		} else {
+			err = client.UnbindKeyPair(cts.Kit, opt)
+		}
+		if err != nil {
+			logs.Errorf("bind or unbind gcp key pair failed, err: %v, bind: %v, id: %s, zone: %s, cvms: %v, rid: %s",
+				err, bind, req.ID, zone, cloudCvmIDs, cts.Kit.Rid)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (svc *service) syncGcpKeyPair(kt *kit.Kit, accountID string) error {
+	client, err := svc.Adaptor.Gcp(kt, accountID)
+	if err != nil {
+		return err
+	}
+
+	syncClient := syncgcp.NewClient(svc.DataCli, client)
+	opt := &syncgcp.SyncKeyPairOption{AccountID: accountID}
+	if _, err = syncClient.KeyPair(kt, opt); err != nil {
+		logs.Errorf("sync gcp key pair failed, err: %v, opt: %v, rid: %s", err, opt, kt.Rid)
+		return err
+	}
+
+	return nil
+}
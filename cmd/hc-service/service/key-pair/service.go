@@ -0,0 +1,168 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package keypair ...
+package keypair
+
+import (
+	"net/http"
+
+	cloudclient "hcm/cmd/hc-service/logics/cloud-adaptor"
+	"hcm/cmd/hc-service/service/capability"
+	"hcm/pkg/api/core"
+	corecvm "hcm/pkg/api/core/cloud/cvm"
+	corekeypair "hcm/pkg/api/core/cloud/key-pair"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	hckeypair "hcm/pkg/api/hc-service/key-pair"
+	dataservice "hcm/pkg/client/data-service"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// InitKeyPairService initial the key pair service
+func InitKeyPairService(cap *capability.Capability) {
+	svc := &service{
+		Adaptor: cap.CloudAdaptor,
+		DataCli: cap.ClientSet.DataService(),
+	}
+
+	h := rest.NewHandler()
+
+	// 导入公钥
+	h.Add("ImportTCloudKeyPair", http.MethodPost, "/vendors/tcloud/key_pairs/import", svc.ImportTCloudKeyPair)
+	h.Add("ImportAwsKeyPair", http.MethodPost, "/vendors/aws/key_pairs/import", svc.ImportAwsKeyPair)
+	h.Add("ImportAzureKeyPair", http.MethodPost, "/vendors/azure/key_pairs/import", svc.ImportAzureKeyPair)
+	h.Add("ImportGcpKeyPair", http.MethodPost, "/vendors/gcp/key_pairs/import", svc.ImportGcpKeyPair)
+	h.Add("ImportHuaWeiKeyPair", http.MethodPost, "/vendors/huawei/key_pairs/import", svc.ImportHuaWeiKeyPair)
+
+	// 删除密钥对
+	h.Add("DeleteTCloudKeyPair", http.MethodDelete, "/vendors/tcloud/key_pairs", svc.DeleteTCloudKeyPair)
+	h.Add("DeleteAwsKeyPair", http.MethodDelete, "/vendors/aws/key_pairs", svc.DeleteAwsKeyPair)
+	h.Add("DeleteAzureKeyPair", http.MethodDelete, "/vendors/azure/key_pairs", svc.DeleteAzureKeyPair)
+	h.Add("DeleteGcpKeyPair", http.MethodDelete, "/vendors/gcp/key_pairs", svc.DeleteGcpKeyPair)
+	h.Add("DeleteHuaWeiKeyPair", http.MethodDelete, "/vendors/huawei/key_pairs", svc.DeleteHuaWeiKeyPair)
+
+	// 主机绑定/解绑密钥对，aws、azure 只支持创建主机时指定密钥对
+	h.Add("BindTCloudKeyPair", http.MethodPost, "/vendors/tcloud/key_pairs/bind", svc.BindTCloudKeyPair)
+	h.Add("UnbindTCloudKeyPair", http.MethodPost, "/vendors/tcloud/key_pairs/unbind", svc.UnbindTCloudKeyPair)
+	h.Add("BindGcpKeyPair", http.MethodPost, "/vendors/gcp/key_pairs/bind", svc.BindGcpKeyPair)
+	h.Add("UnbindGcpKeyPair", http.MethodPost, "/vendors/gcp/key_pairs/unbind", svc.UnbindGcpKeyPair)
+	h.Add("BindHuaWeiKeyPair", http.MethodPost, "/vendors/huawei/key_pairs/bind", svc.BindHuaWeiKeyPair)
+	h.Add("UnbindHuaWeiKeyPair", http.MethodPost, "/vendors/huawei/key_pairs/unbind", svc.UnbindHuaWeiKeyPair)
+
+	h.Load(cap.WebService)
+}
+
+type service struct {
+	DataCli *dataservice.Client
+	Adaptor *cloudclient.CloudAdaptorClient
+}
+
+// getKeyPair 查询带扩展字段的密钥对详情
+func getKeyPair[T corekeypair.Extension](kt *kit.Kit,
+	listFunc func(*kit.Kit, *core.ListReq) (*protocloud.KeyPairExtListResult[T], error), id string) (
+	*corekeypair.KeyPair[T], error) {
+
+	req := &core.ListReq{
+		Filter: tools.EqualExpression("id", id),
+		Page:   core.NewDefaultBasePage(),
+	}
+	result, err := listFunc(kt, req)
+	if err != nil {
+		logs.Errorf("list key pair failed, err: %v, id: %s, rid: %s", err, id, kt.Rid)
+		return nil, err
+	}
+
+	if len(result.Details) == 0 {
+		return nil, errf.Newf(errf.RecordNotFound, "key pair: %s not found", id)
+	}
+
+	return &result.Details[0], nil
+}
+
+// afterImport 密钥对同步到本地后，返回密钥对本地ID
+func (svc *service) afterImport(kt *kit.Kit, vendor enumor.Vendor, accountID, cloudID string) (
+	*hckeypair.KeyPairImportResult, error) {
+
+	req := &core.ListReq{
+		Fields: []string{"id"},
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", vendor),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleEqual("cloud_id", cloudID),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := svc.DataCli.Global.KeyPair.List(kt, req)
+	if err != nil {
+		logs.Errorf("list key pair failed, err: %v, cloudID: %s, rid: %s", err, cloudID, kt.Rid)
+		return nil, err
+	}
+
+	if len(result.Details) == 0 {
+		return &hckeypair.KeyPairImportResult{CloudID: cloudID}, nil
+	}
+
+	return &hckeypair.KeyPairImportResult{ID: result.Details[0].ID, CloudID: cloudID}, nil
+}
+
+// deleteKeyPairFromDB 云上删除成功后删除本地密钥对
+func (svc *service) deleteKeyPairFromDB(kt *kit.Kit, id string) error {
+	req := &protocloud.KeyPairBatchDeleteReq{Filter: tools.EqualExpression("id", id)}
+	if err := svc.DataCli.Global.KeyPair.BatchDelete(kt, req); err != nil {
+		logs.Errorf("delete key pair from db failed, err: %v, id: %s, rid: %s", err, id, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// listBindCvm 查询待绑定/解绑密钥对的主机，主机需要与密钥对属于同一账号，地域不为空时需要属于同一地域
+func (svc *service) listBindCvm(kt *kit.Kit, keyPair corekeypair.BaseKeyPair, cvmIDs []string) (
+	[]corecvm.BaseCvm, error) {
+
+	req := &core.ListReq{
+		Filter: tools.ContainersExpression("id", cvmIDs),
+		Page:   core.NewDefaultBasePage(),
+	}
+	result, err := svc.DataCli.Global.Cvm.ListCvm(kt, req)
+	if err != nil {
+		logs.Errorf("list cvm failed, err: %v, ids: %v, rid: %s", err, cvmIDs, kt.Rid)
+		return nil, err
+	}
+
+	if len(result.Details) != len(cvmIDs) {
+		return nil, errf.Newf(errf.RecordNotFound, "some cvms of %v not found", cvmIDs)
+	}
+
+	for _, one := range result.Details {
+		if one.AccountID != keyPair.AccountID {
+			return nil, errf.Newf(errf.InvalidParameter, "cvm: %s not belong to key pair account", one.ID)
+		}
+		if one.Vendor != enumor.Gcp && one.Region != keyPair.Region {
+			return nil, errf.Newf(errf.InvalidParameter, "cvm: %s not in key pair region", one.ID)
+		}
+	}
+
+	return result.Details, nil
+}
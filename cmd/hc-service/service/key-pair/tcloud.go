@@ -0,0 +1,168 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package keypair
+
+import (
+	synctcloud "hcm/cmd/hc-service/logics/res-sync/tcloud"
+	typekeypair "hcm/pkg/adaptor/types/key-pair"
+	hckeypair "hcm/pkg/api/hc-service/key-pair"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// ImportTCloudKeyPair ...
+func (svc *service) ImportTCloudKeyPair(cts *rest.Contexts) (interface{}, error) {
+	req := new(hckeypair.KeyPairImportReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	if len(req.Region) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "region is required")
+	}
+
+	client, err := svc.Adaptor.TCloud(cts.Kit, req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typekeypair.TCloudKeyPairImportOption{Region: req.Region, Name: req.Name, PublicKey: req.PublicKey}
+	cloudID, err := client.ImportKeyPair(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("import tcloud key pair failed, err: %v, name: %s, rid: %s", err, req.Name, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if err = svc.syncTCloudKeyPair(cts.Kit, req.AccountID, req.Region); err != nil {
+		return nil, err
+	}
+
+	return svc.afterImport(cts.Kit, enumor.TCloud, req.AccountID, cloudID)
+}
+
+// DeleteTCloudKeyPair ...
+func (svc *service) DeleteTCloudKeyPair(cts *rest.Contexts) (interface{}, error) {
+	req := new(hckeypair.KeyPairDeleteReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	keyPair, err := getKeyPair(cts.Kit, svc.DataCli.TCloud.KeyPair.ListKeyPairExt, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := svc.Adaptor.TCloud(cts.Kit, keyPair.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typekeypair.TCloudKeyPairDeleteOption{Region: keyPair.Region, CloudIDs: []string{keyPair.CloudID}}
+	if err = client.DeleteKeyPair(cts.Kit, opt); err != nil {
+		logs.Errorf("delete tcloud key pair failed, err: %v, id: %s, rid: %s", err, req.ID, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, svc.deleteKeyPairFromDB(cts.Kit, req.ID)
+}
+
+// BindTCloudKeyPair 主机绑定密钥对，绑定后主机的密码登录将被禁用
+func (svc *service) BindTCloudKeyPair(cts *rest.Contexts) (interface{}, error) {
+	return nil, svc.bindTCloudKeyPair(cts, true)
+}
+
+// UnbindTCloudKeyPair 主机解绑密钥对
+func (svc *service) UnbindTCloudKeyPair(cts *rest.Contexts) (interface{}, error) {
+	return nil, svc.bindTCloudKeyPair(cts, false)
+}
+
+func (svc *service) bindTCloudKeyPair(cts *rest.Contexts, bind bool) error {
+	req := new(hckeypair.KeyPairBindReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	keyPair, err := getKeyPair(cts.Kit, svc.DataCli.TCloud.KeyPair.ListKeyPairExt, req.ID)
+	if err != nil {
+		return err
+	}
+
+	cvms, err := svc.listBindCvm(cts.Kit, keyPair.BaseKeyPair, req.CvmIDs)
+	if err != nil {
+		return err
+	}
+
+	client, err := svc.Adaptor.TCloud(cts.Kit, keyPair.AccountID)
+	if err != nil {
+		return err
+	}
+
+	opt := &typekeypair.TCloudKeyPairBindOption{
+		Region:      keyPair.Region,
+		CloudIDs:    []string{keyPair.CloudID},
+		CloudCvmIDs: make([]string, 0, len(cvms)),
+		ForceStop:   req.ForceStop,
+	}
+	for _, one := range cvms {
+		opt.CloudCvmIDs = append(opt.CloudCvmIDs, one.CloudID)
+	}
+
+	if bind {
+		err = client.BindKeyPair(cts.Kit, opt)
+	} else {
+		err = client.UnbindKeyPair(cts.Kit, opt)
+	}
+	if err != nil {
+		logs.Errorf("bind or unbind tcloud key pair failed, err: %v, bind: %v, id: %s, cvms: %v, rid: %s", err,
+			bind, req.ID, req.CvmIDs, cts.Kit.Rid)
+		return err
+	}
+
+	// 同步更新密钥对已绑定的主机
+	return svc.syncTCloudKeyPair(cts.Kit, keyPair.AccountID, keyPair.Region)
+}
+
+func (svc *service) syncTCloudKeyPair(kt *kit.Kit, accountID, region string) error {
+	client, err := svc.Adaptor.TCloud(kt, accountID)
+	if err != nil {
+		return err
+	}
+
+	syncClient := synctcloud.NewClient(svc.DataCli, client)
+	opt := &synctcloud.SyncKeyPairOption{AccountID: accountID, Region: region}
+	if _, err = syncClient.KeyPair(kt, opt); err != nil {
+		logs.Errorf("sync tcloud key pair failed, err: %v, opt: %v, rid: %s", err, opt, kt.Rid)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,283 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package loadbalancer
+
+import (
+	"errors"
+	"net/http"
+
+	syncaws "hcm/cmd/hc-service/logics/res-sync/aws"
+	"hcm/cmd/hc-service/service/capability"
+	typelb "hcm/pkg/adaptor/types/load-balancer"
+	corelb "hcm/pkg/api/core/cloud/load-balancer"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	protolb "hcm/pkg/api/hc-service/load-balancer"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	cvt "hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+func (svc *clbSvc) initAwsLoadBalancerService(cap *capability.Capability) {
+	h := rest.NewHandler()
+
+	h.Add("CreateAwsLoadBalancer", http.MethodPost, "/vendors/aws/load_balancers/create",
+		svc.CreateAwsLoadBalancer)
+	h.Add("BatchDeleteAwsLoadBalancer", http.MethodDelete, "/vendors/aws/load_balancers/batch",
+		svc.BatchDeleteAwsLoadBalancer)
+
+	// 目标组
+	h.Add("BatchCreateAwsTargets", http.MethodPost,
+		"/vendors/aws/target_groups/{target_group_id}/targets/create", svc.BatchCreateAwsTargets)
+	h.Add("BatchRemoveAwsTargets", http.MethodDelete,
+		"/vendors/aws/target_groups/{target_group_id}/targets/batch", svc.BatchRemoveAwsTargets)
+
+	h.Load(cap.WebService)
+}
+
+// CreateAwsLoadBalancer 创建aws负载均衡
+func (svc *clbSvc) CreateAwsLoadBalancer(cts *rest.Contexts) (any, error) {
+	req := new(protolb.AwsLoadBalancerCreateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := svc.ad.Aws(cts.Kit, req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	createOpt := &typelb.AwsCreateOption{
+		Region:         req.Region,
+		Name:           req.Name,
+		Type:           req.Type,
+		Scheme:         req.Scheme,
+		IpAddressType:  req.IpAddressType,
+		CloudSubnetIDs: req.CloudSubnetIDs,
+		SecurityGroups: req.SecurityGroups,
+	}
+	cloudID, err := client.CreateLoadBalancer(cts.Kit, createOpt)
+	if err != nil {
+		logs.Errorf("create aws load balancer failed, err: %v, opt: %+v, rid: %s", err, createOpt, cts.Kit.Rid)
+		return nil, err
+	}
+
+	// 先创建本地数据保存业务信息，失败也继续同步
+	_ = svc.createAwsDBLoadBalancer(cts.Kit, req, cloudID)
+
+	syncClient := syncaws.NewClient(svc.dataCli, client)
+	params := &syncaws.SyncBaseParams{
+		AccountID: req.AccountID,
+		Region:    req.Region,
+		CloudIDs:  []string{cloudID},
+	}
+	if _, err = syncClient.LoadBalancerWithListener(cts.Kit, params, new(syncaws.SyncLBOption)); err != nil {
+		logs.Errorf("sync aws load balancer failed, err: %v, params: %+v, rid: %s", err, params, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return &protolb.BatchCreateResult{SuccessCloudIDs: []string{cloudID}}, nil
+}
+
+func (svc *clbSvc) createAwsDBLoadBalancer(kt *kit.Kit, req *protolb.AwsLoadBalancerCreateReq,
+	cloudID string) error {
+
+	lbType := typelb.OpenLoadBalancerType
+	if req.Scheme == typelb.AwsInternalScheme {
+		lbType = typelb.InternalLoadBalancerType
+	}
+	ipVersion := enumor.Ipv4
+	if cvt.PtrToVal(req.IpAddressType) == "dualstack" {
+		ipVersion = enumor.Ipv6DualStack
+	}
+
+	dataReq := &dataproto.AwsLoadBalancerCreateReq{
+		Lbs: []dataproto.LbBatchCreate[corelb.AwsLoadBalancerExtension]{{
+			CloudID:          cloudID,
+			Name:             req.Name,
+			Vendor:           enumor.Aws,
+			AccountID:        req.AccountID,
+			BkBizID:          req.BkBizID,
+			LoadBalancerType: string(lbType),
+			IPVersion:        ipVersion,
+			Region:           req.Region,
+			Extension: &corelb.AwsLoadBalancerExtension{
+				Type:           cvt.ValToPtr(string(req.Type)),
+				CloudSubnetIDs: req.CloudSubnetIDs,
+				SecurityGroups: req.SecurityGroups,
+			},
+		}},
+	}
+	if _, err := svc.dataCli.Aws.LoadBalancer.BatchCreate(kt, dataReq); err != nil {
+		logs.Errorf("fail to create db load balancer after cloud create, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// BatchDeleteAwsLoadBalancer 批量删除aws负载均衡
+func (svc *clbSvc) BatchDeleteAwsLoadBalancer(cts *rest.Contexts) (any, error) {
+	req := new(protolb.AwsBatchDeleteLoadBalancerReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	lbList, err := svc.listLoadBalancerByIDs(cts.Kit, enumor.Aws, req.AccountID, req.IDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lbList) != 0 {
+		client, err := svc.ad.Aws(cts.Kit, req.AccountID)
+		if err != nil {
+			return nil, err
+		}
+
+		opt := &typelb.AwsDeleteOption{
+			Region:   req.Region,
+			CloudIDs: slice.Map(lbList, func(lb corelb.BaseLoadBalancer) string { return lb.CloudID }),
+		}
+		if err = client.DeleteLoadBalancer(cts.Kit, opt); err != nil {
+			logs.Errorf("request adaptor to delete aws load balancer failed, err: %v, opt: %v, rid: %s", err, opt,
+				cts.Kit.Rid)
+			return nil, err
+		}
+	}
+
+	return nil, svc.deleteDBLoadBalancer(cts.Kit, enumor.Aws, req.IDs)
+}
+
+// BatchCreateAwsTargets 批量注册RS到aws目标组
+func (svc *clbSvc) BatchCreateAwsTargets(cts *rest.Contexts) (any, error) {
+	req, tg, err := svc.decodeAwsOperateTargetReq(cts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = svc.operateAwsTargets(cts.Kit, tg, req, true); err != nil {
+		return nil, err
+	}
+
+	rsIDs, err := svc.batchCreateTargetDb(cts.Kit, req.RsList, tg.AccountID, tg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protolb.BatchCreateResult{SuccessCloudIDs: rsIDs.IDs}, nil
+}
+
+// BatchRemoveAwsTargets 批量从aws目标组注销RS
+func (svc *clbSvc) BatchRemoveAwsTargets(cts *rest.Contexts) (any, error) {
+	req, tg, err := svc.decodeAwsOperateTargetReq(cts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = svc.operateAwsTargets(cts.Kit, tg, req, false); err != nil {
+		return nil, err
+	}
+
+	return nil, svc.batchDeleteTargetDb(cts.Kit, req.RsList, tg.AccountID, tg.ID)
+}
+
+func (svc *clbSvc) decodeAwsOperateTargetReq(cts *rest.Contexts) (*protolb.AwsBatchOperateTargetReq,
+	*corelb.BaseTargetGroup, error) {
+
+	tgID := cts.PathParameter("target_group_id").String()
+	if len(tgID) == 0 {
+		return nil, nil, errf.New(errf.InvalidParameter, "target_group_id is required")
+	}
+
+	req := new(protolb.AwsBatchOperateTargetReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	tgList, err := svc.getTargetGroupByID(cts.Kit, tgID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(tgList) == 0 || tgList[0].Vendor != enumor.Aws {
+		return nil, nil, errf.Newf(errf.RecordNotFound, "aws target group: %s not found", tgID)
+	}
+
+	if len(tgList[0].CloudID) == 0 {
+		return nil, nil, errf.Newf(errf.InvalidParameter, "target group: %s has not been created in cloud", tgID)
+	}
+
+	return req, &tgList[0], nil
+}
+
+// operateAwsTargets 调用云上接口注册或注销目标，实例类型使用实例ID，其余使用IP
+func (svc *clbSvc) operateAwsTargets(kt *kit.Kit, tg *corelb.BaseTargetGroup,
+	req *protolb.AwsBatchOperateTargetReq, register bool) error {
+
+	client, err := svc.ad.Aws(kt, tg.AccountID)
+	if err != nil {
+		return err
+	}
+
+	opt := &typelb.AwsTargetOption{
+		Region:             tg.Region,
+		CloudTargetGroupID: tg.CloudID,
+	}
+	for _, rs := range req.RsList {
+		target := &typelb.AwsTarget{Port: rs.Port}
+		switch rs.InstType {
+		case enumor.CvmInstType:
+			target.ID = rs.CloudInstID
+		case enumor.EniInstType:
+			target.ID = rs.IP
+		default:
+			return errors.New("invalid target type: " + string(rs.InstType))
+		}
+		opt.Targets = append(opt.Targets, target)
+	}
+
+	if register {
+		err = client.RegisterTargets(kt, opt)
+	} else {
+		err = client.DeregisterTargets(kt, opt)
+	}
+	if err != nil {
+		logs.Errorf("operate aws targets failed, register: %v, err: %v, opt: %+v, rid: %s", register, err, opt,
+			kt.Rid)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package loadbalancer
+
+import (
+	"net/http"
+
+	"hcm/cmd/hc-service/service/capability"
+	typelb "hcm/pkg/adaptor/types/load-balancer"
+	"hcm/pkg/api/core"
+	protolb "hcm/pkg/api/hc-service/load-balancer"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// initAzureLoadBalancerService azure 负载均衡目前仅支持同步与删除，创建及RS注册/注销暂不支持
+func (svc *clbSvc) initAzureLoadBalancerService(cap *capability.Capability) {
+	h := rest.NewHandler()
+
+	h.Add("BatchDeleteAzureLoadBalancer", http.MethodDelete, "/vendors/azure/load_balancers/batch",
+		svc.BatchDeleteAzureLoadBalancer)
+
+	h.Load(cap.WebService)
+}
+
+// BatchDeleteAzureLoadBalancer 批量删除azure负载均衡
+func (svc *clbSvc) BatchDeleteAzureLoadBalancer(cts *rest.Contexts) (any, error) {
+	req := new(protolb.AzureBatchDeleteLoadBalancerReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	// azure 删除需要资源组，从拓展字段中获取
+	listReq := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("account_id", req.AccountID),
+			tools.RuleIn("id", req.IDs),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	lbResp, err := svc.dataCli.Azure.LoadBalancer.ListLoadBalancer(cts.Kit, listReq)
+	if err != nil {
+		logs.Errorf("request data service list azure load balancer failed, err: %v, ids: %v, rid: %s", err,
+			req.IDs, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if len(lbResp.Details) != 0 {
+		client, err := svc.ad.Azure(cts.Kit, req.AccountID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, lb := range lbResp.Details {
+			if lb.Extension == nil || len(lb.Extension.ResourceGroupName) == 0 {
+				return nil, errf.Newf(errf.InvalidParameter, "load balancer: %s resource group not found", lb.ID)
+			}
+
+			opt := &typelb.AzureDeleteOption{
+				ResourceGroupName: lb.Extension.ResourceGroupName,
+				Name:              lb.Name,
+			}
+			if err = client.DeleteLoadBalancer(cts.Kit, opt); err != nil {
+				logs.Errorf("request adaptor to delete azure load balancer failed, err: %v, opt: %v, rid: %s", err,
+					opt, cts.Kit.Rid)
+				return nil, err
+			}
+		}
+	}
+
+	return nil, svc.deleteDBLoadBalancer(cts.Kit, enumor.Azure, req.IDs)
+}
@@ -0,0 +1,80 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package loadbalancer
+
+import (
+	"net/http"
+
+	"hcm/cmd/hc-service/service/capability"
+	typelb "hcm/pkg/adaptor/types/load-balancer"
+	protolb "hcm/pkg/api/hc-service/load-balancer"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// initHuaWeiLoadBalancerService 华为云负载均衡目前仅支持同步与删除，创建及RS注册/注销暂不支持
+func (svc *clbSvc) initHuaWeiLoadBalancerService(cap *capability.Capability) {
+	h := rest.NewHandler()
+
+	h.Add("BatchDeleteHuaWeiLoadBalancer", http.MethodDelete, "/vendors/huawei/load_balancers/batch",
+		svc.BatchDeleteHuaWeiLoadBalancer)
+
+	h.Load(cap.WebService)
+}
+
+// BatchDeleteHuaWeiLoadBalancer 批量删除华为云负载均衡
+func (svc *clbSvc) BatchDeleteHuaWeiLoadBalancer(cts *rest.Contexts) (any, error) {
+	req := new(protolb.HuaWeiBatchDeleteLoadBalancerReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	lbList, err := svc.listLoadBalancerByIDs(cts.Kit, enumor.HuaWei, req.AccountID, req.IDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lbList) != 0 {
+		client, err := svc.ad.HuaWei(cts.Kit, req.AccountID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, lb := range lbList {
+			opt := &typelb.HuaWeiDeleteOption{
+				Region:  req.Region,
+				CloudID: lb.CloudID,
+			}
+			if err = client.DeleteLoadBalancer(cts.Kit, opt); err != nil {
+				logs.Errorf("request adaptor to delete huawei load balancer failed, err: %v, opt: %v, rid: %s", err,
+					opt, cts.Kit.Rid)
+				return nil, err
+			}
+		}
+	}
+
+	return nil, svc.deleteDBLoadBalancer(cts.Kit, enumor.HuaWei, req.IDs)
+}
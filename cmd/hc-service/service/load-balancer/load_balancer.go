@@ -23,7 +23,14 @@ package loadbalancer
 import (
 	"hcm/cmd/hc-service/logics/cloud-adaptor"
 	"hcm/cmd/hc-service/service/capability"
+	"hcm/pkg/api/core"
+	corelb "hcm/pkg/api/core/cloud/load-balancer"
+	dataproto "hcm/pkg/api/data-service/cloud"
 	dataservice "hcm/pkg/client/data-service"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
 )
 
 // InitLoadBalancerService initial the clb service.
@@ -34,9 +41,49 @@ func InitLoadBalancerService(cap *capability.Capability) {
 	}
 
 	svc.initTCloudClbService(cap)
+	svc.initAwsLoadBalancerService(cap)
+	svc.initAzureLoadBalancerService(cap)
+	svc.initHuaWeiLoadBalancerService(cap)
+	svc.initGcpLoadBalancerService(cap)
 }
 
 type clbSvc struct {
 	ad      *cloudadaptor.CloudAdaptorClient
 	dataCli *dataservice.Client
 }
+
+// listLoadBalancerByIDs 查询指定云厂商、账号下的负载均衡
+func (svc *clbSvc) listLoadBalancerByIDs(kt *kit.Kit, vendor enumor.Vendor, accountID string, ids []string) (
+	[]corelb.BaseLoadBalancer, error) {
+
+	listReq := &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", vendor),
+			tools.RuleEqual("account_id", accountID),
+			tools.RuleIn("id", ids),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	listResp, err := svc.dataCli.Global.LoadBalancer.ListLoadBalancer(kt, listReq)
+	if err != nil {
+		logs.Errorf("request data service list %s load balancer failed, err: %v, ids: %v, rid: %s", vendor, err,
+			ids, kt.Rid)
+		return nil, err
+	}
+
+	return listResp.Details, nil
+}
+
+// deleteDBLoadBalancer 删除本地负载均衡记录
+func (svc *clbSvc) deleteDBLoadBalancer(kt *kit.Kit, vendor enumor.Vendor, ids []string) error {
+	delReq := &dataproto.LoadBalancerBatchDeleteReq{
+		Filter: tools.ContainersExpression("id", ids),
+	}
+	if err := svc.dataCli.Global.LoadBalancer.BatchDeleteLoadBalancer(kt, delReq); err != nil {
+		logs.Errorf("request data service delete %s load balancer failed, err: %v, ids: %v, rid: %s", vendor, err,
+			ids, kt.Rid)
+		return err
+	}
+
+	return nil
+}
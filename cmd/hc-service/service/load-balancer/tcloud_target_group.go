@@ -151,19 +151,19 @@ func (svc *clbSvc) batchAddTargetsToGroup(kt *kit.Kit, req *protolb.TCloudBatchO
 		return nil, errf.Newf(errf.PartialFailed, "register tcloud target failed, failListenerIDs: %v", failIDs)
 	}
 
-	rsIDs, err := svc.batchCreateTargetDb(kt, req, lbInfo.AccountID, req.TargetGroupID)
+	rsIDs, err := svc.batchCreateTargetDb(kt, req.RsList, lbInfo.AccountID, req.TargetGroupID)
 	if err != nil {
 		return nil, err
 	}
 	return &protolb.BatchCreateResult{SuccessCloudIDs: rsIDs.IDs}, nil
 }
 
-func (svc *clbSvc) batchCreateTargetDb(kt *kit.Kit, req *protolb.TCloudBatchOperateTargetReq,
+func (svc *clbSvc) batchCreateTargetDb(kt *kit.Kit, targets []*dataproto.TargetBaseReq,
 	accountID, tgID string) (*core.BatchCreateResult, error) {
 
 	// 检查RS是否已绑定该目标组
 	rsList := make([]*dataproto.TargetBaseReq, 0)
-	for _, item := range req.RsList {
+	for _, item := range targets {
 		tgReq := &core.ListReq{
 			Filter: tools.ExpressionAnd(
 				tools.RuleEqual("account_id", accountID),
@@ -299,19 +299,19 @@ func (svc *clbSvc) batchUnRegisterTargetCloud(kt *kit.Kit, req *protolb.TCloudBa
 		}
 	}
 
-	err = svc.batchDeleteTargetDb(kt, req, tgInfo.AccountID, tgInfo.ID)
+	err = svc.batchDeleteTargetDb(kt, req.RsList, tgInfo.AccountID, tgInfo.ID)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (svc *clbSvc) batchDeleteTargetDb(kt *kit.Kit, req *protolb.TCloudBatchOperateTargetReq,
+func (svc *clbSvc) batchDeleteTargetDb(kt *kit.Kit, targets []*dataproto.TargetBaseReq,
 	accountID, tgID string) error {
 
 	// 检查RS是否已绑定该目标组
 	rsID := make([]string, 0)
-	for _, item := range req.RsList {
+	for _, item := range targets {
 		tgReq := &core.ListReq{
 			Filter: tools.ExpressionAnd(
 				tools.RuleEqual("account_id", accountID),
@@ -0,0 +1,168 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package privatedns
+
+import (
+	syncazure "hcm/cmd/hc-service/logics/res-sync/azure"
+	typesdns "hcm/pkg/adaptor/types/private-dns"
+	coreprivatedns "hcm/pkg/api/core/cloud/private-dns"
+	hcprivatedns "hcm/pkg/api/hc-service/private-dns"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// CreateAzurePrivateDnsRecord ...
+func (svc *service) CreateAzurePrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcprivatedns.RecordCreateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	zone, err := getZone(cts.Kit, svc.DataCli.Azure.PrivateDnsZone.ListPrivateDnsZoneExt, req.ZoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := svc.Adaptor.Azure(cts.Kit, zone.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typesdns.AzureRecordOption{CloudZoneID: zone.CloudID, RecordSpec: req.RecordSpec}
+	cloudID, err := client.CreatePrivateDnsRecord(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("create azure private dns record failed, err: %v, opt: %+v, rid: %s", err, opt, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if err = svc.syncAzurePrivateDnsZone(cts.Kit, zone); err != nil {
+		return nil, err
+	}
+
+	return svc.afterCreate(cts.Kit, zone.ID, []string{cloudID})
+}
+
+// UpdateAzurePrivateDnsRecord ...
+func (svc *service) UpdateAzurePrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcprivatedns.RecordUpdateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	record, zone, err := svc.getAzureRecord(cts.Kit, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := svc.Adaptor.Azure(cts.Kit, zone.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typesdns.AzureRecordOption{
+		CloudZoneID: zone.CloudID,
+		RecordSpec:  typesdns.RecordSpec{Name: record.Name, Type: record.Type, Value: req.Value, TTL: req.TTL},
+	}
+	if err = client.UpdatePrivateDnsRecord(cts.Kit, opt); err != nil {
+		logs.Errorf("update azure private dns record failed, err: %v, id: %s, rid: %s", err, req.ID, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, svc.syncAzurePrivateDnsZone(cts.Kit, zone)
+}
+
+// DeleteAzurePrivateDnsRecord ...
+func (svc *service) DeleteAzurePrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcprivatedns.RecordDeleteReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	record, zone, err := svc.getAzureRecord(cts.Kit, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := svc.Adaptor.Azure(cts.Kit, zone.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typesdns.AzureRecordDeleteOption{CloudID: record.CloudID}
+	if err = client.DeletePrivateDnsRecord(cts.Kit, opt); err != nil {
+		logs.Errorf("delete azure private dns record failed, err: %v, id: %s, rid: %s", err, req.ID, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, svc.syncAzurePrivateDnsZone(cts.Kit, zone)
+}
+
+func (svc *service) getAzureRecord(kt *kit.Kit, id string) (*coreprivatedns.Record,
+	*coreprivatedns.Zone[coreprivatedns.AzureZoneExtension], error) {
+
+	record, err := svc.getRecord(kt, enumor.Azure, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	zone, err := getZone(kt, svc.DataCli.Azure.PrivateDnsZone.ListPrivateDnsZoneExt, record.ZoneID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return record, zone, nil
+}
+
+// syncAzurePrivateDnsZone 同步私有域，更新解析记录和记录数
+func (svc *service) syncAzurePrivateDnsZone(kt *kit.Kit, zone *coreprivatedns.Zone[coreprivatedns.AzureZoneExtension]) error {
+	client, err := svc.Adaptor.Azure(kt, zone.AccountID)
+	if err != nil {
+		return err
+	}
+
+	syncClient := syncazure.NewClient(svc.DataCli, client)
+	if zone.Extension == nil {
+		return errf.Newf(errf.InvalidParameter, "private dns zone: %s extension is empty", zone.ID)
+	}
+
+	opt := &syncazure.SyncPrivateDnsZoneOption{
+		AccountID:         zone.AccountID,
+		ResourceGroupName: zone.Extension.ResourceGroupName,
+		CloudIDs:          []string{zone.CloudID},
+	}
+	if _, err = syncClient.PrivateDnsZone(kt, opt); err != nil {
+		logs.Errorf("sync azure private dns zone failed, err: %v, opt: %v, rid: %s", err, opt, kt.Rid)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package privatedns
+
+import (
+	syncgcp "hcm/cmd/hc-service/logics/res-sync/gcp"
+	typesdns "hcm/pkg/adaptor/types/private-dns"
+	coreprivatedns "hcm/pkg/api/core/cloud/private-dns"
+	hcprivatedns "hcm/pkg/api/hc-service/private-dns"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// CreateGcpPrivateDnsRecord ...
+func (svc *service) CreateGcpPrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcprivatedns.RecordCreateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	zone, err := getZone(cts.Kit, svc.DataCli.Gcp.PrivateDnsZone.ListPrivateDnsZoneExt, req.ZoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	if zone.Extension == nil {
+		return nil, errf.Newf(errf.InvalidParameter, "private dns zone: %s extension is empty", zone.ID)
+	}
+
+	client, err := svc.Adaptor.Gcp(cts.Kit, zone.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typesdns.GcpRecordOption{
+		ZoneName:   zone.Extension.ZoneName,
+		DnsName:    zone.Name,
+		RecordSpec: req.RecordSpec,
+	}
+	cloudID, err := client.CreatePrivateDnsRecord(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("create gcp private dns record failed, err: %v, opt: %+v, rid: %s", err, opt, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if err = svc.syncGcpPrivateDnsZone(cts.Kit, &zone.BaseZone); err != nil {
+		return nil, err
+	}
+
+	return svc.afterCreate(cts.Kit, zone.ID, []string{cloudID})
+}
+
+// UpdateGcpPrivateDnsRecord ...
+func (svc *service) UpdateGcpPrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcprivatedns.RecordUpdateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	record, zone, err := svc.getGcpRecord(cts.Kit, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := svc.Adaptor.Gcp(cts.Kit, zone.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typesdns.GcpRecordOption{
+		ZoneName:   zone.Extension.ZoneName,
+		DnsName:    zone.Name,
+		RecordSpec: typesdns.RecordSpec{Name: record.Name, Type: record.Type, Value: req.Value, TTL: req.TTL},
+	}
+	if err = client.UpdatePrivateDnsRecord(cts.Kit, opt); err != nil {
+		logs.Errorf("update gcp private dns record failed, err: %v, id: %s, rid: %s", err, req.ID, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, svc.syncGcpPrivateDnsZone(cts.Kit, &zone.BaseZone)
+}
+
+// DeleteGcpPrivateDnsRecord ...
+func (svc *service) DeleteGcpPrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcprivatedns.RecordDeleteReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	record, zone, err := svc.getGcpRecord(cts.Kit, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := svc.Adaptor.Gcp(cts.Kit, zone.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typesdns.GcpRecordDeleteOption{
+		ZoneName: zone.Extension.ZoneName,
+		DnsName:  zone.Name,
+		CloudID:  record.CloudID,
+	}
+	if err = client.DeletePrivateDnsRecord(cts.Kit, opt); err != nil {
+		logs.Errorf("delete gcp private dns record failed, err: %v, id: %s, rid: %s", err, req.ID, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, svc.syncGcpPrivateDnsZone(cts.Kit, &zone.BaseZone)
+}
+
+func (svc *service) getGcpRecord(kt *kit.Kit, id string) (*coreprivatedns.Record,
+	*coreprivatedns.Zone[coreprivatedns.GcpZoneExtension], error) {
+
+	record, err := svc.getRecord(kt, enumor.Gcp, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	zone, err := getZone(kt, svc.DataCli.Gcp.PrivateDnsZone.ListPrivateDnsZoneExt, record.ZoneID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if zone.Extension == nil {
+		return nil, nil, errf.Newf(errf.InvalidParameter, "private dns zone: %s extension is empty", zone.ID)
+	}
+
+	return record, zone, nil
+}
+
+// syncGcpPrivateDnsZone 同步私有域，更新解析记录和记录数
+func (svc *service) syncGcpPrivateDnsZone(kt *kit.Kit, zone *coreprivatedns.BaseZone) error {
+	client, err := svc.Adaptor.Gcp(kt, zone.AccountID)
+	if err != nil {
+		return err
+	}
+
+	syncClient := syncgcp.NewClient(svc.DataCli, client)
+	opt := &syncgcp.SyncPrivateDnsZoneOption{AccountID: zone.AccountID, CloudIDs: []string{zone.CloudID}}
+	if _, err = syncClient.PrivateDnsZone(kt, opt); err != nil {
+		logs.Errorf("sync gcp private dns zone failed, err: %v, opt: %v, rid: %s", err, opt, kt.Rid)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package privatedns ...
+package privatedns
+
+import (
+	"net/http"
+
+	cloudclient "hcm/cmd/hc-service/logics/cloud-adaptor"
+	"hcm/cmd/hc-service/service/capability"
+	"hcm/pkg/api/core"
+	coreprivatedns "hcm/pkg/api/core/cloud/private-dns"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	hcprivatedns "hcm/pkg/api/hc-service/private-dns"
+	dataservice "hcm/pkg/client/data-service"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// InitPrivateDnsService initial the private dns service
+func InitPrivateDnsService(cap *capability.Capability) {
+	svc := &service{
+		Adaptor: cap.CloudAdaptor,
+		DataCli: cap.ClientSet.DataService(),
+	}
+
+	h := rest.NewHandler()
+
+	// 创建解析记录
+	h.Add("CreateTCloudPrivateDnsRecord", http.MethodPost, "/vendors/tcloud/private_dns_records/create",
+		svc.CreateTCloudPrivateDnsRecord)
+	h.Add("CreateAwsPrivateDnsRecord", http.MethodPost, "/vendors/aws/private_dns_records/create",
+		svc.CreateAwsPrivateDnsRecord)
+	h.Add("CreateAzurePrivateDnsRecord", http.MethodPost, "/vendors/azure/private_dns_records/create",
+		svc.CreateAzurePrivateDnsRecord)
+	h.Add("CreateGcpPrivateDnsRecord", http.MethodPost, "/vendors/gcp/private_dns_records/create",
+		svc.CreateGcpPrivateDnsRecord)
+	h.Add("CreateHuaWeiPrivateDnsRecord", http.MethodPost, "/vendors/huawei/private_dns_records/create",
+		svc.CreateHuaWeiPrivateDnsRecord)
+
+	// 修改解析记录
+	h.Add("UpdateTCloudPrivateDnsRecord", http.MethodPatch, "/vendors/tcloud/private_dns_records",
+		svc.UpdateTCloudPrivateDnsRecord)
+	h.Add("UpdateAwsPrivateDnsRecord", http.MethodPatch, "/vendors/aws/private_dns_records",
+		svc.UpdateAwsPrivateDnsRecord)
+	h.Add("UpdateAzurePrivateDnsRecord", http.MethodPatch, "/vendors/azure/private_dns_records",
+		svc.UpdateAzurePrivateDnsRecord)
+	h.Add("UpdateGcpPrivateDnsRecord", http.MethodPatch, "/vendors/gcp/private_dns_records",
+		svc.UpdateGcpPrivateDnsRecord)
+	h.Add("UpdateHuaWeiPrivateDnsRecord", http.MethodPatch, "/vendors/huawei/private_dns_records",
+		svc.UpdateHuaWeiPrivateDnsRecord)
+
+	// 删除解析记录
+	h.Add("DeleteTCloudPrivateDnsRecord", http.MethodDelete, "/vendors/tcloud/private_dns_records",
+		svc.DeleteTCloudPrivateDnsRecord)
+	h.Add("DeleteAwsPrivateDnsRecord", http.MethodDelete, "/vendors/aws/private_dns_records",
+		svc.DeleteAwsPrivateDnsRecord)
+	h.Add("DeleteAzurePrivateDnsRecord", http.MethodDelete, "/vendors/azure/private_dns_records",
+		svc.DeleteAzurePrivateDnsRecord)
+	h.Add("DeleteGcpPrivateDnsRecord", http.MethodDelete, "/vendors/gcp/private_dns_records",
+		svc.DeleteGcpPrivateDnsRecord)
+	h.Add("DeleteHuaWeiPrivateDnsRecord", http.MethodDelete, "/vendors/huawei/private_dns_records",
+		svc.DeleteHuaWeiPrivateDnsRecord)
+
+	h.Load(cap.WebService)
+}
+
+type service struct {
+	DataCli *dataservice.Client
+	Adaptor *cloudclient.CloudAdaptorClient
+}
+
+// getZone 查询带扩展字段的私有域详情
+func getZone[T coreprivatedns.Extension](kt *kit.Kit,
+	listFunc func(*kit.Kit, *core.ListReq) (*protocloud.PrivateDnsZoneExtListResult[T], error), id string) (
+	*coreprivatedns.Zone[T], error) {
+
+	req := &core.ListReq{
+		Filter: tools.EqualExpression("id", id),
+		Page:   core.NewDefaultBasePage(),
+	}
+	result, err := listFunc(kt, req)
+	if err != nil {
+		logs.Errorf("list private dns zone failed, err: %v, id: %s, rid: %s", err, id, kt.Rid)
+		return nil, err
+	}
+
+	if len(result.Details) == 0 {
+		return nil, errf.Newf(errf.RecordNotFound, "private dns zone: %s not found", id)
+	}
+
+	return &result.Details[0], nil
+}
+
+// getRecord 查询解析记录详情，并校验解析记录所属云厂商
+func (svc *service) getRecord(kt *kit.Kit, vendor enumor.Vendor, id string) (*coreprivatedns.Record, error) {
+	req := &core.ListReq{
+		Filter: tools.EqualExpression("id", id),
+		Page:   core.NewDefaultBasePage(),
+	}
+	result, err := svc.DataCli.Global.PrivateDnsRecord.List(kt, req)
+	if err != nil {
+		logs.Errorf("list private dns record failed, err: %v, id: %s, rid: %s", err, id, kt.Rid)
+		return nil, err
+	}
+
+	if len(result.Details) == 0 {
+		return nil, errf.Newf(errf.RecordNotFound, "private dns record: %s not found", id)
+	}
+
+	record := result.Details[0]
+	if record.Vendor != vendor {
+		return nil, errf.Newf(errf.InvalidParameter, "private dns record: %s not belong to %s", id, vendor)
+	}
+
+	return &record, nil
+}
+
+// afterCreate 解析记录同步到本地后，返回解析记录本地ID
+func (svc *service) afterCreate(kt *kit.Kit, zoneID string, cloudIDs []string) (
+	*hcprivatedns.RecordCreateResult, error) {
+
+	req := &core.ListReq{
+		Fields: []string{"id"},
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("zone_id", zoneID),
+			tools.RuleIn("cloud_id", cloudIDs),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := svc.DataCli.Global.PrivateDnsRecord.List(kt, req)
+	if err != nil {
+		logs.Errorf("list private dns record failed, err: %v, cloudIDs: %v, rid: %s", err, cloudIDs, kt.Rid)
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(result.Details))
+	for _, one := range result.Details {
+		ids = append(ids, one.ID)
+	}
+
+	return &hcprivatedns.RecordCreateResult{IDs: ids}, nil
+}
@@ -0,0 +1,171 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package privatedns
+
+import (
+	synctcloud "hcm/cmd/hc-service/logics/res-sync/tcloud"
+	typesdns "hcm/pkg/adaptor/types/private-dns"
+	coreprivatedns "hcm/pkg/api/core/cloud/private-dns"
+	hcprivatedns "hcm/pkg/api/hc-service/private-dns"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// CreateTCloudPrivateDnsRecord 腾讯云一条解析记录只有一个记录值，多个记录值时逐个创建
+func (svc *service) CreateTCloudPrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcprivatedns.RecordCreateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	zone, err := getZone(cts.Kit, svc.DataCli.TCloud.PrivateDnsZone.ListPrivateDnsZoneExt, req.ZoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := svc.Adaptor.TCloud(cts.Kit, zone.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	cloudIDs := make([]string, 0, len(req.Value))
+	for _, value := range req.Value {
+		spec := req.RecordSpec
+		spec.Value = []string{value}
+		opt := &typesdns.TCloudRecordCreateOption{CloudZoneID: zone.CloudID, RecordSpec: spec}
+		cloudID, err := client.CreatePrivateDnsRecord(cts.Kit, opt)
+		if err != nil {
+			logs.Errorf("create tcloud private dns record failed, err: %v, opt: %+v, rid: %s", err, opt, cts.Kit.Rid)
+			return nil, err
+		}
+		cloudIDs = append(cloudIDs, cloudID)
+	}
+
+	if err = svc.syncTCloudPrivateDnsZone(cts.Kit, &zone.BaseZone); err != nil {
+		return nil, err
+	}
+
+	return svc.afterCreate(cts.Kit, zone.ID, cloudIDs)
+}
+
+// UpdateTCloudPrivateDnsRecord ...
+func (svc *service) UpdateTCloudPrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcprivatedns.RecordUpdateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	if len(req.Value) != 1 {
+		return nil, errf.New(errf.InvalidParameter, "tcloud private dns record only support one value")
+	}
+
+	record, zone, err := svc.getTCloudRecord(cts.Kit, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := svc.Adaptor.TCloud(cts.Kit, zone.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typesdns.TCloudRecordUpdateOption{
+		CloudZoneID: zone.CloudID,
+		CloudID:     record.CloudID,
+		RecordSpec:  typesdns.RecordSpec{Name: record.Name, Type: record.Type, Value: req.Value, TTL: req.TTL},
+	}
+	if err = client.UpdatePrivateDnsRecord(cts.Kit, opt); err != nil {
+		logs.Errorf("update tcloud private dns record failed, err: %v, id: %s, rid: %s", err, req.ID, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, svc.syncTCloudPrivateDnsZone(cts.Kit, &zone.BaseZone)
+}
+
+// DeleteTCloudPrivateDnsRecord ...
+func (svc *service) DeleteTCloudPrivateDnsRecord(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcprivatedns.RecordDeleteReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	record, zone, err := svc.getTCloudRecord(cts.Kit, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := svc.Adaptor.TCloud(cts.Kit, zone.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typesdns.TCloudRecordDeleteOption{CloudZoneID: zone.CloudID, CloudIDs: []string{record.CloudID}}
+	if err = client.DeletePrivateDnsRecord(cts.Kit, opt); err != nil {
+		logs.Errorf("delete tcloud private dns record failed, err: %v, id: %s, rid: %s", err, req.ID, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, svc.syncTCloudPrivateDnsZone(cts.Kit, &zone.BaseZone)
+}
+
+func (svc *service) getTCloudRecord(kt *kit.Kit, id string) (*coreprivatedns.Record,
+	*coreprivatedns.Zone[coreprivatedns.TCloudZoneExtension], error) {
+
+	record, err := svc.getRecord(kt, enumor.TCloud, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	zone, err := getZone(kt, svc.DataCli.TCloud.PrivateDnsZone.ListPrivateDnsZoneExt, record.ZoneID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return record, zone, nil
+}
+
+// syncTCloudPrivateDnsZone 同步私有域，更新解析记录和记录数
+func (svc *service) syncTCloudPrivateDnsZone(kt *kit.Kit, zone *coreprivatedns.BaseZone) error {
+	client, err := svc.Adaptor.TCloud(kt, zone.AccountID)
+	if err != nil {
+		return err
+	}
+
+	syncClient := synctcloud.NewClient(svc.DataCli, client)
+	opt := &synctcloud.SyncPrivateDnsZoneOption{AccountID: zone.AccountID, CloudIDs: []string{zone.CloudID}}
+	if _, err = syncClient.PrivateDnsZone(kt, opt); err != nil {
+		logs.Errorf("sync tcloud private dns zone failed, err: %v, opt: %v, rid: %s", err, opt, kt.Rid)
+		return err
+	}
+
+	return nil
+}
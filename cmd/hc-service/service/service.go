@@ -37,14 +37,18 @@ import (
 	"hcm/cmd/hc-service/service/capability"
 	"hcm/cmd/hc-service/service/cert"
 	"hcm/cmd/hc-service/service/cvm"
+	dbinstance "hcm/cmd/hc-service/service/database-instance"
 	"hcm/cmd/hc-service/service/disk"
 	"hcm/cmd/hc-service/service/eip"
 	"hcm/cmd/hc-service/service/firewall"
 	instancetype "hcm/cmd/hc-service/service/instance-type"
+	keypair "hcm/cmd/hc-service/service/key-pair"
 	loadbalancer "hcm/cmd/hc-service/service/load-balancer"
 	mainaccount "hcm/cmd/hc-service/service/main-account"
+	privatedns "hcm/cmd/hc-service/service/private-dns"
 	routetable "hcm/cmd/hc-service/service/route-table"
 	securitygroup "hcm/cmd/hc-service/service/security-group"
+	"hcm/cmd/hc-service/service/snapshot"
 	"hcm/cmd/hc-service/service/subnet"
 	"hcm/cmd/hc-service/service/sync"
 	"hcm/cmd/hc-service/service/vpc"
@@ -154,7 +158,7 @@ func (s *Service) apiSet() *restful.Container {
 		WebService:   ws,
 		ClientSet:    s.clientSet,
 		CloudAdaptor: s.cloudAdaptor,
-		ResSyncCli:   ressync.NewClient(s.cloudAdaptor, s.clientSet.DataService()),
+		ResSyncCli:   ressync.NewClient(s.cloudAdaptor, s.clientSet),
 	}
 
 	account.InitAccountService(c)
@@ -174,6 +178,10 @@ func (s *Service) apiSet() *restful.Container {
 	cert.InitCertService(c)
 	bwpkg.InitBwPkgService(c)
 	mainaccount.InitService(c)
+	snapshot.InitSnapshotService(c)
+	dbinstance.InitDatabaseInstanceService(c)
+	keypair.InitKeyPairService(c)
+	privatedns.InitPrivateDnsService(c)
 
 	return restful.NewContainer().Add(c.WebService)
 }
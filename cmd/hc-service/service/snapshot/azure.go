@@ -0,0 +1,173 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package snapshot
+
+import (
+	syncazure "hcm/cmd/hc-service/logics/res-sync/azure"
+	typessnapshot "hcm/pkg/adaptor/types/snapshot"
+	hcsnapshot "hcm/pkg/api/hc-service/snapshot"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// CreateAzureSnapshot ...
+func (svc *service) CreateAzureSnapshot(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcsnapshot.SnapshotCreateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	diskData, err := svc.DataCli.Azure.RetrieveDisk(cts.Kit.Ctx, cts.Kit.Header(), req.DiskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if diskData.Extension == nil {
+		return nil, errf.Newf(errf.InvalidParameter, "disk: %s extension is empty", req.DiskID)
+	}
+
+	client, err := svc.Adaptor.Azure(cts.Kit, diskData.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	resGroupName := diskData.Extension.ResourceGroupName
+	opt := &typessnapshot.AzureSnapshotCreateOption{
+		ResourceGroupName: resGroupName,
+		Region:            diskData.Region,
+		Name:              req.Name,
+		CloudDiskID:       diskData.CloudID,
+	}
+	cloudID, err := client.CreateSnapshot(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("create azure snapshot failed, err: %v, diskID: %s, rid: %s", err, req.DiskID, cts.Kit.Rid)
+		return nil, err
+	}
+
+	syncClient := syncazure.NewClient(svc.DataCli, client)
+	params := &syncazure.SyncBaseParams{
+		AccountID:         diskData.AccountID,
+		ResourceGroupName: resGroupName,
+		CloudIDs:          []string{cloudID},
+	}
+	if _, err = syncClient.Snapshot(cts.Kit, params, new(syncazure.SyncSnapshotOption)); err != nil {
+		logs.Errorf("sync azure snapshot failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return svc.afterCreate(cts.Kit, enumor.Azure, cloudID, req)
+}
+
+// DeleteAzureSnapshot ...
+func (svc *service) DeleteAzureSnapshot(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcsnapshot.SnapshotDeleteReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	snapshot, err := getSnapshot(cts.Kit, svc.DataCli.Azure.Snapshot.ListSnapshotExt, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot.Extension == nil {
+		return nil, errf.Newf(errf.InvalidParameter, "snapshot: %s extension is empty", req.ID)
+	}
+
+	client, err := svc.Adaptor.Azure(cts.Kit, snapshot.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typessnapshot.AzureSnapshotDeleteOption{
+		ResourceGroupName: snapshot.Extension.ResourceGroupName,
+		Name:              snapshot.Name,
+	}
+	if err = client.DeleteSnapshot(cts.Kit, opt); err != nil {
+		logs.Errorf("delete azure snapshot failed, err: %v, id: %s, rid: %s", err, req.ID, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, svc.deleteSnapshotFromDB(cts.Kit, req.ID)
+}
+
+// RollbackAzureSnapshot azure 不支持原地回滚，通过快照创建新的托管磁盘并同步到本地
+func (svc *service) RollbackAzureSnapshot(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcsnapshot.SnapshotRollbackReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	if len(req.DiskName) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "disk_name is required")
+	}
+
+	snapshot, err := getSnapshot(cts.Kit, svc.DataCli.Azure.Snapshot.ListSnapshotExt, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot.Extension == nil {
+		return nil, errf.Newf(errf.InvalidParameter, "snapshot: %s extension is empty", req.ID)
+	}
+
+	client, err := svc.Adaptor.Azure(cts.Kit, snapshot.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	resGroupName := snapshot.Extension.ResourceGroupName
+	opt := &typessnapshot.AzureSnapshotRollbackOption{
+		ResourceGroupName: resGroupName,
+		Region:            snapshot.Region,
+		CloudSnapshotID:   snapshot.CloudID,
+		DiskName:          req.DiskName,
+		Zone:              snapshot.Zone,
+	}
+	cloudDiskID, err := client.RollbackSnapshot(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("rollback azure snapshot failed, err: %v, id: %s, rid: %s", err, req.ID, cts.Kit.Rid)
+		return nil, err
+	}
+
+	syncClient := syncazure.NewClient(svc.DataCli, client)
+	params := &syncazure.SyncBaseParams{
+		AccountID:         snapshot.AccountID,
+		ResourceGroupName: resGroupName,
+		CloudIDs:          []string{cloudDiskID},
+	}
+	if _, err = syncClient.Disk(cts.Kit, params, new(syncazure.SyncDiskOption)); err != nil {
+		logs.Errorf("sync azure disk failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,159 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package snapshot
+
+import (
+	syncgcp "hcm/cmd/hc-service/logics/res-sync/gcp"
+	typessnapshot "hcm/pkg/adaptor/types/snapshot"
+	hcsnapshot "hcm/pkg/api/hc-service/snapshot"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// CreateGcpSnapshot ...
+func (svc *service) CreateGcpSnapshot(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcsnapshot.SnapshotCreateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	diskData, err := svc.DataCli.Gcp.RetrieveDisk(cts.Kit, req.DiskID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := svc.Adaptor.Gcp(cts.Kit, diskData.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typessnapshot.GcpSnapshotCreateOption{
+		Zone:        diskData.Zone,
+		DiskName:    diskData.Name,
+		Name:        req.Name,
+		Description: req.Memo,
+	}
+	name, err := client.CreateSnapshot(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("create gcp snapshot failed, err: %v, diskID: %s, rid: %s", err, req.DiskID, cts.Kit.Rid)
+		return nil, err
+	}
+
+	// gcp 创建快照只返回快照名称，需要通过名称查询快照ID
+	listOpt := &typessnapshot.GcpSnapshotListOption{Names: []string{name}}
+	snapshots, _, err := client.ListSnapshot(cts.Kit, listOpt)
+	if err != nil {
+		logs.Errorf("list gcp snapshot by name failed, err: %v, name: %s, rid: %s", err, name, cts.Kit.Rid)
+		return nil, err
+	}
+
+	// 快照在云上还未就绪时查询不到，由后续的定时同步补充
+	if len(snapshots) == 0 {
+		return new(hcsnapshot.SnapshotCreateResult), nil
+	}
+
+	cloudID := snapshots[0].GetCloudID()
+	syncClient := syncgcp.NewClient(svc.DataCli, client)
+	params := &syncgcp.SyncBaseParams{
+		AccountID: diskData.AccountID,
+		CloudIDs:  []string{cloudID},
+	}
+	if _, err = syncClient.Snapshot(cts.Kit, params, new(syncgcp.SyncSnapshotOption)); err != nil {
+		logs.Errorf("sync gcp snapshot failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return svc.afterCreate(cts.Kit, enumor.Gcp, cloudID, req)
+}
+
+// DeleteGcpSnapshot ...
+func (svc *service) DeleteGcpSnapshot(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcsnapshot.SnapshotDeleteReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	snapshot, err := getSnapshot(cts.Kit, svc.DataCli.Gcp.Snapshot.ListSnapshotExt, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := svc.Adaptor.Gcp(cts.Kit, snapshot.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typessnapshot.GcpSnapshotDeleteOption{Name: snapshot.Name}
+	if err = client.DeleteSnapshot(cts.Kit, opt); err != nil {
+		logs.Errorf("delete gcp snapshot failed, err: %v, id: %s, rid: %s", err, req.ID, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, svc.deleteSnapshotFromDB(cts.Kit, req.ID)
+}
+
+// RollbackGcpSnapshot gcp 不支持原地回滚，在来源云盘所在可用区通过快照创建新的磁盘，新磁盘由后续的定时同步同步到本地
+func (svc *service) RollbackGcpSnapshot(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcsnapshot.SnapshotRollbackReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	if len(req.DiskName) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "disk_name is required")
+	}
+
+	snapshot, err := getSnapshot(cts.Kit, svc.DataCli.Gcp.Snapshot.ListSnapshotExt, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot.Extension == nil || len(snapshot.Zone) == 0 {
+		return nil, errf.Newf(errf.InvalidParameter, "snapshot: %s source disk zone not exist", req.ID)
+	}
+
+	client, err := svc.Adaptor.Gcp(cts.Kit, snapshot.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typessnapshot.GcpSnapshotRollbackOption{
+		Zone:             snapshot.Zone,
+		SnapshotSelfLink: snapshot.Extension.SelfLink,
+		DiskName:         req.DiskName,
+	}
+	if err = client.RollbackSnapshot(cts.Kit, opt); err != nil {
+		logs.Errorf("rollback gcp snapshot failed, err: %v, id: %s, rid: %s", err, req.ID, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,142 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package snapshot
+
+import (
+	synchuawei "hcm/cmd/hc-service/logics/res-sync/huawei"
+	typessnapshot "hcm/pkg/adaptor/types/snapshot"
+	hcsnapshot "hcm/pkg/api/hc-service/snapshot"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// CreateHuaWeiSnapshot ...
+func (svc *service) CreateHuaWeiSnapshot(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcsnapshot.SnapshotCreateReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	diskData, err := svc.DataCli.HuaWei.RetrieveDisk(cts.Kit.Ctx, cts.Kit.Header(), req.DiskID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := svc.Adaptor.HuaWei(cts.Kit, diskData.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typessnapshot.HuaWeiSnapshotCreateOption{
+		Region:      diskData.Region,
+		CloudDiskID: diskData.CloudID,
+		Name:        &req.Name,
+		Description: req.Memo,
+	}
+	cloudID, err := client.CreateSnapshot(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("create huawei snapshot failed, err: %v, diskID: %s, rid: %s", err, req.DiskID, cts.Kit.Rid)
+		return nil, err
+	}
+
+	syncClient := synchuawei.NewClient(svc.DataCli, client)
+	params := &synchuawei.SyncBaseParams{
+		AccountID: diskData.AccountID,
+		Region:    diskData.Region,
+		CloudIDs:  []string{cloudID},
+	}
+	if _, err = syncClient.Snapshot(cts.Kit, params, new(synchuawei.SyncSnapshotOption)); err != nil {
+		logs.Errorf("sync huawei snapshot failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return svc.afterCreate(cts.Kit, enumor.HuaWei, cloudID, req)
+}
+
+// DeleteHuaWeiSnapshot ...
+func (svc *service) DeleteHuaWeiSnapshot(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcsnapshot.SnapshotDeleteReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	snapshot, err := getSnapshot(cts.Kit, svc.DataCli.HuaWei.Snapshot.ListSnapshotExt, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := svc.Adaptor.HuaWei(cts.Kit, snapshot.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typessnapshot.HuaWeiSnapshotDeleteOption{Region: snapshot.Region, CloudID: snapshot.CloudID}
+	if err = client.DeleteSnapshot(cts.Kit, opt); err != nil {
+		logs.Errorf("delete huawei snapshot failed, err: %v, id: %s, rid: %s", err, req.ID, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, svc.deleteSnapshotFromDB(cts.Kit, req.ID)
+}
+
+// RollbackHuaWeiSnapshot 将快照回滚到来源云盘
+func (svc *service) RollbackHuaWeiSnapshot(cts *rest.Contexts) (interface{}, error) {
+	req := new(hcsnapshot.SnapshotRollbackReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	snapshot, err := getSnapshot(cts.Kit, svc.DataCli.HuaWei.Snapshot.ListSnapshotExt, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(snapshot.CloudDiskID) == 0 {
+		return nil, errf.Newf(errf.InvalidParameter, "snapshot: %s source disk not exist", req.ID)
+	}
+
+	client, err := svc.Adaptor.HuaWei(cts.Kit, snapshot.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &typessnapshot.HuaWeiSnapshotRollbackOption{
+		Region:          snapshot.Region,
+		CloudSnapshotID: snapshot.CloudID,
+		CloudDiskID:     snapshot.CloudDiskID,
+	}
+	if err = client.RollbackSnapshot(cts.Kit, opt); err != nil {
+		logs.Errorf("rollback huawei snapshot failed, err: %v, id: %s, rid: %s", err, req.ID, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,152 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package snapshot ...
+package snapshot
+
+import (
+	"net/http"
+
+	cloudclient "hcm/cmd/hc-service/logics/cloud-adaptor"
+	"hcm/cmd/hc-service/service/capability"
+	"hcm/pkg/api/core"
+	coresnapshot "hcm/pkg/api/core/cloud/snapshot"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	hcsnapshot "hcm/pkg/api/hc-service/snapshot"
+	dataservice "hcm/pkg/client/data-service"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// InitSnapshotService initial the snapshot service
+func InitSnapshotService(cap *capability.Capability) {
+	svc := &service{
+		Adaptor: cap.CloudAdaptor,
+		DataCli: cap.ClientSet.DataService(),
+	}
+
+	h := rest.NewHandler()
+
+	// 创建快照
+	h.Add("CreateTCloudSnapshot", http.MethodPost, "/vendors/tcloud/snapshots/create", svc.CreateTCloudSnapshot)
+	h.Add("CreateAwsSnapshot", http.MethodPost, "/vendors/aws/snapshots/create", svc.CreateAwsSnapshot)
+	h.Add("CreateAzureSnapshot", http.MethodPost, "/vendors/azure/snapshots/create", svc.CreateAzureSnapshot)
+	h.Add("CreateGcpSnapshot", http.MethodPost, "/vendors/gcp/snapshots/create", svc.CreateGcpSnapshot)
+	h.Add("CreateHuaWeiSnapshot", http.MethodPost, "/vendors/huawei/snapshots/create", svc.CreateHuaWeiSnapshot)
+
+	// 删除快照
+	h.Add("DeleteTCloudSnapshot", http.MethodDelete, "/vendors/tcloud/snapshots", svc.DeleteTCloudSnapshot)
+	h.Add("DeleteAwsSnapshot", http.MethodDelete, "/vendors/aws/snapshots", svc.DeleteAwsSnapshot)
+	h.Add("DeleteAzureSnapshot", http.MethodDelete, "/vendors/azure/snapshots", svc.DeleteAzureSnapshot)
+	h.Add("DeleteGcpSnapshot", http.MethodDelete, "/vendors/gcp/snapshots", svc.DeleteGcpSnapshot)
+	h.Add("DeleteHuaWeiSnapshot", http.MethodDelete, "/vendors/huawei/snapshots", svc.DeleteHuaWeiSnapshot)
+
+	// 快照回滚
+	h.Add("RollbackTCloudSnapshot", http.MethodPost, "/vendors/tcloud/snapshots/rollback",
+		svc.RollbackTCloudSnapshot)
+	h.Add("RollbackAwsSnapshot", http.MethodPost, "/vendors/aws/snapshots/rollback", svc.RollbackAwsSnapshot)
+	h.Add("RollbackAzureSnapshot", http.MethodPost, "/vendors/azure/snapshots/rollback", svc.RollbackAzureSnapshot)
+	h.Add("RollbackGcpSnapshot", http.MethodPost, "/vendors/gcp/snapshots/rollback", svc.RollbackGcpSnapshot)
+	h.Add("RollbackHuaWeiSnapshot", http.MethodPost, "/vendors/huawei/snapshots/rollback",
+		svc.RollbackHuaWeiSnapshot)
+
+	h.Load(cap.WebService)
+}
+
+type service struct {
+	DataCli *dataservice.Client
+	Adaptor *cloudclient.CloudAdaptorClient
+}
+
+// getSnapshot 查询带扩展字段的快照详情
+func getSnapshot[T coresnapshot.Extension](kt *kit.Kit,
+	listFunc func(*kit.Kit, *core.ListReq) (*protocloud.SnapshotExtListResult[T], error), id string) (
+	*coresnapshot.Snapshot[T], error) {
+
+	req := &core.ListReq{
+		Filter: tools.EqualExpression("id", id),
+		Page:   core.NewDefaultBasePage(),
+	}
+	result, err := listFunc(kt, req)
+	if err != nil {
+		logs.Errorf("list snapshot failed, err: %v, id: %s, rid: %s", err, id, kt.Rid)
+		return nil, err
+	}
+
+	if len(result.Details) == 0 {
+		return nil, errf.Newf(errf.RecordNotFound, "snapshot: %s not found", id)
+	}
+
+	return &result.Details[0], nil
+}
+
+// afterCreate 快照同步到本地后，记录创建快照的策略ID和备注，并返回快照本地ID
+func (svc *service) afterCreate(kt *kit.Kit, vendor enumor.Vendor, cloudID string,
+	createReq *hcsnapshot.SnapshotCreateReq) (*hcsnapshot.SnapshotCreateResult, error) {
+
+	req := &core.ListReq{
+		Fields: []string{"id"},
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", vendor),
+			tools.RuleEqual("cloud_id", cloudID),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	result, err := svc.DataCli.Global.Snapshot.List(kt, req)
+	if err != nil {
+		logs.Errorf("list snapshot failed, err: %v, cloudID: %s, rid: %s", err, cloudID, kt.Rid)
+		return nil, err
+	}
+
+	// 快照在云上还未就绪时同步不到本地，由后续的定时同步补充
+	if len(result.Details) == 0 {
+		return &hcsnapshot.SnapshotCreateResult{CloudID: cloudID}, nil
+	}
+
+	id := result.Details[0].ID
+	// 部分云的快照不支持描述字段，备注统一记录在本地
+	if len(createReq.PolicyID) != 0 || createReq.Memo != nil {
+		updateReq := &protocloud.SnapshotBatchUpdateExprReq{
+			IDs:      []string{id},
+			PolicyID: createReq.PolicyID,
+			Memo:     createReq.Memo,
+		}
+		if err = svc.DataCli.Global.Snapshot.BatchUpdate(kt, updateReq); err != nil {
+			logs.Errorf("update snapshot policy id and memo failed, err: %v, id: %s, rid: %s", err, id, kt.Rid)
+			return nil, err
+		}
+	}
+
+	return &hcsnapshot.SnapshotCreateResult{ID: id, CloudID: cloudID}, nil
+}
+
+// deleteSnapshotFromDB 云上删除成功后删除本地快照
+func (svc *service) deleteSnapshotFromDB(kt *kit.Kit, id string) error {
+	req := &protocloud.SnapshotBatchDeleteReq{Filter: tools.EqualExpression("id", id)}
+	if err := svc.DataCli.Global.Snapshot.BatchDelete(kt, req); err != nil {
+		logs.Errorf("delete snapshot from db failed, err: %v, id: %s, rid: %s", err, id, kt.Rid)
+		return err
+	}
+
+	return nil
+}
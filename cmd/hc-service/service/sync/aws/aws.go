@@ -23,7 +23,9 @@ package aws
 import (
 	ressync "hcm/cmd/hc-service/logics/res-sync"
 	"hcm/cmd/hc-service/logics/res-sync/aws"
+	dryrun "hcm/cmd/hc-service/logics/res-sync/dry-run"
 	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/criteria/errf"
 	"hcm/pkg/rest"
 )
@@ -38,6 +40,10 @@ func defaultPrepare(cts *rest.Contexts, cli ressync.Interface) (*sync.AwsSyncReq
 		return nil, nil, errf.NewFromErr(errf.InvalidParameter, err)
 	}
 
+	if req.DryRun {
+		dryrun.WithRecorder(cts.Kit, cli.DryRunRecorder(enumor.Aws, req.AccountID, req.Region))
+	}
+
 	syncCli, err := cli.Aws(cts.Kit, req.AccountID)
 	if err != nil {
 		return nil, nil, err
@@ -0,0 +1,121 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"strings"
+
+	typeauditevent "hcm/pkg/adaptor/types/audit-event"
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/slice"
+)
+
+// auditResType aws 操作审计事件中的资源类型对应的hcm资源类型，及其云ID前缀
+type auditResType struct {
+	resType enumor.CloudResourceType
+	prefix  string
+}
+
+// awsAuditResTypeMap 支持通过操作审计事件增量同步的资源类型
+var awsAuditResTypeMap = map[string]auditResType{
+	"AWS::EC2::Instance":      {resType: enumor.CvmCloudResType, prefix: "i-"},
+	"AWS::EC2::Volume":        {resType: enumor.DiskCloudResType, prefix: "vol-"},
+	"AWS::EC2::VPC":           {resType: enumor.VpcCloudResType, prefix: "vpc-"},
+	"AWS::EC2::Subnet":        {resType: enumor.SubnetCloudResType, prefix: "subnet-"},
+	"AWS::EC2::SecurityGroup": {resType: enumor.SecurityGroupCloudResType, prefix: "sg-"},
+	// eip 事件中的资源名称可能是公网IP，只取分配ID
+	"AWS::EC2::EIP": {resType: enumor.EipCloudResType, prefix: "eipalloc-"},
+}
+
+// ListChangedResource 通过操作审计(CloudTrail)事件查询时间范围内发生变更的资源云ID，用于增量同步。
+func (svc *service) ListChangedResource(cts *rest.Contexts) (interface{}, error) {
+	req := new(sync.AwsChangedResListReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	cli, err := svc.ad.Aws(cts.Kit, req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := make(map[enumor.CloudResourceType][]string)
+	opt := &typeauditevent.AwsListOption{
+		Region:    req.Region,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		ReadOnly:  false,
+	}
+	for {
+		result, err := cli.ListAuditEvent(cts.Kit, opt)
+		if err != nil {
+			logs.Errorf("list aws audit event failed, err: %v, opt: %+v, rid: %s", err, opt, cts.Kit.Rid)
+			return nil, err
+		}
+
+		collectChangedResource(changed, result.Details)
+
+		if len(converter.PtrToVal(result.NextToken)) == 0 {
+			break
+		}
+		opt.NextToken = result.NextToken
+	}
+
+	for resType, cloudIDs := range changed {
+		changed[resType] = slice.Unique(cloudIDs)
+	}
+
+	return &sync.ChangedResListResult{Details: changed}, nil
+}
+
+// collectChangedResource 从操作审计事件中提取支持增量同步的资源云ID，按资源类型记录到 changed 中
+func collectChangedResource(changed map[enumor.CloudResourceType][]string, events []typeauditevent.AwsEvent) {
+	for _, event := range events {
+		if event.Event == nil {
+			continue
+		}
+
+		for _, res := range event.Resources {
+			if res == nil {
+				continue
+			}
+
+			typ, exists := awsAuditResTypeMap[converter.PtrToVal(res.ResourceType)]
+			if !exists {
+				continue
+			}
+
+			cloudID := converter.PtrToVal(res.ResourceName)
+			if !strings.HasPrefix(cloudID, typ.prefix) {
+				continue
+			}
+			changed[typ.resType] = append(changed[typ.resType], cloudID)
+		}
+	}
+}
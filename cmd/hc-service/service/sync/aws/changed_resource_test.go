@@ -0,0 +1,86 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	typeauditevent "hcm/pkg/adaptor/types/audit-event"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+)
+
+func TestCollectChangedResource(t *testing.T) {
+	event := func(resources ...[2]string) typeauditevent.AwsEvent {
+		one := &cloudtrail.Event{}
+		for _, res := range resources {
+			one.Resources = append(one.Resources, &cloudtrail.Resource{
+				ResourceType: converter.ValToPtr(res[0]),
+				ResourceName: converter.ValToPtr(res[1]),
+			})
+		}
+		return typeauditevent.AwsEvent{Event: one}
+	}
+
+	cases := []struct {
+		name   string
+		events []typeauditevent.AwsEvent
+		want   map[enumor.CloudResourceType][]string
+	}{
+		{
+			name: "supported resources",
+			events: []typeauditevent.AwsEvent{
+				event([2]string{"AWS::EC2::Instance", "i-001"}, [2]string{"AWS::EC2::Volume", "vol-001"}),
+				event([2]string{"AWS::EC2::VPC", "vpc-001"}),
+			},
+			want: map[enumor.CloudResourceType][]string{
+				enumor.CvmCloudResType:  {"i-001"},
+				enumor.DiskCloudResType: {"vol-001"},
+				enumor.VpcCloudResType:  {"vpc-001"},
+			},
+		},
+		{
+			name: "eip public ip is skipped",
+			events: []typeauditevent.AwsEvent{
+				event([2]string{"AWS::EC2::EIP", "1.1.1.1"}, [2]string{"AWS::EC2::EIP", "eipalloc-001"}),
+			},
+			want: map[enumor.CloudResourceType][]string{enumor.EipCloudResType: {"eipalloc-001"}},
+		},
+		{
+			name: "unsupported resource type and nil event",
+			events: []typeauditevent.AwsEvent{
+				event([2]string{"AWS::IAM::User", "user"}),
+				{Event: nil},
+			},
+			want: map[enumor.CloudResourceType][]string{},
+		},
+	}
+
+	for _, c := range cases {
+		changed := make(map[enumor.CloudResourceType][]string)
+		collectChangedResource(changed, c.events)
+		if !reflect.DeepEqual(changed, c.want) {
+			t.Errorf("%s: want: %v, got: %v", c.name, c.want, changed)
+		}
+	}
+}
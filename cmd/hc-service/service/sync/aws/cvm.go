@@ -36,7 +36,7 @@ import (
 
 // SyncCvmWithRelRes ....
 func (svc *service) SyncCvmWithRelRes(cts *rest.Contexts) (interface{}, error) {
-	return nil, handler.ResourceSync(cts, &cvmHandler{cli: svc.syncCli})
+	return handler.ResourceSync(cts, &cvmHandler{cli: svc.syncCli})
 }
 
 // cvmHandler cvm sync handler.
@@ -50,6 +50,7 @@ type cvmHandler struct {
 }
 
 var _ handler.Handler = new(cvmHandler)
+var _ handler.TargetHandler = new(cvmHandler)
 
 // Prepare ...
 func (hd *cvmHandler) Prepare(cts *rest.Contexts) error {
@@ -123,3 +124,8 @@ func (hd *cvmHandler) RemoveDeleteFromCloud(kt *kit.Kit) error {
 func (hd *cvmHandler) Name() enumor.CloudResourceType {
 	return enumor.CvmCloudResType
 }
+
+// TargetCloudIDs ...
+func (hd *cvmHandler) TargetCloudIDs() []string {
+	return hd.request.CloudIDs
+}
@@ -36,7 +36,7 @@ import (
 
 // SyncDisk ....
 func (svc *service) SyncDisk(cts *rest.Contexts) (interface{}, error) {
-	return nil, handler.ResourceSync(cts, &diskHandler{cli: svc.syncCli})
+	return handler.ResourceSync(cts, &diskHandler{cli: svc.syncCli})
 }
 
 // diskHandler disk sync handler.
@@ -50,6 +50,7 @@ type diskHandler struct {
 }
 
 var _ handler.Handler = new(diskHandler)
+var _ handler.TargetHandler = new(diskHandler)
 
 // Prepare ...
 func (hd *diskHandler) Prepare(cts *rest.Contexts) error {
@@ -123,3 +124,8 @@ func (hd *diskHandler) RemoveDeleteFromCloud(kt *kit.Kit) error {
 func (hd *diskHandler) Name() enumor.CloudResourceType {
 	return enumor.DiskCloudResType
 }
+
+// TargetCloudIDs ...
+func (hd *diskHandler) TargetCloudIDs() []string {
+	return hd.request.CloudIDs
+}
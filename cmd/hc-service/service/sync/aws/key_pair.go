@@ -0,0 +1,49 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"hcm/cmd/hc-service/logics/res-sync/aws"
+	dryrun "hcm/cmd/hc-service/logics/res-sync/dry-run"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// SyncKeyPair 同步地域下的密钥对，dry-run 同步时返回同步漂移报告
+func (svc *service) SyncKeyPair(cts *rest.Contexts) (interface{}, error) {
+	req, syncCli, err := defaultPrepare(cts, svc.syncCli)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &aws.SyncKeyPairOption{AccountID: req.AccountID, Region: req.Region}
+	if _, err = syncCli.KeyPair(cts.Kit, opt); err != nil {
+		logs.Errorf("sync aws key pair failed, err: %v, opt: %v, rid: %s", err, opt, cts.Kit.Rid)
+		return nil, err
+	}
+
+	rec, dryRun := dryrun.FromKit(cts.Kit)
+	if !dryRun {
+		return nil, nil
+	}
+
+	return rec.Report(cts.Kit, enumor.KeyPairCloudResType)
+}
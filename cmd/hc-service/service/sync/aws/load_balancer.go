@@ -0,0 +1,136 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	ressync "hcm/cmd/hc-service/logics/res-sync"
+	"hcm/cmd/hc-service/logics/res-sync/aws"
+	"hcm/cmd/hc-service/service/sync/handler"
+	typeslb "hcm/pkg/adaptor/types/load-balancer"
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/converter"
+)
+
+// SyncLoadBalancer 同步负载均衡接口，包含负载均衡下的监听器
+func (svc *service) SyncLoadBalancer(cts *rest.Contexts) (interface{}, error) {
+	return handler.ResourceSync(cts, &lbHandler{cli: svc.syncCli})
+}
+
+// lbHandler lb sync handler.
+type lbHandler struct {
+	cli ressync.Interface
+
+	request *sync.AwsSyncReq
+	syncCli aws.Interface
+	marker  *string
+	done    bool
+}
+
+var _ handler.Handler = new(lbHandler)
+var _ handler.TargetHandler = new(lbHandler)
+
+// Prepare ...
+func (hd *lbHandler) Prepare(cts *rest.Contexts) error {
+	request, syncCli, err := defaultPrepare(cts, hd.cli)
+	if err != nil {
+		return err
+	}
+
+	hd.request = request
+	hd.syncCli = syncCli
+
+	return nil
+}
+
+// Next ...
+func (hd *lbHandler) Next(kt *kit.Kit) ([]string, error) {
+	if hd.done {
+		return nil, nil
+	}
+
+	listOpt := &typeslb.AwsListOption{
+		Region: hd.request.Region,
+		Page: &typeslb.AwsElbPage{
+			Marker:   hd.marker,
+			PageSize: converter.ValToPtr(int64(typeslb.AwsElbQueryLimit)),
+		},
+	}
+	lbResult, err := hd.syncCli.CloudCli().ListLoadBalancer(kt, listOpt)
+	if err != nil {
+		logs.Errorf("request adaptor list aws load balancer failed, err: %v, opt: %v, rid: %s", err, listOpt, kt.Rid)
+		return nil, err
+	}
+
+	if lbResult.NextMarker == nil || len(*lbResult.NextMarker) == 0 {
+		hd.done = true
+	}
+	hd.marker = lbResult.NextMarker
+
+	if len(lbResult.Details) == 0 {
+		return nil, nil
+	}
+
+	cloudIDs := make([]string, 0, len(lbResult.Details))
+	for _, one := range lbResult.Details {
+		cloudIDs = append(cloudIDs, one.GetCloudID())
+	}
+
+	return cloudIDs, nil
+}
+
+// Sync ...
+func (hd *lbHandler) Sync(kt *kit.Kit, cloudIDs []string) error {
+	params := &aws.SyncBaseParams{
+		AccountID: hd.request.AccountID,
+		Region:    hd.request.Region,
+		CloudIDs:  cloudIDs,
+	}
+	if _, err := hd.syncCli.LoadBalancerWithListener(kt, params, new(aws.SyncLBOption)); err != nil {
+		logs.Errorf("sync aws load balancer with rel failed, err: %v, opt: %v, rid: %s", err, params, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// RemoveDeleteFromCloud ...
+func (hd *lbHandler) RemoveDeleteFromCloud(kt *kit.Kit) error {
+	if err := hd.syncCli.RemoveLoadBalancerDeleteFromCloud(kt, hd.request.AccountID, hd.request.Region); err != nil {
+		logs.Errorf("remove load balancer delete from cloud failed, err: %v, accountID: %s, region: %s, rid: %s", err,
+			hd.request.AccountID, hd.request.Region, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// Name load_balancer
+func (hd *lbHandler) Name() enumor.CloudResourceType {
+	return enumor.LoadBalancerCloudResType
+}
+
+// TargetCloudIDs ...
+func (hd *lbHandler) TargetCloudIDs() []string {
+	return hd.request.CloudIDs
+}
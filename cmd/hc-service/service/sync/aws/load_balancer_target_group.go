@@ -0,0 +1,136 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	ressync "hcm/cmd/hc-service/logics/res-sync"
+	"hcm/cmd/hc-service/logics/res-sync/aws"
+	"hcm/cmd/hc-service/service/sync/handler"
+	typeslb "hcm/pkg/adaptor/types/load-balancer"
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/converter"
+)
+
+// SyncTargetGroup 同步目标组接口
+func (svc *service) SyncTargetGroup(cts *rest.Contexts) (interface{}, error) {
+	return handler.ResourceSync(cts, &targetGroupHandler{cli: svc.syncCli})
+}
+
+// targetGroupHandler target group sync handler.
+type targetGroupHandler struct {
+	cli ressync.Interface
+
+	request *sync.AwsSyncReq
+	syncCli aws.Interface
+	marker  *string
+	done    bool
+}
+
+var _ handler.Handler = new(targetGroupHandler)
+var _ handler.TargetHandler = new(targetGroupHandler)
+
+// Prepare ...
+func (hd *targetGroupHandler) Prepare(cts *rest.Contexts) error {
+	request, syncCli, err := defaultPrepare(cts, hd.cli)
+	if err != nil {
+		return err
+	}
+
+	hd.request = request
+	hd.syncCli = syncCli
+
+	return nil
+}
+
+// Next ...
+func (hd *targetGroupHandler) Next(kt *kit.Kit) ([]string, error) {
+	if hd.done {
+		return nil, nil
+	}
+
+	listOpt := &typeslb.AwsListTargetGroupOption{
+		Region: hd.request.Region,
+		Page: &typeslb.AwsElbPage{
+			Marker:   hd.marker,
+			PageSize: converter.ValToPtr(int64(typeslb.AwsElbQueryLimit)),
+		},
+	}
+	tgResult, err := hd.syncCli.CloudCli().ListTargetGroup(kt, listOpt)
+	if err != nil {
+		logs.Errorf("request adaptor list aws target group failed, err: %v, opt: %v, rid: %s", err, listOpt, kt.Rid)
+		return nil, err
+	}
+
+	if tgResult.NextMarker == nil || len(*tgResult.NextMarker) == 0 {
+		hd.done = true
+	}
+	hd.marker = tgResult.NextMarker
+
+	if len(tgResult.Details) == 0 {
+		return nil, nil
+	}
+
+	cloudIDs := make([]string, 0, len(tgResult.Details))
+	for _, one := range tgResult.Details {
+		cloudIDs = append(cloudIDs, one.GetCloudID())
+	}
+
+	return cloudIDs, nil
+}
+
+// Sync ...
+func (hd *targetGroupHandler) Sync(kt *kit.Kit, cloudIDs []string) error {
+	params := &aws.SyncBaseParams{
+		AccountID: hd.request.AccountID,
+		Region:    hd.request.Region,
+		CloudIDs:  cloudIDs,
+	}
+	if _, err := hd.syncCli.TargetGroup(kt, params, new(aws.SyncTargetGroupOption)); err != nil {
+		logs.Errorf("sync aws target group failed, err: %v, opt: %v, rid: %s", err, params, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// RemoveDeleteFromCloud ...
+func (hd *targetGroupHandler) RemoveDeleteFromCloud(kt *kit.Kit) error {
+	if err := hd.syncCli.RemoveTargetGroupDeleteFromCloud(kt, hd.request.AccountID, hd.request.Region); err != nil {
+		logs.Errorf("remove target group delete from cloud failed, err: %v, accountID: %s, region: %s, rid: %s", err,
+			hd.request.AccountID, hd.request.Region, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// Name target_group
+func (hd *targetGroupHandler) Name() enumor.CloudResourceType {
+	return enumor.TargetGroupCloudResType
+}
+
+// TargetCloudIDs ...
+func (hd *targetGroupHandler) TargetCloudIDs() []string {
+	return hd.request.CloudIDs
+}
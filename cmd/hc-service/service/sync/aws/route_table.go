@@ -27,5 +27,5 @@ import (
 
 // SyncRouteTable ....
 func (svc *service) SyncRouteTable(cts *rest.Contexts) (interface{}, error) {
-	return nil, handler.ResourceSync(cts, &logicsrt.AwsRouteTableHandler{Cli: svc.syncCli})
+	return handler.ResourceSync(cts, &logicsrt.AwsRouteTableHandler{Cli: svc.syncCli})
 }
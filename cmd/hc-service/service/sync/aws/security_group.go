@@ -36,7 +36,7 @@ import (
 
 // SyncSecurityGroup ....
 func (svc *service) SyncSecurityGroup(cts *rest.Contexts) (interface{}, error) {
-	return nil, handler.ResourceSync(cts, &sgHandler{cli: svc.syncCli})
+	return handler.ResourceSync(cts, &sgHandler{cli: svc.syncCli})
 }
 
 // sgHandler sg sync handler.
@@ -50,6 +50,7 @@ type sgHandler struct {
 }
 
 var _ handler.Handler = new(sgHandler)
+var _ handler.TargetHandler = new(sgHandler)
 
 // Prepare ...
 func (hd *sgHandler) Prepare(cts *rest.Contexts) error {
@@ -123,3 +124,8 @@ func (hd *sgHandler) RemoveDeleteFromCloud(kt *kit.Kit) error {
 func (hd *sgHandler) Name() enumor.CloudResourceType {
 	return enumor.SecurityGroupCloudResType
 }
+
+// TargetCloudIDs ...
+func (hd *sgHandler) TargetCloudIDs() []string {
+	return hd.request.CloudIDs
+}
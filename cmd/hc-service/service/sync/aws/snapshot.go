@@ -0,0 +1,138 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	ressync "hcm/cmd/hc-service/logics/res-sync"
+	"hcm/cmd/hc-service/logics/res-sync/aws"
+	"hcm/cmd/hc-service/service/sync/handler"
+	typecore "hcm/pkg/adaptor/types/core"
+	typessnapshot "hcm/pkg/adaptor/types/snapshot"
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/converter"
+)
+
+// SyncSnapshot 同步快照接口
+func (svc *service) SyncSnapshot(cts *rest.Contexts) (interface{}, error) {
+	return handler.ResourceSync(cts, &snapshotHandler{cli: svc.syncCli})
+}
+
+// snapshotHandler snapshot sync handler.
+type snapshotHandler struct {
+	cli ressync.Interface
+
+	request   *sync.AwsSyncReq
+	syncCli   aws.Interface
+	nextToken *string
+	done      bool
+}
+
+var _ handler.Handler = new(snapshotHandler)
+var _ handler.TargetHandler = new(snapshotHandler)
+
+// Prepare ...
+func (hd *snapshotHandler) Prepare(cts *rest.Contexts) error {
+	request, syncCli, err := defaultPrepare(cts, hd.cli)
+	if err != nil {
+		return err
+	}
+
+	hd.request = request
+	hd.syncCli = syncCli
+
+	return nil
+}
+
+// Next ...
+func (hd *snapshotHandler) Next(kt *kit.Kit) ([]string, error) {
+	if hd.done {
+		return nil, nil
+	}
+
+	listOpt := &typessnapshot.AwsSnapshotListOption{
+		Region: hd.request.Region,
+		Page: &typecore.AwsPage{
+			MaxResults: converter.ValToPtr(int64(constant.CloudResourceSyncMaxLimit)),
+			NextToken:  hd.nextToken,
+		},
+	}
+	snapshots, nextToken, err := hd.syncCli.CloudCli().ListSnapshot(kt, listOpt)
+	if err != nil {
+		logs.Errorf("request adaptor list aws snapshot failed, err: %v, opt: %v, rid: %s", err, listOpt, kt.Rid)
+		return nil, err
+	}
+
+	if nextToken == nil || len(*nextToken) == 0 {
+		hd.done = true
+	}
+	hd.nextToken = nextToken
+
+	if len(snapshots) == 0 {
+		return nil, nil
+	}
+
+	cloudIDs := make([]string, 0, len(snapshots))
+	for _, one := range snapshots {
+		cloudIDs = append(cloudIDs, one.GetCloudID())
+	}
+
+	return cloudIDs, nil
+}
+
+// Sync ...
+func (hd *snapshotHandler) Sync(kt *kit.Kit, cloudIDs []string) error {
+	params := &aws.SyncBaseParams{
+		AccountID: hd.request.AccountID,
+		Region:    hd.request.Region,
+		CloudIDs:  cloudIDs,
+	}
+	if _, err := hd.syncCli.Snapshot(kt, params, new(aws.SyncSnapshotOption)); err != nil {
+		logs.Errorf("sync aws snapshot failed, err: %v, opt: %v, rid: %s", err, params, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// RemoveDeleteFromCloud ...
+func (hd *snapshotHandler) RemoveDeleteFromCloud(kt *kit.Kit) error {
+	if err := hd.syncCli.RemoveSnapshotDeleteFromCloud(kt, hd.request.AccountID, hd.request.Region); err != nil {
+		logs.Errorf("remove snapshot delete from cloud failed, err: %v, accountID: %s, region: %s, rid: %s", err,
+			hd.request.AccountID, hd.request.Region, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// Name snapshot
+func (hd *snapshotHandler) Name() enumor.CloudResourceType {
+	return enumor.SnapshotCloudResType
+}
+
+// TargetCloudIDs ...
+func (hd *snapshotHandler) TargetCloudIDs() []string {
+	return hd.request.CloudIDs
+}
@@ -22,7 +22,9 @@ package azure
 import (
 	ressync "hcm/cmd/hc-service/logics/res-sync"
 	"hcm/cmd/hc-service/logics/res-sync/azure"
+	dryrun "hcm/cmd/hc-service/logics/res-sync/dry-run"
 	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/criteria/errf"
 	"hcm/pkg/rest"
 )
@@ -37,6 +39,10 @@ func defaultPrepare(cts *rest.Contexts, cli ressync.Interface) (*sync.AzureSyncR
 		return nil, nil, errf.NewFromErr(errf.InvalidParameter, err)
 	}
 
+	if req.DryRun {
+		dryrun.WithRecorder(cts.Kit, cli.DryRunRecorder(enumor.Azure, req.AccountID, req.ResourceGroupName))
+	}
+
 	syncCli, err := cli.Azure(cts.Kit, req.AccountID)
 	if err != nil {
 		return nil, nil, err
@@ -41,7 +41,7 @@ import (
 
 // SyncEip ....
 func (svc *service) SyncEip(cts *rest.Contexts) (interface{}, error) {
-	return nil, handler.ResourceSync(cts, &eipHandler{cli: svc.syncCli})
+	return handler.ResourceSync(cts, &eipHandler{cli: svc.syncCli})
 }
 
 // eipHandler eip sync handler.
@@ -41,7 +41,7 @@ import (
 
 // SyncRouteTable ....
 func (svc *service) SyncRouteTable(cts *rest.Contexts) (interface{}, error) {
-	return nil, handler.ResourceSync(cts, &routeTableHandler{cli: svc.syncCli})
+	return handler.ResourceSync(cts, &routeTableHandler{cli: svc.syncCli})
 }
 
 // routeTableHandler routeTable sync handler.
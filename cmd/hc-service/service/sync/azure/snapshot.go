@@ -0,0 +1,129 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	ressync "hcm/cmd/hc-service/logics/res-sync"
+	"hcm/cmd/hc-service/logics/res-sync/azure"
+	"hcm/cmd/hc-service/service/sync/handler"
+	typessnapshot "hcm/pkg/adaptor/types/snapshot"
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// SyncSnapshot 同步快照接口
+func (svc *service) SyncSnapshot(cts *rest.Contexts) (interface{}, error) {
+	return handler.ResourceSync(cts, &snapshotHandler{cli: svc.syncCli})
+}
+
+// snapshotHandler snapshot sync handler.
+type snapshotHandler struct {
+	cli ressync.Interface
+
+	// Prepare 构建参数
+	request *sync.AzureSyncReq
+	syncCli azure.Interface
+	// cloudIDs 资源组下全部快照ID，azure 快照查询不支持分页，在 Prepare 阶段一次查出
+	cloudIDs []string
+	offset   int
+}
+
+var _ handler.Handler = new(snapshotHandler)
+
+// Prepare ...
+func (hd *snapshotHandler) Prepare(cts *rest.Contexts) error {
+	request, syncCli, err := defaultPrepare(cts, hd.cli)
+	if err != nil {
+		return err
+	}
+
+	hd.request = request
+	hd.syncCli = syncCli
+
+	listOpt := &typessnapshot.AzureSnapshotListOption{
+		ResourceGroupName: hd.request.ResourceGroupName,
+	}
+	snapshots, err := hd.syncCli.CloudCli().ListSnapshot(cts.Kit, listOpt)
+	if err != nil {
+		logs.Errorf("request adaptor list azure snapshot failed, err: %v, opt: %v, rid: %s", err, listOpt,
+			cts.Kit.Rid)
+		return err
+	}
+
+	hd.cloudIDs = make([]string, 0, len(snapshots))
+	for _, one := range snapshots {
+		hd.cloudIDs = append(hd.cloudIDs, one.GetCloudID())
+	}
+
+	return nil
+}
+
+// Next ...
+func (hd *snapshotHandler) Next(kt *kit.Kit) ([]string, error) {
+	if hd.offset >= len(hd.cloudIDs) {
+		return nil, nil
+	}
+
+	end := hd.offset + constant.CloudResourceSyncMaxLimit
+	if end > len(hd.cloudIDs) {
+		end = len(hd.cloudIDs)
+	}
+
+	cloudIDs := hd.cloudIDs[hd.offset:end]
+	hd.offset = end
+
+	return cloudIDs, nil
+}
+
+// Sync ...
+func (hd *snapshotHandler) Sync(kt *kit.Kit, cloudIDs []string) error {
+	params := &azure.SyncBaseParams{
+		AccountID:         hd.request.AccountID,
+		ResourceGroupName: hd.request.ResourceGroupName,
+		CloudIDs:          cloudIDs,
+	}
+	if _, err := hd.syncCli.Snapshot(kt, params, new(azure.SyncSnapshotOption)); err != nil {
+		logs.Errorf("sync azure snapshot failed, err: %v, opt: %v, rid: %s", err, params, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// RemoveDeleteFromCloud ...
+func (hd *snapshotHandler) RemoveDeleteFromCloud(kt *kit.Kit) error {
+	err := hd.syncCli.RemoveSnapshotDeleteFromCloud(kt, hd.request.AccountID, hd.request.ResourceGroupName)
+	if err != nil {
+		logs.Errorf("remove snapshot delete from cloud failed, err: %v, accountID: %s, resGroupName: %s, "+
+			"rid: %s", err, hd.request.AccountID, hd.request.ResourceGroupName, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// Name snapshot
+func (hd *snapshotHandler) Name() enumor.CloudResourceType {
+	return enumor.SnapshotCloudResType
+}
@@ -0,0 +1,142 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gcp
+
+import (
+	ressync "hcm/cmd/hc-service/logics/res-sync"
+	"hcm/cmd/hc-service/logics/res-sync/gcp"
+	"hcm/cmd/hc-service/service/sync/handler"
+	typecore "hcm/pkg/adaptor/types/core"
+	typesdbinstance "hcm/pkg/adaptor/types/database-instance"
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// SyncDatabaseInstance 同步云数据库实例接口
+func (svc *service) SyncDatabaseInstance(cts *rest.Contexts) (interface{}, error) {
+	return handler.ResourceSync(cts, &databaseInstanceHandler{cli: svc.syncCli})
+}
+
+// databaseInstanceHandler database instance sync handler.
+type databaseInstanceHandler struct {
+	cli ressync.Interface
+
+	// Prepare 构建参数
+	request *sync.GcpSyncReq
+	syncCli gcp.Interface
+	// cloudIDs 地域下全部云数据库实例ID，在 Prepare 阶段查出
+	cloudIDs []string
+	offset   int
+}
+
+var _ handler.Handler = new(databaseInstanceHandler)
+
+// Prepare ...
+func (hd *databaseInstanceHandler) Prepare(cts *rest.Contexts) error {
+	request, syncCli, err := defaultPrepare(cts, hd.cli)
+	if err != nil {
+		return err
+	}
+
+	hd.request = request
+	hd.syncCli = syncCli
+
+	listOpt := &typesdbinstance.GcpDBInstanceListOption{
+		Page: &typecore.GcpPage{PageSize: constant.CloudResourceSyncMaxLimit},
+	}
+	hd.cloudIDs = make([]string, 0)
+	for {
+		items, nextToken, err := hd.syncCli.CloudCli().ListDatabaseInstance(cts.Kit, listOpt)
+		if err != nil {
+			logs.Errorf("request adaptor list gcp database instance failed, err: %v, opt: %v, rid: %s", err, listOpt,
+				cts.Kit.Rid)
+			return err
+		}
+
+		// Cloud SQL 实例按项目列出，只保留当前地域的实例
+		for _, one := range items {
+			if one.Region != hd.request.Region {
+				continue
+			}
+			hd.cloudIDs = append(hd.cloudIDs, one.GetCloudID())
+		}
+
+		if len(nextToken) == 0 {
+			break
+		}
+		listOpt.Page.PageToken = nextToken
+	}
+
+	return nil
+}
+
+// Next ...
+func (hd *databaseInstanceHandler) Next(kt *kit.Kit) ([]string, error) {
+	if hd.offset >= len(hd.cloudIDs) {
+		return nil, nil
+	}
+
+	end := hd.offset + constant.CloudResourceSyncMaxLimit
+	if end > len(hd.cloudIDs) {
+		end = len(hd.cloudIDs)
+	}
+
+	cloudIDs := hd.cloudIDs[hd.offset:end]
+	hd.offset = end
+
+	return cloudIDs, nil
+}
+
+// Sync ...
+func (hd *databaseInstanceHandler) Sync(kt *kit.Kit, cloudIDs []string) error {
+	params := &gcp.SyncBaseParams{
+		AccountID: hd.request.AccountID,
+		CloudIDs:  cloudIDs,
+	}
+	opt := &gcp.SyncDatabaseInstanceOption{
+		Region: hd.request.Region,
+	}
+	if _, err := hd.syncCli.DatabaseInstance(kt, params, opt); err != nil {
+		logs.Errorf("sync gcp database instance failed, err: %v, opt: %v, rid: %s", err, params, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// RemoveDeleteFromCloud ...
+func (hd *databaseInstanceHandler) RemoveDeleteFromCloud(kt *kit.Kit) error {
+	if err := hd.syncCli.RemoveDatabaseInstanceDeleteFromCloud(kt, hd.request.AccountID, hd.request.Region); err != nil {
+		logs.Errorf("remove database instance delete from cloud failed, err: %v, accountID: %s, region: %s, rid: %s",
+			err, hd.request.AccountID, hd.request.Region, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// Name database instance
+func (hd *databaseInstanceHandler) Name() enumor.CloudResourceType {
+	return enumor.DatabaseInstanceCloudResType
+}
@@ -22,8 +22,10 @@ package gcp
 
 import (
 	ressync "hcm/cmd/hc-service/logics/res-sync"
+	dryrun "hcm/cmd/hc-service/logics/res-sync/dry-run"
 	"hcm/cmd/hc-service/logics/res-sync/gcp"
 	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/criteria/errf"
 	"hcm/pkg/rest"
 )
@@ -38,6 +40,10 @@ func defaultPrepare(cts *rest.Contexts, cli ressync.Interface) (*sync.GcpSyncReq
 		return nil, nil, errf.NewFromErr(errf.InvalidParameter, err)
 	}
 
+	if req.DryRun {
+		dryrun.WithRecorder(cts.Kit, cli.DryRunRecorder(enumor.Gcp, req.AccountID, req.Region))
+	}
+
 	syncCli, err := cli.Gcp(cts.Kit, req.AccountID)
 	if err != nil {
 		return nil, nil, err
@@ -41,7 +41,7 @@ func (svc *service) SyncImage(cts *rest.Contexts) (interface{}, error) {
 	for index, projectID := range adaptorgcp.PublicImagePlatforms {
 		imageHandler.index = index
 		imageHandler.projectID = projectID
-		err := handler.ResourceSync(cts, imageHandler)
+		_, err := handler.ResourceSync(cts, imageHandler)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,139 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gcp
+
+import (
+	ressync "hcm/cmd/hc-service/logics/res-sync"
+	"hcm/cmd/hc-service/logics/res-sync/gcp"
+	"hcm/cmd/hc-service/service/sync/handler"
+	typecore "hcm/pkg/adaptor/types/core"
+	typesnatgateway "hcm/pkg/adaptor/types/nat-gateway"
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// SyncNatGateway 同步NAT网关接口
+func (svc *service) SyncNatGateway(cts *rest.Contexts) (interface{}, error) {
+	return handler.ResourceSync(cts, &natGatewayHandler{cli: svc.syncCli})
+}
+
+// natGatewayHandler nat gateway sync handler.
+type natGatewayHandler struct {
+	cli ressync.Interface
+
+	// Prepare 构建参数
+	request *sync.GcpSyncReq
+	syncCli gcp.Interface
+	// cloudIDs 地域下全部NAT网关ID，一个路由器下可能有多个NAT，在 Prepare 阶段查出
+	cloudIDs []string
+	offset   int
+}
+
+var _ handler.Handler = new(natGatewayHandler)
+
+// Prepare ...
+func (hd *natGatewayHandler) Prepare(cts *rest.Contexts) error {
+	request, syncCli, err := defaultPrepare(cts, hd.cli)
+	if err != nil {
+		return err
+	}
+
+	hd.request = request
+	hd.syncCli = syncCli
+
+	listOpt := &typesnatgateway.GcpNatGatewayListOption{
+		Region: hd.request.Region,
+		Page:   &typecore.GcpPage{PageSize: constant.CloudResourceSyncMaxLimit},
+	}
+	hd.cloudIDs = make([]string, 0)
+	for {
+		items, nextToken, err := hd.syncCli.CloudCli().ListNatGateway(cts.Kit, listOpt)
+		if err != nil {
+			logs.Errorf("request adaptor list gcp nat gateway failed, err: %v, opt: %v, rid: %s", err, listOpt,
+				cts.Kit.Rid)
+			return err
+		}
+
+		for _, one := range items {
+			hd.cloudIDs = append(hd.cloudIDs, one.GetCloudID())
+		}
+
+		if len(nextToken) == 0 {
+			break
+		}
+		listOpt.Page.PageToken = nextToken
+	}
+
+	return nil
+}
+
+// Next ...
+func (hd *natGatewayHandler) Next(kt *kit.Kit) ([]string, error) {
+	if hd.offset >= len(hd.cloudIDs) {
+		return nil, nil
+	}
+
+	end := hd.offset + constant.CloudResourceSyncMaxLimit
+	if end > len(hd.cloudIDs) {
+		end = len(hd.cloudIDs)
+	}
+
+	cloudIDs := hd.cloudIDs[hd.offset:end]
+	hd.offset = end
+
+	return cloudIDs, nil
+}
+
+// Sync ...
+func (hd *natGatewayHandler) Sync(kt *kit.Kit, cloudIDs []string) error {
+	params := &gcp.SyncBaseParams{
+		AccountID: hd.request.AccountID,
+		CloudIDs:  cloudIDs,
+	}
+	opt := &gcp.SyncNatGatewayOption{
+		Region: hd.request.Region,
+	}
+	if _, err := hd.syncCli.NatGateway(kt, params, opt); err != nil {
+		logs.Errorf("sync gcp nat gateway failed, err: %v, opt: %v, rid: %s", err, params, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// RemoveDeleteFromCloud ...
+func (hd *natGatewayHandler) RemoveDeleteFromCloud(kt *kit.Kit) error {
+	if err := hd.syncCli.RemoveNatGatewayDeleteFromCloud(kt, hd.request.AccountID, hd.request.Region); err != nil {
+		logs.Errorf("remove nat gateway delete from cloud failed, err: %v, accountID: %s, region: %s, rid: %s",
+			err, hd.request.AccountID, hd.request.Region, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// Name nat gateway
+func (hd *natGatewayHandler) Name() enumor.CloudResourceType {
+	return enumor.NatGatewayCloudResType
+}
@@ -36,7 +36,7 @@ import (
 
 // SyncRoute ....
 func (svc *service) SyncRoute(cts *rest.Contexts) (interface{}, error) {
-	return nil, handler.ResourceSync(cts, &routeHandler{cli: svc.syncCli})
+	return handler.ResourceSync(cts, &routeHandler{cli: svc.syncCli})
 }
 
 // routeHandler route sync handler.
@@ -0,0 +1,140 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gcp
+
+import (
+	ressync "hcm/cmd/hc-service/logics/res-sync"
+	"hcm/cmd/hc-service/logics/res-sync/gcp"
+	"hcm/cmd/hc-service/service/sync/handler"
+	typecore "hcm/pkg/adaptor/types/core"
+	typessnapshot "hcm/pkg/adaptor/types/snapshot"
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// SyncSnapshot 同步快照接口，gcp 快照为全局资源，不区分地域
+func (svc *service) SyncSnapshot(cts *rest.Contexts) (interface{}, error) {
+	return handler.ResourceSync(cts, &snapshotHandler{cli: svc.syncCli})
+}
+
+// snapshotHandler snapshot sync handler.
+type snapshotHandler struct {
+	cli ressync.Interface
+
+	// Prepare 构建参数
+	request   *sync.GcpGlobalSyncReq
+	syncCli   gcp.Interface
+	pageToken string
+	done      bool
+}
+
+var _ handler.Handler = new(snapshotHandler)
+
+// Prepare ...
+func (hd *snapshotHandler) Prepare(cts *rest.Contexts) error {
+	req := new(sync.GcpGlobalSyncReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	syncCli, err := hd.cli.Gcp(cts.Kit, req.AccountID)
+	if err != nil {
+		return err
+	}
+
+	hd.request = req
+	hd.syncCli = syncCli
+
+	return nil
+}
+
+// Next ...
+func (hd *snapshotHandler) Next(kt *kit.Kit) ([]string, error) {
+	if hd.done {
+		return nil, nil
+	}
+
+	listOpt := &typessnapshot.GcpSnapshotListOption{
+		Page: &typecore.GcpPage{
+			PageSize:  constant.CloudResourceSyncMaxLimit,
+			PageToken: hd.pageToken,
+		},
+	}
+	snapshots, nextToken, err := hd.syncCli.CloudCli().ListSnapshot(kt, listOpt)
+	if err != nil {
+		logs.Errorf("request adaptor list gcp snapshot failed, err: %v, opt: %v, rid: %s", err, listOpt, kt.Rid)
+		return nil, err
+	}
+
+	hd.pageToken = nextToken
+	if len(hd.pageToken) == 0 {
+		hd.done = true
+	}
+
+	if len(snapshots) == 0 {
+		return nil, nil
+	}
+
+	cloudIDs := make([]string, 0, len(snapshots))
+	for _, one := range snapshots {
+		cloudIDs = append(cloudIDs, one.GetCloudID())
+	}
+
+	return cloudIDs, nil
+}
+
+// Sync ...
+func (hd *snapshotHandler) Sync(kt *kit.Kit, cloudIDs []string) error {
+	params := &gcp.SyncBaseParams{
+		AccountID: hd.request.AccountID,
+		CloudIDs:  cloudIDs,
+	}
+	if _, err := hd.syncCli.Snapshot(kt, params, new(gcp.SyncSnapshotOption)); err != nil {
+		logs.Errorf("sync gcp snapshot failed, err: %v, opt: %v, rid: %s", err, params, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// RemoveDeleteFromCloud ...
+func (hd *snapshotHandler) RemoveDeleteFromCloud(kt *kit.Kit) error {
+	if err := hd.syncCli.RemoveSnapshotDeleteFromCloud(kt, hd.request.AccountID); err != nil {
+		logs.Errorf("remove snapshot delete from cloud failed, err: %v, accountID: %s, rid: %s", err,
+			hd.request.AccountID, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// Name snapshot
+func (hd *snapshotHandler) Name() enumor.CloudResourceType {
+	return enumor.SnapshotCloudResType
+}
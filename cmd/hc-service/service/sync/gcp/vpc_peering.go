@@ -0,0 +1,145 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gcp
+
+import (
+	ressync "hcm/cmd/hc-service/logics/res-sync"
+	"hcm/cmd/hc-service/logics/res-sync/gcp"
+	"hcm/cmd/hc-service/service/sync/handler"
+	typecore "hcm/pkg/adaptor/types/core"
+	typesvpcpeering "hcm/pkg/adaptor/types/vpc-peering"
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// SyncVpcPeering 同步对等连接接口，gcp 对等连接为全局资源，不区分地域
+func (svc *service) SyncVpcPeering(cts *rest.Contexts) (interface{}, error) {
+	return handler.ResourceSync(cts, &vpcPeeringHandler{cli: svc.syncCli})
+}
+
+// vpcPeeringHandler vpc peering sync handler.
+type vpcPeeringHandler struct {
+	cli ressync.Interface
+
+	// Prepare 构建参数
+	request *sync.GcpGlobalSyncReq
+	syncCli gcp.Interface
+	// cloudIDs 全部对等连接ID，一个VPC网络下可能有多个对等连接，在 Prepare 阶段查出
+	cloudIDs []string
+	offset   int
+}
+
+var _ handler.Handler = new(vpcPeeringHandler)
+
+// Prepare ...
+func (hd *vpcPeeringHandler) Prepare(cts *rest.Contexts) error {
+	req := new(sync.GcpGlobalSyncReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	syncCli, err := hd.cli.Gcp(cts.Kit, req.AccountID)
+	if err != nil {
+		return err
+	}
+
+	hd.request = req
+	hd.syncCli = syncCli
+
+	listOpt := &typesvpcpeering.GcpVpcPeeringListOption{
+		Page: &typecore.GcpPage{PageSize: constant.CloudResourceSyncMaxLimit},
+	}
+	hd.cloudIDs = make([]string, 0)
+	for {
+		items, nextToken, err := hd.syncCli.CloudCli().ListVpcPeering(cts.Kit, listOpt)
+		if err != nil {
+			logs.Errorf("request adaptor list gcp vpc peering failed, err: %v, opt: %v, rid: %s", err, listOpt,
+				cts.Kit.Rid)
+			return err
+		}
+
+		for _, one := range items {
+			hd.cloudIDs = append(hd.cloudIDs, one.GetCloudID())
+		}
+
+		if len(nextToken) == 0 {
+			break
+		}
+		listOpt.Page.PageToken = nextToken
+	}
+
+	return nil
+}
+
+// Next ...
+func (hd *vpcPeeringHandler) Next(kt *kit.Kit) ([]string, error) {
+	if hd.offset >= len(hd.cloudIDs) {
+		return nil, nil
+	}
+
+	end := hd.offset + constant.CloudResourceSyncMaxLimit
+	if end > len(hd.cloudIDs) {
+		end = len(hd.cloudIDs)
+	}
+
+	cloudIDs := hd.cloudIDs[hd.offset:end]
+	hd.offset = end
+
+	return cloudIDs, nil
+}
+
+// Sync ...
+func (hd *vpcPeeringHandler) Sync(kt *kit.Kit, cloudIDs []string) error {
+	params := &gcp.SyncBaseParams{
+		AccountID: hd.request.AccountID,
+		CloudIDs:  cloudIDs,
+	}
+	if _, err := hd.syncCli.VpcPeering(kt, params, new(gcp.SyncVpcPeeringOption)); err != nil {
+		logs.Errorf("sync gcp vpc peering failed, err: %v, opt: %v, rid: %s", err, params, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// RemoveDeleteFromCloud ...
+func (hd *vpcPeeringHandler) RemoveDeleteFromCloud(kt *kit.Kit) error {
+	if err := hd.syncCli.RemoveVpcPeeringDeleteFromCloud(kt, hd.request.AccountID); err != nil {
+		logs.Errorf("remove vpc peering delete from cloud failed, err: %v, accountID: %s, rid: %s", err,
+			hd.request.AccountID, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// Name vpc peering
+func (hd *vpcPeeringHandler) Name() enumor.CloudResourceType {
+	return enumor.VpcPeeringCloudResType
+}
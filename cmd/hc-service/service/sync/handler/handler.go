@@ -21,6 +21,8 @@
 package handler
 
 import (
+	dryrun "hcm/cmd/hc-service/logics/res-sync/dry-run"
+	coresync "hcm/pkg/api/core/cloud/sync"
 	"hcm/pkg/criteria/constant"
 	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/kit"
@@ -42,14 +44,44 @@ type Handler interface {
 	Name() enumor.CloudResourceType
 }
 
-// ResourceSync 资源同步流程。
-func ResourceSync(cts *rest.Contexts, handler Handler) error {
+// TargetHandler 定义了指定云ID同步的操作函数，由支持资源变更事件增量同步的 Handler 实现。
+type TargetHandler interface {
+	// TargetCloudIDs 返回请求中指定同步的资源云ID，为空则进行全量同步。
+	TargetCloudIDs() []string
+}
+
+// ResourceSync 资源同步流程，dry-run 同步时返回同步漂移报告。
+func ResourceSync(cts *rest.Contexts, handler Handler) (*coresync.SyncDriftReport, error) {
 	kt := cts.Kit
 
 	// 解析请求参数到handler实现中，构建同步需要的客户端
 	if err := handler.Prepare(cts); err != nil {
 		logs.Errorf("%s sync handler to prepare failed, err: %v, rid: %s", handler.Name(), err, kt.Rid)
-		return err
+		return nil, err
+	}
+
+	if err := resourceSync(kt, handler); err != nil {
+		return nil, err
+	}
+
+	// dry-run 同步时对 data-service 的写操作都已被拦截，生成并保存漂移报告
+	rec, dryRun := dryrun.FromKit(kt)
+	if !dryRun {
+		return nil, nil
+	}
+
+	return rec.Report(kt, handler.Name())
+}
+
+func resourceSync(kt *kit.Kit, handler Handler) error {
+	// 指定了云ID时只同步指定的资源，云上已删除的资源会在 Sync 对比时从db中删除
+	if target, ok := handler.(TargetHandler); ok && len(target.TargetCloudIDs()) != 0 {
+		if err := handler.Sync(kt, target.TargetCloudIDs()); err != nil {
+			logs.Errorf("%s sync handler to sync target failed, err: %v, rid: %s", handler.Name(), err, kt.Rid)
+			return err
+		}
+
+		return nil
 	}
 
 	if err := handler.RemoveDeleteFromCloud(kt); err != nil {
@@ -22,8 +22,10 @@ package huawei
 
 import (
 	ressync "hcm/cmd/hc-service/logics/res-sync"
+	dryrun "hcm/cmd/hc-service/logics/res-sync/dry-run"
 	"hcm/cmd/hc-service/logics/res-sync/huawei"
 	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/criteria/errf"
 	"hcm/pkg/rest"
 )
@@ -38,6 +40,10 @@ func defaultPrepare(cts *rest.Contexts, cli ressync.Interface) (*sync.HuaWeiSync
 		return nil, nil, errf.NewFromErr(errf.InvalidParameter, err)
 	}
 
+	if req.DryRun {
+		dryrun.WithRecorder(cts.Kit, cli.DryRunRecorder(enumor.HuaWei, req.AccountID, req.Region))
+	}
+
 	syncCli, err := cli.HuaWei(cts.Kit, req.AccountID)
 	if err != nil {
 		return nil, nil, err
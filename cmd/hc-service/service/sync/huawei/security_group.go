@@ -36,7 +36,7 @@ import (
 
 // SyncSecurityGroup ....
 func (svc *service) SyncSecurityGroup(cts *rest.Contexts) (interface{}, error) {
-	return nil, handler.ResourceSync(cts, &sgHandler{cli: svc.syncCli})
+	return handler.ResourceSync(cts, &sgHandler{cli: svc.syncCli})
 }
 
 // sgHandler sg sync handler.
@@ -51,6 +51,15 @@ func InitService(cap *capability.Capability) {
 	h.Add("SyncRegion", "POST", "/regions/sync", v.SyncRegion)
 	h.Add("SyncImage", "POST", "/images/sync", v.SyncImage)
 	h.Add("SyncSubAccount", "POST", "/sub_accounts/sync", v.SyncSubAccount)
+	h.Add("SyncBucket", "POST", "/buckets/sync", v.SyncBucket)
+	h.Add("SyncK8sCluster", "POST", "/k8s_clusters/sync", v.SyncK8sCluster)
+	h.Add("SyncKeyPair", "POST", "/key_pairs/sync", v.SyncKeyPair)
+	h.Add("SyncPrivateDnsZone", "POST", "/private_dns_zones/sync", v.SyncPrivateDnsZone)
+	h.Add("SyncLoadBalancer", "POST", "/load_balancers/sync", v.SyncLoadBalancer)
+	h.Add("SyncSnapshot", "POST", "/snapshots/sync", v.SyncSnapshot)
+	h.Add("SyncNatGateway", "POST", "/nat_gateways/sync", v.SyncNatGateway)
+	h.Add("SyncDatabaseInstance", "POST", "/database_instances/sync", v.SyncDatabaseInstance)
+	h.Add("SyncVpcPeering", "POST", "/vpc_peerings/sync", v.SyncVpcPeering)
 
 	h.Load(cap.WebService)
 }
@@ -37,7 +37,7 @@ import (
 
 // SyncSubnet ....
 func (svc *service) SyncSubnet(cts *rest.Contexts) (interface{}, error) {
-	return nil, handler.ResourceSync(cts, &subnetHandler{cli: svc.syncCli})
+	return handler.ResourceSync(cts, &subnetHandler{cli: svc.syncCli})
 }
 
 // subnetHandler subnet sync handler.
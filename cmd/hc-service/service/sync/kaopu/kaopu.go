@@ -0,0 +1,53 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package kaopu ...
+package kaopu
+
+import (
+	ressync "hcm/cmd/hc-service/logics/res-sync"
+	dryrun "hcm/cmd/hc-service/logics/res-sync/dry-run"
+	"hcm/cmd/hc-service/logics/res-sync/kaopu"
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/rest"
+)
+
+func defaultPrepare(cts *rest.Contexts, cli ressync.Interface) (*sync.KaopuSyncReq, kaopu.Interface, error) {
+	req := new(sync.KaopuSyncReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return nil, nil, errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	if req.DryRun {
+		dryrun.WithRecorder(cts.Kit, cli.DryRunRecorder(enumor.Kaopu, req.AccountID, req.Region))
+	}
+
+	syncCli, err := cli.Kaopu(cts.Kit, req.AccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return req, syncCli, nil
+}
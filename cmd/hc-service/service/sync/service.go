@@ -25,7 +25,9 @@ import (
 	"hcm/cmd/hc-service/service/sync/azure"
 	"hcm/cmd/hc-service/service/sync/gcp"
 	"hcm/cmd/hc-service/service/sync/huawei"
+	"hcm/cmd/hc-service/service/sync/kaopu"
 	"hcm/cmd/hc-service/service/sync/tcloud"
+	"hcm/cmd/hc-service/service/sync/zenlayer"
 )
 
 // InitService initial tcloud sync service
@@ -35,4 +37,6 @@ func InitService(cap *capability.Capability) {
 	gcp.InitService(cap)
 	huawei.InitService(cap)
 	azure.InitService(cap)
+	zenlayer.InitService(cap)
+	kaopu.InitService(cap)
 }
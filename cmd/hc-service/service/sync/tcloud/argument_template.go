@@ -38,24 +38,24 @@ import (
 func (svc *service) SyncArgsTpl(cts *rest.Contexts) (interface{}, error) {
 	argsTplHandler := &argsTplAddressHandler{cli: svc.syncCli}
 
-	addressErr := handler.ResourceSync(cts, argsTplHandler)
+	_, addressErr := handler.ResourceSync(cts, argsTplHandler)
 	if addressErr != nil {
 		return nil, addressErr
 	}
 
-	addressGroupErr := handler.ResourceSync(cts, &argsTplAddressGroupHandler{
+	_, addressGroupErr := handler.ResourceSync(cts, &argsTplAddressGroupHandler{
 		cli: svc.syncCli, request: argsTplHandler.request, syncCli: argsTplHandler.syncCli})
 	if addressGroupErr != nil {
 		return nil, addressGroupErr
 	}
 
-	serviceErr := handler.ResourceSync(cts, &argsTplServiceHandler{
+	_, serviceErr := handler.ResourceSync(cts, &argsTplServiceHandler{
 		cli: svc.syncCli, request: argsTplHandler.request, syncCli: argsTplHandler.syncCli})
 	if serviceErr != nil {
 		return nil, serviceErr
 	}
 
-	serviceGroupErr := handler.ResourceSync(cts, &argsTplServiceGroupHandler{
+	_, serviceGroupErr := handler.ResourceSync(cts, &argsTplServiceGroupHandler{
 		cli: svc.syncCli, request: argsTplHandler.request, syncCli: argsTplHandler.syncCli})
 	if serviceGroupErr != nil {
 		return nil, serviceGroupErr
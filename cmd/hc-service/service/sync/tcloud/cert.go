@@ -36,7 +36,7 @@ import (
 
 // SyncCert ....
 func (svc *service) SyncCert(cts *rest.Contexts) (interface{}, error) {
-	return nil, handler.ResourceSync(cts, &certHandler{cli: svc.syncCli})
+	return handler.ResourceSync(cts, &certHandler{cli: svc.syncCli})
 }
 
 // certHandler sync handler.
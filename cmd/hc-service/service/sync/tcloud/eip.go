@@ -35,7 +35,7 @@ import (
 
 // SyncEip ....
 func (svc *service) SyncEip(cts *rest.Contexts) (interface{}, error) {
-	return nil, handler.ResourceSync(cts, &eipHandler{cli: svc.syncCli})
+	return handler.ResourceSync(cts, &eipHandler{cli: svc.syncCli})
 }
 
 // eipHandler eip sync handler.
@@ -49,6 +49,7 @@ type eipHandler struct {
 }
 
 var _ handler.Handler = new(eipHandler)
+var _ handler.TargetHandler = new(eipHandler)
 
 // Prepare ...
 func (hd *eipHandler) Prepare(cts *rest.Contexts) error {
@@ -121,3 +122,8 @@ func (hd *eipHandler) RemoveDeleteFromCloud(kt *kit.Kit) error {
 func (hd *eipHandler) Name() enumor.CloudResourceType {
 	return enumor.EipCloudResType
 }
+
+// TargetCloudIDs ...
+func (hd *eipHandler) TargetCloudIDs() []string {
+	return hd.request.CloudIDs
+}
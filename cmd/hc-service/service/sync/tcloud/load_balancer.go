@@ -35,7 +35,7 @@ import (
 
 // SyncLoadBalancer 同步负载均衡接口
 func (svc *service) SyncLoadBalancer(cts *rest.Contexts) (interface{}, error) {
-	return nil, handler.ResourceSync(cts, &lbHandler{cli: svc.syncCli})
+	return handler.ResourceSync(cts, &lbHandler{cli: svc.syncCli})
 }
 
 // lbHandler lb sync handler.
@@ -48,6 +48,7 @@ type lbHandler struct {
 }
 
 var _ handler.Handler = new(lbHandler)
+var _ handler.TargetHandler = new(lbHandler)
 
 // Prepare ...
 func (hd *lbHandler) Prepare(cts *rest.Contexts) error {
@@ -121,3 +122,8 @@ func (hd *lbHandler) RemoveDeleteFromCloud(kt *kit.Kit) error {
 func (hd *lbHandler) Name() enumor.CloudResourceType {
 	return enumor.LoadBalancerCloudResType
 }
+
+// TargetCloudIDs ...
+func (hd *lbHandler) TargetCloudIDs() []string {
+	return hd.request.CloudIDs
+}
@@ -35,7 +35,7 @@ import (
 
 // SyncLoadBalancerListener 同步负载均衡监听器接口
 func (svc *service) SyncLoadBalancerListener(cts *rest.Contexts) (any, error) {
-	return nil, handler.ResourceSync(cts, &lblHandler{cli: svc.syncCli})
+	return handler.ResourceSync(cts, &lblHandler{cli: svc.syncCli})
 }
 
 // lblHandler lb listener sync handler.
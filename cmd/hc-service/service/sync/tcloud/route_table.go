@@ -33,7 +33,7 @@ import (
 
 // SyncRouteTable ....
 func (svc *service) SyncRouteTable(cts *rest.Contexts) (interface{}, error) {
-	return nil, handler.ResourceSync(cts, &routeTableHandler{cli: svc.syncCli})
+	return handler.ResourceSync(cts, &routeTableHandler{cli: svc.syncCli})
 }
 
 // routeTableHandler routeTable sync handler.
@@ -47,6 +47,7 @@ type routeTableHandler struct {
 }
 
 var _ handler.Handler = new(routeTableHandler)
+var _ handler.TargetHandler = new(routeTableHandler)
 
 // Prepare ...
 func (hd *routeTableHandler) Prepare(cts *rest.Contexts) error {
@@ -120,3 +121,8 @@ func (hd *routeTableHandler) RemoveDeleteFromCloud(kt *kit.Kit) error {
 func (hd *routeTableHandler) Name() enumor.CloudResourceType {
 	return enumor.RouteTableCloudResType
 }
+
+// TargetCloudIDs ...
+func (hd *routeTableHandler) TargetCloudIDs() []string {
+	return hd.request.CloudIDs
+}
@@ -34,7 +34,7 @@ import (
 
 // SyncSubnet ....
 func (svc *service) SyncSubnet(cts *rest.Contexts) (interface{}, error) {
-	return nil, handler.ResourceSync(cts, &subnetHandler{cli: svc.syncCli})
+	return handler.ResourceSync(cts, &subnetHandler{cli: svc.syncCli})
 }
 
 // subnetHandler subnet sync handler.
@@ -48,6 +48,7 @@ type subnetHandler struct {
 }
 
 var _ handler.Handler = new(subnetHandler)
+var _ handler.TargetHandler = new(subnetHandler)
 
 // Prepare ...
 func (hd *subnetHandler) Prepare(cts *rest.Contexts) error {
@@ -121,3 +122,8 @@ func (hd *subnetHandler) RemoveDeleteFromCloud(kt *kit.Kit) error {
 func (hd *subnetHandler) Name() enumor.CloudResourceType {
 	return enumor.SubnetCloudResType
 }
+
+// TargetCloudIDs ...
+func (hd *subnetHandler) TargetCloudIDs() []string {
+	return hd.request.CloudIDs
+}
@@ -22,8 +22,10 @@ package tcloud
 
 import (
 	ressync "hcm/cmd/hc-service/logics/res-sync"
+	dryrun "hcm/cmd/hc-service/logics/res-sync/dry-run"
 	"hcm/cmd/hc-service/logics/res-sync/tcloud"
 	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/criteria/errf"
 	"hcm/pkg/rest"
 )
@@ -38,6 +40,10 @@ func defaultPrepare(cts *rest.Contexts, cli ressync.Interface) (*sync.TCloudSync
 		return nil, nil, errf.NewFromErr(errf.InvalidParameter, err)
 	}
 
+	if req.DryRun {
+		dryrun.WithRecorder(cts.Kit, cli.DryRunRecorder(enumor.TCloud, req.AccountID, req.Region))
+	}
+
 	syncCli, err := cli.TCloud(cts.Kit, req.AccountID)
 	if err != nil {
 		return nil, nil, err
@@ -34,7 +34,7 @@ import (
 
 // SyncVpc ....
 func (svc *service) SyncVpc(cts *rest.Contexts) (interface{}, error) {
-	return nil, handler.ResourceSync(cts, &vpcHandler{cli: svc.syncCli})
+	return handler.ResourceSync(cts, &vpcHandler{cli: svc.syncCli})
 }
 
 // vpcHandler vpc sync handler.
@@ -48,6 +48,7 @@ type vpcHandler struct {
 }
 
 var _ handler.Handler = new(vpcHandler)
+var _ handler.TargetHandler = new(vpcHandler)
 
 // Prepare ...
 func (hd *vpcHandler) Prepare(cts *rest.Contexts) error {
@@ -121,3 +122,8 @@ func (hd *vpcHandler) RemoveDeleteFromCloud(kt *kit.Kit) error {
 func (hd *vpcHandler) Name() enumor.CloudResourceType {
 	return enumor.VpcCloudResType
 }
+
+// TargetCloudIDs ...
+func (hd *vpcHandler) TargetCloudIDs() []string {
+	return hd.request.CloudIDs
+}
@@ -0,0 +1,127 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tcloud
+
+import (
+	ressync "hcm/cmd/hc-service/logics/res-sync"
+	"hcm/cmd/hc-service/logics/res-sync/tcloud"
+	"hcm/cmd/hc-service/service/sync/handler"
+	typecore "hcm/pkg/adaptor/types/core"
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// SyncVpcPeering 同步对等连接接口
+func (svc *service) SyncVpcPeering(cts *rest.Contexts) (interface{}, error) {
+	return handler.ResourceSync(cts, &vpcPeeringHandler{cli: svc.syncCli})
+}
+
+// vpcPeeringHandler vpc peering sync handler.
+type vpcPeeringHandler struct {
+	cli ressync.Interface
+
+	request *sync.TCloudSyncReq
+	syncCli tcloud.Interface
+	offset  uint64
+}
+
+var _ handler.Handler = new(vpcPeeringHandler)
+var _ handler.TargetHandler = new(vpcPeeringHandler)
+
+// Prepare ...
+func (hd *vpcPeeringHandler) Prepare(cts *rest.Contexts) error {
+	request, syncCli, err := defaultPrepare(cts, hd.cli)
+	if err != nil {
+		return err
+	}
+
+	hd.request = request
+	hd.syncCli = syncCli
+
+	return nil
+}
+
+// Next ...
+func (hd *vpcPeeringHandler) Next(kt *kit.Kit) ([]string, error) {
+	listOpt := &typecore.TCloudListOption{
+		Region: hd.request.Region,
+		Page: &typecore.TCloudPage{
+			Offset: hd.offset,
+			Limit:  typecore.TCloudQueryLimit,
+		},
+	}
+
+	items, err := hd.syncCli.CloudCli().ListVpcPeering(kt, listOpt)
+	if err != nil {
+		logs.Errorf("request adaptor list tcloud vpc peering failed, err: %v, opt: %v, rid: %s", err, listOpt, kt.Rid)
+		return nil, err
+	}
+
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	cloudIDs := make([]string, 0, len(items))
+	for _, one := range items {
+		cloudIDs = append(cloudIDs, one.GetCloudID())
+	}
+
+	hd.offset += typecore.TCloudQueryLimit
+	return cloudIDs, nil
+}
+
+// Sync ...
+func (hd *vpcPeeringHandler) Sync(kt *kit.Kit, cloudIDs []string) error {
+	params := &tcloud.SyncBaseParams{
+		AccountID: hd.request.AccountID,
+		Region:    hd.request.Region,
+		CloudIDs:  cloudIDs,
+	}
+	if _, err := hd.syncCli.VpcPeering(kt, params, new(tcloud.SyncVpcPeeringOption)); err != nil {
+		logs.Errorf("sync tcloud vpc peering failed, err: %v, opt: %v, rid: %s", err, params, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// RemoveDeleteFromCloud ...
+func (hd *vpcPeeringHandler) RemoveDeleteFromCloud(kt *kit.Kit) error {
+	if err := hd.syncCli.RemoveVpcPeeringDeleteFromCloud(kt, hd.request.AccountID, hd.request.Region); err != nil {
+		logs.Errorf("remove vpc peering delete from cloud failed, err: %v, accountID: %s, region: %s, rid: %s", err,
+			hd.request.AccountID, hd.request.Region, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// Name vpc peering
+func (hd *vpcPeeringHandler) Name() enumor.CloudResourceType {
+	return enumor.VpcPeeringCloudResType
+}
+
+// TargetCloudIDs ...
+func (hd *vpcPeeringHandler) TargetCloudIDs() []string {
+	return hd.request.CloudIDs
+}
@@ -0,0 +1,133 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package zenlayer
+
+import (
+	ressync "hcm/cmd/hc-service/logics/res-sync"
+	dryrun "hcm/cmd/hc-service/logics/res-sync/dry-run"
+	"hcm/cmd/hc-service/logics/res-sync/zenlayer"
+	"hcm/cmd/hc-service/service/sync/handler"
+	typecore "hcm/pkg/adaptor/types/core"
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+)
+
+// SyncVpc ....
+func (svc *service) SyncVpc(cts *rest.Contexts) (interface{}, error) {
+	return handler.ResourceSync(cts, &vpcHandler{cli: svc.syncCli})
+}
+
+// vpcHandler vpc sync handler.
+type vpcHandler struct {
+	cli ressync.Interface
+
+	// Prepare 构建参数
+	request *sync.ZenlayerGlobalSyncReq
+	syncCli zenlayer.Interface
+	// pageNum 分页查询的页码，从1开始
+	pageNum int64
+}
+
+var _ handler.Handler = new(vpcHandler)
+
+// Prepare zenlayer vpc 为全局资源，同步时不需要指定地域
+func (hd *vpcHandler) Prepare(cts *rest.Contexts) error {
+	req := new(sync.ZenlayerGlobalSyncReq)
+	if err := cts.DecodeInto(req); err != nil {
+		return errf.NewFromErr(errf.DecodeRequestFailed, err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	if req.DryRun {
+		dryrun.WithRecorder(cts.Kit, hd.cli.DryRunRecorder(enumor.Zenlayer, req.AccountID, ""))
+	}
+
+	syncCli, err := hd.cli.Zenlayer(cts.Kit, req.AccountID)
+	if err != nil {
+		return err
+	}
+
+	hd.request = req
+	hd.syncCli = syncCli
+
+	return nil
+}
+
+// Next ...
+func (hd *vpcHandler) Next(kt *kit.Kit) ([]string, error) {
+	hd.pageNum++
+	listOpt := &typecore.ZenlayerListOption{
+		Page: &typecore.ZenlayerPage{
+			PageNum:  hd.pageNum,
+			PageSize: constant.CloudResourceSyncMaxLimit,
+		},
+	}
+
+	vpcResult, err := hd.syncCli.CloudCli().ListVpc(kt, listOpt)
+	if err != nil {
+		logs.Errorf("request adaptor list zenlayer vpc failed, err: %v, opt: %v, rid: %s", err, listOpt, kt.Rid)
+		return nil, err
+	}
+
+	cloudIDs := make([]string, 0, len(vpcResult.Details))
+	for _, one := range vpcResult.Details {
+		cloudIDs = append(cloudIDs, one.CloudID)
+	}
+
+	return cloudIDs, nil
+}
+
+// Sync ...
+func (hd *vpcHandler) Sync(kt *kit.Kit, cloudIDs []string) error {
+	params := &zenlayer.SyncBaseParams{
+		AccountID: hd.request.AccountID,
+		CloudIDs:  cloudIDs,
+	}
+	if _, err := hd.syncCli.Vpc(kt, params, new(zenlayer.SyncVpcOption)); err != nil {
+		logs.Errorf("sync zenlayer vpc failed, err: %v, opt: %v, rid: %s", err, params, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// RemoveDeleteFromCloud ...
+func (hd *vpcHandler) RemoveDeleteFromCloud(kt *kit.Kit) error {
+	if err := hd.syncCli.RemoveVpcDeleteFromCloud(kt, hd.request.AccountID); err != nil {
+		logs.Errorf("remove vpc delete from cloud failed, err: %v, accountID: %s, rid: %s", err,
+			hd.request.AccountID, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// Name ...
+func (hd *vpcHandler) Name() enumor.CloudResourceType {
+	return enumor.VpcCloudResType
+}
@@ -105,7 +105,7 @@ func (v vpc) AwsVpcCreate(cts *rest.Contexts) (interface{}, error) {
 		return nil, err
 	}
 
-	err = handler.ResourceSync(cts, &logicsrt.AwsRouteTableHandler{
+	_, err = handler.ResourceSync(cts, &logicsrt.AwsRouteTableHandler{
 		DisablePrepare: true,
 		Cli:            v.syncCli,
 		Request: &sync.AwsSyncReq{
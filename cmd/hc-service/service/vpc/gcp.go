@@ -134,7 +134,7 @@ func (v vpc) GcpVpcCreate(cts *rest.Contexts) (interface{}, error) {
 
 const maxRetryCount = 10
 
-func (v vpc) createGeneratedRoute(kt *kit.Kit, adaptor *gcp.Gcp, network string) error {
+func (v vpc) createGeneratedRoute(kt *kit.Kit, adaptor gcp.Gcp, network string) error {
 	routeOpt := &adrt.GcpListOption{
 		Page:    &adcore.GcpPage{PageSize: adcore.GcpQueryLimit},
 		Network: []string{network},
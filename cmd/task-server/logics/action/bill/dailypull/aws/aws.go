@@ -0,0 +1,236 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"hcm/cmd/task-server/logics/action/bill/dailypull/registry"
+	actcli "hcm/cmd/task-server/logics/action/cli"
+	dsbill "hcm/pkg/api/data-service/bill"
+	hcbillservice "hcm/pkg/api/hc-service/bill"
+	"hcm/pkg/async/action/run"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/logs"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	awsMaxBill = uint64(1000)
+)
+
+const (
+	// CUR中账单字段名称
+	fieldProductCode   = "line_item_product_code"
+	fieldProductName   = "product_product_name"
+	fieldRegion        = "product_region"
+	fieldCurrency      = "line_item_currency_code"
+	fieldUnblendedCost = "line_item_unblended_cost"
+	fieldUsageAmount   = "line_item_usage_amount"
+	fieldPricingUnit   = "pricing_unit"
+	defaultAwsRegion   = "global"
+	defaultAwsCurrency = enumor.CurrencyUSD
+)
+
+func init() {
+	registry.PullerRegistry[enumor.Aws] = &AwsPuller{}
+}
+
+// AwsPuller aws puller
+type AwsPuller struct{}
+
+// Pull pull aws bill data from cur files of root account
+func (ap *AwsPuller) Pull(kt run.ExecuteKit, opt *registry.PullDailyBillOption) (*registry.PullerResult, error) {
+	offset := uint64(0)
+	count := int64(0)
+	cost := decimal.NewFromInt(0)
+	currency := defaultAwsCurrency
+	for {
+		limit := awsMaxBill
+		itemLen, tmpResult, err := ap.doPull(kt, opt, offset, limit)
+		if err != nil {
+			return nil, err
+		}
+		if len(tmpResult.Currency) != 0 {
+			currency = tmpResult.Currency
+		}
+		cost = cost.Add(tmpResult.Cost)
+		count += int64(itemLen)
+		logs.Infof("get raw bill item %d / total %d of puller %+v", itemLen, tmpResult.Count, opt)
+		if uint64(itemLen) < limit {
+			break
+		}
+		offset = offset + awsMaxBill
+	}
+	return &registry.PullerResult{
+		Count:    count,
+		Currency: currency,
+		Cost:     cost,
+	}, nil
+}
+
+func getRawBillCost(rawBills []dsbill.RawBillItem) decimal.Decimal {
+	cost := decimal.NewFromInt(0)
+	for _, bill := range rawBills {
+		cost = cost.Add(bill.BillCost)
+	}
+	return cost
+}
+
+func convertToRawBill(recordList []map[string]string) ([]dsbill.RawBillItem, error) {
+	var retList []dsbill.RawBillItem
+	for _, record := range recordList {
+		extensionBytes, err := json.Marshal(record)
+		if err != nil {
+			return nil, fmt.Errorf("marshal aws bill item %v failed", record)
+		}
+		newBillItem := dsbill.RawBillItem{
+			Region:        record[fieldRegion],
+			HcProductCode: record[fieldProductCode],
+			HcProductName: record[fieldProductName],
+			BillCurrency:  enumor.CurrencyCode(record[fieldCurrency]),
+			ResAmountUnit: record[fieldPricingUnit],
+		}
+		// 全局服务没有地域信息
+		if len(newBillItem.Region) == 0 {
+			newBillItem.Region = defaultAwsRegion
+		}
+		if len(newBillItem.BillCurrency) == 0 {
+			newBillItem.BillCurrency = defaultAwsCurrency
+		}
+		if costStr := record[fieldUnblendedCost]; len(costStr) != 0 {
+			cost, err := decimal.NewFromString(costStr)
+			if err != nil {
+				return nil, fmt.Errorf("parse aws bill cost %s failed, err %s", costStr, err.Error())
+			}
+			newBillItem.BillCost = cost
+		}
+		if amountStr := record[fieldUsageAmount]; len(amountStr) != 0 {
+			amount, err := decimal.NewFromString(amountStr)
+			if err != nil {
+				return nil, fmt.Errorf("parse aws bill usage amount %s failed, err %s", amountStr, err.Error())
+			}
+			newBillItem.ResAmount = amount
+		}
+		newBillItem.Extension = types.JsonField(string(extensionBytes))
+		retList = append(retList, newBillItem)
+	}
+	return retList, nil
+}
+
+func (ap *AwsPuller) createRawBill(
+	kt run.ExecuteKit, opt *registry.PullDailyBillOption,
+	filename string, billItems []dsbill.RawBillItem) error {
+
+	storeReq := &dsbill.RawBillCreateReq{
+		Vendor:         enumor.Aws,
+		FirstAccountID: opt.RootAccountID,
+		AccountID:      opt.MainAccountID,
+		BillYear:       fmt.Sprintf("%d", opt.BillYear),
+		BillMonth:      fmt.Sprintf("%02d", opt.BillMonth),
+		BillDate:       fmt.Sprintf("%02d", opt.BillDay),
+		Version:        fmt.Sprintf("%d", opt.VersionID),
+		FileName:       filename,
+	}
+	storeReq.Items = billItems
+	databillCli := actcli.GetDataService().Global.Bill
+	_, err := databillCli.CreateRawBill(kt.Kit(), storeReq)
+	if err != nil {
+		return fmt.Errorf("create raw bill to dataservice failed, err %s", err.Error())
+	}
+	return nil
+}
+
+func (ap *AwsPuller) doPull(
+	kt run.ExecuteKit, opt *registry.PullDailyBillOption, offset, limit uint64) (
+	int, *registry.PullerResult, error) {
+
+	date := fmt.Sprintf("%d-%02d-%02d", opt.BillYear, opt.BillMonth, opt.BillDay)
+	hcCli := actcli.GetHCService()
+	resp, err := hcCli.Aws.Bill.RootAccountBillList(kt.Kit().Ctx, kt.Kit().Header(), &hcbillservice.AwsRootBillListReq{
+		RootAccountID:      opt.RootAccountID,
+		MainAccountCloudID: opt.BillAccountID,
+		BeginDate:          date,
+		EndDate:            date,
+		Page: &hcbillservice.AwsBillListPage{
+			Offset: offset,
+			Limit:  limit,
+		},
+	})
+	if err != nil {
+		return 0, nil, fmt.Errorf("list aws root account bill list for %+v, offset %d, limit %d, err %s",
+			opt, offset, limit, err.Error())
+	}
+	// 没有账单时details为空
+	if resp.Details == nil {
+		return 0, &registry.PullerResult{
+			Count:    int64(0),
+			Currency: "",
+			Cost:     decimal.NewFromFloat(0),
+		}, nil
+	}
+	itemList, ok := resp.Details.([]interface{})
+	if !ok {
+		logs.Warnf("response %v is not []map[string]string", resp.Details)
+		return 0, nil, fmt.Errorf("response %v is not []map[string]string", resp.Details)
+	}
+	itemLen := len(itemList)
+	if itemLen == 0 {
+		return 0, &registry.PullerResult{
+			Count:    int64(0),
+			Currency: "",
+			Cost:     decimal.NewFromFloat(0),
+		}, nil
+	}
+
+	currency := enumor.CurrencyCode("")
+	var recordList []map[string]string
+	for _, item := range itemList {
+		respData, err := json.Marshal(item)
+		if err != nil {
+			return 0, nil, fmt.Errorf("marshal aws response failed, err %s", err.Error())
+		}
+		record := make(map[string]string)
+		if err := json.Unmarshal(respData, &record); err != nil {
+			return 0, nil, fmt.Errorf("decode aws response failed, err %s", err.Error())
+		}
+		if len(record[fieldCurrency]) != 0 {
+			currency = enumor.CurrencyCode(record[fieldCurrency])
+		}
+		recordList = append(recordList, record)
+	}
+	filename := fmt.Sprintf("%d-%d.csv", offset, itemLen)
+	billItems, err := convertToRawBill(recordList)
+	if err != nil {
+		return 0, nil, err
+	}
+	cost := getRawBillCost(billItems)
+	if err := ap.createRawBill(kt, opt, filename, billItems); err != nil {
+		return 0, nil, err
+	}
+	return itemLen, &registry.PullerResult{
+		Count:    resp.Count,
+		Currency: currency,
+		Cost:     cost,
+	}, nil
+}
@@ -0,0 +1,247 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"hcm/cmd/task-server/logics/action/bill/dailypull/registry"
+	actcli "hcm/cmd/task-server/logics/action/cli"
+	typesBill "hcm/pkg/adaptor/types/bill"
+	dsbill "hcm/pkg/api/data-service/bill"
+	hcbillservice "hcm/pkg/api/hc-service/bill"
+	"hcm/pkg/async/action/run"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/logs"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	azureMaxBill       = int32(typesBill.AzureQueryLimit)
+	defaultAzureRegion = "global"
+)
+
+func init() {
+	registry.PullerRegistry[enumor.Azure] = &AzurePuller{}
+}
+
+// usageDetail azure consumption usage detail, compatible with both legacy and modern kind.
+type usageDetail struct {
+	Kind       string                `json:"kind"`
+	Properties usageDetailProperties `json:"properties"`
+}
+
+type usageDetailProperties struct {
+	ResourceLocation string           `json:"resourceLocation"`
+	ConsumedService  string           `json:"consumedService"`
+	Quantity         *decimal.Decimal `json:"quantity"`
+	// legacy
+	Cost            *decimal.Decimal `json:"cost"`
+	BillingCurrency string           `json:"billingCurrency"`
+	MeterDetails    *struct {
+		MeterCategory string `json:"meterCategory"`
+		UnitOfMeasure string `json:"unitOfMeasure"`
+	} `json:"meterDetails"`
+	// modern
+	CostInBillingCurrency *decimal.Decimal `json:"costInBillingCurrency"`
+	BillingCurrencyCode   string           `json:"billingCurrencyCode"`
+	MeterCategory         string           `json:"meterCategory"`
+	UnitOfMeasure         string           `json:"unitOfMeasure"`
+}
+
+// AzurePuller azure puller
+type AzurePuller struct{}
+
+// Pull pull azure consumption data
+func (ap *AzurePuller) Pull(kt run.ExecuteKit, opt *registry.PullDailyBillOption) (*registry.PullerResult, error) {
+	nextLink := ""
+	pageIndex := 0
+	count := int64(0)
+	cost := decimal.NewFromInt(0)
+	currency := enumor.CurrencyCode("")
+	for {
+		itemLen, tmpResult, next, err := ap.doPull(kt, opt, pageIndex, nextLink)
+		if err != nil {
+			return nil, err
+		}
+		if len(tmpResult.Currency) != 0 {
+			currency = tmpResult.Currency
+		}
+		cost = cost.Add(tmpResult.Cost)
+		count += int64(itemLen)
+		logs.Infof("get raw bill item %d of page %d of puller %+v", itemLen, pageIndex, opt)
+		if len(next) == 0 {
+			break
+		}
+		nextLink = next
+		pageIndex++
+	}
+	return &registry.PullerResult{
+		Count:    count,
+		Currency: currency,
+		Cost:     cost,
+	}, nil
+}
+
+func getRawBillCost(rawBills []dsbill.RawBillItem) decimal.Decimal {
+	cost := decimal.NewFromInt(0)
+	for _, bill := range rawBills {
+		cost = cost.Add(bill.BillCost)
+	}
+	return cost
+}
+
+func convertToRawBill(recordList []json.RawMessage) ([]dsbill.RawBillItem, enumor.CurrencyCode, error) {
+	var retList []dsbill.RawBillItem
+	currency := enumor.CurrencyCode("")
+	for _, raw := range recordList {
+		record := usageDetail{}
+		if err := json.Unmarshal(raw, &record); err != nil {
+			return nil, "", fmt.Errorf("decode azure usage detail %s failed, err %s", string(raw), err.Error())
+		}
+		prop := record.Properties
+		newBillItem := dsbill.RawBillItem{
+			Region:        prop.ResourceLocation,
+			HcProductCode: prop.ConsumedService,
+		}
+		if len(newBillItem.Region) == 0 {
+			newBillItem.Region = defaultAzureRegion
+		}
+		if prop.Quantity != nil {
+			newBillItem.ResAmount = *prop.Quantity
+		}
+		switch record.Kind {
+		case "modern":
+			newBillItem.HcProductName = prop.MeterCategory
+			newBillItem.ResAmountUnit = prop.UnitOfMeasure
+			newBillItem.BillCurrency = enumor.CurrencyCode(prop.BillingCurrencyCode)
+			if prop.CostInBillingCurrency != nil {
+				newBillItem.BillCost = *prop.CostInBillingCurrency
+			}
+		default:
+			if prop.MeterDetails != nil {
+				newBillItem.HcProductName = prop.MeterDetails.MeterCategory
+				newBillItem.ResAmountUnit = prop.MeterDetails.UnitOfMeasure
+			}
+			newBillItem.BillCurrency = enumor.CurrencyCode(prop.BillingCurrency)
+			if prop.Cost != nil {
+				newBillItem.BillCost = *prop.Cost
+			}
+		}
+		if len(newBillItem.BillCurrency) != 0 {
+			currency = newBillItem.BillCurrency
+		}
+		newBillItem.Extension = types.JsonField(string(raw))
+		retList = append(retList, newBillItem)
+	}
+	return retList, currency, nil
+}
+
+func (ap *AzurePuller) createRawBill(
+	kt run.ExecuteKit, opt *registry.PullDailyBillOption,
+	filename string, billItems []dsbill.RawBillItem) error {
+
+	storeReq := &dsbill.RawBillCreateReq{
+		Vendor:         enumor.Azure,
+		FirstAccountID: opt.RootAccountID,
+		AccountID:      opt.MainAccountID,
+		BillYear:       fmt.Sprintf("%d", opt.BillYear),
+		BillMonth:      fmt.Sprintf("%02d", opt.BillMonth),
+		BillDate:       fmt.Sprintf("%02d", opt.BillDay),
+		Version:        fmt.Sprintf("%d", opt.VersionID),
+		FileName:       filename,
+	}
+	storeReq.Items = billItems
+	databillCli := actcli.GetDataService().Global.Bill
+	_, err := databillCli.CreateRawBill(kt.Kit(), storeReq)
+	if err != nil {
+		return fmt.Errorf("create raw bill to dataservice failed, err %s", err.Error())
+	}
+	return nil
+}
+
+func (ap *AzurePuller) doPull(
+	kt run.ExecuteKit, opt *registry.PullDailyBillOption, pageIndex int, nextLink string) (
+	int, *registry.PullerResult, string, error) {
+
+	date := fmt.Sprintf("%d-%02d-%02d", opt.BillYear, opt.BillMonth, opt.BillDay)
+	hcCli := actcli.GetHCService()
+	resp, err := hcCli.Azure.Bill.RootAccountBillList(kt.Kit().Ctx, kt.Kit().Header(),
+		&hcbillservice.AzureRootBillListReq{
+			RootAccountID:  opt.RootAccountID,
+			SubscriptionID: opt.BillAccountID,
+			BeginDate:      date,
+			EndDate:        date,
+			Page: &typesBill.AzureBillPage{
+				Limit:    azureMaxBill,
+				NextLink: nextLink,
+			},
+		})
+	if err != nil {
+		return 0, nil, "", fmt.Errorf("list azure root account bill list for %+v, page %d, err %s",
+			opt, pageIndex, err.Error())
+	}
+	if resp.Details == nil {
+		return 0, &registry.PullerResult{
+			Count:    int64(0),
+			Currency: "",
+			Cost:     decimal.NewFromFloat(0),
+		}, "", nil
+	}
+	itemList, ok := resp.Details.([]interface{})
+	if !ok {
+		logs.Warnf("response %v is not []armconsumption.UsageDetailClassification", resp.Details)
+		return 0, nil, "", fmt.Errorf("response %v is not []armconsumption.UsageDetailClassification", resp.Details)
+	}
+	itemLen := len(itemList)
+	if itemLen == 0 {
+		return 0, &registry.PullerResult{
+			Count:    int64(0),
+			Currency: "",
+			Cost:     decimal.NewFromFloat(0),
+		}, resp.NextLink, nil
+	}
+
+	var recordList []json.RawMessage
+	for _, item := range itemList {
+		itemData, err := json.Marshal(item)
+		if err != nil {
+			return 0, nil, "", fmt.Errorf("marshal azure response failed, err %s", err.Error())
+		}
+		recordList = append(recordList, itemData)
+	}
+	filename := fmt.Sprintf("%d-%d.csv", pageIndex, itemLen)
+	billItems, currency, err := convertToRawBill(recordList)
+	if err != nil {
+		return 0, nil, "", err
+	}
+	cost := getRawBillCost(billItems)
+	if err := ap.createRawBill(kt, opt, filename, billItems); err != nil {
+		return 0, nil, "", err
+	}
+	return itemLen, &registry.PullerResult{
+		Count:    int64(itemLen),
+		Currency: currency,
+		Cost:     cost,
+	}, resp.NextLink, nil
+}
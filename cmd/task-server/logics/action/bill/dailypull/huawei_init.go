@@ -20,6 +20,9 @@
 package dailypull
 
 import (
+	_ "hcm/cmd/task-server/logics/action/bill/dailypull/aws"
+	_ "hcm/cmd/task-server/logics/action/bill/dailypull/azure"
 	_ "hcm/cmd/task-server/logics/action/bill/dailypull/gcp"
 	_ "hcm/cmd/task-server/logics/action/bill/dailypull/huawei"
+	_ "hcm/cmd/task-server/logics/action/bill/dailypull/tcloud"
 )
@@ -0,0 +1,211 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tcloud
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"hcm/cmd/task-server/logics/action/bill/dailypull/registry"
+	actcli "hcm/cmd/task-server/logics/action/cli"
+	"hcm/pkg/adaptor/types/core"
+	dsbill "hcm/pkg/api/data-service/bill"
+	hcbillservice "hcm/pkg/api/hc-service/bill"
+	"hcm/pkg/async/action/run"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/logs"
+	cvt "hcm/pkg/tools/converter"
+
+	"github.com/shopspring/decimal"
+	billing "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/billing/v20180709"
+)
+
+const (
+	tcloudMaxBill = uint64(core.TCloudQueryLimit)
+)
+
+func init() {
+	registry.PullerRegistry[enumor.TCloud] = &TCloudPuller{}
+}
+
+// TCloudPuller tcloud puller
+type TCloudPuller struct{}
+
+// Pull pull tcloud bill data
+func (tp *TCloudPuller) Pull(kt run.ExecuteKit, opt *registry.PullDailyBillOption) (*registry.PullerResult, error) {
+	offset := uint64(0)
+	count := int64(0)
+	cost := decimal.NewFromInt(0)
+	for {
+		limit := tcloudMaxBill
+		itemLen, tmpResult, err := tp.doPull(kt, opt, offset, limit)
+		if err != nil {
+			return nil, err
+		}
+		cost = cost.Add(tmpResult.Cost)
+		count += int64(itemLen)
+		logs.Infof("get raw bill item %d / total %d of puller %+v", itemLen, tmpResult.Count, opt)
+		if uint64(itemLen) < limit {
+			break
+		}
+		offset = offset + tcloudMaxBill
+	}
+	return &registry.PullerResult{
+		Count:    count,
+		Currency: enumor.CurrencyCNY,
+		Cost:     cost,
+	}, nil
+}
+
+func getRawBillCost(rawBills []dsbill.RawBillItem) decimal.Decimal {
+	cost := decimal.NewFromInt(0)
+	for _, bill := range rawBills {
+		cost = cost.Add(bill.BillCost)
+	}
+	return cost
+}
+
+func convertToRawBill(recordList []billing.BillDetail) ([]dsbill.RawBillItem, error) {
+	var retList []dsbill.RawBillItem
+	for _, record := range recordList {
+		extensionBytes, err := json.Marshal(record)
+		if err != nil {
+			return nil, fmt.Errorf("marshal tcloud bill item %v failed", record)
+		}
+		newBillItem := dsbill.RawBillItem{
+			Region:        cvt.PtrToVal(record.RegionId),
+			HcProductCode: cvt.PtrToVal(record.BusinessCode),
+			HcProductName: cvt.PtrToVal(record.BusinessCodeName),
+			BillCurrency:  enumor.CurrencyCNY,
+			BillCost:      decimal.NewFromInt(0),
+		}
+		// 一条账单明细包含多个组件，费用为各组件优惠后总价之和
+		for _, component := range record.ComponentSet {
+			if component == nil || component.RealCost == nil {
+				continue
+			}
+			realCost, err := decimal.NewFromString(*component.RealCost)
+			if err != nil {
+				return nil, fmt.Errorf("parse tcloud bill component real cost %s failed, err %s",
+					*component.RealCost, err.Error())
+			}
+			newBillItem.BillCost = newBillItem.BillCost.Add(realCost)
+		}
+		// 仅有单个组件时用量才有意义
+		if len(record.ComponentSet) == 1 && record.ComponentSet[0] != nil {
+			component := record.ComponentSet[0]
+			if component.UsedAmount != nil {
+				if amount, err := decimal.NewFromString(*component.UsedAmount); err == nil {
+					newBillItem.ResAmount = amount
+				}
+			}
+			newBillItem.ResAmountUnit = cvt.PtrToVal(component.UsedAmountUnit)
+		}
+		newBillItem.Extension = types.JsonField(string(extensionBytes))
+		retList = append(retList, newBillItem)
+	}
+	return retList, nil
+}
+
+func (tp *TCloudPuller) createRawBill(
+	kt run.ExecuteKit, opt *registry.PullDailyBillOption,
+	filename string, billItems []dsbill.RawBillItem) error {
+
+	storeReq := &dsbill.RawBillCreateReq{
+		Vendor:         enumor.TCloud,
+		FirstAccountID: opt.RootAccountID,
+		AccountID:      opt.MainAccountID,
+		BillYear:       fmt.Sprintf("%d", opt.BillYear),
+		BillMonth:      fmt.Sprintf("%02d", opt.BillMonth),
+		BillDate:       fmt.Sprintf("%02d", opt.BillDay),
+		Version:        fmt.Sprintf("%d", opt.VersionID),
+		FileName:       filename,
+	}
+	storeReq.Items = billItems
+	databillCli := actcli.GetDataService().Global.Bill
+	_, err := databillCli.CreateRawBill(kt.Kit(), storeReq)
+	if err != nil {
+		return fmt.Errorf("create raw bill to dataservice failed, err %s", err.Error())
+	}
+	return nil
+}
+
+func (tp *TCloudPuller) doPull(
+	kt run.ExecuteKit, opt *registry.PullDailyBillOption, offset, limit uint64) (
+	int, *registry.PullerResult, error) {
+
+	hcCli := actcli.GetHCService()
+	resp, err := hcCli.TCloud.Bill.RootAccountBillList(kt.Kit().Ctx, kt.Kit().Header(),
+		&hcbillservice.TCloudRootAccountBillListReq{
+			RootAccountID:      opt.RootAccountID,
+			MainAccountCloudID: opt.BillAccountID,
+			BeginDate:          fmt.Sprintf("%d-%02d-%02d 00:00:00", opt.BillYear, opt.BillMonth, opt.BillDay),
+			EndDate:            fmt.Sprintf("%d-%02d-%02d 23:59:59", opt.BillYear, opt.BillMonth, opt.BillDay),
+			Page: &core.TCloudPage{
+				Offset: offset,
+				Limit:  limit,
+			},
+		})
+	if err != nil {
+		return 0, nil, fmt.Errorf("list tcloud root account bill list for %+v, offset %d, limit %d, err %s",
+			opt, offset, limit, err.Error())
+	}
+	itemList, ok := resp.Details.([]interface{})
+	if !ok {
+		logs.Warnf("response %v is not []billing.BillDetail", resp.Details)
+		return 0, nil, fmt.Errorf("response %v is not []billing.BillDetail", resp.Details)
+	}
+	itemLen := len(itemList)
+	if itemLen == 0 {
+		return 0, &registry.PullerResult{
+			Count:    int64(0),
+			Currency: enumor.CurrencyCNY,
+			Cost:     decimal.NewFromFloat(0),
+		}, nil
+	}
+
+	var recordList []billing.BillDetail
+	for _, item := range itemList {
+		itemData, err := json.Marshal(item)
+		if err != nil {
+			return 0, nil, fmt.Errorf("marshal tcloud response failed, err %s", err.Error())
+		}
+		record := billing.BillDetail{}
+		if err := json.Unmarshal(itemData, &record); err != nil {
+			return 0, nil, fmt.Errorf("decode tcloud response failed, err %s", err.Error())
+		}
+		recordList = append(recordList, record)
+	}
+	filename := fmt.Sprintf("%d-%d.csv", offset, itemLen)
+	billItems, err := convertToRawBill(recordList)
+	if err != nil {
+		return 0, nil, err
+	}
+	cost := getRawBillCost(billItems)
+	if err := tp.createRawBill(kt, opt, filename, billItems); err != nil {
+		return 0, nil, err
+	}
+	return itemLen, &registry.PullerResult{
+		Count:    int64(cvt.PtrToVal(resp.Count)),
+		Currency: enumor.CurrencyCNY,
+		Cost:     cost,
+	}, nil
+}
@@ -31,7 +31,7 @@ import (
 type RawBillSplitter interface {
 	DoSplit(opt *DailyAccountSplitActionOption, billDay int,
 		item *bill.RawBillItem, mainAccount *protocore.BaseMainAccount) (
-		[]*bill.BillItemCreateReq[rawjson.RawMessage], error)
+		[]bill.BillItemCreateReq[rawjson.RawMessage], error)
 }
 
 // DefaultSplitter default account splitter
@@ -190,6 +190,81 @@ func splitBillItem(kt *kit.Kit, opt *DailyAccountSplitActionOption, billDay int)
 	if err != nil {
 		return err
 	}
+
+	// 二级账号配置了分账规则时按规则分账，否则全部归属到二级账号所属的业务
+	var splitter RawBillSplitter = &DefaultSplitter{}
+	ruleSplitter, err := NewRuleSplitter(kt, opt, billDay)
+	if err != nil {
+		return fmt.Errorf("init rule splitter for %v failed, err %s", opt, err.Error())
+	}
+	if ruleSplitter != nil {
+		splitter = ruleSplitter
+	}
+
+	resolver, err := NewResourceResolver(kt, opt.Vendor, mainAccountInfo.CloudID)
+	if err != nil {
+		return fmt.Errorf("init resource resolver for %v failed, err %s", opt, err.Error())
+	}
+	return rangeRawBillItems(kt, opt, billDay, func(filename string, items []*bill.RawBillItem) error {
+		var billItemList []bill.BillItemCreateReq[rawjson.RawMessage]
+		for _, item := range items {
+			reqList, err := splitter.DoSplit(opt, billDay, item, mainAccountInfo)
+			if err != nil {
+				logs.Warnf("raw bill %v do splitting failed, err %s", item, err.Error())
+				return err
+			}
+			cloudResID := ParseRawBillResource(opt.Vendor, []byte(item.Extension)).ResourceID
+			if len(cloudResID) > maxCloudResIDLength {
+				cloudResID = ""
+			}
+			for idx := range reqList {
+				reqList[idx].CloudResID = cloudResID
+			}
+			billItemList = append(billItemList, reqList...)
+		}
+		if err := fillBillItemResource(kt, resolver, billItemList); err != nil {
+			return fmt.Errorf("resolve resource of bill item for %s failed, err %s", filename, err.Error())
+		}
+		_, err = actcli.GetDataService().Global.Bill.BatchCreateBillItem(
+			kt, opt.Vendor, (*bill.BatchBillItemCreateReq[rawjson.RawMessage])(&billItemList))
+		if err != nil {
+			return fmt.Errorf("batch create bill item for %s failed, err %s", filename, err.Error())
+		}
+		logs.Infof("split %s successfully", filename)
+		return nil
+	})
+}
+
+// maxCloudResIDLength 账单明细表中云资源ID字段的最大长度
+const maxCloudResIDLength = 255
+
+// fillBillItemResource 根据云资源ID关联HCM资源，记录资源ID及资源所属业务
+func fillBillItemResource(kt *kit.Kit, resolver *ResourceResolver,
+	billItemList []bill.BillItemCreateReq[rawjson.RawMessage]) error {
+
+	cloudResIDs := make([]string, 0, len(billItemList))
+	for _, item := range billItemList {
+		cloudResIDs = append(cloudResIDs, item.CloudResID)
+	}
+	if err := resolver.Resolve(kt, cloudResIDs); err != nil {
+		return err
+	}
+	for idx := range billItemList {
+		res := resolver.Get(billItemList[idx].CloudResID)
+		if res == nil {
+			continue
+		}
+		billItemList[idx].ResType = res.ResType
+		billItemList[idx].ResID = res.ResID
+		billItemList[idx].ResBkBizID = res.BkBizID
+	}
+	return nil
+}
+
+// rangeRawBillItems 按文件依次读取当天的原始账单明细并交由handler处理
+func rangeRawBillItems(kt *kit.Kit, opt *DailyAccountSplitActionOption, billDay int,
+	handler func(filename string, items []*bill.RawBillItem) error) error {
+
 	resp, err := actcli.GetDataService().Global.Bill.ListRawBillFileNames(kt, &bill.RawBillItemNameListReq{
 		Vendor:         opt.Vendor,
 		FirstAccountID: opt.RootAccountID,
@@ -203,8 +278,6 @@ func splitBillItem(kt *kit.Kit, opt *DailyAccountSplitActionOption, billDay int)
 		return fmt.Errorf("failed to list raw bill files for %v, err %s", opt, err.Error())
 	}
 	for _, filename := range resp.Filenames {
-		var billItemList []bill.BillItemCreateReq[rawjson.RawMessage]
-		// 后续可在该过程中，增加处理过程
 		name := filepath.Base(filename)
 		tmpReq := &bill.RawBillItemQueryReq{
 			Vendor:         opt.Vendor,
@@ -216,25 +289,13 @@ func splitBillItem(kt *kit.Kit, opt *DailyAccountSplitActionOption, billDay int)
 			BillDate:       fmt.Sprintf("%02d", billDay),
 			FileName:       name,
 		}
-		resp, err := actcli.GetDataService().Global.Bill.QueryRawBillItems(kt, tmpReq)
+		itemResp, err := actcli.GetDataService().Global.Bill.QueryRawBillItems(kt, tmpReq)
 		if err != nil {
 			return fmt.Errorf("failed to get raw bill item for %v, err %s", tmpReq, err.Error())
 		}
-		for _, item := range resp.Details {
-			defaultSplitter := &DefaultSplitter{}
-			reqList, err := defaultSplitter.DoSplit(opt, billDay, item, mainAccountInfo)
-			if err != nil {
-				logs.Warnf("raw bill %v do splitting failed, err %s", item, err.Error())
-				return err
-			}
-			billItemList = append(billItemList, reqList...)
-		}
-		_, err = actcli.GetDataService().Global.Bill.BatchCreateBillItem(
-			kt, opt.Vendor, (*bill.BatchBillItemCreateReq[rawjson.RawMessage])(&billItemList))
-		if err != nil {
-			return fmt.Errorf("batch create bill item for %s failed, err %s", filename, err.Error())
+		if err := handler(filename, itemResp.Details); err != nil {
+			return err
 		}
-		logs.Infof("split %s successfully", filename)
 	}
 	return nil
 }
@@ -0,0 +1,219 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dailysplit
+
+import (
+	"fmt"
+	"strings"
+
+	actcli "hcm/cmd/task-server/logics/action/cli"
+	"hcm/pkg/api/core"
+	corecloud "hcm/pkg/api/core/cloud"
+	corecvm "hcm/pkg/api/core/cloud/cvm"
+	coredisk "hcm/pkg/api/core/cloud/disk"
+	corelb "hcm/pkg/api/core/cloud/load-balancer"
+	protocloud "hcm/pkg/api/data-service/cloud"
+	dataeip "hcm/pkg/api/data-service/cloud/eip"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/tools/slice"
+)
+
+// resolvedResource 账单明细关联到的HCM资源
+type resolvedResource struct {
+	ResType enumor.CloudResourceType
+	ResID   string
+	BkBizID int64
+}
+
+type cloudResource struct {
+	ID      string
+	CloudID string
+	BkBizID int64
+}
+
+type cloudResourceLister func(kt *kit.Kit, req *core.ListReq) ([]cloudResource, error)
+
+// ResourceResolver 将账单明细中的云资源ID关联到HCM中的主机、硬盘、弹性IP和负载均衡，查询结果在一次分账内缓存
+type ResourceResolver struct {
+	vendor enumor.Vendor
+	// accountIDs 二级账号对应的HCM资源账号，只关联这些账号下的资源，避免不同账号下云资源ID相同时关联错误
+	accountIDs []string
+	// cache 云资源ID -> 关联到的资源，未关联到时为nil
+	cache map[string]*resolvedResource
+}
+
+// NewResourceResolver 查询二级账号对应的HCM资源账号，没有对应的资源账号时不关联任何资源
+func NewResourceResolver(kt *kit.Kit, vendor enumor.Vendor, mainAccountCloudID string) (*ResourceResolver, error) {
+	accountIDs, err := listResourceAccountIDs(kt, vendor, mainAccountCloudID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceResolver{vendor: vendor, accountIDs: accountIDs, cache: make(map[string]*resolvedResource)}, nil
+}
+
+// listResourceAccountIDs 按主账号字段查询二级账号对应的资源账号
+func listResourceAccountIDs(kt *kit.Kit, vendor enumor.Vendor, mainAccountCloudID string) ([]string, error) {
+	mainAccountIDField := vendor.GetMainAccountIDField()
+	if len(mainAccountIDField) == 0 || len(mainAccountCloudID) == 0 {
+		return nil, nil
+	}
+
+	result, err := actcli.GetDataService().Global.Account.List(kt.Ctx, kt.Header(), &protocloud.AccountListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", vendor),
+			tools.RuleEqual("type", enumor.ResourceAccount),
+			tools.RuleJSONEqual(fmt.Sprintf("extension.%s", mainAccountIDField), mainAccountCloudID),
+		),
+		Page: core.NewDefaultBasePage(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list resource account of %s main account %s failed, err: %v", vendor,
+			mainAccountCloudID, err)
+	}
+
+	return slice.Map(result.Details, func(one *corecloud.BaseAccount) string { return one.ID }), nil
+}
+
+// resourceListers 按顺序依次查找的资源类型
+var resourceListers = []struct {
+	resType enumor.CloudResourceType
+	list    cloudResourceLister
+}{
+	{resType: enumor.CvmCloudResType, list: listCvmByCloudIDs},
+	{resType: enumor.DiskCloudResType, list: listDiskByCloudIDs},
+	{resType: enumor.EipCloudResType, list: listEipByCloudIDs},
+	{resType: enumor.LoadBalancerCloudResType, list: listLoadBalancerByCloudIDs},
+}
+
+// Resolve 批量查询尚未缓存的云资源ID
+func (r *ResourceResolver) Resolve(kt *kit.Kit, cloudResIDs []string) error {
+	// 资源表中的云资源ID -> 账单中的云资源ID
+	pending := make(map[string]string)
+	for _, id := range cloudResIDs {
+		if len(id) == 0 {
+			continue
+		}
+		if _, exists := r.cache[id]; exists {
+			continue
+		}
+		pending[normalizeCloudResID(r.vendor, id)] = id
+		r.cache[id] = nil
+	}
+
+	if len(r.accountIDs) == 0 {
+		return nil
+	}
+
+	for _, lister := range resourceListers {
+		if len(pending) == 0 {
+			return nil
+		}
+		ids := make([]string, 0, len(pending))
+		for id := range pending {
+			ids = append(ids, id)
+		}
+		for _, batch := range slice.Split(ids, int(core.DefaultMaxPageLimit)) {
+			resources, err := lister.list(kt, listByCloudIDsReq(r.vendor, r.accountIDs, batch))
+			if err != nil {
+				return fmt.Errorf("list %s by cloud ids failed, err: %v", lister.resType, err)
+			}
+			for _, res := range resources {
+				billResID, exists := pending[res.CloudID]
+				if !exists {
+					continue
+				}
+				r.cache[billResID] = &resolvedResource{ResType: lister.resType, ResID: res.ID, BkBizID: res.BkBizID}
+				delete(pending, res.CloudID)
+			}
+		}
+	}
+	return nil
+}
+
+// Get 返回已解析的云资源，需先调用Resolve
+func (r *ResourceResolver) Get(cloudResID string) *resolvedResource {
+	return r.cache[cloudResID]
+}
+
+// normalizeCloudResID 将账单中的云资源ID转换为资源表中保存的云资源ID
+func normalizeCloudResID(vendor enumor.Vendor, id string) string {
+	switch vendor {
+	case enumor.Gcp:
+		// 如 //compute.googleapis.com/projects/xxx/zones/xxx/instances/<id>
+		return id[strings.LastIndex(id, "/")+1:]
+	default:
+		return id
+	}
+}
+
+func listByCloudIDsReq(vendor enumor.Vendor, accountIDs, cloudIDs []string) *core.ListReq {
+	return &core.ListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("vendor", vendor),
+			tools.RuleIn("account_id", accountIDs),
+			tools.RuleIn("cloud_id", cloudIDs),
+		),
+		Page:   core.NewDefaultBasePage(),
+		Fields: []string{"id", "cloud_id", "bk_biz_id"},
+	}
+}
+
+func listCvmByCloudIDs(kt *kit.Kit, req *core.ListReq) ([]cloudResource, error) {
+	result, err := actcli.GetDataService().Global.Cvm.ListCvm(kt, req)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(result.Details, func(one corecvm.BaseCvm) cloudResource {
+		return cloudResource{ID: one.ID, CloudID: one.CloudID, BkBizID: one.BkBizID}
+	}), nil
+}
+
+func listDiskByCloudIDs(kt *kit.Kit, req *core.ListReq) ([]cloudResource, error) {
+	result, err := actcli.GetDataService().Global.ListDisk(kt, req)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(result.Details, func(one *coredisk.BaseDisk) cloudResource {
+		return cloudResource{ID: one.ID, CloudID: one.CloudID, BkBizID: one.BkBizID}
+	}), nil
+}
+
+func listEipByCloudIDs(kt *kit.Kit, req *core.ListReq) ([]cloudResource, error) {
+	result, err := actcli.GetDataService().Global.ListEip(kt, req)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(result.Details, func(one *dataeip.EipResult) cloudResource {
+		return cloudResource{ID: one.ID, CloudID: one.CloudID, BkBizID: one.BkBizID}
+	}), nil
+}
+
+func listLoadBalancerByCloudIDs(kt *kit.Kit, req *core.ListReq) ([]cloudResource, error) {
+	result, err := actcli.GetDataService().Global.LoadBalancer.ListLoadBalancer(kt, req)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(result.Details, func(one corelb.BaseLoadBalancer) cloudResource {
+		return cloudResource{ID: one.ID, CloudID: one.CloudID, BkBizID: one.BkBizID}
+	}), nil
+}
@@ -0,0 +1,124 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dailysplit
+
+import (
+	"testing"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/dao/tools"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeCloudResID(t *testing.T) {
+	testCases := []struct {
+		vendor enumor.Vendor
+		id     string
+		expect string
+	}{
+		{vendor: enumor.Gcp, id: "//compute.googleapis.com/projects/p1/zones/z1/instances/123", expect: "123"},
+		{vendor: enumor.Gcp, id: "123", expect: "123"},
+		{vendor: enumor.TCloud, id: "ins-123", expect: "ins-123"},
+		{vendor: enumor.Aws, id: "arn:aws:ec2:us-east-1:123456789012:instance/i-1",
+			expect: "arn:aws:ec2:us-east-1:123456789012:instance/i-1"},
+		{vendor: enumor.Azure, id: "/subscriptions/x/vm1", expect: "/subscriptions/x/vm1"},
+	}
+	for _, test := range testCases {
+		assert.Equal(t, test.expect, normalizeCloudResID(test.vendor, test.id), test.id)
+	}
+}
+
+func TestParseRawBillResourceByVendor(t *testing.T) {
+	testCases := []struct {
+		name       string
+		vendor     enumor.Vendor
+		extension  string
+		resourceID string
+		tags       map[string]string
+	}{
+		{
+			name:       "empty extension",
+			vendor:     enumor.TCloud,
+			extension:  "",
+			resourceID: "",
+			tags:       map[string]string{},
+		},
+		{
+			name:       "tcloud without resource",
+			vendor:     enumor.TCloud,
+			extension:  `{"ProductCode":"p_support"}`,
+			resourceID: "",
+			tags:       map[string]string{},
+		},
+		{
+			name:       "aws tag columns",
+			vendor:     enumor.Aws,
+			extension:  `{"line_item_resource_id":"vol-1","resource_tags_user_app":"web","product_name":"ebs"}`,
+			resourceID: "vol-1",
+			tags:       map[string]string{"app": "web"},
+		},
+		{
+			name:       "huawei empty tag",
+			vendor:     enumor.HuaWei,
+			extension:  `{"resource_id":"eip-1","resource_tag":"team; env=test"}`,
+			resourceID: "eip-1",
+			tags:       map[string]string{"team": "", "env": "test"},
+		},
+		{
+			name:   "gcp global name first",
+			vendor: enumor.Gcp,
+			extension: `{"resource_global_name":"//compute.googleapis.com/projects/p1/zones/z1/instances/1",` +
+				`"resource_name":"vm-gcp","labels":[{"key":"team","value":"e"}]}`,
+			resourceID: "//compute.googleapis.com/projects/p1/zones/z1/instances/1",
+			tags:       map[string]string{"team": "e"},
+		},
+		{
+			name:       "azure resource id first",
+			vendor:     enumor.Azure,
+			extension:  `{"properties":{"resourceId":"/subscriptions/x/disk1","instanceName":"disk1"}}`,
+			resourceID: "/subscriptions/x/disk1",
+			tags:       map[string]string{},
+		},
+		{
+			name:       "other vendor",
+			vendor:     enumor.Zenlayer,
+			extension:  `{"resourceId":"zl-1"}`,
+			resourceID: "zl-1",
+			tags:       map[string]string{},
+		},
+	}
+	for _, test := range testCases {
+		res := ParseRawBillResource(test.vendor, []byte(test.extension))
+		assert.Equal(t, test.resourceID, res.ResourceID, test.name)
+		assert.Equal(t, test.tags, res.Tags, test.name)
+	}
+}
+
+func TestListByCloudIDsReq(t *testing.T) {
+	req := listByCloudIDsReq(enumor.TCloud, []string{"account-1"}, []string{"ins-1", "ins-2"})
+	expect := tools.ExpressionAnd(
+		tools.RuleEqual("vendor", enumor.TCloud),
+		tools.RuleIn("account_id", []string{"account-1"}),
+		tools.RuleIn("cloud_id", []string{"ins-1", "ins-2"}),
+	)
+	assert.Equal(t, expect, req.Filter)
+	assert.Equal(t, []string{"id", "cloud_id", "bk_biz_id"}, req.Fields)
+}
@@ -0,0 +1,108 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dailysplit
+
+import (
+	"strings"
+
+	"hcm/pkg/criteria/enumor"
+
+	"github.com/tidwall/gjson"
+)
+
+// RawBillResource 从原始账单明细中解析出的云资源信息
+type RawBillResource struct {
+	// ResourceID 云资源ID，部分明细（如税费、支持费用）没有对应的云资源
+	ResourceID string
+	// Tags 云资源标签
+	Tags map[string]string
+}
+
+// ParseRawBillResource 按云厂商解析原始账单明细扩展字段中的云资源ID和标签
+func ParseRawBillResource(vendor enumor.Vendor, extension []byte) *RawBillResource {
+	res := &RawBillResource{Tags: make(map[string]string)}
+	if len(extension) == 0 {
+		return res
+	}
+	ext := gjson.ParseBytes(extension)
+
+	switch vendor {
+	case enumor.TCloud:
+		res.ResourceID = ext.Get("ResourceId").String()
+		ext.Get("Tags").ForEach(func(_, tag gjson.Result) bool {
+			res.Tags[tag.Get("TagKey").String()] = tag.Get("TagValue").String()
+			return true
+		})
+	case enumor.Aws:
+		res.ResourceID = ext.Get("line_item_resource_id").String()
+		// CUR中用户标签为独立的列，列名格式为 resource_tags_user_<tag key>
+		ext.ForEach(func(key, value gjson.Result) bool {
+			if tagKey, ok := strings.CutPrefix(key.String(), "resource_tags_user_"); ok && value.String() != "" {
+				res.Tags[tagKey] = value.String()
+			}
+			return true
+		})
+	case enumor.HuaWei:
+		res.ResourceID = ext.Get("resource_id").String()
+		parseKVTags(ext.Get("resource_tag").String(), res.Tags)
+	case enumor.Gcp:
+		res.ResourceID = firstNotEmpty(ext, "resource_global_name", "resource_name")
+		ext.Get("labels").ForEach(func(_, label gjson.Result) bool {
+			res.Tags[label.Get("key").String()] = label.Get("value").String()
+			return true
+		})
+	case enumor.Azure:
+		res.ResourceID = firstNotEmpty(ext, "properties.resourceId", "properties.instanceName",
+			"properties.instanceId")
+		ext.Get("tags").ForEach(func(key, value gjson.Result) bool {
+			res.Tags[key.String()] = value.String()
+			return true
+		})
+	default:
+		res.ResourceID = firstNotEmpty(ext, "resource_id", "ResourceId", "resourceId")
+	}
+
+	return res
+}
+
+func firstNotEmpty(ext gjson.Result, paths ...string) string {
+	for _, path := range paths {
+		if value := ext.Get(path).String(); len(value) != 0 {
+			return value
+		}
+	}
+	return ""
+}
+
+// parseKVTags 解析 k1=v1;k2=v2 或 k1:v1;k2:v2 格式的标签
+func parseKVTags(raw string, tags map[string]string) {
+	for _, pair := range strings.Split(raw, ";") {
+		pair = strings.TrimSpace(pair)
+		if len(pair) == 0 {
+			continue
+		}
+		idx := strings.IndexAny(pair, "=:")
+		if idx < 0 {
+			tags[pair] = ""
+			continue
+		}
+		tags[pair[:idx]] = pair[idx+1:]
+	}
+}
@@ -0,0 +1,319 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dailysplit
+
+import (
+	rawjson "encoding/json"
+	"fmt"
+	"sort"
+
+	actcli "hcm/cmd/task-server/logics/action/cli"
+	"hcm/pkg/api/core"
+	protocore "hcm/pkg/api/core/account-set"
+	billcore "hcm/pkg/api/core/bill"
+	"hcm/pkg/api/data-service/bill"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/runtime/filter"
+	"hcm/pkg/tools/slice"
+
+	"github.com/shopspring/decimal"
+)
+
+// splitPrecision 分摊金额和用量保留的小数位数，与账单明细表字段精度一致
+const splitPrecision = 10
+
+// RuleSplitter 按分账规则对共享账号的账单明细进行分摊，未命中任何规则的明细交由Fallback处理
+type RuleSplitter struct {
+	Vendor enumor.Vendor
+	// Rules 二级账号下的分账规则，按优先级升序排列
+	Rules []*billcore.SplitRule
+	// Weights 各规则分摊目标的权重，与规则的Targets一一对应
+	Weights  map[string][]decimal.Decimal
+	Fallback RawBillSplitter
+}
+
+// DoSplit implements RawBillSplitter
+func (rs *RuleSplitter) DoSplit(opt *DailyAccountSplitActionOption, billDay int,
+	item *bill.RawBillItem, mainAccount *protocore.BaseMainAccount) ([]bill.BillItemCreateReq[rawjson.RawMessage], error) {
+
+	reqList, err := rs.Fallback.DoSplit(opt, billDay, item, mainAccount)
+	if err != nil {
+		return nil, err
+	}
+	if len(reqList) != 1 {
+		return reqList, nil
+	}
+
+	resource := ParseRawBillResource(rs.Vendor, []byte(item.Extension))
+	rule := rs.MatchRule(item, resource)
+	if rule == nil {
+		return reqList, nil
+	}
+	return splitByWeights(reqList[0], rule.Targets, rs.Weights[rule.ID]), nil
+}
+
+// MatchRule 返回第一条命中原始账单明细的规则，没有命中时返回nil
+func (rs *RuleSplitter) MatchRule(item *bill.RawBillItem, resource *RawBillResource) *billcore.SplitRule {
+	for _, rule := range rs.Rules {
+		if matchSplitRule(rule.Match, item, resource) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// matchSplitRule 各匹配条件之间为与关系，同一条件的多个取值之间为或关系，未设置的条件视为命中
+func matchSplitRule(match *billcore.SplitRuleMatch, item *bill.RawBillItem, resource *RawBillResource) bool {
+	if match == nil {
+		return true
+	}
+	if len(match.ProductCodes) != 0 && !slice.IsItemInSlice(match.ProductCodes, item.HcProductCode) {
+		return false
+	}
+	if len(match.Regions) != 0 && !slice.IsItemInSlice(match.Regions, item.Region) {
+		return false
+	}
+	if len(match.ResourceIDs) != 0 && !slice.IsItemInSlice(match.ResourceIDs, resource.ResourceID) {
+		return false
+	}
+	for key, value := range match.Tags {
+		tagValue, exists := resource.Tags[key]
+		if !exists {
+			return false
+		}
+		// 标签值为空时只要求存在该标签
+		if len(value) != 0 && value != tagValue {
+			return false
+		}
+	}
+	return true
+}
+
+// splitByWeights 按权重将一条账单明细拆分到各分摊目标，为避免精度损失，最后一个目标承担剩余的金额和用量
+func splitByWeights(base bill.BillItemCreateReq[rawjson.RawMessage], targets []billcore.SplitRuleTarget,
+	weights []decimal.Decimal) []bill.BillItemCreateReq[rawjson.RawMessage] {
+
+	total := decimal.Zero
+	indexes := make([]int, 0, len(targets))
+	for idx := range targets {
+		if idx < len(weights) && weights[idx].IsPositive() {
+			total = total.Add(weights[idx])
+			indexes = append(indexes, idx)
+		}
+	}
+	// 所有目标权重都为0时（如业务下暂无主机），退化为平均分摊
+	if len(indexes) == 0 {
+		weights = make([]decimal.Decimal, len(targets))
+		for idx := range targets {
+			weights[idx] = decimal.NewFromInt(1)
+			indexes = append(indexes, idx)
+		}
+		total = decimal.NewFromInt(int64(len(targets)))
+	}
+
+	costLeft, amountLeft := base.Cost, base.ResAmount
+	result := make([]bill.BillItemCreateReq[rawjson.RawMessage], 0, len(indexes))
+	for i, idx := range indexes {
+		one := base
+		if targets[idx].ProductID != 0 {
+			one.ProductID = targets[idx].ProductID
+		}
+		if targets[idx].BkBizID != 0 {
+			one.BkBizID = targets[idx].BkBizID
+		}
+		if i == len(indexes)-1 {
+			one.Cost, one.ResAmount = costLeft, amountLeft
+		} else {
+			one.Cost = base.Cost.Mul(weights[idx]).Div(total).Round(splitPrecision)
+			one.ResAmount = base.ResAmount.Mul(weights[idx]).Div(total).Round(splitPrecision)
+			costLeft = costLeft.Sub(one.Cost)
+			amountLeft = amountLeft.Sub(one.ResAmount)
+		}
+		result = append(result, one)
+	}
+	return result
+}
+
+// NewRuleSplitter 加载二级账号的分账规则并计算各规则分摊目标的权重，没有分账规则时返回nil
+func NewRuleSplitter(kt *kit.Kit, opt *DailyAccountSplitActionOption, billDay int) (*RuleSplitter, error) {
+	rules, err := ListSplitRules(kt, opt.MainAccountID)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	rs := &RuleSplitter{
+		Vendor:   opt.Vendor,
+		Rules:    rules,
+		Weights:  make(map[string][]decimal.Decimal, len(rules)),
+		Fallback: &DefaultSplitter{},
+	}
+
+	usageRules := make([]*billcore.SplitRule, 0)
+	var cvmCounts *cvmCountSnapshot
+	for _, rule := range rules {
+		switch rule.SplitType {
+		case enumor.BillSplitFixedRatio:
+			weights := make([]decimal.Decimal, len(rule.Targets))
+			for idx, target := range rule.Targets {
+				if target.Ratio != nil {
+					weights[idx] = *target.Ratio
+				}
+			}
+			rs.Weights[rule.ID] = weights
+		case enumor.BillSplitCvmCount:
+			if cvmCounts == nil {
+				if cvmCounts, err = getCvmCountSnapshot(kt, opt, billDay); err != nil {
+					return nil, err
+				}
+			}
+			weights, err := getCvmCountWeights(kt, opt.Vendor, rule, cvmCounts)
+			if err != nil {
+				return nil, err
+			}
+			rs.Weights[rule.ID] = weights
+		case enumor.BillSplitUsage:
+			usageRules = append(usageRules, rule)
+		default:
+			return nil, fmt.Errorf("unsupported split type %s of rule %s", rule.SplitType, rule.ID)
+		}
+	}
+
+	if cvmCounts != nil {
+		if err := cvmCounts.save(kt, opt, billDay); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(usageRules) != 0 {
+		if err := rs.initUsageWeights(kt, opt, billDay, usageRules); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+// ListSplitRules 查询二级账号下的分账规则，按优先级升序排列
+func ListSplitRules(kt *kit.Kit, mainAccountID string) ([]*billcore.SplitRule, error) {
+	rules := make([]*billcore.SplitRule, 0)
+	page := &core.BasePage{Start: 0, Limit: core.DefaultMaxPageLimit}
+	for {
+		result, err := actcli.GetDataService().Global.Bill.ListBillSplitRule(kt, &bill.BillSplitRuleListReq{
+			Filter: tools.EqualExpression("main_account_id", mainAccountID),
+			Page:   page,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list split rule of main account %s failed, err %s", mainAccountID, err.Error())
+		}
+		rules = append(rules, result.Details...)
+		if uint(len(result.Details)) < page.Limit {
+			break
+		}
+		page.Start += uint32(page.Limit)
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].Priority != rules[j].Priority {
+			return rules[i].Priority < rules[j].Priority
+		}
+		return rules[i].ID < rules[j].ID
+	})
+	return rules, nil
+}
+
+// getCvmCountWeights 以各目标业务下的主机数量作为权重，规则限定了地域时只统计这些地域的主机，
+// 优先使用当天快照中的主机数量，快照中没有时才统计当前的主机数量并记录到快照中
+func getCvmCountWeights(kt *kit.Kit, vendor enumor.Vendor, rule *billcore.SplitRule,
+	snapshot *cvmCountSnapshot) ([]decimal.Decimal, error) {
+
+	var regions []string
+	if rule.Match != nil {
+		regions = rule.Match.Regions
+	}
+
+	weights := make([]decimal.Decimal, len(rule.Targets))
+	for idx, target := range rule.Targets {
+		key := cvmCountKey(target.BkBizID, regions)
+		count, exists := snapshot.counts[key]
+		if !exists {
+			rules := []*filter.AtomRule{
+				tools.RuleEqual("vendor", vendor),
+				tools.RuleEqual("bk_biz_id", target.BkBizID),
+			}
+			if len(regions) != 0 {
+				rules = append(rules, tools.RuleIn("region", regions))
+			}
+			result, err := actcli.GetDataService().Global.Cvm.ListCvm(kt, &core.ListReq{
+				Filter: tools.ExpressionAnd(rules...),
+				Page:   core.NewCountPage(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("count cvm of biz %d for split rule %s failed, err %s",
+					target.BkBizID, rule.ID, err.Error())
+			}
+			count = int64(result.Count)
+			snapshot.counts[key] = count
+			snapshot.changed = true
+		}
+		weights[idx] = decimal.NewFromInt(count)
+	}
+	return weights, nil
+}
+
+// initUsageWeights 统计当天命中规则的明细中，各目标所属云资源的用量之和作为权重
+func (rs *RuleSplitter) initUsageWeights(kt *kit.Kit, opt *DailyAccountSplitActionOption, billDay int,
+	rules []*billcore.SplitRule) error {
+
+	// 云资源ID -> 规则ID -> 目标下标
+	resTargetMap := make(map[string]map[string]int)
+	for _, rule := range rules {
+		rs.Weights[rule.ID] = make([]decimal.Decimal, len(rule.Targets))
+		for idx, target := range rule.Targets {
+			for _, resID := range target.ResourceIDs {
+				if _, exists := resTargetMap[resID]; !exists {
+					resTargetMap[resID] = make(map[string]int)
+				}
+				resTargetMap[resID][rule.ID] = idx
+			}
+		}
+	}
+
+	return rangeRawBillItems(kt, opt, billDay, func(_ string, items []*bill.RawBillItem) error {
+		for _, item := range items {
+			resource := ParseRawBillResource(rs.Vendor, []byte(item.Extension))
+			ruleTargets, exists := resTargetMap[resource.ResourceID]
+			if !exists {
+				continue
+			}
+			for _, rule := range rules {
+				idx, exists := ruleTargets[rule.ID]
+				if !exists || !matchSplitRule(rule.Match, item, resource) {
+					continue
+				}
+				rs.Weights[rule.ID][idx] = rs.Weights[rule.ID][idx].Add(item.ResAmount.Abs())
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,179 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dailysplit
+
+import (
+	rawjson "encoding/json"
+	"testing"
+
+	protocore "hcm/pkg/api/core/account-set"
+	billcore "hcm/pkg/api/core/bill"
+	"hcm/pkg/api/data-service/bill"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/table/types"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRawBillResource(t *testing.T) {
+	testCases := []struct {
+		vendor     enumor.Vendor
+		extension  string
+		resourceID string
+		tags       map[string]string
+	}{
+		{
+			vendor:     enumor.TCloud,
+			extension:  `{"ResourceId":"ins-123","Tags":[{"TagKey":"team","TagValue":"a"}]}`,
+			resourceID: "ins-123",
+			tags:       map[string]string{"team": "a"},
+		},
+		{
+			vendor:     enumor.Aws,
+			extension:  `{"line_item_resource_id":"i-123","resource_tags_user_team":"b","resource_tags_user_env":""}`,
+			resourceID: "i-123",
+			tags:       map[string]string{"team": "b"},
+		},
+		{
+			vendor:     enumor.HuaWei,
+			extension:  `{"resource_id":"vm-1","resource_tag":"team=c;env:prod"}`,
+			resourceID: "vm-1",
+			tags:       map[string]string{"team": "c", "env": "prod"},
+		},
+		{
+			vendor:     enumor.Azure,
+			extension:  `{"properties":{"instanceName":"/subscriptions/x/vm1"},"tags":{"team":"d"}}`,
+			resourceID: "/subscriptions/x/vm1",
+			tags:       map[string]string{"team": "d"},
+		},
+		{
+			vendor:     enumor.Gcp,
+			extension:  `{"resource_name":"vm-gcp"}`,
+			resourceID: "vm-gcp",
+			tags:       map[string]string{},
+		},
+	}
+	for _, test := range testCases {
+		res := ParseRawBillResource(test.vendor, []byte(test.extension))
+		assert.Equal(t, test.resourceID, res.ResourceID, test.vendor)
+		assert.Equal(t, test.tags, res.Tags, test.vendor)
+	}
+}
+
+func TestRuleSplitterDoSplit(t *testing.T) {
+	low := decimal.NewFromFloat(0.3)
+	high := decimal.NewFromFloat(0.7)
+	rs := &RuleSplitter{
+		Vendor: enumor.TCloud,
+		Rules: []*billcore.SplitRule{
+			{
+				ID:        "tag",
+				SplitType: enumor.BillSplitFixedRatio,
+				Match:     &billcore.SplitRuleMatch{Tags: map[string]string{"shared": ""}},
+				Targets: []billcore.SplitRuleTarget{
+					{BkBizID: 1, Ratio: &low},
+					{BkBizID: 2, Ratio: &high},
+				},
+			},
+			{
+				ID:        "cvm",
+				SplitType: enumor.BillSplitCvmCount,
+				Match:     &billcore.SplitRuleMatch{ProductCodes: []string{"cvm"}, Regions: []string{"ap-guangzhou"}},
+				Targets:   []billcore.SplitRuleTarget{{BkBizID: 3}, {BkBizID: 4}, {BkBizID: 5}},
+			},
+		},
+		Weights: map[string][]decimal.Decimal{
+			"tag": {low, high},
+			"cvm": {decimal.NewFromInt(1), decimal.NewFromInt(0), decimal.NewFromInt(2)},
+		},
+		Fallback: &DefaultSplitter{},
+	}
+	opt := &DailyAccountSplitActionOption{RootAccountID: "root", MainAccountID: "main", Vendor: enumor.TCloud}
+	mainAccount := &protocore.BaseMainAccount{BkBizID: 100, OpProductID: 200}
+
+	testCases := []struct {
+		name      string
+		item      *bill.RawBillItem
+		bizIDs    []int64
+		costs     []string
+		productID int64
+	}{
+		{
+			name: "match by tag",
+			item: &bill.RawBillItem{
+				Region:        "ap-shanghai",
+				HcProductCode: "cdb",
+				BillCost:      decimal.NewFromInt(10),
+				Extension:     types.JsonField(`{"Tags":[{"TagKey":"shared","TagValue":"yes"}]}`),
+			},
+			bizIDs: []int64{1, 2},
+			costs:  []string{"3", "7"},
+		},
+		{
+			name: "match by product and region, skip zero weight target",
+			item: &bill.RawBillItem{
+				Region:        "ap-guangzhou",
+				HcProductCode: "cvm",
+				BillCost:      decimal.NewFromInt(1),
+				Extension:     types.JsonField(`{}`),
+			},
+			bizIDs: []int64{3, 5},
+			costs:  []string{"0.3333333333", "0.6666666667"},
+		},
+		{
+			name: "not matched",
+			item: &bill.RawBillItem{
+				Region:        "ap-shanghai",
+				HcProductCode: "cvm",
+				BillCost:      decimal.NewFromInt(1),
+				Extension:     types.JsonField(`{}`),
+			},
+			bizIDs: []int64{100},
+			costs:  []string{"1"},
+		},
+	}
+	for _, test := range testCases {
+		result, err := rs.DoSplit(opt, 1, test.item, mainAccount)
+		assert.NoError(t, err, test.name)
+		assert.Len(t, result, len(test.bizIDs), test.name)
+
+		sum := decimal.Zero
+		for idx, one := range result {
+			assert.Equal(t, test.bizIDs[idx], one.BkBizID, test.name)
+			assert.Equal(t, test.costs[idx], one.Cost.String(), test.name)
+			assert.Equal(t, int64(200), one.ProductID, test.name)
+			sum = sum.Add(one.Cost)
+		}
+		assert.True(t, sum.Equal(test.item.BillCost), test.name)
+	}
+}
+
+func TestSplitByWeightsAllZero(t *testing.T) {
+	base := bill.BillItemCreateReq[rawjson.RawMessage]{Cost: decimal.NewFromInt(10), ResAmount: decimal.NewFromInt(3)}
+	targets := []billcore.SplitRuleTarget{{BkBizID: 1}, {BkBizID: 2}}
+	result := splitByWeights(base, targets, []decimal.Decimal{decimal.Zero, decimal.Zero})
+
+	assert.Len(t, result, 2)
+	assert.Equal(t, "5", result[0].Cost.String())
+	assert.Equal(t, "5", result[1].Cost.String())
+	assert.Equal(t, "1.5", result[0].ResAmount.String())
+	assert.Equal(t, "1.5", result[1].ResAmount.String())
+}
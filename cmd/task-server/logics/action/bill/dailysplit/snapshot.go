@@ -0,0 +1,107 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dailysplit
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	actcli "hcm/cmd/task-server/logics/action/cli"
+	"hcm/pkg/api/core"
+	"hcm/pkg/api/data-service/bill"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+)
+
+// cvmCountSnapshot 当天按主机数量分摊使用的主机数量快照，首次分账时记录，重新分账时复用，
+// 避免历史账单按当前的主机数量分摊
+type cvmCountSnapshot struct {
+	id      string
+	counts  map[string]int64
+	changed bool
+}
+
+// cvmCountKey 主机数量快照的key，由业务ID和排序后的地域组成
+func cvmCountKey(bkBizID int64, regions []string) string {
+	sorted := append([]string(nil), regions...)
+	sort.Strings(sorted)
+	return fmt.Sprintf("%d/%s", bkBizID, strings.Join(sorted, ","))
+}
+
+func getCvmCountSnapshot(kt *kit.Kit, opt *DailyAccountSplitActionOption, billDay int) (*cvmCountSnapshot, error) {
+	result, err := actcli.GetDataService().Global.Bill.ListBillSplitSnapshot(kt, &bill.BillSplitSnapshotListReq{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("main_account_id", opt.MainAccountID),
+			tools.RuleEqual("bill_year", opt.BillYear),
+			tools.RuleEqual("bill_month", opt.BillMonth),
+			tools.RuleEqual("bill_day", billDay),
+		),
+		Page: core.NewDefaultBasePage(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list split snapshot of %v day %d failed, err %s", opt, billDay, err.Error())
+	}
+
+	snapshot := &cvmCountSnapshot{counts: make(map[string]int64)}
+	if len(result.Details) == 0 {
+		return snapshot, nil
+	}
+	snapshot.id = result.Details[0].ID
+	for key, count := range result.Details[0].CvmCounts {
+		snapshot.counts[key] = count
+	}
+	return snapshot, nil
+}
+
+// save 记录新统计的主机数量，快照中已有的主机数量不会被覆盖
+func (s *cvmCountSnapshot) save(kt *kit.Kit, opt *DailyAccountSplitActionOption, billDay int) error {
+	if !s.changed {
+		return nil
+	}
+
+	if len(s.id) != 0 {
+		err := actcli.GetDataService().Global.Bill.UpdateBillSplitSnapshot(kt, &bill.BillSplitSnapshotUpdateReq{
+			ID:        s.id,
+			CvmCounts: s.counts,
+		})
+		if err != nil {
+			return fmt.Errorf("update split snapshot %s failed, err %s", s.id, err.Error())
+		}
+		return nil
+	}
+
+	result, err := actcli.GetDataService().Global.Bill.CreateBillSplitSnapshot(kt, &bill.BillSplitSnapshotCreateReq{
+		RootAccountID: opt.RootAccountID,
+		MainAccountID: opt.MainAccountID,
+		Vendor:        opt.Vendor,
+		BillYear:      opt.BillYear,
+		BillMonth:     opt.BillMonth,
+		BillDay:       billDay,
+		CvmCounts:     s.counts,
+	})
+	if err != nil {
+		return fmt.Errorf("create split snapshot of %v day %d failed, err %s", opt, billDay, err.Error())
+	}
+	logs.Infof("create split snapshot %s of %v day %d, rid: %s", result.ID, opt, billDay, kt.Rid)
+	s.id = result.ID
+	return nil
+}
@@ -0,0 +1,150 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package itemexport ...
+package itemexport
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	actcli "hcm/cmd/task-server/logics/action/cli"
+	corebill "hcm/pkg/api/core/bill"
+	"hcm/pkg/async/action/run"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/runtime/filter"
+	"hcm/pkg/tools/export"
+)
+
+// DownloadURLExpire 导出文件下载链接有效期
+const DownloadURLExpire = 24 * time.Hour
+
+// ExportBillItemOption option for bill item export action
+type ExportBillItemOption struct {
+	Vendor   enumor.Vendor           `json:"vendor" validate:"required"`
+	Format   enumor.BillExportFormat `json:"format" validate:"required"`
+	Filter   *filter.Expression      `json:"filter" validate:"required"`
+	Limit    uint64                  `json:"limit" validate:"omitempty"`
+	FilePath string                  `json:"file_path" validate:"required"`
+}
+
+// Validate ExportBillItemOption
+func (opt *ExportBillItemOption) Validate() error {
+	if err := opt.Format.Validate(); err != nil {
+		return err
+	}
+	return validator.Validate.Struct(opt)
+}
+
+// ExportBillItemResult result of bill item export action
+type ExportBillItemResult struct {
+	Count       uint64 `json:"count"`
+	FilePath    string `json:"file_path"`
+	DownloadURL string `json:"download_url"`
+	ExpiredAt   string `json:"expired_at"`
+}
+
+// ExportBillItemAction export bill items to object store
+type ExportBillItemAction struct{}
+
+// ParameterNew return request params.
+func (act ExportBillItemAction) ParameterNew() interface{} {
+	return new(ExportBillItemOption)
+}
+
+// Name return action name
+func (act ExportBillItemAction) Name() enumor.ActionName {
+	return enumor.ActionExportBillItem
+}
+
+// Run export bill items, rows are streamed to object store through a pipe without holding all of them in memory
+func (act ExportBillItemAction) Run(kt run.ExecuteKit, params interface{}) (interface{}, error) {
+	opt, ok := params.(*ExportBillItemOption)
+	if !ok {
+		return nil, errf.New(errf.InvalidParameter, "params type mismatch")
+	}
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+	ostore := actcli.GetObjectStore()
+	if ostore == nil {
+		return nil, errf.New(errf.Aborted, "object store is not configured, can not export bill items")
+	}
+
+	exporter, err := corebill.NewBillItemExporter(opt.Vendor)
+	if err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	reader, writer := io.Pipe()
+	countCh := make(chan uint64, 1)
+	go func() {
+		count, err := writeBillItems(kt.Kit(), exporter, opt, writer)
+		// err为nil时等同于Close，上传端读到EOF结束
+		_ = writer.CloseWithError(err)
+		countCh <- count
+	}()
+
+	if err := ostore.Upload(kt.Kit().Ctx, opt.FilePath, reader); err != nil {
+		// 通知写入端停止查询
+		_ = reader.CloseWithError(err)
+		<-countCh
+		logs.Errorf("upload bill item export file %s failed, err: %v, rid: %s", opt.FilePath, err, kt.Kit().Rid)
+		return nil, err
+	}
+	count := <-countCh
+
+	url, err := ostore.GetPresignedURL(kt.Kit().Ctx, opt.FilePath, DownloadURLExpire)
+	if err != nil {
+		logs.Errorf("get bill item export file %s download url failed, err: %v, rid: %s",
+			opt.FilePath, err, kt.Kit().Rid)
+		return nil, err
+	}
+	logs.Infof("export %d %s bill items to %s, rid: %s", count, opt.Vendor, opt.FilePath, kt.Kit().Rid)
+
+	return &ExportBillItemResult{
+		Count:       count,
+		FilePath:    opt.FilePath,
+		DownloadURL: url,
+		ExpiredAt:   time.Now().Add(DownloadURLExpire).Format(time.RFC3339),
+	}, nil
+}
+
+func writeBillItems(kt *kit.Kit, exporter *corebill.BillItemExporter, opt *ExportBillItemOption,
+	w io.Writer) (uint64, error) {
+
+	fileWriter, err := export.NewWriter(opt.Format, w, exporter.Header())
+	if err != nil {
+		return 0, err
+	}
+	lister := actcli.GetDataService().Global.Bill.BillItemRawLister(kt, opt.Filter)
+	count, err := exporter.Export(fileWriter, opt.Limit, lister)
+	if err != nil {
+		return count, fmt.Errorf("export bill items failed, exported: %d, err: %v", count, err)
+	}
+	if err := fileWriter.Close(); err != nil {
+		return count, err
+	}
+	return count, nil
+}
@@ -153,6 +153,10 @@ func (act MainAccountSummaryAction) Run(kt run.ExecuteKit, params interface{}) (
 	}
 	if isCurMonthAccounted {
 		req.State = constant.MainAccountBillSummaryStateAccounted
+		if err := act.syncSummaryVersion(kt.Kit(), opt, summary.CurrentVersion, currency, exhangeRate); err != nil {
+			logs.Warnf("failed to sync bill summary version of %v, err %s, rid: %s", opt, err.Error(), kt.Kit().Rid)
+			return nil, err
+		}
 	}
 	if err := actcli.GetDataService().Global.Bill.UpdateBillSummaryMain(kt.Kit(), req); err != nil {
 		logs.Warnf("failed to update main account bill summary %v, err %s, rid: %s", opt, err.Error(), kt.Kit().Rid)
@@ -0,0 +1,155 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package mainsummary
+
+import (
+	"fmt"
+	"strconv"
+
+	"hcm/cmd/task-server/logics/action/bill/dailysplit"
+	actcli "hcm/cmd/task-server/logics/action/cli"
+	"hcm/pkg/api/core"
+	dataservice "hcm/pkg/api/data-service"
+	"hcm/pkg/api/data-service/bill"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+
+	"github.com/shopspring/decimal"
+)
+
+type versionCostKey struct {
+	ProductID int64
+	BkBizID   int64
+}
+
+// syncSummaryVersion 当月出账后，按运营产品和业务记录当前版本分账结果，便于分账规则变更并重新核算后追溯各版本的成本归属
+func (act *MainAccountSummaryAction) syncSummaryVersion(kt *kit.Kit, opt *MainAccountSummaryActionOption,
+	versionID int, currency enumor.CurrencyCode, exchangeRate *decimal.Decimal) error {
+
+	versionFilter := tools.ExpressionAnd(
+		tools.RuleEqual("first_account_id", opt.RootAccountID),
+		tools.RuleEqual("second_account_id", opt.MainAccountID),
+		tools.RuleEqual("vendor", opt.Vendor),
+		tools.RuleEqual("bill_year", opt.BillYear),
+		tools.RuleEqual("bill_month", opt.BillMonth),
+		tools.RuleEqual("version_id", strconv.Itoa(versionID)),
+	)
+	existResult, err := actcli.GetDataService().Global.Bill.ListBillSummaryVersion(kt, &bill.BillSummaryVersionListReq{
+		Filter: versionFilter,
+		Page:   core.NewCountPage(),
+	})
+	if err != nil {
+		return fmt.Errorf("count bill summary version of %v failed, err %s", opt, err.Error())
+	}
+	// 同一版本的分账结果不会再变化，已记录过则跳过
+	if existResult.Count != nil && *existResult.Count > 0 {
+		return nil
+	}
+
+	costMap, err := act.getVersionCostByBiz(kt, opt, versionID)
+	if err != nil {
+		return err
+	}
+
+	// 记录当前生效的分账规则，便于追溯各版本分账结果对应的规则
+	rules, err := dailysplit.ListSplitRules(kt, opt.MainAccountID)
+	if err != nil {
+		return err
+	}
+	ruleSnapshot, err := types.NewJsonField(rules)
+	if err != nil {
+		return fmt.Errorf("marshal split rules of %v failed, err %s", opt, err.Error())
+	}
+
+	for key, cost := range costMap {
+		req := &bill.BillSummaryVersionCreateReq{
+			FirstAccountID:    opt.RootAccountID,
+			SecondAccountID:   opt.MainAccountID,
+			Vendor:            opt.Vendor,
+			ProductID:         key.ProductID,
+			BkBizID:           key.BkBizID,
+			BillYear:          opt.BillYear,
+			BillMonth:         opt.BillMonth,
+			VersionID:         strconv.Itoa(versionID),
+			Currency:          string(currency),
+			Cost:              cost,
+			RMBCost:           decimal.Zero,
+			SplitRuleSnapshot: ruleSnapshot,
+		}
+		if exchangeRate != nil {
+			req.RMBCost = cost.Mul(*exchangeRate)
+		}
+		if _, err := actcli.GetDataService().Global.Bill.CreateBillSummaryVersion(kt, req); err != nil {
+			// 清理已写入的部分记录，下次执行时重新记录
+			delErr := actcli.GetDataService().Global.Bill.BatchDeleteBillSummaryVersion(kt,
+				&dataservice.BatchDeleteReq{Filter: versionFilter})
+			if delErr != nil {
+				logs.Errorf("clean bill summary version of %v failed, err: %v, rid: %s", opt, delErr, kt.Rid)
+			}
+			return fmt.Errorf("create bill summary version %+v failed, err %s", req, err.Error())
+		}
+	}
+	logs.Infof("sync %d bill summary version of %v version %d, rid: %s", len(costMap), opt, versionID, kt.Rid)
+	return nil
+}
+
+func (act *MainAccountSummaryAction) getVersionCostByBiz(kt *kit.Kit, opt *MainAccountSummaryActionOption,
+	versionID int) (map[versionCostKey]decimal.Decimal, error) {
+
+	itemFilter := tools.ExpressionAnd(
+		tools.RuleEqual("root_account_id", opt.RootAccountID),
+		tools.RuleEqual("main_account_id", opt.MainAccountID),
+		tools.RuleEqual("vendor", opt.Vendor),
+		tools.RuleEqual("bill_year", opt.BillYear),
+		tools.RuleEqual("bill_month", opt.BillMonth),
+		tools.RuleEqual("version_id", versionID),
+	)
+	countResult, err := actcli.GetDataService().Global.Bill.ListBillItem(kt, &bill.BillItemListReq{
+		Filter: itemFilter,
+		Page:   core.NewCountPage(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("count bill item of %v version %d failed, err %s", opt, versionID, err.Error())
+	}
+
+	costMap := make(map[versionCostKey]decimal.Decimal)
+	limit := uint64(core.DefaultMaxPageLimit)
+	for start := uint64(0); start < countResult.Count; start = start + limit {
+		result, err := actcli.GetDataService().Global.Bill.ListBillItem(kt, &bill.BillItemListReq{
+			Filter: itemFilter,
+			Page: &core.BasePage{
+				Start: uint32(start),
+				Limit: uint(limit),
+				Sort:  "id",
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list bill item of %v version %d failed, err %s", opt, versionID, err.Error())
+		}
+		for _, item := range result.Details {
+			key := versionCostKey{ProductID: item.ProductID, BkBizID: item.BkBizID}
+			costMap[key] = costMap[key].Add(item.Cost)
+		}
+	}
+	return costMap, nil
+}
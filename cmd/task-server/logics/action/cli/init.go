@@ -24,11 +24,13 @@ import (
 	dataservice "hcm/pkg/client/data-service"
 	hcservice "hcm/pkg/client/hc-service"
 	"hcm/pkg/dal/dao"
+	"hcm/pkg/dal/objectstore"
 )
 
 var (
 	cliSet *client.ClientSet
 	daoSet dao.Set
+	ostore objectstore.Storage
 )
 
 // SetClientSet set client set.
@@ -55,3 +57,13 @@ func SetDaoSet(cli dao.Set) {
 func GetDaoSet() dao.Set {
 	return daoSet
 }
+
+// SetObjectStore set object store.
+func SetObjectStore(store objectstore.Storage) {
+	ostore = store
+}
+
+// GetObjectStore get object store, return nil if object store is not configured.
+func GetObjectStore() objectstore.Storage {
+	return ostore
+}
@@ -61,3 +61,20 @@ func BuildCreateCvmTasks(totalCount int64, bizID int64, limit int64,
 
 	return tasks
 }
+
+// AppendRegisterDnsTask 追加注册主机私有域A记录的任务，依赖所有创建主机的任务
+func AppendRegisterDnsTask(tasks []ts.CustomFlowTask, opt *RegisterDnsRecordOption) []ts.CustomFlowTask {
+	actionIDs := make([]action.ActIDType, 0)
+	for _, one := range tasks {
+		if one.ActionName == enumor.ActionCreateCvm {
+			actionIDs = append(actionIDs, one.ActionID)
+		}
+	}
+
+	return append(tasks, ts.CustomFlowTask{
+		ActionID:   action.ActIDType(uuid.UUID()),
+		ActionName: enumor.ActionRegisterCvmDnsRecord,
+		Params:     opt,
+		DependOn:   actionIDs,
+	})
+}
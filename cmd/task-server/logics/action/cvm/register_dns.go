@@ -0,0 +1,295 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package actioncvm
+
+import (
+	"fmt"
+	"strings"
+
+	actcli "hcm/cmd/task-server/logics/action/cli"
+	actionprivatedns "hcm/cmd/task-server/logics/action/private-dns"
+	typesdns "hcm/pkg/adaptor/types/private-dns"
+	"hcm/pkg/api/core"
+	corecvm "hcm/pkg/api/core/cloud/cvm"
+	coreprivatedns "hcm/pkg/api/core/cloud/private-dns"
+	hcprivatedns "hcm/pkg/api/hc-service/private-dns"
+	"hcm/pkg/async/action"
+	"hcm/pkg/async/action/run"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	tableasync "hcm/pkg/dal/table/async"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/assert"
+	"hcm/pkg/tools/slice"
+)
+
+var _ action.Action = new(RegisterDnsRecordAction)
+var _ action.ParameterAction = new(RegisterDnsRecordAction)
+
+// RegisterDnsRecordAction 为新创建的主机注册私有域A记录
+type RegisterDnsRecordAction struct{}
+
+// RegisterDnsRecordOption register cvm dns record option.
+type RegisterDnsRecordOption struct {
+	Vendor    enumor.Vendor `json:"vendor" validate:"required"`
+	AccountID string        `json:"account_id" validate:"required"`
+	ZoneID    string        `json:"zone_id" validate:"required"`
+	TTL       int64         `json:"ttl" validate:"omitempty"`
+}
+
+// Validate RegisterDnsRecordOption.
+func (opt RegisterDnsRecordOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ParameterNew ...
+func (act RegisterDnsRecordAction) ParameterNew() (params interface{}) {
+	return new(RegisterDnsRecordOption)
+}
+
+// Name return action name.
+func (act RegisterDnsRecordAction) Name() enumor.ActionName {
+	return enumor.ActionRegisterCvmDnsRecord
+}
+
+// Run 以主机名称作为主机记录，主机内网IP作为记录值创建A记录，同名主机及主机名称已被占用时追加实例ID后缀
+func (act RegisterDnsRecordAction) Run(kt run.ExecuteKit, params interface{}) (interface{}, error) {
+	opt, ok := params.(*RegisterDnsRecordOption)
+	if !ok {
+		return nil, errf.New(errf.InvalidParameter, "params type mismatch")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	idsStr, exist := kt.ShareData().Get(SaveCreateCvmCloudIDKey)
+	if !exist {
+		return nil, fmt.Errorf("cvm_cloud_ids is required for register dns record")
+	}
+
+	if err := validateDnsZone(kt.Kit(), opt); err != nil {
+		return nil, err
+	}
+
+	cloudIDs := tableasync.ParseIDsStr(idsStr)
+	cvms := make([]corecvm.BaseCvm, 0, len(cloudIDs))
+	for _, partIDs := range slice.Split(cloudIDs, constant.BatchOperationMaxLimit) {
+		listReq := &core.ListReq{
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("vendor", opt.Vendor),
+				tools.RuleEqual("account_id", opt.AccountID),
+				tools.RuleIn("cloud_id", partIDs),
+			),
+			Page: core.NewDefaultBasePage(),
+		}
+		listResult, err := actcli.GetDataService().Global.Cvm.ListCvm(kt.Kit(), listReq)
+		if err != nil {
+			logs.Errorf("list cvm failed, err: %v, cloudIDs: %v, rid: %s", err, partIDs, kt.Kit().Rid)
+			return nil, err
+		}
+
+		for _, one := range listResult.Details {
+			if len(one.PrivateIPv4Addresses) == 0 {
+				logs.Warnf("cvm: %s has no private ipv4 address, skip register dns record, rid: %s", one.ID,
+					kt.Kit().Rid)
+				continue
+			}
+			cvms = append(cvms, one)
+		}
+	}
+
+	candidateMap := dnsRecordNameCandidates(cvms)
+	existRecords, err := listZoneARecords(kt.Kit(), opt.ZoneID, candidateMap)
+	if err != nil {
+		return nil, err
+	}
+
+	// 任务重试时已注册的记录直接复用，不重复创建
+	recordIDs := make([]string, 0, len(cvms))
+	for _, one := range cvms {
+		name, recordID, ok := pickDnsRecordName(candidateMap[one.ID], existRecords, one.PrivateIPv4Addresses)
+		if !ok {
+			return &RegisterDnsRecordResult{RecordIDs: recordIDs}, errf.Newf(errf.InvalidParameter,
+				"dns record names %v of cvm: %s are used by other records", candidateMap[one.ID], one.ID)
+		}
+		if len(recordID) != 0 {
+			recordIDs = append(recordIDs, recordID)
+			continue
+		}
+
+		req := &hcprivatedns.RecordCreateReq{
+			ZoneID: opt.ZoneID,
+			RecordSpec: typesdns.RecordSpec{
+				Name:  name,
+				Type:  enumor.PrivateDnsRecordA,
+				Value: one.PrivateIPv4Addresses,
+				TTL:   opt.TTL,
+			},
+		}
+		result, err := actionprivatedns.CreateRecord(kt.Kit(), opt.Vendor, req)
+		if err != nil {
+			return &RegisterDnsRecordResult{RecordIDs: recordIDs}, err
+		}
+		recordIDs = append(recordIDs, result.IDs...)
+		existRecords[name] = coreprivatedns.Record{Name: name, Value: one.PrivateIPv4Addresses}
+	}
+
+	return &RegisterDnsRecordResult{RecordIDs: recordIDs}, nil
+}
+
+// listZoneARecords 查询私有域下候选主机记录已存在的A记录，返回 主机记录 -> 解析记录 的映射
+func listZoneARecords(kt *kit.Kit, zoneID string, candidateMap map[string][]string) (
+	map[string]coreprivatedns.Record, error) {
+
+	names := make([]string, 0, len(candidateMap))
+	for _, candidates := range candidateMap {
+		names = append(names, candidates...)
+	}
+
+	recordMap := make(map[string]coreprivatedns.Record)
+	for _, batch := range slice.Split(slice.Unique(names), constant.BatchOperationMaxLimit) {
+		listReq := &core.ListReq{
+			Fields: []string{"id", "name", "value"},
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("zone_id", zoneID),
+				tools.RuleEqual("type", enumor.PrivateDnsRecordA),
+				tools.RuleIn("name", batch),
+			),
+			Page: core.NewDefaultBasePage(),
+		}
+		result, err := actcli.GetDataService().Global.PrivateDnsRecord.List(kt, listReq)
+		if err != nil {
+			logs.Errorf("list private dns record failed, err: %v, zone: %s, names: %v, rid: %s", err, zoneID,
+				batch, kt.Rid)
+			return nil, err
+		}
+		for _, one := range result.Details {
+			recordMap[one.Name] = one
+		}
+	}
+
+	return recordMap, nil
+}
+
+// validateDnsZone 私有域需要与主机属于同一账号
+func validateDnsZone(kt *kit.Kit, opt *RegisterDnsRecordOption) error {
+	listReq := &core.ListReq{
+		Filter: tools.EqualExpression("id", opt.ZoneID),
+		Page:   core.NewDefaultBasePage(),
+	}
+	result, err := actcli.GetDataService().Global.PrivateDnsZone.List(kt, listReq)
+	if err != nil {
+		logs.Errorf("list private dns zone failed, err: %v, id: %s, rid: %s", err, opt.ZoneID, kt.Rid)
+		return err
+	}
+
+	if len(result.Details) == 0 {
+		return errf.Newf(errf.RecordNotFound, "private dns zone: %s not found", opt.ZoneID)
+	}
+
+	zone := result.Details[0]
+	if zone.Vendor != opt.Vendor || zone.AccountID != opt.AccountID {
+		return errf.Newf(errf.InvalidParameter, "private dns zone: %s not belong to account: %s", opt.ZoneID,
+			opt.AccountID)
+	}
+
+	return nil
+}
+
+// convDnsRecordName 主机名称转换为主机记录，只保留小写字母、数字和"-"
+func convDnsRecordName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		default:
+			return '-'
+		}
+	}, name)
+}
+
+// dnsLabelMaxLen 主机记录的最大长度
+const dnsLabelMaxLen = 63
+
+// dnsRecordNameCandidates 返回每台主机可用的主机记录，按优先级排列。同一批主机名称重复时只使用带实例ID后缀的主机记录，
+// 否则优先使用主机名称，主机名称被其他主机占用时使用带实例ID后缀的主机记录
+func dnsRecordNameCandidates(cvms []corecvm.BaseCvm) map[string][]string {
+	nameCount := make(map[string]int, len(cvms))
+	for _, one := range cvms {
+		nameCount[convDnsRecordName(one.Name)]++
+	}
+
+	candidateMap := make(map[string][]string, len(cvms))
+	for _, one := range cvms {
+		name := convDnsRecordName(one.Name)
+		suffixed := appendDnsRecordSuffix(name, one.CloudID)
+		if nameCount[name] > 1 {
+			candidateMap[one.ID] = []string{suffixed}
+			continue
+		}
+		candidateMap[one.ID] = []string{name, suffixed}
+	}
+
+	return candidateMap
+}
+
+// appendDnsRecordSuffix 为主机记录追加实例ID后缀，Azure 等云的实例ID为资源路径，只取最后一段，超长时截断主机名称部分
+func appendDnsRecordSuffix(name, cloudID string) string {
+	suffix := convDnsRecordName(cloudID[strings.LastIndex(cloudID, "/")+1:])
+	if len(suffix) > dnsLabelMaxLen-1 {
+		suffix = suffix[len(suffix)-(dnsLabelMaxLen-1):]
+	}
+
+	if maxLen := dnsLabelMaxLen - 1 - len(suffix); len(name) > maxLen {
+		name = name[:maxLen]
+	}
+
+	return name + "-" + suffix
+}
+
+// pickDnsRecordName 按优先级选择主机记录，已存在且记录值为主机内网IP的记录直接复用并返回记录ID，
+// 没有被占用的主机记录需要新建，全部被其他记录占用时返回false
+func pickDnsRecordName(candidates []string, existRecords map[string]coreprivatedns.Record, ips []string) (
+	string, string, bool) {
+
+	for _, name := range candidates {
+		record, exists := existRecords[name]
+		if !exists {
+			return name, "", true
+		}
+		if assert.IsStringSliceEqual(record.Value, ips) {
+			return name, record.ID, true
+		}
+	}
+
+	return "", "", false
+}
+
+// RegisterDnsRecordResult register cvm dns record result.
+type RegisterDnsRecordResult struct {
+	RecordIDs []string `json:"record_ids"`
+}
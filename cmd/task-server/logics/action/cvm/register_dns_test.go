@@ -0,0 +1,118 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package actioncvm
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	corecvm "hcm/pkg/api/core/cloud/cvm"
+	coreprivatedns "hcm/pkg/api/core/cloud/private-dns"
+)
+
+func TestConvDnsRecordName(t *testing.T) {
+	cases := map[string]string{
+		"web-01":     "web-01",
+		"Web_Server": "web-server",
+		"db.node 1":  "db-node-1",
+		"主机":         "--",
+	}
+
+	for name, expect := range cases {
+		if got := convDnsRecordName(name); got != expect {
+			t.Errorf("convDnsRecordName(%s) = %s, expect: %s", name, got, expect)
+		}
+	}
+}
+
+func TestAppendDnsRecordSuffix(t *testing.T) {
+	cases := []struct {
+		name    string
+		cloudID string
+		expect  string
+	}{
+		{name: "web", cloudID: "ins-abc123", expect: "web-ins-abc123"},
+		{name: "web", cloudID: "/subscriptions/xx/resourceGroups/rg/providers/vm/Web_1", expect: "web-web-1"},
+		{name: strings.Repeat("a", 60), cloudID: "i-0123", expect: strings.Repeat("a", 56) + "-i-0123"},
+		{name: "web", cloudID: strings.Repeat("b", 70), expect: "-" + strings.Repeat("b", 62)},
+	}
+
+	for _, c := range cases {
+		got := appendDnsRecordSuffix(c.name, c.cloudID)
+		if got != c.expect {
+			t.Errorf("appendDnsRecordSuffix(%s, %s) = %s, expect: %s", c.name, c.cloudID, got, c.expect)
+		}
+		if len(got) > dnsLabelMaxLen {
+			t.Errorf("dns record name %s exceeds max length %d", got, dnsLabelMaxLen)
+		}
+	}
+}
+
+func TestDnsRecordNameCandidates(t *testing.T) {
+	cvms := []corecvm.BaseCvm{
+		{ID: "1", CloudID: "ins-1", Name: "web"},
+		{ID: "2", CloudID: "ins-2", Name: "Web"},
+		{ID: "3", CloudID: "ins-3", Name: "db"},
+	}
+
+	expect := map[string][]string{
+		"1": {"web-ins-1"},
+		"2": {"web-ins-2"},
+		"3": {"db", "db-ins-3"},
+	}
+
+	if got := dnsRecordNameCandidates(cvms); !reflect.DeepEqual(got, expect) {
+		t.Errorf("dnsRecordNameCandidates = %v, expect: %v", got, expect)
+	}
+}
+
+func TestPickDnsRecordName(t *testing.T) {
+	exists := map[string]coreprivatedns.Record{
+		"db":       {ID: "r1", Name: "db", Value: []string{"10.0.0.1"}},
+		"web":      {ID: "r2", Name: "web", Value: []string{"10.0.0.2"}},
+		"web-ins1": {ID: "r3", Name: "web-ins1", Value: []string{"10.0.0.3"}},
+	}
+
+	cases := []struct {
+		candidates []string
+		ips        []string
+		name       string
+		recordID   string
+		ok         bool
+	}{
+		// 不存在，需要新建
+		{candidates: []string{"app", "app-ins1"}, ips: []string{"10.0.0.9"}, name: "app", ok: true},
+		// 已注册过，复用记录
+		{candidates: []string{"db", "db-ins1"}, ips: []string{"10.0.0.1"}, name: "db", recordID: "r1", ok: true},
+		// 主机名称被其他主机占用，使用带后缀的主机记录
+		{candidates: []string{"db", "db-ins2"}, ips: []string{"10.0.0.4"}, name: "db-ins2", ok: true},
+		// 全部被占用
+		{candidates: []string{"web", "web-ins1"}, ips: []string{"10.0.0.4"}, ok: false},
+	}
+
+	for idx, c := range cases {
+		name, recordID, ok := pickDnsRecordName(c.candidates, exists, c.ips)
+		if name != c.name || recordID != c.recordID || ok != c.ok {
+			t.Errorf("case %d: got (%s, %s, %v), expect: (%s, %s, %v)", idx, name, recordID, ok, c.name,
+				c.recordID, c.ok)
+		}
+	}
+}
@@ -23,6 +23,7 @@ import (
 	actionbilldailypull "hcm/cmd/task-server/logics/action/bill/dailypull"
 	actionbillsplit "hcm/cmd/task-server/logics/action/bill/dailysplit"
 	actiondailysummary "hcm/cmd/task-server/logics/action/bill/dailysummary"
+	actionbillexport "hcm/cmd/task-server/logics/action/bill/itemexport"
 	actionmainsummary "hcm/cmd/task-server/logics/action/bill/mainsummary"
 	actionrootsummary "hcm/cmd/task-server/logics/action/bill/rootsummary"
 	actcli "hcm/cmd/task-server/logics/action/cli"
@@ -30,18 +31,22 @@ import (
 	actioneip "hcm/cmd/task-server/logics/action/eip"
 	actionfirewall "hcm/cmd/task-server/logics/action/firewall"
 	actionlb "hcm/cmd/task-server/logics/action/load-balancer"
+	actionprivatedns "hcm/cmd/task-server/logics/action/private-dns"
 	actionsg "hcm/cmd/task-server/logics/action/security-group"
+	actionsnapshot "hcm/cmd/task-server/logics/action/snapshot"
 	actionsubnet "hcm/cmd/task-server/logics/action/subnet"
 	actionflow "hcm/cmd/task-server/logics/flow"
 	"hcm/pkg/async/action"
 	"hcm/pkg/client"
 	"hcm/pkg/dal/dao"
+	"hcm/pkg/dal/objectstore"
 )
 
 // Init init action.
-func Init(cli *client.ClientSet, dao dao.Set) {
+func Init(cli *client.ClientSet, dao dao.Set, ostore objectstore.Storage) {
 	actcli.SetClientSet(cli)
 	actcli.SetDaoSet(dao)
+	actcli.SetObjectStore(ostore)
 
 	register()
 }
@@ -53,6 +58,7 @@ func register() {
 	action.RegisterAction(actioncvm.NewDeleteAction())
 	action.RegisterAction(actioncvm.CreateCvmAction{})
 	action.RegisterAction(actioncvm.AssignCvmAction{})
+	action.RegisterAction(actioncvm.RegisterDnsRecordAction{})
 
 	action.RegisterAction(actionfirewall.DeleteAction{})
 
@@ -73,7 +79,13 @@ func register() {
 
 	action.RegisterAction(actionbilldailypull.PullDailyBillAction{})
 	action.RegisterAction(actionbillsplit.DailyAccountSplitAction{})
+	action.RegisterAction(actionbillexport.ExportBillItemAction{})
 	action.RegisterAction(actiondailysummary.DailySummaryAction{})
 	action.RegisterAction(actionmainsummary.MainAccountSummaryAction{})
 	action.RegisterAction(actionrootsummary.RootAccountSummaryAction{})
+
+	action.RegisterAction(actionsnapshot.CreatePolicySnapshotAction{})
+	action.RegisterAction(actionsnapshot.CleanExpiredSnapshotAction{})
+
+	action.RegisterAction(actionprivatedns.DeleteRecordAction{})
 }
@@ -48,6 +48,10 @@ type DeleteLoadBalancerAction struct{}
 type DeleteLoadBalancerOption struct {
 	Vendor                                   enumor.Vendor `json:"vendor,omitempty" validate:"required"`
 	hcproto.TCloudBatchDeleteLoadbalancerReq `json:",inline"`
+	hcproto.AwsBatchDeleteLoadBalancerReq    `json:",inline"`
+	hcproto.AzureBatchDeleteLoadBalancerReq  `json:",inline"`
+	hcproto.GcpBatchDeleteLoadBalancerReq    `json:",inline"`
+	hcproto.HuaWeiBatchDeleteLoadBalancerReq `json:",inline"`
 }
 
 // MarshalJSON DeleteLoadBalancerOption.
@@ -63,6 +67,38 @@ func (opt DeleteLoadBalancerOption) MarshalJSON() ([]byte, error) {
 			Vendor:                           opt.Vendor,
 			TCloudBatchDeleteLoadbalancerReq: opt.TCloudBatchDeleteLoadbalancerReq,
 		}
+	case enumor.Aws:
+		req = struct {
+			Vendor                                enumor.Vendor `json:"vendor" validate:"required"`
+			hcproto.AwsBatchDeleteLoadBalancerReq `json:",inline"`
+		}{
+			Vendor:                        opt.Vendor,
+			AwsBatchDeleteLoadBalancerReq: opt.AwsBatchDeleteLoadBalancerReq,
+		}
+	case enumor.Azure:
+		req = struct {
+			Vendor                                  enumor.Vendor `json:"vendor" validate:"required"`
+			hcproto.AzureBatchDeleteLoadBalancerReq `json:",inline"`
+		}{
+			Vendor:                          opt.Vendor,
+			AzureBatchDeleteLoadBalancerReq: opt.AzureBatchDeleteLoadBalancerReq,
+		}
+	case enumor.Gcp:
+		req = struct {
+			Vendor                                enumor.Vendor `json:"vendor" validate:"required"`
+			hcproto.GcpBatchDeleteLoadBalancerReq `json:",inline"`
+		}{
+			Vendor:                        opt.Vendor,
+			GcpBatchDeleteLoadBalancerReq: opt.GcpBatchDeleteLoadBalancerReq,
+		}
+	case enumor.HuaWei:
+		req = struct {
+			Vendor                                   enumor.Vendor `json:"vendor" validate:"required"`
+			hcproto.HuaWeiBatchDeleteLoadBalancerReq `json:",inline"`
+		}{
+			Vendor:                           opt.Vendor,
+			HuaWeiBatchDeleteLoadBalancerReq: opt.HuaWeiBatchDeleteLoadBalancerReq,
+		}
 	default:
 		return nil, fmt.Errorf("vendor: %s not support", opt.Vendor)
 	}
@@ -77,6 +113,14 @@ func (opt *DeleteLoadBalancerOption) UnmarshalJSON(raw []byte) (err error) {
 	switch opt.Vendor {
 	case enumor.TCloud:
 		err = json.Unmarshal(raw, &opt.TCloudBatchDeleteLoadbalancerReq)
+	case enumor.Aws:
+		err = json.Unmarshal(raw, &opt.AwsBatchDeleteLoadBalancerReq)
+	case enumor.Azure:
+		err = json.Unmarshal(raw, &opt.AzureBatchDeleteLoadBalancerReq)
+	case enumor.Gcp:
+		err = json.Unmarshal(raw, &opt.GcpBatchDeleteLoadBalancerReq)
+	case enumor.HuaWei:
+		err = json.Unmarshal(raw, &opt.HuaWeiBatchDeleteLoadBalancerReq)
 	default:
 		return fmt.Errorf("vendor: %s not support", opt.Vendor)
 	}
@@ -86,8 +130,27 @@ func (opt *DeleteLoadBalancerOption) UnmarshalJSON(raw []byte) (err error) {
 
 // Validate validate option.
 func (opt DeleteLoadBalancerOption) Validate() error {
+	if err := opt.Vendor.Validate(); err != nil {
+		return err
+	}
 
-	return validator.Validate.Struct(opt)
+	var req validator.Interface
+	switch opt.Vendor {
+	case enumor.TCloud:
+		req = &opt.TCloudBatchDeleteLoadbalancerReq
+	case enumor.Aws:
+		req = &opt.AwsBatchDeleteLoadBalancerReq
+	case enumor.Azure:
+		req = &opt.AzureBatchDeleteLoadBalancerReq
+	case enumor.Gcp:
+		req = &opt.GcpBatchDeleteLoadBalancerReq
+	case enumor.HuaWei:
+		req = &opt.HuaWeiBatchDeleteLoadBalancerReq
+	default:
+		return fmt.Errorf("vendor: %s not support", opt.Vendor)
+	}
+
+	return req.Validate()
 }
 
 // ParameterNew return request params.
@@ -111,14 +174,26 @@ func (act DeleteLoadBalancerAction) Run(kt run.ExecuteKit, params any) (any, err
 	switch opt.Vendor {
 	case enumor.TCloud:
 		err = actcli.GetHCService().TCloud.Clb.BatchDeleteLoadBalancer(kt.Kit(), &opt.TCloudBatchDeleteLoadbalancerReq)
-		if err != nil {
-			logs.Errorf("fail to delete tcloud load balancer, err: %v, opt: %+v rid: %s",
-				err, opt.TCloudBatchDeleteLoadbalancerReq, kt.Kit().Rid)
-			return nil, err
-		}
+	case enumor.Aws:
+		err = actcli.GetHCService().Aws.LoadBalancer.BatchDeleteLoadBalancer(kt.Kit(),
+			&opt.AwsBatchDeleteLoadBalancerReq)
+	case enumor.Azure:
+		err = actcli.GetHCService().Azure.LoadBalancer.BatchDeleteLoadBalancer(kt.Kit(),
+			&opt.AzureBatchDeleteLoadBalancerReq)
+	case enumor.Gcp:
+		err = actcli.GetHCService().Gcp.LoadBalancer.BatchDeleteLoadBalancer(kt.Kit(),
+			&opt.GcpBatchDeleteLoadBalancerReq)
+	case enumor.HuaWei:
+		err = actcli.GetHCService().HuaWei.LoadBalancer.BatchDeleteLoadBalancer(kt.Kit(),
+			&opt.HuaWeiBatchDeleteLoadBalancerReq)
 	default:
 		return nil, fmt.Errorf("vendor: %s not support", opt.Vendor)
 	}
+	if err != nil {
+		logs.Errorf("fail to delete %s load balancer, err: %v, opt: %+v rid: %s", opt.Vendor, err, opt,
+			kt.Kit().Rid)
+		return nil, err
+	}
 
 	return nil, nil
 }
@@ -0,0 +1,122 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package actionlb
+
+import (
+	"reflect"
+	"testing"
+
+	"hcm/pkg/api/data-service/cloud"
+	hcproto "hcm/pkg/api/hc-service/load-balancer"
+	"hcm/pkg/criteria/enumor"
+	cvt "hcm/pkg/tools/converter"
+	"hcm/pkg/tools/json"
+)
+
+func TestDeleteLoadBalancerOptionJSON(t *testing.T) {
+	cases := []DeleteLoadBalancerOption{
+		{
+			Vendor: enumor.TCloud,
+			TCloudBatchDeleteLoadbalancerReq: hcproto.TCloudBatchDeleteLoadbalancerReq{
+				AccountID: "account", Region: "ap-guangzhou", IDs: []string{"lb-1"}},
+		},
+		{
+			Vendor: enumor.Aws,
+			AwsBatchDeleteLoadBalancerReq: hcproto.AwsBatchDeleteLoadBalancerReq{
+				AccountID: "account", Region: "us-east-1", IDs: []string{"lb-1", "lb-2"}},
+		},
+		{
+			Vendor: enumor.Azure,
+			AzureBatchDeleteLoadBalancerReq: hcproto.AzureBatchDeleteLoadBalancerReq{
+				AccountID: "account", IDs: []string{"lb-1"}},
+		},
+		{
+			Vendor: enumor.Gcp,
+			GcpBatchDeleteLoadBalancerReq: hcproto.GcpBatchDeleteLoadBalancerReq{
+				AccountID: "account", IDs: []string{"lb-1"}},
+		},
+		{
+			Vendor: enumor.HuaWei,
+			HuaWeiBatchDeleteLoadBalancerReq: hcproto.HuaWeiBatchDeleteLoadBalancerReq{
+				AccountID: "account", Region: "cn-south-1", IDs: []string{"lb-1"}},
+		},
+	}
+
+	for _, c := range cases {
+		raw, err := json.Marshal(c)
+		if err != nil {
+			t.Errorf("marshal %s option failed, err: %v", c.Vendor, err)
+			continue
+		}
+
+		got := new(DeleteLoadBalancerOption)
+		if err = json.Unmarshal(raw, got); err != nil {
+			t.Errorf("unmarshal %s option failed, err: %v", c.Vendor, err)
+			continue
+		}
+		if !reflect.DeepEqual(*got, c) {
+			t.Errorf("%s option = %+v, expect: %+v", c.Vendor, *got, c)
+		}
+		if err = got.Validate(); err != nil {
+			t.Errorf("validate %s option failed, err: %v", c.Vendor, err)
+		}
+	}
+
+	if err := (DeleteLoadBalancerOption{Vendor: enumor.Aws}).Validate(); err == nil {
+		t.Errorf("validate aws option without ids should fail")
+	}
+}
+
+func TestOperateRsOptionJSON(t *testing.T) {
+	rs := []*cloud.TargetBaseReq{{InstType: enumor.CvmInstType, CloudInstID: "i-1", Port: 80,
+		Weight: cvt.ValToPtr(int64(10))}}
+	cases := []OperateRsOption{
+		{
+			Vendor: enumor.TCloud,
+			TCloudBatchOperateTargetReq: hcproto.TCloudBatchOperateTargetReq{
+				TargetGroupID: "tg-1", LbID: "lb-1", RsList: rs},
+		},
+		{
+			Vendor: enumor.Aws,
+			AwsBatchOperateTargetReq: hcproto.AwsBatchOperateTargetReq{
+				TargetGroupID: "tg-1", RsList: rs},
+		},
+	}
+
+	for _, c := range cases {
+		raw, err := json.Marshal(c)
+		if err != nil {
+			t.Errorf("marshal %s option failed, err: %v", c.Vendor, err)
+			continue
+		}
+
+		got := new(OperateRsOption)
+		if err = json.Unmarshal(raw, got); err != nil {
+			t.Errorf("unmarshal %s option failed, err: %v", c.Vendor, err)
+			continue
+		}
+		if !reflect.DeepEqual(*got, c) {
+			t.Errorf("%s option = %+v, expect: %+v", c.Vendor, *got, c)
+		}
+		if err = got.Validate(); err != nil {
+			t.Errorf("validate %s option failed, err: %v", c.Vendor, err)
+		}
+	}
+}
@@ -42,6 +42,7 @@ const SaveRsCloudIDKey = "batch_rs_cloud_ids"
 
 var _ action.Action = new(AddTargetToGroupAction)
 var _ action.ParameterAction = new(AddTargetToGroupAction)
+var _ action.CompensateAction = new(AddTargetToGroupAction)
 
 // AddTargetToGroupAction define add rs action.
 type AddTargetToGroupAction struct{}
@@ -50,6 +51,7 @@ type AddTargetToGroupAction struct{}
 type OperateRsOption struct {
 	Vendor                           enumor.Vendor `json:"vendor" validate:"required"`
 	hclb.TCloudBatchOperateTargetReq `json:",inline"`
+	hclb.AwsBatchOperateTargetReq    `json:",inline"`
 }
 
 // MarshalJSON marshal json.
@@ -64,6 +66,14 @@ func (opt OperateRsOption) MarshalJSON() ([]byte, error) {
 			Vendor:                      opt.Vendor,
 			TCloudBatchOperateTargetReq: opt.TCloudBatchOperateTargetReq,
 		}
+	case enumor.Aws:
+		req = struct {
+			Vendor                        enumor.Vendor `json:"vendor" validate:"required"`
+			hclb.AwsBatchOperateTargetReq `json:",inline"`
+		}{
+			Vendor:                   opt.Vendor,
+			AwsBatchOperateTargetReq: opt.AwsBatchOperateTargetReq,
+		}
 
 	default:
 		return nil, fmt.Errorf("vendor: %s not support", opt.Vendor)
@@ -79,6 +89,8 @@ func (opt *OperateRsOption) UnmarshalJSON(raw []byte) (err error) {
 	switch opt.Vendor {
 	case enumor.TCloud:
 		err = json.Unmarshal(raw, &opt.TCloudBatchOperateTargetReq)
+	case enumor.Aws:
+		err = json.Unmarshal(raw, &opt.AwsBatchOperateTargetReq)
 	default:
 		return fmt.Errorf("vendor: %s not support", opt.Vendor)
 	}
@@ -96,6 +108,8 @@ func (opt OperateRsOption) Validate() error {
 	switch opt.Vendor {
 	case enumor.TCloud:
 		req = &opt.TCloudBatchOperateTargetReq
+	case enumor.Aws:
+		req = &opt.AwsBatchOperateTargetReq
 	default:
 		return fmt.Errorf("vendor: %s not support", opt.Vendor)
 	}
@@ -129,7 +143,10 @@ func (act AddTargetToGroupAction) Run(kt run.ExecuteKit, params interface{}) (in
 	switch opt.Vendor {
 	case enumor.TCloud:
 		result, err = actcli.GetHCService().TCloud.Clb.BatchAddRs(
-			kt.Kit(), opt.TargetGroupID, &opt.TCloudBatchOperateTargetReq)
+			kt.Kit(), opt.TCloudBatchOperateTargetReq.TargetGroupID, &opt.TCloudBatchOperateTargetReq)
+	case enumor.Aws:
+		result, err = actcli.GetHCService().Aws.LoadBalancer.BatchAddRs(
+			kt.Kit(), opt.AwsBatchOperateTargetReq.TargetGroupID, &opt.AwsBatchOperateTargetReq)
 	default:
 		return nil, fmt.Errorf("vendor: %s not support", opt.Vendor)
 	}
@@ -157,6 +174,32 @@ func (act AddTargetToGroupAction) Rollback(kt run.ExecuteKit, params interface{}
 	return nil
 }
 
+// Compensate 任务流失败或被取消时，将已经添加到目标组的RS移除
+func (act AddTargetToGroupAction) Compensate(kt run.ExecuteKit, params interface{}) error {
+	opt, ok := params.(*OperateRsOption)
+	if !ok {
+		return errf.New(errf.InvalidParameter, "params type mismatch")
+	}
+
+	var err error
+	switch opt.Vendor {
+	case enumor.TCloud:
+		_, err = actcli.GetHCService().TCloud.Clb.BatchRemoveTarget(
+			kt.Kit(), opt.TCloudBatchOperateTargetReq.TargetGroupID, &opt.TCloudBatchOperateTargetReq)
+	case enumor.Aws:
+		err = actcli.GetHCService().Aws.LoadBalancer.BatchRemoveTarget(
+			kt.Kit(), opt.AwsBatchOperateTargetReq.TargetGroupID, &opt.AwsBatchOperateTargetReq)
+	default:
+		return fmt.Errorf("vendor: %s not support", opt.Vendor)
+	}
+	if err != nil {
+		logs.Errorf("compensate batch add rs failed, err: %v, rid: %s", err, kt.Kit().Rid)
+		return err
+	}
+
+	return nil
+}
+
 // --------------------------[批量移除RS]-----------------------------
 
 var _ action.Action = new(RemoveTargetAction)
@@ -187,7 +230,10 @@ func (act RemoveTargetAction) Run(kt run.ExecuteKit, params interface{}) (interf
 	switch opt.Vendor {
 	case enumor.TCloud:
 		_, err = actcli.GetHCService().TCloud.Clb.BatchRemoveTarget(
-			kt.Kit(), opt.TargetGroupID, &opt.TCloudBatchOperateTargetReq)
+			kt.Kit(), opt.TCloudBatchOperateTargetReq.TargetGroupID, &opt.TCloudBatchOperateTargetReq)
+	case enumor.Aws:
+		err = actcli.GetHCService().Aws.LoadBalancer.BatchRemoveTarget(
+			kt.Kit(), opt.AwsBatchOperateTargetReq.TargetGroupID, &opt.AwsBatchOperateTargetReq)
 	default:
 		return nil, fmt.Errorf("vendor: %s not support", opt.Vendor)
 	}
@@ -235,7 +281,7 @@ func (act ModifyTargetPortAction) Run(kt run.ExecuteKit, params interface{}) (in
 	switch opt.Vendor {
 	case enumor.TCloud:
 		err = actcli.GetHCService().TCloud.Clb.BatchModifyTargetPort(
-			kt.Kit(), opt.TargetGroupID, &opt.TCloudBatchOperateTargetReq)
+			kt.Kit(), opt.TCloudBatchOperateTargetReq.TargetGroupID, &opt.TCloudBatchOperateTargetReq)
 	default:
 		return nil, fmt.Errorf("vendor: %s not support", opt.Vendor)
 	}
@@ -283,7 +329,7 @@ func (act ModifyTargetWeightAction) Run(kt run.ExecuteKit, params interface{}) (
 	switch opt.Vendor {
 	case enumor.TCloud:
 		err = actcli.GetHCService().TCloud.Clb.BatchModifyTargetWeight(
-			kt.Kit(), opt.TargetGroupID, &opt.TCloudBatchOperateTargetReq)
+			kt.Kit(), opt.TCloudBatchOperateTargetReq.TargetGroupID, &opt.TCloudBatchOperateTargetReq)
 	default:
 		return nil, fmt.Errorf("vendor: %s not support", opt.Vendor)
 	}
@@ -0,0 +1,128 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package actionprivatedns ...
+package actionprivatedns
+
+import (
+	actcli "hcm/cmd/task-server/logics/action/cli"
+	hcprivatedns "hcm/pkg/api/hc-service/private-dns"
+	"hcm/pkg/async/action"
+	"hcm/pkg/async/action/run"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+)
+
+var _ action.Action = new(DeleteRecordAction)
+var _ action.ParameterAction = new(DeleteRecordAction)
+
+// DeleteRecordAction delete private dns record action.
+type DeleteRecordAction struct{}
+
+// DeleteRecordOption ...
+type DeleteRecordOption struct {
+	Vendor enumor.Vendor `json:"vendor" validate:"required"`
+	ID     string        `json:"id" validate:"required"`
+}
+
+// Validate ...
+func (opt *DeleteRecordOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ParameterNew ...
+func (act DeleteRecordAction) ParameterNew() (params interface{}) {
+	return new(DeleteRecordOption)
+}
+
+// Name return action name.
+func (act DeleteRecordAction) Name() enumor.ActionName {
+	return enumor.ActionDeletePrivateDnsRecord
+}
+
+// Run delete private dns record.
+func (act DeleteRecordAction) Run(kt run.ExecuteKit, params interface{}) (interface{}, error) {
+	opt, ok := params.(*DeleteRecordOption)
+	if !ok {
+		return nil, errf.New(errf.InvalidParameter, "params type mismatch")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	cli, err := getRecordCli(opt.Vendor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = cli.DeleteRecord(kt.Kit(), &hcprivatedns.RecordDeleteReq{ID: opt.ID}); err != nil {
+		logs.Errorf("delete private dns record failed, err: %v, vendor: %s, id: %s, rid: %s", err, opt.Vendor,
+			opt.ID, kt.Kit().Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// CreateRecord 调用对应云厂商的 hc-service 接口创建解析记录
+func CreateRecord(kt *kit.Kit, vendor enumor.Vendor, req *hcprivatedns.RecordCreateReq) (
+	*hcprivatedns.RecordCreateResult, error) {
+
+	cli, err := getRecordCli(vendor)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := cli.CreateRecord(kt, req)
+	if err != nil {
+		logs.Errorf("create private dns record failed, err: %v, vendor: %s, req: %+v, rid: %s", err, vendor, req,
+			kt.Rid)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// recordCli 各云厂商 hc-service 解析记录客户端的公共方法
+type recordCli interface {
+	CreateRecord(kt *kit.Kit, req *hcprivatedns.RecordCreateReq) (*hcprivatedns.RecordCreateResult, error)
+	DeleteRecord(kt *kit.Kit, req *hcprivatedns.RecordDeleteReq) error
+}
+
+func getRecordCli(vendor enumor.Vendor) (recordCli, error) {
+	cli := actcli.GetHCService()
+	switch vendor {
+	case enumor.TCloud:
+		return cli.TCloud.PrivateDns, nil
+	case enumor.Aws:
+		return cli.Aws.PrivateDns, nil
+	case enumor.HuaWei:
+		return cli.HuaWei.PrivateDns, nil
+	case enumor.Gcp:
+		return cli.Gcp.PrivateDns, nil
+	case enumor.Azure:
+		return cli.Azure.PrivateDns, nil
+	default:
+		return nil, errf.Newf(errf.InvalidParameter, "vendor: %s not support private dns", vendor)
+	}
+}
@@ -0,0 +1,127 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package actionsnapshot
+
+import (
+	"fmt"
+	"time"
+
+	actcli "hcm/cmd/task-server/logics/action/cli"
+	"hcm/pkg/api/core"
+	hcsnapshot "hcm/pkg/api/hc-service/snapshot"
+	"hcm/pkg/async/action/run"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/logs"
+)
+
+// CleanExpiredSnapshotAction 删除快照策略创建的超过保留天数的快照
+type CleanExpiredSnapshotAction struct {
+}
+
+// ParameterNew returns parameter of CleanExpiredSnapshotAction
+func (act CleanExpiredSnapshotAction) ParameterNew() (params interface{}) {
+	return new(PolicyOption)
+}
+
+// Name ActionCleanExpiredSnapshot
+func (act CleanExpiredSnapshotAction) Name() enumor.ActionName {
+	return enumor.ActionCleanExpiredSnapshot
+}
+
+// Run ...
+func (act CleanExpiredSnapshotAction) Run(kt run.ExecuteKit, params any) (any, error) {
+	opt, ok := params.(*PolicyOption)
+	if !ok {
+		return nil, errf.New(errf.InvalidParameter, "params type mismatch")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	policy, err := getPolicy(kt.Kit(), opt.PolicyID)
+	if err != nil {
+		logs.Errorf("get snapshot policy failed, err: %v, id: %s, rid: %s", err, opt.PolicyID, kt.Kit().Rid)
+		return nil, err
+	}
+
+	expireTime, needClean := snapshotExpireTime(time.Now(), policy.RetentionDays)
+	if !needClean {
+		return nil, nil
+	}
+
+	req := &core.ListReq{
+		Fields: []string{"id"},
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("policy_id", policy.ID),
+			tools.RuleLessThanEqual("created_at", expireTime.Format(constant.TimeStdFormat)),
+		),
+		Page: &core.BasePage{Start: 0, Limit: constant.BatchOperationMaxLimit},
+	}
+	expiredIDs := make([]string, 0)
+	for {
+		result, err := actcli.GetDataService().Global.Snapshot.List(kt.Kit(), req)
+		if err != nil {
+			logs.Errorf("list expired snapshot failed, err: %v, policy: %s, rid: %s", err, policy.ID, kt.Kit().Rid)
+			return nil, err
+		}
+
+		for _, one := range result.Details {
+			expiredIDs = append(expiredIDs, one.ID)
+		}
+
+		if uint(len(result.Details)) < req.Page.Limit {
+			break
+		}
+		req.Page.Start += uint32(req.Page.Limit)
+	}
+
+	cli, err := getSnapshotCli(policy.Vendor)
+	if err != nil {
+		return nil, err
+	}
+
+	failedIDs := make([]string, 0)
+	for _, id := range expiredIDs {
+		if err = cli.DeleteSnapshot(kt.Kit(), &hcsnapshot.SnapshotDeleteReq{ID: id}); err != nil {
+			logs.Errorf("delete expired snapshot failed, err: %v, policy: %s, id: %s, rid: %s", err, policy.ID, id,
+				kt.Kit().Rid)
+			failedIDs = append(failedIDs, id)
+		}
+	}
+
+	if len(failedIDs) != 0 {
+		return nil, fmt.Errorf("delete expired snapshot failed, ids: %v", failedIDs)
+	}
+
+	return nil, nil
+}
+
+// snapshotExpireTime 计算快照过期时间，创建时间早于等于该时间的快照需要清理。保留天数为0时不清理
+func snapshotExpireTime(now time.Time, retentionDays uint) (time.Time, bool) {
+	if retentionDays == 0 {
+		return time.Time{}, false
+	}
+
+	return now.AddDate(0, 0, -int(retentionDays)), true
+}
@@ -0,0 +1,49 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package actionsnapshot
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSnapshotExpireTime(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	cases := []struct {
+		retentionDays uint
+		expect        time.Time
+		needClean     bool
+	}{
+		{retentionDays: 0, needClean: false},
+		{retentionDays: 1, expect: time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC), needClean: true},
+		{retentionDays: 30, expect: time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC), needClean: true},
+	}
+
+	for _, c := range cases {
+		got, needClean := snapshotExpireTime(now, c.retentionDays)
+		if needClean != c.needClean {
+			t.Errorf("snapshotExpireTime(%d) needClean = %v, expect: %v", c.retentionDays, needClean, c.needClean)
+			continue
+		}
+		if needClean && !got.Equal(c.expect) {
+			t.Errorf("snapshotExpireTime(%d) = %v, expect: %v", c.retentionDays, got, c.expect)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package actionsnapshot
+
+import (
+	"fmt"
+	"time"
+
+	actcli "hcm/cmd/task-server/logics/action/cli"
+	"hcm/pkg/api/core"
+	hcsnapshot "hcm/pkg/api/hc-service/snapshot"
+	"hcm/pkg/async/action/run"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+)
+
+// CreatePolicySnapshotAction 为快照策略关联的云盘创建快照
+type CreatePolicySnapshotAction struct {
+}
+
+// ParameterNew returns parameter of CreatePolicySnapshotAction
+func (act CreatePolicySnapshotAction) ParameterNew() (params interface{}) {
+	return new(PolicyOption)
+}
+
+// Name ActionCreatePolicySnapshot
+func (act CreatePolicySnapshotAction) Name() enumor.ActionName {
+	return enumor.ActionCreatePolicySnapshot
+}
+
+// Run 单个云盘创建失败不影响其他云盘，全部执行完后返回失败的云盘
+func (act CreatePolicySnapshotAction) Run(kt run.ExecuteKit, params any) (any, error) {
+	opt, ok := params.(*PolicyOption)
+	if !ok {
+		return nil, errf.New(errf.InvalidParameter, "params type mismatch")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	policy, err := getPolicy(kt.Kit(), opt.PolicyID)
+	if err != nil {
+		logs.Errorf("get snapshot policy failed, err: %v, id: %s, rid: %s", err, opt.PolicyID, kt.Kit().Rid)
+		return nil, err
+	}
+
+	if !policy.Enabled || len(policy.DiskIDs) == 0 {
+		return nil, nil
+	}
+
+	listReq := &core.ListReq{
+		Fields: []string{"id"},
+		Filter: tools.ContainersExpression("id", policy.DiskIDs),
+		Page:   core.NewDefaultBasePage(),
+	}
+	disks, err := actcli.GetDataService().Global.ListDisk(kt.Kit(), listReq)
+	if err != nil {
+		logs.Errorf("list snapshot policy disk failed, err: %v, id: %s, rid: %s", err, opt.PolicyID, kt.Kit().Rid)
+		return nil, err
+	}
+
+	cli, err := getSnapshotCli(policy.Vendor)
+	if err != nil {
+		return nil, err
+	}
+
+	// gcp 快照名称只支持小写字母、数字和中划线，且需以字母开头
+	name := fmt.Sprintf("snap-%s-%s", policy.ID, time.Now().Format("20060102150405"))
+	failedDiskIDs := converter.StringSliceToMap(policy.DiskIDs)
+	for _, disk := range disks.Details {
+		req := &hcsnapshot.SnapshotCreateReq{
+			DiskID:   disk.ID,
+			Name:     name,
+			PolicyID: policy.ID,
+		}
+		if _, err = cli.CreateSnapshot(kt.Kit(), req); err != nil {
+			logs.Errorf("create policy snapshot failed, err: %v, policy: %s, disk: %s, rid: %s", err, policy.ID,
+				disk.ID, kt.Kit().Rid)
+			continue
+		}
+		delete(failedDiskIDs, disk.ID)
+	}
+
+	if len(failedDiskIDs) != 0 {
+		return nil, fmt.Errorf("create snapshot failed or disk not exist, disk ids: %v",
+			converter.MapKeyToStringSlice(failedDiskIDs))
+	}
+
+	return nil, nil
+}
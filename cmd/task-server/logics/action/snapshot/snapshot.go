@@ -0,0 +1,84 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package actionsnapshot ...
+package actionsnapshot
+
+import (
+	actcli "hcm/cmd/task-server/logics/action/cli"
+	"hcm/pkg/api/core"
+	coresnapshot "hcm/pkg/api/core/cloud/snapshot"
+	hcsnapshot "hcm/pkg/api/hc-service/snapshot"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+)
+
+// PolicyOption 快照策略相关 Action 的参数
+type PolicyOption struct {
+	PolicyID string `json:"policy_id" validate:"required"`
+}
+
+// Validate ...
+func (opt *PolicyOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// snapshotCli 各云厂商 hc-service 快照客户端的公共方法
+type snapshotCli interface {
+	CreateSnapshot(kt *kit.Kit, req *hcsnapshot.SnapshotCreateReq) (*hcsnapshot.SnapshotCreateResult, error)
+	DeleteSnapshot(kt *kit.Kit, req *hcsnapshot.SnapshotDeleteReq) error
+}
+
+func getSnapshotCli(vendor enumor.Vendor) (snapshotCli, error) {
+	cli := actcli.GetHCService()
+	switch vendor {
+	case enumor.TCloud:
+		return cli.TCloud.Snapshot, nil
+	case enumor.Aws:
+		return cli.Aws.Snapshot, nil
+	case enumor.HuaWei:
+		return cli.HuaWei.Snapshot, nil
+	case enumor.Gcp:
+		return cli.Gcp.Snapshot, nil
+	case enumor.Azure:
+		return cli.Azure.Snapshot, nil
+	default:
+		return nil, errf.Newf(errf.InvalidParameter, "vendor: %s not support snapshot", vendor)
+	}
+}
+
+func getPolicy(kt *kit.Kit, policyID string) (*coresnapshot.Policy, error) {
+	req := &core.ListReq{
+		Filter: tools.EqualExpression("id", policyID),
+		Page:   core.NewDefaultBasePage(),
+	}
+	result, err := actcli.GetDataService().Global.Snapshot.ListPolicy(kt, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Details) == 0 {
+		return nil, errf.Newf(errf.RecordNotFound, "snapshot policy: %s not found", policyID)
+	}
+
+	return &result.Details[0], nil
+}
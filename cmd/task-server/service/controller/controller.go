@@ -42,6 +42,7 @@ func Init(cap *capability.Capability) {
 
 	h.Add("UpdateCustomFlowState", "PATCH", "/custom_flows/state/update", svc.UpdateCustomFlowState)
 	h.Add("RetryFlowTask", "PATCH", "/flows/{flow_id}/tasks/{task_id}/retry", svc.RetryFlowTask)
+	h.Add("ReplayDeadLetterTasks", "POST", "/tasks/dead_letter/replay", svc.ReplayDeadLetterTasks)
 	h.Add("CancelFlow", "POST", "/flows/{flow_id}/cancel", svc.CancelFlow)
 
 	h.Load(cap.WebService)
@@ -94,6 +95,26 @@ func (p service) RetryFlowTask(cts *rest.Contexts) (any, error) {
 	return nil, nil
 }
 
+// ReplayDeadLetterTasks 重放死信任务，任务及其所属任务流重新进入待执行状态
+func (p service) ReplayDeadLetterTasks(cts *rest.Contexts) (any, error) {
+	opt := new(producer.ReplayDeadLetterTasksOption)
+	if err := cts.DecodeInto(opt); err != nil {
+		return nil, err
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	result, err := p.pro.ReplayDeadLetterTasks(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("task server replay dead letter tasks failed, err: %v, opt: %+v, rid: %s", err, opt, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return result, nil
+}
+
 // CancelFlow 取消任务，无条件终止
 func (p service) CancelFlow(cts *rest.Contexts) (any, error) {
 	// 终止任务
@@ -42,6 +42,9 @@ func Init(cap *capability.Capability) {
 	h.Add("CreateTemplateFlow", "POST", "/template_flows/create", svc.CreateTemplateFlow)
 	h.Add("CreateCustomFlow", "POST", "/custom_flows/create", svc.CreateCustomFlow)
 	h.Add("CloneFlow", "POST", "/flows/{flow_id}/clone", svc.CloneFlow)
+	h.Add("CreateCronFlow", "POST", "/cron_flows/create", svc.CreateCronFlow)
+	h.Add("UpdateCronFlow", "PATCH", "/cron_flows/{id}", svc.UpdateCronFlow)
+	h.Add("DeleteCronFlow", "DELETE", "/cron_flows/{id}", svc.DeleteCronFlow)
 
 	h.Load(cap.WebService)
 }
@@ -126,3 +129,62 @@ func (p service) CloneFlow(cts *rest.Contexts) (any, error) {
 
 	return &core.CreateResult{ID: id}, nil
 }
+
+// CreateCronFlow 创建周期任务流
+func (p service) CreateCronFlow(cts *rest.Contexts) (any, error) {
+	opt := new(producer.AddCronFlowOption)
+	if err := cts.DecodeInto(opt); err != nil {
+		return nil, err
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	id, err := p.pro.AddCronFlow(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("add cron flow failed, err: %v, opt: %+v, rid: %s", err, opt, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return &core.CreateResult{ID: id}, nil
+}
+
+// UpdateCronFlow 更新周期任务流
+func (p service) UpdateCronFlow(cts *rest.Contexts) (any, error) {
+	id := cts.PathParameter("id").String()
+	if len(id) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "id is required")
+	}
+
+	opt := new(producer.UpdateCronFlowOption)
+	if err := cts.DecodeInto(opt); err != nil {
+		return nil, err
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	if err := p.pro.UpdateCronFlow(cts.Kit, id, opt); err != nil {
+		logs.Errorf("update cron flow(%s) failed, err: %v, opt: %+v, rid: %s", id, err, opt, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// DeleteCronFlow 删除周期任务流
+func (p service) DeleteCronFlow(cts *rest.Contexts) (any, error) {
+	id := cts.PathParameter("id").String()
+	if len(id) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "id is required")
+	}
+
+	if err := p.pro.DeleteCronFlow(cts.Kit, id); err != nil {
+		logs.Errorf("delete cron flow(%s) failed, err: %v, rid: %s", id, err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return nil, nil
+}
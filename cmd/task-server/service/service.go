@@ -42,6 +42,7 @@ import (
 	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/criteria/errf"
 	"hcm/pkg/dal/dao"
+	"hcm/pkg/dal/objectstore"
 	"hcm/pkg/handler"
 	"hcm/pkg/logs"
 	"hcm/pkg/metrics"
@@ -52,6 +53,7 @@ import (
 	"hcm/pkg/tools/ssl"
 
 	"github.com/emicklei/go-restful/v3"
+	etcd3 "go.etcd.io/etcd/client/v3"
 )
 
 // Service do all the task server's work
@@ -90,7 +92,13 @@ func NewService(sd serviced.ServiceDiscover, shutdownWaitTimeSec int) (*Service,
 		return nil, err
 	}
 
-	logicsaction.Init(apiClientSet, dao)
+	// 对象存储用于存放账单导出等文件，未配置时相关任务会执行失败
+	oStore, err := objectstore.GetObjectStore(cc.TaskServer().Objectstore)
+	if err != nil {
+		return nil, err
+	}
+
+	logicsaction.Init(apiClientSet, dao, oStore)
 	async, err := createAndStartAsync(sd, dao, shutdownWaitTimeSec)
 	if err != nil {
 		return nil, err
@@ -112,7 +120,10 @@ func createAndStartAsync(sd serviced.ServiceDiscover, dao dao.Set, shutdownWaitT
 		return nil, err
 	}
 
-	leader := leader.NewLeader(sd)
+	leader, err := newEtcdLeader(sd)
+	if err != nil {
+		return nil, err
+	}
 	cfg := cc.TaskServer().Async
 	opt := &async.Option{
 		Register: metrics.Register(),
@@ -158,6 +169,42 @@ func createAndStartAsync(sd serviced.ServiceDiscover, dao dao.Set, shutdownWaitT
 	return async, nil
 }
 
+// newEtcdLeader 创建基于etcd租约选举的主节点控制器
+func newEtcdLeader(sd serviced.ServiceDiscover) (leader.Leader, error) {
+	etcdCfg, err := cc.TaskServer().Service.Etcd.ToConfig()
+	if err != nil {
+		return nil, fmt.Errorf("get etcd config failed, err: %v", err)
+	}
+
+	cli, err := etcd3.New(etcdCfg)
+	if err != nil {
+		return nil, fmt.Errorf("new etcd client failed, err: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	opt := &leader.EtcdLeaderOption{
+		Prefix:      serviced.ServiceElectionName(cc.TaskServerName),
+		LeaseTTLSec: cc.TaskServer().Async.Leader.LeaseTTLSec,
+	}
+	ld, err := leader.NewEtcdLeader(ctx, cli, sd, opt)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	go func() {
+		notifier := shutdown.AddNotifier()
+		select {
+		case <-notifier.Signal:
+			defer notifier.Done()
+			logs.Infof("start resign task server leader...")
+			cancel()
+		}
+	}()
+
+	return ld, nil
+}
+
 // ListenAndServeRest listen and serve the restful server
 func (s *Service) ListenAndServeRest() error {
 	root := http.NewServeMux()
@@ -29,6 +29,7 @@ import (
 	tableasync "hcm/pkg/dal/table/async"
 	"hcm/pkg/logs"
 	"hcm/pkg/rest"
+	"hcm/pkg/tools/times"
 )
 
 // ListFlow list flow.
@@ -67,13 +68,15 @@ func (svc *service) ListFlow(cts *rest.Contexts) (interface{}, error) {
 
 func convCoreFlow(one tableasync.AsyncFlowTable) coreasync.AsyncFlow {
 	return coreasync.AsyncFlow{
-		ID:        one.ID,
-		Name:      one.Name,
-		State:     one.State,
-		Reason:    one.Reason,
-		ShareData: one.ShareData,
-		Memo:      one.Memo,
-		Worker:    one.Worker,
+		ID:         one.ID,
+		Name:       one.Name,
+		State:      one.State,
+		Reason:     one.Reason,
+		ShareData:  one.ShareData,
+		Memo:       one.Memo,
+		Worker:     one.Worker,
+		Compensate: one.Compensate,
+		RunAt:      times.ConvStdTimeFormat(one.RunAt),
 		Revision: core.Revision{
 			Creator:   one.Creator,
 			Reviser:   one.Reviser,
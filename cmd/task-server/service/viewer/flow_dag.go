@@ -0,0 +1,317 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package viewer
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"hcm/pkg/api/core"
+	coreasync "hcm/pkg/api/core/async"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/dal/dao/types"
+	tableasync "hcm/pkg/dal/table/async"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/rest"
+	"hcm/pkg/tools/times"
+)
+
+// flowDAGSlowestTaskCount 依赖关系图中返回的耗时最长的任务数量
+const flowDAGSlowestTaskCount = 10
+
+// GetFlowDAG 查询任务流的依赖关系图，包括关键路径、耗时最长的任务以及Graphviz DOT格式描述。
+func (svc *service) GetFlowDAG(cts *rest.Contexts) (interface{}, error) {
+	id := cts.PathParameter("id").String()
+	opt := &types.ListOption{
+		Filter: tools.EqualExpression("id", id),
+		Page:   core.NewDefaultBasePage(),
+	}
+	result, err := svc.dao.AsyncFlow().List(cts.Kit, opt)
+	if err != nil {
+		logs.Errorf("list flow failed, err: %v, rid: %s", err, cts.Kit.Rid)
+		return nil, err
+	}
+
+	if len(result.Details) == 0 {
+		return nil, errf.Newf(errf.RecordNotFound, "flow: %s not found", id)
+	}
+
+	tasks, err := svc.listFlowTasks(cts.Kit, id)
+	if err != nil {
+		return nil, err
+	}
+
+	dag, err := buildFlowDAG(convCoreFlow(result.Details[0]), tasks, times.ConvStdTimeNow())
+	if err != nil {
+		logs.Errorf("build flow dag failed, err: %v, id: %s, rid: %s", err, id, cts.Kit.Rid)
+		return nil, err
+	}
+
+	return dag, nil
+}
+
+func (svc *service) listFlowTasks(kt *kit.Kit, flowID string) ([]tableasync.AsyncFlowTaskTable, error) {
+	opt := &types.ListOption{
+		Filter: tools.EqualExpression("flow_id", flowID),
+		Page:   core.NewDefaultBasePage(),
+	}
+
+	tasks := make([]tableasync.AsyncFlowTaskTable, 0)
+	for {
+		result, err := svc.dao.AsyncFlowTask().List(kt, opt)
+		if err != nil {
+			logs.Errorf("list task failed, err: %v, flow: %s, rid: %s", err, flowID, kt.Rid)
+			return nil, err
+		}
+
+		tasks = append(tasks, result.Details...)
+		if len(result.Details) < int(opt.Page.Limit) {
+			break
+		}
+		opt.Page.Start += uint32(opt.Page.Limit)
+	}
+
+	return tasks, nil
+}
+
+// buildFlowDAG 根据任务的依赖关系构建依赖关系图，关键路径为耗时之和最大的依赖链路。
+func buildFlowDAG(flow coreasync.AsyncFlow, tasks []tableasync.AsyncFlowTaskTable, now time.Time) (
+	*coreasync.FlowDAG, error) {
+
+	nodes := make([]coreasync.FlowDAGNode, 0, len(tasks))
+	index := make(map[string]int, len(tasks))
+	for _, one := range tasks {
+		if _, exist := index[one.ActionID]; exist {
+			return nil, fmt.Errorf("action id %s is duplicated", one.ActionID)
+		}
+		index[one.ActionID] = len(nodes)
+		nodes = append(nodes, convFlowDAGNode(one, now))
+	}
+
+	edges := make([]coreasync.FlowDAGEdge, 0)
+	children := make([][]int, len(nodes))
+	inDegree := make([]int, len(nodes))
+	for i, one := range tasks {
+		for _, parent := range one.DependOn {
+			parentIdx, exist := index[parent]
+			if !exist {
+				return nil, fmt.Errorf("action %s depend on not exist action %s", one.ActionID, parent)
+			}
+			edges = append(edges, coreasync.FlowDAGEdge{From: parent, To: one.ActionID})
+			children[parentIdx] = append(children[parentIdx], i)
+			inDegree[i]++
+		}
+	}
+
+	// 按拓扑序计算以每个节点结尾的最大耗时链路
+	dist := make([]float64, len(nodes))
+	prev := make([]int, len(nodes))
+	queue := make([]int, 0, len(nodes))
+	for i := range nodes {
+		prev[i] = -1
+		dist[i] = nodes[i].DurationSec
+		if inDegree[i] == 0 {
+			queue = append(queue, i)
+		}
+	}
+
+	visited := 0
+	for len(queue) != 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		visited++
+
+		for _, child := range children[cur] {
+			if dist[cur]+nodes[child].DurationSec > dist[child] || prev[child] == -1 {
+				dist[child] = dist[cur] + nodes[child].DurationSec
+				prev[child] = cur
+			}
+			inDegree[child]--
+			if inDegree[child] == 0 {
+				queue = append(queue, child)
+			}
+		}
+	}
+
+	if visited != len(nodes) {
+		return nil, fmt.Errorf("flow %s task dependency has cycle", flow.ID)
+	}
+
+	dag := &coreasync.FlowDAG{
+		Flow:         flow,
+		Nodes:        nodes,
+		Edges:        edges,
+		CriticalPath: make([]string, 0),
+		SlowestTasks: slowestFlowDAGNodes(nodes, flowDAGSlowestTaskCount),
+	}
+
+	if len(nodes) != 0 {
+		end := 0
+		for i := range dist {
+			if dist[i] > dist[end] {
+				end = i
+			}
+		}
+
+		for cur := end; cur != -1; cur = prev[cur] {
+			dag.CriticalPath = append([]string{nodes[cur].ActionID}, dag.CriticalPath...)
+		}
+		dag.CriticalPathDurationSec = dist[end]
+	}
+
+	dag.Dot = flowDAGToDot(dag)
+
+	return dag, nil
+}
+
+func convFlowDAGNode(task tableasync.AsyncFlowTaskTable, now time.Time) coreasync.FlowDAGNode {
+	node := coreasync.FlowDAGNode{
+		TaskID:     task.ID,
+		ActionID:   task.ActionID,
+		ActionName: task.ActionName,
+		State:      task.State,
+	}
+
+	started, ended := isTaskStarted(task), isTaskEnded(task)
+	if !started {
+		return node
+	}
+	node.StartedAt = times.ConvStdTimeFormat(task.StartedAt)
+
+	end := now
+	if ended {
+		end = task.EndedAt
+		node.EndedAt = times.ConvStdTimeFormat(task.EndedAt)
+	}
+
+	if end.After(task.StartedAt) {
+		node.DurationSec = end.Sub(task.StartedAt).Seconds()
+	}
+
+	return node
+}
+
+// isTaskStarted 任务创建时开始运行时间为默认值，需要根据任务状态判断任务是否已经开始运行
+func isTaskStarted(task tableasync.AsyncFlowTaskTable) bool {
+	var rollbackCount uint
+	var preState string
+	if task.Reason != nil {
+		rollbackCount, preState = task.Reason.RollbackCount, task.Reason.PreState
+	}
+
+	switch task.State {
+	case enumor.TaskInit, enumor.TaskPending:
+		return rollbackCount > 0
+	case enumor.TaskCancel:
+		return rollbackCount > 0 || preState == string(enumor.TaskRunning) ||
+			preState == string(enumor.TaskRollback)
+	default:
+		return true
+	}
+}
+
+// isTaskEnded 判断任务是否已经运行结束
+func isTaskEnded(task tableasync.AsyncFlowTaskTable) bool {
+	switch task.State {
+	case enumor.TaskSuccess, enumor.TaskFailed, enumor.TaskDeadLetter, enumor.TaskCompensating,
+		enumor.TaskCompensated, enumor.TaskCompensateFailed:
+		return true
+	case enumor.TaskCancel:
+		return isTaskStarted(task)
+	default:
+		return false
+	}
+}
+
+func slowestFlowDAGNodes(nodes []coreasync.FlowDAGNode, count int) []coreasync.FlowDAGNode {
+	slowest := make([]coreasync.FlowDAGNode, 0, len(nodes))
+	for _, one := range nodes {
+		if one.DurationSec > 0 {
+			slowest = append(slowest, one)
+		}
+	}
+
+	sort.SliceStable(slowest, func(i, j int) bool {
+		return slowest[i].DurationSec > slowest[j].DurationSec
+	})
+
+	if len(slowest) > count {
+		slowest = slowest[:count]
+	}
+
+	return slowest
+}
+
+var flowDAGStateColor = map[enumor.TaskState]string{
+	enumor.TaskSuccess:          "palegreen",
+	enumor.TaskFailed:           "lightcoral",
+	enumor.TaskDeadLetter:       "lightcoral",
+	enumor.TaskCompensateFailed: "lightcoral",
+	enumor.TaskRunning:          "lightskyblue",
+	enumor.TaskRollback:         "lightskyblue",
+	enumor.TaskCompensating:     "lightskyblue",
+	enumor.TaskCompensated:      "khaki",
+	enumor.TaskCancel:           "lightgrey",
+}
+
+// flowDAGToDot 生成Graphviz DOT格式的依赖关系图，关键路径上的节点和边标红
+func flowDAGToDot(dag *coreasync.FlowDAG) string {
+	critical := make(map[string]bool, len(dag.CriticalPath))
+	criticalEdges := make(map[coreasync.FlowDAGEdge]bool, len(dag.CriticalPath))
+	for i, one := range dag.CriticalPath {
+		critical[one] = true
+		if i > 0 {
+			criticalEdges[coreasync.FlowDAGEdge{From: dag.CriticalPath[i-1], To: one}] = true
+		}
+	}
+
+	b := new(strings.Builder)
+	fmt.Fprintf(b, "digraph %s {\n", strconv.Quote("flow_"+dag.Flow.ID))
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=\"rounded,filled\", fillcolor=white];\n")
+
+	for _, one := range dag.Nodes {
+		label := fmt.Sprintf("%s\n%s\n%s %.1fs", one.ActionID, one.ActionName, one.State, one.DurationSec)
+		attrs := fmt.Sprintf("label=%s", strconv.Quote(label))
+		if color, exist := flowDAGStateColor[one.State]; exist {
+			attrs += fmt.Sprintf(", fillcolor=%s", color)
+		}
+		if critical[one.ActionID] {
+			attrs += ", color=red, penwidth=2"
+		}
+		fmt.Fprintf(b, "  %s [%s];\n", strconv.Quote(one.ActionID), attrs)
+	}
+
+	for _, one := range dag.Edges {
+		attrs := ""
+		if criticalEdges[one] {
+			attrs = " [color=red, penwidth=2]"
+		}
+		fmt.Fprintf(b, "  %s -> %s%s;\n", strconv.Quote(one.From), strconv.Quote(one.To), attrs)
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
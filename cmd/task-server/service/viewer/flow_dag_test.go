@@ -0,0 +1,89 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package viewer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	coreasync "hcm/pkg/api/core/async"
+	"hcm/pkg/criteria/enumor"
+	tableasync "hcm/pkg/dal/table/async"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildFlowDAG(t *testing.T) {
+	base := time.Date(2024, 10, 1, 10, 0, 0, 0, time.Local)
+	newTask := func(actionID string, state enumor.TaskState, startSec, endSec int,
+		dependOn ...string) tableasync.AsyncFlowTaskTable {
+
+		return tableasync.AsyncFlowTaskTable{
+			ID:         "task-" + actionID,
+			ActionID:   actionID,
+			ActionName: enumor.ActionProduceTest,
+			State:      state,
+			DependOn:   dependOn,
+			StartedAt:  base.Add(time.Duration(startSec) * time.Second),
+			EndedAt:    base.Add(time.Duration(endSec) * time.Second),
+		}
+	}
+
+	// 1 -> 2 -> 4, 1 -> 3 -> 4，3 耗时更长；5 仍在运行中，按当前时间计算耗时，6 尚未开始
+	tasks := []tableasync.AsyncFlowTaskTable{
+		newTask("1", enumor.TaskSuccess, 0, 2),
+		newTask("2", enumor.TaskSuccess, 2, 3, "1"),
+		newTask("3", enumor.TaskSuccess, 2, 12, "1"),
+		newTask("4", enumor.TaskSuccess, 12, 13, "2", "3"),
+		newTask("5", enumor.TaskRunning, 13, 0, "4"),
+		newTask("6", enumor.TaskPending, 0, 0, "4"),
+	}
+
+	dag, err := buildFlowDAG(coreasync.AsyncFlow{ID: "flow"}, tasks, base.Add(14*time.Second))
+	assert.NoError(t, err)
+	assert.Len(t, dag.Nodes, 6)
+	assert.Len(t, dag.Edges, 6)
+	assert.Equal(t, []string{"1", "3", "4", "5"}, dag.CriticalPath)
+	assert.Equal(t, float64(14), dag.CriticalPathDurationSec)
+
+	assert.Equal(t, float64(1), dag.Nodes[4].DurationSec, "running task should be counted until now")
+	assert.Empty(t, dag.Nodes[4].EndedAt)
+	assert.Empty(t, dag.Nodes[5].StartedAt, "pending task should not have started_at")
+
+	assert.Equal(t, "3", dag.SlowestTasks[0].ActionID)
+	assert.Len(t, dag.SlowestTasks, 5)
+
+	assert.True(t, strings.HasPrefix(dag.Dot, `digraph "flow_flow" {`))
+	assert.Contains(t, dag.Dot, `"1" -> "3" [color=red, penwidth=2];`)
+	assert.Contains(t, dag.Dot, `"1" -> "2";`)
+}
+
+func TestBuildFlowDAGInvalidDependency(t *testing.T) {
+	tasks := []tableasync.AsyncFlowTaskTable{
+		{ActionID: "1", DependOn: []string{"2"}},
+		{ActionID: "2", DependOn: []string{"1"}},
+	}
+	_, err := buildFlowDAG(coreasync.AsyncFlow{ID: "flow"}, tasks, time.Now())
+	assert.Error(t, err, "cycle dependency should fail")
+
+	tasks = []tableasync.AsyncFlowTaskTable{{ActionID: "1", DependOn: []string{"not-exist"}}}
+	_, err = buildFlowDAG(coreasync.AsyncFlow{ID: "flow"}, tasks, time.Now())
+	assert.Error(t, err, "not exist dependency should fail")
+}
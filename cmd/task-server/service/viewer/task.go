@@ -29,6 +29,7 @@ import (
 	tableasync "hcm/pkg/dal/table/async"
 	"hcm/pkg/logs"
 	"hcm/pkg/rest"
+	"hcm/pkg/tools/times"
 )
 
 // ListTask list task.
@@ -75,9 +76,12 @@ func convCoreTask(one tableasync.AsyncFlowTaskTable) coreasync.AsyncFlowTask {
 		Params:     one.Params,
 		Result:     one.Result,
 		Retry:      one.Retry,
+		TimeoutSec: one.TimeoutSec,
 		DependOn:   one.DependOn,
 		State:      one.State,
 		Reason:     one.Reason,
+		StartedAt:  times.ConvStdTimeFormat(one.StartedAt),
+		EndedAt:    times.ConvStdTimeFormat(one.EndedAt),
 		Revision: core.Revision{
 			Creator:   one.Creator,
 			Reviser:   one.Reviser,
@@ -38,8 +38,10 @@ func Init(cap *capability.Capability) {
 
 	h.Add("ListFlow", "POST", "/flows/list", svc.ListFlow)
 	h.Add("GetFlow", "GET", "/flows/{id}", svc.GetFlow)
+	h.Add("GetFlowDAG", "GET", "/flows/{id}/dag", svc.GetFlowDAG)
 	h.Add("ListTask", "POST", "/tasks/list", svc.ListTask)
 	h.Add("GetTask", "GET", "/tasks/{id}", svc.GetTask)
+	h.Add("ListCronFlow", "POST", "/cron_flows/list", svc.ListCronFlow)
 
 	h.Load(cap.WebService)
 }
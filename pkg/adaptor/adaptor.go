@@ -27,8 +27,10 @@ import (
 	"hcm/pkg/adaptor/azure"
 	"hcm/pkg/adaptor/gcp"
 	"hcm/pkg/adaptor/huawei"
+	"hcm/pkg/adaptor/kaopu"
 	"hcm/pkg/adaptor/tcloud"
 	"hcm/pkg/adaptor/types"
+	"hcm/pkg/adaptor/zenlayer"
 )
 
 // Adaptor holds all the supported operations by the adaptor.
@@ -46,21 +48,31 @@ func (a *Adaptor) TCloud(s *types.BaseSecret) (tcloud.TCloud, error) {
 }
 
 // Aws returns Aws operations.
-func (a *Adaptor) Aws(s *types.BaseSecret, cloudAccountID string) (*aws.Aws, error) {
+func (a *Adaptor) Aws(s *types.BaseSecret, cloudAccountID string) (aws.Aws, error) {
 	return aws.NewAws(s, cloudAccountID)
 }
 
 // Gcp returns Gcp operations.
-func (a *Adaptor) Gcp(credential *types.GcpCredential) (*gcp.Gcp, error) {
+func (a *Adaptor) Gcp(credential *types.GcpCredential) (gcp.Gcp, error) {
 	return gcp.NewGcp(credential)
 }
 
 // Azure returns Azure operations.
-func (a *Adaptor) Azure(credential *types.AzureCredential) (*azure.Azure, error) {
+func (a *Adaptor) Azure(credential *types.AzureCredential) (azure.Azure, error) {
 	return azure.NewAzure(credential)
 }
 
 // HuaWei returns HuaWei operations.
-func (a *Adaptor) HuaWei(s *types.BaseSecret) (*huawei.HuaWei, error) {
+func (a *Adaptor) HuaWei(s *types.BaseSecret) (huawei.HuaWei, error) {
 	return huawei.NewHuaWei(s)
 }
+
+// Zenlayer returns Zenlayer operations.
+func (a *Adaptor) Zenlayer(s *types.BaseSecret) (zenlayer.Zenlayer, error) {
+	return zenlayer.NewZenlayer(s)
+}
+
+// Kaopu returns Kaopu operations.
+func (a *Adaptor) Kaopu(s *types.BaseSecret) (kaopu.Kaopu, error) {
+	return kaopu.NewKaopu(s)
+}
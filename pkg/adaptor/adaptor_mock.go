@@ -23,15 +23,19 @@
 package adaptor
 
 import (
-	"errors"
-
 	"hcm/pkg/adaptor/aws"
 	"hcm/pkg/adaptor/azure"
 	"hcm/pkg/adaptor/gcp"
 	"hcm/pkg/adaptor/huawei"
+	"hcm/pkg/adaptor/kaopu"
+	mockaws "hcm/pkg/adaptor/mock/aws"
+	mockazure "hcm/pkg/adaptor/mock/azure"
+	mockgcp "hcm/pkg/adaptor/mock/gcp"
+	mockhuawei "hcm/pkg/adaptor/mock/huawei"
 	mocktcloud "hcm/pkg/adaptor/mock/tcloud"
 	"hcm/pkg/adaptor/tcloud"
 	"hcm/pkg/adaptor/types"
+	"hcm/pkg/adaptor/zenlayer"
 	"hcm/pkg/logs"
 )
 
@@ -53,21 +57,37 @@ func (a *Adaptor) TCloud(s *types.BaseSecret) (tcloud.TCloud, error) {
 }
 
 // Aws returns Aws operations.
-func (a *Adaptor) Aws(s *types.BaseSecret, cloudAccountID string) (*aws.Aws, error) {
-	return nil, errors.New("mock of aws not implemented")
+func (a *Adaptor) Aws(s *types.BaseSecret, cloudAccountID string) (aws.Aws, error) {
+	mockAws := mockaws.GetMockCloud()
+	return mockAws, nil
 }
 
 // Gcp returns Gcp operations.
-func (a *Adaptor) Gcp(credential *types.GcpCredential) (*gcp.Gcp, error) {
-	return nil, errors.New("mock of gcp not implemented")
+func (a *Adaptor) Gcp(credential *types.GcpCredential) (gcp.Gcp, error) {
+	mockGcp := mockgcp.GetMockCloud()
+	return mockGcp, nil
 }
 
 // Azure returns Azure operations.
-func (a *Adaptor) Azure(credential *types.AzureCredential) (*azure.Azure, error) {
-	return nil, errors.New("mock of azure not implemented")
+func (a *Adaptor) Azure(credential *types.AzureCredential) (azure.Azure, error) {
+	mockAzure := mockazure.GetMockCloud()
+	return mockAzure, nil
 }
 
 // HuaWei returns HuaWei operations.
-func (a *Adaptor) HuaWei(s *types.BaseSecret) (*huawei.HuaWei, error) {
-	return nil, errors.New("mock of huawei not implemented")
+func (a *Adaptor) HuaWei(s *types.BaseSecret) (huawei.HuaWei, error) {
+	mockHuaWei := mockhuawei.GetMockCloud()
+	return mockHuaWei, nil
+}
+
+// zenlayer 和靠谱云暂无 mock 实现，可通过配置 cloudEndpoint 指向本地的 fake cloud 进行测试。
+
+// Zenlayer returns Zenlayer operations.
+func (a *Adaptor) Zenlayer(s *types.BaseSecret) (zenlayer.Zenlayer, error) {
+	return zenlayer.NewZenlayer(s)
+}
+
+// Kaopu returns Kaopu operations.
+func (a *Adaptor) Kaopu(s *types.BaseSecret) (kaopu.Kaopu, error) {
+	return kaopu.NewKaopu(s)
 }
@@ -36,7 +36,7 @@ import (
 
 // ListAccount 查询账号列表，因为账号列表的数量不会很多，且其他云也是全量返回，所以，这里将aws的账号列表进行了全量查询。
 // reference: https://docs.amazonaws.cn/organizations/latest/APIReference/API_ListAccounts.html
-func (a *Aws) ListAccount(kt *kit.Kit) ([]account.AwsAccount, error) {
+func (a *AwsImpl) ListAccount(kt *kit.Kit) ([]account.AwsAccount, error) {
 	client, err := a.clientSet.organizations()
 	if err != nil {
 		return nil, err
@@ -76,7 +76,7 @@ func (a *Aws) ListAccount(kt *kit.Kit) ([]account.AwsAccount, error) {
 
 // CountAccount 返回账号下子账号数量，基于 ListAccountsWithContext 接口
 // reference: https://docs.amazonaws.cn/organizations/latest/APIReference/API_ListAccounts.html
-func (a *Aws) CountAccount(kt *kit.Kit) (int32, error) {
+func (a *AwsImpl) CountAccount(kt *kit.Kit) (int32, error) {
 	client, err := a.clientSet.organizations()
 	if err != nil {
 		return 0, err
@@ -107,7 +107,7 @@ func (a *Aws) CountAccount(kt *kit.Kit) (int32, error) {
 
 // GetAccountInfoBySecret 根据秘钥获取账号信息
 // reference: https://docs.aws.amazon.com/STS/latest/APIReference/API_GetCallerIdentity.html
-func (a *Aws) GetAccountInfoBySecret(kt *kit.Kit) (*cloud.AwsInfoBySecret, error) {
+func (a *AwsImpl) GetAccountInfoBySecret(kt *kit.Kit) (*cloud.AwsInfoBySecret, error) {
 
 	client, err := a.clientSet.stsClient()
 	if err != nil {
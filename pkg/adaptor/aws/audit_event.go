@@ -0,0 +1,77 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"strconv"
+
+	typeauditevent "hcm/pkg/adaptor/types/audit-event"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+)
+
+// ListAuditEvent list audit event.
+// reference: https://docs.aws.amazon.com/awscloudtrail/latest/APIReference/API_LookupEvents.html
+func (a *AwsImpl) ListAuditEvent(kt *kit.Kit, opt *typeauditevent.AwsListOption) (*typeauditevent.AwsListResult,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.cloudTrailClient(opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &cloudtrail.LookupEventsInput{
+		StartTime:  aws.Time(opt.StartTime),
+		EndTime:    aws.Time(opt.EndTime),
+		MaxResults: aws.Int64(typeauditevent.AwsAuditEventMaxLimit),
+		NextToken:  opt.NextToken,
+		LookupAttributes: []*cloudtrail.LookupAttribute{
+			{
+				AttributeKey:   aws.String(cloudtrail.LookupAttributeKeyReadOnly),
+				AttributeValue: aws.String(strconv.FormatBool(opt.ReadOnly)),
+			},
+		},
+	}
+
+	resp, err := client.LookupEventsWithContext(kt.Ctx, req)
+	if err != nil {
+		logs.Errorf("lookup aws audit events failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	details := make([]typeauditevent.AwsEvent, 0, len(resp.Events))
+	for _, one := range resp.Events {
+		details = append(details, typeauditevent.AwsEvent{Event: one})
+	}
+
+	return &typeauditevent.AwsListResult{NextToken: resp.NextToken, Details: details}, nil
+}
@@ -25,16 +25,16 @@ import (
 )
 
 // NewAws new aws.
-func NewAws(s *types.BaseSecret, cloudAccountID string) (*Aws, error) {
+func NewAws(s *types.BaseSecret, cloudAccountID string) (Aws, error) {
 	if err := validateSecret(s); err != nil {
 		return nil, err
 	}
 
-	return &Aws{clientSet: newClientSet(s), cloudAccountID: cloudAccountID}, nil
+	return &AwsImpl{clientSet: newClientSet(s), cloudAccountID: cloudAccountID}, nil
 }
 
-// Aws is aws operator.
-type Aws struct {
+// AwsImpl is aws operator.
+type AwsImpl struct {
 	clientSet      *clientSet
 	cloudAccountID string
 }
@@ -52,6 +52,6 @@ func validateSecret(s *types.BaseSecret) error {
 }
 
 // CloudAccountID return cloud account id.
-func (a *Aws) CloudAccountID() string {
+func (a *AwsImpl) CloudAccountID() string {
 	return a.cloudAccountID
 }
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	typesBill "hcm/pkg/adaptor/types/bill"
+	billcore "hcm/pkg/api/core/bill"
 	"hcm/pkg/api/core/cloud"
 	"hcm/pkg/criteria/constant"
 	"hcm/pkg/criteria/errf"
@@ -75,7 +76,7 @@ const (
 )
 
 // GetBillList get bill list
-func (a *Aws) GetBillList(kt *kit.Kit, opt *typesBill.AwsBillListOption,
+func (a *AwsImpl) GetBillList(kt *kit.Kit, opt *typesBill.AwsBillListOption,
 	billInfo *cloud.AccountBillConfig[cloud.AwsBillConfigExtension]) (int64, interface{}, error) {
 
 	where, err := parseCondition(opt)
@@ -109,7 +110,7 @@ func (a *Aws) GetBillList(kt *kit.Kit, opt *typesBill.AwsBillListOption,
 }
 
 // GetBillTotal get bill total num
-func (a *Aws) GetBillTotal(kt *kit.Kit, where string, billInfo *cloud.AccountBillConfig[cloud.AwsBillConfigExtension]) (
+func (a *AwsImpl) GetBillTotal(kt *kit.Kit, where string, billInfo *cloud.AccountBillConfig[cloud.AwsBillConfigExtension]) (
 	int64, error) {
 
 	sql := fmt.Sprintf(QueryBillTotalSQL, billInfo.CloudDatabaseName, billInfo.CloudTableName, where)
@@ -120,16 +121,81 @@ func (a *Aws) GetBillTotal(kt *kit.Kit, where string, billInfo *cloud.AccountBil
 
 	total, err := strconv.ParseInt(cloudList[0]["_col0"], 10, 64)
 	if err != nil {
-		return 0, errf.Newf(errf.InvalidParameter, "get bill total parse id %s failed, err: %v", total, err)
+		return 0, errf.Newf(errf.InvalidParameter, "get bill total parse id %s failed, err: %v",
+			cloudList[0]["_col0"], err)
 	}
 
 	return total, nil
 }
 
-func (a *Aws) GetAwsAthenaQuery(kt *kit.Kit, query string,
+// GetRootAccountBillList get root account bill list of specified main account from cur data.
+func (a *AwsImpl) GetRootAccountBillList(kt *kit.Kit, opt *typesBill.AwsRootBillListOption,
+	billInfo *billcore.RootAccountBillConfig[billcore.AwsBillConfigExtension]) (int64, []map[string]string, error) {
+
+	if err := opt.Validate(); err != nil {
+		return 0, nil, err
+	}
+
+	where, err := rootAccountBillCondition(opt)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// 只有第一页时才返回数量
+	var total = int64(0)
+	if opt.Page != nil && opt.Page.Offset == 0 {
+		total, err = a.GetRootAccountBillTotal(kt, where, billInfo)
+		if err != nil {
+			return 0, nil, err
+		}
+		if total == 0 {
+			return 0, nil, nil
+		}
+	}
+
+	sql := rootAccountBillListSQL(billInfo.CloudDatabaseName, billInfo.CloudTableName, where, opt.Page)
+	list, err := a.athenaQuery(kt, sql, billInfo.Extension.Region, billInfo.Extension.SavePath,
+		billInfo.CloudDatabaseName, billInfo.RootAccountID)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return total, list, nil
+}
+
+// GetRootAccountBillTotal get root account bill total num
+func (a *AwsImpl) GetRootAccountBillTotal(kt *kit.Kit, where string,
+	billInfo *billcore.RootAccountBillConfig[billcore.AwsBillConfigExtension]) (int64, error) {
+
+	sql := fmt.Sprintf(QueryBillTotalSQL, billInfo.CloudDatabaseName, billInfo.CloudTableName, where)
+	cloudList, err := a.athenaQuery(kt, sql, billInfo.Extension.Region, billInfo.Extension.SavePath,
+		billInfo.CloudDatabaseName, billInfo.RootAccountID)
+	if err != nil {
+		return 0, err
+	}
+
+	total, err := strconv.ParseInt(cloudList[0]["_col0"], 10, 64)
+	if err != nil {
+		return 0, errf.Newf(errf.InvalidParameter, "get root account bill total parse id %s failed, err: %v",
+			cloudList[0]["_col0"], err)
+	}
+
+	return total, nil
+}
+
+// GetAwsAthenaQuery query cur data of account bill config by athena.
+func (a *AwsImpl) GetAwsAthenaQuery(kt *kit.Kit, query string,
 	billInfo *cloud.AccountBillConfig[cloud.AwsBillConfigExtension]) ([]map[string]string, error) {
 
-	client, err := a.clientSet.athenaClient(billInfo.Extension.Region)
+	return a.athenaQuery(kt, query, billInfo.Extension.Region, billInfo.Extension.SavePath,
+		billInfo.CloudDatabaseName, billInfo.AccountID)
+}
+
+// athenaQuery query cur data which is stored in s3 bucket by athena, accountID is only used in error message.
+func (a *AwsImpl) athenaQuery(kt *kit.Kit, query, region, savePath, databaseName, accountID string) (
+	[]map[string]string, error) {
+
+	client, err := a.clientSet.athenaClient(region)
 	if err != nil {
 		return nil, err
 	}
@@ -138,12 +204,13 @@ func (a *Aws) GetAwsAthenaQuery(kt *kit.Kit, query string,
 	s.SetQueryString(query)
 
 	var r athena.ResultConfiguration
-	r.SetOutputLocation(billInfo.Extension.SavePath)
+	r.SetOutputLocation(savePath)
 	s.SetResultConfiguration(&r)
 
 	result, err := client.StartQueryExecution(&s)
 	if err != nil {
-		logs.Errorf("aws athena start query error, billInfo: %+v, err: %v, rid: %s", billInfo, err, kt.Rid)
+		logs.Errorf("aws athena start query error, accountID: %s, database: %s, err: %v, rid: %s",
+			accountID, databaseName, err, kt.Rid)
 		return nil, err
 	}
 
@@ -215,13 +282,34 @@ func (a *Aws) GetAwsAthenaQuery(kt *kit.Kit, query string,
 		errMsg = *qrop.QueryExecution.Status.StateChangeReason
 	}
 
-	if strings.Contains(errMsg, fmt.Sprintf("%s does not exist", billInfo.CloudDatabaseName)) {
-		return nil, errf.Newf(errf.RecordNotFound, "accountID: %s bill record is not found", billInfo.AccountID)
+	if strings.Contains(errMsg, fmt.Sprintf("%s does not exist", databaseName)) {
+		return nil, errf.Newf(errf.RecordNotFound, "accountID: %s bill record is not found", accountID)
 	}
 
 	return nil, errf.Newf(errf.DecodeRequestFailed, "Aws Athena Query Failed(%s)", errMsg)
 }
 
+// rootAccountBillCondition 查询二级账号账单的条件，main_account_id 已在 opt.Validate 中校验为12位数字
+func rootAccountBillCondition(opt *typesBill.AwsRootBillListOption) (string, error) {
+	where, err := parseCondition(&typesBill.AwsBillListOption{BeginDate: opt.BeginDate, EndDate: opt.EndDate})
+	if err != nil {
+		return "", err
+	}
+
+	return where + fmt.Sprintf(" AND line_item_usage_account_id = '%s'", opt.MainAccountID), nil
+}
+
+// rootAccountBillListSQL 查询二级账号账单的SQL，分页查询需要按账单明细ID稳定排序，避免分页间数据重复或遗漏
+func rootAccountBillListSQL(databaseName, tableName, where string, page *typesBill.AwsBillPage) string {
+	sql := fmt.Sprintf(QueryBillSQL, "*", databaseName, tableName, where)
+	if page == nil {
+		return sql
+	}
+
+	return sql + fmt.Sprintf(" ORDER BY identity_line_item_id, identity_time_interval OFFSET %d LIMIT %d",
+		page.Offset, page.Limit)
+}
+
 func parseCondition(opt *typesBill.AwsBillListOption) (string, error) {
 	var condition string
 	if opt.BeginDate != "" && opt.EndDate != "" {
@@ -236,68 +324,9 @@ func parseCondition(opt *typesBill.AwsBillListOption) (string, error) {
 	return condition, nil
 }
 
-// CreateBucket create s3 bucket.
-// reference: https://docs.aws.amazon.com/zh_cn/AmazonS3/latest/API/API_CreateBucket.html
-func (a *Aws) CreateBucket(kt *kit.Kit, opt *typesBill.AwsBillBucketCreateReq) (*string, error) {
-	client, err := a.clientSet.s3Client(opt.Region)
-	if err != nil {
-		logs.Errorf("aws adaptor s3 bucket client failed, opt: %+v, err: %v, rid: %s", opt, err, kt.Rid)
-		return nil, err
-	}
-
-	req := &s3.CreateBucketInput{Bucket: converter.ValToPtr(opt.Bucket)}
-
-	resp, err := client.CreateBucketWithContext(kt.Ctx, req)
-	if err != nil {
-		logs.Errorf("aws adaptor s3 create bucket failed, opt: %+v, err: %v, rid: %s", opt, err, kt.Rid)
-		return nil, err
-	}
-
-	return resp.Location, nil
-}
-
-// DeleteBucket delete s3 bucket.
-// reference: https://docs.aws.amazon.com/zh_cn/AmazonS3/latest/API/API_DeleteBucket.html
-func (a *Aws) DeleteBucket(kt *kit.Kit, opt *typesBill.AwsBillBucketDeleteReq) error {
-	client, err := a.clientSet.s3Client(opt.Region)
-	if err != nil {
-		logs.Errorf("aws adaptor s3 delete bucket client failed, opt: %+v, err: %v, rid: %s", opt, err, kt.Rid)
-		return err
-	}
-
-	req := &s3.DeleteBucketInput{Bucket: converter.ValToPtr(opt.Bucket)}
-	_, err = client.DeleteBucketWithContext(kt.Ctx, req)
-	if err != nil {
-		logs.Errorf("aws adaptor s3 delete bucket failed, opt: %+v, err: %v, rid: %s", opt, err, kt.Rid)
-		return err
-	}
-
-	return nil
-}
-
-// ListBucket list bucket.
-// reference: https://docs.aws.amazon.com/zh_cn/AmazonS3/latest/API/API_ListBuckets.html
-func (a *Aws) ListBucket(kt *kit.Kit, region string) ([]*s3.Bucket, error) {
-	client, err := a.clientSet.s3Client(region)
-	if err != nil {
-		logs.Errorf("aws adaptor bill bucket list client failed, region: %s, err: %v, rid: %s",
-			region, err, kt.Rid)
-		return nil, err
-	}
-
-	req := &s3.ListBucketsInput{}
-	resp, err := client.ListBucketsWithContext(kt.Ctx, req)
-	if err != nil {
-		logs.Errorf("aws adaptor bill bucket list failed, region: %s, err: %v, rid: %s", region, err, kt.Rid)
-		return nil, err
-	}
-
-	return resp.Buckets, nil
-}
-
 // GetObject get object.
 // reference: https://docs.aws.amazon.com/zh_cn/AmazonS3/latest/API/API_GetObject.html
-func (a *Aws) GetObject(kt *kit.Kit, opt *typesBill.AwsBillGetObjectReq) (*s3.GetObjectOutput, error) {
+func (a *AwsImpl) GetObject(kt *kit.Kit, opt *typesBill.AwsBillGetObjectReq) (*s3.GetObjectOutput, error) {
 	client, err := a.clientSet.s3Client(opt.Region)
 	if err != nil {
 		logs.Errorf("aws adaptor bill get object client failed, opt: %+v, err: %v, rid: %s", opt, err, kt.Rid)
@@ -316,30 +345,9 @@ func (a *Aws) GetObject(kt *kit.Kit, opt *typesBill.AwsBillGetObjectReq) (*s3.Ge
 	return resp, nil
 }
 
-// GetBucketPolicy get bucket policy.
-// reference: https://docs.aws.amazon.com/zh_cn/AmazonS3/latest/API/API_GetBucketPolicy.html
-func (a *Aws) GetBucketPolicy(kt *kit.Kit, opt *typesBill.AwsBillBucketPolicyReq) (*string, error) {
-	client, err := a.clientSet.s3Client(opt.Region)
-	if err != nil {
-		logs.Errorf("aws adaptor get bucket policy client failed, opt: %+v, err: %v, rid: %s", opt, err, kt.Rid)
-		return nil, err
-	}
-
-	req := &s3.GetBucketPolicyInput{
-		Bucket: converter.ValToPtr(opt.Bucket),
-	}
-	resp, err := client.GetBucketPolicyWithContext(kt.Ctx, req)
-	if err != nil {
-		logs.Errorf("aws adaptor get bucket policy failed, opt: %+v, err: %v, rid: %s", opt, err, kt.Rid)
-		return nil, err
-	}
-
-	return resp.Policy, nil
-}
-
 // PutBucketPolicy put bucket policy.
 // reference: https://docs.aws.amazon.com/zh_cn/AmazonS3/latest/API/API_PutBucketPolicy.html
-func (a *Aws) PutBucketPolicy(kt *kit.Kit, opt *typesBill.AwsBillBucketPolicyReq) error {
+func (a *AwsImpl) PutBucketPolicy(kt *kit.Kit, opt *typesBill.AwsBillBucketPolicyReq) error {
 	client, err := a.clientSet.s3Client(opt.Region)
 	if err != nil {
 		logs.Errorf("aws adaptor put bucket policy client failed, opt: %+v, err: %v, rid: %s", opt, err, kt.Rid)
@@ -367,7 +375,7 @@ func (a *Aws) PutBucketPolicy(kt *kit.Kit, opt *typesBill.AwsBillBucketPolicyReq
 
 // PutReportDefinition put report definition.
 // reference: https://docs.aws.amazon.com/zh_cn/aws-cost-management/latest/APIReference/API_cur_PutReportDefinition.html
-func (a *Aws) PutReportDefinition(kt *kit.Kit, opt *typesBill.AwsBillPutReportDefinitionReq) error {
+func (a *AwsImpl) PutReportDefinition(kt *kit.Kit, opt *typesBill.AwsBillPutReportDefinitionReq) error {
 	client, err := a.clientSet.costAndUsageReportClient(opt.Region)
 	if err != nil {
 		logs.Errorf("aws adaptor cur put report definition client failed, opt: %v, err: %v, rid: %s",
@@ -402,7 +410,7 @@ func (a *Aws) PutReportDefinition(kt *kit.Kit, opt *typesBill.AwsBillPutReportDe
 // DeleteReportDefinition delete report definition.
 // reference: https://docs.aws.amazon.com/zh_cn/aws-cost-management/latest/APIReference/
 // API_cur_DeleteReportDefinition.html
-func (a *Aws) DeleteReportDefinition(kt *kit.Kit, opt *typesBill.AwsBillDeleteReportDefinitionReq) error {
+func (a *AwsImpl) DeleteReportDefinition(kt *kit.Kit, opt *typesBill.AwsBillDeleteReportDefinitionReq) error {
 	client, err := a.clientSet.costAndUsageReportClient(opt.Region)
 	if err != nil {
 		logs.Errorf("aws adaptor delete report definition client failed, opt: %v, err: %v, rid: %s",
@@ -425,7 +433,7 @@ func (a *Aws) DeleteReportDefinition(kt *kit.Kit, opt *typesBill.AwsBillDeleteRe
 
 // CreateStack create stack.
 // reference: https://docs.aws.amazon.com/AWSCloudFormation/latest/APIReference/API_CreateStack.html
-func (a *Aws) CreateStack(kt *kit.Kit, opt *typesBill.AwsCreateStackReq) (string, error) {
+func (a *AwsImpl) CreateStack(kt *kit.Kit, opt *typesBill.AwsCreateStackReq) (string, error) {
 	client, err := a.clientSet.cloudFormationClient(opt.Region)
 	if err != nil {
 		logs.Errorf("aws adaptor formation client failed, opt: %+v, err: %v, rid: %s", opt, err, kt.Rid)
@@ -448,7 +456,7 @@ func (a *Aws) CreateStack(kt *kit.Kit, opt *typesBill.AwsCreateStackReq) (string
 
 // DescribeStack describe stack.
 // reference: https://docs.aws.amazon.com/AWSCloudFormation/latest/APIReference/API_DescribeStacks.html
-func (a *Aws) DescribeStack(kt *kit.Kit, opt *typesBill.AwsDeleteStackReq) ([]*cloudformation.Stack, error) {
+func (a *AwsImpl) DescribeStack(kt *kit.Kit, opt *typesBill.AwsDeleteStackReq) ([]*cloudformation.Stack, error) {
 	client, err := a.clientSet.cloudFormationClient(opt.Region)
 	if err != nil {
 		logs.Errorf("aws adaptor formation client failed, opt: %+v, err: %v, rid: %s", opt, err, kt.Rid)
@@ -469,7 +477,7 @@ func (a *Aws) DescribeStack(kt *kit.Kit, opt *typesBill.AwsDeleteStackReq) ([]*c
 
 // DeleteStack delete stack.
 // reference: https://docs.aws.amazon.com/AWSCloudFormation/latest/APIReference/API_DeleteStack.html
-func (a *Aws) DeleteStack(kt *kit.Kit, opt *typesBill.AwsDeleteStackReq) error {
+func (a *AwsImpl) DeleteStack(kt *kit.Kit, opt *typesBill.AwsDeleteStackReq) error {
 	client, err := a.clientSet.cloudFormationClient(opt.Region)
 	if err != nil {
 		logs.Errorf("aws adaptor formation client failed, opt: %+v, err: %v, rid: %s", opt, err, kt.Rid)
@@ -0,0 +1,89 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"testing"
+
+	typesBill "hcm/pkg/adaptor/types/bill"
+)
+
+func TestRootAccountBillQuery(t *testing.T) {
+	opt := &typesBill.AwsRootBillListOption{
+		RootAccountID: "root-account",
+		MainAccountID: "123456789012",
+		BeginDate:     "2024-10-01",
+		EndDate:       "2024-10-31",
+		Page:          &typesBill.AwsBillPage{Offset: 200, Limit: 100},
+	}
+	if err := opt.Validate(); err != nil {
+		t.Fatalf("validate option failed, err: %v", err)
+	}
+
+	where, err := rootAccountBillCondition(opt)
+	if err != nil {
+		t.Fatalf("build condition failed, err: %v", err)
+	}
+
+	wantWhere := "WHERE year = '2024' AND month = '10' AND date(line_item_usage_start_date) >= date '2024-10-01' " +
+		"AND date(line_item_usage_start_date) <= date '2024-10-31' AND line_item_usage_account_id = '123456789012'"
+	if where != wantWhere {
+		t.Errorf("condition got %q, want %q", where, wantWhere)
+	}
+
+	wantSQL := "SELECT * FROM db.tbl " + wantWhere +
+		" ORDER BY identity_line_item_id, identity_time_interval OFFSET 200 LIMIT 100"
+	if got := rootAccountBillListSQL("db", "tbl", where, opt.Page); got != wantSQL {
+		t.Errorf("list sql got %q, want %q", got, wantSQL)
+	}
+
+	if got := rootAccountBillListSQL("db", "tbl", where, nil); got != "SELECT * FROM db.tbl "+wantWhere {
+		t.Errorf("list sql without page got %q", got)
+	}
+}
+
+func TestRootAccountBillOptionMainAccountID(t *testing.T) {
+	cases := []struct {
+		mainAccountID string
+		valid         bool
+	}{
+		{mainAccountID: "123456789012", valid: true},
+		{mainAccountID: "12345678901", valid: false},
+		{mainAccountID: "1234567890123", valid: false},
+		{mainAccountID: "12345678901a", valid: false},
+		{mainAccountID: "1' OR '1'='1", valid: false},
+	}
+
+	for _, c := range cases {
+		opt := typesBill.AwsRootBillListOption{
+			RootAccountID: "root-account",
+			MainAccountID: c.mainAccountID,
+			BeginDate:     "2024-10-01",
+			EndDate:       "2024-10-31",
+		}
+		err := opt.Validate()
+		if c.valid && err != nil {
+			t.Errorf("main account id %q should be valid, err: %v", c.mainAccountID, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("main account id %q should be invalid", c.mainAccountID)
+		}
+	}
+}
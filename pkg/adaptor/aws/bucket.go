@@ -0,0 +1,234 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"errors"
+
+	typesBill "hcm/pkg/adaptor/types/bill"
+	typesbucket "hcm/pkg/adaptor/types/bucket"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+const (
+	// errCodeNoSuchPublicAccessBlock 存储桶未配置阻止公共访问
+	errCodeNoSuchPublicAccessBlock = "NoSuchPublicAccessBlockConfiguration"
+	// errCodeNoSuchBucketPolicy 存储桶未配置策略
+	errCodeNoSuchBucketPolicy = "NoSuchBucketPolicy"
+	// errCodeEncryptionNotFound 存储桶未配置默认加密
+	errCodeEncryptionNotFound = "ServerSideEncryptionConfigurationNotFoundError"
+)
+
+// CreateBucket create s3 bucket.
+// reference: https://docs.aws.amazon.com/zh_cn/AmazonS3/latest/API/API_CreateBucket.html
+func (a *AwsImpl) CreateBucket(kt *kit.Kit, opt *typesBill.AwsBillBucketCreateReq) (*string, error) {
+	client, err := a.clientSet.s3Client(opt.Region)
+	if err != nil {
+		logs.Errorf("aws adaptor s3 bucket client failed, opt: %+v, err: %v, rid: %s", opt, err, kt.Rid)
+		return nil, err
+	}
+
+	req := &s3.CreateBucketInput{Bucket: converter.ValToPtr(opt.Bucket)}
+
+	resp, err := client.CreateBucketWithContext(kt.Ctx, req)
+	if err != nil {
+		logs.Errorf("aws adaptor s3 create bucket failed, opt: %+v, err: %v, rid: %s", opt, err, kt.Rid)
+		return nil, err
+	}
+
+	return resp.Location, nil
+}
+
+// DeleteBucket delete s3 bucket.
+// reference: https://docs.aws.amazon.com/zh_cn/AmazonS3/latest/API/API_DeleteBucket.html
+func (a *AwsImpl) DeleteBucket(kt *kit.Kit, opt *typesBill.AwsBillBucketDeleteReq) error {
+	client, err := a.clientSet.s3Client(opt.Region)
+	if err != nil {
+		logs.Errorf("aws adaptor s3 delete bucket client failed, opt: %+v, err: %v, rid: %s", opt, err, kt.Rid)
+		return err
+	}
+
+	req := &s3.DeleteBucketInput{Bucket: converter.ValToPtr(opt.Bucket)}
+	_, err = client.DeleteBucketWithContext(kt.Ctx, req)
+	if err != nil {
+		logs.Errorf("aws adaptor s3 delete bucket failed, opt: %+v, err: %v, rid: %s", opt, err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// GetBucketPolicy get bucket policy.
+// reference: https://docs.aws.amazon.com/zh_cn/AmazonS3/latest/API/API_GetBucketPolicy.html
+func (a *AwsImpl) GetBucketPolicy(kt *kit.Kit, opt *typesBill.AwsBillBucketPolicyReq) (*string, error) {
+	client, err := a.clientSet.s3Client(opt.Region)
+	if err != nil {
+		logs.Errorf("aws adaptor get bucket policy client failed, opt: %+v, err: %v, rid: %s", opt, err, kt.Rid)
+		return nil, err
+	}
+
+	req := &s3.GetBucketPolicyInput{
+		Bucket: converter.ValToPtr(opt.Bucket),
+	}
+	resp, err := client.GetBucketPolicyWithContext(kt.Ctx, req)
+	if err != nil {
+		logs.Errorf("aws adaptor get bucket policy failed, opt: %+v, err: %v, rid: %s", opt, err, kt.Rid)
+		return nil, err
+	}
+
+	return resp.Policy, nil
+}
+
+// ListBucket 查询账号下的S3存储桶，并补充所在地域、公共访问和默认加密配置
+// reference: https://docs.aws.amazon.com/zh_cn/AmazonS3/latest/API/API_ListBuckets.html
+func (a *AwsImpl) ListBucket(kt *kit.Kit, opt *typesbucket.AwsBucketListOption) ([]typesbucket.AwsBucket, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "aws bucket list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	// ListBuckets 返回账号下全部地域的存储桶，使用默认地域调用
+	client, err := a.clientSet.s3Client(endpoints.UsEast1RegionID)
+	if err != nil {
+		logs.Errorf("aws adaptor bucket list client failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	resp, err := client.ListBucketsWithContext(kt.Ctx, new(s3.ListBucketsInput))
+	if err != nil {
+		logs.Errorf("aws adaptor bucket list failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	idMap := converter.StringSliceToMap(opt.CloudIDs)
+	buckets := make([]typesbucket.AwsBucket, 0, len(resp.Buckets))
+	for _, one := range resp.Buckets {
+		if one == nil {
+			continue
+		}
+
+		if _, exist := idMap[converter.PtrToVal(one.Name)]; len(opt.CloudIDs) != 0 && !exist {
+			continue
+		}
+
+		location, err := client.GetBucketLocationWithContext(kt.Ctx,
+			&s3.GetBucketLocationInput{Bucket: one.Name})
+		if err != nil {
+			logs.Errorf("get aws bucket location failed, err: %v, bucket: %s, rid: %s", err,
+				converter.PtrToVal(one.Name), kt.Rid)
+			return nil, err
+		}
+
+		bucket, err := a.getBucketDetail(kt, one,
+			s3.NormalizeBucketLocation(converter.PtrToVal(location.LocationConstraint)))
+		if err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, *bucket)
+	}
+
+	return buckets, nil
+}
+
+// getBucketDetail 查询存储桶的阻止公共访问、策略状态、ACL和默认加密配置，需要使用存储桶所在地域调用
+func (a *AwsImpl) getBucketDetail(kt *kit.Kit, one *s3.Bucket, region string) (*typesbucket.AwsBucket, error) {
+	client, err := a.clientSet.s3Client(region)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket := &typesbucket.AwsBucket{
+		Bucket:          one,
+		Region:          region,
+		ACLPublicAccess: enumor.BucketPrivate,
+	}
+
+	block, err := client.GetPublicAccessBlockWithContext(kt.Ctx, &s3.GetPublicAccessBlockInput{Bucket: one.Name})
+	if err != nil && !isAwsErrCode(err, errCodeNoSuchPublicAccessBlock) {
+		logs.Errorf("get aws bucket public access block failed, err: %v, bucket: %s, rid: %s", err,
+			converter.PtrToVal(one.Name), kt.Rid)
+		return nil, err
+	}
+	if block != nil {
+		bucket.PublicAccessBlock = block.PublicAccessBlockConfiguration
+	}
+
+	status, err := client.GetBucketPolicyStatusWithContext(kt.Ctx, &s3.GetBucketPolicyStatusInput{Bucket: one.Name})
+	if err != nil && !isAwsErrCode(err, errCodeNoSuchBucketPolicy) {
+		logs.Errorf("get aws bucket policy status failed, err: %v, bucket: %s, rid: %s", err,
+			converter.PtrToVal(one.Name), kt.Rid)
+		return nil, err
+	}
+	if status != nil && status.PolicyStatus != nil {
+		bucket.PolicyIsPublic = converter.PtrToVal(status.PolicyStatus.IsPublic)
+	}
+
+	acl, err := client.GetBucketAclWithContext(kt.Ctx, &s3.GetBucketAclInput{Bucket: one.Name})
+	if err != nil {
+		logs.Errorf("get aws bucket acl failed, err: %v, bucket: %s, rid: %s", err, converter.PtrToVal(one.Name),
+			kt.Rid)
+		return nil, err
+	}
+	for _, grant := range acl.Grants {
+		if grant == nil || grant.Grantee == nil {
+			continue
+		}
+
+		uri := converter.PtrToVal(grant.Grantee.URI)
+		if uri == typesbucket.AwsAllUsersURI || uri == typesbucket.AwsAuthenticatedUsersURI {
+			bucket.ACLPublicAccess = typesbucket.MergePublicAccess(bucket.ACLPublicAccess,
+				typesbucket.GrantPublicAccess(converter.PtrToVal(grant.Permission)))
+		}
+	}
+
+	encryption, err := client.GetBucketEncryptionWithContext(kt.Ctx, &s3.GetBucketEncryptionInput{Bucket: one.Name})
+	if err != nil && !isAwsErrCode(err, errCodeEncryptionNotFound) {
+		logs.Errorf("get aws bucket encryption failed, err: %v, bucket: %s, rid: %s", err,
+			converter.PtrToVal(one.Name), kt.Rid)
+		return nil, err
+	}
+	if encryption != nil && encryption.ServerSideEncryptionConfiguration != nil {
+		for _, rule := range encryption.ServerSideEncryptionConfiguration.Rules {
+			if rule != nil && rule.ApplyServerSideEncryptionByDefault != nil {
+				bucket.Encryption = rule.ApplyServerSideEncryptionByDefault
+				bucket.BucketKeyEnabled = rule.BucketKeyEnabled
+				break
+			}
+		}
+	}
+
+	return bucket, nil
+}
+
+func isAwsErrCode(err error, code string) bool {
+	var aErr awserr.Error
+	return errors.As(err, &aErr) && aErr.Code() == code
+}
@@ -26,26 +26,38 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
 	curservice "github.com/aws/aws-sdk-go/service/costandusagereportservice"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/sts"
 )
 
 const (
-	ErrDataNotFound       = "InvalidInstanceID.Malformed: Invalid id"
-	ErrDryRunSuccess      = "DryRunOperation: Request would have succeeded, but DryRun flag is set"
-	ErrSGNotFound         = "InvalidGroup.NotFound"
-	ErrRouteTableNotFound = "InvalidRouteTableID.NotFound"
-	ErrImageNotFound      = "InvalidAMIID.NotFound"
-	ErrVpcNotFound        = "InvalidVpcID.NotFound"
-	ErrSubnetNotFound     = "InvalidSubnetID.NotFound"
-	ErrDiskNotFound       = "InvalidVolume.NotFound"
-	ErrCvmNotFound        = "InvalidInstanceID.NotFound"
+	ErrDataNotFound        = "InvalidInstanceID.Malformed: Invalid id"
+	ErrDryRunSuccess       = "DryRunOperation: Request would have succeeded, but DryRun flag is set"
+	ErrSGNotFound          = "InvalidGroup.NotFound"
+	ErrRouteTableNotFound  = "InvalidRouteTableID.NotFound"
+	ErrImageNotFound       = "InvalidAMIID.NotFound"
+	ErrVpcNotFound         = "InvalidVpcID.NotFound"
+	ErrSubnetNotFound      = "InvalidSubnetID.NotFound"
+	ErrDiskNotFound        = "InvalidVolume.NotFound"
+	ErrCvmNotFound         = "InvalidInstanceID.NotFound"
+	ErrLbNotFound          = "LoadBalancerNotFound"
+	ErrTargetGroupNotFound = "TargetGroupNotFound"
+	ErrDBInstanceNotFound  = "DBInstanceNotFound"
 )
 
+// route53SigningRegion Route 53 接口签名使用的地域
+const route53SigningRegion = "us-east-1"
+
 type clientSet struct {
 	credentials *credentials.Credentials
 }
@@ -213,3 +225,138 @@ func (c *clientSet) cloudFormationClient(region string) (*cloudformation.CloudFo
 
 	return cloudformation.New(sess, aws.NewConfig().WithRegion(region)), nil
 }
+
+func (c *clientSet) cloudTrailClient(region string) (*cloudtrail.CloudTrail, error) {
+	cfg := &aws.Config{
+		Credentials: c.credentials,
+		DisableSSL:  nil,
+		HTTPClient:  nil,
+		LogLevel:    nil,
+		Logger:      nil,
+		MaxRetries:  nil,
+		Retryer:     nil,
+		SleepDelay:  nil,
+	}
+
+	if len(region) != 0 {
+		cfg.Region = aws.String(region)
+	}
+
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return cloudtrail.New(sess), nil
+}
+
+func (c *clientSet) elbV2Client(region string) (*elbv2.ELBV2, error) {
+	cfg := &aws.Config{
+		Credentials: c.credentials,
+		DisableSSL:  nil,
+		HTTPClient:  nil,
+		LogLevel:    nil,
+		Logger:      nil,
+		MaxRetries:  nil,
+		Retryer:     nil,
+		SleepDelay:  nil,
+	}
+
+	if len(region) != 0 {
+		cfg.Region = aws.String(region)
+	}
+
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return elbv2.New(sess), nil
+}
+
+func (c *clientSet) rdsClient(region string) (*rds.RDS, error) {
+	cfg := &aws.Config{
+		Credentials: c.credentials,
+		DisableSSL:  nil,
+		HTTPClient:  nil,
+		LogLevel:    nil,
+		Logger:      nil,
+		MaxRetries:  nil,
+		Retryer:     nil,
+		SleepDelay:  nil,
+	}
+
+	if len(region) != 0 {
+		cfg.Region = aws.String(region)
+	}
+
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return rds.New(sess), nil
+}
+
+func (c *clientSet) eksClient(region string) (*eks.EKS, error) {
+	cfg := &aws.Config{
+		Credentials: c.credentials,
+		DisableSSL:  nil,
+		HTTPClient:  nil,
+		LogLevel:    nil,
+		Logger:      nil,
+		MaxRetries:  nil,
+		Retryer:     nil,
+		SleepDelay:  nil,
+	}
+
+	if len(region) != 0 {
+		cfg.Region = aws.String(region)
+	}
+
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return eks.New(sess), nil
+}
+
+func (c *clientSet) autoScalingClient(region string) (*autoscaling.AutoScaling, error) {
+	cfg := &aws.Config{
+		Credentials: c.credentials,
+		DisableSSL:  nil,
+		HTTPClient:  nil,
+		LogLevel:    nil,
+		Logger:      nil,
+		MaxRetries:  nil,
+		Retryer:     nil,
+		SleepDelay:  nil,
+	}
+
+	if len(region) != 0 {
+		cfg.Region = aws.String(region)
+	}
+
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return autoscaling.New(sess), nil
+}
+
+// route53Client Route 53 为全局服务，固定使用 us-east-1 地域签名
+func (c *clientSet) route53Client() (*route53.Route53, error) {
+	cfg := &aws.Config{
+		Credentials: c.credentials,
+		Region:      aws.String(route53SigningRegion),
+	}
+
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return route53.New(sess), nil
+}
@@ -40,7 +40,7 @@ import (
 
 // ListCvm list cvm.
 // reference: https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_DescribeInstances.html
-func (a *Aws) ListCvm(kt *kit.Kit, opt *typecvm.AwsListOption) ([]typecvm.AwsCvm, *ec2.DescribeInstancesOutput, error) {
+func (a *AwsImpl) ListCvm(kt *kit.Kit, opt *typecvm.AwsListOption) ([]typecvm.AwsCvm, *ec2.DescribeInstancesOutput, error) {
 	if opt == nil {
 		return nil, nil, errf.New(errf.InvalidParameter, "list option is required")
 	}
@@ -86,7 +86,7 @@ func (a *Aws) ListCvm(kt *kit.Kit, opt *typecvm.AwsListOption) ([]typecvm.AwsCvm
 
 // CountCvm 返回单个地域下的ec2 instance 数量，基于 DescribeInstancesWithContext接口
 // reference: https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_DescribeInstances.html
-func (a *Aws) CountCvm(kt *kit.Kit, region string) (int32, error) {
+func (a *AwsImpl) CountCvm(kt *kit.Kit, region string) (int32, error) {
 
 	client, err := a.clientSet.ec2Client(region)
 	if err != nil {
@@ -130,7 +130,7 @@ func GetCvmNameFromTags(tags []*ec2.Tag) *string {
 }
 
 // DeleteCvm reference: https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_TerminateInstances.html
-func (a *Aws) DeleteCvm(kt *kit.Kit, opt *typecvm.AwsDeleteOption) error {
+func (a *AwsImpl) DeleteCvm(kt *kit.Kit, opt *typecvm.AwsDeleteOption) error {
 	if opt == nil {
 		return errf.New(errf.InvalidParameter, "delete option is required")
 	}
@@ -157,7 +157,7 @@ func (a *Aws) DeleteCvm(kt *kit.Kit, opt *typecvm.AwsDeleteOption) error {
 }
 
 // StartCvm reference: https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_StartInstances.html
-func (a *Aws) StartCvm(kt *kit.Kit, opt *typecvm.AwsStartOption) error {
+func (a *AwsImpl) StartCvm(kt *kit.Kit, opt *typecvm.AwsStartOption) error {
 	if opt == nil {
 		return errf.New(errf.InvalidParameter, "start option is required")
 	}
@@ -183,7 +183,7 @@ func (a *Aws) StartCvm(kt *kit.Kit, opt *typecvm.AwsStartOption) error {
 	handler := &startAwsCvmPollingHandler{
 		opt.Region,
 	}
-	respPoller := poller.Poller[*Aws, []*ec2.Instance, poller.BaseDoneResult]{Handler: handler}
+	respPoller := poller.Poller[*AwsImpl, []*ec2.Instance, poller.BaseDoneResult]{Handler: handler}
 	_, err = respPoller.PollUntilDone(a, kt, converter.SliceToPtr(opt.CloudIDs),
 		types.NewBatchOperateCvmPollerOpt())
 	if err != nil {
@@ -194,7 +194,7 @@ func (a *Aws) StartCvm(kt *kit.Kit, opt *typecvm.AwsStartOption) error {
 }
 
 // StopCvm reference: https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_StopInstances.html
-func (a *Aws) StopCvm(kt *kit.Kit, opt *typecvm.AwsStopOption) error {
+func (a *AwsImpl) StopCvm(kt *kit.Kit, opt *typecvm.AwsStopOption) error {
 	if opt == nil {
 		return errf.New(errf.InvalidParameter, "stop option is required")
 	}
@@ -222,7 +222,7 @@ func (a *Aws) StopCvm(kt *kit.Kit, opt *typecvm.AwsStopOption) error {
 	handler := &stopAwsCvmPollingHandler{
 		opt.Region,
 	}
-	respPoller := poller.Poller[*Aws, []*ec2.Instance, poller.BaseDoneResult]{Handler: handler}
+	respPoller := poller.Poller[*AwsImpl, []*ec2.Instance, poller.BaseDoneResult]{Handler: handler}
 	_, err = respPoller.PollUntilDone(a, kt, converter.SliceToPtr(opt.CloudIDs),
 		types.NewBatchOperateCvmPollerOpt())
 	if err != nil {
@@ -233,7 +233,7 @@ func (a *Aws) StopCvm(kt *kit.Kit, opt *typecvm.AwsStopOption) error {
 }
 
 // RebootCvm reference: https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_RebootInstances.html
-func (a *Aws) RebootCvm(kt *kit.Kit, opt *typecvm.AwsRebootOption) error {
+func (a *AwsImpl) RebootCvm(kt *kit.Kit, opt *typecvm.AwsRebootOption) error {
 	if opt == nil {
 		return errf.New(errf.InvalidParameter, "reboot option is required")
 	}
@@ -259,7 +259,7 @@ func (a *Aws) RebootCvm(kt *kit.Kit, opt *typecvm.AwsRebootOption) error {
 	handler := &rebootAwsCvmPollingHandler{
 		opt.Region,
 	}
-	respPoller := poller.Poller[*Aws, []*ec2.Instance, poller.BaseDoneResult]{Handler: handler}
+	respPoller := poller.Poller[*AwsImpl, []*ec2.Instance, poller.BaseDoneResult]{Handler: handler}
 	_, err = respPoller.PollUntilDone(a, kt, converter.SliceToPtr(opt.CloudIDs),
 		types.NewBatchOperateCvmPollerOpt())
 	if err != nil {
@@ -270,7 +270,7 @@ func (a *Aws) RebootCvm(kt *kit.Kit, opt *typecvm.AwsRebootOption) error {
 }
 
 // CreateCvm reference: https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_RunInstances.html
-func (a *Aws) CreateCvm(kt *kit.Kit, opt *typecvm.AwsCreateOption) (*poller.BaseDoneResult, error) {
+func (a *AwsImpl) CreateCvm(kt *kit.Kit, opt *typecvm.AwsCreateOption) (*poller.BaseDoneResult, error) {
 	if opt == nil {
 		return nil, errf.New(errf.InvalidParameter, "create option is required")
 	}
@@ -284,11 +284,6 @@ func (a *Aws) CreateCvm(kt *kit.Kit, opt *typecvm.AwsCreateOption) (*poller.Base
 		return nil, err
 	}
 
-	userData, err := genCvmBase64UserData(kt, client, opt.CloudImageID, opt.Password)
-	if err != nil {
-		return nil, fmt.Errorf("gen cvm base64 user data failed, err: %v", err)
-	}
-
 	req := &ec2.RunInstancesInput{
 		DryRun:       aws.Bool(opt.DryRun),
 		ClientToken:  opt.ClientToken,
@@ -307,12 +302,22 @@ func (a *Aws) CreateCvm(kt *kit.Kit, opt *typecvm.AwsCreateOption) (*poller.Base
 				},
 			},
 		},
-		UserData: aws.String(userData),
 		Placement: &ec2.Placement{
 			AvailabilityZone: aws.String(opt.Zone),
 		},
 	}
 
+	// 指定密钥对时使用密钥对登录，否则通过用户数据设置root密码并开启密码登录
+	if len(opt.KeyName) != 0 {
+		req.KeyName = aws.String(opt.KeyName)
+	} else {
+		userData, err := genCvmBase64UserData(kt, client, opt.CloudImageID, opt.Password)
+		if err != nil {
+			return nil, fmt.Errorf("gen cvm base64 user data failed, err: %v", err)
+		}
+		req.UserData = aws.String(userData)
+	}
+
 	// 如果弹性IP指定了子网，则外部不能设置子网
 	if opt.PublicIPAssigned {
 		req.NetworkInterfaces = []*ec2.InstanceNetworkInterfaceSpecification{
@@ -365,7 +370,7 @@ func (a *Aws) CreateCvm(kt *kit.Kit, opt *typecvm.AwsCreateOption) (*poller.Base
 	handler := &createCvmPollingHandler{
 		opt.Region,
 	}
-	respPoller := poller.Poller[*Aws, []*ec2.Instance, poller.BaseDoneResult]{Handler: handler}
+	respPoller := poller.Poller[*AwsImpl, []*ec2.Instance, poller.BaseDoneResult]{Handler: handler}
 	result, err := respPoller.PollUntilDone(a, kt, cloudIDs, types.NewBatchCreateCvmPollerOption())
 	if err != nil {
 		return nil, err
@@ -384,7 +389,7 @@ func (h *startAwsCvmPollingHandler) Done(cvms []*ec2.Instance) (bool, *poller.Ba
 }
 
 // Poll ...
-func (h *startAwsCvmPollingHandler) Poll(client *Aws, kt *kit.Kit, cloudIDs []*string) ([]*ec2.Instance, error) {
+func (h *startAwsCvmPollingHandler) Poll(client *AwsImpl, kt *kit.Kit, cloudIDs []*string) ([]*ec2.Instance, error) {
 	return poll(client, kt, h.region, cloudIDs)
 }
 
@@ -398,7 +403,7 @@ func (h *stopAwsCvmPollingHandler) Done(cvms []*ec2.Instance) (bool, *poller.Bas
 }
 
 // Poll ...
-func (h *stopAwsCvmPollingHandler) Poll(client *Aws, kt *kit.Kit, cloudIDs []*string) ([]*ec2.Instance, error) {
+func (h *stopAwsCvmPollingHandler) Poll(client *AwsImpl, kt *kit.Kit, cloudIDs []*string) ([]*ec2.Instance, error) {
 	return poll(client, kt, h.region, cloudIDs)
 }
 
@@ -412,7 +417,7 @@ func (h *rebootAwsCvmPollingHandler) Done(cvms []*ec2.Instance) (bool, *poller.B
 }
 
 // Poll ...
-func (h *rebootAwsCvmPollingHandler) Poll(client *Aws, kt *kit.Kit, cloudIDs []*string) ([]*ec2.Instance, error) {
+func (h *rebootAwsCvmPollingHandler) Poll(client *AwsImpl, kt *kit.Kit, cloudIDs []*string) ([]*ec2.Instance, error) {
 	return poll(client, kt, h.region, cloudIDs)
 }
 
@@ -433,7 +438,7 @@ func done(cvms []*ec2.Instance, succeed int64) (bool, *poller.BaseDoneResult) {
 	return flag, result
 }
 
-func poll(client *Aws, kt *kit.Kit, region string, cloudIDs []*string) ([]*ec2.Instance, error) {
+func poll(client *AwsImpl, kt *kit.Kit, region string, cloudIDs []*string) ([]*ec2.Instance, error) {
 	cloudIDSplit := slice.Split(cloudIDs, core.AwsQueryLimit)
 
 	cvms := make([]*ec2.Instance, 0, len(cloudIDs))
@@ -493,7 +498,7 @@ func (h *createCvmPollingHandler) Done(cvms []*ec2.Instance) (bool, *poller.Base
 }
 
 // Poll ...
-func (h *createCvmPollingHandler) Poll(client *Aws, kt *kit.Kit, cloudIDs []*string) ([]*ec2.Instance, error) {
+func (h *createCvmPollingHandler) Poll(client *AwsImpl, kt *kit.Kit, cloudIDs []*string) ([]*ec2.Instance, error) {
 
 	cloudIDSplit := slice.Split(cloudIDs, core.AwsQueryLimit)
 
@@ -524,7 +529,7 @@ func (h *createCvmPollingHandler) Poll(client *Aws, kt *kit.Kit, cloudIDs []*str
 	return cvms, nil
 }
 
-var _ poller.PollingHandler[*Aws, []*ec2.Instance, poller.BaseDoneResult] = new(createCvmPollingHandler)
+var _ poller.PollingHandler[*AwsImpl, []*ec2.Instance, poller.BaseDoneResult] = new(createCvmPollingHandler)
 
 func genCvmBase64UserData(kt *kit.Kit, ec2Client *ec2.EC2, imageID string, passwd string) (string, error) {
 	req := new(ec2.DescribeImagesInput)
@@ -0,0 +1,103 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	dbinstance "hcm/pkg/adaptor/types/database-instance"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// ListDatabaseInstance 查询RDS实例列表
+// reference: https://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_DescribeDBInstances.html
+func (a *AwsImpl) ListDatabaseInstance(kt *kit.Kit, opt *dbinstance.AwsDBInstanceListOption) (
+	[]dbinstance.AwsDBInstance, *string, error) {
+
+	if opt == nil {
+		return nil, nil, errf.New(errf.InvalidParameter, "aws database instance list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.rdsClient(opt.Region)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req := new(rds.DescribeDBInstancesInput)
+	if len(opt.CloudIDs) > 0 {
+		req.Filters = []*rds.Filter{{Name: aws.String("dbi-resource-id"), Values: converter.SliceToPtr(opt.CloudIDs)}}
+	} else if opt.Page != nil {
+		req.MaxRecords = opt.Page.MaxResults
+		req.Marker = opt.Page.NextToken
+	}
+
+	resp, err := client.DescribeDBInstancesWithContext(kt.Ctx, req)
+	if err != nil {
+		logs.Errorf("list aws database instance failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, nil, err
+	}
+
+	dbs := make([]dbinstance.AwsDBInstance, 0, len(resp.DBInstances))
+	for _, one := range resp.DBInstances {
+		dbs = append(dbs, dbinstance.AwsDBInstance{DBInstance: one})
+	}
+
+	return dbs, resp.Marker, nil
+}
+
+// DeleteDatabaseInstance 删除RDS实例，指定 FinalSnapshotID 时删除前会创建最终快照
+// reference: https://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_DeleteDBInstance.html
+func (a *AwsImpl) DeleteDatabaseInstance(kt *kit.Kit, opt *dbinstance.AwsDBInstanceDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "aws database instance delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.rdsClient(opt.Region)
+	if err != nil {
+		return err
+	}
+
+	req := &rds.DeleteDBInstanceInput{
+		DBInstanceIdentifier: aws.String(opt.Identifier),
+		SkipFinalSnapshot:    aws.Bool(len(opt.FinalSnapshotID) == 0),
+	}
+	if len(opt.FinalSnapshotID) != 0 {
+		req.FinalDBSnapshotIdentifier = aws.String(opt.FinalSnapshotID)
+	}
+
+	if _, err = client.DeleteDBInstanceWithContext(kt.Ctx, req); err != nil {
+		logs.Errorf("delete aws database instance failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return err
+	}
+
+	return nil
+}
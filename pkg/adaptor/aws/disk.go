@@ -38,7 +38,7 @@ import (
 // CreateDisk 创建云硬盘
 // reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_CreateVolume.html
 // SDK: https://docs.aws.amazon.com/sdk-for-go/api/service/ec2/#EC2.CreateVolumeWithContext
-func (a *Aws) CreateDisk(kt *kit.Kit, opt *disk.AwsDiskCreateOption) (*poller.BaseDoneResult, error) {
+func (a *AwsImpl) CreateDisk(kt *kit.Kit, opt *disk.AwsDiskCreateOption) (*poller.BaseDoneResult, error) {
 	if opt == nil {
 		return nil, errf.New(errf.InvalidParameter, "aws disk create option is required")
 	}
@@ -57,13 +57,13 @@ func (a *Aws) CreateDisk(kt *kit.Kit, opt *disk.AwsDiskCreateOption) (*poller.Ba
 		diskCloudIDs = append(diskCloudIDs, resp.VolumeId)
 	}
 
-	respPoller := poller.Poller[*Aws, []disk.AwsDisk, poller.BaseDoneResult]{
+	respPoller := poller.Poller[*AwsImpl, []disk.AwsDisk, poller.BaseDoneResult]{
 		Handler: &createDiskPollingHandler{region: opt.Region},
 	}
 	return respPoller.PollUntilDone(a, kt, diskCloudIDs, nil)
 }
 
-func (a *Aws) createDisk(kt *kit.Kit, opt *disk.AwsDiskCreateOption) (*ec2.Volume, error) {
+func (a *AwsImpl) createDisk(kt *kit.Kit, opt *disk.AwsDiskCreateOption) (*ec2.Volume, error) {
 	client, err := a.clientSet.ec2Client(opt.Region)
 	if err != nil {
 		return nil, err
@@ -79,7 +79,7 @@ func (a *Aws) createDisk(kt *kit.Kit, opt *disk.AwsDiskCreateOption) (*ec2.Volum
 
 // ListDisk 查看云硬盘
 // reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_DescribeVolumes.html
-func (a *Aws) ListDisk(kt *kit.Kit, opt *disk.AwsDiskListOption) ([]disk.AwsDisk, *string, error) {
+func (a *AwsImpl) ListDisk(kt *kit.Kit, opt *disk.AwsDiskListOption) ([]disk.AwsDisk, *string, error) {
 	if opt == nil {
 		return nil, nil, errf.New(errf.InvalidParameter, "aws disk list option is required")
 	}
@@ -121,7 +121,7 @@ func (a *Aws) ListDisk(kt *kit.Kit, opt *disk.AwsDiskListOption) ([]disk.AwsDisk
 
 // CountDisk 返回指定地域下所有硬盘数量，基于DescribeVolumes接口
 // reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_DescribeVolumes.html
-func (a *Aws) CountDisk(kt *kit.Kit, region string) (int32, error) {
+func (a *AwsImpl) CountDisk(kt *kit.Kit, region string) (int32, error) {
 	client, err := a.clientSet.ec2Client(region)
 	if err != nil {
 		return 0, err
@@ -149,7 +149,7 @@ func (a *Aws) CountDisk(kt *kit.Kit, region string) (int32, error) {
 
 // DeleteDisk 删除云盘
 // reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_DeleteVolume.html
-func (a *Aws) DeleteDisk(kt *kit.Kit, opt *disk.AwsDiskDeleteOption) error {
+func (a *AwsImpl) DeleteDisk(kt *kit.Kit, opt *disk.AwsDiskDeleteOption) error {
 	if opt == nil {
 		return errf.New(errf.InvalidParameter, "aws disk delete option is required")
 	}
@@ -170,7 +170,7 @@ func (a *Aws) DeleteDisk(kt *kit.Kit, opt *disk.AwsDiskDeleteOption) error {
 
 // AttachDisk 挂载云盘
 // reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_AttachVolume.html
-func (a *Aws) AttachDisk(kt *kit.Kit, opt *disk.AwsDiskAttachOption) error {
+func (a *AwsImpl) AttachDisk(kt *kit.Kit, opt *disk.AwsDiskAttachOption) error {
 	if opt == nil {
 		return errf.New(errf.InvalidParameter, "aws disk attach option is required")
 	}
@@ -190,7 +190,7 @@ func (a *Aws) AttachDisk(kt *kit.Kit, opt *disk.AwsDiskAttachOption) error {
 		return err
 	}
 
-	respPoller := poller.Poller[*Aws, []disk.AwsDisk, poller.BaseDoneResult]{
+	respPoller := poller.Poller[*AwsImpl, []disk.AwsDisk, poller.BaseDoneResult]{
 		Handler: &attachDiskPollingHandler{region: opt.Region},
 	}
 	_, err = respPoller.PollUntilDone(a, kt, []*string{&opt.CloudDiskID}, nil)
@@ -199,7 +199,7 @@ func (a *Aws) AttachDisk(kt *kit.Kit, opt *disk.AwsDiskAttachOption) error {
 
 // DetachDisk 卸载云盘
 // reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_DetachVolume.html
-func (a *Aws) DetachDisk(kt *kit.Kit, opt *disk.AwsDiskDetachOption) error {
+func (a *AwsImpl) DetachDisk(kt *kit.Kit, opt *disk.AwsDiskDetachOption) error {
 	if opt == nil {
 		return errf.New(errf.InvalidParameter, "aws disk detach option is required")
 	}
@@ -219,7 +219,7 @@ func (a *Aws) DetachDisk(kt *kit.Kit, opt *disk.AwsDiskDetachOption) error {
 		return err
 	}
 
-	respPoller := poller.Poller[*Aws, []disk.AwsDisk, poller.BaseDoneResult]{
+	respPoller := poller.Poller[*AwsImpl, []disk.AwsDisk, poller.BaseDoneResult]{
 		Handler: &detachDiskPollingHandler{region: opt.Region},
 	}
 	_, err = respPoller.PollUntilDone(a, kt, []*string{&opt.CloudDiskID}, nil)
@@ -255,7 +255,7 @@ func (h *createDiskPollingHandler) Done(pollResult []disk.AwsDisk) (bool, *polle
 }
 
 // Poll ...
-func (h *createDiskPollingHandler) Poll(client *Aws, kt *kit.Kit, cloudIDs []*string) ([]disk.AwsDisk, error) {
+func (h *createDiskPollingHandler) Poll(client *AwsImpl, kt *kit.Kit, cloudIDs []*string) ([]disk.AwsDisk, error) {
 	cIDs := converter.PtrToSlice(cloudIDs)
 	result, _, err := client.ListDisk(
 		kt,
@@ -268,7 +268,7 @@ func (h *createDiskPollingHandler) Poll(client *Aws, kt *kit.Kit, cloudIDs []*st
 	return result, err
 }
 
-var _ poller.PollingHandler[*Aws, []disk.AwsDisk, poller.BaseDoneResult] = new(createDiskPollingHandler)
+var _ poller.PollingHandler[*AwsImpl, []disk.AwsDisk, poller.BaseDoneResult] = new(createDiskPollingHandler)
 
 type attachDiskPollingHandler struct {
 	region string
@@ -284,7 +284,7 @@ func (h *attachDiskPollingHandler) Done(pollResult []disk.AwsDisk) (bool, *polle
 }
 
 // Poll ...
-func (h *attachDiskPollingHandler) Poll(client *Aws, kt *kit.Kit, cloudIDs []*string) ([]disk.AwsDisk, error) {
+func (h *attachDiskPollingHandler) Poll(client *AwsImpl, kt *kit.Kit, cloudIDs []*string) ([]disk.AwsDisk, error) {
 	if len(cloudIDs) != 1 {
 		return nil, fmt.Errorf("poll only support one id param, but get %v. rid: %s", cloudIDs, kt.Rid)
 	}
@@ -314,7 +314,7 @@ func (h *detachDiskPollingHandler) Done(pollResult []disk.AwsDisk) (bool, *polle
 }
 
 // Poll ...
-func (h *detachDiskPollingHandler) Poll(client *Aws, kt *kit.Kit, cloudIDs []*string) ([]disk.AwsDisk, error) {
+func (h *detachDiskPollingHandler) Poll(client *AwsImpl, kt *kit.Kit, cloudIDs []*string) ([]disk.AwsDisk, error) {
 	cIDs := converter.PtrToSlice(cloudIDs)
 	result, _, err := client.ListDisk(
 		kt,
@@ -898,7 +898,7 @@ var regionImageIDMap = map[string][]*string{
 
 // ListImage ...
 // reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_DescribeImages.html
-func (a *Aws) ListImage(kt *kit.Kit, opt *image.AwsImageListOption) (*image.AwsImageListResult, error) {
+func (a *AwsImpl) ListImage(kt *kit.Kit, opt *image.AwsImageListOption) (*image.AwsImageListResult, error) {
 	client, err := a.clientSet.ec2Client(opt.Region)
 	if err != nil {
 		return nil, err
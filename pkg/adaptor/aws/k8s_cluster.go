@@ -0,0 +1,196 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	typesk8s "hcm/pkg/adaptor/types/k8s-cluster"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/eks"
+)
+
+// ListK8sCluster 查询EKS集群列表，列表接口只返回集群名称，需要逐个查询集群详情
+// reference: https://docs.aws.amazon.com/eks/latest/APIReference/API_ListClusters.html
+func (a *AwsImpl) ListK8sCluster(kt *kit.Kit, opt *typesk8s.AwsK8sClusterListOption) ([]typesk8s.AwsCluster,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "aws k8s cluster list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.eksClient(opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]*string, 0)
+	err = client.ListClustersPagesWithContext(kt.Ctx, new(eks.ListClustersInput),
+		func(output *eks.ListClustersOutput, lastPage bool) bool {
+			names = append(names, output.Clusters...)
+			return true
+		})
+	if err != nil {
+		logs.Errorf("list aws k8s cluster failed, err: %v, region: %s, rid: %s", err, opt.Region, kt.Rid)
+		return nil, err
+	}
+
+	clusters := make([]typesk8s.AwsCluster, 0, len(names))
+	for _, name := range names {
+		resp, err := client.DescribeClusterWithContext(kt.Ctx, &eks.DescribeClusterInput{Name: name})
+		if err != nil {
+			logs.Errorf("describe aws k8s cluster failed, err: %v, name: %s, rid: %s", err,
+				converter.PtrToVal(name), kt.Rid)
+			return nil, err
+		}
+		clusters = append(clusters, typesk8s.AwsCluster{Cluster: resp.Cluster})
+	}
+
+	return clusters, nil
+}
+
+// ListK8sNodePool 查询EKS集群的托管节点组
+// reference: https://docs.aws.amazon.com/eks/latest/APIReference/API_DescribeNodegroup.html
+func (a *AwsImpl) ListK8sNodePool(kt *kit.Kit, opt *typesk8s.AwsK8sNodeListOption) ([]typesk8s.K8sNodePool, error) {
+	nodeGroups, err := a.listNodeGroup(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	pools := make([]typesk8s.K8sNodePool, 0, len(nodeGroups))
+	for _, one := range nodeGroups {
+		pool := typesk8s.K8sNodePool{
+			CloudID: converter.PtrToVal(one.NodegroupName),
+			Name:    converter.PtrToVal(one.NodegroupName),
+			Status:  converter.PtrToVal(one.Status),
+		}
+		if len(one.InstanceTypes) != 0 {
+			pool.InstanceType = converter.PtrToVal(one.InstanceTypes[0])
+		}
+		if one.ScalingConfig != nil {
+			pool.NodeCount = converter.PtrToVal(one.ScalingConfig.DesiredSize)
+			pool.MinSize = converter.PtrToVal(one.ScalingConfig.MinSize)
+			pool.MaxSize = converter.PtrToVal(one.ScalingConfig.MaxSize)
+		}
+		pools = append(pools, pool)
+	}
+
+	return pools, nil
+}
+
+// ListK8sNode 查询EKS托管节点组的节点，节点组的节点由伸缩组创建，通过伸缩组查询节点对应的实例
+// reference: https://docs.aws.amazon.com/autoscaling/ec2/APIReference/API_DescribeAutoScalingGroups.html
+func (a *AwsImpl) ListK8sNode(kt *kit.Kit, opt *typesk8s.AwsK8sNodeListOption) ([]typesk8s.K8sNode, error) {
+	nodeGroups, err := a.listNodeGroup(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	asgToNodeGroup := make(map[string]string)
+	for _, one := range nodeGroups {
+		if one.Resources == nil {
+			continue
+		}
+		for _, asg := range one.Resources.AutoScalingGroups {
+			asgToNodeGroup[converter.PtrToVal(asg.Name)] = converter.PtrToVal(one.NodegroupName)
+		}
+	}
+
+	nodes := make([]typesk8s.K8sNode, 0)
+	if len(asgToNodeGroup) == 0 {
+		return nodes, nil
+	}
+
+	client, err := a.clientSet.autoScalingClient(opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice(converter.MapKeyToStringSlice(asgToNodeGroup)),
+	}
+	err = client.DescribeAutoScalingGroupsPagesWithContext(kt.Ctx, req,
+		func(output *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool {
+			for _, group := range output.AutoScalingGroups {
+				for _, instance := range group.Instances {
+					nodes = append(nodes, typesk8s.K8sNode{
+						CloudCvmID:      converter.PtrToVal(instance.InstanceId),
+						CloudNodePoolID: asgToNodeGroup[converter.PtrToVal(group.AutoScalingGroupName)],
+					})
+				}
+			}
+			return true
+		})
+	if err != nil {
+		logs.Errorf("list aws k8s node auto scaling group failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
+func (a *AwsImpl) listNodeGroup(kt *kit.Kit, opt *typesk8s.AwsK8sNodeListOption) ([]*eks.Nodegroup, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "aws k8s node list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.eksClient(opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]*string, 0)
+	listReq := &eks.ListNodegroupsInput{ClusterName: aws.String(opt.ClusterName)}
+	err = client.ListNodegroupsPagesWithContext(kt.Ctx, listReq,
+		func(output *eks.ListNodegroupsOutput, lastPage bool) bool {
+			names = append(names, output.Nodegroups...)
+			return true
+		})
+	if err != nil {
+		logs.Errorf("list aws k8s node group failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return nil, err
+	}
+
+	nodeGroups := make([]*eks.Nodegroup, 0, len(names))
+	for _, name := range names {
+		req := &eks.DescribeNodegroupInput{ClusterName: aws.String(opt.ClusterName), NodegroupName: name}
+		resp, err := client.DescribeNodegroupWithContext(kt.Ctx, req)
+		if err != nil {
+			logs.Errorf("describe aws k8s node group failed, err: %v, name: %s, rid: %s", err,
+				converter.PtrToVal(name), kt.Rid)
+			return nil, err
+		}
+		nodeGroups = append(nodeGroups, resp.Nodegroup)
+	}
+
+	return nodeGroups, nil
+}
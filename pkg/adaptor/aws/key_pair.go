@@ -0,0 +1,113 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	typekeypair "hcm/pkg/adaptor/types/key-pair"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// ListKeyPair 查询密钥对列表
+// reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_DescribeKeyPairs.html
+func (a *AwsImpl) ListKeyPair(kt *kit.Kit, opt *typekeypair.AwsKeyPairListOption) ([]typekeypair.AwsKeyPair, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "aws key pair list option is required")
+	}
+
+	req, err := opt.ToDescribeKeyPairsInput()
+	if err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.ec2Client(opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.DescribeKeyPairsWithContext(kt.Ctx, req)
+	if err != nil {
+		logs.Errorf("list aws key pair failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	keyPairs := make([]typekeypair.AwsKeyPair, 0, len(resp.KeyPairs))
+	for _, one := range resp.KeyPairs {
+		keyPairs = append(keyPairs, typekeypair.AwsKeyPair{KeyPairInfo: one})
+	}
+
+	return keyPairs, nil
+}
+
+// ImportKeyPair 导入公钥创建密钥对，返回密钥对ID
+// reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_ImportKeyPair.html
+func (a *AwsImpl) ImportKeyPair(kt *kit.Kit, opt *typekeypair.AwsKeyPairImportOption) (string, error) {
+	if opt == nil {
+		return "", errf.New(errf.InvalidParameter, "aws key pair import option is required")
+	}
+
+	req, err := opt.ToImportKeyPairInput()
+	if err != nil {
+		return "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.ec2Client(opt.Region)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.ImportKeyPairWithContext(kt.Ctx, req)
+	if err != nil {
+		logs.Errorf("import aws key pair failed, err: %v, name: %s, rid: %s", err, opt.Name, kt.Rid)
+		return "", err
+	}
+
+	return converter.PtrToVal(resp.KeyPairId), nil
+}
+
+// DeleteKeyPair 删除密钥对，已使用该密钥对的主机不受影响
+// reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_DeleteKeyPair.html
+func (a *AwsImpl) DeleteKeyPair(kt *kit.Kit, opt *typekeypair.AwsKeyPairDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "aws key pair delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.ec2Client(opt.Region)
+	if err != nil {
+		return err
+	}
+
+	req := &ec2.DeleteKeyPairInput{KeyPairId: aws.String(opt.CloudID)}
+	if _, err = client.DeleteKeyPairWithContext(kt.Ctx, req); err != nil {
+		logs.Errorf("delete aws key pair failed, err: %v, id: %s, rid: %s", err, opt.CloudID, kt.Rid)
+		return err
+	}
+
+	return nil
+}
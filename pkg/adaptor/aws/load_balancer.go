@@ -0,0 +1,296 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	typelb "hcm/pkg/adaptor/types/load-balancer"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	cvt "hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+)
+
+// ListLoadBalancer 查询负载均衡(ALB/NLB)列表
+// reference: https://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_DescribeLoadBalancers.html
+func (a *AwsImpl) ListLoadBalancer(kt *kit.Kit, opt *typelb.AwsListOption) (*typelb.AwsListResult, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.elbV2Client(opt.Region)
+	if err != nil {
+		return nil, fmt.Errorf("new aws elbv2 client failed, region: %s, err: %v", opt.Region, err)
+	}
+
+	req := new(elbv2.DescribeLoadBalancersInput)
+	if len(opt.CloudIDs) != 0 {
+		req.LoadBalancerArns = cvt.SliceToPtr(opt.CloudIDs)
+	}
+
+	if opt.Page != nil {
+		req.Marker = opt.Page.Marker
+		req.PageSize = opt.Page.PageSize
+	}
+
+	resp, err := client.DescribeLoadBalancersWithContext(kt.Ctx, req)
+	if err != nil {
+		if strings.Contains(err.Error(), ErrLbNotFound) {
+			return new(typelb.AwsListResult), nil
+		}
+		logs.Errorf("list aws load balancer failed, req: %+v, err: %v, rid: %s", req, err, kt.Rid)
+		return nil, err
+	}
+
+	details := make([]typelb.AwsLoadBalancer, 0, len(resp.LoadBalancers))
+	for _, one := range resp.LoadBalancers {
+		details = append(details, typelb.AwsLoadBalancer{LoadBalancer: one})
+	}
+
+	return &typelb.AwsListResult{NextMarker: resp.NextMarker, Details: details}, nil
+}
+
+// CreateLoadBalancer 创建负载均衡，返回负载均衡ARN
+// reference: https://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_CreateLoadBalancer.html
+func (a *AwsImpl) CreateLoadBalancer(kt *kit.Kit, opt *typelb.AwsCreateOption) (string, error) {
+	if opt == nil {
+		return "", errf.New(errf.InvalidParameter, "create option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.elbV2Client(opt.Region)
+	if err != nil {
+		return "", fmt.Errorf("new aws elbv2 client failed, region: %s, err: %v", opt.Region, err)
+	}
+
+	req := &elbv2.CreateLoadBalancerInput{
+		Name:          cvt.ValToPtr(opt.Name),
+		Type:          cvt.ValToPtr(string(opt.Type)),
+		IpAddressType: opt.IpAddressType,
+		Subnets:       cvt.SliceToPtr(opt.CloudSubnetIDs),
+	}
+	if len(opt.Scheme) != 0 {
+		req.Scheme = cvt.ValToPtr(string(opt.Scheme))
+	}
+	if len(opt.SecurityGroups) != 0 {
+		req.SecurityGroups = cvt.SliceToPtr(opt.SecurityGroups)
+	}
+
+	resp, err := client.CreateLoadBalancerWithContext(kt.Ctx, req)
+	if err != nil {
+		logs.Errorf("create aws load balancer failed, req: %+v, err: %v, rid: %s", req, err, kt.Rid)
+		return "", err
+	}
+
+	if len(resp.LoadBalancers) == 0 {
+		return "", fmt.Errorf("create aws load balancer succeed but no load balancer returned")
+	}
+
+	return cvt.PtrToVal(resp.LoadBalancers[0].LoadBalancerArn), nil
+}
+
+// DeleteLoadBalancer 删除负载均衡，aws 不支持批量删除，逐个删除
+// reference: https://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_DeleteLoadBalancer.html
+func (a *AwsImpl) DeleteLoadBalancer(kt *kit.Kit, opt *typelb.AwsDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.elbV2Client(opt.Region)
+	if err != nil {
+		return fmt.Errorf("new aws elbv2 client failed, region: %s, err: %v", opt.Region, err)
+	}
+
+	for _, cloudID := range opt.CloudIDs {
+		req := &elbv2.DeleteLoadBalancerInput{LoadBalancerArn: cvt.ValToPtr(cloudID)}
+		if _, err = client.DeleteLoadBalancerWithContext(kt.Ctx, req); err != nil {
+			logs.Errorf("delete aws load balancer failed, arn: %s, err: %v, rid: %s", cloudID, err, kt.Rid)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ListListener 查询负载均衡下的监听器
+// reference: https://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_DescribeListeners.html
+func (a *AwsImpl) ListListener(kt *kit.Kit, opt *typelb.AwsListListenerOption) (*typelb.AwsListenerListResult,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.elbV2Client(opt.Region)
+	if err != nil {
+		return nil, fmt.Errorf("new aws elbv2 client failed, region: %s, err: %v", opt.Region, err)
+	}
+
+	req := &elbv2.DescribeListenersInput{LoadBalancerArn: cvt.ValToPtr(opt.LoadBalancerID)}
+	if opt.Page != nil {
+		req.Marker = opt.Page.Marker
+		req.PageSize = opt.Page.PageSize
+	}
+
+	resp, err := client.DescribeListenersWithContext(kt.Ctx, req)
+	if err != nil {
+		logs.Errorf("list aws listener failed, req: %+v, err: %v, rid: %s", req, err, kt.Rid)
+		return nil, err
+	}
+
+	details := make([]typelb.AwsListener, 0, len(resp.Listeners))
+	for _, one := range resp.Listeners {
+		details = append(details, typelb.AwsListener{Listener: one})
+	}
+
+	return &typelb.AwsListenerListResult{NextMarker: resp.NextMarker, Details: details}, nil
+}
+
+// ListTargetGroup 查询目标组列表
+// reference: https://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_DescribeTargetGroups.html
+func (a *AwsImpl) ListTargetGroup(kt *kit.Kit, opt *typelb.AwsListTargetGroupOption) (
+	*typelb.AwsTargetGroupListResult, error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.elbV2Client(opt.Region)
+	if err != nil {
+		return nil, fmt.Errorf("new aws elbv2 client failed, region: %s, err: %v", opt.Region, err)
+	}
+
+	req := new(elbv2.DescribeTargetGroupsInput)
+	if len(opt.CloudIDs) != 0 {
+		req.TargetGroupArns = cvt.SliceToPtr(opt.CloudIDs)
+	}
+
+	if opt.Page != nil {
+		req.Marker = opt.Page.Marker
+		req.PageSize = opt.Page.PageSize
+	}
+
+	resp, err := client.DescribeTargetGroupsWithContext(kt.Ctx, req)
+	if err != nil {
+		if strings.Contains(err.Error(), ErrTargetGroupNotFound) {
+			return new(typelb.AwsTargetGroupListResult), nil
+		}
+		logs.Errorf("list aws target group failed, req: %+v, err: %v, rid: %s", req, err, kt.Rid)
+		return nil, err
+	}
+
+	details := make([]typelb.AwsTargetGroup, 0, len(resp.TargetGroups))
+	for _, one := range resp.TargetGroups {
+		details = append(details, typelb.AwsTargetGroup{TargetGroup: one})
+	}
+
+	return &typelb.AwsTargetGroupListResult{NextMarker: resp.NextMarker, Details: details}, nil
+}
+
+// RegisterTargets 向目标组注册后端目标
+// reference: https://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_RegisterTargets.html
+func (a *AwsImpl) RegisterTargets(kt *kit.Kit, opt *typelb.AwsTargetOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "register targets option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.elbV2Client(opt.Region)
+	if err != nil {
+		return fmt.Errorf("new aws elbv2 client failed, region: %s, err: %v", opt.Region, err)
+	}
+
+	req := &elbv2.RegisterTargetsInput{
+		TargetGroupArn: cvt.ValToPtr(opt.CloudTargetGroupID),
+		Targets:        convTargetDescriptions(opt.Targets),
+	}
+	if _, err = client.RegisterTargetsWithContext(kt.Ctx, req); err != nil {
+		logs.Errorf("register aws targets failed, req: %+v, err: %v, rid: %s", req, err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// DeregisterTargets 从目标组解注册后端目标
+// reference: https://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_DeregisterTargets.html
+func (a *AwsImpl) DeregisterTargets(kt *kit.Kit, opt *typelb.AwsTargetOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "deregister targets option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.elbV2Client(opt.Region)
+	if err != nil {
+		return fmt.Errorf("new aws elbv2 client failed, region: %s, err: %v", opt.Region, err)
+	}
+
+	req := &elbv2.DeregisterTargetsInput{
+		TargetGroupArn: cvt.ValToPtr(opt.CloudTargetGroupID),
+		Targets:        convTargetDescriptions(opt.Targets),
+	}
+	if _, err = client.DeregisterTargetsWithContext(kt.Ctx, req); err != nil {
+		logs.Errorf("deregister aws targets failed, req: %+v, err: %v, rid: %s", req, err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+func convTargetDescriptions(targets []*typelb.AwsTarget) []*elbv2.TargetDescription {
+	result := make([]*elbv2.TargetDescription, 0, len(targets))
+	for _, one := range targets {
+		desc := &elbv2.TargetDescription{Id: cvt.ValToPtr(one.ID)}
+		if one.Port > 0 {
+			desc.Port = cvt.ValToPtr(one.Port)
+		}
+		result = append(result, desc)
+	}
+	return result
+}
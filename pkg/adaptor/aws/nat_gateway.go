@@ -0,0 +1,72 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	natgateway "hcm/pkg/adaptor/types/nat-gateway"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// ListNatGateway 查询NAT网关列表
+// reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_DescribeNatGateways.html
+func (a *AwsImpl) ListNatGateway(kt *kit.Kit, opt *natgateway.AwsNatGatewayListOption) (
+	[]natgateway.AwsNatGateway, *string, error) {
+
+	if opt == nil {
+		return nil, nil, errf.New(errf.InvalidParameter, "aws nat gateway list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.ec2Client(opt.Region)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req := new(ec2.DescribeNatGatewaysInput)
+	// 通过 NatGatewayIds 查询已被删除的NAT网关会直接报错，所以使用 nat-gateway-id 过滤
+	if len(opt.CloudIDs) > 0 {
+		req.Filter = []*ec2.Filter{{Name: aws.String("nat-gateway-id"), Values: converter.SliceToPtr(opt.CloudIDs)}}
+	} else if opt.Page != nil {
+		req.MaxResults = opt.Page.MaxResults
+		req.NextToken = opt.Page.NextToken
+	}
+
+	resp, err := client.DescribeNatGatewaysWithContext(kt.Ctx, req)
+	if err != nil {
+		logs.Errorf("list aws nat gateway failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, nil, err
+	}
+
+	nats := make([]natgateway.AwsNatGateway, 0, len(resp.NatGateways))
+	for _, one := range resp.NatGateways {
+		nats = append(nats, natgateway.AwsNatGateway{NatGateway: one})
+	}
+
+	return nats, resp.NextToken, nil
+}
@@ -0,0 +1,205 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package aws
+
+import (
+	"strings"
+
+	typesdns "hcm/pkg/adaptor/types/private-dns"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+// ListPrivateDnsZone 查询私有托管区域，托管区域列表不返回关联的VPC，需要逐个查询详情
+// reference: https://docs.aws.amazon.com/Route53/latest/APIReference/API_ListHostedZones.html
+func (a *AwsImpl) ListPrivateDnsZone(kt *kit.Kit, opt *typesdns.AwsZoneListOption) ([]typesdns.AwsZone, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "aws private dns zone list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.route53Client()
+	if err != nil {
+		return nil, err
+	}
+
+	cloudIDs := opt.CloudIDs
+	if len(cloudIDs) == 0 {
+		cloudIDs = make([]string, 0)
+		err = client.ListHostedZonesPagesWithContext(kt.Ctx, new(route53.ListHostedZonesInput),
+			func(page *route53.ListHostedZonesOutput, lastPage bool) bool {
+				for _, one := range page.HostedZones {
+					if one.Config == nil || !converter.PtrToVal(one.Config.PrivateZone) {
+						continue
+					}
+					cloudIDs = append(cloudIDs, strings.TrimPrefix(converter.PtrToVal(one.Id),
+						typesdns.AwsHostedZoneIDPrefix))
+				}
+				return true
+			})
+		if err != nil {
+			logs.Errorf("list aws hosted zone failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, err
+		}
+	}
+
+	zones := make([]typesdns.AwsZone, 0, len(cloudIDs))
+	for _, id := range cloudIDs {
+		resp, err := client.GetHostedZoneWithContext(kt.Ctx, &route53.GetHostedZoneInput{Id: aws.String(id)})
+		if err != nil {
+			if strings.Contains(err.Error(), route53.ErrCodeNoSuchHostedZone) {
+				continue
+			}
+			logs.Errorf("get aws hosted zone failed, err: %v, id: %s, rid: %s", err, id, kt.Rid)
+			return nil, err
+		}
+
+		if resp.HostedZone.Config == nil || !converter.PtrToVal(resp.HostedZone.Config.PrivateZone) {
+			continue
+		}
+		zones = append(zones, typesdns.AwsZone{HostedZone: resp.HostedZone, VPCs: resp.VPCs})
+	}
+
+	return zones, nil
+}
+
+// ListPrivateDnsRecord 查询托管区域的记录集，别名记录和带路由策略的记录集不在管理范围内
+// reference: https://docs.aws.amazon.com/Route53/latest/APIReference/API_ListResourceRecordSets.html
+func (a *AwsImpl) ListPrivateDnsRecord(kt *kit.Kit, opt *typesdns.AwsRecordListOption) ([]typesdns.Record, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "aws private dns record list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.route53Client()
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]typesdns.Record, 0)
+	req := &route53.ListResourceRecordSetsInput{HostedZoneId: aws.String(opt.CloudZoneID)}
+	err = client.ListResourceRecordSetsPagesWithContext(kt.Ctx, req,
+		func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+			for _, one := range page.ResourceRecordSets {
+				recordType := converter.PtrToVal(one.Type)
+				if !typesdns.IsSupportedRecordType(recordType) || one.AliasTarget != nil || one.SetIdentifier != nil {
+					continue
+				}
+
+				// Route 53 返回的域名中 "*" 会被转义为 "\052"
+				fqdn := strings.ReplaceAll(converter.PtrToVal(one.Name), `\052`, "*")
+				name := typesdns.ToRecordName(fqdn, opt.ZoneName)
+				values := make([]string, 0, len(one.ResourceRecords))
+				for _, rr := range one.ResourceRecords {
+					values = append(values, converter.PtrToVal(rr.Value))
+				}
+
+				records = append(records, typesdns.Record{
+					CloudID: typesdns.GetRecordSetCloudID(name, enumor.PrivateDnsRecordType(recordType)),
+					Name:    name,
+					Type:    enumor.PrivateDnsRecordType(recordType),
+					Value:   values,
+					TTL:     converter.PtrToVal(one.TTL),
+				})
+			}
+			return true
+		})
+	if err != nil {
+		logs.Errorf("list aws resource record set failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// CreatePrivateDnsRecord 创建记录集，返回记录集云上ID
+// reference: https://docs.aws.amazon.com/Route53/latest/APIReference/API_ChangeResourceRecordSets.html
+func (a *AwsImpl) CreatePrivateDnsRecord(kt *kit.Kit, opt *typesdns.AwsRecordOption) (string, error) {
+	if err := a.changeRecordSet(kt, route53.ChangeActionCreate, opt); err != nil {
+		return "", err
+	}
+
+	return typesdns.GetRecordSetCloudID(opt.Name, opt.Type), nil
+}
+
+// UpdatePrivateDnsRecord 修改记录集，记录集不存在时会创建
+// reference: https://docs.aws.amazon.com/Route53/latest/APIReference/API_ChangeResourceRecordSets.html
+func (a *AwsImpl) UpdatePrivateDnsRecord(kt *kit.Kit, opt *typesdns.AwsRecordOption) error {
+	return a.changeRecordSet(kt, route53.ChangeActionUpsert, opt)
+}
+
+// DeletePrivateDnsRecord 删除记录集，记录值和TTL需要与云上一致
+// reference: https://docs.aws.amazon.com/Route53/latest/APIReference/API_ChangeResourceRecordSets.html
+func (a *AwsImpl) DeletePrivateDnsRecord(kt *kit.Kit, opt *typesdns.AwsRecordOption) error {
+	return a.changeRecordSet(kt, route53.ChangeActionDelete, opt)
+}
+
+func (a *AwsImpl) changeRecordSet(kt *kit.Kit, action string, opt *typesdns.AwsRecordOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "aws private dns record option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.route53Client()
+	if err != nil {
+		return err
+	}
+
+	records := make([]*route53.ResourceRecord, 0, len(opt.Value))
+	for _, value := range opt.Value {
+		records = append(records, &route53.ResourceRecord{Value: aws.String(value)})
+	}
+
+	req := &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(opt.CloudZoneID),
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: []*route53.Change{{
+				Action: aws.String(action),
+				ResourceRecordSet: &route53.ResourceRecordSet{
+					Name:            aws.String(typesdns.ToFQDN(opt.Name, opt.ZoneName)),
+					Type:            aws.String(string(opt.Type)),
+					TTL:             aws.Int64(opt.GetTTL()),
+					ResourceRecords: records,
+				},
+			}},
+		},
+	}
+	if _, err = client.ChangeResourceRecordSetsWithContext(kt.Ctx, req); err != nil {
+		logs.Errorf("%s aws resource record set failed, err: %v, opt: %+v, rid: %s", strings.ToLower(action), err,
+			opt, kt.Rid)
+		return err
+	}
+
+	return nil
+}
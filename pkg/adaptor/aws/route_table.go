@@ -34,13 +34,13 @@ import (
 
 // UpdateRouteTable update route table.
 // TODO right now only memo is supported to update, add other update operations later.
-func (a *Aws) UpdateRouteTable(kt *kit.Kit, opt *routetable.AwsRouteTableUpdateOption) error {
+func (a *AwsImpl) UpdateRouteTable(kt *kit.Kit, opt *routetable.AwsRouteTableUpdateOption) error {
 	return nil
 }
 
 // DeleteRouteTable delete route table.
 // reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_DeleteRouteTable.html
-func (a *Aws) DeleteRouteTable(kt *kit.Kit, opt *core.BaseRegionalDeleteOption) error {
+func (a *AwsImpl) DeleteRouteTable(kt *kit.Kit, opt *core.BaseRegionalDeleteOption) error {
 	if err := opt.Validate(); err != nil {
 		return err
 	}
@@ -64,7 +64,7 @@ func (a *Aws) DeleteRouteTable(kt *kit.Kit, opt *core.BaseRegionalDeleteOption)
 
 // ListRouteTable list route table.
 // reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_DescribeRouteTables.html
-func (a *Aws) ListRouteTable(kt *kit.Kit, opt *routetable.AwsRouteTableListOption) (
+func (a *AwsImpl) ListRouteTable(kt *kit.Kit, opt *routetable.AwsRouteTableListOption) (
 	*routetable.AwsRouteTableListResult, error) {
 
 	if err := opt.Validate(); err != nil {
@@ -113,7 +113,7 @@ func (a *Aws) ListRouteTable(kt *kit.Kit, opt *routetable.AwsRouteTableListOptio
 
 // CountRouteTable 返回给定地域的所有eip数量，基于DescribeRouteTables 接口遍历
 // reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_DescribeRouteTables.html
-func (a *Aws) CountRouteTable(kt *kit.Kit, region string) (int32, error) {
+func (a *AwsImpl) CountRouteTable(kt *kit.Kit, region string) (int32, error) {
 
 	client, err := a.clientSet.ec2Client(region)
 	if err != nil {
@@ -0,0 +1,150 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"hcm/pkg/adaptor/types/snapshot"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// ListSnapshot 查询当前账号拥有的快照
+// reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_DescribeSnapshots.html
+func (a *AwsImpl) ListSnapshot(kt *kit.Kit, opt *snapshot.AwsSnapshotListOption) ([]snapshot.AwsSnapshot, *string,
+	error) {
+
+	if opt == nil {
+		return nil, nil, errf.New(errf.InvalidParameter, "aws snapshot list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.ec2Client(opt.Region)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 不指定 OwnerIds 会返回所有公共快照
+	req := &ec2.DescribeSnapshotsInput{OwnerIds: []*string{aws.String("self")}}
+	// 通过 SnapshotIds 查询已被删除的快照会直接报错，所以使用 snapshot-id 过滤
+	if len(opt.CloudIDs) > 0 {
+		req.Filters = []*ec2.Filter{{Name: aws.String("snapshot-id"), Values: converter.SliceToPtr(opt.CloudIDs)}}
+	} else if opt.Page != nil {
+		req.MaxResults = opt.Page.MaxResults
+		req.NextToken = opt.Page.NextToken
+	}
+
+	resp, err := client.DescribeSnapshotsWithContext(kt.Ctx, req)
+	if err != nil {
+		logs.Errorf("list aws snapshot failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, nil, err
+	}
+
+	snapshots := make([]snapshot.AwsSnapshot, 0, len(resp.Snapshots))
+	for _, one := range resp.Snapshots {
+		snapshots = append(snapshots, snapshot.AwsSnapshot{Snapshot: one})
+	}
+
+	return snapshots, resp.NextToken, nil
+}
+
+// CreateSnapshot 创建快照，返回快照ID
+// reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_CreateSnapshot.html
+func (a *AwsImpl) CreateSnapshot(kt *kit.Kit, opt *snapshot.AwsSnapshotCreateOption) (string, error) {
+	if opt == nil {
+		return "", errf.New(errf.InvalidParameter, "aws snapshot create option is required")
+	}
+
+	input, err := opt.ToCreateSnapshotInput()
+	if err != nil {
+		return "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.ec2Client(opt.Region)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.CreateSnapshotWithContext(kt.Ctx, input)
+	if err != nil {
+		logs.Errorf("create aws snapshot failed, err: %v, disk: %s, rid: %s", err, opt.CloudDiskID, kt.Rid)
+		return "", err
+	}
+
+	return converter.PtrToVal(resp.SnapshotId), nil
+}
+
+// DeleteSnapshot 删除快照
+// reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_DeleteSnapshot.html
+func (a *AwsImpl) DeleteSnapshot(kt *kit.Kit, opt *snapshot.AwsSnapshotDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "aws snapshot delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.ec2Client(opt.Region)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeleteSnapshotWithContext(kt.Ctx, &ec2.DeleteSnapshotInput{SnapshotId: aws.String(opt.CloudID)})
+	if err != nil {
+		logs.Errorf("delete aws snapshot failed, err: %v, id: %s, rid: %s", err, opt.CloudID, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// RollbackSnapshot 使用快照替换实例根卷
+// reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_CreateReplaceRootVolumeTask.html
+func (a *AwsImpl) RollbackSnapshot(kt *kit.Kit, opt *snapshot.AwsSnapshotRollbackOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "aws snapshot rollback option is required")
+	}
+
+	input, err := opt.ToCreateReplaceRootVolumeTaskInput()
+	if err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.ec2Client(opt.Region)
+	if err != nil {
+		return err
+	}
+
+	if _, err = client.CreateReplaceRootVolumeTaskWithContext(kt.Ctx, input); err != nil {
+		logs.Errorf("rollback aws snapshot failed, err: %v, snapshot: %s, cvm: %s, rid: %s", err,
+			opt.CloudSnapshotID, opt.CloudCvmID, kt.Rid)
+		return err
+	}
+
+	return nil
+}
@@ -39,7 +39,7 @@ import (
 
 // CreateSubnet create subnet.
 // reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_CreateSubnet.html
-func (a *Aws) CreateSubnet(kt *kit.Kit, opt *adtysubnet.AwsSubnetCreateOption) (*adtysubnet.AwsSubnet, error) {
+func (a *AwsImpl) CreateSubnet(kt *kit.Kit, opt *adtysubnet.AwsSubnetCreateOption) (*adtysubnet.AwsSubnet, error) {
 	if err := opt.Validate(); err != nil {
 		return nil, err
 	}
@@ -70,7 +70,7 @@ func (a *Aws) CreateSubnet(kt *kit.Kit, opt *adtysubnet.AwsSubnetCreateOption) (
 	handler := &createSubnetPollingHandler{
 		opt.Extension.Region,
 	}
-	respPoller := poller.Poller[*Aws, []*ec2.Subnet, []*adtysubnet.AwsSubnet]{Handler: handler}
+	respPoller := poller.Poller[*AwsImpl, []*ec2.Subnet, []*adtysubnet.AwsSubnet]{Handler: handler}
 	results, err := respPoller.PollUntilDone(a, kt, []*string{resp.Subnet.SubnetId},
 		types.NewBatchCreateSubnetPollerOption())
 	if err != nil {
@@ -86,7 +86,7 @@ func (a *Aws) CreateSubnet(kt *kit.Kit, opt *adtysubnet.AwsSubnetCreateOption) (
 
 // CreateDefaultSubnet create default subnet.
 // reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_CreateDefaultSubnet.html
-func (a *Aws) CreateDefaultSubnet(kt *kit.Kit, opt *adtysubnet.AwsDefaultSubnetCreateOption) (*adtysubnet.AwsSubnet,
+func (a *AwsImpl) CreateDefaultSubnet(kt *kit.Kit, opt *adtysubnet.AwsDefaultSubnetCreateOption) (*adtysubnet.AwsSubnet,
 	error) {
 	if err := opt.Validate(); err != nil {
 		return nil, err
@@ -114,13 +114,13 @@ func (a *Aws) CreateDefaultSubnet(kt *kit.Kit, opt *adtysubnet.AwsDefaultSubnetC
 
 // UpdateSubnet update subnet.
 // TODO right now only memo is supported to update, add other update operations later.
-func (a *Aws) UpdateSubnet(_ *kit.Kit, _ *adtysubnet.AwsSubnetUpdateOption) error {
+func (a *AwsImpl) UpdateSubnet(_ *kit.Kit, _ *adtysubnet.AwsSubnetUpdateOption) error {
 	return nil
 }
 
 // DeleteSubnet delete subnet.
 // reference: https://docs.aws.amazon.com/zh_cn/AWSEC2/latest/APIReference/API_DeleteSubnet.html
-func (a *Aws) DeleteSubnet(kt *kit.Kit, opt *core.BaseRegionalDeleteOption) error {
+func (a *AwsImpl) DeleteSubnet(kt *kit.Kit, opt *core.BaseRegionalDeleteOption) error {
 	if err := opt.Validate(); err != nil {
 		return err
 	}
@@ -144,7 +144,7 @@ func (a *Aws) DeleteSubnet(kt *kit.Kit, opt *core.BaseRegionalDeleteOption) erro
 
 // ListSubnet list subnet.
 // reference: https://docs.aws.amazon.com/zh_cn/AWSEC2/latest/APIReference/API_DescribeSubnets.html
-func (a *Aws) ListSubnet(kt *kit.Kit, opt *core.AwsListOption) (*adtysubnet.AwsSubnetListResult, error) {
+func (a *AwsImpl) ListSubnet(kt *kit.Kit, opt *core.AwsListOption) (*adtysubnet.AwsSubnetListResult, error) {
 	if err := opt.Validate(); err != nil {
 		return nil, err
 	}
@@ -184,7 +184,7 @@ func (a *Aws) ListSubnet(kt *kit.Kit, opt *core.AwsListOption) (*adtysubnet.AwsS
 
 // CountSubnet 返回给定地域的所有子网数量，基于 DescribeSubnetsWithContext 接口遍历得到
 // reference: https://docs.aws.amazon.com/zh_cn/AWSEC2/latest/APIReference/API_DescribeSubnets.html
-func (a *Aws) CountSubnet(kt *kit.Kit, region string) (int32, error) {
+func (a *AwsImpl) CountSubnet(kt *kit.Kit, region string) (int32, error) {
 
 	client, err := a.clientSet.ec2Client(region)
 	if err != nil {
@@ -276,7 +276,7 @@ func (h *createSubnetPollingHandler) Done(subnets []*ec2.Subnet) (bool, *[]*adty
 }
 
 // Poll ...
-func (h *createSubnetPollingHandler) Poll(client *Aws, kt *kit.Kit, cloudIDs []*string) ([]*ec2.Subnet, error) {
+func (h *createSubnetPollingHandler) Poll(client *AwsImpl, kt *kit.Kit, cloudIDs []*string) ([]*ec2.Subnet, error) {
 
 	cloudIDSplit := slice.Split(cloudIDs, core.AwsQueryLimit)
 
@@ -40,7 +40,7 @@ import (
 
 // CreateVpc create vpc.
 // reference: https://docs.aws.amazon.com/zh_cn/AWSEC2/latest/APIReference/API_CreateVpc.html
-func (a *Aws) CreateVpc(kt *kit.Kit, opt *types.AwsVpcCreateOption) (*types.AwsVpc, error) {
+func (a *AwsImpl) CreateVpc(kt *kit.Kit, opt *types.AwsVpcCreateOption) (*types.AwsVpc, error) {
 	if err := opt.Validate(); err != nil {
 		return nil, err
 	}
@@ -74,7 +74,7 @@ func (a *Aws) CreateVpc(kt *kit.Kit, opt *types.AwsVpcCreateOption) (*types.AwsV
 	handler := &createVpcPollingHandler{
 		opt.Extension.Region,
 	}
-	respPoller := poller.Poller[*Aws, []*ec2.Vpc, []*types.AwsVpc]{Handler: handler}
+	respPoller := poller.Poller[*AwsImpl, []*ec2.Vpc, []*types.AwsVpc]{Handler: handler}
 	results, err := respPoller.PollUntilDone(a, kt, []*string{resp.Vpc.VpcId},
 		types.NewBatchCreateVpcPollerOption())
 	if err != nil {
@@ -90,13 +90,13 @@ func (a *Aws) CreateVpc(kt *kit.Kit, opt *types.AwsVpcCreateOption) (*types.AwsV
 
 // UpdateVpc update vpc.
 // TODO right now only memo is supported to update, add other update operations later.
-func (a *Aws) UpdateVpc(kt *kit.Kit, opt *types.AwsVpcUpdateOption) error {
+func (a *AwsImpl) UpdateVpc(kt *kit.Kit, opt *types.AwsVpcUpdateOption) error {
 	return nil
 }
 
 // DeleteVpc delete vpc.
 // reference: https://docs.aws.amazon.com/zh_cn/AWSEC2/latest/APIReference/API_DeleteVpc.html
-func (a *Aws) DeleteVpc(kt *kit.Kit, opt *core.BaseRegionalDeleteOption) error {
+func (a *AwsImpl) DeleteVpc(kt *kit.Kit, opt *core.BaseRegionalDeleteOption) error {
 	if err := opt.Validate(); err != nil {
 		return err
 	}
@@ -120,7 +120,7 @@ func (a *Aws) DeleteVpc(kt *kit.Kit, opt *core.BaseRegionalDeleteOption) error {
 
 // ListVpc list vpc. 如果查询的ID不存在，会报错：InvalidVpcID.NotFound
 // reference: https://docs.aws.amazon.com/zh_cn/AWSEC2/latest/APIReference/API_DescribeVpcs.html
-func (a *Aws) ListVpc(kt *kit.Kit, opt *core.AwsListOption) (*types.AwsVpcListResult, error) {
+func (a *AwsImpl) ListVpc(kt *kit.Kit, opt *core.AwsListOption) (*types.AwsVpcListResult, error) {
 	if err := opt.Validate(); err != nil {
 		return nil, err
 	}
@@ -160,7 +160,7 @@ func (a *Aws) ListVpc(kt *kit.Kit, opt *core.AwsListOption) (*types.AwsVpcListRe
 
 // CountVpc 返回指定地域下所有的vpc数量，基于 DescribeVpcs 接口遍历
 // reference: https://docs.aws.amazon.com/zh_cn/AWSEC2/latest/APIReference/API_DescribeVpcs.html
-func (a *Aws) CountVpc(kt *kit.Kit, region string) (int32, error) {
+func (a *AwsImpl) CountVpc(kt *kit.Kit, region string) (int32, error) {
 
 	client, err := a.clientSet.ec2Client(region)
 	if err != nil {
@@ -189,7 +189,7 @@ func (a *Aws) CountVpc(kt *kit.Kit, region string) (int32, error) {
 
 // GetVpcAttribute get vpc enableDnsHostnames and enableDnsSupport attribute.
 // reference: https://docs.aws.amazon.com/zh_cn/AWSEC2/latest/APIReference/API_DescribeVpcAttribute.html
-func (a *Aws) GetVpcAttribute(kt *kit.Kit, vpcID, region string) (bool, bool, error) {
+func (a *AwsImpl) GetVpcAttribute(kt *kit.Kit, vpcID, region string) (bool, bool, error) {
 	if len(vpcID) == 0 {
 		return false, false, errf.New(errf.InvalidParameter, "vpc id can not be empty")
 	}
@@ -321,7 +321,7 @@ func (h *createVpcPollingHandler) Done(vpcs []*ec2.Vpc) (bool, *[]*types.AwsVpc)
 }
 
 // Poll ...
-func (h *createVpcPollingHandler) Poll(client *Aws, kt *kit.Kit, cloudIDs []*string) ([]*ec2.Vpc, error) {
+func (h *createVpcPollingHandler) Poll(client *AwsImpl, kt *kit.Kit, cloudIDs []*string) ([]*ec2.Vpc, error) {
 	cloudIDSplit := slice.Split(cloudIDs, core.AwsQueryLimit)
 
 	vpcs := make([]*ec2.Vpc, 0, len(cloudIDs))
@@ -0,0 +1,72 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	vpcpeering "hcm/pkg/adaptor/types/vpc-peering"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// ListVpcPeering 查询对等连接列表
+// reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_DescribeVpcPeeringConnections.html
+func (a *AwsImpl) ListVpcPeering(kt *kit.Kit, opt *vpcpeering.AwsVpcPeeringListOption) (
+	[]vpcpeering.AwsVpcPeering, *string, error) {
+
+	if opt == nil {
+		return nil, nil, errf.New(errf.InvalidParameter, "aws vpc peering list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := a.clientSet.ec2Client(opt.Region)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req := new(ec2.DescribeVpcPeeringConnectionsInput)
+	if len(opt.CloudIDs) > 0 {
+		req.Filters = []*ec2.Filter{{Name: aws.String("vpc-peering-connection-id"),
+			Values: converter.SliceToPtr(opt.CloudIDs)}}
+	} else if opt.Page != nil {
+		req.MaxResults = opt.Page.MaxResults
+		req.NextToken = opt.Page.NextToken
+	}
+
+	resp, err := client.DescribeVpcPeeringConnectionsWithContext(kt.Ctx, req)
+	if err != nil {
+		logs.Errorf("list aws vpc peering failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, nil, err
+	}
+
+	peerings := make([]vpcpeering.AwsVpcPeering, 0, len(resp.VpcPeeringConnections))
+	for _, one := range resp.VpcPeeringConnections {
+		peerings = append(peerings, vpcpeering.AwsVpcPeering{VpcPeeringConnection: one})
+	}
+
+	return peerings, resp.NextToken, nil
+}
@@ -30,7 +30,7 @@ import (
 
 // ListZone list zone
 // reference: https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_DescribeAvailabilityZones.html
-func (a *Aws) ListZone(kit *kit.Kit, opt *typeszone.AwsZoneListOption) ([]typeszone.AwsZone, error) {
+func (a *AwsImpl) ListZone(kit *kit.Kit, opt *typeszone.AwsZoneListOption) ([]typeszone.AwsZone, error) {
 
 	if opt == nil {
 		return nil, errf.New(errf.InvalidParameter, "aws zone list option is required")
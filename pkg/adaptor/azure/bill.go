@@ -153,8 +153,11 @@ func (b *billClient) GetUsageDetail(kt *kit.Kit, opt *typesBill.AzureBillListOpt
 	// 获取AccessToken
 	h.Set(AuthHeader, "Bearer "+b.LoginToken.AccessToken)
 
-	apiPath := fmt.Sprintf("subscriptions/%s/providers/Microsoft.Consumption/usageDetails",
-		b.LoginToken.SubscriptionID)
+	subscriptionID := b.LoginToken.SubscriptionID
+	if opt.SubscriptionID != "" {
+		subscriptionID = opt.SubscriptionID
+	}
+	apiPath := fmt.Sprintf("subscriptions/%s/providers/Microsoft.Consumption/usageDetails", subscriptionID)
 	usageClient := b.client.Get().
 		WithContext(kt.Ctx).
 		WithHeaders(h).
@@ -208,7 +211,7 @@ func (b *billClient) GetUsageDetail(kt *kit.Kit, opt *typesBill.AzureBillListOpt
 
 // GetBillList get bill list.
 // reference: https://learn.microsoft.com/zh-cn/rest/api/consumption/usage-details/list?tabs=HTTP#usagedetailslistresult
-func (az *Azure) GetBillList(kt *kit.Kit, opt *typesBill.AzureBillListOption) (
+func (az *AzureImpl) GetBillList(kt *kit.Kit, opt *typesBill.AzureBillListOption) (
 	*armconsumption.UsageDetailsListResult, error) {
 
 	if err := opt.Validate(); err != nil {
@@ -0,0 +1,91 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	"fmt"
+
+	typesbucket "hcm/pkg/adaptor/types/bucket"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/tools/converter"
+)
+
+// ListBucket 查询资源组下存储账户中的Blob容器，容器的公共访问和加密配置需要结合所属存储账户计算
+// reference: https://learn.microsoft.com/en-us/rest/api/storagerp/blob-containers/list
+func (az *AzureImpl) ListBucket(kt *kit.Kit, opt *typesbucket.AzureBucketListOption) ([]typesbucket.AzureBucket,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "azure bucket list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	accountClient, err := az.clientSet.storageAccountClient()
+	if err != nil {
+		return nil, err
+	}
+
+	containerClient, err := az.clientSet.blobContainerClient()
+	if err != nil {
+		return nil, err
+	}
+
+	idMap := converter.StringSliceToMap(opt.CloudIDs)
+	buckets := make([]typesbucket.AzureBucket, 0)
+	accountPager := accountClient.NewListByResourceGroupPager(opt.ResourceGroupName, nil)
+	for accountPager.More() {
+		accountResult, err := accountPager.NextPage(kt.Ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to advance page: %v", err)
+		}
+
+		for _, account := range accountResult.Value {
+			if account == nil {
+				continue
+			}
+
+			containerPager := containerClient.NewListPager(opt.ResourceGroupName, converter.PtrToVal(account.Name), nil)
+			for containerPager.More() {
+				containerResult, err := containerPager.NextPage(kt.Ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to advance page: %v", err)
+				}
+
+				for _, one := range containerResult.Value {
+					if one == nil {
+						continue
+					}
+
+					bucket := typesbucket.AzureBucket{ListContainerItem: one, Account: account}
+					if _, exist := idMap[bucket.GetCloudID()]; len(opt.CloudIDs) != 0 && !exist {
+						continue
+					}
+					buckets = append(buckets, bucket)
+				}
+			}
+		}
+	}
+
+	return buckets, nil
+}
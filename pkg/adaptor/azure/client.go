@@ -26,15 +26,24 @@ import (
 	"hcm/pkg/kit"
 	"hcm/pkg/logs"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v2"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armsubscriptions"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/subscription/armsubscription"
 	msgraphsdk "github.com/microsoftgraph/msgraph-sdk-go"
 )
 
+const (
+	// armClientModuleName 通用 ARM 客户端上报的模块名称
+	armClientModuleName = "hcm"
+	// armClientModuleVersion 通用 ARM 客户端上报的模块版本
+	armClientModuleVersion = "v1.0.0"
+)
+
 type clientSet struct {
 	credential *types.AzureCredential
 }
@@ -133,6 +142,24 @@ func (c *clientSet) diskClient() (*armcompute.DisksClient, error) {
 	return armcompute.NewDisksClient(c.credential.CloudSubscriptionID, credential, nil)
 }
 
+// snapshotClient ...
+func (c *clientSet) snapshotClient() (*armcompute.SnapshotsClient, error) {
+	credential, err := c.newClientSecretCredential()
+	if err != nil {
+		return nil, fmt.Errorf("init azure credential failed, err: %v", err)
+	}
+	return armcompute.NewSnapshotsClient(c.credential.CloudSubscriptionID, credential, nil)
+}
+
+// sshPublicKeyClient ...
+func (c *clientSet) sshPublicKeyClient() (*armcompute.SSHPublicKeysClient, error) {
+	credential, err := c.newClientSecretCredential()
+	if err != nil {
+		return nil, fmt.Errorf("init azure credential failed, err: %v", err)
+	}
+	return armcompute.NewSSHPublicKeysClient(c.credential.CloudSubscriptionID, credential, nil)
+}
+
 // imageClient ...
 func (c *clientSet) imageClient() (*armcompute.VirtualMachineImagesClient, error) {
 	credential, err := c.newClientSecretCredential()
@@ -288,7 +315,80 @@ func (c *clientSet) publicIPAddressesClient() (*armnetwork.PublicIPAddressesClie
 	return client, nil
 }
 
+func (c *clientSet) natGatewayClient() (*armnetwork.NatGatewaysClient, error) {
+	credential, err := c.newClientSecretCredential()
+	if err != nil {
+		return nil, fmt.Errorf("init azure credential failed, err: %v", err)
+	}
+	client, err := armnetwork.NewNatGatewaysClient(c.credential.CloudSubscriptionID, credential, nil)
+	if err != nil {
+		return nil, fmt.Errorf("init azure nat gateway client failed, err: %v", err)
+	}
+	return client, nil
+}
+
+func (c *clientSet) resourcesClient() (*armresources.Client, error) {
+	credential, err := c.newClientSecretCredential()
+	if err != nil {
+		return nil, fmt.Errorf("init azure credential failed, err: %v", err)
+	}
+	client, err := armresources.NewClient(c.credential.CloudSubscriptionID, credential, nil)
+	if err != nil {
+		return nil, fmt.Errorf("init azure resources client failed, err: %v", err)
+	}
+	return client, nil
+}
+
+// armClient 通用 ARM 客户端，用于调用没有引入对应sdk包的资源接口
+func (c *clientSet) armClient() (*arm.Client, error) {
+	credential, err := c.newClientSecretCredential()
+	if err != nil {
+		return nil, fmt.Errorf("init azure credential failed, err: %v", err)
+	}
+	client, err := arm.NewClient(armClientModuleName, armClientModuleVersion, credential, nil)
+	if err != nil {
+		return nil, fmt.Errorf("init azure arm client failed, err: %v", err)
+	}
+	return client, nil
+}
+
+func (c *clientSet) storageAccountClient() (*armstorage.AccountsClient, error) {
+	credential, err := c.newClientSecretCredential()
+	if err != nil {
+		return nil, fmt.Errorf("init azure credential failed, err: %v", err)
+	}
+	client, err := armstorage.NewAccountsClient(c.credential.CloudSubscriptionID, credential, nil)
+	if err != nil {
+		return nil, fmt.Errorf("init azure storage account client failed, err: %v", err)
+	}
+	return client, nil
+}
+
+func (c *clientSet) blobContainerClient() (*armstorage.BlobContainersClient, error) {
+	credential, err := c.newClientSecretCredential()
+	if err != nil {
+		return nil, fmt.Errorf("init azure credential failed, err: %v", err)
+	}
+	client, err := armstorage.NewBlobContainersClient(c.credential.CloudSubscriptionID, credential, nil)
+	if err != nil {
+		return nil, fmt.Errorf("init azure blob container client failed, err: %v", err)
+	}
+	return client, nil
+}
+
 // networkInterfaceClient ...
+func (c *clientSet) loadBalancerClient() (*armnetwork.LoadBalancersClient, error) {
+	credential, err := c.newClientSecretCredential()
+	if err != nil {
+		return nil, fmt.Errorf("init azure credential failed, err: %v", err)
+	}
+	client, err := armnetwork.NewLoadBalancersClient(c.credential.CloudSubscriptionID, credential, nil)
+	if err != nil {
+		return nil, fmt.Errorf("init azure load balancer client failed, err: %v", err)
+	}
+	return client, nil
+}
+
 func (c *clientSet) networkInterfaceClient() (*armnetwork.InterfacesClient, error) {
 	credential, err := c.newClientSecretCredential()
 	if err != nil {
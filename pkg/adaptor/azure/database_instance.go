@@ -0,0 +1,189 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	dbinstance "hcm/pkg/adaptor/types/database-instance"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+)
+
+// ListDatabaseInstance 查询资源组下的 MySQL/PostgreSQL 灵活服务器，Azure 数据库没有统一的SDK，
+// 通过通用资源接口查询列表，再按资源类型对应的 api-version 获取实例详情
+// reference: https://learn.microsoft.com/en-us/rest/api/resources/resources/list-by-resource-group
+func (az *AzureImpl) ListDatabaseInstance(kt *kit.Kit, opt *dbinstance.AzureDBInstanceListOption) (
+	[]dbinstance.AzureDBInstance, error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "azure database instance list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := az.clientSet.resourcesClient()
+	if err != nil {
+		return nil, err
+	}
+
+	filters := make([]string, 0, len(dbinstance.AzureDBInstanceResourceTypes))
+	for resType := range dbinstance.AzureDBInstanceResourceTypes {
+		filters = append(filters, fmt.Sprintf("resourceType eq '%s'", resType))
+	}
+	listOpt := &armresources.ClientListByResourceGroupOptions{Filter: converter.ValToPtr(strings.Join(filters, " or "))}
+
+	idMap := converter.StringSliceToMap(opt.CloudIDs)
+	dbs := make([]dbinstance.AzureDBInstance, 0)
+	pager := client.NewListByResourceGroupPager(opt.ResourceGroupName, listOpt)
+	for pager.More() {
+		nextResult, err := pager.NextPage(kt.Ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to advance page: %v", err)
+		}
+
+		for _, one := range nextResult.Value {
+			id := converter.PtrToVal(SPtrToLowerSPtr(one.ID))
+			if _, exist := idMap[id]; len(opt.CloudIDs) != 0 && !exist {
+				continue
+			}
+
+			db, err := az.getDatabaseInstance(kt, client, one)
+			if err != nil {
+				return nil, err
+			}
+			dbs = append(dbs, db)
+		}
+	}
+
+	return dbs, nil
+}
+
+func (az *AzureImpl) getDatabaseInstance(kt *kit.Kit, client *armresources.Client,
+	one *armresources.GenericResourceExpanded) (dbinstance.AzureDBInstance, error) {
+
+	resType := strings.ToLower(converter.PtrToVal(one.Type))
+	resp, err := client.GetByID(kt.Ctx, converter.PtrToVal(one.ID), dbinstance.AzureDBInstanceResourceTypes[resType], nil)
+	if err != nil {
+		logs.Errorf("get azure database instance failed, err: %v, id: %s, rid: %s", err, converter.PtrToVal(one.ID),
+			kt.Rid)
+		return dbinstance.AzureDBInstance{}, err
+	}
+
+	return converterDBInstance(resType, &resp.GenericResource)
+}
+
+// azureFlexibleServerProperties 灵活服务器详情中需要同步的属性
+type azureFlexibleServerProperties struct {
+	Version                  *string `json:"version"`
+	State                    *string `json:"state"`
+	FullyQualifiedDomainName *string `json:"fullyQualifiedDomainName"`
+	AvailabilityZone         *string `json:"availabilityZone"`
+	Storage                  *struct {
+		StorageSizeGB *int64 `json:"storageSizeGB"`
+	} `json:"storage"`
+	Network *struct {
+		DelegatedSubnetResourceID *string `json:"delegatedSubnetResourceId"`
+	} `json:"network"`
+}
+
+func converterDBInstance(resType string, one *armresources.GenericResource) (dbinstance.AzureDBInstance, error) {
+	result := dbinstance.AzureDBInstance{
+		ID:       SPtrToLowerSPtr(one.ID),
+		Name:     SPtrToLowerSPtr(one.Name),
+		Type:     converter.ValToPtr(resType),
+		Location: SPtrToLowerNoSpaceSPtr(one.Location),
+		Engine:   strings.TrimPrefix(strings.Split(resType, "/")[0], "microsoft.dbfor"),
+	}
+	if one.SKU != nil {
+		result.SKUName = one.SKU.Name
+		result.SKUTier = one.SKU.Tier
+	}
+
+	if one.Properties == nil {
+		return result, nil
+	}
+
+	raw, err := json.Marshal(one.Properties)
+	if err != nil {
+		return result, err
+	}
+	prop := new(azureFlexibleServerProperties)
+	if err = json.Unmarshal(raw, prop); err != nil {
+		return result, fmt.Errorf("unmarshal azure database instance properties failed, err: %v", err)
+	}
+
+	result.Version = prop.Version
+	result.State = prop.State
+	result.FullyQualifiedDomainName = prop.FullyQualifiedDomainName
+	result.AvailabilityZone = prop.AvailabilityZone
+	if prop.Storage != nil {
+		result.StorageSizeGB = prop.Storage.StorageSizeGB
+	}
+	if prop.Network != nil {
+		result.CloudSubnetID = SPtrToLowerSPtr(prop.Network.DelegatedSubnetResourceID)
+	}
+
+	return result, nil
+}
+
+// DeleteDatabaseInstance 删除数据库灵活服务器
+// reference: https://learn.microsoft.com/en-us/rest/api/resources/resources/delete-by-id
+func (az *AzureImpl) DeleteDatabaseInstance(kt *kit.Kit, opt *dbinstance.AzureDBInstanceDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "azure database instance delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	apiVersion, exist := dbinstance.AzureDBInstanceResourceTypes[strings.ToLower(opt.ResourceType)]
+	if !exist {
+		return errf.Newf(errf.InvalidParameter, "azure database resource type %s not supported", opt.ResourceType)
+	}
+
+	client, err := az.clientSet.resourcesClient()
+	if err != nil {
+		return err
+	}
+
+	poller, err := client.BeginDeleteByID(kt.Ctx, opt.CloudID, apiVersion, nil)
+	if err != nil {
+		logs.Errorf("delete azure database instance failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return err
+	}
+
+	if _, err = poller.PollUntilDone(kt.Ctx, nil); err != nil {
+		logs.Errorf("poll azure database instance delete result failed, err: %v, opt: %+v, rid: %s", err, opt,
+			kt.Rid)
+		return err
+	}
+
+	return nil
+}
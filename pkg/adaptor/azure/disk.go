@@ -35,7 +35,7 @@ import (
 
 // CreateDisk 创建云硬盘
 // reference: https://learn.microsoft.com/en-us/rest/api/compute/disks/list?source=recommendations&tabs=Go#disklist
-func (az *Azure) CreateDisk(kt *kit.Kit, opt *disk.AzureDiskCreateOption) ([]string, error) {
+func (az *AzureImpl) CreateDisk(kt *kit.Kit, opt *disk.AzureDiskCreateOption) ([]string, error) {
 	if opt == nil {
 		return nil, errf.New(errf.InvalidParameter, "azure disk create option is required")
 	}
@@ -65,7 +65,7 @@ func (az *Azure) CreateDisk(kt *kit.Kit, opt *disk.AzureDiskCreateOption) ([]str
 	return diskCloudIDs, nil
 }
 
-func (az *Azure) createDisk(kt *kit.Kit, opt *disk.AzureDiskCreateOption, diskName string) (*armcompute.Disk, error) {
+func (az *AzureImpl) createDisk(kt *kit.Kit, opt *disk.AzureDiskCreateOption, diskName string) (*armcompute.Disk, error) {
 	client, err := az.clientSet.diskClient()
 	if err != nil {
 		return nil, err
@@ -92,7 +92,7 @@ func (az *Azure) createDisk(kt *kit.Kit, opt *disk.AzureDiskCreateOption, diskNa
 
 // GetDisk 查询单个云盘
 // reference: https://learn.microsoft.com/en-us/rest/api/compute/disks/get?tabs=Go
-func (az *Azure) GetDisk(kt *kit.Kit, opt *disk.AzureDiskGetOption) (*disk.AzureDisk, error) {
+func (az *AzureImpl) GetDisk(kt *kit.Kit, opt *disk.AzureDiskGetOption) (*disk.AzureDisk, error) {
 	if opt == nil {
 		return nil, errf.New(errf.InvalidParameter, "azure disk get option is required")
 	}
@@ -142,7 +142,7 @@ func (handler *diskResultHandler) BuildResult(resp armcompute.DisksClientListByR
 
 // CountDisk count disk.
 // reference: https://learn.microsoft.com/en-us/rest/api/compute/disks/list?source=recommendations&tabs=Go#disklist
-func (az *Azure) CountDisk(kt *kit.Kit) (int32, error) {
+func (az *AzureImpl) CountDisk(kt *kit.Kit) (int32, error) {
 
 	client, err := az.clientSet.diskClient()
 	if err != nil {
@@ -166,7 +166,7 @@ func (az *Azure) CountDisk(kt *kit.Kit) (int32, error) {
 
 // ListDiskByPage ...
 // reference: https://learn.microsoft.com/en-us/rest/api/compute/disks/list?source=recommendations&tabs=Go#disklist
-func (az *Azure) ListDiskByPage(kt *kit.Kit, opt *disk.AzureDiskListOption) (
+func (az *AzureImpl) ListDiskByPage(kt *kit.Kit, opt *disk.AzureDiskListOption) (
 	*Pager[armcompute.DisksClientListByResourceGroupResponse, disk.AzureDisk], error) {
 
 	client, err := az.clientSet.diskClient()
@@ -188,7 +188,7 @@ func (az *Azure) ListDiskByPage(kt *kit.Kit, opt *disk.AzureDiskListOption) (
 
 // ListDisk 查看云硬盘
 // reference: https://learn.microsoft.com/en-us/rest/api/compute/disks/list?source=recommendations&tabs=Go#disklist
-func (az *Azure) ListDisk(kt *kit.Kit, opt *disk.AzureDiskListOption) ([]*disk.AzureDisk, error) {
+func (az *AzureImpl) ListDisk(kt *kit.Kit, opt *disk.AzureDiskListOption) ([]*disk.AzureDisk, error) {
 	if opt == nil {
 		return nil, errf.New(errf.InvalidParameter, "azure disk list option is required")
 	}
@@ -217,7 +217,7 @@ func (az *Azure) ListDisk(kt *kit.Kit, opt *disk.AzureDiskListOption) ([]*disk.A
 
 // ListDiskByID 查看云硬盘
 // reference: https://learn.microsoft.com/en-us/rest/api/compute/disks/list?source=recommendations&tabs=Go#disklist
-func (az *Azure) ListDiskByID(kit *kit.Kit, opt *core.AzureListByIDOption) ([]*disk.AzureDisk, error) {
+func (az *AzureImpl) ListDiskByID(kit *kit.Kit, opt *core.AzureListByIDOption) ([]*disk.AzureDisk, error) {
 	if opt == nil {
 		return nil, errf.New(errf.InvalidParameter, "azure disk list option is required")
 	}
@@ -284,7 +284,7 @@ func converterDisk(disks []*armcompute.Disk) []*disk.AzureDisk {
 
 // DeleteDisk 删除云盘
 // reference: https://learn.microsoft.com/en-us/rest/api/compute/disks/delete?tabs=Go
-func (az *Azure) DeleteDisk(kt *kit.Kit, opt *disk.AzureDiskDeleteOption) error {
+func (az *AzureImpl) DeleteDisk(kt *kit.Kit, opt *disk.AzureDiskDeleteOption) error {
 	if opt == nil {
 		return errf.New(errf.InvalidParameter, "azure disk delete option is required")
 	}
@@ -310,7 +310,7 @@ func (az *Azure) DeleteDisk(kt *kit.Kit, opt *disk.AzureDiskDeleteOption) error
 // AttachDisk 挂载云盘
 // reference:
 // https://learn.microsoft.com/en-us/rest/api/compute/virtual-machines/create-or-update?tabs=HTTP#storageprofile
-func (az *Azure) AttachDisk(kt *kit.Kit, opt *disk.AzureDiskAttachOption) error {
+func (az *AzureImpl) AttachDisk(kt *kit.Kit, opt *disk.AzureDiskAttachOption) error {
 	if opt == nil {
 		return errf.New(errf.InvalidParameter, "azure disk attach option is required")
 	}
@@ -340,7 +340,7 @@ func (az *Azure) AttachDisk(kt *kit.Kit, opt *disk.AzureDiskAttachOption) error
 // DetachDisk 卸载云盘
 // reference:
 // https://learn.microsoft.com/en-us/rest/api/compute/virtual-machines/create-or-update?tabs=HTTP#storageprofile
-func (az *Azure) DetachDisk(kt *kit.Kit, opt *disk.AzureDiskDetachOption) error {
+func (az *AzureImpl) DetachDisk(kt *kit.Kit, opt *disk.AzureDiskDetachOption) error {
 	if opt == nil {
 		return errf.New(errf.InvalidParameter, "azure disk detach option is required")
 	}
@@ -368,7 +368,7 @@ func (az *Azure) DetachDisk(kt *kit.Kit, opt *disk.AzureDiskDetachOption) error
 }
 
 // attachDisk 通过 vm 的 BeginCreateOrUpdate 接口完成云盘挂载
-func (az *Azure) attachDisk(
+func (az *AzureImpl) attachDisk(
 	kt *kit.Kit,
 	opt *disk.AzureDiskAttachOption,
 	cvmData *typecvm.AzureCvm,
@@ -419,7 +419,7 @@ func (az *Azure) attachDisk(
 }
 
 // attachDisk 通过 vm 的 BeginCreateOrUpdate 接口完成云盘卸载
-func (az *Azure) detachDisk(
+func (az *AzureImpl) detachDisk(
 	kt *kit.Kit,
 	opt *disk.AzureDiskDetachOption,
 	cvmData *typecvm.AzureCvm,
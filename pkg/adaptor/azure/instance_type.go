@@ -75,7 +75,7 @@ var ArmInstanceMap map[string]struct{} = map[string]struct{}{
 
 // ListInstanceType ...
 // reference: https://learn.microsoft.com/en-us/rest/api/compute/virtual-machine-sizes/list?tabs=HTTP
-func (az *Azure) ListInstanceType(kt *kit.Kit, opt *typesinstancetype.AzureInstanceTypeListOption) (
+func (az *AzureImpl) ListInstanceType(kt *kit.Kit, opt *typesinstancetype.AzureInstanceTypeListOption) (
 	its []*typesinstancetype.AzureInstanceType, err error) {
 
 	var typeFamilyMap map[string]string
@@ -104,7 +104,7 @@ func (az *Azure) ListInstanceType(kt *kit.Kit, opt *typesinstancetype.AzureInsta
 	return its, nil
 }
 
-func (az *Azure) getInstanceTypeList(kt *kit.Kit, region string) ([]*typesinstancetype.AzureInstanceType, error) {
+func (az *AzureImpl) getInstanceTypeList(kt *kit.Kit, region string) ([]*typesinstancetype.AzureInstanceType, error) {
 
 	client, err := az.clientSet.virtualMachineSizeClient()
 	if err != nil {
@@ -135,7 +135,7 @@ func (az *Azure) getInstanceTypeList(kt *kit.Kit, region string) ([]*typesinstan
 	return its, nil
 }
 
-func (az *Azure) getInstanceTypeFamilyMap(kt *kit.Kit) (map[string]string, error) {
+func (az *AzureImpl) getInstanceTypeFamilyMap(kt *kit.Kit) (map[string]string, error) {
 	cli, err := az.clientSet.clientFactory()
 	if err != nil {
 		logs.Errorf("new client factory failed, err: %v, rid: %s", err, kt.Rid)
@@ -0,0 +1,181 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+//go:generate  mockgen -destination ../mock/azure/azure_mock.go  -package=mockazure -typed -source=interface.go
+
+package azure
+
+import (
+	"hcm/pkg/adaptor/types"
+	"hcm/pkg/adaptor/types/account"
+	typesBill "hcm/pkg/adaptor/types/bill"
+	typesbucket "hcm/pkg/adaptor/types/bucket"
+	"hcm/pkg/adaptor/types/core"
+	typecvm "hcm/pkg/adaptor/types/cvm"
+	dbinstance "hcm/pkg/adaptor/types/database-instance"
+	"hcm/pkg/adaptor/types/disk"
+	"hcm/pkg/adaptor/types/eip"
+	"hcm/pkg/adaptor/types/image"
+	typesinstancetype "hcm/pkg/adaptor/types/instance-type"
+	typesk8s "hcm/pkg/adaptor/types/k8s-cluster"
+	typekeypair "hcm/pkg/adaptor/types/key-pair"
+	typelb "hcm/pkg/adaptor/types/load-balancer"
+	natgateway "hcm/pkg/adaptor/types/nat-gateway"
+	typesniproto "hcm/pkg/adaptor/types/network-interface"
+	typesdns "hcm/pkg/adaptor/types/private-dns"
+	"hcm/pkg/adaptor/types/region"
+	"hcm/pkg/adaptor/types/resource-group"
+	"hcm/pkg/adaptor/types/route-table"
+	"hcm/pkg/adaptor/types/security-group"
+	"hcm/pkg/adaptor/types/security-group-rule"
+	"hcm/pkg/adaptor/types/snapshot"
+	"hcm/pkg/adaptor/types/subnet"
+	vpcpeering "hcm/pkg/adaptor/types/vpc-peering"
+	"hcm/pkg/api/core/cloud"
+	coreni "hcm/pkg/api/core/cloud/network-interface"
+	"hcm/pkg/kit"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/consumption/armconsumption"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v2"
+)
+
+// Azure adaptor interface for azure
+type Azure interface {
+	CountAccount(kt *kit.Kit) (int32, error)
+	ListAccount(kt *kit.Kit) ([]account.AzureAccount, error)
+	GetAccountInfoBySecret(kt *kit.Kit) (*cloud.AzureInfoBySecret, error)
+	GetBillList(kt *kit.Kit, opt *typesBill.AzureBillListOption) (*armconsumption.UsageDetailsListResult, error)
+	CountCvm(kt *kit.Kit) (int32, error)
+	ListCvmByPage(kt *kit.Kit, opt *typecvm.AzureListOption) (
+		*Pager[armcompute.VirtualMachinesClientListResponse, typecvm.AzureCvm], error)
+	ListCvm(kt *kit.Kit, opt *typecvm.AzureListOption) ([]*typecvm.AzureCvm, error)
+	ListCvmByID(kt *kit.Kit, opt *core.AzureListByIDOption) ([]*typecvm.AzureCvm, error)
+	DeleteCvm(kt *kit.Kit, opt *typecvm.AzureDeleteOption) error
+	StartCvm(kt *kit.Kit, opt *typecvm.AzureStartOption) error
+	RebootCvm(kt *kit.Kit, opt *typecvm.AzureRebootOption) error
+	StopCvm(kt *kit.Kit, opt *typecvm.AzureStopOption) error
+	CreateCvm(kt *kit.Kit, opt *typecvm.AzureCreateOption) (string, error)
+	GetCvm(kt *kit.Kit, opt *typecvm.AzureGetOption) (*typecvm.AzureCvm, error)
+	GetCvmStatus(kt *kit.Kit, resGroupName, cvmName string) (string, error)
+	CreateDisk(kt *kit.Kit, opt *disk.AzureDiskCreateOption) ([]string, error)
+	GetDisk(kt *kit.Kit, opt *disk.AzureDiskGetOption) (*disk.AzureDisk, error)
+	CountDisk(kt *kit.Kit) (int32, error)
+	ListDiskByPage(kt *kit.Kit, opt *disk.AzureDiskListOption) (
+		*Pager[armcompute.DisksClientListByResourceGroupResponse, disk.AzureDisk], error)
+	ListDisk(kt *kit.Kit, opt *disk.AzureDiskListOption) ([]*disk.AzureDisk, error)
+	ListDiskByID(kit *kit.Kit, opt *core.AzureListByIDOption) ([]*disk.AzureDisk, error)
+	DeleteDisk(kt *kit.Kit, opt *disk.AzureDiskDeleteOption) error
+	AttachDisk(kt *kit.Kit, opt *disk.AzureDiskAttachOption) error
+	DetachDisk(kt *kit.Kit, opt *disk.AzureDiskDetachOption) error
+	ListSnapshot(kt *kit.Kit, opt *snapshot.AzureSnapshotListOption) ([]snapshot.AzureSnapshot, error)
+	CreateSnapshot(kt *kit.Kit, opt *snapshot.AzureSnapshotCreateOption) (string, error)
+	DeleteSnapshot(kt *kit.Kit, opt *snapshot.AzureSnapshotDeleteOption) error
+	RollbackSnapshot(kt *kit.Kit, opt *snapshot.AzureSnapshotRollbackOption) (string, error)
+	ListKeyPair(kt *kit.Kit, opt *typekeypair.AzureKeyPairListOption) ([]typekeypair.AzureKeyPair, error)
+	ImportKeyPair(kt *kit.Kit, opt *typekeypair.AzureKeyPairImportOption) (string, error)
+	DeleteKeyPair(kt *kit.Kit, opt *typekeypair.AzureKeyPairDeleteOption) error
+	ListPrivateDnsZone(kt *kit.Kit, opt *typesdns.AzureZoneListOption) ([]typesdns.AzureZone, error)
+	ListPrivateDnsRecord(kt *kit.Kit, opt *typesdns.AzureRecordListOption) ([]typesdns.Record, error)
+	CreatePrivateDnsRecord(kt *kit.Kit, opt *typesdns.AzureRecordOption) (string, error)
+	UpdatePrivateDnsRecord(kt *kit.Kit, opt *typesdns.AzureRecordOption) error
+	DeletePrivateDnsRecord(kt *kit.Kit, opt *typesdns.AzureRecordDeleteOption) error
+	ListDatabaseInstance(kt *kit.Kit, opt *dbinstance.AzureDBInstanceListOption) ([]dbinstance.AzureDBInstance, error)
+	DeleteDatabaseInstance(kt *kit.Kit, opt *dbinstance.AzureDBInstanceDeleteOption) error
+	ListBucket(kt *kit.Kit, opt *typesbucket.AzureBucketListOption) ([]typesbucket.AzureBucket, error)
+	ListK8sCluster(kt *kit.Kit, opt *typesk8s.AzureK8sClusterListOption) ([]typesk8s.AzureCluster, error)
+	ListNatGateway(kt *kit.Kit, opt *natgateway.AzureNatGatewayListOption) ([]natgateway.AzureNatGateway, error)
+	ListVpcPeering(kt *kit.Kit, opt *vpcpeering.AzureVpcPeeringListOption) ([]vpcpeering.AzureVpcPeering, error)
+	ListEipByID(kt *kit.Kit, opt *core.AzureListByIDOption) (*eip.AzureEipListResult, error)
+	CountEip(kt *kit.Kit) (int32, error)
+	ListEipByPage(kt *kit.Kit, opt *core.AzureListOption) (
+		*Pager[armnetwork.PublicIPAddressesClientListResponse, eip.AzureEip], error)
+	DeleteEip(kt *kit.Kit, opt *eip.AzureEipDeleteOption) error
+	AssociateEip(kt *kit.Kit, opt *eip.AzureEipAssociateOption) error
+	DisassociateEip(kt *kit.Kit, opt *eip.AzureEipDisassociateOption) error
+	CreateEip(kt *kit.Kit, opt *eip.AzureEipCreateOption) (*string, error)
+	ListImage(kt *kit.Kit, opt *image.AzureImageListOption) (*image.AzureImageListResult, error)
+	ListInstanceType(kt *kit.Kit, opt *typesinstancetype.AzureInstanceTypeListOption) (
+		its []*typesinstancetype.AzureInstanceType, err error)
+	CountNI(kt *kit.Kit) (int32, error)
+	ListNetworkInterface(kt *kit.Kit) (*typesniproto.AzureInterfaceListResult, error)
+	ListNetworkInterfaceByPage(kt *kit.Kit) (
+		*Pager[armnetwork.InterfacesClientListAllResponse, typesniproto.AzureNI], error)
+	ListNetworkInterfaceByID(kt *kit.Kit, opt *core.AzureListByIDOption) (*typesniproto.AzureInterfaceListResult, error)
+	ListRawNetworkInterfaceByIDs(kt *kit.Kit, opt *core.AzureListByIDOption) ([]*armnetwork.Interface, error)
+	ConvertCloudNetworkInterface(kt *kit.Kit, data *armnetwork.Interface) *typesniproto.AzureNI
+	GetNetworkInterface(kt *kit.Kit, opt *core.AzureListOption) (*typesniproto.AzureNI, error)
+	ListNetworkSecurityGroup(kt *kit.Kit, opt *core.AzureListOption) (interface{}, error)
+	ListIP(kt *kit.Kit, opt *core.AzureListOption) ([]*coreni.InterfaceIPConfiguration, error)
+	ListNetworkInterfacePage() (*runtime.Pager[armnetwork.InterfacesClientListAllResponse], error)
+	ListNetworkInterfaceByIDPage(opt *core.AzureListByIDOption) (
+		*runtime.Pager[armnetwork.InterfacesClientListResponse], error)
+	GetEipByCloudID(kt *kit.Kit, resourceGroupName, cloudPublicIP string) (*eip.AzureEip, error)
+	ListRegion(kit *kit.Kit) ([]*region.AzureRegion, error)
+	ListResourceGroup(kt *kit.Kit) ([]*resourcegroup.AzureResourceGroup, error)
+	UpdateRouteTable(_ *kit.Kit, _ *routetable.AzureRouteTableUpdateOption) error
+	DeleteRouteTable(kt *kit.Kit, opt *core.AzureDeleteOption) error
+	CountRouteTable(kt *kit.Kit) (int32, error)
+	ListRouteTable(kt *kit.Kit, opt *core.AzureListOption) (*routetable.AzureRouteTableListResult, error)
+	ListRouteTableByPage(kt *kit.Kit, opt *core.AzureListOption) (
+		*Pager[armnetwork.RouteTablesClientListResponse, routetable.AzureRouteTable], error)
+	ListRouteTablePage(opt *core.AzureListByIDOption) (
+		*runtime.Pager[armnetwork.RouteTablesClientListResponse], string, error)
+	ListRouteTableByID(kt *kit.Kit, opt *core.AzureListByIDOption) (*routetable.AzureRouteTableListResult, error)
+	GetRouteTable(kt *kit.Kit, opt *routetable.AzureRouteTableGetOption) (*routetable.AzureRouteTable, error)
+	ConvertRouteTable(data *armnetwork.RouteTable, resourceGroup, subscription string) *routetable.AzureRouteTable
+	CreateSecurityGroup(kt *kit.Kit, opt *securitygroup.AzureOption) (*securitygroup.AzureSecurityGroup, error)
+	DeleteSecurityGroup(kt *kit.Kit, opt *securitygroup.AzureOption) error
+	CountSecurityGroup(kt *kit.Kit) (int32, error)
+	ListSecurityGroupByPage(kt *kit.Kit, opt *securitygroup.AzureListOption) (
+		*Pager[armnetwork.SecurityGroupsClientListResponse, securitygroup.AzureSecurityGroup], error)
+	ListSecurityGroup(kt *kit.Kit, opt *securitygroup.AzureListOption) ([]*securitygroup.AzureSecurityGroup, error)
+	ListSecurityGroupByID(kt *kit.Kit, opt *core.AzureListByIDOption) ([]*securitygroup.AzureSecurityGroup, error)
+	SecurityGroupSubnetAssociate(kt *kit.Kit, opt *securitygroup.AzureAssociateSubnetOption) error
+	SecurityGroupSubnetDisassociate(kt *kit.Kit, opt *securitygroup.AzureAssociateSubnetOption) error
+	SecurityGroupNetworkInterfaceAssociate(kt *kit.Kit, opt *securitygroup.AzureAssociateNetworkInterfaceOption) error
+	SecurityGroupNetworkInterfaceDisassociate(kt *kit.Kit,
+		opt *securitygroup.AzureAssociateNetworkInterfaceOption) error
+	CreateSecurityGroupRule(kt *kit.Kit, opt *securitygrouprule.AzureCreateOption) (
+		[]*securitygrouprule.AzureSGRule, error)
+	UpdateSecurityGroupRule(kt *kit.Kit, opt *securitygrouprule.AzureUpdateOption) error
+	DeleteSecurityGroupRule(kt *kit.Kit, opt *securitygrouprule.AzureDeleteOption) error
+	ListSecurityGroupRule(kt *kit.Kit, opt *securitygrouprule.AzureListOption) ([]*securitygrouprule.AzureSGRule, error)
+	CreateSubnet(kt *kit.Kit, opt *adtysubnet.AzureSubnetCreateOption) (*adtysubnet.AzureSubnet, error)
+	UpdateSubnet(_ *kit.Kit, _ *adtysubnet.AzureSubnetUpdateOption) error
+	DeleteSubnet(kt *kit.Kit, opt *adtysubnet.AzureSubnetDeleteOption) error
+	ListSubnet(kt *kit.Kit, opt *adtysubnet.AzureSubnetListOption) (*adtysubnet.AzureSubnetListResult, error)
+	ListSubnetByPage(kt *kit.Kit, opt *adtysubnet.AzureSubnetListOption) (
+		*Pager[armnetwork.SubnetsClientListResponse, adtysubnet.AzureSubnet], error)
+	ListSubnetByID(kt *kit.Kit, opt *adtysubnet.AzureSubnetListByIDOption) (*adtysubnet.AzureSubnetListResult, error)
+	CreateVpc(kt *kit.Kit, opt *types.AzureVpcCreateOption) (*types.AzureVpc, error)
+	UpdateVpc(kt *kit.Kit, opt *types.AzureVpcUpdateOption) error
+	DeleteVpc(kt *kit.Kit, opt *core.AzureDeleteOption) error
+	ListVpc(kt *kit.Kit, opt *core.AzureListOption) (*types.AzureVpcListResult, error)
+	CountVpcAndSubnet(kt *kit.Kit) (int32, int32, error)
+	ListVpcByPage(kt *kit.Kit, opt *core.AzureListOption) (
+		*Pager[armnetwork.VirtualNetworksClientListResponse, types.AzureVpc], error)
+	ListVpcByID(kt *kit.Kit, opt *core.AzureListByIDOption) (*types.AzureVpcListResult, error)
+	ListVpcUsage(kt *kit.Kit, opt *types.AzureVpcListUsageOption) ([]types.VpcUsage, error)
+	ListLoadBalancerByID(kt *kit.Kit, opt *core.AzureListByIDOption) (*typelb.AzureListResult, error)
+	ListLoadBalancerByPage(kt *kit.Kit, opt *core.AzureListOption) (
+		*Pager[armnetwork.LoadBalancersClientListResponse, typelb.AzureLoadBalancer], error)
+	DeleteLoadBalancer(kt *kit.Kit, opt *typelb.AzureDeleteOption) error
+}
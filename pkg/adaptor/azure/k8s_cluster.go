@@ -0,0 +1,139 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	"encoding/json"
+	"fmt"
+
+	typesk8s "hcm/pkg/adaptor/types/k8s-cluster"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/times"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+)
+
+// ListK8sCluster 查询资源组下的AKS集群，通过通用资源接口查询列表，再获取集群详情，节点池在集群详情中返回
+// reference: https://learn.microsoft.com/en-us/rest/api/aks/managed-clusters/get
+func (az *AzureImpl) ListK8sCluster(kt *kit.Kit, opt *typesk8s.AzureK8sClusterListOption) (
+	[]typesk8s.AzureCluster, error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "azure k8s cluster list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := az.clientSet.resourcesClient()
+	if err != nil {
+		return nil, err
+	}
+
+	listOpt := &armresources.ClientListByResourceGroupOptions{
+		Filter: converter.ValToPtr(fmt.Sprintf("resourceType eq '%s'", typesk8s.AzureManagedClusterResourceType)),
+		Expand: converter.ValToPtr("createdTime"),
+	}
+
+	clusters := make([]typesk8s.AzureCluster, 0)
+	pager := client.NewListByResourceGroupPager(opt.ResourceGroupName, listOpt)
+	for pager.More() {
+		nextResult, err := pager.NextPage(kt.Ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to advance page: %v", err)
+		}
+
+		for _, one := range nextResult.Value {
+			resp, err := client.GetByID(kt.Ctx, converter.PtrToVal(one.ID), typesk8s.AzureManagedClusterAPIVersion, nil)
+			if err != nil {
+				logs.Errorf("get azure k8s cluster failed, err: %v, id: %s, rid: %s", err,
+					converter.PtrToVal(one.ID), kt.Rid)
+				return nil, err
+			}
+
+			cluster, err := converterK8sCluster(&resp.GenericResource)
+			if err != nil {
+				return nil, err
+			}
+			if one.CreatedTime != nil {
+				cluster.CreatedTime = converter.ValToPtr(times.ConvStdTimeFormat(*one.CreatedTime))
+			}
+			clusters = append(clusters, cluster)
+		}
+	}
+
+	return clusters, nil
+}
+
+// azureManagedClusterProperties AKS集群详情中需要同步的属性
+type azureManagedClusterProperties struct {
+	KubernetesVersion *string `json:"kubernetesVersion"`
+	ProvisioningState *string `json:"provisioningState"`
+	PowerState        *struct {
+		Code *string `json:"code"`
+	} `json:"powerState"`
+	DnsPrefix         *string `json:"dnsPrefix"`
+	Fqdn              *string `json:"fqdn"`
+	NodeResourceGroup *string `json:"nodeResourceGroup"`
+	NetworkProfile    *struct {
+		NetworkPlugin *string `json:"networkPlugin"`
+	} `json:"networkProfile"`
+	AgentPoolProfiles []typesk8s.AzureAgentPool `json:"agentPoolProfiles"`
+}
+
+func converterK8sCluster(one *armresources.GenericResource) (typesk8s.AzureCluster, error) {
+	result := typesk8s.AzureCluster{
+		ID:       SPtrToLowerSPtr(one.ID),
+		Name:     SPtrToLowerSPtr(one.Name),
+		Location: SPtrToLowerNoSpaceSPtr(one.Location),
+	}
+
+	if one.Properties == nil {
+		return result, nil
+	}
+
+	raw, err := json.Marshal(one.Properties)
+	if err != nil {
+		return result, err
+	}
+	prop := new(azureManagedClusterProperties)
+	if err = json.Unmarshal(raw, prop); err != nil {
+		return result, fmt.Errorf("unmarshal azure k8s cluster properties failed, err: %v", err)
+	}
+
+	result.KubernetesVersion = prop.KubernetesVersion
+	result.ProvisioningState = prop.ProvisioningState
+	if prop.PowerState != nil {
+		result.PowerState = prop.PowerState.Code
+	}
+	result.DnsPrefix = prop.DnsPrefix
+	result.Fqdn = prop.Fqdn
+	result.NodeResourceGroup = SPtrToLowerSPtr(prop.NodeResourceGroup)
+	if prop.NetworkProfile != nil {
+		result.NetworkPlugin = prop.NetworkProfile.NetworkPlugin
+	}
+	result.AgentPoolProfiles = prop.AgentPoolProfiles
+
+	return result, nil
+}
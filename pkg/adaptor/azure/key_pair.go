@@ -0,0 +1,143 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	"fmt"
+	"strings"
+
+	typekeypair "hcm/pkg/adaptor/types/key-pair"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+)
+
+// ListKeyPair 查询资源组下的 SSH 公钥，指定 CloudIDs 时只返回对应公钥
+// reference: https://learn.microsoft.com/en-us/rest/api/compute/ssh-public-keys/list-by-resource-group
+func (az *AzureImpl) ListKeyPair(kt *kit.Kit, opt *typekeypair.AzureKeyPairListOption) ([]typekeypair.AzureKeyPair,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "azure key pair list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := az.clientSet.sshPublicKeyClient()
+	if err != nil {
+		return nil, err
+	}
+
+	idMap := converter.StringSliceToMap(opt.CloudIDs)
+	keyPairs := make([]typekeypair.AzureKeyPair, 0)
+	pager := client.NewListByResourceGroupPager(opt.ResourceGroupName, nil)
+	for pager.More() {
+		nextResult, err := pager.NextPage(kt.Ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to advance page: %v", err)
+		}
+
+		for _, one := range nextResult.Value {
+			if len(opt.CloudIDs) == 0 {
+				keyPairs = append(keyPairs, converterKeyPair(one))
+				continue
+			}
+
+			id := converter.PtrToVal(SPtrToLowerSPtr(one.ID))
+			if _, exist := idMap[id]; exist {
+				keyPairs = append(keyPairs, converterKeyPair(one))
+				delete(idMap, id)
+			}
+			if len(idMap) == 0 {
+				return keyPairs, nil
+			}
+		}
+	}
+
+	return keyPairs, nil
+}
+
+func converterKeyPair(one *armcompute.SSHPublicKeyResource) typekeypair.AzureKeyPair {
+	result := typekeypair.AzureKeyPair{
+		ID:       SPtrToLowerSPtr(one.ID),
+		Name:     one.Name,
+		Location: SPtrToLowerNoSpaceSPtr(one.Location),
+	}
+	if one.Properties != nil {
+		result.PublicKey = one.Properties.PublicKey
+	}
+
+	return result
+}
+
+// ImportKeyPair 导入 SSH 公钥，返回公钥资源ID
+// reference: https://learn.microsoft.com/en-us/rest/api/compute/ssh-public-keys/create
+func (az *AzureImpl) ImportKeyPair(kt *kit.Kit, opt *typekeypair.AzureKeyPairImportOption) (string, error) {
+	if opt == nil {
+		return "", errf.New(errf.InvalidParameter, "azure key pair import option is required")
+	}
+
+	req, err := opt.ToSSHPublicKeyResource()
+	if err != nil {
+		return "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := az.clientSet.sshPublicKeyClient()
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.Create(kt.Ctx, opt.ResourceGroupName, opt.Name, *req, nil)
+	if err != nil {
+		logs.Errorf("import azure key pair failed, err: %v, name: %s, rid: %s", err, opt.Name, kt.Rid)
+		return "", err
+	}
+
+	return strings.ToLower(converter.PtrToVal(resp.ID)), nil
+}
+
+// DeleteKeyPair 删除 SSH 公钥，已使用该公钥创建的主机不受影响
+// reference: https://learn.microsoft.com/en-us/rest/api/compute/ssh-public-keys/delete
+func (az *AzureImpl) DeleteKeyPair(kt *kit.Kit, opt *typekeypair.AzureKeyPairDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "azure key pair delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := az.clientSet.sshPublicKeyClient()
+	if err != nil {
+		return err
+	}
+
+	if _, err = client.Delete(kt.Ctx, opt.ResourceGroupName, opt.Name, nil); err != nil {
+		logs.Errorf("delete azure key pair failed, err: %v, name: %s, rid: %s", err, opt.Name, kt.Rid)
+		return err
+	}
+
+	return nil
+}
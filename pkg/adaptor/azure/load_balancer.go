@@ -0,0 +1,236 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	"fmt"
+	"strings"
+
+	"hcm/pkg/adaptor/types/core"
+	typelb "hcm/pkg/adaptor/types/load-balancer"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v2"
+)
+
+// ListLoadBalancerByID 根据ID查询资源组下的负载均衡
+// reference: https://learn.microsoft.com/zh-cn/rest/api/load-balancer/load-balancers/list
+func (az *AzureImpl) ListLoadBalancerByID(kt *kit.Kit, opt *core.AzureListByIDOption) (*typelb.AzureListResult,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := az.clientSet.loadBalancerClient()
+	if err != nil {
+		return nil, err
+	}
+
+	idMap := converter.StringSliceToMap(opt.CloudIDs)
+	details := make([]typelb.AzureLoadBalancer, 0, len(idMap))
+	pager := client.NewListPager(opt.ResourceGroupName, nil)
+	for pager.More() {
+		nextResult, err := pager.NextPage(kt.Ctx)
+		if err != nil {
+			logs.Errorf("list azure load balancer failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, fmt.Errorf("list azure load balancer failed, err: %v", err)
+		}
+
+		for _, one := range nextResult.Value {
+			id := SPtrToLowerStr(one.ID)
+			if _, exist := idMap[id]; !exist {
+				continue
+			}
+
+			details = append(details, convertLoadBalancer(one, opt.ResourceGroupName))
+			delete(idMap, id)
+			if len(idMap) == 0 {
+				return &typelb.AzureListResult{Details: details}, nil
+			}
+		}
+	}
+
+	return &typelb.AzureListResult{Details: details}, nil
+}
+
+// ListLoadBalancerByPage 分页查询资源组下的负载均衡
+// reference: https://learn.microsoft.com/zh-cn/rest/api/load-balancer/load-balancers/list
+func (az *AzureImpl) ListLoadBalancerByPage(kt *kit.Kit, opt *core.AzureListOption) (
+	*Pager[armnetwork.LoadBalancersClientListResponse, typelb.AzureLoadBalancer], error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := az.clientSet.loadBalancerClient()
+	if err != nil {
+		return nil, err
+	}
+
+	pager := &Pager[armnetwork.LoadBalancersClientListResponse, typelb.AzureLoadBalancer]{
+		pager: client.NewListPager(opt.ResourceGroupName, nil),
+		resultHandler: &loadBalancerResultHandler{
+			resGroupName: opt.ResourceGroupName,
+		},
+	}
+
+	return pager, nil
+}
+
+type loadBalancerResultHandler struct {
+	resGroupName string
+}
+
+// BuildResult ...
+func (handler *loadBalancerResultHandler) BuildResult(
+	resp armnetwork.LoadBalancersClientListResponse) []typelb.AzureLoadBalancer {
+
+	details := make([]typelb.AzureLoadBalancer, 0, len(resp.Value))
+	for _, one := range resp.Value {
+		details = append(details, convertLoadBalancer(one, handler.resGroupName))
+	}
+
+	return details
+}
+
+func convertLoadBalancer(one *armnetwork.LoadBalancer, resGroupName string) typelb.AzureLoadBalancer {
+	lb := typelb.AzureLoadBalancer{
+		CloudID:           SPtrToLowerStr(one.ID),
+		Name:              SPtrToLowerStr(one.Name),
+		Region:            SPtrToLowerNoSpaceStr(one.Location),
+		ResourceGroupName: strings.ToLower(resGroupName),
+	}
+
+	if one.SKU != nil {
+		if one.SKU.Name != nil {
+			lb.SkuName = string(*one.SKU.Name)
+		}
+		if one.SKU.Tier != nil {
+			lb.SkuTier = string(*one.SKU.Tier)
+		}
+	}
+
+	if one.Properties == nil {
+		return lb
+	}
+
+	if one.Properties.ProvisioningState != nil {
+		lb.ProvisioningState = string(*one.Properties.ProvisioningState)
+	}
+
+	zoneMap := make(map[string]struct{})
+	for _, frontend := range one.Properties.FrontendIPConfigurations {
+		if frontend == nil {
+			continue
+		}
+		lb.FrontendIPConfigurations = append(lb.FrontendIPConfigurations, SPtrToLowerStr(frontend.ID))
+		for _, zone := range frontend.Zones {
+			zoneMap[converter.PtrToVal(zone)] = struct{}{}
+		}
+
+		prop := frontend.Properties
+		if prop == nil {
+			continue
+		}
+
+		if prop.PrivateIPAddress != nil {
+			if prop.PrivateIPAddressVersion != nil && *prop.PrivateIPAddressVersion == armnetwork.IPVersionIPv6 {
+				lb.PrivateIPv6Addresses = append(lb.PrivateIPv6Addresses, *prop.PrivateIPAddress)
+			} else {
+				lb.PrivateIPv4Addresses = append(lb.PrivateIPv4Addresses, *prop.PrivateIPAddress)
+			}
+		}
+
+		if prop.PublicIPAddress != nil && prop.PublicIPAddress.ID != nil {
+			lb.CloudPublicIPIDs = append(lb.CloudPublicIPIDs, SPtrToLowerStr(prop.PublicIPAddress.ID))
+		}
+
+		// 内网负载均衡的前端IP配置在子网中，子网ID格式: {vpcID}/subnets/{subnetName}
+		if prop.Subnet != nil && prop.Subnet.ID != nil && len(lb.CloudSubnetID) == 0 {
+			lb.CloudSubnetID = SPtrToLowerStr(prop.Subnet.ID)
+			if idx := strings.Index(lb.CloudSubnetID, "/subnets/"); idx > 0 {
+				lb.CloudVpcID = lb.CloudSubnetID[:idx]
+			}
+		}
+	}
+	lb.Zones = converter.MapKeyToStringSlice(zoneMap)
+
+	for _, pool := range one.Properties.BackendAddressPools {
+		if pool != nil {
+			lb.BackendAddressPools = append(lb.BackendAddressPools, SPtrToLowerStr(pool.ID))
+		}
+	}
+
+	for _, rule := range one.Properties.LoadBalancingRules {
+		if rule != nil {
+			lb.LoadBalancingRules = append(lb.LoadBalancingRules, SPtrToLowerStr(rule.ID))
+		}
+	}
+
+	for _, probe := range one.Properties.Probes {
+		if probe != nil {
+			lb.Probes = append(lb.Probes, SPtrToLowerStr(probe.ID))
+		}
+	}
+
+	return lb
+}
+
+// DeleteLoadBalancer 删除负载均衡
+// reference: https://learn.microsoft.com/zh-cn/rest/api/load-balancer/load-balancers/delete
+func (az *AzureImpl) DeleteLoadBalancer(kt *kit.Kit, opt *typelb.AzureDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "azure load balancer delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := az.clientSet.loadBalancerClient()
+	if err != nil {
+		return err
+	}
+
+	poller, err := client.BeginDelete(kt.Ctx, opt.ResourceGroupName, opt.Name, nil)
+	if err != nil {
+		logs.Errorf("delete azure load balancer failed, opt: %+v, err: %v, rid: %s", opt, err, kt.Rid)
+		return fmt.Errorf("delete azure load balancer failed, err: %v", err)
+	}
+
+	if _, err = poller.PollUntilDone(kt.Ctx, nil); err != nil {
+		logs.Errorf("poll azure load balancer delete failed, opt: %+v, err: %v, rid: %s", opt, err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	"fmt"
+
+	natgateway "hcm/pkg/adaptor/types/nat-gateway"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/tools/converter"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v2"
+)
+
+// ListNatGateway 查询资源组下的NAT网关，指定 CloudIDs 时只返回对应NAT网关
+// reference: https://learn.microsoft.com/en-us/rest/api/virtualnetwork/nat-gateways/list
+func (az *AzureImpl) ListNatGateway(kt *kit.Kit, opt *natgateway.AzureNatGatewayListOption) (
+	[]natgateway.AzureNatGateway, error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "azure nat gateway list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := az.clientSet.natGatewayClient()
+	if err != nil {
+		return nil, err
+	}
+
+	idMap := converter.StringSliceToMap(opt.CloudIDs)
+	nats := make([]natgateway.AzureNatGateway, 0)
+	pager := client.NewListPager(opt.ResourceGroupName, nil)
+	for pager.More() {
+		nextResult, err := pager.NextPage(kt.Ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to advance page: %v", err)
+		}
+
+		for _, one := range nextResult.Value {
+			if len(opt.CloudIDs) == 0 {
+				nats = append(nats, converterNatGateway(one))
+				continue
+			}
+
+			id := converter.PtrToVal(SPtrToLowerSPtr(one.ID))
+			if _, exist := idMap[id]; exist {
+				nats = append(nats, converterNatGateway(one))
+				delete(idMap, id)
+			}
+			if len(idMap) == 0 {
+				return nats, nil
+			}
+		}
+	}
+
+	return nats, nil
+}
+
+func converterNatGateway(one *armnetwork.NatGateway) natgateway.AzureNatGateway {
+	result := natgateway.AzureNatGateway{
+		ID:       SPtrToLowerSPtr(one.ID),
+		Name:     SPtrToLowerSPtr(one.Name),
+		Location: SPtrToLowerNoSpaceSPtr(one.Location),
+		Zones:    converter.PtrToSlice(one.Zones),
+	}
+	if one.SKU != nil {
+		result.SKUName = (*string)(one.SKU.Name)
+	}
+	if one.Properties != nil {
+		result.ProvisioningState = (*string)(one.Properties.ProvisioningState)
+		result.IdleTimeoutInMinutes = one.Properties.IdleTimeoutInMinutes
+		result.CloudPublicIPIDs = convSubResourceIDs(one.Properties.PublicIPAddresses)
+		result.CloudSubnetIDs = convSubResourceIDs(one.Properties.Subnets)
+	}
+
+	return result
+}
+
+func convSubResourceIDs(resources []*armnetwork.SubResource) []string {
+	ids := make([]string, 0, len(resources))
+	for _, one := range resources {
+		if one != nil && one.ID != nil {
+			ids = append(ids, converter.PtrToVal(SPtrToLowerSPtr(one.ID)))
+		}
+	}
+	return ids
+}
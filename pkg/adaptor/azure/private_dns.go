@@ -0,0 +1,405 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package azure
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	typesdns "hcm/pkg/adaptor/types/private-dns"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+// ListPrivateDnsZone 查询资源组下的私有 DNS 区域及其虚拟网络链接，私有 DNS 没有引入对应的sdk包，通过通用 ARM 客户端调用
+// reference: https://learn.microsoft.com/en-us/rest/api/dns/privatednszones/privatezones/list-by-resource-group
+func (az *AzureImpl) ListPrivateDnsZone(kt *kit.Kit, opt *typesdns.AzureZoneListOption) ([]typesdns.AzureZone,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "azure private dns zone list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := az.clientSet.armClient()
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/%s", az.clientSet.credential.CloudSubscriptionID,
+		opt.ResourceGroupName, typesdns.AzurePrivateDnsZoneResourceType)
+	list, err := listArmResource[azurePrivateZone](kt, client, path)
+	if err != nil {
+		logs.Errorf("list azure private dns zone failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return nil, err
+	}
+
+	idMap := converter.StringSliceToMap(opt.CloudIDs)
+	zones := make([]typesdns.AzureZone, 0, len(list))
+	for _, one := range list {
+		id := strings.ToLower(one.ID)
+		if _, exist := idMap[id]; len(opt.CloudIDs) != 0 && !exist {
+			continue
+		}
+
+		links, err := listArmResource[azureVirtualNetworkLink](kt, client, one.ID+"/virtualNetworkLinks")
+		if err != nil {
+			logs.Errorf("list azure private dns zone virtual network link failed, err: %v, id: %s, rid: %s", err,
+				one.ID, kt.Rid)
+			return nil, err
+		}
+
+		vpcIDs := make([]string, 0, len(links))
+		for _, link := range links {
+			if link.Properties.VirtualNetwork != nil {
+				vpcIDs = append(vpcIDs, strings.ToLower(link.Properties.VirtualNetwork.ID))
+			}
+		}
+
+		zones = append(zones, typesdns.AzureZone{
+			ID:                 id,
+			Name:               one.Name,
+			ResourceGroupName:  opt.ResourceGroupName,
+			NumberOfRecordSets: one.Properties.NumberOfRecordSets,
+			ProvisioningState:  one.Properties.ProvisioningState,
+			CloudVpcIDs:        vpcIDs,
+		})
+	}
+
+	return zones, nil
+}
+
+// ListPrivateDnsRecord 查询私有 DNS 区域的记录集
+// reference: https://learn.microsoft.com/en-us/rest/api/dns/privatednszones/record-sets/list
+func (az *AzureImpl) ListPrivateDnsRecord(kt *kit.Kit, opt *typesdns.AzureRecordListOption) ([]typesdns.Record,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "azure private dns record list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := az.clientSet.armClient()
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := listArmResource[azureRecordSet](kt, client, opt.CloudZoneID+"/ALL")
+	if err != nil {
+		logs.Errorf("list azure private dns record set failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return nil, err
+	}
+
+	records := make([]typesdns.Record, 0, len(list))
+	for _, one := range list {
+		recordType := one.Type[strings.LastIndex(one.Type, "/")+1:]
+		if !typesdns.IsSupportedRecordType(recordType) {
+			continue
+		}
+
+		records = append(records, typesdns.Record{
+			CloudID: strings.ToLower(one.ID),
+			Name:    one.Name,
+			Type:    enumor.PrivateDnsRecordType(recordType),
+			Value:   one.Properties.values(),
+			TTL:     one.Properties.TTL,
+		})
+	}
+
+	return records, nil
+}
+
+// CreatePrivateDnsRecord 创建记录集，记录集已存在时返回错误，返回记录集云上ID
+// reference: https://learn.microsoft.com/en-us/rest/api/dns/privatednszones/record-sets/create-or-update
+func (az *AzureImpl) CreatePrivateDnsRecord(kt *kit.Kit, opt *typesdns.AzureRecordOption) (string, error) {
+	return az.putRecordSet(kt, opt, true)
+}
+
+// UpdatePrivateDnsRecord 修改记录集
+// reference: https://learn.microsoft.com/en-us/rest/api/dns/privatednszones/record-sets/create-or-update
+func (az *AzureImpl) UpdatePrivateDnsRecord(kt *kit.Kit, opt *typesdns.AzureRecordOption) error {
+	_, err := az.putRecordSet(kt, opt, false)
+	return err
+}
+
+func (az *AzureImpl) putRecordSet(kt *kit.Kit, opt *typesdns.AzureRecordOption, onlyCreate bool) (string, error) {
+	if opt == nil {
+		return "", errf.New(errf.InvalidParameter, "azure private dns record option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	props, err := newAzureRecordSetProperties(opt.RecordSpec)
+	if err != nil {
+		return "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := az.clientSet.armClient()
+	if err != nil {
+		return "", err
+	}
+
+	path := fmt.Sprintf("%s/%s/%s", opt.CloudZoneID, opt.Type, opt.Name)
+	req, err := newArmRequest(kt, client, http.MethodPut, path)
+	if err != nil {
+		return "", err
+	}
+	if onlyCreate {
+		req.Raw().Header.Set("If-None-Match", "*")
+	}
+	if err = runtime.MarshalAsJSON(req, map[string]interface{}{"properties": props}); err != nil {
+		return "", err
+	}
+
+	result := new(azureRecordSet)
+	if err = doArmRequest(client, req, result, http.StatusOK, http.StatusCreated); err != nil {
+		logs.Errorf("put azure private dns record set failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return "", err
+	}
+
+	return strings.ToLower(result.ID), nil
+}
+
+// DeletePrivateDnsRecord 删除记录集
+// reference: https://learn.microsoft.com/en-us/rest/api/dns/privatednszones/record-sets/delete
+func (az *AzureImpl) DeletePrivateDnsRecord(kt *kit.Kit, opt *typesdns.AzureRecordDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "azure private dns record delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := az.clientSet.armClient()
+	if err != nil {
+		return err
+	}
+
+	req, err := newArmRequest(kt, client, http.MethodDelete, opt.CloudID)
+	if err != nil {
+		return err
+	}
+
+	if err = doArmRequest(client, req, nil, http.StatusOK, http.StatusNoContent); err != nil {
+		logs.Errorf("delete azure private dns record set failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// azurePrivateZone ARM 返回的私有 DNS 区域
+type azurePrivateZone struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Properties struct {
+		NumberOfRecordSets int64  `json:"numberOfRecordSets"`
+		ProvisioningState  string `json:"provisioningState"`
+	} `json:"properties"`
+}
+
+// azureVirtualNetworkLink ARM 返回的虚拟网络链接
+type azureVirtualNetworkLink struct {
+	Properties struct {
+		VirtualNetwork *struct {
+			ID string `json:"id"`
+		} `json:"virtualNetwork"`
+	} `json:"properties"`
+}
+
+// azureRecordSet ARM 返回的记录集，Type 格式为 Microsoft.Network/privateDnsZones/A
+type azureRecordSet struct {
+	ID         string                   `json:"id"`
+	Name       string                   `json:"name"`
+	Type       string                   `json:"type"`
+	Properties azureRecordSetProperties `json:"properties"`
+}
+
+type azureARecord struct {
+	IPv4Address string `json:"ipv4Address"`
+}
+
+type azureAaaaRecord struct {
+	IPv6Address string `json:"ipv6Address"`
+}
+
+type azureCnameRecord struct {
+	Cname string `json:"cname"`
+}
+
+type azureMxRecord struct {
+	Preference int64  `json:"preference"`
+	Exchange   string `json:"exchange"`
+}
+
+type azureTxtRecord struct {
+	Value []string `json:"value"`
+}
+
+type azurePtrRecord struct {
+	Ptrdname string `json:"ptrdname"`
+}
+
+type azureSrvRecord struct {
+	Priority int64  `json:"priority"`
+	Weight   int64  `json:"weight"`
+	Port     int64  `json:"port"`
+	Target   string `json:"target"`
+}
+
+// azureRecordSetProperties 记录集属性，不同记录类型的记录值在不同字段中
+type azureRecordSetProperties struct {
+	TTL         int64             `json:"ttl"`
+	ARecords    []azureARecord    `json:"aRecords,omitempty"`
+	AaaaRecords []azureAaaaRecord `json:"aaaaRecords,omitempty"`
+	CnameRecord *azureCnameRecord `json:"cnameRecord,omitempty"`
+	MxRecords   []azureMxRecord   `json:"mxRecords,omitempty"`
+	TxtRecords  []azureTxtRecord  `json:"txtRecords,omitempty"`
+	PtrRecords  []azurePtrRecord  `json:"ptrRecords,omitempty"`
+	SrvRecords  []azureSrvRecord  `json:"srvRecords,omitempty"`
+}
+
+// values 将记录集的记录值统一转换为字符串
+func (p azureRecordSetProperties) values() []string {
+	values := make([]string, 0)
+	for _, one := range p.ARecords {
+		values = append(values, one.IPv4Address)
+	}
+	for _, one := range p.AaaaRecords {
+		values = append(values, one.IPv6Address)
+	}
+	if p.CnameRecord != nil {
+		values = append(values, p.CnameRecord.Cname)
+	}
+	for _, one := range p.MxRecords {
+		values = append(values, fmt.Sprintf("%d %s", one.Preference, one.Exchange))
+	}
+	for _, one := range p.TxtRecords {
+		values = append(values, strings.Join(one.Value, ""))
+	}
+	for _, one := range p.PtrRecords {
+		values = append(values, one.Ptrdname)
+	}
+	for _, one := range p.SrvRecords {
+		values = append(values, fmt.Sprintf("%d %d %d %s", one.Priority, one.Weight, one.Port, one.Target))
+	}
+	return values
+}
+
+func newAzureRecordSetProperties(spec typesdns.RecordSpec) (*azureRecordSetProperties, error) {
+	props := &azureRecordSetProperties{TTL: spec.GetTTL()}
+	for _, value := range spec.Value {
+		switch spec.Type {
+		case enumor.PrivateDnsRecordA:
+			props.ARecords = append(props.ARecords, azureARecord{IPv4Address: value})
+		case enumor.PrivateDnsRecordAAAA:
+			props.AaaaRecords = append(props.AaaaRecords, azureAaaaRecord{IPv6Address: value})
+		case enumor.PrivateDnsRecordCNAME:
+			props.CnameRecord = &azureCnameRecord{Cname: value}
+		case enumor.PrivateDnsRecordMX:
+			priority, exchange, err := typesdns.ParseMXValue(value)
+			if err != nil {
+				return nil, err
+			}
+			props.MxRecords = append(props.MxRecords, azureMxRecord{Preference: priority, Exchange: exchange})
+		case enumor.PrivateDnsRecordTXT:
+			props.TxtRecords = append(props.TxtRecords, azureTxtRecord{Value: []string{value}})
+		case enumor.PrivateDnsRecordPTR:
+			props.PtrRecords = append(props.PtrRecords, azurePtrRecord{Ptrdname: value})
+		case enumor.PrivateDnsRecordSRV:
+			srv := azureSrvRecord{}
+			if _, err := fmt.Sscanf(value, "%d %d %d %s", &srv.Priority, &srv.Weight, &srv.Port,
+				&srv.Target); err != nil {
+				return nil, fmt.Errorf("srv record value should be 'priority weight port target', value: %s", value)
+			}
+			props.SrvRecords = append(props.SrvRecords, srv)
+		default:
+			return nil, fmt.Errorf("unsupported private dns record type: %s", spec.Type)
+		}
+	}
+
+	return props, nil
+}
+
+// armListResult ARM 列表接口的返回结果，NextLink 不为空时还有下一页
+type armListResult[T any] struct {
+	Value    []T    `json:"value"`
+	NextLink string `json:"nextLink"`
+}
+
+// listArmResource 通过通用 ARM 客户端查询资源列表，按 nextLink 查询所有分页
+func listArmResource[T any](kt *kit.Kit, client *arm.Client, path string) ([]T, error) {
+	list := make([]T, 0)
+	endpoint := runtime.JoinPaths(client.Endpoint(), path) + "?api-version=" + typesdns.AzurePrivateDnsAPIVersion
+	for len(endpoint) != 0 {
+		req, err := runtime.NewRequest(kt.Ctx, http.MethodGet, endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		result := new(armListResult[T])
+		if err = doArmRequest(client, req, result, http.StatusOK); err != nil {
+			return nil, err
+		}
+
+		list = append(list, result.Value...)
+		endpoint = result.NextLink
+	}
+
+	return list, nil
+}
+
+func newArmRequest(kt *kit.Kit, client *arm.Client, method, path string) (*policy.Request, error) {
+	endpoint := runtime.JoinPaths(client.Endpoint(), path) + "?api-version=" + typesdns.AzurePrivateDnsAPIVersion
+	return runtime.NewRequest(kt.Ctx, method, endpoint)
+}
+
+// doArmRequest 发送请求，返回的状态码不符合预期时解析为 ResponseError，result 为空时不解析返回结果
+func doArmRequest(client *arm.Client, req *policy.Request, result interface{}, statusCodes ...int) error {
+	resp, err := client.Pipeline().Do(req)
+	if err != nil {
+		return err
+	}
+
+	if !runtime.HasStatusCode(resp, statusCodes...) {
+		return runtime.NewResponseError(resp)
+	}
+
+	if result == nil {
+		return nil
+	}
+	return runtime.UnmarshalAsJSON(resp, result)
+}
@@ -30,7 +30,7 @@ import (
 
 // ListRegion list region.
 // reference: https://learn.microsoft.com/en-us/rest/api/resources/subscriptions/list-locations?tabs=HTTP#examples
-func (az *Azure) ListRegion(kit *kit.Kit) ([]*region.AzureRegion, error) {
+func (az *AzureImpl) ListRegion(kit *kit.Kit) ([]*region.AzureRegion, error) {
 
 	client, err := az.clientSet.regionClient()
 	if err != nil {
@@ -0,0 +1,199 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	"fmt"
+	"strings"
+
+	"hcm/pkg/adaptor/types/snapshot"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/times"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+)
+
+// ListSnapshot 查询资源组下的快照，指定 CloudIDs 时只返回对应快照
+// reference: https://learn.microsoft.com/en-us/rest/api/compute/snapshots/list-by-resource-group
+func (az *AzureImpl) ListSnapshot(kt *kit.Kit, opt *snapshot.AzureSnapshotListOption) ([]snapshot.AzureSnapshot,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "azure snapshot list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := az.clientSet.snapshotClient()
+	if err != nil {
+		return nil, err
+	}
+
+	idMap := converter.StringSliceToMap(opt.CloudIDs)
+	snapshots := make([]snapshot.AzureSnapshot, 0)
+	pager := client.NewListByResourceGroupPager(opt.ResourceGroupName, nil)
+	for pager.More() {
+		nextResult, err := pager.NextPage(kt.Ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to advance page: %v", err)
+		}
+
+		for _, one := range nextResult.Value {
+			if len(opt.CloudIDs) == 0 {
+				snapshots = append(snapshots, converterSnapshot(one))
+				continue
+			}
+
+			id := converter.PtrToVal(SPtrToLowerSPtr(one.ID))
+			if _, exist := idMap[id]; exist {
+				snapshots = append(snapshots, converterSnapshot(one))
+				delete(idMap, id)
+			}
+			if len(idMap) == 0 {
+				return snapshots, nil
+			}
+		}
+	}
+
+	return snapshots, nil
+}
+
+func converterSnapshot(one *armcompute.Snapshot) snapshot.AzureSnapshot {
+	result := snapshot.AzureSnapshot{
+		ID:       SPtrToLowerSPtr(one.ID),
+		Name:     SPtrToLowerSPtr(one.Name),
+		Location: SPtrToLowerNoSpaceSPtr(one.Location),
+		Type:     one.Type,
+	}
+	if one.SKU != nil {
+		result.SKUName = (*string)(one.SKU.Name)
+	}
+	if one.Properties != nil {
+		result.Status = (*string)(one.Properties.DiskState)
+		result.DiskSize = one.Properties.DiskSizeBytes
+		result.OSType = (*string)(one.Properties.OSType)
+		result.Incremental = one.Properties.Incremental
+		result.ProvisioningState = one.Properties.ProvisioningState
+		if one.Properties.CreationData != nil {
+			result.SourceResourceID = SPtrToLowerSPtr(one.Properties.CreationData.SourceResourceID)
+		}
+		if one.Properties.TimeCreated != nil {
+			result.TimeCreated = converter.ValToPtr(times.ConvStdTimeFormat(*one.Properties.TimeCreated))
+		}
+	}
+
+	return result
+}
+
+// CreateSnapshot 创建快照，返回快照ID
+// reference: https://learn.microsoft.com/en-us/rest/api/compute/snapshots/create-or-update
+func (az *AzureImpl) CreateSnapshot(kt *kit.Kit, opt *snapshot.AzureSnapshotCreateOption) (string, error) {
+	if opt == nil {
+		return "", errf.New(errf.InvalidParameter, "azure snapshot create option is required")
+	}
+
+	req, err := opt.ToSnapshot()
+	if err != nil {
+		return "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := az.clientSet.snapshotClient()
+	if err != nil {
+		return "", err
+	}
+
+	poller, err := client.BeginCreateOrUpdate(kt.Ctx, opt.ResourceGroupName, opt.Name, *req, nil)
+	if err != nil {
+		logs.Errorf("create azure snapshot failed, err: %v, disk: %s, rid: %s", err, opt.CloudDiskID, kt.Rid)
+		return "", err
+	}
+
+	resp, err := poller.PollUntilDone(kt.Ctx, nil)
+	if err != nil {
+		logs.Errorf("poll create azure snapshot failed, err: %v, disk: %s, rid: %s", err, opt.CloudDiskID, kt.Rid)
+		return "", err
+	}
+
+	return strings.ToLower(converter.PtrToVal(resp.ID)), nil
+}
+
+// DeleteSnapshot 删除快照
+// reference: https://learn.microsoft.com/en-us/rest/api/compute/snapshots/delete
+func (az *AzureImpl) DeleteSnapshot(kt *kit.Kit, opt *snapshot.AzureSnapshotDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "azure snapshot delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := az.clientSet.snapshotClient()
+	if err != nil {
+		return err
+	}
+
+	poller, err := client.BeginDelete(kt.Ctx, opt.ResourceGroupName, opt.Name, nil)
+	if err != nil {
+		return fmt.Errorf("failed to finish the request:  %v", err)
+	}
+	_, err = poller.PollUntilDone(kt.Ctx, nil)
+
+	return err
+}
+
+// RollbackSnapshot 通过快照创建新的托管磁盘，返回新磁盘ID
+// reference: https://learn.microsoft.com/en-us/rest/api/compute/disks/create-or-update
+func (az *AzureImpl) RollbackSnapshot(kt *kit.Kit, opt *snapshot.AzureSnapshotRollbackOption) (string, error) {
+	if opt == nil {
+		return "", errf.New(errf.InvalidParameter, "azure snapshot rollback option is required")
+	}
+
+	req, err := opt.ToDisk()
+	if err != nil {
+		return "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := az.clientSet.diskClient()
+	if err != nil {
+		return "", err
+	}
+
+	poller, err := client.BeginCreateOrUpdate(kt.Ctx, opt.ResourceGroupName, opt.DiskName, *req, nil)
+	if err != nil {
+		logs.Errorf("create azure disk from snapshot failed, err: %v, snapshot: %s, rid: %s", err,
+			opt.CloudSnapshotID, kt.Rid)
+		return "", err
+	}
+
+	resp, err := poller.PollUntilDone(kt.Ctx, nil)
+	if err != nil {
+		logs.Errorf("poll create azure disk from snapshot failed, err: %v, snapshot: %s, rid: %s", err,
+			opt.CloudSnapshotID, kt.Rid)
+		return "", err
+	}
+
+	return strings.ToLower(converter.PtrToVal(resp.ID)), nil
+}
@@ -37,7 +37,7 @@ import (
 
 // CreateVpc create vpc.
 // reference: https://docs.microsoft.com/en-us/rest/api/virtualnetwork/virtual-networks/create-or-update
-func (az *Azure) CreateVpc(kt *kit.Kit, opt *types.AzureVpcCreateOption) (*types.AzureVpc, error) {
+func (az *AzureImpl) CreateVpc(kt *kit.Kit, opt *types.AzureVpcCreateOption) (*types.AzureVpc, error) {
 	if err := opt.Validate(); err != nil {
 		return nil, err
 	}
@@ -84,13 +84,13 @@ func (az *Azure) CreateVpc(kt *kit.Kit, opt *types.AzureVpcCreateOption) (*types
 
 // UpdateVpc update vpc.
 // TODO right now only memo is supported to update, add other update operations later.
-func (az *Azure) UpdateVpc(kt *kit.Kit, opt *types.AzureVpcUpdateOption) error {
+func (az *AzureImpl) UpdateVpc(kt *kit.Kit, opt *types.AzureVpcUpdateOption) error {
 	return nil
 }
 
 // DeleteVpc delete vpc.
 // reference: https://docs.microsoft.com/en-us/rest/api/virtualnetwork/virtual-networks/delete
-func (az *Azure) DeleteVpc(kt *kit.Kit, opt *core.AzureDeleteOption) error {
+func (az *AzureImpl) DeleteVpc(kt *kit.Kit, opt *core.AzureDeleteOption) error {
 	if err := opt.Validate(); err != nil {
 		return err
 	}
@@ -116,7 +116,7 @@ func (az *Azure) DeleteVpc(kt *kit.Kit, opt *core.AzureDeleteOption) error {
 
 // ListVpc list vpc.
 // reference: https://learn.microsoft.com/en-us/rest/api/virtualnetwork/virtual-networks/list
-func (az *Azure) ListVpc(kt *kit.Kit, opt *core.AzureListOption) (*types.AzureVpcListResult, error) {
+func (az *AzureImpl) ListVpc(kt *kit.Kit, opt *core.AzureListOption) (*types.AzureVpcListResult, error) {
 	if err := opt.Validate(); err != nil {
 		return nil, err
 	}
@@ -151,7 +151,7 @@ func (az *Azure) ListVpc(kt *kit.Kit, opt *core.AzureListOption) (*types.AzureVp
 
 // CountVpcAndSubnet count vpc.
 // reference: https://learn.microsoft.com/en-us/rest/api/virtualnetwork/virtual-networks/list
-func (az *Azure) CountVpcAndSubnet(kt *kit.Kit) (int32, int32, error) {
+func (az *AzureImpl) CountVpcAndSubnet(kt *kit.Kit) (int32, int32, error) {
 
 	client, err := az.clientSet.vpcClient()
 	if err != nil {
@@ -180,7 +180,7 @@ func (az *Azure) CountVpcAndSubnet(kt *kit.Kit) (int32, int32, error) {
 
 // ListVpcByPage list vpc.
 // reference: https://learn.microsoft.com/en-us/rest/api/virtualnetwork/virtual-networks/list
-func (az *Azure) ListVpcByPage(kt *kit.Kit, opt *core.AzureListOption) (
+func (az *AzureImpl) ListVpcByPage(kt *kit.Kit, opt *core.AzureListOption) (
 	*Pager[armnetwork.VirtualNetworksClientListResponse, types.AzureVpc], error) {
 
 	if err := opt.Validate(); err != nil {
@@ -221,7 +221,7 @@ func (handler *vpcResultHandler) BuildResult(resp armnetwork.VirtualNetworksClie
 
 // ListVpcByID list vpc.
 // reference: https://learn.microsoft.com/en-us/rest/api/virtualnetwork/virtual-networks/list
-func (az *Azure) ListVpcByID(kt *kit.Kit, opt *core.AzureListByIDOption) (*types.AzureVpcListResult, error) {
+func (az *AzureImpl) ListVpcByID(kt *kit.Kit, opt *core.AzureListByIDOption) (*types.AzureVpcListResult, error) {
 	if err := opt.Validate(); err != nil {
 		return nil, err
 	}
@@ -312,7 +312,7 @@ func convertVpc(data *armnetwork.VirtualNetwork, resourceGroup string) *types.Az
 
 // ListVpcUsage list vpc usage.
 // reference: https://learn.microsoft.com/en-us/rest/api/virtualnetwork/virtual-networks/list-usage?tabs=HTTP
-func (az *Azure) ListVpcUsage(kt *kit.Kit, opt *types.AzureVpcListUsageOption) ([]types.VpcUsage,
+func (az *AzureImpl) ListVpcUsage(kt *kit.Kit, opt *types.AzureVpcListUsageOption) ([]types.VpcUsage,
 	error) {
 
 	if err := opt.Validate(); err != nil {
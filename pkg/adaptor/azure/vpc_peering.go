@@ -0,0 +1,104 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	"fmt"
+
+	vpcpeering "hcm/pkg/adaptor/types/vpc-peering"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/tools/converter"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v2"
+)
+
+// ListVpcPeering 查询资源组下虚拟网络的对等连接，对等连接挂在虚拟网络下，通过查询虚拟网络获取
+// reference: https://learn.microsoft.com/en-us/rest/api/virtualnetwork/virtual-networks/list
+func (az *AzureImpl) ListVpcPeering(kt *kit.Kit, opt *vpcpeering.AzureVpcPeeringListOption) (
+	[]vpcpeering.AzureVpcPeering, error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "azure vpc peering list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := az.clientSet.vpcClient()
+	if err != nil {
+		return nil, err
+	}
+
+	idMap := converter.StringSliceToMap(opt.CloudIDs)
+	peerings := make([]vpcpeering.AzureVpcPeering, 0)
+	pager := client.NewListPager(opt.ResourceGroupName, nil)
+	for pager.More() {
+		nextResult, err := pager.NextPage(kt.Ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to advance page: %v", err)
+		}
+
+		for _, vnet := range nextResult.Value {
+			if vnet.Properties == nil {
+				continue
+			}
+
+			for _, one := range vnet.Properties.VirtualNetworkPeerings {
+				peering := converterVpcPeering(vnet, one)
+				if len(opt.CloudIDs) == 0 {
+					peerings = append(peerings, peering)
+					continue
+				}
+
+				if _, exist := idMap[peering.GetCloudID()]; exist {
+					peerings = append(peerings, peering)
+				}
+			}
+		}
+	}
+
+	return peerings, nil
+}
+
+func converterVpcPeering(vnet *armnetwork.VirtualNetwork,
+	one *armnetwork.VirtualNetworkPeering) vpcpeering.AzureVpcPeering {
+
+	result := vpcpeering.AzureVpcPeering{
+		ID:         SPtrToLowerSPtr(one.ID),
+		Name:       SPtrToLowerSPtr(one.Name),
+		Location:   SPtrToLowerNoSpaceSPtr(vnet.Location),
+		CloudVpcID: SPtrToLowerSPtr(vnet.ID),
+	}
+	if one.Properties != nil {
+		result.PeeringState = (*string)(one.Properties.PeeringState)
+		result.ProvisioningState = (*string)(one.Properties.ProvisioningState)
+		result.AllowVirtualNetworkAccess = one.Properties.AllowVirtualNetworkAccess
+		result.AllowForwardedTraffic = one.Properties.AllowForwardedTraffic
+		result.AllowGatewayTransit = one.Properties.AllowGatewayTransit
+		result.UseRemoteGateways = one.Properties.UseRemoteGateways
+		if one.Properties.RemoteVirtualNetwork != nil {
+			result.CloudRemoteVpcID = SPtrToLowerSPtr(one.Properties.RemoteVirtualNetwork.ID)
+		}
+	}
+
+	return result
+}
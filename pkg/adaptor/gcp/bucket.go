@@ -0,0 +1,128 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gcp
+
+import (
+	typesbucket "hcm/pkg/adaptor/types/bucket"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"google.golang.org/api/storage/v1"
+)
+
+// bucketFullProjection 查询存储桶时返回ACL等完整属性
+const bucketFullProjection = "full"
+
+// ListBucket 查询项目下的GCS存储桶，并补充IAM策略中授予所有用户的角色，返回下一页的 PageToken
+// reference: https://cloud.google.com/storage/docs/json_api/v1/buckets/list
+func (g *GcpImpl) ListBucket(kt *kit.Kit, opt *typesbucket.GcpBucketListOption) ([]typesbucket.GcpBucket, string,
+	error) {
+
+	if opt == nil {
+		return nil, "", errf.New(errf.InvalidParameter, "gcp bucket list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := g.clientSet.storageClient(kt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// GCS 不支持按名称批量过滤，指定 CloudIDs 时查询全部存储桶后再过滤
+	if len(opt.CloudIDs) > 0 {
+		idMap := converter.StringSliceToMap(opt.CloudIDs)
+		items := make([]*storage.Bucket, 0, len(opt.CloudIDs))
+		err = client.Buckets.List(g.CloudProjectID()).Projection(bucketFullProjection).Context(kt.Ctx).Pages(kt.Ctx,
+			func(resp *storage.Buckets) error {
+				for _, one := range resp.Items {
+					if _, exist := idMap[one.Name]; exist {
+						items = append(items, one)
+					}
+				}
+				return nil
+			})
+		if err != nil {
+			logs.Errorf("list gcp bucket failed, err: %v, opt: %v, rid: %s", err, opt, kt.Rid)
+			return nil, "", err
+		}
+
+		buckets, err := g.getBucketPublicRoles(kt, client, items)
+		if err != nil {
+			return nil, "", err
+		}
+		return buckets, "", nil
+	}
+
+	request := client.Buckets.List(g.CloudProjectID()).Projection(bucketFullProjection).Context(kt.Ctx)
+	if opt.Page != nil {
+		request.MaxResults(opt.Page.PageSize).PageToken(opt.Page.PageToken)
+	}
+
+	resp, err := request.Do()
+	if err != nil {
+		logs.Errorf("list gcp bucket failed, err: %v, opt: %v, rid: %s", err, opt, kt.Rid)
+		return nil, "", err
+	}
+
+	buckets, err := g.getBucketPublicRoles(kt, client, resp.Items)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return buckets, resp.NextPageToken, nil
+}
+
+// getBucketPublicRoles 查询存储桶IAM策略，带条件的绑定不视为对公网开放
+// reference: https://cloud.google.com/storage/docs/json_api/v1/buckets/getIamPolicy
+func (g *GcpImpl) getBucketPublicRoles(kt *kit.Kit, client *storage.Service, items []*storage.Bucket) (
+	[]typesbucket.GcpBucket, error) {
+
+	buckets := make([]typesbucket.GcpBucket, 0, len(items))
+	for _, one := range items {
+		policy, err := client.Buckets.GetIamPolicy(one.Name).Context(kt.Ctx).Do()
+		if err != nil {
+			logs.Errorf("get gcp bucket iam policy failed, err: %v, bucket: %s, rid: %s", err, one.Name, kt.Rid)
+			return nil, err
+		}
+
+		roles := make([]string, 0)
+		for _, binding := range policy.Bindings {
+			if binding == nil || binding.Condition != nil {
+				continue
+			}
+
+			for _, member := range binding.Members {
+				if member == typesbucket.GcpAllUsers || member == typesbucket.GcpAllAuthenticatedUsers {
+					roles = append(roles, binding.Role)
+					break
+				}
+			}
+		}
+
+		buckets = append(buckets, typesbucket.GcpBucket{Bucket: one, PublicRoles: roles})
+	}
+
+	return buckets, nil
+}
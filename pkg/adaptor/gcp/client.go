@@ -31,8 +31,12 @@ import (
 	"google.golang.org/api/cloudbilling/v1"
 	res "google.golang.org/api/cloudresourcemanager/v3"
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/container/v1"
+	"google.golang.org/api/dns/v1"
 	iam "google.golang.org/api/iam/v1"
 	"google.golang.org/api/option"
+	"google.golang.org/api/sqladmin/v1"
+	"google.golang.org/api/storage/v1"
 )
 
 type clientSet struct {
@@ -113,3 +117,43 @@ func (c *clientSet) billingClient(kt *kit.Kit) (*cloudbilling.APIService, error)
 	}
 	return service, nil
 }
+
+func (c *clientSet) sqlAdminClient(kt *kit.Kit) (*sqladmin.Service, error) {
+	opt := option.WithCredentialsJSON(c.credential.Json)
+	service, err := sqladmin.NewService(kt.Ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+func (c *clientSet) containerClient(kt *kit.Kit) (*container.Service, error) {
+	opt := option.WithCredentialsJSON(c.credential.Json)
+	service, err := container.NewService(kt.Ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+func (c *clientSet) storageClient(kt *kit.Kit) (*storage.Service, error) {
+	opt := option.WithCredentialsJSON(c.credential.Json)
+	service, err := storage.NewService(kt.Ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+func (c *clientSet) dnsClient(kt *kit.Kit) (*dns.Service, error) {
+	opt := option.WithCredentialsJSON(c.credential.Json)
+	service, err := dns.NewService(kt.Ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
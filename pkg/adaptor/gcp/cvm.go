@@ -28,6 +28,7 @@ import (
 	"hcm/pkg/adaptor/poller"
 	"hcm/pkg/adaptor/types"
 	typecvm "hcm/pkg/adaptor/types/cvm"
+	typekeypair "hcm/pkg/adaptor/types/key-pair"
 	"hcm/pkg/criteria/constant"
 	"hcm/pkg/criteria/errf"
 	"hcm/pkg/kit"
@@ -39,7 +40,7 @@ import (
 )
 
 // ListCvm reference: https://cloud.google.com/compute/docs/reference/rest/v1/instances/list
-func (g *Gcp) ListCvm(kt *kit.Kit, opt *typecvm.GcpListOption) ([]typecvm.GcpCvm, string, error) {
+func (g *GcpImpl) ListCvm(kt *kit.Kit, opt *typecvm.GcpListOption) ([]typecvm.GcpCvm, string, error) {
 	if opt == nil {
 		return nil, "", errf.New(errf.InvalidParameter, "list option is required")
 	}
@@ -82,7 +83,7 @@ func (g *Gcp) ListCvm(kt *kit.Kit, opt *typecvm.GcpListOption) ([]typecvm.GcpCvm
 }
 
 // CountCvmAndNI reference: https://cloud.google.com/compute/docs/reference/rest/v1/instances/list
-func (g *Gcp) CountCvmAndNI(kt *kit.Kit) (int32, int32, error) {
+func (g *GcpImpl) CountCvmAndNI(kt *kit.Kit) (int32, int32, error) {
 
 	client, err := g.clientSet.computeClient(kt)
 	if err != nil {
@@ -152,7 +153,7 @@ func GetGcpIPAddresses(networkInterfaces []*compute.NetworkInterface) ([]string,
 }
 
 // DeleteCvm reference: https://cloud.google.com/compute/docs/reference/rest/v1/instances/delete
-func (g *Gcp) DeleteCvm(kt *kit.Kit, opt *typecvm.GcpDeleteOption) error {
+func (g *GcpImpl) DeleteCvm(kt *kit.Kit, opt *typecvm.GcpDeleteOption) error {
 	if opt == nil {
 		return errf.New(errf.InvalidParameter, "delete option is required")
 	}
@@ -176,7 +177,7 @@ func (g *Gcp) DeleteCvm(kt *kit.Kit, opt *typecvm.GcpDeleteOption) error {
 }
 
 // StopCvm reference: https://cloud.google.com/compute/docs/reference/rest/v1/instances/stop
-func (g *Gcp) StopCvm(kt *kit.Kit, opt *typecvm.GcpStopOption) error {
+func (g *GcpImpl) StopCvm(kt *kit.Kit, opt *typecvm.GcpStopOption) error {
 	if opt == nil {
 		return errf.New(errf.InvalidParameter, "stop option is required")
 	}
@@ -199,7 +200,7 @@ func (g *Gcp) StopCvm(kt *kit.Kit, opt *typecvm.GcpStopOption) error {
 	handler := &stopCvmPollingHandler{
 		opt.Zone,
 	}
-	respPoller := poller.Poller[*Gcp, []*compute.Instance, poller.BaseDoneResult]{Handler: handler}
+	respPoller := poller.Poller[*GcpImpl, []*compute.Instance, poller.BaseDoneResult]{Handler: handler}
 	_, err = respPoller.PollUntilDone(g, kt, []*string{to.Ptr(opt.Name)},
 		types.NewBatchOperateCvmPollerOpt())
 	if err != nil {
@@ -210,7 +211,7 @@ func (g *Gcp) StopCvm(kt *kit.Kit, opt *typecvm.GcpStopOption) error {
 }
 
 // StartCvm reference: https://cloud.google.com/compute/docs/reference/rest/v1/instances/start
-func (g *Gcp) StartCvm(kt *kit.Kit, opt *typecvm.GcpStartOption) error {
+func (g *GcpImpl) StartCvm(kt *kit.Kit, opt *typecvm.GcpStartOption) error {
 	if opt == nil {
 		return errf.New(errf.InvalidParameter, "start option is required")
 	}
@@ -233,7 +234,7 @@ func (g *Gcp) StartCvm(kt *kit.Kit, opt *typecvm.GcpStartOption) error {
 	handler := &startCvmPollingHandler{
 		opt.Zone,
 	}
-	respPoller := poller.Poller[*Gcp, []*compute.Instance, poller.BaseDoneResult]{Handler: handler}
+	respPoller := poller.Poller[*GcpImpl, []*compute.Instance, poller.BaseDoneResult]{Handler: handler}
 	_, err = respPoller.PollUntilDone(g, kt, []*string{to.Ptr(opt.Name)},
 		types.NewBatchOperateCvmPollerOpt())
 	if err != nil {
@@ -244,7 +245,7 @@ func (g *Gcp) StartCvm(kt *kit.Kit, opt *typecvm.GcpStartOption) error {
 }
 
 // ResetCvm reference: https://cloud.google.com/compute/docs/reference/rest/v1/instances/reset
-func (g *Gcp) ResetCvm(kt *kit.Kit, opt *typecvm.GcpResetOption) error {
+func (g *GcpImpl) ResetCvm(kt *kit.Kit, opt *typecvm.GcpResetOption) error {
 	if opt == nil {
 		return errf.New(errf.InvalidParameter, "reset option is required")
 	}
@@ -267,7 +268,7 @@ func (g *Gcp) ResetCvm(kt *kit.Kit, opt *typecvm.GcpResetOption) error {
 	handler := &resetCvmPollingHandler{
 		opt.Zone,
 	}
-	respPoller := poller.Poller[*Gcp, []*compute.Instance, poller.BaseDoneResult]{Handler: handler}
+	respPoller := poller.Poller[*GcpImpl, []*compute.Instance, poller.BaseDoneResult]{Handler: handler}
 	_, err = respPoller.PollUntilDone(g, kt, []*string{to.Ptr(opt.Name)},
 		types.NewBatchOperateCvmPollerOpt())
 	if err != nil {
@@ -278,7 +279,7 @@ func (g *Gcp) ResetCvm(kt *kit.Kit, opt *typecvm.GcpResetOption) error {
 }
 
 // CreateCvm reference: https://cloud.google.com/compute/docs/reference/rest/v1/instances/bulkInsert
-func (g *Gcp) CreateCvm(kt *kit.Kit, opt *typecvm.GcpCreateOption) (*poller.BaseDoneResult, error) {
+func (g *GcpImpl) CreateCvm(kt *kit.Kit, opt *typecvm.GcpCreateOption) (*poller.BaseDoneResult, error) {
 	if opt == nil {
 		return nil, errf.New(errf.InvalidParameter, "reset option is required")
 	}
@@ -292,7 +293,7 @@ func (g *Gcp) CreateCvm(kt *kit.Kit, opt *typecvm.GcpCreateOption) (*poller.Base
 		return nil, err
 	}
 
-	script, err := opt.ImageProjectType.StartupScript(opt.Password)
+	metadata, err := genCvmMetadata(opt)
 	if err != nil {
 		return nil, err
 	}
@@ -313,14 +314,7 @@ func (g *Gcp) CreateCvm(kt *kit.Kit, opt *typecvm.GcpCreateOption) (*poller.Base
 				},
 			},
 			MachineType: opt.InstanceType,
-			Metadata: &compute.Metadata{
-				Items: []*compute.MetadataItems{
-					{
-						Key:   "startup-script",
-						Value: converter.ValToPtr(script),
-					},
-				},
-			},
+			Metadata:    metadata,
 		},
 		MinCount:    opt.RequiredCount,
 		NamePattern: opt.NamePrefix + "-####",
@@ -377,19 +371,50 @@ func (g *Gcp) CreateCvm(kt *kit.Kit, opt *typecvm.GcpCreateOption) (*poller.Base
 	handler := &createCvmPollingHandler{
 		opt.Zone,
 	}
-	respPoller := poller.Poller[*Gcp, []*compute.Operation, poller.BaseDoneResult]{Handler: handler}
+	respPoller := poller.Poller[*GcpImpl, []*compute.Operation, poller.BaseDoneResult]{Handler: handler}
 	result, err := respPoller.PollUntilDone(g, kt, []*string{to.Ptr(resp.OperationGroupId)},
 		types.NewBatchCreateCvmPollerOption())
 	if err != nil {
 		return nil, err
 	}
 
-	g.deleteCvmMetadataStartScript(kt, client, opt.Zone, result.SuccessCloudIDs)
+	// 使用密钥对登录的主机没有设置密码的启动脚本，不需要删除
+	if len(opt.SSHKey) == 0 {
+		g.deleteCvmMetadataStartScript(kt, client, opt.Zone, result.SuccessCloudIDs)
+	}
 
 	return result, nil
 }
 
-func (g *Gcp) deleteCvmMetadataStartScript(kt *kit.Kit, client *compute.Service, zone string, ids []string) {
+// genCvmMetadata 指定密钥对时将公钥写入主机元数据，否则通过启动脚本设置密码
+func genCvmMetadata(opt *typecvm.GcpCreateOption) (*compute.Metadata, error) {
+	if len(opt.SSHKey) != 0 {
+		return &compute.Metadata{
+			Items: []*compute.MetadataItems{
+				{
+					Key:   typekeypair.GcpSSHKeysMetadataKey,
+					Value: converter.ValToPtr(opt.SSHKey),
+				},
+			},
+		}, nil
+	}
+
+	script, err := opt.ImageProjectType.StartupScript(opt.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	return &compute.Metadata{
+		Items: []*compute.MetadataItems{
+			{
+				Key:   "startup-script",
+				Value: converter.ValToPtr(script),
+			},
+		},
+	}, nil
+}
+
+func (g *GcpImpl) deleteCvmMetadataStartScript(kt *kit.Kit, client *compute.Service, zone string, ids []string) {
 
 	resp, err := client.Instances.List(g.CloudProjectID(), zone).Context(kt.Ctx).
 		Filter(generateResourceIDsFilter(ids)).Do()
@@ -425,7 +450,7 @@ func (h *startCvmPollingHandler) Done(instances []*compute.Instance) (bool, *pol
 }
 
 // Poll ...
-func (h *startCvmPollingHandler) Poll(client *Gcp, kt *kit.Kit, names []*string) ([]*compute.Instance, error) {
+func (h *startCvmPollingHandler) Poll(client *GcpImpl, kt *kit.Kit, names []*string) ([]*compute.Instance, error) {
 	return poll(client, kt, h.zone, names)
 }
 
@@ -439,7 +464,7 @@ func (h *stopCvmPollingHandler) Done(instances []*compute.Instance) (bool, *poll
 }
 
 // Poll ...
-func (h *stopCvmPollingHandler) Poll(client *Gcp, kt *kit.Kit, names []*string) ([]*compute.Instance, error) {
+func (h *stopCvmPollingHandler) Poll(client *GcpImpl, kt *kit.Kit, names []*string) ([]*compute.Instance, error) {
 	return poll(client, kt, h.zone, names)
 }
 
@@ -453,7 +478,7 @@ func (h *resetCvmPollingHandler) Done(instances []*compute.Instance) (bool, *pol
 }
 
 // Poll ...
-func (h *resetCvmPollingHandler) Poll(client *Gcp, kt *kit.Kit, names []*string) ([]*compute.Instance, error) {
+func (h *resetCvmPollingHandler) Poll(client *GcpImpl, kt *kit.Kit, names []*string) ([]*compute.Instance, error) {
 	return poll(client, kt, h.zone, names)
 }
 
@@ -476,7 +501,7 @@ func done(instances []*compute.Instance, succeed []string) (bool, *poller.BaseDo
 	return flag, result
 }
 
-func poll(client *Gcp, kt *kit.Kit, zone string, names []*string) ([]*compute.Instance, error) {
+func poll(client *GcpImpl, kt *kit.Kit, zone string, names []*string) ([]*compute.Instance, error) {
 	cli, err := client.clientSet.computeClient(kt)
 	if err != nil {
 		return nil, err
@@ -528,7 +553,7 @@ func (h *createCvmPollingHandler) Done(items []*compute.Operation) (bool, *polle
 	return flag, result
 }
 
-func (h *createCvmPollingHandler) Poll(client *Gcp, kt *kit.Kit, operGroupIDs []*string) ([]*compute.Operation, error) {
+func (h *createCvmPollingHandler) Poll(client *GcpImpl, kt *kit.Kit, operGroupIDs []*string) ([]*compute.Operation, error) {
 
 	if len(operGroupIDs) == 0 {
 		return nil, errors.New("operation group id is required")
@@ -553,4 +578,4 @@ func (h *createCvmPollingHandler) Poll(client *Gcp, kt *kit.Kit, operGroupIDs []
 	return operResp.Items, nil
 }
 
-var _ poller.PollingHandler[*Gcp, []*compute.Operation, poller.BaseDoneResult] = new(createCvmPollingHandler)
+var _ poller.PollingHandler[*GcpImpl, []*compute.Operation, poller.BaseDoneResult] = new(createCvmPollingHandler)
@@ -0,0 +1,111 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gcp
+
+import (
+	dbinstance "hcm/pkg/adaptor/types/database-instance"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"google.golang.org/api/sqladmin/v1"
+)
+
+// ListDatabaseInstance 查询项目下的 Cloud SQL 实例，返回下一页的 PageToken
+// reference: https://cloud.google.com/sql/docs/mysql/admin-api/rest/v1/instances/list
+func (g *GcpImpl) ListDatabaseInstance(kt *kit.Kit, opt *dbinstance.GcpDBInstanceListOption) (
+	[]dbinstance.GcpDBInstance, string, error) {
+
+	if opt == nil {
+		return nil, "", errf.New(errf.InvalidParameter, "gcp database instance list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := g.clientSet.sqlAdminClient(kt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Cloud SQL 不支持按连接名过滤，指定 CloudIDs 时查询全部实例后再过滤
+	if len(opt.CloudIDs) > 0 {
+		idMap := converter.StringSliceToMap(opt.CloudIDs)
+		dbs := make([]dbinstance.GcpDBInstance, 0, len(opt.CloudIDs))
+		err = client.Instances.List(g.CloudProjectID()).Context(kt.Ctx).Pages(kt.Ctx,
+			func(resp *sqladmin.InstancesListResponse) error {
+				for _, one := range resp.Items {
+					if _, exist := idMap[one.ConnectionName]; exist {
+						dbs = append(dbs, dbinstance.GcpDBInstance{DatabaseInstance: one})
+					}
+				}
+				return nil
+			})
+		if err != nil {
+			logs.Errorf("list gcp database instance failed, err: %v, opt: %v, rid: %s", err, opt, kt.Rid)
+			return nil, "", err
+		}
+		return dbs, "", nil
+	}
+
+	request := client.Instances.List(g.CloudProjectID()).Context(kt.Ctx)
+	if opt.Page != nil {
+		request.MaxResults(opt.Page.PageSize).PageToken(opt.Page.PageToken)
+	}
+
+	resp, err := request.Do()
+	if err != nil {
+		logs.Errorf("list gcp database instance failed, err: %v, opt: %v, rid: %s", err, opt, kt.Rid)
+		return nil, "", err
+	}
+
+	dbs := make([]dbinstance.GcpDBInstance, 0, len(resp.Items))
+	for _, one := range resp.Items {
+		dbs = append(dbs, dbinstance.GcpDBInstance{DatabaseInstance: one})
+	}
+
+	return dbs, resp.NextPageToken, nil
+}
+
+// DeleteDatabaseInstance 删除 Cloud SQL 实例
+// reference: https://cloud.google.com/sql/docs/mysql/admin-api/rest/v1/instances/delete
+func (g *GcpImpl) DeleteDatabaseInstance(kt *kit.Kit, opt *dbinstance.GcpDBInstanceDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "gcp database instance delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := g.clientSet.sqlAdminClient(kt)
+	if err != nil {
+		return err
+	}
+
+	if _, err = client.Instances.Delete(g.CloudProjectID(), opt.Name).Context(kt.Ctx).Do(); err != nil {
+		logs.Errorf("delete gcp database instance failed, err: %v, opt: %v, rid: %s", err, opt, kt.Rid)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gcp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	typesk8s "hcm/pkg/adaptor/types/k8s-cluster"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+
+	"google.golang.org/api/compute/v1"
+)
+
+// ListK8sCluster 查询项目下所有地域和可用区的GKE集群，节点池在集群详情中返回
+// reference: https://cloud.google.com/kubernetes-engine/docs/reference/rest/v1/projects.locations.clusters/list
+func (g *GcpImpl) ListK8sCluster(kt *kit.Kit) ([]typesk8s.GcpCluster, error) {
+	client, err := g.clientSet.containerClient(kt)
+	if err != nil {
+		return nil, err
+	}
+
+	parent := fmt.Sprintf("projects/%s/locations/-", g.CloudProjectID())
+	resp, err := client.Projects.Locations.Clusters.List(parent).Context(kt.Ctx).Do()
+	if err != nil {
+		logs.Errorf("list gcp k8s cluster failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	clusters := make([]typesk8s.GcpCluster, 0, len(resp.Clusters))
+	for _, one := range resp.Clusters {
+		clusters = append(clusters, typesk8s.GcpCluster{Cluster: one})
+	}
+
+	return clusters, nil
+}
+
+// ListK8sNode 查询GKE节点池的节点，节点池的节点由托管实例组创建，通过托管实例组查询节点对应的实例
+// reference: https://cloud.google.com/compute/docs/reference/rest/v1/instanceGroupManagers/listManagedInstances
+func (g *GcpImpl) ListK8sNode(kt *kit.Kit, opt *typesk8s.GcpK8sNodeListOption) ([]typesk8s.K8sNode, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "gcp k8s node list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := g.clientSet.computeClient(kt)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]typesk8s.K8sNode, 0)
+	for poolName, urls := range opt.NodePoolInstanceGroups {
+		for _, url := range urls {
+			instances, err := g.listManagedInstance(kt, client, url)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, one := range instances {
+				nodes = append(nodes, typesk8s.K8sNode{
+					CloudCvmID:      strconv.FormatUint(one.Id, 10),
+					CloudNodePoolID: poolName,
+				})
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+// listManagedInstance 查询托管实例组下的实例，实例组链接格式:
+// https://www.googleapis.com/compute/v1/projects/{project}/zones/{zone}/instanceGroupManagers/{name}，
+// 区域级节点池使用 regions/{region} 代替 zones/{zone}
+func (g *GcpImpl) listManagedInstance(kt *kit.Kit, client *compute.Service, url string) ([]*compute.ManagedInstance,
+	error) {
+
+	parts := strings.Split(strings.TrimSuffix(url, "/"), "/")
+	if len(parts) < 4 {
+		return nil, fmt.Errorf("invalid gcp instance group url: %s", url)
+	}
+	locationType, location, name := parts[len(parts)-4], parts[len(parts)-3], parts[len(parts)-1]
+
+	instances := make([]*compute.ManagedInstance, 0)
+	var err error
+	switch locationType {
+	case "zones":
+		err = client.InstanceGroupManagers.ListManagedInstances(g.CloudProjectID(), location, name).Pages(kt.Ctx,
+			func(resp *compute.InstanceGroupManagersListManagedInstancesResponse) error {
+				instances = append(instances, resp.ManagedInstances...)
+				return nil
+			})
+	case "regions":
+		err = client.RegionInstanceGroupManagers.ListManagedInstances(g.CloudProjectID(), location, name).Pages(kt.Ctx,
+			func(resp *compute.RegionInstanceGroupManagersListInstancesResponse) error {
+				instances = append(instances, resp.ManagedInstances...)
+				return nil
+			})
+	default:
+		return nil, fmt.Errorf("invalid gcp instance group url: %s", url)
+	}
+	if err != nil {
+		logs.Errorf("list gcp managed instance failed, err: %v, url: %s, rid: %s", err, url, kt.Rid)
+		return nil, err
+	}
+
+	return instances, nil
+}
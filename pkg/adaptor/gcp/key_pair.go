@@ -0,0 +1,303 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gcp
+
+import (
+	"fmt"
+	"strings"
+
+	typekeypair "hcm/pkg/adaptor/types/key-pair"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"google.golang.org/api/compute/v1"
+)
+
+// ListKeyPair 查询项目元数据中的 SSH 公钥
+// reference: https://cloud.google.com/compute/docs/reference/rest/v1/projects/get
+func (g *GcpImpl) ListKeyPair(kt *kit.Kit, opt *typekeypair.GcpKeyPairListOption) ([]typekeypair.GcpKeyPair, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "gcp key pair list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := g.clientSet.computeClient(kt)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := client.Projects.Get(g.CloudProjectID()).Context(kt.Ctx).Do()
+	if err != nil {
+		logs.Errorf("get gcp project failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	keys := typekeypair.ParseGcpSSHKeys(getMetadataValue(project.CommonInstanceMetadata,
+		typekeypair.GcpSSHKeysMetadataKey))
+	if len(opt.CloudIDs) == 0 {
+		return keys, nil
+	}
+
+	idMap := converter.StringSliceToMap(opt.CloudIDs)
+	result := make([]typekeypair.GcpKeyPair, 0, len(opt.CloudIDs))
+	for _, key := range keys {
+		if _, exist := idMap[key.GetCloudID()]; exist {
+			result = append(result, key)
+		}
+	}
+
+	return result, nil
+}
+
+// ImportKeyPair 将 SSH 公钥追加到项目元数据，返回公钥云上ID（用户名）
+// reference: https://cloud.google.com/compute/docs/reference/rest/v1/projects/setCommonInstanceMetadata
+func (g *GcpImpl) ImportKeyPair(kt *kit.Kit, opt *typekeypair.GcpKeyPairImportOption) (string, error) {
+	if opt == nil {
+		return "", errf.New(errf.InvalidParameter, "gcp key pair import option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := g.clientSet.computeClient(kt)
+	if err != nil {
+		return "", err
+	}
+
+	project, err := client.Projects.Get(g.CloudProjectID()).Context(kt.Ctx).Do()
+	if err != nil {
+		logs.Errorf("get gcp project failed, err: %v, rid: %s", err, kt.Rid)
+		return "", err
+	}
+
+	metadata := project.CommonInstanceMetadata
+	if metadata == nil {
+		metadata = new(compute.Metadata)
+	}
+
+	keys := typekeypair.ParseGcpSSHKeys(getMetadataValue(metadata, typekeypair.GcpSSHKeysMetadataKey))
+	for _, key := range keys {
+		if key.Name == opt.Name {
+			return "", errf.Newf(errf.InvalidParameter, "ssh key of %s already exists", opt.Name)
+		}
+	}
+
+	newKey, err := typekeypair.ParseGcpSSHKey(opt.Name + ":" + opt.PublicKey)
+	if err != nil {
+		return "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+	keys = append(keys, *newKey)
+	setMetadataValue(metadata, typekeypair.GcpSSHKeysMetadataKey, joinGcpSSHKeys(keys))
+
+	// 元数据中带有 fingerprint，并发修改时云上会返回冲突错误，避免覆盖其他人的修改
+	_, err = client.Projects.SetCommonInstanceMetadata(g.CloudProjectID(), metadata).Context(kt.Ctx).Do()
+	if err != nil {
+		logs.Errorf("import gcp key pair failed, err: %v, name: %s, rid: %s", err, opt.Name, kt.Rid)
+		return "", err
+	}
+
+	return newKey.GetCloudID(), nil
+}
+
+// DeleteKeyPair 从项目元数据中删除 SSH 公钥，已写入主机实例元数据的公钥不受影响
+// reference: https://cloud.google.com/compute/docs/reference/rest/v1/projects/setCommonInstanceMetadata
+func (g *GcpImpl) DeleteKeyPair(kt *kit.Kit, opt *typekeypair.GcpKeyPairDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "gcp key pair delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := g.clientSet.computeClient(kt)
+	if err != nil {
+		return err
+	}
+
+	project, err := client.Projects.Get(g.CloudProjectID()).Context(kt.Ctx).Do()
+	if err != nil {
+		logs.Errorf("get gcp project failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	metadata := project.CommonInstanceMetadata
+	if metadata == nil {
+		return nil
+	}
+
+	value := getMetadataValue(metadata, typekeypair.GcpSSHKeysMetadataKey)
+	left, removed := removeGcpSSHKeys(value, []string{opt.CloudID})
+	if !removed {
+		return nil
+	}
+	setMetadataValue(metadata, typekeypair.GcpSSHKeysMetadataKey, left)
+
+	_, err = client.Projects.SetCommonInstanceMetadata(g.CloudProjectID(), metadata).Context(kt.Ctx).Do()
+	if err != nil {
+		logs.Errorf("delete gcp key pair failed, err: %v, id: %s, rid: %s", err, opt.CloudID, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// BindKeyPair 将项目中的 SSH 公钥写入主机实例元数据
+// reference: https://cloud.google.com/compute/docs/reference/rest/v1/instances/setMetadata
+func (g *GcpImpl) BindKeyPair(kt *kit.Kit, opt *typekeypair.GcpKeyPairBindOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "gcp key pair bind option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	keys, err := g.ListKeyPair(kt, &typekeypair.GcpKeyPairListOption{CloudIDs: opt.CloudIDs})
+	if err != nil {
+		return err
+	}
+	if len(keys) != len(opt.CloudIDs) {
+		return fmt.Errorf("some ssh keys of %v not found in project metadata", opt.CloudIDs)
+	}
+
+	return g.updateCvmSSHKeys(kt, opt.Zone, opt.CloudCvmIDs, func(value string) string {
+		left, _ := removeGcpSSHKeys(value, opt.CloudIDs)
+		lines := make([]string, 0, len(keys)+1)
+		if len(left) != 0 {
+			lines = append(lines, left)
+		}
+		for _, key := range keys {
+			lines = append(lines, key.MetadataLine())
+		}
+		return strings.Join(lines, "\n")
+	})
+}
+
+// UnbindKeyPair 从主机实例元数据中删除 SSH 公钥
+// reference: https://cloud.google.com/compute/docs/reference/rest/v1/instances/setMetadata
+func (g *GcpImpl) UnbindKeyPair(kt *kit.Kit, opt *typekeypair.GcpKeyPairBindOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "gcp key pair unbind option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	return g.updateCvmSSHKeys(kt, opt.Zone, opt.CloudCvmIDs, func(value string) string {
+		left, _ := removeGcpSSHKeys(value, opt.CloudIDs)
+		return left
+	})
+}
+
+func (g *GcpImpl) updateCvmSSHKeys(kt *kit.Kit, zone string, cloudCvmIDs []string, update func(string) string) error {
+	client, err := g.clientSet.computeClient(kt)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Instances.List(g.CloudProjectID(), zone).Context(kt.Ctx).
+		Filter(generateResourceIDsFilter(cloudCvmIDs)).Do()
+	if err != nil {
+		logs.Errorf("list gcp cvm failed, err: %v, ids: %v, rid: %s", err, cloudCvmIDs, kt.Rid)
+		return err
+	}
+
+	if len(resp.Items) != len(cloudCvmIDs) {
+		return fmt.Errorf("some cvms of %v not found in zone %s", cloudCvmIDs, zone)
+	}
+
+	for _, one := range resp.Items {
+		metadata := one.Metadata
+		if metadata == nil {
+			metadata = new(compute.Metadata)
+		}
+		setMetadataValue(metadata, typekeypair.GcpSSHKeysMetadataKey,
+			update(getMetadataValue(metadata, typekeypair.GcpSSHKeysMetadataKey)))
+
+		_, err = client.Instances.SetMetadata(g.CloudProjectID(), zone, one.Name, metadata).Context(kt.Ctx).Do()
+		if err != nil {
+			logs.Errorf("set gcp cvm metadata failed, err: %v, name: %s, rid: %s", err, one.Name, kt.Rid)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func joinGcpSSHKeys(keys []typekeypair.GcpKeyPair) string {
+	lines := make([]string, 0, len(keys))
+	for _, key := range keys {
+		lines = append(lines, key.MetadataLine())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// removeGcpSSHKeys 删除 ssh-keys 中指定用户名的行，保留其余无法解析的行不做改动
+func removeGcpSSHKeys(value string, names []string) (string, bool) {
+	nameMap := converter.StringSliceToMap(names)
+	removed := false
+	lines := make([]string, 0)
+	for _, line := range strings.Split(value, "\n") {
+		if len(strings.TrimSpace(line)) == 0 {
+			continue
+		}
+		name, _, _ := strings.Cut(strings.TrimSpace(line), ":")
+		if _, exist := nameMap[name]; exist {
+			removed = true
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n"), removed
+}
+
+func getMetadataValue(metadata *compute.Metadata, key string) string {
+	if metadata == nil {
+		return ""
+	}
+
+	for _, item := range metadata.Items {
+		if item != nil && item.Key == key {
+			return converter.PtrToVal(item.Value)
+		}
+	}
+
+	return ""
+}
+
+func setMetadataValue(metadata *compute.Metadata, key, value string) {
+	for _, item := range metadata.Items {
+		if item != nil && item.Key == key {
+			item.Value = converter.ValToPtr(value)
+			return
+		}
+	}
+
+	metadata.Items = append(metadata.Items, &compute.MetadataItems{Key: key, Value: converter.ValToPtr(value)})
+}
@@ -0,0 +1,109 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gcp
+
+import (
+	typelb "hcm/pkg/adaptor/types/load-balancer"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+
+	"google.golang.org/api/compute/v1"
+)
+
+// ListLoadBalancer 查询负载均衡，以转发规则作为负载均衡
+// reference: https://cloud.google.com/compute/docs/reference/rest/v1/forwardingRules/list
+// reference: https://cloud.google.com/compute/docs/reference/rest/v1/globalForwardingRules/list
+func (g *GcpImpl) ListLoadBalancer(kt *kit.Kit, opt *typelb.GcpListOption) (*typelb.GcpListResult, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := g.clientSet.computeClient(kt)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *compute.ForwardingRuleList
+	if opt.Region == typelb.GcpGlobalRegion {
+		request := client.GlobalForwardingRules.List(g.CloudProjectID()).Context(kt.Ctx)
+		if len(opt.CloudIDs) > 0 {
+			request.Filter(generateResourceIDsFilter(opt.CloudIDs))
+		}
+		if opt.Page != nil {
+			request.MaxResults(opt.Page.PageSize).PageToken(opt.Page.PageToken)
+		}
+		resp, err = request.Do()
+	} else {
+		request := client.ForwardingRules.List(g.CloudProjectID(), opt.Region).Context(kt.Ctx)
+		if len(opt.CloudIDs) > 0 {
+			request.Filter(generateResourceIDsFilter(opt.CloudIDs))
+		}
+		if opt.Page != nil {
+			request.MaxResults(opt.Page.PageSize).PageToken(opt.Page.PageToken)
+		}
+		resp, err = request.Do()
+	}
+	if err != nil {
+		logs.Errorf("list gcp forwarding rule failed, err: %v, opt: %v, rid: %s", err, opt, kt.Rid)
+		return nil, err
+	}
+
+	details := make([]typelb.GcpLoadBalancer, 0, len(resp.Items))
+	for _, one := range resp.Items {
+		details = append(details, typelb.GcpLoadBalancer{ForwardingRule: one})
+	}
+
+	return &typelb.GcpListResult{NextPageToken: resp.NextPageToken, Details: details}, nil
+}
+
+// DeleteLoadBalancer 删除负载均衡(转发规则)
+// reference: https://cloud.google.com/compute/docs/reference/rest/v1/forwardingRules/delete
+// reference: https://cloud.google.com/compute/docs/reference/rest/v1/globalForwardingRules/delete
+func (g *GcpImpl) DeleteLoadBalancer(kt *kit.Kit, opt *typelb.GcpDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := g.clientSet.computeClient(kt)
+	if err != nil {
+		return err
+	}
+
+	if opt.Region == typelb.GcpGlobalRegion {
+		_, err = client.GlobalForwardingRules.Delete(g.CloudProjectID(), opt.Name).Context(kt.Ctx).Do()
+	} else {
+		_, err = client.ForwardingRules.Delete(g.CloudProjectID(), opt.Region, opt.Name).Context(kt.Ctx).Do()
+	}
+	if err != nil {
+		logs.Errorf("delete gcp forwarding rule failed, err: %v, opt: %v, rid: %s", err, opt, kt.Rid)
+		return err
+	}
+
+	return nil
+}
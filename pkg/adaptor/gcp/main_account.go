@@ -42,7 +42,7 @@ const (
 )
 
 // CreateProject create project, if success, return project id.
-func (g *Gcp) CreateProject(kt *kit.Kit, name string, organization string) (projectId string, err error) {
+func (g *GcpImpl) CreateProject(kt *kit.Kit, name string, organization string) (projectId string, err error) {
 	// Note: 根据name生成合规的ID，id自动生成出于以下考虑：
 	// 1.产品设计上只让用户输入name，id需要自动生成；
 	// 2.gcp页面上创建也可以只输入name，自动生成一个推荐的id（规则与hcm规则一致，后附6位随机数）。
@@ -57,7 +57,7 @@ func (g *Gcp) CreateProject(kt *kit.Kit, name string, organization string) (proj
 // resourcemanager.projects.create
 // resourcemanager.projects.delete (optional)
 // resourcemanager.projects.undelete
-func (g *Gcp) createProjectWithId(kt *kit.Kit, projectId, projectName string, organization string) (string, error) {
+func (g *GcpImpl) createProjectWithId(kt *kit.Kit, projectId, projectName string, organization string) (string, error) {
 	client, err := g.clientSet.resClient(kt)
 	if err != nil {
 		logs.Errorf("init gcp client failed, err: %v, rid: %s", err, kt.Rid)
@@ -97,7 +97,7 @@ func (g *Gcp) createProjectWithId(kt *kit.Kit, projectId, projectName string, or
 	}
 
 	handler := &createMainAccountPollingHandler{}
-	respPoller := poller.Poller[*Gcp, *resourcemanager.Operation, resourcemanager.Operation]{Handler: handler}
+	respPoller := poller.Poller[*GcpImpl, *resourcemanager.Operation, resourcemanager.Operation]{Handler: handler}
 	result, err := respPoller.PollUntilDone(g, kt, []*string{&operation.Name},
 		types.NewCreateMainAccountPollerOption())
 	if err != nil {
@@ -125,7 +125,7 @@ func (g *Gcp) createProjectWithId(kt *kit.Kit, projectId, projectName string, or
 // resourcemanager.projects.get
 // resourcemanager.projects.createBillingAssignment
 // resourcemanager.projects.deleteBillingAssignment
-func (g *Gcp) UpdateBillingInfo(kt *kit.Kit, projectId string, billingAccountName string) error {
+func (g *GcpImpl) UpdateBillingInfo(kt *kit.Kit, projectId string, billingAccountName string) error {
 	client, err := g.clientSet.billingClient(kt)
 	if err != nil {
 		logs.Errorf("init gcp client failed, err: %v, rid: %s", err, kt.Rid)
@@ -148,7 +148,7 @@ func (g *Gcp) UpdateBillingInfo(kt *kit.Kit, projectId string, billingAccountNam
 
 // BindingProjectEditor set iam policy.
 // reference: https://cloud.google.com/resource-manager/reference/rest/v3/projects/setIamPolicy
-func (g *Gcp) BindingProjectEditor(kt *kit.Kit, projectId, email string) error {
+func (g *GcpImpl) BindingProjectEditor(kt *kit.Kit, projectId, email string) error {
 	client, err := g.clientSet.resClient(kt)
 	if err != nil {
 		logs.Errorf("init gcp client failed, err: %v, rid: %s", err, kt.Rid)
@@ -205,7 +205,7 @@ func (h *createMainAccountPollingHandler) Done(op *resourcemanager.Operation) (b
 }
 
 // Poll ...
-func (h *createMainAccountPollingHandler) Poll(client *Gcp, kt *kit.Kit, opIds []*string) (*resourcemanager.Operation, error) {
+func (h *createMainAccountPollingHandler) Poll(client *GcpImpl, kt *kit.Kit, opIds []*string) (*resourcemanager.Operation, error) {
 	if len(opIds) == 0 {
 		return nil, fmt.Errorf("operation group id is required")
 	}
@@ -0,0 +1,71 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gcp
+
+import (
+	natgateway "hcm/pkg/adaptor/types/nat-gateway"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+)
+
+// ListNatGateway 查询地域下路由器中配置的 Cloud NAT，返回下一页的 PageToken
+// reference: https://cloud.google.com/compute/docs/reference/rest/v1/routers/list
+func (g *GcpImpl) ListNatGateway(kt *kit.Kit, opt *natgateway.GcpNatGatewayListOption) (
+	[]natgateway.GcpNatGateway, string, error) {
+
+	if opt == nil {
+		return nil, "", errf.New(errf.InvalidParameter, "gcp nat gateway list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := g.clientSet.computeClient(kt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	request := client.Routers.List(g.CloudProjectID(), opt.Region).Context(kt.Ctx)
+
+	if len(opt.CloudRouterIDs) > 0 {
+		request.Filter(generateResourceIDsFilter(opt.CloudRouterIDs))
+	}
+
+	if opt.Page != nil {
+		request.MaxResults(opt.Page.PageSize).PageToken(opt.Page.PageToken)
+	}
+
+	resp, err := request.Do()
+	if err != nil {
+		logs.Errorf("list routers failed, err: %v, opt: %v, rid: %s", err, opt, kt.Rid)
+		return nil, "", err
+	}
+
+	nats := make([]natgateway.GcpNatGateway, 0)
+	for _, router := range resp.Items {
+		for _, one := range router.Nats {
+			nats = append(nats, natgateway.GcpNatGateway{RouterNat: one, Router: router})
+		}
+	}
+
+	return nats, resp.NextPageToken, nil
+}
@@ -0,0 +1,206 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package gcp
+
+import (
+	"strconv"
+
+	typesdns "hcm/pkg/adaptor/types/private-dns"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"google.golang.org/api/dns/v1"
+)
+
+// ListPrivateDnsZone 查询项目下的私有托管区域
+// reference: https://cloud.google.com/dns/docs/reference/v1/managedZones/list
+func (g *GcpImpl) ListPrivateDnsZone(kt *kit.Kit, opt *typesdns.GcpZoneListOption) ([]typesdns.GcpZone, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "gcp private dns zone list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := g.clientSet.dnsClient(kt)
+	if err != nil {
+		return nil, err
+	}
+
+	idMap := converter.StringSliceToMap(opt.CloudIDs)
+	zones := make([]typesdns.GcpZone, 0)
+	err = client.ManagedZones.List(g.CloudProjectID()).Pages(kt.Ctx, func(resp *dns.ManagedZonesListResponse) error {
+		for _, one := range resp.ManagedZones {
+			if one.Visibility != typesdns.GcpPrivateVisibility {
+				continue
+			}
+			if _, exist := idMap[strconv.FormatUint(one.Id, 10)]; len(opt.CloudIDs) != 0 && !exist {
+				continue
+			}
+			zones = append(zones, typesdns.GcpZone{ManagedZone: one})
+		}
+		return nil
+	})
+	if err != nil {
+		logs.Errorf("list gcp managed zone failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return zones, nil
+}
+
+// ListPrivateDnsRecord 查询托管区域的记录集
+// reference: https://cloud.google.com/dns/docs/reference/v1/resourceRecordSets/list
+func (g *GcpImpl) ListPrivateDnsRecord(kt *kit.Kit, opt *typesdns.GcpRecordListOption) ([]typesdns.Record, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "gcp private dns record list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := g.clientSet.dnsClient(kt)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]typesdns.Record, 0)
+	err = client.ResourceRecordSets.List(g.CloudProjectID(), opt.ZoneName).Pages(kt.Ctx,
+		func(resp *dns.ResourceRecordSetsListResponse) error {
+			for _, one := range resp.Rrsets {
+				if !typesdns.IsSupportedRecordType(one.Type) {
+					continue
+				}
+
+				name := typesdns.ToRecordName(one.Name, opt.DnsName)
+				records = append(records, typesdns.Record{
+					CloudID: typesdns.GetRecordSetCloudID(name, enumor.PrivateDnsRecordType(one.Type)),
+					Name:    name,
+					Type:    enumor.PrivateDnsRecordType(one.Type),
+					Value:   one.Rrdatas,
+					TTL:     one.Ttl,
+				})
+			}
+			return nil
+		})
+	if err != nil {
+		logs.Errorf("list gcp resource record set failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// CreatePrivateDnsRecord 创建记录集，返回记录集云上ID
+// reference: https://cloud.google.com/dns/docs/reference/v1/resourceRecordSets/create
+func (g *GcpImpl) CreatePrivateDnsRecord(kt *kit.Kit, opt *typesdns.GcpRecordOption) (string, error) {
+	if opt == nil {
+		return "", errf.New(errf.InvalidParameter, "gcp private dns record create option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := g.clientSet.dnsClient(kt)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = client.ResourceRecordSets.Create(g.CloudProjectID(), opt.ZoneName, convResourceRecordSet(opt)).
+		Context(kt.Ctx).Do()
+	if err != nil {
+		logs.Errorf("create gcp resource record set failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return "", err
+	}
+
+	return typesdns.GetRecordSetCloudID(opt.Name, opt.Type), nil
+}
+
+// UpdatePrivateDnsRecord 修改记录集
+// reference: https://cloud.google.com/dns/docs/reference/v1/resourceRecordSets/patch
+func (g *GcpImpl) UpdatePrivateDnsRecord(kt *kit.Kit, opt *typesdns.GcpRecordOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "gcp private dns record update option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := g.clientSet.dnsClient(kt)
+	if err != nil {
+		return err
+	}
+
+	rrset := convResourceRecordSet(opt)
+	_, err = client.ResourceRecordSets.Patch(g.CloudProjectID(), opt.ZoneName, rrset.Name, rrset.Type, rrset).
+		Context(kt.Ctx).Do()
+	if err != nil {
+		logs.Errorf("update gcp resource record set failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// DeletePrivateDnsRecord 删除记录集
+// reference: https://cloud.google.com/dns/docs/reference/v1/resourceRecordSets/delete
+func (g *GcpImpl) DeletePrivateDnsRecord(kt *kit.Kit, opt *typesdns.GcpRecordDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "gcp private dns record delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	name, recordType, err := typesdns.ParseRecordSetCloudID(opt.CloudID)
+	if err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := g.clientSet.dnsClient(kt)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ResourceRecordSets.Delete(g.CloudProjectID(), opt.ZoneName, typesdns.ToFQDN(name, opt.DnsName),
+		string(recordType)).Context(kt.Ctx).Do()
+	if err != nil {
+		logs.Errorf("delete gcp resource record set failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+func convResourceRecordSet(opt *typesdns.GcpRecordOption) *dns.ResourceRecordSet {
+	return &dns.ResourceRecordSet{
+		Name:    typesdns.ToFQDN(opt.Name, opt.DnsName),
+		Type:    string(opt.Type),
+		Ttl:     opt.GetTTL(),
+		Rrdatas: opt.Value,
+	}
+}
@@ -28,7 +28,7 @@ import (
 
 // getProject 获取项目信息(账号需要有 compute.projects.get 权限)
 // 接口参考 https://cloud.google.com/compute/docs/reference/rest/v1/projects/get
-func (g *Gcp) getProject(kt *kit.Kit) (*compute.Project, error) {
+func (g *GcpImpl) getProject(kt *kit.Kit) (*compute.Project, error) {
 	client, err := g.clientSet.computeClient(kt)
 	if err != nil {
 		logs.Errorf("init gcp client failed, err: %v, rid: %s", err, kt.Rid)
@@ -0,0 +1,159 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gcp
+
+import (
+	"hcm/pkg/adaptor/types/snapshot"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+
+	"google.golang.org/api/compute/v1"
+)
+
+// ListSnapshot 查询快照列表
+// reference: https://cloud.google.com/compute/docs/reference/rest/v1/snapshots/list
+func (g *GcpImpl) ListSnapshot(kt *kit.Kit, opt *snapshot.GcpSnapshotListOption) ([]snapshot.GcpSnapshot, string,
+	error) {
+
+	if opt == nil {
+		return nil, "", errf.New(errf.InvalidParameter, "gcp snapshot list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := g.clientSet.computeClient(kt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	request := client.Snapshots.List(g.CloudProjectID()).Context(kt.Ctx)
+
+	if len(opt.CloudIDs) > 0 {
+		request.Filter(generateResourceIDsFilter(opt.CloudIDs))
+	}
+
+	if len(opt.SelfLinks) > 0 {
+		request.Filter(generateResourceFilter("selfLink", opt.SelfLinks))
+	}
+
+	if len(opt.Names) > 0 {
+		request.Filter(generateResourceFilter("name", opt.Names))
+	}
+
+	if opt.Page != nil {
+		request.MaxResults(opt.Page.PageSize).PageToken(opt.Page.PageToken)
+	}
+
+	resp, err := request.Do()
+	if err != nil {
+		logs.Errorf("list snapshots failed, err: %v, opt: %v, rid: %s", err, opt, kt.Rid)
+		return nil, "", err
+	}
+
+	snapshots := make([]snapshot.GcpSnapshot, 0, len(resp.Items))
+	for _, one := range resp.Items {
+		snapshots = append(snapshots, snapshot.GcpSnapshot{Snapshot: one})
+	}
+
+	return snapshots, resp.NextPageToken, nil
+}
+
+// CreateSnapshot 基于磁盘创建快照，返回快照名称
+// reference: https://cloud.google.com/compute/docs/reference/rest/v1/disks/createSnapshot
+func (g *GcpImpl) CreateSnapshot(kt *kit.Kit, opt *snapshot.GcpSnapshotCreateOption) (string, error) {
+	if opt == nil {
+		return "", errf.New(errf.InvalidParameter, "gcp snapshot create option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := g.clientSet.computeClient(kt)
+	if err != nil {
+		return "", err
+	}
+
+	req := &compute.Snapshot{Name: opt.Name}
+	if opt.Description != nil {
+		req.Description = *opt.Description
+	}
+
+	_, err = client.Disks.CreateSnapshot(g.CloudProjectID(), opt.Zone, opt.DiskName, req).Context(kt.Ctx).Do()
+	if err != nil {
+		logs.Errorf("create gcp snapshot failed, err: %v, disk: %s, rid: %s", err, opt.DiskName, kt.Rid)
+		return "", err
+	}
+
+	return opt.Name, nil
+}
+
+// DeleteSnapshot 删除快照
+// reference: https://cloud.google.com/compute/docs/reference/rest/v1/snapshots/delete
+func (g *GcpImpl) DeleteSnapshot(kt *kit.Kit, opt *snapshot.GcpSnapshotDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "gcp snapshot delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := g.clientSet.computeClient(kt)
+	if err != nil {
+		return err
+	}
+
+	if _, err = client.Snapshots.Delete(g.CloudProjectID(), opt.Name).Context(kt.Ctx).Do(); err != nil {
+		logs.Errorf("delete gcp snapshot failed, err: %v, name: %s, rid: %s", err, opt.Name, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// RollbackSnapshot 通过快照创建新的磁盘
+// reference: https://cloud.google.com/compute/docs/reference/rest/v1/disks/insert
+func (g *GcpImpl) RollbackSnapshot(kt *kit.Kit, opt *snapshot.GcpSnapshotRollbackOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "gcp snapshot rollback option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := g.clientSet.computeClient(kt)
+	if err != nil {
+		return err
+	}
+
+	req := &compute.Disk{Name: opt.DiskName, SourceSnapshot: opt.SnapshotSelfLink}
+	if _, err = client.Disks.Insert(g.CloudProjectID(), opt.Zone, req).Context(kt.Ctx).Do(); err != nil {
+		logs.Errorf("create gcp disk from snapshot failed, err: %v, snapshot: %s, rid: %s", err,
+			opt.SnapshotSelfLink, kt.Rid)
+		return err
+	}
+
+	return nil
+}
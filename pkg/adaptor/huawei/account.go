@@ -34,7 +34,7 @@ import (
 
 // ListAccount list account.
 // reference: https://support.huaweicloud.com/intl/zh-cn/api-iam/iam_08_0001.html
-func (h *HuaWei) ListAccount(kt *kit.Kit) ([]typeaccount.HuaWeiAccount, error) {
+func (h *HuaWeiImpl) ListAccount(kt *kit.Kit) ([]typeaccount.HuaWeiAccount, error) {
 	client, err := h.clientSet.iamGlobalClient(region.AP_SOUTHEAST_1)
 	if err != nil {
 		logs.Errorf("new iam client failed, err: %v, rid: %s", err, kt.Rid)
@@ -70,7 +70,7 @@ func (h *HuaWei) ListAccount(kt *kit.Kit) ([]typeaccount.HuaWeiAccount, error) {
 
 // GetAccountQuota get account quota.
 // KeystoneListAuthDomains: https://support.huaweicloud.com/intl/zh-cn/api-ecs/ecs_02_0801.html
-func (h *HuaWei) GetAccountQuota(kt *kit.Kit, opt *typeaccount.GetHuaWeiAccountZoneQuotaOption) (
+func (h *HuaWeiImpl) GetAccountQuota(kt *kit.Kit, opt *typeaccount.GetHuaWeiAccountZoneQuotaOption) (
 	*typeaccount.HuaWeiAccountQuota, error) {
 
 	client, err := h.clientSet.ecsClient(opt.Region)
@@ -110,7 +110,7 @@ func (h *HuaWei) GetAccountQuota(kt *kit.Kit, opt *typeaccount.GetHuaWeiAccountZ
 // 1. https://console-intl.huaweicloud.com/apiexplorer/#/openapi/IAM/doc?api=ShowPermanentAccessKey
 // 2. https://console-intl.huaweicloud.com/apiexplorer/#/openapi/IAM/debug?api=ShowUser
 // 3. https://console-intl.huaweicloud.com/apiexplorer/#/openapi/IAM/doc?api=KeystoneListAuthDomains
-func (h *HuaWei) GetAccountInfoBySecret(kt *kit.Kit, accessKeyID string) (*cloud.HuaWeiInfoBySecret, error) {
+func (h *HuaWeiImpl) GetAccountInfoBySecret(kt *kit.Kit, accessKeyID string) (*cloud.HuaWeiInfoBySecret, error) {
 
 	client, err := h.clientSet.iamGlobalClient(region.AP_SOUTHEAST_1)
 	if err != nil {
@@ -32,7 +32,7 @@ import (
 
 // GetBillList get bill list.
 // reference: https://support.huaweicloud.com/api-oce/mbc_00003.html
-func (h *HuaWei) GetBillList(kt *kit.Kit, opt *typesBill.HuaWeiBillListOption) (
+func (h *HuaWeiImpl) GetBillList(kt *kit.Kit, opt *typesBill.HuaWeiBillListOption) (
 	*model.ListCustomerselfResourceRecordDetailsResponse, error) {
 
 	if err := opt.Validate(); err != nil {
@@ -65,7 +65,7 @@ func (h *HuaWei) GetBillList(kt *kit.Kit, opt *typesBill.HuaWeiBillListOption) (
 
 // GetFeeRecordList get fee record list.
 // reference: https://console-intl.huaweicloud.com/apiexplorer/#/openapi/BSSINTL/debug?api=ListCustomerselfResourceRecords
-func (h *HuaWei) GetFeeRecordList(kt *kit.Kit, opt *typesBill.HuaWeiFeeRecordListOption) (
+func (h *HuaWeiImpl) GetFeeRecordList(kt *kit.Kit, opt *typesBill.HuaWeiFeeRecordListOption) (
 	*model.ListCustomerselfResourceRecordsResponse, error) {
 
 	if err := opt.Validate(); err != nil {
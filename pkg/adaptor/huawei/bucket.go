@@ -0,0 +1,140 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package huawei
+
+import (
+	"errors"
+	"net/http"
+
+	typesbucket "hcm/pkg/adaptor/types/bucket"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-obs/obs"
+)
+
+// ListBucket 查询账号下的OBS存储桶，并补充存储类型、ACL、存储桶策略和默认加密配置
+// reference: https://support.huaweicloud.com/api-obs/obs_04_0020.html
+func (h *HuaWeiImpl) ListBucket(kt *kit.Kit, opt *typesbucket.HuaWeiBucketListOption) ([]typesbucket.HuaWeiBucket,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "huawei bucket list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.obsClient(opt.Region)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	resp, err := client.ListBuckets(&obs.ListBucketsInput{QueryLocation: true})
+	if err != nil {
+		logs.Errorf("list huawei bucket failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	idMap := converter.StringSliceToMap(opt.CloudIDs)
+	buckets := make([]typesbucket.HuaWeiBucket, 0, len(resp.Buckets))
+	for _, one := range resp.Buckets {
+		if _, exist := idMap[one.Name]; len(opt.CloudIDs) != 0 && !exist {
+			continue
+		}
+
+		bucket, err := h.getBucketDetail(kt, one)
+		if err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, *bucket)
+	}
+
+	return buckets, nil
+}
+
+// getBucketDetail 查询存储桶详情需要使用存储桶所在地域的终端节点，未配置策略或加密时接口返回404
+func (h *HuaWeiImpl) getBucketDetail(kt *kit.Kit, one obs.Bucket) (*typesbucket.HuaWeiBucket, error) {
+	client, err := h.clientSet.obsClient(one.Location)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	bucket := &typesbucket.HuaWeiBucket{
+		Bucket:             one,
+		ACLPublicAccess:    enumor.BucketPrivate,
+		PolicyPublicAccess: enumor.BucketPrivate,
+	}
+
+	storagePolicy, err := client.GetBucketStoragePolicy(one.Name)
+	if err != nil {
+		logs.Errorf("get huawei bucket storage policy failed, err: %v, bucket: %s, rid: %s", err, one.Name, kt.Rid)
+		return nil, err
+	}
+	bucket.StorageClass = storagePolicy.StorageClass
+
+	acl, err := client.GetBucketAcl(one.Name)
+	if err != nil {
+		logs.Errorf("get huawei bucket acl failed, err: %v, bucket: %s, rid: %s", err, one.Name, kt.Rid)
+		return nil, err
+	}
+	for _, grant := range acl.Grants {
+		if grant.Grantee.URI == obs.GroupAllUsers || grant.Grantee.URI == obs.GroupAuthenticatedUsers {
+			bucket.ACLPublicAccess = typesbucket.MergePublicAccess(bucket.ACLPublicAccess,
+				typesbucket.GrantPublicAccess(string(grant.Permission)))
+		}
+	}
+
+	policy, err := client.GetBucketPolicy(one.Name)
+	if err != nil && !isObsNotFound(err) {
+		logs.Errorf("get huawei bucket policy failed, err: %v, bucket: %s, rid: %s", err, one.Name, kt.Rid)
+		return nil, err
+	}
+	if policy != nil && len(policy.Policy) != 0 {
+		statements, err := typesbucket.ParseHuaWeiPolicy(policy.Policy)
+		if err != nil {
+			logs.Errorf("parse huawei bucket policy failed, err: %v, bucket: %s, rid: %s", err, one.Name, kt.Rid)
+			return nil, err
+		}
+		bucket.PolicyPublicAccess = typesbucket.PolicyPublicAccess(statements, typesbucket.HuaWeiAnonymousPrincipal)
+	}
+
+	encryption, err := client.GetBucketEncryption(one.Name)
+	if err != nil && !isObsNotFound(err) {
+		logs.Errorf("get huawei bucket encryption failed, err: %v, bucket: %s, rid: %s", err, one.Name, kt.Rid)
+		return nil, err
+	}
+	if encryption != nil && len(encryption.SSEAlgorithm) != 0 {
+		bucket.Encryption = &encryption.BucketEncryptionConfiguration
+	}
+
+	return bucket, nil
+}
+
+func isObsNotFound(err error) bool {
+	var obsErr obs.ObsError
+	return errors.As(err, &obsErr) && obsErr.StatusCode == http.StatusNotFound
+}
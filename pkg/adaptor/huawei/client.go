@@ -24,25 +24,38 @@ import (
 
 	"hcm/pkg/adaptor/types"
 
+	"github.com/huaweicloud/huaweicloud-sdk-go-obs/obs"
 	"github.com/huaweicloud/huaweicloud-sdk-go-v3/core/auth/basic"
 	"github.com/huaweicloud/huaweicloud-sdk-go-v3/core/auth/global"
 	"github.com/huaweicloud/huaweicloud-sdk-go-v3/core/config"
 	"github.com/huaweicloud/huaweicloud-sdk-go-v3/core/region"
 	bssintl "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/bssintl/v2"
 	bssintlv2region "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/bssintl/v2/region"
+	cce "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/cce/v3"
+	cceregion "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/cce/v3/region"
 	dcs "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/dcs/v2"
 	dcsregion "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/dcs/v2/region"
+	dns "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/dns/v2"
+	dnsregion "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/dns/v2/region"
 	ecs "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/ecs/v2"
 	ecsregion "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/ecs/v2/region"
 	eip "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/eip/v2"
 	eipregion "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/eip/v2/region"
 	eipv3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/eip/v3"
 	eipv3region "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/eip/v3/region"
+	elb "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/elb/v3"
+	elbregion "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/elb/v3/region"
 	evs "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/evs/v2"
 	evsregion "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/evs/v2/region"
 	iam "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/iam/v3"
 	iamregion "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/iam/v3/region"
 	ims "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/ims/v2"
+	kps "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/kps/v3"
+	kpsregion "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/kps/v3/region"
+	nat "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/nat/v2"
+	natregion "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/nat/v2/region"
+	rds "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3"
+	rdsregion "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/region"
 	rms "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rms/v1"
 	rmsregion "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rms/v1/region"
 	vpcv2 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/vpc/v2"
@@ -183,6 +196,64 @@ func (c *clientSet) vpcClientV2(regionID string) (cli *vpcv2.VpcClient, err erro
 	return client, nil
 }
 
+func (c *clientSet) natClient(regionID string) (cli *nat.NatClient, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("huawei error recovered, err: %v", p)
+		}
+	}()
+
+	client := nat.NewNatClient(
+		nat.NatClientBuilder().
+			WithRegion(natregion.ValueOf(regionID)).
+			WithCredential(c.credentials()).
+			WithHttpConfig(config.DefaultHttpConfig()).
+			Build())
+
+	return client, nil
+}
+
+func (c *clientSet) rdsClient(regionID string) (cli *rds.RdsClient, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("huawei error recovered, err: %v", p)
+		}
+	}()
+
+	client := rds.NewRdsClient(
+		rds.RdsClientBuilder().
+			WithRegion(rdsregion.ValueOf(regionID)).
+			WithCredential(c.credentials()).
+			WithHttpConfig(config.DefaultHttpConfig()).
+			Build())
+
+	return client, nil
+}
+
+func (c *clientSet) cceClient(regionID string) (cli *cce.CceClient, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("huawei error recovered, err: %v", p)
+		}
+	}()
+
+	client := cce.NewCceClient(
+		cce.CceClientBuilder().
+			WithRegion(cceregion.ValueOf(regionID)).
+			WithCredential(c.credentials()).
+			WithHttpConfig(config.DefaultHttpConfig()).
+			Build())
+
+	return client, nil
+}
+
+// obsClient OBS 没有集成到 huaweicloud-sdk-go-v3 中，使用独立的 OBS SDK，调用方需要关闭客户端
+func (c *clientSet) obsClient(regionID string) (*obs.ObsClient, error) {
+	credentials := c.credentials()
+	return obs.New(credentials.AK, credentials.SK, fmt.Sprintf("https://obs.%s.myhuaweicloud.com", regionID),
+		obs.WithRegion(regionID))
+}
+
 func (c *clientSet) imsClientV2(region *region.Region) (cli *ims.ImsClient, err error) {
 	defer func() {
 		if p := recover(); p != nil {
@@ -217,6 +288,23 @@ func (c *clientSet) ecsClient(regionID string) (cli *ecs.EcsClient, err error) {
 	return client, nil
 }
 
+func (c *clientSet) kpsClient(regionID string) (cli *kps.KpsClient, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("huawei error recovered, err: %v", p)
+		}
+	}()
+
+	client := kps.NewKpsClient(
+		kps.KpsClientBuilder().
+			WithRegion(kpsregion.ValueOf(regionID)).
+			WithCredential(c.credentials()).
+			WithHttpConfig(config.DefaultHttpConfig()).
+			Build())
+
+	return client, nil
+}
+
 func (c *clientSet) dcsClient(regionID string) (cli *dcs.DcsClient, err error) {
 	defer func() {
 		if p := recover(); p != nil {
@@ -234,6 +322,23 @@ func (c *clientSet) dcsClient(regionID string) (cli *dcs.DcsClient, err error) {
 	return client, nil
 }
 
+func (c *clientSet) dnsClient(regionID string) (cli *dns.DnsClient, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("huawei error recovered, err: %v", p)
+		}
+	}()
+
+	client := dns.NewDnsClient(
+		dns.DnsClientBuilder().
+			WithRegion(dnsregion.ValueOf(regionID)).
+			WithCredential(c.credentials()).
+			WithHttpConfig(config.DefaultHttpConfig()).
+			Build())
+
+	return client, nil
+}
+
 func (c *clientSet) eipClient(regionID string) (cli *eip.EipClient, err error) {
 	defer func() {
 		if p := recover(); p != nil {
@@ -268,6 +373,23 @@ func (c *clientSet) eipV3Client(regionID string) (cli *eipv3.EipClient, err erro
 	return cli, nil
 }
 
+func (c *clientSet) elbClient(regionID string) (cli *elb.ElbClient, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("huawei error recovered, err: %v", p)
+		}
+	}()
+
+	cli = elb.NewElbClient(
+		elb.ElbClientBuilder().
+			WithRegion(elbregion.ValueOf(regionID)).
+			WithCredential(c.credentials()).
+			WithHttpConfig(config.DefaultHttpConfig()).
+			Build())
+
+	return cli, nil
+}
+
 func (c *clientSet) bssintlClient(regionID string) (cli *bssintl.BssintlClient, err error) {
 	defer func() {
 		if p := recover(); p != nil {
@@ -0,0 +1,92 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package huawei
+
+import (
+	"strings"
+
+	dbinstance "hcm/pkg/adaptor/types/database-instance"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+)
+
+// ListDatabaseInstance 查询RDS实例列表
+// reference: https://support.huaweicloud.com/api-rds/rds_01_0004.html
+func (h *HuaWeiImpl) ListDatabaseInstance(kt *kit.Kit, opt *dbinstance.HuaWeiDBInstanceListOption) (
+	[]dbinstance.HuaWeiDBInstance, error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "huawei database instance list option is required")
+	}
+
+	req, err := opt.ToListInstancesRequest()
+	if err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.rdsClient(opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ListInstances(req)
+	if err != nil {
+		if strings.Contains(err.Error(), ErrDataNotFound) {
+			return make([]dbinstance.HuaWeiDBInstance, 0), nil
+		}
+		logs.Errorf("list huawei database instance failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	dbs := make([]dbinstance.HuaWeiDBInstance, 0, len(converter.PtrToVal(resp.Instances)))
+	for _, one := range converter.PtrToVal(resp.Instances) {
+		dbs = append(dbs, dbinstance.HuaWeiDBInstance{InstanceResponse: one})
+	}
+
+	return dbs, nil
+}
+
+// DeleteDatabaseInstance 删除RDS实例
+// reference: https://support.huaweicloud.com/api-rds/rds_01_0005.html
+func (h *HuaWeiImpl) DeleteDatabaseInstance(kt *kit.Kit, opt *dbinstance.HuaWeiDBInstanceDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "huawei database instance delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.rdsClient(opt.Region)
+	if err != nil {
+		return err
+	}
+
+	if _, err = client.DeleteInstance(&model.DeleteInstanceRequest{InstanceId: opt.CloudID}); err != nil {
+		logs.Errorf("delete huawei database instance failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return err
+	}
+
+	return nil
+}
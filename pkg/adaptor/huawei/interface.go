@@ -0,0 +1,159 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+//go:generate  mockgen -destination ../mock/huawei/huawei_mock.go  -package=mockhuawei -typed -source=interface.go
+
+package huawei
+
+import (
+	"hcm/pkg/adaptor/poller"
+	"hcm/pkg/adaptor/types"
+	typeaccount "hcm/pkg/adaptor/types/account"
+	typesBill "hcm/pkg/adaptor/types/bill"
+	typesbucket "hcm/pkg/adaptor/types/bucket"
+	"hcm/pkg/adaptor/types/core"
+	typecvm "hcm/pkg/adaptor/types/cvm"
+	dbinstance "hcm/pkg/adaptor/types/database-instance"
+	"hcm/pkg/adaptor/types/disk"
+	"hcm/pkg/adaptor/types/eip"
+	"hcm/pkg/adaptor/types/image"
+	typesinstancetype "hcm/pkg/adaptor/types/instance-type"
+	typesk8s "hcm/pkg/adaptor/types/k8s-cluster"
+	typekeypair "hcm/pkg/adaptor/types/key-pair"
+	typelb "hcm/pkg/adaptor/types/load-balancer"
+	natgateway "hcm/pkg/adaptor/types/nat-gateway"
+	typesniproto "hcm/pkg/adaptor/types/network-interface"
+	typesdns "hcm/pkg/adaptor/types/private-dns"
+	"hcm/pkg/adaptor/types/region"
+	"hcm/pkg/adaptor/types/route-table"
+	"hcm/pkg/adaptor/types/security-group"
+	"hcm/pkg/adaptor/types/security-group-rule"
+	"hcm/pkg/adaptor/types/snapshot"
+	"hcm/pkg/adaptor/types/subnet"
+	vpcpeering "hcm/pkg/adaptor/types/vpc-peering"
+	typeszone "hcm/pkg/adaptor/types/zone"
+	"hcm/pkg/api/core/cloud"
+	coreni "hcm/pkg/api/core/cloud/network-interface"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/kit"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/bssintl/v2/model"
+	model2 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rms/v1/model"
+	model4 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/vpc/v2/model"
+	model3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/vpc/v3/model"
+)
+
+// HuaWei adaptor interface for huawei cloud
+type HuaWei interface {
+	ListAccount(kt *kit.Kit) ([]typeaccount.HuaWeiAccount, error)
+	GetAccountQuota(kt *kit.Kit, opt *typeaccount.GetHuaWeiAccountZoneQuotaOption) (
+		*typeaccount.HuaWeiAccountQuota, error)
+	GetAccountInfoBySecret(kt *kit.Kit, accessKeyID string) (*cloud.HuaWeiInfoBySecret, error)
+	GetBillList(kt *kit.Kit, opt *typesBill.HuaWeiBillListOption) (
+		*model.ListCustomerselfResourceRecordDetailsResponse, error)
+	GetFeeRecordList(kt *kit.Kit, opt *typesBill.HuaWeiFeeRecordListOption) (
+		*model.ListCustomerselfResourceRecordsResponse, error)
+	CountAllResources(kt *kit.Kit, typ enumor.HuaWeiProviderType) (*model2.CountAllResourcesResponse, error)
+	CountSubAccountResources(kt *kit.Kit) (int32, error)
+	CountSubnetRouteTableRes(kt *kit.Kit) (int32, int32, error)
+	CountNIResources(kt *kit.Kit) (int32, error)
+	ListCvm(kt *kit.Kit, opt *typecvm.HuaWeiListOption) ([]typecvm.HuaWeiCvm, error)
+	DeleteCvm(kt *kit.Kit, opt *typecvm.HuaWeiDeleteOption) error
+	StartCvm(kt *kit.Kit, opt *typecvm.HuaWeiStartOption) error
+	StopCvm(kt *kit.Kit, opt *typecvm.HuaWeiStopOption) error
+	RebootCvm(kt *kit.Kit, opt *typecvm.HuaWeiRebootOption) error
+	ResetCvmPwd(kt *kit.Kit, opt *typecvm.HuaWeiResetPwdOption) error
+	InquiryPriceCvm(kt *kit.Kit, opt *typecvm.HuaWeiCreateOption) (*typecvm.InquiryPriceResult, error)
+	CreateCvm(kt *kit.Kit, opt *typecvm.HuaWeiCreateOption) (*poller.BaseDoneResult, error)
+	CreateDisk(kt *kit.Kit, opt *disk.HuaWeiDiskCreateOption) (*poller.BaseDoneResult, error)
+	InquiryPriceDisk(kt *kit.Kit, opt *disk.HuaWeiDiskCreateOption) (*disk.InquiryPriceResult, error)
+	ListDisk(kt *kit.Kit, opt *disk.HuaWeiDiskListOption) ([]disk.HuaWeiDisk, error)
+	DeleteDisk(kt *kit.Kit, chargeType string, opt *disk.HuaWeiDiskDeleteOption) error
+	DeletePrePaidResource(kt *kit.Kit, cloudIDs []string) error
+	AttachDisk(kt *kit.Kit, opt *disk.HuaWeiDiskAttachOption) error
+	DetachDisk(kt *kit.Kit, opt *disk.HuaWeiDiskDetachOption) error
+	ListSnapshot(kt *kit.Kit, opt *snapshot.HuaWeiSnapshotListOption) ([]snapshot.HuaWeiSnapshot, error)
+	CreateSnapshot(kt *kit.Kit, opt *snapshot.HuaWeiSnapshotCreateOption) (string, error)
+	DeleteSnapshot(kt *kit.Kit, opt *snapshot.HuaWeiSnapshotDeleteOption) error
+	RollbackSnapshot(kt *kit.Kit, opt *snapshot.HuaWeiSnapshotRollbackOption) error
+	ListKeyPair(kt *kit.Kit, opt *typekeypair.HuaWeiKeyPairListOption) ([]typekeypair.HuaWeiKeyPair, error)
+	ImportKeyPair(kt *kit.Kit, opt *typekeypair.HuaWeiKeyPairImportOption) (string, error)
+	DeleteKeyPair(kt *kit.Kit, opt *typekeypair.HuaWeiKeyPairDeleteOption) error
+	BindKeyPair(kt *kit.Kit, opt *typekeypair.HuaWeiKeyPairBindOption) error
+	UnbindKeyPair(kt *kit.Kit, opt *typekeypair.HuaWeiKeyPairBindOption) error
+	ListPrivateDnsZone(kt *kit.Kit, opt *typesdns.HuaWeiZoneListOption) ([]typesdns.HuaWeiZone, error)
+	ListPrivateDnsRecord(kt *kit.Kit, opt *typesdns.HuaWeiRecordListOption) ([]typesdns.Record, error)
+	CreatePrivateDnsRecord(kt *kit.Kit, opt *typesdns.HuaWeiRecordCreateOption) (string, error)
+	UpdatePrivateDnsRecord(kt *kit.Kit, opt *typesdns.HuaWeiRecordUpdateOption) error
+	DeletePrivateDnsRecord(kt *kit.Kit, opt *typesdns.HuaWeiRecordDeleteOption) error
+	ListDatabaseInstance(kt *kit.Kit, opt *dbinstance.HuaWeiDBInstanceListOption) ([]dbinstance.HuaWeiDBInstance, error)
+	DeleteDatabaseInstance(kt *kit.Kit, opt *dbinstance.HuaWeiDBInstanceDeleteOption) error
+	ListBucket(kt *kit.Kit, opt *typesbucket.HuaWeiBucketListOption) ([]typesbucket.HuaWeiBucket, error)
+	ListK8sCluster(kt *kit.Kit, opt *typesk8s.HuaWeiK8sClusterListOption) ([]typesk8s.HuaWeiCluster, error)
+	ListK8sNodePool(kt *kit.Kit, opt *typesk8s.HuaWeiK8sNodeListOption) ([]typesk8s.K8sNodePool, error)
+	ListK8sNode(kt *kit.Kit, opt *typesk8s.HuaWeiK8sNodeListOption) ([]typesk8s.K8sNode, error)
+	ListNatGateway(kt *kit.Kit, opt *natgateway.HuaWeiNatGatewayListOption) ([]natgateway.HuaWeiNatGateway, error)
+	ListVpcPeering(kt *kit.Kit, opt *vpcpeering.HuaWeiVpcPeeringListOption) ([]vpcpeering.HuaWeiVpcPeering, error)
+	ListEip(kt *kit.Kit, opt *eip.HuaWeiEipListOption) (*eip.HuaWeiEipListResult, error)
+	DeleteEip(kt *kit.Kit, opt *eip.HuaWeiEipDeleteOption) error
+	AssociateEip(kt *kit.Kit, opt *eip.HuaWeiEipAssociateOption) error
+	DisassociateEip(kt *kit.Kit, opt *eip.HuaWeiEipDisassociateOption) error
+	CreateEip(kt *kit.Kit, opt *eip.HuaWeiEipCreateOption) (*poller.BaseDoneResult, error)
+	ListImage(kt *kit.Kit, opt *image.HuaWeiImageListOption) (*image.HuaWeiImageListResult, error)
+	ListInstanceType(kt *kit.Kit, opt *typesinstancetype.HuaWeiInstanceTypeListOption) (
+		[]*typesinstancetype.HuaWeiInstanceType, error)
+	ListNetworkInterface(kt *kit.Kit, opt *typesniproto.HuaWeiNIListOption) (
+		*typesniproto.HuaWeiInterfaceListResult, error)
+	GetPublicIpsMapByPortIDs(kt *kit.Kit, opt *typesniproto.HuaWeiEipListOption) (map[string]*coreni.EipNetwork, error)
+	GetSecurityGroupsByPortID(kt *kit.Kit, opt *typesniproto.HuaWeiPortInfoOption) (map[string][]string, error)
+	GetSecurityGroupsByNetID(kt *kit.Kit, opt *typesniproto.HuaWeiPortInfoOption) (
+		map[string][]string, []coreni.NetVirtualIP, error)
+	GetProjectID(kt *kit.Kit, name string) (string, error)
+	ListRegion(kt *kit.Kit) ([]*region.HuaWeiRegionModel, error)
+	UpdateRouteTable(kt *kit.Kit, opt *routetable.HuaWeiRouteTableUpdateOption) error
+	DeleteRouteTable(kt *kit.Kit, opt *core.BaseRegionalDeleteOption) error
+	ListRouteTables(kt *kit.Kit, opt *routetable.HuaWeiRouteTableListOption) ([]routetable.HuaWeiRouteTable, error)
+	ListRouteTableIDs(kt *kit.Kit, opt *routetable.HuaWeiRouteTableListOption) ([]string, error)
+	GetRouteTable(kt *kit.Kit, opt *routetable.HuaWeiRouteTableGetOption) (*routetable.HuaWeiRouteTable, error)
+	CreateSecurityGroup(kt *kit.Kit, opt *securitygroup.HuaWeiCreateOption) (*model3.SecurityGroupInfo, error)
+	DeleteSecurityGroup(kt *kit.Kit, opt *securitygroup.HuaWeiDeleteOption) error
+	UpdateSecurityGroup(kt *kit.Kit, opt *securitygroup.HuaWeiUpdateOption) error
+	ListSecurityGroup(kt *kit.Kit, opt *securitygroup.HuaWeiListOption) (
+		[]securitygroup.HuaWeiSG, *model3.PageInfo, error)
+	SecurityGroupCvmAssociate(kt *kit.Kit, opt *securitygroup.HuaWeiAssociateCvmOption) error
+	SecurityGroupCvmDisassociate(kt *kit.Kit, opt *securitygroup.HuaWeiAssociateCvmOption) error
+	CreateSecurityGroupRule(kt *kit.Kit, opt *securitygrouprule.HuaWeiCreateOption) (*model3.SecurityGroupRule, error)
+	DeleteSecurityGroupRule(kt *kit.Kit, opt *securitygrouprule.HuaWeiDeleteOption) error
+	ListSecurityGroupRule(kt *kit.Kit, opt *securitygrouprule.HuaWeiListOption) (
+		[]securitygrouprule.HuaWeiSGRule, error)
+	CreateSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetCreateOption) (*adtysubnet.HuaWeiSubnet, error)
+	UpdateSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetUpdateOption) error
+	DeleteSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetDeleteOption) error
+	ListSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetListOption) (*adtysubnet.HuaWeiSubnetListResult, error)
+	ListSubnetByID(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetListByIDOption) (*adtysubnet.HuaWeiSubnetListResult, error)
+	GetSubnetIPAvailabilities(kt *kit.Kit, opt *types.HuaWeiVpcIPAvailGetOption) (*model4.NetworkIpAvailability, error)
+	CreateVpc(kt *kit.Kit, opt *types.HuaWeiVpcCreateOption) error
+	UpdateVpc(kt *kit.Kit, opt *types.HuaWeiVpcUpdateOption) error
+	DeleteVpc(kt *kit.Kit, opt *core.BaseRegionalDeleteOption) error
+	ListVpcRaw(kt *kit.Kit, opt *types.HuaWeiVpcListOption) (*model3.ListVpcsResponse, error)
+	ListVpc(kt *kit.Kit, opt *types.HuaWeiVpcListOption) (*types.HuaWeiVpcListResult, error)
+	ListZone(kt *kit.Kit, opt *typeszone.HuaWeiZoneListOption) ([]typeszone.HuaWeiZone, error)
+	ListLoadBalancer(kt *kit.Kit, opt *core.HuaWeiListOption) (*typelb.HuaWeiListResult, error)
+	DeleteLoadBalancer(kt *kit.Kit, opt *typelb.HuaWeiDeleteOption) error
+}
@@ -0,0 +1,163 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package huawei
+
+import (
+	typesk8s "hcm/pkg/adaptor/types/k8s-cluster"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/cce/v3/model"
+)
+
+// ListK8sCluster 查询CCE集群列表
+// reference: https://support.huaweicloud.com/api-cce/cce_02_0239.html
+func (h *HuaWeiImpl) ListK8sCluster(kt *kit.Kit, opt *typesk8s.HuaWeiK8sClusterListOption) ([]typesk8s.HuaWeiCluster,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "huawei k8s cluster list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.cceClient(opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ListClusters(new(model.ListClustersRequest))
+	if err != nil {
+		logs.Errorf("list huawei k8s cluster failed, err: %v, region: %s, rid: %s", err, opt.Region, kt.Rid)
+		return nil, err
+	}
+
+	clusters := make([]typesk8s.HuaWeiCluster, 0)
+	if resp.Items == nil {
+		return clusters, nil
+	}
+	for _, one := range *resp.Items {
+		clusters = append(clusters, typesk8s.HuaWeiCluster{Cluster: one})
+	}
+
+	return clusters, nil
+}
+
+// ListK8sNodePool 查询CCE集群的节点池，默认节点池不会返回
+// reference: https://support.huaweicloud.com/api-cce/cce_02_0355.html
+func (h *HuaWeiImpl) ListK8sNodePool(kt *kit.Kit, opt *typesk8s.HuaWeiK8sNodeListOption) ([]typesk8s.K8sNodePool,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "huawei k8s node list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.cceClient(opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ListNodePools(&model.ListNodePoolsRequest{ClusterId: opt.ClusterCloudID})
+	if err != nil {
+		logs.Errorf("list huawei k8s node pool failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return nil, err
+	}
+
+	pools := make([]typesk8s.K8sNodePool, 0)
+	if resp.Items == nil {
+		return pools, nil
+	}
+	for _, one := range *resp.Items {
+		if one.Metadata == nil {
+			continue
+		}
+
+		pool := typesk8s.K8sNodePool{
+			CloudID: converter.PtrToVal(one.Metadata.Uid),
+			Name:    one.Metadata.Name,
+		}
+		if one.Spec != nil {
+			if one.Spec.NodeTemplate != nil {
+				pool.InstanceType = one.Spec.NodeTemplate.Flavor
+			}
+			if one.Spec.Autoscaling != nil {
+				pool.MinSize = int64(converter.PtrToVal(one.Spec.Autoscaling.MinNodeCount))
+				pool.MaxSize = int64(converter.PtrToVal(one.Spec.Autoscaling.MaxNodeCount))
+			}
+		}
+		if one.Status != nil {
+			pool.NodeCount = int64(converter.PtrToVal(one.Status.CurrentNode))
+			if one.Status.Phase != nil {
+				pool.Status = one.Status.Phase.Value()
+			}
+		}
+		pools = append(pools, pool)
+	}
+
+	return pools, nil
+}
+
+// ListK8sNode 查询CCE集群的节点，节点的 serverId 即节点对应的云主机ID
+// reference: https://support.huaweicloud.com/api-cce/cce_02_0243.html
+func (h *HuaWeiImpl) ListK8sNode(kt *kit.Kit, opt *typesk8s.HuaWeiK8sNodeListOption) ([]typesk8s.K8sNode, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "huawei k8s node list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.cceClient(opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ListNodes(&model.ListNodesRequest{ClusterId: opt.ClusterCloudID})
+	if err != nil {
+		logs.Errorf("list huawei k8s node failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return nil, err
+	}
+
+	nodes := make([]typesk8s.K8sNode, 0)
+	if resp.Items == nil {
+		return nodes, nil
+	}
+	for _, one := range *resp.Items {
+		if one.Status == nil || one.Status.ServerId == nil {
+			continue
+		}
+
+		nodes = append(nodes, typesk8s.K8sNode{
+			CloudCvmID:      *one.Status.ServerId,
+			CloudNodePoolID: typesk8s.GetNodePoolID(one),
+		})
+	}
+
+	return nodes, nil
+}
@@ -0,0 +1,201 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package huawei
+
+import (
+	"strings"
+
+	typekeypair "hcm/pkg/adaptor/types/key-pair"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/kps/v3/model"
+)
+
+// ListKeyPair 查询密钥对列表，接口不支持按名称过滤，查询全部后按 CloudIDs 过滤
+// reference: https://support.huaweicloud.com/api-dew/ListKeypairs.html
+func (h *HuaWeiImpl) ListKeyPair(kt *kit.Kit, opt *typekeypair.HuaWeiKeyPairListOption) ([]typekeypair.HuaWeiKeyPair,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "huawei key pair list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.kpsClient(opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	idMap := converter.StringSliceToMap(opt.CloudIDs)
+	keyPairs := make([]typekeypair.HuaWeiKeyPair, 0)
+	req := &model.ListKeypairsRequest{Limit: converter.ValToPtr("100")}
+	for {
+		resp, err := client.ListKeypairs(req)
+		if err != nil {
+			if strings.Contains(err.Error(), ErrDataNotFound) {
+				return keyPairs, nil
+			}
+			logs.Errorf("list huawei key pair failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, err
+		}
+
+		for _, one := range converter.PtrToVal(resp.Keypairs) {
+			if one.Keypair == nil {
+				continue
+			}
+
+			if len(opt.CloudIDs) != 0 {
+				if _, exist := idMap[converter.PtrToVal(one.Keypair.Name)]; !exist {
+					continue
+				}
+			}
+			keyPairs = append(keyPairs, typekeypair.HuaWeiKeyPair{Keypair: one.Keypair})
+		}
+
+		if resp.PageInfo == nil || len(converter.PtrToVal(resp.PageInfo.NextMarker)) == 0 {
+			break
+		}
+		req.Marker = resp.PageInfo.NextMarker
+	}
+
+	return keyPairs, nil
+}
+
+// ImportKeyPair 导入公钥创建密钥对，华为云以密钥对名称作为唯一标识，返回密钥对名称
+// reference: https://support.huaweicloud.com/api-dew/CreateKeypair.html
+func (h *HuaWeiImpl) ImportKeyPair(kt *kit.Kit, opt *typekeypair.HuaWeiKeyPairImportOption) (string, error) {
+	if opt == nil {
+		return "", errf.New(errf.InvalidParameter, "huawei key pair import option is required")
+	}
+
+	req, err := opt.ToCreateKeypairRequest()
+	if err != nil {
+		return "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.kpsClient(opt.Region)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err = client.CreateKeypair(req); err != nil {
+		logs.Errorf("import huawei key pair failed, err: %v, name: %s, rid: %s", err, opt.Name, kt.Rid)
+		return "", err
+	}
+
+	return opt.Name, nil
+}
+
+// DeleteKeyPair 删除密钥对
+// reference: https://support.huaweicloud.com/api-dew/DeleteKeypair.html
+func (h *HuaWeiImpl) DeleteKeyPair(kt *kit.Kit, opt *typekeypair.HuaWeiKeyPairDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "huawei key pair delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.kpsClient(opt.Region)
+	if err != nil {
+		return err
+	}
+
+	if _, err = client.DeleteKeypair(&model.DeleteKeypairRequest{KeypairName: opt.CloudID}); err != nil {
+		logs.Errorf("delete huawei key pair failed, err: %v, name: %s, rid: %s", err, opt.CloudID, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// BindKeyPair 主机绑定密钥对，主机已绑定其他密钥对时需先解绑
+// reference: https://support.huaweicloud.com/api-dew/AssociateKeypair.html
+func (h *HuaWeiImpl) BindKeyPair(kt *kit.Kit, opt *typekeypair.HuaWeiKeyPairBindOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "huawei key pair bind option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	if len(opt.CloudID) == 0 {
+		return errf.New(errf.InvalidParameter, "cloud_id is required")
+	}
+
+	client, err := h.clientSet.kpsClient(opt.Region)
+	if err != nil {
+		return err
+	}
+
+	req := &model.AssociateKeypairRequest{
+		Body: &model.AssociateKeypairRequestBody{
+			KeypairName: opt.CloudID,
+			Server: &model.EcsServerInfo{
+				Id:              opt.CloudCvmID,
+				DisablePassword: opt.DisablePassword,
+			},
+		},
+	}
+	if _, err = client.AssociateKeypair(req); err != nil {
+		logs.Errorf("bind huawei key pair failed, err: %v, name: %s, cvm: %s, rid: %s", err, opt.CloudID,
+			opt.CloudCvmID, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// UnbindKeyPair 主机解绑密钥对
+// reference: https://support.huaweicloud.com/api-dew/DisassociateKeypair.html
+func (h *HuaWeiImpl) UnbindKeyPair(kt *kit.Kit, opt *typekeypair.HuaWeiKeyPairBindOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "huawei key pair unbind option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.kpsClient(opt.Region)
+	if err != nil {
+		return err
+	}
+
+	req := &model.DisassociateKeypairRequest{
+		Body: &model.DisassociateKeypairRequestBody{
+			Server: &model.DisassociateEcsServerInfo{Id: opt.CloudCvmID},
+		},
+	}
+	if _, err = client.DisassociateKeypair(req); err != nil {
+		logs.Errorf("unbind huawei key pair failed, err: %v, cvm: %s, rid: %s", err, opt.CloudCvmID, kt.Rid)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package huawei
+
+import (
+	"fmt"
+
+	"hcm/pkg/adaptor/types/core"
+	typelb "hcm/pkg/adaptor/types/load-balancer"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/elb/v3/model"
+)
+
+// ListLoadBalancer 查询独享型负载均衡列表
+// reference: https://support.huaweicloud.com/api-elb/ListLoadBalancers.html
+func (h *HuaWeiImpl) ListLoadBalancer(kt *kit.Kit, opt *core.HuaWeiListOption) (*typelb.HuaWeiListResult, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.elbClient(opt.Region)
+	if err != nil {
+		return nil, fmt.Errorf("new huawei elb client failed, region: %s, err: %v", opt.Region, err)
+	}
+
+	req := new(model.ListLoadBalancersRequest)
+	if len(opt.CloudIDs) != 0 {
+		req.Id = &opt.CloudIDs
+	}
+
+	if opt.Page != nil {
+		req.Marker = opt.Page.Marker
+		req.Limit = opt.Page.Limit
+	}
+
+	resp, err := client.ListLoadBalancers(req)
+	if err != nil {
+		logs.Errorf("list huawei load balancer failed, req: %+v, err: %v, rid: %s", req, err, kt.Rid)
+		return nil, err
+	}
+
+	result := new(typelb.HuaWeiListResult)
+	if resp.Loadbalancers == nil {
+		return result, nil
+	}
+
+	result.Details = make([]typelb.HuaWeiLoadBalancer, 0, len(*resp.Loadbalancers))
+	for _, one := range *resp.Loadbalancers {
+		result.Details = append(result.Details, typelb.HuaWeiLoadBalancer{LoadBalancer: one})
+	}
+
+	if resp.PageInfo != nil {
+		result.NextMarker = resp.PageInfo.NextMarker
+	}
+
+	return result, nil
+}
+
+// DeleteLoadBalancer 删除负载均衡
+// reference: https://support.huaweicloud.com/api-elb/DeleteLoadBalancer.html
+func (h *HuaWeiImpl) DeleteLoadBalancer(kt *kit.Kit, opt *typelb.HuaWeiDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.elbClient(opt.Region)
+	if err != nil {
+		return fmt.Errorf("new huawei elb client failed, region: %s, err: %v", opt.Region, err)
+	}
+
+	req := &model.DeleteLoadBalancerRequest{LoadbalancerId: opt.CloudID}
+	if _, err = client.DeleteLoadBalancer(req); err != nil {
+		logs.Errorf("delete huawei load balancer failed, id: %s, err: %v, rid: %s", opt.CloudID, err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package huawei
+
+import (
+	"strings"
+
+	natgateway "hcm/pkg/adaptor/types/nat-gateway"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+)
+
+// ListNatGateway 查询公网NAT网关列表
+// reference: https://support.huaweicloud.com/api-natgateway/ListNatGateways.html
+func (h *HuaWeiImpl) ListNatGateway(kt *kit.Kit, opt *natgateway.HuaWeiNatGatewayListOption) (
+	[]natgateway.HuaWeiNatGateway, error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "huawei nat gateway list option is required")
+	}
+
+	req, err := opt.ToListNatGatewaysRequest()
+	if err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.natClient(opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ListNatGateways(req)
+	if err != nil {
+		if strings.Contains(err.Error(), ErrDataNotFound) {
+			return make([]natgateway.HuaWeiNatGateway, 0), nil
+		}
+		logs.Errorf("list huawei nat gateway failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	nats := make([]natgateway.HuaWeiNatGateway, 0, len(converter.PtrToVal(resp.NatGateways)))
+	for _, one := range converter.PtrToVal(resp.NatGateways) {
+		nats = append(nats, natgateway.HuaWeiNatGateway{NatGatewayResponseBody: one})
+	}
+
+	return nats, nil
+}
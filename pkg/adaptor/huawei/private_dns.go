@@ -0,0 +1,229 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package huawei
+
+import (
+	typesdns "hcm/pkg/adaptor/types/private-dns"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/dns/v2/model"
+)
+
+// huaWeiDnsQueryLimit 云解析服务分页查询的最大数量
+const huaWeiDnsQueryLimit = 500
+
+// ListPrivateDnsZone 查询内网域名列表
+// reference: https://support.huaweicloud.com/api-dns/ListPrivateZones.html
+func (h *HuaWeiImpl) ListPrivateDnsZone(kt *kit.Kit, opt *typesdns.HuaWeiZoneListOption) ([]typesdns.HuaWeiZone,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "huawei private dns zone list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.dnsClient(opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	idMap := converter.StringSliceToMap(opt.CloudIDs)
+	zones := make([]typesdns.HuaWeiZone, 0)
+	for offset := int32(0); ; offset += huaWeiDnsQueryLimit {
+		req := &model.ListPrivateZonesRequest{
+			Type:   typesdns.HuaWeiPrivateZoneType,
+			Limit:  converter.ValToPtr(int32(huaWeiDnsQueryLimit)),
+			Offset: converter.ValToPtr(offset),
+		}
+		resp, err := client.ListPrivateZones(req)
+		if err != nil {
+			logs.Errorf("list huawei private zone failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+			return nil, err
+		}
+
+		list := converter.PtrToVal(resp.Zones)
+		for _, one := range list {
+			if _, exist := idMap[converter.PtrToVal(one.Id)]; len(opt.CloudIDs) != 0 && !exist {
+				continue
+			}
+			zones = append(zones, typesdns.HuaWeiZone{PrivateZoneResp: one})
+		}
+
+		if len(list) < huaWeiDnsQueryLimit {
+			break
+		}
+	}
+
+	return zones, nil
+}
+
+// ListPrivateDnsRecord 查询内网域名的记录集，系统默认生成的记录集不同步
+// reference: https://support.huaweicloud.com/api-dns/ListRecordSetsByZone.html
+func (h *HuaWeiImpl) ListPrivateDnsRecord(kt *kit.Kit, opt *typesdns.HuaWeiRecordListOption) ([]typesdns.Record,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "huawei private dns record list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.dnsClient(opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]typesdns.Record, 0)
+	for offset := int32(0); ; offset += huaWeiDnsQueryLimit {
+		req := &model.ListRecordSetsByZoneRequest{
+			ZoneId: opt.CloudZoneID,
+			Limit:  converter.ValToPtr(int32(huaWeiDnsQueryLimit)),
+			Offset: converter.ValToPtr(offset),
+		}
+		resp, err := client.ListRecordSetsByZone(req)
+		if err != nil {
+			logs.Errorf("list huawei record set failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+			return nil, err
+		}
+
+		list := converter.PtrToVal(resp.Recordsets)
+		for _, one := range list {
+			recordType := converter.PtrToVal(one.Type)
+			if converter.PtrToVal(one.Default) || !typesdns.IsSupportedRecordType(recordType) {
+				continue
+			}
+
+			records = append(records, typesdns.Record{
+				CloudID: converter.PtrToVal(one.Id),
+				Name:    typesdns.ToRecordName(converter.PtrToVal(one.Name), opt.ZoneName),
+				Type:    enumor.PrivateDnsRecordType(recordType),
+				Value:   converter.PtrToVal(one.Records),
+				TTL:     int64(converter.PtrToVal(one.Ttl)),
+			})
+		}
+
+		if len(list) < huaWeiDnsQueryLimit {
+			break
+		}
+	}
+
+	return records, nil
+}
+
+// CreatePrivateDnsRecord 创建记录集，返回记录集ID
+// reference: https://support.huaweicloud.com/api-dns/CreateRecordSet.html
+func (h *HuaWeiImpl) CreatePrivateDnsRecord(kt *kit.Kit, opt *typesdns.HuaWeiRecordCreateOption) (string, error) {
+	if opt == nil {
+		return "", errf.New(errf.InvalidParameter, "huawei private dns record create option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.dnsClient(opt.Region)
+	if err != nil {
+		return "", err
+	}
+
+	req := &model.CreateRecordSetRequest{
+		ZoneId: opt.CloudZoneID,
+		Body: &model.CreateRecordSetReq{
+			Name:    typesdns.ToFQDN(opt.Name, opt.ZoneName),
+			Type:    string(opt.Type),
+			Ttl:     converter.ValToPtr(int32(opt.GetTTL())),
+			Records: opt.Value,
+		},
+	}
+	resp, err := client.CreateRecordSet(req)
+	if err != nil {
+		logs.Errorf("create huawei record set failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return "", err
+	}
+
+	return converter.PtrToVal(resp.Id), nil
+}
+
+// UpdatePrivateDnsRecord 修改记录集
+// reference: https://support.huaweicloud.com/api-dns/UpdateRecordSet.html
+func (h *HuaWeiImpl) UpdatePrivateDnsRecord(kt *kit.Kit, opt *typesdns.HuaWeiRecordUpdateOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "huawei private dns record update option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.dnsClient(opt.Region)
+	if err != nil {
+		return err
+	}
+
+	req := &model.UpdateRecordSetRequest{
+		ZoneId:      opt.CloudZoneID,
+		RecordsetId: opt.CloudID,
+		Body: &model.UpdateRecordSetReq{
+			Name:    typesdns.ToFQDN(opt.Name, opt.ZoneName),
+			Type:    string(opt.Type),
+			Ttl:     converter.ValToPtr(int32(opt.GetTTL())),
+			Records: converter.ValToPtr(opt.Value),
+		},
+	}
+	if _, err = client.UpdateRecordSet(req); err != nil {
+		logs.Errorf("update huawei record set failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// DeletePrivateDnsRecord 删除记录集
+// reference: https://support.huaweicloud.com/api-dns/DeleteRecordSet.html
+func (h *HuaWeiImpl) DeletePrivateDnsRecord(kt *kit.Kit, opt *typesdns.HuaWeiRecordDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "huawei private dns record delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.dnsClient(opt.Region)
+	if err != nil {
+		return err
+	}
+
+	req := &model.DeleteRecordSetRequest{ZoneId: opt.CloudZoneID, RecordsetId: opt.CloudID}
+	if _, err = client.DeleteRecordSet(req); err != nil {
+		logs.Errorf("delete huawei record set failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return err
+	}
+
+	return nil
+}
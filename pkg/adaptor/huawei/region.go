@@ -32,7 +32,7 @@ import (
 
 // ListRegion 查看地域
 // reference: https://support.huaweicloud.com/api-iam/iam_05_0001.html
-func (h *HuaWei) ListRegion(kt *kit.Kit) ([]*region.HuaWeiRegionModel, error) {
+func (h *HuaWeiImpl) ListRegion(kt *kit.Kit) ([]*region.HuaWeiRegionModel, error) {
 	// huawei region need by resource but we can use in public
 	regions := make([]*region.HuaWeiRegionModel, 0)
 
@@ -34,7 +34,7 @@ import (
 
 // UpdateRouteTable update route table.
 // reference: https://support.huaweicloud.com/api-vpc/vpc_apiroutetab_0004.html
-func (h *HuaWei) UpdateRouteTable(kt *kit.Kit, opt *routetable.HuaWeiRouteTableUpdateOption) error {
+func (h *HuaWeiImpl) UpdateRouteTable(kt *kit.Kit, opt *routetable.HuaWeiRouteTableUpdateOption) error {
 	if err := opt.Validate(); err != nil {
 		return err
 	}
@@ -64,7 +64,7 @@ func (h *HuaWei) UpdateRouteTable(kt *kit.Kit, opt *routetable.HuaWeiRouteTableU
 
 // DeleteRouteTable delete route table.
 // reference: https://support.huaweicloud.com/api-vpc/vpc_apiroutetab_0006.html
-func (h *HuaWei) DeleteRouteTable(kt *kit.Kit, opt *core.BaseRegionalDeleteOption) error {
+func (h *HuaWeiImpl) DeleteRouteTable(kt *kit.Kit, opt *core.BaseRegionalDeleteOption) error {
 	if err := opt.Validate(); err != nil {
 		return err
 	}
@@ -89,7 +89,7 @@ func (h *HuaWei) DeleteRouteTable(kt *kit.Kit, opt *core.BaseRegionalDeleteOptio
 
 // ListRouteTables list route table ids.
 // reference: https://support.huaweicloud.com/api-vpc/vpc_apiroutetab_0001.html
-func (h *HuaWei) ListRouteTables(kt *kit.Kit, opt *routetable.HuaWeiRouteTableListOption) ([]routetable.HuaWeiRouteTable, error) {
+func (h *HuaWeiImpl) ListRouteTables(kt *kit.Kit, opt *routetable.HuaWeiRouteTableListOption) ([]routetable.HuaWeiRouteTable, error) {
 	if err := opt.Validate(); err != nil {
 		return nil, err
 	}
@@ -150,7 +150,7 @@ func (h *HuaWei) ListRouteTables(kt *kit.Kit, opt *routetable.HuaWeiRouteTableLi
 
 // ListRouteTableIDs list route table ids.
 // reference: https://support.huaweicloud.com/api-vpc/vpc_apiroutetab_0001.html
-func (h *HuaWei) ListRouteTableIDs(kt *kit.Kit, opt *routetable.HuaWeiRouteTableListOption) ([]string, error) {
+func (h *HuaWeiImpl) ListRouteTableIDs(kt *kit.Kit, opt *routetable.HuaWeiRouteTableListOption) ([]string, error) {
 	if err := opt.Validate(); err != nil {
 		return nil, err
 	}
@@ -200,7 +200,7 @@ func (h *HuaWei) ListRouteTableIDs(kt *kit.Kit, opt *routetable.HuaWeiRouteTable
 
 // GetRouteTable get route table.
 // reference: https://support.huaweicloud.com/api-vpc/vpc_apiroutetab_0002.html
-func (h *HuaWei) GetRouteTable(kt *kit.Kit, opt *routetable.HuaWeiRouteTableGetOption) (*routetable.HuaWeiRouteTable,
+func (h *HuaWeiImpl) GetRouteTable(kt *kit.Kit, opt *routetable.HuaWeiRouteTableGetOption) (*routetable.HuaWeiRouteTable,
 	error) {
 
 	if err := opt.Validate(); err != nil {
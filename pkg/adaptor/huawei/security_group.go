@@ -36,7 +36,7 @@ import (
 
 // CreateSecurityGroup create security group.
 // reference: https://support.huaweicloud.com/api-vpc/vpc_apiv3_0010.html
-func (h *HuaWei) CreateSecurityGroup(kt *kit.Kit, opt *securitygroup.HuaWeiCreateOption) (
+func (h *HuaWeiImpl) CreateSecurityGroup(kt *kit.Kit, opt *securitygroup.HuaWeiCreateOption) (
 	*model.SecurityGroupInfo, error) {
 
 	if opt == nil {
@@ -75,7 +75,7 @@ func (h *HuaWei) CreateSecurityGroup(kt *kit.Kit, opt *securitygroup.HuaWeiCreat
 
 // DeleteSecurityGroup delete security group.
 // reference: https://support.huaweicloud.com/api-vpc/vpc_apiv3_0014.html
-func (h *HuaWei) DeleteSecurityGroup(kt *kit.Kit, opt *securitygroup.HuaWeiDeleteOption) error {
+func (h *HuaWeiImpl) DeleteSecurityGroup(kt *kit.Kit, opt *securitygroup.HuaWeiDeleteOption) error {
 
 	if opt == nil {
 		return errf.New(errf.InvalidParameter, "security group delete option is required")
@@ -104,7 +104,7 @@ func (h *HuaWei) DeleteSecurityGroup(kt *kit.Kit, opt *securitygroup.HuaWeiDelet
 
 // UpdateSecurityGroup update security group.
 // reference: https://support.huaweicloud.com/api-vpc/vpc_apiv3_0013.html
-func (h *HuaWei) UpdateSecurityGroup(kt *kit.Kit, opt *securitygroup.HuaWeiUpdateOption) error {
+func (h *HuaWeiImpl) UpdateSecurityGroup(kt *kit.Kit, opt *securitygroup.HuaWeiUpdateOption) error {
 
 	if opt == nil {
 		return errf.New(errf.InvalidParameter, "security group update option is required")
@@ -143,7 +143,7 @@ func (h *HuaWei) UpdateSecurityGroup(kt *kit.Kit, opt *securitygroup.HuaWeiUpdat
 
 // ListSecurityGroup list security group.
 // reference: https://support.huaweicloud.com/api-vpc/vpc_apiv3_0011.html
-func (h *HuaWei) ListSecurityGroup(kt *kit.Kit, opt *securitygroup.HuaWeiListOption) ([]securitygroup.HuaWeiSG,
+func (h *HuaWeiImpl) ListSecurityGroup(kt *kit.Kit, opt *securitygroup.HuaWeiListOption) ([]securitygroup.HuaWeiSG,
 	*model.PageInfo, error) {
 
 	if opt == nil {
@@ -188,7 +188,7 @@ func (h *HuaWei) ListSecurityGroup(kt *kit.Kit, opt *securitygroup.HuaWeiListOpt
 
 // SecurityGroupCvmAssociate associate cvm.
 // reference: https://support.huaweicloud.com/api-ecs/ecs_03_0601.html
-func (h *HuaWei) SecurityGroupCvmAssociate(kt *kit.Kit, opt *securitygroup.HuaWeiAssociateCvmOption) error {
+func (h *HuaWeiImpl) SecurityGroupCvmAssociate(kt *kit.Kit, opt *securitygroup.HuaWeiAssociateCvmOption) error {
 
 	if opt == nil {
 		return errf.New(errf.InvalidParameter, "associate option is required")
@@ -222,7 +222,7 @@ func (h *HuaWei) SecurityGroupCvmAssociate(kt *kit.Kit, opt *securitygroup.HuaWe
 
 // SecurityGroupCvmDisassociate disassociate cvm.
 // reference: https://support.huaweicloud.com/api-ecs/ecs_03_0601.html
-func (h *HuaWei) SecurityGroupCvmDisassociate(kt *kit.Kit, opt *securitygroup.HuaWeiAssociateCvmOption) error {
+func (h *HuaWeiImpl) SecurityGroupCvmDisassociate(kt *kit.Kit, opt *securitygroup.HuaWeiAssociateCvmOption) error {
 
 	if opt == nil {
 		return errf.New(errf.InvalidParameter, "disassociate option is required")
@@ -0,0 +1,148 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package huawei
+
+import (
+	"strings"
+
+	"hcm/pkg/adaptor/types/snapshot"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/evs/v2/model"
+)
+
+// ListSnapshot 查询快照列表
+// reference: https://support.huaweicloud.com/api-evs/evs_04_2056.html
+func (h *HuaWeiImpl) ListSnapshot(kt *kit.Kit, opt *snapshot.HuaWeiSnapshotListOption) ([]snapshot.HuaWeiSnapshot,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "huawei snapshot list option is required")
+	}
+
+	req, err := opt.ToListSnapshotsRequest()
+	if err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.evsClient(opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ListSnapshots(req)
+	if err != nil {
+		if strings.Contains(err.Error(), ErrDataNotFound) {
+			return make([]snapshot.HuaWeiSnapshot, 0), nil
+		}
+		logs.Errorf("list huawei snapshot failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	snapshots := make([]snapshot.HuaWeiSnapshot, 0, len(converter.PtrToVal(resp.Snapshots)))
+	for _, one := range converter.PtrToVal(resp.Snapshots) {
+		snapshots = append(snapshots, snapshot.HuaWeiSnapshot{SnapshotList: one})
+	}
+
+	return snapshots, nil
+}
+
+// CreateSnapshot 创建快照，返回快照ID
+// reference: https://support.huaweicloud.com/api-evs/evs_04_2053.html
+func (h *HuaWeiImpl) CreateSnapshot(kt *kit.Kit, opt *snapshot.HuaWeiSnapshotCreateOption) (string, error) {
+	if opt == nil {
+		return "", errf.New(errf.InvalidParameter, "huawei snapshot create option is required")
+	}
+
+	req, err := opt.ToCreateSnapshotRequest()
+	if err != nil {
+		return "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.evsClient(opt.Region)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.CreateSnapshot(req)
+	if err != nil {
+		logs.Errorf("create huawei snapshot failed, err: %v, disk: %s, rid: %s", err, opt.CloudDiskID, kt.Rid)
+		return "", err
+	}
+
+	if resp.Snapshot == nil {
+		return "", errf.New(errf.Unknown, "create huawei snapshot return empty snapshot")
+	}
+
+	return converter.PtrToVal(resp.Snapshot.Id), nil
+}
+
+// DeleteSnapshot 删除快照
+// reference: https://support.huaweicloud.com/api-evs/evs_04_2054.html
+func (h *HuaWeiImpl) DeleteSnapshot(kt *kit.Kit, opt *snapshot.HuaWeiSnapshotDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "huawei snapshot delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.evsClient(opt.Region)
+	if err != nil {
+		return err
+	}
+
+	if _, err = client.DeleteSnapshot(&model.DeleteSnapshotRequest{SnapshotId: opt.CloudID}); err != nil {
+		logs.Errorf("delete huawei snapshot failed, err: %v, id: %s, rid: %s", err, opt.CloudID, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// RollbackSnapshot 回滚快照到云硬盘
+// reference: https://support.huaweicloud.com/api-evs/evs_04_2058.html
+func (h *HuaWeiImpl) RollbackSnapshot(kt *kit.Kit, opt *snapshot.HuaWeiSnapshotRollbackOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "huawei snapshot rollback option is required")
+	}
+
+	req, err := opt.ToRollbackSnapshotRequest()
+	if err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.evsClient(opt.Region)
+	if err != nil {
+		return err
+	}
+
+	if _, err = client.RollbackSnapshot(req); err != nil {
+		logs.Errorf("rollback huawei snapshot failed, err: %v, snapshot: %s, disk: %s, rid: %s", err,
+			opt.CloudSnapshotID, opt.CloudDiskID, kt.Rid)
+		return err
+	}
+
+	return nil
+}
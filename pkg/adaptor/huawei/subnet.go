@@ -37,7 +37,7 @@ import (
 
 // CreateSubnet create subnet.
 // reference: https://support.huaweicloud.com/intl/zh-cn/api-vpc/vpc_subnet01_0001.html
-func (h *HuaWei) CreateSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetCreateOption) (*adtysubnet.HuaWeiSubnet, error) {
+func (h *HuaWeiImpl) CreateSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetCreateOption) (*adtysubnet.HuaWeiSubnet, error) {
 	if err := opt.Validate(); err != nil {
 		return nil, err
 	}
@@ -76,7 +76,7 @@ func (h *HuaWei) CreateSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetCreateOpt
 		opt.Extension.Region,
 		converter.ValToPtr(resp.Subnet.VpcId),
 	}
-	respPoller := poller.Poller[*HuaWei, []model.Subnet, []*adtysubnet.HuaWeiSubnet]{Handler: handler}
+	respPoller := poller.Poller[*HuaWeiImpl, []model.Subnet, []*adtysubnet.HuaWeiSubnet]{Handler: handler}
 	results, err := respPoller.PollUntilDone(h, kt, []*string{converter.ValToPtr(resp.Subnet.Id)},
 		types.NewBatchCreateSubnetPollerOption())
 	if err != nil {
@@ -92,7 +92,7 @@ func (h *HuaWei) CreateSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetCreateOpt
 
 // UpdateSubnet update subnet.
 // reference: https://support.huaweicloud.com/intl/zh-cn/api-vpc/vpc_subnet01_0004.html
-func (h *HuaWei) UpdateSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetUpdateOption) error {
+func (h *HuaWeiImpl) UpdateSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetUpdateOption) error {
 	if err := opt.Validate(); err != nil {
 		return err
 	}
@@ -124,7 +124,7 @@ func (h *HuaWei) UpdateSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetUpdateOpt
 
 // DeleteSubnet delete subnet.
 // reference: https://support.huaweicloud.com/intl/zh-cn/api-vpc/vpc_subnet01_0005.html
-func (h *HuaWei) DeleteSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetDeleteOption) error {
+func (h *HuaWeiImpl) DeleteSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetDeleteOption) error {
 	if err := opt.Validate(); err != nil {
 		return err
 	}
@@ -150,7 +150,7 @@ func (h *HuaWei) DeleteSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetDeleteOpt
 
 // ListSubnet list subnet.
 // reference: https://support.huaweicloud.com/intl/zh-cn/api-vpc/vpc_subnet01_0003.html
-func (h *HuaWei) ListSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetListOption) (*adtysubnet.HuaWeiSubnetListResult,
+func (h *HuaWeiImpl) ListSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetListOption) (*adtysubnet.HuaWeiSubnetListResult,
 	error) {
 	if err := opt.Validate(); err != nil {
 		return nil, err
@@ -193,7 +193,7 @@ func (h *HuaWei) ListSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetListOption)
 
 // ListSubnetByID list subnet by id.
 // reference: https://support.huaweicloud.com/intl/zh-cn/api-vpc/vpc_subnet01_0003.html
-func (h *HuaWei) ListSubnetByID(kt *kit.Kit,
+func (h *HuaWeiImpl) ListSubnetByID(kt *kit.Kit,
 	opt *adtysubnet.HuaWeiSubnetListByIDOption) (*adtysubnet.HuaWeiSubnetListResult,
 	error) {
 
@@ -295,7 +295,7 @@ func convertSubnet(data *model.Subnet, region string) *adtysubnet.HuaWeiSubnet {
 
 // GetSubnetIPAvailabilities get subnet ip availabilities.
 // reference: https://support.huaweicloud.com/intl/zh-cn/api-vpc/vpc_natworkip_0001.html
-func (h *HuaWei) GetSubnetIPAvailabilities(kt *kit.Kit, opt *types.HuaWeiVpcIPAvailGetOption) (
+func (h *HuaWeiImpl) GetSubnetIPAvailabilities(kt *kit.Kit, opt *types.HuaWeiVpcIPAvailGetOption) (
 	*model.NetworkIpAvailability, error) {
 
 	if err := opt.Validate(); err != nil {
@@ -341,7 +341,7 @@ func (h *createSubnetPollingHandler) Done(subnets []model.Subnet) (bool, *[]*adt
 }
 
 // Poll ...
-func (h *createSubnetPollingHandler) Poll(client *HuaWei, kt *kit.Kit, cloudIDs []*string) ([]model.Subnet, error) {
+func (h *createSubnetPollingHandler) Poll(client *HuaWeiImpl, kt *kit.Kit, cloudIDs []*string) ([]model.Subnet, error) {
 	cloudIDSplit := slice.Split(cloudIDs, core.HuaWeiQueryLimit)
 
 	subnets := make([]model.Subnet, 0, len(cloudIDs))
@@ -0,0 +1,66 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package huawei
+
+import (
+	"strings"
+
+	vpcpeering "hcm/pkg/adaptor/types/vpc-peering"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+)
+
+// ListVpcPeering 查询对等连接列表
+// reference: https://support.huaweicloud.com/api-vpc/vpc_peering_0001.html
+func (h *HuaWeiImpl) ListVpcPeering(kt *kit.Kit, opt *vpcpeering.HuaWeiVpcPeeringListOption) (
+	[]vpcpeering.HuaWeiVpcPeering, error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "huawei vpc peering list option is required")
+	}
+
+	req, err := opt.ToListVpcPeeringsRequest()
+	if err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := h.clientSet.vpcClientV2(opt.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ListVpcPeerings(req)
+	if err != nil {
+		if strings.Contains(err.Error(), ErrDataNotFound) {
+			return make([]vpcpeering.HuaWeiVpcPeering, 0), nil
+		}
+		logs.Errorf("list huawei vpc peering failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	peerings := make([]vpcpeering.HuaWeiVpcPeering, 0, len(converter.PtrToVal(resp.Peerings)))
+	for _, one := range converter.PtrToVal(resp.Peerings) {
+		peerings = append(peerings, vpcpeering.HuaWeiVpcPeering{VpcPeering: one})
+	}
+
+	return peerings, nil
+}
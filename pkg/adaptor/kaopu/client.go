@@ -0,0 +1,174 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package kaopu
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"hcm/pkg/adaptor/types"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/uuid"
+)
+
+const (
+	// defaultEndpoint kaopu cloud api 默认地址
+	defaultEndpoint = "https://api.kaopuyun.com"
+	// apiVersion kaopu cloud api 版本
+	apiVersion = "2023-06-01"
+	// signatureMethod kaopu cloud api 签名算法
+	signatureMethod = "HMAC-SHA256"
+
+	// ErrNotFound kaopu resource not found code
+	ErrNotFound = "InvalidResource.NotFound"
+)
+
+// client kaopu cloud api client, 靠谱云没有官方 go sdk，所以这里直接通过 http 调用。
+type client struct {
+	secretID  string
+	secretKey string
+	endpoint  string
+	httpCli   *http.Client
+}
+
+func newClient(s *types.BaseSecret) *client {
+	endpoint := defaultEndpoint
+	if len(s.Endpoint) != 0 {
+		endpoint = s.Endpoint
+	}
+
+	return &client{
+		secretID:  s.CloudSecretID,
+		secretKey: s.CloudSecretKey,
+		endpoint:  endpoint,
+		httpCli:   &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// baseResponse kaopu cloud api 通用返回字段，失败时 Code 和 Message 有值。
+type baseResponse struct {
+	RequestID string `json:"RequestId"`
+	Code      string `json:"Code"`
+	Message   string `json:"Message"`
+}
+
+// call 调用 kaopu cloud api，params 为业务参数，返回结果解析到 result 中。
+func (c *client) call(kt *kit.Kit, action string, params url.Values, result interface{}) error {
+	query := url.Values{}
+	for key, values := range params {
+		query[key] = values
+	}
+	query.Set("Action", action)
+	query.Set("Version", apiVersion)
+	query.Set("AccessKeyId", c.secretID)
+	query.Set("Timestamp", time.Now().UTC().Format("2006-01-02T15:04:05Z"))
+	query.Set("SignatureMethod", signatureMethod)
+	query.Set("SignatureNonce", uuid.UUID())
+	query.Set("Signature", c.sign(http.MethodGet, query))
+
+	reqURL := strings.TrimSuffix(c.endpoint, "/") + "/?" + query.Encode()
+	httpReq, err := http.NewRequestWithContext(kt.Ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("new kaopu %s request failed, err: %v", action, err)
+	}
+
+	resp, err := c.httpCli.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("call kaopu %s failed, err: %v", action, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read kaopu %s response failed, err: %v", action, err)
+	}
+
+	base := new(baseResponse)
+	if err = json.Unmarshal(respBody, base); err != nil {
+		logs.Errorf("unmarshal kaopu %s response failed, status: %d, body: %s, rid: %s", action,
+			resp.StatusCode, respBody, kt.Rid)
+		return fmt.Errorf("unmarshal kaopu %s response failed, err: %v", action, err)
+	}
+
+	if len(base.Code) != 0 || resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("kaopu %s failed, code: %s, message: %s, requestId: %s", action, base.Code,
+			base.Message, base.RequestID)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	if err = json.Unmarshal(respBody, result); err != nil {
+		return fmt.Errorf("unmarshal kaopu %s result failed, err: %v", action, err)
+	}
+
+	return nil
+}
+
+// sign 按参数名排序后拼接待签名串，使用 HMAC-SHA256 计算签名并 base64 编码。
+func (c *client) sign(method string, query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, percentEncode(key)+"="+percentEncode(query.Get(key)))
+	}
+
+	stringToSign := method + "&" + percentEncode("/") + "&" + percentEncode(strings.Join(pairs, "&"))
+	mac := hmac.New(sha256.New, []byte(c.secretKey+"&"))
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func percentEncode(s string) string {
+	encoded := url.QueryEscape(s)
+	encoded = strings.ReplaceAll(encoded, "+", "%20")
+	encoded = strings.ReplaceAll(encoded, "*", "%2A")
+	return strings.ReplaceAll(encoded, "%7E", "~")
+}
+
+// pageParams 生成分页及资源 id 过滤参数，靠谱云的 id 列表参数以 json 数组形式传递。
+func pageParams(region string, idKey string, cloudIDs []string, pageNumber, pageSize int64) url.Values {
+	params := url.Values{}
+	if len(region) != 0 {
+		params.Set("RegionId", region)
+	}
+	params.Set("PageNumber", fmt.Sprintf("%d", pageNumber))
+	params.Set("PageSize", fmt.Sprintf("%d", pageSize))
+	if len(cloudIDs) != 0 {
+		ids, _ := json.Marshal(cloudIDs)
+		params.Set(idKey, string(ids))
+	}
+	return params
+}
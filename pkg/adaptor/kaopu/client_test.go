@@ -0,0 +1,157 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package kaopu
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"testing"
+
+	"hcm/pkg/adaptor/types"
+	"hcm/pkg/adaptor/types/core"
+	"hcm/pkg/kit"
+)
+
+const (
+	testSecretID  = "test-secret-id"
+	testSecretKey = "test-secret-key"
+)
+
+// newFakeServer 模拟 kaopu cloud api，按 HMAC-SHA256 规则校验签名，校验通过后返回 handler 的结果
+func newFakeServer(t *testing.T, handler func(query url.Values) string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		signature := query.Get("Signature")
+		query.Del("Signature")
+
+		keys := make([]string, 0, len(query))
+		for key := range query {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		pairs := make([]string, 0, len(keys))
+		for _, key := range keys {
+			pairs = append(pairs, rfc3986Escape(key)+"="+rfc3986Escape(query.Get(key)))
+		}
+		stringToSign := "GET&%2F&" + rfc3986Escape(strings.Join(pairs, "&"))
+		mac := hmac.New(sha256.New, []byte(testSecretKey+"&"))
+		mac.Write([]byte(stringToSign))
+
+		if signature != base64.StdEncoding.EncodeToString(mac.Sum(nil)) {
+			fmt.Fprint(w, `{"RequestId":"r1","Code":"SignatureDoesNotMatch","Message":"signature mismatch"}`)
+			return
+		}
+		if query.Get("AccessKeyId") != testSecretID || query.Get("Version") != apiVersion {
+			t.Errorf("unexpected common params: %v", query)
+		}
+
+		fmt.Fprint(w, handler(query))
+	}))
+}
+
+// rfc3986Escape 按 RFC 3986 编码，除字母、数字和 -_.~ 外全部编码
+func rfc3986Escape(s string) string {
+	var b strings.Builder
+	for _, c := range []byte(s) {
+		isAlnum := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+		if isAlnum || strings.IndexByte("-_.~", c) >= 0 {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func TestListRegion(t *testing.T) {
+	server := newFakeServer(t, func(query url.Values) string {
+		if query.Get("Action") != "DescribeRegions" {
+			return `{"RequestId":"r1","Code":"InvalidAction","Message":"unknown action"}`
+		}
+		return `{"RequestId":"r1","Regions":[{"RegionId":"cn-beijing","LocalName":"北京"}]}`
+	})
+	defer server.Close()
+
+	cli, err := NewKaopu(&types.BaseSecret{CloudSecretID: testSecretID, CloudSecretKey: testSecretKey,
+		Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("new kaopu failed, err: %v", err)
+	}
+
+	result, err := cli.ListRegion(kit.New())
+	if err != nil {
+		t.Fatalf("list region failed, err: %v", err)
+	}
+	if len(result.Details) != 1 || result.Details[0].RegionID != "cn-beijing" {
+		t.Errorf("unexpected regions: %+v", result.Details)
+	}
+}
+
+func TestListVpcParams(t *testing.T) {
+	server := newFakeServer(t, func(query url.Values) string {
+		if query.Get("RegionId") != "cn-beijing" || query.Get("VpcIds") != `["vpc-1","vpc-2"]` ||
+			query.Get("PageNumber") != "1" || query.Get("PageSize") != "50" {
+			return `{"RequestId":"r1","Code":"InvalidParameter","Message":"unexpected params"}`
+		}
+		return `{"RequestId":"r1","Vpcs":[{"VpcId":"vpc-1","RegionId":"cn-beijing","CidrBlock":"10.0.0.0/16"}]}`
+	})
+	defer server.Close()
+
+	cli, err := NewKaopu(&types.BaseSecret{CloudSecretID: testSecretID, CloudSecretKey: testSecretKey,
+		Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("new kaopu failed, err: %v", err)
+	}
+
+	opt := &core.KaopuListOption{Region: "cn-beijing", CloudIDs: []string{"vpc-1", "vpc-2"},
+		Page: &core.KaopuPage{PageNumber: 1, PageSize: 50}}
+	result, err := cli.ListVpc(kit.New(), opt)
+	if err != nil {
+		t.Fatalf("list vpc failed, err: %v", err)
+	}
+	if len(result.Details) != 1 || result.Details[0].CloudID != "vpc-1" {
+		t.Errorf("unexpected vpcs: %+v", result.Details)
+	}
+}
+
+func TestCallWithWrongSecret(t *testing.T) {
+	server := newFakeServer(t, func(query url.Values) string {
+		return `{"RequestId":"r1"}`
+	})
+	defer server.Close()
+
+	cli, err := NewKaopu(&types.BaseSecret{CloudSecretID: testSecretID, CloudSecretKey: "wrong-key",
+		Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("new kaopu failed, err: %v", err)
+	}
+
+	_, err = cli.ListRegion(kit.New())
+	if err == nil || !strings.Contains(err.Error(), "SignatureDoesNotMatch") {
+		t.Errorf("expect signature mismatch error, got: %v", err)
+	}
+}
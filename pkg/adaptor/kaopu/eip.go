@@ -0,0 +1,52 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package kaopu
+
+import (
+	"fmt"
+
+	"hcm/pkg/adaptor/types/core"
+	"hcm/pkg/adaptor/types/eip"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+)
+
+// ListEip 查询弹性公网ip列表
+// reference: 靠谱云 OpenAPI DescribeEipAddresses 接口文档
+func (k *KaopuImpl) ListEip(kt *kit.Kit, opt *core.KaopuListOption) ([]eip.KaopuEip, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "kaopu eip list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	params := pageParams(opt.Region, "AllocationIds", opt.CloudIDs, opt.Page.PageNumber, opt.Page.PageSize)
+	resp := new(struct {
+		EipAddresses []eip.KaopuEip `json:"EipAddresses"`
+	})
+	if err := k.client.call(kt, "DescribeEipAddresses", params, resp); err != nil {
+		logs.Errorf("list kaopu eip failed, err: %v, region: %s, rid: %s", err, opt.Region, kt.Rid)
+		return nil, fmt.Errorf("list kaopu eip failed, err: %v", err)
+	}
+
+	return resp.EipAddresses, nil
+}
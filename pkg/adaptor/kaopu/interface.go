@@ -0,0 +1,40 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package kaopu
+
+import (
+	"hcm/pkg/adaptor/types"
+	"hcm/pkg/adaptor/types/core"
+	typecvm "hcm/pkg/adaptor/types/cvm"
+	"hcm/pkg/adaptor/types/eip"
+	"hcm/pkg/adaptor/types/region"
+	"hcm/pkg/adaptor/types/subnet"
+	typeszone "hcm/pkg/adaptor/types/zone"
+	"hcm/pkg/kit"
+)
+
+// Kaopu adaptor interface for kaopu
+type Kaopu interface {
+	ListRegion(kt *kit.Kit) (*region.KaopuRegionListResult, error)
+	ListZone(kt *kit.Kit, opt *typeszone.KaopuZoneListOption) ([]typeszone.KaopuZone, error)
+	ListVpc(kt *kit.Kit, opt *core.KaopuListOption) (*types.KaopuVpcListResult, error)
+	ListSubnet(kt *kit.Kit, opt *core.KaopuListOption) (*adtysubnet.KaopuSubnetListResult, error)
+	ListCvm(kt *kit.Kit, opt *core.KaopuListOption) ([]typecvm.KaopuCvm, error)
+	ListEip(kt *kit.Kit, opt *core.KaopuListOption) ([]eip.KaopuEip, error)
+}
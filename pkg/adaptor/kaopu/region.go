@@ -0,0 +1,42 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package kaopu
+
+import (
+	"fmt"
+	"net/url"
+
+	"hcm/pkg/adaptor/types/region"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+)
+
+// ListRegion 查询地域列表
+// reference: 靠谱云 OpenAPI DescribeRegions 接口文档
+func (k *KaopuImpl) ListRegion(kt *kit.Kit) (*region.KaopuRegionListResult, error) {
+	resp := new(struct {
+		Regions []region.KaopuRegion `json:"Regions"`
+	})
+	if err := k.client.call(kt, "DescribeRegions", url.Values{}, resp); err != nil {
+		logs.Errorf("list kaopu region failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, fmt.Errorf("list kaopu region failed, err: %v", err)
+	}
+
+	return &region.KaopuRegionListResult{Details: resp.Regions}, nil
+}
@@ -0,0 +1,95 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package kaopu
+
+import (
+	"fmt"
+
+	"hcm/pkg/adaptor/types/core"
+	adtysubnet "hcm/pkg/adaptor/types/subnet"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+)
+
+// kaopuSubnet kaopu DescribeSubnets 返回的子网结构。
+type kaopuSubnet struct {
+	SubnetID      string `json:"SubnetId"`
+	SubnetName    string `json:"SubnetName"`
+	VpcID         string `json:"VpcId"`
+	ZoneID        string `json:"ZoneId"`
+	CidrBlock     string `json:"CidrBlock"`
+	Ipv6CidrBlock string `json:"Ipv6CidrBlock"`
+	GatewayIP     string `json:"GatewayIp"`
+	Status        string `json:"Status"`
+	Description   string `json:"Description"`
+}
+
+// ListSubnet 查询子网列表
+// reference: 靠谱云 OpenAPI DescribeSubnets 接口文档
+func (k *KaopuImpl) ListSubnet(kt *kit.Kit, opt *core.KaopuListOption) (*adtysubnet.KaopuSubnetListResult, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "kaopu subnet list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	params := pageParams(opt.Region, "SubnetIds", opt.CloudIDs, opt.Page.PageNumber, opt.Page.PageSize)
+	resp := new(struct {
+		Subnets []kaopuSubnet `json:"Subnets"`
+	})
+	if err := k.client.call(kt, "DescribeSubnets", params, resp); err != nil {
+		logs.Errorf("list kaopu subnet failed, err: %v, region: %s, rid: %s", err, opt.Region, kt.Rid)
+		return nil, fmt.Errorf("list kaopu subnet failed, err: %v", err)
+	}
+
+	details := make([]adtysubnet.KaopuSubnet, 0, len(resp.Subnets))
+	for _, one := range resp.Subnets {
+		details = append(details, convertSubnet(one, opt.Region))
+	}
+
+	return &adtysubnet.KaopuSubnetListResult{Details: details}, nil
+}
+
+func convertSubnet(data kaopuSubnet, region string) adtysubnet.KaopuSubnet {
+	subnet := adtysubnet.KaopuSubnet{
+		CloudVpcID: data.VpcID,
+		CloudID:    data.SubnetID,
+		Name:       data.SubnetName,
+		Region:     region,
+		Memo:       &data.Description,
+		Extension: &adtysubnet.KaopuSubnetExtension{
+			Zone:      data.ZoneID,
+			Status:    data.Status,
+			GatewayIp: data.GatewayIP,
+		},
+	}
+
+	if len(data.CidrBlock) != 0 {
+		subnet.Ipv4Cidr = []string{data.CidrBlock}
+	}
+
+	if len(data.Ipv6CidrBlock) != 0 {
+		subnet.Ipv6Cidr = []string{data.Ipv6CidrBlock}
+	}
+
+	return subnet
+}
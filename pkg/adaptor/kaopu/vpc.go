@@ -0,0 +1,93 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package kaopu
+
+import (
+	"fmt"
+
+	"hcm/pkg/adaptor/types"
+	"hcm/pkg/adaptor/types/core"
+	"hcm/pkg/api/core/cloud"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+)
+
+// kaopuVpc kaopu DescribeVpcs 返回的 vpc 结构。
+type kaopuVpc struct {
+	VpcID         string `json:"VpcId"`
+	VpcName       string `json:"VpcName"`
+	RegionID      string `json:"RegionId"`
+	CidrBlock     string `json:"CidrBlock"`
+	Ipv6CidrBlock string `json:"Ipv6CidrBlock"`
+	Status        string `json:"Status"`
+	Description   string `json:"Description"`
+}
+
+// ListVpc 查询 vpc 列表
+// reference: 靠谱云 OpenAPI DescribeVpcs 接口文档
+func (k *KaopuImpl) ListVpc(kt *kit.Kit, opt *core.KaopuListOption) (*types.KaopuVpcListResult, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "kaopu vpc list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	params := pageParams(opt.Region, "VpcIds", opt.CloudIDs, opt.Page.PageNumber, opt.Page.PageSize)
+	resp := new(struct {
+		Vpcs []kaopuVpc `json:"Vpcs"`
+	})
+	if err := k.client.call(kt, "DescribeVpcs", params, resp); err != nil {
+		logs.Errorf("list kaopu vpc failed, err: %v, region: %s, rid: %s", err, opt.Region, kt.Rid)
+		return nil, fmt.Errorf("list kaopu vpc failed, err: %v", err)
+	}
+
+	details := make([]types.KaopuVpc, 0, len(resp.Vpcs))
+	for _, one := range resp.Vpcs {
+		details = append(details, convertVpc(one, opt.Region))
+	}
+
+	return &types.KaopuVpcListResult{Details: details}, nil
+}
+
+func convertVpc(data kaopuVpc, region string) types.KaopuVpc {
+	vpc := types.KaopuVpc{
+		CloudID: data.VpcID,
+		Name:    data.VpcName,
+		Region:  region,
+		Memo:    &data.Description,
+		Extension: &cloud.KaopuVpcExtension{
+			Cidr:   make([]cloud.KaopuCidr, 0),
+			Status: data.Status,
+		},
+	}
+
+	if len(data.CidrBlock) != 0 {
+		vpc.Extension.Cidr = append(vpc.Extension.Cidr, cloud.KaopuCidr{Type: enumor.Ipv4, Cidr: data.CidrBlock})
+	}
+
+	if len(data.Ipv6CidrBlock) != 0 {
+		vpc.Extension.Cidr = append(vpc.Extension.Cidr, cloud.KaopuCidr{Type: enumor.Ipv6, Cidr: data.Ipv6CidrBlock})
+	}
+
+	return vpc
+}
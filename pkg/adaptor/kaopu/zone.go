@@ -0,0 +1,53 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package kaopu
+
+import (
+	"fmt"
+	"net/url"
+
+	typeszone "hcm/pkg/adaptor/types/zone"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+)
+
+// ListZone 查询可用区列表
+// reference: 靠谱云 OpenAPI DescribeZones 接口文档
+func (k *KaopuImpl) ListZone(kt *kit.Kit, opt *typeszone.KaopuZoneListOption) ([]typeszone.KaopuZone, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "kaopu zone list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	params := url.Values{}
+	params.Set("RegionId", opt.Region)
+	resp := new(struct {
+		Zones []typeszone.KaopuZone `json:"Zones"`
+	})
+	if err := k.client.call(kt, "DescribeZones", params, resp); err != nil {
+		logs.Errorf("list kaopu zone failed, err: %v, region: %s, rid: %s", err, opt.Region, kt.Rid)
+		return nil, fmt.Errorf("list kaopu zone failed, err: %v", err)
+	}
+
+	return resp.Zones, nil
+}
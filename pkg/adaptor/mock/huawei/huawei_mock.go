@@ -0,0 +1,3431 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../huawei/interface.go
+
+// Package mockhuawei is a generated GoMock package.
+package mockhuawei
+
+import (
+	poller "hcm/pkg/adaptor/poller"
+	types "hcm/pkg/adaptor/types"
+	account "hcm/pkg/adaptor/types/account"
+	bill "hcm/pkg/adaptor/types/bill"
+	bucket "hcm/pkg/adaptor/types/bucket"
+	core "hcm/pkg/adaptor/types/core"
+	cvm "hcm/pkg/adaptor/types/cvm"
+	databaseinstance "hcm/pkg/adaptor/types/database-instance"
+	disk "hcm/pkg/adaptor/types/disk"
+	eip "hcm/pkg/adaptor/types/eip"
+	image "hcm/pkg/adaptor/types/image"
+	instancetype "hcm/pkg/adaptor/types/instance-type"
+	k8scluster "hcm/pkg/adaptor/types/k8s-cluster"
+	keypair "hcm/pkg/adaptor/types/key-pair"
+	loadbalancer "hcm/pkg/adaptor/types/load-balancer"
+	natgateway "hcm/pkg/adaptor/types/nat-gateway"
+	networkinterface "hcm/pkg/adaptor/types/network-interface"
+	typesdns "hcm/pkg/adaptor/types/private-dns"
+	region "hcm/pkg/adaptor/types/region"
+	routetable "hcm/pkg/adaptor/types/route-table"
+	securitygroup "hcm/pkg/adaptor/types/security-group"
+	securitygrouprule "hcm/pkg/adaptor/types/security-group-rule"
+	snapshot "hcm/pkg/adaptor/types/snapshot"
+	adtysubnet "hcm/pkg/adaptor/types/subnet"
+	vpcpeering "hcm/pkg/adaptor/types/vpc-peering"
+	zone "hcm/pkg/adaptor/types/zone"
+	cloud "hcm/pkg/api/core/cloud"
+	networkinterface0 "hcm/pkg/api/core/cloud/network-interface"
+	enumor "hcm/pkg/criteria/enumor"
+	kit "hcm/pkg/kit"
+	reflect "reflect"
+
+	model "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/bssintl/v2/model"
+	model0 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rms/v1/model"
+	model1 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/vpc/v2/model"
+	model2 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/vpc/v3/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHuaWei is a mock of HuaWei interface.
+type MockHuaWei struct {
+	ctrl     *gomock.Controller
+	recorder *MockHuaWeiMockRecorder
+}
+
+// MockHuaWeiMockRecorder is the mock recorder for MockHuaWei.
+type MockHuaWeiMockRecorder struct {
+	mock *MockHuaWei
+}
+
+// NewMockHuaWei creates a new mock instance.
+func NewMockHuaWei(ctrl *gomock.Controller) *MockHuaWei {
+	mock := &MockHuaWei{ctrl: ctrl}
+	mock.recorder = &MockHuaWeiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHuaWei) EXPECT() *MockHuaWeiMockRecorder {
+	return m.recorder
+}
+
+// AssociateEip mocks base method.
+func (m *MockHuaWei) AssociateEip(kt *kit.Kit, opt *eip.HuaWeiEipAssociateOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssociateEip", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssociateEip indicates an expected call of AssociateEip.
+func (mr *MockHuaWeiMockRecorder) AssociateEip(kt, opt interface{}) *HuaWeiAssociateEipCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateEip", reflect.TypeOf((*MockHuaWei)(nil).AssociateEip), kt, opt)
+	return &HuaWeiAssociateEipCall{Call: call}
+}
+
+// HuaWeiAssociateEipCall wrap *gomock.Call
+type HuaWeiAssociateEipCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiAssociateEipCall) Return(arg0 error) *HuaWeiAssociateEipCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiAssociateEipCall) Do(f func(*kit.Kit, *eip.HuaWeiEipAssociateOption) error) *HuaWeiAssociateEipCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiAssociateEipCall) DoAndReturn(f func(*kit.Kit, *eip.HuaWeiEipAssociateOption) error) *HuaWeiAssociateEipCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AttachDisk mocks base method.
+func (m *MockHuaWei) AttachDisk(kt *kit.Kit, opt *disk.HuaWeiDiskAttachOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachDisk", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachDisk indicates an expected call of AttachDisk.
+func (mr *MockHuaWeiMockRecorder) AttachDisk(kt, opt interface{}) *HuaWeiAttachDiskCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachDisk", reflect.TypeOf((*MockHuaWei)(nil).AttachDisk), kt, opt)
+	return &HuaWeiAttachDiskCall{Call: call}
+}
+
+// HuaWeiAttachDiskCall wrap *gomock.Call
+type HuaWeiAttachDiskCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiAttachDiskCall) Return(arg0 error) *HuaWeiAttachDiskCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiAttachDiskCall) Do(f func(*kit.Kit, *disk.HuaWeiDiskAttachOption) error) *HuaWeiAttachDiskCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiAttachDiskCall) DoAndReturn(f func(*kit.Kit, *disk.HuaWeiDiskAttachOption) error) *HuaWeiAttachDiskCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// BindKeyPair mocks base method.
+func (m *MockHuaWei) BindKeyPair(kt *kit.Kit, opt *keypair.HuaWeiKeyPairBindOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BindKeyPair", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BindKeyPair indicates an expected call of BindKeyPair.
+func (mr *MockHuaWeiMockRecorder) BindKeyPair(kt, opt interface{}) *HuaWeiBindKeyPairCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindKeyPair", reflect.TypeOf((*MockHuaWei)(nil).BindKeyPair), kt, opt)
+	return &HuaWeiBindKeyPairCall{Call: call}
+}
+
+// HuaWeiBindKeyPairCall wrap *gomock.Call
+type HuaWeiBindKeyPairCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiBindKeyPairCall) Return(arg0 error) *HuaWeiBindKeyPairCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiBindKeyPairCall) Do(f func(*kit.Kit, *keypair.HuaWeiKeyPairBindOption) error) *HuaWeiBindKeyPairCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiBindKeyPairCall) DoAndReturn(f func(*kit.Kit, *keypair.HuaWeiKeyPairBindOption) error) *HuaWeiBindKeyPairCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CountAllResources mocks base method.
+func (m *MockHuaWei) CountAllResources(kt *kit.Kit, typ enumor.HuaWeiProviderType) (*model0.CountAllResourcesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAllResources", kt, typ)
+	ret0, _ := ret[0].(*model0.CountAllResourcesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAllResources indicates an expected call of CountAllResources.
+func (mr *MockHuaWeiMockRecorder) CountAllResources(kt, typ interface{}) *HuaWeiCountAllResourcesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAllResources", reflect.TypeOf((*MockHuaWei)(nil).CountAllResources), kt, typ)
+	return &HuaWeiCountAllResourcesCall{Call: call}
+}
+
+// HuaWeiCountAllResourcesCall wrap *gomock.Call
+type HuaWeiCountAllResourcesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiCountAllResourcesCall) Return(arg0 *model0.CountAllResourcesResponse, arg1 error) *HuaWeiCountAllResourcesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiCountAllResourcesCall) Do(f func(*kit.Kit, enumor.HuaWeiProviderType) (*model0.CountAllResourcesResponse, error)) *HuaWeiCountAllResourcesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiCountAllResourcesCall) DoAndReturn(f func(*kit.Kit, enumor.HuaWeiProviderType) (*model0.CountAllResourcesResponse, error)) *HuaWeiCountAllResourcesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CountNIResources mocks base method.
+func (m *MockHuaWei) CountNIResources(kt *kit.Kit) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountNIResources", kt)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountNIResources indicates an expected call of CountNIResources.
+func (mr *MockHuaWeiMockRecorder) CountNIResources(kt interface{}) *HuaWeiCountNIResourcesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountNIResources", reflect.TypeOf((*MockHuaWei)(nil).CountNIResources), kt)
+	return &HuaWeiCountNIResourcesCall{Call: call}
+}
+
+// HuaWeiCountNIResourcesCall wrap *gomock.Call
+type HuaWeiCountNIResourcesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiCountNIResourcesCall) Return(arg0 int32, arg1 error) *HuaWeiCountNIResourcesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiCountNIResourcesCall) Do(f func(*kit.Kit) (int32, error)) *HuaWeiCountNIResourcesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiCountNIResourcesCall) DoAndReturn(f func(*kit.Kit) (int32, error)) *HuaWeiCountNIResourcesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CountSubAccountResources mocks base method.
+func (m *MockHuaWei) CountSubAccountResources(kt *kit.Kit) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSubAccountResources", kt)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSubAccountResources indicates an expected call of CountSubAccountResources.
+func (mr *MockHuaWeiMockRecorder) CountSubAccountResources(kt interface{}) *HuaWeiCountSubAccountResourcesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSubAccountResources", reflect.TypeOf((*MockHuaWei)(nil).CountSubAccountResources), kt)
+	return &HuaWeiCountSubAccountResourcesCall{Call: call}
+}
+
+// HuaWeiCountSubAccountResourcesCall wrap *gomock.Call
+type HuaWeiCountSubAccountResourcesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiCountSubAccountResourcesCall) Return(arg0 int32, arg1 error) *HuaWeiCountSubAccountResourcesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiCountSubAccountResourcesCall) Do(f func(*kit.Kit) (int32, error)) *HuaWeiCountSubAccountResourcesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiCountSubAccountResourcesCall) DoAndReturn(f func(*kit.Kit) (int32, error)) *HuaWeiCountSubAccountResourcesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CountSubnetRouteTableRes mocks base method.
+func (m *MockHuaWei) CountSubnetRouteTableRes(kt *kit.Kit) (int32, int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSubnetRouteTableRes", kt)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(int32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CountSubnetRouteTableRes indicates an expected call of CountSubnetRouteTableRes.
+func (mr *MockHuaWeiMockRecorder) CountSubnetRouteTableRes(kt interface{}) *HuaWeiCountSubnetRouteTableResCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSubnetRouteTableRes", reflect.TypeOf((*MockHuaWei)(nil).CountSubnetRouteTableRes), kt)
+	return &HuaWeiCountSubnetRouteTableResCall{Call: call}
+}
+
+// HuaWeiCountSubnetRouteTableResCall wrap *gomock.Call
+type HuaWeiCountSubnetRouteTableResCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiCountSubnetRouteTableResCall) Return(arg0, arg1 int32, arg2 error) *HuaWeiCountSubnetRouteTableResCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiCountSubnetRouteTableResCall) Do(f func(*kit.Kit) (int32, int32, error)) *HuaWeiCountSubnetRouteTableResCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiCountSubnetRouteTableResCall) DoAndReturn(f func(*kit.Kit) (int32, int32, error)) *HuaWeiCountSubnetRouteTableResCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateCvm mocks base method.
+func (m *MockHuaWei) CreateCvm(kt *kit.Kit, opt *cvm.HuaWeiCreateOption) (*poller.BaseDoneResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCvm", kt, opt)
+	ret0, _ := ret[0].(*poller.BaseDoneResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCvm indicates an expected call of CreateCvm.
+func (mr *MockHuaWeiMockRecorder) CreateCvm(kt, opt interface{}) *HuaWeiCreateCvmCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCvm", reflect.TypeOf((*MockHuaWei)(nil).CreateCvm), kt, opt)
+	return &HuaWeiCreateCvmCall{Call: call}
+}
+
+// HuaWeiCreateCvmCall wrap *gomock.Call
+type HuaWeiCreateCvmCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiCreateCvmCall) Return(arg0 *poller.BaseDoneResult, arg1 error) *HuaWeiCreateCvmCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiCreateCvmCall) Do(f func(*kit.Kit, *cvm.HuaWeiCreateOption) (*poller.BaseDoneResult, error)) *HuaWeiCreateCvmCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiCreateCvmCall) DoAndReturn(f func(*kit.Kit, *cvm.HuaWeiCreateOption) (*poller.BaseDoneResult, error)) *HuaWeiCreateCvmCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateDisk mocks base method.
+func (m *MockHuaWei) CreateDisk(kt *kit.Kit, opt *disk.HuaWeiDiskCreateOption) (*poller.BaseDoneResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDisk", kt, opt)
+	ret0, _ := ret[0].(*poller.BaseDoneResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDisk indicates an expected call of CreateDisk.
+func (mr *MockHuaWeiMockRecorder) CreateDisk(kt, opt interface{}) *HuaWeiCreateDiskCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDisk", reflect.TypeOf((*MockHuaWei)(nil).CreateDisk), kt, opt)
+	return &HuaWeiCreateDiskCall{Call: call}
+}
+
+// HuaWeiCreateDiskCall wrap *gomock.Call
+type HuaWeiCreateDiskCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiCreateDiskCall) Return(arg0 *poller.BaseDoneResult, arg1 error) *HuaWeiCreateDiskCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiCreateDiskCall) Do(f func(*kit.Kit, *disk.HuaWeiDiskCreateOption) (*poller.BaseDoneResult, error)) *HuaWeiCreateDiskCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiCreateDiskCall) DoAndReturn(f func(*kit.Kit, *disk.HuaWeiDiskCreateOption) (*poller.BaseDoneResult, error)) *HuaWeiCreateDiskCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateEip mocks base method.
+func (m *MockHuaWei) CreateEip(kt *kit.Kit, opt *eip.HuaWeiEipCreateOption) (*poller.BaseDoneResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEip", kt, opt)
+	ret0, _ := ret[0].(*poller.BaseDoneResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEip indicates an expected call of CreateEip.
+func (mr *MockHuaWeiMockRecorder) CreateEip(kt, opt interface{}) *HuaWeiCreateEipCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEip", reflect.TypeOf((*MockHuaWei)(nil).CreateEip), kt, opt)
+	return &HuaWeiCreateEipCall{Call: call}
+}
+
+// HuaWeiCreateEipCall wrap *gomock.Call
+type HuaWeiCreateEipCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiCreateEipCall) Return(arg0 *poller.BaseDoneResult, arg1 error) *HuaWeiCreateEipCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiCreateEipCall) Do(f func(*kit.Kit, *eip.HuaWeiEipCreateOption) (*poller.BaseDoneResult, error)) *HuaWeiCreateEipCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiCreateEipCall) DoAndReturn(f func(*kit.Kit, *eip.HuaWeiEipCreateOption) (*poller.BaseDoneResult, error)) *HuaWeiCreateEipCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreatePrivateDnsRecord mocks base method.
+func (m *MockHuaWei) CreatePrivateDnsRecord(kt *kit.Kit, opt *typesdns.HuaWeiRecordCreateOption) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePrivateDnsRecord", kt, opt)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePrivateDnsRecord indicates an expected call of CreatePrivateDnsRecord.
+func (mr *MockHuaWeiMockRecorder) CreatePrivateDnsRecord(kt, opt interface{}) *HuaWeiCreatePrivateDnsRecordCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrivateDnsRecord", reflect.TypeOf((*MockHuaWei)(nil).CreatePrivateDnsRecord), kt, opt)
+	return &HuaWeiCreatePrivateDnsRecordCall{Call: call}
+}
+
+// HuaWeiCreatePrivateDnsRecordCall wrap *gomock.Call
+type HuaWeiCreatePrivateDnsRecordCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiCreatePrivateDnsRecordCall) Return(arg0 string, arg1 error) *HuaWeiCreatePrivateDnsRecordCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiCreatePrivateDnsRecordCall) Do(f func(*kit.Kit, *typesdns.HuaWeiRecordCreateOption) (string, error)) *HuaWeiCreatePrivateDnsRecordCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiCreatePrivateDnsRecordCall) DoAndReturn(f func(*kit.Kit, *typesdns.HuaWeiRecordCreateOption) (string, error)) *HuaWeiCreatePrivateDnsRecordCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateSecurityGroup mocks base method.
+func (m *MockHuaWei) CreateSecurityGroup(kt *kit.Kit, opt *securitygroup.HuaWeiCreateOption) (*model2.SecurityGroupInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecurityGroup", kt, opt)
+	ret0, _ := ret[0].(*model2.SecurityGroupInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSecurityGroup indicates an expected call of CreateSecurityGroup.
+func (mr *MockHuaWeiMockRecorder) CreateSecurityGroup(kt, opt interface{}) *HuaWeiCreateSecurityGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecurityGroup", reflect.TypeOf((*MockHuaWei)(nil).CreateSecurityGroup), kt, opt)
+	return &HuaWeiCreateSecurityGroupCall{Call: call}
+}
+
+// HuaWeiCreateSecurityGroupCall wrap *gomock.Call
+type HuaWeiCreateSecurityGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiCreateSecurityGroupCall) Return(arg0 *model2.SecurityGroupInfo, arg1 error) *HuaWeiCreateSecurityGroupCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiCreateSecurityGroupCall) Do(f func(*kit.Kit, *securitygroup.HuaWeiCreateOption) (*model2.SecurityGroupInfo, error)) *HuaWeiCreateSecurityGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiCreateSecurityGroupCall) DoAndReturn(f func(*kit.Kit, *securitygroup.HuaWeiCreateOption) (*model2.SecurityGroupInfo, error)) *HuaWeiCreateSecurityGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateSecurityGroupRule mocks base method.
+func (m *MockHuaWei) CreateSecurityGroupRule(kt *kit.Kit, opt *securitygrouprule.HuaWeiCreateOption) (*model2.SecurityGroupRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecurityGroupRule", kt, opt)
+	ret0, _ := ret[0].(*model2.SecurityGroupRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSecurityGroupRule indicates an expected call of CreateSecurityGroupRule.
+func (mr *MockHuaWeiMockRecorder) CreateSecurityGroupRule(kt, opt interface{}) *HuaWeiCreateSecurityGroupRuleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecurityGroupRule", reflect.TypeOf((*MockHuaWei)(nil).CreateSecurityGroupRule), kt, opt)
+	return &HuaWeiCreateSecurityGroupRuleCall{Call: call}
+}
+
+// HuaWeiCreateSecurityGroupRuleCall wrap *gomock.Call
+type HuaWeiCreateSecurityGroupRuleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiCreateSecurityGroupRuleCall) Return(arg0 *model2.SecurityGroupRule, arg1 error) *HuaWeiCreateSecurityGroupRuleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiCreateSecurityGroupRuleCall) Do(f func(*kit.Kit, *securitygrouprule.HuaWeiCreateOption) (*model2.SecurityGroupRule, error)) *HuaWeiCreateSecurityGroupRuleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiCreateSecurityGroupRuleCall) DoAndReturn(f func(*kit.Kit, *securitygrouprule.HuaWeiCreateOption) (*model2.SecurityGroupRule, error)) *HuaWeiCreateSecurityGroupRuleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateSnapshot mocks base method.
+func (m *MockHuaWei) CreateSnapshot(kt *kit.Kit, opt *snapshot.HuaWeiSnapshotCreateOption) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshot", kt, opt)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshot indicates an expected call of CreateSnapshot.
+func (mr *MockHuaWeiMockRecorder) CreateSnapshot(kt, opt interface{}) *HuaWeiCreateSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockHuaWei)(nil).CreateSnapshot), kt, opt)
+	return &HuaWeiCreateSnapshotCall{Call: call}
+}
+
+// HuaWeiCreateSnapshotCall wrap *gomock.Call
+type HuaWeiCreateSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiCreateSnapshotCall) Return(arg0 string, arg1 error) *HuaWeiCreateSnapshotCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiCreateSnapshotCall) Do(f func(*kit.Kit, *snapshot.HuaWeiSnapshotCreateOption) (string, error)) *HuaWeiCreateSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiCreateSnapshotCall) DoAndReturn(f func(*kit.Kit, *snapshot.HuaWeiSnapshotCreateOption) (string, error)) *HuaWeiCreateSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateSubnet mocks base method.
+func (m *MockHuaWei) CreateSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetCreateOption) (*adtysubnet.HuaWeiSubnet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubnet", kt, opt)
+	ret0, _ := ret[0].(*adtysubnet.HuaWeiSubnet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubnet indicates an expected call of CreateSubnet.
+func (mr *MockHuaWeiMockRecorder) CreateSubnet(kt, opt interface{}) *HuaWeiCreateSubnetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubnet", reflect.TypeOf((*MockHuaWei)(nil).CreateSubnet), kt, opt)
+	return &HuaWeiCreateSubnetCall{Call: call}
+}
+
+// HuaWeiCreateSubnetCall wrap *gomock.Call
+type HuaWeiCreateSubnetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiCreateSubnetCall) Return(arg0 *adtysubnet.HuaWeiSubnet, arg1 error) *HuaWeiCreateSubnetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiCreateSubnetCall) Do(f func(*kit.Kit, *adtysubnet.HuaWeiSubnetCreateOption) (*adtysubnet.HuaWeiSubnet, error)) *HuaWeiCreateSubnetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiCreateSubnetCall) DoAndReturn(f func(*kit.Kit, *adtysubnet.HuaWeiSubnetCreateOption) (*adtysubnet.HuaWeiSubnet, error)) *HuaWeiCreateSubnetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateVpc mocks base method.
+func (m *MockHuaWei) CreateVpc(kt *kit.Kit, opt *types.HuaWeiVpcCreateOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVpc", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVpc indicates an expected call of CreateVpc.
+func (mr *MockHuaWeiMockRecorder) CreateVpc(kt, opt interface{}) *HuaWeiCreateVpcCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpc", reflect.TypeOf((*MockHuaWei)(nil).CreateVpc), kt, opt)
+	return &HuaWeiCreateVpcCall{Call: call}
+}
+
+// HuaWeiCreateVpcCall wrap *gomock.Call
+type HuaWeiCreateVpcCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiCreateVpcCall) Return(arg0 error) *HuaWeiCreateVpcCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiCreateVpcCall) Do(f func(*kit.Kit, *types.HuaWeiVpcCreateOption) error) *HuaWeiCreateVpcCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiCreateVpcCall) DoAndReturn(f func(*kit.Kit, *types.HuaWeiVpcCreateOption) error) *HuaWeiCreateVpcCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteCvm mocks base method.
+func (m *MockHuaWei) DeleteCvm(kt *kit.Kit, opt *cvm.HuaWeiDeleteOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCvm", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCvm indicates an expected call of DeleteCvm.
+func (mr *MockHuaWeiMockRecorder) DeleteCvm(kt, opt interface{}) *HuaWeiDeleteCvmCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCvm", reflect.TypeOf((*MockHuaWei)(nil).DeleteCvm), kt, opt)
+	return &HuaWeiDeleteCvmCall{Call: call}
+}
+
+// HuaWeiDeleteCvmCall wrap *gomock.Call
+type HuaWeiDeleteCvmCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiDeleteCvmCall) Return(arg0 error) *HuaWeiDeleteCvmCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiDeleteCvmCall) Do(f func(*kit.Kit, *cvm.HuaWeiDeleteOption) error) *HuaWeiDeleteCvmCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiDeleteCvmCall) DoAndReturn(f func(*kit.Kit, *cvm.HuaWeiDeleteOption) error) *HuaWeiDeleteCvmCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteDatabaseInstance mocks base method.
+func (m *MockHuaWei) DeleteDatabaseInstance(kt *kit.Kit, opt *databaseinstance.HuaWeiDBInstanceDeleteOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDatabaseInstance", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDatabaseInstance indicates an expected call of DeleteDatabaseInstance.
+func (mr *MockHuaWeiMockRecorder) DeleteDatabaseInstance(kt, opt interface{}) *HuaWeiDeleteDatabaseInstanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDatabaseInstance", reflect.TypeOf((*MockHuaWei)(nil).DeleteDatabaseInstance), kt, opt)
+	return &HuaWeiDeleteDatabaseInstanceCall{Call: call}
+}
+
+// HuaWeiDeleteDatabaseInstanceCall wrap *gomock.Call
+type HuaWeiDeleteDatabaseInstanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiDeleteDatabaseInstanceCall) Return(arg0 error) *HuaWeiDeleteDatabaseInstanceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiDeleteDatabaseInstanceCall) Do(f func(*kit.Kit, *databaseinstance.HuaWeiDBInstanceDeleteOption) error) *HuaWeiDeleteDatabaseInstanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiDeleteDatabaseInstanceCall) DoAndReturn(f func(*kit.Kit, *databaseinstance.HuaWeiDBInstanceDeleteOption) error) *HuaWeiDeleteDatabaseInstanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteDisk mocks base method.
+func (m *MockHuaWei) DeleteDisk(kt *kit.Kit, chargeType string, opt *disk.HuaWeiDiskDeleteOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDisk", kt, chargeType, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDisk indicates an expected call of DeleteDisk.
+func (mr *MockHuaWeiMockRecorder) DeleteDisk(kt, chargeType, opt interface{}) *HuaWeiDeleteDiskCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDisk", reflect.TypeOf((*MockHuaWei)(nil).DeleteDisk), kt, chargeType, opt)
+	return &HuaWeiDeleteDiskCall{Call: call}
+}
+
+// HuaWeiDeleteDiskCall wrap *gomock.Call
+type HuaWeiDeleteDiskCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiDeleteDiskCall) Return(arg0 error) *HuaWeiDeleteDiskCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiDeleteDiskCall) Do(f func(*kit.Kit, string, *disk.HuaWeiDiskDeleteOption) error) *HuaWeiDeleteDiskCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiDeleteDiskCall) DoAndReturn(f func(*kit.Kit, string, *disk.HuaWeiDiskDeleteOption) error) *HuaWeiDeleteDiskCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteEip mocks base method.
+func (m *MockHuaWei) DeleteEip(kt *kit.Kit, opt *eip.HuaWeiEipDeleteOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEip", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEip indicates an expected call of DeleteEip.
+func (mr *MockHuaWeiMockRecorder) DeleteEip(kt, opt interface{}) *HuaWeiDeleteEipCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEip", reflect.TypeOf((*MockHuaWei)(nil).DeleteEip), kt, opt)
+	return &HuaWeiDeleteEipCall{Call: call}
+}
+
+// HuaWeiDeleteEipCall wrap *gomock.Call
+type HuaWeiDeleteEipCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiDeleteEipCall) Return(arg0 error) *HuaWeiDeleteEipCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiDeleteEipCall) Do(f func(*kit.Kit, *eip.HuaWeiEipDeleteOption) error) *HuaWeiDeleteEipCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiDeleteEipCall) DoAndReturn(f func(*kit.Kit, *eip.HuaWeiEipDeleteOption) error) *HuaWeiDeleteEipCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteKeyPair mocks base method.
+func (m *MockHuaWei) DeleteKeyPair(kt *kit.Kit, opt *keypair.HuaWeiKeyPairDeleteOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKeyPair", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteKeyPair indicates an expected call of DeleteKeyPair.
+func (mr *MockHuaWeiMockRecorder) DeleteKeyPair(kt, opt interface{}) *HuaWeiDeleteKeyPairCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKeyPair", reflect.TypeOf((*MockHuaWei)(nil).DeleteKeyPair), kt, opt)
+	return &HuaWeiDeleteKeyPairCall{Call: call}
+}
+
+// HuaWeiDeleteKeyPairCall wrap *gomock.Call
+type HuaWeiDeleteKeyPairCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiDeleteKeyPairCall) Return(arg0 error) *HuaWeiDeleteKeyPairCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiDeleteKeyPairCall) Do(f func(*kit.Kit, *keypair.HuaWeiKeyPairDeleteOption) error) *HuaWeiDeleteKeyPairCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiDeleteKeyPairCall) DoAndReturn(f func(*kit.Kit, *keypair.HuaWeiKeyPairDeleteOption) error) *HuaWeiDeleteKeyPairCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteLoadBalancer mocks base method.
+func (m *MockHuaWei) DeleteLoadBalancer(kt *kit.Kit, opt *loadbalancer.HuaWeiDeleteOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLoadBalancer", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLoadBalancer indicates an expected call of DeleteLoadBalancer.
+func (mr *MockHuaWeiMockRecorder) DeleteLoadBalancer(kt, opt interface{}) *HuaWeiDeleteLoadBalancerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancer", reflect.TypeOf((*MockHuaWei)(nil).DeleteLoadBalancer), kt, opt)
+	return &HuaWeiDeleteLoadBalancerCall{Call: call}
+}
+
+// HuaWeiDeleteLoadBalancerCall wrap *gomock.Call
+type HuaWeiDeleteLoadBalancerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiDeleteLoadBalancerCall) Return(arg0 error) *HuaWeiDeleteLoadBalancerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiDeleteLoadBalancerCall) Do(f func(*kit.Kit, *loadbalancer.HuaWeiDeleteOption) error) *HuaWeiDeleteLoadBalancerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiDeleteLoadBalancerCall) DoAndReturn(f func(*kit.Kit, *loadbalancer.HuaWeiDeleteOption) error) *HuaWeiDeleteLoadBalancerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeletePrePaidResource mocks base method.
+func (m *MockHuaWei) DeletePrePaidResource(kt *kit.Kit, cloudIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrePaidResource", kt, cloudIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrePaidResource indicates an expected call of DeletePrePaidResource.
+func (mr *MockHuaWeiMockRecorder) DeletePrePaidResource(kt, cloudIDs interface{}) *HuaWeiDeletePrePaidResourceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrePaidResource", reflect.TypeOf((*MockHuaWei)(nil).DeletePrePaidResource), kt, cloudIDs)
+	return &HuaWeiDeletePrePaidResourceCall{Call: call}
+}
+
+// HuaWeiDeletePrePaidResourceCall wrap *gomock.Call
+type HuaWeiDeletePrePaidResourceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiDeletePrePaidResourceCall) Return(arg0 error) *HuaWeiDeletePrePaidResourceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiDeletePrePaidResourceCall) Do(f func(*kit.Kit, []string) error) *HuaWeiDeletePrePaidResourceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiDeletePrePaidResourceCall) DoAndReturn(f func(*kit.Kit, []string) error) *HuaWeiDeletePrePaidResourceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeletePrivateDnsRecord mocks base method.
+func (m *MockHuaWei) DeletePrivateDnsRecord(kt *kit.Kit, opt *typesdns.HuaWeiRecordDeleteOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrivateDnsRecord", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrivateDnsRecord indicates an expected call of DeletePrivateDnsRecord.
+func (mr *MockHuaWeiMockRecorder) DeletePrivateDnsRecord(kt, opt interface{}) *HuaWeiDeletePrivateDnsRecordCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateDnsRecord", reflect.TypeOf((*MockHuaWei)(nil).DeletePrivateDnsRecord), kt, opt)
+	return &HuaWeiDeletePrivateDnsRecordCall{Call: call}
+}
+
+// HuaWeiDeletePrivateDnsRecordCall wrap *gomock.Call
+type HuaWeiDeletePrivateDnsRecordCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiDeletePrivateDnsRecordCall) Return(arg0 error) *HuaWeiDeletePrivateDnsRecordCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiDeletePrivateDnsRecordCall) Do(f func(*kit.Kit, *typesdns.HuaWeiRecordDeleteOption) error) *HuaWeiDeletePrivateDnsRecordCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiDeletePrivateDnsRecordCall) DoAndReturn(f func(*kit.Kit, *typesdns.HuaWeiRecordDeleteOption) error) *HuaWeiDeletePrivateDnsRecordCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteRouteTable mocks base method.
+func (m *MockHuaWei) DeleteRouteTable(kt *kit.Kit, opt *core.BaseRegionalDeleteOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRouteTable", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRouteTable indicates an expected call of DeleteRouteTable.
+func (mr *MockHuaWeiMockRecorder) DeleteRouteTable(kt, opt interface{}) *HuaWeiDeleteRouteTableCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRouteTable", reflect.TypeOf((*MockHuaWei)(nil).DeleteRouteTable), kt, opt)
+	return &HuaWeiDeleteRouteTableCall{Call: call}
+}
+
+// HuaWeiDeleteRouteTableCall wrap *gomock.Call
+type HuaWeiDeleteRouteTableCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiDeleteRouteTableCall) Return(arg0 error) *HuaWeiDeleteRouteTableCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiDeleteRouteTableCall) Do(f func(*kit.Kit, *core.BaseRegionalDeleteOption) error) *HuaWeiDeleteRouteTableCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiDeleteRouteTableCall) DoAndReturn(f func(*kit.Kit, *core.BaseRegionalDeleteOption) error) *HuaWeiDeleteRouteTableCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteSecurityGroup mocks base method.
+func (m *MockHuaWei) DeleteSecurityGroup(kt *kit.Kit, opt *securitygroup.HuaWeiDeleteOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecurityGroup", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecurityGroup indicates an expected call of DeleteSecurityGroup.
+func (mr *MockHuaWeiMockRecorder) DeleteSecurityGroup(kt, opt interface{}) *HuaWeiDeleteSecurityGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityGroup", reflect.TypeOf((*MockHuaWei)(nil).DeleteSecurityGroup), kt, opt)
+	return &HuaWeiDeleteSecurityGroupCall{Call: call}
+}
+
+// HuaWeiDeleteSecurityGroupCall wrap *gomock.Call
+type HuaWeiDeleteSecurityGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiDeleteSecurityGroupCall) Return(arg0 error) *HuaWeiDeleteSecurityGroupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiDeleteSecurityGroupCall) Do(f func(*kit.Kit, *securitygroup.HuaWeiDeleteOption) error) *HuaWeiDeleteSecurityGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiDeleteSecurityGroupCall) DoAndReturn(f func(*kit.Kit, *securitygroup.HuaWeiDeleteOption) error) *HuaWeiDeleteSecurityGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteSecurityGroupRule mocks base method.
+func (m *MockHuaWei) DeleteSecurityGroupRule(kt *kit.Kit, opt *securitygrouprule.HuaWeiDeleteOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecurityGroupRule", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecurityGroupRule indicates an expected call of DeleteSecurityGroupRule.
+func (mr *MockHuaWeiMockRecorder) DeleteSecurityGroupRule(kt, opt interface{}) *HuaWeiDeleteSecurityGroupRuleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityGroupRule", reflect.TypeOf((*MockHuaWei)(nil).DeleteSecurityGroupRule), kt, opt)
+	return &HuaWeiDeleteSecurityGroupRuleCall{Call: call}
+}
+
+// HuaWeiDeleteSecurityGroupRuleCall wrap *gomock.Call
+type HuaWeiDeleteSecurityGroupRuleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiDeleteSecurityGroupRuleCall) Return(arg0 error) *HuaWeiDeleteSecurityGroupRuleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiDeleteSecurityGroupRuleCall) Do(f func(*kit.Kit, *securitygrouprule.HuaWeiDeleteOption) error) *HuaWeiDeleteSecurityGroupRuleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiDeleteSecurityGroupRuleCall) DoAndReturn(f func(*kit.Kit, *securitygrouprule.HuaWeiDeleteOption) error) *HuaWeiDeleteSecurityGroupRuleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteSnapshot mocks base method.
+func (m *MockHuaWei) DeleteSnapshot(kt *kit.Kit, opt *snapshot.HuaWeiSnapshotDeleteOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshot", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSnapshot indicates an expected call of DeleteSnapshot.
+func (mr *MockHuaWeiMockRecorder) DeleteSnapshot(kt, opt interface{}) *HuaWeiDeleteSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockHuaWei)(nil).DeleteSnapshot), kt, opt)
+	return &HuaWeiDeleteSnapshotCall{Call: call}
+}
+
+// HuaWeiDeleteSnapshotCall wrap *gomock.Call
+type HuaWeiDeleteSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiDeleteSnapshotCall) Return(arg0 error) *HuaWeiDeleteSnapshotCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiDeleteSnapshotCall) Do(f func(*kit.Kit, *snapshot.HuaWeiSnapshotDeleteOption) error) *HuaWeiDeleteSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiDeleteSnapshotCall) DoAndReturn(f func(*kit.Kit, *snapshot.HuaWeiSnapshotDeleteOption) error) *HuaWeiDeleteSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteSubnet mocks base method.
+func (m *MockHuaWei) DeleteSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetDeleteOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubnet", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubnet indicates an expected call of DeleteSubnet.
+func (mr *MockHuaWeiMockRecorder) DeleteSubnet(kt, opt interface{}) *HuaWeiDeleteSubnetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubnet", reflect.TypeOf((*MockHuaWei)(nil).DeleteSubnet), kt, opt)
+	return &HuaWeiDeleteSubnetCall{Call: call}
+}
+
+// HuaWeiDeleteSubnetCall wrap *gomock.Call
+type HuaWeiDeleteSubnetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiDeleteSubnetCall) Return(arg0 error) *HuaWeiDeleteSubnetCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiDeleteSubnetCall) Do(f func(*kit.Kit, *adtysubnet.HuaWeiSubnetDeleteOption) error) *HuaWeiDeleteSubnetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiDeleteSubnetCall) DoAndReturn(f func(*kit.Kit, *adtysubnet.HuaWeiSubnetDeleteOption) error) *HuaWeiDeleteSubnetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteVpc mocks base method.
+func (m *MockHuaWei) DeleteVpc(kt *kit.Kit, opt *core.BaseRegionalDeleteOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVpc", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVpc indicates an expected call of DeleteVpc.
+func (mr *MockHuaWeiMockRecorder) DeleteVpc(kt, opt interface{}) *HuaWeiDeleteVpcCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpc", reflect.TypeOf((*MockHuaWei)(nil).DeleteVpc), kt, opt)
+	return &HuaWeiDeleteVpcCall{Call: call}
+}
+
+// HuaWeiDeleteVpcCall wrap *gomock.Call
+type HuaWeiDeleteVpcCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiDeleteVpcCall) Return(arg0 error) *HuaWeiDeleteVpcCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiDeleteVpcCall) Do(f func(*kit.Kit, *core.BaseRegionalDeleteOption) error) *HuaWeiDeleteVpcCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiDeleteVpcCall) DoAndReturn(f func(*kit.Kit, *core.BaseRegionalDeleteOption) error) *HuaWeiDeleteVpcCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DetachDisk mocks base method.
+func (m *MockHuaWei) DetachDisk(kt *kit.Kit, opt *disk.HuaWeiDiskDetachOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachDisk", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachDisk indicates an expected call of DetachDisk.
+func (mr *MockHuaWeiMockRecorder) DetachDisk(kt, opt interface{}) *HuaWeiDetachDiskCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachDisk", reflect.TypeOf((*MockHuaWei)(nil).DetachDisk), kt, opt)
+	return &HuaWeiDetachDiskCall{Call: call}
+}
+
+// HuaWeiDetachDiskCall wrap *gomock.Call
+type HuaWeiDetachDiskCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiDetachDiskCall) Return(arg0 error) *HuaWeiDetachDiskCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiDetachDiskCall) Do(f func(*kit.Kit, *disk.HuaWeiDiskDetachOption) error) *HuaWeiDetachDiskCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiDetachDiskCall) DoAndReturn(f func(*kit.Kit, *disk.HuaWeiDiskDetachOption) error) *HuaWeiDetachDiskCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DisassociateEip mocks base method.
+func (m *MockHuaWei) DisassociateEip(kt *kit.Kit, opt *eip.HuaWeiEipDisassociateOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisassociateEip", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisassociateEip indicates an expected call of DisassociateEip.
+func (mr *MockHuaWeiMockRecorder) DisassociateEip(kt, opt interface{}) *HuaWeiDisassociateEipCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateEip", reflect.TypeOf((*MockHuaWei)(nil).DisassociateEip), kt, opt)
+	return &HuaWeiDisassociateEipCall{Call: call}
+}
+
+// HuaWeiDisassociateEipCall wrap *gomock.Call
+type HuaWeiDisassociateEipCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiDisassociateEipCall) Return(arg0 error) *HuaWeiDisassociateEipCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiDisassociateEipCall) Do(f func(*kit.Kit, *eip.HuaWeiEipDisassociateOption) error) *HuaWeiDisassociateEipCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiDisassociateEipCall) DoAndReturn(f func(*kit.Kit, *eip.HuaWeiEipDisassociateOption) error) *HuaWeiDisassociateEipCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAccountInfoBySecret mocks base method.
+func (m *MockHuaWei) GetAccountInfoBySecret(kt *kit.Kit, accessKeyID string) (*cloud.HuaWeiInfoBySecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountInfoBySecret", kt, accessKeyID)
+	ret0, _ := ret[0].(*cloud.HuaWeiInfoBySecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountInfoBySecret indicates an expected call of GetAccountInfoBySecret.
+func (mr *MockHuaWeiMockRecorder) GetAccountInfoBySecret(kt, accessKeyID interface{}) *HuaWeiGetAccountInfoBySecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountInfoBySecret", reflect.TypeOf((*MockHuaWei)(nil).GetAccountInfoBySecret), kt, accessKeyID)
+	return &HuaWeiGetAccountInfoBySecretCall{Call: call}
+}
+
+// HuaWeiGetAccountInfoBySecretCall wrap *gomock.Call
+type HuaWeiGetAccountInfoBySecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiGetAccountInfoBySecretCall) Return(arg0 *cloud.HuaWeiInfoBySecret, arg1 error) *HuaWeiGetAccountInfoBySecretCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiGetAccountInfoBySecretCall) Do(f func(*kit.Kit, string) (*cloud.HuaWeiInfoBySecret, error)) *HuaWeiGetAccountInfoBySecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiGetAccountInfoBySecretCall) DoAndReturn(f func(*kit.Kit, string) (*cloud.HuaWeiInfoBySecret, error)) *HuaWeiGetAccountInfoBySecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAccountQuota mocks base method.
+func (m *MockHuaWei) GetAccountQuota(kt *kit.Kit, opt *account.GetHuaWeiAccountZoneQuotaOption) (*account.HuaWeiAccountQuota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountQuota", kt, opt)
+	ret0, _ := ret[0].(*account.HuaWeiAccountQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountQuota indicates an expected call of GetAccountQuota.
+func (mr *MockHuaWeiMockRecorder) GetAccountQuota(kt, opt interface{}) *HuaWeiGetAccountQuotaCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountQuota", reflect.TypeOf((*MockHuaWei)(nil).GetAccountQuota), kt, opt)
+	return &HuaWeiGetAccountQuotaCall{Call: call}
+}
+
+// HuaWeiGetAccountQuotaCall wrap *gomock.Call
+type HuaWeiGetAccountQuotaCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiGetAccountQuotaCall) Return(arg0 *account.HuaWeiAccountQuota, arg1 error) *HuaWeiGetAccountQuotaCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiGetAccountQuotaCall) Do(f func(*kit.Kit, *account.GetHuaWeiAccountZoneQuotaOption) (*account.HuaWeiAccountQuota, error)) *HuaWeiGetAccountQuotaCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiGetAccountQuotaCall) DoAndReturn(f func(*kit.Kit, *account.GetHuaWeiAccountZoneQuotaOption) (*account.HuaWeiAccountQuota, error)) *HuaWeiGetAccountQuotaCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetBillList mocks base method.
+func (m *MockHuaWei) GetBillList(kt *kit.Kit, opt *bill.HuaWeiBillListOption) (*model.ListCustomerselfResourceRecordDetailsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBillList", kt, opt)
+	ret0, _ := ret[0].(*model.ListCustomerselfResourceRecordDetailsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBillList indicates an expected call of GetBillList.
+func (mr *MockHuaWeiMockRecorder) GetBillList(kt, opt interface{}) *HuaWeiGetBillListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBillList", reflect.TypeOf((*MockHuaWei)(nil).GetBillList), kt, opt)
+	return &HuaWeiGetBillListCall{Call: call}
+}
+
+// HuaWeiGetBillListCall wrap *gomock.Call
+type HuaWeiGetBillListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiGetBillListCall) Return(arg0 *model.ListCustomerselfResourceRecordDetailsResponse, arg1 error) *HuaWeiGetBillListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiGetBillListCall) Do(f func(*kit.Kit, *bill.HuaWeiBillListOption) (*model.ListCustomerselfResourceRecordDetailsResponse, error)) *HuaWeiGetBillListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiGetBillListCall) DoAndReturn(f func(*kit.Kit, *bill.HuaWeiBillListOption) (*model.ListCustomerselfResourceRecordDetailsResponse, error)) *HuaWeiGetBillListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetFeeRecordList mocks base method.
+func (m *MockHuaWei) GetFeeRecordList(kt *kit.Kit, opt *bill.HuaWeiFeeRecordListOption) (*model.ListCustomerselfResourceRecordsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeRecordList", kt, opt)
+	ret0, _ := ret[0].(*model.ListCustomerselfResourceRecordsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeeRecordList indicates an expected call of GetFeeRecordList.
+func (mr *MockHuaWeiMockRecorder) GetFeeRecordList(kt, opt interface{}) *HuaWeiGetFeeRecordListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeRecordList", reflect.TypeOf((*MockHuaWei)(nil).GetFeeRecordList), kt, opt)
+	return &HuaWeiGetFeeRecordListCall{Call: call}
+}
+
+// HuaWeiGetFeeRecordListCall wrap *gomock.Call
+type HuaWeiGetFeeRecordListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiGetFeeRecordListCall) Return(arg0 *model.ListCustomerselfResourceRecordsResponse, arg1 error) *HuaWeiGetFeeRecordListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiGetFeeRecordListCall) Do(f func(*kit.Kit, *bill.HuaWeiFeeRecordListOption) (*model.ListCustomerselfResourceRecordsResponse, error)) *HuaWeiGetFeeRecordListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiGetFeeRecordListCall) DoAndReturn(f func(*kit.Kit, *bill.HuaWeiFeeRecordListOption) (*model.ListCustomerselfResourceRecordsResponse, error)) *HuaWeiGetFeeRecordListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetProjectID mocks base method.
+func (m *MockHuaWei) GetProjectID(kt *kit.Kit, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectID", kt, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectID indicates an expected call of GetProjectID.
+func (mr *MockHuaWeiMockRecorder) GetProjectID(kt, name interface{}) *HuaWeiGetProjectIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectID", reflect.TypeOf((*MockHuaWei)(nil).GetProjectID), kt, name)
+	return &HuaWeiGetProjectIDCall{Call: call}
+}
+
+// HuaWeiGetProjectIDCall wrap *gomock.Call
+type HuaWeiGetProjectIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiGetProjectIDCall) Return(arg0 string, arg1 error) *HuaWeiGetProjectIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiGetProjectIDCall) Do(f func(*kit.Kit, string) (string, error)) *HuaWeiGetProjectIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiGetProjectIDCall) DoAndReturn(f func(*kit.Kit, string) (string, error)) *HuaWeiGetProjectIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetPublicIpsMapByPortIDs mocks base method.
+func (m *MockHuaWei) GetPublicIpsMapByPortIDs(kt *kit.Kit, opt *networkinterface.HuaWeiEipListOption) (map[string]*networkinterface0.EipNetwork, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicIpsMapByPortIDs", kt, opt)
+	ret0, _ := ret[0].(map[string]*networkinterface0.EipNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublicIpsMapByPortIDs indicates an expected call of GetPublicIpsMapByPortIDs.
+func (mr *MockHuaWeiMockRecorder) GetPublicIpsMapByPortIDs(kt, opt interface{}) *HuaWeiGetPublicIpsMapByPortIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicIpsMapByPortIDs", reflect.TypeOf((*MockHuaWei)(nil).GetPublicIpsMapByPortIDs), kt, opt)
+	return &HuaWeiGetPublicIpsMapByPortIDsCall{Call: call}
+}
+
+// HuaWeiGetPublicIpsMapByPortIDsCall wrap *gomock.Call
+type HuaWeiGetPublicIpsMapByPortIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiGetPublicIpsMapByPortIDsCall) Return(arg0 map[string]*networkinterface0.EipNetwork, arg1 error) *HuaWeiGetPublicIpsMapByPortIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiGetPublicIpsMapByPortIDsCall) Do(f func(*kit.Kit, *networkinterface.HuaWeiEipListOption) (map[string]*networkinterface0.EipNetwork, error)) *HuaWeiGetPublicIpsMapByPortIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiGetPublicIpsMapByPortIDsCall) DoAndReturn(f func(*kit.Kit, *networkinterface.HuaWeiEipListOption) (map[string]*networkinterface0.EipNetwork, error)) *HuaWeiGetPublicIpsMapByPortIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetRouteTable mocks base method.
+func (m *MockHuaWei) GetRouteTable(kt *kit.Kit, opt *routetable.HuaWeiRouteTableGetOption) (*routetable.HuaWeiRouteTable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRouteTable", kt, opt)
+	ret0, _ := ret[0].(*routetable.HuaWeiRouteTable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRouteTable indicates an expected call of GetRouteTable.
+func (mr *MockHuaWeiMockRecorder) GetRouteTable(kt, opt interface{}) *HuaWeiGetRouteTableCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouteTable", reflect.TypeOf((*MockHuaWei)(nil).GetRouteTable), kt, opt)
+	return &HuaWeiGetRouteTableCall{Call: call}
+}
+
+// HuaWeiGetRouteTableCall wrap *gomock.Call
+type HuaWeiGetRouteTableCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiGetRouteTableCall) Return(arg0 *routetable.HuaWeiRouteTable, arg1 error) *HuaWeiGetRouteTableCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiGetRouteTableCall) Do(f func(*kit.Kit, *routetable.HuaWeiRouteTableGetOption) (*routetable.HuaWeiRouteTable, error)) *HuaWeiGetRouteTableCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiGetRouteTableCall) DoAndReturn(f func(*kit.Kit, *routetable.HuaWeiRouteTableGetOption) (*routetable.HuaWeiRouteTable, error)) *HuaWeiGetRouteTableCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSecurityGroupsByNetID mocks base method.
+func (m *MockHuaWei) GetSecurityGroupsByNetID(kt *kit.Kit, opt *networkinterface.HuaWeiPortInfoOption) (map[string][]string, []networkinterface0.NetVirtualIP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecurityGroupsByNetID", kt, opt)
+	ret0, _ := ret[0].(map[string][]string)
+	ret1, _ := ret[1].([]networkinterface0.NetVirtualIP)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSecurityGroupsByNetID indicates an expected call of GetSecurityGroupsByNetID.
+func (mr *MockHuaWeiMockRecorder) GetSecurityGroupsByNetID(kt, opt interface{}) *HuaWeiGetSecurityGroupsByNetIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityGroupsByNetID", reflect.TypeOf((*MockHuaWei)(nil).GetSecurityGroupsByNetID), kt, opt)
+	return &HuaWeiGetSecurityGroupsByNetIDCall{Call: call}
+}
+
+// HuaWeiGetSecurityGroupsByNetIDCall wrap *gomock.Call
+type HuaWeiGetSecurityGroupsByNetIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiGetSecurityGroupsByNetIDCall) Return(arg0 map[string][]string, arg1 []networkinterface0.NetVirtualIP, arg2 error) *HuaWeiGetSecurityGroupsByNetIDCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiGetSecurityGroupsByNetIDCall) Do(f func(*kit.Kit, *networkinterface.HuaWeiPortInfoOption) (map[string][]string, []networkinterface0.NetVirtualIP, error)) *HuaWeiGetSecurityGroupsByNetIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiGetSecurityGroupsByNetIDCall) DoAndReturn(f func(*kit.Kit, *networkinterface.HuaWeiPortInfoOption) (map[string][]string, []networkinterface0.NetVirtualIP, error)) *HuaWeiGetSecurityGroupsByNetIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSecurityGroupsByPortID mocks base method.
+func (m *MockHuaWei) GetSecurityGroupsByPortID(kt *kit.Kit, opt *networkinterface.HuaWeiPortInfoOption) (map[string][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecurityGroupsByPortID", kt, opt)
+	ret0, _ := ret[0].(map[string][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecurityGroupsByPortID indicates an expected call of GetSecurityGroupsByPortID.
+func (mr *MockHuaWeiMockRecorder) GetSecurityGroupsByPortID(kt, opt interface{}) *HuaWeiGetSecurityGroupsByPortIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityGroupsByPortID", reflect.TypeOf((*MockHuaWei)(nil).GetSecurityGroupsByPortID), kt, opt)
+	return &HuaWeiGetSecurityGroupsByPortIDCall{Call: call}
+}
+
+// HuaWeiGetSecurityGroupsByPortIDCall wrap *gomock.Call
+type HuaWeiGetSecurityGroupsByPortIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiGetSecurityGroupsByPortIDCall) Return(arg0 map[string][]string, arg1 error) *HuaWeiGetSecurityGroupsByPortIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiGetSecurityGroupsByPortIDCall) Do(f func(*kit.Kit, *networkinterface.HuaWeiPortInfoOption) (map[string][]string, error)) *HuaWeiGetSecurityGroupsByPortIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiGetSecurityGroupsByPortIDCall) DoAndReturn(f func(*kit.Kit, *networkinterface.HuaWeiPortInfoOption) (map[string][]string, error)) *HuaWeiGetSecurityGroupsByPortIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSubnetIPAvailabilities mocks base method.
+func (m *MockHuaWei) GetSubnetIPAvailabilities(kt *kit.Kit, opt *types.HuaWeiVpcIPAvailGetOption) (*model1.NetworkIpAvailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetIPAvailabilities", kt, opt)
+	ret0, _ := ret[0].(*model1.NetworkIpAvailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetIPAvailabilities indicates an expected call of GetSubnetIPAvailabilities.
+func (mr *MockHuaWeiMockRecorder) GetSubnetIPAvailabilities(kt, opt interface{}) *HuaWeiGetSubnetIPAvailabilitiesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetIPAvailabilities", reflect.TypeOf((*MockHuaWei)(nil).GetSubnetIPAvailabilities), kt, opt)
+	return &HuaWeiGetSubnetIPAvailabilitiesCall{Call: call}
+}
+
+// HuaWeiGetSubnetIPAvailabilitiesCall wrap *gomock.Call
+type HuaWeiGetSubnetIPAvailabilitiesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiGetSubnetIPAvailabilitiesCall) Return(arg0 *model1.NetworkIpAvailability, arg1 error) *HuaWeiGetSubnetIPAvailabilitiesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiGetSubnetIPAvailabilitiesCall) Do(f func(*kit.Kit, *types.HuaWeiVpcIPAvailGetOption) (*model1.NetworkIpAvailability, error)) *HuaWeiGetSubnetIPAvailabilitiesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiGetSubnetIPAvailabilitiesCall) DoAndReturn(f func(*kit.Kit, *types.HuaWeiVpcIPAvailGetOption) (*model1.NetworkIpAvailability, error)) *HuaWeiGetSubnetIPAvailabilitiesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ImportKeyPair mocks base method.
+func (m *MockHuaWei) ImportKeyPair(kt *kit.Kit, opt *keypair.HuaWeiKeyPairImportOption) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportKeyPair", kt, opt)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportKeyPair indicates an expected call of ImportKeyPair.
+func (mr *MockHuaWeiMockRecorder) ImportKeyPair(kt, opt interface{}) *HuaWeiImportKeyPairCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportKeyPair", reflect.TypeOf((*MockHuaWei)(nil).ImportKeyPair), kt, opt)
+	return &HuaWeiImportKeyPairCall{Call: call}
+}
+
+// HuaWeiImportKeyPairCall wrap *gomock.Call
+type HuaWeiImportKeyPairCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiImportKeyPairCall) Return(arg0 string, arg1 error) *HuaWeiImportKeyPairCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiImportKeyPairCall) Do(f func(*kit.Kit, *keypair.HuaWeiKeyPairImportOption) (string, error)) *HuaWeiImportKeyPairCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiImportKeyPairCall) DoAndReturn(f func(*kit.Kit, *keypair.HuaWeiKeyPairImportOption) (string, error)) *HuaWeiImportKeyPairCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// InquiryPriceCvm mocks base method.
+func (m *MockHuaWei) InquiryPriceCvm(kt *kit.Kit, opt *cvm.HuaWeiCreateOption) (*cvm.InquiryPriceResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InquiryPriceCvm", kt, opt)
+	ret0, _ := ret[0].(*cvm.InquiryPriceResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InquiryPriceCvm indicates an expected call of InquiryPriceCvm.
+func (mr *MockHuaWeiMockRecorder) InquiryPriceCvm(kt, opt interface{}) *HuaWeiInquiryPriceCvmCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InquiryPriceCvm", reflect.TypeOf((*MockHuaWei)(nil).InquiryPriceCvm), kt, opt)
+	return &HuaWeiInquiryPriceCvmCall{Call: call}
+}
+
+// HuaWeiInquiryPriceCvmCall wrap *gomock.Call
+type HuaWeiInquiryPriceCvmCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiInquiryPriceCvmCall) Return(arg0 *cvm.InquiryPriceResult, arg1 error) *HuaWeiInquiryPriceCvmCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiInquiryPriceCvmCall) Do(f func(*kit.Kit, *cvm.HuaWeiCreateOption) (*cvm.InquiryPriceResult, error)) *HuaWeiInquiryPriceCvmCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiInquiryPriceCvmCall) DoAndReturn(f func(*kit.Kit, *cvm.HuaWeiCreateOption) (*cvm.InquiryPriceResult, error)) *HuaWeiInquiryPriceCvmCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// InquiryPriceDisk mocks base method.
+func (m *MockHuaWei) InquiryPriceDisk(kt *kit.Kit, opt *disk.HuaWeiDiskCreateOption) (*disk.InquiryPriceResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InquiryPriceDisk", kt, opt)
+	ret0, _ := ret[0].(*disk.InquiryPriceResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InquiryPriceDisk indicates an expected call of InquiryPriceDisk.
+func (mr *MockHuaWeiMockRecorder) InquiryPriceDisk(kt, opt interface{}) *HuaWeiInquiryPriceDiskCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InquiryPriceDisk", reflect.TypeOf((*MockHuaWei)(nil).InquiryPriceDisk), kt, opt)
+	return &HuaWeiInquiryPriceDiskCall{Call: call}
+}
+
+// HuaWeiInquiryPriceDiskCall wrap *gomock.Call
+type HuaWeiInquiryPriceDiskCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiInquiryPriceDiskCall) Return(arg0 *disk.InquiryPriceResult, arg1 error) *HuaWeiInquiryPriceDiskCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiInquiryPriceDiskCall) Do(f func(*kit.Kit, *disk.HuaWeiDiskCreateOption) (*disk.InquiryPriceResult, error)) *HuaWeiInquiryPriceDiskCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiInquiryPriceDiskCall) DoAndReturn(f func(*kit.Kit, *disk.HuaWeiDiskCreateOption) (*disk.InquiryPriceResult, error)) *HuaWeiInquiryPriceDiskCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListAccount mocks base method.
+func (m *MockHuaWei) ListAccount(kt *kit.Kit) ([]account.HuaWeiAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccount", kt)
+	ret0, _ := ret[0].([]account.HuaWeiAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccount indicates an expected call of ListAccount.
+func (mr *MockHuaWeiMockRecorder) ListAccount(kt interface{}) *HuaWeiListAccountCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccount", reflect.TypeOf((*MockHuaWei)(nil).ListAccount), kt)
+	return &HuaWeiListAccountCall{Call: call}
+}
+
+// HuaWeiListAccountCall wrap *gomock.Call
+type HuaWeiListAccountCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListAccountCall) Return(arg0 []account.HuaWeiAccount, arg1 error) *HuaWeiListAccountCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListAccountCall) Do(f func(*kit.Kit) ([]account.HuaWeiAccount, error)) *HuaWeiListAccountCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListAccountCall) DoAndReturn(f func(*kit.Kit) ([]account.HuaWeiAccount, error)) *HuaWeiListAccountCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListBucket mocks base method.
+func (m *MockHuaWei) ListBucket(kt *kit.Kit, opt *bucket.HuaWeiBucketListOption) ([]bucket.HuaWeiBucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBucket", kt, opt)
+	ret0, _ := ret[0].([]bucket.HuaWeiBucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBucket indicates an expected call of ListBucket.
+func (mr *MockHuaWeiMockRecorder) ListBucket(kt, opt interface{}) *HuaWeiListBucketCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBucket", reflect.TypeOf((*MockHuaWei)(nil).ListBucket), kt, opt)
+	return &HuaWeiListBucketCall{Call: call}
+}
+
+// HuaWeiListBucketCall wrap *gomock.Call
+type HuaWeiListBucketCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListBucketCall) Return(arg0 []bucket.HuaWeiBucket, arg1 error) *HuaWeiListBucketCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListBucketCall) Do(f func(*kit.Kit, *bucket.HuaWeiBucketListOption) ([]bucket.HuaWeiBucket, error)) *HuaWeiListBucketCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListBucketCall) DoAndReturn(f func(*kit.Kit, *bucket.HuaWeiBucketListOption) ([]bucket.HuaWeiBucket, error)) *HuaWeiListBucketCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListCvm mocks base method.
+func (m *MockHuaWei) ListCvm(kt *kit.Kit, opt *cvm.HuaWeiListOption) ([]cvm.HuaWeiCvm, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCvm", kt, opt)
+	ret0, _ := ret[0].([]cvm.HuaWeiCvm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCvm indicates an expected call of ListCvm.
+func (mr *MockHuaWeiMockRecorder) ListCvm(kt, opt interface{}) *HuaWeiListCvmCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCvm", reflect.TypeOf((*MockHuaWei)(nil).ListCvm), kt, opt)
+	return &HuaWeiListCvmCall{Call: call}
+}
+
+// HuaWeiListCvmCall wrap *gomock.Call
+type HuaWeiListCvmCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListCvmCall) Return(arg0 []cvm.HuaWeiCvm, arg1 error) *HuaWeiListCvmCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListCvmCall) Do(f func(*kit.Kit, *cvm.HuaWeiListOption) ([]cvm.HuaWeiCvm, error)) *HuaWeiListCvmCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListCvmCall) DoAndReturn(f func(*kit.Kit, *cvm.HuaWeiListOption) ([]cvm.HuaWeiCvm, error)) *HuaWeiListCvmCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListDatabaseInstance mocks base method.
+func (m *MockHuaWei) ListDatabaseInstance(kt *kit.Kit, opt *databaseinstance.HuaWeiDBInstanceListOption) ([]databaseinstance.HuaWeiDBInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDatabaseInstance", kt, opt)
+	ret0, _ := ret[0].([]databaseinstance.HuaWeiDBInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDatabaseInstance indicates an expected call of ListDatabaseInstance.
+func (mr *MockHuaWeiMockRecorder) ListDatabaseInstance(kt, opt interface{}) *HuaWeiListDatabaseInstanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDatabaseInstance", reflect.TypeOf((*MockHuaWei)(nil).ListDatabaseInstance), kt, opt)
+	return &HuaWeiListDatabaseInstanceCall{Call: call}
+}
+
+// HuaWeiListDatabaseInstanceCall wrap *gomock.Call
+type HuaWeiListDatabaseInstanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListDatabaseInstanceCall) Return(arg0 []databaseinstance.HuaWeiDBInstance, arg1 error) *HuaWeiListDatabaseInstanceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListDatabaseInstanceCall) Do(f func(*kit.Kit, *databaseinstance.HuaWeiDBInstanceListOption) ([]databaseinstance.HuaWeiDBInstance, error)) *HuaWeiListDatabaseInstanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListDatabaseInstanceCall) DoAndReturn(f func(*kit.Kit, *databaseinstance.HuaWeiDBInstanceListOption) ([]databaseinstance.HuaWeiDBInstance, error)) *HuaWeiListDatabaseInstanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListDisk mocks base method.
+func (m *MockHuaWei) ListDisk(kt *kit.Kit, opt *disk.HuaWeiDiskListOption) ([]disk.HuaWeiDisk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDisk", kt, opt)
+	ret0, _ := ret[0].([]disk.HuaWeiDisk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDisk indicates an expected call of ListDisk.
+func (mr *MockHuaWeiMockRecorder) ListDisk(kt, opt interface{}) *HuaWeiListDiskCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDisk", reflect.TypeOf((*MockHuaWei)(nil).ListDisk), kt, opt)
+	return &HuaWeiListDiskCall{Call: call}
+}
+
+// HuaWeiListDiskCall wrap *gomock.Call
+type HuaWeiListDiskCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListDiskCall) Return(arg0 []disk.HuaWeiDisk, arg1 error) *HuaWeiListDiskCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListDiskCall) Do(f func(*kit.Kit, *disk.HuaWeiDiskListOption) ([]disk.HuaWeiDisk, error)) *HuaWeiListDiskCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListDiskCall) DoAndReturn(f func(*kit.Kit, *disk.HuaWeiDiskListOption) ([]disk.HuaWeiDisk, error)) *HuaWeiListDiskCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListEip mocks base method.
+func (m *MockHuaWei) ListEip(kt *kit.Kit, opt *eip.HuaWeiEipListOption) (*eip.HuaWeiEipListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEip", kt, opt)
+	ret0, _ := ret[0].(*eip.HuaWeiEipListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEip indicates an expected call of ListEip.
+func (mr *MockHuaWeiMockRecorder) ListEip(kt, opt interface{}) *HuaWeiListEipCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEip", reflect.TypeOf((*MockHuaWei)(nil).ListEip), kt, opt)
+	return &HuaWeiListEipCall{Call: call}
+}
+
+// HuaWeiListEipCall wrap *gomock.Call
+type HuaWeiListEipCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListEipCall) Return(arg0 *eip.HuaWeiEipListResult, arg1 error) *HuaWeiListEipCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListEipCall) Do(f func(*kit.Kit, *eip.HuaWeiEipListOption) (*eip.HuaWeiEipListResult, error)) *HuaWeiListEipCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListEipCall) DoAndReturn(f func(*kit.Kit, *eip.HuaWeiEipListOption) (*eip.HuaWeiEipListResult, error)) *HuaWeiListEipCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListImage mocks base method.
+func (m *MockHuaWei) ListImage(kt *kit.Kit, opt *image.HuaWeiImageListOption) (*image.HuaWeiImageListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImage", kt, opt)
+	ret0, _ := ret[0].(*image.HuaWeiImageListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImage indicates an expected call of ListImage.
+func (mr *MockHuaWeiMockRecorder) ListImage(kt, opt interface{}) *HuaWeiListImageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImage", reflect.TypeOf((*MockHuaWei)(nil).ListImage), kt, opt)
+	return &HuaWeiListImageCall{Call: call}
+}
+
+// HuaWeiListImageCall wrap *gomock.Call
+type HuaWeiListImageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListImageCall) Return(arg0 *image.HuaWeiImageListResult, arg1 error) *HuaWeiListImageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListImageCall) Do(f func(*kit.Kit, *image.HuaWeiImageListOption) (*image.HuaWeiImageListResult, error)) *HuaWeiListImageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListImageCall) DoAndReturn(f func(*kit.Kit, *image.HuaWeiImageListOption) (*image.HuaWeiImageListResult, error)) *HuaWeiListImageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListInstanceType mocks base method.
+func (m *MockHuaWei) ListInstanceType(kt *kit.Kit, opt *instancetype.HuaWeiInstanceTypeListOption) ([]*instancetype.HuaWeiInstanceType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstanceType", kt, opt)
+	ret0, _ := ret[0].([]*instancetype.HuaWeiInstanceType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInstanceType indicates an expected call of ListInstanceType.
+func (mr *MockHuaWeiMockRecorder) ListInstanceType(kt, opt interface{}) *HuaWeiListInstanceTypeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceType", reflect.TypeOf((*MockHuaWei)(nil).ListInstanceType), kt, opt)
+	return &HuaWeiListInstanceTypeCall{Call: call}
+}
+
+// HuaWeiListInstanceTypeCall wrap *gomock.Call
+type HuaWeiListInstanceTypeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListInstanceTypeCall) Return(arg0 []*instancetype.HuaWeiInstanceType, arg1 error) *HuaWeiListInstanceTypeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListInstanceTypeCall) Do(f func(*kit.Kit, *instancetype.HuaWeiInstanceTypeListOption) ([]*instancetype.HuaWeiInstanceType, error)) *HuaWeiListInstanceTypeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListInstanceTypeCall) DoAndReturn(f func(*kit.Kit, *instancetype.HuaWeiInstanceTypeListOption) ([]*instancetype.HuaWeiInstanceType, error)) *HuaWeiListInstanceTypeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListK8sCluster mocks base method.
+func (m *MockHuaWei) ListK8sCluster(kt *kit.Kit, opt *k8scluster.HuaWeiK8sClusterListOption) ([]k8scluster.HuaWeiCluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListK8sCluster", kt, opt)
+	ret0, _ := ret[0].([]k8scluster.HuaWeiCluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListK8sCluster indicates an expected call of ListK8sCluster.
+func (mr *MockHuaWeiMockRecorder) ListK8sCluster(kt, opt interface{}) *HuaWeiListK8sClusterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListK8sCluster", reflect.TypeOf((*MockHuaWei)(nil).ListK8sCluster), kt, opt)
+	return &HuaWeiListK8sClusterCall{Call: call}
+}
+
+// HuaWeiListK8sClusterCall wrap *gomock.Call
+type HuaWeiListK8sClusterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListK8sClusterCall) Return(arg0 []k8scluster.HuaWeiCluster, arg1 error) *HuaWeiListK8sClusterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListK8sClusterCall) Do(f func(*kit.Kit, *k8scluster.HuaWeiK8sClusterListOption) ([]k8scluster.HuaWeiCluster, error)) *HuaWeiListK8sClusterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListK8sClusterCall) DoAndReturn(f func(*kit.Kit, *k8scluster.HuaWeiK8sClusterListOption) ([]k8scluster.HuaWeiCluster, error)) *HuaWeiListK8sClusterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListK8sNode mocks base method.
+func (m *MockHuaWei) ListK8sNode(kt *kit.Kit, opt *k8scluster.HuaWeiK8sNodeListOption) ([]k8scluster.K8sNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListK8sNode", kt, opt)
+	ret0, _ := ret[0].([]k8scluster.K8sNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListK8sNode indicates an expected call of ListK8sNode.
+func (mr *MockHuaWeiMockRecorder) ListK8sNode(kt, opt interface{}) *HuaWeiListK8sNodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListK8sNode", reflect.TypeOf((*MockHuaWei)(nil).ListK8sNode), kt, opt)
+	return &HuaWeiListK8sNodeCall{Call: call}
+}
+
+// HuaWeiListK8sNodeCall wrap *gomock.Call
+type HuaWeiListK8sNodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListK8sNodeCall) Return(arg0 []k8scluster.K8sNode, arg1 error) *HuaWeiListK8sNodeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListK8sNodeCall) Do(f func(*kit.Kit, *k8scluster.HuaWeiK8sNodeListOption) ([]k8scluster.K8sNode, error)) *HuaWeiListK8sNodeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListK8sNodeCall) DoAndReturn(f func(*kit.Kit, *k8scluster.HuaWeiK8sNodeListOption) ([]k8scluster.K8sNode, error)) *HuaWeiListK8sNodeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListK8sNodePool mocks base method.
+func (m *MockHuaWei) ListK8sNodePool(kt *kit.Kit, opt *k8scluster.HuaWeiK8sNodeListOption) ([]k8scluster.K8sNodePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListK8sNodePool", kt, opt)
+	ret0, _ := ret[0].([]k8scluster.K8sNodePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListK8sNodePool indicates an expected call of ListK8sNodePool.
+func (mr *MockHuaWeiMockRecorder) ListK8sNodePool(kt, opt interface{}) *HuaWeiListK8sNodePoolCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListK8sNodePool", reflect.TypeOf((*MockHuaWei)(nil).ListK8sNodePool), kt, opt)
+	return &HuaWeiListK8sNodePoolCall{Call: call}
+}
+
+// HuaWeiListK8sNodePoolCall wrap *gomock.Call
+type HuaWeiListK8sNodePoolCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListK8sNodePoolCall) Return(arg0 []k8scluster.K8sNodePool, arg1 error) *HuaWeiListK8sNodePoolCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListK8sNodePoolCall) Do(f func(*kit.Kit, *k8scluster.HuaWeiK8sNodeListOption) ([]k8scluster.K8sNodePool, error)) *HuaWeiListK8sNodePoolCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListK8sNodePoolCall) DoAndReturn(f func(*kit.Kit, *k8scluster.HuaWeiK8sNodeListOption) ([]k8scluster.K8sNodePool, error)) *HuaWeiListK8sNodePoolCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListKeyPair mocks base method.
+func (m *MockHuaWei) ListKeyPair(kt *kit.Kit, opt *keypair.HuaWeiKeyPairListOption) ([]keypair.HuaWeiKeyPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKeyPair", kt, opt)
+	ret0, _ := ret[0].([]keypair.HuaWeiKeyPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKeyPair indicates an expected call of ListKeyPair.
+func (mr *MockHuaWeiMockRecorder) ListKeyPair(kt, opt interface{}) *HuaWeiListKeyPairCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKeyPair", reflect.TypeOf((*MockHuaWei)(nil).ListKeyPair), kt, opt)
+	return &HuaWeiListKeyPairCall{Call: call}
+}
+
+// HuaWeiListKeyPairCall wrap *gomock.Call
+type HuaWeiListKeyPairCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListKeyPairCall) Return(arg0 []keypair.HuaWeiKeyPair, arg1 error) *HuaWeiListKeyPairCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListKeyPairCall) Do(f func(*kit.Kit, *keypair.HuaWeiKeyPairListOption) ([]keypair.HuaWeiKeyPair, error)) *HuaWeiListKeyPairCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListKeyPairCall) DoAndReturn(f func(*kit.Kit, *keypair.HuaWeiKeyPairListOption) ([]keypair.HuaWeiKeyPair, error)) *HuaWeiListKeyPairCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListLoadBalancer mocks base method.
+func (m *MockHuaWei) ListLoadBalancer(kt *kit.Kit, opt *core.HuaWeiListOption) (*loadbalancer.HuaWeiListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoadBalancer", kt, opt)
+	ret0, _ := ret[0].(*loadbalancer.HuaWeiListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoadBalancer indicates an expected call of ListLoadBalancer.
+func (mr *MockHuaWeiMockRecorder) ListLoadBalancer(kt, opt interface{}) *HuaWeiListLoadBalancerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancer", reflect.TypeOf((*MockHuaWei)(nil).ListLoadBalancer), kt, opt)
+	return &HuaWeiListLoadBalancerCall{Call: call}
+}
+
+// HuaWeiListLoadBalancerCall wrap *gomock.Call
+type HuaWeiListLoadBalancerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListLoadBalancerCall) Return(arg0 *loadbalancer.HuaWeiListResult, arg1 error) *HuaWeiListLoadBalancerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListLoadBalancerCall) Do(f func(*kit.Kit, *core.HuaWeiListOption) (*loadbalancer.HuaWeiListResult, error)) *HuaWeiListLoadBalancerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListLoadBalancerCall) DoAndReturn(f func(*kit.Kit, *core.HuaWeiListOption) (*loadbalancer.HuaWeiListResult, error)) *HuaWeiListLoadBalancerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListNatGateway mocks base method.
+func (m *MockHuaWei) ListNatGateway(kt *kit.Kit, opt *natgateway.HuaWeiNatGatewayListOption) ([]natgateway.HuaWeiNatGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNatGateway", kt, opt)
+	ret0, _ := ret[0].([]natgateway.HuaWeiNatGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNatGateway indicates an expected call of ListNatGateway.
+func (mr *MockHuaWeiMockRecorder) ListNatGateway(kt, opt interface{}) *HuaWeiListNatGatewayCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNatGateway", reflect.TypeOf((*MockHuaWei)(nil).ListNatGateway), kt, opt)
+	return &HuaWeiListNatGatewayCall{Call: call}
+}
+
+// HuaWeiListNatGatewayCall wrap *gomock.Call
+type HuaWeiListNatGatewayCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListNatGatewayCall) Return(arg0 []natgateway.HuaWeiNatGateway, arg1 error) *HuaWeiListNatGatewayCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListNatGatewayCall) Do(f func(*kit.Kit, *natgateway.HuaWeiNatGatewayListOption) ([]natgateway.HuaWeiNatGateway, error)) *HuaWeiListNatGatewayCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListNatGatewayCall) DoAndReturn(f func(*kit.Kit, *natgateway.HuaWeiNatGatewayListOption) ([]natgateway.HuaWeiNatGateway, error)) *HuaWeiListNatGatewayCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListNetworkInterface mocks base method.
+func (m *MockHuaWei) ListNetworkInterface(kt *kit.Kit, opt *networkinterface.HuaWeiNIListOption) (*networkinterface.HuaWeiInterfaceListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworkInterface", kt, opt)
+	ret0, _ := ret[0].(*networkinterface.HuaWeiInterfaceListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkInterface indicates an expected call of ListNetworkInterface.
+func (mr *MockHuaWeiMockRecorder) ListNetworkInterface(kt, opt interface{}) *HuaWeiListNetworkInterfaceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkInterface", reflect.TypeOf((*MockHuaWei)(nil).ListNetworkInterface), kt, opt)
+	return &HuaWeiListNetworkInterfaceCall{Call: call}
+}
+
+// HuaWeiListNetworkInterfaceCall wrap *gomock.Call
+type HuaWeiListNetworkInterfaceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListNetworkInterfaceCall) Return(arg0 *networkinterface.HuaWeiInterfaceListResult, arg1 error) *HuaWeiListNetworkInterfaceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListNetworkInterfaceCall) Do(f func(*kit.Kit, *networkinterface.HuaWeiNIListOption) (*networkinterface.HuaWeiInterfaceListResult, error)) *HuaWeiListNetworkInterfaceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListNetworkInterfaceCall) DoAndReturn(f func(*kit.Kit, *networkinterface.HuaWeiNIListOption) (*networkinterface.HuaWeiInterfaceListResult, error)) *HuaWeiListNetworkInterfaceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListPrivateDnsRecord mocks base method.
+func (m *MockHuaWei) ListPrivateDnsRecord(kt *kit.Kit, opt *typesdns.HuaWeiRecordListOption) ([]typesdns.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPrivateDnsRecord", kt, opt)
+	ret0, _ := ret[0].([]typesdns.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPrivateDnsRecord indicates an expected call of ListPrivateDnsRecord.
+func (mr *MockHuaWeiMockRecorder) ListPrivateDnsRecord(kt, opt interface{}) *HuaWeiListPrivateDnsRecordCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrivateDnsRecord", reflect.TypeOf((*MockHuaWei)(nil).ListPrivateDnsRecord), kt, opt)
+	return &HuaWeiListPrivateDnsRecordCall{Call: call}
+}
+
+// HuaWeiListPrivateDnsRecordCall wrap *gomock.Call
+type HuaWeiListPrivateDnsRecordCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListPrivateDnsRecordCall) Return(arg0 []typesdns.Record, arg1 error) *HuaWeiListPrivateDnsRecordCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListPrivateDnsRecordCall) Do(f func(*kit.Kit, *typesdns.HuaWeiRecordListOption) ([]typesdns.Record, error)) *HuaWeiListPrivateDnsRecordCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListPrivateDnsRecordCall) DoAndReturn(f func(*kit.Kit, *typesdns.HuaWeiRecordListOption) ([]typesdns.Record, error)) *HuaWeiListPrivateDnsRecordCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListPrivateDnsZone mocks base method.
+func (m *MockHuaWei) ListPrivateDnsZone(kt *kit.Kit, opt *typesdns.HuaWeiZoneListOption) ([]typesdns.HuaWeiZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPrivateDnsZone", kt, opt)
+	ret0, _ := ret[0].([]typesdns.HuaWeiZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPrivateDnsZone indicates an expected call of ListPrivateDnsZone.
+func (mr *MockHuaWeiMockRecorder) ListPrivateDnsZone(kt, opt interface{}) *HuaWeiListPrivateDnsZoneCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrivateDnsZone", reflect.TypeOf((*MockHuaWei)(nil).ListPrivateDnsZone), kt, opt)
+	return &HuaWeiListPrivateDnsZoneCall{Call: call}
+}
+
+// HuaWeiListPrivateDnsZoneCall wrap *gomock.Call
+type HuaWeiListPrivateDnsZoneCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListPrivateDnsZoneCall) Return(arg0 []typesdns.HuaWeiZone, arg1 error) *HuaWeiListPrivateDnsZoneCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListPrivateDnsZoneCall) Do(f func(*kit.Kit, *typesdns.HuaWeiZoneListOption) ([]typesdns.HuaWeiZone, error)) *HuaWeiListPrivateDnsZoneCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListPrivateDnsZoneCall) DoAndReturn(f func(*kit.Kit, *typesdns.HuaWeiZoneListOption) ([]typesdns.HuaWeiZone, error)) *HuaWeiListPrivateDnsZoneCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListRegion mocks base method.
+func (m *MockHuaWei) ListRegion(kt *kit.Kit) ([]*region.HuaWeiRegionModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRegion", kt)
+	ret0, _ := ret[0].([]*region.HuaWeiRegionModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRegion indicates an expected call of ListRegion.
+func (mr *MockHuaWeiMockRecorder) ListRegion(kt interface{}) *HuaWeiListRegionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegion", reflect.TypeOf((*MockHuaWei)(nil).ListRegion), kt)
+	return &HuaWeiListRegionCall{Call: call}
+}
+
+// HuaWeiListRegionCall wrap *gomock.Call
+type HuaWeiListRegionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListRegionCall) Return(arg0 []*region.HuaWeiRegionModel, arg1 error) *HuaWeiListRegionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListRegionCall) Do(f func(*kit.Kit) ([]*region.HuaWeiRegionModel, error)) *HuaWeiListRegionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListRegionCall) DoAndReturn(f func(*kit.Kit) ([]*region.HuaWeiRegionModel, error)) *HuaWeiListRegionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListRouteTableIDs mocks base method.
+func (m *MockHuaWei) ListRouteTableIDs(kt *kit.Kit, opt *routetable.HuaWeiRouteTableListOption) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRouteTableIDs", kt, opt)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRouteTableIDs indicates an expected call of ListRouteTableIDs.
+func (mr *MockHuaWeiMockRecorder) ListRouteTableIDs(kt, opt interface{}) *HuaWeiListRouteTableIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRouteTableIDs", reflect.TypeOf((*MockHuaWei)(nil).ListRouteTableIDs), kt, opt)
+	return &HuaWeiListRouteTableIDsCall{Call: call}
+}
+
+// HuaWeiListRouteTableIDsCall wrap *gomock.Call
+type HuaWeiListRouteTableIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListRouteTableIDsCall) Return(arg0 []string, arg1 error) *HuaWeiListRouteTableIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListRouteTableIDsCall) Do(f func(*kit.Kit, *routetable.HuaWeiRouteTableListOption) ([]string, error)) *HuaWeiListRouteTableIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListRouteTableIDsCall) DoAndReturn(f func(*kit.Kit, *routetable.HuaWeiRouteTableListOption) ([]string, error)) *HuaWeiListRouteTableIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListRouteTables mocks base method.
+func (m *MockHuaWei) ListRouteTables(kt *kit.Kit, opt *routetable.HuaWeiRouteTableListOption) ([]routetable.HuaWeiRouteTable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRouteTables", kt, opt)
+	ret0, _ := ret[0].([]routetable.HuaWeiRouteTable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRouteTables indicates an expected call of ListRouteTables.
+func (mr *MockHuaWeiMockRecorder) ListRouteTables(kt, opt interface{}) *HuaWeiListRouteTablesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRouteTables", reflect.TypeOf((*MockHuaWei)(nil).ListRouteTables), kt, opt)
+	return &HuaWeiListRouteTablesCall{Call: call}
+}
+
+// HuaWeiListRouteTablesCall wrap *gomock.Call
+type HuaWeiListRouteTablesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListRouteTablesCall) Return(arg0 []routetable.HuaWeiRouteTable, arg1 error) *HuaWeiListRouteTablesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListRouteTablesCall) Do(f func(*kit.Kit, *routetable.HuaWeiRouteTableListOption) ([]routetable.HuaWeiRouteTable, error)) *HuaWeiListRouteTablesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListRouteTablesCall) DoAndReturn(f func(*kit.Kit, *routetable.HuaWeiRouteTableListOption) ([]routetable.HuaWeiRouteTable, error)) *HuaWeiListRouteTablesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSecurityGroup mocks base method.
+func (m *MockHuaWei) ListSecurityGroup(kt *kit.Kit, opt *securitygroup.HuaWeiListOption) ([]securitygroup.HuaWeiSG, *model2.PageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecurityGroup", kt, opt)
+	ret0, _ := ret[0].([]securitygroup.HuaWeiSG)
+	ret1, _ := ret[1].(*model2.PageInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListSecurityGroup indicates an expected call of ListSecurityGroup.
+func (mr *MockHuaWeiMockRecorder) ListSecurityGroup(kt, opt interface{}) *HuaWeiListSecurityGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityGroup", reflect.TypeOf((*MockHuaWei)(nil).ListSecurityGroup), kt, opt)
+	return &HuaWeiListSecurityGroupCall{Call: call}
+}
+
+// HuaWeiListSecurityGroupCall wrap *gomock.Call
+type HuaWeiListSecurityGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListSecurityGroupCall) Return(arg0 []securitygroup.HuaWeiSG, arg1 *model2.PageInfo, arg2 error) *HuaWeiListSecurityGroupCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListSecurityGroupCall) Do(f func(*kit.Kit, *securitygroup.HuaWeiListOption) ([]securitygroup.HuaWeiSG, *model2.PageInfo, error)) *HuaWeiListSecurityGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListSecurityGroupCall) DoAndReturn(f func(*kit.Kit, *securitygroup.HuaWeiListOption) ([]securitygroup.HuaWeiSG, *model2.PageInfo, error)) *HuaWeiListSecurityGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSecurityGroupRule mocks base method.
+func (m *MockHuaWei) ListSecurityGroupRule(kt *kit.Kit, opt *securitygrouprule.HuaWeiListOption) ([]securitygrouprule.HuaWeiSGRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecurityGroupRule", kt, opt)
+	ret0, _ := ret[0].([]securitygrouprule.HuaWeiSGRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecurityGroupRule indicates an expected call of ListSecurityGroupRule.
+func (mr *MockHuaWeiMockRecorder) ListSecurityGroupRule(kt, opt interface{}) *HuaWeiListSecurityGroupRuleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityGroupRule", reflect.TypeOf((*MockHuaWei)(nil).ListSecurityGroupRule), kt, opt)
+	return &HuaWeiListSecurityGroupRuleCall{Call: call}
+}
+
+// HuaWeiListSecurityGroupRuleCall wrap *gomock.Call
+type HuaWeiListSecurityGroupRuleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListSecurityGroupRuleCall) Return(arg0 []securitygrouprule.HuaWeiSGRule, arg1 error) *HuaWeiListSecurityGroupRuleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListSecurityGroupRuleCall) Do(f func(*kit.Kit, *securitygrouprule.HuaWeiListOption) ([]securitygrouprule.HuaWeiSGRule, error)) *HuaWeiListSecurityGroupRuleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListSecurityGroupRuleCall) DoAndReturn(f func(*kit.Kit, *securitygrouprule.HuaWeiListOption) ([]securitygrouprule.HuaWeiSGRule, error)) *HuaWeiListSecurityGroupRuleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSnapshot mocks base method.
+func (m *MockHuaWei) ListSnapshot(kt *kit.Kit, opt *snapshot.HuaWeiSnapshotListOption) ([]snapshot.HuaWeiSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnapshot", kt, opt)
+	ret0, _ := ret[0].([]snapshot.HuaWeiSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshot indicates an expected call of ListSnapshot.
+func (mr *MockHuaWeiMockRecorder) ListSnapshot(kt, opt interface{}) *HuaWeiListSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshot", reflect.TypeOf((*MockHuaWei)(nil).ListSnapshot), kt, opt)
+	return &HuaWeiListSnapshotCall{Call: call}
+}
+
+// HuaWeiListSnapshotCall wrap *gomock.Call
+type HuaWeiListSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListSnapshotCall) Return(arg0 []snapshot.HuaWeiSnapshot, arg1 error) *HuaWeiListSnapshotCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListSnapshotCall) Do(f func(*kit.Kit, *snapshot.HuaWeiSnapshotListOption) ([]snapshot.HuaWeiSnapshot, error)) *HuaWeiListSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListSnapshotCall) DoAndReturn(f func(*kit.Kit, *snapshot.HuaWeiSnapshotListOption) ([]snapshot.HuaWeiSnapshot, error)) *HuaWeiListSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSubnet mocks base method.
+func (m *MockHuaWei) ListSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetListOption) (*adtysubnet.HuaWeiSubnetListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubnet", kt, opt)
+	ret0, _ := ret[0].(*adtysubnet.HuaWeiSubnetListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubnet indicates an expected call of ListSubnet.
+func (mr *MockHuaWeiMockRecorder) ListSubnet(kt, opt interface{}) *HuaWeiListSubnetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubnet", reflect.TypeOf((*MockHuaWei)(nil).ListSubnet), kt, opt)
+	return &HuaWeiListSubnetCall{Call: call}
+}
+
+// HuaWeiListSubnetCall wrap *gomock.Call
+type HuaWeiListSubnetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListSubnetCall) Return(arg0 *adtysubnet.HuaWeiSubnetListResult, arg1 error) *HuaWeiListSubnetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListSubnetCall) Do(f func(*kit.Kit, *adtysubnet.HuaWeiSubnetListOption) (*adtysubnet.HuaWeiSubnetListResult, error)) *HuaWeiListSubnetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListSubnetCall) DoAndReturn(f func(*kit.Kit, *adtysubnet.HuaWeiSubnetListOption) (*adtysubnet.HuaWeiSubnetListResult, error)) *HuaWeiListSubnetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSubnetByID mocks base method.
+func (m *MockHuaWei) ListSubnetByID(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetListByIDOption) (*adtysubnet.HuaWeiSubnetListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubnetByID", kt, opt)
+	ret0, _ := ret[0].(*adtysubnet.HuaWeiSubnetListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubnetByID indicates an expected call of ListSubnetByID.
+func (mr *MockHuaWeiMockRecorder) ListSubnetByID(kt, opt interface{}) *HuaWeiListSubnetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubnetByID", reflect.TypeOf((*MockHuaWei)(nil).ListSubnetByID), kt, opt)
+	return &HuaWeiListSubnetByIDCall{Call: call}
+}
+
+// HuaWeiListSubnetByIDCall wrap *gomock.Call
+type HuaWeiListSubnetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListSubnetByIDCall) Return(arg0 *adtysubnet.HuaWeiSubnetListResult, arg1 error) *HuaWeiListSubnetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListSubnetByIDCall) Do(f func(*kit.Kit, *adtysubnet.HuaWeiSubnetListByIDOption) (*adtysubnet.HuaWeiSubnetListResult, error)) *HuaWeiListSubnetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListSubnetByIDCall) DoAndReturn(f func(*kit.Kit, *adtysubnet.HuaWeiSubnetListByIDOption) (*adtysubnet.HuaWeiSubnetListResult, error)) *HuaWeiListSubnetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListVpc mocks base method.
+func (m *MockHuaWei) ListVpc(kt *kit.Kit, opt *types.HuaWeiVpcListOption) (*types.HuaWeiVpcListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVpc", kt, opt)
+	ret0, _ := ret[0].(*types.HuaWeiVpcListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVpc indicates an expected call of ListVpc.
+func (mr *MockHuaWeiMockRecorder) ListVpc(kt, opt interface{}) *HuaWeiListVpcCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpc", reflect.TypeOf((*MockHuaWei)(nil).ListVpc), kt, opt)
+	return &HuaWeiListVpcCall{Call: call}
+}
+
+// HuaWeiListVpcCall wrap *gomock.Call
+type HuaWeiListVpcCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListVpcCall) Return(arg0 *types.HuaWeiVpcListResult, arg1 error) *HuaWeiListVpcCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListVpcCall) Do(f func(*kit.Kit, *types.HuaWeiVpcListOption) (*types.HuaWeiVpcListResult, error)) *HuaWeiListVpcCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListVpcCall) DoAndReturn(f func(*kit.Kit, *types.HuaWeiVpcListOption) (*types.HuaWeiVpcListResult, error)) *HuaWeiListVpcCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListVpcPeering mocks base method.
+func (m *MockHuaWei) ListVpcPeering(kt *kit.Kit, opt *vpcpeering.HuaWeiVpcPeeringListOption) ([]vpcpeering.HuaWeiVpcPeering, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVpcPeering", kt, opt)
+	ret0, _ := ret[0].([]vpcpeering.HuaWeiVpcPeering)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVpcPeering indicates an expected call of ListVpcPeering.
+func (mr *MockHuaWeiMockRecorder) ListVpcPeering(kt, opt interface{}) *HuaWeiListVpcPeeringCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpcPeering", reflect.TypeOf((*MockHuaWei)(nil).ListVpcPeering), kt, opt)
+	return &HuaWeiListVpcPeeringCall{Call: call}
+}
+
+// HuaWeiListVpcPeeringCall wrap *gomock.Call
+type HuaWeiListVpcPeeringCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListVpcPeeringCall) Return(arg0 []vpcpeering.HuaWeiVpcPeering, arg1 error) *HuaWeiListVpcPeeringCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListVpcPeeringCall) Do(f func(*kit.Kit, *vpcpeering.HuaWeiVpcPeeringListOption) ([]vpcpeering.HuaWeiVpcPeering, error)) *HuaWeiListVpcPeeringCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListVpcPeeringCall) DoAndReturn(f func(*kit.Kit, *vpcpeering.HuaWeiVpcPeeringListOption) ([]vpcpeering.HuaWeiVpcPeering, error)) *HuaWeiListVpcPeeringCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListVpcRaw mocks base method.
+func (m *MockHuaWei) ListVpcRaw(kt *kit.Kit, opt *types.HuaWeiVpcListOption) (*model2.ListVpcsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVpcRaw", kt, opt)
+	ret0, _ := ret[0].(*model2.ListVpcsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVpcRaw indicates an expected call of ListVpcRaw.
+func (mr *MockHuaWeiMockRecorder) ListVpcRaw(kt, opt interface{}) *HuaWeiListVpcRawCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpcRaw", reflect.TypeOf((*MockHuaWei)(nil).ListVpcRaw), kt, opt)
+	return &HuaWeiListVpcRawCall{Call: call}
+}
+
+// HuaWeiListVpcRawCall wrap *gomock.Call
+type HuaWeiListVpcRawCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListVpcRawCall) Return(arg0 *model2.ListVpcsResponse, arg1 error) *HuaWeiListVpcRawCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListVpcRawCall) Do(f func(*kit.Kit, *types.HuaWeiVpcListOption) (*model2.ListVpcsResponse, error)) *HuaWeiListVpcRawCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListVpcRawCall) DoAndReturn(f func(*kit.Kit, *types.HuaWeiVpcListOption) (*model2.ListVpcsResponse, error)) *HuaWeiListVpcRawCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListZone mocks base method.
+func (m *MockHuaWei) ListZone(kt *kit.Kit, opt *zone.HuaWeiZoneListOption) ([]zone.HuaWeiZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListZone", kt, opt)
+	ret0, _ := ret[0].([]zone.HuaWeiZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListZone indicates an expected call of ListZone.
+func (mr *MockHuaWeiMockRecorder) ListZone(kt, opt interface{}) *HuaWeiListZoneCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZone", reflect.TypeOf((*MockHuaWei)(nil).ListZone), kt, opt)
+	return &HuaWeiListZoneCall{Call: call}
+}
+
+// HuaWeiListZoneCall wrap *gomock.Call
+type HuaWeiListZoneCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiListZoneCall) Return(arg0 []zone.HuaWeiZone, arg1 error) *HuaWeiListZoneCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiListZoneCall) Do(f func(*kit.Kit, *zone.HuaWeiZoneListOption) ([]zone.HuaWeiZone, error)) *HuaWeiListZoneCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiListZoneCall) DoAndReturn(f func(*kit.Kit, *zone.HuaWeiZoneListOption) ([]zone.HuaWeiZone, error)) *HuaWeiListZoneCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RebootCvm mocks base method.
+func (m *MockHuaWei) RebootCvm(kt *kit.Kit, opt *cvm.HuaWeiRebootOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebootCvm", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebootCvm indicates an expected call of RebootCvm.
+func (mr *MockHuaWeiMockRecorder) RebootCvm(kt, opt interface{}) *HuaWeiRebootCvmCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootCvm", reflect.TypeOf((*MockHuaWei)(nil).RebootCvm), kt, opt)
+	return &HuaWeiRebootCvmCall{Call: call}
+}
+
+// HuaWeiRebootCvmCall wrap *gomock.Call
+type HuaWeiRebootCvmCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiRebootCvmCall) Return(arg0 error) *HuaWeiRebootCvmCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiRebootCvmCall) Do(f func(*kit.Kit, *cvm.HuaWeiRebootOption) error) *HuaWeiRebootCvmCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiRebootCvmCall) DoAndReturn(f func(*kit.Kit, *cvm.HuaWeiRebootOption) error) *HuaWeiRebootCvmCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ResetCvmPwd mocks base method.
+func (m *MockHuaWei) ResetCvmPwd(kt *kit.Kit, opt *cvm.HuaWeiResetPwdOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetCvmPwd", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetCvmPwd indicates an expected call of ResetCvmPwd.
+func (mr *MockHuaWeiMockRecorder) ResetCvmPwd(kt, opt interface{}) *HuaWeiResetCvmPwdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetCvmPwd", reflect.TypeOf((*MockHuaWei)(nil).ResetCvmPwd), kt, opt)
+	return &HuaWeiResetCvmPwdCall{Call: call}
+}
+
+// HuaWeiResetCvmPwdCall wrap *gomock.Call
+type HuaWeiResetCvmPwdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiResetCvmPwdCall) Return(arg0 error) *HuaWeiResetCvmPwdCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiResetCvmPwdCall) Do(f func(*kit.Kit, *cvm.HuaWeiResetPwdOption) error) *HuaWeiResetCvmPwdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiResetCvmPwdCall) DoAndReturn(f func(*kit.Kit, *cvm.HuaWeiResetPwdOption) error) *HuaWeiResetCvmPwdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackSnapshot mocks base method.
+func (m *MockHuaWei) RollbackSnapshot(kt *kit.Kit, opt *snapshot.HuaWeiSnapshotRollbackOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackSnapshot", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackSnapshot indicates an expected call of RollbackSnapshot.
+func (mr *MockHuaWeiMockRecorder) RollbackSnapshot(kt, opt interface{}) *HuaWeiRollbackSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackSnapshot", reflect.TypeOf((*MockHuaWei)(nil).RollbackSnapshot), kt, opt)
+	return &HuaWeiRollbackSnapshotCall{Call: call}
+}
+
+// HuaWeiRollbackSnapshotCall wrap *gomock.Call
+type HuaWeiRollbackSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiRollbackSnapshotCall) Return(arg0 error) *HuaWeiRollbackSnapshotCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiRollbackSnapshotCall) Do(f func(*kit.Kit, *snapshot.HuaWeiSnapshotRollbackOption) error) *HuaWeiRollbackSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiRollbackSnapshotCall) DoAndReturn(f func(*kit.Kit, *snapshot.HuaWeiSnapshotRollbackOption) error) *HuaWeiRollbackSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SecurityGroupCvmAssociate mocks base method.
+func (m *MockHuaWei) SecurityGroupCvmAssociate(kt *kit.Kit, opt *securitygroup.HuaWeiAssociateCvmOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecurityGroupCvmAssociate", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SecurityGroupCvmAssociate indicates an expected call of SecurityGroupCvmAssociate.
+func (mr *MockHuaWeiMockRecorder) SecurityGroupCvmAssociate(kt, opt interface{}) *HuaWeiSecurityGroupCvmAssociateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityGroupCvmAssociate", reflect.TypeOf((*MockHuaWei)(nil).SecurityGroupCvmAssociate), kt, opt)
+	return &HuaWeiSecurityGroupCvmAssociateCall{Call: call}
+}
+
+// HuaWeiSecurityGroupCvmAssociateCall wrap *gomock.Call
+type HuaWeiSecurityGroupCvmAssociateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiSecurityGroupCvmAssociateCall) Return(arg0 error) *HuaWeiSecurityGroupCvmAssociateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiSecurityGroupCvmAssociateCall) Do(f func(*kit.Kit, *securitygroup.HuaWeiAssociateCvmOption) error) *HuaWeiSecurityGroupCvmAssociateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiSecurityGroupCvmAssociateCall) DoAndReturn(f func(*kit.Kit, *securitygroup.HuaWeiAssociateCvmOption) error) *HuaWeiSecurityGroupCvmAssociateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SecurityGroupCvmDisassociate mocks base method.
+func (m *MockHuaWei) SecurityGroupCvmDisassociate(kt *kit.Kit, opt *securitygroup.HuaWeiAssociateCvmOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecurityGroupCvmDisassociate", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SecurityGroupCvmDisassociate indicates an expected call of SecurityGroupCvmDisassociate.
+func (mr *MockHuaWeiMockRecorder) SecurityGroupCvmDisassociate(kt, opt interface{}) *HuaWeiSecurityGroupCvmDisassociateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityGroupCvmDisassociate", reflect.TypeOf((*MockHuaWei)(nil).SecurityGroupCvmDisassociate), kt, opt)
+	return &HuaWeiSecurityGroupCvmDisassociateCall{Call: call}
+}
+
+// HuaWeiSecurityGroupCvmDisassociateCall wrap *gomock.Call
+type HuaWeiSecurityGroupCvmDisassociateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiSecurityGroupCvmDisassociateCall) Return(arg0 error) *HuaWeiSecurityGroupCvmDisassociateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiSecurityGroupCvmDisassociateCall) Do(f func(*kit.Kit, *securitygroup.HuaWeiAssociateCvmOption) error) *HuaWeiSecurityGroupCvmDisassociateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiSecurityGroupCvmDisassociateCall) DoAndReturn(f func(*kit.Kit, *securitygroup.HuaWeiAssociateCvmOption) error) *HuaWeiSecurityGroupCvmDisassociateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// StartCvm mocks base method.
+func (m *MockHuaWei) StartCvm(kt *kit.Kit, opt *cvm.HuaWeiStartOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartCvm", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartCvm indicates an expected call of StartCvm.
+func (mr *MockHuaWeiMockRecorder) StartCvm(kt, opt interface{}) *HuaWeiStartCvmCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartCvm", reflect.TypeOf((*MockHuaWei)(nil).StartCvm), kt, opt)
+	return &HuaWeiStartCvmCall{Call: call}
+}
+
+// HuaWeiStartCvmCall wrap *gomock.Call
+type HuaWeiStartCvmCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiStartCvmCall) Return(arg0 error) *HuaWeiStartCvmCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiStartCvmCall) Do(f func(*kit.Kit, *cvm.HuaWeiStartOption) error) *HuaWeiStartCvmCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiStartCvmCall) DoAndReturn(f func(*kit.Kit, *cvm.HuaWeiStartOption) error) *HuaWeiStartCvmCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// StopCvm mocks base method.
+func (m *MockHuaWei) StopCvm(kt *kit.Kit, opt *cvm.HuaWeiStopOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopCvm", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopCvm indicates an expected call of StopCvm.
+func (mr *MockHuaWeiMockRecorder) StopCvm(kt, opt interface{}) *HuaWeiStopCvmCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopCvm", reflect.TypeOf((*MockHuaWei)(nil).StopCvm), kt, opt)
+	return &HuaWeiStopCvmCall{Call: call}
+}
+
+// HuaWeiStopCvmCall wrap *gomock.Call
+type HuaWeiStopCvmCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiStopCvmCall) Return(arg0 error) *HuaWeiStopCvmCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiStopCvmCall) Do(f func(*kit.Kit, *cvm.HuaWeiStopOption) error) *HuaWeiStopCvmCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiStopCvmCall) DoAndReturn(f func(*kit.Kit, *cvm.HuaWeiStopOption) error) *HuaWeiStopCvmCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UnbindKeyPair mocks base method.
+func (m *MockHuaWei) UnbindKeyPair(kt *kit.Kit, opt *keypair.HuaWeiKeyPairBindOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbindKeyPair", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnbindKeyPair indicates an expected call of UnbindKeyPair.
+func (mr *MockHuaWeiMockRecorder) UnbindKeyPair(kt, opt interface{}) *HuaWeiUnbindKeyPairCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbindKeyPair", reflect.TypeOf((*MockHuaWei)(nil).UnbindKeyPair), kt, opt)
+	return &HuaWeiUnbindKeyPairCall{Call: call}
+}
+
+// HuaWeiUnbindKeyPairCall wrap *gomock.Call
+type HuaWeiUnbindKeyPairCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiUnbindKeyPairCall) Return(arg0 error) *HuaWeiUnbindKeyPairCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiUnbindKeyPairCall) Do(f func(*kit.Kit, *keypair.HuaWeiKeyPairBindOption) error) *HuaWeiUnbindKeyPairCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiUnbindKeyPairCall) DoAndReturn(f func(*kit.Kit, *keypair.HuaWeiKeyPairBindOption) error) *HuaWeiUnbindKeyPairCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdatePrivateDnsRecord mocks base method.
+func (m *MockHuaWei) UpdatePrivateDnsRecord(kt *kit.Kit, opt *typesdns.HuaWeiRecordUpdateOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePrivateDnsRecord", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePrivateDnsRecord indicates an expected call of UpdatePrivateDnsRecord.
+func (mr *MockHuaWeiMockRecorder) UpdatePrivateDnsRecord(kt, opt interface{}) *HuaWeiUpdatePrivateDnsRecordCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePrivateDnsRecord", reflect.TypeOf((*MockHuaWei)(nil).UpdatePrivateDnsRecord), kt, opt)
+	return &HuaWeiUpdatePrivateDnsRecordCall{Call: call}
+}
+
+// HuaWeiUpdatePrivateDnsRecordCall wrap *gomock.Call
+type HuaWeiUpdatePrivateDnsRecordCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiUpdatePrivateDnsRecordCall) Return(arg0 error) *HuaWeiUpdatePrivateDnsRecordCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiUpdatePrivateDnsRecordCall) Do(f func(*kit.Kit, *typesdns.HuaWeiRecordUpdateOption) error) *HuaWeiUpdatePrivateDnsRecordCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiUpdatePrivateDnsRecordCall) DoAndReturn(f func(*kit.Kit, *typesdns.HuaWeiRecordUpdateOption) error) *HuaWeiUpdatePrivateDnsRecordCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateRouteTable mocks base method.
+func (m *MockHuaWei) UpdateRouteTable(kt *kit.Kit, opt *routetable.HuaWeiRouteTableUpdateOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRouteTable", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRouteTable indicates an expected call of UpdateRouteTable.
+func (mr *MockHuaWeiMockRecorder) UpdateRouteTable(kt, opt interface{}) *HuaWeiUpdateRouteTableCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRouteTable", reflect.TypeOf((*MockHuaWei)(nil).UpdateRouteTable), kt, opt)
+	return &HuaWeiUpdateRouteTableCall{Call: call}
+}
+
+// HuaWeiUpdateRouteTableCall wrap *gomock.Call
+type HuaWeiUpdateRouteTableCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiUpdateRouteTableCall) Return(arg0 error) *HuaWeiUpdateRouteTableCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiUpdateRouteTableCall) Do(f func(*kit.Kit, *routetable.HuaWeiRouteTableUpdateOption) error) *HuaWeiUpdateRouteTableCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiUpdateRouteTableCall) DoAndReturn(f func(*kit.Kit, *routetable.HuaWeiRouteTableUpdateOption) error) *HuaWeiUpdateRouteTableCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateSecurityGroup mocks base method.
+func (m *MockHuaWei) UpdateSecurityGroup(kt *kit.Kit, opt *securitygroup.HuaWeiUpdateOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecurityGroup", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSecurityGroup indicates an expected call of UpdateSecurityGroup.
+func (mr *MockHuaWeiMockRecorder) UpdateSecurityGroup(kt, opt interface{}) *HuaWeiUpdateSecurityGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecurityGroup", reflect.TypeOf((*MockHuaWei)(nil).UpdateSecurityGroup), kt, opt)
+	return &HuaWeiUpdateSecurityGroupCall{Call: call}
+}
+
+// HuaWeiUpdateSecurityGroupCall wrap *gomock.Call
+type HuaWeiUpdateSecurityGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiUpdateSecurityGroupCall) Return(arg0 error) *HuaWeiUpdateSecurityGroupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiUpdateSecurityGroupCall) Do(f func(*kit.Kit, *securitygroup.HuaWeiUpdateOption) error) *HuaWeiUpdateSecurityGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiUpdateSecurityGroupCall) DoAndReturn(f func(*kit.Kit, *securitygroup.HuaWeiUpdateOption) error) *HuaWeiUpdateSecurityGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateSubnet mocks base method.
+func (m *MockHuaWei) UpdateSubnet(kt *kit.Kit, opt *adtysubnet.HuaWeiSubnetUpdateOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubnet", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSubnet indicates an expected call of UpdateSubnet.
+func (mr *MockHuaWeiMockRecorder) UpdateSubnet(kt, opt interface{}) *HuaWeiUpdateSubnetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubnet", reflect.TypeOf((*MockHuaWei)(nil).UpdateSubnet), kt, opt)
+	return &HuaWeiUpdateSubnetCall{Call: call}
+}
+
+// HuaWeiUpdateSubnetCall wrap *gomock.Call
+type HuaWeiUpdateSubnetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiUpdateSubnetCall) Return(arg0 error) *HuaWeiUpdateSubnetCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiUpdateSubnetCall) Do(f func(*kit.Kit, *adtysubnet.HuaWeiSubnetUpdateOption) error) *HuaWeiUpdateSubnetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiUpdateSubnetCall) DoAndReturn(f func(*kit.Kit, *adtysubnet.HuaWeiSubnetUpdateOption) error) *HuaWeiUpdateSubnetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateVpc mocks base method.
+func (m *MockHuaWei) UpdateVpc(kt *kit.Kit, opt *types.HuaWeiVpcUpdateOption) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVpc", kt, opt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVpc indicates an expected call of UpdateVpc.
+func (mr *MockHuaWeiMockRecorder) UpdateVpc(kt, opt interface{}) *HuaWeiUpdateVpcCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVpc", reflect.TypeOf((*MockHuaWei)(nil).UpdateVpc), kt, opt)
+	return &HuaWeiUpdateVpcCall{Call: call}
+}
+
+// HuaWeiUpdateVpcCall wrap *gomock.Call
+type HuaWeiUpdateVpcCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *HuaWeiUpdateVpcCall) Return(arg0 error) *HuaWeiUpdateVpcCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *HuaWeiUpdateVpcCall) Do(f func(*kit.Kit, *types.HuaWeiVpcUpdateOption) error) *HuaWeiUpdateVpcCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *HuaWeiUpdateVpcCall) DoAndReturn(f func(*kit.Kit, *types.HuaWeiVpcUpdateOption) error) *HuaWeiUpdateVpcCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,140 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tcloud
+
+import (
+	typesbucket "hcm/pkg/adaptor/types/bucket"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"github.com/tencentyun/cos-go-sdk-v5"
+)
+
+// cosServiceMaxKeys COS GetService 单次最多返回的存储桶数量
+const cosServiceMaxKeys = 1000
+
+// ListBucket 查询账号下的COS存储桶，并补充ACL、存储桶策略和默认加密配置
+// reference: https://cloud.tencent.com/document/product/436/8291
+func (t *TCloudImpl) ListBucket(kt *kit.Kit, opt *typesbucket.TCloudBucketListOption) ([]typesbucket.TCloudBucket,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "tcloud bucket list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client := t.clientSet.CosClient(nil)
+	idMap := converter.StringSliceToMap(opt.CloudIDs)
+	buckets := make([]typesbucket.TCloudBucket, 0)
+	req := &cos.ServiceGetOptions{MaxKeys: cosServiceMaxKeys}
+	for {
+		resp, _, err := client.Service.Get(kt.Ctx, req)
+		if err != nil {
+			logs.Errorf("list tcloud bucket failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, err
+		}
+
+		for _, one := range resp.Buckets {
+			if _, exist := idMap[one.Name]; len(opt.CloudIDs) != 0 && !exist {
+				continue
+			}
+
+			bucket, err := t.getBucketDetail(kt, one)
+			if err != nil {
+				return nil, err
+			}
+			buckets = append(buckets, *bucket)
+		}
+
+		if !resp.IsTruncated || len(resp.NextMarker) == 0 {
+			break
+		}
+		req.Marker = resp.NextMarker
+	}
+
+	return buckets, nil
+}
+
+// getBucketDetail 查询存储桶的ACL、策略和加密配置，未配置策略或加密时接口返回404
+func (t *TCloudImpl) getBucketDetail(kt *kit.Kit, one cos.Bucket) (*typesbucket.TCloudBucket, error) {
+	bucketURL, err := cos.NewBucketURL(one.Name, one.Region, true)
+	if err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+	client := t.clientSet.CosClient(bucketURL)
+
+	bucket := &typesbucket.TCloudBucket{
+		Bucket:             one,
+		ACLPublicAccess:    enumor.BucketPrivate,
+		PolicyPublicAccess: enumor.BucketPrivate,
+	}
+
+	acl, _, err := client.Bucket.GetACL(kt.Ctx)
+	if err != nil {
+		logs.Errorf("get tcloud bucket acl failed, err: %v, bucket: %s, rid: %s", err, one.Name, kt.Rid)
+		return nil, err
+	}
+	for _, grant := range acl.AccessControlList {
+		if grant.Grantee != nil && grant.Grantee.URI == typesbucket.TCloudAllUsersURI {
+			bucket.ACLPublicAccess = typesbucket.MergePublicAccess(bucket.ACLPublicAccess,
+				typesbucket.GrantPublicAccess(grant.Permission))
+		}
+	}
+
+	policy, _, err := client.Bucket.GetPolicy(kt.Ctx)
+	if err != nil && !cos.IsNotFoundError(err) {
+		logs.Errorf("get tcloud bucket policy failed, err: %v, bucket: %s, rid: %s", err, one.Name, kt.Rid)
+		return nil, err
+	}
+	if policy != nil {
+		statements := make([]typesbucket.PolicyStatement, 0, len(policy.Statement))
+		for _, statement := range policy.Statement {
+			principals := make([]string, 0)
+			for _, values := range statement.Principal {
+				principals = append(principals, values...)
+			}
+			statements = append(statements, typesbucket.PolicyStatement{
+				Effect:       statement.Effect,
+				Principals:   principals,
+				Actions:      statement.Action,
+				HasCondition: len(statement.Condition) != 0,
+			})
+		}
+		bucket.PolicyPublicAccess = typesbucket.PolicyPublicAccess(statements,
+			typesbucket.TCloudAnonymousPrincipal, "*")
+	}
+
+	encryption, _, err := client.Bucket.GetEncryption(kt.Ctx)
+	if err != nil && !cos.IsNotFoundError(err) {
+		logs.Errorf("get tcloud bucket encryption failed, err: %v, bucket: %s, rid: %s", err, one.Name, kt.Rid)
+		return nil, err
+	}
+	if encryption != nil && encryption.Rule != nil {
+		bucket.Encryption = encryption.Rule
+	}
+
+	return bucket, nil
+}
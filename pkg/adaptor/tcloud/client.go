@@ -20,17 +20,25 @@
 package tcloud
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
 	"hcm/pkg/adaptor/types"
+	"hcm/pkg/kit"
 
 	billing "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/billing/v20180709"
 	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
 	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
 	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	tchttp "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/http"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/profile"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 	ssl "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ssl/v20191205"
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+	"github.com/tencentyun/cos-go-sdk-v5"
 )
 
 const (
@@ -47,6 +55,10 @@ type ClientSet interface {
 	BillClient() (*billing.Client, error)
 	ClbClient(region string) (*clb.Client, error)
 	CertClient() (*ssl.Client, error)
+	CdbClient(region string) (*common.Client, error)
+	TkeClient(region string) (*common.Client, error)
+	PrivateDnsClient() (*common.Client, error)
+	CosClient(bucketURL *url.URL) *cos.Client
 }
 
 // clientSet to get tcloud sdk client set
@@ -131,3 +143,52 @@ func (c *clientSet) CertClient() (*ssl.Client, error) {
 
 	return client, nil
 }
+
+// CdbClient tcloud cdb client, 云数据库MySQL没有引入对应的sdk包，使用通用客户端调用
+func (c *clientSet) CdbClient(region string) (*common.Client, error) {
+	return common.NewCommonClient(c.credential, region, c.profile), nil
+}
+
+// TkeClient tcloud tke client, 容器服务没有引入对应的sdk包，使用通用客户端调用
+func (c *clientSet) TkeClient(region string) (*common.Client, error) {
+	return common.NewCommonClient(c.credential, region, c.profile), nil
+}
+
+// PrivateDnsClient tcloud private dns client, 私有域解析为全局服务且没有引入对应的sdk包，使用通用客户端调用
+func (c *clientSet) PrivateDnsClient() (*common.Client, error) {
+	return common.NewCommonClient(c.credential, "", c.profile), nil
+}
+
+// CosClient tcloud cos client, bucketURL 为空时只能调用服务级接口，如查询存储桶列表
+func (c *clientSet) CosClient(bucketURL *url.URL) *cos.Client {
+	return cos.NewClient(&cos.BaseURL{BucketURL: bucketURL}, &http.Client{
+		Transport: &cos.AuthorizationTransport{
+			SecretID:  c.credential.SecretId,
+			SecretKey: c.credential.SecretKey,
+		},
+	})
+}
+
+// sendCommonRequest 通过通用客户端发送请求，并将返回的 Response 解析到 result 中
+func sendCommonRequest(kt *kit.Kit, client *common.Client, req *tchttp.CommonRequest,
+	params map[string]interface{}, result interface{}) error {
+
+	req.SetContext(kt.Ctx)
+	if err := req.SetActionParameters(params); err != nil {
+		return err
+	}
+
+	resp := tchttp.NewCommonResponse()
+	if err := client.Send(req, resp); err != nil {
+		return err
+	}
+
+	body := struct {
+		Response json.RawMessage `json:"Response"`
+	}{}
+	if err := json.Unmarshal(resp.GetBody(), &body); err != nil {
+		return fmt.Errorf("unmarshal tcloud %s response failed, err: %v", req.GetAction(), err)
+	}
+
+	return json.Unmarshal(body.Response, result)
+}
@@ -371,9 +371,7 @@ func (t *TCloudImpl) CreateCvm(kt *kit.Kit, opt *typecvm.TCloudCreateOption) (*p
 		VpcId:    common.StringPtr(opt.CloudVpcID),
 		SubnetId: common.StringPtr(opt.CloudSubnetID),
 	}
-	req.LoginSettings = &cvm.LoginSettings{
-		Password: common.StringPtr(opt.Password),
-	}
+	req.LoginSettings = genCvmLoginSettings(opt)
 	req.InternetAccessible = &cvm.InternetAccessible{
 		InternetMaxBandwidthOut: common.Int64Ptr(opt.InternetMaxBandwidthOut),
 		PublicIpAssigned:        common.BoolPtr(opt.PublicIPAssigned),
@@ -467,9 +465,7 @@ func (t *TCloudImpl) InquiryPriceCvm(kt *kit.Kit, opt *typecvm.TCloudCreateOptio
 		VpcId:    common.StringPtr(opt.CloudVpcID),
 		SubnetId: common.StringPtr(opt.CloudSubnetID),
 	}
-	req.LoginSettings = &cvm.LoginSettings{
-		Password: common.StringPtr(opt.Password),
-	}
+	req.LoginSettings = genCvmLoginSettings(opt)
 	req.InternetAccessible = &cvm.InternetAccessible{
 		PublicIpAssigned:        common.BoolPtr(opt.PublicIPAssigned),
 		InternetMaxBandwidthOut: common.Int64Ptr(opt.InternetMaxBandwidthOut),
@@ -698,3 +694,12 @@ func (h *createCvmPollingHandler) Poll(client *TCloudImpl, kt *kit.Kit, cloudIDs
 }
 
 var _ poller.PollingHandler[*TCloudImpl, []*cvm.Instance, poller.BaseDoneResult] = new(createCvmPollingHandler)
+
+// genCvmLoginSettings 指定密钥对时使用密钥对登录，否则使用密码登录
+func genCvmLoginSettings(opt *typecvm.TCloudCreateOption) *cvm.LoginSettings {
+	if len(opt.CloudKeyPairID) != 0 {
+		return &cvm.LoginSettings{KeyIds: common.StringPtrs([]string{opt.CloudKeyPairID})}
+	}
+
+	return &cvm.LoginSettings{Password: common.StringPtr(opt.Password)}
+}
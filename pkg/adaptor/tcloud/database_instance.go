@@ -0,0 +1,121 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tcloud
+
+import (
+	"fmt"
+
+	"hcm/pkg/adaptor/types/core"
+	dbinstance "hcm/pkg/adaptor/types/database-instance"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+
+	tchttp "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/http"
+)
+
+const (
+	cdbService = "cdb"
+	cdbVersion = "2017-03-20"
+)
+
+// ListDatabaseInstance 查询云数据库MySQL实例列表
+// reference: https://cloud.tencent.com/document/api/236/15872
+func (t *TCloudImpl) ListDatabaseInstance(kt *kit.Kit, opt *core.TCloudListOption) ([]dbinstance.TCloudDBInstance,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "tcloud database instance list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	params := map[string]interface{}{
+		"Offset": opt.Page.Offset,
+		"Limit":  opt.Page.Limit,
+	}
+	if len(opt.CloudIDs) != 0 {
+		params["InstanceIds"] = opt.CloudIDs
+		params["Offset"] = 0
+		params["Limit"] = core.TCloudQueryLimit
+	}
+
+	result := new(dbinstance.TCloudDBInstanceListResult)
+	if err := t.sendCdbRequest(kt, opt.Region, "DescribeDBInstances", params, result); err != nil {
+		logs.Errorf("list tcloud database instance failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return result.Items, nil
+}
+
+// ListDatabaseInstanceSecurityGroup 查询云数据库实例绑定的安全组
+// reference: https://cloud.tencent.com/document/api/236/15854
+func (t *TCloudImpl) ListDatabaseInstanceSecurityGroup(kt *kit.Kit, region string, cloudID string) ([]string,
+	error) {
+
+	if len(region) == 0 || len(cloudID) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "region and cloud id are required")
+	}
+
+	params := map[string]interface{}{"InstanceId": cloudID}
+	result := new(dbinstance.TCloudDBSecurityGroupListResult)
+	if err := t.sendCdbRequest(kt, region, "DescribeDBSecurityGroups", params, result); err != nil {
+		logs.Errorf("list tcloud database instance security group failed, err: %v, id: %s, rid: %s", err,
+			cloudID, kt.Rid)
+		return nil, err
+	}
+
+	return result.GetCloudSecurityGroupIDs(), nil
+}
+
+// DeleteDatabaseInstance 隔离云数据库实例，隔离后的实例由腾讯云回收站统一下线
+// reference: https://cloud.tencent.com/document/api/236/15869
+func (t *TCloudImpl) DeleteDatabaseInstance(kt *kit.Kit, opt *dbinstance.TCloudDBInstanceDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "tcloud database instance delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	params := map[string]interface{}{"InstanceId": opt.CloudID}
+	if err := t.sendCdbRequest(kt, opt.Region, "IsolateDBInstance", params, new(struct{})); err != nil {
+		logs.Errorf("isolate tcloud database instance failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// sendCdbRequest 通过通用客户端调用云数据库接口，并将返回的 Response 解析到 result 中
+func (t *TCloudImpl) sendCdbRequest(kt *kit.Kit, region, action string, params map[string]interface{},
+	result interface{}) error {
+
+	client, err := t.clientSet.CdbClient(region)
+	if err != nil {
+		return fmt.Errorf("new tcloud cdb client failed, err: %v", err)
+	}
+
+	return sendCommonRequest(kt, client, tchttp.NewCommonRequest(cdbService, cdbVersion, action), params, result)
+}
@@ -27,19 +27,27 @@ import (
 	"hcm/pkg/adaptor/types/account"
 	typeargstpl "hcm/pkg/adaptor/types/argument-template"
 	typesBill "hcm/pkg/adaptor/types/bill"
+	typesbucket "hcm/pkg/adaptor/types/bucket"
 	"hcm/pkg/adaptor/types/cert"
 	"hcm/pkg/adaptor/types/core"
 	"hcm/pkg/adaptor/types/cvm"
+	dbinstance "hcm/pkg/adaptor/types/database-instance"
 	"hcm/pkg/adaptor/types/disk"
 	"hcm/pkg/adaptor/types/eip"
 	"hcm/pkg/adaptor/types/image"
 	"hcm/pkg/adaptor/types/instance-type"
+	typesk8s "hcm/pkg/adaptor/types/k8s-cluster"
+	typekeypair "hcm/pkg/adaptor/types/key-pair"
 	typelb "hcm/pkg/adaptor/types/load-balancer"
+	natgateway "hcm/pkg/adaptor/types/nat-gateway"
+	typesdns "hcm/pkg/adaptor/types/private-dns"
 	"hcm/pkg/adaptor/types/region"
 	"hcm/pkg/adaptor/types/route-table"
 	"hcm/pkg/adaptor/types/security-group"
 	"hcm/pkg/adaptor/types/security-group-rule"
+	"hcm/pkg/adaptor/types/snapshot"
 	"hcm/pkg/adaptor/types/subnet"
+	vpcpeering "hcm/pkg/adaptor/types/vpc-peering"
 	"hcm/pkg/adaptor/types/zone"
 	"hcm/pkg/api/core/cloud"
 	"hcm/pkg/kit"
@@ -87,6 +95,29 @@ type TCloud interface {
 	DeleteDisk(kt *kit.Kit, opt *disk.TCloudDiskDeleteOption) error
 	AttachDisk(kt *kit.Kit, opt *disk.TCloudDiskAttachOption) error
 	DetachDisk(kt *kit.Kit, opt *disk.TCloudDiskDetachOption) error
+	ListSnapshot(kt *kit.Kit, opt *core.TCloudListOption) ([]snapshot.TCloudSnapshot, error)
+	CreateSnapshot(kt *kit.Kit, opt *snapshot.TCloudSnapshotCreateOption) (string, error)
+	DeleteSnapshot(kt *kit.Kit, opt *snapshot.TCloudSnapshotDeleteOption) error
+	RollbackSnapshot(kt *kit.Kit, opt *snapshot.TCloudSnapshotRollbackOption) error
+	ListDatabaseInstance(kt *kit.Kit, opt *core.TCloudListOption) ([]dbinstance.TCloudDBInstance, error)
+	ListDatabaseInstanceSecurityGroup(kt *kit.Kit, region string, cloudID string) ([]string, error)
+	DeleteDatabaseInstance(kt *kit.Kit, opt *dbinstance.TCloudDBInstanceDeleteOption) error
+	ListBucket(kt *kit.Kit, opt *typesbucket.TCloudBucketListOption) ([]typesbucket.TCloudBucket, error)
+	ListK8sCluster(kt *kit.Kit, opt *core.TCloudListOption) ([]typesk8s.TCloudCluster, error)
+	ListK8sNodePool(kt *kit.Kit, opt *typesk8s.TCloudK8sNodeListOption) ([]typesk8s.K8sNodePool, error)
+	ListK8sNode(kt *kit.Kit, opt *typesk8s.TCloudK8sNodeListOption) ([]typesk8s.K8sNode, error)
+	ListNatGateway(kt *kit.Kit, opt *core.TCloudListOption) ([]natgateway.TCloudNatGateway, error)
+	ListVpcPeering(kt *kit.Kit, opt *core.TCloudListOption) ([]vpcpeering.TCloudVpcPeering, error)
+	ListKeyPair(kt *kit.Kit, opt *core.TCloudListOption) ([]typekeypair.TCloudKeyPair, error)
+	ImportKeyPair(kt *kit.Kit, opt *typekeypair.TCloudKeyPairImportOption) (string, error)
+	DeleteKeyPair(kt *kit.Kit, opt *typekeypair.TCloudKeyPairDeleteOption) error
+	BindKeyPair(kt *kit.Kit, opt *typekeypair.TCloudKeyPairBindOption) error
+	UnbindKeyPair(kt *kit.Kit, opt *typekeypair.TCloudKeyPairBindOption) error
+	ListPrivateDnsZone(kt *kit.Kit, opt *typesdns.TCloudZoneListOption) ([]typesdns.TCloudZone, error)
+	ListPrivateDnsRecord(kt *kit.Kit, opt *typesdns.TCloudRecordListOption) ([]typesdns.Record, error)
+	CreatePrivateDnsRecord(kt *kit.Kit, opt *typesdns.TCloudRecordCreateOption) (string, error)
+	UpdatePrivateDnsRecord(kt *kit.Kit, opt *typesdns.TCloudRecordUpdateOption) error
+	DeletePrivateDnsRecord(kt *kit.Kit, opt *typesdns.TCloudRecordDeleteOption) error
 	ListEip(kt *kit.Kit, opt *eip.TCloudEipListOption) (*eip.TCloudEipListResult, error)
 	CountEip(kt *kit.Kit, region string) (int32, error)
 	DeleteEip(kt *kit.Kit, opt *eip.TCloudEipDeleteOption) error
@@ -0,0 +1,155 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tcloud
+
+import (
+	"fmt"
+
+	"hcm/pkg/adaptor/types/core"
+	typesk8s "hcm/pkg/adaptor/types/k8s-cluster"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	tchttp "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/http"
+)
+
+const (
+	tkeService = "tke"
+	tkeVersion = "2018-05-25"
+)
+
+// ListK8sCluster 查询TKE集群列表
+// reference: https://cloud.tencent.com/document/api/457/31862
+func (t *TCloudImpl) ListK8sCluster(kt *kit.Kit, opt *core.TCloudListOption) ([]typesk8s.TCloudCluster, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "tcloud k8s cluster list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	params := map[string]interface{}{
+		"Offset": opt.Page.Offset,
+		"Limit":  opt.Page.Limit,
+	}
+	if len(opt.CloudIDs) != 0 {
+		params["ClusterIds"] = opt.CloudIDs
+		params["Offset"] = 0
+		params["Limit"] = core.TCloudQueryLimit
+	}
+
+	result := new(typesk8s.TCloudClusterListResult)
+	if err := t.sendTkeRequest(kt, opt.Region, "DescribeClusters", params, result); err != nil {
+		logs.Errorf("list tcloud k8s cluster failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return result.Clusters, nil
+}
+
+// ListK8sNodePool 查询TKE集群的节点池
+// reference: https://cloud.tencent.com/document/api/457/68187
+func (t *TCloudImpl) ListK8sNodePool(kt *kit.Kit, opt *typesk8s.TCloudK8sNodeListOption) ([]typesk8s.K8sNodePool,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "tcloud k8s node pool list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	params := map[string]interface{}{"ClusterId": opt.ClusterCloudID}
+	result := new(typesk8s.TCloudNodePoolListResult)
+	if err := t.sendTkeRequest(kt, opt.Region, "DescribeClusterNodePools", params, result); err != nil {
+		logs.Errorf("list tcloud k8s node pool failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return nil, err
+	}
+
+	pools := make([]typesk8s.K8sNodePool, 0, len(result.NodePoolSet))
+	for _, one := range result.NodePoolSet {
+		pools = append(pools, typesk8s.K8sNodePool{
+			CloudID:   converter.PtrToVal(one.NodePoolId),
+			Name:      converter.PtrToVal(one.Name),
+			Status:    converter.PtrToVal(one.LifeState),
+			NodeCount: one.GetNodeCount(),
+			MinSize:   converter.PtrToVal(one.MinNodesNum),
+			MaxSize:   converter.PtrToVal(one.MaxNodesNum),
+		})
+	}
+
+	return pools, nil
+}
+
+// ListK8sNode 查询TKE集群的工作节点，包括不属于任何节点池的节点
+// reference: https://cloud.tencent.com/document/api/457/31867
+func (t *TCloudImpl) ListK8sNode(kt *kit.Kit, opt *typesk8s.TCloudK8sNodeListOption) ([]typesk8s.K8sNode, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "tcloud k8s node list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	nodes := make([]typesk8s.K8sNode, 0)
+	for offset := 0; ; offset += core.TCloudQueryLimit {
+		params := map[string]interface{}{
+			"ClusterId":    opt.ClusterCloudID,
+			"InstanceRole": "WORKER",
+			"Offset":       offset,
+			"Limit":        core.TCloudQueryLimit,
+		}
+		result := new(typesk8s.TCloudNodeListResult)
+		if err := t.sendTkeRequest(kt, opt.Region, "DescribeClusterInstances", params, result); err != nil {
+			logs.Errorf("list tcloud k8s node failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+			return nil, err
+		}
+
+		for _, one := range result.InstanceSet {
+			nodes = append(nodes, typesk8s.K8sNode{
+				CloudCvmID:      converter.PtrToVal(one.InstanceId),
+				CloudNodePoolID: converter.PtrToVal(one.NodePoolId),
+			})
+		}
+
+		if len(result.InstanceSet) < core.TCloudQueryLimit {
+			break
+		}
+	}
+
+	return nodes, nil
+}
+
+// sendTkeRequest 通过通用客户端调用容器服务接口
+func (t *TCloudImpl) sendTkeRequest(kt *kit.Kit, region, action string, params map[string]interface{},
+	result interface{}) error {
+
+	client, err := t.clientSet.TkeClient(region)
+	if err != nil {
+		return fmt.Errorf("new tcloud tke client failed, err: %v", err)
+	}
+
+	return sendCommonRequest(kt, client, tchttp.NewCommonRequest(tkeService, tkeVersion, action), params, result)
+}
@@ -0,0 +1,172 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tcloud
+
+import (
+	"fmt"
+
+	"hcm/pkg/adaptor/types/core"
+	typekeypair "hcm/pkg/adaptor/types/key-pair"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+)
+
+// ListKeyPair 查询密钥对列表
+func (t *TCloudImpl) ListKeyPair(kt *kit.Kit, opt *core.TCloudListOption) ([]typekeypair.TCloudKeyPair, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "tcloud key pair list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := t.clientSet.CvmClient(opt.Region)
+	if err != nil {
+		return nil, fmt.Errorf("new tcloud cvm client failed, err: %v", err)
+	}
+
+	req := cvm.NewDescribeKeyPairsRequest()
+	if len(opt.CloudIDs) != 0 {
+		req.KeyIds = common.StringPtrs(opt.CloudIDs)
+		req.Limit = common.Int64Ptr(int64(core.TCloudQueryLimit))
+	}
+	if opt.Page != nil {
+		req.Offset = common.Int64Ptr(int64(opt.Page.Offset))
+		req.Limit = common.Int64Ptr(int64(opt.Page.Limit))
+	}
+
+	resp, err := client.DescribeKeyPairsWithContext(kt.Ctx, req)
+	if err != nil {
+		logs.Errorf("list tcloud key pair failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	keyPairs := make([]typekeypair.TCloudKeyPair, 0, len(resp.Response.KeyPairSet))
+	for _, one := range resp.Response.KeyPairSet {
+		keyPairs = append(keyPairs, typekeypair.TCloudKeyPair{KeyPair: one})
+	}
+	return keyPairs, nil
+}
+
+// ImportKeyPair 导入公钥创建密钥对，返回密钥对ID
+func (t *TCloudImpl) ImportKeyPair(kt *kit.Kit, opt *typekeypair.TCloudKeyPairImportOption) (string, error) {
+	if opt == nil {
+		return "", errf.New(errf.InvalidParameter, "tcloud key pair import option is required")
+	}
+
+	req, err := opt.ToImportKeyPairRequest()
+	if err != nil {
+		return "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := t.clientSet.CvmClient(opt.Region)
+	if err != nil {
+		return "", fmt.Errorf("new tcloud cvm client failed, err: %v", err)
+	}
+
+	resp, err := client.ImportKeyPairWithContext(kt.Ctx, req)
+	if err != nil {
+		logs.Errorf("import tcloud key pair failed, err: %v, name: %s, rid: %s", err, opt.Name, kt.Rid)
+		return "", err
+	}
+
+	return converter.PtrToVal(resp.Response.KeyId), nil
+}
+
+// DeleteKeyPair 删除密钥对，已绑定主机的密钥对无法删除
+func (t *TCloudImpl) DeleteKeyPair(kt *kit.Kit, opt *typekeypair.TCloudKeyPairDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "tcloud key pair delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := t.clientSet.CvmClient(opt.Region)
+	if err != nil {
+		return fmt.Errorf("new tcloud cvm client failed, err: %v", err)
+	}
+
+	req := cvm.NewDeleteKeyPairsRequest()
+	req.KeyIds = common.StringPtrs(opt.CloudIDs)
+	if _, err = client.DeleteKeyPairsWithContext(kt.Ctx, req); err != nil {
+		logs.Errorf("delete tcloud key pair failed, err: %v, ids: %v, rid: %s", err, opt.CloudIDs, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// BindKeyPair 主机绑定密钥对，绑定后主机的密码登录将被禁用
+func (t *TCloudImpl) BindKeyPair(kt *kit.Kit, opt *typekeypair.TCloudKeyPairBindOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "tcloud key pair bind option is required")
+	}
+
+	req, err := opt.ToAssociateRequest()
+	if err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := t.clientSet.CvmClient(opt.Region)
+	if err != nil {
+		return fmt.Errorf("new tcloud cvm client failed, err: %v", err)
+	}
+
+	if _, err = client.AssociateInstancesKeyPairsWithContext(kt.Ctx, req); err != nil {
+		logs.Errorf("bind tcloud key pair failed, err: %v, ids: %v, cvms: %v, rid: %s", err, opt.CloudIDs,
+			opt.CloudCvmIDs, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// UnbindKeyPair 主机解绑密钥对
+func (t *TCloudImpl) UnbindKeyPair(kt *kit.Kit, opt *typekeypair.TCloudKeyPairBindOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "tcloud key pair unbind option is required")
+	}
+
+	req, err := opt.ToDisassociateRequest()
+	if err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := t.clientSet.CvmClient(opt.Region)
+	if err != nil {
+		return fmt.Errorf("new tcloud cvm client failed, err: %v", err)
+	}
+
+	if _, err = client.DisassociateInstancesKeyPairsWithContext(kt.Ctx, req); err != nil {
+		logs.Errorf("unbind tcloud key pair failed, err: %v, ids: %v, cvms: %v, rid: %s", err, opt.CloudIDs,
+			opt.CloudCvmIDs, kt.Rid)
+		return err
+	}
+
+	return nil
+}
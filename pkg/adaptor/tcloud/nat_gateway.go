@@ -0,0 +1,74 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tcloud
+
+import (
+	"fmt"
+
+	"hcm/pkg/adaptor/types/core"
+	natgateway "hcm/pkg/adaptor/types/nat-gateway"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+)
+
+// ListNatGateway 查询NAT网关列表
+// reference: https://cloud.tencent.com/document/api/215/36034
+func (t *TCloudImpl) ListNatGateway(kt *kit.Kit, opt *core.TCloudListOption) ([]natgateway.TCloudNatGateway,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "tcloud nat gateway list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := t.clientSet.VpcClient(opt.Region)
+	if err != nil {
+		return nil, fmt.Errorf("new tcloud vpc client failed, err: %v", err)
+	}
+
+	req := vpc.NewDescribeNatGatewaysRequest()
+	if len(opt.CloudIDs) != 0 {
+		req.NatGatewayIds = common.StringPtrs(opt.CloudIDs)
+		req.Limit = common.Uint64Ptr(uint64(core.TCloudQueryLimit))
+	}
+	if opt.Page != nil {
+		req.Offset = &opt.Page.Offset
+		req.Limit = &opt.Page.Limit
+	}
+
+	resp, err := client.DescribeNatGatewaysWithContext(kt.Ctx, req)
+	if err != nil {
+		logs.Errorf("list tcloud nat gateway failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	nats := make([]natgateway.TCloudNatGateway, 0, len(resp.Response.NatGatewaySet))
+	for _, one := range resp.Response.NatGatewaySet {
+		nats = append(nats, natgateway.TCloudNatGateway{NatGateway: one})
+	}
+	return nats, nil
+}
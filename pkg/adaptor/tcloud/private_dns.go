@@ -0,0 +1,238 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package tcloud
+
+import (
+	"fmt"
+
+	"hcm/pkg/adaptor/types/core"
+	typesdns "hcm/pkg/adaptor/types/private-dns"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	tchttp "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/http"
+)
+
+const (
+	privateDnsService = "privatedns"
+	privateDnsVersion = "2020-10-28"
+)
+
+// ListPrivateDnsZone 查询私有域列表
+// reference: https://cloud.tencent.com/document/api/1338/55937
+func (t *TCloudImpl) ListPrivateDnsZone(kt *kit.Kit, opt *typesdns.TCloudZoneListOption) ([]typesdns.TCloudZone,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "tcloud private dns zone list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	params := map[string]interface{}{
+		"Offset": 0,
+		"Limit":  core.TCloudQueryLimit,
+	}
+	if opt.Page != nil {
+		params["Offset"] = opt.Page.Offset
+		params["Limit"] = opt.Page.Limit
+	}
+	if len(opt.CloudIDs) != 0 {
+		params["Filters"] = []map[string]interface{}{{"Name": "ZoneId", "Values": opt.CloudIDs}}
+		params["Offset"] = 0
+		params["Limit"] = core.TCloudQueryLimit
+	}
+
+	result := new(typesdns.TCloudZoneListResult)
+	if err := t.sendPrivateDnsRequest(kt, "DescribePrivateZoneList", params, result); err != nil {
+		logs.Errorf("list tcloud private dns zone failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return result.PrivateZoneSet, nil
+}
+
+// ListPrivateDnsRecord 查询私有域解析记录，腾讯云每条记录只有一个记录值
+// reference: https://cloud.tencent.com/document/api/1338/55935
+func (t *TCloudImpl) ListPrivateDnsRecord(kt *kit.Kit, opt *typesdns.TCloudRecordListOption) ([]typesdns.Record,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "tcloud private dns record list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	records := make([]typesdns.Record, 0)
+	for offset := 0; ; offset += core.TCloudQueryLimit {
+		params := map[string]interface{}{
+			"ZoneId": opt.CloudZoneID,
+			"Offset": offset,
+			"Limit":  core.TCloudQueryLimit,
+		}
+		result := new(typesdns.TCloudRecordListResult)
+		if err := t.sendPrivateDnsRequest(kt, "DescribePrivateZoneRecordList", params, result); err != nil {
+			logs.Errorf("list tcloud private dns record failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+			return nil, err
+		}
+
+		for _, one := range result.RecordSet {
+			recordType := converter.PtrToVal(one.RecordType)
+			if !typesdns.IsSupportedRecordType(recordType) {
+				continue
+			}
+
+			value := converter.PtrToVal(one.RecordValue)
+			if enumor.PrivateDnsRecordType(recordType) == enumor.PrivateDnsRecordMX {
+				value = fmt.Sprintf("%d %s", converter.PtrToVal(one.MX), value)
+			}
+			records = append(records, typesdns.Record{
+				CloudID: converter.PtrToVal(one.RecordId),
+				Name:    converter.PtrToVal(one.SubDomain),
+				Type:    enumor.PrivateDnsRecordType(recordType),
+				Value:   []string{value},
+				TTL:     converter.PtrToVal(one.TTL),
+			})
+		}
+
+		if len(result.RecordSet) < core.TCloudQueryLimit {
+			break
+		}
+	}
+
+	return records, nil
+}
+
+// CreatePrivateDnsRecord 创建私有域解析记录，返回记录ID
+// reference: https://cloud.tencent.com/document/api/1338/55942
+func (t *TCloudImpl) CreatePrivateDnsRecord(kt *kit.Kit, opt *typesdns.TCloudRecordCreateOption) (string, error) {
+	if opt == nil {
+		return "", errf.New(errf.InvalidParameter, "tcloud private dns record create option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	params, err := convRecordParams(opt.RecordSpec)
+	if err != nil {
+		return "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+	params["ZoneId"] = opt.CloudZoneID
+
+	result := new(struct {
+		RecordId *string `json:"RecordId"`
+	})
+	if err = t.sendPrivateDnsRequest(kt, "CreatePrivateZoneRecord", params, result); err != nil {
+		logs.Errorf("create tcloud private dns record failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return "", err
+	}
+
+	return converter.PtrToVal(result.RecordId), nil
+}
+
+// UpdatePrivateDnsRecord 修改私有域解析记录
+// reference: https://cloud.tencent.com/document/api/1338/55941
+func (t *TCloudImpl) UpdatePrivateDnsRecord(kt *kit.Kit, opt *typesdns.TCloudRecordUpdateOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "tcloud private dns record update option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	params, err := convRecordParams(opt.RecordSpec)
+	if err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+	params["ZoneId"] = opt.CloudZoneID
+	params["RecordId"] = opt.CloudID
+
+	if err = t.sendPrivateDnsRequest(kt, "ModifyPrivateZoneRecord", params, new(struct{})); err != nil {
+		logs.Errorf("update tcloud private dns record failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// DeletePrivateDnsRecord 删除私有域解析记录
+// reference: https://cloud.tencent.com/document/api/1338/55940
+func (t *TCloudImpl) DeletePrivateDnsRecord(kt *kit.Kit, opt *typesdns.TCloudRecordDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "tcloud private dns record delete option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	params := map[string]interface{}{
+		"ZoneId":      opt.CloudZoneID,
+		"RecordIdSet": opt.CloudIDs,
+	}
+	if err := t.sendPrivateDnsRequest(kt, "DeletePrivateZoneRecord", params, new(struct{})); err != nil {
+		logs.Errorf("delete tcloud private dns record failed, err: %v, opt: %+v, rid: %s", err, opt, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// convRecordParams 转换解析记录的通用参数，MX 记录需要拆分优先级和邮件服务器
+func convRecordParams(spec typesdns.RecordSpec) (map[string]interface{}, error) {
+	params := map[string]interface{}{
+		"SubDomain":   spec.Name,
+		"RecordType":  string(spec.Type),
+		"RecordValue": spec.Value[0],
+		"TTL":         spec.GetTTL(),
+	}
+
+	if spec.Type == enumor.PrivateDnsRecordMX {
+		priority, exchange, err := typesdns.ParseMXValue(spec.Value[0])
+		if err != nil {
+			return nil, err
+		}
+		params["MX"] = priority
+		params["RecordValue"] = exchange
+	}
+
+	return params, nil
+}
+
+// sendPrivateDnsRequest 通过通用客户端调用私有域解析接口
+func (t *TCloudImpl) sendPrivateDnsRequest(kt *kit.Kit, action string, params map[string]interface{},
+	result interface{}) error {
+
+	client, err := t.clientSet.PrivateDnsClient()
+	if err != nil {
+		return fmt.Errorf("new tcloud private dns client failed, err: %v", err)
+	}
+
+	return sendCommonRequest(kt, client, tchttp.NewCommonRequest(privateDnsService, privateDnsVersion, action),
+		params, result)
+}
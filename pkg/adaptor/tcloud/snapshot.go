@@ -0,0 +1,150 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tcloud
+
+import (
+	"fmt"
+
+	"hcm/pkg/adaptor/types/core"
+	"hcm/pkg/adaptor/types/snapshot"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+
+	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+)
+
+// ListSnapshot 查询快照列表
+// reference: https://cloud.tencent.com/document/api/362/15647
+func (t *TCloudImpl) ListSnapshot(kt *kit.Kit, opt *core.TCloudListOption) ([]snapshot.TCloudSnapshot, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "tcloud snapshot list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := t.clientSet.CbsClient(opt.Region)
+	if err != nil {
+		return nil, fmt.Errorf("new tcloud cbs client failed, err: %v", err)
+	}
+
+	req := cbs.NewDescribeSnapshotsRequest()
+	if len(opt.CloudIDs) != 0 {
+		req.SnapshotIds = common.StringPtrs(opt.CloudIDs)
+		req.Limit = common.Uint64Ptr(uint64(core.TCloudQueryLimit))
+	}
+	if opt.Page != nil {
+		req.Offset = &opt.Page.Offset
+		req.Limit = &opt.Page.Limit
+	}
+
+	resp, err := client.DescribeSnapshotsWithContext(kt.Ctx, req)
+	if err != nil {
+		logs.Errorf("list tcloud snapshot failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	snapshots := make([]snapshot.TCloudSnapshot, 0, len(resp.Response.SnapshotSet))
+	for _, one := range resp.Response.SnapshotSet {
+		snapshots = append(snapshots, snapshot.TCloudSnapshot{Snapshot: one})
+	}
+	return snapshots, nil
+}
+
+// CreateSnapshot 创建快照，返回快照ID
+// reference: https://cloud.tencent.com/document/api/362/15648
+func (t *TCloudImpl) CreateSnapshot(kt *kit.Kit, opt *snapshot.TCloudSnapshotCreateOption) (string, error) {
+	if opt == nil {
+		return "", errf.New(errf.InvalidParameter, "tcloud snapshot create option is required")
+	}
+
+	req, err := opt.ToCreateSnapshotRequest()
+	if err != nil {
+		return "", errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := t.clientSet.CbsClient(opt.Region)
+	if err != nil {
+		return "", fmt.Errorf("new tcloud cbs client failed, err: %v", err)
+	}
+
+	resp, err := client.CreateSnapshotWithContext(kt.Ctx, req)
+	if err != nil {
+		logs.Errorf("create tcloud snapshot failed, err: %v, disk: %s, rid: %s", err, opt.CloudDiskID, kt.Rid)
+		return "", err
+	}
+
+	return converter.PtrToVal(resp.Response.SnapshotId), nil
+}
+
+// DeleteSnapshot 删除快照
+// reference: https://cloud.tencent.com/document/api/362/15649
+func (t *TCloudImpl) DeleteSnapshot(kt *kit.Kit, opt *snapshot.TCloudSnapshotDeleteOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "tcloud snapshot delete option is required")
+	}
+
+	req, err := opt.ToDeleteSnapshotsRequest()
+	if err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := t.clientSet.CbsClient(opt.Region)
+	if err != nil {
+		return fmt.Errorf("new tcloud cbs client failed, err: %v", err)
+	}
+
+	if _, err = client.DeleteSnapshotsWithContext(kt.Ctx, req); err != nil {
+		logs.Errorf("delete tcloud snapshot failed, err: %v, ids: %v, rid: %s", err, opt.CloudIDs, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// RollbackSnapshot 回滚快照到原云硬盘
+// reference: https://cloud.tencent.com/document/api/362/15650
+func (t *TCloudImpl) RollbackSnapshot(kt *kit.Kit, opt *snapshot.TCloudSnapshotRollbackOption) error {
+	if opt == nil {
+		return errf.New(errf.InvalidParameter, "tcloud snapshot rollback option is required")
+	}
+
+	req, err := opt.ToApplySnapshotRequest()
+	if err != nil {
+		return errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	client, err := t.clientSet.CbsClient(opt.Region)
+	if err != nil {
+		return fmt.Errorf("new tcloud cbs client failed, err: %v", err)
+	}
+
+	if _, err = client.ApplySnapshotWithContext(kt.Ctx, req); err != nil {
+		logs.Errorf("rollback tcloud snapshot failed, err: %v, snapshot: %s, disk: %s, rid: %s", err,
+			opt.CloudSnapshotID, opt.CloudDiskID, kt.Rid)
+		return err
+	}
+
+	return nil
+}
@@ -21,6 +21,9 @@
 package tcloud
 
 import (
+	"net/url"
+	"strings"
+
 	"hcm/pkg/adaptor/types"
 	"hcm/pkg/criteria/errf"
 
@@ -34,9 +37,31 @@ func NewTCloud(s *types.BaseSecret) (TCloud, error) {
 		return nil, err
 	}
 
+	if len(s.Endpoint) != 0 {
+		if err := setProfileEndpoint(prof, s.Endpoint); err != nil {
+			return nil, err
+		}
+	}
+
 	return &TCloudImpl{clientSet: newClientSet(s, prof)}, nil
 }
 
+// setProfileEndpoint 所有服务的请求都发往自定义地址，用于对接本地模拟云
+func setProfileEndpoint(prof *profile.ClientProfile, endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return errf.Newf(errf.InvalidParameter, "endpoint %s is invalid, err: %v", endpoint, err)
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return errf.Newf(errf.InvalidParameter, "endpoint should be like http://127.0.0.1:9900, but got %s", endpoint)
+	}
+
+	prof.HttpProfile.Endpoint = u.Host
+	prof.HttpProfile.Scheme = strings.ToUpper(u.Scheme)
+	return nil
+}
+
 // TCloudImpl is tencent cloud operator.
 type TCloudImpl struct {
 	clientSet ClientSet
@@ -0,0 +1,67 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package auditevent 云上操作审计事件
+package auditevent
+
+import (
+	"errors"
+	"time"
+
+	"hcm/pkg/criteria/validator"
+
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+)
+
+// AwsAuditEventMaxLimit aws LookupEvents 单次查询最大条数
+const AwsAuditEventMaxLimit = 50
+
+// AwsListOption define aws audit event list option.
+type AwsListOption struct {
+	Region    string    `json:"region" validate:"required"`
+	StartTime time.Time `json:"start_time" validate:"required"`
+	EndTime   time.Time `json:"end_time" validate:"required"`
+	NextToken *string   `json:"next_token" validate:"omitempty"`
+	// ReadOnly 是否只查询只读事件，资源同步只关心写操作事件
+	ReadOnly bool `json:"read_only"`
+}
+
+// Validate aws audit event list option.
+func (opt AwsListOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if !opt.StartTime.Before(opt.EndTime) {
+		return errors.New("start_time should be before end_time")
+	}
+
+	return nil
+}
+
+// AwsListResult define aws audit event list result.
+type AwsListResult struct {
+	NextToken *string    `json:"next_token"`
+	Details   []AwsEvent `json:"details"`
+}
+
+// AwsEvent for cloudtrail Event
+type AwsEvent struct {
+	*cloudtrail.Event
+}
@@ -21,6 +21,7 @@
 package bill
 
 import (
+	"regexp"
 	"time"
 
 	"hcm/pkg/adaptor/types/core"
@@ -84,6 +85,42 @@ func (opt AwsBillListOption) Validate() error {
 	return nil
 }
 
+// awsAccountIDRegexp aws账号ID为12位数字
+var awsAccountIDRegexp = regexp.MustCompile(`^\d{12}$`)
+
+// AwsRootBillListOption define aws root account bill list option.
+type AwsRootBillListOption struct {
+	RootAccountID string `json:"root_account_id" validate:"required"`
+	// MainAccountID 二级账号云上ID，对应CUR中的line_item_usage_account_id
+	MainAccountID string `json:"main_account_id" validate:"required"`
+	// 起始日期，格式为yyyy-mm-dd，不支持跨月查询
+	BeginDate string `json:"begin_date" validate:"required"`
+	// 截止日期，格式为yyyy-mm-dd，不支持跨月查询
+	EndDate string       `json:"end_date" validate:"required"`
+	Page    *AwsBillPage `json:"page" validate:"omitempty"`
+}
+
+// Validate aws root account bill list option.
+func (opt AwsRootBillListOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	// main_account_id 会拼接到Athena查询语句中，需要严格校验格式
+	if !awsAccountIDRegexp.MatchString(opt.MainAccountID) {
+		return errf.Newf(errf.InvalidParameter, "main_account_id: %s should be 12-digit aws account id",
+			opt.MainAccountID)
+	}
+
+	listOpt := AwsBillListOption{
+		AccountID: opt.RootAccountID,
+		BeginDate: opt.BeginDate,
+		EndDate:   opt.EndDate,
+		Page:      opt.Page,
+	}
+	return listOpt.Validate()
+}
+
 // AwsBillPage defines aws bill page option.
 type AwsBillPage struct {
 	Offset uint64 `json:"offset"`
@@ -273,6 +310,8 @@ type TCloudBillListOption struct {
 	// 本次请求的上下文信息，可用于下一次请求的请求参数中，加快查询速度
 	// 注意：此字段可能返回 null，表示取不到有效值。
 	Context *string `json:"Context" validate:"omitempty"`
+	// PayerUin 支付者的账号ID，集团管理账号查询成员账号自付的账单时需传入成员账号UIN
+	PayerUin string `json:"payer_uin" validate:"omitempty"`
 }
 
 // Validate tcloud bill list option.
@@ -385,6 +424,8 @@ type AzureBillListOption struct {
 	EndDate string `json:"end_date" validate:"required"`
 	// Page 分页信息
 	Page *AzureBillPage `json:"page" validate:"omitempty"`
+	// SubscriptionID 查询的订阅ID，为空时查询凭证所属订阅
+	SubscriptionID string `json:"subscription_id" validate:"omitempty"`
 }
 
 const AzureQueryLimit = 1000
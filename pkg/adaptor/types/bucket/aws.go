@@ -0,0 +1,94 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bucket
+
+import (
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+const (
+	// AwsAllUsersURI S3 ACL 中表示所有用户的授权对象
+	AwsAllUsersURI = "http://acs.amazonaws.com/groups/global/AllUsers"
+	// AwsAuthenticatedUsersURI S3 ACL 中表示所有AWS认证用户的授权对象，同样视为公共访问
+	AwsAuthenticatedUsersURI = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+)
+
+// AwsBucketListOption define aws bucket list option.
+type AwsBucketListOption struct {
+	// CloudIDs 存储桶名称
+	CloudIDs []string `json:"cloud_ids" validate:"omitempty,max=100"`
+}
+
+// Validate aws bucket list option.
+func (opt AwsBucketListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AwsBucket for s3 Bucket
+type AwsBucket struct {
+	*s3.Bucket
+	Region string
+	// PublicAccessBlock 存储桶的阻止公共访问配置，未配置时为空
+	PublicAccessBlock *s3.PublicAccessBlockConfiguration
+	// PolicyIsPublic 存储桶策略是否公开，由 S3 根据策略内容判断
+	PolicyIsPublic bool
+	// ACLPublicAccess 存储桶ACL授予所有用户的权限
+	ACLPublicAccess enumor.BucketPublicAccess
+	// Encryption 默认加密配置，未开启时为空
+	Encryption       *s3.ServerSideEncryptionByDefault
+	BucketKeyEnabled *bool
+}
+
+// GetCloudID 存储桶名称全局唯一
+func (b AwsBucket) GetCloudID() string {
+	return converter.PtrToVal(b.Name)
+}
+
+// GetPublicAccess 计算时考虑阻止公共访问配置，策略状态无法区分读写，按公有读处理
+func (b AwsBucket) GetPublicAccess() enumor.BucketPublicAccess {
+	block := b.PublicAccessBlock
+	if block == nil {
+		block = new(s3.PublicAccessBlockConfiguration)
+	}
+
+	aclAccess := b.ACLPublicAccess
+	if converter.PtrToVal(block.IgnorePublicAcls) {
+		aclAccess = enumor.BucketPrivate
+	}
+
+	policyAccess := enumor.BucketPrivate
+	if b.PolicyIsPublic && !converter.PtrToVal(block.RestrictPublicBuckets) {
+		policyAccess = enumor.BucketPublicRead
+	}
+
+	return MergePublicAccess(aclAccess, policyAccess)
+}
+
+// GetEncryption ...
+func (b AwsBucket) GetEncryption() string {
+	if b.Encryption == nil {
+		return ""
+	}
+	return converter.PtrToVal(b.Encryption.SSEAlgorithm)
+}
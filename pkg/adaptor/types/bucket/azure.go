@@ -0,0 +1,100 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bucket
+
+import (
+	"strings"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+)
+
+// AzureBucketListOption define azure bucket list option.
+type AzureBucketListOption struct {
+	ResourceGroupName string `json:"resource_group_name" validate:"required"`
+	// CloudIDs Blob容器的资源ID
+	CloudIDs []string `json:"cloud_ids" validate:"omitempty,max=100"`
+}
+
+// Validate azure bucket list option.
+func (opt AzureBucketListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AzureBucket Blob容器，Azure没有存储桶的概念，对应存储账户下的Blob容器
+type AzureBucket struct {
+	*armstorage.ListContainerItem
+	// Account 容器所属的存储账户
+	Account *armstorage.Account
+}
+
+// GetCloudID 容器名称只在存储账户内唯一，使用小写的资源ID作为云上ID
+func (b AzureBucket) GetCloudID() string {
+	return strings.ToLower(converter.PtrToVal(b.ID))
+}
+
+// GetAccountName ...
+func (b AzureBucket) GetAccountName() string {
+	if b.Account == nil {
+		return ""
+	}
+	return converter.PtrToVal(b.Account.Name)
+}
+
+// GetRegion 容器的地域为所属存储账户的地域
+func (b AzureBucket) GetRegion() string {
+	if b.Account == nil {
+		return ""
+	}
+	return strings.ToLower(converter.PtrToVal(b.Account.Location))
+}
+
+// AllowBlobPublicAccess 存储账户是否允许Blob公共访问，未设置时默认允许
+func (b AzureBucket) AllowBlobPublicAccess() bool {
+	if b.Account == nil || b.Account.Properties == nil || b.Account.Properties.AllowBlobPublicAccess == nil {
+		return true
+	}
+	return *b.Account.Properties.AllowBlobPublicAccess
+}
+
+// GetPublicAccess 匿名用户只能读取Blob容器，不存在公有写
+func (b AzureBucket) GetPublicAccess() enumor.BucketPublicAccess {
+	if !b.AllowBlobPublicAccess() || b.Properties == nil || b.Properties.PublicAccess == nil {
+		return enumor.BucketPrivate
+	}
+
+	switch *b.Properties.PublicAccess {
+	case armstorage.PublicAccessBlob, armstorage.PublicAccessContainer:
+		return enumor.BucketPublicRead
+	default:
+		return enumor.BucketPrivate
+	}
+}
+
+// GetEncryption Azure存储默认开启加密，返回密钥来源
+func (b AzureBucket) GetEncryption() string {
+	if b.Account == nil || b.Account.Properties == nil || b.Account.Properties.Encryption == nil {
+		return ""
+	}
+	return string(converter.PtrToVal(b.Account.Properties.Encryption.KeySource))
+}
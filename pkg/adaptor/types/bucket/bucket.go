@@ -0,0 +1,113 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package bucket ...
+package bucket
+
+import (
+	"strings"
+
+	"hcm/pkg/criteria/enumor"
+)
+
+// BucketListMaxLimit 按 CloudIDs 查询存储桶时的最大数量
+const BucketListMaxLimit = 100
+
+// MergePublicAccess 合并ACL、策略等多个来源计算出的公共访问权限，取其中最宽松的一个
+func MergePublicAccess(accesses ...enumor.BucketPublicAccess) enumor.BucketPublicAccess {
+	result := enumor.BucketPrivate
+	for _, one := range accesses {
+		switch one {
+		case enumor.BucketPublicReadWrite:
+			return enumor.BucketPublicReadWrite
+		case enumor.BucketPublicRead:
+			result = enumor.BucketPublicRead
+		}
+	}
+
+	return result
+}
+
+// GrantPublicAccess 根据授予所有用户的ACL权限计算公共访问权限，只开放写权限也视为公有读写
+func GrantPublicAccess(permission string) enumor.BucketPublicAccess {
+	switch strings.ToUpper(permission) {
+	case "READ":
+		return enumor.BucketPublicRead
+	case "WRITE", "FULL_CONTROL":
+		return enumor.BucketPublicReadWrite
+	default:
+		return enumor.BucketPrivate
+	}
+}
+
+// PolicyStatement 存储桶策略语句，用于兼容 COS、OBS 等厂商的策略格式
+type PolicyStatement struct {
+	Effect string
+	// Principals 授权对象，各厂商表示匿名用户的方式不同，由调用方传入匿名用户标识
+	Principals []string
+	Actions    []string
+	// HasCondition 语句带有条件时（如限制来源IP）不视为对公网开放
+	HasCondition bool
+}
+
+// PolicyPublicAccess 根据允许匿名用户访问且没有条件限制的策略语句计算公共访问权限
+func PolicyPublicAccess(statements []PolicyStatement, anonymous ...string) enumor.BucketPublicAccess {
+	anonymousMap := make(map[string]struct{}, len(anonymous))
+	for _, one := range anonymous {
+		anonymousMap[one] = struct{}{}
+	}
+
+	result := enumor.BucketPrivate
+	for _, statement := range statements {
+		if !strings.EqualFold(statement.Effect, "allow") || statement.HasCondition {
+			continue
+		}
+
+		isAnonymous := false
+		for _, principal := range statement.Principals {
+			if _, exist := anonymousMap[principal]; exist {
+				isAnonymous = true
+				break
+			}
+		}
+		if !isAnonymous {
+			continue
+		}
+
+		for _, action := range statement.Actions {
+			result = MergePublicAccess(result, actionPublicAccess(action))
+		}
+	}
+
+	return result
+}
+
+// actionPublicAccess 根据策略中的操作名称计算公共访问权限，只读操作以 Get、List、Head 开头
+func actionPublicAccess(action string) enumor.BucketPublicAccess {
+	name := strings.ToLower(action)
+	if idx := strings.LastIndex(name, ":"); idx >= 0 {
+		name = name[idx+1:]
+	}
+
+	if strings.HasPrefix(name, "get") || strings.HasPrefix(name, "list") || strings.HasPrefix(name, "head") {
+		return enumor.BucketPublicRead
+	}
+
+	return enumor.BucketPublicReadWrite
+}
@@ -0,0 +1,71 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bucket
+
+import (
+	"testing"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyPublicAccess(t *testing.T) {
+	statements := []PolicyStatement{
+		// 带条件的语句不视为公开
+		{Effect: "Allow", Principals: []string{"*"}, Actions: []string{"name/cos:PutObject"}, HasCondition: true},
+		// 非匿名用户的语句不视为公开
+		{Effect: "Allow", Principals: []string{"qcs::cam::uin/100:uin/100"}, Actions: []string{"*"}},
+		{Effect: "Deny", Principals: []string{"*"}, Actions: []string{"*"}},
+	}
+	assert.Equal(t, enumor.BucketPrivate, PolicyPublicAccess(statements, TCloudAnonymousPrincipal, "*"))
+
+	statements = append(statements, PolicyStatement{Effect: "allow", Principals: []string{TCloudAnonymousPrincipal},
+		Actions: []string{"name/cos:GetObject", "name/cos:HeadObject"}})
+	assert.Equal(t, enumor.BucketPublicRead, PolicyPublicAccess(statements, TCloudAnonymousPrincipal, "*"))
+
+	statements = append(statements, PolicyStatement{Effect: "Allow", Principals: []string{"*"},
+		Actions: []string{"PutObject"}})
+	assert.Equal(t, enumor.BucketPublicReadWrite, PolicyPublicAccess(statements, TCloudAnonymousPrincipal, "*"))
+}
+
+func TestAwsBucketPublicAccess(t *testing.T) {
+	bucket := AwsBucket{ACLPublicAccess: enumor.BucketPublicReadWrite, PolicyIsPublic: true}
+	assert.Equal(t, enumor.BucketPublicReadWrite, bucket.GetPublicAccess())
+
+	// 忽略公共ACL后只剩策略公开
+	bucket.PublicAccessBlock = &s3.PublicAccessBlockConfiguration{IgnorePublicAcls: converter.ValToPtr(true)}
+	assert.Equal(t, enumor.BucketPublicRead, bucket.GetPublicAccess())
+
+	bucket.PublicAccessBlock.RestrictPublicBuckets = converter.ValToPtr(true)
+	assert.Equal(t, enumor.BucketPrivate, bucket.GetPublicAccess())
+}
+
+func TestParseHuaWeiPolicy(t *testing.T) {
+	policy := `{"Statement":[{"Effect":"Allow","Principal":{"ID":["*"]},"Action":["GetObject"],` +
+		`"Resource":["b/*"]},{"Effect":"Allow","Principal":"*","Action":"PutObject","Resource":"b/*",` +
+		`"Condition":{"IpAddress":{"SourceIp":"10.0.0.0/8"}}}]}`
+	statements, err := ParseHuaWeiPolicy(policy)
+	assert.NoError(t, err)
+	assert.Len(t, statements, 2)
+	assert.Equal(t, enumor.BucketPublicRead, PolicyPublicAccess(statements, HuaWeiAnonymousPrincipal))
+}
@@ -0,0 +1,129 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bucket
+
+import (
+	"strings"
+
+	"hcm/pkg/adaptor/types/core"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+
+	"google.golang.org/api/storage/v1"
+)
+
+const (
+	// GcpAllUsers GCS 中表示所有用户的授权对象
+	GcpAllUsers = "allUsers"
+	// GcpAllAuthenticatedUsers GCS 中表示所有Google认证用户的授权对象，同样视为公共访问
+	GcpAllAuthenticatedUsers = "allAuthenticatedUsers"
+	// GcpPublicAccessPreventionEnforced 强制阻止公共访问
+	GcpPublicAccessPreventionEnforced = "enforced"
+	// GcpGoogleManagedEncryption 未指定默认KMS密钥时，GCS使用Google管理的密钥加密
+	GcpGoogleManagedEncryption = "google-managed"
+	// GcpCloudKmsEncryption 使用 Cloud KMS 密钥加密
+	GcpCloudKmsEncryption = "cloud-kms"
+)
+
+// GcpBucketListOption define gcp bucket list option.
+type GcpBucketListOption struct {
+	// CloudIDs 存储桶名称
+	CloudIDs []string      `json:"cloud_ids" validate:"omitempty,max=100"`
+	Page     *core.GcpPage `json:"page" validate:"omitempty"`
+}
+
+// Validate gcp bucket list option.
+func (opt GcpBucketListOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if opt.Page != nil {
+		if err := opt.Page.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GcpBucket for storage Bucket
+type GcpBucket struct {
+	*storage.Bucket
+	// PublicRoles 存储桶IAM策略中授予所有用户的角色
+	PublicRoles []string
+}
+
+// GetCloudID 存储桶名称全局唯一
+func (b GcpBucket) GetCloudID() string {
+	return b.Name
+}
+
+// GetRegion GCS返回的位置为大写，可能是单地域、双地域或多地域
+func (b GcpBucket) GetRegion() string {
+	return strings.ToLower(b.Location)
+}
+
+// GetPublicAccessPrevention ...
+func (b GcpBucket) GetPublicAccessPrevention() string {
+	if b.IamConfiguration == nil {
+		return ""
+	}
+	return b.IamConfiguration.PublicAccessPrevention
+}
+
+// GetPublicAccess 根据IAM策略及ACL中授予所有用户的角色计算，强制阻止公共访问时为私有
+func (b GcpBucket) GetPublicAccess() enumor.BucketPublicAccess {
+	if b.GetPublicAccessPrevention() == GcpPublicAccessPreventionEnforced {
+		return enumor.BucketPrivate
+	}
+
+	result := enumor.BucketPrivate
+	for _, role := range b.PublicRoles {
+		result = MergePublicAccess(result, gcpRolePublicAccess(role))
+	}
+
+	for _, acl := range b.Acl {
+		if acl == nil || (acl.Entity != GcpAllUsers && acl.Entity != GcpAllAuthenticatedUsers) {
+			continue
+		}
+		result = MergePublicAccess(result, GrantPublicAccess(acl.Role))
+	}
+
+	return result
+}
+
+// gcpRolePublicAccess 只读角色名称中包含 viewer 或 reader，其余角色都带有写权限
+func gcpRolePublicAccess(role string) enumor.BucketPublicAccess {
+	name := strings.ToLower(role)
+	if strings.Contains(name, "viewer") || strings.Contains(name, "reader") {
+		return enumor.BucketPublicRead
+	}
+
+	return enumor.BucketPublicReadWrite
+}
+
+// GetEncryption ...
+func (b GcpBucket) GetEncryption() string {
+	if b.Encryption != nil && len(b.Encryption.DefaultKmsKeyName) != 0 {
+		return GcpCloudKmsEncryption
+	}
+	return GcpGoogleManagedEncryption
+}
@@ -0,0 +1,137 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bucket
+
+import (
+	"encoding/json"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-obs/obs"
+)
+
+// HuaWeiAnonymousPrincipal OBS 存储桶策略中表示匿名用户的授权对象
+const HuaWeiAnonymousPrincipal = "*"
+
+// HuaWeiBucketListOption define huawei bucket list option.
+type HuaWeiBucketListOption struct {
+	// Region 列表接口请求的终端节点地域，列表接口会返回账号下全部地域的存储桶
+	Region string `json:"region" validate:"required"`
+	// CloudIDs 存储桶名称
+	CloudIDs []string `json:"cloud_ids" validate:"omitempty,max=100"`
+}
+
+// Validate huawei bucket list option.
+func (opt HuaWeiBucketListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// HuaWeiBucket for obs Bucket
+type HuaWeiBucket struct {
+	obs.Bucket
+	StorageClass string
+	// ACLPublicAccess 存储桶ACL授予所有用户的权限
+	ACLPublicAccess enumor.BucketPublicAccess
+	// PolicyPublicAccess 存储桶策略授予匿名用户的权限
+	PolicyPublicAccess enumor.BucketPublicAccess
+	// Encryption 默认加密配置，未开启时为空
+	Encryption *obs.BucketEncryptionConfiguration
+}
+
+// GetCloudID 存储桶名称全局唯一
+func (b HuaWeiBucket) GetCloudID() string {
+	return b.Name
+}
+
+// GetPublicAccess ...
+func (b HuaWeiBucket) GetPublicAccess() enumor.BucketPublicAccess {
+	return MergePublicAccess(b.ACLPublicAccess, b.PolicyPublicAccess)
+}
+
+// GetEncryption ...
+func (b HuaWeiBucket) GetEncryption() string {
+	if b.Encryption == nil {
+		return ""
+	}
+	return b.Encryption.SSEAlgorithm
+}
+
+// HuaWeiPolicy OBS 存储桶策略
+type HuaWeiPolicy struct {
+	Statement []HuaWeiPolicyStatement `json:"Statement"`
+}
+
+// HuaWeiPolicyStatement OBS 存储桶策略语句
+type HuaWeiPolicyStatement struct {
+	Effect string `json:"Effect"`
+	// Principal 可能是 "*" 或 {"ID": ["*"]} 形式
+	Principal json.RawMessage `json:"Principal"`
+	Action    json.RawMessage `json:"Action"`
+	Condition json.RawMessage `json:"Condition"`
+}
+
+// ParseHuaWeiPolicy 解析OBS存储桶策略，Principal、Action 可能是字符串或字符串数组
+func ParseHuaWeiPolicy(policy string) ([]PolicyStatement, error) {
+	p := new(HuaWeiPolicy)
+	if err := json.Unmarshal([]byte(policy), p); err != nil {
+		return nil, err
+	}
+
+	statements := make([]PolicyStatement, 0, len(p.Statement))
+	for _, one := range p.Statement {
+		statements = append(statements, PolicyStatement{
+			Effect:       one.Effect,
+			Principals:   parsePrincipal(one.Principal),
+			Actions:      parseStringOrSlice(one.Action),
+			HasCondition: len(one.Condition) != 0 && string(one.Condition) != "null",
+		})
+	}
+
+	return statements, nil
+}
+
+func parsePrincipal(raw json.RawMessage) []string {
+	principalMap := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(raw, &principalMap); err != nil {
+		return parseStringOrSlice(raw)
+	}
+
+	principals := make([]string, 0)
+	for _, one := range principalMap {
+		principals = append(principals, parseStringOrSlice(one)...)
+	}
+	return principals
+}
+
+func parseStringOrSlice(raw json.RawMessage) []string {
+	if len(raw) == 0 {
+		return make([]string, 0)
+	}
+
+	var one string
+	if err := json.Unmarshal(raw, &one); err == nil {
+		return []string{one}
+	}
+
+	list := make([]string, 0)
+	_ = json.Unmarshal(raw, &list)
+	return list
+}
@@ -0,0 +1,74 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bucket
+
+import (
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+
+	"github.com/tencentyun/cos-go-sdk-v5"
+)
+
+const (
+	// TCloudAllUsersURI COS ACL 中表示所有用户的授权对象
+	TCloudAllUsersURI = "http://cam.qcloud.com/groups/global/AllUsers"
+	// TCloudAnonymousPrincipal COS 存储桶策略中表示匿名用户的授权对象
+	TCloudAnonymousPrincipal = "qcs::cam::anyone:anyone"
+)
+
+// TCloudBucketListOption define tcloud bucket list option.
+type TCloudBucketListOption struct {
+	// CloudIDs 存储桶名称，格式为 BucketName-APPID
+	CloudIDs []string `json:"cloud_ids" validate:"omitempty,max=100"`
+}
+
+// Validate tcloud bucket list option.
+func (opt TCloudBucketListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// TCloudBucket for cos Bucket
+type TCloudBucket struct {
+	cos.Bucket
+	// ACLPublicAccess 存储桶ACL授予所有用户的权限
+	ACLPublicAccess enumor.BucketPublicAccess
+	// PolicyPublicAccess 存储桶策略授予匿名用户的权限
+	PolicyPublicAccess enumor.BucketPublicAccess
+	// Encryption 默认加密配置，未开启时为空
+	Encryption *cos.BucketEncryptionConfiguration
+}
+
+// GetCloudID 存储桶名称带有APPID后缀，全局唯一
+func (b TCloudBucket) GetCloudID() string {
+	return b.Name
+}
+
+// GetPublicAccess ...
+func (b TCloudBucket) GetPublicAccess() enumor.BucketPublicAccess {
+	return MergePublicAccess(b.ACLPublicAccess, b.PolicyPublicAccess)
+}
+
+// GetEncryption ...
+func (b TCloudBucket) GetEncryption() string {
+	if b.Encryption == nil {
+		return ""
+	}
+	return b.Encryption.SSEAlgorithm
+}
@@ -36,6 +36,10 @@ const (
 	HuaWeiQueryLimit = 2000
 	// GcpSelfLinkMaxQueryLimit gcp selfLink 最大允许查询数量，如果数量过多，导致请求体太大，gcp会报错
 	GcpSelfLinkMaxQueryLimit = 50
+	// ZenlayerQueryLimit is zenlayer maximum query limit
+	ZenlayerQueryLimit = 100
+	// KaopuQueryLimit is kaopu maximum query limit
+	KaopuQueryLimit = 100
 )
 
 // TCloudPage defines tencent cloud page option.
@@ -148,3 +152,41 @@ func (h HuaWeiCvmOffsetPage) Validate() error {
 
 	return nil
 }
+
+// ZenlayerPage defines zenlayer page option, page number starts from 1.
+type ZenlayerPage struct {
+	PageNum  int64 `json:"pageNum"`
+	PageSize int64 `json:"pageSize"`
+}
+
+// Validate ZenlayerPage.
+func (z ZenlayerPage) Validate() error {
+	if z.PageNum <= 0 {
+		return errf.New(errf.InvalidParameter, "zenlayer.pageNum should >= 1")
+	}
+
+	if z.PageSize <= 0 || z.PageSize > ZenlayerQueryLimit {
+		return errf.New(errf.InvalidParameter, "zenlayer.pageSize should >= 1 and <= 100")
+	}
+
+	return nil
+}
+
+// KaopuPage defines kaopu page option, page number starts from 1.
+type KaopuPage struct {
+	PageNumber int64 `json:"PageNumber"`
+	PageSize   int64 `json:"PageSize"`
+}
+
+// Validate KaopuPage.
+func (k KaopuPage) Validate() error {
+	if k.PageNumber <= 0 {
+		return errf.New(errf.InvalidParameter, "kaopu.PageNumber should >= 1")
+	}
+
+	if k.PageSize <= 0 || k.PageSize > KaopuQueryLimit {
+		return errf.New(errf.InvalidParameter, "kaopu.PageSize should >= 1 and <= 100")
+	}
+
+	return nil
+}
@@ -209,6 +209,54 @@ func (a HuaWeiListOption) Validate() error {
 	return nil
 }
 
+// ZenlayerListOption defines basic zenlayer list options.
+type ZenlayerListOption struct {
+	Region   string        `json:"region" validate:"omitempty"`
+	CloudIDs []string      `json:"cloud_ids" validate:"omitempty"`
+	Page     *ZenlayerPage `json:"page" validate:"required"`
+}
+
+// Validate zenlayer list option.
+func (opt ZenlayerListOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if len(opt.CloudIDs) > ZenlayerQueryLimit {
+		return errf.New(errf.InvalidParameter, "zenlayer resource ids length should <= 100")
+	}
+
+	if err := opt.Page.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// KaopuListOption defines basic kaopu list options.
+type KaopuListOption struct {
+	Region   string     `json:"region" validate:"required"`
+	CloudIDs []string   `json:"cloud_ids" validate:"omitempty"`
+	Page     *KaopuPage `json:"page" validate:"required"`
+}
+
+// Validate kaopu list option.
+func (opt KaopuListOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if len(opt.CloudIDs) > KaopuQueryLimit {
+		return errf.New(errf.InvalidParameter, "kaopu resource ids length should <= 100")
+	}
+
+	if err := opt.Page.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // AzureListByIDOption azure list by id option.
 type AzureListByIDOption struct {
 	ResourceGroupName string   `json:"resource_group_name" validate:"required"`
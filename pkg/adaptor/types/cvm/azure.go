@@ -105,14 +105,16 @@ func (opt AzureStopOption) Validate() error {
 
 // AzureCreateOption defines options to create azure cvm instances.
 type AzureCreateOption struct {
-	ResourceGroupName    string          `json:"resource_group_name" validate:"required"`
-	Region               string          `json:"region" validate:"required"`
-	Name                 string          `json:"name" validate:"required"`
-	Zones                []string        `json:"zones" validate:"omitempty"`
-	InstanceType         string          `json:"instance_type" validate:"required"`
-	Image                *AzureImage     `json:"image" validate:"required"`
-	Username             string          `json:"username" validate:"required"`
-	Password             string          `json:"password" validate:"required"`
+	ResourceGroupName string      `json:"resource_group_name" validate:"required"`
+	Region            string      `json:"region" validate:"required"`
+	Name              string      `json:"name" validate:"required"`
+	Zones             []string    `json:"zones" validate:"omitempty"`
+	InstanceType      string      `json:"instance_type" validate:"required"`
+	Image             *AzureImage `json:"image" validate:"required"`
+	Username          string      `json:"username" validate:"required"`
+	Password          string      `json:"password" validate:"required_without=SSHPublicKey"`
+	// SSHPublicKey 使用密钥对登录时指定公钥内容，不为空时禁用密码登录，仅支持 Linux 主机
+	SSHPublicKey         string          `json:"ssh_public_key" validate:"omitempty"`
 	CloudSubnetID        string          `json:"cloud_subnet_id" validate:"required"`
 	CloudSecurityGroupID string          `json:"cloud_security_group_id" validate:"required"`
 	OSDisk               *AzureOSDisk    `json:"os_disk" validate:"required"`
@@ -119,8 +119,10 @@ type GcpCreateOption struct {
 	Zone               string `json:"zone" validate:"required"`
 	InstanceType       string `json:"instance_type" validate:"required"`
 	CloudImageSelfLink string `json:"cloud_image_self_link" validate:"required"`
-	Password           string `json:"password" validate:"required"`
-	RequiredCount      int64  `json:"required_count" validate:"required"`
+	Password           string `json:"password" validate:"required_without=SSHKey"`
+	// SSHKey 使用密钥对登录时指定，格式为元数据 ssh-keys 中的一行，不为空时不通过启动脚本设置密码
+	SSHKey        string `json:"ssh_key" validate:"omitempty"`
+	RequiredCount int64  `json:"required_count" validate:"required"`
 	// RequestID 唯一标识支持生产请求
 	RequestID           string `json:"request_id" validate:"omitempty"`
 	CloudVpcSelfLink    string `json:"cloud_vpc_self_link" validate:"required"`
@@ -126,13 +126,15 @@ func (opt HuaWeiResetPwdOption) Validate() error {
 
 // HuaWeiCreateOption defines options to create aws cvm instances.
 type HuaWeiCreateOption struct {
-	DryRun                bool                  `json:"dry_run" validate:"omitempty"`
-	Region                string                `json:"region" validate:"required"`
-	Name                  string                `json:"name" validate:"required"`
-	Zone                  string                `json:"zone" validate:"required"`
-	InstanceType          string                `json:"instance_type" validate:"required"`
-	CloudImageID          string                `json:"cloud_image_id" validate:"required"`
-	Password              string                `json:"password" validate:"required"`
+	DryRun       bool   `json:"dry_run" validate:"omitempty"`
+	Region       string `json:"region" validate:"required"`
+	Name         string `json:"name" validate:"required"`
+	Zone         string `json:"zone" validate:"required"`
+	InstanceType string `json:"instance_type" validate:"required"`
+	CloudImageID string `json:"cloud_image_id" validate:"required"`
+	Password     string `json:"password" validate:"required_without=KeyName"`
+	// KeyName 使用密钥对登录时指定密钥对名称，不为空时不设置密码
+	KeyName               string                `json:"key_name" validate:"omitempty"`
 	RequiredCount         int32                 `json:"required_count" validate:"required"`
 	CloudSecurityGroupIDs []string              `json:"cloud_security_group_ids" validate:"required"`
 	ClientToken           *string               `json:"client_token" validate:"omitempty"`
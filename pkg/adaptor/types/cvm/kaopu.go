@@ -0,0 +1,48 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package cvm
+
+// KaopuCvm defines kaopu cvm instance.
+type KaopuCvm struct {
+	InstanceID         string   `json:"InstanceId"`
+	InstanceName       string   `json:"InstanceName"`
+	RegionID           string   `json:"RegionId"`
+	ZoneID             string   `json:"ZoneId"`
+	InstanceType       string   `json:"InstanceType"`
+	Cpu                int64    `json:"Cpu"`
+	Memory             int64    `json:"Memory"`
+	ImageID            string   `json:"ImageId"`
+	OSName             string   `json:"OSName"`
+	Status             string   `json:"Status"`
+	VpcID              string   `json:"VpcId"`
+	SubnetID           string   `json:"SubnetId"`
+	PrivateIPAddresses []string `json:"PrivateIpAddresses"`
+	PublicIPAddresses  []string `json:"PublicIpAddresses"`
+	SecurityGroupIDs   []string `json:"SecurityGroupIds"`
+	ChargeType         string   `json:"ChargeType"`
+	// Bandwidth 公网带宽，单位Mbps。
+	Bandwidth    int64  `json:"Bandwidth"`
+	CreationTime string `json:"CreationTime"`
+	ExpiredTime  string `json:"ExpiredTime"`
+}
+
+// GetCloudID ...
+func (cvm KaopuCvm) GetCloudID() string {
+	return cvm.InstanceID
+}
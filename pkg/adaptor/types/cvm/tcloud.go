@@ -162,13 +162,15 @@ func (opt TCloudResetPwdOption) Validate() error {
 
 // TCloudCreateOption defines options to create aws cvm instances.
 type TCloudCreateOption struct {
-	DryRun                  bool                         `json:"dry_run" validate:"omitempty"`
-	Region                  string                       `json:"region" validate:"required"`
-	Name                    string                       `json:"name" validate:"required"`
-	Zone                    string                       `json:"zone" validate:"required"`
-	InstanceType            string                       `json:"instance_type" validate:"required"`
-	CloudImageID            string                       `json:"cloud_image_id" validate:"required"`
-	Password                string                       `json:"password" validate:"required"`
+	DryRun       bool   `json:"dry_run" validate:"omitempty"`
+	Region       string `json:"region" validate:"required"`
+	Name         string `json:"name" validate:"required"`
+	Zone         string `json:"zone" validate:"required"`
+	InstanceType string `json:"instance_type" validate:"required"`
+	CloudImageID string `json:"cloud_image_id" validate:"required"`
+	Password     string `json:"password" validate:"required_without=CloudKeyPairID"`
+	// CloudKeyPairID 使用密钥对登录时指定，不为空时不设置密码
+	CloudKeyPairID          string                       `json:"cloud_key_pair_id" validate:"omitempty"`
 	RequiredCount           int64                        `json:"required_count" validate:"required"`
 	CloudSecurityGroupIDs   []string                     `json:"cloud_security_group_ids" validate:"required"`
 	ClientToken             *string                      `json:"client_token" validate:"omitempty"`
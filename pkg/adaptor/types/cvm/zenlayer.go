@@ -0,0 +1,56 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package cvm
+
+// ZenlayerCvm defines zenlayer cvm instance.
+type ZenlayerCvm struct {
+	InstanceID         string   `json:"instanceId"`
+	InstanceName       string   `json:"instanceName"`
+	RegionID           string   `json:"regionId"`
+	ZoneID             string   `json:"zoneId"`
+	InstanceType       string   `json:"instanceType"`
+	Cpu                int64    `json:"cpu"`
+	Memory             int64    `json:"memory"`
+	ImageID            string   `json:"imageId"`
+	ImageName          string   `json:"imageName"`
+	Status             string   `json:"status"`
+	VpcID              string   `json:"vpcId"`
+	SubnetID           string   `json:"subnetId"`
+	PrivateIPAddresses []string `json:"privateIpAddresses"`
+	PublicIPAddresses  []string `json:"publicIpAddresses"`
+	SecurityGroupIDs   []string `json:"securityGroupIds"`
+	InstanceChargeType string   `json:"instanceChargeType"`
+	// InternetMaxBandwidthOut 公网出带宽上限，单位Mbps。
+	InternetMaxBandwidthOut int64              `json:"internetMaxBandwidthOut"`
+	SystemDisk              *ZenlayerCvmDisk   `json:"systemDisk"`
+	DataDisks               []*ZenlayerCvmDisk `json:"dataDisks"`
+	CreateTime              string             `json:"createTime"`
+	ExpiredTime             string             `json:"expiredTime"`
+}
+
+// ZenlayerCvmDisk defines zenlayer cvm disk.
+type ZenlayerCvmDisk struct {
+	DiskID string `json:"diskId"`
+	Size   int64  `json:"size"`
+}
+
+// GetCloudID ...
+func (cvm ZenlayerCvm) GetCloudID() string {
+	return cvm.InstanceID
+}
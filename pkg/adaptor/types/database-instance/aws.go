@@ -0,0 +1,122 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package databaseinstance
+
+import (
+	"errors"
+
+	"hcm/pkg/adaptor/types/core"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// AwsDBInstanceMaxRecords RDS DescribeDBInstances 单次最多返回的数量
+const AwsDBInstanceMaxRecords = 100
+
+// AwsDBInstanceListOption define aws database instance list option.
+type AwsDBInstanceListOption struct {
+	Region string `json:"region" validate:"required"`
+	// CloudIDs 实例的 DbiResourceId
+	CloudIDs []string      `json:"cloud_ids" validate:"omitempty"`
+	Page     *core.AwsPage `json:"page" validate:"omitempty"`
+}
+
+// Validate aws database instance list option.
+func (opt AwsDBInstanceListOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if len(opt.CloudIDs) > AwsDBInstanceMaxRecords {
+		return errors.New("cloud_ids should <= 100")
+	}
+
+	if opt.Page != nil {
+		if err := opt.Page.Validate(); err != nil {
+			return err
+		}
+
+		if converter.PtrToVal(opt.Page.MaxResults) > AwsDBInstanceMaxRecords {
+			return errors.New("page.max_results should <= 100")
+		}
+	}
+
+	return nil
+}
+
+// AwsDBInstanceDeleteOption define aws database instance delete option.
+type AwsDBInstanceDeleteOption struct {
+	Region string `json:"region" validate:"required"`
+	// Identifier 实例标识符，即 DBInstanceIdentifier
+	Identifier string `json:"identifier" validate:"required"`
+	// FinalSnapshotID 删除前创建的最终快照ID，为空时跳过最终快照
+	FinalSnapshotID string `json:"final_snapshot_id" validate:"omitempty"`
+}
+
+// Validate aws database instance delete option.
+func (opt AwsDBInstanceDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AwsDBInstance for rds DBInstance
+type AwsDBInstance struct {
+	*rds.DBInstance
+}
+
+// GetCloudID 实例标识符在不同地域、账号下可以重复，使用 DbiResourceId 作为云上ID
+func (db AwsDBInstance) GetCloudID() string {
+	return converter.PtrToVal(db.DbiResourceId)
+}
+
+// GetCloudVpcID ...
+func (db AwsDBInstance) GetCloudVpcID() string {
+	if db.DBSubnetGroup == nil {
+		return ""
+	}
+	return converter.PtrToVal(db.DBSubnetGroup.VpcId)
+}
+
+// GetCloudSubnetIDs 返回实例子网组中的子网
+func (db AwsDBInstance) GetCloudSubnetIDs() []string {
+	if db.DBSubnetGroup == nil {
+		return make([]string, 0)
+	}
+
+	ids := make([]string, 0, len(db.DBSubnetGroup.Subnets))
+	for _, one := range db.DBSubnetGroup.Subnets {
+		if one != nil && one.SubnetIdentifier != nil {
+			ids = append(ids, *one.SubnetIdentifier)
+		}
+	}
+	return ids
+}
+
+// GetCloudSecurityGroupIDs ...
+func (db AwsDBInstance) GetCloudSecurityGroupIDs() []string {
+	ids := make([]string, 0, len(db.VpcSecurityGroups))
+	for _, one := range db.VpcSecurityGroups {
+		if one != nil && one.VpcSecurityGroupId != nil {
+			ids = append(ids, *one.VpcSecurityGroupId)
+		}
+	}
+	return ids
+}
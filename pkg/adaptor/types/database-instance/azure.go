@@ -0,0 +1,78 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package databaseinstance
+
+import (
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+)
+
+// AzureDBInstanceResourceTypes 同步的Azure数据库资源类型及其查询时使用的 api-version
+var AzureDBInstanceResourceTypes = map[string]string{
+	"microsoft.dbformysql/flexibleservers":      "2021-05-01",
+	"microsoft.dbforpostgresql/flexibleservers": "2022-12-01",
+}
+
+// AzureDBInstanceListOption define azure database instance list option.
+type AzureDBInstanceListOption struct {
+	ResourceGroupName string   `json:"resource_group_name" validate:"required"`
+	CloudIDs          []string `json:"cloud_ids" validate:"omitempty"`
+}
+
+// Validate azure database instance list option.
+func (opt AzureDBInstanceListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AzureDBInstanceDeleteOption define azure database instance delete option.
+type AzureDBInstanceDeleteOption struct {
+	CloudID string `json:"cloud_id" validate:"required"`
+	// ResourceType 资源类型，用于确定删除时使用的 api-version
+	ResourceType string `json:"resource_type" validate:"required"`
+}
+
+// Validate azure database instance delete option.
+func (opt AzureDBInstanceDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AzureDBInstance define azure database flexible server.
+type AzureDBInstance struct {
+	ID       *string `json:"id"`
+	Name     *string `json:"name"`
+	Type     *string `json:"type"`
+	Location *string `json:"location"`
+	SKUName  *string `json:"sku_name"`
+	SKUTier  *string `json:"sku_tier"`
+	// Engine 数据库引擎，mysql 或 postgresql
+	Engine                   string  `json:"engine"`
+	Version                  *string `json:"version"`
+	State                    *string `json:"state"`
+	FullyQualifiedDomainName *string `json:"fully_qualified_domain_name"`
+	AvailabilityZone         *string `json:"availability_zone"`
+	StorageSizeGB            *int64  `json:"storage_size_gb"`
+	// CloudSubnetID VNet集成时委托的子网，公网访问模式下为空
+	CloudSubnetID *string `json:"cloud_subnet_id"`
+}
+
+// GetCloudID ...
+func (db AzureDBInstance) GetCloudID() string {
+	return converter.PtrToVal(db.ID)
+}
@@ -0,0 +1,94 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package databaseinstance
+
+import (
+	"hcm/pkg/adaptor/types/core"
+	"hcm/pkg/criteria/validator"
+
+	"google.golang.org/api/sqladmin/v1"
+)
+
+// GcpDBInstanceListOption define gcp database instance list option.
+type GcpDBInstanceListOption struct {
+	// CloudIDs 实例的连接名，格式为 "项目:地域:实例名"
+	CloudIDs []string      `json:"cloud_ids" validate:"omitempty"`
+	Page     *core.GcpPage `json:"page" validate:"omitempty"`
+}
+
+// Validate gcp database instance list option.
+func (opt GcpDBInstanceListOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if opt.Page != nil {
+		if err := opt.Page.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GcpDBInstanceDeleteOption define gcp database instance delete option.
+type GcpDBInstanceDeleteOption struct {
+	Name string `json:"name" validate:"required"`
+}
+
+// Validate gcp database instance delete option.
+func (opt GcpDBInstanceDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// GcpDBInstance for sqladmin DatabaseInstance
+type GcpDBInstance struct {
+	*sqladmin.DatabaseInstance
+}
+
+// GetCloudID Cloud SQL 实例没有数字ID，实例名仅在项目内唯一，使用连接名作为云上ID
+func (db GcpDBInstance) GetCloudID() string {
+	return db.ConnectionName
+}
+
+// GetCloudVpcSelfLink 返回私有IP所在VPC的selfLink，未开启私有IP时为空
+func (db GcpDBInstance) GetCloudVpcSelfLink() string {
+	if db.Settings == nil || db.Settings.IpConfiguration == nil || len(db.Settings.IpConfiguration.PrivateNetwork) == 0 {
+		return ""
+	}
+	return "https://www.googleapis.com/compute/v1/" + db.Settings.IpConfiguration.PrivateNetwork
+}
+
+// GetIPs 返回实例的公网IP和私网IP
+func (db GcpDBInstance) GetIPs() (publicIPs []string, privateIPs []string) {
+	publicIPs, privateIPs = make([]string, 0), make([]string, 0)
+	for _, one := range db.IpAddresses {
+		if one == nil {
+			continue
+		}
+		switch one.Type {
+		case "PRIMARY":
+			publicIPs = append(publicIPs, one.IpAddress)
+		case "PRIVATE":
+			privateIPs = append(privateIPs, one.IpAddress)
+		}
+	}
+	return publicIPs, privateIPs
+}
@@ -0,0 +1,99 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package databaseinstance
+
+import (
+	"strings"
+
+	"hcm/pkg/criteria/validator"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+)
+
+// HuaWeiDBInstanceMaxLimit 华为云RDS实例查询单次最多返回的数量
+const HuaWeiDBInstanceMaxLimit = 100
+
+// HuaWeiDBInstanceListOption define huawei database instance list option.
+type HuaWeiDBInstanceListOption struct {
+	Region string `json:"region" validate:"required"`
+	// CloudID 华为云按ID过滤RDS实例时只支持单个ID
+	CloudID *string `json:"cloud_id" validate:"omitempty"`
+	Offset  *int32  `json:"offset" validate:"omitempty"`
+	Limit   *int32  `json:"limit" validate:"omitempty,max=100"`
+}
+
+// Validate huawei database instance list option.
+func (opt HuaWeiDBInstanceListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ToListInstancesRequest ...
+func (opt HuaWeiDBInstanceListOption) ToListInstancesRequest() (*model.ListInstancesRequest, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &model.ListInstancesRequest{Id: opt.CloudID, Offset: opt.Offset, Limit: opt.Limit}, nil
+}
+
+// HuaWeiDBInstanceDeleteOption define huawei database instance delete option.
+type HuaWeiDBInstanceDeleteOption struct {
+	Region  string `json:"region" validate:"required"`
+	CloudID string `json:"cloud_id" validate:"required"`
+}
+
+// Validate huawei database instance delete option.
+func (opt HuaWeiDBInstanceDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// HuaWeiDBInstance for model InstanceResponse
+type HuaWeiDBInstance struct {
+	model.InstanceResponse
+}
+
+// GetCloudID ...
+func (db HuaWeiDBInstance) GetCloudID() string {
+	return db.Id
+}
+
+// GetZone 返回主节点所在的可用区
+func (db HuaWeiDBInstance) GetZone() string {
+	for _, one := range db.Nodes {
+		if one.Role == "master" {
+			return one.AvailabilityZone
+		}
+	}
+	if len(db.Nodes) != 0 {
+		return db.Nodes[0].AvailabilityZone
+	}
+	return ""
+}
+
+// GetCloudSecurityGroupIDs 华为云RDS实例的 security_group_id 可能包含多个以逗号分隔的安全组
+func (db HuaWeiDBInstance) GetCloudSecurityGroupIDs() []string {
+	ids := make([]string, 0)
+	for _, one := range strings.Split(db.SecurityGroupId, ",") {
+		if one = strings.TrimSpace(one); len(one) != 0 {
+			ids = append(ids, one)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,118 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package databaseinstance ...
+package databaseinstance
+
+import (
+	"strconv"
+
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+)
+
+// TCloudDBInstanceDeleteOption define tcloud database instance delete option.
+type TCloudDBInstanceDeleteOption struct {
+	Region  string `json:"region" validate:"required"`
+	CloudID string `json:"cloud_id" validate:"required"`
+}
+
+// Validate tcloud database instance delete option.
+func (opt TCloudDBInstanceDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// TCloudDBInstance 云数据库MySQL实例，字段参考 DescribeDBInstances 返回的 InstanceInfo
+type TCloudDBInstance struct {
+	InstanceId    *string `json:"InstanceId"`
+	InstanceName  *string `json:"InstanceName"`
+	Region        *string `json:"Region"`
+	Zone          *string `json:"Zone"`
+	Status        *int64  `json:"Status"`
+	Vip           *string `json:"Vip"`
+	Vport         *int64  `json:"Vport"`
+	WanDomain     *string `json:"WanDomain"`
+	WanPort       *int64  `json:"WanPort"`
+	UniqVpcId     *string `json:"UniqVpcId"`
+	UniqSubnetId  *string `json:"UniqSubnetId"`
+	EngineVersion *string `json:"EngineVersion"`
+	EngineType    *string `json:"EngineType"`
+	DeviceType    *string `json:"DeviceType"`
+	Cpu           *int64  `json:"Cpu"`
+	Memory        *int64  `json:"Memory"`
+	Volume        *int64  `json:"Volume"`
+	// PayType 付费类型，0-包年包月，1-按量计费
+	PayType *int64 `json:"PayType"`
+	// InstanceType 实例类型，1-主实例，2-灾备实例，3-只读实例
+	InstanceType *int64  `json:"InstanceType"`
+	ProjectId    *int64  `json:"ProjectId"`
+	CreateTime   *string `json:"CreateTime"`
+	DeadlineTime *string `json:"DeadlineTime"`
+}
+
+// GetCloudID ...
+func (db TCloudDBInstance) GetCloudID() string {
+	return converter.PtrToVal(db.InstanceId)
+}
+
+// GetStatus 实例状态，0-创建中，1-运行中，4-正在进行隔离操作，5-已隔离
+func (db TCloudDBInstance) GetStatus() string {
+	if db.Status == nil {
+		return ""
+	}
+	return strconv.FormatInt(*db.Status, 10)
+}
+
+// GetChargeType ...
+func (db TCloudDBInstance) GetChargeType() string {
+	switch converter.PtrToVal(db.PayType) {
+	case 0:
+		return "PREPAID"
+	case 1:
+		return "POSTPAID_BY_HOUR"
+	default:
+		return ""
+	}
+}
+
+// TCloudDBInstanceListResult ...
+type TCloudDBInstanceListResult struct {
+	TotalCount *int64             `json:"TotalCount"`
+	Items      []TCloudDBInstance `json:"Items"`
+	RequestId  *string            `json:"RequestId"`
+}
+
+// TCloudDBSecurityGroupListResult DescribeDBSecurityGroups 的返回结果
+type TCloudDBSecurityGroupListResult struct {
+	Groups []struct {
+		SecurityGroupId *string `json:"SecurityGroupId"`
+	} `json:"Groups"`
+	RequestId *string `json:"RequestId"`
+}
+
+// GetCloudSecurityGroupIDs ...
+func (r TCloudDBSecurityGroupListResult) GetCloudSecurityGroupIDs() []string {
+	ids := make([]string, 0, len(r.Groups))
+	for _, one := range r.Groups {
+		if one.SecurityGroupId != nil {
+			ids = append(ids, *one.SecurityGroupId)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,67 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package k8scluster
+
+import (
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/service/eks"
+)
+
+// AwsK8sClusterListOption define aws eks cluster list option.
+type AwsK8sClusterListOption struct {
+	Region string `json:"region" validate:"required"`
+}
+
+// Validate aws eks cluster list option.
+func (opt AwsK8sClusterListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AwsK8sNodeListOption define aws eks node group and node list option.
+type AwsK8sNodeListOption struct {
+	Region string `json:"region" validate:"required"`
+	// ClusterName EKS 接口通过集群名称查询节点组
+	ClusterName string `json:"cluster_name" validate:"required"`
+}
+
+// Validate aws eks node list option.
+func (opt AwsK8sNodeListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AwsCluster for eks Cluster
+type AwsCluster struct {
+	*eks.Cluster
+}
+
+// GetCloudID EKS 集群名称只在地域内唯一，使用ARN作为云上ID
+func (c AwsCluster) GetCloudID() string {
+	return converter.PtrToVal(c.Arn)
+}
+
+// GetCloudVpcID ...
+func (c AwsCluster) GetCloudVpcID() string {
+	if c.ResourcesVpcConfig == nil {
+		return ""
+	}
+	return converter.PtrToVal(c.ResourcesVpcConfig.VpcId)
+}
@@ -0,0 +1,115 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package k8scluster
+
+import (
+	"strings"
+
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+)
+
+const (
+	// AzureManagedClusterResourceType AKS集群的资源类型
+	AzureManagedClusterResourceType = "Microsoft.ContainerService/managedClusters"
+	// AzureManagedClusterAPIVersion 查询AKS集群详情时使用的 api-version
+	AzureManagedClusterAPIVersion = "2023-08-01"
+)
+
+// AzureK8sClusterListOption define azure aks cluster list option.
+type AzureK8sClusterListOption struct {
+	ResourceGroupName string `json:"resource_group_name" validate:"required"`
+}
+
+// Validate azure aks cluster list option.
+func (opt AzureK8sClusterListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AzureCluster define azure aks managed cluster.
+type AzureCluster struct {
+	ID                *string `json:"id"`
+	Name              *string `json:"name"`
+	Location          *string `json:"location"`
+	CreatedTime       *string `json:"created_time"`
+	KubernetesVersion *string `json:"kubernetes_version"`
+	ProvisioningState *string `json:"provisioning_state"`
+	// PowerState 集群运行状态，Running 或 Stopped
+	PowerState    *string `json:"power_state"`
+	DnsPrefix     *string `json:"dns_prefix"`
+	Fqdn          *string `json:"fqdn"`
+	NetworkPlugin *string `json:"network_plugin"`
+	// NodeResourceGroup 存放节点虚拟机规模集的资源组
+	NodeResourceGroup *string          `json:"node_resource_group"`
+	AgentPoolProfiles []AzureAgentPool `json:"agent_pool_profiles"`
+}
+
+// AzureAgentPool AKS节点池，节点池在集群详情中返回，名称在集群内唯一
+type AzureAgentPool struct {
+	Name              *string `json:"name"`
+	Count             *int64  `json:"count"`
+	MinCount          *int64  `json:"minCount"`
+	MaxCount          *int64  `json:"maxCount"`
+	VMSize            *string `json:"vmSize"`
+	ProvisioningState *string `json:"provisioningState"`
+	VnetSubnetID      *string `json:"vnetSubnetID"`
+}
+
+// GetCloudID ...
+func (c AzureCluster) GetCloudID() string {
+	return converter.PtrToVal(c.ID)
+}
+
+// GetStatus 集群创建成功后使用运行状态，否则使用部署状态
+func (c AzureCluster) GetStatus() string {
+	if converter.PtrToVal(c.ProvisioningState) == "Succeeded" && c.PowerState != nil {
+		return *c.PowerState
+	}
+	return converter.PtrToVal(c.ProvisioningState)
+}
+
+// GetCloudVpcID 使用节点池所在子网解析出虚拟网络，使用默认网络的集群没有指定子网
+func (c AzureCluster) GetCloudVpcID() string {
+	for _, one := range c.AgentPoolProfiles {
+		subnetID := strings.ToLower(converter.PtrToVal(one.VnetSubnetID))
+		if idx := strings.Index(subnetID, "/subnets/"); idx > 0 {
+			return subnetID[:idx]
+		}
+	}
+	return ""
+}
+
+// GetNodePools ...
+func (c AzureCluster) GetNodePools() []K8sNodePool {
+	pools := make([]K8sNodePool, 0, len(c.AgentPoolProfiles))
+	for _, one := range c.AgentPoolProfiles {
+		pools = append(pools, K8sNodePool{
+			CloudID:      converter.PtrToVal(one.Name),
+			Name:         converter.PtrToVal(one.Name),
+			Status:       converter.PtrToVal(one.ProvisioningState),
+			InstanceType: converter.PtrToVal(one.VMSize),
+			NodeCount:    converter.PtrToVal(one.Count),
+			MinSize:      converter.PtrToVal(one.MinCount),
+			MaxSize:      converter.PtrToVal(one.MaxCount),
+		})
+	}
+
+	return pools
+}
@@ -0,0 +1,97 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package k8scluster
+
+import (
+	"hcm/pkg/criteria/validator"
+
+	"google.golang.org/api/container/v1"
+)
+
+// GcpK8sNodeListOption define gcp gke node list option.
+type GcpK8sNodeListOption struct {
+	// NodePoolInstanceGroups 节点池名称到节点池托管实例组链接的映射
+	NodePoolInstanceGroups map[string][]string `json:"node_pool_instance_groups" validate:"required"`
+}
+
+// Validate gcp gke node list option.
+func (opt GcpK8sNodeListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// GcpCluster for gke Cluster
+type GcpCluster struct {
+	*container.Cluster
+}
+
+// GetCloudID ...
+func (c GcpCluster) GetCloudID() string {
+	return c.Id
+}
+
+// GetRegion 可用区集群的 Location 为可用区，转换为所在地域
+func (c GcpCluster) GetRegion() string {
+	location := c.Location
+	if idx := len(location) - 2; idx > 0 && location[idx] == '-' {
+		return location[:idx]
+	}
+	return location
+}
+
+// GetCloudVpcSelfLink 返回集群所在VPC的selfLink
+func (c GcpCluster) GetCloudVpcSelfLink() string {
+	if c.NetworkConfig == nil || len(c.NetworkConfig.Network) == 0 {
+		return ""
+	}
+	return "https://www.googleapis.com/compute/v1/" + c.NetworkConfig.Network
+}
+
+// GetNodePoolInstanceGroups 返回 节点池名称 -> 节点池托管实例组链接 的映射
+func (c GcpCluster) GetNodePoolInstanceGroups() map[string][]string {
+	result := make(map[string][]string, len(c.NodePools))
+	for _, one := range c.NodePools {
+		if len(one.InstanceGroupUrls) != 0 {
+			result[one.Name] = one.InstanceGroupUrls
+		}
+	}
+	return result
+}
+
+// GetNodePools 节点池在集群详情中返回，节点数需要查询托管实例组后确定
+func (c GcpCluster) GetNodePools() []K8sNodePool {
+	pools := make([]K8sNodePool, 0, len(c.NodePools))
+	for _, one := range c.NodePools {
+		pool := K8sNodePool{
+			CloudID: one.Name,
+			Name:    one.Name,
+			Status:  one.Status,
+		}
+		if one.Config != nil {
+			pool.InstanceType = one.Config.MachineType
+		}
+		if one.Autoscaling != nil && one.Autoscaling.Enabled {
+			pool.MinSize = one.Autoscaling.MinNodeCount
+			pool.MaxSize = one.Autoscaling.MaxNodeCount
+		}
+		pools = append(pools, pool)
+	}
+
+	return pools
+}
@@ -0,0 +1,119 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package k8scluster
+
+import (
+	"strings"
+
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/cce/v3/model"
+)
+
+// HuaWeiNodePoolAnnotation CCE节点所属节点池的注解，取值格式为 {az}#{节点池ID}
+const HuaWeiNodePoolAnnotation = "kubernetes.io/node-pool.id"
+
+// HuaWeiK8sClusterListOption define huawei cce cluster list option.
+type HuaWeiK8sClusterListOption struct {
+	Region string `json:"region" validate:"required"`
+}
+
+// Validate huawei cce cluster list option.
+func (opt HuaWeiK8sClusterListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// HuaWeiK8sNodeListOption define huawei cce node pool and node list option.
+type HuaWeiK8sNodeListOption struct {
+	Region         string `json:"region" validate:"required"`
+	ClusterCloudID string `json:"cluster_cloud_id" validate:"required"`
+}
+
+// Validate huawei cce node list option.
+func (opt HuaWeiK8sNodeListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// HuaWeiCluster for cce Cluster
+type HuaWeiCluster struct {
+	model.Cluster
+}
+
+// GetCloudID ...
+func (c HuaWeiCluster) GetCloudID() string {
+	if c.Metadata == nil {
+		return ""
+	}
+	return converter.PtrToVal(c.Metadata.Uid)
+}
+
+// GetName ...
+func (c HuaWeiCluster) GetName() string {
+	if c.Metadata == nil {
+		return ""
+	}
+	return c.Metadata.Name
+}
+
+// GetStatus ...
+func (c HuaWeiCluster) GetStatus() string {
+	if c.Status == nil {
+		return ""
+	}
+	return converter.PtrToVal(c.Status.Phase)
+}
+
+// GetVersion ...
+func (c HuaWeiCluster) GetVersion() string {
+	if c.Spec == nil {
+		return ""
+	}
+	return converter.PtrToVal(c.Spec.Version)
+}
+
+// GetCreatedTime ...
+func (c HuaWeiCluster) GetCreatedTime() string {
+	if c.Metadata == nil {
+		return ""
+	}
+	return converter.PtrToVal(c.Metadata.CreationTimestamp)
+}
+
+// GetCloudVpcID ...
+func (c HuaWeiCluster) GetCloudVpcID() string {
+	if c.Spec == nil || c.Spec.HostNetwork == nil {
+		return ""
+	}
+	return c.Spec.HostNetwork.Vpc
+}
+
+// GetNodePoolID 解析节点注解中的节点池ID，不属于任何节点池的节点没有该注解
+func GetNodePoolID(node model.Node) string {
+	if node.Metadata == nil {
+		return ""
+	}
+
+	value := node.Metadata.Annotations[HuaWeiNodePoolAnnotation]
+	if idx := strings.LastIndex(value, "#"); idx >= 0 {
+		return value[idx+1:]
+	}
+	return value
+}
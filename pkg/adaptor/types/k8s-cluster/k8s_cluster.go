@@ -0,0 +1,40 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package k8scluster ...
+package k8scluster
+
+// K8sNodePool 节点池，各云厂商节点池结构差异较大，统一转换后再同步
+type K8sNodePool struct {
+	CloudID      string
+	Name         string
+	Status       string
+	InstanceType string
+	NodeCount    int64
+	MinSize      int64
+	MaxSize      int64
+}
+
+// K8sNode 集群节点，只包含同步节点和主机关联关系需要的信息
+type K8sNode struct {
+	// CloudCvmID 节点对应的云主机ID
+	CloudCvmID string
+	// CloudNodePoolID 节点所属的节点池，不属于任何节点池时为空
+	CloudNodePoolID string
+}
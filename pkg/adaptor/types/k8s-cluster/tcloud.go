@@ -0,0 +1,133 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package k8scluster
+
+import (
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+)
+
+// TCloudK8sNodeListOption define tcloud tke node pool and node list option.
+type TCloudK8sNodeListOption struct {
+	Region         string `json:"region" validate:"required"`
+	ClusterCloudID string `json:"cluster_cloud_id" validate:"required"`
+}
+
+// Validate tcloud tke node list option.
+func (opt TCloudK8sNodeListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// TCloudCluster TKE集群，字段参考 DescribeClusters 返回的 Cluster
+type TCloudCluster struct {
+	ClusterId      *string `json:"ClusterId"`
+	ClusterName    *string `json:"ClusterName"`
+	ClusterVersion *string `json:"ClusterVersion"`
+	ClusterOs      *string `json:"ClusterOs"`
+	// ClusterType 集群类型，MANAGED_CLUSTER-托管集群，INDEPENDENT_CLUSTER-独立集群
+	ClusterType            *string `json:"ClusterType"`
+	ClusterStatus          *string `json:"ClusterStatus"`
+	ContainerRuntime       *string `json:"ContainerRuntime"`
+	ProjectId              *int64  `json:"ProjectId"`
+	CreatedTime            *string `json:"CreatedTime"`
+	ClusterNetworkSettings *struct {
+		VpcId       *string `json:"VpcId"`
+		ClusterCIDR *string `json:"ClusterCIDR"`
+	} `json:"ClusterNetworkSettings"`
+}
+
+// GetCloudID ...
+func (c TCloudCluster) GetCloudID() string {
+	return converter.PtrToVal(c.ClusterId)
+}
+
+// GetCloudVpcID ...
+func (c TCloudCluster) GetCloudVpcID() string {
+	if c.ClusterNetworkSettings == nil {
+		return ""
+	}
+	return converter.PtrToVal(c.ClusterNetworkSettings.VpcId)
+}
+
+// GetClusterCIDR ...
+func (c TCloudCluster) GetClusterCIDR() string {
+	if c.ClusterNetworkSettings == nil {
+		return ""
+	}
+	return converter.PtrToVal(c.ClusterNetworkSettings.ClusterCIDR)
+}
+
+// TCloudClusterListResult DescribeClusters 的返回结果
+type TCloudClusterListResult struct {
+	TotalCount *int64          `json:"TotalCount"`
+	Clusters   []TCloudCluster `json:"Clusters"`
+	RequestId  *string         `json:"RequestId"`
+}
+
+// TCloudNodePool TKE节点池，字段参考 DescribeClusterNodePools 返回的 NodePool
+type TCloudNodePool struct {
+	NodePoolId       *string `json:"NodePoolId"`
+	Name             *string `json:"Name"`
+	LifeState        *string `json:"LifeState"`
+	NodeCountSummary *struct {
+		ManuallyAdded    *TCloudNodeCount `json:"ManuallyAdded"`
+		AutoscalingAdded *TCloudNodeCount `json:"AutoscalingAdded"`
+	} `json:"NodeCountSummary"`
+	MaxNodesNum *int64 `json:"MaxNodesNum"`
+	MinNodesNum *int64 `json:"MinNodesNum"`
+}
+
+// TCloudNodeCount 节点池中某一类节点的数量统计
+type TCloudNodeCount struct {
+	Total *int64 `json:"Total"`
+}
+
+// GetNodeCount 节点池节点数为手动加入和伸缩组创建的节点之和
+func (p TCloudNodePool) GetNodeCount() int64 {
+	if p.NodeCountSummary == nil {
+		return 0
+	}
+
+	var count int64
+	if p.NodeCountSummary.ManuallyAdded != nil {
+		count += converter.PtrToVal(p.NodeCountSummary.ManuallyAdded.Total)
+	}
+	if p.NodeCountSummary.AutoscalingAdded != nil {
+		count += converter.PtrToVal(p.NodeCountSummary.AutoscalingAdded.Total)
+	}
+	return count
+}
+
+// TCloudNodePoolListResult DescribeClusterNodePools 的返回结果
+type TCloudNodePoolListResult struct {
+	TotalCount  *int64           `json:"TotalCount"`
+	NodePoolSet []TCloudNodePool `json:"NodePoolSet"`
+	RequestId   *string          `json:"RequestId"`
+}
+
+// TCloudNodeListResult DescribeClusterInstances 的返回结果
+type TCloudNodeListResult struct {
+	TotalCount  *int64 `json:"TotalCount"`
+	InstanceSet []struct {
+		InstanceId *string `json:"InstanceId"`
+		NodePoolId *string `json:"NodePoolId"`
+	} `json:"InstanceSet"`
+	RequestId *string `json:"RequestId"`
+}
@@ -0,0 +1,107 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package keypair
+
+import (
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// AwsKeyPairListOption aws 密钥对查询接口不分页，一次返回地域下的全部密钥对
+type AwsKeyPairListOption struct {
+	Region   string   `json:"region" validate:"required"`
+	CloudIDs []string `json:"cloud_ids" validate:"omitempty"`
+	// Names 按密钥对名称过滤，aws 创建主机时只能通过名称指定密钥对
+	Names []string `json:"names" validate:"omitempty"`
+}
+
+// Validate aws key pair list option.
+func (opt AwsKeyPairListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ToDescribeKeyPairsInput ...
+func (opt AwsKeyPairListOption) ToDescribeKeyPairsInput() (*ec2.DescribeKeyPairsInput, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	// 通过 KeyPairIds 查询已被删除的密钥对会直接报错，所以使用 key-pair-id 过滤
+	input := &ec2.DescribeKeyPairsInput{IncludePublicKey: aws.Bool(true)}
+	if len(opt.CloudIDs) != 0 {
+		input.Filters = append(input.Filters, &ec2.Filter{Name: aws.String("key-pair-id"),
+			Values: aws.StringSlice(opt.CloudIDs)})
+	}
+	if len(opt.Names) != 0 {
+		input.Filters = append(input.Filters, &ec2.Filter{Name: aws.String("key-name"),
+			Values: aws.StringSlice(opt.Names)})
+	}
+
+	return input, nil
+}
+
+// AwsKeyPairImportOption aws 导入公钥参数
+// reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_ImportKeyPair.html
+type AwsKeyPairImportOption struct {
+	Region    string `json:"region" validate:"required"`
+	Name      string `json:"name" validate:"required,max=255"`
+	PublicKey string `json:"public_key" validate:"required"`
+}
+
+// Validate ...
+func (opt *AwsKeyPairImportOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ToImportKeyPairInput ...
+func (opt *AwsKeyPairImportOption) ToImportKeyPairInput() (*ec2.ImportKeyPairInput, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &ec2.ImportKeyPairInput{
+		KeyName:           aws.String(opt.Name),
+		PublicKeyMaterial: []byte(opt.PublicKey),
+	}, nil
+}
+
+// AwsKeyPairDeleteOption ...
+type AwsKeyPairDeleteOption struct {
+	Region  string `json:"region" validate:"required"`
+	CloudID string `json:"cloud_id" validate:"required"`
+}
+
+// Validate ...
+func (opt *AwsKeyPairDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AwsKeyPair for ec2 KeyPairInfo
+type AwsKeyPair struct {
+	*ec2.KeyPairInfo
+}
+
+// GetCloudID ...
+func (key AwsKeyPair) GetCloudID() string {
+	return converter.PtrToVal(key.KeyPairId)
+}
@@ -0,0 +1,91 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package keypair
+
+import (
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+)
+
+// AzureKeyPairListOption define azure ssh public key list option.
+type AzureKeyPairListOption struct {
+	ResourceGroupName string   `json:"resource_group_name" validate:"required"`
+	CloudIDs          []string `json:"cloud_ids" validate:"omitempty"`
+}
+
+// Validate azure key pair list option.
+func (opt AzureKeyPairListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AzureKeyPairImportOption Azure 导入 SSH 公钥参数，公钥需为至少 2048 位的 ssh-rsa 格式
+// reference: https://learn.microsoft.com/en-us/rest/api/compute/ssh-public-keys/create
+type AzureKeyPairImportOption struct {
+	ResourceGroupName string `json:"resource_group_name" validate:"required"`
+	Region            string `json:"region" validate:"required"`
+	Name              string `json:"name" validate:"required"`
+	PublicKey         string `json:"public_key" validate:"required"`
+}
+
+// Validate ...
+func (opt *AzureKeyPairImportOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ToSSHPublicKeyResource 转换成接口需要的 armcompute.SSHPublicKeyResource 结构
+func (opt *AzureKeyPairImportOption) ToSSHPublicKeyResource() (*armcompute.SSHPublicKeyResource, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &armcompute.SSHPublicKeyResource{
+		Location: to.Ptr(opt.Region),
+		Properties: &armcompute.SSHPublicKeyResourceProperties{
+			PublicKey: to.Ptr(opt.PublicKey),
+		},
+	}, nil
+}
+
+// AzureKeyPairDeleteOption ...
+type AzureKeyPairDeleteOption struct {
+	ResourceGroupName string `json:"resource_group_name" validate:"required"`
+	Name              string `json:"name" validate:"required"`
+}
+
+// Validate ...
+func (opt *AzureKeyPairDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AzureKeyPair define azure ssh public key.
+type AzureKeyPair struct {
+	ID        *string `json:"id"`
+	Name      *string `json:"name"`
+	Location  *string `json:"location"`
+	PublicKey *string `json:"public_key"`
+}
+
+// GetCloudID ...
+func (key AzureKeyPair) GetCloudID() string {
+	return converter.PtrToVal(key.ID)
+}
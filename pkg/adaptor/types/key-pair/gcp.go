@@ -0,0 +1,173 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package keypair
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"hcm/pkg/criteria/validator"
+)
+
+// GcpSSHKeysMetadataKey gcp 项目级/实例级元数据中存放 SSH 公钥的 key，每行格式为 "用户名:公钥"
+// reference: https://cloud.google.com/compute/docs/connect/add-ssh-keys#metadata
+const GcpSSHKeysMetadataKey = "ssh-keys"
+
+// GcpBlockProjectSSHKeysMetadataKey 实例设置该元数据为 TRUE 时只使用实例级 SSH 公钥，不继承项目级公钥
+const GcpBlockProjectSSHKeysMetadataKey = "block-project-ssh-keys"
+
+// GcpKeyPairListOption gcp 的 SSH 公钥保存在项目元数据中，为全局资源，以用户名作为云上ID
+type GcpKeyPairListOption struct {
+	CloudIDs []string `json:"cloud_ids" validate:"omitempty"`
+}
+
+// Validate gcp key pair list option.
+func (opt GcpKeyPairListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// GcpKeyPairImportOption Gcp 导入 SSH 公钥参数，Name 为登录主机使用的用户名
+type GcpKeyPairImportOption struct {
+	Name      string `json:"name" validate:"required,max=32"`
+	PublicKey string `json:"public_key" validate:"required"`
+}
+
+// Validate ...
+func (opt *GcpKeyPairImportOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if strings.ContainsAny(opt.Name, ": \n") {
+		return errors.New("name can not contain ':', space or line break")
+	}
+
+	if strings.Contains(opt.PublicKey, "\n") {
+		return errors.New("public_key can not contain line break")
+	}
+
+	return nil
+}
+
+// GcpKeyPairDeleteOption ...
+type GcpKeyPairDeleteOption struct {
+	CloudID string `json:"cloud_id" validate:"required"`
+}
+
+// Validate ...
+func (opt *GcpKeyPairDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// GcpKeyPairBindOption 将项目中的 SSH 公钥写入主机实例元数据，写入后主机内的 guest agent 会自动创建对应用户
+type GcpKeyPairBindOption struct {
+	Zone        string   `json:"zone" validate:"required"`
+	CloudIDs    []string `json:"cloud_ids" validate:"required,min=1"`
+	CloudCvmIDs []string `json:"cloud_cvm_ids" validate:"required,min=1,max=100"`
+}
+
+// Validate ...
+func (opt *GcpKeyPairBindOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// GcpKeyPair define gcp ssh key in metadata.
+type GcpKeyPair struct {
+	Name        string `json:"name"`
+	PublicKey   string `json:"public_key"`
+	Fingerprint string `json:"fingerprint"`
+	Comment     string `json:"comment"`
+}
+
+// GetCloudID ...
+func (key GcpKeyPair) GetCloudID() string {
+	return key.Name
+}
+
+// MetadataLine 转换成元数据中 ssh-keys 的一行
+func (key GcpKeyPair) MetadataLine() string {
+	return key.Name + ":" + key.PublicKey
+}
+
+// ParseGcpSSHKeys 解析元数据中 ssh-keys 的值，忽略格式错误的行
+func ParseGcpSSHKeys(value string) []GcpKeyPair {
+	keys := make([]GcpKeyPair, 0)
+	for _, line := range strings.Split(value, "\n") {
+		key, err := ParseGcpSSHKey(line)
+		if err != nil {
+			continue
+		}
+		keys = append(keys, *key)
+	}
+
+	return keys
+}
+
+// ParseGcpSSHKey 解析 "用户名:类型 公钥内容 [注释]" 格式的一行 SSH 公钥
+func ParseGcpSSHKey(line string) (*GcpKeyPair, error) {
+	line = strings.TrimSpace(line)
+	name, publicKey, found := strings.Cut(line, ":")
+	if !found || len(name) == 0 {
+		return nil, fmt.Errorf("invalid ssh key line: %s", line)
+	}
+
+	fields := strings.Fields(publicKey)
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("invalid ssh public key of %s", name)
+	}
+
+	fingerprint, err := SSHPublicKeyFingerprint(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &GcpKeyPair{
+		Name:        name,
+		PublicKey:   publicKey,
+		Fingerprint: fingerprint,
+	}
+	if len(fields) > 2 {
+		key.Comment = strings.Join(fields[2:], " ")
+	}
+
+	return key, nil
+}
+
+// SSHPublicKeyFingerprint 计算 OpenSSH 格式公钥的 SHA256 指纹，格式与 ssh-keygen -l 一致
+func SSHPublicKeyFingerprint(publicKey string) (string, error) {
+	fields := strings.Fields(publicKey)
+	if len(fields) < 2 {
+		return "", errors.New("invalid ssh public key format")
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return "", fmt.Errorf("decode ssh public key failed, err: %v", err)
+	}
+
+	sum := sha256.Sum256(raw)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:]), nil
+}
+
+// GcpGlobalRegion gcp 的 SSH 公钥为项目级全局资源，本地以 global 作为地域
+const GcpGlobalRegion = "global"
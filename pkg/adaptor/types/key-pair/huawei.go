@@ -0,0 +1,106 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package keypair
+
+import (
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/kps/v3/model"
+)
+
+// HuaWeiKeyPairListOption 华为云密钥对以名称作为云上ID，查询接口不支持按名称过滤，需全量查询后过滤
+type HuaWeiKeyPairListOption struct {
+	Region   string   `json:"region" validate:"required"`
+	CloudIDs []string `json:"cloud_ids" validate:"omitempty"`
+}
+
+// Validate huawei key pair list option.
+func (opt HuaWeiKeyPairListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// HuaWeiKeyPairImportOption 华为云导入公钥参数
+// reference: https://support.huaweicloud.com/api-dew/CreateKeypair.html
+type HuaWeiKeyPairImportOption struct {
+	Region    string `json:"region" validate:"required"`
+	Name      string `json:"name" validate:"required,max=64"`
+	PublicKey string `json:"public_key" validate:"required"`
+}
+
+// Validate ...
+func (opt *HuaWeiKeyPairImportOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ToCreateKeypairRequest 转换成接口需要的 CreateKeypairRequest 结构
+func (opt *HuaWeiKeyPairImportOption) ToCreateKeypairRequest() (*model.CreateKeypairRequest, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	typ := model.GetCreateKeypairActionTypeEnum().SSH
+	scope := model.GetCreateKeypairActionScopeEnum().USER
+	return &model.CreateKeypairRequest{
+		Body: &model.CreateKeypairRequestBody{
+			Keypair: &model.CreateKeypairAction{
+				Name:      opt.Name,
+				PublicKey: converter.ValToPtr(opt.PublicKey),
+				Type:      &typ,
+				Scope:     &scope,
+			},
+		},
+	}, nil
+}
+
+// HuaWeiKeyPairDeleteOption ...
+type HuaWeiKeyPairDeleteOption struct {
+	Region  string `json:"region" validate:"required"`
+	CloudID string `json:"cloud_id" validate:"required"`
+}
+
+// Validate ...
+func (opt *HuaWeiKeyPairDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// HuaWeiKeyPairBindOption 华为云绑定/解绑密钥对参数，一台主机只能绑定一个密钥对，操作时主机需处于关机状态
+type HuaWeiKeyPairBindOption struct {
+	Region     string `json:"region" validate:"required"`
+	CloudID    string `json:"cloud_id" validate:"omitempty"`
+	CloudCvmID string `json:"cloud_cvm_id" validate:"required"`
+	// DisablePassword 绑定密钥对后是否禁用主机的密码登录
+	DisablePassword *bool `json:"disable_password" validate:"omitempty"`
+}
+
+// Validate ...
+func (opt *HuaWeiKeyPairBindOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// HuaWeiKeyPair for kps Keypair
+type HuaWeiKeyPair struct {
+	*model.Keypair
+}
+
+// GetCloudID ...
+func (key HuaWeiKeyPair) GetCloudID() string {
+	return converter.PtrToVal(key.Name)
+}
@@ -0,0 +1,120 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package keypair ...
+package keypair
+
+import (
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+)
+
+// TCloudKeyPairImportOption 腾讯云导入公钥参数
+type TCloudKeyPairImportOption struct {
+	Region    string `json:"region" validate:"required"`
+	Name      string `json:"name" validate:"required,max=25"`
+	PublicKey string `json:"public_key" validate:"required"`
+	// ProjectID 密钥对所属项目，不传时为默认项目
+	ProjectID *int64 `json:"project_id" validate:"omitempty"`
+}
+
+// Validate ...
+func (opt *TCloudKeyPairImportOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ToImportKeyPairRequest 转换成接口需要的 ImportKeyPairRequest 结构
+func (opt *TCloudKeyPairImportOption) ToImportKeyPairRequest() (*cvm.ImportKeyPairRequest, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	req := cvm.NewImportKeyPairRequest()
+	req.KeyName = common.StringPtr(opt.Name)
+	req.PublicKey = common.StringPtr(opt.PublicKey)
+	req.ProjectId = common.Int64Ptr(converter.PtrToVal(opt.ProjectID))
+
+	return req, nil
+}
+
+// TCloudKeyPairDeleteOption ...
+type TCloudKeyPairDeleteOption struct {
+	Region   string   `json:"region" validate:"required"`
+	CloudIDs []string `json:"cloud_ids" validate:"required,min=1,max=100"`
+}
+
+// Validate ...
+func (opt *TCloudKeyPairDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// TCloudKeyPairBindOption 腾讯云绑定/解绑密钥对参数，绑定和解绑都需要主机处于关机状态
+type TCloudKeyPairBindOption struct {
+	Region      string   `json:"region" validate:"required"`
+	CloudIDs    []string `json:"cloud_ids" validate:"required,min=1,max=100"`
+	CloudCvmIDs []string `json:"cloud_cvm_ids" validate:"required,min=1,max=100"`
+	// ForceStop 主机处于运行中时是否强制关机
+	ForceStop *bool `json:"force_stop" validate:"omitempty"`
+}
+
+// Validate ...
+func (opt *TCloudKeyPairBindOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ToAssociateRequest ...
+func (opt *TCloudKeyPairBindOption) ToAssociateRequest() (*cvm.AssociateInstancesKeyPairsRequest, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	req := cvm.NewAssociateInstancesKeyPairsRequest()
+	req.KeyIds = common.StringPtrs(opt.CloudIDs)
+	req.InstanceIds = common.StringPtrs(opt.CloudCvmIDs)
+	req.ForceStop = opt.ForceStop
+
+	return req, nil
+}
+
+// ToDisassociateRequest ...
+func (opt *TCloudKeyPairBindOption) ToDisassociateRequest() (*cvm.DisassociateInstancesKeyPairsRequest, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	req := cvm.NewDisassociateInstancesKeyPairsRequest()
+	req.KeyIds = common.StringPtrs(opt.CloudIDs)
+	req.InstanceIds = common.StringPtrs(opt.CloudCvmIDs)
+	req.ForceStop = opt.ForceStop
+
+	return req, nil
+}
+
+// TCloudKeyPair for cvm KeyPair
+type TCloudKeyPair struct {
+	*cvm.KeyPair
+}
+
+// GetCloudID ...
+func (key TCloudKeyPair) GetCloudID() string {
+	return converter.PtrToVal(key.KeyId)
+}
@@ -0,0 +1,237 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package loadbalancer
+
+import (
+	"errors"
+
+	"hcm/pkg/adaptor/types/core"
+	"hcm/pkg/criteria/validator"
+	cvt "hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+)
+
+// AwsLoadBalancerType aws负载均衡类型
+type AwsLoadBalancerType string
+
+const (
+	// AwsApplicationLoadBalancer 应用型负载均衡(ALB)
+	AwsApplicationLoadBalancer AwsLoadBalancerType = "application"
+	// AwsNetworkLoadBalancer 网络型负载均衡(NLB)
+	AwsNetworkLoadBalancer AwsLoadBalancerType = "network"
+)
+
+// AwsLoadBalancerScheme aws负载均衡的网络类型
+type AwsLoadBalancerScheme string
+
+const (
+	// AwsInternetFacingScheme 公网
+	AwsInternetFacingScheme AwsLoadBalancerScheme = "internet-facing"
+	// AwsInternalScheme 内网
+	AwsInternalScheme AwsLoadBalancerScheme = "internal"
+)
+
+// AwsElbQueryLimit elbv2 按ARN查询时单次最多支持的数量
+const AwsElbQueryLimit = 20
+
+// AwsElbPageSizeLimit elbv2 分页查询的最大页大小
+const AwsElbPageSizeLimit = 400
+
+// AwsElbPage aws elbv2 分页参数，elbv2 使用 Marker 分页，与 ec2 的 NextToken 不同
+type AwsElbPage struct {
+	Marker   *string `json:"marker,omitempty"`
+	PageSize *int64  `json:"page_size" validate:"omitempty,min=1,max=400"`
+}
+
+// -------------------------- List Load Balancer --------------------------
+
+// AwsListOption defines options to list aws load balancer.
+type AwsListOption struct {
+	Region   string      `json:"region" validate:"required"`
+	CloudIDs []string    `json:"cloud_ids" validate:"omitempty,max=20"`
+	Page     *AwsElbPage `json:"page" validate:"omitempty"`
+}
+
+// Validate aws load balancer list option.
+func (opt AwsListOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if len(opt.CloudIDs) != 0 && opt.Page != nil {
+		return errors.New("only one of cloud_ids and page can be set")
+	}
+
+	return nil
+}
+
+// AwsListResult aws load balancer list result.
+type AwsListResult struct {
+	NextMarker *string           `json:"next_marker"`
+	Details    []AwsLoadBalancer `json:"details"`
+}
+
+// AwsLoadBalancer for aws elbv2 load balancer.
+type AwsLoadBalancer struct {
+	*elbv2.LoadBalancer
+}
+
+// GetCloudID get cloud id
+func (lb AwsLoadBalancer) GetCloudID() string {
+	return cvt.PtrToVal(lb.LoadBalancerArn)
+}
+
+// GetZones 返回负载均衡所在的可用区及子网
+func (lb AwsLoadBalancer) GetZones() (zones []string, cloudSubnetIDs []string) {
+	for _, one := range lb.AvailabilityZones {
+		if one == nil {
+			continue
+		}
+		zones = append(zones, cvt.PtrToVal(one.ZoneName))
+		if one.SubnetId != nil {
+			cloudSubnetIDs = append(cloudSubnetIDs, *one.SubnetId)
+		}
+	}
+	return zones, cloudSubnetIDs
+}
+
+// -------------------------- Create Load Balancer --------------------------
+
+// AwsCreateOption defines options to create aws load balancer.
+type AwsCreateOption struct {
+	Region         string                `json:"region" validate:"required"`
+	Name           string                `json:"name" validate:"required,max=32"`
+	Type           AwsLoadBalancerType   `json:"type" validate:"required,oneof=application network"`
+	Scheme         AwsLoadBalancerScheme `json:"scheme" validate:"omitempty,oneof=internet-facing internal"`
+	IpAddressType  *string               `json:"ip_address_type" validate:"omitempty"`
+	CloudSubnetIDs []string              `json:"cloud_subnet_ids" validate:"required,min=1"`
+	SecurityGroups []string              `json:"security_groups" validate:"omitempty"`
+}
+
+// Validate aws load balancer create option.
+func (opt AwsCreateOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// -------------------------- Delete Load Balancer --------------------------
+
+// AwsDeleteOption defines options to delete aws load balancer.
+type AwsDeleteOption struct {
+	Region   string   `json:"region" validate:"required"`
+	CloudIDs []string `json:"cloud_ids" validate:"required,min=1,max=20"`
+}
+
+// Validate aws load balancer delete option.
+func (opt AwsDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// -------------------------- List Listener --------------------------
+
+// AwsListListenerOption defines options to list aws listener.
+type AwsListListenerOption struct {
+	Region         string      `json:"region" validate:"required"`
+	LoadBalancerID string      `json:"load_balancer_id" validate:"required"`
+	Page           *AwsElbPage `json:"page" validate:"omitempty"`
+}
+
+// Validate aws listener list option.
+func (opt AwsListListenerOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AwsListenerListResult aws listener list result.
+type AwsListenerListResult struct {
+	NextMarker *string       `json:"next_marker"`
+	Details    []AwsListener `json:"details"`
+}
+
+// AwsListener for aws elbv2 listener.
+type AwsListener struct {
+	*elbv2.Listener
+}
+
+// GetCloudID get cloud id
+func (l AwsListener) GetCloudID() string {
+	return cvt.PtrToVal(l.ListenerArn)
+}
+
+// -------------------------- List Target Group --------------------------
+
+// AwsListTargetGroupOption defines options to list aws target group.
+type AwsListTargetGroupOption struct {
+	Region   string      `json:"region" validate:"required"`
+	CloudIDs []string    `json:"cloud_ids" validate:"omitempty,max=20"`
+	Page     *AwsElbPage `json:"page" validate:"omitempty"`
+}
+
+// Validate aws target group list option.
+func (opt AwsListTargetGroupOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if len(opt.CloudIDs) != 0 && opt.Page != nil {
+		return errors.New("only one of cloud_ids and page can be set")
+	}
+
+	return nil
+}
+
+// AwsTargetGroupListResult aws target group list result.
+type AwsTargetGroupListResult struct {
+	NextMarker *string          `json:"next_marker"`
+	Details    []AwsTargetGroup `json:"details"`
+}
+
+// AwsTargetGroup for aws elbv2 target group.
+type AwsTargetGroup struct {
+	*elbv2.TargetGroup
+}
+
+// GetCloudID get cloud id
+func (tg AwsTargetGroup) GetCloudID() string {
+	return cvt.PtrToVal(tg.TargetGroupArn)
+}
+
+// -------------------------- Register/Deregister Targets --------------------------
+
+// AwsTarget aws 后端目标，实例类型目标组中 ID 为实例ID，IP类型目标组中 ID 为IP地址
+type AwsTarget struct {
+	ID   string `json:"id" validate:"required"`
+	Port int64  `json:"port" validate:"omitempty,min=1,max=65535"`
+}
+
+// AwsTargetOption defines options to register or deregister aws targets.
+type AwsTargetOption struct {
+	Region             string       `json:"region" validate:"required"`
+	CloudTargetGroupID string       `json:"cloud_target_group_id" validate:"required"`
+	Targets            []*AwsTarget `json:"targets" validate:"required,min=1,dive,required"`
+}
+
+// Validate aws target register/deregister option.
+func (opt AwsTargetOption) Validate() error {
+	if len(opt.Targets) > core.AwsQueryLimit {
+		return errors.New("targets count should <= 1000")
+	}
+
+	return validator.Validate.Struct(opt)
+}
@@ -0,0 +1,73 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package loadbalancer
+
+import (
+	"hcm/pkg/criteria/validator"
+)
+
+// AzureLoadBalancer azure负载均衡
+type AzureLoadBalancer struct {
+	CloudID           string   `json:"cloud_id"`
+	Name              string   `json:"name"`
+	Region            string   `json:"region"`
+	ResourceGroupName string   `json:"resource_group_name"`
+	SkuName           string   `json:"sku_name"`
+	SkuTier           string   `json:"sku_tier"`
+	Zones             []string `json:"zones"`
+	ProvisioningState string   `json:"provisioning_state"`
+	CloudVpcID        string   `json:"cloud_vpc_id"`
+	CloudSubnetID     string   `json:"cloud_subnet_id"`
+	// PrivateIPv4Addresses 前端IP配置中的内网IPv4地址
+	PrivateIPv4Addresses []string `json:"private_ipv4_addresses"`
+	// PrivateIPv6Addresses 前端IP配置中的内网IPv6地址
+	PrivateIPv6Addresses []string `json:"private_ipv6_addresses"`
+	// CloudPublicIPIDs 前端IP配置关联的公网IP资源ID
+	CloudPublicIPIDs []string `json:"cloud_public_ip_ids"`
+	// FrontendIPConfigurations 前端IP配置ID
+	FrontendIPConfigurations []string `json:"frontend_ip_configurations"`
+	// BackendAddressPools 后端池ID
+	BackendAddressPools []string `json:"backend_address_pools"`
+	// LoadBalancingRules 负载均衡规则ID
+	LoadBalancingRules []string `json:"load_balancing_rules"`
+	// Probes 运行状况探测ID
+	Probes []string `json:"probes"`
+}
+
+// GetCloudID get cloud id
+func (lb AzureLoadBalancer) GetCloudID() string {
+	return lb.CloudID
+}
+
+// AzureListResult azure load balancer list result.
+type AzureListResult struct {
+	Details []AzureLoadBalancer `json:"details"`
+}
+
+// AzureDeleteOption defines options to delete azure load balancer.
+type AzureDeleteOption struct {
+	ResourceGroupName string `json:"resource_group_name" validate:"required"`
+	Name              string `json:"name" validate:"required"`
+}
+
+// Validate azure load balancer delete option.
+func (opt AzureDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
@@ -0,0 +1,71 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package loadbalancer
+
+import (
+	"strconv"
+
+	"hcm/pkg/adaptor/types/core"
+	"hcm/pkg/criteria/validator"
+
+	"google.golang.org/api/compute/v1"
+)
+
+// GcpGlobalRegion gcp 全局负载均衡(全局转发规则)使用的地域
+const GcpGlobalRegion = "global"
+
+// GcpListOption defines options to list gcp load balancer(forwarding rule).
+type GcpListOption struct {
+	Region   string        `json:"region" validate:"required"`
+	CloudIDs []string      `json:"cloud_ids" validate:"omitempty,max=500"`
+	Page     *core.GcpPage `json:"page" validate:"omitempty"`
+}
+
+// Validate gcp load balancer list option.
+func (opt GcpListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// GcpListResult gcp load balancer list result.
+type GcpListResult struct {
+	NextPageToken string            `json:"next_page_token"`
+	Details       []GcpLoadBalancer `json:"details"`
+}
+
+// GcpLoadBalancer gcp 负载均衡，gcp 没有负载均衡实例的概念，对外暴露的入口为转发规则，这里以转发规则作为负载均衡实例
+type GcpLoadBalancer struct {
+	*compute.ForwardingRule
+}
+
+// GetCloudID get cloud id
+func (lb GcpLoadBalancer) GetCloudID() string {
+	return strconv.FormatUint(lb.Id, 10)
+}
+
+// GcpDeleteOption defines options to delete gcp load balancer(forwarding rule).
+type GcpDeleteOption struct {
+	Region string `json:"region" validate:"required"`
+	Name   string `json:"name" validate:"required"`
+}
+
+// Validate gcp load balancer delete option.
+func (opt GcpDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
@@ -0,0 +1,53 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package loadbalancer
+
+import (
+	"hcm/pkg/criteria/validator"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/elb/v3/model"
+)
+
+// HuaWeiListResult huawei load balancer list result.
+type HuaWeiListResult struct {
+	NextMarker *string              `json:"next_marker"`
+	Details    []HuaWeiLoadBalancer `json:"details"`
+}
+
+// HuaWeiLoadBalancer for huawei elb instance.
+type HuaWeiLoadBalancer struct {
+	model.LoadBalancer
+}
+
+// GetCloudID get cloud id
+func (lb HuaWeiLoadBalancer) GetCloudID() string {
+	return lb.Id
+}
+
+// HuaWeiDeleteOption defines options to delete huawei load balancer.
+type HuaWeiDeleteOption struct {
+	Region  string `json:"region" validate:"required"`
+	CloudID string `json:"cloud_id" validate:"required"`
+}
+
+// Validate huawei load balancer delete option.
+func (opt HuaWeiDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
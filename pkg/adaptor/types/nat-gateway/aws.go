@@ -0,0 +1,87 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package natgateway
+
+import (
+	"hcm/pkg/adaptor/types/core"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// AwsNatGatewayListOption define aws nat gateway list option.
+type AwsNatGatewayListOption struct {
+	Region   string        `json:"region" validate:"required"`
+	CloudIDs []string      `json:"cloud_ids" validate:"omitempty"`
+	Page     *core.AwsPage `json:"page" validate:"omitempty"`
+}
+
+// Validate aws nat gateway list option.
+func (opt AwsNatGatewayListOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if opt.Page != nil {
+		if err := opt.Page.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AwsNatGateway for ec2 NatGateway
+type AwsNatGateway struct {
+	*ec2.NatGateway
+}
+
+// GetCloudID ...
+func (nat AwsNatGateway) GetCloudID() string {
+	return converter.PtrToVal(nat.NatGatewayId)
+}
+
+// GetName 返回 Name 标签的值
+func (nat AwsNatGateway) GetName() string {
+	for _, tag := range nat.Tags {
+		if tag != nil && converter.PtrToVal(tag.Key) == "Name" {
+			return converter.PtrToVal(tag.Value)
+		}
+	}
+	return ""
+}
+
+// GetIPs 返回NAT网关的公网IP和私网IP
+func (nat AwsNatGateway) GetIPs() (publicIPs []string, privateIPs []string) {
+	publicIPs, privateIPs = make([]string, 0), make([]string, 0)
+	for _, one := range nat.NatGatewayAddresses {
+		if one == nil {
+			continue
+		}
+		if one.PublicIp != nil {
+			publicIPs = append(publicIPs, *one.PublicIp)
+		}
+		if one.PrivateIp != nil {
+			privateIPs = append(privateIPs, *one.PrivateIp)
+		}
+	}
+	return publicIPs, privateIPs
+}
@@ -0,0 +1,56 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package natgateway
+
+import (
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+)
+
+// AzureNatGatewayListOption define azure nat gateway list option.
+type AzureNatGatewayListOption struct {
+	ResourceGroupName string   `json:"resource_group_name" validate:"required"`
+	CloudIDs          []string `json:"cloud_ids" validate:"omitempty"`
+}
+
+// Validate azure nat gateway list option.
+func (opt AzureNatGatewayListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AzureNatGateway define azure nat gateway.
+type AzureNatGateway struct {
+	ID                   *string  `json:"id"`
+	Name                 *string  `json:"name"`
+	Location             *string  `json:"location"`
+	Zones                []string `json:"zones"`
+	SKUName              *string  `json:"sku_name"`
+	ProvisioningState    *string  `json:"provisioning_state"`
+	IdleTimeoutInMinutes *int32   `json:"idle_timeout_in_minutes"`
+	// CloudPublicIPIDs 绑定的公网IP资源ID
+	CloudPublicIPIDs []string `json:"cloud_public_ip_ids"`
+	// CloudSubnetIDs 关联的子网ID，Azure NAT网关通过子网关联到虚拟网络
+	CloudSubnetIDs []string `json:"cloud_subnet_ids"`
+}
+
+// GetCloudID ...
+func (nat AzureNatGateway) GetCloudID() string {
+	return converter.PtrToVal(nat.ID)
+}
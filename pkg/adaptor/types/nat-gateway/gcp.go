@@ -0,0 +1,68 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package natgateway
+
+import (
+	"fmt"
+
+	"hcm/pkg/adaptor/types/core"
+	"hcm/pkg/criteria/validator"
+
+	"google.golang.org/api/compute/v1"
+)
+
+// GcpNatGatewayListOption define gcp nat gateway list option.
+type GcpNatGatewayListOption struct {
+	Region string `json:"region" validate:"required"`
+	// CloudRouterIDs Cloud NAT 挂在 Cloud Router 下，按路由器ID过滤
+	CloudRouterIDs []string      `json:"cloud_router_ids" validate:"omitempty"`
+	Page           *core.GcpPage `json:"page" validate:"omitempty"`
+}
+
+// Validate gcp nat gateway list option.
+func (opt GcpNatGatewayListOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if opt.Page != nil {
+		if err := opt.Page.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GcpNatGateway gcp 的 NAT 网关即 Cloud Router 中配置的 Cloud NAT
+type GcpNatGateway struct {
+	*compute.RouterNat
+	Router *compute.Router `json:"router"`
+}
+
+// GetCloudID Cloud NAT 没有独立ID，使用 "路由器ID/NAT名称" 作为云上ID
+func (nat GcpNatGateway) GetCloudID() string {
+	return GcpNatGatewayCloudID(nat.Router.Id, nat.Name)
+}
+
+// GcpNatGatewayCloudID ...
+func GcpNatGatewayCloudID(routerID uint64, natName string) string {
+	return fmt.Sprintf("%d/%s", routerID, natName)
+}
@@ -0,0 +1,61 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package natgateway
+
+import (
+	"hcm/pkg/criteria/validator"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/nat/v2/model"
+)
+
+// HuaWeiNatGatewayMaxLimit 华为云NAT网关查询不支持分页，单次最多返回的数量
+const HuaWeiNatGatewayMaxLimit = 2000
+
+// HuaWeiNatGatewayListOption define huawei nat gateway list option.
+type HuaWeiNatGatewayListOption struct {
+	Region string `json:"region" validate:"required"`
+	// CloudID 华为云按ID过滤NAT网关时只支持单个ID
+	CloudID *string `json:"cloud_id" validate:"omitempty"`
+	Limit   *int32  `json:"limit" validate:"omitempty,max=2000"`
+}
+
+// Validate huawei nat gateway list option.
+func (opt HuaWeiNatGatewayListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ToListNatGatewaysRequest ...
+func (opt HuaWeiNatGatewayListOption) ToListNatGatewaysRequest() (*model.ListNatGatewaysRequest, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &model.ListNatGatewaysRequest{Id: opt.CloudID, Limit: opt.Limit}, nil
+}
+
+// HuaWeiNatGateway for model NatGatewayResponseBody
+type HuaWeiNatGateway struct {
+	model.NatGatewayResponseBody
+}
+
+// GetCloudID ...
+func (nat HuaWeiNatGateway) GetCloudID() string {
+	return nat.Id
+}
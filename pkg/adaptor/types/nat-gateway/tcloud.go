@@ -0,0 +1,48 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package natgateway ...
+package natgateway
+
+import (
+	"hcm/pkg/tools/converter"
+
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+)
+
+// TCloudNatGateway for vpc NatGateway
+type TCloudNatGateway struct {
+	*vpc.NatGateway
+}
+
+// GetCloudID ...
+func (nat TCloudNatGateway) GetCloudID() string {
+	return converter.PtrToVal(nat.NatGatewayId)
+}
+
+// GetPublicIPs 返回NAT网关绑定的弹性公网IP
+func (nat TCloudNatGateway) GetPublicIPs() []string {
+	ips := make([]string, 0, len(nat.PublicIpAddressSet))
+	for _, one := range nat.PublicIpAddressSet {
+		if one != nil && one.PublicIpAddress != nil {
+			ips = append(ips, *one.PublicIpAddress)
+		}
+	}
+	return ips
+}
@@ -0,0 +1,88 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package privatedns
+
+import (
+	"strings"
+
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+// AwsHostedZoneIDPrefix Route 53 返回的托管区域ID前缀
+const AwsHostedZoneIDPrefix = "/hostedzone/"
+
+// AwsZoneListOption aws 私有托管区域查询参数，Route 53 为全局服务，不需要指定地域
+type AwsZoneListOption struct {
+	CloudIDs []string `json:"cloud_ids" validate:"omitempty,max=100"`
+}
+
+// Validate ...
+func (opt AwsZoneListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AwsZone aws 私有托管区域
+type AwsZone struct {
+	*route53.HostedZone
+	VPCs []*route53.VPC
+}
+
+// GetCloudID 返回去掉 "/hostedzone/" 前缀的托管区域ID
+func (z AwsZone) GetCloudID() string {
+	return strings.TrimPrefix(converter.PtrToVal(z.Id), AwsHostedZoneIDPrefix)
+}
+
+// GetCloudVpcIDs 私有托管区域关联的VPC
+func (z AwsZone) GetCloudVpcIDs() []string {
+	ids := make([]string, 0, len(z.VPCs))
+	for _, one := range z.VPCs {
+		ids = append(ids, converter.PtrToVal(one.VPCId))
+	}
+	return ids
+}
+
+// AwsRecordListOption aws 记录集查询参数
+type AwsRecordListOption struct {
+	CloudZoneID string `json:"cloud_zone_id" validate:"required"`
+	ZoneName    string `json:"zone_name" validate:"required"`
+}
+
+// Validate ...
+func (opt AwsRecordListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AwsRecordOption aws 创建/修改/删除记录集参数，记录集以主机记录和类型唯一标识，删除时记录值和TTL需要与云上一致
+type AwsRecordOption struct {
+	CloudZoneID string `json:"cloud_zone_id" validate:"required"`
+	ZoneName    string `json:"zone_name" validate:"required"`
+	RecordSpec  `json:",inline"`
+}
+
+// Validate ...
+func (opt AwsRecordOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	return opt.RecordSpec.Validate()
+}
@@ -0,0 +1,92 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package privatedns
+
+import (
+	"hcm/pkg/criteria/validator"
+)
+
+const (
+	// AzurePrivateDnsAPIVersion 私有 DNS 区域接口版本
+	AzurePrivateDnsAPIVersion = "2018-09-01"
+	// AzurePrivateDnsZoneResourceType 私有 DNS 区域资源类型
+	AzurePrivateDnsZoneResourceType = "Microsoft.Network/privateDnsZones"
+)
+
+// AzureZoneListOption azure 私有 DNS 区域查询参数
+type AzureZoneListOption struct {
+	ResourceGroupName string   `json:"resource_group_name" validate:"required"`
+	CloudIDs          []string `json:"cloud_ids" validate:"omitempty,max=100"`
+}
+
+// Validate ...
+func (opt AzureZoneListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AzureZone azure 私有 DNS 区域，ID 统一转换为小写
+type AzureZone struct {
+	ID                 string
+	Name               string
+	ResourceGroupName  string
+	NumberOfRecordSets int64
+	ProvisioningState  string
+	// CloudVpcIDs 通过虚拟网络链接关联的虚拟网络
+	CloudVpcIDs []string
+}
+
+// GetCloudID ...
+func (z AzureZone) GetCloudID() string {
+	return z.ID
+}
+
+// AzureRecordListOption azure 记录集查询参数
+type AzureRecordListOption struct {
+	CloudZoneID string `json:"cloud_zone_id" validate:"required"`
+}
+
+// Validate ...
+func (opt AzureRecordListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AzureRecordOption azure 创建/修改记录集参数
+type AzureRecordOption struct {
+	CloudZoneID string `json:"cloud_zone_id" validate:"required"`
+	RecordSpec  `json:",inline"`
+}
+
+// Validate ...
+func (opt AzureRecordOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	return opt.RecordSpec.Validate()
+}
+
+// AzureRecordDeleteOption azure 删除记录集参数
+type AzureRecordDeleteOption struct {
+	CloudID string `json:"cloud_id" validate:"required"`
+}
+
+// Validate ...
+func (opt AzureRecordDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
@@ -0,0 +1,105 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package privatedns
+
+import (
+	"strconv"
+
+	"hcm/pkg/criteria/validator"
+
+	"google.golang.org/api/dns/v1"
+)
+
+// GcpPrivateVisibility 私有托管区域的可见性
+const GcpPrivateVisibility = "private"
+
+// GcpZoneListOption gcp 私有托管区域查询参数，托管区域为全局资源
+type GcpZoneListOption struct {
+	CloudIDs []string `json:"cloud_ids" validate:"omitempty,max=100"`
+}
+
+// Validate ...
+func (opt GcpZoneListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// GcpZone gcp 私有托管区域
+type GcpZone struct {
+	*dns.ManagedZone
+}
+
+// GetCloudID ...
+func (z GcpZone) GetCloudID() string {
+	return strconv.FormatUint(z.Id, 10)
+}
+
+// GetNetworkUrls 私有托管区域关联的网络 self link
+func (z GcpZone) GetNetworkUrls() []string {
+	if z.PrivateVisibilityConfig == nil {
+		return make([]string, 0)
+	}
+
+	urls := make([]string, 0, len(z.PrivateVisibilityConfig.Networks))
+	for _, one := range z.PrivateVisibilityConfig.Networks {
+		urls = append(urls, one.NetworkUrl)
+	}
+	return urls
+}
+
+// GcpRecordListOption gcp 记录集查询参数
+type GcpRecordListOption struct {
+	// ZoneName 托管区域名称，非域名
+	ZoneName string `json:"zone_name" validate:"required"`
+	DnsName  string `json:"dns_name" validate:"required"`
+}
+
+// Validate ...
+func (opt GcpRecordListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// GcpRecordOption gcp 创建/修改记录集参数
+type GcpRecordOption struct {
+	// ZoneName 托管区域名称，非域名
+	ZoneName   string `json:"zone_name" validate:"required"`
+	DnsName    string `json:"dns_name" validate:"required"`
+	RecordSpec `json:",inline"`
+}
+
+// Validate ...
+func (opt GcpRecordOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	return opt.RecordSpec.Validate()
+}
+
+// GcpRecordDeleteOption gcp 删除记录集参数
+type GcpRecordDeleteOption struct {
+	ZoneName string `json:"zone_name" validate:"required"`
+	DnsName  string `json:"dns_name" validate:"required"`
+	// CloudID 记录集云上ID，格式为 "主机记录:类型"
+	CloudID string `json:"cloud_id" validate:"required"`
+}
+
+// Validate ...
+func (opt GcpRecordDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
@@ -0,0 +1,122 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package privatedns
+
+import (
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/dns/v2/model"
+)
+
+// HuaWeiPrivateZoneType 内网域名类型
+const HuaWeiPrivateZoneType = "private"
+
+// HuaWeiZoneListOption 华为云内网域名查询参数
+type HuaWeiZoneListOption struct {
+	Region   string   `json:"region" validate:"required"`
+	CloudIDs []string `json:"cloud_ids" validate:"omitempty,max=100"`
+}
+
+// Validate ...
+func (opt HuaWeiZoneListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// HuaWeiZone 华为云内网域名
+type HuaWeiZone struct {
+	model.PrivateZoneResp
+}
+
+// GetCloudID ...
+func (z HuaWeiZone) GetCloudID() string {
+	return converter.PtrToVal(z.Id)
+}
+
+// GetCloudVpcIDs 内网域名关联的VPC
+func (z HuaWeiZone) GetCloudVpcIDs() []string {
+	if z.Routers == nil {
+		return make([]string, 0)
+	}
+
+	ids := make([]string, 0, len(*z.Routers))
+	for _, one := range *z.Routers {
+		ids = append(ids, converter.PtrToVal(one.RouterId))
+	}
+	return ids
+}
+
+// HuaWeiRecordListOption 华为云记录集查询参数
+type HuaWeiRecordListOption struct {
+	Region      string `json:"region" validate:"required"`
+	CloudZoneID string `json:"cloud_zone_id" validate:"required"`
+	ZoneName    string `json:"zone_name" validate:"required"`
+}
+
+// Validate ...
+func (opt HuaWeiRecordListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// HuaWeiRecordCreateOption 华为云创建记录集参数
+type HuaWeiRecordCreateOption struct {
+	Region      string `json:"region" validate:"required"`
+	CloudZoneID string `json:"cloud_zone_id" validate:"required"`
+	ZoneName    string `json:"zone_name" validate:"required"`
+	RecordSpec  `json:",inline"`
+}
+
+// Validate ...
+func (opt HuaWeiRecordCreateOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	return opt.RecordSpec.Validate()
+}
+
+// HuaWeiRecordUpdateOption 华为云修改记录集参数
+type HuaWeiRecordUpdateOption struct {
+	Region      string `json:"region" validate:"required"`
+	CloudZoneID string `json:"cloud_zone_id" validate:"required"`
+	CloudID     string `json:"cloud_id" validate:"required"`
+	ZoneName    string `json:"zone_name" validate:"required"`
+	RecordSpec  `json:",inline"`
+}
+
+// Validate ...
+func (opt HuaWeiRecordUpdateOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	return opt.RecordSpec.Validate()
+}
+
+// HuaWeiRecordDeleteOption 华为云删除记录集参数
+type HuaWeiRecordDeleteOption struct {
+	Region      string `json:"region" validate:"required"`
+	CloudZoneID string `json:"cloud_zone_id" validate:"required"`
+	CloudID     string `json:"cloud_id" validate:"required"`
+}
+
+// Validate ...
+func (opt HuaWeiRecordDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
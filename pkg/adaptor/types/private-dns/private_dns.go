@@ -0,0 +1,142 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+// Package privatedns ...
+package privatedns
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+)
+
+const (
+	// ApexRecordName 表示域名本身的主机记录
+	ApexRecordName = "@"
+	// DefaultRecordTTL 解析记录默认TTL，单位秒
+	DefaultRecordTTL = 300
+)
+
+// Record 解析记录，各云厂商解析记录结构差异较大，统一转换后再同步。
+// 同一主机记录和类型的多个记录值合并为一个记录集，MX 记录值格式为 "优先级 邮件服务器"
+type Record struct {
+	CloudID string
+	// Name 主机记录，不包含私有域名
+	Name  string
+	Type  enumor.PrivateDnsRecordType
+	Value []string
+	TTL   int64
+}
+
+// RecordSpec 创建/修改解析记录的通用参数
+type RecordSpec struct {
+	Name  string                      `json:"name" validate:"required,max=255"`
+	Type  enumor.PrivateDnsRecordType `json:"type" validate:"required"`
+	Value []string                    `json:"value" validate:"required,min=1,max=100"`
+	TTL   int64                       `json:"ttl" validate:"omitempty,min=1,max=604800"`
+}
+
+// Validate ...
+func (spec RecordSpec) Validate() error {
+	if err := spec.Type.Validate(); err != nil {
+		return err
+	}
+
+	if spec.Type == enumor.PrivateDnsRecordMX {
+		for _, value := range spec.Value {
+			if _, _, err := ParseMXValue(value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return validator.Validate.Struct(spec)
+}
+
+// GetTTL 未指定TTL时使用默认值
+func (spec RecordSpec) GetTTL() int64 {
+	if spec.TTL == 0 {
+		return DefaultRecordTTL
+	}
+	return spec.TTL
+}
+
+// ParseMXValue 解析 "优先级 邮件服务器" 格式的MX记录值
+func ParseMXValue(value string) (int64, string, error) {
+	fields := strings.Fields(value)
+	if len(fields) != 2 {
+		return 0, "", fmt.Errorf("mx record value should be 'priority exchange', value: %s", value)
+	}
+
+	priority, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("mx record priority is invalid, value: %s", value)
+	}
+
+	return priority, fields[1], nil
+}
+
+// GetRecordSetCloudID 按主机记录和类型拼接记录集云上ID，用于以主机记录和类型唯一标识记录集的云厂商
+func GetRecordSetCloudID(name string, recordType enumor.PrivateDnsRecordType) string {
+	return name + ":" + string(recordType)
+}
+
+// ParseRecordSetCloudID 解析 GetRecordSetCloudID 拼接的记录集云上ID
+func ParseRecordSetCloudID(cloudID string) (string, enumor.PrivateDnsRecordType, error) {
+	idx := strings.LastIndex(cloudID, ":")
+	if idx <= 0 {
+		return "", "", errors.New("private dns record set cloud id is invalid")
+	}
+
+	return cloudID[:idx], enumor.PrivateDnsRecordType(cloudID[idx+1:]), nil
+}
+
+// TrimZoneName 去掉私有域名末尾的"."
+func TrimZoneName(name string) string {
+	return strings.TrimSuffix(name, ".")
+}
+
+// ToFQDN 将主机记录转换为以"."结尾的完整域名
+func ToFQDN(name, zoneName string) string {
+	zoneName = TrimZoneName(zoneName)
+	if len(name) == 0 || name == ApexRecordName {
+		return zoneName + "."
+	}
+
+	return name + "." + zoneName + "."
+}
+
+// ToRecordName 将完整域名转换为主机记录
+func ToRecordName(fqdn, zoneName string) string {
+	fqdn = TrimZoneName(fqdn)
+	zoneName = TrimZoneName(zoneName)
+	if fqdn == zoneName {
+		return ApexRecordName
+	}
+
+	return strings.TrimSuffix(fqdn, "."+zoneName)
+}
+
+// IsSupportedRecordType 是否为支持管理的解析记录类型，SOA、NS 等系统记录不同步
+func IsSupportedRecordType(recordType string) bool {
+	return enumor.PrivateDnsRecordType(recordType).Validate() == nil
+}
@@ -0,0 +1,161 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package privatedns
+
+import (
+	"errors"
+
+	"hcm/pkg/adaptor/types/core"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+)
+
+// TCloudZoneListOption 腾讯云私有域查询参数，私有域为全局资源，不需要指定地域
+type TCloudZoneListOption struct {
+	CloudIDs []string         `json:"cloud_ids" validate:"omitempty,max=100"`
+	Page     *core.TCloudPage `json:"page" validate:"omitempty"`
+}
+
+// Validate ...
+func (opt TCloudZoneListOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if opt.Page != nil {
+		if err := opt.Page.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TCloudZone 腾讯云私有域，字段参考 DescribePrivateZoneList 返回的 PrivateZone
+type TCloudZone struct {
+	ZoneId      *string `json:"ZoneId"`
+	Domain      *string `json:"Domain"`
+	Remark      *string `json:"Remark"`
+	CreatedOn   *string `json:"CreatedOn"`
+	RecordCount *int64  `json:"RecordCount"`
+	// Status 私有域状态，ENABLED-解析已启用，SUSPEND-解析已暂停，FROZEN-已冻结
+	Status *string `json:"Status"`
+	// DnsForwardStatus 子域名递归解析状态，ENABLED-开启，DISABLED-关闭
+	DnsForwardStatus *string `json:"DnsForwardStatus"`
+	VpcSet           []struct {
+		UniqVpcId *string `json:"UniqVpcId"`
+		Region    *string `json:"Region"`
+	} `json:"VpcSet"`
+}
+
+// GetCloudID ...
+func (z TCloudZone) GetCloudID() string {
+	return converter.PtrToVal(z.ZoneId)
+}
+
+// GetCloudVpcIDs 私有域关联的VPC
+func (z TCloudZone) GetCloudVpcIDs() []string {
+	ids := make([]string, 0, len(z.VpcSet))
+	for _, one := range z.VpcSet {
+		ids = append(ids, converter.PtrToVal(one.UniqVpcId))
+	}
+	return ids
+}
+
+// TCloudZoneListResult DescribePrivateZoneList 的返回结果
+type TCloudZoneListResult struct {
+	TotalCount     *int64       `json:"TotalCount"`
+	PrivateZoneSet []TCloudZone `json:"PrivateZoneSet"`
+}
+
+// TCloudRecord 腾讯云私有域解析记录，字段参考 DescribePrivateZoneRecordList 返回的 PrivateZoneRecord
+type TCloudRecord struct {
+	RecordId    *string `json:"RecordId"`
+	SubDomain   *string `json:"SubDomain"`
+	RecordType  *string `json:"RecordType"`
+	RecordValue *string `json:"RecordValue"`
+	TTL         *int64  `json:"TTL"`
+	MX          *int64  `json:"MX"`
+}
+
+// TCloudRecordListResult DescribePrivateZoneRecordList 的返回结果
+type TCloudRecordListResult struct {
+	TotalCount *int64         `json:"TotalCount"`
+	RecordSet  []TCloudRecord `json:"RecordSet"`
+}
+
+// TCloudRecordListOption 腾讯云解析记录查询参数
+type TCloudRecordListOption struct {
+	CloudZoneID string `json:"cloud_zone_id" validate:"required"`
+}
+
+// Validate ...
+func (opt TCloudRecordListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// TCloudRecordCreateOption 腾讯云创建解析记录参数，腾讯云每条记录只有一个记录值
+type TCloudRecordCreateOption struct {
+	CloudZoneID string `json:"cloud_zone_id" validate:"required"`
+	RecordSpec  `json:",inline"`
+}
+
+// Validate ...
+func (opt TCloudRecordCreateOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if len(opt.Value) != 1 {
+		return errors.New("tcloud private dns record only support one value")
+	}
+
+	return opt.RecordSpec.Validate()
+}
+
+// TCloudRecordUpdateOption 腾讯云修改解析记录参数
+type TCloudRecordUpdateOption struct {
+	CloudZoneID string `json:"cloud_zone_id" validate:"required"`
+	CloudID     string `json:"cloud_id" validate:"required"`
+	RecordSpec  `json:",inline"`
+}
+
+// Validate ...
+func (opt TCloudRecordUpdateOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if len(opt.Value) != 1 {
+		return errors.New("tcloud private dns record only support one value")
+	}
+
+	return opt.RecordSpec.Validate()
+}
+
+// TCloudRecordDeleteOption 腾讯云删除解析记录参数
+type TCloudRecordDeleteOption struct {
+	CloudZoneID string   `json:"cloud_zone_id" validate:"required"`
+	CloudIDs    []string `json:"cloud_ids" validate:"required,min=1,max=100"`
+}
+
+// Validate ...
+func (opt TCloudRecordDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
@@ -32,6 +32,9 @@ type BaseSecret struct {
 	CloudSecretKey string `json:"cloud_secret_key"`
 	// CloudAccountID is the account id to do credential.
 	CloudAccountID string `json:"cloud_account_id"`
+	// Endpoint is the custom cloud api endpoint, such as http://127.0.0.1:9900, used to access the fake cloud
+	// for local testing. it is only set by server side config, and empty means the vendor's default endpoint.
+	Endpoint string `json:"-"`
 }
 
 // Validate BaseSecret.
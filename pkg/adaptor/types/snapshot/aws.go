@@ -0,0 +1,146 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package snapshot
+
+import (
+	"hcm/pkg/adaptor/types/core"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// AwsSnapshotListOption define aws snapshot list option.
+type AwsSnapshotListOption struct {
+	Region   string        `json:"region" validate:"required"`
+	CloudIDs []string      `json:"cloud_ids" validate:"omitempty"`
+	Page     *core.AwsPage `json:"page" validate:"omitempty"`
+}
+
+// Validate aws snapshot list option.
+func (opt AwsSnapshotListOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if opt.Page != nil {
+		if err := opt.Page.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AwsSnapshotCreateOption AWS 创建快照参数
+// reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_CreateSnapshot.html
+type AwsSnapshotCreateOption struct {
+	Region      string  `json:"region" validate:"required"`
+	CloudDiskID string  `json:"cloud_disk_id" validate:"required"`
+	Name        *string `json:"name" validate:"omitempty"`
+	Description *string `json:"description" validate:"omitempty"`
+}
+
+// Validate ...
+func (opt *AwsSnapshotCreateOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ToCreateSnapshotInput 转换成接口需要的 CreateSnapshotInput 结构，快照名称通过 Name 标签设置
+func (opt *AwsSnapshotCreateOption) ToCreateSnapshotInput() (*ec2.CreateSnapshotInput, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	input := &ec2.CreateSnapshotInput{
+		VolumeId:    aws.String(opt.CloudDiskID),
+		Description: opt.Description,
+	}
+	if opt.Name != nil {
+		input.TagSpecifications = []*ec2.TagSpecification{{
+			ResourceType: aws.String(ec2.ResourceTypeSnapshot),
+			Tags:         []*ec2.Tag{{Key: aws.String("Name"), Value: opt.Name}},
+		}}
+	}
+
+	return input, nil
+}
+
+// AwsSnapshotDeleteOption ...
+type AwsSnapshotDeleteOption struct {
+	Region  string `json:"region" validate:"required"`
+	CloudID string `json:"cloud_id" validate:"required"`
+}
+
+// Validate ...
+func (opt *AwsSnapshotDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AwsSnapshotRollbackOption AWS 快照回滚参数，AWS 仅支持通过替换根卷的方式将系统盘恢复到快照状态
+// reference: https://docs.amazonaws.cn/AWSEC2/latest/APIReference/API_CreateReplaceRootVolumeTask.html
+type AwsSnapshotRollbackOption struct {
+	Region          string `json:"region" validate:"required"`
+	CloudSnapshotID string `json:"cloud_snapshot_id" validate:"required"`
+	CloudCvmID      string `json:"cloud_cvm_id" validate:"required"`
+	// DeleteReplacedRootVolume 是否删除被替换的原根卷
+	DeleteReplacedRootVolume *bool `json:"delete_replaced_root_volume" validate:"omitempty"`
+}
+
+// Validate ...
+func (opt *AwsSnapshotRollbackOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ToCreateReplaceRootVolumeTaskInput ...
+func (opt *AwsSnapshotRollbackOption) ToCreateReplaceRootVolumeTaskInput() (
+	*ec2.CreateReplaceRootVolumeTaskInput, error) {
+
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &ec2.CreateReplaceRootVolumeTaskInput{
+		InstanceId:               aws.String(opt.CloudCvmID),
+		SnapshotId:               aws.String(opt.CloudSnapshotID),
+		DeleteReplacedRootVolume: opt.DeleteReplacedRootVolume,
+	}, nil
+}
+
+// AwsSnapshot for ec2 Snapshot
+type AwsSnapshot struct {
+	*ec2.Snapshot
+}
+
+// GetCloudID ...
+func (snapshot AwsSnapshot) GetCloudID() string {
+	return converter.PtrToVal(snapshot.SnapshotId)
+}
+
+// GetName 返回 Name 标签的值
+func (snapshot AwsSnapshot) GetName() string {
+	for _, tag := range snapshot.Tags {
+		if tag != nil && converter.PtrToVal(tag.Key) == "Name" {
+			return converter.PtrToVal(tag.Value)
+		}
+	}
+	return ""
+}
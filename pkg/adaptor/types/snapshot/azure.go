@@ -0,0 +1,145 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package snapshot
+
+import (
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+)
+
+// AzureSnapshotListOption define azure snapshot list option.
+type AzureSnapshotListOption struct {
+	ResourceGroupName string   `json:"resource_group_name" validate:"required"`
+	CloudIDs          []string `json:"cloud_ids" validate:"omitempty"`
+}
+
+// Validate azure snapshot list option.
+func (opt AzureSnapshotListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AzureSnapshotCreateOption Azure 创建快照参数
+// reference: https://learn.microsoft.com/en-us/rest/api/compute/snapshots/create-or-update
+type AzureSnapshotCreateOption struct {
+	ResourceGroupName string `json:"resource_group_name" validate:"required"`
+	Region            string `json:"region" validate:"required"`
+	Name              string `json:"name" validate:"required"`
+	// CloudDiskID 源托管磁盘的完整资源ID
+	CloudDiskID string `json:"cloud_disk_id" validate:"required"`
+	// Incremental 是否创建增量快照
+	Incremental *bool `json:"incremental" validate:"omitempty"`
+}
+
+// Validate ...
+func (opt *AzureSnapshotCreateOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ToSnapshot 转换成接口需要的 armcompute.Snapshot 结构
+func (opt *AzureSnapshotCreateOption) ToSnapshot() (*armcompute.Snapshot, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &armcompute.Snapshot{
+		Location: to.Ptr(opt.Region),
+		Properties: &armcompute.SnapshotProperties{
+			CreationData: &armcompute.CreationData{
+				CreateOption:     to.Ptr(armcompute.DiskCreateOptionCopy),
+				SourceResourceID: to.Ptr(opt.CloudDiskID),
+			},
+			Incremental: opt.Incremental,
+		},
+	}, nil
+}
+
+// AzureSnapshotDeleteOption ...
+type AzureSnapshotDeleteOption struct {
+	ResourceGroupName string `json:"resource_group_name" validate:"required"`
+	Name              string `json:"name" validate:"required"`
+}
+
+// Validate ...
+func (opt *AzureSnapshotDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AzureSnapshotRollbackOption Azure 不支持将快照原地回滚到已有磁盘，通过快照创建新的托管磁盘来恢复数据
+// reference: https://learn.microsoft.com/en-us/rest/api/compute/disks/create-or-update
+type AzureSnapshotRollbackOption struct {
+	ResourceGroupName string `json:"resource_group_name" validate:"required"`
+	Region            string `json:"region" validate:"required"`
+	// CloudSnapshotID 快照的完整资源ID
+	CloudSnapshotID string `json:"cloud_snapshot_id" validate:"required"`
+	// DiskName 恢复出的新磁盘名称
+	DiskName string `json:"disk_name" validate:"required"`
+	Zone     string `json:"zone" validate:"omitempty"`
+}
+
+// Validate ...
+func (opt *AzureSnapshotRollbackOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ToDisk 转换成接口需要的 armcompute.Disk 结构
+func (opt *AzureSnapshotRollbackOption) ToDisk() (*armcompute.Disk, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	disk := &armcompute.Disk{
+		Location: to.Ptr(opt.Region),
+		Properties: &armcompute.DiskProperties{
+			CreationData: &armcompute.CreationData{
+				CreateOption:     to.Ptr(armcompute.DiskCreateOptionCopy),
+				SourceResourceID: to.Ptr(opt.CloudSnapshotID),
+			},
+		},
+	}
+	if len(opt.Zone) != 0 {
+		disk.Zones = to.SliceOfPtrs(opt.Zone)
+	}
+
+	return disk, nil
+}
+
+// AzureSnapshot define azure snapshot.
+type AzureSnapshot struct {
+	ID                *string `json:"id"`
+	Name              *string `json:"name"`
+	Location          *string `json:"location"`
+	Type              *string `json:"type"`
+	Status            *string `json:"status"`
+	DiskSize          *int64  `json:"disk_size"`
+	OSType            *string `json:"os_type"`
+	SKUName           *string `json:"sku_name"`
+	Incremental       *bool   `json:"incremental"`
+	SourceResourceID  *string `json:"source_resource_id"`
+	ProvisioningState *string `json:"provisioning_state"`
+	TimeCreated       *string `json:"time_created"`
+}
+
+// GetCloudID ...
+func (snapshot AzureSnapshot) GetCloudID() string {
+	return converter.PtrToVal(snapshot.ID)
+}
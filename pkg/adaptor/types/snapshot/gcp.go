@@ -0,0 +1,111 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package snapshot
+
+import (
+	"fmt"
+	"strconv"
+
+	"hcm/pkg/adaptor/types/core"
+	"hcm/pkg/criteria/validator"
+
+	"google.golang.org/api/compute/v1"
+)
+
+// GcpSnapshotListOption define gcp snapshot list option. gcp 快照为全局资源，不区分地域
+type GcpSnapshotListOption struct {
+	CloudIDs  []string `json:"cloud_ids" validate:"omitempty"`
+	SelfLinks []string `json:"self_links" validate:"omitempty"`
+	// Names 按快照名称过滤，gcp 创建快照只返回快照名称
+	Names []string      `json:"names" validate:"omitempty"`
+	Page  *core.GcpPage `json:"page" validate:"omitempty"`
+}
+
+// Validate gcp snapshot list option.
+func (opt GcpSnapshotListOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if len(opt.CloudIDs) != 0 && opt.Page != nil {
+		return fmt.Errorf("list by cloud_ids not support page")
+	}
+
+	if len(opt.CloudIDs) > core.GcpQueryLimit {
+		return fmt.Errorf("cloud_ids should <= %d", core.GcpQueryLimit)
+	}
+
+	if opt.Page != nil {
+		if err := opt.Page.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GcpSnapshotCreateOption Gcp 创建快照参数
+// reference: https://cloud.google.com/compute/docs/reference/rest/v1/disks/createSnapshot
+type GcpSnapshotCreateOption struct {
+	Zone        string  `json:"zone" validate:"required"`
+	DiskName    string  `json:"disk_name" validate:"required"`
+	Name        string  `json:"name" validate:"required"`
+	Description *string `json:"description" validate:"omitempty"`
+}
+
+// Validate ...
+func (opt *GcpSnapshotCreateOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// GcpSnapshotDeleteOption ...
+type GcpSnapshotDeleteOption struct {
+	Name string `json:"name" validate:"required"`
+}
+
+// Validate ...
+func (opt *GcpSnapshotDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// GcpSnapshotRollbackOption Gcp 不支持将快照原地回滚到已有磁盘，通过快照创建新的磁盘来恢复数据
+// reference: https://cloud.google.com/compute/docs/reference/rest/v1/disks/insert
+type GcpSnapshotRollbackOption struct {
+	Zone string `json:"zone" validate:"required"`
+	// SnapshotSelfLink 快照的 selfLink
+	SnapshotSelfLink string `json:"snapshot_self_link" validate:"required"`
+	// DiskName 恢复出的新磁盘名称
+	DiskName string `json:"disk_name" validate:"required"`
+}
+
+// Validate ...
+func (opt *GcpSnapshotRollbackOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// GcpSnapshot for compute Snapshot
+type GcpSnapshot struct {
+	*compute.Snapshot
+}
+
+// GetCloudID ...
+func (snapshot GcpSnapshot) GetCloudID() string {
+	return strconv.FormatUint(snapshot.Id, 10)
+}
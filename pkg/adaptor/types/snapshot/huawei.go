@@ -0,0 +1,128 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package snapshot
+
+import (
+	"hcm/pkg/criteria/validator"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/evs/v2/model"
+)
+
+// HuaWeiSnapshotListOption define huawei snapshot list option.
+type HuaWeiSnapshotListOption struct {
+	Region string `json:"region" validate:"required"`
+	// CloudID 华为云按ID过滤快照时只支持单个ID
+	CloudID *string `json:"cloud_id" validate:"omitempty"`
+	Offset  *int32  `json:"offset" validate:"omitempty"`
+	Limit   *int32  `json:"limit" validate:"omitempty,max=1000"`
+}
+
+// Validate huawei snapshot list option.
+func (opt HuaWeiSnapshotListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ToListSnapshotsRequest ...
+func (opt HuaWeiSnapshotListOption) ToListSnapshotsRequest() (*model.ListSnapshotsRequest, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &model.ListSnapshotsRequest{Id: opt.CloudID, Offset: opt.Offset, Limit: opt.Limit}, nil
+}
+
+// HuaWeiSnapshotCreateOption 华为云创建快照参数
+// reference: https://support.huaweicloud.com/api-evs/evs_04_2053.html
+type HuaWeiSnapshotCreateOption struct {
+	Region      string  `json:"region" validate:"required"`
+	CloudDiskID string  `json:"cloud_disk_id" validate:"required"`
+	Name        *string `json:"name" validate:"omitempty"`
+	Description *string `json:"description" validate:"omitempty"`
+}
+
+// Validate ...
+func (opt *HuaWeiSnapshotCreateOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ToCreateSnapshotRequest ...
+func (opt *HuaWeiSnapshotCreateOption) ToCreateSnapshotRequest() (*model.CreateSnapshotRequest, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &model.CreateSnapshotRequest{
+		Body: &model.CreateSnapshotRequestBody{
+			Snapshot: &model.CreateSnapshotOption{
+				VolumeId:    opt.CloudDiskID,
+				Name:        opt.Name,
+				Description: opt.Description,
+			},
+		},
+	}, nil
+}
+
+// HuaWeiSnapshotDeleteOption ...
+type HuaWeiSnapshotDeleteOption struct {
+	Region  string `json:"region" validate:"required"`
+	CloudID string `json:"cloud_id" validate:"required"`
+}
+
+// Validate ...
+func (opt *HuaWeiSnapshotDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// HuaWeiSnapshotRollbackOption 华为云快照回滚参数
+// reference: https://support.huaweicloud.com/api-evs/evs_04_2058.html
+type HuaWeiSnapshotRollbackOption struct {
+	Region          string `json:"region" validate:"required"`
+	CloudSnapshotID string `json:"cloud_snapshot_id" validate:"required"`
+	CloudDiskID     string `json:"cloud_disk_id" validate:"required"`
+}
+
+// Validate ...
+func (opt *HuaWeiSnapshotRollbackOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ToRollbackSnapshotRequest ...
+func (opt *HuaWeiSnapshotRollbackOption) ToRollbackSnapshotRequest() (*model.RollbackSnapshotRequest, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &model.RollbackSnapshotRequest{
+		SnapshotId: opt.CloudSnapshotID,
+		Body: &model.RollbackSnapshotRequestBody{
+			Rollback: &model.RollbackSnapshotOption{VolumeId: opt.CloudDiskID},
+		},
+	}, nil
+}
+
+// HuaWeiSnapshot for model SnapshotList
+type HuaWeiSnapshot struct {
+	model.SnapshotList
+}
+
+// GetCloudID ...
+func (snapshot HuaWeiSnapshot) GetCloudID() string {
+	return snapshot.Id
+}
@@ -0,0 +1,119 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package snapshot
+
+import (
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+
+	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+)
+
+// TCloudSnapshotCreateOption 腾讯云创建快照参数
+// reference: https://cloud.tencent.com/document/api/362/15648
+type TCloudSnapshotCreateOption struct {
+	Region      string  `json:"region" validate:"required"`
+	CloudDiskID string  `json:"cloud_disk_id" validate:"required"`
+	Name        *string `json:"name" validate:"omitempty"`
+}
+
+// Validate ...
+func (opt *TCloudSnapshotCreateOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ToCreateSnapshotRequest 转换成接口需要的 CreateSnapshotRequest 结构
+func (opt *TCloudSnapshotCreateOption) ToCreateSnapshotRequest() (*cbs.CreateSnapshotRequest, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	req := cbs.NewCreateSnapshotRequest()
+	req.DiskId = common.StringPtr(opt.CloudDiskID)
+	req.SnapshotName = opt.Name
+
+	return req, nil
+}
+
+// TCloudSnapshotDeleteOption ...
+type TCloudSnapshotDeleteOption struct {
+	Region   string   `json:"region" validate:"required"`
+	CloudIDs []string `json:"cloud_ids" validate:"required,min=1"`
+}
+
+// Validate ...
+func (opt *TCloudSnapshotDeleteOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ToDeleteSnapshotsRequest ...
+func (opt *TCloudSnapshotDeleteOption) ToDeleteSnapshotsRequest() (*cbs.DeleteSnapshotsRequest, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	req := cbs.NewDeleteSnapshotsRequest()
+	req.SnapshotIds = common.StringPtrs(opt.CloudIDs)
+
+	return req, nil
+}
+
+// TCloudSnapshotRollbackOption 腾讯云快照回滚参数
+// reference: https://cloud.tencent.com/document/api/362/15650
+type TCloudSnapshotRollbackOption struct {
+	Region          string `json:"region" validate:"required"`
+	CloudSnapshotID string `json:"cloud_snapshot_id" validate:"required"`
+	CloudDiskID     string `json:"cloud_disk_id" validate:"required"`
+	// AutoStopInstance 回滚前是否自动关机，云盘已挂载时需要关机才能回滚
+	AutoStopInstance *bool `json:"auto_stop_instance" validate:"omitempty"`
+	// AutoStartInstance 回滚完成后是否自动开机，需要和 AutoStopInstance 同时使用
+	AutoStartInstance *bool `json:"auto_start_instance" validate:"omitempty"`
+}
+
+// Validate ...
+func (opt *TCloudSnapshotRollbackOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ToApplySnapshotRequest ...
+func (opt *TCloudSnapshotRollbackOption) ToApplySnapshotRequest() (*cbs.ApplySnapshotRequest, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	req := cbs.NewApplySnapshotRequest()
+	req.SnapshotId = common.StringPtr(opt.CloudSnapshotID)
+	req.DiskId = common.StringPtr(opt.CloudDiskID)
+	req.AutoStopInstance = opt.AutoStopInstance
+	req.AutoStartInstance = opt.AutoStartInstance
+
+	return req, nil
+}
+
+// TCloudSnapshot for cbs Snapshot
+type TCloudSnapshot struct {
+	*cbs.Snapshot
+}
+
+// GetCloudID ...
+func (snapshot TCloudSnapshot) GetCloudID() string {
+	return converter.PtrToVal(snapshot.SnapshotId)
+}
@@ -0,0 +1,78 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package vpcpeering
+
+import (
+	"hcm/pkg/adaptor/types/core"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// AwsVpcPeeringListOption define aws vpc peering list option.
+type AwsVpcPeeringListOption struct {
+	Region   string        `json:"region" validate:"required"`
+	CloudIDs []string      `json:"cloud_ids" validate:"omitempty"`
+	Page     *core.AwsPage `json:"page" validate:"omitempty"`
+}
+
+// Validate aws vpc peering list option.
+func (opt AwsVpcPeeringListOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if opt.Page != nil {
+		if err := opt.Page.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AwsVpcPeering for ec2 VpcPeeringConnection
+type AwsVpcPeering struct {
+	*ec2.VpcPeeringConnection
+}
+
+// GetCloudID ...
+func (peering AwsVpcPeering) GetCloudID() string {
+	return converter.PtrToVal(peering.VpcPeeringConnectionId)
+}
+
+// GetName 返回 Name 标签的值
+func (peering AwsVpcPeering) GetName() string {
+	for _, tag := range peering.Tags {
+		if tag != nil && converter.PtrToVal(tag.Key) == "Name" {
+			return converter.PtrToVal(tag.Value)
+		}
+	}
+	return ""
+}
+
+// GetStatus ...
+func (peering AwsVpcPeering) GetStatus() string {
+	if peering.Status == nil {
+		return ""
+	}
+	return converter.PtrToVal(peering.Status.Code)
+}
@@ -0,0 +1,58 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package vpcpeering
+
+import (
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/converter"
+)
+
+// AzureVpcPeeringListOption define azure vpc peering list option.
+type AzureVpcPeeringListOption struct {
+	ResourceGroupName string   `json:"resource_group_name" validate:"required"`
+	CloudIDs          []string `json:"cloud_ids" validate:"omitempty"`
+}
+
+// Validate azure vpc peering list option.
+func (opt AzureVpcPeeringListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AzureVpcPeering define azure virtual network peering.
+type AzureVpcPeering struct {
+	ID       *string `json:"id"`
+	Name     *string `json:"name"`
+	Location *string `json:"location"`
+	// CloudVpcID 对等连接所属的本端虚拟网络ID
+	CloudVpcID *string `json:"cloud_vpc_id"`
+	// CloudRemoteVpcID 对端虚拟网络ID
+	CloudRemoteVpcID          *string `json:"cloud_remote_vpc_id"`
+	PeeringState              *string `json:"peering_state"`
+	ProvisioningState         *string `json:"provisioning_state"`
+	AllowVirtualNetworkAccess *bool   `json:"allow_virtual_network_access"`
+	AllowForwardedTraffic     *bool   `json:"allow_forwarded_traffic"`
+	AllowGatewayTransit       *bool   `json:"allow_gateway_transit"`
+	UseRemoteGateways         *bool   `json:"use_remote_gateways"`
+}
+
+// GetCloudID ...
+func (peering AzureVpcPeering) GetCloudID() string {
+	return converter.PtrToVal(peering.ID)
+}
@@ -0,0 +1,68 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package vpcpeering
+
+import (
+	"fmt"
+
+	"hcm/pkg/adaptor/types/core"
+	"hcm/pkg/criteria/validator"
+
+	"google.golang.org/api/compute/v1"
+)
+
+// GcpVpcPeeringListOption define gcp vpc peering list option.
+type GcpVpcPeeringListOption struct {
+	// CloudVpcIDs 对等连接挂在VPC网络下，按VPC网络ID过滤
+	CloudVpcIDs []string      `json:"cloud_vpc_ids" validate:"omitempty"`
+	Page        *core.GcpPage `json:"page" validate:"omitempty"`
+}
+
+// Validate gcp vpc peering list option.
+func (opt GcpVpcPeeringListOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if opt.Page != nil {
+		if err := opt.Page.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GcpVpcPeering gcp 对等连接为VPC网络的属性
+type GcpVpcPeering struct {
+	*compute.NetworkPeering
+	CloudVpcID  string `json:"cloud_vpc_id"`
+	VpcSelfLink string `json:"vpc_self_link"`
+}
+
+// GetCloudID 对等连接没有独立ID，使用 "VPC网络ID/对等连接名称" 作为云上ID
+func (peering GcpVpcPeering) GetCloudID() string {
+	return GcpVpcPeeringCloudID(peering.CloudVpcID, peering.Name)
+}
+
+// GcpVpcPeeringCloudID ...
+func GcpVpcPeeringCloudID(cloudVpcID, name string) string {
+	return fmt.Sprintf("%s/%s", cloudVpcID, name)
+}
@@ -0,0 +1,60 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package vpcpeering
+
+import (
+	"hcm/pkg/criteria/validator"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/vpc/v2/model"
+)
+
+// HuaWeiVpcPeeringListOption define huawei vpc peering list option.
+type HuaWeiVpcPeeringListOption struct {
+	Region string `json:"region" validate:"required"`
+	// CloudID 华为云按ID过滤对等连接时只支持单个ID
+	CloudID *string `json:"cloud_id" validate:"omitempty"`
+	Limit   *int32  `json:"limit" validate:"omitempty"`
+	// Marker 分页查询的起始资源ID，为上一页最后一条记录的ID
+	Marker *string `json:"marker" validate:"omitempty"`
+}
+
+// Validate huawei vpc peering list option.
+func (opt HuaWeiVpcPeeringListOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// ToListVpcPeeringsRequest ...
+func (opt HuaWeiVpcPeeringListOption) ToListVpcPeeringsRequest() (*model.ListVpcPeeringsRequest, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &model.ListVpcPeeringsRequest{Id: opt.CloudID, Limit: opt.Limit, Marker: opt.Marker}, nil
+}
+
+// HuaWeiVpcPeering for model VpcPeering
+type HuaWeiVpcPeering struct {
+	model.VpcPeering
+}
+
+// GetCloudID ...
+func (peering HuaWeiVpcPeering) GetCloudID() string {
+	return peering.Id
+}
@@ -269,6 +269,16 @@ type HuaWeiVpcListResult struct {
 	Details    []HuaWeiVpc `json:"details"`
 }
 
+// ZenlayerVpcListResult defines zenlayer list vpc result.
+type ZenlayerVpcListResult struct {
+	Details []ZenlayerVpc `json:"details"`
+}
+
+// KaopuVpcListResult defines kaopu list vpc result.
+type KaopuVpcListResult struct {
+	Details []KaopuVpc `json:"details"`
+}
+
 // Vpc defines vpc struct.
 type Vpc[T VpcExtension] struct {
 	CloudID   string  `json:"cloud_id"`
@@ -289,7 +299,7 @@ type AzureVpcExtension struct {
 // VpcExtension defines vpc extensional info.
 type VpcExtension interface {
 	cloud.TCloudVpcExtension | cloud.AwsVpcExtension | cloud.GcpVpcExtension | AzureVpcExtension |
-		cloud.HuaWeiVpcExtension
+		cloud.HuaWeiVpcExtension | cloud.ZenlayerVpcExtension | cloud.KaopuVpcExtension
 }
 
 // TCloudVpc defines tencent cloud vpc.
@@ -332,6 +342,22 @@ func (vpc HuaWeiVpc) GetCloudID() string {
 	return vpc.CloudID
 }
 
+// ZenlayerVpc defines zenlayer vpc.
+type ZenlayerVpc Vpc[cloud.ZenlayerVpcExtension]
+
+// GetCloudID ...
+func (vpc ZenlayerVpc) GetCloudID() string {
+	return vpc.CloudID
+}
+
+// KaopuVpc defines kaopu vpc.
+type KaopuVpc Vpc[cloud.KaopuVpcExtension]
+
+// GetCloudID ...
+func (vpc KaopuVpc) GetCloudID() string {
+	return vpc.CloudID
+}
+
 // VpcUsage define vpc usage.
 type VpcUsage struct {
 	ID           *string  `json:"id"`
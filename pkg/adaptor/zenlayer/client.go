@@ -0,0 +1,172 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package zenlayer
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"hcm/pkg/adaptor/types"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+)
+
+const (
+	// defaultEndpoint zenlayer cloud api 默认地址
+	defaultEndpoint = "https://console.zenlayer.com/api/v2/zec"
+	// apiVersion zenlayer cloud api 版本
+	apiVersion = "2024-04-01"
+	// signatureMethod zenlayer cloud api 签名算法
+	signatureMethod = "ZC2-HMAC-SHA256"
+	// signedHeaders 参与签名的请求头
+	signedHeaders = "content-type;host"
+	contentType   = "application/json; charset=utf-8"
+
+	// ErrNotFound zenlayer resource not found code
+	ErrNotFound = "INVALID_RESOURCE_NOT_FOUND"
+)
+
+// client zenlayer cloud api client. 官方 go sdk（github.com/zenlayer/zenlayercloud-sdk-go）尚未纳入项目依赖，
+// 这里按 sdk 相同的 ZC2-HMAC-SHA256 签名规则直接通过 http 调用，引入 sdk 后可替换为 sdk 的 client。
+type client struct {
+	secretID  string
+	secretKey string
+	endpoint  string
+	httpCli   *http.Client
+}
+
+func newClient(s *types.BaseSecret) *client {
+	endpoint := defaultEndpoint
+	if len(s.Endpoint) != 0 {
+		endpoint = s.Endpoint
+	}
+
+	return &client{
+		secretID:  s.CloudSecretID,
+		secretKey: s.CloudSecretKey,
+		endpoint:  endpoint,
+		httpCli:   &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// response zenlayer cloud api 通用返回结构，成功时 Response 有值，失败时 Code 和 Message 有值。
+type response struct {
+	RequestID string          `json:"requestId"`
+	Response  json.RawMessage `json:"response"`
+	Code      string          `json:"code"`
+	Message   string          `json:"message"`
+}
+
+// pageResult zenlayer cloud api 分页查询返回结构。
+type pageResult[T any] struct {
+	TotalCount int64 `json:"totalCount"`
+	DataSet    []T   `json:"dataSet"`
+}
+
+// call 调用 zenlayer cloud api，并将 response 字段解析到 result 中。
+func (c *client) call(kt *kit.Kit, action string, req interface{}, result interface{}) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal zenlayer %s request failed, err: %v", action, err)
+	}
+
+	u, err := url.Parse(c.endpoint)
+	if err != nil {
+		return fmt.Errorf("parse zenlayer endpoint %s failed, err: %v", c.endpoint, err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(kt.Ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("new zenlayer %s request failed, err: %v", action, err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	httpReq.Header.Set("Content-Type", contentType)
+	httpReq.Header.Set("X-ZC-Action", action)
+	httpReq.Header.Set("X-ZC-Version", apiVersion)
+	httpReq.Header.Set("X-ZC-Timestamp", timestamp)
+	httpReq.Header.Set("X-ZC-Signature-Method", signatureMethod)
+	httpReq.Header.Set("Authorization", c.authorization(u.Host, u.Path, timestamp, body))
+
+	resp, err := c.httpCli.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("call zenlayer %s failed, err: %v", action, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read zenlayer %s response failed, err: %v", action, err)
+	}
+
+	ret := new(response)
+	if err = json.Unmarshal(respBody, ret); err != nil {
+		logs.Errorf("unmarshal zenlayer %s response failed, status: %d, body: %s, rid: %s", action,
+			resp.StatusCode, respBody, kt.Rid)
+		return fmt.Errorf("unmarshal zenlayer %s response failed, err: %v", action, err)
+	}
+
+	if len(ret.Code) != 0 || resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("zenlayer %s failed, code: %s, message: %s, requestId: %s", action, ret.Code,
+			ret.Message, ret.RequestID)
+	}
+
+	if result == nil || len(ret.Response) == 0 {
+		return nil
+	}
+
+	if err = json.Unmarshal(ret.Response, result); err != nil {
+		return fmt.Errorf("unmarshal zenlayer %s result failed, err: %v", action, err)
+	}
+
+	return nil
+}
+
+// authorization 生成 ZC2-HMAC-SHA256 签名的 Authorization 请求头。
+func (c *client) authorization(host, path, timestamp string, body []byte) string {
+	if len(path) == 0 {
+		path = "/"
+	}
+
+	canonicalHeaders := fmt.Sprintf("content-type:%s\nhost:%s\n", contentType, host)
+	canonicalRequest := fmt.Sprintf("%s\n%s\n\n%s\n%s\n%s", http.MethodPost, path, canonicalHeaders,
+		signedHeaders, sha256Hex(body))
+	stringToSign := fmt.Sprintf("%s\n%s\n%s", signatureMethod, timestamp, sha256Hex([]byte(canonicalRequest)))
+
+	mac := hmac.New(sha256.New, []byte(c.secretKey))
+	mac.Write([]byte(stringToSign))
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	return fmt.Sprintf("%s Credential=%s, SignedHeaders=%s, Signature=%s", signatureMethod, c.secretID,
+		signedHeaders, signature)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
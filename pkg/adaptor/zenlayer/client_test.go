@@ -0,0 +1,114 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package zenlayer
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"hcm/pkg/adaptor/types"
+	"hcm/pkg/kit"
+)
+
+const (
+	testSecretID  = "test-secret-id"
+	testSecretKey = "test-secret-key"
+)
+
+// newFakeServer 模拟 zenlayer cloud api，按 ZC2-HMAC-SHA256 规则校验签名，校验通过后返回 handler 的结果
+func newFakeServer(t *testing.T, handler func(action string) string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("read request body failed, err: %v", err)
+			return
+		}
+
+		bodyHash := sha256.Sum256(body)
+		canonicalRequest := fmt.Sprintf("POST\n%s\n\ncontent-type:%s\nhost:%s\n\ncontent-type;host\n%s",
+			r.URL.Path, r.Header.Get("Content-Type"), r.Host, hex.EncodeToString(bodyHash[:]))
+		requestHash := sha256.Sum256([]byte(canonicalRequest))
+		stringToSign := fmt.Sprintf("ZC2-HMAC-SHA256\n%s\n%s", r.Header.Get("X-ZC-Timestamp"),
+			hex.EncodeToString(requestHash[:]))
+		mac := hmac.New(sha256.New, []byte(testSecretKey))
+		mac.Write([]byte(stringToSign))
+		expect := fmt.Sprintf("ZC2-HMAC-SHA256 Credential=%s, SignedHeaders=content-type;host, Signature=%s",
+			testSecretID, hex.EncodeToString(mac.Sum(nil)))
+
+		if r.Header.Get("Authorization") != expect {
+			fmt.Fprint(w, `{"requestId":"r1","code":"AUTH_FAILED","message":"signature mismatch"}`)
+			return
+		}
+		if r.Header.Get("X-ZC-Version") != apiVersion {
+			t.Errorf("api version %s mismatch", r.Header.Get("X-ZC-Version"))
+		}
+
+		fmt.Fprint(w, handler(r.Header.Get("X-ZC-Action")))
+	}))
+}
+
+func TestListRegion(t *testing.T) {
+	server := newFakeServer(t, func(action string) string {
+		if action != "DescribeRegions" {
+			return `{"requestId":"r1","code":"INVALID_ACTION","message":"unknown action"}`
+		}
+		return `{"requestId":"r1","response":{"regionSet":[{"regionId":"asia-east-1","regionName":"Hong Kong"}]}}`
+	})
+	defer server.Close()
+
+	cli, err := NewZenlayer(&types.BaseSecret{CloudSecretID: testSecretID, CloudSecretKey: testSecretKey,
+		Endpoint: server.URL + "/api/v2/zec"})
+	if err != nil {
+		t.Fatalf("new zenlayer failed, err: %v", err)
+	}
+
+	result, err := cli.ListRegion(kit.New())
+	if err != nil {
+		t.Fatalf("list region failed, err: %v", err)
+	}
+	if len(result.Details) != 1 || result.Details[0].RegionID != "asia-east-1" {
+		t.Errorf("unexpected regions: %+v", result.Details)
+	}
+}
+
+func TestCallWithWrongSecret(t *testing.T) {
+	server := newFakeServer(t, func(action string) string {
+		return `{"requestId":"r1","response":{}}`
+	})
+	defer server.Close()
+
+	cli, err := NewZenlayer(&types.BaseSecret{CloudSecretID: testSecretID, CloudSecretKey: "wrong-key",
+		Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("new zenlayer failed, err: %v", err)
+	}
+
+	_, err = cli.ListRegion(kit.New())
+	if err == nil || !strings.Contains(err.Error(), "AUTH_FAILED") {
+		t.Errorf("expect auth failed error, got: %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package zenlayer
+
+import (
+	"fmt"
+
+	"hcm/pkg/adaptor/types/core"
+	"hcm/pkg/adaptor/types/eip"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+)
+
+// ListEip 查询弹性公网ip列表
+// reference: https://docs.console.zenlayer.com/api-reference/networking/eip/describeeips
+func (z *ZenlayerImpl) ListEip(kt *kit.Kit, opt *core.ZenlayerListOption) ([]eip.ZenlayerEip, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "zenlayer eip list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := map[string]interface{}{
+		"pageNum":  opt.Page.PageNum,
+		"pageSize": opt.Page.PageSize,
+	}
+	if len(opt.Region) != 0 {
+		req["regionId"] = opt.Region
+	}
+	if len(opt.CloudIDs) != 0 {
+		req["eipIds"] = opt.CloudIDs
+	}
+
+	resp := new(pageResult[eip.ZenlayerEip])
+	if err := z.client.call(kt, "DescribeEips", req, resp); err != nil {
+		logs.Errorf("list zenlayer eip failed, err: %v, region: %s, rid: %s", err, opt.Region, kt.Rid)
+		return nil, fmt.Errorf("list zenlayer eip failed, err: %v", err)
+	}
+
+	return resp.DataSet, nil
+}
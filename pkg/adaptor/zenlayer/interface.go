@@ -0,0 +1,40 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package zenlayer
+
+import (
+	"hcm/pkg/adaptor/types"
+	"hcm/pkg/adaptor/types/core"
+	typecvm "hcm/pkg/adaptor/types/cvm"
+	"hcm/pkg/adaptor/types/eip"
+	"hcm/pkg/adaptor/types/region"
+	"hcm/pkg/adaptor/types/subnet"
+	typeszone "hcm/pkg/adaptor/types/zone"
+	"hcm/pkg/kit"
+)
+
+// Zenlayer adaptor interface for zenlayer
+type Zenlayer interface {
+	ListRegion(kt *kit.Kit) (*region.ZenlayerRegionListResult, error)
+	ListZone(kt *kit.Kit, opt *typeszone.ZenlayerZoneListOption) ([]typeszone.ZenlayerZone, error)
+	ListVpc(kt *kit.Kit, opt *core.ZenlayerListOption) (*types.ZenlayerVpcListResult, error)
+	ListSubnet(kt *kit.Kit, opt *core.ZenlayerListOption) (*adtysubnet.ZenlayerSubnetListResult, error)
+	ListCvm(kt *kit.Kit, opt *core.ZenlayerListOption) ([]typecvm.ZenlayerCvm, error)
+	ListEip(kt *kit.Kit, opt *core.ZenlayerListOption) ([]eip.ZenlayerEip, error)
+}
@@ -0,0 +1,108 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package zenlayer
+
+import (
+	"fmt"
+
+	"hcm/pkg/adaptor/types/core"
+	adtysubnet "hcm/pkg/adaptor/types/subnet"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+)
+
+// zenlayerSubnet zenlayer DescribeSubnets 返回的子网结构。
+type zenlayerSubnet struct {
+	SubnetID      string `json:"subnetId"`
+	Name          string `json:"name"`
+	VpcID         string `json:"vpcId"`
+	RegionID      string `json:"regionId"`
+	ZoneID        string `json:"zoneId"`
+	CidrBlock     string `json:"cidrBlock"`
+	Ipv6CidrBlock string `json:"ipv6CidrBlock"`
+	GatewayIP     string `json:"gatewayIpAddress"`
+	Status        string `json:"status"`
+	IsDefault     bool   `json:"isDefault"`
+	Description   string `json:"description"`
+}
+
+// ListSubnet 查询子网列表
+// reference: https://docs.console.zenlayer.com/api-reference/networking/vpc/describesubnets
+func (z *ZenlayerImpl) ListSubnet(kt *kit.Kit, opt *core.ZenlayerListOption) (*adtysubnet.ZenlayerSubnetListResult,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "zenlayer subnet list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := map[string]interface{}{
+		"pageNum":  opt.Page.PageNum,
+		"pageSize": opt.Page.PageSize,
+	}
+	if len(opt.Region) != 0 {
+		req["regionId"] = opt.Region
+	}
+	if len(opt.CloudIDs) != 0 {
+		req["subnetIds"] = opt.CloudIDs
+	}
+
+	resp := new(pageResult[zenlayerSubnet])
+	if err := z.client.call(kt, "DescribeSubnets", req, resp); err != nil {
+		logs.Errorf("list zenlayer subnet failed, err: %v, region: %s, rid: %s", err, opt.Region, kt.Rid)
+		return nil, fmt.Errorf("list zenlayer subnet failed, err: %v", err)
+	}
+
+	details := make([]adtysubnet.ZenlayerSubnet, 0, len(resp.DataSet))
+	for _, one := range resp.DataSet {
+		details = append(details, convertSubnet(one))
+	}
+
+	return &adtysubnet.ZenlayerSubnetListResult{Details: details}, nil
+}
+
+func convertSubnet(data zenlayerSubnet) adtysubnet.ZenlayerSubnet {
+	subnet := adtysubnet.ZenlayerSubnet{
+		CloudVpcID: data.VpcID,
+		CloudID:    data.SubnetID,
+		Name:       data.Name,
+		Region:     data.RegionID,
+		Memo:       &data.Description,
+		Extension: &adtysubnet.ZenlayerSubnetExtension{
+			Zone:      data.ZoneID,
+			Status:    data.Status,
+			GatewayIp: data.GatewayIP,
+			IsDefault: data.IsDefault,
+		},
+	}
+
+	if len(data.CidrBlock) != 0 {
+		subnet.Ipv4Cidr = []string{data.CidrBlock}
+	}
+
+	if len(data.Ipv6CidrBlock) != 0 {
+		subnet.Ipv6Cidr = []string{data.Ipv6CidrBlock}
+	}
+
+	return subnet
+}
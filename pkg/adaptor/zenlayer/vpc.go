@@ -0,0 +1,99 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package zenlayer
+
+import (
+	"fmt"
+
+	"hcm/pkg/adaptor/types"
+	"hcm/pkg/adaptor/types/core"
+	"hcm/pkg/api/core/cloud"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+)
+
+// zenlayerVpc zenlayer DescribeVpcs 返回的 vpc 结构。
+type zenlayerVpc struct {
+	VpcID         string `json:"vpcId"`
+	Name          string `json:"name"`
+	CidrBlock     string `json:"cidrBlock"`
+	Ipv6CidrBlock string `json:"ipv6CidrBlock"`
+	Status        string `json:"status"`
+	IsDefault     bool   `json:"isDefault"`
+	Description   string `json:"description"`
+}
+
+// ListVpc 查询全局 vpc 列表，zenlayer 的 vpc 是全局资源，不区分地域。
+// reference: https://docs.console.zenlayer.com/api-reference/networking/vpc/describevpcs
+func (z *ZenlayerImpl) ListVpc(kt *kit.Kit, opt *core.ZenlayerListOption) (*types.ZenlayerVpcListResult, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "zenlayer vpc list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := map[string]interface{}{
+		"pageNum":  opt.Page.PageNum,
+		"pageSize": opt.Page.PageSize,
+	}
+	if len(opt.CloudIDs) != 0 {
+		req["vpcIds"] = opt.CloudIDs
+	}
+
+	resp := new(pageResult[zenlayerVpc])
+	if err := z.client.call(kt, "DescribeVpcs", req, resp); err != nil {
+		logs.Errorf("list zenlayer vpc failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, fmt.Errorf("list zenlayer vpc failed, err: %v", err)
+	}
+
+	details := make([]types.ZenlayerVpc, 0, len(resp.DataSet))
+	for _, one := range resp.DataSet {
+		details = append(details, convertVpc(one))
+	}
+
+	return &types.ZenlayerVpcListResult{Details: details}, nil
+}
+
+func convertVpc(data zenlayerVpc) types.ZenlayerVpc {
+	vpc := types.ZenlayerVpc{
+		CloudID: data.VpcID,
+		Name:    data.Name,
+		Memo:    &data.Description,
+		Extension: &cloud.ZenlayerVpcExtension{
+			Cidr:      make([]cloud.ZenlayerCidr, 0),
+			Status:    data.Status,
+			IsDefault: data.IsDefault,
+		},
+	}
+
+	if len(data.CidrBlock) != 0 {
+		vpc.Extension.Cidr = append(vpc.Extension.Cidr, cloud.ZenlayerCidr{Type: enumor.Ipv4, Cidr: data.CidrBlock})
+	}
+
+	if len(data.Ipv6CidrBlock) != 0 {
+		vpc.Extension.Cidr = append(vpc.Extension.Cidr,
+			cloud.ZenlayerCidr{Type: enumor.Ipv6, Cidr: data.Ipv6CidrBlock})
+	}
+
+	return vpc
+}
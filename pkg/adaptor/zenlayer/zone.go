@@ -0,0 +1,53 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package zenlayer
+
+import (
+	"fmt"
+
+	typeszone "hcm/pkg/adaptor/types/zone"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+)
+
+// ListZone 查询可用区列表
+// reference: https://docs.console.zenlayer.com/api-reference/compute/zec/region/describezones
+func (z *ZenlayerImpl) ListZone(kt *kit.Kit, opt *typeszone.ZenlayerZoneListOption) ([]typeszone.ZenlayerZone,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "zenlayer zone list option is required")
+	}
+
+	if err := opt.Validate(); err != nil {
+		return nil, errf.NewFromErr(errf.InvalidParameter, err)
+	}
+
+	req := map[string]interface{}{"regionId": opt.Region}
+	resp := new(struct {
+		ZoneSet []typeszone.ZenlayerZone `json:"zoneSet"`
+	})
+	if err := z.client.call(kt, "DescribeZones", req, resp); err != nil {
+		logs.Errorf("list zenlayer zone failed, err: %v, region: %s, rid: %s", err, opt.Region, kt.Rid)
+		return nil, fmt.Errorf("list zenlayer zone failed, err: %v", err)
+	}
+
+	return resp.ZoneSet, nil
+}
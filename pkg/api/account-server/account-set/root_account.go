@@ -78,6 +78,22 @@ func (req *RootAccountUpdateReq) Validate() error {
 	return nil
 }
 
+// TCloudRootAccountExtensionUpdateReq ...
+type TCloudRootAccountExtensionUpdateReq struct {
+	CloudSubAccountID string `json:"cloud_sub_account_id" validate:"required"`
+	CloudSecretID     string `json:"cloud_secret_id" validate:"omitempty"`
+	CloudSecretKey    string `json:"cloud_secret_key" validate:"omitempty"`
+}
+
+// Validate ...
+func (req *TCloudRootAccountExtensionUpdateReq) Validate() error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // AwsRootAccountExtensionUpdateReq	...
 type AwsRootAccountExtensionUpdateReq struct {
 	CloudIamUsername string `json:"cloud_iam_username" validate:"required"`
@@ -20,31 +20,48 @@
 package bill
 
 import (
-	"errors"
-
 	"hcm/pkg/api/core"
 	"hcm/pkg/api/core/bill"
-	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/criteria/validator"
 	"hcm/pkg/runtime/filter"
 )
 
 // ExportBillItemReq ...
 type ExportBillItemReq struct {
-	BillYear    int                `json:"bill_year" validate:"required"`
-	BillMonth   int                `json:"bill_month" validate:"required"`
+	BillYear  int `json:"bill_year" validate:"required"`
+	BillMonth int `json:"bill_month" validate:"required"`
+	// ExportLimit 导出条数，为0时导出全部。超过constant.ExcelExportLimit时以异步任务导出
 	ExportLimit uint64             `json:"export_limit" validate:"omitempty"`
 	Filter      *filter.Expression `json:"filter" validate:"omitempty"`
+	// Format 导出文件格式，默认为csv
+	Format enumor.BillExportFormat `json:"format" validate:"omitempty"`
 }
 
-// Validate ListBillItemReq
+// Validate ExportBillItemReq
 func (r *ExportBillItemReq) Validate() error {
-	if r.ExportLimit > constant.ExcelExportLimit {
-		return errors.New("export limit exceed")
+	if len(r.Format) != 0 {
+		if err := r.Format.Validate(); err != nil {
+			return err
+		}
 	}
 	return validator.Validate.Struct(r)
 }
 
+// ExportBillItemAsyncResult 导出数据量超过constant.ExcelExportLimit时，创建异步导出任务并返回任务流ID
+type ExportBillItemAsyncResult struct {
+	FlowID string `json:"flow_id"`
+}
+
+// ExportBillItemFlowResult 异步导出任务执行结果，任务成功后返回文件下载链接
+type ExportBillItemFlowResult struct {
+	State       enumor.FlowState `json:"state"`
+	Reason      string           `json:"reason,omitempty"`
+	Count       uint64           `json:"count,omitempty"`
+	DownloadURL string           `json:"download_url,omitempty"`
+	ExpiredAt   string           `json:"expired_at,omitempty"`
+}
+
 // ListBillItemReq ...
 type ListBillItemReq struct {
 	BillYear  int                `json:"bill_year" validate:"required"`
@@ -0,0 +1,90 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bill
+
+import (
+	"errors"
+
+	"hcm/pkg/api/core/bill"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+
+	"github.com/shopspring/decimal"
+)
+
+// BillBudgetCreateReq create bill budget request
+type BillBudgetCreateReq struct {
+	Name       string                 `json:"name" validate:"required,max=128"`
+	Scope      enumor.BillBudgetScope `json:"scope" validate:"required"`
+	ScopeID    string                 `json:"scope_id" validate:"required,max=64"`
+	Amount     decimal.Decimal        `json:"amount" validate:"required"`
+	Thresholds []bill.BudgetThreshold `json:"thresholds" validate:"required,min=1"`
+	Receivers  []string               `json:"receivers" validate:"omitempty,max=50"`
+	WebhookUrl *string                `json:"webhook_url" validate:"omitempty,max=512"`
+	Memo       *string                `json:"memo" validate:"omitempty,max=255"`
+}
+
+// Validate ...
+func (r *BillBudgetCreateReq) Validate() error {
+	if err := validator.Validate.Struct(r); err != nil {
+		return err
+	}
+	if !r.Amount.IsPositive() {
+		return errors.New("amount should be positive")
+	}
+	if err := bill.ValidateBudgetScope(r.Scope, r.ScopeID); err != nil {
+		return err
+	}
+	if r.WebhookUrl != nil {
+		if err := bill.ValidateBudgetWebhookUrl(*r.WebhookUrl); err != nil {
+			return err
+		}
+	}
+	return bill.ValidateBudgetThresholds(r.Thresholds)
+}
+
+// BillBudgetUpdateReq update bill budget request
+type BillBudgetUpdateReq struct {
+	Name       string                 `json:"name" validate:"omitempty,max=128"`
+	Amount     *decimal.Decimal       `json:"amount" validate:"omitempty"`
+	Thresholds []bill.BudgetThreshold `json:"thresholds" validate:"omitempty"`
+	Receivers  []string               `json:"receivers" validate:"omitempty,max=50"`
+	WebhookUrl *string                `json:"webhook_url" validate:"omitempty,max=512"`
+	Memo       *string                `json:"memo" validate:"omitempty,max=255"`
+}
+
+// Validate ...
+func (r *BillBudgetUpdateReq) Validate() error {
+	if err := validator.Validate.Struct(r); err != nil {
+		return err
+	}
+	if r.Amount != nil && !r.Amount.IsPositive() {
+		return errors.New("amount should be positive")
+	}
+	if r.WebhookUrl != nil {
+		if err := bill.ValidateBudgetWebhookUrl(*r.WebhookUrl); err != nil {
+			return err
+		}
+	}
+	if len(r.Thresholds) != 0 {
+		return bill.ValidateBudgetThresholds(r.Thresholds)
+	}
+	return nil
+}
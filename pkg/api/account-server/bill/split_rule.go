@@ -0,0 +1,81 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bill
+
+import (
+	"errors"
+
+	"hcm/pkg/api/core/bill"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+)
+
+// BillSplitRuleCreateReq create bill split rule request
+type BillSplitRuleCreateReq struct {
+	Name          string                 `json:"name" validate:"required,max=128"`
+	MainAccountID string                 `json:"main_account_id" validate:"required"`
+	Priority      int64                  `json:"priority" validate:"min=0"`
+	SplitType     enumor.BillSplitType   `json:"split_type" validate:"required"`
+	Match         *bill.SplitRuleMatch   `json:"match" validate:"omitempty"`
+	Targets       []bill.SplitRuleTarget `json:"targets" validate:"required,min=1"`
+	Memo          *string                `json:"memo" validate:"omitempty,max=255"`
+}
+
+// Validate ...
+func (r *BillSplitRuleCreateReq) Validate() error {
+	if err := validator.Validate.Struct(r); err != nil {
+		return err
+	}
+	if r.Match != nil {
+		if err := r.Match.Validate(); err != nil {
+			return err
+		}
+	}
+	return bill.ValidateSplitRuleTargets(r.SplitType, r.Targets)
+}
+
+// BillSplitRuleUpdateReq update bill split rule request
+type BillSplitRuleUpdateReq struct {
+	Name      string                 `json:"name" validate:"omitempty,max=128"`
+	Priority  *int64                 `json:"priority" validate:"omitempty,min=0"`
+	SplitType enumor.BillSplitType   `json:"split_type" validate:"omitempty"`
+	Match     *bill.SplitRuleMatch   `json:"match" validate:"omitempty"`
+	Targets   []bill.SplitRuleTarget `json:"targets" validate:"omitempty"`
+	Memo      *string                `json:"memo" validate:"omitempty,max=255"`
+}
+
+// Validate ...
+func (r *BillSplitRuleUpdateReq) Validate() error {
+	if err := validator.Validate.Struct(r); err != nil {
+		return err
+	}
+	if r.Match != nil {
+		if err := r.Match.Validate(); err != nil {
+			return err
+		}
+	}
+	if (len(r.SplitType) == 0) != (len(r.Targets) == 0) {
+		return errors.New("split_type and targets should be updated together")
+	}
+	if len(r.SplitType) != 0 {
+		return bill.ValidateSplitRuleTargets(r.SplitType, r.Targets)
+	}
+	return nil
+}
@@ -184,6 +184,60 @@ func (req *AzureAccountExtensionCreateReq) IsFull() bool {
 		req.CloudApplicationName != ""
 }
 
+// ZenlayerAccountExtensionCreateReq ...
+type ZenlayerAccountExtensionCreateReq struct {
+	CloudMainAccountID string `json:"cloud_main_account_id" validate:"required"`
+	CloudSubAccountID  string `json:"cloud_sub_account_id" validate:"required"`
+	CloudSecretID      string `json:"cloud_secret_id" validate:"omitempty"`
+	CloudSecretKey     string `json:"cloud_secret_key" validate:"omitempty"`
+}
+
+// Validate ...
+func (req *ZenlayerAccountExtensionCreateReq) Validate(accountType enumor.AccountType) error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+
+	// 登记账号密钥可为空，其他类型则必填
+	if accountType != enumor.RegistrationAccount && !req.IsFull() {
+		return secretEmptyError
+	}
+
+	return nil
+}
+
+// IsFull 对于不同账号类型，有些字段是允许为空的，这里返回是否所有字段都有值
+func (req *ZenlayerAccountExtensionCreateReq) IsFull() bool {
+	return req.CloudSecretID != "" && req.CloudSecretKey != ""
+}
+
+// KaopuAccountExtensionCreateReq ...
+type KaopuAccountExtensionCreateReq struct {
+	CloudMainAccountID string `json:"cloud_main_account_id" validate:"required"`
+	CloudSubAccountID  string `json:"cloud_sub_account_id" validate:"required"`
+	CloudSecretID      string `json:"cloud_secret_id" validate:"omitempty"`
+	CloudSecretKey     string `json:"cloud_secret_key" validate:"omitempty"`
+}
+
+// Validate ...
+func (req *KaopuAccountExtensionCreateReq) Validate(accountType enumor.AccountType) error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+
+	// 登记账号密钥可为空，其他类型则必填
+	if accountType != enumor.RegistrationAccount && !req.IsFull() {
+		return secretEmptyError
+	}
+
+	return nil
+}
+
+// IsFull 对于不同账号类型，有些字段是允许为空的，这里返回是否所有字段都有值
+func (req *KaopuAccountExtensionCreateReq) IsFull() bool {
+	return req.CloudSecretID != "" && req.CloudSecretKey != ""
+}
+
 // AccountCommonInfoCreateReq ...
 type AccountCommonInfoCreateReq struct {
 	Vendor   enumor.Vendor          `json:"vendor" validate:"required"`
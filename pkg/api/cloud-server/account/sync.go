@@ -0,0 +1,42 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package account
+
+import (
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+)
+
+// SyncByEventReq define sync resource by resource change event request.
+type SyncByEventReq struct {
+	Events []ResSyncEvent `json:"events" validate:"required,min=1,max=100,dive"`
+}
+
+// Validate SyncByEventReq.
+func (req *SyncByEventReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// ResSyncEvent 资源变更事件，例如由云上操作审计(CloudAudit/CloudTrail)推送的资源变更事件转换而来。
+type ResSyncEvent struct {
+	ResType  enumor.CloudResourceType `json:"res_type" validate:"required"`
+	Region   string                   `json:"region" validate:"required"`
+	CloudIDs []string                 `json:"cloud_ids" validate:"required,min=1,max=100"`
+}
@@ -173,6 +173,58 @@ func (req *AzureAccountExtensionUpdateReq) IsFull() bool {
 		req.CloudApplicationName != ""
 }
 
+// ZenlayerAccountExtensionUpdateReq ...
+type ZenlayerAccountExtensionUpdateReq struct {
+	CloudSubAccountID string `json:"cloud_sub_account_id" validate:"required"`
+	CloudSecretID     string `json:"cloud_secret_id" validate:"omitempty"`
+	CloudSecretKey    string `json:"cloud_secret_key" validate:"omitempty"`
+}
+
+// Validate ...
+func (req *ZenlayerAccountExtensionUpdateReq) Validate(accountType enumor.AccountType) error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+
+	// 登记账号密钥可为空，其他类型则必填
+	if accountType != enumor.RegistrationAccount && !req.IsFull() {
+		return secretEmptyError
+	}
+
+	return nil
+}
+
+// IsFull 对于不同账号类型，有些字段是允许为空的，这里返回是否所有字段都有值
+func (req *ZenlayerAccountExtensionUpdateReq) IsFull() bool {
+	return req.CloudSecretID != "" && req.CloudSecretKey != ""
+}
+
+// KaopuAccountExtensionUpdateReq ...
+type KaopuAccountExtensionUpdateReq struct {
+	CloudSubAccountID string `json:"cloud_sub_account_id" validate:"required"`
+	CloudSecretID     string `json:"cloud_secret_id" validate:"omitempty"`
+	CloudSecretKey    string `json:"cloud_secret_key" validate:"omitempty"`
+}
+
+// Validate ...
+func (req *KaopuAccountExtensionUpdateReq) Validate(accountType enumor.AccountType) error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+
+	// 登记账号密钥可为空，其他类型则必填
+	if accountType != enumor.RegistrationAccount && !req.IsFull() {
+		return secretEmptyError
+	}
+
+	return nil
+}
+
+// IsFull 对于不同账号类型，有些字段是允许为空的，这里返回是否所有字段都有值
+func (req *KaopuAccountExtensionUpdateReq) IsFull() bool {
+	return req.CloudSecretID != "" && req.CloudSecretKey != ""
+}
+
 // AccountUpdateReq ...
 type AccountUpdateReq struct {
 	Name               string   `json:"name" validate:"omitempty"`
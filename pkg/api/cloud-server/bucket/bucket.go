@@ -0,0 +1,79 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package csbucket ...
+package csbucket
+
+import (
+	"errors"
+	"fmt"
+
+	corebucket "hcm/pkg/api/core/cloud/bucket"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/validator"
+)
+
+// AssignBucketToBizReq define assign bucket to biz req.
+type AssignBucketToBizReq struct {
+	BkBizID int64    `json:"bk_biz_id" validate:"required"`
+	IDs     []string `json:"ids" validate:"required"`
+}
+
+// Validate assign bucket to biz request.
+func (req *AssignBucketToBizReq) Validate() error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+
+	if req.BkBizID <= 0 {
+		return errors.New("bk_biz_id should >= 0")
+	}
+
+	if len(req.IDs) == 0 {
+		return errors.New("ids is required")
+	}
+
+	if len(req.IDs) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("ids should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return nil
+}
+
+// PublicBucketCheckReq 检查公开可读的存储桶，BkBizIDs 为空时检查全部业务及未分配的存储桶
+type PublicBucketCheckReq struct {
+	BkBizIDs []int64 `json:"bk_biz_ids" validate:"omitempty,max=100"`
+}
+
+// Validate PublicBucketCheckReq
+func (req *PublicBucketCheckReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// PublicBucketCheckResult 按业务分组的公开可读存储桶
+type PublicBucketCheckResult struct {
+	Details []BizPublicBucket `json:"details"`
+}
+
+// BizPublicBucket 业务下公开可读的存储桶，未分配业务的存储桶 bk_biz_id 为 -1
+type BizPublicBucket struct {
+	BkBizID int64                   `json:"bk_biz_id"`
+	Count   uint64                  `json:"count"`
+	Buckets []corebucket.BaseBucket `json:"buckets"`
+}
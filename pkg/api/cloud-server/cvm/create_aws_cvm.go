@@ -56,8 +56,12 @@ type AwsCvmCreateReq struct {
 	} `json:"data_disk" validate:"omitempty"`
 
 	// Note: aws是通过执行用户脚本添加密码的，可能有特殊字符会导致脚本执行失败，而且这是无法通过DryRun测试出来的
-	Password          string `json:"password" validate:"required"`
+	Password          string `json:"password" validate:"required_without=KeyPairID,excluded_with=KeyPairID"`
 	ConfirmedPassword string `json:"confirmed_password" validate:"eqfield=Password"`
+	// KeyPairID 使用密钥对登录时指定，与密码二选一
+	KeyPairID string `json:"key_pair_id" validate:"excluded_with=Password"`
+	// DnsRecord 指定时主机创建成功后在私有域下为主机内网IP注册A记录
+	DnsRecord *CvmDnsRecordOption `json:"dns_record" validate:"omitempty"`
 
 	RequiredCount int64 `json:"required_count" validate:"required,min=1,max=500"`
 
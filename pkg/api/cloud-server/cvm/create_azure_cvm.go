@@ -73,8 +73,12 @@ type AzureCvmCreateReq struct {
 	// https://learn.microsoft.com/en-us/azure/virtual-machines/linux/faq
 	// https://learn.microsoft.com/en-us/azure/virtual-machines/windows/faq
 	Username          string `json:"username" validate:"required,min=1,max=32"`
-	Password          string `json:"password" validate:"required"`
+	Password          string `json:"password" validate:"required_without=KeyPairID,excluded_with=KeyPairID"`
 	ConfirmedPassword string `json:"confirmed_password" validate:"eqfield=Password"`
+	// KeyPairID 使用密钥对登录时指定，与密码二选一
+	KeyPairID string `json:"key_pair_id" validate:"excluded_with=Password"`
+	// DnsRecord 指定时主机创建成功后在私有域下为主机内网IP注册A记录
+	DnsRecord *CvmDnsRecordOption `json:"dns_record" validate:"omitempty"`
 
 	RequiredCount int64 `json:"required_count" validate:"required,min=1,max=500"`
 
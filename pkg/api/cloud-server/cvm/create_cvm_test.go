@@ -0,0 +1,60 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package cscvm
+
+import (
+	"testing"
+
+	"hcm/pkg/criteria/validator"
+)
+
+func TestCvmCreateReqLoginValidate(t *testing.T) {
+	cases := []struct {
+		name      string
+		password  string
+		keyPairID string
+		wantErr   bool
+	}{
+		{name: "password", password: "Passw0rd!", wantErr: false},
+		{name: "key pair", keyPairID: "00000001", wantErr: false},
+		{name: "both password and key pair", password: "Passw0rd!", keyPairID: "00000001", wantErr: true},
+		{name: "neither password nor key pair", wantErr: true},
+	}
+
+	for _, c := range cases {
+		reqs := map[string]interface{}{
+			"tcloud": &TCloudCvmCreateReq{Password: c.password, ConfirmedPassword: c.password,
+				KeyPairID: c.keyPairID},
+			"aws": &AwsCvmCreateReq{Password: c.password, ConfirmedPassword: c.password, KeyPairID: c.keyPairID},
+			"huawei": &HuaWeiCvmCreateReq{Password: c.password, ConfirmedPassword: c.password,
+				KeyPairID: c.keyPairID},
+			"azure": &AzureCvmCreateReq{Password: c.password, ConfirmedPassword: c.password,
+				KeyPairID: c.keyPairID},
+			"gcp": &GcpCvmCreateReq{Password: c.password, KeyPairID: c.keyPairID},
+		}
+
+		for vendor, req := range reqs {
+			err := validator.Validate.StructPartial(req, "Password", "KeyPairID")
+			if (err != nil) != c.wantErr {
+				t.Errorf("%s %s: want err: %v, got: %v", vendor, c.name, c.wantErr, err)
+			}
+		}
+	}
+}
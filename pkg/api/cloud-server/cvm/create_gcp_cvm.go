@@ -56,7 +56,11 @@ type GcpCvmCreateReq struct {
 	} `json:"data_disk" validate:"omitempty"`
 
 	// 访问主机的ssh公钥
-	Password string `json:"password" validate:"required"`
+	Password string `json:"password" validate:"required_without=KeyPairID,excluded_with=KeyPairID"`
+	// KeyPairID 使用密钥对登录时指定，与密码二选一
+	KeyPairID string `json:"key_pair_id" validate:"excluded_with=Password"`
+	// DnsRecord 指定时主机创建成功后在私有域下为主机内网IP注册A记录
+	DnsRecord *CvmDnsRecordOption `json:"dns_record" validate:"omitempty"`
 
 	RequiredCount int64 `json:"required_count" validate:"required,min=1,max=500"`
 
@@ -68,8 +68,12 @@ type TCloudCvmCreateReq struct {
 		DiskCount  int64                      `json:"disk_count" validate:"required,min=1"`
 	} `json:"data_disk" validate:"omitempty,max=20"`
 
-	Password          string `json:"password" validate:"required"`
+	Password          string `json:"password" validate:"required_without=KeyPairID,excluded_with=KeyPairID"`
 	ConfirmedPassword string `json:"confirmed_password" validate:"eqfield=Password"`
+	// KeyPairID 使用密钥对登录时指定，与密码二选一
+	KeyPairID string `json:"key_pair_id" validate:"excluded_with=Password"`
+	// DnsRecord 指定时主机创建成功后在私有域下为主机内网IP注册A记录
+	DnsRecord *CvmDnsRecordOption `json:"dns_record" validate:"omitempty"`
 
 	InstanceChargeType typecvm.TCloudInstanceChargeType `json:"instance_charge_type" validate:"required"`
 
@@ -133,8 +137,10 @@ func (req *TCloudCvmCreateReq) Validate(bizRequired bool) error {
 	}
 
 	// 校验密码是否符合要求
-	if err := req.validatePassword(); err != nil {
-		return err
+	if len(req.Password) != 0 {
+		if err := req.validatePassword(); err != nil {
+			return err
+		}
 	}
 
 	if req.PublicIPAssigned && req.InternetMaxBandwidthOut == 0 {
@@ -55,6 +55,12 @@ func (req *AssignCvmToBizReq) Validate() error {
 	return nil
 }
 
+// CvmDnsRecordOption 创建主机时注册私有域解析记录的参数，主机记录为主机名称
+type CvmDnsRecordOption struct {
+	ZoneID string `json:"zone_id" validate:"required"`
+	TTL    int64  `json:"ttl" validate:"omitempty,min=1,max=604800"`
+}
+
 // BatchStartCvmReq batch start cvm req.
 type BatchStartCvmReq struct {
 	IDs []string `json:"ids" validate:"required"`
@@ -0,0 +1,86 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package csdbinstance ...
+package csdbinstance
+
+import (
+	"errors"
+	"fmt"
+
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/validator"
+)
+
+// AssignDatabaseInstanceToBizReq define assign database instance to biz req.
+type AssignDatabaseInstanceToBizReq struct {
+	BkBizID int64    `json:"bk_biz_id" validate:"required"`
+	IDs     []string `json:"ids" validate:"required"`
+}
+
+// Validate assign database instance to biz request.
+func (req *AssignDatabaseInstanceToBizReq) Validate() error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+
+	if req.BkBizID <= 0 {
+		return errors.New("bk_biz_id should >= 0")
+	}
+
+	if len(req.IDs) == 0 {
+		return errors.New("ids is required")
+	}
+
+	if len(req.IDs) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("ids should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return nil
+}
+
+// DatabaseInstanceRecycleReq recycle database instance request.
+type DatabaseInstanceRecycleReq struct {
+	IDs []string `json:"ids" validate:"min=1,max=100"`
+}
+
+// Validate DatabaseInstanceRecycleReq
+func (req DatabaseInstanceRecycleReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// DatabaseInstanceRecoverReq recover database instance request.
+type DatabaseInstanceRecoverReq struct {
+	RecordIDs []string `json:"record_ids" validate:"min=1,max=100"`
+}
+
+// Validate DatabaseInstanceRecoverReq
+func (req DatabaseInstanceRecoverReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// DatabaseInstanceDeleteRecycleReq delete recycled database instance request.
+type DatabaseInstanceDeleteRecycleReq struct {
+	RecordIDs []string `json:"record_ids" validate:"min=1,max=100"`
+}
+
+// Validate DatabaseInstanceDeleteRecycleReq
+func (req DatabaseInstanceDeleteRecycleReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
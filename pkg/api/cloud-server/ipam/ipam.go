@@ -0,0 +1,187 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package csipam ...
+package csipam
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+)
+
+// CreatePoolReq define create ipam pool req.
+type CreatePoolReq struct {
+	Name    string        `json:"name" validate:"required,max=255"`
+	Vendor  enumor.Vendor `json:"vendor" validate:"required"`
+	Region  string        `json:"region" validate:"required"`
+	BkBizID int64         `json:"bk_biz_id" validate:"required"`
+	Cidr    string        `json:"cidr" validate:"required,cidrv4"`
+	Memo    *string       `json:"memo" validate:"omitempty,max=255"`
+}
+
+// Validate create ipam pool request.
+func (req *CreatePoolReq) Validate() error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+
+	if err := req.Vendor.Validate(); err != nil {
+		return err
+	}
+
+	if req.BkBizID <= 0 && req.BkBizID != constant.UnassignedBiz {
+		return fmt.Errorf("bk_biz_id should > 0 or be %d", constant.UnassignedBiz)
+	}
+
+	ip, ipNet, err := net.ParseCIDR(req.Cidr)
+	if err != nil {
+		return err
+	}
+
+	if !ip.Equal(ipNet.IP) {
+		return fmt.Errorf("cidr %s is not a network address, should be %s", req.Cidr, ipNet.String())
+	}
+
+	return nil
+}
+
+// UpdatePoolReq define update ipam pool req.
+type UpdatePoolReq struct {
+	Name string  `json:"name" validate:"omitempty,max=255"`
+	Memo *string `json:"memo" validate:"omitempty,max=255"`
+}
+
+// Validate update ipam pool request.
+func (req *UpdatePoolReq) Validate() error {
+	if len(req.Name) == 0 && req.Memo == nil {
+		return errors.New("name or memo is required")
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// BatchDeleteReq define batch delete ipam pool or release ipam allocation req.
+type BatchDeleteReq struct {
+	IDs []string `json:"ids" validate:"required,min=1"`
+}
+
+// Validate batch delete request.
+func (req *BatchDeleteReq) Validate() error {
+	if len(req.IDs) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("ids should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// AllocateCidrReq define allocate cidr from ipam pool req.
+type AllocateCidrReq struct {
+	ResType enumor.CloudResourceType `json:"res_type" validate:"required"`
+	MaskLen int                      `json:"mask_len" validate:"required,min=8,max=29"`
+	Memo    *string                  `json:"memo" validate:"omitempty,max=255"`
+}
+
+// Validate allocate cidr request.
+func (req *AllocateCidrReq) Validate() error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+
+	switch req.ResType {
+	case enumor.VpcCloudResType, enumor.SubnetCloudResType:
+	default:
+		return fmt.Errorf("res_type: %s not support allocate cidr", req.ResType)
+	}
+
+	return nil
+}
+
+// AllocateCidrResult define allocate cidr result.
+type AllocateCidrResult struct {
+	ID   string `json:"id"`
+	Cidr string `json:"cidr"`
+}
+
+// ListVpcCidrOverlapReq define list vpc cidr overlap req.
+type ListVpcCidrOverlapReq struct {
+	Vendors []enumor.Vendor `json:"vendors" validate:"omitempty"`
+}
+
+// Validate list vpc cidr overlap request.
+func (req *ListVpcCidrOverlapReq) Validate() error {
+	for _, vendor := range req.Vendors {
+		if err := vendor.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// VpcCidr define one ipv4 cidr of vpc. gcp vpc has no cidr, use the cidr of its subnets instead.
+type VpcCidr struct {
+	VpcID     string        `json:"vpc_id"`
+	CloudID   string        `json:"cloud_id"`
+	Name      string        `json:"name"`
+	Vendor    enumor.Vendor `json:"vendor"`
+	AccountID string        `json:"account_id"`
+	Region    string        `json:"region"`
+	BkBizID   int64         `json:"bk_biz_id"`
+	Cidr      string        `json:"cidr"`
+}
+
+// VpcCidrOverlap define two overlapped vpc cidr.
+type VpcCidrOverlap struct {
+	Source VpcCidr `json:"source"`
+	Target VpcCidr `json:"target"`
+}
+
+// VpcCidrOverlapResult define list vpc cidr overlap result.
+type VpcCidrOverlapResult struct {
+	Details []VpcCidrOverlap `json:"details"`
+}
+
+// SubnetUtilization define subnet ip utilization, used ip is counted by the private ipv4 of cvm and network
+// interface stored in hcm.
+type SubnetUtilization struct {
+	SubnetID         string        `json:"subnet_id"`
+	CloudID          string        `json:"cloud_id"`
+	Name             string        `json:"name"`
+	Vendor           enumor.Vendor `json:"vendor"`
+	AccountID        string        `json:"account_id"`
+	Region           string        `json:"region"`
+	VpcID            string        `json:"vpc_id"`
+	BkBizID          int64         `json:"bk_biz_id"`
+	Ipv4Cidr         []string      `json:"ipv4_cidr"`
+	TotalIPCount     uint64        `json:"total_ip_count"`
+	UsedIPCount      uint64        `json:"used_ip_count"`
+	AvailableIPCount uint64        `json:"available_ip_count"`
+	UsageRate        float64       `json:"usage_rate"`
+}
+
+// SubnetUtilizationResult define list subnet utilization result.
+type SubnetUtilizationResult struct {
+	Count   uint64              `json:"count"`
+	Details []SubnetUtilization `json:"details"`
+}
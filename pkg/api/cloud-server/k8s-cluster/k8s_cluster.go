@@ -0,0 +1,81 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package csk8s ...
+package csk8s
+
+import (
+	"errors"
+	"fmt"
+
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/validator"
+)
+
+// AssignK8sClusterToBizReq define assign k8s cluster to biz req.
+type AssignK8sClusterToBizReq struct {
+	BkBizID int64    `json:"bk_biz_id" validate:"required"`
+	IDs     []string `json:"ids" validate:"required"`
+}
+
+// Validate assign k8s cluster to biz request.
+func (req *AssignK8sClusterToBizReq) Validate() error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+
+	if req.BkBizID <= 0 {
+		return errors.New("bk_biz_id should >= 0")
+	}
+
+	if len(req.IDs) == 0 {
+		return errors.New("ids is required")
+	}
+
+	if len(req.IDs) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("ids should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return nil
+}
+
+// CvmK8sClusterListReq 查询主机所属的容器集群
+type CvmK8sClusterListReq struct {
+	CvmIDs []string `json:"cvm_ids" validate:"required,min=1,max=500"`
+}
+
+// Validate CvmK8sClusterListReq
+func (req *CvmK8sClusterListReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// CvmK8sClusterListResult 主机所属的容器集群，不是集群节点的主机不返回
+type CvmK8sClusterListResult struct {
+	Details []CvmK8sCluster `json:"details"`
+}
+
+// CvmK8sCluster 主机所属的容器集群及节点池，不属于任何节点池的节点 node_pool_id 为空
+type CvmK8sCluster struct {
+	CvmID          string `json:"cvm_id"`
+	ClusterID      string `json:"cluster_id"`
+	CloudClusterID string `json:"cloud_cluster_id"`
+	ClusterName    string `json:"cluster_name"`
+	NodePoolID     string `json:"node_pool_id"`
+	NodePoolName   string `json:"node_pool_name"`
+}
@@ -0,0 +1,102 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package cskeypair ...
+package cskeypair
+
+import (
+	"errors"
+	"fmt"
+
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+)
+
+// ImportKeyPairReq define import key pair req.
+type ImportKeyPairReq struct {
+	Vendor    enumor.Vendor `json:"vendor" validate:"required"`
+	AccountID string        `json:"account_id" validate:"required"`
+	// Region gcp 密钥对写入项目元数据，不需要指定地域
+	Region string `json:"region" validate:"omitempty"`
+	// ResourceGroupName 仅 azure 需要
+	ResourceGroupName string `json:"resource_group_name" validate:"omitempty"`
+	Name              string `json:"name" validate:"required,max=255"`
+	PublicKey         string `json:"public_key" validate:"required"`
+}
+
+// Validate import key pair request.
+func (req *ImportKeyPairReq) Validate() error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+
+	if err := req.Vendor.Validate(); err != nil {
+		return err
+	}
+
+	if req.Vendor != enumor.Gcp && len(req.Region) == 0 {
+		return errors.New("region is required")
+	}
+
+	if req.Vendor == enumor.Azure && len(req.ResourceGroupName) == 0 {
+		return errors.New("resource_group_name is required")
+	}
+
+	return nil
+}
+
+// AssignKeyPairToBizReq define assign key pair to biz req.
+type AssignKeyPairToBizReq struct {
+	BkBizID int64    `json:"bk_biz_id" validate:"required"`
+	IDs     []string `json:"ids" validate:"required"`
+}
+
+// Validate assign key pair to biz request.
+func (req *AssignKeyPairToBizReq) Validate() error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+
+	if req.BkBizID <= 0 {
+		return errors.New("bk_biz_id should >= 0")
+	}
+
+	if len(req.IDs) == 0 {
+		return errors.New("ids is required")
+	}
+
+	if len(req.IDs) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("ids should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return nil
+}
+
+// BindKeyPairReq define bind or unbind key pair with cvm req.
+type BindKeyPairReq struct {
+	CvmIDs []string `json:"cvm_ids" validate:"required,min=1,max=100"`
+	// ForceStop 主机处于运行中时是否强制关机，仅腾讯云支持
+	ForceStop *bool `json:"force_stop" validate:"omitempty"`
+}
+
+// Validate bind key pair request.
+func (req *BindKeyPairReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
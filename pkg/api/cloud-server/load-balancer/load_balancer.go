@@ -279,6 +279,38 @@ type TCloudRemoveTargetReq struct {
 	TargetIDs     []string `json:"target_ids" validate:"required,min=1,max=100,dive"`
 }
 
+// AwsTargetBatchCreateReq aws target batch create req.
+type AwsTargetBatchCreateReq struct {
+	TargetGroups []*AwsBatchAddTargetReq `json:"target_groups" validate:"required,min=1,max=10,dive"`
+}
+
+// Validate request.
+func (req *AwsTargetBatchCreateReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// AwsBatchAddTargetReq aws target batch operate req，实例类型目标使用 cloud_inst_id，ip类型目标使用 ip
+type AwsBatchAddTargetReq struct {
+	TargetGroupID string                 `json:"target_group_id" validate:"required"`
+	Targets       []*cloud.TargetBaseReq `json:"targets" validate:"required,min=1,max=100,dive"`
+}
+
+// AwsTargetBatchRemoveReq aws target batch remove req.
+type AwsTargetBatchRemoveReq struct {
+	TargetGroups []*AwsRemoveTargetReq `json:"target_groups" validate:"required,min=1,max=10,dive"`
+}
+
+// Validate request.
+func (req *AwsTargetBatchRemoveReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// AwsRemoveTargetReq aws remove target req.
+type AwsRemoveTargetReq struct {
+	TargetGroupID string   `json:"target_group_id" validate:"required"`
+	TargetIDs     []string `json:"target_ids" validate:"required,min=1,max=100,dive"`
+}
+
 // TCloudRuleBatchCreateReq tcloud lb url rule batch create req.
 type TCloudRuleBatchCreateReq struct {
 	Rules []TCloudRuleCreate `json:"rules" validate:"min=1,dive"`
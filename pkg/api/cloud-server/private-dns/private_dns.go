@@ -0,0 +1,88 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package csprivatedns ...
+package csprivatedns
+
+import (
+	"errors"
+	"fmt"
+
+	typesdns "hcm/pkg/adaptor/types/private-dns"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/validator"
+)
+
+// AssignPrivateDnsZoneToBizReq define assign private dns zone to biz req.
+type AssignPrivateDnsZoneToBizReq struct {
+	BkBizID int64    `json:"bk_biz_id" validate:"required"`
+	IDs     []string `json:"ids" validate:"required"`
+}
+
+// Validate assign private dns zone to biz request.
+func (req *AssignPrivateDnsZoneToBizReq) Validate() error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+
+	if req.BkBizID <= 0 {
+		return errors.New("bk_biz_id should >= 0")
+	}
+
+	if len(req.IDs) == 0 {
+		return errors.New("ids is required")
+	}
+
+	if len(req.IDs) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("ids should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return nil
+}
+
+// CreatePrivateDnsRecordReq define create private dns record req.
+type CreatePrivateDnsRecordReq struct {
+	typesdns.RecordSpec `json:",inline"`
+}
+
+// Validate create private dns record request.
+func (req *CreatePrivateDnsRecordReq) Validate() error {
+	return req.RecordSpec.Validate()
+}
+
+// UpdatePrivateDnsRecordReq define update private dns record req.
+type UpdatePrivateDnsRecordReq struct {
+	Value []string `json:"value" validate:"required,min=1,max=100"`
+	TTL   int64    `json:"ttl" validate:"omitempty,min=1,max=604800"`
+}
+
+// Validate update private dns record request.
+func (req *UpdatePrivateDnsRecordReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// BatchDeletePrivateDnsRecordReq define batch delete private dns record req.
+type BatchDeletePrivateDnsRecordReq struct {
+	IDs []string `json:"ids" validate:"required,min=1,max=100"`
+}
+
+// Validate batch delete private dns record request.
+func (req *BatchDeletePrivateDnsRecordReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
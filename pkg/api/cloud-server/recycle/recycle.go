@@ -30,6 +30,14 @@ import (
 // RecycleResult defines recycle resource result.
 type RecycleResult struct {
 	TaskID string `json:"task_id"`
+	// Failed 未通过校验、没有加入回收任务的资源
+	Failed []RecycleFailedInfo `json:"failed,omitempty"`
+}
+
+// RecycleFailedInfo defines recycle failed resource and the reason.
+type RecycleFailedInfo struct {
+	ID     string `json:"id"`
+	Reason string `json:"reason"`
 }
 
 // -------------------------- List --------------------------
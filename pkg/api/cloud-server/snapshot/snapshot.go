@@ -0,0 +1,115 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package cssnapshot ...
+package cssnapshot
+
+import (
+	"errors"
+	"fmt"
+
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/tools/cron"
+)
+
+// CreateSnapshotReq define create snapshot req.
+type CreateSnapshotReq struct {
+	DiskID string  `json:"disk_id" validate:"required"`
+	Name   string  `json:"name" validate:"required,max=60"`
+	Memo   *string `json:"memo"`
+}
+
+// Validate create snapshot request.
+func (req *CreateSnapshotReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// RollbackSnapshotReq define rollback snapshot req.
+type RollbackSnapshotReq struct {
+	// DiskName azure、gcp 回滚时会基于快照新建云盘，需要指定云盘名称
+	DiskName string `json:"disk_name"`
+}
+
+// Validate rollback snapshot request.
+func (req *RollbackSnapshotReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// CreatePolicyReq define create snapshot policy req.
+type CreatePolicyReq struct {
+	Name string `json:"name" validate:"required,max=64"`
+	// Spec 快照创建周期，cron表达式
+	Spec string `json:"spec" validate:"required,max=64"`
+	// RetentionDays 快照保留天数，为0时不清理
+	RetentionDays uint     `json:"retention_days"`
+	DiskIDs       []string `json:"disk_ids" validate:"required"`
+	Enabled       *bool    `json:"enabled" validate:"required"`
+	Memo          *string  `json:"memo"`
+}
+
+// Validate create snapshot policy request.
+func (req *CreatePolicyReq) Validate() error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+
+	if len(req.DiskIDs) == 0 {
+		return errors.New("disk ids is required")
+	}
+
+	if _, err := cron.Parse(req.Spec); err != nil {
+		return fmt.Errorf("invalid spec: %v", err)
+	}
+
+	if len(req.DiskIDs) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("disk ids should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return nil
+}
+
+// UpdatePolicyReq define update snapshot policy req.
+type UpdatePolicyReq struct {
+	Name          string   `json:"name" validate:"max=64"`
+	Spec          string   `json:"spec" validate:"max=64"`
+	RetentionDays *uint    `json:"retention_days"`
+	DiskIDs       []string `json:"disk_ids"`
+	Enabled       *bool    `json:"enabled"`
+	Memo          *string  `json:"memo"`
+}
+
+// Validate update snapshot policy request.
+func (req *UpdatePolicyReq) Validate() error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+
+	if len(req.DiskIDs) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("disk ids should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	if len(req.Spec) != 0 {
+		if _, err := cron.Parse(req.Spec); err != nil {
+			return fmt.Errorf("invalid spec: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package cssnapshot
+
+import (
+	"testing"
+
+	cvt "hcm/pkg/tools/converter"
+)
+
+func TestCreatePolicyReqValidate(t *testing.T) {
+	cases := []struct {
+		name    string
+		req     CreatePolicyReq
+		wantErr bool
+	}{
+		{name: "valid", req: CreatePolicyReq{Name: "daily", Spec: "0 2 * * *", RetentionDays: 7,
+			DiskIDs: []string{"disk-1"}, Enabled: cvt.ValToPtr(true)}},
+		{name: "no retention", req: CreatePolicyReq{Name: "daily", Spec: "@every 6h",
+			DiskIDs: []string{"disk-1"}, Enabled: cvt.ValToPtr(false)}},
+		{name: "invalid spec", req: CreatePolicyReq{Name: "daily", Spec: "61 * * * *",
+			DiskIDs: []string{"disk-1"}, Enabled: cvt.ValToPtr(true)}, wantErr: true},
+		{name: "missing disks", req: CreatePolicyReq{Name: "daily", Spec: "0 2 * * *",
+			DiskIDs: []string{}, Enabled: cvt.ValToPtr(true)}, wantErr: true},
+		{name: "missing enabled", req: CreatePolicyReq{Name: "daily", Spec: "0 2 * * *",
+			DiskIDs: []string{"disk-1"}}, wantErr: true},
+	}
+
+	for _, c := range cases {
+		err := c.req.Validate()
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: Validate() err = %v, wantErr: %v", c.name, err, c.wantErr)
+		}
+	}
+}
+
+func TestUpdatePolicyReqValidate(t *testing.T) {
+	cases := []struct {
+		name    string
+		req     UpdatePolicyReq
+		wantErr bool
+	}{
+		{name: "empty", req: UpdatePolicyReq{}},
+		{name: "valid spec", req: UpdatePolicyReq{Spec: "30 1 * * 1-5"}},
+		{name: "invalid spec", req: UpdatePolicyReq{Spec: "every day"}, wantErr: true},
+		{name: "retention only", req: UpdatePolicyReq{RetentionDays: cvt.ValToPtr(uint(0))}},
+	}
+
+	for _, c := range cases {
+		err := c.req.Validate()
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: Validate() err = %v, wantErr: %v", c.name, err, c.wantErr)
+		}
+	}
+}
@@ -31,12 +31,14 @@ type AwsVpcCreateReq struct {
 	AccountID string `json:"account_id" validate:"required"`
 	Region    string `json:"region" validate:"required"`
 	Name      string `json:"name" validate:"required,min=1,max=60"`
-	IPv4Cidr  string `json:"ipv4_cidr" validate:"required,cidrv4"`
+	IPv4Cidr  string `json:"ipv4_cidr" validate:"omitempty,cidrv4"`
 	BkCloudID int64  `json:"bk_cloud_id" validate:"required,min=1"`
 
 	InstanceTenancy string `json:"instance_tenancy" validate:"required,oneof=default dedicated"`
 
 	Memo *string `json:"memo" validate:"omitempty"`
+
+	IpamOption `json:",inline"`
 }
 
 // Validate ...
@@ -49,5 +51,13 @@ func (req *AwsVpcCreateReq) Validate(bizRequired bool) error {
 		return errors.New("bk_biz_id is required")
 	}
 
+	// 设置地址池时网段可以为空，交付时从地址池分配
+	cidrFields := map[string]string{
+		"ipv4_cidr": req.IPv4Cidr,
+	}
+	if err := req.IpamOption.ValidateCidr(cidrFields); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -36,15 +36,17 @@ type AzureVpcCreateReq struct {
 	ResourceGroupName string `json:"resource_group_name" validate:"required,lowercase"`
 	Region            string `json:"region" validate:"required,lowercase"`
 	Name              string `json:"name" validate:"required,min=1,max=60,lowercase"`
-	IPv4Cidr          string `json:"ipv4_cidr" validate:"required,cidrv4"`
+	IPv4Cidr          string `json:"ipv4_cidr" validate:"omitempty,cidrv4"`
 	BkCloudID         int64  `json:"bk_cloud_id" validate:"required,min=1"`
 
 	Subnet struct {
 		Name     string `json:"name" validate:"required,min=1,max=60,lowercase"`
-		IPv4Cidr string `json:"ipv4_cidr" validate:"required,cidrv4"`
+		IPv4Cidr string `json:"ipv4_cidr" validate:"omitempty,cidrv4"`
 	} `json:"subnet" validate:"required"`
 
 	Memo *string `json:"memo" validate:"omitempty"`
+
+	IpamOption `json:",inline"`
 }
 
 // Validate ...
@@ -57,11 +59,24 @@ func (req *AzureVpcCreateReq) Validate(bizRequired bool) error {
 		return errors.New("bk_biz_id is required")
 	}
 
+	// 设置地址池时网段可以为空，交付时从地址池分配
+	cidrFields := map[string]string{
+		"ipv4_cidr":        req.IPv4Cidr,
+		"subnet.ipv4_cidr": req.Subnet.IPv4Cidr,
+	}
+	if err := req.IpamOption.ValidateCidr(cidrFields); err != nil {
+		return err
+	}
+
 	// region can be no space lowercase
 	if !assert.IsSameCaseNoSpaceString(req.Region) {
 		return errf.New(errf.InvalidParameter, "region can only be lowercase")
 	}
 
+	if req.IpamOption.IsIpamAllocate(req.IPv4Cidr) {
+		return nil
+	}
+
 	if err := cidr.IsSubnetContained(req.IPv4Cidr, req.Subnet.IPv4Cidr); err != nil {
 		return fmt.Errorf("is subnet contained failed, err: %v", err)
 	}
@@ -36,12 +36,14 @@ type GcpVpcCreateReq struct {
 
 	Subnet struct {
 		Name                  string `json:"name" validate:"required,min=1,max=60"`
-		IPv4Cidr              string `json:"ipv4_cidr" validate:"required,cidrv4"`
+		IPv4Cidr              string `json:"ipv4_cidr" validate:"omitempty,cidrv4"`
 		PrivateIPGoogleAccess *bool  `json:"private_ip_google_access"  validate:"required"`
 		EnableFlowLogs        *bool  `json:"enable_flow_logs"  validate:"required"`
 	} `json:"subnet" validate:"required"`
 
 	Memo *string `json:"memo" validate:"omitempty"`
+
+	IpamOption `json:",inline"`
 }
 
 // Validate ...
@@ -54,5 +56,13 @@ func (req *GcpVpcCreateReq) Validate(bizRequired bool) error {
 		return errors.New("bk_biz_id is required")
 	}
 
+	// 设置地址池时网段可以为空，交付时从地址池分配
+	cidrFields := map[string]string{
+		"subnet.ipv4_cidr": req.Subnet.IPv4Cidr,
+	}
+	if err := req.IpamOption.ValidateCidr(cidrFields); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -33,16 +33,18 @@ type TCloudVpcCreateReq struct {
 	AccountID string `json:"account_id" validate:"required"`
 	Region    string `json:"region" validate:"required"`
 	Name      string `json:"name" validate:"required,min=1,max=60"`
-	IPv4Cidr  string `json:"ipv4_cidr" validate:"required,cidrv4"`
+	IPv4Cidr  string `json:"ipv4_cidr" validate:"omitempty,cidrv4"`
 	BkCloudID int64  `json:"bk_cloud_id" validate:"required,min=1"`
 
 	Subnet struct {
 		Name     string `json:"name" validate:"required,min=1,max=60"`
-		IPv4Cidr string `json:"ipv4_cidr" validate:"required,cidrv4"`
+		IPv4Cidr string `json:"ipv4_cidr" validate:"omitempty,cidrv4"`
 		Zone     string `json:"zone" validate:"required"`
 	} `json:"subnet" validate:"required"`
 
 	Memo *string `json:"memo" validate:"omitempty"`
+
+	IpamOption `json:",inline"`
 }
 
 // Validate ...
@@ -55,6 +57,19 @@ func (req *TCloudVpcCreateReq) Validate(bizRequired bool) error {
 		return errors.New("bk_biz_id is required")
 	}
 
+	// 设置地址池时网段可以为空，交付时从地址池分配
+	cidrFields := map[string]string{
+		"ipv4_cidr":        req.IPv4Cidr,
+		"subnet.ipv4_cidr": req.Subnet.IPv4Cidr,
+	}
+	if err := req.IpamOption.ValidateCidr(cidrFields); err != nil {
+		return err
+	}
+
+	if req.IpamOption.IsIpamAllocate(req.IPv4Cidr) {
+		return nil
+	}
+
 	if err := cidr.IsSubnetContained(req.IPv4Cidr, req.Subnet.IPv4Cidr); err != nil {
 		return fmt.Errorf("is subnet contained failed, err: %v", err)
 	}
@@ -0,0 +1,58 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package csvpc
+
+import "errors"
+
+// IpamOption 从IP地址池自动分配网段的参数，设置地址池且未指定网段时，VPC网段在交付时从地址池中分配，
+// 子网网段为VPC网段中第一个满足掩码长度的网段
+type IpamOption struct {
+	IpamPoolID    string `json:"ipam_pool_id" validate:"omitempty"`
+	IpamMaskLen   int    `json:"ipam_mask_len" validate:"omitempty,min=8,max=29"`
+	SubnetMaskLen int    `json:"subnet_mask_len" validate:"omitempty,min=8,max=29"`
+	// IpamAllocationID 分配记录ID，由系统在交付时分配网段后填充，用于回填资源ID或创建失败时释放，不对外暴露
+	IpamAllocationID string `json:"-"`
+}
+
+// ValidateCidr 校验由地址池分配的字段，未设置地址池时字段必填，设置地址池时字段需要全部为空（交付时分配）或全部指定
+func (opt IpamOption) ValidateCidr(fields map[string]string) error {
+	emptyCount := 0
+	for name, value := range fields {
+		if len(value) != 0 {
+			continue
+		}
+
+		if len(opt.IpamPoolID) == 0 {
+			return errors.New(name + " is required when ipam_pool_id is not set")
+		}
+		emptyCount++
+	}
+
+	if emptyCount != 0 && emptyCount != len(fields) {
+		return errors.New("cidr fields should be all empty to allocate from ipam pool, or all specified")
+	}
+
+	return nil
+}
+
+// IsIpamAllocate 是否需要在交付时从地址池分配网段
+func (opt IpamOption) IsIpamAllocate(cidr string) bool {
+	return len(opt.IpamPoolID) != 0 && len(cidr) == 0
+}
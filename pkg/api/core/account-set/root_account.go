@@ -40,6 +40,26 @@ type BaseRootAccount struct {
 	core.Revision `json:",inline"`
 }
 
+// TCloudRootAccountExtension 云主账号/云二级账号扩展字段
+type TCloudRootAccountExtension struct {
+	CloudMainAccountID string `json:"cloud_main_account_id"`
+	CloudSubAccountID  string `json:"cloud_sub_account_id"`
+	CloudSecretID      string `json:"cloud_secret_id"`
+	CloudSecretKey     string `json:"cloud_secret_key,omitempty"`
+}
+
+// DecryptSecretKey ...
+func (e *TCloudRootAccountExtension) DecryptSecretKey(cipher cryptography.Crypto) error {
+	if e.CloudSecretKey != "" {
+		plainSecretKey, err := cipher.DecryptFromBase64(e.CloudSecretKey)
+		if err != nil {
+			return err
+		}
+		e.CloudSecretKey = plainSecretKey
+	}
+	return nil
+}
+
 // AwsRootAccountExtension 云主账号/云二级账号扩展字段
 type AwsRootAccountExtension struct {
 	CloudAccountID   string `json:"cloud_account_id"`
@@ -35,6 +35,21 @@ type AsyncFlow struct {
 	ShareData     *tableasync.ShareData `json:"share_data"`
 	Memo          string                `json:"memo"`
 	Worker        *string               `json:"worker"`
+	Compensate    *bool                 `json:"compensate"`
+	RunAt         string                `json:"run_at"`
+	core.Revision `json:",inline"`
+}
+
+// AsyncCronFlow ...
+type AsyncCronFlow struct {
+	ID            string                     `json:"id"`
+	Name          string                     `json:"name"`
+	Spec          string                     `json:"spec"`
+	Flow          *tableasync.CronFlowDefine `json:"flow"`
+	Enabled       *bool                      `json:"enabled"`
+	NextRunAt     string                     `json:"next_run_at"`
+	LastFlowID    string                     `json:"last_flow_id"`
+	Memo          *string                    `json:"memo"`
 	core.Revision `json:",inline"`
 }
 
@@ -48,8 +63,46 @@ type AsyncFlowTask struct {
 	Params        types.JsonField    `json:"params"`
 	Result        types.JsonField    `json:"result"`
 	Retry         *tableasync.Retry  `json:"retry"`
+	TimeoutSec    uint               `json:"timeout_sec"`
 	DependOn      types.StringArray  `json:"depend_on"`
 	State         enumor.TaskState   `json:"state"`
 	Reason        *tableasync.Reason `json:"reason"`
+	StartedAt     string             `json:"started_at"`
+	EndedAt       string             `json:"ended_at"`
 	core.Revision `json:",inline"`
 }
+
+// FlowDAG 任务流依赖关系图
+type FlowDAG struct {
+	Flow  AsyncFlow     `json:"flow"`
+	Nodes []FlowDAGNode `json:"nodes"`
+	Edges []FlowDAGEdge `json:"edges"`
+	// CriticalPath 关键路径，耗时之和最大的依赖链路上的任务ActionID，按执行顺序排列
+	CriticalPath []string `json:"critical_path"`
+	// CriticalPathDurationSec 关键路径上的任务耗时之和
+	CriticalPathDurationSec float64 `json:"critical_path_duration_sec"`
+	// SlowestTasks 耗时最长的任务，按耗时倒序排列
+	SlowestTasks []FlowDAGNode `json:"slowest_tasks"`
+	// Dot Graphviz DOT格式的依赖关系图
+	Dot string `json:"dot"`
+}
+
+// FlowDAGNode 任务流依赖关系图中的任务节点
+type FlowDAGNode struct {
+	TaskID     string            `json:"task_id"`
+	ActionID   string            `json:"action_id"`
+	ActionName enumor.ActionName `json:"action_name"`
+	State      enumor.TaskState  `json:"state"`
+	// StartedAt 任务首次开始运行的时间，未运行时为空
+	StartedAt string `json:"started_at"`
+	// EndedAt 任务运行结束的时间，未结束时为空
+	EndedAt string `json:"ended_at"`
+	// DurationSec 任务耗时，运行中的任务按当前时间计算
+	DurationSec float64 `json:"duration_sec"`
+}
+
+// FlowDAGEdge 任务流依赖关系图中的依赖关系，To 依赖 From
+type FlowDAGEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
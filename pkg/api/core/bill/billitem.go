@@ -27,26 +27,31 @@ import (
 
 	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/bssintl/v2/model"
 	"github.com/shopspring/decimal"
+	billing "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/billing/v20180709"
 )
 
 // BaseBillItem 存储分账后的明细
 type BaseBillItem struct {
-	ID             string              `json:"id,omitempty"`
-	RootAccountID  string              `json:"root_account_id"`
-	MainAccountID  string              `json:"main_account_id"`
-	Vendor         enumor.Vendor       `json:"vendor" validate:"required"`
-	ProductID      int64               `json:"product_id" validate:"omitempty"`
-	BkBizID        int64               `json:"bk_biz_id" validate:"omitempty"`
-	BillYear       int                 `json:"bill_year" validate:"required"`
-	BillMonth      int                 `json:"bill_month" validate:"required"`
-	BillDay        int                 `json:"bill_day" validate:"required"`
-	VersionID      int                 `json:"version_id" validate:"required"`
-	Currency       enumor.CurrencyCode `json:"currency" validate:"required"`
-	Cost           decimal.Decimal     `json:"cost" validate:"required"`
-	HcProductCode  string              `json:"hc_product_code,omitempty"`
-	HcProductName  string              `json:"hc_product_name,omitempty"`
-	ResAmount      decimal.Decimal     `json:"res_amount,omitempty"`
-	ResAmountUnit  string              `json:"res_amount_unit,omitempty"`
+	ID             string                   `json:"id,omitempty"`
+	RootAccountID  string                   `json:"root_account_id"`
+	MainAccountID  string                   `json:"main_account_id"`
+	Vendor         enumor.Vendor            `json:"vendor" validate:"required"`
+	ProductID      int64                    `json:"product_id" validate:"omitempty"`
+	BkBizID        int64                    `json:"bk_biz_id" validate:"omitempty"`
+	BillYear       int                      `json:"bill_year" validate:"required"`
+	BillMonth      int                      `json:"bill_month" validate:"required"`
+	BillDay        int                      `json:"bill_day" validate:"required"`
+	VersionID      int                      `json:"version_id" validate:"required"`
+	Currency       enumor.CurrencyCode      `json:"currency" validate:"required"`
+	Cost           decimal.Decimal          `json:"cost" validate:"required"`
+	HcProductCode  string                   `json:"hc_product_code,omitempty"`
+	HcProductName  string                   `json:"hc_product_name,omitempty"`
+	ResAmount      decimal.Decimal          `json:"res_amount,omitempty"`
+	ResAmountUnit  string                   `json:"res_amount_unit,omitempty"`
+	CloudResID     string                   `json:"cloud_res_id,omitempty"`
+	ResType        enumor.CloudResourceType `json:"res_type,omitempty"`
+	ResID          string                   `json:"res_id,omitempty"`
+	ResBkBizID     int64                    `json:"res_bk_biz_id,omitempty"`
 	*core.Revision `json:",inline"`
 }
 
@@ -97,10 +102,40 @@ type BillItemExtension interface {
 
 // TCloudBillItemExtension ...
 type TCloudBillItemExtension struct {
+	*billing.BillDetail `json:",inline"`
 }
 
-// AwsBillItemExtension ...
+// AwsBillItemExtension aws CUR账单字段，字段名与CUR列名一致
 type AwsBillItemExtension struct {
+	BillPayerAccountID            string `json:"bill_payer_account_id,omitempty"`
+	BillBillingPeriodStartDate    string `json:"bill_billing_period_start_date,omitempty"`
+	BillBillingPeriodEndDate      string `json:"bill_billing_period_end_date,omitempty"`
+	LineItemUsageAccountID        string `json:"line_item_usage_account_id,omitempty"`
+	LineItemLineItemType          string `json:"line_item_line_item_type,omitempty"`
+	LineItemUsageStartDate        string `json:"line_item_usage_start_date,omitempty"`
+	LineItemUsageEndDate          string `json:"line_item_usage_end_date,omitempty"`
+	LineItemProductCode           string `json:"line_item_product_code,omitempty"`
+	LineItemUsageType             string `json:"line_item_usage_type,omitempty"`
+	LineItemOperation             string `json:"line_item_operation,omitempty"`
+	LineItemResourceID            string `json:"line_item_resource_id,omitempty"`
+	LineItemUsageAmount           string `json:"line_item_usage_amount,omitempty"`
+	LineItemCurrencyCode          string `json:"line_item_currency_code,omitempty"`
+	LineItemUnblendedRate         string `json:"line_item_unblended_rate,omitempty"`
+	LineItemUnblendedCost         string `json:"line_item_unblended_cost,omitempty"`
+	LineItemBlendedRate           string `json:"line_item_blended_rate,omitempty"`
+	LineItemBlendedCost           string `json:"line_item_blended_cost,omitempty"`
+	LineItemLineItemDescription   string `json:"line_item_line_item_description,omitempty"`
+	ProductProductName            string `json:"product_product_name,omitempty"`
+	ProductRegion                 string `json:"product_region,omitempty"`
+	ProductInstanceType           string `json:"product_instance_type,omitempty"`
+	PricingUnit                   string `json:"pricing_unit,omitempty"`
+	PricingTerm                   string `json:"pricing_term,omitempty"`
+	ReservationReservationARN     string `json:"reservation_reservation_a_r_n,omitempty"`
+	SavingsPlanSavingsPlanARN     string `json:"savings_plan_savings_plan_a_r_n,omitempty"`
+	SavingsPlanSavingsPlanRate    string `json:"savings_plan_savings_plan_rate,omitempty"`
+	LineItemNetUnblendedCost      string `json:"line_item_net_unblended_cost,omitempty"`
+	LineItemNormalizationFactor   string `json:"line_item_normalization_factor,omitempty"`
+	LineItemNormalizedUsageAmount string `json:"line_item_normalized_usage_amount,omitempty"`
 }
 
 // HuaweiBillItemExtension ...
@@ -145,8 +180,39 @@ type GcpBillItemExtension struct {
 	*GcpRawBillItem `json:",inline"`
 }
 
-// AzureBillItemExtension ...
+// AzureBillItemExtension azure consumption usage detail，兼容legacy和modern两种类型
 type AzureBillItemExtension struct {
+	ID         string                            `json:"id,omitempty"`
+	Name       string                            `json:"name,omitempty"`
+	Kind       string                            `json:"kind,omitempty"`
+	Properties *AzureBillItemExtensionProperties `json:"properties,omitempty"`
+}
+
+// AzureBillItemExtensionProperties azure usage detail properties
+type AzureBillItemExtensionProperties struct {
+	BillingPeriodStartDate string           `json:"billingPeriodStartDate,omitempty"`
+	BillingPeriodEndDate   string           `json:"billingPeriodEndDate,omitempty"`
+	SubscriptionID         string           `json:"subscriptionId,omitempty"`
+	SubscriptionName       string           `json:"subscriptionName,omitempty"`
+	Date                   string           `json:"date,omitempty"`
+	ResourceGroup          string           `json:"resourceGroup,omitempty"`
+	ResourceID             string           `json:"resourceId,omitempty"`
+	ResourceLocation       string           `json:"resourceLocation,omitempty"`
+	ConsumedService        string           `json:"consumedService,omitempty"`
+	MeterID                string           `json:"meterId,omitempty"`
+	MeterCategory          string           `json:"meterCategory,omitempty"`
+	MeterSubCategory       string           `json:"meterSubCategory,omitempty"`
+	MeterName              string           `json:"meterName,omitempty"`
+	UnitOfMeasure          string           `json:"unitOfMeasure,omitempty"`
+	Quantity               *decimal.Decimal `json:"quantity,omitempty"`
+	EffectivePrice         *decimal.Decimal `json:"effectivePrice,omitempty"`
+	UnitPrice              *decimal.Decimal `json:"unitPrice,omitempty"`
+	// legacy
+	Cost            *decimal.Decimal `json:"cost,omitempty"`
+	BillingCurrency string           `json:"billingCurrency,omitempty"`
+	// modern
+	CostInBillingCurrency *decimal.Decimal `json:"costInBillingCurrency,omitempty"`
+	BillingCurrencyCode   string           `json:"billingCurrencyCode,omitempty"`
 }
 
 // KaopuBillItemExtension ...
@@ -156,3 +222,15 @@ type KaopuBillItemExtension struct {
 // ZenlayerBillItemExtension ...
 type ZenlayerBillItemExtension struct {
 }
+
+// ResourceCost 按云资源汇总的账单明细费用
+type ResourceCost struct {
+	Vendor     enumor.Vendor            `json:"vendor"`
+	CloudResID string                   `json:"cloud_res_id"`
+	ResType    enumor.CloudResourceType `json:"res_type"`
+	ResID      string                   `json:"res_id"`
+	ResBkBizID int64                    `json:"res_bk_biz_id"`
+	Currency   enumor.CurrencyCode      `json:"currency"`
+	Cost       decimal.Decimal          `json:"cost"`
+	ItemCount  uint64                   `json:"item_count"`
+}
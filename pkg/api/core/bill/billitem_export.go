@@ -0,0 +1,221 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bill
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/tools/export"
+)
+
+// billItemExportBaseHeader 账单明细导出的公共列
+var billItemExportBaseHeader = []string{
+	"id", "root_account_id", "main_account_id", "vendor", "product_id", "bk_biz_id", "bill_year", "bill_month",
+	"bill_day", "version_id", "currency", "cost", "hc_product_code", "hc_product_name", "res_amount",
+	"res_amount_unit", "cloud_res_id", "res_type", "res_id", "res_bk_biz_id",
+}
+
+// billItemExtensionTypes 各云厂商账单明细扩展字段结构
+var billItemExtensionTypes = map[enumor.Vendor]reflect.Type{
+	enumor.TCloud:   reflect.TypeOf(TCloudBillItemExtension{}),
+	enumor.Aws:      reflect.TypeOf(AwsBillItemExtension{}),
+	enumor.HuaWei:   reflect.TypeOf(HuaweiBillItemExtension{}),
+	enumor.Gcp:      reflect.TypeOf(GcpBillItemExtension{}),
+	enumor.Azure:    reflect.TypeOf(AzureBillItemExtension{}),
+	enumor.Kaopu:    reflect.TypeOf(KaopuBillItemExtension{}),
+	enumor.Zenlayer: reflect.TypeOf(ZenlayerBillItemExtension{}),
+}
+
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// BillItemExporter 将账单明细转换为导出行，扩展字段按云厂商扩展结构展开为独立的列，嵌套字段以点号连接
+type BillItemExporter struct {
+	extColumns []string
+}
+
+// NewBillItemExporter ...
+func NewBillItemExporter(vendor enumor.Vendor) (*BillItemExporter, error) {
+	extType, ok := billItemExtensionTypes[vendor]
+	if !ok {
+		return nil, fmt.Errorf("unsupported bill item export vendor: %s", vendor)
+	}
+	return &BillItemExporter{extColumns: flattenExtensionColumns(extType, "")}, nil
+}
+
+// Header 返回导出表头，公共列在前，扩展列以extension.为前缀
+func (e *BillItemExporter) Header() []string {
+	header := make([]string, 0, len(billItemExportBaseHeader)+len(e.extColumns))
+	header = append(header, billItemExportBaseHeader...)
+	for _, col := range e.extColumns {
+		header = append(header, "extension."+col)
+	}
+	return header
+}
+
+// Row 返回账单明细对应的导出行，与Header一一对应
+func (e *BillItemExporter) Row(item *BillItemRaw) ([]string, error) {
+	row := make([]string, 0, len(billItemExportBaseHeader)+len(e.extColumns))
+	base := item.BaseBillItem
+	row = append(row, base.ID, base.RootAccountID, base.MainAccountID, string(base.Vendor),
+		strconv.FormatInt(base.ProductID, 10), strconv.FormatInt(base.BkBizID, 10),
+		strconv.Itoa(base.BillYear), strconv.Itoa(base.BillMonth), strconv.Itoa(base.BillDay),
+		strconv.Itoa(base.VersionID), string(base.Currency), base.Cost.String(), base.HcProductCode,
+		base.HcProductName, base.ResAmount.String(), base.ResAmountUnit, base.CloudResID, string(base.ResType),
+		base.ResID, strconv.FormatInt(base.ResBkBizID, 10))
+
+	if len(e.extColumns) == 0 {
+		return row, nil
+	}
+	ext := make(map[string]interface{})
+	if len(item.Extension) != 0 {
+		decoder := json.NewDecoder(bytes.NewReader(item.Extension))
+		decoder.UseNumber()
+		if err := decoder.Decode(&ext); err != nil {
+			return nil, fmt.Errorf("decode bill item %s extension failed, err: %v", base.ID, err)
+		}
+	}
+	for _, col := range e.extColumns {
+		value, err := formatExtensionValue(lookupExtensionValue(ext, col))
+		if err != nil {
+			return nil, fmt.Errorf("format bill item %s extension %s failed, err: %v", base.ID, col, err)
+		}
+		row = append(row, value)
+	}
+	return row, nil
+}
+
+// BillItemRawLister 分页查询账单明细
+type BillItemRawLister func(page *core.BasePage) ([]*BillItemRaw, error)
+
+// Export 按id排序分页查询账单明细并逐行写出，不会在内存中保留全部数据。
+// w需要以Header创建，limit为0时导出全部数据，返回写出的行数。写出完成后由调用方关闭Writer
+func (e *BillItemExporter) Export(w export.Writer, limit uint64, lister BillItemRawLister) (uint64, error) {
+	count := uint64(0)
+	for limit == 0 || count < limit {
+		page := &core.BasePage{
+			Start: uint32(count),
+			Limit: core.DefaultMaxPageLimit,
+			Sort:  "id",
+			Order: core.Ascending,
+		}
+		if limit != 0 && limit-count < uint64(page.Limit) {
+			page.Limit = uint(limit - count)
+		}
+		items, err := lister(page)
+		if err != nil {
+			return count, err
+		}
+		for _, item := range items {
+			row, err := e.Row(item)
+			if err != nil {
+				return count, err
+			}
+			if err := w.Write(row); err != nil {
+				return count, err
+			}
+			count++
+		}
+		if uint(len(items)) < page.Limit {
+			break
+		}
+	}
+	return count, nil
+}
+
+// flattenExtensionColumns 按json序列化规则展开结构体字段，匿名嵌入的结构体字段提升到上一层
+func flattenExtensionColumns(t reflect.Type, prefix string) []string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	columns := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		isStruct := fieldType.Kind() == reflect.Struct && !reflect.PointerTo(fieldType).Implements(jsonMarshalerType)
+		if field.Anonymous && name == "" && isStruct {
+			columns = append(columns, flattenExtensionColumns(fieldType, prefix)...)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if isStruct {
+			columns = append(columns, flattenExtensionColumns(fieldType, prefix+name+".")...)
+			continue
+		}
+		columns = append(columns, prefix+name)
+	}
+	return columns
+}
+
+func lookupExtensionValue(ext map[string]interface{}, column string) interface{} {
+	var value interface{} = ext
+	for _, key := range strings.Split(column, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[key]
+	}
+	return value
+}
+
+// formatExtensionValue 基础类型直接转为字符串，数组、对象等复合类型保留json格式
+func formatExtensionValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+}
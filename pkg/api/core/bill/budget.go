@@ -0,0 +1,161 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bill
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+
+	"github.com/shopspring/decimal"
+)
+
+// Budget 月度预算
+type Budget struct {
+	ID            string                 `json:"id"`
+	Name          string                 `json:"name"`
+	Scope         enumor.BillBudgetScope `json:"scope"`
+	ScopeID       string                 `json:"scope_id"`
+	Amount        decimal.Decimal        `json:"amount"`
+	Thresholds    []BudgetThreshold      `json:"thresholds"`
+	Receivers     []string               `json:"receivers"`
+	WebhookUrl    *string                `json:"webhook_url"`
+	Memo          *string                `json:"memo"`
+	core.Revision `json:",inline"`
+}
+
+// BudgetThreshold 预算告警阈值
+type BudgetThreshold struct {
+	// Type 按实际费用或预测费用告警
+	Type enumor.BillBudgetAlertType `json:"type" validate:"required"`
+	// Percent 费用占预算金额的百分比，如50、80、100
+	Percent int `json:"percent" validate:"min=1,max=1000"`
+}
+
+// BudgetAlert 预算告警记录
+type BudgetAlert struct {
+	ID          string                       `json:"id"`
+	BudgetID    string                       `json:"budget_id"`
+	BillYear    int                          `json:"bill_year"`
+	BillMonth   int                          `json:"bill_month"`
+	AlertType   enumor.BillBudgetAlertType   `json:"alert_type"`
+	Threshold   int                          `json:"threshold"`
+	Amount      decimal.Decimal              `json:"amount"`
+	Cost        decimal.Decimal              `json:"cost"`
+	NotifyState enumor.BillBudgetNotifyState `json:"notify_state"`
+	NotifyCount int                          `json:"notify_count"`
+	Creator     string                       `json:"creator"`
+	CreatedAt   string                       `json:"created_at"`
+	UpdatedAt   string                       `json:"updated_at"`
+}
+
+// ValidateBudgetScope validate scope and scope id of budget
+func ValidateBudgetScope(scope enumor.BillBudgetScope, scopeID string) error {
+	if err := scope.Validate(); err != nil {
+		return err
+	}
+	if len(scopeID) == 0 {
+		return errors.New("scope_id is required")
+	}
+
+	switch scope {
+	case enumor.BillBudgetScopeBiz, enumor.BillBudgetScopeProduct:
+		id, err := strconv.ParseInt(scopeID, 10, 64)
+		if err != nil || id <= 0 {
+			return fmt.Errorf("scope_id of %s should be positive integer, but got %s", scope, scopeID)
+		}
+	}
+	return nil
+}
+
+// ValidateBudgetThresholds validate thresholds of budget
+func ValidateBudgetThresholds(thresholds []BudgetThreshold) error {
+	if len(thresholds) == 0 {
+		return errors.New("thresholds is required")
+	}
+	if len(thresholds) > 20 {
+		return errors.New("thresholds should <= 20")
+	}
+
+	existed := make(map[BudgetThreshold]struct{}, len(thresholds))
+	for idx, threshold := range thresholds {
+		if err := validator.Validate.Struct(threshold); err != nil {
+			return fmt.Errorf("thresholds[%d] is invalid, err: %v", idx, err)
+		}
+		if err := threshold.Type.Validate(); err != nil {
+			return fmt.Errorf("thresholds[%d] is invalid, err: %v", idx, err)
+		}
+		if _, ok := existed[threshold]; ok {
+			return fmt.Errorf("thresholds[%d] is duplicated", idx)
+		}
+		existed[threshold] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateBudgetWebhookUrl validate webhook url of budget, only http(s) url is allowed and the host can not be
+// an internal ip address, domain host will be checked again when calling the webhook
+func ValidateBudgetWebhookUrl(webhookUrl string) error {
+	if len(webhookUrl) == 0 {
+		return nil
+	}
+
+	u, err := url.Parse(webhookUrl)
+	if err != nil {
+		return fmt.Errorf("webhook_url is invalid, err: %v", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("webhook_url scheme should be http or https, but got %s", u.Scheme)
+	}
+	host := u.Hostname()
+	if len(host) == 0 {
+		return errors.New("webhook_url host is required")
+	}
+	if len(u.User.String()) != 0 {
+		return errors.New("webhook_url should not contain user info")
+	}
+	if strings.EqualFold(host, "localhost") {
+		return errors.New("webhook_url host can not be localhost")
+	}
+	if ip := net.ParseIP(host); ip != nil && !IsBudgetWebhookIPAllowed(ip) {
+		return fmt.Errorf("webhook_url host %s is not a public ip address", host)
+	}
+	return nil
+}
+
+// IsBudgetWebhookIPAllowed 回调地址只允许访问公网地址，禁止环回、私有、链路本地、组播及未指定地址
+func IsBudgetWebhookIPAllowed(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return false
+	}
+	// 100.64.0.0/10 运营商级NAT地址同样属于内部网络
+	if ip4 := ip.To4(); ip4 != nil && ip4[0] == 100 && ip4[1]&0xc0 == 64 {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,130 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bill
+
+import (
+	"errors"
+	"fmt"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+
+	"github.com/shopspring/decimal"
+)
+
+// SplitRule 共享账号分账规则
+type SplitRule struct {
+	ID            string               `json:"id"`
+	Name          string               `json:"name"`
+	Vendor        enumor.Vendor        `json:"vendor"`
+	RootAccountID string               `json:"root_account_id"`
+	MainAccountID string               `json:"main_account_id"`
+	Priority      int64                `json:"priority"`
+	SplitType     enumor.BillSplitType `json:"split_type"`
+	Match         *SplitRuleMatch      `json:"match"`
+	Targets       []SplitRuleTarget    `json:"targets"`
+	Memo          *string              `json:"memo"`
+	core.Revision `json:",inline"`
+}
+
+// SplitRuleMatch 分账规则匹配条件，各条件之间为与关系，同一条件内多个取值为或关系，空条件表示不限制
+type SplitRuleMatch struct {
+	ProductCodes []string          `json:"product_codes,omitempty" validate:"omitempty,max=100"`
+	ResourceIDs  []string          `json:"resource_ids,omitempty" validate:"omitempty,max=500"`
+	Regions      []string          `json:"regions,omitempty" validate:"omitempty,max=100"`
+	Tags         map[string]string `json:"tags,omitempty" validate:"omitempty,max=20"`
+}
+
+// Validate SplitRuleMatch
+func (m *SplitRuleMatch) Validate() error {
+	return validator.Validate.Struct(m)
+}
+
+// SplitRuleTarget 分摊目标
+type SplitRuleTarget struct {
+	// ProductID 运营产品ID，为0时使用二级账号的运营产品
+	ProductID int64 `json:"product_id,omitempty" validate:"omitempty,min=0"`
+	// BkBizID 业务ID，为0时使用二级账号的业务
+	BkBizID int64 `json:"bk_biz_id,omitempty" validate:"omitempty,min=0"`
+	// Ratio 分摊比例，仅fixed_ratio方式使用，所有目标的比例之和须为1
+	Ratio *decimal.Decimal `json:"ratio,omitempty"`
+	// ResourceIDs 目标占用的云资源ID，仅usage方式使用
+	ResourceIDs []string `json:"resource_ids,omitempty" validate:"omitempty,max=500"`
+}
+
+// ValidateSplitRuleTargets validate targets of split rule by split type
+func ValidateSplitRuleTargets(splitType enumor.BillSplitType, targets []SplitRuleTarget) error {
+	if err := splitType.Validate(); err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		return errors.New("targets is required")
+	}
+	if len(targets) > 100 {
+		return errors.New("targets should <= 100")
+	}
+
+	sum := decimal.Zero
+	for idx, target := range targets {
+		if err := validator.Validate.Struct(target); err != nil {
+			return fmt.Errorf("targets[%d] is invalid, err: %v", idx, err)
+		}
+		if target.ProductID == 0 && target.BkBizID == 0 {
+			return fmt.Errorf("targets[%d] product_id or bk_biz_id is required", idx)
+		}
+
+		switch splitType {
+		case enumor.BillSplitFixedRatio:
+			if target.Ratio == nil || !target.Ratio.IsPositive() {
+				return fmt.Errorf("targets[%d] ratio should be positive", idx)
+			}
+			sum = sum.Add(*target.Ratio)
+		case enumor.BillSplitCvmCount:
+			if target.BkBizID <= 0 {
+				return fmt.Errorf("targets[%d] bk_biz_id is required by %s", idx, splitType)
+			}
+		case enumor.BillSplitUsage:
+			if len(target.ResourceIDs) == 0 {
+				return fmt.Errorf("targets[%d] resource_ids is required by %s", idx, splitType)
+			}
+		}
+	}
+
+	if splitType == enumor.BillSplitFixedRatio && !sum.Equal(decimal.NewFromInt(1)) {
+		return fmt.Errorf("sum of targets ratio should be 1, but got %s", sum.String())
+	}
+
+	return nil
+}
+
+// SplitSnapshot 共享账号按天分账时使用的权重快照
+type SplitSnapshot struct {
+	ID            string        `json:"id"`
+	RootAccountID string        `json:"root_account_id"`
+	MainAccountID string        `json:"main_account_id"`
+	Vendor        enumor.Vendor `json:"vendor"`
+	BillYear      int           `json:"bill_year"`
+	BillMonth     int           `json:"bill_month"`
+	BillDay       int           `json:"bill_day"`
+	// CvmCounts 按主机数量分摊时各业务、地域的主机数量，key为业务ID及地域
+	CvmCounts     map[string]int64 `json:"cvm_counts"`
+	core.Revision `json:",inline"`
+}
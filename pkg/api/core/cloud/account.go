@@ -157,3 +157,45 @@ func (e *AzureAccountExtension) DecryptSecretKey(cipher cryptography.Crypto) err
 
 	return nil
 }
+
+// ZenlayerAccountExtension define zenlayer account extension.
+type ZenlayerAccountExtension struct {
+	CloudMainAccountID string `json:"cloud_main_account_id"`
+	CloudSubAccountID  string `json:"cloud_sub_account_id"`
+	CloudSecretID      string `json:"cloud_secret_id"`
+	CloudSecretKey     string `json:"cloud_secret_key,omitempty"`
+}
+
+// DecryptSecretKey ...
+func (e *ZenlayerAccountExtension) DecryptSecretKey(cipher cryptography.Crypto) error {
+	if e.CloudSecretKey != "" {
+		plainSecretKey, err := cipher.DecryptFromBase64(e.CloudSecretKey)
+		if err != nil {
+			return err
+		}
+		e.CloudSecretKey = plainSecretKey
+	}
+
+	return nil
+}
+
+// KaopuAccountExtension define kaopu account extension.
+type KaopuAccountExtension struct {
+	CloudMainAccountID string `json:"cloud_main_account_id"`
+	CloudSubAccountID  string `json:"cloud_sub_account_id"`
+	CloudSecretID      string `json:"cloud_secret_id"`
+	CloudSecretKey     string `json:"cloud_secret_key,omitempty"`
+}
+
+// DecryptSecretKey ...
+func (e *KaopuAccountExtension) DecryptSecretKey(cipher cryptography.Crypto) error {
+	if e.CloudSecretKey != "" {
+		plainSecretKey, err := cipher.DecryptFromBase64(e.CloudSecretKey)
+		if err != nil {
+			return err
+		}
+		e.CloudSecretKey = plainSecretKey
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bucket
+
+import "hcm/pkg/criteria/enumor"
+
+// AwsBucketExtension aws bucket extension.
+type AwsBucketExtension struct {
+	// 阻止公共访问配置，未配置时为空
+	BlockPublicAcls       *bool `json:"block_public_acls,omitempty"`
+	IgnorePublicAcls      *bool `json:"ignore_public_acls,omitempty"`
+	BlockPublicPolicy     *bool `json:"block_public_policy,omitempty"`
+	RestrictPublicBuckets *bool `json:"restrict_public_buckets,omitempty"`
+	// ACLPublicAccess 存储桶ACL授予所有用户的权限
+	ACLPublicAccess enumor.BucketPublicAccess `json:"acl_public_access"`
+	// PolicyIsPublic 存储桶策略是否公开
+	PolicyIsPublic   bool    `json:"policy_is_public"`
+	KMSMasterKeyID   *string `json:"kms_master_key_id,omitempty"`
+	BucketKeyEnabled *bool   `json:"bucket_key_enabled,omitempty"`
+}
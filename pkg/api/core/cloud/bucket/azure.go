@@ -0,0 +1,32 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bucket
+
+// AzureBucketExtension azure bucket extension.
+type AzureBucketExtension struct {
+	ResourceGroupName  string `json:"resource_group_name"`
+	StorageAccountName string `json:"storage_account_name"`
+	// ContainerPublicAccess 容器的公共访问级别，None、Blob、Container
+	ContainerPublicAccess string `json:"container_public_access,omitempty"`
+	// AllowBlobPublicAccess 存储账户是否允许Blob公共访问，不允许时容器的公共访问级别不生效
+	AllowBlobPublicAccess  bool    `json:"allow_blob_public_access"`
+	DefaultEncryptionScope *string `json:"default_encryption_scope,omitempty"`
+	SKUName                *string `json:"sku_name,omitempty"`
+}
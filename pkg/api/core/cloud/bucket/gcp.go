@@ -0,0 +1,32 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bucket
+
+// GcpBucketExtension gcp bucket extension.
+type GcpBucketExtension struct {
+	SelfLink     string `json:"self_link,omitempty"`
+	LocationType string `json:"location_type,omitempty"`
+	// PublicAccessPrevention 阻止公共访问，enforced 表示强制阻止
+	PublicAccessPrevention   string `json:"public_access_prevention,omitempty"`
+	UniformBucketLevelAccess bool   `json:"uniform_bucket_level_access"`
+	// PublicRoles IAM策略中授予所有用户的角色
+	PublicRoles       []string `json:"public_roles,omitempty"`
+	DefaultKmsKeyName string   `json:"default_kms_key_name,omitempty"`
+}
@@ -0,0 +1,32 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bucket
+
+import "hcm/pkg/criteria/enumor"
+
+// HuaWeiBucketExtension huawei bucket extension.
+type HuaWeiBucketExtension struct {
+	BucketType string `json:"bucket_type,omitempty"`
+	// ACLPublicAccess 存储桶ACL授予所有用户的权限
+	ACLPublicAccess enumor.BucketPublicAccess `json:"acl_public_access"`
+	// PolicyPublicAccess 存储桶策略授予匿名用户的权限
+	PolicyPublicAccess enumor.BucketPublicAccess `json:"policy_public_access"`
+	KMSMasterKeyID     string                    `json:"kms_master_key_id,omitempty"`
+}
@@ -0,0 +1,34 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package cvm
+
+// KaopuCvmExtension cvm extension.
+type KaopuCvmExtension struct {
+	// InstanceType 实例规格。
+	InstanceType string `json:"instance_type,omitempty"`
+	Cpu          int64  `json:"cpu,omitempty"`
+	// Memory 内存大小，单位MB。
+	Memory int64 `json:"memory,omitempty"`
+	// ChargeType 计费模式。(PrePaid：包年包月、PostPaid：按量计费)
+	ChargeType            string   `json:"charge_type,omitempty"`
+	CloudSecurityGroupIDs []string `json:"cloud_security_group_ids,omitempty"`
+	// Bandwidth 公网带宽，单位Mbps。
+	Bandwidth int64 `json:"bandwidth,omitempty"`
+}
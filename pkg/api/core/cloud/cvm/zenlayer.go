@@ -0,0 +1,36 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package cvm
+
+// ZenlayerCvmExtension cvm extension.
+type ZenlayerCvmExtension struct {
+	// InstanceType 实例规格。
+	InstanceType string `json:"instance_type,omitempty"`
+	Cpu          int64  `json:"cpu,omitempty"`
+	// Memory 内存大小，单位GB。
+	Memory int64 `json:"memory,omitempty"`
+	// InstanceChargeType 实例计费模式。(PREPAID：包年包月、POSTPAID：按量计费)
+	InstanceChargeType    string   `json:"instance_charge_type,omitempty"`
+	CloudSystemDiskID     string   `json:"cloud_system_disk_id,omitempty"`
+	CloudDataDiskIDs      []string `json:"cloud_data_disk_ids,omitempty"`
+	CloudSecurityGroupIDs []string `json:"cloud_security_group_ids,omitempty"`
+	// InternetMaxBandwidthOut 公网出带宽上限，单位Mbps。
+	InternetMaxBandwidthOut int64 `json:"internet_max_bandwidth_out,omitempty"`
+}
@@ -0,0 +1,78 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package dbinstance ...
+package dbinstance
+
+import (
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/enumor"
+)
+
+// BaseDatabaseInstance define base database instance.
+type BaseDatabaseInstance struct {
+	ID               string        `json:"id"`
+	Vendor           enumor.Vendor `json:"vendor"`
+	AccountID        string        `json:"account_id"`
+	CloudID          string        `json:"cloud_id"`
+	BkBizID          int64         `json:"bk_biz_id"`
+	Name             string        `json:"name"`
+	Region           string        `json:"region"`
+	Zone             string        `json:"zone"`
+	Status           string        `json:"status"`
+	Engine           string        `json:"engine"`
+	EngineVersion    string        `json:"engine_version"`
+	InstanceClass    string        `json:"instance_class"`
+	StorageSize      int64         `json:"storage_size"`
+	ChargeType       string        `json:"charge_type"`
+	VpcID            string        `json:"vpc_id"`
+	CloudVpcID       string        `json:"cloud_vpc_id"`
+	SubnetID         string        `json:"subnet_id"`
+	CloudSubnetID    string        `json:"cloud_subnet_id"`
+	PrivateAddress   string        `json:"private_address"`
+	PublicAddress    string        `json:"public_address"`
+	Port             int64         `json:"port"`
+	CloudCreatedTime string        `json:"cloud_created_time"`
+	CloudExpiredTime string        `json:"cloud_expired_time"`
+	RecycleStatus    string        `json:"recycle_status,omitempty"`
+	Memo             *string       `json:"memo"`
+	*core.Revision   `json:",inline"`
+}
+
+// DatabaseInstance define database instance with extension.
+type DatabaseInstance[Ext Extension] struct {
+	BaseDatabaseInstance `json:",inline"`
+	Extension            *Ext `json:"extension"`
+}
+
+// GetID ...
+func (d DatabaseInstance[T]) GetID() string {
+	return d.BaseDatabaseInstance.ID
+}
+
+// GetCloudID ...
+func (d DatabaseInstance[T]) GetCloudID() string {
+	return d.BaseDatabaseInstance.CloudID
+}
+
+// Extension database instance extension.
+type Extension interface {
+	TCloudDBInstanceExtension | AwsDBInstanceExtension | AzureDBInstanceExtension | GcpDBInstanceExtension |
+		HuaWeiDBInstanceExtension
+}
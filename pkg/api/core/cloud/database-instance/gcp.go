@@ -0,0 +1,31 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dbinstance
+
+// GcpDBInstanceExtension gcp database instance extension.
+type GcpDBInstanceExtension struct {
+	// InstanceName 实例名，调用 Cloud SQL 接口操作实例时使用
+	InstanceName     string `json:"instance_name"`
+	SelfLink         string `json:"self_link,omitempty"`
+	AvailabilityType string `json:"availability_type,omitempty"`
+	BackendType      string `json:"backend_type,omitempty"`
+	// CloudVpcSelfLink 私有IP所在VPC的selfLink
+	CloudVpcSelfLink string `json:"cloud_vpc_self_link,omitempty"`
+}
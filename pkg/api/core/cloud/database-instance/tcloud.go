@@ -0,0 +1,32 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package dbinstance
+
+// TCloudDBInstanceExtension tcloud database instance extension.
+type TCloudDBInstanceExtension struct {
+	// InstanceType 实例类型，1-主实例，2-灾备实例，3-只读实例
+	InstanceType *int64  `json:"instance_type,omitempty"`
+	ProjectID    *int64  `json:"project_id,omitempty"`
+	DeviceType   *string `json:"device_type,omitempty"`
+	EngineType   *string `json:"engine_type,omitempty"`
+	Cpu          *int64  `json:"cpu,omitempty"`
+	// Memory 内存大小，单位MB
+	Memory *int64 `json:"memory,omitempty"`
+}
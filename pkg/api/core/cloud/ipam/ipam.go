@@ -0,0 +1,61 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package ipam ...
+package ipam
+
+import (
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/enumor"
+)
+
+// Pool define ipam address pool.
+type Pool struct {
+	ID     string        `json:"id"`
+	Name   string        `json:"name"`
+	Vendor enumor.Vendor `json:"vendor"`
+	// Region 地址池所属地域，全局 vpc 的地址池地域为空
+	Region         string  `json:"region"`
+	BkBizID        int64   `json:"bk_biz_id"`
+	Cidr           string  `json:"cidr"`
+	Memo           *string `json:"memo"`
+	*core.Revision `json:",inline"`
+}
+
+// GetID ...
+func (p Pool) GetID() string {
+	return p.ID
+}
+
+// Allocation define cidr allocated from ipam pool.
+type Allocation struct {
+	ID      string                   `json:"id"`
+	PoolID  string                   `json:"pool_id"`
+	Cidr    string                   `json:"cidr"`
+	ResType enumor.CloudResourceType `json:"res_type"`
+	// ResID 使用该网段的资源ID，为空表示网段已预留，资源还未创建
+	ResID          string  `json:"res_id"`
+	Memo           *string `json:"memo"`
+	*core.Revision `json:",inline"`
+}
+
+// GetID ...
+func (a Allocation) GetID() string {
+	return a.ID
+}
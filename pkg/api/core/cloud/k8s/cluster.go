@@ -0,0 +1,114 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package k8s ...
+package k8s
+
+import (
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/enumor"
+)
+
+// BaseK8sCluster define base k8s cluster.
+type BaseK8sCluster struct {
+	ID               string        `json:"id"`
+	Vendor           enumor.Vendor `json:"vendor"`
+	AccountID        string        `json:"account_id"`
+	CloudID          string        `json:"cloud_id"`
+	BkBizID          int64         `json:"bk_biz_id"`
+	Name             string        `json:"name"`
+	Region           string        `json:"region"`
+	Status           string        `json:"status"`
+	Version          string        `json:"version"`
+	CloudVpcID       string        `json:"cloud_vpc_id"`
+	CloudCreatedTime string        `json:"cloud_created_time"`
+	Memo             *string       `json:"memo"`
+	*core.Revision   `json:",inline"`
+}
+
+// K8sCluster define k8s cluster with extension.
+type K8sCluster[Ext Extension] struct {
+	BaseK8sCluster `json:",inline"`
+	Extension      *Ext `json:"extension"`
+}
+
+// GetID ...
+func (b K8sCluster[T]) GetID() string {
+	return b.BaseK8sCluster.ID
+}
+
+// GetCloudID ...
+func (b K8sCluster[T]) GetCloudID() string {
+	return b.BaseK8sCluster.CloudID
+}
+
+// Extension k8s cluster extension.
+type Extension interface {
+	TCloudK8sClusterExtension | AwsK8sClusterExtension | AzureK8sClusterExtension | GcpK8sClusterExtension |
+		HuaWeiK8sClusterExtension
+}
+
+// TCloudK8sClusterExtension tcloud tke cluster extension.
+type TCloudK8sClusterExtension struct {
+	// ClusterType 集群类型，MANAGED_CLUSTER-托管集群，INDEPENDENT_CLUSTER-独立集群
+	ClusterType      string `json:"cluster_type,omitempty"`
+	ClusterOs        string `json:"cluster_os,omitempty"`
+	ContainerRuntime string `json:"container_runtime,omitempty"`
+	ClusterCIDR      string `json:"cluster_cidr,omitempty"`
+	ProjectID        int64  `json:"project_id"`
+}
+
+// AwsK8sClusterExtension aws eks cluster extension.
+type AwsK8sClusterExtension struct {
+	Arn                   string   `json:"arn,omitempty"`
+	PlatformVersion       string   `json:"platform_version,omitempty"`
+	RoleArn               string   `json:"role_arn,omitempty"`
+	Endpoint              string   `json:"endpoint,omitempty"`
+	CloudSubnetIDs        []string `json:"cloud_subnet_ids,omitempty"`
+	CloudSecurityGroupIDs []string `json:"cloud_security_group_ids,omitempty"`
+}
+
+// AzureK8sClusterExtension azure aks cluster extension.
+type AzureK8sClusterExtension struct {
+	ResourceGroupName string `json:"resource_group_name,omitempty"`
+	DnsPrefix         string `json:"dns_prefix,omitempty"`
+	Fqdn              string `json:"fqdn,omitempty"`
+	// NodeResourceGroup 存放集群节点（虚拟机规模集）的资源组
+	NodeResourceGroup string `json:"node_resource_group,omitempty"`
+	NetworkPlugin     string `json:"network_plugin,omitempty"`
+}
+
+// GcpK8sClusterExtension gcp gke cluster extension.
+type GcpK8sClusterExtension struct {
+	SelfLink string `json:"self_link,omitempty"`
+	// Location 区域集群为地域，可用区集群为可用区
+	Location        string `json:"location,omitempty"`
+	Endpoint        string `json:"endpoint,omitempty"`
+	Subnetwork      string `json:"subnetwork,omitempty"`
+	ClusterIpv4Cidr string `json:"cluster_ipv4_cidr,omitempty"`
+}
+
+// HuaWeiK8sClusterExtension huawei cce cluster extension.
+type HuaWeiK8sClusterExtension struct {
+	// ClusterType 集群类型，VirtualMachine-CCE集群，ARM64-鲲鹏集群
+	ClusterType          string `json:"cluster_type,omitempty"`
+	Flavor               string `json:"flavor,omitempty"`
+	CloudSubnetID        string `json:"cloud_subnet_id,omitempty"`
+	ContainerNetworkMode string `json:"container_network_mode,omitempty"`
+}
@@ -0,0 +1,63 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package k8s
+
+import (
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/enumor"
+)
+
+// K8sNodePool define k8s node pool.
+type K8sNodePool struct {
+	ID             string        `json:"id"`
+	Vendor         enumor.Vendor `json:"vendor"`
+	AccountID      string        `json:"account_id"`
+	ClusterID      string        `json:"cluster_id"`
+	CloudClusterID string        `json:"cloud_cluster_id"`
+	CloudID        string        `json:"cloud_id"`
+	Name           string        `json:"name"`
+	Region         string        `json:"region"`
+	Status         string        `json:"status"`
+	InstanceType   string        `json:"instance_type"`
+	NodeCount      int64         `json:"node_count"`
+	MinSize        int64         `json:"min_size"`
+	MaxSize        int64         `json:"max_size"`
+	*core.Revision `json:",inline"`
+}
+
+// GetID ...
+func (p K8sNodePool) GetID() string {
+	return p.ID
+}
+
+// GetCloudID ...
+func (p K8sNodePool) GetCloudID() string {
+	return p.CloudID
+}
+
+// K8sNodePoolCvmRel define k8s node pool cvm rel.
+type K8sNodePoolCvmRel struct {
+	ID         uint64 `json:"id"`
+	ClusterID  string `json:"cluster_id"`
+	NodePoolID string `json:"node_pool_id"`
+	CvmID      string `json:"cvm_id"`
+	Creator    string `json:"creator"`
+	CreatedAt  string `json:"created_at"`
+}
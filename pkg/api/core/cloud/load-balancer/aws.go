@@ -0,0 +1,85 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package loadbalancer
+
+// AwsLoadBalancer ...
+type AwsLoadBalancer = LoadBalancer[AwsLoadBalancerExtension]
+
+// AwsLoadBalancerExtension aws 负载均衡(ALB/NLB)拓展
+type AwsLoadBalancerExtension struct {
+	// Type 负载均衡类型: application、network、gateway
+	Type *string `json:"type,omitempty"`
+	// Scheme 网络类型: internet-facing、internal
+	Scheme *string `json:"scheme,omitempty"`
+	// CanonicalHostedZoneID 负载均衡DNS所在的Route53托管区域ID
+	CanonicalHostedZoneID *string `json:"canonical_hosted_zone_id,omitempty"`
+	// CloudSubnetIDs 负载均衡所在的子网，aws 负载均衡可以跨多个可用区的子网
+	CloudSubnetIDs []string `json:"cloud_subnet_ids,omitempty"`
+	// SecurityGroups 安全组，仅ALB及部分NLB支持
+	SecurityGroups []string `json:"security_groups,omitempty"`
+	// StateReason 状态原因
+	StateReason *string `json:"state_reason,omitempty"`
+}
+
+// AwsListener ...
+type AwsListener = Listener[AwsListenerExtension]
+
+// AwsListenerExtension aws 监听器拓展
+type AwsListenerExtension struct {
+	// SslPolicy HTTPS/TLS 监听器的安全策略
+	SslPolicy *string `json:"ssl_policy,omitempty"`
+	// Certificates 证书ARN
+	Certificates []string `json:"certificates,omitempty"`
+	// AlpnPolicy TLS 监听器的ALPN策略
+	AlpnPolicy []string `json:"alpn_policy,omitempty"`
+	// DefaultTargetGroups 默认动作转发到的目标组ARN
+	DefaultTargetGroups []string `json:"default_target_groups,omitempty"`
+}
+
+// AwsTargetGroup ...
+type AwsTargetGroup = TargetGroup[AwsTargetGroupExtension]
+
+// AwsTargetGroupExtension aws 目标组拓展
+type AwsTargetGroupExtension struct {
+	// TargetType 目标类型: instance、ip、lambda、alb
+	TargetType *string `json:"target_type,omitempty"`
+	// ProtocolVersion 协议版本: HTTP1、HTTP2、GRPC
+	ProtocolVersion *string `json:"protocol_version,omitempty"`
+	// IpAddressType ipv4、ipv6
+	IpAddressType *string `json:"ip_address_type,omitempty"`
+	// CloudLbIDs 关联的负载均衡ARN
+	CloudLbIDs []string `json:"cloud_lb_ids,omitempty"`
+	// HealthCheck 健康检查配置
+	HealthCheck *AwsHealthCheck `json:"health_check,omitempty"`
+}
+
+// AwsHealthCheck aws 目标组健康检查
+type AwsHealthCheck struct {
+	Enabled            *bool   `json:"enabled,omitempty"`
+	Protocol           *string `json:"protocol,omitempty"`
+	Port               *string `json:"port,omitempty"`
+	Path               *string `json:"path,omitempty"`
+	IntervalSeconds    *int64  `json:"interval_seconds,omitempty"`
+	TimeoutSeconds     *int64  `json:"timeout_seconds,omitempty"`
+	HealthyThreshold   *int64  `json:"healthy_threshold,omitempty"`
+	UnhealthyThreshold *int64  `json:"unhealthy_threshold,omitempty"`
+	// Matcher 健康检查成功的HTTP或gRPC状态码
+	Matcher *string `json:"matcher,omitempty"`
+}
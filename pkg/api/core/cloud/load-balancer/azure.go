@@ -0,0 +1,42 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package loadbalancer
+
+// AzureLoadBalancer ...
+type AzureLoadBalancer = LoadBalancer[AzureLoadBalancerExtension]
+
+// AzureLoadBalancerExtension azure 负载均衡拓展
+type AzureLoadBalancerExtension struct {
+	ResourceGroupName string `json:"resource_group_name,omitempty"`
+	// SkuName Basic、Standard、Gateway
+	SkuName *string `json:"sku_name,omitempty"`
+	// SkuTier Regional、Global
+	SkuTier *string `json:"sku_tier,omitempty"`
+	// CloudPublicIPIDs 前端IP配置关联的公网IP资源ID
+	CloudPublicIPIDs []string `json:"cloud_public_ip_ids,omitempty"`
+	// FrontendIPConfigurations 前端IP配置ID
+	FrontendIPConfigurations []string `json:"frontend_ip_configurations,omitempty"`
+	// BackendAddressPools 后端池ID
+	BackendAddressPools []string `json:"backend_address_pools,omitempty"`
+	// LoadBalancingRules 负载均衡规则ID
+	LoadBalancingRules []string `json:"load_balancing_rules,omitempty"`
+	// Probes 运行状况探测ID
+	Probes []string `json:"probes,omitempty"`
+}
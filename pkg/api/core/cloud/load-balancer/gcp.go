@@ -0,0 +1,41 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package loadbalancer
+
+// GcpLoadBalancer ...
+type GcpLoadBalancer = LoadBalancer[GcpLoadBalancerExtension]
+
+// GcpLoadBalancerExtension gcp 负载均衡(转发规则)拓展
+type GcpLoadBalancerExtension struct {
+	SelfLink *string `json:"self_link,omitempty"`
+	// LoadBalancingScheme EXTERNAL、EXTERNAL_MANAGED、INTERNAL、INTERNAL_MANAGED、INTERNAL_SELF_MANAGED
+	LoadBalancingScheme *string `json:"load_balancing_scheme,omitempty"`
+	// IPProtocol TCP、UDP、ESP、AH、SCTP、ICMP、L3_DEFAULT
+	IPProtocol *string  `json:"ip_protocol,omitempty"`
+	PortRange  *string  `json:"port_range,omitempty"`
+	Ports      []string `json:"ports,omitempty"`
+	AllPorts   *bool    `json:"all_ports,omitempty"`
+	// Target 转发目标(目标代理或目标池)的selfLink
+	Target *string `json:"target,omitempty"`
+	// BackendService 内部直通负载均衡的后端服务selfLink
+	BackendService *string `json:"backend_service,omitempty"`
+	// NetworkTier PREMIUM、STANDARD
+	NetworkTier *string `json:"network_tier,omitempty"`
+}
@@ -0,0 +1,49 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package loadbalancer
+
+// HuaWeiLoadBalancer ...
+type HuaWeiLoadBalancer = LoadBalancer[HuaWeiLoadBalancerExtension]
+
+// HuaWeiLoadBalancerExtension 华为云独享型负载均衡拓展
+type HuaWeiLoadBalancerExtension struct {
+	// Provider 负载均衡的生产者名称，固定为vlb
+	Provider *string `json:"provider,omitempty"`
+	// Guaranteed 是否独享型负载均衡
+	Guaranteed *bool `json:"guaranteed,omitempty"`
+	// VipSubnetCidrID IPv4子网ID(neutron子网)
+	VipSubnetCidrID *string `json:"vip_subnet_cidr_id,omitempty"`
+	// ElbVirsubnetIDs 下联面子网的网络ID列表
+	ElbVirsubnetIDs []string `json:"elb_virsubnet_ids,omitempty"`
+	// L4FlavorID 四层规格ID
+	L4FlavorID *string `json:"l4_flavor_id,omitempty"`
+	// L7FlavorID 七层规格ID
+	L7FlavorID *string `json:"l7_flavor_id,omitempty"`
+	// BillingInfo 资源账单信息，为空表示按需计费
+	BillingInfo *string `json:"billing_info,omitempty"`
+	// EnterpriseProjectID 企业项目ID
+	EnterpriseProjectID *string `json:"enterprise_project_id,omitempty"`
+	// OperatingStatus 操作状态
+	OperatingStatus *string `json:"operating_status,omitempty"`
+	// CloudEipIDs 绑定的EIP ID
+	CloudEipIDs []string `json:"cloud_eip_ids,omitempty"`
+	// DeletionProtectionEnable 删除保护
+	DeletionProtectionEnable *bool `json:"deletion_protection_enable,omitempty"`
+}
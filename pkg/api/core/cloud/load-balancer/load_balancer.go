@@ -89,7 +89,8 @@ func (lb LoadBalancer[T]) GetCloudID() string {
 
 // Extension extension.
 type Extension interface {
-	TCloudClbExtension
+	TCloudClbExtension | AwsLoadBalancerExtension | AzureLoadBalancerExtension | HuaWeiLoadBalancerExtension |
+		GcpLoadBalancerExtension
 }
 
 // BaseListener define base listener.
@@ -133,7 +134,7 @@ func (lbl Listener[T]) GetCloudID() string {
 
 // ListenerExtension 监听器拓展
 type ListenerExtension interface {
-	TCloudListenerExtension
+	TCloudListenerExtension | AwsListenerExtension
 }
 
 // TCloudLbUrlRule define base tcloud lb url rule.
@@ -0,0 +1,70 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package natgateway ...
+package natgateway
+
+import (
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/enumor"
+)
+
+// BaseNatGateway define base nat gateway.
+type BaseNatGateway struct {
+	ID               string        `json:"id"`
+	Vendor           enumor.Vendor `json:"vendor"`
+	AccountID        string        `json:"account_id"`
+	CloudID          string        `json:"cloud_id"`
+	BkBizID          int64         `json:"bk_biz_id"`
+	Name             string        `json:"name"`
+	Region           string        `json:"region"`
+	Zone             string        `json:"zone"`
+	Status           string        `json:"status"`
+	VpcID            string        `json:"vpc_id"`
+	CloudVpcID       string        `json:"cloud_vpc_id"`
+	SubnetID         string        `json:"subnet_id"`
+	CloudSubnetID    string        `json:"cloud_subnet_id"`
+	PublicIPs        []string      `json:"public_ips"`
+	PrivateIPs       []string      `json:"private_ips"`
+	CloudCreatedTime string        `json:"cloud_created_time"`
+	Memo             *string       `json:"memo"`
+	*core.Revision   `json:",inline"`
+}
+
+// NatGateway define nat gateway with extension.
+type NatGateway[Ext Extension] struct {
+	BaseNatGateway `json:",inline"`
+	Extension      *Ext `json:"extension"`
+}
+
+// GetID ...
+func (n NatGateway[T]) GetID() string {
+	return n.BaseNatGateway.ID
+}
+
+// GetCloudID ...
+func (n NatGateway[T]) GetCloudID() string {
+	return n.BaseNatGateway.CloudID
+}
+
+// Extension nat gateway extension.
+type Extension interface {
+	TCloudNatGatewayExtension | AwsNatGatewayExtension | AzureNatGatewayExtension | GcpNatGatewayExtension |
+		HuaWeiNatGatewayExtension
+}
@@ -0,0 +1,49 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package privatedns
+
+import (
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/enumor"
+)
+
+// Record define private dns record.
+type Record struct {
+	ID             string                      `json:"id"`
+	Vendor         enumor.Vendor               `json:"vendor"`
+	AccountID      string                      `json:"account_id"`
+	ZoneID         string                      `json:"zone_id"`
+	CloudZoneID    string                      `json:"cloud_zone_id"`
+	CloudID        string                      `json:"cloud_id"`
+	Name           string                      `json:"name"`
+	Type           enumor.PrivateDnsRecordType `json:"type"`
+	Value          []string                    `json:"value"`
+	TTL            int64                       `json:"ttl"`
+	*core.Revision `json:",inline"`
+}
+
+// GetID ...
+func (r Record) GetID() string {
+	return r.ID
+}
+
+// GetCloudID ...
+func (r Record) GetCloudID() string {
+	return r.CloudID
+}
@@ -0,0 +1,99 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+// Package privatedns ...
+package privatedns
+
+import (
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/enumor"
+)
+
+// BaseZone define base private dns zone.
+type BaseZone struct {
+	ID               string        `json:"id"`
+	Vendor           enumor.Vendor `json:"vendor"`
+	AccountID        string        `json:"account_id"`
+	CloudID          string        `json:"cloud_id"`
+	BkBizID          int64         `json:"bk_biz_id"`
+	Name             string        `json:"name"`
+	Region           string        `json:"region"`
+	RecordCount      int64         `json:"record_count"`
+	CloudCreatedTime string        `json:"cloud_created_time"`
+	Memo             *string       `json:"memo"`
+	*core.Revision   `json:",inline"`
+}
+
+// Zone define private dns zone with extension.
+type Zone[Ext Extension] struct {
+	BaseZone  `json:",inline"`
+	Extension *Ext `json:"extension"`
+}
+
+// GetID ...
+func (z Zone[T]) GetID() string {
+	return z.BaseZone.ID
+}
+
+// GetCloudID ...
+func (z Zone[T]) GetCloudID() string {
+	return z.BaseZone.CloudID
+}
+
+// Extension private dns zone extension.
+type Extension interface {
+	TCloudZoneExtension | AwsZoneExtension | AzureZoneExtension | GcpZoneExtension | HuaWeiZoneExtension
+}
+
+// TCloudZoneExtension tcloud private dns zone extension.
+type TCloudZoneExtension struct {
+	Status string `json:"status,omitempty"`
+	// DnsForwardStatus 子域名递归解析状态，ENABLED 或 DISABLED
+	DnsForwardStatus string `json:"dns_forward_status,omitempty"`
+}
+
+// AwsZoneExtension aws route53 private hosted zone extension.
+type AwsZoneExtension struct {
+	CallerReference string `json:"caller_reference,omitempty"`
+}
+
+// AzureZoneExtension azure private dns zone extension.
+type AzureZoneExtension struct {
+	ResourceGroupName string `json:"resource_group_name,omitempty"`
+	ProvisioningState string `json:"provisioning_state,omitempty"`
+}
+
+// GcpZoneExtension gcp cloud dns private managed zone extension.
+type GcpZoneExtension struct {
+	// ZoneName 托管区域名称，Gcp 的记录操作需要使用托管区域名称而非域名
+	ZoneName string `json:"zone_name,omitempty"`
+}
+
+// HuaWeiZoneExtension huawei private dns zone extension.
+type HuaWeiZoneExtension struct {
+	Status string `json:"status,omitempty"`
+}
+
+// ZoneVpcRel define private dns zone vpc rel.
+type ZoneVpcRel struct {
+	ID        uint64 `json:"id"`
+	ZoneID    string `json:"zone_id"`
+	VpcID     string `json:"vpc_id"`
+	Creator   string `json:"creator"`
+	CreatedAt string `json:"created_at"`
+}
@@ -21,31 +21,34 @@ package routetable
 
 import (
 	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/tools/converter"
 	"hcm/pkg/tools/hash"
 )
 
 // AwsRoute defines aws route info.
 type AwsRoute struct {
-	ID                               string  `json:"id"`
-	RouteTableID                     string  `json:"route_table_id"`
-	CloudRouteTableID                string  `json:"cloud_route_table_id"`
-	DestinationCidrBlock             *string `json:"destination_cidr_block,omitempty"`
-	DestinationIpv6CidrBlock         *string `json:"destination_ipv6_cidr_block,omitempty"`
-	CloudDestinationPrefixListID     *string `json:"cloud_destination_prefix_list_id,omitempty"`
-	CloudCarrierGatewayID            *string `json:"cloud_carrier_gateway_id,omitempty"`
-	CoreNetworkArn                   *string `json:"core_network_arn,omitempty"`
-	CloudEgressOnlyInternetGatewayID *string `json:"cloud_egress_only_internet_gateway_id,omitempty"`
-	CloudGatewayID                   *string `json:"cloud_gateway_id,omitempty"`
-	CloudInstanceID                  *string `json:"cloud_instance_id,omitempty"`
-	CloudInstanceOwnerID             *string `json:"cloud_instance_owner_id,omitempty"`
-	CloudLocalGatewayID              *string `json:"cloud_local_gateway_id,omitempty"`
-	CloudNatGatewayID                *string `json:"cloud_nat_gateway_id,omitempty"`
-	CloudNetworkInterfaceID          *string `json:"cloud_network_interface_id,omitempty"`
-	CloudTransitGatewayID            *string `json:"cloud_transit_gateway_id,omitempty"`
-	CloudVpcPeeringConnectionID      *string `json:"cloud_vpc_peering_connection_id,omitempty"`
-	State                            string  `json:"state"`
-	Propagated                       bool    `json:"propagated"`
+	ID                               string                   `json:"id"`
+	RouteTableID                     string                   `json:"route_table_id"`
+	CloudRouteTableID                string                   `json:"cloud_route_table_id"`
+	DestinationCidrBlock             *string                  `json:"destination_cidr_block,omitempty"`
+	DestinationIpv6CidrBlock         *string                  `json:"destination_ipv6_cidr_block,omitempty"`
+	CloudDestinationPrefixListID     *string                  `json:"cloud_destination_prefix_list_id,omitempty"`
+	CloudCarrierGatewayID            *string                  `json:"cloud_carrier_gateway_id,omitempty"`
+	CoreNetworkArn                   *string                  `json:"core_network_arn,omitempty"`
+	CloudEgressOnlyInternetGatewayID *string                  `json:"cloud_egress_only_internet_gateway_id,omitempty"`
+	CloudGatewayID                   *string                  `json:"cloud_gateway_id,omitempty"`
+	CloudInstanceID                  *string                  `json:"cloud_instance_id,omitempty"`
+	CloudInstanceOwnerID             *string                  `json:"cloud_instance_owner_id,omitempty"`
+	CloudLocalGatewayID              *string                  `json:"cloud_local_gateway_id,omitempty"`
+	CloudNatGatewayID                *string                  `json:"cloud_nat_gateway_id,omitempty"`
+	CloudNetworkInterfaceID          *string                  `json:"cloud_network_interface_id,omitempty"`
+	CloudTransitGatewayID            *string                  `json:"cloud_transit_gateway_id,omitempty"`
+	CloudVpcPeeringConnectionID      *string                  `json:"cloud_vpc_peering_connection_id,omitempty"`
+	NextHopResType                   enumor.CloudResourceType `json:"next_hop_res_type"`
+	NextHopResID                     string                   `json:"next_hop_res_id"`
+	State                            string                   `json:"state"`
+	Propagated                       bool                     `json:"propagated"`
 	*core.Revision                   `json:",inline"`
 }
 
@@ -57,5 +60,5 @@ func (route AwsRoute) GetID() string {
 // GetCloudID ...
 func (route AwsRoute) GetCloudID() string {
 	return hash.HashString(route.CloudRouteTableID + converter.PtrToVal(route.DestinationCidrBlock) +
-	converter.PtrToVal(route.DestinationIpv6CidrBlock) + converter.PtrToVal(route.CloudCarrierGatewayID))
+		converter.PtrToVal(route.DestinationIpv6CidrBlock) + converter.PtrToVal(route.CloudCarrierGatewayID))
 }
@@ -21,30 +21,33 @@ package routetable
 
 import (
 	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/enumor"
 )
 
 // GcpRoute defines gcp route info.
 type GcpRoute struct {
-	ID               string   `json:"id"`
-	CloudID          string   `json:"cloud_id"`
-	RouteTableID     string   `json:"route_table_id"`
-	VpcID            string   `json:"vpc_id"`
-	CloudVpcID       string   `json:"cloud_vpc_id"`
-	SelfLink         string   `json:"self_link"`
-	Name             string   `json:"name"`
-	DestRange        string   `json:"dest_range"`
-	NextHopGateway   *string  `json:"next_hop_gateway,omitempty"`
-	NextHopIlb       *string  `json:"next_hop_ilb,omitempty"`
-	NextHopInstance  *string  `json:"next_hop_instance,omitempty"`
-	NextHopIp        *string  `json:"next_hop_ip,omitempty"`
-	NextHopNetwork   *string  `json:"next_hop_network,omitempty"`
-	NextHopPeering   *string  `json:"next_hop_peering,omitempty"`
-	NextHopVpnTunnel *string  `json:"next_hop_vpn_tunnel,omitempty"`
-	Priority         int64    `json:"priority"`
-	RouteStatus      string   `json:"route_status"`
-	RouteType        string   `json:"route_type"`
-	Tags             []string `json:"tags,omitempty"`
-	Memo             *string  `json:"memo,omitempty"`
+	ID               string                   `json:"id"`
+	CloudID          string                   `json:"cloud_id"`
+	RouteTableID     string                   `json:"route_table_id"`
+	VpcID            string                   `json:"vpc_id"`
+	CloudVpcID       string                   `json:"cloud_vpc_id"`
+	SelfLink         string                   `json:"self_link"`
+	Name             string                   `json:"name"`
+	DestRange        string                   `json:"dest_range"`
+	NextHopGateway   *string                  `json:"next_hop_gateway,omitempty"`
+	NextHopIlb       *string                  `json:"next_hop_ilb,omitempty"`
+	NextHopInstance  *string                  `json:"next_hop_instance,omitempty"`
+	NextHopIp        *string                  `json:"next_hop_ip,omitempty"`
+	NextHopNetwork   *string                  `json:"next_hop_network,omitempty"`
+	NextHopPeering   *string                  `json:"next_hop_peering,omitempty"`
+	NextHopResType   enumor.CloudResourceType `json:"next_hop_res_type"`
+	NextHopResID     string                   `json:"next_hop_res_id"`
+	NextHopVpnTunnel *string                  `json:"next_hop_vpn_tunnel,omitempty"`
+	Priority         int64                    `json:"priority"`
+	RouteStatus      string                   `json:"route_status"`
+	RouteType        string                   `json:"route_type"`
+	Tags             []string                 `json:"tags,omitempty"`
+	Memo             *string                  `json:"memo,omitempty"`
 	*core.Revision   `json:",inline"`
 }
 
@@ -21,17 +21,20 @@ package routetable
 
 import (
 	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/enumor"
 )
 
 // HuaWeiRoute defines huawei route info.
 type HuaWeiRoute struct {
-	ID                string  `json:"id"`
-	RouteTableID      string  `json:"route_table_id"`
-	CloudRouteTableID string  `json:"cloud_route_table_id"`
-	Type              string  `json:"type"`
-	Destination       string  `json:"destination"`
-	NextHop           string  `json:"nexthop"`
-	Memo              *string `json:"memo,omitempty"`
+	ID                string                   `json:"id"`
+	RouteTableID      string                   `json:"route_table_id"`
+	CloudRouteTableID string                   `json:"cloud_route_table_id"`
+	Type              string                   `json:"type"`
+	Destination       string                   `json:"destination"`
+	NextHop           string                   `json:"nexthop"`
+	NextHopResType    enumor.CloudResourceType `json:"next_hop_res_type"`
+	NextHopResID      string                   `json:"next_hop_res_id"`
+	Memo              *string                  `json:"memo,omitempty"`
 	*core.Revision    `json:",inline"`
 }
 
@@ -21,22 +21,25 @@ package routetable
 
 import (
 	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/enumor"
 )
 
 // TCloudRoute defines tencent cloud route info.
 type TCloudRoute struct {
-	ID                       string  `json:"id"`
-	CloudID                  string  `json:"cloud_id"`
-	RouteTableID             string  `json:"route_table_id"`
-	CloudRouteTableID        string  `json:"cloud_route_table_id"`
-	DestinationCidrBlock     string  `json:"destination_cidr_block"`
-	DestinationIpv6CidrBlock *string `json:"destination_ipv6_cidr_block,omitempty"`
-	GatewayType              string  `json:"gateway_type"`
-	CloudGatewayID           string  `json:"cloud_gateway_id"`
-	Enabled                  bool    `json:"enabled"`
-	RouteType                string  `json:"route_type"`
-	PublishedToVbc           bool    `json:"published_to_vbc"`
-	Memo                     *string `json:"memo,omitempty"`
+	ID                       string                   `json:"id"`
+	CloudID                  string                   `json:"cloud_id"`
+	RouteTableID             string                   `json:"route_table_id"`
+	CloudRouteTableID        string                   `json:"cloud_route_table_id"`
+	DestinationCidrBlock     string                   `json:"destination_cidr_block"`
+	DestinationIpv6CidrBlock *string                  `json:"destination_ipv6_cidr_block,omitempty"`
+	GatewayType              string                   `json:"gateway_type"`
+	CloudGatewayID           string                   `json:"cloud_gateway_id"`
+	NextHopResType           enumor.CloudResourceType `json:"next_hop_res_type"`
+	NextHopResID             string                   `json:"next_hop_res_id"`
+	Enabled                  bool                     `json:"enabled"`
+	RouteType                string                   `json:"route_type"`
+	PublishedToVbc           bool                     `json:"published_to_vbc"`
+	Memo                     *string                  `json:"memo,omitempty"`
 	*core.Revision           `json:",inline"`
 }
 
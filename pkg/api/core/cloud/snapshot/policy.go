@@ -0,0 +1,43 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package snapshot
+
+import (
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/enumor"
+)
+
+// Policy define snapshot policy.
+type Policy struct {
+	ID        string        `json:"id"`
+	Vendor    enumor.Vendor `json:"vendor"`
+	AccountID string        `json:"account_id"`
+	BkBizID   int64         `json:"bk_biz_id"`
+	Name      string        `json:"name"`
+	// Spec 快照创建周期，cron表达式
+	Spec string `json:"spec"`
+	// RetentionDays 快照保留天数，为0时不清理
+	RetentionDays  uint     `json:"retention_days"`
+	DiskIDs        []string `json:"disk_ids"`
+	Enabled        bool     `json:"enabled"`
+	CronFlowID     string   `json:"cron_flow_id"`
+	Memo           *string  `json:"memo"`
+	*core.Revision `json:",inline"`
+}
@@ -62,7 +62,8 @@ type BaseSubnet struct {
 
 // SubnetExtension defines subnet extensional info.
 type SubnetExtension interface {
-	TCloudSubnetExtension | AwsSubnetExtension | GcpSubnetExtension | AzureSubnetExtension | HuaWeiSubnetExtension
+	TCloudSubnetExtension | AwsSubnetExtension | GcpSubnetExtension | AzureSubnetExtension | HuaWeiSubnetExtension |
+		ZenlayerSubnetExtension | KaopuSubnetExtension
 }
 
 // TCloudSubnetExtension defines tencent cloud subnet extensional info.
@@ -109,6 +110,19 @@ type HuaWeiSubnetExtension struct {
 	NtpAddresses []string `json:"ntp_addresses"`
 }
 
+// ZenlayerSubnetExtension defines zenlayer subnet extensional info.
+type ZenlayerSubnetExtension struct {
+	Status    string `json:"status"`
+	GatewayIp string `json:"gateway_ip"`
+	IsDefault bool   `json:"is_default"`
+}
+
+// KaopuSubnetExtension defines kaopu subnet extensional info.
+type KaopuSubnetExtension struct {
+	Status    string `json:"status"`
+	GatewayIp string `json:"gateway_ip"`
+}
+
 // TCloudSubnet defines tencent cloud subnet.
 type TCloudSubnet Subnet[TCloudSubnetExtension]
 
@@ -123,3 +137,9 @@ type AzureSubnet Subnet[AzureSubnetExtension]
 
 // HuaWeiSubnet defines huawei subnet.
 type HuaWeiSubnet Subnet[HuaWeiSubnetExtension]
+
+// ZenlayerSubnet defines zenlayer subnet.
+type ZenlayerSubnet Subnet[ZenlayerSubnetExtension]
+
+// KaopuSubnet defines kaopu subnet.
+type KaopuSubnet Subnet[KaopuSubnetExtension]
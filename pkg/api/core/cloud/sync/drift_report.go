@@ -0,0 +1,82 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package coresync
+
+import (
+	"encoding/json"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/table/types"
+)
+
+// DriftAction 同步漂移变更类型。
+type DriftAction string
+
+const (
+	// DriftCreate 同步将在db中新增资源。
+	DriftCreate DriftAction = "create"
+	// DriftUpdate 同步将更新db中的资源。
+	DriftUpdate DriftAction = "update"
+	// DriftDelete 同步将从db中删除资源。
+	DriftDelete DriftAction = "delete"
+)
+
+// DriftChange 同步过程中对 data-service 的一次写操作，Items 为该操作涉及的资源，更新操作记录同步对比出的字段级差异。
+type DriftChange struct {
+	// Resource data-service 接口对应的资源，如 vpcs、disk_cvm_rels
+	Resource string      `json:"resource"`
+	Action   DriftAction `json:"action"`
+	Method   string      `json:"method"`
+	Path     string      `json:"path"`
+	Items    []DriftItem `json:"items"`
+}
+
+// DriftItem 一次写操作涉及的单个资源。
+type DriftItem struct {
+	// ID 资源在db中的ID，新增的资源为空
+	ID string `json:"id,omitempty"`
+	// CloudID 资源云ID，关联关系等没有云ID的资源为空
+	CloudID string `json:"cloud_id,omitempty"`
+	// Fields 更新操作中值发生变化的字段，新增和删除操作为空
+	Fields []DriftField `json:"fields,omitempty"`
+}
+
+// DriftField 资源单个字段的差异，Field 为字段路径，如 name、extension.cidr。
+type DriftField struct {
+	Field string          `json:"field"`
+	Old   json.RawMessage `json:"old"`
+	New   json.RawMessage `json:"new"`
+}
+
+// DriftSummary 按资源统计的各操作类型将发生漂移的资源数量，批量写操作按其涉及的资源数计数。
+type DriftSummary map[string]map[DriftAction]uint64
+
+// SyncDriftReport 同步漂移报告，记录一次 dry-run 同步将对db做的变更。
+type SyncDriftReport struct {
+	ID        string                   `json:"id"`
+	Vendor    enumor.Vendor            `json:"vendor"`
+	AccountID string                   `json:"account_id"`
+	Region    string                   `json:"region"`
+	ResType   enumor.CloudResourceType `json:"res_type"`
+	Summary   DriftSummary             `json:"summary"`
+	Changes   []DriftChange            `json:"changes"`
+	Creator   string                   `json:"creator"`
+	CreatedAt types.Time               `json:"created_at"`
+}
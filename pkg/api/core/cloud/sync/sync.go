@@ -32,6 +32,7 @@ type AccountSyncDetailTable struct {
 	ResStatus       string          `json:"res_status"`
 	ResEndTime      string          `json:"res_end_time"`
 	ResFailedReason types.JsonField `json:"res_failed_reason"`
+	ResWatermark    types.JsonField `json:"res_watermark"`
 	Creator         string          `json:"creator"`
 	Reviser         string          `json:"reviser"`
 	CreatedAt       types.Time      `json:"created_at"`
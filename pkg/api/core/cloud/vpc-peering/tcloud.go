@@ -0,0 +1,29 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package vpcpeering
+
+// TCloudVpcPeeringExtension tcloud vpc peering extension.
+type TCloudVpcPeeringExtension struct {
+	Bandwidth  *int64  `json:"bandwidth,omitempty"`
+	ChargeType *string `json:"charge_type,omitempty"`
+	QosLevel   *string `json:"qos_level,omitempty"`
+	// Type 互通类型，VPC_PEER:VPC间互通 VPC_BM_PEER:VPC与黑石网络互通
+	Type *string `json:"type,omitempty"`
+}
@@ -0,0 +1,69 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package vpcpeering ...
+package vpcpeering
+
+import (
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/enumor"
+)
+
+// BaseVpcPeering define base vpc peering.
+type BaseVpcPeering struct {
+	ID               string        `json:"id"`
+	Vendor           enumor.Vendor `json:"vendor"`
+	AccountID        string        `json:"account_id"`
+	CloudID          string        `json:"cloud_id"`
+	BkBizID          int64         `json:"bk_biz_id"`
+	Name             string        `json:"name"`
+	Region           string        `json:"region"`
+	Status           string        `json:"status"`
+	VpcID            string        `json:"vpc_id"`
+	CloudVpcID       string        `json:"cloud_vpc_id"`
+	PeerVpcID        string        `json:"peer_vpc_id"`
+	CloudPeerVpcID   string        `json:"cloud_peer_vpc_id"`
+	PeerRegion       string        `json:"peer_region"`
+	PeerAccount      string        `json:"peer_account"`
+	CloudCreatedTime string        `json:"cloud_created_time"`
+	Memo             *string       `json:"memo"`
+	*core.Revision   `json:",inline"`
+}
+
+// VpcPeering define vpc peering with extension.
+type VpcPeering[Ext Extension] struct {
+	BaseVpcPeering `json:",inline"`
+	Extension      *Ext `json:"extension"`
+}
+
+// GetID ...
+func (p VpcPeering[T]) GetID() string {
+	return p.BaseVpcPeering.ID
+}
+
+// GetCloudID ...
+func (p VpcPeering[T]) GetCloudID() string {
+	return p.BaseVpcPeering.CloudID
+}
+
+// Extension vpc peering extension.
+type Extension interface {
+	TCloudVpcPeeringExtension | AwsVpcPeeringExtension | AzureVpcPeeringExtension | GcpVpcPeeringExtension |
+		HuaWeiVpcPeeringExtension
+}
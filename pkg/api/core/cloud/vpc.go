@@ -57,7 +57,8 @@ type BaseVpc struct {
 
 // VpcExtension defines vpc extensional info.
 type VpcExtension interface {
-	TCloudVpcExtension | AwsVpcExtension | GcpVpcExtension | AzureVpcExtension | HuaWeiVpcExtension
+	TCloudVpcExtension | AwsVpcExtension | GcpVpcExtension | AzureVpcExtension | HuaWeiVpcExtension |
+		ZenlayerVpcExtension | KaopuVpcExtension
 }
 
 // TCloudVpcExtension defines tencent cloud vpc extensional info.
@@ -130,6 +131,31 @@ type HuaWeiCidr struct {
 	Cidr string               `json:"cidr"`
 }
 
+// ZenlayerVpcExtension defines zenlayer vpc extensional info.
+type ZenlayerVpcExtension struct {
+	Cidr      []ZenlayerCidr `json:"cidr"`
+	Status    string         `json:"status"`
+	IsDefault bool           `json:"is_default"`
+}
+
+// ZenlayerCidr zenlayer cidr
+type ZenlayerCidr struct {
+	Type enumor.IPAddressType `json:"type"`
+	Cidr string               `json:"cidr"`
+}
+
+// KaopuVpcExtension defines kaopu vpc extensional info.
+type KaopuVpcExtension struct {
+	Cidr   []KaopuCidr `json:"cidr"`
+	Status string      `json:"status"`
+}
+
+// KaopuCidr kaopu cidr
+type KaopuCidr struct {
+	Type enumor.IPAddressType `json:"type"`
+	Cidr string               `json:"cidr"`
+}
+
 // TCloudVpc defines tencent cloud vpc.
 type TCloudVpc Vpc[TCloudVpcExtension]
 
@@ -144,3 +170,9 @@ type AzureVpc Vpc[AzureVpcExtension]
 
 // HuaWeiVpc defines huawei vpc.
 type HuaWeiVpc Vpc[HuaWeiVpcExtension]
+
+// ZenlayerVpc defines zenlayer vpc.
+type ZenlayerVpc Vpc[ZenlayerVpcExtension]
+
+// KaopuVpc defines kaopu vpc.
+type KaopuVpc Vpc[KaopuVpcExtension]
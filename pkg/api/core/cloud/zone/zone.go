@@ -56,7 +56,8 @@ type Zone[Extension ZoneExtension] struct {
 
 // ZoneExtension define zone extension.
 type ZoneExtension interface {
-	TCloudZoneExtension | AwsZoneExtension | HuaWeiZoneExtension | GcpZoneExtension
+	TCloudZoneExtension | AwsZoneExtension | HuaWeiZoneExtension | GcpZoneExtension | ZenlayerZoneExtension |
+		KaopuZoneExtension
 }
 
 // TCloudZoneExtension define tcloud zone extension.
@@ -76,3 +77,11 @@ type GcpZoneExtension struct {
 // AwsZoneExtension define aws zone extension.
 type AwsZoneExtension struct {
 }
+
+// ZenlayerZoneExtension define zenlayer zone extension.
+type ZenlayerZoneExtension struct {
+}
+
+// KaopuZoneExtension define kaopu zone extension.
+type KaopuZoneExtension struct {
+}
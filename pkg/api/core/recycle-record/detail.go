@@ -96,6 +96,13 @@ type CvmRecycleOptions struct {
 // DiskRecycleOptions disk recycle record options.
 type DiskRecycleOptions struct{}
 
+// DiskRecycleDetail 云盘回收记录详情，记录已创建的最终快照云ID及创建时间，避免重试时重复创建快照
+type DiskRecycleDetail struct {
+	FinalSnapshotCloudID   string `json:"final_snapshot_cloud_id,omitempty"`
+	FinalSnapshotCreatedAt string `json:"final_snapshot_created_at,omitempty"`
+	ErrorMessage           string `json:"error_message,omitempty"`
+}
+
 // DiskRelatedRecycleOpt 磁盘作为关联资源回收时的回收选项，记录关联的cvm_id
 type DiskRelatedRecycleOpt struct {
 	CvmID string `json:"cvm_id"`
@@ -32,11 +32,23 @@ import (
 // -------------------------- Create --------------------------
 // MainAccountExtensionCreateReq main account extension create req.
 type MainAccountExtensionCreateReq interface {
-	AwsMainAccountExtensionCreateReq | GcpMainAccountExtensionCreateReq |
+	TCloudMainAccountExtensionCreateReq | AwsMainAccountExtensionCreateReq | GcpMainAccountExtensionCreateReq |
 		AzureMainAccountExtensionCreateReq | HuaWeiMainAccountExtensionCreateReq |
 		ZenlayerMainAccountExtensionCreateReq | KaopuMainAccountExtensionCreateReq
 }
 
+// TCloudMainAccountExtensionCreateReq ...
+type TCloudMainAccountExtensionCreateReq struct {
+	CloudMainAccountID   string `json:"cloud_main_account_id"`
+	CloudMainAccountName string `json:"cloud_main_account_name"`
+	CloudInitPassword    string `json:"cloud_init_password"`
+}
+
+// EncryptSecretKey encrypt secret key
+func (req *TCloudMainAccountExtensionCreateReq) EncryptSecretKey(cipher cryptography.Crypto) {
+	req.CloudInitPassword = cipher.EncryptToBase64(req.CloudInitPassword)
+}
+
 // AwsMainAccountExtensionCreateReq ...
 type AwsMainAccountExtensionCreateReq struct {
 	CloudMainAccountID   string `json:"cloud_main_account_id"`
@@ -164,7 +176,7 @@ type MainAccountGetBaseResp struct {
 
 // MainAccountExtensionGetResp main account extension
 type MainAccountExtensionGetResp interface {
-	protocore.AwsMainAccountExtension | protocore.GcpMainAccountExtension |
+	protocore.TCloudMainAccountExtension | protocore.AwsMainAccountExtension | protocore.GcpMainAccountExtension |
 		protocore.HuaWeiMainAccountExtension | protocore.AzureMainAccountExtension |
 		protocore.ZenlayerMainAccountExtension | protocore.KaopuMainAccountExtension
 }
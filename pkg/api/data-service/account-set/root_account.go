@@ -32,11 +32,24 @@ import (
 // -------------------------- Create --------------------------
 // RootAccountCreateReq main account extension create req.
 type RootAccountExtensionCreateReq interface {
-	AwsRootAccountExtensionCreateReq | GcpRootAccountExtensionCreateReq |
+	TCloudRootAccountExtensionCreateReq | AwsRootAccountExtensionCreateReq | GcpRootAccountExtensionCreateReq |
 		AzureRootAccountExtensionCreateReq | HuaWeiRootAccountExtensionCreateReq |
 		ZenlayerRootAccountExtensionCreateReq | KaopuRootAccountExtensionCreateReq
 }
 
+// TCloudRootAccountExtensionCreateReq ...
+type TCloudRootAccountExtensionCreateReq struct {
+	CloudMainAccountID string `json:"cloud_main_account_id" validate:"required"`
+	CloudSubAccountID  string `json:"cloud_sub_account_id" validate:"required"`
+	CloudSecretID      string `json:"cloud_secret_id" validate:"omitempty"`
+	CloudSecretKey     string `json:"cloud_secret_key" validate:"omitempty"`
+}
+
+// EncryptSecretKey encrypt secret key
+func (req *TCloudRootAccountExtensionCreateReq) EncryptSecretKey(cipher cryptography.Crypto) {
+	req.CloudSecretKey = cipher.EncryptToBase64(req.CloudSecretKey)
+}
+
 // AwsRootAccountExtensionCreateReq ...
 type AwsRootAccountExtensionCreateReq struct {
 	CloudAccountID   string `json:"cloud_account_id" validate:"required"`
@@ -138,11 +151,27 @@ func (c *RootAccountCreateReq[T]) Validate() error {
 
 // RootAccountExtensionUpdateReq ...
 type RootAccountExtensionUpdateReq interface {
-	AwsRootAccountExtensionUpdateReq | GcpRootAccountExtensionUpdateReq |
+	TCloudRootAccountExtensionUpdateReq | AwsRootAccountExtensionUpdateReq | GcpRootAccountExtensionUpdateReq |
 		HuaWeiRootAccountExtensionUpdateReq | AzureRootAccountExtensionUpdateReq |
 		ZenlayerRootAccountExtensionUpdateReq | KaopuRootAccountExtensionUpdateReq
 }
 
+// TCloudRootAccountExtensionUpdateReq ...
+type TCloudRootAccountExtensionUpdateReq struct {
+	CloudMainAccountID string  `json:"cloud_main_account_id,omitempty" validate:"omitempty"`
+	CloudSubAccountID  string  `json:"cloud_sub_account_id,omitempty" validate:"omitempty"`
+	CloudSecretID      *string `json:"cloud_secret_id,omitempty" validate:"omitempty"`
+	CloudSecretKey     *string `json:"cloud_secret_key,omitempty" validate:"omitempty"`
+}
+
+// EncryptSecretKey ...
+func (req *TCloudRootAccountExtensionUpdateReq) EncryptSecretKey(cipher cryptography.Crypto) {
+	if req.CloudSecretKey != nil {
+		encryptedCloudSecretKey := cipher.EncryptToBase64(*req.CloudSecretKey)
+		req.CloudSecretKey = &encryptedCloudSecretKey
+	}
+}
+
 // AwsRootAccountExtensionUpdateReq ...
 type AwsRootAccountExtensionUpdateReq struct {
 	CloudAccountID   string  `json:"cloud_account_id,omitempty" validate:"omitempty"`
@@ -266,7 +295,7 @@ type RootAccountGetBaseResp struct {
 
 // RootAccountExtensionGetResp ...
 type RootAccountExtensionGetResp interface {
-	protocore.AwsRootAccountExtension | protocore.GcpRootAccountExtension |
+	protocore.TCloudRootAccountExtension | protocore.AwsRootAccountExtension | protocore.GcpRootAccountExtension |
 		protocore.HuaWeiRootAccountExtension | protocore.AzureRootAccountExtension |
 		protocore.ZenlayerRootAccountExtension | protocore.KaopuRootAccountExtension
 }
@@ -34,22 +34,26 @@ type BatchBillItemCreateReq[E bill.BillItemExtension] []BillItemCreateReq[E]
 
 // BillItemCreateReq create request
 type BillItemCreateReq[E bill.BillItemExtension] struct {
-	RootAccountID string              `json:"root_account_id" validate:"required"`
-	MainAccountID string              `json:"main_account_id" validate:"required"`
-	Vendor        enumor.Vendor       `json:"vendor" validate:"required"`
-	ProductID     int64               `json:"product_id" validate:"omitempty"`
-	BkBizID       int64               `json:"bk_biz_id" validate:"omitempty"`
-	BillYear      int                 `json:"bill_year" validate:"required"`
-	BillMonth     int                 `json:"bill_month" validate:"required"`
-	BillDay       int                 `json:"bill_day" validate:"required"`
-	VersionID     int                 `json:"version_id" validate:"required"`
-	Currency      enumor.CurrencyCode `json:"currency" validate:"required"`
-	Cost          decimal.Decimal     `json:"cost" validate:"required"`
-	HcProductCode string              `json:"hc_product_code,omitempty"`
-	HcProductName string              `json:"hc_product_name,omitempty"`
-	ResAmount     decimal.Decimal     `json:"res_amount,omitempty"`
-	ResAmountUnit string              `json:"res_amount_unit,omitempty"`
-	Extension     *E                  `json:"extension"`
+	RootAccountID string                   `json:"root_account_id" validate:"required"`
+	MainAccountID string                   `json:"main_account_id" validate:"required"`
+	Vendor        enumor.Vendor            `json:"vendor" validate:"required"`
+	ProductID     int64                    `json:"product_id" validate:"omitempty"`
+	BkBizID       int64                    `json:"bk_biz_id" validate:"omitempty"`
+	BillYear      int                      `json:"bill_year" validate:"required"`
+	BillMonth     int                      `json:"bill_month" validate:"required"`
+	BillDay       int                      `json:"bill_day" validate:"required"`
+	VersionID     int                      `json:"version_id" validate:"required"`
+	Currency      enumor.CurrencyCode      `json:"currency" validate:"required"`
+	Cost          decimal.Decimal          `json:"cost" validate:"required"`
+	HcProductCode string                   `json:"hc_product_code,omitempty"`
+	HcProductName string                   `json:"hc_product_name,omitempty"`
+	ResAmount     decimal.Decimal          `json:"res_amount,omitempty"`
+	ResAmountUnit string                   `json:"res_amount_unit,omitempty"`
+	CloudResID    string                   `json:"cloud_res_id,omitempty" validate:"max=255"`
+	ResType       enumor.CloudResourceType `json:"res_type,omitempty"`
+	ResID         string                   `json:"res_id,omitempty" validate:"max=64"`
+	ResBkBizID    int64                    `json:"res_bk_biz_id,omitempty"`
+	Extension     *E                       `json:"extension"`
 }
 
 // Validate ...
@@ -60,6 +64,9 @@ func (c *BillItemCreateReq[E]) Validate() error {
 // BillItemListReq list request
 type BillItemListReq = core.ListReq
 
+// BillItemResourceCostListResult 按云资源汇总的费用列表
+type BillItemResourceCostListResult = core.ListResultT[*bill.ResourceCost]
+
 // BillItemBaseListResult ...
 type BillItemBaseListResult = core.ListResultT[*bill.BaseBillItem]
 
@@ -88,10 +95,10 @@ type ZenlayerBillItemListResult = core.ListResultT[*bill.ZenlayerBillItem]
 type BillItemUpdateReq struct {
 	ID            string              `json:"id,omitempty" validate:"required"`
 	Currency      enumor.CurrencyCode `json:"currency" validate:"required"`
-	Cost          *decimal.Decimal     `json:"cost" validate:"required"`
+	Cost          *decimal.Decimal    `json:"cost" validate:"required"`
 	HcProductCode string              `json:"hc_product_code,omitempty"`
 	HcProductName string              `json:"hc_product_name,omitempty"`
-	ResAmount     *decimal.Decimal     `json:"res_amount,omitempty"`
+	ResAmount     *decimal.Decimal    `json:"res_amount,omitempty"`
 	ResAmountUnit string              `json:"res_amount_unit,omitempty"`
 	Extension     types.JsonField     `json:"extension"`
 }
@@ -42,6 +42,8 @@ type BillSummaryVersionCreateReq struct {
 	Currency        string          `json:"currency" validate:"required"`
 	Cost            decimal.Decimal `json:"cost" validate:"required"`
 	RMBCost         decimal.Decimal `json:"rmb_cost" validate:"required"`
+	// SplitRuleSnapshot 记录版本时生效的分账规则快照
+	SplitRuleSnapshot types.JsonField `json:"split_rule_snapshot" validate:"omitempty"`
 }
 
 // Validate ...
@@ -81,8 +83,10 @@ type BillSummaryVersionResult struct {
 	Currency        string          `json:"currency" validate:"required"`
 	Cost            decimal.Decimal `json:"cost" validate:"required"`
 	RMBCost         decimal.Decimal `json:"rmb_cost" validate:"required"`
-	CreatedAt       types.Time      `json:"created_at,omitempty"`
-	UpdatedAt       types.Time      `json:"updated_at,omitempty"`
+	// SplitRuleSnapshot 记录版本时生效的分账规则快照
+	SplitRuleSnapshot types.JsonField `json:"split_rule_snapshot"`
+	CreatedAt         types.Time      `json:"created_at,omitempty"`
+	UpdatedAt         types.Time      `json:"updated_at,omitempty"`
 }
 
 // BillSummaryVersionUpdateReq update request
@@ -0,0 +1,168 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bill
+
+import (
+	"errors"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/api/core/bill"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+
+	"github.com/shopspring/decimal"
+)
+
+// BatchBillBudgetCreateReq batch create request
+type BatchBillBudgetCreateReq struct {
+	Items []BillBudgetCreateReq `json:"items" validate:"required,min=1,max=100,dive,required"`
+}
+
+// Validate ...
+func (r *BatchBillBudgetCreateReq) Validate() error {
+	if err := validator.Validate.Struct(r); err != nil {
+		return err
+	}
+	for idx := range r.Items {
+		if err := r.Items[idx].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BillBudgetCreateReq create request
+type BillBudgetCreateReq struct {
+	Name       string                 `json:"name" validate:"required,max=128"`
+	Scope      enumor.BillBudgetScope `json:"scope" validate:"required"`
+	ScopeID    string                 `json:"scope_id" validate:"required,max=64"`
+	Amount     decimal.Decimal        `json:"amount" validate:"required"`
+	Thresholds []bill.BudgetThreshold `json:"thresholds" validate:"required,min=1"`
+	Receivers  []string               `json:"receivers" validate:"omitempty,max=50"`
+	WebhookUrl *string                `json:"webhook_url" validate:"omitempty,max=512"`
+	Memo       *string                `json:"memo" validate:"omitempty,max=255"`
+}
+
+// Validate ...
+func (c *BillBudgetCreateReq) Validate() error {
+	if err := validator.Validate.Struct(c); err != nil {
+		return err
+	}
+	if !c.Amount.IsPositive() {
+		return errors.New("amount should be positive")
+	}
+	if err := bill.ValidateBudgetScope(c.Scope, c.ScopeID); err != nil {
+		return err
+	}
+	if c.WebhookUrl != nil {
+		if err := bill.ValidateBudgetWebhookUrl(*c.WebhookUrl); err != nil {
+			return err
+		}
+	}
+	return bill.ValidateBudgetThresholds(c.Thresholds)
+}
+
+// BillBudgetListReq list request
+type BillBudgetListReq = core.ListReq
+
+// BillBudgetListResult list result
+type BillBudgetListResult = core.ListResultT[*bill.Budget]
+
+// BillBudgetUpdateReq update request
+type BillBudgetUpdateReq struct {
+	ID         string                 `json:"id" validate:"required"`
+	Name       string                 `json:"name" validate:"omitempty,max=128"`
+	Amount     *decimal.Decimal       `json:"amount" validate:"omitempty"`
+	Thresholds []bill.BudgetThreshold `json:"thresholds" validate:"omitempty"`
+	Receivers  []string               `json:"receivers" validate:"omitempty,max=50"`
+	WebhookUrl *string                `json:"webhook_url" validate:"omitempty,max=512"`
+	Memo       *string                `json:"memo" validate:"omitempty,max=255"`
+}
+
+// Validate ...
+func (req *BillBudgetUpdateReq) Validate() error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+	if req.Amount != nil && !req.Amount.IsPositive() {
+		return errors.New("amount should be positive")
+	}
+	if req.WebhookUrl != nil {
+		if err := bill.ValidateBudgetWebhookUrl(*req.WebhookUrl); err != nil {
+			return err
+		}
+	}
+	if len(req.Thresholds) != 0 {
+		return bill.ValidateBudgetThresholds(req.Thresholds)
+	}
+	return nil
+}
+
+// BatchBillBudgetAlertCreateReq batch create budget alert request
+type BatchBillBudgetAlertCreateReq struct {
+	Items []BillBudgetAlertCreateReq `json:"items" validate:"required,min=1,max=100,dive,required"`
+}
+
+// Validate ...
+func (r *BatchBillBudgetAlertCreateReq) Validate() error {
+	if err := validator.Validate.Struct(r); err != nil {
+		return err
+	}
+	for idx := range r.Items {
+		if err := r.Items[idx].AlertType.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BillBudgetAlertCreateReq create budget alert request
+type BillBudgetAlertCreateReq struct {
+	BudgetID  string                     `json:"budget_id" validate:"required"`
+	BillYear  int                        `json:"bill_year" validate:"required"`
+	BillMonth int                        `json:"bill_month" validate:"required,min=1,max=12"`
+	AlertType enumor.BillBudgetAlertType `json:"alert_type" validate:"required"`
+	Threshold int                        `json:"threshold" validate:"required,min=1"`
+	Amount    decimal.Decimal            `json:"amount" validate:"required"`
+	Cost      decimal.Decimal            `json:"cost" validate:"required"`
+}
+
+// BillBudgetAlertUpdateReq update notify state of budget alert request
+type BillBudgetAlertUpdateReq struct {
+	ID          string                       `json:"id" validate:"required"`
+	NotifyState enumor.BillBudgetNotifyState `json:"notify_state" validate:"required"`
+	NotifyCount *int                         `json:"notify_count" validate:"omitempty,min=0"`
+	// PreNotifyCount 不为空时仅在告警当前通知次数与其一致时更新，不一致时返回记录不存在错误，用于并发重试时抢占告警
+	PreNotifyCount *int `json:"pre_notify_count" validate:"omitempty,min=0"`
+}
+
+// Validate ...
+func (req *BillBudgetAlertUpdateReq) Validate() error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+	return req.NotifyState.Validate()
+}
+
+// BillBudgetAlertListReq list budget alert request
+type BillBudgetAlertListReq = core.ListReq
+
+// BillBudgetAlertListResult list budget alert result
+type BillBudgetAlertListResult = core.ListResultT[*bill.BudgetAlert]
@@ -0,0 +1,143 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bill
+
+import (
+	"errors"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/api/core/bill"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+)
+
+// BatchBillSplitRuleCreateReq batch create request
+type BatchBillSplitRuleCreateReq struct {
+	Items []BillSplitRuleCreateReq `json:"items" validate:"required,min=1,max=100,dive,required"`
+}
+
+// Validate ...
+func (r *BatchBillSplitRuleCreateReq) Validate() error {
+	if err := validator.Validate.Struct(r); err != nil {
+		return err
+	}
+	for idx := range r.Items {
+		if err := r.Items[idx].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BillSplitRuleCreateReq create request
+type BillSplitRuleCreateReq struct {
+	Name          string                 `json:"name" validate:"required,max=128"`
+	Vendor        enumor.Vendor          `json:"vendor" validate:"required"`
+	RootAccountID string                 `json:"root_account_id" validate:"required"`
+	MainAccountID string                 `json:"main_account_id" validate:"required"`
+	Priority      int64                  `json:"priority" validate:"min=0"`
+	SplitType     enumor.BillSplitType   `json:"split_type" validate:"required"`
+	Match         *bill.SplitRuleMatch   `json:"match" validate:"omitempty"`
+	Targets       []bill.SplitRuleTarget `json:"targets" validate:"required,min=1"`
+	Memo          *string                `json:"memo" validate:"omitempty,max=255"`
+}
+
+// Validate ...
+func (c *BillSplitRuleCreateReq) Validate() error {
+	if err := validator.Validate.Struct(c); err != nil {
+		return err
+	}
+	if c.Match != nil {
+		if err := c.Match.Validate(); err != nil {
+			return err
+		}
+	}
+	return bill.ValidateSplitRuleTargets(c.SplitType, c.Targets)
+}
+
+// BillSplitRuleListReq list request
+type BillSplitRuleListReq = core.ListReq
+
+// BillSplitRuleListResult list result
+type BillSplitRuleListResult = core.ListResultT[*bill.SplitRule]
+
+// BillSplitRuleUpdateReq update request
+type BillSplitRuleUpdateReq struct {
+	ID        string                 `json:"id" validate:"required"`
+	Name      string                 `json:"name" validate:"omitempty,max=128"`
+	Priority  *int64                 `json:"priority" validate:"omitempty,min=0"`
+	SplitType enumor.BillSplitType   `json:"split_type" validate:"omitempty"`
+	Match     *bill.SplitRuleMatch   `json:"match" validate:"omitempty"`
+	Targets   []bill.SplitRuleTarget `json:"targets" validate:"omitempty"`
+	Memo      *string                `json:"memo" validate:"omitempty,max=255"`
+}
+
+// Validate ...
+func (req *BillSplitRuleUpdateReq) Validate() error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+	if req.Match != nil {
+		if err := req.Match.Validate(); err != nil {
+			return err
+		}
+	}
+	// 分摊方式与分摊目标相互依赖，需一起更新
+	if (len(req.SplitType) == 0) != (len(req.Targets) == 0) {
+		return errors.New("split_type and targets should be updated together")
+	}
+	if len(req.SplitType) != 0 {
+		return bill.ValidateSplitRuleTargets(req.SplitType, req.Targets)
+	}
+	return nil
+}
+
+// BillSplitSnapshotCreateReq create split snapshot request
+type BillSplitSnapshotCreateReq struct {
+	RootAccountID string           `json:"root_account_id" validate:"required"`
+	MainAccountID string           `json:"main_account_id" validate:"required"`
+	Vendor        enumor.Vendor    `json:"vendor" validate:"required"`
+	BillYear      int              `json:"bill_year" validate:"required"`
+	BillMonth     int              `json:"bill_month" validate:"required,min=1,max=12"`
+	BillDay       int              `json:"bill_day" validate:"required,min=1,max=31"`
+	CvmCounts     map[string]int64 `json:"cvm_counts" validate:"omitempty"`
+}
+
+// Validate ...
+func (c *BillSplitSnapshotCreateReq) Validate() error {
+	return validator.Validate.Struct(c)
+}
+
+// BillSplitSnapshotListReq list request
+type BillSplitSnapshotListReq = core.ListReq
+
+// BillSplitSnapshotListResult list result
+type BillSplitSnapshotListResult = core.ListResultT[*bill.SplitSnapshot]
+
+// BillSplitSnapshotUpdateReq update split snapshot request
+type BillSplitSnapshotUpdateReq struct {
+	ID        string           `json:"id" validate:"required"`
+	CvmCounts map[string]int64 `json:"cvm_counts" validate:"required"`
+}
+
+// Validate ...
+func (req *BillSplitSnapshotUpdateReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
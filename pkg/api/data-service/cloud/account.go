@@ -35,7 +35,8 @@ import (
 // AccountExtensionCreateReq account extension create req.
 type AccountExtensionCreateReq interface {
 	TCloudAccountExtensionCreateReq | AwsAccountExtensionCreateReq | HuaWeiAccountExtensionCreateReq |
-		GcpAccountExtensionCreateReq | AzureAccountExtensionCreateReq
+		GcpAccountExtensionCreateReq | AzureAccountExtensionCreateReq | ZenlayerAccountExtensionCreateReq |
+		KaopuAccountExtensionCreateReq
 }
 
 // TCloudAccountExtensionCreateReq ...
@@ -111,6 +112,32 @@ func (req *AzureAccountExtensionCreateReq) EncryptSecretKey(cipher cryptography.
 	req.CloudClientSecretKey = cipher.EncryptToBase64(req.CloudClientSecretKey)
 }
 
+// ZenlayerAccountExtensionCreateReq ...
+type ZenlayerAccountExtensionCreateReq struct {
+	CloudMainAccountID string `json:"cloud_main_account_id" validate:"required"`
+	CloudSubAccountID  string `json:"cloud_sub_account_id" validate:"required"`
+	CloudSecretID      string `json:"cloud_secret_id" validate:"omitempty"`
+	CloudSecretKey     string `json:"cloud_secret_key" validate:"omitempty"`
+}
+
+// EncryptSecretKey ...
+func (req *ZenlayerAccountExtensionCreateReq) EncryptSecretKey(cipher cryptography.Crypto) {
+	req.CloudSecretKey = cipher.EncryptToBase64(req.CloudSecretKey)
+}
+
+// KaopuAccountExtensionCreateReq ...
+type KaopuAccountExtensionCreateReq struct {
+	CloudMainAccountID string `json:"cloud_main_account_id" validate:"required"`
+	CloudSubAccountID  string `json:"cloud_sub_account_id" validate:"required"`
+	CloudSecretID      string `json:"cloud_secret_id" validate:"omitempty"`
+	CloudSecretKey     string `json:"cloud_secret_key" validate:"omitempty"`
+}
+
+// EncryptSecretKey ...
+func (req *KaopuAccountExtensionCreateReq) EncryptSecretKey(cipher cryptography.Crypto) {
+	req.CloudSecretKey = cipher.EncryptToBase64(req.CloudSecretKey)
+}
+
 // AccountCreateReq ...
 type AccountCreateReq[T AccountExtensionCreateReq] struct {
 	Name      string                 `json:"name" validate:"required"`
@@ -133,7 +160,8 @@ func (c *AccountCreateReq[T]) Validate() error {
 // Note: 对于允许为空字符串的字段，则其类型需要定义为指针，正常情况下，Json合并时空值会被忽略
 type AccountExtensionUpdateReq interface {
 	TCloudAccountExtensionUpdateReq | AwsAccountExtensionUpdateReq | HuaWeiAccountExtensionUpdateReq |
-		GcpAccountExtensionUpdateReq | AzureAccountExtensionUpdateReq
+		GcpAccountExtensionUpdateReq | AzureAccountExtensionUpdateReq | ZenlayerAccountExtensionUpdateReq |
+		KaopuAccountExtensionUpdateReq
 }
 
 type TCloudAccountExtensionUpdateReq struct {
@@ -220,6 +248,36 @@ func (req *AzureAccountExtensionUpdateReq) EncryptSecretKey(cipher cryptography.
 	}
 }
 
+type ZenlayerAccountExtensionUpdateReq struct {
+	CloudMainAccountID string  `json:"cloud_main_account_id,omitempty" validate:"omitempty"`
+	CloudSubAccountID  string  `json:"cloud_sub_account_id,omitempty" validate:"omitempty"`
+	CloudSecretID      *string `json:"cloud_secret_id,omitempty" validate:"omitempty"`
+	CloudSecretKey     *string `json:"cloud_secret_key,omitempty" validate:"omitempty"`
+}
+
+// EncryptSecretKey ...
+func (req *ZenlayerAccountExtensionUpdateReq) EncryptSecretKey(cipher cryptography.Crypto) {
+	if req.CloudSecretKey != nil {
+		encryptedCloudSecretKey := cipher.EncryptToBase64(*req.CloudSecretKey)
+		req.CloudSecretKey = &encryptedCloudSecretKey
+	}
+}
+
+type KaopuAccountExtensionUpdateReq struct {
+	CloudMainAccountID string  `json:"cloud_main_account_id,omitempty" validate:"omitempty"`
+	CloudSubAccountID  string  `json:"cloud_sub_account_id,omitempty" validate:"omitempty"`
+	CloudSecretID      *string `json:"cloud_secret_id,omitempty" validate:"omitempty"`
+	CloudSecretKey     *string `json:"cloud_secret_key,omitempty" validate:"omitempty"`
+}
+
+// EncryptSecretKey ...
+func (req *KaopuAccountExtensionUpdateReq) EncryptSecretKey(cipher cryptography.Crypto) {
+	if req.CloudSecretKey != nil {
+		encryptedCloudSecretKey := cipher.EncryptToBase64(*req.CloudSecretKey)
+		req.CloudSecretKey = &encryptedCloudSecretKey
+	}
+}
+
 // AccountUpdateReq ...
 type AccountUpdateReq[T AccountExtensionUpdateReq] struct {
 	Name               string   `json:"name" validate:"omitempty"`
@@ -240,7 +298,8 @@ func (u *AccountUpdateReq[T]) Validate() error {
 
 type AccountExtensionGetResp interface {
 	cloud.TCloudAccountExtension | cloud.AwsAccountExtension | cloud.HuaWeiAccountExtension |
-		cloud.GcpAccountExtension | cloud.AzureAccountExtension
+		cloud.GcpAccountExtension | cloud.AzureAccountExtension | cloud.ZenlayerAccountExtension |
+		cloud.KaopuAccountExtension
 }
 
 type AccountGetResult[T AccountExtensionGetResp] struct {
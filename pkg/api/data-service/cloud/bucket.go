@@ -0,0 +1,137 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package cloud
+
+import (
+	"errors"
+	"fmt"
+
+	"hcm/pkg/api/core"
+	corebucket "hcm/pkg/api/core/cloud/bucket"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/runtime/filter"
+)
+
+// -------------------------- Create --------------------------
+
+// BucketBatchCreateReq bucket batch create req.
+type BucketBatchCreateReq[Extension corebucket.Extension] struct {
+	Buckets []BucketBatchCreate[Extension] `json:"buckets" validate:"required,min=1"`
+}
+
+// BucketBatchCreate define bucket batch create.
+type BucketBatchCreate[Extension corebucket.Extension] struct {
+	CloudID          string                    `json:"cloud_id" validate:"required"`
+	AccountID        string                    `json:"account_id" validate:"required"`
+	BkBizID          int64                     `json:"bk_biz_id"`
+	Name             string                    `json:"name"`
+	Region           string                    `json:"region"`
+	StorageClass     string                    `json:"storage_class"`
+	PublicAccess     enumor.BucketPublicAccess `json:"public_access"`
+	Encryption       string                    `json:"encryption"`
+	CloudCreatedTime string                    `json:"cloud_created_time"`
+	Memo             *string                   `json:"memo"`
+	Extension        *Extension                `json:"extension"`
+}
+
+// Validate bucket batch create request.
+func (req *BucketBatchCreateReq[T]) Validate() error {
+	if len(req.Buckets) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("buckets count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// -------------------------- Update --------------------------
+
+// BucketExtUpdateReq 同步时更新存储桶，只更新云上属性，不更新分配的业务
+type BucketExtUpdateReq[T corebucket.Extension] struct {
+	ID           string                    `json:"id" validate:"required"`
+	Name         string                    `json:"name"`
+	Region       string                    `json:"region"`
+	StorageClass string                    `json:"storage_class"`
+	PublicAccess enumor.BucketPublicAccess `json:"public_access"`
+	Encryption   string                    `json:"encryption"`
+	Memo         *string                   `json:"memo"`
+	Extension    *T                        `json:"extension"`
+}
+
+// BucketExtBatchUpdateReq ...
+type BucketExtBatchUpdateReq[T corebucket.Extension] []*BucketExtUpdateReq[T]
+
+// Validate ...
+func (req *BucketExtBatchUpdateReq[T]) Validate() error {
+	if len(*req) == 0 {
+		return errors.New("update items is required")
+	}
+
+	if len(*req) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("update items count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	for _, r := range *req {
+		if err := validator.Validate.Struct(r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BucketBatchUpdateReq 批量更新存储桶的通用属性，目前只用于分配业务
+type BucketBatchUpdateReq struct {
+	IDs     []string `json:"ids" validate:"required,min=1"`
+	BkBizID int64    `json:"bk_biz_id" validate:"required"`
+}
+
+// Validate ...
+func (req *BucketBatchUpdateReq) Validate() error {
+	if len(req.IDs) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("ids count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// -------------------------- List --------------------------
+
+// BucketListResult define bucket list result.
+type BucketListResult = core.ListResultT[corebucket.BaseBucket]
+
+// BucketExtListResult define bucket with extension list result.
+type BucketExtListResult[T corebucket.Extension] struct {
+	Count   uint64                 `json:"count,omitempty"`
+	Details []corebucket.Bucket[T] `json:"details,omitempty"`
+}
+
+// -------------------------- Delete --------------------------
+
+// BucketBatchDeleteReq delete request.
+type BucketBatchDeleteReq struct {
+	Filter *filter.Expression `json:"filter" validate:"required"`
+}
+
+// Validate delete request.
+func (req *BucketBatchDeleteReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
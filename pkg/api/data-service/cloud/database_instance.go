@@ -0,0 +1,158 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package cloud
+
+import (
+	"errors"
+	"fmt"
+
+	"hcm/pkg/api/core"
+	coredbinstance "hcm/pkg/api/core/cloud/database-instance"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/runtime/filter"
+)
+
+// -------------------------- Create --------------------------
+
+// DatabaseInstanceBatchCreateReq database instance batch create req.
+type DatabaseInstanceBatchCreateReq[Extension coredbinstance.Extension] struct {
+	DatabaseInstances []DatabaseInstanceBatchCreate[Extension] `json:"database_instances" validate:"required,min=1"`
+}
+
+// DatabaseInstanceBatchCreate define database instance batch create.
+type DatabaseInstanceBatchCreate[Extension coredbinstance.Extension] struct {
+	CloudID          string     `json:"cloud_id" validate:"required"`
+	AccountID        string     `json:"account_id" validate:"required"`
+	BkBizID          int64      `json:"bk_biz_id"`
+	Name             string     `json:"name"`
+	Region           string     `json:"region"`
+	Zone             string     `json:"zone"`
+	Status           string     `json:"status"`
+	Engine           string     `json:"engine"`
+	EngineVersion    string     `json:"engine_version"`
+	InstanceClass    string     `json:"instance_class"`
+	StorageSize      int64      `json:"storage_size"`
+	ChargeType       string     `json:"charge_type"`
+	VpcID            string     `json:"vpc_id"`
+	CloudVpcID       string     `json:"cloud_vpc_id"`
+	SubnetID         string     `json:"subnet_id"`
+	CloudSubnetID    string     `json:"cloud_subnet_id"`
+	PrivateAddress   string     `json:"private_address"`
+	PublicAddress    string     `json:"public_address"`
+	Port             int64      `json:"port"`
+	CloudCreatedTime string     `json:"cloud_created_time"`
+	CloudExpiredTime string     `json:"cloud_expired_time"`
+	Memo             *string    `json:"memo"`
+	Extension        *Extension `json:"extension"`
+}
+
+// Validate database instance batch create request.
+func (req *DatabaseInstanceBatchCreateReq[T]) Validate() error {
+	if len(req.DatabaseInstances) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("database_instances count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// -------------------------- Update --------------------------
+
+// DatabaseInstanceExtUpdateReq 同步时更新云数据库实例，只更新云上属性，不更新分配的业务
+type DatabaseInstanceExtUpdateReq[T coredbinstance.Extension] struct {
+	ID               string  `json:"id" validate:"required"`
+	Name             string  `json:"name"`
+	Zone             string  `json:"zone"`
+	Status           string  `json:"status"`
+	EngineVersion    string  `json:"engine_version"`
+	InstanceClass    string  `json:"instance_class"`
+	StorageSize      int64   `json:"storage_size"`
+	ChargeType       string  `json:"charge_type"`
+	VpcID            string  `json:"vpc_id"`
+	CloudVpcID       string  `json:"cloud_vpc_id"`
+	SubnetID         string  `json:"subnet_id"`
+	CloudSubnetID    string  `json:"cloud_subnet_id"`
+	PrivateAddress   string  `json:"private_address"`
+	PublicAddress    string  `json:"public_address"`
+	Port             int64   `json:"port"`
+	CloudExpiredTime string  `json:"cloud_expired_time"`
+	Memo             *string `json:"memo"`
+	Extension        *T      `json:"extension"`
+}
+
+// DatabaseInstanceExtBatchUpdateReq ...
+type DatabaseInstanceExtBatchUpdateReq[T coredbinstance.Extension] []*DatabaseInstanceExtUpdateReq[T]
+
+// Validate ...
+func (req *DatabaseInstanceExtBatchUpdateReq[T]) Validate() error {
+	if len(*req) == 0 {
+		return errors.New("update items is required")
+	}
+
+	if len(*req) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("update items count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	for _, r := range *req {
+		if err := validator.Validate.Struct(r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DatabaseInstanceBatchUpdateReq 批量更新云数据库实例的通用属性，目前只用于分配业务
+type DatabaseInstanceBatchUpdateReq struct {
+	IDs     []string `json:"ids" validate:"required,min=1"`
+	BkBizID int64    `json:"bk_biz_id" validate:"required"`
+}
+
+// Validate ...
+func (req *DatabaseInstanceBatchUpdateReq) Validate() error {
+	if len(req.IDs) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("ids count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// -------------------------- List --------------------------
+
+// DatabaseInstanceListResult define database instance list result.
+type DatabaseInstanceListResult = core.ListResultT[coredbinstance.BaseDatabaseInstance]
+
+// DatabaseInstanceExtListResult define database instance with extension list result.
+type DatabaseInstanceExtListResult[T coredbinstance.Extension] struct {
+	Count   uint64                               `json:"count,omitempty"`
+	Details []coredbinstance.DatabaseInstance[T] `json:"details,omitempty"`
+}
+
+// -------------------------- Delete --------------------------
+
+// DatabaseInstanceBatchDeleteReq delete request.
+type DatabaseInstanceBatchDeleteReq struct {
+	Filter *filter.Expression `json:"filter" validate:"required"`
+}
+
+// Validate delete request.
+func (req *DatabaseInstanceBatchDeleteReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
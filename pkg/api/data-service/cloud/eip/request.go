@@ -59,7 +59,8 @@ func (req *EipExtCreateReq[T]) Validate() error {
 
 // EipExtensionCreateReq ...
 type EipExtensionCreateReq interface {
-	TCloudEipExtensionCreateReq | AwsEipExtensionCreateReq | AzureEipExtensionCreateReq | GcpEipExtensionCreateReq | HuaWeiEipExtensionCreateReq
+	TCloudEipExtensionCreateReq | AwsEipExtensionCreateReq | AzureEipExtensionCreateReq | GcpEipExtensionCreateReq | HuaWeiEipExtensionCreateReq |
+		ZenlayerEipExtensionCreateReq | KaopuEipExtensionCreateReq
 }
 
 // EipListReq ...
@@ -76,7 +77,8 @@ func (req *EipListReq) Validate() error {
 
 // EipExtensionUpdateReq ...
 type EipExtensionUpdateReq interface {
-	TCloudEipExtensionUpdateReq | AwsEipExtensionUpdateReq | AzureEipExtensionUpdateReq | GcpEipExtensionUpdateReq | HuaWeiEipExtensionUpdateReq
+	TCloudEipExtensionUpdateReq | AwsEipExtensionUpdateReq | AzureEipExtensionUpdateReq | GcpEipExtensionUpdateReq | HuaWeiEipExtensionUpdateReq |
+		ZenlayerEipExtensionUpdateReq | KaopuEipExtensionUpdateReq
 }
 
 // EipExtBatchUpdateReq ...
@@ -0,0 +1,171 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package cloud
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	coreipam "hcm/pkg/api/core/cloud/ipam"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/runtime/filter"
+)
+
+// -------------------------- Pool --------------------------
+
+// IpamPoolBatchCreateReq ipam pool batch create req.
+type IpamPoolBatchCreateReq struct {
+	Pools []IpamPoolCreate `json:"pools" validate:"required,min=1,dive"`
+}
+
+// IpamPoolCreate define ipam pool create.
+type IpamPoolCreate struct {
+	Name    string        `json:"name" validate:"required,max=255"`
+	Vendor  enumor.Vendor `json:"vendor" validate:"required"`
+	Region  string        `json:"region" validate:"omitempty"`
+	BkBizID int64         `json:"bk_biz_id" validate:"required"`
+	Cidr    string        `json:"cidr" validate:"required,cidrv4"`
+	Memo    *string       `json:"memo" validate:"omitempty,max=255"`
+}
+
+// Validate ipam pool batch create request.
+func (req *IpamPoolBatchCreateReq) Validate() error {
+	if len(req.Pools) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("pools count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// IpamPoolUpdateReq 地址池网段已被分配使用，只允许更新名称和备注
+type IpamPoolUpdateReq struct {
+	ID   string  `json:"id" validate:"required"`
+	Name string  `json:"name" validate:"omitempty,max=255"`
+	Memo *string `json:"memo" validate:"omitempty,max=255"`
+}
+
+// IpamPoolBatchUpdateReq ...
+type IpamPoolBatchUpdateReq struct {
+	Pools []IpamPoolUpdateReq `json:"pools" validate:"required,min=1,dive"`
+}
+
+// Validate ...
+func (req *IpamPoolBatchUpdateReq) Validate() error {
+	if len(req.Pools) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("pools count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// IpamPoolListResult define ipam pool list result.
+type IpamPoolListResult = core.ListResultT[coreipam.Pool]
+
+// IpamPoolBatchDeleteReq delete request.
+type IpamPoolBatchDeleteReq struct {
+	Filter *filter.Expression `json:"filter" validate:"required"`
+}
+
+// Validate delete request.
+func (req *IpamPoolBatchDeleteReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// -------------------------- Allocation --------------------------
+
+// IpamAllocationBatchCreateReq ipam allocation batch create req.
+type IpamAllocationBatchCreateReq struct {
+	Allocations []IpamAllocationCreate `json:"allocations" validate:"required,min=1,dive"`
+}
+
+// IpamAllocationCreate define ipam allocation create.
+type IpamAllocationCreate struct {
+	PoolID  string                   `json:"pool_id" validate:"required"`
+	Cidr    string                   `json:"cidr" validate:"required,cidrv4"`
+	ResType enumor.CloudResourceType `json:"res_type" validate:"required"`
+	ResID   string                   `json:"res_id" validate:"omitempty"`
+	Memo    *string                  `json:"memo" validate:"omitempty,max=255"`
+}
+
+// Validate ipam allocation batch create request.
+func (req *IpamAllocationBatchCreateReq) Validate() error {
+	if len(req.Allocations) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("allocations count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// IpamAllocationAllocateReq 从地址池分配网段请求，在地址池行锁内计算可用网段并创建分配记录
+type IpamAllocationAllocateReq struct {
+	PoolID  string                   `json:"pool_id" validate:"required"`
+	MaskLen int                      `json:"mask_len" validate:"required,min=8,max=29"`
+	ResType enumor.CloudResourceType `json:"res_type" validate:"required"`
+	Memo    *string                  `json:"memo" validate:"omitempty,max=255"`
+	// UsedCidrs 分配记录以外需要避让的已占用网段，如已同步的VPC网段
+	UsedCidrs []string `json:"used_cidrs" validate:"omitempty"`
+}
+
+// Validate ipam allocation allocate request.
+func (req *IpamAllocationAllocateReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// IpamAllocationAllocateResult 从地址池分配网段结果
+type IpamAllocationAllocateResult struct {
+	ID   string `json:"id"`
+	Cidr string `json:"cidr"`
+}
+
+// IpamAllocationUpdateReq 资源创建成功后回填资源ID
+type IpamAllocationUpdateReq struct {
+	ID    string  `json:"id" validate:"required"`
+	ResID string  `json:"res_id" validate:"omitempty"`
+	Memo  *string `json:"memo" validate:"omitempty,max=255"`
+}
+
+// IpamAllocationBatchUpdateReq ...
+type IpamAllocationBatchUpdateReq struct {
+	Allocations []IpamAllocationUpdateReq `json:"allocations" validate:"required,min=1,dive"`
+}
+
+// Validate ...
+func (req *IpamAllocationBatchUpdateReq) Validate() error {
+	if len(req.Allocations) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("allocations count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// IpamAllocationListResult define ipam allocation list result.
+type IpamAllocationListResult = core.ListResultT[coreipam.Allocation]
+
+// IpamAllocationBatchDeleteReq delete request.
+type IpamAllocationBatchDeleteReq struct {
+	Filter *filter.Expression `json:"filter" validate:"required"`
+}
+
+// Validate delete request.
+func (req *IpamAllocationBatchDeleteReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
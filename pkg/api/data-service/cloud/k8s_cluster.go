@@ -0,0 +1,243 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package cloud
+
+import (
+	"errors"
+	"fmt"
+
+	"hcm/pkg/api/core"
+	corek8s "hcm/pkg/api/core/cloud/k8s"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/runtime/filter"
+)
+
+// -------------------------- Create --------------------------
+
+// K8sClusterBatchCreateReq k8s cluster batch create req.
+type K8sClusterBatchCreateReq[Extension corek8s.Extension] struct {
+	K8sClusters []K8sClusterBatchCreate[Extension] `json:"k8s_clusters" validate:"required,min=1"`
+}
+
+// K8sClusterBatchCreate define k8s cluster batch create.
+type K8sClusterBatchCreate[Extension corek8s.Extension] struct {
+	CloudID          string     `json:"cloud_id" validate:"required"`
+	AccountID        string     `json:"account_id" validate:"required"`
+	BkBizID          int64      `json:"bk_biz_id"`
+	Name             string     `json:"name"`
+	Region           string     `json:"region"`
+	Status           string     `json:"status"`
+	Version          string     `json:"version"`
+	CloudVpcID       string     `json:"cloud_vpc_id"`
+	CloudCreatedTime string     `json:"cloud_created_time"`
+	Memo             *string    `json:"memo"`
+	Extension        *Extension `json:"extension"`
+}
+
+// Validate k8s cluster batch create request.
+func (req *K8sClusterBatchCreateReq[T]) Validate() error {
+	if len(req.K8sClusters) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("k8s_clusters count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// -------------------------- Update --------------------------
+
+// K8sClusterExtUpdateReq 同步时更新容器集群，只更新云上属性，不更新分配的业务
+type K8sClusterExtUpdateReq[T corek8s.Extension] struct {
+	ID         string  `json:"id" validate:"required"`
+	Name       string  `json:"name"`
+	Region     string  `json:"region"`
+	Status     string  `json:"status"`
+	Version    string  `json:"version"`
+	CloudVpcID string  `json:"cloud_vpc_id"`
+	Memo       *string `json:"memo"`
+	Extension  *T      `json:"extension"`
+}
+
+// K8sClusterExtBatchUpdateReq ...
+type K8sClusterExtBatchUpdateReq[T corek8s.Extension] []*K8sClusterExtUpdateReq[T]
+
+// Validate ...
+func (req *K8sClusterExtBatchUpdateReq[T]) Validate() error {
+	if len(*req) == 0 {
+		return errors.New("update items is required")
+	}
+
+	if len(*req) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("update items count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	for _, r := range *req {
+		if err := validator.Validate.Struct(r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// K8sClusterBatchUpdateReq 批量更新容器集群的通用属性，目前只用于分配业务
+type K8sClusterBatchUpdateReq struct {
+	IDs     []string `json:"ids" validate:"required,min=1"`
+	BkBizID int64    `json:"bk_biz_id" validate:"required"`
+}
+
+// Validate ...
+func (req *K8sClusterBatchUpdateReq) Validate() error {
+	if len(req.IDs) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("ids count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// -------------------------- List --------------------------
+
+// K8sClusterListResult define k8s cluster list result.
+type K8sClusterListResult = core.ListResultT[corek8s.BaseK8sCluster]
+
+// K8sClusterExtListResult define k8s cluster with extension list result.
+type K8sClusterExtListResult[T corek8s.Extension] struct {
+	Count   uint64                  `json:"count,omitempty"`
+	Details []corek8s.K8sCluster[T] `json:"details,omitempty"`
+}
+
+// -------------------------- Delete --------------------------
+
+// K8sClusterBatchDeleteReq delete request.
+type K8sClusterBatchDeleteReq struct {
+	Filter *filter.Expression `json:"filter" validate:"required"`
+}
+
+// Validate delete request.
+func (req *K8sClusterBatchDeleteReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// -------------------------- Node Pool --------------------------
+
+// K8sNodePoolBatchCreateReq k8s node pool batch create req.
+type K8sNodePoolBatchCreateReq struct {
+	NodePools []K8sNodePoolCreate `json:"node_pools" validate:"required,min=1"`
+}
+
+// K8sNodePoolCreate define k8s node pool create.
+type K8sNodePoolCreate struct {
+	Vendor         enumor.Vendor `json:"vendor" validate:"required"`
+	AccountID      string        `json:"account_id" validate:"required"`
+	ClusterID      string        `json:"cluster_id" validate:"required"`
+	CloudClusterID string        `json:"cloud_cluster_id" validate:"required"`
+	CloudID        string        `json:"cloud_id" validate:"required"`
+	Name           string        `json:"name"`
+	Region         string        `json:"region"`
+	Status         string        `json:"status"`
+	InstanceType   string        `json:"instance_type"`
+	NodeCount      int64         `json:"node_count"`
+	MinSize        int64         `json:"min_size"`
+	MaxSize        int64         `json:"max_size"`
+}
+
+// Validate k8s node pool batch create request.
+func (req *K8sNodePoolBatchCreateReq) Validate() error {
+	if len(req.NodePools) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("node_pools count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// K8sNodePoolUpdateReq 同步时更新节点池的云上属性
+type K8sNodePoolUpdateReq struct {
+	ID           string `json:"id" validate:"required"`
+	Name         string `json:"name"`
+	Status       string `json:"status"`
+	InstanceType string `json:"instance_type"`
+	NodeCount    int64  `json:"node_count"`
+	MinSize      int64  `json:"min_size"`
+	MaxSize      int64  `json:"max_size"`
+}
+
+// K8sNodePoolBatchUpdateReq ...
+type K8sNodePoolBatchUpdateReq struct {
+	NodePools []K8sNodePoolUpdateReq `json:"node_pools" validate:"required,min=1,dive"`
+}
+
+// Validate ...
+func (req *K8sNodePoolBatchUpdateReq) Validate() error {
+	if len(req.NodePools) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("node_pools count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// K8sNodePoolListResult define k8s node pool list result.
+type K8sNodePoolListResult = core.ListResultT[corek8s.K8sNodePool]
+
+// K8sNodePoolBatchDeleteReq delete request, 删除节点池时会同时删除节点池下的节点关联关系.
+type K8sNodePoolBatchDeleteReq struct {
+	Filter *filter.Expression `json:"filter" validate:"required"`
+}
+
+// Validate delete request.
+func (req *K8sNodePoolBatchDeleteReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// -------------------------- Node Pool Cvm Rel --------------------------
+
+// K8sNodePoolCvmRelBatchCreateReq ...
+type K8sNodePoolCvmRelBatchCreateReq struct {
+	Rels []K8sNodePoolCvmRelCreate `json:"rels" validate:"required,min=1,dive"`
+}
+
+// K8sNodePoolCvmRelCreate ...
+type K8sNodePoolCvmRelCreate struct {
+	ClusterID  string `json:"cluster_id" validate:"required"`
+	NodePoolID string `json:"node_pool_id"`
+	CvmID      string `json:"cvm_id" validate:"required"`
+}
+
+// Validate ...
+func (req *K8sNodePoolCvmRelBatchCreateReq) Validate() error {
+	if len(req.Rels) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("rels count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// K8sNodePoolCvmRelListResult ...
+type K8sNodePoolCvmRelListResult = core.ListResultT[corek8s.K8sNodePoolCvmRel]
+
+// K8sNodePoolCvmRelBatchDeleteReq ...
+type K8sNodePoolCvmRelBatchDeleteReq struct {
+	Filter *filter.Expression `json:"filter" validate:"required"`
+}
+
+// Validate ...
+func (req *K8sNodePoolCvmRelBatchDeleteReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
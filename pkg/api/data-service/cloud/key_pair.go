@@ -0,0 +1,134 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package cloud
+
+import (
+	"errors"
+	"fmt"
+
+	"hcm/pkg/api/core"
+	corekeypair "hcm/pkg/api/core/cloud/key-pair"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/runtime/filter"
+)
+
+// -------------------------- Create --------------------------
+
+// KeyPairBatchCreateReq key pair batch create req.
+type KeyPairBatchCreateReq[Extension corekeypair.Extension] struct {
+	KeyPairs []KeyPairBatchCreate[Extension] `json:"key_pairs" validate:"required,min=1"`
+}
+
+// KeyPairBatchCreate define key pair batch create.
+type KeyPairBatchCreate[Extension corekeypair.Extension] struct {
+	CloudID          string     `json:"cloud_id" validate:"required"`
+	AccountID        string     `json:"account_id" validate:"required"`
+	BkBizID          int64      `json:"bk_biz_id"`
+	Name             string     `json:"name"`
+	Region           string     `json:"region"`
+	Fingerprint      string     `json:"fingerprint"`
+	PublicKey        string     `json:"public_key"`
+	CloudCreatedTime string     `json:"cloud_created_time"`
+	Memo             *string    `json:"memo"`
+	Extension        *Extension `json:"extension"`
+}
+
+// Validate key pair batch create request.
+func (req *KeyPairBatchCreateReq[T]) Validate() error {
+	if len(req.KeyPairs) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("key_pairs count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// -------------------------- Update --------------------------
+
+// KeyPairExtUpdateReq 同步时更新密钥对，只更新云上属性
+type KeyPairExtUpdateReq[T corekeypair.Extension] struct {
+	ID          string  `json:"id" validate:"required"`
+	Name        string  `json:"name"`
+	Fingerprint string  `json:"fingerprint"`
+	PublicKey   string  `json:"public_key"`
+	Memo        *string `json:"memo"`
+	Extension   *T      `json:"extension"`
+}
+
+// KeyPairExtBatchUpdateReq ...
+type KeyPairExtBatchUpdateReq[T corekeypair.Extension] []*KeyPairExtUpdateReq[T]
+
+// Validate ...
+func (req *KeyPairExtBatchUpdateReq[T]) Validate() error {
+	if len(*req) == 0 {
+		return errors.New("update items is required")
+	}
+
+	if len(*req) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("update items count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	for _, r := range *req {
+		if err := validator.Validate.Struct(r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// KeyPairBatchUpdateExprReq 按ID批量更新密钥对的业务、备注等本地属性
+type KeyPairBatchUpdateExprReq struct {
+	IDs     []string `json:"ids" validate:"required,min=1"`
+	BkBizID int64    `json:"bk_biz_id"`
+	Memo    *string  `json:"memo"`
+}
+
+// Validate ...
+func (req *KeyPairBatchUpdateExprReq) Validate() error {
+	if len(req.IDs) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("ids count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// -------------------------- List --------------------------
+
+// KeyPairListResult define key pair list result.
+type KeyPairListResult = core.ListResultT[corekeypair.BaseKeyPair]
+
+// KeyPairExtListResult define key pair with extension list result.
+type KeyPairExtListResult[T corekeypair.Extension] struct {
+	Count   uint64                   `json:"count,omitempty"`
+	Details []corekeypair.KeyPair[T] `json:"details,omitempty"`
+}
+
+// -------------------------- Delete --------------------------
+
+// KeyPairBatchDeleteReq delete request.
+type KeyPairBatchDeleteReq struct {
+	Filter *filter.Expression `json:"filter" validate:"required"`
+}
+
+// Validate delete request.
+func (req *KeyPairBatchDeleteReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
@@ -45,6 +45,18 @@ type TCloudCLBCreateReq = LoadBalancerBatchCreateReq[corelb.TCloudClbExtension]
 // TCloudCLBCreate create load balancer
 type TCloudCLBCreate = LbBatchCreate[corelb.TCloudClbExtension]
 
+// AwsLoadBalancerCreateReq batch create aws load balancer
+type AwsLoadBalancerCreateReq = LoadBalancerBatchCreateReq[corelb.AwsLoadBalancerExtension]
+
+// AzureLoadBalancerCreateReq batch create azure load balancer
+type AzureLoadBalancerCreateReq = LoadBalancerBatchCreateReq[corelb.AzureLoadBalancerExtension]
+
+// HuaWeiLoadBalancerCreateReq batch create huawei load balancer
+type HuaWeiLoadBalancerCreateReq = LoadBalancerBatchCreateReq[corelb.HuaWeiLoadBalancerExtension]
+
+// GcpLoadBalancerCreateReq batch create gcp load balancer
+type GcpLoadBalancerCreateReq = LoadBalancerBatchCreateReq[corelb.GcpLoadBalancerExtension]
+
 // LbBatchCreate define load balancer batch create.
 type LbBatchCreate[Extension corelb.Extension] struct {
 	CloudID          string               `json:"cloud_id" validate:"required"`
@@ -135,6 +147,18 @@ func (req *LbExtBatchUpdateReq[T]) Validate() error {
 // TCloudClbBatchUpdateReq ...
 type TCloudClbBatchUpdateReq = LbExtBatchUpdateReq[corelb.TCloudClbExtension]
 
+// AwsLoadBalancerBatchUpdateReq ...
+type AwsLoadBalancerBatchUpdateReq = LbExtBatchUpdateReq[corelb.AwsLoadBalancerExtension]
+
+// AzureLoadBalancerBatchUpdateReq ...
+type AzureLoadBalancerBatchUpdateReq = LbExtBatchUpdateReq[corelb.AzureLoadBalancerExtension]
+
+// HuaWeiLoadBalancerBatchUpdateReq ...
+type HuaWeiLoadBalancerBatchUpdateReq = LbExtBatchUpdateReq[corelb.HuaWeiLoadBalancerExtension]
+
+// GcpLoadBalancerBatchUpdateReq ...
+type GcpLoadBalancerBatchUpdateReq = LbExtBatchUpdateReq[corelb.GcpLoadBalancerExtension]
+
 // BizBatchUpdateReq 批量更新业务id
 type BizBatchUpdateReq struct {
 	IDs     []string `json:"ids" validate:"required"`
@@ -191,6 +215,9 @@ type ListenerListResult = core.ListResultT[corelb.BaseListener]
 // TCloudListenerListResult ...
 type TCloudListenerListResult = core.ListResultT[corelb.Listener[corelb.TCloudListenerExtension]]
 
+// AwsListenerListResult ...
+type AwsListenerListResult = core.ListResultT[corelb.Listener[corelb.AwsListenerExtension]]
+
 // -------------------------- List Count Listener By LbIDs --------------------------
 
 // ListListenerCountByLbIDsReq define list listener count by lbIDs req.
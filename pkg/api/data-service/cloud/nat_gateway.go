@@ -0,0 +1,131 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package cloud
+
+import (
+	"errors"
+	"fmt"
+
+	"hcm/pkg/api/core"
+	corenatgateway "hcm/pkg/api/core/cloud/nat-gateway"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/runtime/filter"
+)
+
+// -------------------------- Create --------------------------
+
+// NatGatewayBatchCreateReq nat gateway batch create req.
+type NatGatewayBatchCreateReq[Extension corenatgateway.Extension] struct {
+	NatGateways []NatGatewayBatchCreate[Extension] `json:"nat_gateways" validate:"required,min=1"`
+}
+
+// NatGatewayBatchCreate define nat gateway batch create.
+type NatGatewayBatchCreate[Extension corenatgateway.Extension] struct {
+	CloudID          string     `json:"cloud_id" validate:"required"`
+	AccountID        string     `json:"account_id" validate:"required"`
+	BkBizID          int64      `json:"bk_biz_id"`
+	Name             string     `json:"name"`
+	Region           string     `json:"region"`
+	Zone             string     `json:"zone"`
+	Status           string     `json:"status"`
+	VpcID            string     `json:"vpc_id"`
+	CloudVpcID       string     `json:"cloud_vpc_id"`
+	SubnetID         string     `json:"subnet_id"`
+	CloudSubnetID    string     `json:"cloud_subnet_id"`
+	PublicIPs        []string   `json:"public_ips"`
+	PrivateIPs       []string   `json:"private_ips"`
+	CloudCreatedTime string     `json:"cloud_created_time"`
+	Memo             *string    `json:"memo"`
+	Extension        *Extension `json:"extension"`
+}
+
+// Validate nat gateway batch create request.
+func (req *NatGatewayBatchCreateReq[T]) Validate() error {
+	if len(req.NatGateways) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("nat_gateways count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// -------------------------- Update --------------------------
+
+// NatGatewayExtUpdateReq 同步时更新NAT网关，只更新云上属性及跟随VPC的业务
+type NatGatewayExtUpdateReq[T corenatgateway.Extension] struct {
+	ID            string   `json:"id" validate:"required"`
+	BkBizID       int64    `json:"bk_biz_id"`
+	Name          string   `json:"name"`
+	Zone          string   `json:"zone"`
+	Status        string   `json:"status"`
+	VpcID         string   `json:"vpc_id"`
+	CloudVpcID    string   `json:"cloud_vpc_id"`
+	SubnetID      string   `json:"subnet_id"`
+	CloudSubnetID string   `json:"cloud_subnet_id"`
+	PublicIPs     []string `json:"public_ips"`
+	PrivateIPs    []string `json:"private_ips"`
+	Memo          *string  `json:"memo"`
+	Extension     *T       `json:"extension"`
+}
+
+// NatGatewayExtBatchUpdateReq ...
+type NatGatewayExtBatchUpdateReq[T corenatgateway.Extension] []*NatGatewayExtUpdateReq[T]
+
+// Validate ...
+func (req *NatGatewayExtBatchUpdateReq[T]) Validate() error {
+	if len(*req) == 0 {
+		return errors.New("update items is required")
+	}
+
+	if len(*req) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("update items count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	for _, r := range *req {
+		if err := validator.Validate.Struct(r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// -------------------------- List --------------------------
+
+// NatGatewayListResult define nat gateway list result.
+type NatGatewayListResult = core.ListResultT[corenatgateway.BaseNatGateway]
+
+// NatGatewayExtListResult define nat gateway with extension list result.
+type NatGatewayExtListResult[T corenatgateway.Extension] struct {
+	Count   uint64                         `json:"count,omitempty"`
+	Details []corenatgateway.NatGateway[T] `json:"details,omitempty"`
+}
+
+// -------------------------- Delete --------------------------
+
+// NatGatewayBatchDeleteReq delete request.
+type NatGatewayBatchDeleteReq struct {
+	Filter *filter.Expression `json:"filter" validate:"required"`
+}
+
+// Validate delete request.
+func (req *NatGatewayBatchDeleteReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
@@ -0,0 +1,229 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package cloud
+
+import (
+	"errors"
+	"fmt"
+
+	"hcm/pkg/api/core"
+	coreprivatedns "hcm/pkg/api/core/cloud/private-dns"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/runtime/filter"
+)
+
+// -------------------------- Create --------------------------
+
+// PrivateDnsZoneBatchCreateReq private dns zone batch create req.
+type PrivateDnsZoneBatchCreateReq[Extension coreprivatedns.Extension] struct {
+	Zones []PrivateDnsZoneBatchCreate[Extension] `json:"zones" validate:"required,min=1"`
+}
+
+// PrivateDnsZoneBatchCreate define private dns zone batch create.
+type PrivateDnsZoneBatchCreate[Extension coreprivatedns.Extension] struct {
+	CloudID          string     `json:"cloud_id" validate:"required"`
+	AccountID        string     `json:"account_id" validate:"required"`
+	BkBizID          int64      `json:"bk_biz_id"`
+	Name             string     `json:"name"`
+	Region           string     `json:"region"`
+	RecordCount      int64      `json:"record_count"`
+	CloudCreatedTime string     `json:"cloud_created_time"`
+	Memo             *string    `json:"memo"`
+	Extension        *Extension `json:"extension"`
+}
+
+// Validate private dns zone batch create request.
+func (req *PrivateDnsZoneBatchCreateReq[T]) Validate() error {
+	if len(req.Zones) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("zones count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// -------------------------- Update --------------------------
+
+// PrivateDnsZoneExtUpdateReq 同步时更新私有域，只更新云上属性，不更新分配的业务
+type PrivateDnsZoneExtUpdateReq[T coreprivatedns.Extension] struct {
+	ID          string  `json:"id" validate:"required"`
+	Name        string  `json:"name"`
+	RecordCount int64   `json:"record_count"`
+	Memo        *string `json:"memo"`
+	Extension   *T      `json:"extension"`
+}
+
+// PrivateDnsZoneExtBatchUpdateReq ...
+type PrivateDnsZoneExtBatchUpdateReq[T coreprivatedns.Extension] []*PrivateDnsZoneExtUpdateReq[T]
+
+// Validate ...
+func (req *PrivateDnsZoneExtBatchUpdateReq[T]) Validate() error {
+	if len(*req) == 0 {
+		return errors.New("update items is required")
+	}
+
+	if len(*req) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("update items count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	for _, r := range *req {
+		if err := validator.Validate.Struct(r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PrivateDnsZoneBatchUpdateReq 批量更新私有域的通用属性，目前只用于分配业务
+type PrivateDnsZoneBatchUpdateReq struct {
+	IDs     []string `json:"ids" validate:"required,min=1"`
+	BkBizID int64    `json:"bk_biz_id" validate:"required"`
+}
+
+// Validate ...
+func (req *PrivateDnsZoneBatchUpdateReq) Validate() error {
+	if len(req.IDs) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("ids count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// -------------------------- List --------------------------
+
+// PrivateDnsZoneListResult define private dns zone list result.
+type PrivateDnsZoneListResult = core.ListResultT[coreprivatedns.BaseZone]
+
+// PrivateDnsZoneExtListResult define private dns zone with extension list result.
+type PrivateDnsZoneExtListResult[T coreprivatedns.Extension] struct {
+	Count   uint64                   `json:"count,omitempty"`
+	Details []coreprivatedns.Zone[T] `json:"details,omitempty"`
+}
+
+// -------------------------- Delete --------------------------
+
+// PrivateDnsZoneBatchDeleteReq delete request, 删除私有域时会同时删除私有域下的解析记录和VPC关联关系.
+type PrivateDnsZoneBatchDeleteReq struct {
+	Filter *filter.Expression `json:"filter" validate:"required"`
+}
+
+// Validate delete request.
+func (req *PrivateDnsZoneBatchDeleteReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// -------------------------- Zone Vpc Rel --------------------------
+
+// PrivateDnsZoneVpcRelBatchCreateReq ...
+type PrivateDnsZoneVpcRelBatchCreateReq struct {
+	Rels []PrivateDnsZoneVpcRelCreate `json:"rels" validate:"required,min=1,dive"`
+}
+
+// PrivateDnsZoneVpcRelCreate ...
+type PrivateDnsZoneVpcRelCreate struct {
+	ZoneID string `json:"zone_id" validate:"required"`
+	VpcID  string `json:"vpc_id" validate:"required"`
+}
+
+// Validate ...
+func (req *PrivateDnsZoneVpcRelBatchCreateReq) Validate() error {
+	if len(req.Rels) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("rels count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// PrivateDnsZoneVpcRelListResult ...
+type PrivateDnsZoneVpcRelListResult = core.ListResultT[coreprivatedns.ZoneVpcRel]
+
+// PrivateDnsZoneVpcRelBatchDeleteReq ...
+type PrivateDnsZoneVpcRelBatchDeleteReq struct {
+	Filter *filter.Expression `json:"filter" validate:"required"`
+}
+
+// Validate ...
+func (req *PrivateDnsZoneVpcRelBatchDeleteReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// -------------------------- Record --------------------------
+
+// PrivateDnsRecordBatchCreateReq private dns record batch create req.
+type PrivateDnsRecordBatchCreateReq struct {
+	Records []PrivateDnsRecordCreate `json:"records" validate:"required,min=1,dive"`
+}
+
+// PrivateDnsRecordCreate define private dns record create.
+type PrivateDnsRecordCreate struct {
+	Vendor      enumor.Vendor               `json:"vendor" validate:"required"`
+	AccountID   string                      `json:"account_id" validate:"required"`
+	ZoneID      string                      `json:"zone_id" validate:"required"`
+	CloudZoneID string                      `json:"cloud_zone_id" validate:"required"`
+	CloudID     string                      `json:"cloud_id" validate:"required"`
+	Name        string                      `json:"name"`
+	Type        enumor.PrivateDnsRecordType `json:"type" validate:"required"`
+	Value       []string                    `json:"value"`
+	TTL         int64                       `json:"ttl"`
+}
+
+// Validate private dns record batch create request.
+func (req *PrivateDnsRecordBatchCreateReq) Validate() error {
+	if len(req.Records) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("records count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// PrivateDnsRecordUpdateReq 更新解析记录的云上属性
+type PrivateDnsRecordUpdateReq struct {
+	ID    string   `json:"id" validate:"required"`
+	Value []string `json:"value"`
+	TTL   int64    `json:"ttl"`
+}
+
+// PrivateDnsRecordBatchUpdateReq ...
+type PrivateDnsRecordBatchUpdateReq struct {
+	Records []PrivateDnsRecordUpdateReq `json:"records" validate:"required,min=1,dive"`
+}
+
+// Validate ...
+func (req *PrivateDnsRecordBatchUpdateReq) Validate() error {
+	if len(req.Records) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("records count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// PrivateDnsRecordListResult define private dns record list result.
+type PrivateDnsRecordListResult = core.ListResultT[coreprivatedns.Record]
+
+// PrivateDnsRecordBatchDeleteReq delete request.
+type PrivateDnsRecordBatchDeleteReq struct {
+	Filter *filter.Expression `json:"filter" validate:"required"`
+}
+
+// Validate delete request.
+func (req *PrivateDnsRecordBatchDeleteReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
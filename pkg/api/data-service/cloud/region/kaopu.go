@@ -0,0 +1,117 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package region
+
+import (
+	"errors"
+	"fmt"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/api/core/cloud/region"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/rest"
+	"hcm/pkg/runtime/filter"
+)
+
+// -------------------------- Create --------------------------
+
+// KaopuRegionCreateReq define region create request.
+type KaopuRegionCreateReq struct {
+	Regions   []KaopuRegionBatchCreate `json:"regions" validate:"required"`
+	AccountID string                   `json:"account_id" validate:"omitempty"`
+}
+
+// KaopuRegionBatchCreate define region rule when create.
+type KaopuRegionBatchCreate struct {
+	Vendor     enumor.Vendor `json:"vendor" validate:"required"`
+	RegionID   string        `json:"region_id" validate:"required"`
+	RegionName string        `json:"region_name" validate:"required"`
+	Status     string        `json:"status"`
+}
+
+// Validate region create request.
+func (req *KaopuRegionCreateReq) Validate() error {
+	if len(req.Regions) == 0 {
+		return errors.New("regions is required")
+	}
+
+	if len(req.Regions) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("regions count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return nil
+}
+
+// -------------------------- Update --------------------------
+
+// KaopuRegionBatchUpdateReq define kaopu region batch update request.
+type KaopuRegionBatchUpdateReq struct {
+	Regions []KaopuRegionBatchUpdate `json:"regions" validate:"required"`
+}
+
+// KaopuRegionBatchUpdate kaopu region batch update option.
+type KaopuRegionBatchUpdate struct {
+	ID         string        `json:"id" validate:"required"`
+	Vendor     enumor.Vendor `json:"vendor" validate:"required"`
+	RegionID   string        `json:"region_id"`
+	RegionName string        `json:"region_name"`
+	Status     string        `json:"status"`
+}
+
+// Validate kaopu region batch update request.
+func (req *KaopuRegionBatchUpdateReq) Validate() error {
+	if len(req.Regions) == 0 {
+		return errors.New("regions is required")
+	}
+
+	if len(req.Regions) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("regions count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return nil
+}
+
+// -------------------------- List --------------------------
+
+// KaopuRegionListReq kaopu region list req.
+type KaopuRegionListReq struct {
+	Field  []string           `json:"field" validate:"omitempty"`
+	Filter *filter.Expression `json:"filter" validate:"required"`
+	Page   *core.BasePage     `json:"page" validate:"required"`
+}
+
+// Validate kaopu region list request.
+func (req *KaopuRegionListReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// KaopuRegionListResp define kaopu region list resp.
+type KaopuRegionListResp struct {
+	rest.BaseResp `json:",inline"`
+	Data          *KaopuRegionListResult `json:"data"`
+}
+
+// KaopuRegionListResult define kaopu region list result.
+type KaopuRegionListResult struct {
+	Count   uint64               `json:"count,omitempty"`
+	Details []region.KaopuRegion `json:"details,omitempty"`
+}
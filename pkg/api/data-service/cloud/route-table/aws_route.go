@@ -22,6 +22,7 @@ package routetable
 import (
 	"hcm/pkg/api/core"
 	routetable "hcm/pkg/api/core/cloud/route-table"
+	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/criteria/errf"
 	"hcm/pkg/criteria/validator"
 	"hcm/pkg/rest"
@@ -41,23 +42,25 @@ func (c *AwsRouteBatchCreateReq) Validate() error {
 
 // AwsRouteCreateReq defines create aws route request.
 type AwsRouteCreateReq struct {
-	CloudRouteTableID                string  `json:"cloud_route_table_id"`
-	DestinationCidrBlock             *string `json:"destination_cidr_block,omitempty"`
-	DestinationIpv6CidrBlock         *string `json:"destination_ipv6_cidr_block,omitempty"`
-	CloudDestinationPrefixListID     *string `json:"cloud_destination_prefix_list_id,omitempty"`
-	CloudCarrierGatewayID            *string `json:"cloud_carrier_gateway_id,omitempty"`
-	CoreNetworkArn                   *string `json:"core_network_arn,omitempty"`
-	CloudEgressOnlyInternetGatewayID *string `json:"cloud_egress_only_internet_gateway_id,omitempty"`
-	CloudGatewayID                   *string `json:"cloud_gateway_id,omitempty"`
-	CloudInstanceID                  *string `json:"cloud_instance_id,omitempty"`
-	CloudInstanceOwnerID             *string `json:"cloud_instance_owner_id,omitempty"`
-	CloudLocalGatewayID              *string `json:"cloud_local_gateway_id,omitempty"`
-	CloudNatGatewayID                *string `json:"cloud_nat_gateway_id,omitempty"`
-	CloudNetworkInterfaceID          *string `json:"cloud_network_interface_id,omitempty"`
-	CloudTransitGatewayID            *string `json:"cloud_transit_gateway_id,omitempty"`
-	CloudVpcPeeringConnectionID      *string `json:"cloud_vpc_peering_connection_id,omitempty"`
-	State                            string  `json:"state"`
-	Propagated                       bool    `json:"propagated"`
+	CloudRouteTableID                string                   `json:"cloud_route_table_id"`
+	DestinationCidrBlock             *string                  `json:"destination_cidr_block,omitempty"`
+	DestinationIpv6CidrBlock         *string                  `json:"destination_ipv6_cidr_block,omitempty"`
+	CloudDestinationPrefixListID     *string                  `json:"cloud_destination_prefix_list_id,omitempty"`
+	CloudCarrierGatewayID            *string                  `json:"cloud_carrier_gateway_id,omitempty"`
+	CoreNetworkArn                   *string                  `json:"core_network_arn,omitempty"`
+	CloudEgressOnlyInternetGatewayID *string                  `json:"cloud_egress_only_internet_gateway_id,omitempty"`
+	CloudGatewayID                   *string                  `json:"cloud_gateway_id,omitempty"`
+	CloudInstanceID                  *string                  `json:"cloud_instance_id,omitempty"`
+	CloudInstanceOwnerID             *string                  `json:"cloud_instance_owner_id,omitempty"`
+	CloudLocalGatewayID              *string                  `json:"cloud_local_gateway_id,omitempty"`
+	CloudNatGatewayID                *string                  `json:"cloud_nat_gateway_id,omitempty"`
+	CloudNetworkInterfaceID          *string                  `json:"cloud_network_interface_id,omitempty"`
+	CloudTransitGatewayID            *string                  `json:"cloud_transit_gateway_id,omitempty"`
+	CloudVpcPeeringConnectionID      *string                  `json:"cloud_vpc_peering_connection_id,omitempty"`
+	NextHopResType                   enumor.CloudResourceType `json:"next_hop_res_type"`
+	NextHopResID                     string                   `json:"next_hop_res_id"`
+	State                            string                   `json:"state"`
+	Propagated                       bool                     `json:"propagated"`
 }
 
 // -------------------------- Update --------------------------
@@ -80,19 +83,21 @@ type AwsRouteUpdateReq struct {
 
 // AwsRouteUpdateInfo defines update aws route request base info.
 type AwsRouteUpdateInfo struct {
-	CloudCarrierGatewayID            *string `json:"cloud_carrier_gateway_id,omitempty"`
-	CoreNetworkArn                   *string `json:"core_network_arn,omitempty"`
-	CloudEgressOnlyInternetGatewayID *string `json:"cloud_egress_only_internet_gateway_id,omitempty"`
-	CloudGatewayID                   *string `json:"cloud_gateway_id,omitempty"`
-	CloudInstanceID                  *string `json:"cloud_instance_id,omitempty"`
-	CloudInstanceOwnerID             *string `json:"cloud_instance_owner_id,omitempty"`
-	CloudLocalGatewayID              *string `json:"cloud_local_gateway_id,omitempty"`
-	CloudNatGatewayID                *string `json:"cloud_nat_gateway_id,omitempty"`
-	CloudNetworkInterfaceID          *string `json:"cloud_network_interface_id,omitempty"`
-	CloudTransitGatewayID            *string `json:"cloud_transit_gateway_id,omitempty"`
-	CloudVpcPeeringConnectionID      *string `json:"cloud_vpc_peering_connection_id,omitempty"`
-	State                            string  `json:"state"`
-	Propagated                       *bool   `json:"propagated"`
+	CloudCarrierGatewayID            *string                  `json:"cloud_carrier_gateway_id,omitempty"`
+	CoreNetworkArn                   *string                  `json:"core_network_arn,omitempty"`
+	CloudEgressOnlyInternetGatewayID *string                  `json:"cloud_egress_only_internet_gateway_id,omitempty"`
+	CloudGatewayID                   *string                  `json:"cloud_gateway_id,omitempty"`
+	CloudInstanceID                  *string                  `json:"cloud_instance_id,omitempty"`
+	CloudInstanceOwnerID             *string                  `json:"cloud_instance_owner_id,omitempty"`
+	CloudLocalGatewayID              *string                  `json:"cloud_local_gateway_id,omitempty"`
+	CloudNatGatewayID                *string                  `json:"cloud_nat_gateway_id,omitempty"`
+	CloudNetworkInterfaceID          *string                  `json:"cloud_network_interface_id,omitempty"`
+	CloudTransitGatewayID            *string                  `json:"cloud_transit_gateway_id,omitempty"`
+	CloudVpcPeeringConnectionID      *string                  `json:"cloud_vpc_peering_connection_id,omitempty"`
+	NextHopResType                   enumor.CloudResourceType `json:"next_hop_res_type"`
+	NextHopResID                     string                   `json:"next_hop_res_id"`
+	State                            string                   `json:"state"`
+	Propagated                       *bool                    `json:"propagated"`
 }
 
 // -------------------------- List --------------------------
@@ -22,6 +22,7 @@ package routetable
 import (
 	"hcm/pkg/api/core"
 	routetable "hcm/pkg/api/core/cloud/route-table"
+	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/criteria/errf"
 	"hcm/pkg/criteria/validator"
 	"hcm/pkg/rest"
@@ -44,21 +45,23 @@ type GcpRouteCreateReq struct {
 	CloudID  string `json:"cloud_id" validate:"required"`
 	SelfLink string `json:"self_link" validate:"required"`
 	// Network self link
-	Network          string   `json:"network" validate:"required"`
-	Name             string   `json:"name" validate:"required"`
-	DestRange        string   `json:"dest_range" validate:"required"`
-	NextHopGateway   *string  `json:"next_hop_gateway,omitempty" validate:"omitempty"`
-	NextHopIlb       *string  `json:"next_hop_ilb,omitempty" validate:"omitempty"`
-	NextHopInstance  *string  `json:"next_hop_instance,omitempty" validate:"omitempty"`
-	NextHopIp        *string  `json:"next_hop_ip,omitempty" validate:"omitempty"`
-	NextHopNetwork   *string  `json:"next_hop_network,omitempty" validate:"omitempty"`
-	NextHopPeering   *string  `json:"next_hop_peering,omitempty" validate:"omitempty"`
-	NextHopVpnTunnel *string  `json:"next_hop_vpn_tunnel,omitempty" validate:"omitempty"`
-	Priority         int64    `json:"priority" validate:"required"`
-	RouteStatus      string   `json:"route_status" validate:"required"`
-	RouteType        string   `json:"route_type" validate:"required"`
-	Tags             []string `json:"tags,omitempty" validate:"omitempty"`
-	Memo             *string  `json:"memo,omitempty" validate:"omitempty"`
+	Network          string                   `json:"network" validate:"required"`
+	Name             string                   `json:"name" validate:"required"`
+	DestRange        string                   `json:"dest_range" validate:"required"`
+	NextHopGateway   *string                  `json:"next_hop_gateway,omitempty" validate:"omitempty"`
+	NextHopIlb       *string                  `json:"next_hop_ilb,omitempty" validate:"omitempty"`
+	NextHopInstance  *string                  `json:"next_hop_instance,omitempty" validate:"omitempty"`
+	NextHopIp        *string                  `json:"next_hop_ip,omitempty" validate:"omitempty"`
+	NextHopNetwork   *string                  `json:"next_hop_network,omitempty" validate:"omitempty"`
+	NextHopPeering   *string                  `json:"next_hop_peering,omitempty" validate:"omitempty"`
+	NextHopResType   enumor.CloudResourceType `json:"next_hop_res_type" validate:"omitempty"`
+	NextHopResID     string                   `json:"next_hop_res_id" validate:"omitempty"`
+	NextHopVpnTunnel *string                  `json:"next_hop_vpn_tunnel,omitempty" validate:"omitempty"`
+	Priority         int64                    `json:"priority" validate:"required"`
+	RouteStatus      string                   `json:"route_status" validate:"required"`
+	RouteType        string                   `json:"route_type" validate:"required"`
+	Tags             []string                 `json:"tags,omitempty" validate:"omitempty"`
+	Memo             *string                  `json:"memo,omitempty" validate:"omitempty"`
 }
 
 // -------------------------- List --------------------------
@@ -22,6 +22,7 @@ package routetable
 import (
 	"hcm/pkg/api/core"
 	routetable "hcm/pkg/api/core/cloud/route-table"
+	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/criteria/errf"
 	"hcm/pkg/criteria/validator"
 	"hcm/pkg/rest"
@@ -41,11 +42,13 @@ func (c *HuaWeiRouteBatchCreateReq) Validate() error {
 
 // HuaWeiRouteCreateReq defines create huawei route request.
 type HuaWeiRouteCreateReq struct {
-	CloudRouteTableID string  `json:"cloud_route_table_id" validate:"required"`
-	Type              string  `json:"type" validate:"required"`
-	Destination       string  `json:"destination" validate:"required"`
-	NextHop           string  `json:"nexthop" validate:"required"`
-	Memo              *string `json:"memo,omitempty" validate:"omitempty"`
+	CloudRouteTableID string                   `json:"cloud_route_table_id" validate:"required"`
+	Type              string                   `json:"type" validate:"required"`
+	Destination       string                   `json:"destination" validate:"required"`
+	NextHop           string                   `json:"nexthop" validate:"required"`
+	NextHopResType    enumor.CloudResourceType `json:"next_hop_res_type" validate:"omitempty"`
+	NextHopResID      string                   `json:"next_hop_res_id" validate:"omitempty"`
+	Memo              *string                  `json:"memo,omitempty" validate:"omitempty"`
 }
 
 // -------------------------- Update --------------------------
@@ -68,10 +71,12 @@ type HuaWeiRouteUpdateReq struct {
 
 // HuaWeiRouteUpdateInfo defines update huawei route request base info.
 type HuaWeiRouteUpdateInfo struct {
-	Type        string  `json:"type" validate:"omitempty"`
-	Destination string  `json:"destination" validate:"omitempty"`
-	NextHop     string  `json:"nexthop" validate:"omitempty"`
-	Memo        *string `json:"memo,omitempty" validate:"omitempty"`
+	Type           string                   `json:"type" validate:"omitempty"`
+	Destination    string                   `json:"destination" validate:"omitempty"`
+	NextHop        string                   `json:"nexthop" validate:"omitempty"`
+	NextHopResType enumor.CloudResourceType `json:"next_hop_res_type" validate:"omitempty"`
+	NextHopResID   string                   `json:"next_hop_res_id" validate:"omitempty"`
+	Memo           *string                  `json:"memo,omitempty" validate:"omitempty"`
 }
 
 // -------------------------- List --------------------------
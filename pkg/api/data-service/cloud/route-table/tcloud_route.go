@@ -22,6 +22,7 @@ package routetable
 import (
 	"hcm/pkg/api/core"
 	routetable "hcm/pkg/api/core/cloud/route-table"
+	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/criteria/errf"
 	"hcm/pkg/criteria/validator"
 	"hcm/pkg/rest"
@@ -41,16 +42,18 @@ func (c *TCloudRouteBatchCreateReq) Validate() error {
 
 // TCloudRouteCreateReq defines create tcloud route request.
 type TCloudRouteCreateReq struct {
-	CloudID                  string  `json:"cloud_id" validate:"required"`
-	CloudRouteTableID        string  `json:"cloud_route_table_id" validate:"required"`
-	DestinationCidrBlock     string  `json:"destination_cidr_block" validate:"required"`
-	DestinationIpv6CidrBlock *string `json:"destination_ipv6_cidr_block,omitempty" validate:"omitempty"`
-	GatewayType              string  `json:"gateway_type" validate:"required"`
-	CloudGatewayID           string  `json:"cloud_gateway_id" validate:"required"`
-	Enabled                  bool    `json:"enabled" validate:"-"`
-	RouteType                string  `json:"route_type" validate:"required"`
-	PublishedToVbc           bool    `json:"published_to_vbc" validate:"-"`
-	Memo                     *string `json:"memo,omitempty" validate:"omitempty"`
+	CloudID                  string                   `json:"cloud_id" validate:"required"`
+	CloudRouteTableID        string                   `json:"cloud_route_table_id" validate:"required"`
+	DestinationCidrBlock     string                   `json:"destination_cidr_block" validate:"required"`
+	DestinationIpv6CidrBlock *string                  `json:"destination_ipv6_cidr_block,omitempty" validate:"omitempty"`
+	GatewayType              string                   `json:"gateway_type" validate:"required"`
+	CloudGatewayID           string                   `json:"cloud_gateway_id" validate:"required"`
+	NextHopResType           enumor.CloudResourceType `json:"next_hop_res_type" validate:"omitempty"`
+	NextHopResID             string                   `json:"next_hop_res_id" validate:"omitempty"`
+	Enabled                  bool                     `json:"enabled" validate:"-"`
+	RouteType                string                   `json:"route_type" validate:"required"`
+	PublishedToVbc           bool                     `json:"published_to_vbc" validate:"-"`
+	Memo                     *string                  `json:"memo,omitempty" validate:"omitempty"`
 }
 
 // -------------------------- Update --------------------------
@@ -73,14 +76,16 @@ type TCloudRouteUpdateReq struct {
 
 // TCloudRouteUpdateInfo defines update tcloud route request base info.
 type TCloudRouteUpdateInfo struct {
-	DestinationCidrBlock     string  `json:"destination_cidr_block"`
-	DestinationIpv6CidrBlock *string `json:"destination_ipv6_cidr_block,omitempty"`
-	GatewayType              string  `json:"gateway_type"`
-	CloudGatewayID           string  `json:"cloud_gateway_id"`
-	Enabled                  *bool   `json:"enabled"`
-	RouteType                string  `json:"route_type"`
-	PublishedToVbc           *bool   `json:"published_to_vbc"`
-	Memo                     *string `json:"memo,omitempty"`
+	DestinationCidrBlock     string                   `json:"destination_cidr_block"`
+	DestinationIpv6CidrBlock *string                  `json:"destination_ipv6_cidr_block,omitempty"`
+	GatewayType              string                   `json:"gateway_type"`
+	CloudGatewayID           string                   `json:"cloud_gateway_id"`
+	NextHopResType           enumor.CloudResourceType `json:"next_hop_res_type"`
+	NextHopResID             string                   `json:"next_hop_res_id"`
+	Enabled                  *bool                    `json:"enabled"`
+	RouteType                string                   `json:"route_type"`
+	PublishedToVbc           *bool                    `json:"published_to_vbc"`
+	Memo                     *string                  `json:"memo,omitempty"`
 }
 
 // -------------------------- List --------------------------
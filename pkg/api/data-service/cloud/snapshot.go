@@ -0,0 +1,187 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package cloud
+
+import (
+	"errors"
+	"fmt"
+
+	"hcm/pkg/api/core"
+	coresnapshot "hcm/pkg/api/core/cloud/snapshot"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/runtime/filter"
+)
+
+// -------------------------- Create --------------------------
+
+// SnapshotBatchCreateReq snapshot batch create req.
+type SnapshotBatchCreateReq[Extension coresnapshot.Extension] struct {
+	Snapshots []SnapshotBatchCreate[Extension] `json:"snapshots" validate:"required,min=1"`
+}
+
+// SnapshotBatchCreate define snapshot batch create.
+type SnapshotBatchCreate[Extension coresnapshot.Extension] struct {
+	CloudID          string     `json:"cloud_id" validate:"required"`
+	AccountID        string     `json:"account_id" validate:"required"`
+	BkBizID          int64      `json:"bk_biz_id"`
+	Name             string     `json:"name"`
+	Region           string     `json:"region"`
+	Zone             string     `json:"zone"`
+	Status           string     `json:"status"`
+	Size             uint64     `json:"size"`
+	DiskID           string     `json:"disk_id"`
+	CloudDiskID      string     `json:"cloud_disk_id"`
+	CvmID            string     `json:"cvm_id"`
+	CloudCvmID       string     `json:"cloud_cvm_id"`
+	PolicyID         string     `json:"policy_id"`
+	CloudCreatedTime string     `json:"cloud_created_time"`
+	Memo             *string    `json:"memo"`
+	Extension        *Extension `json:"extension"`
+}
+
+// Validate snapshot batch create request.
+func (req *SnapshotBatchCreateReq[T]) Validate() error {
+	if len(req.Snapshots) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("snapshots count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// -------------------------- Update --------------------------
+
+// SnapshotExtUpdateReq 同步时更新快照，只更新云上属性
+type SnapshotExtUpdateReq[T coresnapshot.Extension] struct {
+	ID          string  `json:"id" validate:"required"`
+	Name        string  `json:"name"`
+	Status      string  `json:"status"`
+	Size        uint64  `json:"size"`
+	DiskID      string  `json:"disk_id"`
+	CloudDiskID string  `json:"cloud_disk_id"`
+	CvmID       string  `json:"cvm_id"`
+	CloudCvmID  string  `json:"cloud_cvm_id"`
+	Memo        *string `json:"memo"`
+	Extension   *T      `json:"extension"`
+}
+
+// SnapshotExtBatchUpdateReq ...
+type SnapshotExtBatchUpdateReq[T coresnapshot.Extension] []*SnapshotExtUpdateReq[T]
+
+// Validate ...
+func (req *SnapshotExtBatchUpdateReq[T]) Validate() error {
+	if len(*req) == 0 {
+		return errors.New("update items is required")
+	}
+
+	if len(*req) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("update items count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	for _, r := range *req {
+		if err := validator.Validate.Struct(r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SnapshotBatchUpdateExprReq 按ID批量更新快照的业务、策略等本地属性
+type SnapshotBatchUpdateExprReq struct {
+	IDs      []string `json:"ids" validate:"required,min=1"`
+	BkBizID  int64    `json:"bk_biz_id"`
+	PolicyID string   `json:"policy_id"`
+	Memo     *string  `json:"memo"`
+}
+
+// Validate ...
+func (req *SnapshotBatchUpdateExprReq) Validate() error {
+	if len(req.IDs) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("ids count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// -------------------------- List --------------------------
+
+// SnapshotListResult define snapshot list result.
+type SnapshotListResult = core.ListResultT[coresnapshot.BaseSnapshot]
+
+// SnapshotExtListResult define snapshot with extension list result.
+type SnapshotExtListResult[T coresnapshot.Extension] struct {
+	Count   uint64                     `json:"count,omitempty"`
+	Details []coresnapshot.Snapshot[T] `json:"details,omitempty"`
+}
+
+// -------------------------- Delete --------------------------
+
+// SnapshotBatchDeleteReq delete request.
+type SnapshotBatchDeleteReq struct {
+	Filter *filter.Expression `json:"filter" validate:"required"`
+}
+
+// Validate delete request.
+func (req *SnapshotBatchDeleteReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// -------------------------- Policy --------------------------
+
+// SnapshotPolicyCreateReq snapshot policy create req.
+type SnapshotPolicyCreateReq struct {
+	Vendor        enumor.Vendor `json:"vendor" validate:"required"`
+	AccountID     string        `json:"account_id" validate:"required"`
+	BkBizID       int64         `json:"bk_biz_id"`
+	Name          string        `json:"name" validate:"required,max=64"`
+	Spec          string        `json:"spec" validate:"required,max=64"`
+	RetentionDays uint          `json:"retention_days"`
+	DiskIDs       []string      `json:"disk_ids" validate:"max=100"`
+	Enabled       *bool         `json:"enabled" validate:"required"`
+	CronFlowID    string        `json:"cron_flow_id"`
+	Memo          *string       `json:"memo"`
+}
+
+// Validate ...
+func (req *SnapshotPolicyCreateReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// SnapshotPolicyUpdateReq snapshot policy update req.
+type SnapshotPolicyUpdateReq struct {
+	ID            string   `json:"id" validate:"required"`
+	Name          string   `json:"name" validate:"max=64"`
+	Spec          string   `json:"spec" validate:"max=64"`
+	RetentionDays *uint    `json:"retention_days"`
+	DiskIDs       []string `json:"disk_ids" validate:"max=100"`
+	Enabled       *bool    `json:"enabled"`
+	CronFlowID    string   `json:"cron_flow_id"`
+	Memo          *string  `json:"memo"`
+}
+
+// Validate ...
+func (req *SnapshotPolicyUpdateReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// SnapshotPolicyListResult define snapshot policy list result.
+type SnapshotPolicyListResult = core.ListResultT[coresnapshot.Policy]
@@ -57,7 +57,8 @@ func (c *SubnetBatchCreateReq[T]) Validate() error {
 
 // SubnetCreateExtension defines create subnet extensional info.
 type SubnetCreateExtension interface {
-	TCloudSubnetCreateExt | AwsSubnetCreateExt | GcpSubnetCreateExt | AzureSubnetCreateExt | HuaWeiSubnetCreateExt
+	TCloudSubnetCreateExt | AwsSubnetCreateExt | GcpSubnetCreateExt | AzureSubnetCreateExt | HuaWeiSubnetCreateExt |
+		ZenlayerSubnetCreateExt | KaopuSubnetCreateExt
 }
 
 // TCloudSubnetCreateExt defines create tencent cloud subnet extensional info.
@@ -103,6 +104,19 @@ type HuaWeiSubnetCreateExt struct {
 	NtpAddresses []string `json:"ntp_addresses" validate:"required"`
 }
 
+// ZenlayerSubnetCreateExt defines create zenlayer subnet extensional info.
+type ZenlayerSubnetCreateExt struct {
+	Status    string `json:"status" validate:"omitempty"`
+	GatewayIp string `json:"gateway_ip" validate:"omitempty"`
+	IsDefault bool   `json:"is_default" validate:"omitempty"`
+}
+
+// KaopuSubnetCreateExt defines create kaopu subnet extensional info.
+type KaopuSubnetCreateExt struct {
+	Status    string `json:"status" validate:"omitempty"`
+	GatewayIp string `json:"gateway_ip" validate:"omitempty"`
+}
+
 // -------------------------- Update --------------------------
 
 // SubnetBatchUpdateReq defines batch update subnet request.
@@ -136,7 +150,8 @@ type SubnetUpdateBaseInfo struct {
 
 // SubnetUpdateExtension defines subnet update request extensional info.
 type SubnetUpdateExtension interface {
-	TCloudSubnetUpdateExt | AwsSubnetUpdateExt | GcpSubnetUpdateExt | AzureSubnetUpdateExt | HuaWeiSubnetUpdateExt
+	TCloudSubnetUpdateExt | AwsSubnetUpdateExt | GcpSubnetUpdateExt | AzureSubnetUpdateExt | HuaWeiSubnetUpdateExt |
+		ZenlayerSubnetUpdateExt | KaopuSubnetUpdateExt
 }
 
 // TCloudSubnetUpdateExt defines update tencent cloud subnet extensional info.
@@ -183,6 +198,19 @@ type HuaWeiSubnetUpdateExt struct {
 	NtpAddresses []string `json:"ntp_addresses,omitempty" validate:"omitempty"`
 }
 
+// ZenlayerSubnetUpdateExt defines update zenlayer subnet extensional info.
+type ZenlayerSubnetUpdateExt struct {
+	Status    string `json:"status,omitempty" validate:"omitempty"`
+	GatewayIp string `json:"gateway_ip,omitempty" validate:"omitempty"`
+	IsDefault *bool  `json:"is_default,omitempty" validate:"omitempty"`
+}
+
+// KaopuSubnetUpdateExt defines update kaopu subnet extensional info.
+type KaopuSubnetUpdateExt struct {
+	Status    string `json:"status,omitempty" validate:"omitempty"`
+	GatewayIp string `json:"gateway_ip,omitempty" validate:"omitempty"`
+}
+
 // SubnetBaseInfoBatchUpdateReq defines batch update subnet base info request.
 type SubnetBaseInfoBatchUpdateReq struct {
 	Subnets []SubnetBaseInfoUpdateReq `json:"subnets" validate:"required"`
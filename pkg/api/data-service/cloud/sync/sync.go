@@ -54,6 +54,7 @@ type CreateField struct {
 	ResStatus       string          `json:"res_status" validate:"required"`
 	ResEndTime      string          `json:"res_end_time" validate:"required"`
 	ResFailedReason types.JsonField `json:"res_failed_reason" validate:"omitempty"`
+	ResWatermark    types.JsonField `json:"res_watermark" validate:"omitempty"`
 }
 
 // Validate CreateField.
@@ -89,6 +90,7 @@ type UpdateField struct {
 	ResStatus       string          `json:"res_status" validate:"required"`
 	ResEndTime      string          `json:"res_end_time" validate:"required"`
 	ResFailedReason types.JsonField `json:"res_failed_reason" validate:"omitempty"`
+	ResWatermark    types.JsonField `json:"res_watermark" validate:"omitempty"`
 }
 
 // Validate UpdateField.
@@ -103,3 +105,46 @@ type ListResult struct {
 	Count   uint64                            `json:"count"`
 	Details []coresync.AccountSyncDetailTable `json:"details"`
 }
+
+// -------------------------- Drift Report --------------------------
+
+// CreateDriftReportReq define create sync drift report request.
+type CreateDriftReportReq struct {
+	Items []CreateDriftReportField `json:"items" validate:"required,min=1,max=100"`
+}
+
+// Validate CreateDriftReportReq.
+func (req CreateDriftReportReq) Validate() error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+
+	for _, item := range req.Items {
+		if err := item.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CreateDriftReportField define sync drift report create field.
+type CreateDriftReportField struct {
+	Vendor    enumor.Vendor            `json:"vendor" validate:"required"`
+	AccountID string                   `json:"account_id" validate:"required"`
+	Region    string                   `json:"region" validate:"omitempty"`
+	ResType   enumor.CloudResourceType `json:"res_type" validate:"required"`
+	Summary   coresync.DriftSummary    `json:"summary" validate:"omitempty"`
+	Changes   []coresync.DriftChange   `json:"changes" validate:"omitempty"`
+}
+
+// Validate CreateDriftReportField.
+func (req CreateDriftReportField) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// ListDriftReportResult defines list sync drift report result.
+type ListDriftReportResult struct {
+	Count   uint64                     `json:"count"`
+	Details []coresync.SyncDriftReport `json:"details"`
+}
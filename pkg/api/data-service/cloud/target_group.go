@@ -111,8 +111,12 @@ func (req *TargetGroupBatchCreateReq[T]) Validate() error {
 // TCloudTargetGroupCreateReq ...
 type TCloudTargetGroupCreateReq = TargetGroupBatchCreateReq[corelb.TCloudTargetGroupExtension]
 
+// AwsTargetGroupCreateReq ...
+type AwsTargetGroupCreateReq = TargetGroupBatchCreateReq[corelb.AwsTargetGroupExtension]
+
 // TargetGroupBatchCreate define target group batch create.
 type TargetGroupBatchCreate[Extension corelb.TargetGroupExtension] struct {
+	CloudID         string                 `json:"cloud_id" validate:"omitempty"`
 	Name            string                 `json:"name" validate:"required"`
 	Vendor          enumor.Vendor          `json:"vendor" validate:"required"`
 	AccountID       string                 `json:"account_id" validate:"required"`
@@ -232,6 +236,9 @@ func (req *TargetGroupExtUpdateReq[T]) Validate() error {
 // TargetGroupBatchUpdateReq 目标组批量更新参数
 type TargetGroupBatchUpdateReq[T corelb.TargetGroupExtension] []*TargetGroupExtUpdateReq[T]
 
+// AwsTargetGroupBatchUpdateReq ...
+type AwsTargetGroupBatchUpdateReq = TargetGroupBatchUpdateReq[corelb.AwsTargetGroupExtension]
+
 // Validate ...
 func (req *TargetGroupBatchUpdateReq[T]) Validate() error {
 	for _, r := range *req {
@@ -310,6 +317,9 @@ func (req *TGListenerRelStatusUpdateReq) Validate() error {
 // TCloudListenerBatchCreateReq ...
 type TCloudListenerBatchCreateReq = ListenerBatchCreateReq[corelb.TCloudListenerExtension]
 
+// AwsListenerBatchCreateReq ...
+type AwsListenerBatchCreateReq = ListenerBatchCreateReq[corelb.AwsListenerExtension]
+
 // ListenerBatchCreateReq listener batch create req.
 type ListenerBatchCreateReq[T corelb.ListenerExtension] struct {
 	Listeners []ListenersCreateReq[T] `json:"listeners" validate:"required,min=1,dive,required"`
@@ -400,6 +410,9 @@ type ListenerBatchUpdateReq[Extension corelb.ListenerExtension] struct {
 // TCloudListenerUpdateReq ...
 type TCloudListenerUpdateReq = ListenerBatchUpdateReq[corelb.TCloudListenerExtension]
 
+// AwsListenerUpdateReq ...
+type AwsListenerUpdateReq = ListenerBatchUpdateReq[corelb.AwsListenerExtension]
+
 // Validate 验证监听器更新参数
 func (req *ListenerBatchUpdateReq[T]) Validate() error {
 	for _, item := range req.Listeners {
@@ -48,7 +48,8 @@ type VpcCreateReq[T VpcCreateExtension] struct {
 
 // VpcCreateExtension defines create vpc extensional info.
 type VpcCreateExtension interface {
-	TCloudVpcCreateExt | AwsVpcCreateExt | GcpVpcCreateExt | AzureVpcCreateExt | HuaWeiVpcCreateExt
+	TCloudVpcCreateExt | AwsVpcCreateExt | GcpVpcCreateExt | AzureVpcCreateExt | HuaWeiVpcCreateExt |
+		ZenlayerVpcCreateExt | KaopuVpcCreateExt
 }
 
 // TCloudVpcCreateExt defines create tencent cloud vpc extensional info.
@@ -94,6 +95,19 @@ type HuaWeiVpcCreateExt struct {
 	EnterpriseProjectID string       `json:"enterprise_project_id" validate:"omitempty"`
 }
 
+// ZenlayerVpcCreateExt defines zenlayer vpc extensional info.
+type ZenlayerVpcCreateExt struct {
+	Cidr      []ZenlayerCidr `json:"cidr" validate:"required,min=1"`
+	Status    string         `json:"status" validate:"omitempty"`
+	IsDefault bool           `json:"is_default" validate:"omitempty"`
+}
+
+// KaopuVpcCreateExt defines kaopu vpc extensional info.
+type KaopuVpcCreateExt struct {
+	Cidr   []KaopuCidr `json:"cidr" validate:"required,min=1"`
+	Status string      `json:"status" validate:"omitempty"`
+}
+
 // Validate VpcBatchCreateReq.
 func (c *VpcBatchCreateReq[T]) Validate() error {
 	return validator.Validate.Struct(c)
@@ -129,7 +143,8 @@ type VpcUpdateBaseInfo struct {
 
 // VpcUpdateExtension defines vpc update request extensional info.
 type VpcUpdateExtension interface {
-	TCloudVpcUpdateExt | AwsVpcUpdateExt | GcpVpcUpdateExt | AzureVpcUpdateExt | HuaWeiVpcUpdateExt
+	TCloudVpcUpdateExt | AwsVpcUpdateExt | GcpVpcUpdateExt | AzureVpcUpdateExt | HuaWeiVpcUpdateExt |
+		ZenlayerVpcUpdateExt | KaopuVpcUpdateExt
 }
 
 // TCloudVpcUpdateExt defines tencent cloud vpc extensional info.
@@ -172,6 +187,19 @@ type HuaWeiVpcUpdateExt struct {
 	EnterpriseProjectId *string      `json:"enterprise_project_id,omitempty" validate:"omitempty"`
 }
 
+// ZenlayerVpcUpdateExt defines zenlayer vpc extensional info.
+type ZenlayerVpcUpdateExt struct {
+	Cidr      []ZenlayerCidr `json:"cidr,omitempty" validate:"omitempty"`
+	Status    string         `json:"status,omitempty" validate:"omitempty"`
+	IsDefault *bool          `json:"is_default,omitempty" validate:"omitempty"`
+}
+
+// KaopuVpcUpdateExt defines kaopu vpc extensional info.
+type KaopuVpcUpdateExt struct {
+	Cidr   []KaopuCidr `json:"cidr,omitempty" validate:"omitempty"`
+	Status string      `json:"status,omitempty" validate:"omitempty"`
+}
+
 // VpcBaseInfoBatchUpdateReq defines batch update vpc base info request.
 type VpcBaseInfoBatchUpdateReq struct {
 	Vpcs []VpcBaseInfoUpdateReq `json:"vpcs" validate:"required"`
@@ -250,3 +278,15 @@ type HuaWeiCidr struct {
 	Type enumor.IPAddressType `json:"type" validate:"required"`
 	Cidr string               `json:"cidr" validate:"required"`
 }
+
+// ZenlayerCidr zenlayer cidr
+type ZenlayerCidr struct {
+	Type enumor.IPAddressType `json:"type" validate:"required"`
+	Cidr string               `json:"cidr" validate:"required"`
+}
+
+// KaopuCidr kaopu cidr
+type KaopuCidr struct {
+	Type enumor.IPAddressType `json:"type" validate:"required"`
+	Cidr string               `json:"cidr" validate:"required"`
+}
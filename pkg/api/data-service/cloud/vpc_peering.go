@@ -0,0 +1,129 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package cloud
+
+import (
+	"errors"
+	"fmt"
+
+	"hcm/pkg/api/core"
+	corevpcpeering "hcm/pkg/api/core/cloud/vpc-peering"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/runtime/filter"
+)
+
+// -------------------------- Create --------------------------
+
+// VpcPeeringBatchCreateReq vpc peering batch create req.
+type VpcPeeringBatchCreateReq[Extension corevpcpeering.Extension] struct {
+	VpcPeerings []VpcPeeringBatchCreate[Extension] `json:"vpc_peerings" validate:"required,min=1"`
+}
+
+// VpcPeeringBatchCreate define vpc peering batch create.
+type VpcPeeringBatchCreate[Extension corevpcpeering.Extension] struct {
+	CloudID          string     `json:"cloud_id" validate:"required"`
+	AccountID        string     `json:"account_id" validate:"required"`
+	BkBizID          int64      `json:"bk_biz_id"`
+	Name             string     `json:"name"`
+	Region           string     `json:"region"`
+	Status           string     `json:"status"`
+	VpcID            string     `json:"vpc_id"`
+	CloudVpcID       string     `json:"cloud_vpc_id"`
+	PeerVpcID        string     `json:"peer_vpc_id"`
+	CloudPeerVpcID   string     `json:"cloud_peer_vpc_id"`
+	PeerRegion       string     `json:"peer_region"`
+	PeerAccount      string     `json:"peer_account"`
+	CloudCreatedTime string     `json:"cloud_created_time"`
+	Memo             *string    `json:"memo"`
+	Extension        *Extension `json:"extension"`
+}
+
+// Validate vpc peering batch create request.
+func (req *VpcPeeringBatchCreateReq[T]) Validate() error {
+	if len(req.VpcPeerings) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("vpc_peerings count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	return validator.Validate.Struct(req)
+}
+
+// -------------------------- Update --------------------------
+
+// VpcPeeringExtUpdateReq 同步时更新对等连接，只更新云上属性及跟随本端VPC的业务
+type VpcPeeringExtUpdateReq[T corevpcpeering.Extension] struct {
+	ID             string  `json:"id" validate:"required"`
+	BkBizID        int64   `json:"bk_biz_id"`
+	Name           string  `json:"name"`
+	Status         string  `json:"status"`
+	VpcID          string  `json:"vpc_id"`
+	CloudVpcID     string  `json:"cloud_vpc_id"`
+	PeerVpcID      string  `json:"peer_vpc_id"`
+	CloudPeerVpcID string  `json:"cloud_peer_vpc_id"`
+	PeerRegion     string  `json:"peer_region"`
+	PeerAccount    string  `json:"peer_account"`
+	Memo           *string `json:"memo"`
+	Extension      *T      `json:"extension"`
+}
+
+// VpcPeeringExtBatchUpdateReq ...
+type VpcPeeringExtBatchUpdateReq[T corevpcpeering.Extension] []*VpcPeeringExtUpdateReq[T]
+
+// Validate ...
+func (req *VpcPeeringExtBatchUpdateReq[T]) Validate() error {
+	if len(*req) == 0 {
+		return errors.New("update items is required")
+	}
+
+	if len(*req) > constant.BatchOperationMaxLimit {
+		return fmt.Errorf("update items count should <= %d", constant.BatchOperationMaxLimit)
+	}
+
+	for _, r := range *req {
+		if err := validator.Validate.Struct(r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// -------------------------- List --------------------------
+
+// VpcPeeringListResult define vpc peering list result.
+type VpcPeeringListResult = core.ListResultT[corevpcpeering.BaseVpcPeering]
+
+// VpcPeeringExtListResult define vpc peering with extension list result.
+type VpcPeeringExtListResult[T corevpcpeering.Extension] struct {
+	Count   uint64                         `json:"count,omitempty"`
+	Details []corevpcpeering.VpcPeering[T] `json:"details,omitempty"`
+}
+
+// -------------------------- Delete --------------------------
+
+// VpcPeeringBatchDeleteReq delete request.
+type VpcPeeringBatchDeleteReq struct {
+	Filter *filter.Expression `json:"filter" validate:"required"`
+}
+
+// Validate delete request.
+func (req *VpcPeeringBatchDeleteReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
@@ -99,3 +99,31 @@ type AzureAccountCheckReq struct {
 func (r *AzureAccountCheckReq) Validate() error {
 	return validator.Validate.Struct(r)
 }
+
+// ZenlayerAccountCheckReq ...
+type ZenlayerAccountCheckReq struct {
+	CloudSecretID  string `json:"cloud_secret_id" validate:"required"`
+	CloudSecretKey string `json:"cloud_secret_key" validate:"required"`
+
+	CloudMainAccountID string `json:"cloud_main_account_id" validate:"required"`
+	CloudSubAccountID  string `json:"cloud_sub_account_id" validate:"required"`
+}
+
+// Validate ...
+func (r *ZenlayerAccountCheckReq) Validate() error {
+	return validator.Validate.Struct(r)
+}
+
+// KaopuAccountCheckReq ...
+type KaopuAccountCheckReq struct {
+	CloudSecretID  string `json:"cloud_secret_id" validate:"required"`
+	CloudSecretKey string `json:"cloud_secret_key" validate:"required"`
+
+	CloudMainAccountID string `json:"cloud_main_account_id" validate:"required"`
+	CloudSubAccountID  string `json:"cloud_sub_account_id" validate:"required"`
+}
+
+// Validate ...
+func (r *KaopuAccountCheckReq) Validate() error {
+	return validator.Validate.Struct(r)
+}
@@ -99,6 +99,33 @@ func (opt AwsBillListPage) Validate() error {
 	return nil
 }
 
+// AwsRootBillListReq define aws root account bill list req.
+type AwsRootBillListReq struct {
+	RootAccountID string `json:"root_account_id" validate:"required"`
+	// MainAccountCloudID 二级账号云上ID
+	MainAccountCloudID string `json:"main_account_cloud_id" validate:"required"`
+	// 起始日期，格式为yyyy-mm-dd，不支持跨月查询
+	BeginDate string `json:"begin_date" validate:"required"`
+	// 截止日期，格式为yyyy-mm-dd，不支持跨月查询
+	EndDate string           `json:"end_date" validate:"required"`
+	Page    *AwsBillListPage `json:"page" validate:"omitempty"`
+}
+
+// Validate aws root account bill list req.
+func (opt AwsRootBillListReq) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	listReq := AwsBillListReq{
+		AccountID: opt.RootAccountID,
+		BeginDate: opt.BeginDate,
+		EndDate:   opt.EndDate,
+		Page:      opt.Page,
+	}
+	return listReq.Validate()
+}
+
 // -------------------------- BillPipeline --------------------------
 
 // BillPipelineReq define bill pipeline request.
@@ -211,6 +238,40 @@ func (opt TCloudBillListReq) Validate() error {
 	return nil
 }
 
+// TCloudRootAccountBillListReq define tcloud root account bill list req.
+type TCloudRootAccountBillListReq struct {
+	RootAccountID string `json:"root_account_id" validate:"required"`
+	// MainAccountCloudID 二级账号云上UIN
+	MainAccountCloudID string `json:"main_account_cloud_id" validate:"required"`
+	// 月份，格式为yyyy-mm，不能早于开通账单2.0的月份，最多可拉取24个月内的数据,不支持跨月查询
+	Month string `json:"month" validate:"omitempty"`
+	// 起始日期，周期开始时间，格式为Y-m-d H:i:s，Month和BeginDate&EndDate必传一个，如果有该字段则Month字段无效
+	BeginDate string `json:"begin_date" validate:"omitempty"`
+	// 截止日期，周期结束时间，格式为Y-m-d H:i:s，Month和BeginDate&EndDate必传一个，如果有该字段则Month字段无效
+	EndDate string `json:"end_date" validate:"omitempty"`
+	// Limit: 最大值为100
+	Page *core.TCloudPage `json:"page" validate:"omitempty"`
+}
+
+// Validate tcloud root account bill list req.
+func (opt TCloudRootAccountBillListReq) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if opt.Month == "" && opt.BeginDate == "" && opt.EndDate == "" {
+		return errf.New(errf.InvalidParameter, "month and begin_date and end_date can not be empty")
+	}
+
+	if opt.Page != nil {
+		if err := opt.Page.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // HuaWeiBillListReq defines huawei bill list req.
 type HuaWeiBillListReq struct {
 	AccountID string `json:"account_id" validate:"required"`
@@ -289,6 +350,36 @@ func (opt AzureBillListReq) Validate() error {
 	return nil
 }
 
+// AzureRootBillListReq define azure root account bill list req.
+type AzureRootBillListReq struct {
+	RootAccountID string `json:"root_account_id" validate:"required"`
+	// SubscriptionID 二级账号对应的订阅ID
+	SubscriptionID string `json:"subscription_id" validate:"required"`
+	// 起始日期，格式为yyyy-mm-dd，不支持跨月查询
+	BeginDate string `json:"begin_date" validate:"required"`
+	// 截止日期，格式为yyyy-mm-dd，不支持跨月查询
+	EndDate string                   `json:"end_date" validate:"required"`
+	Page    *typesBill.AzureBillPage `json:"page" validate:"omitempty"`
+}
+
+// Validate azure root account bill list req.
+func (opt AzureRootBillListReq) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if opt.Page != nil {
+		if err := opt.Page.Validate(); err != nil {
+			return err
+		}
+		if opt.Page.Limit > typesBill.AzureQueryLimit {
+			return errf.New(errf.InvalidParameter, "page.limit should be <= 1000")
+		}
+	}
+
+	return nil
+}
+
 // GcpBillListReq defines gcp bill list req.
 type GcpBillListReq struct {
 	// BillAccountID bill账号ID
@@ -78,7 +78,8 @@ type AzureCreateReq struct {
 	InstanceType         string                  `json:"instance_type" validate:"required"`
 	CloudImageID         string                  `json:"cloud_image_id" validate:"required"`
 	Username             string                  `json:"username" validate:"required"`
-	Password             string                  `json:"password" validate:"required"`
+	Password             string                  `json:"password" validate:"required_without=KeyPairID"`
+	KeyPairID            string                  `json:"key_pair_id" validate:"omitempty"`
 	CloudSubnetID        string                  `json:"cloud_subnet_id" validate:"required"`
 	CloudSecurityGroupID string                  `json:"cloud_security_group_id" validate:"required"`
 	OSDisk               *typecvm.AzureOSDisk    `json:"os_disk" validate:"required"`
@@ -56,7 +56,8 @@ type GcpBatchCreateReq struct {
 	Zone          string `json:"zone" validate:"required"`
 	InstanceType  string `json:"instance_type" validate:"required"`
 	CloudImageID  string `json:"cloud_image_id" validate:"required"`
-	Password      string `json:"password" validate:"required"`
+	Password      string `json:"password" validate:"required_without=KeyPairID"`
+	KeyPairID     string `json:"key_pair_id" validate:"omitempty"`
 	RequiredCount int64  `json:"required_count" validate:"required"`
 	// RequestID 唯一标识支持生产请求
 	RequestID        string                `json:"request_id" validate:"omitempty"`
@@ -123,7 +123,8 @@ type TCloudBatchCreateReq struct {
 	Zone                    string                               `json:"zone" validate:"required"`
 	InstanceType            string                               `json:"instance_type" validate:"required"`
 	CloudImageID            string                               `json:"cloud_image_id" validate:"required"`
-	Password                string                               `json:"password" validate:"required"`
+	Password                string                               `json:"password" validate:"required_without=KeyPairID"`
+	KeyPairID               string                               `json:"key_pair_id" validate:"omitempty"`
 	RequiredCount           int64                                `json:"required_count" validate:"required"`
 	CloudSecurityGroupIDs   []string                             `json:"cloud_security_group_ids" validate:"required"`
 	ClientToken             *string                              `json:"client_token" validate:"omitempty"`
@@ -0,0 +1,68 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package hckeypair ...
+package hckeypair
+
+import (
+	"hcm/pkg/criteria/validator"
+)
+
+// KeyPairImportReq 导入公钥创建密钥对请求，azure 需指定资源组，gcp 为全局资源不需要指定地域
+type KeyPairImportReq struct {
+	AccountID         string `json:"account_id" validate:"required"`
+	Region            string `json:"region" validate:"omitempty"`
+	ResourceGroupName string `json:"resource_group_name" validate:"omitempty"`
+	Name              string `json:"name" validate:"required,max=255"`
+	PublicKey         string `json:"public_key" validate:"required"`
+}
+
+// Validate ...
+func (req *KeyPairImportReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// KeyPairImportResult 导入密钥对结果
+type KeyPairImportResult struct {
+	ID      string `json:"id"`
+	CloudID string `json:"cloud_id"`
+}
+
+// KeyPairDeleteReq ...
+type KeyPairDeleteReq struct {
+	ID string `json:"id" validate:"required"`
+}
+
+// Validate ...
+func (req *KeyPairDeleteReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// KeyPairBindReq 主机绑定/解绑密钥对请求，主机需要与密钥对属于同一账号和地域
+type KeyPairBindReq struct {
+	ID     string   `json:"id" validate:"required"`
+	CvmIDs []string `json:"cvm_ids" validate:"required,min=1,max=100"`
+	// ForceStop 主机处于运行中时是否强制关机，仅腾讯云支持
+	ForceStop *bool `json:"force_stop" validate:"omitempty"`
+}
+
+// Validate ...
+func (req *KeyPairBindReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
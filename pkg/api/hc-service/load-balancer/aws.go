@@ -0,0 +1,75 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package hclb
+
+import (
+	"errors"
+
+	typelb "hcm/pkg/adaptor/types/load-balancer"
+	"hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/validator"
+)
+
+// AwsLoadBalancerCreateReq aws 负载均衡(ALB/NLB)创建请求
+type AwsLoadBalancerCreateReq struct {
+	AccountID string `json:"account_id" validate:"required"`
+	BkBizID   int64  `json:"bk_biz_id"`
+	Region    string `json:"region" validate:"required"`
+	Name      string `json:"name" validate:"required,max=32"`
+
+	Type           typelb.AwsLoadBalancerType   `json:"type" validate:"required,oneof=application network"`
+	Scheme         typelb.AwsLoadBalancerScheme `json:"scheme" validate:"omitempty,oneof=internet-facing internal"`
+	IpAddressType  *string                      `json:"ip_address_type" validate:"omitempty"`
+	CloudSubnetIDs []string                     `json:"cloud_subnet_ids" validate:"required,min=1"`
+	SecurityGroups []string                     `json:"security_groups" validate:"omitempty"`
+}
+
+// Validate ...
+func (req *AwsLoadBalancerCreateReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// AwsBatchDeleteLoadBalancerReq aws 批量删除负载均衡请求
+type AwsBatchDeleteLoadBalancerReq struct {
+	AccountID string   `json:"account_id" validate:"required"`
+	Region    string   `json:"region" validate:"required"`
+	IDs       []string `json:"ids" validate:"required,min=1"`
+}
+
+// Validate ...
+func (r *AwsBatchDeleteLoadBalancerReq) Validate() error {
+	if len(r.IDs) > constant.BatchListenerMaxLimit {
+		return errors.New("batch delete limit is 20")
+	}
+	return validator.Validate.Struct(r)
+}
+
+// AwsBatchOperateTargetReq aws 批量操作目标组RS请求，实例类型目标使用 CloudInstID，ip类型目标使用 IP。
+// aws 目标组可以被多个负载均衡使用，因此不需要指定负载均衡ID
+type AwsBatchOperateTargetReq struct {
+	TargetGroupID string                 `json:"target_group_id" validate:"required"`
+	RsList        []*cloud.TargetBaseReq `json:"targets" validate:"required,min=1,max=100,dive"`
+}
+
+// Validate RsList最大支持100个.
+func (req *AwsBatchOperateTargetReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
@@ -0,0 +1,41 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package hclb
+
+import (
+	"errors"
+
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/validator"
+)
+
+// GcpBatchDeleteLoadBalancerReq gcp 批量删除负载均衡(转发规则)请求，地域从负载均衡中获取
+type GcpBatchDeleteLoadBalancerReq struct {
+	AccountID string   `json:"account_id" validate:"required"`
+	IDs       []string `json:"ids" validate:"required,min=1"`
+}
+
+// Validate ...
+func (r *GcpBatchDeleteLoadBalancerReq) Validate() error {
+	if len(r.IDs) > constant.BatchListenerMaxLimit {
+		return errors.New("batch delete limit is 20")
+	}
+	return validator.Validate.Struct(r)
+}
@@ -0,0 +1,68 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package hcprivatedns ...
+package hcprivatedns
+
+import (
+	typesdns "hcm/pkg/adaptor/types/private-dns"
+	"hcm/pkg/criteria/validator"
+)
+
+// RecordCreateReq 在私有域下创建解析记录请求，腾讯云每个记录值对应一条解析记录
+type RecordCreateReq struct {
+	ZoneID              string `json:"zone_id" validate:"required"`
+	typesdns.RecordSpec `json:",inline"`
+}
+
+// Validate ...
+func (req *RecordCreateReq) Validate() error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+
+	return req.RecordSpec.Validate()
+}
+
+// RecordCreateResult 创建解析记录结果
+type RecordCreateResult struct {
+	IDs []string `json:"ids"`
+}
+
+// RecordUpdateReq 修改解析记录值和TTL请求，主机记录和记录类型不支持修改
+type RecordUpdateReq struct {
+	ID    string   `json:"id" validate:"required"`
+	Value []string `json:"value" validate:"required,min=1,max=100"`
+	TTL   int64    `json:"ttl" validate:"omitempty,min=1,max=604800"`
+}
+
+// Validate ...
+func (req *RecordUpdateReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// RecordDeleteReq ...
+type RecordDeleteReq struct {
+	ID string `json:"id" validate:"required"`
+}
+
+// Validate ...
+func (req *RecordDeleteReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
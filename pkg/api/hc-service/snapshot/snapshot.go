@@ -0,0 +1,67 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package hcsnapshot ...
+package hcsnapshot
+
+import (
+	"hcm/pkg/criteria/validator"
+)
+
+// SnapshotCreateReq 基于云盘创建快照请求
+type SnapshotCreateReq struct {
+	DiskID string `json:"disk_id" validate:"required"`
+	Name   string `json:"name" validate:"required,max=60"`
+	// PolicyID 由快照策略创建时传入，用于按策略保留时间清理快照
+	PolicyID string  `json:"policy_id" validate:"omitempty"`
+	Memo     *string `json:"memo" validate:"omitempty"`
+}
+
+// Validate ...
+func (req *SnapshotCreateReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// SnapshotCreateResult 创建快照结果
+type SnapshotCreateResult struct {
+	// ID 快照同步到本地后的ID，快照在云上还未就绪时为空，由后续同步补充
+	ID      string `json:"id"`
+	CloudID string `json:"cloud_id"`
+}
+
+// SnapshotDeleteReq ...
+type SnapshotDeleteReq struct {
+	ID string `json:"id" validate:"required"`
+}
+
+// Validate ...
+func (req *SnapshotDeleteReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// SnapshotRollbackReq 快照回滚请求，azure、gcp 不支持原地回滚，通过快照创建名为 DiskName 的新云盘
+type SnapshotRollbackReq struct {
+	ID       string `json:"id" validate:"required"`
+	DiskName string `json:"disk_name" validate:"omitempty"`
+}
+
+// Validate ...
+func (req *SnapshotRollbackReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
@@ -20,7 +20,13 @@
 // Package sync ...
 package sync
 
-import "hcm/pkg/criteria/validator"
+import (
+	"errors"
+	"time"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+)
 
 // TCloudGlobalSyncReq tcloud sync request
 type TCloudGlobalSyncReq struct {
@@ -36,6 +42,10 @@ func (req *TCloudGlobalSyncReq) Validate() error {
 type TCloudSyncReq struct {
 	AccountID string `json:"account_id" validate:"required"`
 	Region    string `json:"region" validate:"required"`
+	// CloudIDs 指定同步的资源云ID，用于资源变更事件触发的增量同步，为空则同步该地域下的全量资源
+	CloudIDs []string `json:"cloud_ids" validate:"omitempty,max=100"`
+	// DryRun 只对比云上和db的资源，不写入db，返回并保存同步漂移报告
+	DryRun bool `json:"dry_run" validate:"omitempty"`
 }
 
 // Validate tcloud sync request.
@@ -57,6 +67,10 @@ func (req *AwsGlobalSyncReq) Validate() error {
 type AwsSyncReq struct {
 	AccountID string `json:"account_id" validate:"required"`
 	Region    string `json:"region" validate:"required"`
+	// CloudIDs 指定同步的资源云ID，用于资源变更事件触发的增量同步，为空则同步该地域下的全量资源
+	CloudIDs []string `json:"cloud_ids" validate:"omitempty,max=100"`
+	// DryRun 只对比云上和db的资源，不写入db，返回并保存同步漂移报告
+	DryRun bool `json:"dry_run" validate:"omitempty"`
 }
 
 // Validate aws sync request.
@@ -64,6 +78,32 @@ func (req *AwsSyncReq) Validate() error {
 	return validator.Validate.Struct(req)
 }
 
+// AwsChangedResListReq aws changed resource list request
+type AwsChangedResListReq struct {
+	AccountID string    `json:"account_id" validate:"required"`
+	Region    string    `json:"region" validate:"required"`
+	StartTime time.Time `json:"start_time" validate:"required"`
+	EndTime   time.Time `json:"end_time" validate:"required"`
+}
+
+// Validate aws changed resource list request.
+func (req *AwsChangedResListReq) Validate() error {
+	if err := validator.Validate.Struct(req); err != nil {
+		return err
+	}
+
+	if !req.StartTime.Before(req.EndTime) {
+		return errors.New("start_time should be before end_time")
+	}
+
+	return nil
+}
+
+// ChangedResListResult changed resource list result, key is resource type, value is changed resource cloud ids.
+type ChangedResListResult struct {
+	Details map[enumor.CloudResourceType][]string `json:"details"`
+}
+
 // HuaWeiGlobalSyncReq huawei sync request
 type HuaWeiGlobalSyncReq struct {
 	AccountID string `json:"account_id" validate:"required"`
@@ -78,6 +118,8 @@ func (req *HuaWeiGlobalSyncReq) Validate() error {
 type HuaWeiSyncReq struct {
 	AccountID string `json:"account_id" validate:"required"`
 	Region    string `json:"region" validate:"required"`
+	// DryRun 只对比云上和db的资源，不写入db，返回并保存同步漂移报告
+	DryRun bool `json:"dry_run" validate:"omitempty"`
 }
 
 // Validate huawei sync request.
@@ -113,6 +155,8 @@ func (req *GcpCvmSyncReq) Validate() error {
 type GcpSyncReq struct {
 	AccountID string `json:"account_id" validate:"required"`
 	Region    string `json:"region" validate:"required"`
+	// DryRun 只对比云上和db的资源，不写入db，返回并保存同步漂移报告
+	DryRun bool `json:"dry_run" validate:"omitempty"`
 }
 
 // Validate gcp sync request.
@@ -175,6 +219,8 @@ func (req *AzureGlobalSyncReq) Validate() error {
 type AzureSyncReq struct {
 	AccountID         string `json:"account_id" validate:"required"`
 	ResourceGroupName string `json:"resource_group_name" validate:"required"`
+	// DryRun 只对比云上和db的资源，不写入db，返回并保存同步漂移报告
+	DryRun bool `json:"dry_run" validate:"omitempty"`
 }
 
 // Validate azure sync request.
@@ -205,6 +251,54 @@ func (req *AzureImageReq) Validate() error {
 	return validator.Validate.Struct(req)
 }
 
+// ZenlayerGlobalSyncReq zenlayer sync request
+type ZenlayerGlobalSyncReq struct {
+	AccountID string `json:"account_id" validate:"required"`
+	// DryRun 只对比云上和db的资源，不写入db，返回并保存同步漂移报告
+	DryRun bool `json:"dry_run" validate:"omitempty"`
+}
+
+// Validate zenlayer sync request.
+func (req *ZenlayerGlobalSyncReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// ZenlayerSyncReq zenlayer sync request
+type ZenlayerSyncReq struct {
+	AccountID string `json:"account_id" validate:"required"`
+	Region    string `json:"region" validate:"required"`
+	// DryRun 只对比云上和db的资源，不写入db，返回并保存同步漂移报告
+	DryRun bool `json:"dry_run" validate:"omitempty"`
+}
+
+// Validate zenlayer sync request.
+func (req *ZenlayerSyncReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// KaopuGlobalSyncReq kaopu sync request
+type KaopuGlobalSyncReq struct {
+	AccountID string `json:"account_id" validate:"required"`
+}
+
+// Validate kaopu sync request.
+func (req *KaopuGlobalSyncReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
+// KaopuSyncReq kaopu sync request
+type KaopuSyncReq struct {
+	AccountID string `json:"account_id" validate:"required"`
+	Region    string `json:"region" validate:"required"`
+	// DryRun 只对比云上和db的资源，不写入db，返回并保存同步漂移报告
+	DryRun bool `json:"dry_run" validate:"omitempty"`
+}
+
+// Validate kaopu sync request.
+func (req *KaopuSyncReq) Validate() error {
+	return validator.Validate.Struct(req)
+}
+
 // TCloudListenerSyncReq 监听器同步
 type TCloudListenerSyncReq struct {
 	AccountID           string   `json:"account_id" validate:"required"`
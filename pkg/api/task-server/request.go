@@ -36,6 +36,10 @@ type AddTemplateFlowReq struct {
 	Tasks []TemplateFlowTask `json:"tasks" validate:"required, min=1"`
 	// IsInitState 是否初始化状态
 	IsInitState bool `json:"is_init_state" validate:"omitempty"`
+	// Compensate 任务流失败或被取消时，是否对已经执行成功的任务进行补偿
+	Compensate bool `json:"compensate" validate:"omitempty"`
+	// RunAt 计划执行时间，格式同created_at，不设置时立即执行
+	RunAt string `json:"run_at" validate:"omitempty"`
 }
 
 // Validate AddTemplateFlowReq
@@ -79,6 +83,10 @@ type AddCustomFlowReq struct {
 	Tasks []CustomFlowTask `json:"tasks" validate:"omitempty"`
 	// IsInitState 是否初始化状态
 	IsInitState bool `json:"is_init_state" validate:"omitempty"`
+	// Compensate 任务流失败或被取消时，是否对已经执行成功的任务进行补偿
+	Compensate bool `json:"compensate" validate:"omitempty"`
+	// RunAt 计划执行时间，格式同created_at，不设置时立即执行
+	RunAt string `json:"run_at" validate:"omitempty"`
 }
 
 // Validate AddCustomFlowReq
@@ -110,6 +118,8 @@ type CustomFlowTask struct {
 
 	// Retry 任务运行重试相关配置参数，如果不设置，默认不允许进行重试。
 	Retry *tableasync.Retry `json:"retry" validate:"omitempty"`
+	// TimeoutSec 任务单次执行超时时间，为0时使用执行器默认的超时时间。
+	TimeoutSec uint `json:"timeout_sec" validate:"omitempty"`
 }
 
 // Validate CustomFlowTask
@@ -32,3 +32,9 @@ type ListTaskResult struct {
 	Count   uint64                    `json:"count"`
 	Details []coreasync.AsyncFlowTask `json:"details"`
 }
+
+// ListCronFlowResult ...
+type ListCronFlowResult struct {
+	Count   uint64                    `json:"count"`
+	Details []coreasync.AsyncCronFlow `json:"details"`
+}
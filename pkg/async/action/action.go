@@ -39,6 +39,13 @@ type RollbackAction interface {
 	Rollback(kt run.ExecuteKit, params interface{}) error
 }
 
+// CompensateAction Action如果支持补偿操作，实现该接口。任务流开启补偿后，在任务流失败或被取消时，
+// 会按照依赖关系逆序对已经执行成功的任务调用补偿，补偿操作需要保证幂等。
+// State: success -> compensating -> compensated / compensate_failed
+type CompensateAction interface {
+	Compensate(kt run.ExecuteKit, params interface{}) error
+}
+
 // ParameterAction 如果任务运行需要依赖请求参数，需要通过该接口返回参数结构，会将任务实例中的参数内容解析到这个返回参数上。
 type ParameterAction interface {
 	// ParameterNew 返回新的参数结构。返回参数可以实现 Decoder 接口，自定义解码方式。
@@ -0,0 +1,131 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package action
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// ErrorClass 任务执行错误分类，决定任务执行失败后是否重试以及重试前的等待策略。
+type ErrorClass string
+
+const (
+	// ErrorRetryable 可重试错误，按照任务的重试策略进行重试，未分类的错误默认为可重试错误。
+	ErrorRetryable ErrorClass = "retryable"
+	// ErrorThrottled 被限流，按照限流退避策略等待更长时间后再重试。
+	ErrorThrottled ErrorClass = "throttled"
+	// ErrorPermanent 永久性错误，重试也无法成功，不再进行重试。
+	ErrorPermanent ErrorClass = "permanent"
+)
+
+// ErrorClassifier Action如果需要自定义错误分类，实现该接口。未实现时使用 ClassifyError 的默认分类。
+type ErrorClassifier interface {
+	ClassifyError(err error) ErrorClass
+}
+
+// throttledErrKeywords 云厂商限流错误码关键字
+var throttledErrKeywords = []string{
+	"RequestLimitExceeded",
+	"Throttling",
+	"TooManyRequests",
+	"rateLimitExceeded",
+}
+
+// ClassifyError 对Action执行返回的错误进行分类。优先使用Action实现的 ErrorClassifier，其次根据错误类型判断，
+// 最后根据云厂商限流错误码判断是否被限流，其余错误均为可重试错误。
+func ClassifyError(act Action, err error) ErrorClass {
+	if err == nil {
+		return ErrorRetryable
+	}
+
+	if classifier, ok := act.(ErrorClassifier); ok {
+		if class := classifier.ClassifyError(err); len(class) != 0 {
+			return class
+		}
+	}
+
+	var permanent *PermanentError
+	if errors.As(err, &permanent) {
+		return ErrorPermanent
+	}
+
+	var throttled *ThrottledError
+	if errors.As(err, &throttled) {
+		return ErrorThrottled
+	}
+
+	msg := err.Error()
+	for _, keyword := range throttledErrKeywords {
+		if strings.Contains(msg, keyword) {
+			return ErrorThrottled
+		}
+	}
+
+	return ErrorRetryable
+}
+
+// PermanentError 永久性错误，任务不再进行重试。
+type PermanentError struct {
+	Err error
+}
+
+// NewPermanentError new permanent error.
+func NewPermanentError(err error) error {
+	return &PermanentError{Err: err}
+}
+
+// Error return error message.
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap return wrapped error.
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// ThrottledError 被限流错误，任务按照限流退避策略重试。
+type ThrottledError struct {
+	Err error
+	// After 云厂商返回的建议重试等待时间，为0时使用重试策略计算的等待时间
+	After time.Duration
+}
+
+// NewThrottledError new throttled error.
+func NewThrottledError(err error, after time.Duration) error {
+	return &ThrottledError{Err: err, After: after}
+}
+
+// Error return error message.
+func (e *ThrottledError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap return wrapped error.
+func (e *ThrottledError) Unwrap() error {
+	return e.Err
+}
+
+// RetryAfter return the minimum wait time before retry.
+func (e *ThrottledError) RetryAfter() time.Duration {
+	return e.After
+}
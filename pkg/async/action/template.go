@@ -61,6 +61,9 @@ type TaskTemplate struct {
 
 	// Retry 任务运行重试相关配置参数，如果不设置，默认不允许进行重试。
 	Retry *tableasync.Retry `json:"retry" validate:"omitempty"`
+
+	// TimeoutSec 任务单次执行超时时间，为0时使用执行器默认的超时时间。
+	TimeoutSec uint `json:"timeout_sec" validate:"omitempty"`
 }
 
 // Validate TaskTemplate.
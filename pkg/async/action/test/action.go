@@ -22,6 +22,7 @@ package test
 import (
 	"errors"
 	"math/rand"
+	"sync"
 	"time"
 
 	"hcm/pkg/async/action"
@@ -70,21 +71,116 @@ func (act CreateFactory) Run(kt run.ExecuteKit, params interface{}) (interface{}
 }
 
 var _ action.Action = new(Produce)
+var _ action.CompensateAction = new(Produce)
 
 // Produce ...
 type Produce struct{}
 
+// ProduceParams 可选参数，Mark 用于记录补偿顺序
+type ProduceParams struct {
+	Mark string `json:"mark"`
+}
+
+// compensatedMarks 按补偿调用顺序记录的 ProduceParams.Mark
+var compensatedMarks = struct {
+	sync.Mutex
+	marks []string
+}{}
+
+// CompensatedMarks 返回按补偿调用顺序记录的标识
+func CompensatedMarks() []string {
+	compensatedMarks.Lock()
+	defer compensatedMarks.Unlock()
+
+	return append([]string(nil), compensatedMarks.marks...)
+}
+
+// ResetCompensatedMarks 清空补偿顺序记录
+func ResetCompensatedMarks() {
+	compensatedMarks.Lock()
+	defer compensatedMarks.Unlock()
+
+	compensatedMarks.marks = nil
+}
+
 // Name ...
 func (p Produce) Name() enumor.ActionName {
 	return enumor.ActionProduceTest
 }
 
+// ParameterNew ...
+func (p Produce) ParameterNew() interface{} {
+	return new(ProduceParams)
+}
+
 // Run ...
 func (p Produce) Run(kt run.ExecuteKit, params interface{}) (interface{}, error) {
 	logs.Infof(" ----------- Produce -----------, rid: %s", kt.Kit().Rid)
 	return nil, nil
 }
 
+// Compensate ...
+func (p Produce) Compensate(kt run.ExecuteKit, params interface{}) error {
+	logs.Infof(" ----------- Produce Compensate -----------, rid: %s", kt.Kit().Rid)
+
+	if req, ok := params.(*ProduceParams); ok && len(req.Mark) != 0 {
+		compensatedMarks.Lock()
+		compensatedMarks.marks = append(compensatedMarks.marks, req.Mark)
+		compensatedMarks.Unlock()
+	}
+	return nil
+}
+
+var _ action.Action = new(Fail)
+var _ action.RollbackAction = new(Fail)
+
+// Fail 执行必定失败，用于测试任务流失败场景
+type Fail struct{}
+
+// failRunTimes 按执行顺序记录的 Fail.Run 执行时间
+var failRunTimes = struct {
+	sync.Mutex
+	times []time.Time
+}{}
+
+// FailRunTimes 返回按执行顺序记录的 Fail.Run 执行时间
+func FailRunTimes() []time.Time {
+	failRunTimes.Lock()
+	defer failRunTimes.Unlock()
+
+	return append([]time.Time(nil), failRunTimes.times...)
+}
+
+// ResetFailRunTimes 清空 Fail.Run 执行时间记录
+func ResetFailRunTimes() {
+	failRunTimes.Lock()
+	defer failRunTimes.Unlock()
+
+	failRunTimes.times = nil
+}
+
+// Name ...
+func (f Fail) Name() enumor.ActionName {
+	return enumor.ActionFailTest
+}
+
+// Run ...
+func (f Fail) Run(kt run.ExecuteKit, params interface{}) (interface{}, error) {
+	logs.Infof(" ----------- Fail -----------, rid: %s", kt.Kit().Rid)
+
+	failRunTimes.Lock()
+	failRunTimes.times = append(failRunTimes.times, time.Now())
+	failRunTimes.Unlock()
+
+	return nil, errors.New("planned failed")
+}
+
+// Rollback ...
+func (f Fail) Rollback(kt run.ExecuteKit, params interface{}) error {
+	logs.Infof(" ----------- Fail Rollback -----------, rid: %s", kt.Kit().Rid)
+	return nil
+}
+
 var _ action.Action = new(Assemble)
 
 // Assemble ...
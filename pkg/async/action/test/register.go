@@ -26,6 +26,7 @@ func init() {
 	action.RegisterAction(Produce{})
 	action.RegisterAction(Assemble{})
 	action.RegisterAction(Sleep{})
+	action.RegisterAction(Fail{})
 
 	action.RegisterTpl(NormalTpl)
 	action.RegisterTpl(SleepTpl)
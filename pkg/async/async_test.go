@@ -0,0 +1,345 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package async
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/async/action"
+	"hcm/pkg/async/action/test"
+	"hcm/pkg/async/backend"
+	"hcm/pkg/async/backend/model"
+	"hcm/pkg/async/consumer"
+	"hcm/pkg/async/producer"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/dao/tools"
+	tableasync "hcm/pkg/dal/table/async"
+	tabletypes "hcm/pkg/dal/table/types"
+	"hcm/pkg/tools/times"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// singleNodeLeader 单节点场景下的主节点，当前节点永远是主节点
+type singleNodeLeader struct{}
+
+func (singleNodeLeader) IsLeader() bool { return true }
+
+func (singleNodeLeader) AliveNodes() ([]string, error) { return []string{"test-node"}, nil }
+
+func (singleNodeLeader) CurrNode() string { return "test-node" }
+
+func (singleNodeLeader) FencingToken() uint64 { return 1 }
+
+func newTestAsync(t *testing.T) (Async, backend.Backend) {
+	bd, err := backend.Factory(enumor.BackendMemory, nil)
+	if err != nil {
+		t.Fatalf("create memory backend failed, err: %v", err)
+	}
+
+	opt := &Option{
+		Register: prometheus.NewRegistry(),
+		ConsumerOption: &consumer.Option{
+			Scheduler:  &consumer.SchedulerOption{WatchIntervalSec: 1, WorkerNumber: 2},
+			Executor:   &consumer.ExecutorOption{WorkerNumber: 2, TaskExecTimeoutSec: 10},
+			Dispatcher: &consumer.DispatcherOption{WatchIntervalSec: 1},
+			WatchDog: &consumer.WatchDogOption{WatchIntervalSec: 1, TaskRunTimeoutSec: 60,
+				ShutdownWaitTimeSec: 10},
+		},
+	}
+	syn, err := NewAsync(bd, singleNodeLeader{}, opt)
+	if err != nil {
+		t.Fatalf("new async failed, err: %v", err)
+	}
+	if err = syn.GetConsumer().Start(); err != nil {
+		t.Fatalf("start consumer failed, err: %v", err)
+	}
+
+	return syn, bd
+}
+
+// waitFlowFinished 等待任务流进入终态，返回任务流和任务（按ActionID索引）
+func waitFlowFinished(t *testing.T, bd backend.Backend, flowID string) (model.Flow, map[action.ActIDType]model.Task) {
+	kt := core.NewBackendKit()
+	deadline := time.Now().Add(30 * time.Second)
+	for time.Now().Before(deadline) {
+		flows, err := bd.ListFlow(kt, &backend.ListInput{
+			Filter: tools.EqualExpression("id", flowID),
+			Page:   core.NewDefaultBasePage(),
+		})
+		if err != nil {
+			t.Fatalf("list flow failed, err: %v", err)
+		}
+
+		switch flows[0].State {
+		case enumor.FlowSuccess, enumor.FlowFailed, enumor.FlowCancel:
+			tasks, err := bd.ListTask(kt, &backend.ListInput{
+				Filter: tools.EqualExpression("flow_id", flowID),
+				Page:   core.NewDefaultBasePage(),
+			})
+			if err != nil {
+				t.Fatalf("list task failed, err: %v", err)
+			}
+
+			taskMap := make(map[action.ActIDType]model.Task, len(tasks))
+			for _, one := range tasks {
+				taskMap[one.ActionID] = one
+			}
+			return flows[0], taskMap
+		}
+
+		time.Sleep(200 * time.Millisecond)
+	}
+
+	t.Fatalf("flow %s not finished in time", flowID)
+	return model.Flow{}, nil
+}
+
+// getFlow 查询任务流当前状态
+func getFlow(t *testing.T, bd backend.Backend, flowID string) model.Flow {
+	flows, err := bd.ListFlow(core.NewBackendKit(), &backend.ListInput{
+		Filter: tools.EqualExpression("id", flowID),
+		Page:   core.NewDefaultBasePage(),
+	})
+	if err != nil {
+		t.Fatalf("list flow failed, err: %v", err)
+	}
+	if len(flows) == 0 {
+		t.Fatalf("flow %s not found", flowID)
+	}
+
+	return flows[0]
+}
+
+func TestNormalFlowWithMemoryBackend(t *testing.T) {
+	syn, bd := newTestAsync(t)
+
+	flowID, err := syn.GetProducer().AddTemplateFlow(core.NewBackendKit(), &producer.AddTemplateFlowOption{
+		Name: enumor.FlowNormalTest,
+		Tasks: []producer.TemplateFlowTask{{
+			ActionID: "1",
+			Params:   `{"name":"test","age":1}`,
+		}},
+	})
+	if err != nil {
+		t.Fatalf("add template flow failed, err: %v", err)
+	}
+
+	flow, tasks := waitFlowFinished(t, bd, flowID)
+	if flow.State != enumor.FlowSuccess {
+		t.Fatalf("flow should be success, got: %s, reason: %+v", flow.State, flow.Reason)
+	}
+	for _, one := range tasks {
+		if one.State != enumor.TaskSuccess {
+			t.Errorf("task %s(%s) should be success, got: %s", one.ID, one.ActionID, one.State)
+		}
+	}
+}
+
+func TestCompensateFlowWithMemoryBackend(t *testing.T) {
+	syn, bd := newTestAsync(t)
+	test.ResetCompensatedMarks()
+
+	params := func(mark string) tabletypes.JsonField {
+		field, err := tabletypes.NewJsonField(&test.ProduceParams{Mark: mark})
+		if err != nil {
+			t.Fatalf("marshal produce params failed, err: %v", err)
+		}
+		return field
+	}
+
+	// produce(1) -> produce(2) -> fail(3)，3 失败后按 2、1 的顺序补偿
+	flowID, err := syn.GetProducer().AddCustomFlow(core.NewBackendKit(), &producer.AddCustomFlowOption{
+		Name:       enumor.FlowNormalTest,
+		Compensate: true,
+		Tasks: []producer.CustomFlowTask{
+			{ActionID: "1", ActionName: enumor.ActionProduceTest, Params: params("1")},
+			{ActionID: "2", ActionName: enumor.ActionProduceTest, Params: params("2"),
+				DependOn: []action.ActIDType{"1"}},
+			{ActionID: "3", ActionName: enumor.ActionFailTest, DependOn: []action.ActIDType{"2"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("add custom flow failed, err: %v", err)
+	}
+
+	flow, tasks := waitFlowFinished(t, bd, flowID)
+	if flow.State != enumor.FlowFailed {
+		t.Fatalf("flow should be failed, got: %s, reason: %+v", flow.State, flow.Reason)
+	}
+	if !strings.Contains(flow.Reason.Message, "compensated: 2") {
+		t.Errorf("flow reason should record compensate result, got: %s", flow.Reason.Message)
+	}
+
+	expects := map[action.ActIDType]enumor.TaskState{
+		"1": enumor.TaskCompensated,
+		"2": enumor.TaskCompensated,
+		"3": enumor.TaskFailed,
+	}
+	for actionID, state := range expects {
+		if tasks[actionID].State != state {
+			t.Errorf("task %s should be %s, got: %s", actionID, state, tasks[actionID].State)
+		}
+	}
+	if marks := test.CompensatedMarks(); strings.Join(marks, ",") != "2,1" {
+		t.Errorf("tasks should be compensated in order [2 1], got: %v", marks)
+	}
+}
+
+func TestDeadLetterTaskWithMemoryBackend(t *testing.T) {
+	syn, bd := newTestAsync(t)
+	kt := core.NewBackendKit()
+
+	flowID, err := syn.GetProducer().AddCustomFlow(kt, &producer.AddCustomFlowOption{
+		Name: enumor.FlowNormalTest,
+		Tasks: []producer.CustomFlowTask{{
+			ActionID:   "1",
+			ActionName: enumor.ActionFailTest,
+			Retry: &tableasync.Retry{
+				Enable: true,
+				Policy: &tableasync.RetryPolicy{Count: 2, SleepRangeMS: [2]uint{10, 20}},
+			},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("add custom flow failed, err: %v", err)
+	}
+
+	flow, tasks := waitFlowFinished(t, bd, flowID)
+	if flow.State != enumor.FlowFailed {
+		t.Fatalf("flow should be failed, got: %s, reason: %+v", flow.State, flow.Reason)
+	}
+	if tasks["1"].State != enumor.TaskDeadLetter {
+		t.Fatalf("task should be dead_letter after retry exhausted, got: %s", tasks["1"].State)
+	}
+
+	result, err := syn.GetProducer().ReplayDeadLetterTasks(kt, &producer.ReplayDeadLetterTasksOption{
+		TaskIDs: []string{tasks["1"].ID, "not-exist"},
+	})
+	if err != nil {
+		t.Fatalf("replay dead letter tasks failed, err: %v", err)
+	}
+	if len(result.Succeeded) != 1 || len(result.Failed) != 1 {
+		t.Fatalf("replay result should have 1 succeeded and 1 failed, got: %+v", result)
+	}
+
+	// 重放后任务会再次执行，失败后重新进入死信状态
+	flow, tasks = waitFlowFinished(t, bd, flowID)
+	if flow.State != enumor.FlowFailed || tasks["1"].State != enumor.TaskDeadLetter {
+		t.Errorf("replayed flow should be failed with dead_letter task, got: %s, %s", flow.State, tasks["1"].State)
+	}
+}
+
+func TestRetryBackoffWithMemoryBackend(t *testing.T) {
+	syn, bd := newTestAsync(t)
+	test.ResetFailRunTimes()
+
+	policy := &tableasync.RetryPolicy{Count: 3, SleepRangeMS: [2]uint{300, 400}}
+	flowID, err := syn.GetProducer().AddCustomFlow(core.NewBackendKit(), &producer.AddCustomFlowOption{
+		Name: enumor.FlowNormalTest,
+		Tasks: []producer.CustomFlowTask{{
+			ActionID:   "1",
+			ActionName: enumor.ActionFailTest,
+			Retry:      &tableasync.Retry{Enable: true, Policy: policy},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("add custom flow failed, err: %v", err)
+	}
+
+	_, tasks := waitFlowFinished(t, bd, flowID)
+	if tasks["1"].State != enumor.TaskDeadLetter {
+		t.Fatalf("task should be dead_letter after retry exhausted, got: %s", tasks["1"].State)
+	}
+
+	runTimes := test.FailRunTimes()
+	if len(runTimes) != int(policy.Count) {
+		t.Fatalf("task should run %d times, got: %d", policy.Count, len(runTimes))
+	}
+	minWait := time.Duration(policy.SleepRangeMS[0]) * time.Millisecond
+	for i := 1; i < len(runTimes); i++ {
+		if wait := runTimes[i].Sub(runTimes[i-1]); wait < minWait {
+			t.Errorf("retry %d should wait at least %s before running, got: %s", i, minWait, wait)
+		}
+	}
+}
+
+func TestCronAndDelayedFlowWithMemoryBackend(t *testing.T) {
+	syn, bd := newTestAsync(t)
+	kt := core.NewBackendKit()
+
+	// 计划3秒后执行的任务流，在此之前不会被派发
+	runAt := times.ConvStdTimeNow().Add(3 * time.Second)
+	delayedID, err := syn.GetProducer().AddTemplateFlow(kt, &producer.AddTemplateFlowOption{
+		Name:  enumor.FlowNormalTest,
+		RunAt: times.ConvStdTimeFormat(runAt),
+		Tasks: []producer.TemplateFlowTask{{ActionID: "1", Params: `{"name":"test","age":1}`}},
+	})
+	if err != nil {
+		t.Fatalf("add delayed flow failed, err: %v", err)
+	}
+
+	// 已经到达执行时间的周期任务流，会被立即触发并推进下次执行时间
+	pastRunAt := times.ConvStdTimeFormat(times.ConvStdTimeNow().Add(-time.Minute))
+	cronID, err := bd.CreateCronFlow(kt, &model.CronFlow{
+		Name: "test",
+		Spec: "@daily",
+		Flow: model.Flow{
+			Name:  enumor.FlowNormalTest,
+			Tasks: []model.Task{{ActionID: "1", ActionName: enumor.ActionProduceTest}},
+		},
+		Enabled:   true,
+		NextRunAt: pastRunAt,
+	})
+	if err != nil {
+		t.Fatalf("create cron flow failed, err: %v", err)
+	}
+
+	time.Sleep(1500 * time.Millisecond)
+	if flow := getFlow(t, bd, delayedID); flow.State != enumor.FlowPending {
+		t.Errorf("delayed flow should be pending before run_at, got: %s", flow.State)
+	}
+
+	crons, err := bd.ListCronFlow(kt, &backend.ListInput{
+		Filter: tools.EqualExpression("id", cronID),
+		Page:   core.NewDefaultBasePage(),
+	})
+	if err != nil {
+		t.Fatalf("list cron flow failed, err: %v", err)
+	}
+	if len(crons[0].LastFlowID) == 0 || crons[0].NextRunAt == pastRunAt {
+		t.Fatalf("cron flow should be triggered, got: %+v", crons[0])
+	}
+
+	if flow, _ := waitFlowFinished(t, bd, crons[0].LastFlowID); flow.State != enumor.FlowSuccess {
+		t.Errorf("cron triggered flow should be success, got: %s", flow.State)
+	}
+
+	flow, _ := waitFlowFinished(t, bd, delayedID)
+	if flow.State != enumor.FlowSuccess {
+		t.Errorf("delayed flow should be success, got: %s", flow.State)
+	}
+	if time.Now().Before(runAt) {
+		t.Errorf("delayed flow should not finish before run_at")
+	}
+}
@@ -56,6 +56,30 @@ type Backend interface {
 
 	// RetryTask 重试任务 将flow置为running, task 置为pending
 	RetryTask(kt *kit.Kit, flowID, taskID string) error
+	// ReplayDeadLetterTasks 重放死信任务，同一事务内将task由dead_letter置为pending，flow由failed置为pending
+	ReplayDeadLetterTasks(kt *kit.Kit, flowID string, taskIDs []string) error
+
+	/*
+		CronFlow 相关接口
+	*/
+	// CreateCronFlow 创建周期任务流
+	CreateCronFlow(kt *kit.Kit, cron *model.CronFlow) (string, error)
+	// UpdateCronFlow 更新周期任务流的cron表达式、启用状态、下次执行时间及备注
+	UpdateCronFlow(kt *kit.Kit, cron *model.CronFlow) error
+	// ListCronFlow 查询周期任务流
+	ListCronFlow(kt *kit.Kit, input *ListInput) ([]model.CronFlow, error)
+	// DeleteCronFlow 删除周期任务流，已经创建的任务流不受影响
+	DeleteCronFlow(kt *kit.Kit, id string) error
+	// TriggerCronFlow 同一事务内CAS更新周期任务流的下次执行时间并创建任务流，返回创建的任务流ID，
+	// Flow为空时只更新下次执行时间，用于跳过本次执行
+	TriggerCronFlow(kt *kit.Kit, info *TriggerCronFlowInfo) (string, error)
+
+	/*
+		Leader 相关接口
+	*/
+	// AdvanceFencingToken 注册主节点屏障令牌，只会增大已注册的令牌，已注册的令牌更大时返回错误。
+	// 携带屏障令牌的CAS更新只有在令牌不小于已注册的令牌时才能成功。
+	AdvanceFencingToken(kt *kit.Kit, token uint64) error
 }
 
 // ListInput 查询输入参数
@@ -69,6 +93,24 @@ func (info *UpdateFlowInfo) Validate() error {
 	return validator.Validate.Struct(info)
 }
 
+// TriggerCronFlowInfo define trigger cron flow info.
+type TriggerCronFlowInfo struct {
+	ID string `json:"id" validate:"required"`
+	// PreRunAt 当前的下次执行时间，用于CAS更新
+	PreRunAt string `json:"pre_run_at" validate:"required"`
+	// NextRunAt 新的下次执行时间
+	NextRunAt string `json:"next_run_at" validate:"required"`
+	// Flow 本次触发需要创建的任务流
+	Flow *model.Flow `json:"flow" validate:"omitempty"`
+	// FencingToken 主节点屏障令牌，不为0时只有令牌不小于已注册的最大令牌才能更新成功
+	FencingToken uint64 `json:"fencing_token" validate:"omitempty"`
+}
+
+// Validate TriggerCronFlowInfo
+func (info *TriggerCronFlowInfo) Validate() error {
+	return validator.Validate.Struct(info)
+}
+
 // UpdateTaskInfo define update task info.
 type UpdateTaskInfo typesasync.UpdateTaskInfo
 
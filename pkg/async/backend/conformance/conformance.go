@@ -0,0 +1,660 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package conformance 异步任务框架 Backend 一致性测试集，所有 Backend 实现都需要通过该测试集。
+package conformance
+
+import (
+	"testing"
+	"time"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/async/action"
+	"hcm/pkg/async/backend"
+	"hcm/pkg/async/backend/model"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/dao/tools"
+	tableasync "hcm/pkg/dal/table/async"
+	"hcm/pkg/kit"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/times"
+)
+
+// Factory 为每个测试用例创建一个新的、数据为空的 Backend。
+type Factory func(t *testing.T) backend.Backend
+
+// Run 对给定的 Backend 实现执行一致性测试。
+func Run(t *testing.T, factory Factory) {
+	cases := []struct {
+		name string
+		run  func(t *testing.T, bd backend.Backend)
+	}{
+		{name: "CreateAndListFlow", run: testCreateAndListFlow},
+		{name: "ListFlowFilter", run: testListFlowFilter},
+		{name: "ListFlowPage", run: testListFlowPage},
+		{name: "FlowStateCAS", run: testFlowStateCAS},
+		{name: "BatchFlowStateCASAtomic", run: testBatchFlowStateCASAtomic},
+		{name: "TaskStateCAS", run: testTaskStateCAS},
+		{name: "UpdateTask", run: testUpdateTask},
+		{name: "RetryTask", run: testRetryTask},
+		{name: "ReplayDeadLetterTasks", run: testReplayDeadLetterTasks},
+		{name: "RunAtFilter", run: testRunAtFilter},
+		{name: "CronFlow", run: testCronFlow},
+		{name: "FencingToken", run: testFencingToken},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			c.run(t, factory(t))
+		})
+	}
+}
+
+func newKit() *kit.Kit {
+	return core.NewBackendKit()
+}
+
+// createFlow 创建一个包含 1 -> 2 两个任务的任务流
+func createFlow(t *testing.T, bd backend.Backend, state enumor.FlowState) (string, []model.Task) {
+	kt := newKit()
+	flow := &model.Flow{
+		Name:      enumor.FlowNormalTest,
+		ShareData: tableasync.NewShareData(map[string]string{"key": "value"}),
+		Memo:      "conformance",
+		State:     state,
+		Tasks: []model.Task{
+			{
+				FlowName:   enumor.FlowNormalTest,
+				ActionID:   "1",
+				ActionName: enumor.ActionCreateFactoryTest,
+				Params:     "{}",
+				State:      enumor.TaskPending,
+			},
+			{
+				FlowName:   enumor.FlowNormalTest,
+				ActionID:   "2",
+				ActionName: enumor.ActionProduceTest,
+				DependOn:   []action.ActIDType{"1"},
+				State:      enumor.TaskPending,
+			},
+		},
+	}
+	flowID, err := bd.CreateFlow(kt, flow)
+	if err != nil {
+		t.Fatalf("create flow failed, err: %v", err)
+	}
+
+	return flowID, listTasks(t, bd, flowID)
+}
+
+func getFlow(t *testing.T, bd backend.Backend, id string) model.Flow {
+	flows, err := bd.ListFlow(newKit(), &backend.ListInput{
+		Filter: tools.EqualExpression("id", id),
+		Page:   core.NewDefaultBasePage(),
+	})
+	if err != nil {
+		t.Fatalf("list flow failed, err: %v", err)
+	}
+	if len(flows) != 1 {
+		t.Fatalf("flow %s should be found once, got: %d", id, len(flows))
+	}
+
+	return flows[0]
+}
+
+func listTasks(t *testing.T, bd backend.Backend, flowID string) []model.Task {
+	tasks, err := bd.ListTask(newKit(), &backend.ListInput{
+		Filter: tools.EqualExpression("flow_id", flowID),
+		Page:   &core.BasePage{Limit: core.DefaultMaxPageLimit, Sort: "action_id"},
+	})
+	if err != nil {
+		t.Fatalf("list task failed, err: %v", err)
+	}
+
+	return tasks
+}
+
+func testCreateAndListFlow(t *testing.T, bd backend.Backend) {
+	flowID, tasks := createFlow(t, bd, enumor.FlowPending)
+	flow := getFlow(t, bd, flowID)
+	if flow.State != enumor.FlowPending {
+		t.Errorf("flow state should be pending, got: %s", flow.State)
+	}
+	if converter.PtrToVal(flow.Worker) != "" {
+		t.Errorf("flow worker should be empty, got: %s", converter.PtrToVal(flow.Worker))
+	}
+	if v, _ := flow.ShareData.Get("key"); v != "value" {
+		t.Errorf("flow share data should be persisted, got: %s", v)
+	}
+
+	if len(tasks) != 2 {
+		t.Fatalf("flow should have 2 tasks, got: %d", len(tasks))
+	}
+	for _, one := range tasks {
+		if one.FlowID != flowID || one.State != enumor.TaskPending {
+			t.Errorf("task %s should belong to flow %s with pending state, got flow: %s, state: %s", one.ID,
+				flowID, one.FlowID, one.State)
+		}
+	}
+	if len(tasks[1].DependOn) != 1 || tasks[1].DependOn[0] != "1" {
+		t.Errorf("task depend on should be persisted, got: %v", tasks[1].DependOn)
+	}
+
+	initID, _ := createFlow(t, bd, enumor.FlowInit)
+	if state := getFlow(t, bd, initID).State; state != enumor.FlowInit {
+		t.Errorf("init flow state should be kept, got: %s", state)
+	}
+}
+
+func testListFlowFilter(t *testing.T, bd backend.Backend) {
+	kt := newKit()
+	pendingID, _ := createFlow(t, bd, enumor.FlowPending)
+	scheduledID, _ := createFlow(t, bd, enumor.FlowPending)
+	initID, _ := createFlow(t, bd, enumor.FlowInit)
+
+	err := bd.BatchUpdateFlowStateByCAS(kt, []backend.UpdateFlowInfo{{
+		ID:     scheduledID,
+		Source: enumor.FlowPending,
+		Target: enumor.FlowScheduled,
+		Worker: converter.ValToPtr("node-1"),
+	}})
+	if err != nil {
+		t.Fatalf("update flow state failed, err: %v", err)
+	}
+
+	cases := []struct {
+		name   string
+		input  *backend.ListInput
+		expect []string
+	}{
+		{
+			name:   "equal",
+			input:  &backend.ListInput{Filter: tools.EqualExpression("state", enumor.FlowPending)},
+			expect: []string{pendingID},
+		},
+		{
+			name: "and",
+			input: &backend.ListInput{Filter: tools.ExpressionAnd(
+				tools.RuleEqual("state", enumor.FlowScheduled),
+				tools.RuleEqual("worker", "node-1"))},
+			expect: []string{scheduledID},
+		},
+		{
+			name: "in",
+			input: &backend.ListInput{Filter: tools.ContainersExpression("state",
+				[]enumor.FlowState{enumor.FlowInit, enumor.FlowScheduled})},
+			expect: []string{scheduledID, initID},
+		},
+		{
+			name: "or",
+			input: &backend.ListInput{Filter: tools.ExpressionOr(
+				tools.RuleEqual("id", pendingID),
+				tools.RuleEqual("id", initID))},
+			expect: []string{pendingID, initID},
+		},
+	}
+
+	for _, c := range cases {
+		c.input.Page = core.NewDefaultBasePage()
+		flows, err := bd.ListFlow(kt, c.input)
+		if err != nil {
+			t.Fatalf("%s: list flow failed, err: %v", c.name, err)
+		}
+
+		got := make(map[string]bool, len(flows))
+		for _, one := range flows {
+			got[one.ID] = true
+		}
+		if len(got) != len(c.expect) {
+			t.Errorf("%s: expect flows %v, got %v", c.name, c.expect, got)
+			continue
+		}
+		for _, id := range c.expect {
+			if !got[id] {
+				t.Errorf("%s: expect flows %v, got %v", c.name, c.expect, got)
+			}
+		}
+	}
+}
+
+func testListFlowPage(t *testing.T, bd backend.Backend) {
+	ids := make([]string, 0, 3)
+	for i := 0; i < 3; i++ {
+		id, _ := createFlow(t, bd, enumor.FlowPending)
+		ids = append(ids, id)
+	}
+
+	flows, err := bd.ListFlow(newKit(), &backend.ListInput{
+		Filter: tools.ContainersExpression("id", ids),
+		Page:   &core.BasePage{Start: 1, Limit: 1, Sort: "id", Order: core.Ascending},
+	})
+	if err != nil {
+		t.Fatalf("list flow failed, err: %v", err)
+	}
+	if len(flows) != 1 || flows[0].ID != ids[1] {
+		t.Errorf("page should return the second flow %s, got: %+v", ids[1], flows)
+	}
+}
+
+func testFlowStateCAS(t *testing.T, bd backend.Backend) {
+	kt := newKit()
+	flowID, _ := createFlow(t, bd, enumor.FlowPending)
+
+	err := bd.BatchUpdateFlowStateByCAS(kt, []backend.UpdateFlowInfo{{
+		ID:     flowID,
+		Source: enumor.FlowRunning,
+		Target: enumor.FlowSuccess,
+	}})
+	if err == nil {
+		t.Fatalf("cas update with wrong source state should fail")
+	}
+	if state := getFlow(t, bd, flowID).State; state != enumor.FlowPending {
+		t.Errorf("failed cas update should not change state, got: %s", state)
+	}
+
+	err = bd.BatchUpdateFlowStateByCAS(kt, []backend.UpdateFlowInfo{{
+		ID:     flowID,
+		Source: enumor.FlowPending,
+		Target: enumor.FlowScheduled,
+		Worker: converter.ValToPtr("node-1"),
+		Reason: &tableasync.Reason{Message: "dispatch"},
+	}})
+	if err != nil {
+		t.Fatalf("cas update flow failed, err: %v", err)
+	}
+
+	flow := getFlow(t, bd, flowID)
+	if flow.State != enumor.FlowScheduled || converter.PtrToVal(flow.Worker) != "node-1" {
+		t.Errorf("flow should be scheduled to node-1, got state: %s, worker: %s", flow.State,
+			converter.PtrToVal(flow.Worker))
+	}
+	if flow.Reason == nil || flow.Reason.Message != "dispatch" {
+		t.Errorf("flow reason should be updated, got: %+v", flow.Reason)
+	}
+}
+
+func testBatchFlowStateCASAtomic(t *testing.T, bd backend.Backend) {
+	kt := newKit()
+	firstID, _ := createFlow(t, bd, enumor.FlowPending)
+	secondID, _ := createFlow(t, bd, enumor.FlowPending)
+
+	err := bd.BatchUpdateFlowStateByCAS(kt, []backend.UpdateFlowInfo{
+		{ID: firstID, Source: enumor.FlowPending, Target: enumor.FlowScheduled},
+		{ID: secondID, Source: enumor.FlowRunning, Target: enumor.FlowScheduled},
+	})
+	if err == nil {
+		t.Fatalf("batch cas update should fail when one of flows not match")
+	}
+
+	if state := getFlow(t, bd, firstID).State; state != enumor.FlowPending {
+		t.Errorf("batch cas update should be atomic, first flow state: %s", state)
+	}
+}
+
+func testTaskStateCAS(t *testing.T, bd backend.Backend) {
+	kt := newKit()
+	flowID, tasks := createFlow(t, bd, enumor.FlowPending)
+
+	err := bd.UpdateTaskStateByCAS(kt, &backend.UpdateTaskInfo{
+		ID:     tasks[0].ID,
+		Source: enumor.TaskRunning,
+		Target: enumor.TaskSuccess,
+	})
+	if err == nil {
+		t.Fatalf("task cas update with wrong source state should fail")
+	}
+
+	err = bd.UpdateTaskStateByCAS(kt, &backend.UpdateTaskInfo{
+		ID:     tasks[0].ID,
+		Source: enumor.TaskPending,
+		Target: enumor.TaskRunning,
+		Reason: &tableasync.Reason{Message: "run"},
+	})
+	if err != nil {
+		t.Fatalf("task cas update failed, err: %v", err)
+	}
+
+	tasks = listTasks(t, bd, flowID)
+	if tasks[0].State != enumor.TaskRunning || tasks[0].Reason.Message != "run" {
+		t.Errorf("task should be running with reason, got state: %s, reason: %+v", tasks[0].State, tasks[0].Reason)
+	}
+	if tasks[1].State != enumor.TaskPending {
+		t.Errorf("other task should not be changed, got: %s", tasks[1].State)
+	}
+}
+
+func testUpdateTask(t *testing.T, bd backend.Backend) {
+	kt := newKit()
+	flowID, tasks := createFlow(t, bd, enumor.FlowPending)
+
+	md := &model.Task{
+		ID:     tasks[0].ID,
+		State:  enumor.TaskSuccess,
+		Result: `{"ok":true}`,
+		Reason: &tableasync.Reason{Message: "done"},
+	}
+	if err := bd.UpdateTask(kt, md); err != nil {
+		t.Fatalf("update task failed, err: %v", err)
+	}
+
+	tasks = listTasks(t, bd, flowID)
+	if tasks[0].State != enumor.TaskSuccess || tasks[0].Reason.Message != "done" || len(tasks[0].Result) == 0 {
+		t.Errorf("task should be updated, got: %+v", tasks[0])
+	}
+
+	err := bd.BatchUpdateFlow(kt, []model.Flow{{ID: flowID, State: enumor.FlowRunning, Worker: converter.ValToPtr("node-2")}})
+	if err != nil {
+		t.Fatalf("update flow failed, err: %v", err)
+	}
+	flow := getFlow(t, bd, flowID)
+	if flow.State != enumor.FlowRunning || converter.PtrToVal(flow.Worker) != "node-2" {
+		t.Errorf("flow should be updated, got state: %s, worker: %s", flow.State, converter.PtrToVal(flow.Worker))
+	}
+}
+
+func testRetryTask(t *testing.T, bd backend.Backend) {
+	kt := newKit()
+	flowID, tasks := createFlow(t, bd, enumor.FlowPending)
+
+	if err := bd.RetryTask(kt, flowID, tasks[0].ID); err == nil {
+		t.Fatalf("retry task of not failed flow should fail")
+	}
+
+	err := bd.BatchUpdateFlowStateByCAS(kt, []backend.UpdateFlowInfo{{
+		ID:     flowID,
+		Source: enumor.FlowPending,
+		Target: enumor.FlowFailed,
+	}})
+	if err != nil {
+		t.Fatalf("update flow state failed, err: %v", err)
+	}
+
+	if err = bd.RetryTask(kt, flowID, tasks[0].ID); err == nil {
+		t.Fatalf("retry not failed task should fail")
+	}
+
+	if err = bd.UpdateTask(kt, &model.Task{ID: tasks[0].ID, State: enumor.TaskFailed}); err != nil {
+		t.Fatalf("update task failed, err: %v", err)
+	}
+
+	if err = bd.RetryTask(kt, flowID, tasks[0].ID); err != nil {
+		t.Fatalf("retry task failed, err: %v", err)
+	}
+
+	if state := getFlow(t, bd, flowID).State; state != enumor.FlowPending {
+		t.Errorf("flow should be pending after retry, got: %s", state)
+	}
+	if state := listTasks(t, bd, flowID)[0].State; state != enumor.TaskPending {
+		t.Errorf("task should be pending after retry, got: %s", state)
+	}
+
+	if err = bd.RetryTask(kt, flowID, "not-exist"); err == nil {
+		t.Errorf("retry not exist task should fail")
+	}
+}
+
+func testReplayDeadLetterTasks(t *testing.T, bd backend.Backend) {
+	kt := newKit()
+	flowID, tasks := createFlow(t, bd, enumor.FlowPending)
+	ids := []string{tasks[0].ID, tasks[1].ID}
+
+	err := bd.BatchUpdateFlowStateByCAS(kt, []backend.UpdateFlowInfo{{
+		ID:     flowID,
+		Source: enumor.FlowPending,
+		Target: enumor.FlowFailed,
+	}})
+	if err != nil {
+		t.Fatalf("update flow state failed, err: %v", err)
+	}
+
+	if err = bd.UpdateTask(kt, &model.Task{ID: tasks[0].ID, State: enumor.TaskDeadLetter}); err != nil {
+		t.Fatalf("update task failed, err: %v", err)
+	}
+
+	// 部分任务不是死信状态，整体失败，不能有任务被更新
+	if err = bd.ReplayDeadLetterTasks(kt, flowID, ids); err == nil {
+		t.Fatalf("replay not dead letter task should fail")
+	}
+	if state := listTasks(t, bd, flowID)[0].State; state != enumor.TaskDeadLetter {
+		t.Errorf("task should keep dead_letter after replay failed, got: %s", state)
+	}
+
+	if err = bd.UpdateTask(kt, &model.Task{ID: tasks[1].ID, State: enumor.TaskDeadLetter}); err != nil {
+		t.Fatalf("update task failed, err: %v", err)
+	}
+
+	if err = bd.ReplayDeadLetterTasks(kt, flowID, ids); err != nil {
+		t.Fatalf("replay dead letter tasks failed, err: %v", err)
+	}
+
+	if state := getFlow(t, bd, flowID).State; state != enumor.FlowPending {
+		t.Errorf("flow should be pending after replay, got: %s", state)
+	}
+	for _, one := range listTasks(t, bd, flowID) {
+		if one.State != enumor.TaskPending {
+			t.Errorf("task %s should be pending after replay, got: %s", one.ID, one.State)
+		}
+	}
+
+	if err = bd.ReplayDeadLetterTasks(kt, flowID, ids); err == nil {
+		t.Errorf("replay tasks of not failed flow should fail")
+	}
+}
+
+func testRunAtFilter(t *testing.T, bd backend.Backend) {
+	kt := newKit()
+	now := times.ConvStdTimeNow()
+	runAt := times.ConvStdTimeFormat(now.Add(time.Hour))
+	flowID, err := bd.CreateFlow(kt, &model.Flow{
+		Name:  enumor.FlowNormalTest,
+		RunAt: runAt,
+		Tasks: []model.Task{{FlowName: enumor.FlowNormalTest, ActionID: "1",
+			ActionName: enumor.ActionCreateFactoryTest}},
+	})
+	if err != nil {
+		t.Fatalf("create flow failed, err: %v", err)
+	}
+
+	if got := getFlow(t, bd, flowID).RunAt; got != runAt {
+		t.Errorf("flow run_at should be %s, got: %s", runAt, got)
+	}
+
+	for _, c := range []struct {
+		at     time.Time
+		expect int
+	}{{at: now, expect: 0}, {at: now.Add(2 * time.Hour), expect: 1}} {
+		flows, err := bd.ListFlow(kt, &backend.ListInput{
+			Filter: tools.ExpressionAnd(
+				tools.RuleEqual("state", enumor.FlowPending),
+				tools.RuleLessThanEqual("run_at", times.ConvStdTimeFormat(c.at)),
+			),
+			Page: core.NewDefaultBasePage(),
+		})
+		if err != nil {
+			t.Fatalf("list flow failed, err: %v", err)
+		}
+		if len(flows) != c.expect {
+			t.Errorf("list flow run_at <= %s should get %d, got: %d", c.at, c.expect, len(flows))
+		}
+	}
+}
+
+func testCronFlow(t *testing.T, bd backend.Backend) {
+	kt := newKit()
+	nextRunAt := times.ConvStdTimeFormat(times.ConvStdTimeNow().Truncate(time.Minute))
+	cron := &model.CronFlow{
+		Name: "conformance",
+		Spec: "@daily",
+		Flow: model.Flow{
+			Name:      enumor.FlowNormalTest,
+			ShareData: tableasync.NewShareData(map[string]string{"key": "value"}),
+			Tasks: []model.Task{
+				{ActionID: "1", ActionName: enumor.ActionCreateFactoryTest, Params: "{}"},
+				{ActionID: "2", ActionName: enumor.ActionProduceTest, DependOn: []action.ActIDType{"1"}},
+			},
+		},
+		Enabled:   true,
+		NextRunAt: nextRunAt,
+	}
+	id, err := bd.CreateCronFlow(kt, cron)
+	if err != nil {
+		t.Fatalf("create cron flow failed, err: %v", err)
+	}
+
+	if _, err = bd.CreateCronFlow(kt, cron); err == nil {
+		t.Errorf("create cron flow with duplicate name should fail")
+	}
+
+	listCron := func() model.CronFlow {
+		crons, err := bd.ListCronFlow(kt, &backend.ListInput{
+			Filter: tools.EqualExpression("id", id),
+			Page:   core.NewDefaultBasePage(),
+		})
+		if err != nil {
+			t.Fatalf("list cron flow failed, err: %v", err)
+		}
+		if len(crons) != 1 {
+			t.Fatalf("cron flow %s should exist, got: %d", id, len(crons))
+		}
+		return crons[0]
+	}
+
+	got := listCron()
+	if got.Name != cron.Name || got.Spec != cron.Spec || !got.Enabled || got.NextRunAt != nextRunAt {
+		t.Errorf("cron flow mismatch, got: %+v", got)
+	}
+	if len(got.Flow.Tasks) != 2 || got.Flow.Tasks[1].DependOn[0] != "1" {
+		t.Errorf("cron flow tasks mismatch, got: %+v", got.Flow.Tasks)
+	}
+
+	secondRunAt := times.ConvStdTimeFormat(times.ConvStdTimeNow().Truncate(time.Minute).Add(24 * time.Hour))
+	flow := got.Flow
+	flow.RunAt = got.NextRunAt
+
+	// 下次执行时间不匹配时，不能创建任务流
+	_, err = bd.TriggerCronFlow(kt, &backend.TriggerCronFlowInfo{ID: id, PreRunAt: secondRunAt,
+		NextRunAt: secondRunAt, Flow: &flow})
+	if err == nil {
+		t.Fatalf("trigger cron flow with wrong pre_run_at should fail")
+	}
+
+	flowID, err := bd.TriggerCronFlow(kt, &backend.TriggerCronFlowInfo{ID: id, PreRunAt: nextRunAt,
+		NextRunAt: secondRunAt, Flow: &flow})
+	if err != nil {
+		t.Fatalf("trigger cron flow failed, err: %v", err)
+	}
+
+	created := getFlow(t, bd, flowID)
+	if created.RunAt != nextRunAt || created.Name != enumor.FlowNormalTest {
+		t.Errorf("triggered flow mismatch, got: %+v", created)
+	}
+	if tasks := listTasks(t, bd, flowID); len(tasks) != 2 {
+		t.Errorf("triggered flow should have 2 tasks, got: %d", len(tasks))
+	}
+
+	got = listCron()
+	if got.NextRunAt != secondRunAt || got.LastFlowID != flowID {
+		t.Errorf("cron flow should be updated after trigger, got: %+v", got)
+	}
+
+	// 不传任务流时只推进下次执行时间
+	thirdRunAt := times.ConvStdTimeFormat(times.ConvStdTimeNow().Truncate(time.Minute).Add(48 * time.Hour))
+	if _, err = bd.TriggerCronFlow(kt, &backend.TriggerCronFlowInfo{ID: id, PreRunAt: secondRunAt,
+		NextRunAt: thirdRunAt}); err != nil {
+		t.Fatalf("skip cron flow failed, err: %v", err)
+	}
+	if got = listCron(); got.NextRunAt != thirdRunAt || got.LastFlowID != flowID {
+		t.Errorf("cron flow should only update next_run_at after skip, got: %+v", got)
+	}
+
+	if err = bd.UpdateCronFlow(kt, &model.CronFlow{ID: id, Spec: "0 2 * * *", Enabled: false}); err != nil {
+		t.Fatalf("update cron flow failed, err: %v", err)
+	}
+	if got = listCron(); got.Spec != "0 2 * * *" || got.Enabled || got.NextRunAt != thirdRunAt {
+		t.Errorf("cron flow mismatch after update, got: %+v", got)
+	}
+
+	if err = bd.DeleteCronFlow(kt, id); err != nil {
+		t.Fatalf("delete cron flow failed, err: %v", err)
+	}
+	crons, err := bd.ListCronFlow(kt, &backend.ListInput{Filter: tools.EqualExpression("id", id),
+		Page: core.NewDefaultBasePage()})
+	if err != nil || len(crons) != 0 {
+		t.Errorf("cron flow should be deleted, got: %d, err: %v", len(crons), err)
+	}
+}
+
+func testFencingToken(t *testing.T, bd backend.Backend) {
+	kt := newKit()
+	flowID, _ := createFlow(t, bd, enumor.FlowPending)
+
+	// 尚未注册屏障令牌时，携带令牌的更新不能成功
+	err := bd.BatchUpdateFlowStateByCAS(kt, []backend.UpdateFlowInfo{{
+		ID:           flowID,
+		Source:       enumor.FlowPending,
+		Target:       enumor.FlowScheduled,
+		FencingToken: 1,
+	}})
+	if err == nil {
+		t.Fatalf("cas update with unregistered fencing token should fail")
+	}
+
+	if err = bd.AdvanceFencingToken(kt, 5); err != nil {
+		t.Fatalf("advance fencing token failed, err: %v", err)
+	}
+	if err = bd.AdvanceFencingToken(kt, 3); err == nil {
+		t.Errorf("advance smaller fencing token should fail")
+	}
+
+	// 旧主节点的令牌小于已注册的令牌，更新失败
+	err = bd.BatchUpdateFlowStateByCAS(kt, []backend.UpdateFlowInfo{{
+		ID:           flowID,
+		Source:       enumor.FlowPending,
+		Target:       enumor.FlowScheduled,
+		Worker:       converter.ValToPtr("old-leader-node"),
+		FencingToken: 3,
+	}})
+	if err == nil {
+		t.Fatalf("cas update with stale fencing token should fail")
+	}
+	if state := getFlow(t, bd, flowID).State; state != enumor.FlowPending {
+		t.Errorf("stale fencing token should not change state, got: %s", state)
+	}
+
+	err = bd.BatchUpdateFlowStateByCAS(kt, []backend.UpdateFlowInfo{{
+		ID:           flowID,
+		Source:       enumor.FlowPending,
+		Target:       enumor.FlowScheduled,
+		Worker:       converter.ValToPtr("node-1"),
+		FencingToken: 5,
+	}})
+	if err != nil {
+		t.Fatalf("cas update with current fencing token failed, err: %v", err)
+	}
+
+	// 不携带令牌的更新不受屏障限制
+	err = bd.BatchUpdateFlowStateByCAS(kt, []backend.UpdateFlowInfo{{
+		ID:     flowID,
+		Source: enumor.FlowScheduled,
+		Target: enumor.FlowRunning,
+	}})
+	if err != nil {
+		t.Fatalf("cas update without fencing token failed, err: %v", err)
+	}
+}
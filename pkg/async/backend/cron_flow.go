@@ -0,0 +1,117 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package backend
+
+import (
+	"time"
+
+	"hcm/pkg/async/action"
+	"hcm/pkg/async/backend/model"
+	"hcm/pkg/criteria/constant"
+	tableasync "hcm/pkg/dal/table/async"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/times"
+)
+
+// cronFlowDefine 将任务流转为周期任务流中保存的任务流定义
+func cronFlowDefine(flow model.Flow) *tableasync.CronFlowDefine {
+	define := &tableasync.CronFlowDefine{
+		Name:       flow.Name,
+		Memo:       flow.Memo,
+		Compensate: flow.Compensate,
+		Tasks:      make([]tableasync.CronFlowTask, 0, len(flow.Tasks)),
+	}
+	if flow.ShareData != nil {
+		define.ShareData = flow.ShareData.GetInitData()
+	}
+
+	for _, one := range flow.Tasks {
+		define.Tasks = append(define.Tasks, tableasync.CronFlowTask{
+			ActionID:   string(one.ActionID),
+			ActionName: one.ActionName,
+			Params:     one.Params,
+			Retry:      one.Retry,
+			TimeoutSec: one.TimeoutSec,
+			DependOn:   dependOnToStringArray(one.DependOn),
+		})
+	}
+
+	return define
+}
+
+// flowFromCronDefine 将周期任务流中保存的任务流定义转为任务流
+func flowFromCronDefine(define *tableasync.CronFlowDefine) model.Flow {
+	if define == nil {
+		return model.Flow{}
+	}
+
+	flow := model.Flow{
+		Name:       define.Name,
+		ShareData:  tableasync.NewShareData(define.ShareData),
+		Memo:       define.Memo,
+		Compensate: define.Compensate,
+		Tasks:      make([]model.Task, 0, len(define.Tasks)),
+	}
+
+	for _, one := range define.Tasks {
+		if one.Retry == nil {
+			one.Retry = new(tableasync.Retry)
+		}
+
+		flow.Tasks = append(flow.Tasks, model.Task{
+			FlowName:   define.Name,
+			ActionID:   action.ActIDType(one.ActionID),
+			ActionName: one.ActionName,
+			Params:     one.Params,
+			Retry:      one.Retry,
+			TimeoutSec: one.TimeoutSec,
+			DependOn:   dependOnToActIDArray(one.DependOn),
+		})
+	}
+
+	return flow
+}
+
+func convCronFlow(one *tableasync.AsyncCronFlowTable) model.CronFlow {
+	return model.CronFlow{
+		ID:         one.ID,
+		Name:       one.Name,
+		Spec:       one.Spec,
+		Flow:       flowFromCronDefine(one.Flow),
+		Enabled:    converter.PtrToVal(one.Enabled),
+		NextRunAt:  times.ConvStdTimeFormat(one.NextRunAt),
+		LastFlowID: one.LastFlowID,
+		Memo:       converter.PtrToVal(one.Memo),
+		Creator:    one.Creator,
+		Reviser:    one.Reviser,
+		CreatedAt:  one.CreatedAt.String(),
+		UpdatedAt:  one.UpdatedAt.String(),
+	}
+}
+
+// parseStdTime 解析标准格式的时间，统一转为本地时区并精确到秒
+func parseStdTime(value string) (time.Time, error) {
+	t, err := time.Parse(constant.TimeStdFormat, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return t.In(time.Local).Truncate(time.Second), nil
+}
@@ -0,0 +1,832 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/async/action"
+	"hcm/pkg/async/backend/model"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/errf"
+	tableasync "hcm/pkg/dal/table/async"
+	tabletypes "hcm/pkg/dal/table/types"
+	"hcm/pkg/kit"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/json"
+	"hcm/pkg/tools/times"
+)
+
+// NewMemory create memory instance. 基于内存的backend实现，数据不持久化，主要用于单测和本地调试。
+func NewMemory() Backend {
+	return &memory{
+		flows: make(map[string]*tableasync.AsyncFlowTable),
+		tasks: make(map[string]*tableasync.AsyncFlowTaskTable),
+		crons: make(map[string]*tableasync.AsyncCronFlowTable),
+	}
+}
+
+// memory 内存backend，所有操作在一把锁内完成，用于模拟mysql事务的原子性。
+type memory struct {
+	lock sync.RWMutex
+
+	flowSeq uint64
+	taskSeq uint64
+	cronSeq uint64
+	flows   map[string]*tableasync.AsyncFlowTable
+	tasks   map[string]*tableasync.AsyncFlowTaskTable
+	crons   map[string]*tableasync.AsyncCronFlowTable
+	// fencingToken 已注册的最大主节点屏障令牌，为0表示尚未注册
+	fencingToken uint64
+}
+
+var _ Backend = new(memory)
+
+// CreateFlow 创建任务流
+func (m *memory) CreateFlow(kt *kit.Kit, flow *model.Flow) (string, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.createFlow(kt, flow)
+}
+
+// createFlow 创建任务流，调用方需持有写锁
+func (m *memory) createFlow(kt *kit.Kit, flow *model.Flow) (string, error) {
+	flowState := enumor.FlowPending
+	if flow.State == enumor.FlowInit {
+		flowState = flow.State
+	}
+
+	runAt, err := model.ParseRunAt(flow.RunAt)
+	if err != nil {
+		return "", err
+	}
+
+	now := tabletypes.Time(times.ConvStdTimeFormat(times.ConvStdTimeNow()))
+	md := &tableasync.AsyncFlowTable{
+		ID:         m.nextFlowID(),
+		Name:       flow.Name,
+		State:      flowState,
+		Reason:     new(tableasync.Reason),
+		ShareData:  cloneShareData(flow.ShareData),
+		Memo:       flow.Memo,
+		Worker:     converter.ValToPtr(""),
+		Compensate: converter.ValToPtr(flow.Compensate),
+		RunAt:      runAt,
+		Creator:    kt.User,
+		Reviser:    kt.User,
+	}
+	if err := md.InsertValidate(); err != nil {
+		return "", err
+	}
+	md.CreatedAt, md.UpdatedAt = now, now
+
+	mds := make([]*tableasync.AsyncFlowTaskTable, 0, len(flow.Tasks))
+	for _, one := range flow.Tasks {
+		taskState := enumor.TaskPending
+		if one.State == enumor.TaskInit {
+			taskState = one.State
+		}
+
+		task := &tableasync.AsyncFlowTaskTable{
+			ID:         m.nextTaskID(),
+			FlowID:     md.ID,
+			FlowName:   one.FlowName,
+			ActionID:   string(one.ActionID),
+			ActionName: one.ActionName,
+			Params:     one.Params,
+			Retry:      cloneRetry(one.Retry),
+			TimeoutSec: one.TimeoutSec,
+			DependOn:   dependOnToStringArray(one.DependOn),
+			State:      taskState,
+			Reason:     new(tableasync.Reason),
+			Creator:    kt.User,
+			Reviser:    kt.User,
+		}
+		if err := task.InsertValidate(); err != nil {
+			return "", err
+		}
+		task.CreatedAt, task.UpdatedAt = now, now
+		mds = append(mds, task)
+	}
+
+	m.flows[md.ID] = md
+	for _, one := range mds {
+		m.tasks[one.ID] = one
+	}
+
+	return md.ID, nil
+}
+
+// BatchUpdateFlow 批量更新任务流
+func (m *memory) BatchUpdateFlow(kt *kit.Kit, flows []model.Flow) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for _, one := range flows {
+		if _, exist := m.flows[one.ID]; !exist {
+			return errf.New(errf.RecordNotUpdate, "record not update")
+		}
+	}
+
+	for _, one := range flows {
+		md := m.flows[one.ID]
+		if len(one.State) != 0 {
+			md.State = one.State
+		}
+		if one.Reason != nil {
+			md.Reason = cloneReason(one.Reason)
+		}
+		if one.ShareData != nil {
+			md.ShareData = cloneShareData(one.ShareData)
+		}
+		if len(one.Memo) != 0 {
+			md.Memo = one.Memo
+		}
+		if one.Worker != nil {
+			md.Worker = converter.ValToPtr(*one.Worker)
+		}
+		if len(one.Reviser) != 0 {
+			md.Reviser = one.Reviser
+		}
+		md.UpdatedAt = tabletypes.Time(times.ConvStdTimeFormat(times.ConvStdTimeNow()))
+	}
+
+	return nil
+}
+
+// ListFlow 查询任务流
+func (m *memory) ListFlow(kt *kit.Kit, input *ListInput) ([]model.Flow, error) {
+	if input == nil {
+		return nil, errf.New(errf.InvalidParameter, "list input is nil")
+	}
+
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	records := make([]*tableasync.AsyncFlowTable, 0)
+	for _, one := range m.flows {
+		matched, err := matchRecord(input.Filter, one)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			records = append(records, one)
+		}
+	}
+
+	page, err := pageRecords(input, records)
+	if err != nil {
+		return nil, err
+	}
+
+	flows := make([]model.Flow, 0, len(page))
+	for _, one := range page {
+		flows = append(flows, model.Flow{
+			ID:         one.ID,
+			Name:       one.Name,
+			State:      one.State,
+			Reason:     cloneReason(one.Reason),
+			ShareData:  cloneShareData(one.ShareData),
+			Memo:       one.Memo,
+			Worker:     converter.ValToPtr(converter.PtrToVal(one.Worker)),
+			Compensate: converter.PtrToVal(one.Compensate),
+			RunAt:      times.ConvStdTimeFormat(one.RunAt),
+			Creator:    one.Creator,
+			Reviser:    one.Reviser,
+			CreatedAt:  one.CreatedAt.String(),
+			UpdatedAt:  one.UpdatedAt.String(),
+		})
+	}
+
+	return flows, nil
+}
+
+// BatchUpdateFlowStateByCAS CAS批量更新流状态
+func (m *memory) BatchUpdateFlowStateByCAS(kt *kit.Kit, infos []UpdateFlowInfo) error {
+	for _, one := range infos {
+		if err := one.Validate(); err != nil {
+			return err
+		}
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	// 先在副本上执行全部CAS操作，全部成功后再提交，模拟事务语义
+	staged := make(map[string]tableasync.AsyncFlowTable, len(infos))
+	for _, one := range infos {
+		if m.isFencingTokenStale(one.FencingToken) {
+			return casFlowErr(one)
+		}
+
+		md, exist := staged[one.ID]
+		if !exist {
+			origin, ok := m.flows[one.ID]
+			if !ok {
+				return casFlowErr(one)
+			}
+			md = *origin
+		}
+
+		if md.State != one.Source {
+			return casFlowErr(one)
+		}
+
+		md.State = one.Target
+		if one.Worker != nil {
+			md.Worker = converter.ValToPtr(*one.Worker)
+		}
+		if one.Reason != nil {
+			md.Reason = cloneReason(one.Reason)
+		}
+		md.UpdatedAt = tabletypes.Time(times.ConvStdTimeFormat(times.ConvStdTimeNow()))
+		staged[one.ID] = md
+	}
+
+	for id := range staged {
+		md := staged[id]
+		m.flows[id] = &md
+	}
+
+	return nil
+}
+
+func casFlowErr(info UpdateFlowInfo) error {
+	return errf.Newf(errf.RecordNotUpdate, "flow[%s] update state: `%s`->`%s`, worker: %+v failed",
+		info.ID, info.Source, info.Target, info.Worker)
+}
+
+// BatchCreateTask 批量创建任务
+func (m *memory) BatchCreateTask(kt *kit.Kit, tasks []model.Task) ([]string, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	nowTime := times.ConvStdTimeNow().In(time.Local).Truncate(time.Second)
+	now := tabletypes.Time(times.ConvStdTimeFormat(nowTime))
+	mds := make([]*tableasync.AsyncFlowTaskTable, 0, len(tasks))
+	for _, one := range tasks {
+		md := &tableasync.AsyncFlowTaskTable{
+			ID:         m.nextTaskID(),
+			FlowID:     one.FlowID,
+			FlowName:   one.FlowName,
+			ActionID:   string(one.ActionID),
+			ActionName: one.ActionName,
+			Params:     one.Params,
+			Retry:      cloneRetry(one.Retry),
+			TimeoutSec: one.TimeoutSec,
+			DependOn:   dependOnToStringArray(one.DependOn),
+			State:      enumor.TaskPending,
+			Reason:     cloneReason(one.Reason),
+			Creator:    one.Creator,
+			Reviser:    one.Reviser,
+		}
+		if err := md.InsertValidate(); err != nil {
+			return nil, err
+		}
+		md.CreatedAt, md.UpdatedAt = now, now
+		// 与mysql默认值一致，开始、结束运行时间默认为创建时间
+		md.StartedAt, md.EndedAt = nowTime, nowTime
+		mds = append(mds, md)
+	}
+
+	ids := make([]string, 0, len(mds))
+	for _, one := range mds {
+		m.tasks[one.ID] = one
+		ids = append(ids, one.ID)
+	}
+
+	return ids, nil
+}
+
+// UpdateTask 更新任务
+func (m *memory) UpdateTask(kt *kit.Kit, task *model.Task) error {
+	if task == nil || len(task.ID) == 0 {
+		return errf.New(errf.InvalidParameter, "id is required")
+	}
+
+	startedAt, endedAt, err := parseTaskRunTime(task)
+	if err != nil {
+		return err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	md, exist := m.tasks[task.ID]
+	if !exist {
+		return errf.New(errf.RecordNotUpdate, "record not update")
+	}
+
+	if task.Retry != nil {
+		md.Retry = cloneRetry(task.Retry)
+	}
+	if len(task.State) != 0 {
+		md.State = task.State
+	}
+	if len(task.Result) != 0 {
+		md.Result = task.Result
+	}
+	if task.Reason != nil {
+		md.Reason = cloneReason(task.Reason)
+	}
+	if !startedAt.IsZero() {
+		md.StartedAt = startedAt
+	}
+	if !endedAt.IsZero() {
+		md.EndedAt = endedAt
+	}
+	if len(kt.User) != 0 {
+		md.Reviser = kt.User
+	}
+	md.UpdatedAt = tabletypes.Time(times.ConvStdTimeFormat(times.ConvStdTimeNow()))
+
+	return nil
+}
+
+// UpdateTaskStateByCAS CAS更新任务状态
+func (m *memory) UpdateTaskStateByCAS(kt *kit.Kit, info *UpdateTaskInfo) error {
+	if info == nil {
+		return errf.New(errf.InvalidParameter, "update task info is nil")
+	}
+
+	if err := info.Validate(); err != nil {
+		return err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.casTaskState(info.ID, info.Source, info.Target, info.Reason)
+}
+
+func (m *memory) casTaskState(id string, source, target enumor.TaskState, reason *tableasync.Reason) error {
+	md, exist := m.tasks[id]
+	if !exist || md.State != source {
+		return errf.Newf(errf.RecordNotUpdate, "task[%s] update state: `%s`->`%s` failed", id, source, target)
+	}
+
+	md.State = target
+	if reason != nil {
+		md.Reason = cloneReason(reason)
+	}
+	md.UpdatedAt = tabletypes.Time(times.ConvStdTimeFormat(times.ConvStdTimeNow()))
+
+	return nil
+}
+
+// ListTask 查询任务
+func (m *memory) ListTask(kt *kit.Kit, input *ListInput) ([]model.Task, error) {
+	if input == nil {
+		return nil, errf.New(errf.InvalidParameter, "list input is nil")
+	}
+
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	records := make([]*tableasync.AsyncFlowTaskTable, 0)
+	for _, one := range m.tasks {
+		matched, err := matchRecord(input.Filter, one)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			records = append(records, one)
+		}
+	}
+
+	page, err := pageRecords(input, records)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]model.Task, 0, len(page))
+	for _, one := range page {
+		tasks = append(tasks, model.Task{
+			ID:         one.ID,
+			FlowID:     one.FlowID,
+			FlowName:   one.FlowName,
+			ActionID:   action.ActIDType(one.ActionID),
+			ActionName: one.ActionName,
+			Params:     one.Params,
+			Retry:      cloneRetry(one.Retry),
+			TimeoutSec: one.TimeoutSec,
+			DependOn:   dependOnToActIDArray(one.DependOn),
+			State:      one.State,
+			Reason:     cloneReason(one.Reason),
+			Result:     one.Result,
+			StartedAt:  times.ConvStdTimeFormat(one.StartedAt),
+			EndedAt:    times.ConvStdTimeFormat(one.EndedAt),
+			Creator:    one.Creator,
+			Reviser:    one.Reviser,
+			CreatedAt:  one.CreatedAt.String(),
+			UpdatedAt:  one.UpdatedAt.String(),
+		})
+	}
+
+	return tasks, nil
+}
+
+// RetryTask 重试任务
+func (m *memory) RetryTask(kt *kit.Kit, flowID, taskID string) error {
+	if len(flowID) == 0 || len(taskID) == 0 {
+		return errors.New("empty flow id or task id")
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	flow, exist := m.flows[flowID]
+	if !exist {
+		return fmt.Errorf("flow %s not found", flowID)
+	}
+	if flow.State != enumor.FlowFailed {
+		return fmt.Errorf("flow(%s) state(%s) wrong, only `failed` allowed for retry", flowID, flow.State)
+	}
+
+	task, exist := m.tasks[taskID]
+	if !exist || task.FlowID != flowID {
+		return fmt.Errorf("task(%s) of flow(%s) not found", taskID, flowID)
+	}
+	if task.State != enumor.TaskFailed {
+		return fmt.Errorf("task(%s) state(%s) wrong, only `failed` allowed for retry", taskID, task.State)
+	}
+
+	reason := &tableasync.Reason{Message: "retry task " + taskID}
+	if err := m.casTaskState(taskID, enumor.TaskFailed, enumor.TaskPending, reason); err != nil {
+		return err
+	}
+
+	flow.State = enumor.FlowPending
+	flow.Reason = cloneReason(reason)
+	flow.UpdatedAt = tabletypes.Time(times.ConvStdTimeFormat(times.ConvStdTimeNow()))
+
+	return nil
+}
+
+// ReplayDeadLetterTasks 重放死信任务
+func (m *memory) ReplayDeadLetterTasks(kt *kit.Kit, flowID string, taskIDs []string) error {
+	if len(flowID) == 0 || len(taskIDs) == 0 {
+		return errors.New("empty flow id or task ids")
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	flow, exist := m.flows[flowID]
+	if !exist {
+		return fmt.Errorf("flow %s not found", flowID)
+	}
+	if flow.State != enumor.FlowFailed {
+		return fmt.Errorf("flow(%s) state(%s) wrong, only `failed` allowed for replay", flowID, flow.State)
+	}
+
+	// 先全部校验再更新，与mysql事务保持一致
+	seen := make(map[string]struct{}, len(taskIDs))
+	for _, taskID := range taskIDs {
+		if _, ok := seen[taskID]; ok {
+			return fmt.Errorf("task(%s) is repeated", taskID)
+		}
+		seen[taskID] = struct{}{}
+
+		task, exist := m.tasks[taskID]
+		if !exist || task.FlowID != flowID {
+			return fmt.Errorf("some tasks of flow(%s) not found, ids: %v", flowID, taskIDs)
+		}
+		if task.State != enumor.TaskDeadLetter {
+			return fmt.Errorf("task(%s) state(%s) wrong, only `dead_letter` allowed for replay", taskID, task.State)
+		}
+	}
+
+	reason := &tableasync.Reason{Message: fmt.Sprintf("replay dead letter tasks %v", taskIDs)}
+	for _, taskID := range taskIDs {
+		if err := m.casTaskState(taskID, enumor.TaskDeadLetter, enumor.TaskPending, reason); err != nil {
+			return err
+		}
+	}
+
+	flow.State = enumor.FlowPending
+	flow.Reason = cloneReason(reason)
+	flow.UpdatedAt = tabletypes.Time(times.ConvStdTimeFormat(times.ConvStdTimeNow()))
+
+	return nil
+}
+
+// CreateCronFlow 创建周期任务流
+func (m *memory) CreateCronFlow(kt *kit.Kit, cron *model.CronFlow) (string, error) {
+	if err := cron.CreateValidate(); err != nil {
+		return "", err
+	}
+
+	nextRunAt, err := parseStdTime(cron.NextRunAt)
+	if err != nil {
+		return "", err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	// 与mysql唯一索引保持一致
+	for _, one := range m.crons {
+		if one.Name == cron.Name {
+			return "", errf.Newf(errf.RecordDuplicated, "cron flow %s already exist", cron.Name)
+		}
+	}
+
+	now := tabletypes.Time(times.ConvStdTimeFormat(times.ConvStdTimeNow()))
+	md := &tableasync.AsyncCronFlowTable{
+		ID:        m.nextCronID(),
+		Name:      cron.Name,
+		Spec:      cron.Spec,
+		Flow:      cronFlowDefine(cron.Flow),
+		Enabled:   converter.ValToPtr(cron.Enabled),
+		NextRunAt: nextRunAt,
+		Memo:      converter.ValToPtr(cron.Memo),
+		Creator:   kt.User,
+		Reviser:   kt.User,
+	}
+	if err = md.InsertValidate(); err != nil {
+		return "", err
+	}
+	md.CreatedAt, md.UpdatedAt = now, now
+	m.crons[md.ID] = md
+
+	return md.ID, nil
+}
+
+// UpdateCronFlow 更新周期任务流
+func (m *memory) UpdateCronFlow(kt *kit.Kit, cron *model.CronFlow) error {
+	if err := cron.UpdateValidate(); err != nil {
+		return err
+	}
+
+	var nextRunAt time.Time
+	if len(cron.NextRunAt) != 0 {
+		var err error
+		if nextRunAt, err = parseStdTime(cron.NextRunAt); err != nil {
+			return err
+		}
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	md, exist := m.crons[cron.ID]
+	if !exist {
+		return errf.New(errf.RecordNotUpdate, "record not update")
+	}
+
+	if len(cron.Spec) != 0 {
+		md.Spec = cron.Spec
+	}
+	if !nextRunAt.IsZero() {
+		md.NextRunAt = nextRunAt
+	}
+	md.Enabled = converter.ValToPtr(cron.Enabled)
+	md.Memo = converter.ValToPtr(cron.Memo)
+	md.Reviser = kt.User
+	md.UpdatedAt = tabletypes.Time(times.ConvStdTimeFormat(times.ConvStdTimeNow()))
+
+	return nil
+}
+
+// ListCronFlow 查询周期任务流
+func (m *memory) ListCronFlow(kt *kit.Kit, input *ListInput) ([]model.CronFlow, error) {
+	if input == nil {
+		return nil, errf.New(errf.InvalidParameter, "list input is nil")
+	}
+
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	records := make([]*tableasync.AsyncCronFlowTable, 0)
+	for _, one := range m.crons {
+		matched, err := matchRecord(input.Filter, one)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			records = append(records, one)
+		}
+	}
+
+	page, err := pageRecords(input, records)
+	if err != nil {
+		return nil, err
+	}
+
+	crons := make([]model.CronFlow, 0, len(page))
+	for _, one := range page {
+		crons = append(crons, convCronFlow(one))
+	}
+
+	return crons, nil
+}
+
+// DeleteCronFlow 删除周期任务流
+func (m *memory) DeleteCronFlow(kt *kit.Kit, id string) error {
+	if len(id) == 0 {
+		return errors.New("id is required")
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.crons, id)
+	return nil
+}
+
+// TriggerCronFlow 触发周期任务流
+func (m *memory) TriggerCronFlow(kt *kit.Kit, info *TriggerCronFlowInfo) (string, error) {
+	if err := info.Validate(); err != nil {
+		return "", err
+	}
+
+	preRunAt, err := parseStdTime(info.PreRunAt)
+	if err != nil {
+		return "", err
+	}
+	nextRunAt, err := parseStdTime(info.NextRunAt)
+	if err != nil {
+		return "", err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	md, exist := m.crons[info.ID]
+	if !exist || !md.NextRunAt.Equal(preRunAt) || m.isFencingTokenStale(info.FencingToken) {
+		return "", errf.Newf(errf.RecordNotUpdate, "cron flow[%s] update next_run_at: `%s`->`%s` failed", info.ID,
+			info.PreRunAt, info.NextRunAt)
+	}
+
+	flowID := ""
+	if info.Flow != nil {
+		if flowID, err = m.createFlow(kt, info.Flow); err != nil {
+			return "", err
+		}
+		md.LastFlowID = flowID
+	}
+	md.NextRunAt = nextRunAt
+	md.UpdatedAt = tabletypes.Time(times.ConvStdTimeFormat(times.ConvStdTimeNow()))
+
+	return flowID, nil
+}
+
+// AdvanceFencingToken 注册主节点屏障令牌
+func (m *memory) AdvanceFencingToken(kt *kit.Kit, token uint64) error {
+	if token == 0 {
+		return errf.New(errf.InvalidParameter, "fencing token is required")
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.fencingToken > token {
+		return errf.Newf(errf.RecordNotUpdate, "fencing token %d is stale, current: %d", token, m.fencingToken)
+	}
+	m.fencingToken = token
+
+	return nil
+}
+
+// isFencingTokenStale 判断令牌是否已失效，令牌为0时不做限制，与mysql一致尚未注册令牌时视为失效。
+func (m *memory) isFencingTokenStale(token uint64) bool {
+	if token == 0 {
+		return false
+	}
+
+	return m.fencingToken == 0 || token < m.fencingToken
+}
+
+func (m *memory) nextFlowID() string {
+	m.flowSeq++
+	return fmt.Sprintf("%08s", strconv.FormatUint(m.flowSeq, 36))
+}
+
+func (m *memory) nextTaskID() string {
+	m.taskSeq++
+	return fmt.Sprintf("%08s", strconv.FormatUint(m.taskSeq, 36))
+}
+
+func (m *memory) nextCronID() string {
+	m.cronSeq++
+	return fmt.Sprintf("%08s", strconv.FormatUint(m.cronSeq, 36))
+}
+
+func cloneReason(reason *tableasync.Reason) *tableasync.Reason {
+	if reason == nil {
+		return nil
+	}
+
+	cloned := *reason
+	return &cloned
+}
+
+// cloneShareData 通过序列化复制共享数据，避免调用方修改内存中存储的数据，与mysql每次查询返回新对象的行为保持一致。
+func cloneShareData(shareData *tableasync.ShareData) *tableasync.ShareData {
+	if shareData == nil {
+		return nil
+	}
+
+	cloned := tableasync.NewShareData(nil)
+	raw, err := json.Marshal(shareData)
+	if err != nil || string(raw) == "null" {
+		return cloned
+	}
+
+	if err = json.Unmarshal(raw, cloned); err != nil {
+		return tableasync.NewShareData(nil)
+	}
+	return cloned
+}
+
+func cloneRetry(retry *tableasync.Retry) *tableasync.Retry {
+	if retry == nil {
+		return nil
+	}
+
+	cloned := *retry
+	if retry.Policy != nil {
+		policy := *retry.Policy
+		cloned.Policy = &policy
+	}
+	return &cloned
+}
+
+// pageRecords 按照分页参数对记录进行排序、截取，默认按照id升序。count查询与mysql保持一致，不返回详情。
+func pageRecords[T any](input *ListInput, records []T) ([]T, error) {
+	if input.Page == nil {
+		return nil, errf.New(errf.InvalidParameter, "page is required")
+	}
+
+	if input.Page.Count {
+		return make([]T, 0), nil
+	}
+
+	sortField := input.Page.Sort
+	if len(sortField) == 0 {
+		sortField = "id"
+	}
+
+	values := make([]map[string]interface{}, len(records))
+	for i := range records {
+		value, err := recordToMap(records[i])
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+
+	indexes := make([]int, len(records))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	desc := input.Page.Order == core.Descending
+	sort.SliceStable(indexes, func(i, j int) bool {
+		cmp, _ := compareValue(values[indexes[i]][sortField], values[indexes[j]][sortField])
+		if desc {
+			return cmp > 0
+		}
+		return cmp < 0
+	})
+
+	start := int(input.Page.Start)
+	if start > len(records) {
+		start = len(records)
+	}
+	end := len(records)
+	if input.Page.Limit != 0 && start+int(input.Page.Limit) < end {
+		end = start + int(input.Page.Limit)
+	}
+
+	result := make([]T, 0, end-start)
+	for _, idx := range indexes[start:end] {
+		result = append(result, records[idx])
+	}
+
+	return result, nil
+}
@@ -0,0 +1,209 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package backend
+
+import (
+	"fmt"
+	"strings"
+
+	"hcm/pkg/runtime/filter"
+	"hcm/pkg/tools/json"
+)
+
+// matchRecord 在内存中计算过滤表达式，记录通过json序列化转为以列名为key的map后进行比较。
+// 仅支持框架内使用到的比较类操作符，json类操作符返回错误。
+func matchRecord(expr *filter.Expression, record interface{}) (bool, error) {
+	if expr == nil || expr.IsEmpty() {
+		return true, nil
+	}
+
+	value, err := recordToMap(record)
+	if err != nil {
+		return false, err
+	}
+
+	return matchExpression(expr, value)
+}
+
+func recordToMap(record interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+
+	value := make(map[string]interface{})
+	if err = json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func matchExpression(expr *filter.Expression, record map[string]interface{}) (bool, error) {
+	if len(expr.Rules) == 0 {
+		return true, nil
+	}
+
+	for _, rule := range expr.Rules {
+		matched, err := matchRule(rule, record)
+		if err != nil {
+			return false, err
+		}
+
+		if expr.Op == filter.Or && matched {
+			return true, nil
+		}
+
+		if expr.Op != filter.Or && !matched {
+			return false, nil
+		}
+	}
+
+	return expr.Op != filter.Or, nil
+}
+
+func matchRule(rule filter.RuleFactory, record map[string]interface{}) (bool, error) {
+	switch r := rule.(type) {
+	case *filter.Expression:
+		return matchExpression(r, record)
+	case *filter.AtomRule:
+		return matchAtomRule(r, record)
+	case filter.AtomRule:
+		return matchAtomRule(&r, record)
+	default:
+		return false, fmt.Errorf("unsupported rule type: %T", rule)
+	}
+}
+
+func matchAtomRule(rule *filter.AtomRule, record map[string]interface{}) (bool, error) {
+	field, exist := record[rule.Field]
+	if !exist {
+		return false, fmt.Errorf("rule field: %s not exist", rule.Field)
+	}
+
+	// 过滤值可能是枚举等自定义类型，统一序列化为json基础类型后再比较
+	expected, err := normalizeValue(rule.Value)
+	if err != nil {
+		return false, err
+	}
+
+	switch rule.Op {
+	case filter.Equal.Factory():
+		cmp, err := compareValue(field, expected)
+		return err == nil && cmp == 0, nil
+	case filter.NotEqual.Factory():
+		cmp, err := compareValue(field, expected)
+		return err != nil || cmp != 0, nil
+	case filter.GreaterThan.Factory(), filter.GreaterThanEqual.Factory(), filter.LessThan.Factory(),
+		filter.LessThanEqual.Factory():
+
+		cmp, err := compareValue(field, expected)
+		if err != nil {
+			return false, err
+		}
+		switch rule.Op {
+		case filter.GreaterThan.Factory():
+			return cmp > 0, nil
+		case filter.GreaterThanEqual.Factory():
+			return cmp >= 0, nil
+		case filter.LessThan.Factory():
+			return cmp < 0, nil
+		default:
+			return cmp <= 0, nil
+		}
+	case filter.In.Factory(), filter.NotIn.Factory():
+		values, ok := expected.([]interface{})
+		if !ok {
+			return false, fmt.Errorf("%s operator value should be an array", rule.Op)
+		}
+		in := false
+		for _, one := range values {
+			if cmp, err := compareValue(field, one); err == nil && cmp == 0 {
+				in = true
+				break
+			}
+		}
+		return in == (rule.Op == filter.In.Factory()), nil
+	case filter.ContainsSensitive.Factory():
+		return strings.Contains(fmt.Sprint(field), fmt.Sprint(expected)), nil
+	case filter.ContainsInsensitive.Factory():
+		return strings.Contains(strings.ToLower(fmt.Sprint(field)), strings.ToLower(fmt.Sprint(expected))), nil
+	default:
+		return false, fmt.Errorf("memory backend unsupported filter operator: %s", rule.Op)
+	}
+}
+
+func normalizeValue(value interface{}) (interface{}, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var normalized interface{}
+	if err = json.Unmarshal(raw, &normalized); err != nil {
+		return nil, err
+	}
+
+	return normalized, nil
+}
+
+// compareValue 比较两个json基础类型的值，返回 -1/0/1，类型不一致时返回错误。
+func compareValue(a, b interface{}) (int, error) {
+	switch av := a.(type) {
+	case string:
+		bv, ok := b.(string)
+		if !ok {
+			return 0, fmt.Errorf("can not compare string with %T", b)
+		}
+		return strings.Compare(av, bv), nil
+	case float64:
+		bv, ok := b.(float64)
+		if !ok {
+			return 0, fmt.Errorf("can not compare number with %T", b)
+		}
+		switch {
+		case av < bv:
+			return -1, nil
+		case av > bv:
+			return 1, nil
+		default:
+			return 0, nil
+		}
+	case bool:
+		bv, ok := b.(bool)
+		if !ok {
+			return 0, fmt.Errorf("can not compare bool with %T", b)
+		}
+		if av == bv {
+			return 0, nil
+		}
+		if !av {
+			return -1, nil
+		}
+		return 1, nil
+	case nil:
+		if b == nil {
+			return 0, nil
+		}
+		return -1, nil
+	default:
+		return 0, fmt.Errorf("unsupported compare value type: %T", a)
+	}
+}
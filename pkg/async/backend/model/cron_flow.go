@@ -0,0 +1,88 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package model
+
+import (
+	"errors"
+)
+
+// CronFlow 周期任务流，按照Spec定义的周期，使用Flow定义创建任务流
+type CronFlow struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Spec cron表达式
+	Spec string `json:"spec"`
+	// Flow 每次触发时创建的任务流定义，只使用Name、ShareData、Memo、Compensate、Tasks
+	Flow       Flow   `json:"flow"`
+	Enabled    bool   `json:"enabled"`
+	NextRunAt  string `json:"next_run_at"`
+	LastFlowID string `json:"last_flow_id"`
+	Memo       string `json:"memo"`
+	Creator    string `json:"creator"`
+	Reviser    string `json:"reviser"`
+	CreatedAt  string `json:"created_at"`
+	UpdatedAt  string `json:"updated_at"`
+}
+
+// CreateValidate CronFlow.
+func (c CronFlow) CreateValidate() error {
+	if len(c.ID) != 0 {
+		return errors.New("id can not set")
+	}
+
+	if len(c.Name) == 0 {
+		return errors.New("name is required")
+	}
+
+	if len(c.Spec) == 0 {
+		return errors.New("spec is required")
+	}
+
+	if len(c.Flow.Name) == 0 {
+		return errors.New("flow name is required")
+	}
+
+	if len(c.Flow.Tasks) == 0 {
+		return errors.New("flow tasks is required")
+	}
+
+	if len(c.NextRunAt) == 0 {
+		return errors.New("next_run_at is required")
+	}
+
+	return nil
+}
+
+// UpdateValidate CronFlow.
+func (c CronFlow) UpdateValidate() error {
+	if len(c.ID) == 0 {
+		return errors.New("id is required")
+	}
+
+	if len(c.Name) != 0 {
+		return errors.New("name can not set")
+	}
+
+	if len(c.Flow.Tasks) != 0 {
+		return errors.New("flow can not set")
+	}
+
+	return nil
+}
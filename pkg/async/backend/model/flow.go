@@ -21,7 +21,10 @@ package model
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
+	"hcm/pkg/criteria/constant"
 	"hcm/pkg/criteria/enumor"
 	tableasync "hcm/pkg/dal/table/async"
 )
@@ -32,14 +35,16 @@ type Flow struct {
 	ShareData *tableasync.ShareData `json:"share_data"`
 	Memo      string                `json:"memo"`
 
-	ID        string             `json:"id"`
-	State     enumor.FlowState   `json:"state"`
-	Reason    *tableasync.Reason `json:"reason"`
-	Worker    *string            `json:"worker"`
-	Creator   string             `json:"creator"`
-	Reviser   string             `json:"reviser"`
-	CreatedAt string             `json:"created_at"`
-	UpdatedAt string             `json:"updated_at"`
+	ID         string             `json:"id"`
+	State      enumor.FlowState   `json:"state"`
+	Reason     *tableasync.Reason `json:"reason"`
+	Worker     *string            `json:"worker"`
+	Compensate bool               `json:"compensate"`
+	RunAt      string             `json:"run_at"`
+	Creator    string             `json:"creator"`
+	Reviser    string             `json:"reviser"`
+	CreatedAt  string             `json:"created_at"`
+	UpdatedAt  string             `json:"updated_at"`
 
 	Tasks []Task `json:"tasks"`
 }
@@ -111,3 +116,17 @@ func (f Flow) UpdateValidate() error {
 
 	return nil
 }
+
+// ParseRunAt 解析任务流计划执行时间，未设置时返回当前时间，统一转为本地时区并精确到秒。
+func ParseRunAt(runAt string) (time.Time, error) {
+	if len(runAt) == 0 {
+		return time.Now().In(time.Local).Truncate(time.Second), nil
+	}
+
+	t, err := time.Parse(constant.TimeStdFormat, runAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("run_at should be like %s, err: %v", constant.TimeStdFormat, err)
+	}
+
+	return t.In(time.Local).Truncate(time.Second), nil
+}
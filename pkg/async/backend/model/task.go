@@ -37,10 +37,13 @@ type Task struct {
 	ActionName enumor.ActionName  `json:"action_name"`
 	Params     types.JsonField    `json:"params"`
 	Retry      *tableasync.Retry  `json:"can_retry"`
+	TimeoutSec uint               `json:"timeout_sec"`
 	DependOn   []action.ActIDType `json:"depend_on"`
 	State      enumor.TaskState   `json:"state"`
 	Reason     *tableasync.Reason `json:"reason"`
 	Result     types.JsonField    `json:"result"`
+	StartedAt  string             `json:"started_at"`
+	EndedAt    string             `json:"ended_at"`
 	Creator    string             `json:"creator"`
 	Reviser    string             `json:"reviser"`
 	CreatedAt  string             `json:"created_at"`
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"time"
 
 	"hcm/pkg/api/core"
 	"hcm/pkg/async/action"
@@ -38,6 +39,7 @@ import (
 	"hcm/pkg/kit"
 	"hcm/pkg/logs"
 	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/times"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -71,6 +73,8 @@ func (db *mysql) BatchUpdateFlowStateByCAS(kt *kit.Kit, infos []UpdateFlowInfo)
 				Target: one.Target,
 				Reason: one.Reason,
 				Worker: one.Worker,
+
+				FencingToken: one.FencingToken,
 			}
 			if err := db.dao.AsyncFlow().UpdateStateByCAS(kt, txn, info); err != nil {
 				return nil, err
@@ -167,6 +171,90 @@ func (db *mysql) checkFlowTaskForRetry(kt *kit.Kit, flowID string, taskID string
 	return nil
 }
 
+// ReplayDeadLetterTasks 重放死信任务
+func (db *mysql) ReplayDeadLetterTasks(kt *kit.Kit, flowID string, taskIDs []string) error {
+
+	if err := db.checkFlowTaskForReplay(kt, flowID, taskIDs); err != nil {
+		return err
+	}
+
+	reason := &tableasync.Reason{Message: fmt.Sprintf("replay dead letter tasks %v", taskIDs)}
+	flowUpdate := &typesasync.UpdateFlowInfo{
+		ID:     flowID,
+		Source: enumor.FlowFailed,
+		Target: enumor.FlowPending,
+		Reason: reason,
+	}
+
+	_, err := db.dao.Txn().AutoTxn(kt, func(txn *sqlx.Tx, opt *orm.TxnOption) (any, error) {
+		for _, taskID := range taskIDs {
+			taskUpdate := &typesasync.UpdateTaskInfo{
+				ID:     taskID,
+				Source: enumor.TaskDeadLetter,
+				Target: enumor.TaskPending,
+				Reason: reason,
+			}
+			if err := db.dao.AsyncFlowTask().UpdateStateByCAS(kt, txn, taskUpdate); err != nil {
+				logs.Errorf("fail to update task status for replay, err: %v, task id: %s, rid: %s",
+					err, taskID, kt.Rid)
+				return nil, err
+			}
+		}
+		if err := db.dao.AsyncFlow().UpdateStateByCAS(kt, txn, flowUpdate); err != nil {
+			logs.Errorf("fail to update flow status for replay, err: %v, flow id: %s, rid: %s",
+				err, flowID, kt.Rid)
+			return nil, err
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (db *mysql) checkFlowTaskForReplay(kt *kit.Kit, flowID string, taskIDs []string) error {
+	if len(flowID) == 0 || len(taskIDs) == 0 {
+		return errors.New("empty flow id or task ids")
+	}
+
+	listOpt := &types.ListOption{
+		Filter: tools.EqualExpression("id", flowID),
+		Page:   core.NewDefaultBasePage(),
+	}
+	flowResp, err := db.dao.AsyncFlow().List(kt, listOpt)
+	if err != nil {
+		return err
+	}
+	if len(flowResp.Details) == 0 {
+		return fmt.Errorf("flow %s not found", flowID)
+	}
+	if flowResp.Details[0].State != enumor.FlowFailed {
+		return fmt.Errorf("flow(%s) state(%s) wrong, only `failed` allowed for replay",
+			flowID, flowResp.Details[0].State)
+	}
+
+	listOpt = &types.ListOption{
+		Filter: tools.ExpressionAnd(
+			tools.RuleIn("id", taskIDs),
+			tools.RuleEqual("flow_id", flowID)),
+		Page: core.NewDefaultBasePage(),
+	}
+	taskResp, err := db.dao.AsyncFlowTask().List(kt, listOpt)
+	if err != nil {
+		return err
+	}
+	if len(taskResp.Details) != len(taskIDs) {
+		return fmt.Errorf("some tasks of flow(%s) not found, ids: %v", flowID, taskIDs)
+	}
+	for _, one := range taskResp.Details {
+		if one.State != enumor.TaskDeadLetter {
+			return fmt.Errorf("task(%s) state(%s) wrong, only `dead_letter` allowed for replay", one.ID, one.State)
+		}
+	}
+	return nil
+}
+
 // UpdateTaskStateByCAS CAS更新任务状态
 func (db *mysql) UpdateTaskStateByCAS(kt *kit.Kit, info *UpdateTaskInfo) error {
 	update := &typesasync.UpdateTaskInfo{
@@ -190,64 +278,76 @@ var _ Backend = new(mysql)
 // CreateFlow 创建任务流
 func (db *mysql) CreateFlow(kt *kit.Kit, flow *model.Flow) (string, error) {
 
+	result, err := db.dao.Txn().AutoTxn(kt, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		return db.createFlowWithTx(kt, txn, flow)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	flowID, ok := result.(string)
+	if !ok {
+		return "", fmt.Errorf("return result not string type, type: %s", reflect.TypeOf(result).String())
+	}
+
+	return flowID, nil
+}
+
+func (db *mysql) createFlowWithTx(kt *kit.Kit, txn *sqlx.Tx, flow *model.Flow) (string, error) {
 	flowState := enumor.FlowPending
 	if flow.State == enumor.FlowInit {
 		flowState = flow.State
 	}
 
-	result, err := db.dao.Txn().AutoTxn(kt, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
-		// 创建任务流
-		md := &tableasync.AsyncFlowTable{
-			Name:      flow.Name,
-			State:     flowState,
-			Reason:    new(tableasync.Reason),
-			ShareData: flow.ShareData,
-			Memo:      flow.Memo,
-			Worker:    converter.ValToPtr(""),
-			Creator:   kt.User,
-			Reviser:   kt.User,
-		}
-		flowID, err := db.dao.AsyncFlow().Create(kt, txn, md)
-		if err != nil {
-			return nil, err
-		}
-
-		// 创建任务
-		tasks := flow.Tasks
-		mds := make([]tableasync.AsyncFlowTaskTable, 0, len(tasks))
-		for _, one := range tasks {
-			taskState := enumor.TaskPending
-			if one.State == enumor.TaskInit {
-				taskState = one.State
-			}
-
-			mds = append(mds, tableasync.AsyncFlowTaskTable{
-				FlowID:     flowID,
-				FlowName:   one.FlowName,
-				ActionID:   string(one.ActionID),
-				ActionName: one.ActionName,
-				Params:     one.Params,
-				Retry:      one.Retry,
-				DependOn:   dependOnToStringArray(one.DependOn),
-				State:      taskState,
-				Reason:     new(tableasync.Reason),
-				Creator:    kt.User,
-				Reviser:    kt.User,
-			})
-		}
-		if _, err = db.dao.AsyncFlowTask().BatchCreateWithTx(kt, txn, mds); err != nil {
-			return nil, err
-		}
+	runAt, err := model.ParseRunAt(flow.RunAt)
+	if err != nil {
+		return "", err
+	}
 
-		return flowID, nil
-	})
+	// 创建任务流
+	md := &tableasync.AsyncFlowTable{
+		Name:       flow.Name,
+		State:      flowState,
+		Reason:     new(tableasync.Reason),
+		ShareData:  flow.ShareData,
+		Memo:       flow.Memo,
+		Worker:     converter.ValToPtr(""),
+		Compensate: converter.ValToPtr(flow.Compensate),
+		RunAt:      runAt,
+		Creator:    kt.User,
+		Reviser:    kt.User,
+	}
+	flowID, err := db.dao.AsyncFlow().Create(kt, txn, md)
 	if err != nil {
 		return "", err
 	}
 
-	flowID, ok := result.(string)
-	if !ok {
-		return "", fmt.Errorf("return result not string type, type: %s", reflect.TypeOf(result).String())
+	// 创建任务
+	tasks := flow.Tasks
+	mds := make([]tableasync.AsyncFlowTaskTable, 0, len(tasks))
+	for _, one := range tasks {
+		taskState := enumor.TaskPending
+		if one.State == enumor.TaskInit {
+			taskState = one.State
+		}
+
+		mds = append(mds, tableasync.AsyncFlowTaskTable{
+			FlowID:     flowID,
+			FlowName:   one.FlowName,
+			ActionID:   string(one.ActionID),
+			ActionName: one.ActionName,
+			Params:     one.Params,
+			Retry:      one.Retry,
+			TimeoutSec: one.TimeoutSec,
+			DependOn:   dependOnToStringArray(one.DependOn),
+			State:      taskState,
+			Reason:     new(tableasync.Reason),
+			Creator:    kt.User,
+			Reviser:    kt.User,
+		})
+	}
+	if _, err = db.dao.AsyncFlowTask().BatchCreateWithTx(kt, txn, mds); err != nil {
+		return "", err
 	}
 
 	return flowID, nil
@@ -297,17 +397,19 @@ func (db *mysql) ListFlow(kt *kit.Kit, input *ListInput) ([]model.Flow, error) {
 	flows := make([]model.Flow, 0, len(list.Details))
 	for _, one := range list.Details {
 		flows = append(flows, model.Flow{
-			ID:        one.ID,
-			Name:      one.Name,
-			State:     one.State,
-			Reason:    one.Reason,
-			ShareData: one.ShareData,
-			Memo:      one.Memo,
-			Worker:    one.Worker,
-			Creator:   one.Creator,
-			Reviser:   one.Reviser,
-			CreatedAt: one.CreatedAt.String(),
-			UpdatedAt: one.UpdatedAt.String(),
+			ID:         one.ID,
+			Name:       one.Name,
+			State:      one.State,
+			Reason:     one.Reason,
+			ShareData:  one.ShareData,
+			Memo:       one.Memo,
+			Worker:     one.Worker,
+			Compensate: converter.PtrToVal(one.Compensate),
+			RunAt:      times.ConvStdTimeFormat(one.RunAt),
+			Creator:    one.Creator,
+			Reviser:    one.Reviser,
+			CreatedAt:  one.CreatedAt.String(),
+			UpdatedAt:  one.UpdatedAt.String(),
 		})
 	}
 
@@ -326,6 +428,7 @@ func (db *mysql) BatchCreateTask(kt *kit.Kit, tasks []model.Task) ([]string, err
 			ActionName: one.ActionName,
 			Params:     one.Params,
 			Retry:      one.Retry,
+			TimeoutSec: one.TimeoutSec,
 			DependOn:   dependOnToStringArray(one.DependOn),
 			State:      enumor.TaskPending,
 			Reason:     one.Reason,
@@ -340,12 +443,19 @@ func (db *mysql) BatchCreateTask(kt *kit.Kit, tasks []model.Task) ([]string, err
 // UpdateTask 更新任务
 func (db *mysql) UpdateTask(kt *kit.Kit, task *model.Task) error {
 
+	startedAt, endedAt, err := parseTaskRunTime(task)
+	if err != nil {
+		return err
+	}
+
 	md := &tableasync.AsyncFlowTaskTable{
-		Retry:   task.Retry,
-		State:   task.State,
-		Result:  task.Result,
-		Reason:  task.Reason,
-		Reviser: kt.User,
+		Retry:     task.Retry,
+		State:     task.State,
+		Result:    task.Result,
+		Reason:    task.Reason,
+		StartedAt: startedAt,
+		EndedAt:   endedAt,
+		Reviser:   kt.User,
 	}
 
 	return db.dao.AsyncFlowTask().UpdateByID(kt, task.ID, md)
@@ -374,10 +484,13 @@ func (db *mysql) ListTask(kt *kit.Kit, input *ListInput) ([]model.Task, error) {
 			ActionName: one.ActionName,
 			Params:     one.Params,
 			Retry:      one.Retry,
+			TimeoutSec: one.TimeoutSec,
 			DependOn:   dependOnToActIDArray(one.DependOn),
 			State:      one.State,
 			Reason:     one.Reason,
 			Result:     one.Result,
+			StartedAt:  times.ConvStdTimeFormat(one.StartedAt),
+			EndedAt:    times.ConvStdTimeFormat(one.EndedAt),
 			Creator:    one.Creator,
 			Reviser:    one.Reviser,
 			CreatedAt:  one.CreatedAt.String(),
@@ -405,3 +518,169 @@ func dependOnToActIDArray(d tabletypes.StringArray) []action.ActIDType {
 
 	return result
 }
+
+// parseTaskRunTime 解析任务的开始、结束运行时间，为空时返回零值，零值不会被更新
+func parseTaskRunTime(task *model.Task) (startedAt time.Time, endedAt time.Time, err error) {
+	if len(task.StartedAt) != 0 {
+		if startedAt, err = parseStdTime(task.StartedAt); err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+
+	if len(task.EndedAt) != 0 {
+		if endedAt, err = parseStdTime(task.EndedAt); err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+
+	return startedAt, endedAt, nil
+}
+
+// CreateCronFlow 创建周期任务流
+func (db *mysql) CreateCronFlow(kt *kit.Kit, cron *model.CronFlow) (string, error) {
+	if err := cron.CreateValidate(); err != nil {
+		return "", err
+	}
+
+	nextRunAt, err := parseStdTime(cron.NextRunAt)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := db.dao.Txn().AutoTxn(kt, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		md := &tableasync.AsyncCronFlowTable{
+			Name:      cron.Name,
+			Spec:      cron.Spec,
+			Flow:      cronFlowDefine(cron.Flow),
+			Enabled:   converter.ValToPtr(cron.Enabled),
+			NextRunAt: nextRunAt,
+			Memo:      converter.ValToPtr(cron.Memo),
+			Creator:   kt.User,
+			Reviser:   kt.User,
+		}
+		return db.dao.AsyncCronFlow().Create(kt, txn, md)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	id, ok := result.(string)
+	if !ok {
+		return "", fmt.Errorf("return result not string type, type: %s", reflect.TypeOf(result).String())
+	}
+
+	return id, nil
+}
+
+// UpdateCronFlow 更新周期任务流
+func (db *mysql) UpdateCronFlow(kt *kit.Kit, cron *model.CronFlow) error {
+	if err := cron.UpdateValidate(); err != nil {
+		return err
+	}
+
+	md := &tableasync.AsyncCronFlowTable{
+		Spec:    cron.Spec,
+		Enabled: converter.ValToPtr(cron.Enabled),
+		Memo:    converter.ValToPtr(cron.Memo),
+		Reviser: kt.User,
+	}
+	if len(cron.NextRunAt) != 0 {
+		nextRunAt, err := parseStdTime(cron.NextRunAt)
+		if err != nil {
+			return err
+		}
+		md.NextRunAt = nextRunAt
+	}
+
+	_, err := db.dao.Txn().AutoTxn(kt, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		return nil, db.dao.AsyncCronFlow().UpdateByIDWithTx(kt, txn, cron.ID, md)
+	})
+	return err
+}
+
+// ListCronFlow 查询周期任务流
+func (db *mysql) ListCronFlow(kt *kit.Kit, input *ListInput) ([]model.CronFlow, error) {
+
+	opt := &types.ListOption{
+		Fields: input.Fields,
+		Filter: input.Filter,
+		Page:   input.Page,
+	}
+	list, err := db.dao.AsyncCronFlow().List(kt, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	crons := make([]model.CronFlow, 0, len(list.Details))
+	for index := range list.Details {
+		crons = append(crons, convCronFlow(&list.Details[index]))
+	}
+
+	return crons, nil
+}
+
+// DeleteCronFlow 删除周期任务流
+func (db *mysql) DeleteCronFlow(kt *kit.Kit, id string) error {
+	if len(id) == 0 {
+		return errors.New("id is required")
+	}
+
+	_, err := db.dao.Txn().AutoTxn(kt, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		return nil, db.dao.AsyncCronFlow().DeleteWithTx(kt, txn, tools.EqualExpression("id", id))
+	})
+	return err
+}
+
+// TriggerCronFlow 触发周期任务流
+func (db *mysql) TriggerCronFlow(kt *kit.Kit, info *TriggerCronFlowInfo) (string, error) {
+	if err := info.Validate(); err != nil {
+		return "", err
+	}
+
+	preRunAt, err := parseStdTime(info.PreRunAt)
+	if err != nil {
+		return "", err
+	}
+	nextRunAt, err := parseStdTime(info.NextRunAt)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := db.dao.Txn().AutoTxn(kt, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		flowID := ""
+		if info.Flow != nil {
+			if flowID, err = db.createFlowWithTx(kt, txn, info.Flow); err != nil {
+				return nil, err
+			}
+		}
+
+		casInfo := &typesasync.UpdateCronNextRunInfo{
+			ID:         info.ID,
+			PreRunAt:   preRunAt,
+			NextRunAt:  nextRunAt,
+			LastFlowID: flowID,
+
+			FencingToken: info.FencingToken,
+		}
+		if err = db.dao.AsyncCronFlow().UpdateNextRunByCAS(kt, txn, casInfo); err != nil {
+			return nil, err
+		}
+
+		return flowID, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	flowID, ok := result.(string)
+	if !ok {
+		return "", fmt.Errorf("return result not string type, type: %s", reflect.TypeOf(result).String())
+	}
+
+	return flowID, nil
+}
+
+// AdvanceFencingToken 注册主节点屏障令牌
+func (db *mysql) AdvanceFencingToken(kt *kit.Kit, token uint64) error {
+	return db.dao.AsyncLeaderFence().Advance(kt, token)
+}
@@ -0,0 +1,94 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package backend_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"hcm/pkg/async/backend"
+	"hcm/pkg/async/backend/conformance"
+	"hcm/pkg/cc"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/dao"
+	"hcm/pkg/dal/table"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+)
+
+// mysqlDSNEnv 设置该环境变量后对 MySQL Backend 执行一致性测试，如 root:password@tcp(127.0.0.1:3306)/hcm_test，
+// 数据库需已执行 scripts/sql 下的建表脚本。测试会清空异步任务相关表，请勿指向正在使用的数据库。
+const mysqlDSNEnv = "HCM_TEST_MYSQL_DSN"
+
+func TestMysqlConformance(t *testing.T) {
+	dsn := os.Getenv(mysqlDSNEnv)
+	if len(dsn) == 0 {
+		t.Skipf("%s is not set, skip mysql backend conformance test", mysqlDSNEnv)
+	}
+
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		t.Fatalf("parse %s failed, err: %v", mysqlDSNEnv, err)
+	}
+	set, err := dao.NewDaoSet(cc.DataBase{
+		Resource: cc.ResourceDB{
+			Endpoints:         []string{cfg.Addr},
+			Database:          cfg.DBName,
+			User:              cfg.User,
+			Password:          cfg.Passwd,
+			DialTimeoutSec:    15,
+			ReadTimeoutSec:    10,
+			WriteTimeoutSec:   10,
+			MaxIdleTimeoutMin: 60,
+			MaxOpenConn:       20,
+			MaxIdleConn:       5,
+		},
+		MaxSlowLogLatencyMS: 200,
+		Limiter:             &cc.Limiter{QPS: 500, Burst: 500},
+	})
+	if err != nil {
+		t.Fatalf("create dao set failed, err: %v", err)
+	}
+
+	db, err := sqlx.Connect("mysql", dsn)
+	if err != nil {
+		t.Fatalf("connect to mysql failed, err: %v", err)
+	}
+	defer db.Close()
+
+	conformance.Run(t, func(t *testing.T) backend.Backend {
+		// 每个用例都从空表开始，与内存 Backend 的语义保持一致
+		tables := []table.Name{table.AsyncFlowTable, table.AsyncFlowTaskTable, table.AsyncCronFlowTable,
+			table.AsyncLeaderFenceTable}
+		for _, name := range tables {
+			if _, err := db.Exec(fmt.Sprintf("DELETE FROM %s", name)); err != nil {
+				t.Fatalf("clean table %s failed, err: %v", name, err)
+			}
+		}
+
+		bd, err := backend.Factory(enumor.BackendMysql, set)
+		if err != nil {
+			t.Fatalf("create mysql backend failed, err: %v", err)
+		}
+		return bd
+	})
+}
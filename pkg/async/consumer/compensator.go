@@ -0,0 +1,371 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package consumer
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"hcm/pkg/async/action"
+	"hcm/pkg/async/action/run"
+	"hcm/pkg/async/backend"
+	"hcm/pkg/async/backend/model"
+	"hcm/pkg/async/compctrl"
+	"hcm/pkg/async/consumer/leader"
+	"hcm/pkg/criteria/constant"
+	"hcm/pkg/criteria/enumor"
+	tableasync "hcm/pkg/dal/table/async"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	cvt "hcm/pkg/tools/converter"
+	"hcm/pkg/tools/retry"
+)
+
+/*
+Compensator （补偿器）: 公共组件，Saga 方式的补偿。
+ 1. 开启补偿的任务流失败或被取消时，任务流进入 Compensating 状态，Reason.PreState 记录补偿完成后需要恢复的状态。
+ 2. 获取分配给当前节点的处于 Compensating 状态的任务流，按照依赖关系逆序对执行成功且实现了 CompensateAction 的任务进行补偿。
+ 3. 某个任务补偿失败后，依赖的上游任务不再进行补偿，任务流恢复为失败或取消状态，原因中记录补偿结果。
+*/
+type Compensator interface {
+	compctrl.Closer
+	// Start 启动补偿器。
+	Start()
+}
+
+// compensator 定义任务流补偿器
+type compensator struct {
+	backend backend.Backend
+	leader  leader.Leader
+
+	watchIntervalSec   time.Duration
+	taskExecTimeoutSec uint
+
+	wg      sync.WaitGroup
+	closeCh chan struct{}
+}
+
+// NewCompensator 实例化任务流补偿器
+func NewCompensator(bd backend.Backend, ld leader.Leader, opt *Option) Compensator {
+	return &compensator{
+		backend:            bd,
+		leader:             ld,
+		watchIntervalSec:   time.Duration(opt.Scheduler.WatchIntervalSec) * time.Second,
+		taskExecTimeoutSec: opt.Executor.TaskExecTimeoutSec,
+		wg:                 sync.WaitGroup{},
+		closeCh:            make(chan struct{}),
+	}
+}
+
+// Start 启动补偿器
+func (cps *compensator) Start() {
+
+	logs.Infof("compensator start, interval: %v", cps.watchIntervalSec)
+
+	cps.wg.Add(1)
+	go cps.compensatingFlowWatcher()
+}
+
+// compensatingFlowWatcher 定期查询分配给当前节点处于补偿中的flow并执行补偿
+func (cps *compensator) compensatingFlowWatcher() {
+	defer cps.wg.Done()
+
+	for {
+		select {
+		case <-cps.closeCh:
+			return
+		default:
+		}
+
+		// Kit: Kit initiate, 每次执行创建新kit
+		kt := NewKit()
+		if err := cps.handleCompensatingFlow(kt); err != nil {
+			logs.Errorf("%s: compensator watch compensating flow failed, err: %v, rid: %s",
+				constant.AsyncTaskWarnSign, err, kt.Rid)
+		}
+
+		time.Sleep(cps.watchIntervalSec)
+	}
+}
+
+func (cps *compensator) handleCompensatingFlow(kt *kit.Kit) error {
+	flows, err := queryNodeFlow(kt, cps.backend, cps.leader.CurrNode(), enumor.FlowCompensating,
+		listScheduledFlowLimit)
+	if err != nil {
+		return err
+	}
+
+	for _, flow := range flows {
+		if err = cps.compensateFlow(kt.NewSubKit(), flow); err != nil {
+			logs.Errorf("compensate flow failed, err: %v, flow id: %s, rid: %s", err, flow.ID, kt.Rid)
+			// keep compensating other flow
+			continue
+		}
+	}
+
+	return nil
+}
+
+// compensateFlow 按照依赖关系逆序补偿任务流中执行成功的任务，全部处理完后恢复任务流补偿前的状态
+func (cps *compensator) compensateFlow(kt *kit.Kit, flow model.Flow) error {
+	tasks, err := listTaskByFlowID(kt, cps.backend, flow.ID)
+	if err != nil {
+		return err
+	}
+
+	root, err := BuildTaskRoot(tasks)
+	if err != nil {
+		logs.Errorf("build task root failed, err: %v, flow id: %s, rid: %s", err, flow.ID, kt.Rid)
+		return cps.finishFlow(kt, flow, fmt.Sprintf("compensate failed, build task tree err: %v", err))
+	}
+
+	nodeMap := make(map[string]*TaskNode, len(tasks))
+	walkAllNode(root, func(node *TaskNode) {
+		nodeMap[node.TaskID] = node
+	})
+
+	taskMap := make(map[string]*Task, len(tasks))
+	for _, task := range tasks {
+		taskMap[task.ID] = task
+	}
+
+	// 设置共享数据更新函数
+	flow.ShareData.Save = func(kt *kit.Kit, data *tableasync.ShareData) error {
+		return cps.backend.BatchUpdateFlow(kt, []model.Flow{{ID: flow.ID, ShareData: data}})
+	}
+
+	// 每轮补偿下游任务都已补偿完成的任务，直到没有可以补偿的任务
+	for {
+		ready := make([]*Task, 0)
+		for _, task := range tasks {
+			if !needCompensate(task) {
+				continue
+			}
+
+			if compensateReady(nodeMap[task.ID], taskMap) {
+				ready = append(ready, task)
+			}
+		}
+
+		if len(ready) == 0 {
+			break
+		}
+
+		for _, task := range ready {
+			if err = cps.compensateTask(flow, task); err != nil {
+				return err
+			}
+			nodeMap[task.ID].State = task.State
+		}
+	}
+
+	var compensated, failed, skipped int
+	for _, task := range tasks {
+		switch {
+		case task.State == enumor.TaskCompensated:
+			compensated++
+		case task.State == enumor.TaskCompensateFailed:
+			failed++
+		case needCompensate(task):
+			skipped++
+		}
+	}
+
+	return cps.finishFlow(kt, flow, fmt.Sprintf("compensated: %d, compensate failed: %d, skipped: %d",
+		compensated, failed, skipped))
+}
+
+// compensateTask 执行单个任务的补偿，State: success -> compensating -> compensated / compensate_failed
+func (cps *compensator) compensateTask(flow model.Flow, task *Task) error {
+	kt := task.Kit
+
+	if task.State == enumor.TaskSuccess {
+		if err := updateTaskStateByCAS(kt, cps.backend, task, enumor.TaskCompensating, ""); err != nil {
+			return err
+		}
+	}
+
+	act, _ := action.GetAction(task.ActionName)
+	params, err := task.prepareParams(act)
+	if err == nil {
+		cancel := kt.CtxWithTimeoutMS(int(cps.taskExecTimeoutSec) * 1000)
+		err = act.(action.CompensateAction).Compensate(run.NewExecuteContext(kt, flow.ShareData), params)
+		cancel()
+	}
+
+	if err != nil {
+		logs.Errorf("task compensate failed, err: %v, task id: %s, rid: %s", err, task.ID, kt.Rid)
+		return updateTaskStateByCAS(kt, cps.backend, task, enumor.TaskCompensateFailed,
+			fmt.Sprintf("compensate failed, err: %v", err))
+	}
+
+	return updateTaskStateByCAS(kt, cps.backend, task, enumor.TaskCompensated, "")
+}
+
+// finishFlow 补偿结束，任务流恢复为补偿前的失败或取消状态
+func (cps *compensator) finishFlow(kt *kit.Kit, flow model.Flow, result string) error {
+	reason := cvt.PtrToVal(flow.Reason)
+	target := enumor.FlowState(reason.PreState)
+	if target != enumor.FlowCancel {
+		target = enumor.FlowFailed
+	}
+
+	info := backend.UpdateFlowInfo{
+		ID:     flow.ID,
+		Source: enumor.FlowCompensating,
+		Target: target,
+		Reason: &tableasync.Reason{
+			PreState: string(enumor.FlowCompensating),
+			Message:  fmt.Sprintf("%s, %s", reason.Message, result),
+		},
+	}
+	// 与取消流程保持一致，取消的任务流清空 worker
+	if target == enumor.FlowCancel {
+		info.Worker = cvt.ValToPtr("")
+	}
+
+	rty := retry.NewRetryPolicy(DefRetryCount, DefRetryRangeMS)
+	err := rty.BaseExec(kt, func() error {
+		return cps.backend.BatchUpdateFlowStateByCAS(kt, []backend.UpdateFlowInfo{info})
+	})
+	if err != nil {
+		logs.Errorf("update flow state from compensating to %s failed, err: %v, flow id: %s, rid: %s",
+			target, err, flow.ID, kt.Rid)
+		return err
+	}
+
+	logs.Infof("flow %s compensate finished, %s, rid: %s", flow.ID, result, kt.Rid)
+
+	return nil
+}
+
+// Close 等待当前补偿执行完成后再关闭
+func (cps *compensator) Close() {
+
+	logs.Infof("compensator receive close cmd, start to close")
+
+	close(cps.closeCh)
+	cps.wg.Wait()
+
+	logs.Infof("compensator close success")
+}
+
+// needCompensate 任务执行成功（或补偿中途中断）且 Action 实现了补偿接口，需要进行补偿
+func needCompensate(task *Task) bool {
+	if task.State != enumor.TaskSuccess && task.State != enumor.TaskCompensating {
+		return false
+	}
+
+	act, exist := action.GetAction(task.ActionName)
+	if !exist {
+		return false
+	}
+
+	_, ok := act.(action.CompensateAction)
+	return ok
+}
+
+// compensateReady 依赖当前任务的下游任务都已经补偿完成或无需补偿，当前任务才可以补偿；下游补偿失败时，当前任务不再补偿
+func compensateReady(node *TaskNode, taskMap map[string]*Task) bool {
+	for _, child := range node.GetChildren() {
+		if child.State == enumor.TaskCompensateFailed {
+			return false
+		}
+
+		if needCompensate(taskMap[child.TaskID]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// walkAllNode 遍历任务树中的所有任务节点，不受节点状态影响
+func walkAllNode(root *TaskNode, walkFunc func(node *TaskNode)) {
+	visited := make(map[string]struct{})
+
+	var walk func(node *TaskNode)
+	walk = func(node *TaskNode) {
+		for _, child := range node.GetChildren() {
+			if _, ok := visited[child.TaskID]; ok {
+				continue
+			}
+			visited[child.TaskID] = struct{}{}
+			walkFunc(child)
+			walk(child)
+		}
+	}
+	walk(root)
+}
+
+// startFlowCompensate 开启补偿的任务流失败或取消后，将任务流状态改为补偿中，Reason.PreState 记录补偿完成后需要恢复的状态。
+func startFlowCompensate(kt *kit.Kit, bd backend.Backend, flowID string, source, dest enumor.FlowState,
+	reason string) error {
+
+	info := backend.UpdateFlowInfo{
+		ID:     flowID,
+		Source: source,
+		Target: enumor.FlowCompensating,
+		Reason: &tableasync.Reason{
+			PreState: string(dest),
+			Message:  reason,
+		},
+	}
+
+	rty := retry.NewRetryPolicy(DefRetryCount, DefRetryRangeMS)
+	err := rty.BaseExec(kt, func() error {
+		return bd.BatchUpdateFlowStateByCAS(kt, []backend.UpdateFlowInfo{info})
+	})
+	if err != nil {
+		logs.Errorf("update flow state from %s to compensating failed, err: %v, flow id: %s, rid: %s",
+			source, err, flowID, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// updateTaskStateByCAS CAS更新任务状态，采用三次重试。
+func updateTaskStateByCAS(kt *kit.Kit, bd backend.Backend, task *Task, dest enumor.TaskState, reason string) error {
+	info := &backend.UpdateTaskInfo{
+		ID:     task.ID,
+		Source: task.State,
+		Target: dest,
+		Reason: &tableasync.Reason{
+			PreState: string(task.State),
+			Message:  reason,
+		},
+	}
+
+	rty := retry.NewRetryPolicy(DefRetryCount, DefRetryRangeMS)
+	err := rty.BaseExec(kt, func() error {
+		return bd.UpdateTaskStateByCAS(kt, info)
+	})
+	if err != nil {
+		logs.Errorf("update task state from %s to %s failed, err: %v, task id: %s, rid: %s",
+			task.State, dest, err, task.ID, kt.Rid)
+		return err
+	}
+
+	task.State = dest
+
+	return nil
+}
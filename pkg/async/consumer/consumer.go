@@ -53,6 +53,7 @@ Consumer 异步任务消费者。组件分为两类，公共组件、主节点
     1. 获取分配给当前节点的处于Scheduled状态的任务流，构建任务流树，将待执行任务推送到执行器执行。
     2. 分析执行器执行完的任务，判断任务流树状态，如果任务流处理完，更新状态，否则将子节点推送到执行器执行。
   - executor（执行器）: 准备任务执行所需要的超时控制，共享数据等工具，并执行任务。
+  - compensator（补偿器）: 对开启补偿且失败或被取消的任务流，按照依赖关系逆序补偿已经执行成功的任务。
   - commander（指挥者）:
     1. 强制关闭处于执行中的任务
 */
@@ -95,10 +96,11 @@ type consumer struct {
 	leader  leader.Leader
 	mc      *metric
 
-	executor  Executor
-	scheduler Scheduler
-	watchDog  WatchDog
-	cmd       Commander
+	executor    Executor
+	scheduler   Scheduler
+	compensator Compensator
+	watchDog    WatchDog
+	cmd         Commander
 
 	// closers 所有组件的关闭操作
 	closers []compctrl.Closer
@@ -145,6 +147,11 @@ func (csm *consumer) initCommonComponent(kt *kit.Kit, opt *Option) {
 	csm.scheduler.Start()
 	csm.closers = append(csm.closers, csm.scheduler)
 
+	// 初始化补偿器并启动同时设置关闭函数
+	csm.compensator = NewCompensator(csm.backend, csm.leader, opt)
+	csm.compensator.Start()
+	csm.closers = append(csm.closers, csm.compensator)
+
 	// 设置命令工具
 	csm.cmd = NewCommander(csm.executor)
 }
@@ -183,6 +190,9 @@ func (csm *consumer) CancelFlow(kt *kit.Kit, flowId string) error {
 	if flow.State == enumor.FlowSuccess {
 		return errors.New("flow has already succeeded")
 	}
+	if flow.State == enumor.FlowCompensating {
+		return errors.New("flow is compensating")
+	}
 
 	// 取消flow 需要执行该flow的worker执行，调用该方法的时候，对应flow 不一定在当前worker上，因此这里先
 	// 更改flow状态为canceled，后续步骤由对应worker上的`canceledFlowWatcher`函数继续执行
@@ -21,11 +21,13 @@ package consumer
 
 import (
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
 	"hcm/pkg/api/core"
 	"hcm/pkg/async/backend"
+	"hcm/pkg/async/backend/model"
 	"hcm/pkg/async/consumer/leader"
 	"hcm/pkg/criteria/constant"
 	"hcm/pkg/criteria/enumor"
@@ -33,25 +35,32 @@ import (
 	"hcm/pkg/kit"
 	"hcm/pkg/logs"
 	cvt "hcm/pkg/tools/converter"
+	"hcm/pkg/tools/cron"
+	"hcm/pkg/tools/times"
 )
 
 // NewDispatcher new dispatcher.
-func NewDispatcher(bd backend.Backend, ld leader.Leader, opt *DispatcherOption) *Dispatcher {
+// fencingToken 为当前节点成为主节点时注册的屏障令牌，派发器的CAS更新都会携带该令牌。
+func NewDispatcher(bd backend.Backend, ld leader.Leader, fencingToken uint64, opt *DispatcherOption) *Dispatcher {
 	return &Dispatcher{
 		watchIntervalSec: time.Duration(opt.WatchIntervalSec) * time.Second,
 		bd:               bd,
 		ld:               ld,
+		fencingToken:     fencingToken,
 		closeCh:          make(chan struct{}),
 		wg:               new(sync.WaitGroup),
 	}
 }
 
 // Dispatcher 派发器，负责将Pending状态的任务流，派发到指定节点去执行，并将Flow状态改为Scheduled。。
+// 同时负责触发到达执行时间的周期任务流。
 type Dispatcher struct {
 	watchIntervalSec time.Duration
 
 	bd backend.Backend
 	ld leader.Leader
+	// fencingToken 主节点屏障令牌，旧主节点的令牌小于新主节点注册的令牌，CAS更新会失败
+	fencingToken uint64
 
 	wg      *sync.WaitGroup
 	closeCh chan struct{}
@@ -59,16 +68,19 @@ type Dispatcher struct {
 
 // Start dispatcher.
 func (d *Dispatcher) Start() {
-	d.wg.Add(1)
+	d.wg.Add(2)
 	go d.WatchPendingFlow()
+	go d.WatchCronFlow()
 }
 
 // WatchPendingFlow 监听处于Pending状态的流，并派发到指定节点。
 func (d *Dispatcher) WatchPendingFlow() {
+	defer d.wg.Done()
+
 	for {
 		select {
 		case <-d.closeCh:
-			break
+			return
 		default:
 		}
 
@@ -79,15 +91,16 @@ func (d *Dispatcher) WatchPendingFlow() {
 
 		time.Sleep(d.watchIntervalSec)
 	}
-
-	d.wg.Done()
 }
 
-// Do 监听处于Pending状态的流，并派发到指定节点。
+// Do 监听处于Pending状态且已到计划执行时间的流，并派发到指定节点。
 func (d *Dispatcher) Do(kt *kit.Kit) error {
 	input := &backend.ListInput{
-		Filter: tools.EqualExpression("state", enumor.FlowPending),
-		Page:   core.NewDefaultBasePage(),
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("state", enumor.FlowPending),
+			tools.RuleLessThanEqual("run_at", times.ConvStdTimeFormat(times.ConvStdTimeNow())),
+		),
+		Page: core.NewDefaultBasePage(),
 	}
 	flows, err := d.bd.ListFlow(kt, input)
 	if err != nil {
@@ -116,6 +129,8 @@ func (d *Dispatcher) Do(kt *kit.Kit) error {
 			Source: enumor.FlowPending,
 			Target: enumor.FlowScheduled,
 			Worker: cvt.ValToPtr(nodes[index%len(nodes)]), // 任务分发算法，后续看是否优化
+
+			FencingToken: d.fencingToken,
 		})
 	}
 
@@ -127,6 +142,122 @@ func (d *Dispatcher) Do(kt *kit.Kit) error {
 	return nil
 }
 
+// WatchCronFlow 监听到达执行时间的周期任务流，并创建任务流。
+func (d *Dispatcher) WatchCronFlow() {
+	defer d.wg.Done()
+
+	for {
+		select {
+		case <-d.closeCh:
+			return
+		default:
+		}
+
+		kt := NewKit()
+		if err := d.TriggerCronFlow(kt); err != nil {
+			logs.Errorf("%s: dispatcher trigger cron flow failed, err: %v, rid: %s", constant.AsyncTaskWarnSign, err,
+				kt.Rid)
+		}
+
+		time.Sleep(d.watchIntervalSec)
+	}
+}
+
+// TriggerCronFlow 触发已启用且到达执行时间的周期任务流。
+func (d *Dispatcher) TriggerCronFlow(kt *kit.Kit) error {
+	now := times.ConvStdTimeNow()
+	input := &backend.ListInput{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("enabled", true),
+			tools.RuleLessThanEqual("next_run_at", times.ConvStdTimeFormat(now)),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	crons, err := d.bd.ListCronFlow(kt, input)
+	if err != nil {
+		logs.Errorf("list cron flow failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	for _, one := range crons {
+		if err = d.triggerCronFlow(kt, one, now); err != nil {
+			logs.Errorf("%s: trigger cron flow(%s) failed, err: %v, rid: %s", constant.AsyncTaskWarnSign, one.Name,
+				err, kt.Rid)
+		}
+	}
+
+	return nil
+}
+
+// triggerCronFlow 创建本次任务流并推进下次执行时间，错过的多次执行只补偿一次。
+// 上次创建的任务流尚未结束时跳过本次执行，避免同一周期任务并发执行。
+func (d *Dispatcher) triggerCronFlow(kt *kit.Kit, one model.CronFlow, now time.Time) error {
+	sch, err := cron.Parse(one.Spec)
+	if err != nil {
+		return err
+	}
+
+	next := sch.Next(now)
+	if next.IsZero() {
+		return fmt.Errorf("cron spec %s has no next run time", one.Spec)
+	}
+
+	info := &backend.TriggerCronFlowInfo{
+		ID:        one.ID,
+		PreRunAt:  one.NextRunAt,
+		NextRunAt: times.ConvStdTimeFormat(next),
+
+		FencingToken: d.fencingToken,
+	}
+
+	running, err := d.isFlowRunning(kt, one.LastFlowID)
+	if err != nil {
+		return err
+	}
+
+	if running {
+		logs.Warnf("last flow(%s) of cron flow(%s) is still running, skip this run at %s, rid: %s",
+			one.LastFlowID, one.Name, one.NextRunAt, kt.Rid)
+	} else {
+		flow := one.Flow
+		flow.RunAt = one.NextRunAt
+		if len(flow.Memo) == 0 {
+			flow.Memo = "cron: " + one.Name
+		}
+		info.Flow = &flow
+	}
+
+	flowID, err := d.bd.TriggerCronFlow(kt, info)
+	if err != nil {
+		return err
+	}
+
+	logs.Infof("cron flow(%s) triggered, flow: %s, next run at: %s, rid: %s", one.Name, flowID, info.NextRunAt,
+		kt.Rid)
+	return nil
+}
+
+func (d *Dispatcher) isFlowRunning(kt *kit.Kit, flowID string) (bool, error) {
+	if len(flowID) == 0 {
+		return false, nil
+	}
+
+	input := &backend.ListInput{
+		Filter: tools.ExpressionAnd(
+			tools.RuleEqual("id", flowID),
+			tools.RuleNotIn("state", []enumor.FlowState{enumor.FlowSuccess, enumor.FlowFailed, enumor.FlowCancel}),
+		),
+		Page: core.NewDefaultBasePage(),
+	}
+	flows, err := d.bd.ListFlow(kt, input)
+	if err != nil {
+		logs.Errorf("list flow failed, err: %v, id: %s, rid: %s", err, flowID, kt.Rid)
+		return false, err
+	}
+
+	return len(flows) != 0, nil
+}
+
 // Close dispatcher
 func (d *Dispatcher) Close() {
 
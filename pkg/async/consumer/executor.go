@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"hcm/pkg/api/core"
 	"hcm/pkg/async/action"
@@ -38,6 +39,7 @@ import (
 	tableasync "hcm/pkg/dal/table/async"
 	"hcm/pkg/kit"
 	"hcm/pkg/logs"
+	cvt "hcm/pkg/tools/converter"
 	"hcm/pkg/tools/retry"
 	"hcm/pkg/tools/times"
 )
@@ -132,8 +134,8 @@ func (exec *executor) initWorkerTask(flow *Flow, task *Task) {
 		return
 	}
 
-	// 设置超时控制
-	cancel := task.Kit.CtxWithTimeoutMS(int(exec.taskExecTimeoutSec) * 1000)
+	// 设置取消控制，超时控制在任务每次执行时设置
+	cancel := task.Kit.CtxBackgroundWithCancel()
 
 	// 设置共享数据更新函数
 	flow.ShareData.Save = func(kt *kit.Kit, data *tableasync.ShareData) error {
@@ -196,7 +198,11 @@ func (exec *executor) workerDo(task *Task) (err error) {
 			task.State = enumor.TaskCancel
 			return
 		}
+		// 自动重试次数耗尽的任务进入死信状态，等待人工重放
 		nextState := enumor.TaskFailed
+		if errors.Is(runErr, tableasync.ErrRetryExhausted) {
+			nextState = enumor.TaskDeadLetter
+		}
 		if patchErr := exec.UpdateTask(task, nextState, runErr.Error(), failedRet); patchErr != nil {
 			logs.Errorf("task set %s state failed after run failed, err: %v, patchErr: %v, exeRid: %s, "+
 				"taskRid: %s", nextState, runErr, patchErr, exec.kt.Rid, task.Kit.Rid)
@@ -213,8 +219,9 @@ func (exec *executor) workerDo(task *Task) (err error) {
 	}
 
 	if task.State == enumor.TaskRollback && task.Reason.RollbackCount >= task.Retry.Policy.Count {
-		// 超过指定重试次数，置为失败
-		runErr = fmt.Errorf("too many retries: %w", errors.New(task.Reason.Message))
+		// 超过指定重试次数，置为死信
+		runErr = fmt.Errorf("%w: %d, lastErr: %s", tableasync.ErrRetryExhausted, task.Retry.Policy.Count,
+			task.Reason.Message)
 		return
 	}
 	// 减去已经执行的count
@@ -228,33 +235,38 @@ func (exec *executor) workerDo(task *Task) (err error) {
 		if !needRetry {
 			return true, failRet, err
 		}
-		// 允许重试，将Task状态由 running -> rollback，进行回滚
+		// 允许重试，将Task状态由 running -> rollback，返回错误交由重试策略退避等待后，在下一次执行时先回滚再重新运行
 		if patchErr := exec.UpdateTask(task, enumor.TaskRollback, err.Error(), failRet); patchErr != nil {
 			e := fmt.Errorf("task set rollback state failed, after runAction failed, err: %v, patchErr: %v",
 				err, patchErr)
-			return false, failRet, e
+			return true, failRet, e
 		}
 
-		return false, nil, nil
+		return false, failRet, err
 	})
 
 	return nil
 }
 
-// runTaskOnce 只有执行Action运行逻辑失败才会允许重试，更改状态失败不进行重试。
+// runTaskOnce 只有执行Action运行逻辑失败才会允许重试，更改状态失败不进行重试。永久性错误不重试，被限流的错误按照限流策略重试。
 // 如果执行成功直接写入状态和结果，失败时才将状态和结果返回到上层
 func (exec *executor) runTaskOnce(task *Task, act action.Action) (needRetry bool, failedResult any, err error) {
 	params, err := task.prepareParams(act)
 	if err != nil {
 		return false, nil, err
 	}
+
+	// 每次执行设置独立的超时控制
+	execKit, cancel := exec.newTimeoutExecuteKit(task)
+	defer cancel()
+
 	if task.State == enumor.TaskRollback {
 		rollbackAct, ok := act.(action.RollbackAction)
 		if !ok {
 			return false, nil, fmt.Errorf("action: %s not has RollbackAction", act.Name())
 		}
 
-		if err = rollbackAct.Rollback(task.ExecuteKit, params); err != nil {
+		if err = rollbackAct.Rollback(execKit, params); err != nil {
 			return true, nil, fmt.Errorf("rollback failed, err: %v", err)
 		}
 
@@ -268,14 +280,26 @@ func (exec *executor) runTaskOnce(task *Task, act action.Action) (needRetry bool
 			return false, nil, err
 		}
 
-		result, err := act.Run(task.ExecuteKit, params)
+		result, err := act.Run(execKit, params)
 		if err != nil {
 			if errf.IsContextCanceled(err) {
 				// 被取消不需要重试
 				return false, result, err
 			}
-			return true, result, fmt.Errorf("run failed, err: %v, time: %s",
-				err, times.ConvStdTimeNow())
+
+			runErr := fmt.Errorf("run failed, err: %w, time: %s", err, times.ConvStdTimeNow())
+			switch action.ClassifyError(act, err) {
+			case action.ErrorPermanent:
+				return false, result, runErr
+			case action.ErrorThrottled:
+				var throttled *action.ThrottledError
+				if !errors.As(err, &throttled) {
+					runErr = action.NewThrottledError(runErr, 0)
+				}
+				return true, result, runErr
+			default:
+				return true, result, runErr
+			}
 		}
 
 		// 如果执行成功，返回 result 属于成功结果，设置成功状态时，同时设置成功结果。如果执行失败，
@@ -288,6 +312,20 @@ func (exec *executor) runTaskOnce(task *Task, act action.Action) (needRetry bool
 	return false, nil, nil
 }
 
+// newTimeoutExecuteKit 创建任务单次执行使用的kit，超时时间优先使用任务自身设置的超时时间
+func (exec *executor) newTimeoutExecuteKit(task *Task) (run.ExecuteKit, context.CancelFunc) {
+	timeoutSec := exec.taskExecTimeoutSec
+	if task.TimeoutSec > 0 {
+		timeoutSec = task.TimeoutSec
+	}
+
+	kt := cvt.ValToPtr(*task.ExecuteKit.Kit())
+	var cancel context.CancelFunc
+	kt.Ctx, cancel = context.WithTimeout(kt.Ctx, time.Duration(timeoutSec)*time.Second)
+
+	return run.NewExecuteContext(kt, task.ExecuteKit.ShareData()), cancel
+}
+
 // Push 任务写入到initQueue
 func (exec *executor) Push(flow *Flow, task *Task) {
 
@@ -338,7 +376,8 @@ func (exec *executor) CancelFlow(kt *kit.Kit, flowId string) error {
 	for _, task := range taskList {
 		switch task.State {
 
-		case enumor.TaskPending, enumor.TaskInit, enumor.TaskRollback, enumor.TaskFailed, enumor.TaskRunning:
+		case enumor.TaskPending, enumor.TaskInit, enumor.TaskRollback, enumor.TaskFailed, enumor.TaskRunning,
+			enumor.TaskDeadLetter:
 			// 	更新数据库状态
 			err := exec.UpdateTask(&Task{Task: task}, enumor.TaskCancel, string(task.State), nil)
 			logs.Errorf("fail to update task(%s) state for cancel, err: %v, rid: %s", task.ID, err, kt.Rid)
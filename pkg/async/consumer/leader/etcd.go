@@ -0,0 +1,222 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package leader
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"hcm/pkg/logs"
+	"hcm/pkg/serviced"
+
+	etcd3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+// EtcdLeaderOption etcd选主参数
+type EtcdLeaderOption struct {
+	// Prefix 选举使用的etcd key前缀
+	Prefix string
+	// LeaseTTLSec 主节点租约有效时间，主节点失联超过该时间后重新选主
+	LeaseTTLSec uint
+}
+
+// Validate EtcdLeaderOption
+func (opt *EtcdLeaderOption) Validate() error {
+	if len(opt.Prefix) == 0 {
+		return errors.New("prefix is required")
+	}
+
+	if opt.LeaseTTLSec == 0 {
+		return errors.New("lease ttl sec is required")
+	}
+
+	return nil
+}
+
+var _ Leader = new(etcdLeader)
+
+// NewEtcdLeader 创建基于etcd租约选举的主节点控制器，当前节点会在后台持续参与选举，直到ctx结束。
+// 主节点屏障令牌为主节点选举key的创建版本号，新主节点的令牌一定大于旧主节点。存活节点仍然通过服务发现获取。
+func NewEtcdLeader(ctx context.Context, cli *etcd3.Client, sd serviced.ServiceDiscover,
+	opt *EtcdLeaderOption) (Leader, error) {
+
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	el := &etcdLeader{
+		leader: &leader{sd: sd},
+		newElector: func(ctx context.Context) (elector, error) {
+			return newEtcdElector(ctx, cli, opt)
+		},
+		campaignInterval: time.Second,
+	}
+	go el.run(ctx)
+
+	return el, nil
+}
+
+// elector 一次选举会话，会话租约失效后需要创建新的会话重新参与选举
+type elector interface {
+	// Campaign 阻塞直到当前节点当选，返回当选时的主节点屏障令牌
+	Campaign(ctx context.Context, node string) (uint64, error)
+	// Done 会话租约失效时关闭
+	Done() <-chan struct{}
+	// Resign 主动放弃主节点
+	Resign(ctx context.Context) error
+	// Close 关闭会话并撤销租约
+	Close() error
+}
+
+// etcdElector 基于etcd concurrency.Session 和 concurrency.Election 实现的选举会话
+type etcdElector struct {
+	session  *concurrency.Session
+	election *concurrency.Election
+}
+
+func newEtcdElector(ctx context.Context, cli *etcd3.Client, opt *EtcdLeaderOption) (elector, error) {
+	session, err := concurrency.NewSession(cli, concurrency.WithTTL(int(opt.LeaseTTLSec)),
+		concurrency.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &etcdElector{
+		session:  session,
+		election: concurrency.NewElection(session, opt.Prefix),
+	}, nil
+}
+
+// Campaign 参与选举，当选后返回选举key的创建版本号作为屏障令牌
+func (e *etcdElector) Campaign(ctx context.Context, node string) (uint64, error) {
+	if err := e.election.Campaign(ctx, node); err != nil {
+		return 0, err
+	}
+
+	return uint64(e.election.Rev()), nil
+}
+
+// Done 会话租约失效时关闭
+func (e *etcdElector) Done() <-chan struct{} {
+	return e.session.Done()
+}
+
+// Resign 主动放弃主节点
+func (e *etcdElector) Resign(ctx context.Context) error {
+	return e.election.Resign(ctx)
+}
+
+// Close 关闭会话
+func (e *etcdElector) Close() error {
+	return e.session.Close()
+}
+
+// etcdLeader 基于etcd选举实现的主节点控制器
+type etcdLeader struct {
+	*leader
+
+	newElector       func(ctx context.Context) (elector, error)
+	campaignInterval time.Duration
+
+	lock     sync.RWMutex
+	isLeader bool
+	token    uint64
+}
+
+// IsLeader 判断是否是主节点
+func (el *etcdLeader) IsLeader() bool {
+	el.lock.RLock()
+	defer el.lock.RUnlock()
+
+	return el.isLeader
+}
+
+// FencingToken 返回当前节点作为主节点的屏障令牌，非主节点时返回0
+func (el *etcdLeader) FencingToken() uint64 {
+	el.lock.RLock()
+	defer el.lock.RUnlock()
+
+	return el.token
+}
+
+func (el *etcdLeader) setLeader(isLeader bool, token uint64) {
+	el.lock.Lock()
+	defer el.lock.Unlock()
+
+	el.isLeader = isLeader
+	el.token = token
+}
+
+// run 循环参与选举，租约失效或选举失败后重新参与选举
+func (el *etcdLeader) run(ctx context.Context) {
+	for {
+		if err := el.campaign(ctx); err != nil {
+			logs.Errorf("node %s campaign leader failed, err: %v", el.CurrNode(), err)
+		}
+		el.setLeader(false, 0)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(el.campaignInterval):
+		}
+	}
+}
+
+// campaign 参与一次选举，成为主节点后阻塞直到租约失效或ctx结束
+func (el *etcdLeader) campaign(ctx context.Context) error {
+	e, err := el.newElector(ctx)
+	if err != nil {
+		return err
+	}
+	defer e.Close()
+
+	// 等待选举期间租约失效时需要结束本次选举，否则会一直阻塞
+	campaignCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-e.Done():
+			cancel()
+		case <-campaignCtx.Done():
+		}
+	}()
+
+	token, err := e.Campaign(campaignCtx, el.CurrNode())
+	if err != nil {
+		return err
+	}
+
+	el.setLeader(true, token)
+	logs.Infof("node %s become leader, fencing token: %d", el.CurrNode(), token)
+
+	select {
+	case <-e.Done():
+		logs.Warnf("node %s leader lease expired, lose leader", el.CurrNode())
+		return nil
+	case <-ctx.Done():
+		el.setLeader(false, 0)
+		resignCtx, resignCancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer resignCancel()
+		return e.Resign(resignCtx)
+	}
+}
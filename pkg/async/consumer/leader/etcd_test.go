@@ -0,0 +1,201 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package leader
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/async/backend"
+	"hcm/pkg/async/backend/model"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/serviced"
+)
+
+// fakeDiscover 只提供当前节点标识的服务发现
+type fakeDiscover struct {
+	serviced.ServiceDiscover
+}
+
+// CurrentNodeKey ...
+func (fakeDiscover) CurrentNodeKey() string {
+	return "/hcm/task-server/node-1"
+}
+
+// fakeElection 模拟etcd选举，每次当选的令牌与etcd的版本号一样单调递增
+type fakeElection struct {
+	lock     sync.Mutex
+	rev      uint64
+	sessions []*fakeElector
+}
+
+func (f *fakeElection) newElector(_ context.Context) (elector, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	e := &fakeElector{election: f, done: make(chan struct{})}
+	f.sessions = append(f.sessions, e)
+	return e, nil
+}
+
+// expire 使当前会话租约失效
+func (f *fakeElection) expire() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	close(f.sessions[len(f.sessions)-1].done)
+}
+
+type fakeElector struct {
+	election *fakeElection
+	done     chan struct{}
+}
+
+// Campaign ...
+func (e *fakeElector) Campaign(_ context.Context, _ string) (uint64, error) {
+	e.election.lock.Lock()
+	defer e.election.lock.Unlock()
+
+	e.election.rev++
+	return e.election.rev, nil
+}
+
+// Done ...
+func (e *fakeElector) Done() <-chan struct{} {
+	return e.done
+}
+
+// Resign ...
+func (e *fakeElector) Resign(_ context.Context) error {
+	return nil
+}
+
+// Close ...
+func (e *fakeElector) Close() error {
+	return nil
+}
+
+func newTestEtcdLeader(election *fakeElection) *etcdLeader {
+	return &etcdLeader{
+		leader:           &leader{sd: fakeDiscover{}},
+		newElector:       election.newElector,
+		campaignInterval: time.Millisecond,
+	}
+}
+
+// waitToken 等待当前节点成为主节点并返回屏障令牌
+func waitToken(t *testing.T, el *etcdLeader, greaterThan uint64) uint64 {
+	deadline := time.Now().Add(3 * time.Second)
+	for time.Now().Before(deadline) {
+		if token := el.FencingToken(); el.IsLeader() && token > greaterThan {
+			return token
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("node should become leader with token greater than %d", greaterThan)
+	return 0
+}
+
+func TestEtcdLeaderTokenIncreaseOnReelection(t *testing.T) {
+	election := new(fakeElection)
+	el := newTestEtcdLeader(election)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		el.run(ctx)
+		close(done)
+	}()
+
+	first := waitToken(t, el, 0)
+
+	// 租约失效后重新当选，令牌必须大于上一任期
+	election.expire()
+	second := waitToken(t, el, first)
+	if second <= first {
+		t.Errorf("token after re-election should be greater than %d, got: %d", first, second)
+	}
+
+	cancel()
+	<-done
+	if el.IsLeader() || el.FencingToken() != 0 {
+		t.Errorf("node should not be leader after stopped, is leader: %v, token: %d", el.IsLeader(),
+			el.FencingToken())
+	}
+}
+
+func TestEtcdLeaderRejectStaleToken(t *testing.T) {
+	election := new(fakeElection)
+	el := newTestEtcdLeader(election)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go el.run(ctx)
+
+	bd := backend.NewMemory()
+	kt := core.NewBackendKit()
+	flowID, err := bd.CreateFlow(kt, &model.Flow{
+		Name:  enumor.FlowNormalTest,
+		State: enumor.FlowPending,
+		Tasks: []model.Task{{
+			FlowName:   enumor.FlowNormalTest,
+			ActionID:   "1",
+			ActionName: enumor.ActionCreateFactoryTest,
+			Params:     "{}",
+			State:      enumor.TaskPending,
+		}},
+	})
+	if err != nil {
+		t.Fatalf("create flow failed, err: %v", err)
+	}
+
+	staleToken := waitToken(t, el, 0)
+	if err = bd.AdvanceFencingToken(kt, staleToken); err != nil {
+		t.Fatalf("advance fencing token failed, err: %v", err)
+	}
+
+	election.expire()
+	token := waitToken(t, el, staleToken)
+	if err = bd.AdvanceFencingToken(kt, token); err != nil {
+		t.Fatalf("advance fencing token failed, err: %v", err)
+	}
+
+	// 旧任期的令牌既不能重新注册，也不能更新任务流状态
+	if err = bd.AdvanceFencingToken(kt, staleToken); err == nil {
+		t.Errorf("advance stale fencing token %d should fail", staleToken)
+	}
+	update := func(token uint64) error {
+		return bd.BatchUpdateFlowStateByCAS(kt, []backend.UpdateFlowInfo{{
+			ID:           flowID,
+			Source:       enumor.FlowPending,
+			Target:       enumor.FlowScheduled,
+			FencingToken: token,
+		}})
+	}
+	if err = update(staleToken); err == nil {
+		t.Errorf("update flow with stale fencing token %d should fail", staleToken)
+	}
+	if err = update(token); err != nil {
+		t.Errorf("update flow with current fencing token %d failed, err: %v", token, err)
+	}
+}
@@ -33,6 +33,8 @@ type Leader interface {
 	IsLeader() bool
 	AliveNodes() ([]string, error)
 	CurrNode() string
+	// FencingToken 主节点屏障令牌，每次选出的主节点令牌单调递增，为0表示不使用屏障令牌
+	FencingToken() uint64
 }
 
 var _ Leader = new(leader)
@@ -78,3 +80,8 @@ func (al *leader) AliveNodes() ([]string, error) {
 func (al *leader) IsLeader() bool {
 	return al.sd.IsMaster()
 }
+
+// FencingToken 基于服务发现节点比较的主节点不提供屏障令牌
+func (al *leader) FencingToken() uint64 {
+	return 0
+}
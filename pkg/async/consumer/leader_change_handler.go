@@ -50,6 +50,8 @@ type LeaderChangeHandler struct {
 
 	dispatcher *Dispatcher
 	watchDog   WatchDog
+	// fencingToken 主节点组件启动时使用的屏障令牌
+	fencingToken uint64
 
 	closeCh chan struct{}
 
@@ -65,6 +67,8 @@ func (handler *LeaderChangeHandler) Start() {
 
 // Do 负责主节点组件的开启和关闭，在切主/切从的时候。
 func (handler *LeaderChangeHandler) Do() {
+	defer handler.wg.Done()
+
 	for {
 		time.Sleep(time.Second)
 
@@ -72,7 +76,7 @@ func (handler *LeaderChangeHandler) Do() {
 		select {
 		case <-handler.closeCh:
 			handler.closeLeaderComponent()
-			break
+			return
 		default:
 		}
 
@@ -93,26 +97,45 @@ func (handler *LeaderChangeHandler) Do() {
 		// 如果是从切主，需要开启主节点组件
 		if handler.ld.IsLeader() && len(handler.closers) == 0 {
 			logs.Infof("the current node is master, start leader component...")
-			handler.startLeaderComponent()
+			if err := handler.startLeaderComponent(); err != nil {
+				logs.Errorf("the current node is master, but start leader component failed, err: %v", err)
+				continue
+			}
 			logs.Infof("the current node is master, start leader success")
 			continue
 		}
-	}
 
-	handler.wg.Done()
+		// 如果两次检查之间重新当选了主节点，屏障令牌会发生变化，需要使用新的令牌重启主节点组件
+		if handler.ld.IsLeader() && handler.ld.FencingToken() != handler.fencingToken {
+			logs.Infof("the fencing token of current master node changed, restart leader component")
+			handler.closeLeaderComponent()
+			continue
+		}
+	}
 }
 
-func (handler *LeaderChangeHandler) startLeaderComponent() {
-	dis := NewDispatcher(handler.bd, handler.ld, handler.opt.Dispatcher)
+func (handler *LeaderChangeHandler) startLeaderComponent() error {
+	// 启动主节点组件前先注册屏障令牌，使旧主节点携带旧令牌的更新全部失效
+	token := handler.ld.FencingToken()
+	if token != 0 {
+		if err := handler.bd.AdvanceFencingToken(NewKit(), token); err != nil {
+			return err
+		}
+	}
+	handler.fencingToken = token
+
+	dis := NewDispatcher(handler.bd, handler.ld, token, handler.opt.Dispatcher)
 	dis.Start()
 	handler.closers = append(handler.closers, dis)
 	handler.dispatcher = dis
 
 	// 初始化watchdog并启动同时设置关闭函数
-	wd := NewWatchDog(handler.bd, handler.ld, handler.opt.WatchDog)
+	wd := NewWatchDog(handler.bd, handler.ld, token, handler.opt.WatchDog)
 	wd.Start()
 	handler.closers = append(handler.closers, wd)
 	handler.watchDog = wd
+
+	return nil
 }
 
 // Close 主从切换处理器
@@ -107,10 +107,12 @@ func (sch *scheduler) Start() {
 
 // flowWatcher 定期查询调度到该节点的flow
 func (sch *scheduler) scheduledFlowWatcher() {
+	defer sch.workerWg.Done()
+
 	for {
 		select {
 		case <-sch.closeCh:
-			break
+			return
 		default:
 		}
 		// Kit: Kit initiate, 每次执行创建新kit
@@ -122,27 +124,32 @@ func (sch *scheduler) scheduledFlowWatcher() {
 
 		time.Sleep(sch.watchIntervalSec)
 	}
-
-	sch.workerWg.Done()
 }
 
 // queryCurrNodeFlow 查询主节点分配给当前节点处于 Scheduled 状态的任务流。
 func (sch *scheduler) queryCurrNodeFlow(kt *kit.Kit, state enumor.FlowState, limit int32) (
 	[]model.Flow, error) {
 
+	return queryNodeFlow(kt, sch.backend, sch.leader.CurrNode(), state, limit)
+}
+
+// queryNodeFlow 查询分配给指定节点处于指定状态的任务流。
+func queryNodeFlow(kt *kit.Kit, bd backend.Backend, node string, state enumor.FlowState, limit int32) (
+	[]model.Flow, error) {
+
 	if limit > int32(core.DefaultMaxPageLimit) {
 		return nil, fmt.Errorf("limit should <= %d", core.DefaultMaxPageLimit)
 	}
 	input := &backend.ListInput{
 		Filter: tools.ExpressionAnd(
 			tools.RuleEqual("state", state),
-			tools.RuleEqual("worker", sch.leader.CurrNode())),
+			tools.RuleEqual("worker", node)),
 		Page: &core.BasePage{
 			Start: 0,
 			Limit: uint(limit),
 		},
 	}
-	result, err := sch.backend.ListFlow(kt, input)
+	result, err := bd.ListFlow(kt, input)
 	if err != nil {
 		logs.Errorf("list flows failed, err: %v, rid: %s", err, kt.Rid)
 		return nil, err
@@ -152,10 +159,12 @@ func (sch *scheduler) queryCurrNodeFlow(kt *kit.Kit, state enumor.FlowState, lim
 
 // canceledFlowWatcher 查询当前节点上被取消的flow并执行task取消操作
 func (sch *scheduler) canceledFlowWatcher() {
+	defer sch.workerWg.Done()
+
 	for {
 		select {
 		case <-sch.closeCh:
-			break
+			return
 		default:
 		}
 		// Kit: Kit initiate, 每次执行创建新kit
@@ -167,8 +176,6 @@ func (sch *scheduler) canceledFlowWatcher() {
 
 		time.Sleep(sch.watchIntervalSec)
 	}
-
-	sch.workerWg.Done()
 }
 
 func (sch *scheduler) handleCanceledFlow(kt *kit.Kit) error {
@@ -185,6 +192,23 @@ func (sch *scheduler) handleCanceledFlow(kt *kit.Kit) error {
 		logs.Infof("canceling flow: %s", flow.ID)
 		// 清空任务树，阻止继续调度
 		sch.DeleteFlowTaskTree(flow.ID)
+
+		// 开启补偿的任务流，先取消执行中的任务，再交由补偿器补偿已经执行成功的任务，补偿结束后恢复为取消状态
+		if flow.Compensate {
+			if err := sch.executor.CancelFlow(kt, flow.ID); err != nil {
+				logs.Errorf("fail to handle flow canceling, err: %v, flow id: %s, rid: %s", err, flow.ID, kt.Rid)
+				continue
+			}
+
+			err = startFlowCompensate(kt, sch.backend, flow.ID, enumor.FlowCancel, enumor.FlowCancel,
+				cvt.PtrToVal(flow.Reason).Message)
+			if err != nil {
+				logs.Errorf("fail to start canceled flow compensate, err: %v, flow id: %s, rid: %s",
+					err, flow.ID, kt.Rid)
+			}
+			continue
+		}
+
 		err = updateFlowToCancel(kt, sch.backend, flow.ID, cvt.PtrToVal(flow.Worker), enumor.FlowCancel)
 		if err != nil {
 			logs.Errorf("fail to update flow clear worker id, err: %v, flow id: %s rid: %s",
@@ -313,9 +337,7 @@ func (sch *scheduler) parseFlowAndPushTask(kt *kit.Kit, flow *Flow) error {
 		}
 
 		if state == enumor.FlowFailed {
-			if err = updateFlowStateAndReason(kt, sch.backend, flow.ID, enumor.FlowRunning, state,
-				ErrSomeTaskExecFailed); err != nil {
-
+			if err = updateFlowToFailed(kt, sch.backend, &flow.Flow, ErrSomeTaskExecFailed); err != nil {
 				logs.Errorf("update flow state to %s failed, err: %v, rid: %s", state, err, kt.Rid)
 				return err
 			}
@@ -348,6 +370,30 @@ func updateFlowState(kt *kit.Kit, bd backend.Backend, flowID string, source,
 	return updateFlowStateAndReason(kt, bd, flowID, source, dest, "")
 }
 
+// updateFlowStateWithFence 携带主节点屏障令牌更新Flow状态和原因，采用CAS加三次重试，令牌过期时更新失败。
+// 用于主节点重置任务流状态，避免已失去主节点身份的旧主节点修改任务流状态。
+func updateFlowStateWithFence(kt *kit.Kit, bd backend.Backend, flowID string, source, dest enumor.FlowState,
+	reason string, fencingToken uint64) error {
+
+	info := backend.UpdateFlowInfo{
+		ID:           flowID,
+		Source:       source,
+		Target:       dest,
+		FencingToken: fencingToken,
+	}
+	if len(reason) != 0 {
+		info.Reason = &tableasync.Reason{
+			PreState: string(source),
+			Message:  reason,
+		}
+	}
+
+	rty := retry.NewRetryPolicy(DefRetryCount, DefRetryRangeMS)
+	return rty.BaseExec(kt, func() error {
+		return bd.BatchUpdateFlowStateByCAS(kt, []backend.UpdateFlowInfo{info})
+	})
+}
+
 // updateFlowState 更新Flow状态和原因，采用CAS加三次重试。source原状态，dest目标状态。
 func updateFlowStateAndReason(kt *kit.Kit, bd backend.Backend, flowID string, source, dest enumor.FlowState,
 	reason string) error {
@@ -375,6 +421,15 @@ func updateFlowStateAndReason(kt *kit.Kit, bd backend.Backend, flowID string, so
 	return nil
 }
 
+// updateFlowToFailed 执行中的任务流状态改为失败，开启补偿的任务流改为补偿中，补偿结束后再恢复为失败。
+func updateFlowToFailed(kt *kit.Kit, bd backend.Backend, flow *model.Flow, reason string) error {
+	if flow.Compensate {
+		return startFlowCompensate(kt, bd, flow.ID, enumor.FlowRunning, enumor.FlowFailed, reason)
+	}
+
+	return updateFlowStateAndReason(kt, bd, flow.ID, enumor.FlowRunning, enumor.FlowFailed, reason)
+}
+
 // updateFlowToCancel 状态改为取消，清空 worker字段,
 func updateFlowToCancel(kt *kit.Kit, bd backend.Backend, flowId, oldWorkerID string, source enumor.FlowState) error {
 
@@ -475,9 +530,7 @@ func (sch *scheduler) executeNext(kt *kit.Kit, task *Task) error {
 
 			sch.DeleteFlowTaskTree(task.FlowID)
 		case enumor.FlowFailed:
-			if err := updateFlowStateAndReason(kt, sch.backend, task.FlowID, enumor.FlowRunning, state,
-				ErrSomeTaskExecFailed); err != nil {
-
+			if err := updateFlowToFailed(kt, sch.backend, &tree.Flow.Flow, ErrSomeTaskExecFailed); err != nil {
 				logs.Errorf("update flow state to `%s` failed, err: %v, rid: %s", state, err, kt.Rid)
 				return err
 			}
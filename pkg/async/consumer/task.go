@@ -33,6 +33,7 @@ import (
 	"hcm/pkg/kit"
 	"hcm/pkg/logs"
 	"hcm/pkg/tools/retry"
+	"hcm/pkg/tools/times"
 )
 
 // Task 异步任务执行体，包含了任务运行流程、回滚流程。
@@ -201,6 +202,7 @@ func (task *Task) UpdateTask(state enumor.TaskState, reason string, result inter
 	}
 
 	task.State = state
+	task.Reason = md.Reason
 
 	return nil
 }
@@ -225,6 +227,16 @@ func (task *Task) buildTaskUpdateModel(kt *kit.Kit, state enumor.TaskState, reas
 	if state == enumor.TaskRollback {
 		md.Reason.RollbackCount = task.Reason.RollbackCount + 1
 	}
+
+	// 记录任务首次开始运行时间和运行结束时间，重试时保留首次开始运行时间
+	switch state {
+	case enumor.TaskRunning:
+		if task.State == enumor.TaskPending && task.Reason.RollbackCount == 0 {
+			md.StartedAt = times.ConvStdTimeFormat(times.ConvStdTimeNow())
+		}
+	case enumor.TaskSuccess, enumor.TaskFailed, enumor.TaskDeadLetter, enumor.TaskCancel:
+		md.EndedAt = times.ConvStdTimeFormat(times.ConvStdTimeNow())
+	}
 	if result != nil {
 		field, err := types.NewJsonField(result)
 		if err != nil {
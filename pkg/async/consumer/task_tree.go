@@ -121,7 +121,7 @@ func (t *TaskNode) ComputeState() (state enumor.FlowState) {
 		case enumor.TaskCancel:
 			state = enumor.FlowCancel
 			return false
-		case enumor.TaskFailed:
+		case enumor.TaskFailed, enumor.TaskDeadLetter:
 			state = enumor.FlowFailed
 			return false
 		// 如果当前节点运行成功，继续遍历当前节点子节点。
@@ -45,6 +45,7 @@ WatchDog （看门狗）:
  1. 处理超时任务
  2. 处理处于Scheduled状态，但执行节点已经挂掉的任务流
  3. 处理处于Running状态，但执行节点正在Shutdown或者已经挂掉的任务流
+ 4. 处理处于Compensating状态，但执行节点已经挂掉的任务流
 */
 type WatchDog interface {
 	compctrl.Closer
@@ -56,6 +57,8 @@ type WatchDog interface {
 type watchDog struct {
 	bd backend.Backend
 	ld leader.Leader
+	// fencingToken 主节点屏障令牌
+	fencingToken uint64
 
 	taskTimeoutSec      time.Duration
 	shutdownWaitTimeSec time.Duration
@@ -68,11 +71,13 @@ type watchDog struct {
 }
 
 // NewWatchDog 创建一个watchdog
-func NewWatchDog(bd backend.Backend, ld leader.Leader, opt *WatchDogOption) WatchDog {
+// fencingToken 为当前节点成为主节点时注册的屏障令牌，重新分配任务流节点的CAS更新会携带该令牌。
+func NewWatchDog(bd backend.Backend, ld leader.Leader, fencingToken uint64, opt *WatchDogOption) WatchDog {
 
 	return &watchDog{
 		bd:                  bd,
 		ld:                  ld,
+		fencingToken:        fencingToken,
 		taskTimeoutSec:      time.Duration(opt.TaskRunTimeoutSec) * time.Second,
 		shutdownWaitTimeSec: time.Duration(opt.ShutdownWaitTimeSec) * time.Second,
 		watchIntervalSec:    time.Duration(opt.WatchIntervalSec) * time.Second,
@@ -90,14 +95,18 @@ func (wd *watchDog) Start() {
 	go wd.watchWrapper(wd.handleScheduledNotExistWorkerFlow)
 	wd.wg.Add(1)
 	go wd.watchWrapper(wd.handleRunningNotExistWorkerFlow)
+	wd.wg.Add(1)
+	go wd.watchWrapper(wd.handleCompensatingNotExistWorkerFlow)
 }
 
 // 定期处理异常任务流或任务
 func (wd *watchDog) watchWrapper(do func(kt *kit.Kit) error) {
+	defer wd.wg.Done()
+
 	for {
 		select {
 		case <-wd.closeCh:
-			break
+			return
 		default:
 		}
 
@@ -108,8 +117,6 @@ func (wd *watchDog) watchWrapper(do func(kt *kit.Kit) error) {
 		}
 		time.Sleep(wd.watchIntervalSec)
 	}
-
-	wd.wg.Done()
 }
 
 // Close 等待当前执行体执行完成后再关闭
@@ -166,18 +173,12 @@ func (wd *watchDog) handleExpiredTasks(kt *kit.Kit) error {
 			return err
 		}
 
-		flows := []model.Flow{
-			{
-				ID:    one.FlowID,
-				State: enumor.FlowFailed,
-				Reason: &tableasync.Reason{
-					Message: ErrTaskExecTimeout,
-				},
-			},
-		}
-		if err = wd.bd.BatchUpdateFlow(kt, flows); err != nil {
-			logs.Errorf("update flow to failed state failed, err: %v, rid: %s", err, kt.Rid)
-			return err
+		// 任务流不处于执行中（如补偿中）或当前节点已不是主节点时更新失败，不影响其他超时任务的处理
+		err = updateFlowStateWithFence(kt, wd.bd, one.FlowID, enumor.FlowRunning, enumor.FlowFailed,
+			ErrTaskExecTimeout, wd.fencingToken)
+		if err != nil {
+			logs.Errorf("update flow %s to failed state failed, err: %v, rid: %s", one.FlowID, err, kt.Rid)
+			continue
 		}
 	}
 
@@ -213,22 +214,68 @@ func (wd *watchDog) handleScheduledNotExistWorkerFlow(kt *kit.Kit) error {
 		return nil
 	}
 
-	mds := make([]model.Flow, 0, len(flows))
 	ids := make([]string, 0, len(flows))
 	for _, one := range flows {
-		ids = append(ids, one.ID)
-		mds = append(mds, model.Flow{
+		info := backend.UpdateFlowInfo{
 			ID:     one.ID,
-			State:  enumor.FlowPending,
+			Source: enumor.FlowScheduled,
+			Target: enumor.FlowPending,
 			Worker: converter.ValToPtr(""),
-		})
+
+			FencingToken: wd.fencingToken,
+		}
+		if err = wd.bd.BatchUpdateFlowStateByCAS(kt, []backend.UpdateFlowInfo{info}); err != nil {
+			logs.Errorf("reset scheduled flow to pending failed, err: %v, id: %s, rid: %s", err, one.ID, kt.Rid)
+			return err
+		}
+		ids = append(ids, one.ID)
 	}
-	if err = wd.bd.BatchUpdateFlow(kt, flows); err != nil {
-		logs.Errorf("update flows failed, err: %v, rid: %s", err, kt.Rid)
+
+	logs.Infof("handleScheduledNotExistWorkerFlow success, count: %d, ids: %v, rid: %s", len(ids), ids, kt.Rid)
+
+	return nil
+}
+
+// handleCompensatingNotExistWorkerFlow 将处于补偿中【Compensating】且分配的节点已经下线的任务流重新分配给存活节点继续补偿，
+// 补偿操作是幂等的，补偿中的任务会被重新补偿。
+func (wd *watchDog) handleCompensatingNotExistWorkerFlow(kt *kit.Kit) error {
+
+	flows, err := wd.queryNotExistNodesFlowByState(kt, enumor.FlowCompensating)
+	if err != nil {
 		return err
 	}
 
-	logs.Infof("handleScheduledNotExistWorkerFlow success, count: %d, ids: %v, rid: %s", len(ids), ids, kt.Rid)
+	if len(flows) == 0 {
+		return nil
+	}
+
+	nodes, err := wd.ld.AliveNodes()
+	if err != nil {
+		logs.Errorf("query alive nodes failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(flows))
+	for index, one := range flows {
+		info := backend.UpdateFlowInfo{
+			ID:     one.ID,
+			Source: enumor.FlowCompensating,
+			Target: enumor.FlowCompensating,
+			Worker: converter.ValToPtr(nodes[index%len(nodes)]),
+
+			FencingToken: wd.fencingToken,
+		}
+		if err = wd.bd.BatchUpdateFlowStateByCAS(kt, []backend.UpdateFlowInfo{info}); err != nil {
+			logs.Errorf("reassign compensating flow failed, err: %v, id: %s, rid: %s", err, one.ID, kt.Rid)
+			return err
+		}
+		ids = append(ids, one.ID)
+	}
+
+	logs.Infof("handleCompensatingNotExistWorkerFlow success, count: %d, ids: %v, rid: %s", len(ids), ids, kt.Rid)
 
 	return nil
 }
@@ -330,7 +377,8 @@ func (wd *watchDog) handleRunningFlow(kt *kit.Kit, flow model.Flow) error {
 	// 如果树已经处于结束状态，则直接更新
 	state := root.ComputeState()
 	if state == enumor.FlowSuccess || state == enumor.FlowFailed {
-		if err = updateFlowState(kt, wd.bd, flow.ID, enumor.FlowRunning, state); err != nil {
+		err = updateFlowStateWithFence(kt, wd.bd, flow.ID, enumor.FlowRunning, state, "", wd.fencingToken)
+		if err != nil {
 			logs.Errorf("update flow state to %s failed, err: %v, rid: %s", state, err, kt.Rid)
 			return err
 		}
@@ -341,15 +389,16 @@ func (wd *watchDog) handleRunningFlow(kt *kit.Kit, flow model.Flow) error {
 	ids := root.GetExecStateTasks()
 	// 如果没有处于执行中的节点，将Flow置于Pending状态，等待重新被调度
 	if len(ids) == 0 {
-		mds := []model.Flow{
-			{
-				ID:     flow.ID,
-				State:  enumor.FlowPending,
-				Worker: converter.ValToPtr(""),
-			},
+		info := backend.UpdateFlowInfo{
+			ID:     flow.ID,
+			Source: enumor.FlowRunning,
+			Target: enumor.FlowPending,
+			Worker: converter.ValToPtr(""),
+
+			FencingToken: wd.fencingToken,
 		}
-		if err = wd.bd.BatchUpdateFlow(kt, mds); err != nil {
-			logs.Errorf("update flows failed, err: %v, rid: %s", err, kt.Rid)
+		if err = wd.bd.BatchUpdateFlowStateByCAS(kt, []backend.UpdateFlowInfo{info}); err != nil {
+			logs.Errorf("reset running flow to pending failed, err: %v, id: %s, rid: %s", err, flow.ID, kt.Rid)
 			return err
 		}
 
@@ -423,23 +472,26 @@ func (wd *watchDog) handleRunningTasks(kt *kit.Kit, flow model.Flow, ids []strin
 	return nil
 }
 
-// checkIsExpireTask 检查任务是否超时
+// checkIsExpireTask 检查任务是否超时。任务每次执行和重试都会更新状态，所以只需要判断距离上次更新是否超过了
+// 单次执行超时时间（任务自身设置的超时时间和WatchDog超时时间取较大值）加上单次重试等待时间的上限。
 func (wd *watchDog) checkIsExpireTask(kt *kit.Kit, task model.Task) bool {
-	if task.Retry == nil || !task.Retry.IsEnable() || task.Retry.Policy == nil {
-		return task.UpdatedAt < times.ConvStdTimeFormat(times.ConvStdTimeNow().Add(-wd.taskTimeoutSec))
+	timeout := max(wd.taskTimeoutSec, time.Duration(task.TimeoutSec)*time.Second)
+	if task.Retry != nil && task.Retry.IsEnable() && task.Retry.Policy != nil {
+		timeout += task.Retry.Policy.MaxBackoff()
 	}
 
-	// 检查任务的重试策略，是否已超时
-	retryMillSec := task.Retry.Policy.Count * task.Retry.Policy.SleepRangeMS[0]
 	updateDate, err := time.Parse(constant.TimeStdFormat, task.UpdatedAt)
-	if err == nil {
-		expireTime := updateDate.Add(time.Duration(retryMillSec) * time.Millisecond)
-		if expireTime.UnixNano() > time.Now().UnixMicro() {
-			logs.V(5).Infof(
-				"check task is not expired, taskID: %s, flowID: %s, updateAt: %s, expireTime: %s, rid: %s",
-				task.ID, task.FlowID, task.UpdatedAt, expireTime.Format(constant.DateTimeLayout), kt.Rid)
-			return false
-		}
+	if err != nil {
+		logs.Errorf("parse task updated_at failed, err: %v, taskID: %s, updatedAt: %s, rid: %s", err, task.ID,
+			task.UpdatedAt, kt.Rid)
+		return true
+	}
+
+	expireTime := updateDate.Add(timeout)
+	if expireTime.After(time.Now()) {
+		logs.V(5).Infof("check task is not expired, taskID: %s, flowID: %s, updateAt: %s, expireTime: %s, rid: %s",
+			task.ID, task.FlowID, task.UpdatedAt, expireTime.Format(constant.DateTimeLayout), kt.Rid)
+		return false
 	}
 
 	return true
@@ -0,0 +1,103 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package consumer
+
+import (
+	"testing"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/async/backend"
+	"hcm/pkg/async/backend/model"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/dao/tools"
+)
+
+// newFinishedRunningFlow 创建一个任务均已成功但状态仍为执行中的任务流
+func newFinishedRunningFlow(t *testing.T, bd backend.Backend) model.Flow {
+	kt := core.NewBackendKit()
+	flowID, err := bd.CreateFlow(kt, &model.Flow{
+		Name:  enumor.FlowNormalTest,
+		State: enumor.FlowPending,
+		Tasks: []model.Task{{
+			FlowName:   enumor.FlowNormalTest,
+			ActionID:   "1",
+			ActionName: enumor.ActionCreateFactoryTest,
+			Params:     "{}",
+			State:      enumor.TaskPending,
+		}},
+	})
+	if err != nil {
+		t.Fatalf("create flow failed, err: %v", err)
+	}
+
+	if err = updateFlowState(kt, bd, flowID, enumor.FlowPending, enumor.FlowRunning); err != nil {
+		t.Fatalf("update flow to running failed, err: %v", err)
+	}
+
+	tasks, err := listTaskByFlowID(kt, bd, flowID)
+	if err != nil {
+		t.Fatalf("list task failed, err: %v", err)
+	}
+	for _, task := range tasks {
+		if err = bd.UpdateTask(kt, &model.Task{ID: task.ID, State: enumor.TaskSuccess}); err != nil {
+			t.Fatalf("update task failed, err: %v", err)
+		}
+	}
+
+	return getTestFlow(t, bd, flowID)
+}
+
+func getTestFlow(t *testing.T, bd backend.Backend, id string) model.Flow {
+	flows, err := bd.ListFlow(core.NewBackendKit(), &backend.ListInput{
+		Filter: tools.EqualExpression("id", id),
+		Page:   core.NewDefaultBasePage(),
+	})
+	if err != nil || len(flows) != 1 {
+		t.Fatalf("get flow %s failed, count: %d, err: %v", id, len(flows), err)
+	}
+	return flows[0]
+}
+
+func TestWatchDogHandleRunningFlowFenced(t *testing.T) {
+	bd := backend.NewMemory()
+	kt := core.NewBackendKit()
+	flow := newFinishedRunningFlow(t, bd)
+
+	if err := bd.AdvanceFencingToken(kt, 5); err != nil {
+		t.Fatalf("advance fencing token failed, err: %v", err)
+	}
+
+	// 已被新主节点取代的旧主节点不能修改任务流状态
+	stale := &watchDog{bd: bd, fencingToken: 3}
+	if err := stale.handleRunningFlow(kt, flow); err == nil {
+		t.Errorf("handle running flow with stale fencing token should fail")
+	}
+	if state := getTestFlow(t, bd, flow.ID).State; state != enumor.FlowRunning {
+		t.Errorf("stale leader should not change flow state, got: %s", state)
+	}
+
+	current := &watchDog{bd: bd, fencingToken: 5}
+	if err := current.handleRunningFlow(kt, flow); err != nil {
+		t.Fatalf("handle running flow failed, err: %v", err)
+	}
+	if state := getTestFlow(t, bd, flow.ID).State; state != enumor.FlowSuccess {
+		t.Errorf("finished flow should be updated to success, got: %s", state)
+	}
+}
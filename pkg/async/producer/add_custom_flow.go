@@ -109,10 +109,12 @@ func buildCustomFlow(opt *AddCustomFlowOption) *model.Flow {
 	}
 
 	flow := &model.Flow{
-		Name:      opt.Name,
-		ShareData: opt.ShareData,
-		Memo:      opt.Memo,
-		Tasks:     make([]model.Task, 0, len(opt.Tasks)),
+		Name:       opt.Name,
+		ShareData:  opt.ShareData,
+		Memo:       opt.Memo,
+		Compensate: opt.Compensate,
+		RunAt:      opt.RunAt,
+		Tasks:      make([]model.Task, 0, len(opt.Tasks)),
 	}
 	if opt.IsInitState {
 		flow.State = enumor.FlowInit
@@ -129,6 +131,7 @@ func buildCustomFlow(opt *AddCustomFlowOption) *model.Flow {
 			ActionName: one.ActionName,
 			Params:     one.Params,
 			Retry:      one.Retry,
+			TimeoutSec: one.TimeoutSec,
 			DependOn:   one.DependOn,
 		}
 
@@ -60,10 +60,12 @@ func (p *producer) AddTemplateFlow(kt *kit.Kit, opt *AddTemplateFlowOption) (id
 
 func buildFlow(tpl action.FlowTemplate, opt *AddTemplateFlowOption) *model.Flow {
 	flow := &model.Flow{
-		Name:      tpl.Name,
-		ShareData: tpl.ShareData,
-		Memo:      opt.Memo,
-		Tasks:     make([]model.Task, 0, len(tpl.Tasks)),
+		Name:       tpl.Name,
+		ShareData:  tpl.ShareData,
+		Memo:       opt.Memo,
+		Compensate: opt.Compensate,
+		RunAt:      opt.RunAt,
+		Tasks:      make([]model.Task, 0, len(tpl.Tasks)),
 	}
 	if opt.IsInitState {
 		flow.State = enumor.FlowInit
@@ -85,6 +87,7 @@ func buildFlow(tpl action.FlowTemplate, opt *AddTemplateFlowOption) *model.Flow
 			ActionName: one.ActionName,
 			Params:     m[one.ActionID],
 			Retry:      one.Retry,
+			TimeoutSec: one.TimeoutSec,
 			DependOn:   one.DependOn,
 		}
 		if opt.IsInitState {
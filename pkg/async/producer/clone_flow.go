@@ -67,15 +67,16 @@ func (p *producer) CloneFlow(kt *kit.Kit, flowId string, opt *CloneFlowOption) (
 
 func clone(kt *kit.Kit, oldFlow model.Flow, oldTaskList []model.Task, opt *CloneFlowOption) (newFlow *model.Flow) {
 	newFlow = &model.Flow{
-		Name:      oldFlow.Name,
-		ShareData: tableasync.NewShareData(oldFlow.ShareData.GetInitData()),
-		Memo:      oldFlow.Memo,
-		State:     enumor.FlowPending,
-		Reason:    nil,
-		Worker:    nil,
-		Tasks:     make([]model.Task, len(oldTaskList)),
-		Creator:   kt.User,
-		Reviser:   kt.User,
+		Name:       oldFlow.Name,
+		ShareData:  tableasync.NewShareData(oldFlow.ShareData.GetInitData()),
+		Memo:       oldFlow.Memo,
+		Compensate: oldFlow.Compensate,
+		State:      enumor.FlowPending,
+		Reason:     nil,
+		Worker:     nil,
+		Tasks:      make([]model.Task, len(oldTaskList)),
+		Creator:    kt.User,
+		Reviser:    kt.User,
 	}
 
 	if opt.IsInitState {
@@ -91,6 +92,7 @@ func clone(kt *kit.Kit, oldFlow model.Flow, oldTaskList []model.Task, opt *Clone
 			ActionName: old.ActionName,
 			Params:     old.Params,
 			Retry:      old.Retry,
+			TimeoutSec: old.TimeoutSec,
 			DependOn:   old.DependOn,
 			State:      mapCloneTaskState(old.State),
 			Reason:     nil,
@@ -0,0 +1,161 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package producer
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/async/action"
+	"hcm/pkg/async/backend"
+	"hcm/pkg/async/backend/model"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/converter"
+	"hcm/pkg/tools/cron"
+	"hcm/pkg/tools/times"
+)
+
+// AddCronFlow 添加周期任务流，由主节点的派发器在到达执行时间后创建任务流
+func (p *producer) AddCronFlow(kt *kit.Kit, opt *AddCronFlowOption) (id string, err error) {
+	if err = opt.Validate(); err != nil {
+		return "", err
+	}
+
+	var flow *model.Flow
+	if opt.TemplateFlow != nil {
+		tpl, exist := action.GetTpl(opt.TemplateFlow.Name)
+		if !exist {
+			return "", fmt.Errorf("flow tempalte: %s not found", opt.TemplateFlow.Name)
+		}
+
+		if err = validateTplUseParam(kt, tpl, opt.TemplateFlow); err != nil {
+			logs.Errorf("validate flow template use param failed, err: %v, rid: %s", err, kt.Rid)
+			return "", err
+		}
+		flow = buildFlow(tpl, opt.TemplateFlow)
+	} else {
+		if err = validateCustomFlowParam(kt, opt.CustomFlow); err != nil {
+			logs.Errorf("validate custom flow param failed, err: %v, rid: %s", err, kt.Rid)
+			return "", err
+		}
+		flow = buildCustomFlow(opt.CustomFlow)
+	}
+
+	nextRunAt, err := nextCronRunAt(opt.Spec)
+	if err != nil {
+		return "", err
+	}
+
+	cronFlow := &model.CronFlow{
+		Name:      opt.Name,
+		Spec:      opt.Spec,
+		Flow:      *flow,
+		Enabled:   true,
+		NextRunAt: nextRunAt,
+		Memo:      opt.Memo,
+	}
+	id, err = p.backend.CreateCronFlow(kt, cronFlow)
+	if err != nil {
+		logs.Errorf("create cron flow failed, err: %v, name: %s, rid: %s", err, opt.Name, kt.Rid)
+		return "", err
+	}
+
+	return id, nil
+}
+
+// UpdateCronFlow 更新周期任务流，修改cron表达式或重新启用时重新计算下次执行时间
+func (p *producer) UpdateCronFlow(kt *kit.Kit, id string, opt *UpdateCronFlowOption) error {
+	if err := opt.Validate(); err != nil {
+		return err
+	}
+
+	crons, err := p.backend.ListCronFlow(kt, &backend.ListInput{
+		Filter: tools.EqualExpression("id", id),
+		Page:   core.NewDefaultBasePage(),
+	})
+	if err != nil {
+		logs.Errorf("list cron flow failed, err: %v, id: %s, rid: %s", err, id, kt.Rid)
+		return err
+	}
+	if len(crons) == 0 {
+		return errf.Newf(errf.RecordNotFound, "cron flow: %s not found", id)
+	}
+	old := crons[0]
+
+	md := &model.CronFlow{
+		ID:      id,
+		Spec:    old.Spec,
+		Enabled: converter.PtrToVal(opt.Enabled),
+		Memo:    old.Memo,
+	}
+	if opt.Enabled == nil {
+		md.Enabled = old.Enabled
+	}
+	if opt.Memo != nil {
+		md.Memo = *opt.Memo
+	}
+	if len(opt.Spec) != 0 {
+		md.Spec = opt.Spec
+	}
+
+	if md.Spec != old.Spec || (md.Enabled && !old.Enabled) {
+		if md.NextRunAt, err = nextCronRunAt(md.Spec); err != nil {
+			return err
+		}
+	}
+
+	if err = p.backend.UpdateCronFlow(kt, md); err != nil {
+		logs.Errorf("update cron flow failed, err: %v, id: %s, rid: %s", err, id, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteCronFlow 删除周期任务流，已经创建的任务流不受影响
+func (p *producer) DeleteCronFlow(kt *kit.Kit, id string) error {
+	if len(id) == 0 {
+		return errf.New(errf.InvalidParameter, "id is required")
+	}
+
+	if err := p.backend.DeleteCronFlow(kt, id); err != nil {
+		logs.Errorf("delete cron flow failed, err: %v, id: %s, rid: %s", err, id, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+func nextCronRunAt(spec string) (string, error) {
+	sch, err := cron.Parse(spec)
+	if err != nil {
+		return "", err
+	}
+
+	next := sch.Next(times.ConvStdTimeNow())
+	if next.IsZero() {
+		return "", fmt.Errorf("cron spec %s has no next run time", spec)
+	}
+
+	return times.ConvStdTimeFormat(next), nil
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 
+	"hcm/pkg/api/core"
 	"hcm/pkg/async/backend"
 	"hcm/pkg/kit"
 )
@@ -35,7 +36,11 @@ type Producer interface {
 	AddCustomFlow(kt *kit.Kit, opt *AddCustomFlowOption) (id string, err error)
 	BatchUpdateCustomFlowState(kt *kit.Kit, opt *UpdateCustomFlowStateOption) error
 	RetryFlowTask(kt *kit.Kit, flowID, taskID string) error
+	ReplayDeadLetterTasks(kt *kit.Kit, opt *ReplayDeadLetterTasksOption) (*core.BatchOperateAllResult, error)
 	CloneFlow(kt *kit.Kit, flowId string, opt *CloneFlowOption) (id string, err error)
+	AddCronFlow(kt *kit.Kit, opt *AddCronFlowOption) (id string, err error)
+	UpdateCronFlow(kt *kit.Kit, id string, opt *UpdateCronFlowOption) error
+	DeleteCronFlow(kt *kit.Kit, id string) error
 }
 
 var _ Producer = new(producer)
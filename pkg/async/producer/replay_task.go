@@ -0,0 +1,91 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package producer
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/async/backend"
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/tools/slice"
+)
+
+// ReplayDeadLetterTasks 批量重放死信任务，按任务流分组重放，单个任务流失败不影响其他任务流。
+func (p *producer) ReplayDeadLetterTasks(kt *kit.Kit, opt *ReplayDeadLetterTasksOption) (
+	*core.BatchOperateAllResult, error) {
+
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	taskIDs := slice.Unique(opt.TaskIDs)
+	tasks, err := p.backend.ListTask(kt, &backend.ListInput{
+		Filter: tools.ContainersExpression("id", taskIDs),
+		Page:   core.NewDefaultBasePage(),
+		Fields: []string{"id", "flow_id", "state"},
+	})
+	if err != nil {
+		logs.Errorf("list dead letter tasks failed, err: %v, ids: %v, rid: %s", err, taskIDs, kt.Rid)
+		return nil, err
+	}
+
+	result := new(core.BatchOperateAllResult)
+	flowOrder := make([]string, 0)
+	flowTaskMap := make(map[string][]string)
+	existMap := make(map[string]struct{}, len(tasks))
+	for _, one := range tasks {
+		existMap[one.ID] = struct{}{}
+		if one.State != enumor.TaskDeadLetter {
+			result.Failed = append(result.Failed, core.FailedInfo{ID: one.ID,
+				Error: fmt.Errorf("task state is %s, not %s", one.State, enumor.TaskDeadLetter)})
+			continue
+		}
+
+		if _, exist := flowTaskMap[one.FlowID]; !exist {
+			flowOrder = append(flowOrder, one.FlowID)
+		}
+		flowTaskMap[one.FlowID] = append(flowTaskMap[one.FlowID], one.ID)
+	}
+
+	for _, id := range taskIDs {
+		if _, exist := existMap[id]; !exist {
+			result.Failed = append(result.Failed, core.FailedInfo{ID: id, Error: fmt.Errorf("task %s not found", id)})
+		}
+	}
+
+	for _, flowID := range flowOrder {
+		ids := flowTaskMap[flowID]
+		if err = p.backend.ReplayDeadLetterTasks(kt, flowID, ids); err != nil {
+			logs.Errorf("replay dead letter tasks of flow(%s) failed, err: %v, ids: %v, rid: %s", flowID, err, ids,
+				kt.Rid)
+			for _, id := range ids {
+				result.Failed = append(result.Failed, core.FailedInfo{ID: id, Error: err})
+			}
+			continue
+		}
+		result.Succeeded = append(result.Succeeded, ids...)
+	}
+
+	return result, nil
+}
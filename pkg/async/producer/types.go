@@ -24,10 +24,12 @@ import (
 
 	"hcm/pkg/async/action"
 	"hcm/pkg/async/backend"
+	"hcm/pkg/async/backend/model"
 	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/criteria/validator"
 	tableasync "hcm/pkg/dal/table/async"
 	"hcm/pkg/dal/table/types"
+	"hcm/pkg/tools/cron"
 )
 
 // AddTemplateFlowOption define add flow option.
@@ -40,6 +42,10 @@ type AddTemplateFlowOption struct {
 	Tasks []TemplateFlowTask `json:"tasks" validate:"omitempty"`
 	// IsInitState 是否初始化状态
 	IsInitState bool `json:"is_init_state" validate:"omitempty"`
+	// Compensate 任务流失败或被取消时，是否对已经执行成功的任务进行补偿
+	Compensate bool `json:"compensate" validate:"omitempty"`
+	// RunAt 计划执行时间，格式同created_at，不设置时立即执行
+	RunAt string `json:"run_at" validate:"omitempty"`
 }
 
 // Validate AddTemplateFlowOption
@@ -53,6 +59,10 @@ func (opt *AddTemplateFlowOption) Validate() error {
 		return err
 	}
 
+	if _, err := model.ParseRunAt(opt.RunAt); err != nil {
+		return err
+	}
+
 	for index := range opt.Tasks {
 		if err := opt.Tasks[index].Validate(); err != nil {
 			return err
@@ -87,6 +97,10 @@ type AddCustomFlowOption struct {
 	Tasks []CustomFlowTask `json:"tasks" validate:"required"`
 	// IsInitState 是否初始化状态
 	IsInitState bool `json:"is_init_state" validate:"omitempty"`
+	// Compensate 任务流失败或被取消时，是否对已经执行成功的任务进行补偿
+	Compensate bool `json:"compensate" validate:"omitempty"`
+	// RunAt 计划执行时间，格式同created_at，不设置时立即执行
+	RunAt string `json:"run_at" validate:"omitempty"`
 }
 
 // Validate AddCustomFlowOption
@@ -100,6 +114,10 @@ func (opt *AddCustomFlowOption) Validate() error {
 		return errors.New("tasks is required")
 	}
 
+	if _, err := model.ParseRunAt(opt.RunAt); err != nil {
+		return err
+	}
+
 	for _, task := range opt.Tasks {
 		if err := task.Validate(); err != nil {
 			return err
@@ -121,6 +139,8 @@ type CustomFlowTask struct {
 	Params types.JsonField `json:"params" validate:"omitempty"`
 	// Retry 任务运行重试相关配置参数，如果不设置，默认不允许进行重试。
 	Retry *tableasync.Retry `json:"retry" validate:"omitempty"`
+	// TimeoutSec 任务单次执行超时时间，为0时使用执行器默认的超时时间。
+	TimeoutSec uint `json:"timeout_sec" validate:"omitempty"`
 }
 
 // Validate CustomFlowTask
@@ -157,6 +177,88 @@ func (opt *UpdateCustomFlowStateOption) Validate() error {
 	return validator.Validate.Struct(opt)
 }
 
+// ReplayDeadLetterTasksOption define replay dead letter tasks option.
+type ReplayDeadLetterTasksOption struct {
+	// TaskIDs 死信状态的任务ID，可以属于不同的任务流
+	TaskIDs []string `json:"task_ids" validate:"required,min=1,max=100"`
+}
+
+// Validate ReplayDeadLetterTasksOption
+func (opt *ReplayDeadLetterTasksOption) Validate() error {
+	return validator.Validate.Struct(opt)
+}
+
+// AddCronFlowOption define add cron flow option. TemplateFlow 和 CustomFlow 必须且只能设置一个。
+type AddCronFlowOption struct {
+	// Name 周期任务流名称，全局唯一
+	Name string `json:"name" validate:"required,lte=64"`
+	// Spec cron表达式，支持标准5段格式及 @daily、@every 1h 等描述符
+	Spec string `json:"spec" validate:"required,lte=64"`
+	// Memo 备注
+	Memo string `json:"memo" validate:"omitempty,lte=255"`
+	// TemplateFlow 按照模版创建任务流
+	TemplateFlow *AddTemplateFlowOption `json:"template_flow" validate:"omitempty"`
+	// CustomFlow 按照自定义任务创建任务流
+	CustomFlow *AddCustomFlowOption `json:"custom_flow" validate:"omitempty"`
+}
+
+// Validate AddCronFlowOption
+func (opt *AddCronFlowOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if _, err := cron.Parse(opt.Spec); err != nil {
+		return err
+	}
+
+	switch {
+	case opt.TemplateFlow != nil && opt.CustomFlow == nil:
+		if opt.TemplateFlow.IsInitState || len(opt.TemplateFlow.RunAt) != 0 {
+			return errors.New("cron flow can not set is_init_state or run_at")
+		}
+		return opt.TemplateFlow.Validate()
+
+	case opt.TemplateFlow == nil && opt.CustomFlow != nil:
+		if opt.CustomFlow.IsInitState || len(opt.CustomFlow.RunAt) != 0 {
+			return errors.New("cron flow can not set is_init_state or run_at")
+		}
+		return opt.CustomFlow.Validate()
+
+	default:
+		return errors.New("one of template_flow and custom_flow is required")
+	}
+}
+
+// UpdateCronFlowOption define update cron flow option.
+type UpdateCronFlowOption struct {
+	// Spec cron表达式，修改后重新计算下次执行时间
+	Spec string `json:"spec" validate:"omitempty,lte=64"`
+	// Enabled 是否启用，重新启用时从当前时间开始计算下次执行时间
+	Enabled *bool `json:"enabled" validate:"omitempty"`
+	// Memo 备注
+	Memo *string `json:"memo" validate:"omitempty,lte=255"`
+}
+
+// Validate UpdateCronFlowOption
+func (opt *UpdateCronFlowOption) Validate() error {
+	if err := validator.Validate.Struct(opt); err != nil {
+		return err
+	}
+
+	if len(opt.Spec) == 0 && opt.Enabled == nil && opt.Memo == nil {
+		return errors.New("at least one of spec, enabled and memo is required")
+	}
+
+	if len(opt.Spec) != 0 {
+		if _, err := cron.Parse(opt.Spec); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // CloneFlowOption ...
 type CloneFlowOption struct {
 
@@ -136,6 +136,7 @@ func (s *CloudServerSetting) trySetDefault() {
 	s.Network.trySetDefault()
 	s.Service.trySetDefault()
 	s.Log.trySetDefault()
+	s.Recycle.trySetDefault()
 
 	return
 }
@@ -234,9 +235,10 @@ func (s DataServiceSetting) Validate() error {
 
 // HCServiceSetting defines hc service used setting options.
 type HCServiceSetting struct {
-	Network Network   `yaml:"network"`
-	Service Service   `yaml:"service"`
-	Log     LogOption `yaml:"log"`
+	Network       Network       `yaml:"network"`
+	Service       Service       `yaml:"service"`
+	Log           LogOption     `yaml:"log"`
+	CloudEndpoint CloudEndpoint `yaml:"cloudEndpoint"`
 }
 
 // trySetFlagBindIP try set flag bind ip.
@@ -264,6 +266,10 @@ func (s HCServiceSetting) Validate() error {
 		return err
 	}
 
+	if err := s.CloudEndpoint.validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -364,11 +370,12 @@ func (s WebServerSetting) Validate() error {
 
 // TaskServerSetting defines task server used setting options.
 type TaskServerSetting struct {
-	Network  Network   `yaml:"network"`
-	Service  Service   `yaml:"service"`
-	Database DataBase  `yaml:"database"`
-	Log      LogOption `yaml:"log"`
-	Async    Async     `yaml:"async"`
+	Network     Network     `yaml:"network"`
+	Service     Service     `yaml:"service"`
+	Database    DataBase    `yaml:"database"`
+	Log         LogOption   `yaml:"log"`
+	Async       Async       `yaml:"async"`
+	Objectstore ObjectStore `yaml:"objectstore"`
 }
 
 // trySetFlagBindIP try set flag bind ip.
@@ -382,6 +389,7 @@ func (s *TaskServerSetting) trySetDefault() {
 	s.Service.trySetDefault()
 	s.Database.trySetDefault()
 	s.Log.trySetDefault()
+	s.Async.Leader.trySetDefault()
 
 	return
 }
@@ -410,6 +418,8 @@ type AccountServerSetting struct {
 	Service    Service              `yaml:"service"`
 	Controller BillControllerOption `yaml:"contorller"`
 	Log        LogOption            `yaml:"log"`
+	// Cmsi 预算告警邮件通知，未配置endpoints时不发送邮件
+	Cmsi CMSI `yaml:"cmsi"`
 }
 
 // trySetFlagBindIP try set flag bind ip.
@@ -436,5 +446,11 @@ func (s AccountServerSetting) Validate() error {
 		return err
 	}
 
+	if len(s.Cmsi.Endpoints) != 0 {
+		if err := s.Cmsi.validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
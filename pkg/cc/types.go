@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
 	"os"
 	"time"
 
@@ -163,6 +164,7 @@ type Async struct {
 	Executor   Executor   `yaml:"executor"`
 	Dispatcher Dispatcher `yaml:"dispatcher"`
 	WatchDog   WatchDog   `yaml:"watchDog"`
+	Leader     Leader     `yaml:"leader"`
 }
 
 // Validate Async
@@ -194,6 +196,51 @@ type WatchDog struct {
 	TaskTimeoutSec   uint `yaml:"taskTimeoutSec"`
 }
 
+// Leader 主节点选举，基于etcd租约选举主节点
+type Leader struct {
+	LeaseTTLSec uint `yaml:"leaseTTLSec"`
+}
+
+// trySetDefault set the leader default value if user not configured.
+func (l *Leader) trySetDefault() {
+	if l.LeaseTTLSec == 0 {
+		l.LeaseTTLSec = 10
+	}
+}
+
+// CloudEndpoint 自定义云API访问地址，用于对接本地模拟云(test/fake-cloud)进行测试，为空时使用云厂商默认地址
+type CloudEndpoint struct {
+	TCloud   string `yaml:"tcloud"`
+	Zenlayer string `yaml:"zenlayer"`
+	Kaopu    string `yaml:"kaopu"`
+}
+
+// validate cloud endpoint.
+func (c CloudEndpoint) validate() error {
+	endpoints := map[string]string{
+		"tcloud":   c.TCloud,
+		"zenlayer": c.Zenlayer,
+		"kaopu":    c.Kaopu,
+	}
+
+	for name, endpoint := range endpoints {
+		if len(endpoint) == 0 {
+			continue
+		}
+
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return fmt.Errorf("cloudEndpoint.%s is invalid, err: %v", name, err)
+		}
+
+		if (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+			return fmt.Errorf("cloudEndpoint.%s should be like http://127.0.0.1:9900, but got %s", name, endpoint)
+		}
+	}
+
+	return nil
+}
+
 // DataBase defines database related runtime
 type DataBase struct {
 	Resource ResourceDB `yaml:"resource"`
@@ -618,6 +665,10 @@ type CloudResourceSync struct {
 	Enable                       bool   `yaml:"enable"`
 	SyncIntervalMin              uint64 `yaml:"syncIntervalMin"`
 	SyncFrequencyLimitingTimeMin uint64 `yaml:"syncFrequencyLimitingTimeMin"`
+	// Incremental 是否开启增量同步，开启后支持增量查询的云厂商只同步同步水位之后发生变更的资源
+	Incremental bool `yaml:"incremental"`
+	// FullSyncIntervalHour 增量同步模式下全量同步的间隔，用于兜底增量同步遗漏的变更
+	FullSyncIntervalHour uint64 `yaml:"fullSyncIntervalHour"`
 }
 
 func (c CloudResourceSync) validate() error {
@@ -625,6 +676,10 @@ func (c CloudResourceSync) validate() error {
 		if c.SyncFrequencyLimitingTimeMin < 10 {
 			return errors.New("syncFrequencyLimitingTimeMin must > 10")
 		}
+
+		if c.Incremental && c.FullSyncIntervalHour < 1 {
+			return errors.New("fullSyncIntervalHour must >= 1 when incremental sync is enabled")
+		}
 	}
 
 	return nil
@@ -633,6 +688,16 @@ func (c CloudResourceSync) validate() error {
 // Recycle configuration.
 type Recycle struct {
 	AutoDeleteTime uint `yaml:"autoDeleteTimeHour"`
+	// DiskFinalSnapshot 回收站删除云盘前是否先为云盘创建最终快照
+	DiskFinalSnapshot bool `yaml:"diskFinalSnapshot"`
+	// DiskFinalSnapshotTimeoutHour 等待最终快照创建完成的最长时间，超时后回收失败
+	DiskFinalSnapshotTimeoutHour uint `yaml:"diskFinalSnapshotTimeoutHour"`
+}
+
+func (a *Recycle) trySetDefault() {
+	if a.DiskFinalSnapshotTimeoutHour == 0 {
+		a.DiskFinalSnapshotTimeoutHour = 24
+	}
 }
 
 func (a Recycle) validate() error {
@@ -85,6 +85,16 @@ func (cs *ClientSet) DataService() *dataservice.Client {
 	return dataservice.NewClient(c, cs.version)
 }
 
+// DataServiceWithWrapper get data-service client whose http client is wrapped by the given wrapper.
+func (cs *ClientSet) DataServiceWithWrapper(wrap func(client.HTTPClient) client.HTTPClient) *dataservice.Client {
+	c := &client.Capability{
+		Client:   wrap(cs.client),
+		Discover: cs.discovery(cc.DataServiceName),
+	}
+
+	return dataservice.NewClient(c, cs.version)
+}
+
 // HCService get hc-service client.
 func (cs *ClientSet) HCService() *hcservice.Client {
 	c := &client.Capability{
@@ -0,0 +1,64 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"hcm/pkg/api/core"
+	corebucket "hcm/pkg/api/core/cloud/bucket"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// BucketClient ...
+type BucketClient struct {
+	client rest.ClientInterface
+}
+
+// NewBucketClient ...
+func NewBucketClient(client rest.ClientInterface) *BucketClient {
+	return &BucketClient{client: client}
+}
+
+// BatchCreate 批量创建存储桶
+func (cli *BucketClient) BatchCreate(kt *kit.Kit,
+	req *dataproto.BucketBatchCreateReq[corebucket.AwsBucketExtension]) (
+	*core.BatchCreateResult, error) {
+
+	return common.Request[dataproto.BucketBatchCreateReq[corebucket.AwsBucketExtension],
+		core.BatchCreateResult](cli.client, rest.POST, kt, req, "/buckets/batch/create")
+}
+
+// ListBucketExt list bucket with extension.
+func (cli *BucketClient) ListBucketExt(kt *kit.Kit, req *core.ListReq) (
+	*dataproto.BucketExtListResult[corebucket.AwsBucketExtension], error) {
+
+	return common.Request[core.ListReq, dataproto.BucketExtListResult[corebucket.AwsBucketExtension]](
+		cli.client, rest.POST, kt, req, "/buckets/list")
+}
+
+// BatchUpdate 批量更新存储桶
+func (cli *BucketClient) BatchUpdate(kt *kit.Kit,
+	req *dataproto.BucketExtBatchUpdateReq[corebucket.AwsBucketExtension]) error {
+
+	return common.RequestNoResp[dataproto.BucketExtBatchUpdateReq[corebucket.AwsBucketExtension]](
+		cli.client, rest.PATCH, kt, req, "/buckets")
+}
@@ -39,6 +39,15 @@ type Client struct {
 	MainAccount           *MainAccountClient
 	RootAccount           *RootAccountClient
 	RootAccountBillConfig *RootAccountBillConfigClient
+	LoadBalancer          *LoadBalancerClient
+	Snapshot              *SnapshotClient
+	NatGateway            *NatGatewayClient
+	DatabaseInstance      *DatabaseInstanceClient
+	Bucket                *BucketClient
+	K8sCluster            *K8sClusterClient
+	KeyPair               *KeyPairClient
+	PrivateDnsZone        *PrivateDnsZoneClient
+	VpcPeering            *VpcPeeringClient
 }
 
 type restClient struct {
@@ -62,5 +71,14 @@ func NewClient(client rest.ClientInterface) *Client {
 		MainAccount:           NewMainAccountClient(client),
 		RootAccount:           NewRootAccountClient(client),
 		RootAccountBillConfig: NewRootAccountBillConfigClient(client),
+		LoadBalancer:          NewLoadBalancerClient(client),
+		Snapshot:              NewSnapshotClient(client),
+		NatGateway:            NewNatGatewayClient(client),
+		DatabaseInstance:      NewDatabaseInstanceClient(client),
+		Bucket:                NewBucketClient(client),
+		K8sCluster:            NewK8sClusterClient(client),
+		KeyPair:               NewKeyPairClient(client),
+		PrivateDnsZone:        NewPrivateDnsZoneClient(client),
+		VpcPeering:            NewVpcPeeringClient(client),
 	}
 }
@@ -0,0 +1,64 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"hcm/pkg/api/core"
+	coredbinstance "hcm/pkg/api/core/cloud/database-instance"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// DatabaseInstanceClient ...
+type DatabaseInstanceClient struct {
+	client rest.ClientInterface
+}
+
+// NewDatabaseInstanceClient ...
+func NewDatabaseInstanceClient(client rest.ClientInterface) *DatabaseInstanceClient {
+	return &DatabaseInstanceClient{client: client}
+}
+
+// BatchCreate 批量创建云数据库实例
+func (cli *DatabaseInstanceClient) BatchCreate(kt *kit.Kit,
+	req *dataproto.DatabaseInstanceBatchCreateReq[coredbinstance.AwsDBInstanceExtension]) (
+	*core.BatchCreateResult, error) {
+
+	return common.Request[dataproto.DatabaseInstanceBatchCreateReq[coredbinstance.AwsDBInstanceExtension],
+		core.BatchCreateResult](cli.client, rest.POST, kt, req, "/database_instances/batch/create")
+}
+
+// ListDatabaseInstanceExt list database instance with extension.
+func (cli *DatabaseInstanceClient) ListDatabaseInstanceExt(kt *kit.Kit, req *core.ListReq) (
+	*dataproto.DatabaseInstanceExtListResult[coredbinstance.AwsDBInstanceExtension], error) {
+
+	return common.Request[core.ListReq, dataproto.DatabaseInstanceExtListResult[coredbinstance.AwsDBInstanceExtension]](
+		cli.client, rest.POST, kt, req, "/database_instances/list")
+}
+
+// BatchUpdate 批量更新云数据库实例
+func (cli *DatabaseInstanceClient) BatchUpdate(kt *kit.Kit,
+	req *dataproto.DatabaseInstanceExtBatchUpdateReq[coredbinstance.AwsDBInstanceExtension]) error {
+
+	return common.RequestNoResp[dataproto.DatabaseInstanceExtBatchUpdateReq[coredbinstance.AwsDBInstanceExtension]](
+		cli.client, rest.PATCH, kt, req, "/database_instances")
+}
@@ -0,0 +1,117 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"hcm/pkg/api/core"
+	corelb "hcm/pkg/api/core/cloud/load-balancer"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// LoadBalancerClient ...
+type LoadBalancerClient struct {
+	client rest.ClientInterface
+}
+
+// NewLoadBalancerClient ...
+func NewLoadBalancerClient(client rest.ClientInterface) *LoadBalancerClient {
+	return &LoadBalancerClient{client: client}
+}
+
+// BatchCreate 批量创建负载均衡
+func (cli *LoadBalancerClient) BatchCreate(kt *kit.Kit, req *dataproto.AwsLoadBalancerCreateReq) (
+	*core.BatchCreateResult, error) {
+
+	return common.Request[dataproto.AwsLoadBalancerCreateReq, core.BatchCreateResult](
+		cli.client, rest.POST, kt, req, "/load_balancers/batch/create")
+}
+
+// Get 获取负载均衡详情
+func (cli *LoadBalancerClient) Get(kt *kit.Kit, id string) (*corelb.AwsLoadBalancer, error) {
+	return common.Request[common.Empty, corelb.AwsLoadBalancer](
+		cli.client, rest.GET, kt, nil, "/load_balancers/%s", id)
+}
+
+// BatchUpdate 批量更新负载均衡
+func (cli *LoadBalancerClient) BatchUpdate(kt *kit.Kit, req *dataproto.AwsLoadBalancerBatchUpdateReq) error {
+	return common.RequestNoResp[dataproto.AwsLoadBalancerBatchUpdateReq](cli.client,
+		rest.PATCH, kt, req, "/load_balancers/batch/update")
+}
+
+// ListLoadBalancer list load balancer with extension.
+func (cli *LoadBalancerClient) ListLoadBalancer(kt *kit.Kit, req *core.ListReq) (
+	*core.ListResultT[corelb.AwsLoadBalancer], error) {
+
+	return common.Request[core.ListReq, core.ListResultT[corelb.AwsLoadBalancer]](
+		cli.client, rest.POST, kt, req, "/load_balancers/list")
+}
+
+// BatchCreateListener 批量创建监听器
+func (cli *LoadBalancerClient) BatchCreateListener(kt *kit.Kit, req *dataproto.AwsListenerBatchCreateReq) (
+	*core.BatchCreateResult, error) {
+
+	return common.Request[dataproto.AwsListenerBatchCreateReq, core.BatchCreateResult](
+		cli.client, rest.POST, kt, req, "/listeners/batch/create")
+}
+
+// BatchUpdateListener 批量更新监听器
+func (cli *LoadBalancerClient) BatchUpdateListener(kt *kit.Kit, req *dataproto.AwsListenerUpdateReq) error {
+	return common.RequestNoResp[dataproto.AwsListenerUpdateReq](
+		cli.client, rest.PATCH, kt, req, "/listeners/batch/update")
+}
+
+// ListListener list listener with extension.
+func (cli *LoadBalancerClient) ListListener(kt *kit.Kit, req *core.ListReq) (*dataproto.AwsListenerListResult,
+	error) {
+
+	return common.Request[core.ListReq, dataproto.AwsListenerListResult](cli.client,
+		rest.POST, kt, req, "/load_balancers/listeners/list")
+}
+
+// BatchCreateTargetGroup 批量创建目标组
+func (cli *LoadBalancerClient) BatchCreateTargetGroup(kt *kit.Kit, req *dataproto.AwsTargetGroupCreateReq) (
+	*core.BatchCreateResult, error) {
+
+	return common.Request[dataproto.AwsTargetGroupCreateReq, core.BatchCreateResult](
+		cli.client, rest.POST, kt, req, "/target_groups/batch/create")
+}
+
+// BatchUpdateTargetGroup 批量更新目标组
+func (cli *LoadBalancerClient) BatchUpdateTargetGroup(kt *kit.Kit, req *dataproto.AwsTargetGroupBatchUpdateReq) error {
+	return common.RequestNoResp[dataproto.AwsTargetGroupBatchUpdateReq](
+		cli.client, rest.PATCH, kt, req, "/target_groups/batch/update")
+}
+
+// GetTargetGroup 获取目标组详情
+func (cli *LoadBalancerClient) GetTargetGroup(kt *kit.Kit, id string) (*corelb.AwsTargetGroup, error) {
+	return common.Request[common.Empty, corelb.AwsTargetGroup](
+		cli.client, rest.GET, kt, nil, "/target_groups/%s", id)
+}
+
+// ListTargetGroup list target group with extension.
+func (cli *LoadBalancerClient) ListTargetGroup(kt *kit.Kit, req *core.ListReq) (
+	*dataproto.TargetGroupExtListResult[corelb.AwsTargetGroupExtension], error) {
+
+	return common.Request[core.ListReq, dataproto.TargetGroupExtListResult[corelb.AwsTargetGroupExtension]](
+		cli.client, rest.POST, kt, req, "/load_balancers/target_groups/list")
+}
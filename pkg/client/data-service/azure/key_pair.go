@@ -0,0 +1,63 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	"hcm/pkg/api/core"
+	corekeypair "hcm/pkg/api/core/cloud/key-pair"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// KeyPairClient ...
+type KeyPairClient struct {
+	client rest.ClientInterface
+}
+
+// NewKeyPairClient ...
+func NewKeyPairClient(client rest.ClientInterface) *KeyPairClient {
+	return &KeyPairClient{client: client}
+}
+
+// BatchCreate 批量创建密钥对
+func (cli *KeyPairClient) BatchCreate(kt *kit.Kit,
+	req *dataproto.KeyPairBatchCreateReq[corekeypair.AzureKeyPairExtension]) (*core.BatchCreateResult, error) {
+
+	return common.Request[dataproto.KeyPairBatchCreateReq[corekeypair.AzureKeyPairExtension],
+		core.BatchCreateResult](cli.client, rest.POST, kt, req, "/key_pairs/batch/create")
+}
+
+// ListKeyPairExt list key pair with extension.
+func (cli *KeyPairClient) ListKeyPairExt(kt *kit.Kit, req *core.ListReq) (
+	*dataproto.KeyPairExtListResult[corekeypair.AzureKeyPairExtension], error) {
+
+	return common.Request[core.ListReq, dataproto.KeyPairExtListResult[corekeypair.AzureKeyPairExtension]](
+		cli.client, rest.POST, kt, req, "/key_pairs/list")
+}
+
+// BatchUpdate 批量更新密钥对
+func (cli *KeyPairClient) BatchUpdate(kt *kit.Kit,
+	req *dataproto.KeyPairExtBatchUpdateReq[corekeypair.AzureKeyPairExtension]) error {
+
+	return common.RequestNoResp[dataproto.KeyPairExtBatchUpdateReq[corekeypair.AzureKeyPairExtension]](
+		cli.client, rest.PATCH, kt, req, "/key_pairs")
+}
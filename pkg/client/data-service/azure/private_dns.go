@@ -0,0 +1,64 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	"hcm/pkg/api/core"
+	coreprivatedns "hcm/pkg/api/core/cloud/private-dns"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// PrivateDnsZoneClient ...
+type PrivateDnsZoneClient struct {
+	client rest.ClientInterface
+}
+
+// NewPrivateDnsZoneClient ...
+func NewPrivateDnsZoneClient(client rest.ClientInterface) *PrivateDnsZoneClient {
+	return &PrivateDnsZoneClient{client: client}
+}
+
+// BatchCreate 批量创建私有域
+func (cli *PrivateDnsZoneClient) BatchCreate(kt *kit.Kit,
+	req *dataproto.PrivateDnsZoneBatchCreateReq[coreprivatedns.AzureZoneExtension]) (
+	*core.BatchCreateResult, error) {
+
+	return common.Request[dataproto.PrivateDnsZoneBatchCreateReq[coreprivatedns.AzureZoneExtension],
+		core.BatchCreateResult](cli.client, rest.POST, kt, req, "/private_dns_zones/batch/create")
+}
+
+// ListPrivateDnsZoneExt list private dns zone with extension.
+func (cli *PrivateDnsZoneClient) ListPrivateDnsZoneExt(kt *kit.Kit, req *core.ListReq) (
+	*dataproto.PrivateDnsZoneExtListResult[coreprivatedns.AzureZoneExtension], error) {
+
+	return common.Request[core.ListReq, dataproto.PrivateDnsZoneExtListResult[coreprivatedns.AzureZoneExtension]](
+		cli.client, rest.POST, kt, req, "/private_dns_zones/list")
+}
+
+// BatchUpdate 批量更新私有域
+func (cli *PrivateDnsZoneClient) BatchUpdate(kt *kit.Kit,
+	req *dataproto.PrivateDnsZoneExtBatchUpdateReq[coreprivatedns.AzureZoneExtension]) error {
+
+	return common.RequestNoResp[dataproto.PrivateDnsZoneExtBatchUpdateReq[coreprivatedns.AzureZoneExtension]](
+		cli.client, rest.PATCH, kt, req, "/private_dns_zones")
+}
@@ -40,6 +40,15 @@ type Client struct {
 	RootAccount           *RootAccountClient
 	RootAccountBillConfig *RootAccountBillConfigClient
 	Bill                  *BillClient
+	LoadBalancer          *LoadBalancerClient
+	Snapshot              *SnapshotClient
+	NatGateway            *NatGatewayClient
+	DatabaseInstance      *DatabaseInstanceClient
+	Bucket                *BucketClient
+	K8sCluster            *K8sClusterClient
+	KeyPair               *KeyPairClient
+	PrivateDnsZone        *PrivateDnsZoneClient
+	VpcPeering            *VpcPeeringClient
 }
 
 type restClient struct {
@@ -64,5 +73,14 @@ func NewClient(client rest.ClientInterface) *Client {
 		RootAccount:           NewRootAccountClient(client),
 		RootAccountBillConfig: NewRootAccountBillConfigClient(client),
 		Bill:                  NewBillClient(client),
+		LoadBalancer:          NewLoadBalancerClient(client),
+		Snapshot:              NewSnapshotClient(client),
+		NatGateway:            NewNatGatewayClient(client),
+		DatabaseInstance:      NewDatabaseInstanceClient(client),
+		Bucket:                NewBucketClient(client),
+		K8sCluster:            NewK8sClusterClient(client),
+		KeyPair:               NewKeyPairClient(client),
+		PrivateDnsZone:        NewPrivateDnsZoneClient(client),
+		VpcPeering:            NewVpcPeeringClient(client),
 	}
 }
@@ -0,0 +1,64 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gcp
+
+import (
+	"hcm/pkg/api/core"
+	corek8s "hcm/pkg/api/core/cloud/k8s"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// K8sClusterClient ...
+type K8sClusterClient struct {
+	client rest.ClientInterface
+}
+
+// NewK8sClusterClient ...
+func NewK8sClusterClient(client rest.ClientInterface) *K8sClusterClient {
+	return &K8sClusterClient{client: client}
+}
+
+// BatchCreate 批量创建容器集群
+func (cli *K8sClusterClient) BatchCreate(kt *kit.Kit,
+	req *dataproto.K8sClusterBatchCreateReq[corek8s.GcpK8sClusterExtension]) (
+	*core.BatchCreateResult, error) {
+
+	return common.Request[dataproto.K8sClusterBatchCreateReq[corek8s.GcpK8sClusterExtension],
+		core.BatchCreateResult](cli.client, rest.POST, kt, req, "/k8s_clusters/batch/create")
+}
+
+// ListK8sClusterExt list k8s cluster with extension.
+func (cli *K8sClusterClient) ListK8sClusterExt(kt *kit.Kit, req *core.ListReq) (
+	*dataproto.K8sClusterExtListResult[corek8s.GcpK8sClusterExtension], error) {
+
+	return common.Request[core.ListReq, dataproto.K8sClusterExtListResult[corek8s.GcpK8sClusterExtension]](
+		cli.client, rest.POST, kt, req, "/k8s_clusters/list")
+}
+
+// BatchUpdate 批量更新容器集群
+func (cli *K8sClusterClient) BatchUpdate(kt *kit.Kit,
+	req *dataproto.K8sClusterExtBatchUpdateReq[corek8s.GcpK8sClusterExtension]) error {
+
+	return common.RequestNoResp[dataproto.K8sClusterExtBatchUpdateReq[corek8s.GcpK8sClusterExtension]](
+		cli.client, rest.PATCH, kt, req, "/k8s_clusters")
+}
@@ -35,6 +35,7 @@ import (
 	"hcm/pkg/criteria/errf"
 	"hcm/pkg/kit"
 	"hcm/pkg/rest"
+	"hcm/pkg/runtime/filter"
 )
 
 // BillClient is data service bill api client.
@@ -158,6 +159,24 @@ func (b *BillClient) ListBillItemRaw(kt *kit.Kit, req *core.ListReq) (*core.List
 		b.client, rest.POST, kt, req, "/bills/items/list_with_extension")
 }
 
+// ListBillItemCostByResource list bill item cost group by cloud resource
+func (b *BillClient) ListBillItemCostByResource(kt *kit.Kit, req *core.ListReq) (
+	*billproto.BillItemResourceCostListResult, error) {
+	return common.Request[core.ListReq, billproto.BillItemResourceCostListResult](
+		b.client, rest.POST, kt, req, "/bills/items/cost_by_resource/list")
+}
+
+// BillItemRawLister return lister of bill items matched given filter, used for exporting bill items page by page
+func (b *BillClient) BillItemRawLister(kt *kit.Kit, expr *filter.Expression) bill.BillItemRawLister {
+	return func(page *core.BasePage) ([]*bill.BillItemRaw, error) {
+		result, err := b.ListBillItemRaw(kt, &core.ListReq{Filter: expr, Page: page})
+		if err != nil {
+			return nil, err
+		}
+		return result.Details, nil
+	}
+}
+
 // --- bill daily pull task ---
 
 // CreateBillDailyPullTask create bill daily pull task
@@ -483,3 +502,95 @@ func (b *BillClient) ListBillSyncRecord(kt *kit.Kit, req *billproto.BillSyncReco
 	return common.Request[billproto.BillSyncRecordListReq, billproto.BillSyncRecordListResult](
 		b.client, rest.POST, kt, req, "/bills/sync_records/list")
 }
+
+// BatchCreateBillSplitRule create bill split rule
+func (b *BillClient) BatchCreateBillSplitRule(kt *kit.Kit, req *billproto.BatchBillSplitRuleCreateReq) (
+	*core.BatchCreateResult, error) {
+	return common.Request[billproto.BatchBillSplitRuleCreateReq, core.BatchCreateResult](
+		b.client, rest.POST, kt, req, "/bills/split_rules/create")
+}
+
+// BatchDeleteBillSplitRule delete bill split rule
+func (b *BillClient) BatchDeleteBillSplitRule(kt *kit.Kit, req *dataservice.BatchDeleteReq) error {
+	return common.RequestNoResp[dataservice.BatchDeleteReq](
+		b.client, rest.DELETE, kt, req, "/bills/split_rules")
+}
+
+// UpdateBillSplitRule update bill split rule
+func (b *BillClient) UpdateBillSplitRule(kt *kit.Kit, req *billproto.BillSplitRuleUpdateReq) error {
+	return common.RequestNoResp[billproto.BillSplitRuleUpdateReq](
+		b.client, rest.PUT, kt, req, "/bills/split_rules")
+}
+
+// ListBillSplitRule list bill split rule
+func (b *BillClient) ListBillSplitRule(kt *kit.Kit, req *billproto.BillSplitRuleListReq) (
+	*billproto.BillSplitRuleListResult, error) {
+	return common.Request[billproto.BillSplitRuleListReq, billproto.BillSplitRuleListResult](
+		b.client, rest.POST, kt, req, "/bills/split_rules/list")
+}
+
+// CreateBillSplitSnapshot create bill split snapshot
+func (b *BillClient) CreateBillSplitSnapshot(kt *kit.Kit, req *billproto.BillSplitSnapshotCreateReq) (
+	*core.CreateResult, error) {
+	return common.Request[billproto.BillSplitSnapshotCreateReq, core.CreateResult](
+		b.client, rest.POST, kt, req, "/bills/split_snapshots/create")
+}
+
+// UpdateBillSplitSnapshot update bill split snapshot
+func (b *BillClient) UpdateBillSplitSnapshot(kt *kit.Kit, req *billproto.BillSplitSnapshotUpdateReq) error {
+	return common.RequestNoResp[billproto.BillSplitSnapshotUpdateReq](
+		b.client, rest.PUT, kt, req, "/bills/split_snapshots")
+}
+
+// ListBillSplitSnapshot list bill split snapshot
+func (b *BillClient) ListBillSplitSnapshot(kt *kit.Kit, req *billproto.BillSplitSnapshotListReq) (
+	*billproto.BillSplitSnapshotListResult, error) {
+	return common.Request[billproto.BillSplitSnapshotListReq, billproto.BillSplitSnapshotListResult](
+		b.client, rest.POST, kt, req, "/bills/split_snapshots/list")
+}
+
+// BatchCreateBillBudget create bill budget
+func (b *BillClient) BatchCreateBillBudget(kt *kit.Kit, req *billproto.BatchBillBudgetCreateReq) (
+	*core.BatchCreateResult, error) {
+	return common.Request[billproto.BatchBillBudgetCreateReq, core.BatchCreateResult](
+		b.client, rest.POST, kt, req, "/bills/budgets/create")
+}
+
+// BatchDeleteBillBudget delete bill budget
+func (b *BillClient) BatchDeleteBillBudget(kt *kit.Kit, req *dataservice.BatchDeleteReq) error {
+	return common.RequestNoResp[dataservice.BatchDeleteReq](
+		b.client, rest.DELETE, kt, req, "/bills/budgets")
+}
+
+// UpdateBillBudget update bill budget
+func (b *BillClient) UpdateBillBudget(kt *kit.Kit, req *billproto.BillBudgetUpdateReq) error {
+	return common.RequestNoResp[billproto.BillBudgetUpdateReq](
+		b.client, rest.PUT, kt, req, "/bills/budgets")
+}
+
+// ListBillBudget list bill budget
+func (b *BillClient) ListBillBudget(kt *kit.Kit, req *billproto.BillBudgetListReq) (
+	*billproto.BillBudgetListResult, error) {
+	return common.Request[billproto.BillBudgetListReq, billproto.BillBudgetListResult](
+		b.client, rest.POST, kt, req, "/bills/budgets/list")
+}
+
+// BatchCreateBillBudgetAlert create bill budget alert
+func (b *BillClient) BatchCreateBillBudgetAlert(kt *kit.Kit, req *billproto.BatchBillBudgetAlertCreateReq) (
+	*core.BatchCreateResult, error) {
+	return common.Request[billproto.BatchBillBudgetAlertCreateReq, core.BatchCreateResult](
+		b.client, rest.POST, kt, req, "/bills/budgets/alerts/create")
+}
+
+// UpdateBillBudgetAlert update notify state of bill budget alert
+func (b *BillClient) UpdateBillBudgetAlert(kt *kit.Kit, req *billproto.BillBudgetAlertUpdateReq) error {
+	return common.RequestNoResp[billproto.BillBudgetAlertUpdateReq](
+		b.client, rest.PUT, kt, req, "/bills/budgets/alerts")
+}
+
+// ListBillBudgetAlert list bill budget alert
+func (b *BillClient) ListBillBudgetAlert(kt *kit.Kit, req *billproto.BillBudgetAlertListReq) (
+	*billproto.BillBudgetAlertListResult, error) {
+	return common.Request[billproto.BillBudgetAlertListReq, billproto.BillBudgetAlertListResult](
+		b.client, rest.POST, kt, req, "/bills/budgets/alerts/list")
+}
@@ -0,0 +1,56 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package global
+
+import (
+	"hcm/pkg/api/core"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// BucketClient is data service bucket api client.
+type BucketClient struct {
+	client rest.ClientInterface
+}
+
+// NewBucketClient create a new bucket api client.
+func NewBucketClient(client rest.ClientInterface) *BucketClient {
+	return &BucketClient{client: client}
+}
+
+// List 查询存储桶列表
+func (cli *BucketClient) List(kt *kit.Kit, req *core.ListReq) (*dataproto.BucketListResult, error) {
+	return common.Request[core.ListReq, dataproto.BucketListResult](cli.client, rest.POST, kt, req,
+		"/buckets/list")
+}
+
+// BatchDelete 批量删除存储桶
+func (cli *BucketClient) BatchDelete(kt *kit.Kit, req *dataproto.BucketBatchDeleteReq) error {
+	return common.RequestNoResp[dataproto.BucketBatchDeleteReq](cli.client, rest.DELETE, kt, req,
+		"/buckets/batch")
+}
+
+// BatchUpdate 批量更新存储桶的通用属性
+func (cli *BucketClient) BatchUpdate(kt *kit.Kit, req *dataproto.BucketBatchUpdateReq) error {
+	return common.RequestNoResp[dataproto.BucketBatchUpdateReq](cli.client, rest.PATCH, kt, req,
+		"/buckets/batch/update")
+}
@@ -41,6 +41,21 @@ type Client struct {
 	NetworkInterfaceCvmRel *NetworkInterfaceCvmRelClient
 	SubAccount             *SubAccountClient
 	AccountSyncDetail      *AccountSyncDetailClient
+	SyncDriftReport        *SyncDriftReportClient
+	Snapshot               *SnapshotClient
+	NatGateway             *NatGatewayClient
+	DatabaseInstance       *DatabaseInstanceClient
+	Bucket                 *BucketClient
+	K8sCluster             *K8sClusterClient
+	K8sNodePool            *K8sNodePoolClient
+	K8sNodePoolCvmRel      *K8sNodePoolCvmRelClient
+	KeyPair                *KeyPairClient
+	PrivateDnsZone         *PrivateDnsZoneClient
+	PrivateDnsZoneVpcRel   *PrivateDnsZoneVpcRelClient
+	PrivateDnsRecord       *PrivateDnsRecordClient
+	IpamPool               *IpamPoolClient
+	IpamAllocation         *IpamAllocationClient
+	VpcPeering             *VpcPeeringClient
 
 	Auth          *AuthClient
 	Account       *AccountClient
@@ -84,6 +99,21 @@ func NewClient(client rest.ClientInterface) *Client {
 		NetworkInterfaceCvmRel: NewNetworkInterfaceCvmRelClient(client),
 		SubAccount:             NewSubAccountClient(client),
 		AccountSyncDetail:      NewAccountSyncDetailClient(client),
+		SyncDriftReport:        NewSyncDriftReportClient(client),
+		Snapshot:               NewSnapshotClient(client),
+		NatGateway:             NewNatGatewayClient(client),
+		DatabaseInstance:       NewDatabaseInstanceClient(client),
+		Bucket:                 NewBucketClient(client),
+		K8sCluster:             NewK8sClusterClient(client),
+		K8sNodePool:            NewK8sNodePoolClient(client),
+		K8sNodePoolCvmRel:      NewK8sNodePoolCvmRelClient(client),
+		KeyPair:                NewKeyPairClient(client),
+		PrivateDnsZone:         NewPrivateDnsZoneClient(client),
+		PrivateDnsZoneVpcRel:   NewPrivateDnsZoneVpcRelClient(client),
+		PrivateDnsRecord:       NewPrivateDnsRecordClient(client),
+		IpamPool:               NewIpamPoolClient(client),
+		IpamAllocation:         NewIpamAllocationClient(client),
+		VpcPeering:             NewVpcPeeringClient(client),
 
 		Auth:          NewAuthClient(client),
 		Account:       NewAccountClient(client),
@@ -0,0 +1,56 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package global
+
+import (
+	"hcm/pkg/api/core"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// DatabaseInstanceClient is data service database instance api client.
+type DatabaseInstanceClient struct {
+	client rest.ClientInterface
+}
+
+// NewDatabaseInstanceClient create a new database instance api client.
+func NewDatabaseInstanceClient(client rest.ClientInterface) *DatabaseInstanceClient {
+	return &DatabaseInstanceClient{client: client}
+}
+
+// List 查询云数据库实例列表
+func (cli *DatabaseInstanceClient) List(kt *kit.Kit, req *core.ListReq) (*dataproto.DatabaseInstanceListResult, error) {
+	return common.Request[core.ListReq, dataproto.DatabaseInstanceListResult](cli.client, rest.POST, kt, req,
+		"/database_instances/list")
+}
+
+// BatchDelete 批量删除云数据库实例
+func (cli *DatabaseInstanceClient) BatchDelete(kt *kit.Kit, req *dataproto.DatabaseInstanceBatchDeleteReq) error {
+	return common.RequestNoResp[dataproto.DatabaseInstanceBatchDeleteReq](cli.client, rest.DELETE, kt, req,
+		"/database_instances/batch")
+}
+
+// BatchUpdate 批量更新云数据库实例的通用属性
+func (cli *DatabaseInstanceClient) BatchUpdate(kt *kit.Kit, req *dataproto.DatabaseInstanceBatchUpdateReq) error {
+	return common.RequestNoResp[dataproto.DatabaseInstanceBatchUpdateReq](cli.client, rest.PATCH, kt, req,
+		"/database_instances/batch/update")
+}
@@ -0,0 +1,108 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package global
+
+import (
+	"hcm/pkg/api/core"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// IpamPoolClient is data service ipam pool api client.
+type IpamPoolClient struct {
+	client rest.ClientInterface
+}
+
+// NewIpamPoolClient create a new ipam pool api client.
+func NewIpamPoolClient(client rest.ClientInterface) *IpamPoolClient {
+	return &IpamPoolClient{client: client}
+}
+
+// BatchCreate 批量创建地址池
+func (cli *IpamPoolClient) BatchCreate(kt *kit.Kit, req *dataproto.IpamPoolBatchCreateReq) (
+	*core.BatchCreateResult, error) {
+
+	return common.Request[dataproto.IpamPoolBatchCreateReq, core.BatchCreateResult](cli.client, rest.POST,
+		kt, req, "/ipam/pools/batch/create")
+}
+
+// List 查询地址池列表
+func (cli *IpamPoolClient) List(kt *kit.Kit, req *core.ListReq) (*dataproto.IpamPoolListResult, error) {
+	return common.Request[core.ListReq, dataproto.IpamPoolListResult](cli.client, rest.POST, kt, req,
+		"/ipam/pools/list")
+}
+
+// BatchUpdate 批量更新地址池
+func (cli *IpamPoolClient) BatchUpdate(kt *kit.Kit, req *dataproto.IpamPoolBatchUpdateReq) error {
+	return common.RequestNoResp[dataproto.IpamPoolBatchUpdateReq](cli.client, rest.PATCH, kt, req,
+		"/ipam/pools/batch/update")
+}
+
+// BatchDelete 批量删除地址池，地址池下的分配记录会一并删除
+func (cli *IpamPoolClient) BatchDelete(kt *kit.Kit, req *dataproto.IpamPoolBatchDeleteReq) error {
+	return common.RequestNoResp[dataproto.IpamPoolBatchDeleteReq](cli.client, rest.DELETE, kt, req,
+		"/ipam/pools/batch")
+}
+
+// IpamAllocationClient is data service ipam allocation api client.
+type IpamAllocationClient struct {
+	client rest.ClientInterface
+}
+
+// NewIpamAllocationClient create a new ipam allocation api client.
+func NewIpamAllocationClient(client rest.ClientInterface) *IpamAllocationClient {
+	return &IpamAllocationClient{client: client}
+}
+
+// BatchCreate 批量创建网段分配记录
+func (cli *IpamAllocationClient) BatchCreate(kt *kit.Kit, req *dataproto.IpamAllocationBatchCreateReq) (
+	*core.BatchCreateResult, error) {
+
+	return common.Request[dataproto.IpamAllocationBatchCreateReq, core.BatchCreateResult](cli.client, rest.POST,
+		kt, req, "/ipam/allocations/batch/create")
+}
+
+// Allocate 从地址池分配网段并创建分配记录，同一地址池的分配会串行执行
+func (cli *IpamAllocationClient) Allocate(kt *kit.Kit, req *dataproto.IpamAllocationAllocateReq) (
+	*dataproto.IpamAllocationAllocateResult, error) {
+
+	return common.Request[dataproto.IpamAllocationAllocateReq, dataproto.IpamAllocationAllocateResult](cli.client,
+		rest.POST, kt, req, "/ipam/allocations/allocate")
+}
+
+// List 查询网段分配记录列表
+func (cli *IpamAllocationClient) List(kt *kit.Kit, req *core.ListReq) (*dataproto.IpamAllocationListResult, error) {
+	return common.Request[core.ListReq, dataproto.IpamAllocationListResult](cli.client, rest.POST, kt, req,
+		"/ipam/allocations/list")
+}
+
+// BatchUpdate 批量更新网段分配记录
+func (cli *IpamAllocationClient) BatchUpdate(kt *kit.Kit, req *dataproto.IpamAllocationBatchUpdateReq) error {
+	return common.RequestNoResp[dataproto.IpamAllocationBatchUpdateReq](cli.client, rest.PATCH, kt, req,
+		"/ipam/allocations/batch/update")
+}
+
+// BatchDelete 批量删除网段分配记录
+func (cli *IpamAllocationClient) BatchDelete(kt *kit.Kit, req *dataproto.IpamAllocationBatchDeleteReq) error {
+	return common.RequestNoResp[dataproto.IpamAllocationBatchDeleteReq](cli.client, rest.DELETE, kt, req,
+		"/ipam/allocations/batch")
+}
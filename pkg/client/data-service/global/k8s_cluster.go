@@ -0,0 +1,122 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package global
+
+import (
+	"hcm/pkg/api/core"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// K8sClusterClient is data service k8s cluster api client.
+type K8sClusterClient struct {
+	client rest.ClientInterface
+}
+
+// NewK8sClusterClient create a new k8s cluster api client.
+func NewK8sClusterClient(client rest.ClientInterface) *K8sClusterClient {
+	return &K8sClusterClient{client: client}
+}
+
+// List 查询容器集群列表
+func (cli *K8sClusterClient) List(kt *kit.Kit, req *core.ListReq) (*dataproto.K8sClusterListResult, error) {
+	return common.Request[core.ListReq, dataproto.K8sClusterListResult](cli.client, rest.POST, kt, req,
+		"/k8s_clusters/list")
+}
+
+// BatchDelete 批量删除容器集群
+func (cli *K8sClusterClient) BatchDelete(kt *kit.Kit, req *dataproto.K8sClusterBatchDeleteReq) error {
+	return common.RequestNoResp[dataproto.K8sClusterBatchDeleteReq](cli.client, rest.DELETE, kt, req,
+		"/k8s_clusters/batch")
+}
+
+// BatchUpdate 批量更新容器集群的通用属性
+func (cli *K8sClusterClient) BatchUpdate(kt *kit.Kit, req *dataproto.K8sClusterBatchUpdateReq) error {
+	return common.RequestNoResp[dataproto.K8sClusterBatchUpdateReq](cli.client, rest.PATCH, kt, req,
+		"/k8s_clusters/batch/update")
+}
+
+// K8sNodePoolClient is data service k8s node pool api client.
+type K8sNodePoolClient struct {
+	client rest.ClientInterface
+}
+
+// NewK8sNodePoolClient create a new k8s node pool api client.
+func NewK8sNodePoolClient(client rest.ClientInterface) *K8sNodePoolClient {
+	return &K8sNodePoolClient{client: client}
+}
+
+// BatchCreate 批量创建节点池
+func (cli *K8sNodePoolClient) BatchCreate(kt *kit.Kit, req *dataproto.K8sNodePoolBatchCreateReq) (
+	*core.BatchCreateResult, error) {
+
+	return common.Request[dataproto.K8sNodePoolBatchCreateReq, core.BatchCreateResult](cli.client, rest.POST, kt,
+		req, "/k8s_node_pools/batch/create")
+}
+
+// List 查询节点池列表
+func (cli *K8sNodePoolClient) List(kt *kit.Kit, req *core.ListReq) (*dataproto.K8sNodePoolListResult, error) {
+	return common.Request[core.ListReq, dataproto.K8sNodePoolListResult](cli.client, rest.POST, kt, req,
+		"/k8s_node_pools/list")
+}
+
+// BatchUpdate 批量更新节点池
+func (cli *K8sNodePoolClient) BatchUpdate(kt *kit.Kit, req *dataproto.K8sNodePoolBatchUpdateReq) error {
+	return common.RequestNoResp[dataproto.K8sNodePoolBatchUpdateReq](cli.client, rest.PATCH, kt, req,
+		"/k8s_node_pools/batch/update")
+}
+
+// BatchDelete 批量删除节点池
+func (cli *K8sNodePoolClient) BatchDelete(kt *kit.Kit, req *dataproto.K8sNodePoolBatchDeleteReq) error {
+	return common.RequestNoResp[dataproto.K8sNodePoolBatchDeleteReq](cli.client, rest.DELETE, kt, req,
+		"/k8s_node_pools/batch")
+}
+
+// K8sNodePoolCvmRelClient is data service k8s node pool cvm rel api client.
+type K8sNodePoolCvmRelClient struct {
+	client rest.ClientInterface
+}
+
+// NewK8sNodePoolCvmRelClient create a new k8s node pool cvm rel api client.
+func NewK8sNodePoolCvmRelClient(client rest.ClientInterface) *K8sNodePoolCvmRelClient {
+	return &K8sNodePoolCvmRelClient{client: client}
+}
+
+// BatchCreate 批量创建节点池和主机的关联关系
+func (cli *K8sNodePoolCvmRelClient) BatchCreate(kt *kit.Kit, req *dataproto.K8sNodePoolCvmRelBatchCreateReq) error {
+	return common.RequestNoResp[dataproto.K8sNodePoolCvmRelBatchCreateReq](cli.client, rest.POST, kt, req,
+		"/k8s_node_pool_cvm_rels/batch/create")
+}
+
+// List 查询节点池和主机的关联关系
+func (cli *K8sNodePoolCvmRelClient) List(kt *kit.Kit, req *core.ListReq) (*dataproto.K8sNodePoolCvmRelListResult,
+	error) {
+
+	return common.Request[core.ListReq, dataproto.K8sNodePoolCvmRelListResult](cli.client, rest.POST, kt, req,
+		"/k8s_node_pool_cvm_rels/list")
+}
+
+// BatchDelete 批量删除节点池和主机的关联关系
+func (cli *K8sNodePoolCvmRelClient) BatchDelete(kt *kit.Kit, req *dataproto.K8sNodePoolCvmRelBatchDeleteReq) error {
+	return common.RequestNoResp[dataproto.K8sNodePoolCvmRelBatchDeleteReq](cli.client, rest.DELETE, kt, req,
+		"/k8s_node_pool_cvm_rels/batch")
+}
@@ -0,0 +1,56 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package global
+
+import (
+	"hcm/pkg/api/core"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// KeyPairClient is data service key pair api client.
+type KeyPairClient struct {
+	client rest.ClientInterface
+}
+
+// NewKeyPairClient create a new key pair api client.
+func NewKeyPairClient(client rest.ClientInterface) *KeyPairClient {
+	return &KeyPairClient{client: client}
+}
+
+// List 查询密钥对列表
+func (cli *KeyPairClient) List(kt *kit.Kit, req *core.ListReq) (*dataproto.KeyPairListResult, error) {
+	return common.Request[core.ListReq, dataproto.KeyPairListResult](cli.client, rest.POST, kt, req,
+		"/key_pairs/list")
+}
+
+// BatchUpdate 批量更新密钥对的业务、备注等本地属性
+func (cli *KeyPairClient) BatchUpdate(kt *kit.Kit, req *dataproto.KeyPairBatchUpdateExprReq) error {
+	return common.RequestNoResp[dataproto.KeyPairBatchUpdateExprReq](cli.client, rest.PATCH, kt, req,
+		"/key_pairs")
+}
+
+// BatchDelete 批量删除密钥对
+func (cli *KeyPairClient) BatchDelete(kt *kit.Kit, req *dataproto.KeyPairBatchDeleteReq) error {
+	return common.RequestNoResp[dataproto.KeyPairBatchDeleteReq](cli.client, rest.DELETE, kt, req,
+		"/key_pairs/batch")
+}
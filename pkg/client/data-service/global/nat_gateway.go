@@ -0,0 +1,50 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package global
+
+import (
+	"hcm/pkg/api/core"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// NatGatewayClient is data service nat gateway api client.
+type NatGatewayClient struct {
+	client rest.ClientInterface
+}
+
+// NewNatGatewayClient create a new nat gateway api client.
+func NewNatGatewayClient(client rest.ClientInterface) *NatGatewayClient {
+	return &NatGatewayClient{client: client}
+}
+
+// List 查询NAT网关列表
+func (cli *NatGatewayClient) List(kt *kit.Kit, req *core.ListReq) (*dataproto.NatGatewayListResult, error) {
+	return common.Request[core.ListReq, dataproto.NatGatewayListResult](cli.client, rest.POST, kt, req,
+		"/nat_gateways/list")
+}
+
+// BatchDelete 批量删除NAT网关
+func (cli *NatGatewayClient) BatchDelete(kt *kit.Kit, req *dataproto.NatGatewayBatchDeleteReq) error {
+	return common.RequestNoResp[dataproto.NatGatewayBatchDeleteReq](cli.client, rest.DELETE, kt, req,
+		"/nat_gateways/batch")
+}
@@ -0,0 +1,127 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package global
+
+import (
+	"hcm/pkg/api/core"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// PrivateDnsZoneClient is data service private dns zone api client.
+type PrivateDnsZoneClient struct {
+	client rest.ClientInterface
+}
+
+// NewPrivateDnsZoneClient create a new private dns zone api client.
+func NewPrivateDnsZoneClient(client rest.ClientInterface) *PrivateDnsZoneClient {
+	return &PrivateDnsZoneClient{client: client}
+}
+
+// List 查询私有域列表
+func (cli *PrivateDnsZoneClient) List(kt *kit.Kit, req *core.ListReq) (*dataproto.PrivateDnsZoneListResult, error) {
+	return common.Request[core.ListReq, dataproto.PrivateDnsZoneListResult](cli.client, rest.POST, kt, req,
+		"/private_dns_zones/list")
+}
+
+// BatchDelete 批量删除私有域
+func (cli *PrivateDnsZoneClient) BatchDelete(kt *kit.Kit, req *dataproto.PrivateDnsZoneBatchDeleteReq) error {
+	return common.RequestNoResp[dataproto.PrivateDnsZoneBatchDeleteReq](cli.client, rest.DELETE, kt, req,
+		"/private_dns_zones/batch")
+}
+
+// BatchUpdate 批量更新私有域的通用属性
+func (cli *PrivateDnsZoneClient) BatchUpdate(kt *kit.Kit, req *dataproto.PrivateDnsZoneBatchUpdateReq) error {
+	return common.RequestNoResp[dataproto.PrivateDnsZoneBatchUpdateReq](cli.client, rest.PATCH, kt, req,
+		"/private_dns_zones/batch/update")
+}
+
+// PrivateDnsZoneVpcRelClient is data service private dns zone vpc rel api client.
+type PrivateDnsZoneVpcRelClient struct {
+	client rest.ClientInterface
+}
+
+// NewPrivateDnsZoneVpcRelClient create a new private dns zone vpc rel api client.
+func NewPrivateDnsZoneVpcRelClient(client rest.ClientInterface) *PrivateDnsZoneVpcRelClient {
+	return &PrivateDnsZoneVpcRelClient{client: client}
+}
+
+// BatchCreate 批量创建私有域和VPC的关联关系
+func (cli *PrivateDnsZoneVpcRelClient) BatchCreate(kt *kit.Kit,
+	req *dataproto.PrivateDnsZoneVpcRelBatchCreateReq) error {
+
+	return common.RequestNoResp[dataproto.PrivateDnsZoneVpcRelBatchCreateReq](cli.client, rest.POST, kt, req,
+		"/private_dns_zone_vpc_rels/batch/create")
+}
+
+// List 查询私有域和VPC的关联关系
+func (cli *PrivateDnsZoneVpcRelClient) List(kt *kit.Kit, req *core.ListReq) (
+	*dataproto.PrivateDnsZoneVpcRelListResult, error) {
+
+	return common.Request[core.ListReq, dataproto.PrivateDnsZoneVpcRelListResult](cli.client, rest.POST, kt, req,
+		"/private_dns_zone_vpc_rels/list")
+}
+
+// BatchDelete 批量删除私有域和VPC的关联关系
+func (cli *PrivateDnsZoneVpcRelClient) BatchDelete(kt *kit.Kit,
+	req *dataproto.PrivateDnsZoneVpcRelBatchDeleteReq) error {
+
+	return common.RequestNoResp[dataproto.PrivateDnsZoneVpcRelBatchDeleteReq](cli.client, rest.DELETE, kt, req,
+		"/private_dns_zone_vpc_rels/batch")
+}
+
+// PrivateDnsRecordClient is data service private dns record api client.
+type PrivateDnsRecordClient struct {
+	client rest.ClientInterface
+}
+
+// NewPrivateDnsRecordClient create a new private dns record api client.
+func NewPrivateDnsRecordClient(client rest.ClientInterface) *PrivateDnsRecordClient {
+	return &PrivateDnsRecordClient{client: client}
+}
+
+// BatchCreate 批量创建解析记录
+func (cli *PrivateDnsRecordClient) BatchCreate(kt *kit.Kit, req *dataproto.PrivateDnsRecordBatchCreateReq) (
+	*core.BatchCreateResult, error) {
+
+	return common.Request[dataproto.PrivateDnsRecordBatchCreateReq, core.BatchCreateResult](cli.client, rest.POST,
+		kt, req, "/private_dns_records/batch/create")
+}
+
+// List 查询解析记录列表
+func (cli *PrivateDnsRecordClient) List(kt *kit.Kit, req *core.ListReq) (*dataproto.PrivateDnsRecordListResult,
+	error) {
+
+	return common.Request[core.ListReq, dataproto.PrivateDnsRecordListResult](cli.client, rest.POST, kt, req,
+		"/private_dns_records/list")
+}
+
+// BatchUpdate 批量更新解析记录
+func (cli *PrivateDnsRecordClient) BatchUpdate(kt *kit.Kit, req *dataproto.PrivateDnsRecordBatchUpdateReq) error {
+	return common.RequestNoResp[dataproto.PrivateDnsRecordBatchUpdateReq](cli.client, rest.PATCH, kt, req,
+		"/private_dns_records/batch/update")
+}
+
+// BatchDelete 批量删除解析记录
+func (cli *PrivateDnsRecordClient) BatchDelete(kt *kit.Kit, req *dataproto.PrivateDnsRecordBatchDeleteReq) error {
+	return common.RequestNoResp[dataproto.PrivateDnsRecordBatchDeleteReq](cli.client, rest.DELETE, kt, req,
+		"/private_dns_records/batch")
+}
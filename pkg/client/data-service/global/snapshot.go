@@ -0,0 +1,81 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package global
+
+import (
+	"hcm/pkg/api/core"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// SnapshotClient is data service snapshot api client.
+type SnapshotClient struct {
+	client rest.ClientInterface
+}
+
+// NewSnapshotClient create a new snapshot api client.
+func NewSnapshotClient(client rest.ClientInterface) *SnapshotClient {
+	return &SnapshotClient{client: client}
+}
+
+// List 查询快照列表
+func (cli *SnapshotClient) List(kt *kit.Kit, req *core.ListReq) (*dataproto.SnapshotListResult, error) {
+	return common.Request[core.ListReq, dataproto.SnapshotListResult](cli.client, rest.POST, kt, req,
+		"/snapshots/list")
+}
+
+// BatchUpdate 批量更新快照的业务、策略等本地属性
+func (cli *SnapshotClient) BatchUpdate(kt *kit.Kit, req *dataproto.SnapshotBatchUpdateExprReq) error {
+	return common.RequestNoResp[dataproto.SnapshotBatchUpdateExprReq](cli.client, rest.PATCH, kt, req,
+		"/snapshots")
+}
+
+// BatchDelete 批量删除快照
+func (cli *SnapshotClient) BatchDelete(kt *kit.Kit, req *dataproto.SnapshotBatchDeleteReq) error {
+	return common.RequestNoResp[dataproto.SnapshotBatchDeleteReq](cli.client, rest.DELETE, kt, req,
+		"/snapshots/batch")
+}
+
+// CreatePolicy 创建快照策略
+func (cli *SnapshotClient) CreatePolicy(kt *kit.Kit, req *dataproto.SnapshotPolicyCreateReq) (
+	*core.CreateResult, error) {
+
+	return common.Request[dataproto.SnapshotPolicyCreateReq, core.CreateResult](cli.client, rest.POST, kt, req,
+		"/snapshot_policies/create")
+}
+
+// ListPolicy 查询快照策略列表
+func (cli *SnapshotClient) ListPolicy(kt *kit.Kit, req *core.ListReq) (*dataproto.SnapshotPolicyListResult, error) {
+	return common.Request[core.ListReq, dataproto.SnapshotPolicyListResult](cli.client, rest.POST, kt, req,
+		"/snapshot_policies/list")
+}
+
+// UpdatePolicy 更新快照策略
+func (cli *SnapshotClient) UpdatePolicy(kt *kit.Kit, req *dataproto.SnapshotPolicyUpdateReq) error {
+	return common.RequestNoResp[dataproto.SnapshotPolicyUpdateReq](cli.client, rest.PATCH, kt, req,
+		"/snapshot_policies")
+}
+
+// BatchDeletePolicy 批量删除快照策略
+func (cli *SnapshotClient) BatchDeletePolicy(kt *kit.Kit, req *core.BatchDeleteReq) error {
+	return common.RequestNoResp[core.BatchDeleteReq](cli.client, rest.DELETE, kt, req, "/snapshot_policies/batch")
+}
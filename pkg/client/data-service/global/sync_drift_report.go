@@ -0,0 +1,89 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package global
+
+import (
+	"hcm/pkg/api/core"
+	dssync "hcm/pkg/api/data-service/cloud/sync"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// SyncDriftReportClient is data service sync_drift_report api client.
+type SyncDriftReportClient struct {
+	client rest.ClientInterface
+}
+
+// NewSyncDriftReportClient create a new sync_drift_report api client.
+func NewSyncDriftReportClient(client rest.ClientInterface) *SyncDriftReportClient {
+	return &SyncDriftReportClient{
+		client: client,
+	}
+}
+
+// List ...
+func (a *SyncDriftReportClient) List(kt *kit.Kit, request *core.ListReq) (*dssync.ListDriftReportResult, error) {
+	resp := &struct {
+		rest.BaseResp `json:",inline"`
+		Data          *dssync.ListDriftReportResult `json:"data"`
+	}{}
+
+	err := a.client.Post().
+		WithContext(kt.Ctx).
+		Body(request).
+		SubResourcef("/sync_drift_reports/list").
+		WithHeaders(kt.Header()).
+		Do().
+		Into(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Code != errf.OK {
+		return nil, errf.New(resp.Code, resp.Message)
+	}
+
+	return resp.Data, nil
+}
+
+// BatchCreate ...
+func (a *SyncDriftReportClient) BatchCreate(kt *kit.Kit, request *dssync.CreateDriftReportReq) (
+	*core.BatchCreateResult, error) {
+
+	resp := new(core.BatchCreateResp)
+
+	err := a.client.Post().
+		WithContext(kt.Ctx).
+		Body(request).
+		SubResourcef("/sync_drift_reports/batch/create").
+		WithHeaders(kt.Header()).
+		Do().
+		Into(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Code != errf.OK {
+		return nil, errf.New(resp.Code, resp.Message)
+	}
+
+	return resp.Data, nil
+}
@@ -0,0 +1,63 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package huawei
+
+import (
+	"hcm/pkg/api/core"
+	corenatgateway "hcm/pkg/api/core/cloud/nat-gateway"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// NatGatewayClient ...
+type NatGatewayClient struct {
+	client rest.ClientInterface
+}
+
+// NewNatGatewayClient ...
+func NewNatGatewayClient(client rest.ClientInterface) *NatGatewayClient {
+	return &NatGatewayClient{client: client}
+}
+
+// BatchCreate 批量创建NAT网关
+func (cli *NatGatewayClient) BatchCreate(kt *kit.Kit,
+	req *dataproto.NatGatewayBatchCreateReq[corenatgateway.HuaWeiNatGatewayExtension]) (*core.BatchCreateResult, error) {
+
+	return common.Request[dataproto.NatGatewayBatchCreateReq[corenatgateway.HuaWeiNatGatewayExtension],
+		core.BatchCreateResult](cli.client, rest.POST, kt, req, "/nat_gateways/batch/create")
+}
+
+// ListNatGatewayExt list nat gateway with extension.
+func (cli *NatGatewayClient) ListNatGatewayExt(kt *kit.Kit, req *core.ListReq) (
+	*dataproto.NatGatewayExtListResult[corenatgateway.HuaWeiNatGatewayExtension], error) {
+
+	return common.Request[core.ListReq, dataproto.NatGatewayExtListResult[corenatgateway.HuaWeiNatGatewayExtension]](
+		cli.client, rest.POST, kt, req, "/nat_gateways/list")
+}
+
+// BatchUpdate 批量更新NAT网关
+func (cli *NatGatewayClient) BatchUpdate(kt *kit.Kit,
+	req *dataproto.NatGatewayExtBatchUpdateReq[corenatgateway.HuaWeiNatGatewayExtension]) error {
+
+	return common.RequestNoResp[dataproto.NatGatewayExtBatchUpdateReq[corenatgateway.HuaWeiNatGatewayExtension]](
+		cli.client, rest.PATCH, kt, req, "/nat_gateways")
+}
@@ -0,0 +1,63 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package huawei
+
+import (
+	"hcm/pkg/api/core"
+	coresnapshot "hcm/pkg/api/core/cloud/snapshot"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// SnapshotClient ...
+type SnapshotClient struct {
+	client rest.ClientInterface
+}
+
+// NewSnapshotClient ...
+func NewSnapshotClient(client rest.ClientInterface) *SnapshotClient {
+	return &SnapshotClient{client: client}
+}
+
+// BatchCreate 批量创建快照
+func (cli *SnapshotClient) BatchCreate(kt *kit.Kit,
+	req *dataproto.SnapshotBatchCreateReq[coresnapshot.HuaWeiSnapshotExtension]) (*core.BatchCreateResult, error) {
+
+	return common.Request[dataproto.SnapshotBatchCreateReq[coresnapshot.HuaWeiSnapshotExtension], core.BatchCreateResult](
+		cli.client, rest.POST, kt, req, "/snapshots/batch/create")
+}
+
+// ListSnapshotExt list snapshot with extension.
+func (cli *SnapshotClient) ListSnapshotExt(kt *kit.Kit, req *core.ListReq) (
+	*dataproto.SnapshotExtListResult[coresnapshot.HuaWeiSnapshotExtension], error) {
+
+	return common.Request[core.ListReq, dataproto.SnapshotExtListResult[coresnapshot.HuaWeiSnapshotExtension]](
+		cli.client, rest.POST, kt, req, "/snapshots/list")
+}
+
+// BatchUpdate 批量更新快照
+func (cli *SnapshotClient) BatchUpdate(kt *kit.Kit,
+	req *dataproto.SnapshotExtBatchUpdateReq[coresnapshot.HuaWeiSnapshotExtension]) error {
+
+	return common.RequestNoResp[dataproto.SnapshotExtBatchUpdateReq[coresnapshot.HuaWeiSnapshotExtension]](
+		cli.client, rest.PATCH, kt, req, "/snapshots")
+}
@@ -23,9 +23,15 @@ import (
 	"hcm/pkg/rest"
 )
 
-// Client is a huawei api client
+// Client is a kaopu api client
 type Client struct {
 	*restClient
+	Account     *AccountClient
+	Region      *RegionClient
+	Zone        *ZoneClient
+	Vpc         *VpcClient
+	Subnet      *SubnetClient
+	Cvm         *CvmClient
 	MainAccount *MainAccountClient
 	RootAccount *RootAccountClient
 	Bill        *BillClient
@@ -35,10 +41,16 @@ type restClient struct {
 	client rest.ClientInterface
 }
 
-// NewClient create a new huawei api client.
+// NewClient create a new kaopu api client.
 func NewClient(client rest.ClientInterface) *Client {
 	return &Client{
 		restClient:  &restClient{client: client},
+		Account:     NewAccountClient(client),
+		Region:      NewRegionClient(client),
+		Zone:        NewZoneClient(client),
+		Vpc:         NewVpcClient(client),
+		Subnet:      NewSubnetClient(client),
+		Cvm:         NewCloudCvmClient(client),
 		MainAccount: NewMainAccountClient(client),
 		RootAccount: NewRootAccountClient(client),
 		Bill:        NewBillClient(client),
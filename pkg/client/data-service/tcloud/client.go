@@ -26,16 +26,26 @@ import (
 // Client is a tcloud api client
 type Client struct {
 	*restClient
-	Account       *AccountClient
-	SecurityGroup *SecurityGroupClient
-	Vpc           *VpcClient
-	Subnet        *SubnetClient
-	Region        *RegionClient
-	Zone          *ZoneClient
-	Cvm           *CvmClient
-	RouteTable    *RouteTableClient
-	SubAccount    *SubAccountClient
-	LoadBalancer  *LoadBalancerClient
+	Account          *AccountClient
+	SecurityGroup    *SecurityGroupClient
+	Vpc              *VpcClient
+	Subnet           *SubnetClient
+	Region           *RegionClient
+	Zone             *ZoneClient
+	Cvm              *CvmClient
+	RouteTable       *RouteTableClient
+	SubAccount       *SubAccountClient
+	LoadBalancer     *LoadBalancerClient
+	Snapshot         *SnapshotClient
+	NatGateway       *NatGatewayClient
+	DatabaseInstance *DatabaseInstanceClient
+	Bucket           *BucketClient
+	K8sCluster       *K8sClusterClient
+	KeyPair          *KeyPairClient
+	PrivateDnsZone   *PrivateDnsZoneClient
+	VpcPeering       *VpcPeeringClient
+	MainAccount      *MainAccountClient
+	RootAccount      *RootAccountClient
 }
 
 type restClient struct {
@@ -45,16 +55,26 @@ type restClient struct {
 // NewClient create a new tcloud api client.
 func NewClient(client rest.ClientInterface) *Client {
 	return &Client{
-		restClient:    &restClient{client: client},
-		Account:       NewAccountClient(client),
-		SecurityGroup: NewCloudSecurityGroupClient(client),
-		Vpc:           NewVpcClient(client),
-		Subnet:        NewSubnetClient(client),
-		Region:        NewRegionClient(client),
-		Zone:          NewZoneClient(client),
-		Cvm:           NewCloudCvmClient(client),
-		RouteTable:    NewRouteTableClient(client),
-		SubAccount:    NewSubAccountClient(client),
-		LoadBalancer:  NewLoadBalancerClient(client),
+		restClient:       &restClient{client: client},
+		Account:          NewAccountClient(client),
+		SecurityGroup:    NewCloudSecurityGroupClient(client),
+		Vpc:              NewVpcClient(client),
+		Subnet:           NewSubnetClient(client),
+		Region:           NewRegionClient(client),
+		Zone:             NewZoneClient(client),
+		Cvm:              NewCloudCvmClient(client),
+		RouteTable:       NewRouteTableClient(client),
+		SubAccount:       NewSubAccountClient(client),
+		LoadBalancer:     NewLoadBalancerClient(client),
+		Snapshot:         NewSnapshotClient(client),
+		NatGateway:       NewNatGatewayClient(client),
+		DatabaseInstance: NewDatabaseInstanceClient(client),
+		Bucket:           NewBucketClient(client),
+		K8sCluster:       NewK8sClusterClient(client),
+		KeyPair:          NewKeyPairClient(client),
+		PrivateDnsZone:   NewPrivateDnsZoneClient(client),
+		VpcPeering:       NewVpcPeeringClient(client),
+		MainAccount:      NewMainAccountClient(client),
+		RootAccount:      NewRootAccountClient(client),
 	}
 }
@@ -0,0 +1,63 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tcloud
+
+import (
+	"hcm/pkg/api/core"
+	corevpcpeering "hcm/pkg/api/core/cloud/vpc-peering"
+	dataproto "hcm/pkg/api/data-service/cloud"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// VpcPeeringClient ...
+type VpcPeeringClient struct {
+	client rest.ClientInterface
+}
+
+// NewVpcPeeringClient ...
+func NewVpcPeeringClient(client rest.ClientInterface) *VpcPeeringClient {
+	return &VpcPeeringClient{client: client}
+}
+
+// BatchCreate 批量创建对等连接
+func (cli *VpcPeeringClient) BatchCreate(kt *kit.Kit,
+	req *dataproto.VpcPeeringBatchCreateReq[corevpcpeering.TCloudVpcPeeringExtension]) (*core.BatchCreateResult, error) {
+
+	return common.Request[dataproto.VpcPeeringBatchCreateReq[corevpcpeering.TCloudVpcPeeringExtension],
+		core.BatchCreateResult](cli.client, rest.POST, kt, req, "/vpc_peerings/batch/create")
+}
+
+// ListVpcPeeringExt list vpc peering with extension.
+func (cli *VpcPeeringClient) ListVpcPeeringExt(kt *kit.Kit, req *core.ListReq) (
+	*dataproto.VpcPeeringExtListResult[corevpcpeering.TCloudVpcPeeringExtension], error) {
+
+	return common.Request[core.ListReq, dataproto.VpcPeeringExtListResult[corevpcpeering.TCloudVpcPeeringExtension]](
+		cli.client, rest.POST, kt, req, "/vpc_peerings/list")
+}
+
+// BatchUpdate 批量更新对等连接
+func (cli *VpcPeeringClient) BatchUpdate(kt *kit.Kit,
+	req *dataproto.VpcPeeringExtBatchUpdateReq[corevpcpeering.TCloudVpcPeeringExtension]) error {
+
+	return common.RequestNoResp[dataproto.VpcPeeringExtBatchUpdateReq[corevpcpeering.TCloudVpcPeeringExtension]](
+		cli.client, rest.PATCH, kt, req, "/vpc_peerings")
+}
@@ -108,3 +108,28 @@ func (v *BillClient) BillPipeline(ctx context.Context, h http.Header, req *hcbil
 
 	return nil
 }
+
+// RootAccountBillList list root account bill list
+func (v *BillClient) RootAccountBillList(ctx context.Context, h http.Header, req *hcbillservice.AwsRootBillListReq) (
+	*hcbillservice.AwsBillListResult, error) {
+
+	resp := new(hcbillservice.AwsBillListResp)
+
+	err := v.client.Post().
+		WithContext(ctx).
+		Body(req).
+		SubResourcef("/root-account-bills/list").
+		WithHeaders(h).
+		Do().
+		Into(resp)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Code != errf.OK {
+		return nil, errf.New(resp.Code, resp.Message)
+	}
+
+	return resp.Data, nil
+}
@@ -25,38 +25,58 @@ import (
 
 // Client is a aws api client
 type Client struct {
-	Account       *AccountClient
-	SecurityGroup *SecurityGroupClient
-	Vpc           *VpcClient
-	Subnet        *SubnetClient
-	Eip           *EipClient
-	Disk          *DiskClient
-	Zone          *ZoneClient
-	Region        *RegionClient
-	Cvm           *CvmClient
-	Image         *ImageClient
-	RouteTable    *RouteTableClient
-	InstanceType  *InstanceTypeClient
-	Bill          *BillClient
-	MainAccount   *MainAccountClient
+	Account          *AccountClient
+	SecurityGroup    *SecurityGroupClient
+	Vpc              *VpcClient
+	Subnet           *SubnetClient
+	Eip              *EipClient
+	Disk             *DiskClient
+	Zone             *ZoneClient
+	Region           *RegionClient
+	Cvm              *CvmClient
+	Image            *ImageClient
+	RouteTable       *RouteTableClient
+	InstanceType     *InstanceTypeClient
+	Bill             *BillClient
+	MainAccount      *MainAccountClient
+	Sync             *SyncClient
+	LoadBalancer     *LoadBalancerClient
+	Snapshot         *SnapshotClient
+	KeyPair          *KeyPairClient
+	PrivateDns       *PrivateDnsClient
+	NatGateway       *NatGatewayClient
+	DatabaseInstance *DatabaseInstanceClient
+	Bucket           *BucketClient
+	K8sCluster       *K8sClusterClient
+	VpcPeering       *VpcPeeringClient
 }
 
 // NewClient create a new aws api client.
 func NewClient(client rest.ClientInterface) *Client {
 	return &Client{
-		Account:       NewAccountClient(client),
-		SecurityGroup: NewCloudSecurityGroupClient(client),
-		Vpc:           NewVpcClient(client),
-		Subnet:        NewSubnetClient(client),
-		Eip:           NewEipClient(client),
-		Disk:          NewCloudDiskClient(client),
-		Zone:          NewZoneClient(client),
-		Region:        NewRegionClient(client),
-		Cvm:           NewCvmClient(client),
-		Image:         NewCloudPublicClient(client),
-		RouteTable:    NewRouteTableClient(client),
-		InstanceType:  NewInstanceTypeClient(client),
-		Bill:          NewBillClient(client),
-		MainAccount:   NewMainAccountClient(client),
+		Account:          NewAccountClient(client),
+		SecurityGroup:    NewCloudSecurityGroupClient(client),
+		Vpc:              NewVpcClient(client),
+		Subnet:           NewSubnetClient(client),
+		Eip:              NewEipClient(client),
+		Disk:             NewCloudDiskClient(client),
+		Zone:             NewZoneClient(client),
+		Region:           NewRegionClient(client),
+		Cvm:              NewCvmClient(client),
+		Image:            NewCloudPublicClient(client),
+		RouteTable:       NewRouteTableClient(client),
+		InstanceType:     NewInstanceTypeClient(client),
+		Bill:             NewBillClient(client),
+		MainAccount:      NewMainAccountClient(client),
+		Sync:             NewSyncClient(client),
+		LoadBalancer:     NewLoadBalancerClient(client),
+		Snapshot:         NewSnapshotClient(client),
+		KeyPair:          NewKeyPairClient(client),
+		PrivateDns:       NewPrivateDnsClient(client),
+		NatGateway:       NewNatGatewayClient(client),
+		DatabaseInstance: NewDatabaseInstanceClient(client),
+		Bucket:           NewBucketClient(client),
+		K8sCluster:       NewK8sClusterClient(client),
+		VpcPeering:       NewVpcPeeringClient(client),
 	}
 }
@@ -0,0 +1,47 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"net/http"
+
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// NewK8sClusterClient create a new k8s cluster api client.
+func NewK8sClusterClient(client rest.ClientInterface) *K8sClusterClient {
+	return &K8sClusterClient{
+		client: client,
+	}
+}
+
+// K8sClusterClient is hc service k8s cluster api client.
+type K8sClusterClient struct {
+	client rest.ClientInterface
+}
+
+// SyncK8sCluster 同步容器集群
+func (c *K8sClusterClient) SyncK8sCluster(kt *kit.Kit, req *sync.AwsSyncReq) error {
+
+	return common.RequestNoResp[sync.AwsSyncReq](c.client, http.MethodPost, kt, req, "/k8s_clusters/sync")
+}
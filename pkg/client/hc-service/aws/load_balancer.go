@@ -0,0 +1,86 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package aws
+
+import (
+	"net/http"
+
+	hcproto "hcm/pkg/api/hc-service/load-balancer"
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// NewLoadBalancerClient create a new load balancer api client.
+func NewLoadBalancerClient(client rest.ClientInterface) *LoadBalancerClient {
+	return &LoadBalancerClient{
+		client: client,
+	}
+}
+
+// LoadBalancerClient is hc service load balancer api client.
+type LoadBalancerClient struct {
+	client rest.ClientInterface
+}
+
+// SyncLoadBalancer 同步负载均衡
+func (c *LoadBalancerClient) SyncLoadBalancer(kt *kit.Kit, req *sync.AwsSyncReq) error {
+
+	return common.RequestNoResp[sync.AwsSyncReq](c.client, http.MethodPost, kt, req, "/load_balancers/sync")
+}
+
+// BatchDeleteLoadBalancer 批量删除负载均衡
+func (c *LoadBalancerClient) BatchDeleteLoadBalancer(kt *kit.Kit,
+	req *hcproto.AwsBatchDeleteLoadBalancerReq) error {
+
+	return common.RequestNoResp[hcproto.AwsBatchDeleteLoadBalancerReq](
+		c.client, http.MethodDelete, kt, req, "/load_balancers/batch")
+}
+
+// SyncTargetGroup 同步目标组
+func (c *LoadBalancerClient) SyncTargetGroup(kt *kit.Kit, req *sync.AwsSyncReq) error {
+
+	return common.RequestNoResp[sync.AwsSyncReq](c.client, http.MethodPost, kt, req, "/target_groups/sync")
+}
+
+// Create 创建负载均衡
+func (c *LoadBalancerClient) Create(kt *kit.Kit, req *hcproto.AwsLoadBalancerCreateReq) (
+	*hcproto.BatchCreateResult, error) {
+
+	return common.Request[hcproto.AwsLoadBalancerCreateReq, hcproto.BatchCreateResult](
+		c.client, http.MethodPost, kt, req, "/load_balancers/create")
+}
+
+// BatchAddRs 批量注册RS到目标组
+func (c *LoadBalancerClient) BatchAddRs(kt *kit.Kit, targetGroupID string, req *hcproto.AwsBatchOperateTargetReq) (
+	*hcproto.BatchCreateResult, error) {
+
+	return common.Request[hcproto.AwsBatchOperateTargetReq, hcproto.BatchCreateResult](
+		c.client, http.MethodPost, kt, req, "/target_groups/%s/targets/create", targetGroupID)
+}
+
+// BatchRemoveTarget 批量从目标组注销RS
+func (c *LoadBalancerClient) BatchRemoveTarget(kt *kit.Kit, targetGroupID string,
+	req *hcproto.AwsBatchOperateTargetReq) error {
+
+	return common.RequestNoResp[hcproto.AwsBatchOperateTargetReq](
+		c.client, http.MethodDelete, kt, req, "/target_groups/%s/targets/batch", targetGroupID)
+}
@@ -39,6 +39,15 @@ type Client struct {
 	InstanceType     *InstanceTypeClient
 	NetworkInterface *NetworkInterfaceClient
 	Bill             *BillClient
+	LoadBalancer     *LoadBalancerClient
+	Snapshot         *SnapshotClient
+	KeyPair          *KeyPairClient
+	PrivateDns       *PrivateDnsClient
+	NatGateway       *NatGatewayClient
+	DatabaseInstance *DatabaseInstanceClient
+	Bucket           *BucketClient
+	K8sCluster       *K8sClusterClient
+	VpcPeering       *VpcPeeringClient
 }
 
 // NewClient create a new azure api client.
@@ -58,5 +67,14 @@ func NewClient(client rest.ClientInterface) *Client {
 		InstanceType:     NewInstanceTypeClient(client),
 		NetworkInterface: NewNetworkInterfaceClient(client),
 		Bill:             NewBillClient(client),
+		LoadBalancer:     NewLoadBalancerClient(client),
+		Snapshot:         NewSnapshotClient(client),
+		KeyPair:          NewKeyPairClient(client),
+		PrivateDns:       NewPrivateDnsClient(client),
+		NatGateway:       NewNatGatewayClient(client),
+		DatabaseInstance: NewDatabaseInstanceClient(client),
+		Bucket:           NewBucketClient(client),
+		K8sCluster:       NewK8sClusterClient(client),
+		VpcPeering:       NewVpcPeeringClient(client),
 	}
 }
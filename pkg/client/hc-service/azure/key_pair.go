@@ -0,0 +1,62 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	"net/http"
+
+	hckeypair "hcm/pkg/api/hc-service/key-pair"
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// NewKeyPairClient create a new key pair api client.
+func NewKeyPairClient(client rest.ClientInterface) *KeyPairClient {
+	return &KeyPairClient{
+		client: client,
+	}
+}
+
+// KeyPairClient is hc service key pair api client.
+type KeyPairClient struct {
+	client rest.ClientInterface
+}
+
+// SyncKeyPair 同步密钥对
+func (c *KeyPairClient) SyncKeyPair(kt *kit.Kit, req *sync.AzureSyncReq) error {
+
+	return common.RequestNoResp[sync.AzureSyncReq](c.client, http.MethodPost, kt, req, "/key_pairs/sync")
+}
+
+// ImportKeyPair 导入公钥创建密钥对
+func (c *KeyPairClient) ImportKeyPair(kt *kit.Kit, req *hckeypair.KeyPairImportReq) (
+	*hckeypair.KeyPairImportResult, error) {
+
+	return common.Request[hckeypair.KeyPairImportReq, hckeypair.KeyPairImportResult](
+		c.client, http.MethodPost, kt, req, "/key_pairs/import")
+}
+
+// DeleteKeyPair 删除密钥对
+func (c *KeyPairClient) DeleteKeyPair(kt *kit.Kit, req *hckeypair.KeyPairDeleteReq) error {
+
+	return common.RequestNoResp[hckeypair.KeyPairDeleteReq](c.client, http.MethodDelete, kt, req, "/key_pairs")
+}
@@ -0,0 +1,69 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package azure
+
+import (
+	"net/http"
+
+	hcsnapshot "hcm/pkg/api/hc-service/snapshot"
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// NewSnapshotClient create a new snapshot api client.
+func NewSnapshotClient(client rest.ClientInterface) *SnapshotClient {
+	return &SnapshotClient{
+		client: client,
+	}
+}
+
+// SnapshotClient is hc service snapshot api client.
+type SnapshotClient struct {
+	client rest.ClientInterface
+}
+
+// SyncSnapshot 同步快照
+func (c *SnapshotClient) SyncSnapshot(kt *kit.Kit, req *sync.AzureSyncReq) error {
+
+	return common.RequestNoResp[sync.AzureSyncReq](c.client, http.MethodPost, kt, req, "/snapshots/sync")
+}
+
+// CreateSnapshot 基于云盘创建快照
+func (c *SnapshotClient) CreateSnapshot(kt *kit.Kit, req *hcsnapshot.SnapshotCreateReq) (
+	*hcsnapshot.SnapshotCreateResult, error) {
+
+	return common.Request[hcsnapshot.SnapshotCreateReq, hcsnapshot.SnapshotCreateResult](
+		c.client, http.MethodPost, kt, req, "/snapshots/create")
+}
+
+// DeleteSnapshot 删除快照
+func (c *SnapshotClient) DeleteSnapshot(kt *kit.Kit, req *hcsnapshot.SnapshotDeleteReq) error {
+
+	return common.RequestNoResp[hcsnapshot.SnapshotDeleteReq](c.client, http.MethodDelete, kt, req, "/snapshots")
+}
+
+// RollbackSnapshot 快照回滚
+func (c *SnapshotClient) RollbackSnapshot(kt *kit.Kit, req *hcsnapshot.SnapshotRollbackReq) error {
+
+	return common.RequestNoResp[hcsnapshot.SnapshotRollbackReq](c.client, http.MethodPost, kt, req,
+		"/snapshots/rollback")
+}
@@ -26,7 +26,9 @@ import (
 	"hcm/pkg/client/hc-service/azure"
 	"hcm/pkg/client/hc-service/gcp"
 	"hcm/pkg/client/hc-service/huawei"
+	"hcm/pkg/client/hc-service/kaopu"
 	"hcm/pkg/client/hc-service/tcloud"
+	"hcm/pkg/client/hc-service/zenlayer"
 	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/rest"
 	"hcm/pkg/rest/client"
@@ -34,11 +36,13 @@ import (
 
 // Client is hc-service api client.
 type Client struct {
-	TCloud *tcloud.Client
-	Aws    *aws.Client
-	HuaWei *huawei.Client
-	Gcp    *gcp.Client
-	Azure  *azure.Client
+	TCloud   *tcloud.Client
+	Aws      *aws.Client
+	HuaWei   *huawei.Client
+	Gcp      *gcp.Client
+	Azure    *azure.Client
+	Zenlayer *zenlayer.Client
+	Kaopu    *kaopu.Client
 }
 
 // NewClient create a new hc-service api client.
@@ -61,5 +65,11 @@ func NewClient(c *client.Capability, version string) *Client {
 		Azure: azure.NewClient(
 			rest.NewClient(c, fmt.Sprintf("%s/%s", prefixPath, enumor.Azure)),
 		),
+		Zenlayer: zenlayer.NewClient(
+			rest.NewClient(c, fmt.Sprintf("%s/%s", prefixPath, enumor.Zenlayer)),
+		),
+		Kaopu: kaopu.NewClient(
+			rest.NewClient(c, fmt.Sprintf("%s/%s", prefixPath, enumor.Kaopu)),
+		),
 	}
 }
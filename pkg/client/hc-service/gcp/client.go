@@ -40,6 +40,15 @@ type Client struct {
 	NetworkInterface *NetworkInterfaceClient
 	Bill             *BillClient
 	MainAccount      *MainAccountClient
+	LoadBalancer     *LoadBalancerClient
+	Snapshot         *SnapshotClient
+	KeyPair          *KeyPairClient
+	PrivateDns       *PrivateDnsClient
+	NatGateway       *NatGatewayClient
+	DatabaseInstance *DatabaseInstanceClient
+	Bucket           *BucketClient
+	K8sCluster       *K8sClusterClient
+	VpcPeering       *VpcPeeringClient
 }
 
 // NewClient create a new gcp api client.
@@ -60,5 +69,14 @@ func NewClient(client rest.ClientInterface) *Client {
 		NetworkInterface: NewNetworkInterfaceClient(client),
 		Bill:             NewBillClient(client),
 		MainAccount:      NewMainAccountClient(client),
+		LoadBalancer:     NewLoadBalancerClient(client),
+		Snapshot:         NewSnapshotClient(client),
+		KeyPair:          NewKeyPairClient(client),
+		PrivateDns:       NewPrivateDnsClient(client),
+		NatGateway:       NewNatGatewayClient(client),
+		DatabaseInstance: NewDatabaseInstanceClient(client),
+		Bucket:           NewBucketClient(client),
+		K8sCluster:       NewK8sClusterClient(client),
+		VpcPeering:       NewVpcPeeringClient(client),
 	}
 }
@@ -0,0 +1,70 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package gcp
+
+import (
+	"net/http"
+
+	hcprivatedns "hcm/pkg/api/hc-service/private-dns"
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// NewPrivateDnsClient create a new private dns api client.
+func NewPrivateDnsClient(client rest.ClientInterface) *PrivateDnsClient {
+	return &PrivateDnsClient{
+		client: client,
+	}
+}
+
+// PrivateDnsClient is hc service private dns api client.
+type PrivateDnsClient struct {
+	client rest.ClientInterface
+}
+
+// SyncPrivateDnsZone 同步私有域及其解析记录
+func (c *PrivateDnsClient) SyncPrivateDnsZone(kt *kit.Kit, req *sync.GcpGlobalSyncReq) error {
+
+	return common.RequestNoResp[sync.GcpGlobalSyncReq](c.client, http.MethodPost, kt, req, "/private_dns_zones/sync")
+}
+
+// CreateRecord 创建解析记录
+func (c *PrivateDnsClient) CreateRecord(kt *kit.Kit, req *hcprivatedns.RecordCreateReq) (
+	*hcprivatedns.RecordCreateResult, error) {
+
+	return common.Request[hcprivatedns.RecordCreateReq, hcprivatedns.RecordCreateResult](
+		c.client, http.MethodPost, kt, req, "/private_dns_records/create")
+}
+
+// UpdateRecord 修改解析记录
+func (c *PrivateDnsClient) UpdateRecord(kt *kit.Kit, req *hcprivatedns.RecordUpdateReq) error {
+
+	return common.RequestNoResp[hcprivatedns.RecordUpdateReq](c.client, http.MethodPatch, kt, req,
+		"/private_dns_records")
+}
+
+// DeleteRecord 删除解析记录
+func (c *PrivateDnsClient) DeleteRecord(kt *kit.Kit, req *hcprivatedns.RecordDeleteReq) error {
+
+	return common.RequestNoResp[hcprivatedns.RecordDeleteReq](c.client, http.MethodDelete, kt, req,
+		"/private_dns_records")
+}
@@ -25,44 +25,60 @@ import (
 
 // Client is a tcloud api client
 type Client struct {
-	Account       *AccountClient
-	Subnet        *SubnetClient
-	SecurityGroup *SecurityGroupClient
-	Vpc           *VpcClient
-	Eip           *EipClient
-	Disk          *DiskClient
-	Zone          *ZoneClient
-	Region        *RegionClient
-	Cvm           *CvmClient
-	Image         *ImageClient
-	RouteTable    *RouteTableClient
-	InstanceType  *InstanceTypeClient
-	Bill          *BillClient
-	ArgsTpl       *ArgsTplClient
-	Cert          *CertClient
-	Clb           *ClbClient
-	BandPkg       *BandwidthPackageClient
+	Account          *AccountClient
+	Subnet           *SubnetClient
+	SecurityGroup    *SecurityGroupClient
+	Vpc              *VpcClient
+	Eip              *EipClient
+	Disk             *DiskClient
+	Zone             *ZoneClient
+	Region           *RegionClient
+	Cvm              *CvmClient
+	Image            *ImageClient
+	RouteTable       *RouteTableClient
+	InstanceType     *InstanceTypeClient
+	Bill             *BillClient
+	ArgsTpl          *ArgsTplClient
+	Cert             *CertClient
+	Clb              *ClbClient
+	BandPkg          *BandwidthPackageClient
+	Snapshot         *SnapshotClient
+	KeyPair          *KeyPairClient
+	PrivateDns       *PrivateDnsClient
+	NatGateway       *NatGatewayClient
+	DatabaseInstance *DatabaseInstanceClient
+	Bucket           *BucketClient
+	K8sCluster       *K8sClusterClient
+	VpcPeering       *VpcPeeringClient
 }
 
 // NewClient create a new tcloud api client.
 func NewClient(client rest.ClientInterface) *Client {
 	return &Client{
-		Account:       NewAccountClient(client),
-		SecurityGroup: NewCloudSecurityGroupClient(client),
-		Subnet:        NewSubnetClient(client),
-		Vpc:           NewVpcClient(client),
-		Eip:           NewEipClient(client),
-		Disk:          NewCloudDiskClient(client),
-		Zone:          NewZoneClient(client),
-		Region:        NewRegionClient(client),
-		Cvm:           NewCvmClient(client),
-		Image:         NewCloudPublicClient(client),
-		RouteTable:    NewRouteTableClient(client),
-		InstanceType:  NewInstanceTypeClient(client),
-		Bill:          NewBillClient(client),
-		ArgsTpl:       NewArgsTplClient(client),
-		Cert:          NewCertClient(client),
-		Clb:           NewClbClient(client),
-		BandPkg:       NewBandPkgClient(client),
+		Account:          NewAccountClient(client),
+		SecurityGroup:    NewCloudSecurityGroupClient(client),
+		Subnet:           NewSubnetClient(client),
+		Vpc:              NewVpcClient(client),
+		Eip:              NewEipClient(client),
+		Disk:             NewCloudDiskClient(client),
+		Zone:             NewZoneClient(client),
+		Region:           NewRegionClient(client),
+		Cvm:              NewCvmClient(client),
+		Image:            NewCloudPublicClient(client),
+		RouteTable:       NewRouteTableClient(client),
+		InstanceType:     NewInstanceTypeClient(client),
+		Bill:             NewBillClient(client),
+		ArgsTpl:          NewArgsTplClient(client),
+		Cert:             NewCertClient(client),
+		Clb:              NewClbClient(client),
+		BandPkg:          NewBandPkgClient(client),
+		Snapshot:         NewSnapshotClient(client),
+		KeyPair:          NewKeyPairClient(client),
+		PrivateDns:       NewPrivateDnsClient(client),
+		NatGateway:       NewNatGatewayClient(client),
+		DatabaseInstance: NewDatabaseInstanceClient(client),
+		Bucket:           NewBucketClient(client),
+		K8sCluster:       NewK8sClusterClient(client),
+		VpcPeering:       NewVpcPeeringClient(client),
 	}
 }
@@ -0,0 +1,55 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tcloud
+
+import (
+	"net/http"
+
+	hcdbinstance "hcm/pkg/api/hc-service/database-instance"
+	"hcm/pkg/api/hc-service/sync"
+	"hcm/pkg/client/common"
+	"hcm/pkg/kit"
+	"hcm/pkg/rest"
+)
+
+// NewDatabaseInstanceClient create a new database instance api client.
+func NewDatabaseInstanceClient(client rest.ClientInterface) *DatabaseInstanceClient {
+	return &DatabaseInstanceClient{
+		client: client,
+	}
+}
+
+// DatabaseInstanceClient is hc service database instance api client.
+type DatabaseInstanceClient struct {
+	client rest.ClientInterface
+}
+
+// SyncDatabaseInstance 同步云数据库实例
+func (c *DatabaseInstanceClient) SyncDatabaseInstance(kt *kit.Kit, req *sync.TCloudSyncReq) error {
+
+	return common.RequestNoResp[sync.TCloudSyncReq](c.client, http.MethodPost, kt, req, "/database_instances/sync")
+}
+
+// DeleteDatabaseInstance 删除云数据库实例
+func (c *DatabaseInstanceClient) DeleteDatabaseInstance(kt *kit.Kit, req *hcdbinstance.DatabaseInstanceDeleteReq) error {
+
+	return common.RequestNoResp[hcdbinstance.DatabaseInstanceDeleteReq](c.client, http.MethodDelete, kt, req,
+		"/database_instances")
+}
@@ -122,6 +122,24 @@ func (c *Client) GetFlow(kt *kit.Kit, id string) (*coreasync.AsyncFlow, error) {
 	return resp.Data, err
 }
 
+// GetFlowDAG get flow dag.
+func (c *Client) GetFlowDAG(kt *kit.Kit, id string) (*coreasync.FlowDAG, error) {
+	resp := new(core.BaseResp[*coreasync.FlowDAG])
+
+	err := c.client.Get().
+		WithContext(kt.Ctx).
+		SubResourcef("/flows/%s/dag", id).
+		WithHeaders(kt.Header()).
+		Do().
+		Into(resp)
+
+	if resp.Code != errf.OK {
+		return nil, errf.New(resp.Code, resp.Message)
+	}
+
+	return resp.Data, err
+}
+
 // ListTask list task.
 func (c *Client) ListTask(kt *kit.Kit, req *core.ListReq) (*apits.ListTaskResult, error) {
 	resp := new(core.BaseResp[*apits.ListTaskResult])
@@ -183,3 +201,32 @@ func (c *Client) RetryTask(kt *kit.Kit, flowID string, taskID string) error {
 	return common.RequestNoResp[common.Empty](c.client, rest.PATCH, kt, nil,
 		"/flows/%s/tasks/%s/retry", flowID, taskID)
 }
+
+// ReplayDeadLetterTasks 重放死信任务
+func (c *Client) ReplayDeadLetterTasks(kt *kit.Kit, req *producer.ReplayDeadLetterTasksOption) (
+	*core.BatchOperateAllResult, error) {
+
+	return common.Request[producer.ReplayDeadLetterTasksOption, core.BatchOperateAllResult](c.client, rest.POST, kt,
+		req, "/tasks/dead_letter/replay")
+}
+
+// CreateCronFlow 创建周期任务流
+func (c *Client) CreateCronFlow(kt *kit.Kit, req *producer.AddCronFlowOption) (*core.CreateResult, error) {
+	return common.Request[producer.AddCronFlowOption, core.CreateResult](c.client, rest.POST, kt, req,
+		"/cron_flows/create")
+}
+
+// UpdateCronFlow 更新周期任务流
+func (c *Client) UpdateCronFlow(kt *kit.Kit, id string, req *producer.UpdateCronFlowOption) error {
+	return common.RequestNoResp[producer.UpdateCronFlowOption](c.client, rest.PATCH, kt, req, "/cron_flows/%s", id)
+}
+
+// DeleteCronFlow 删除周期任务流
+func (c *Client) DeleteCronFlow(kt *kit.Kit, id string) error {
+	return common.RequestNoResp[common.Empty](c.client, rest.DELETE, kt, nil, "/cron_flows/%s", id)
+}
+
+// ListCronFlow 查询周期任务流
+func (c *Client) ListCronFlow(kt *kit.Kit, req *core.ListReq) (*apits.ListCronFlowResult, error) {
+	return common.Request[core.ListReq, apits.ListCronFlowResult](c.client, rest.POST, kt, req, "/cron_flows/list")
+}
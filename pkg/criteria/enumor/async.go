@@ -39,6 +39,14 @@ const (
 	TaskSuccess TaskState = "success"
 	// TaskFailed task state is failed
 	TaskFailed TaskState = "failed"
+	// TaskDeadLetter task state is dead_letter（自动重试次数耗尽，保留最后一次失败原因，可以通过重放接口重新执行）
+	TaskDeadLetter TaskState = "dead_letter"
+	// TaskCompensating task state is compensating（任务流失败或取消后，正在对执行成功的任务进行补偿）
+	TaskCompensating TaskState = "compensating"
+	// TaskCompensated task state is compensated
+	TaskCompensated TaskState = "compensated"
+	// TaskCompensateFailed task state is compensate_failed
+	TaskCompensateFailed TaskState = "compensate_failed"
 )
 
 // FlowState is flow state.
@@ -59,6 +67,8 @@ const (
 	FlowSuccess FlowState = "success"
 	// FlowFailed flow state is failed
 	FlowFailed FlowState = "failed"
+	// FlowCompensating flow state is compensating（补偿完成后恢复为补偿前的失败或取消状态）
+	FlowCompensating FlowState = "compensating"
 )
 
 // BackendType is backend type.
@@ -68,6 +78,7 @@ type BackendType string
 func (v BackendType) Validate() error {
 	switch v {
 	case BackendMysql:
+	case BackendMemory:
 	default:
 		return fmt.Errorf("unsupported backend type: %s", v)
 	}
@@ -78,4 +89,6 @@ func (v BackendType) Validate() error {
 const (
 	// BackendMysql mysql backend
 	BackendMysql BackendType = "mysql"
+	// BackendMemory memory backend, 数据不持久化，仅用于测试
+	BackendMemory BackendType = "memory"
 )
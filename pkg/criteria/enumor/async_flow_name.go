@@ -58,6 +58,9 @@ var defaultFlowNameMap = map[FlowName]struct{}{
 	FlowBillDailySummary:       {},
 	FlowBillMainAccountSummary: {},
 	FlowBillRootAccountSummary: {},
+	FlowExportBillItem:         {},
+	FlowExecuteSnapshotPolicy:  {},
+	FlowDeletePrivateDnsRecord: {},
 }
 
 // ValidateDefault validate default FlowName.
@@ -153,4 +156,16 @@ const (
 	FlowBillDailySummary       FlowName = "bill_daily_summary"
 	FlowBillMainAccountSummary FlowName = "bill_main_account_summary"
 	FlowBillRootAccountSummary FlowName = "bill_root_account_summary"
+	FlowExportBillItem         FlowName = "bill_export_item"
+)
+
+// 快照相关Flow
+const (
+	// FlowExecuteSnapshotPolicy 周期执行快照策略，创建快照并清理过期快照
+	FlowExecuteSnapshotPolicy FlowName = "execute_snapshot_policy"
+)
+
+// 私有域相关Flow
+const (
+	FlowDeletePrivateDnsRecord FlowName = "delete_private_dns_record"
 )
@@ -37,13 +37,15 @@ func (v ActionName) Validate() error {
 	case ActionDeleteEIP:
 
 	case VirRoot:
-	case ActionCreateFactoryTest, ActionProduceTest, ActionAssembleTest, ActionSleep:
+	case ActionCreateFactoryTest, ActionProduceTest, ActionAssembleTest, ActionSleep, ActionFailTest:
 	case ActionTargetGroupAddRS, ActionTargetGroupRemoveRS, ActionTargetGroupModifyPort, ActionTargetGroupModifyWeight:
 	case ActionLoadBalancerOperateWatch:
 	case ActionListenerRuleAddTarget:
 	case ActionDeleteLoadBalancer:
 	case ActionPullDailyRawBill, ActionMainAccountSummary, ActionRootAccountSummary,
-		ActionDailyAccountSplit, ActionDailyAccountSummary:
+		ActionDailyAccountSplit, ActionDailyAccountSummary, ActionExportBillItem:
+	case ActionCreatePolicySnapshot, ActionCleanExpiredSnapshot:
+	case ActionDeletePrivateDnsRecord, ActionRegisterCvmDnsRecord:
 	default:
 		return fmt.Errorf("unsupported action name type: %s", v)
 	}
@@ -85,6 +87,7 @@ const (
 	ActionProduceTest       ActionName = "produce"
 	ActionAssembleTest      ActionName = "assemble"
 	ActionSleep             ActionName = "sleep"
+	ActionFailTest          ActionName = "fail"
 )
 
 // Security Group
@@ -124,4 +127,18 @@ const (
 	ActionMainAccountSummary  = "bill_main_account_summary"
 	ActionDailyAccountSplit   = "bill_daily_account_split"
 	ActionDailyAccountSummary = "bill_daily_account_summary"
+	ActionExportBillItem      = "bill_export_item"
+)
+
+// 快照相关Action
+const (
+	ActionCreatePolicySnapshot ActionName = "create_policy_snapshot"
+	ActionCleanExpiredSnapshot ActionName = "clean_expired_snapshot"
+)
+
+// 私有域相关Action
+const (
+	ActionDeletePrivateDnsRecord ActionName = "delete_private_dns_record"
+	// ActionRegisterCvmDnsRecord 为新创建的主机注册私有域A记录
+	ActionRegisterCvmDnsRecord ActionName = "register_cvm_dns_record"
 )
@@ -35,9 +35,17 @@ const (
 	VpcCloudAuditResType          AuditResourceType = "vpc"
 	SubnetAuditResType            AuditResourceType = "subnet"
 	DiskAuditResType              AuditResourceType = "disk"
+	SnapshotAuditResType          AuditResourceType = "snapshot"
 	CvmAuditResType               AuditResourceType = "cvm"
 	RouteTableAuditResType        AuditResourceType = "route_table"
 	RouteAuditResType             AuditResourceType = "route"
+	NatGatewayAuditResType        AuditResourceType = "nat_gateway"
+	VpcPeeringAuditResType        AuditResourceType = "vpc_peering"
+	DatabaseInstanceAuditResType  AuditResourceType = "database_instance"
+	BucketAuditResType            AuditResourceType = "bucket"
+	K8sClusterAuditResType        AuditResourceType = "k8s_cluster"
+	KeyPairAuditResType           AuditResourceType = "key_pair"
+	PrivateDnsZoneAuditResType    AuditResourceType = "private_dns_zone"
 	EipAuditResType               AuditResourceType = "eip"
 	GcpFirewallRuleAuditResType   AuditResourceType = "gcp_firewall_rule"
 	NetworkInterfaceAuditResType  AuditResourceType = "network_interface"
@@ -61,8 +69,16 @@ var AuditResourceTypeEnums = map[AuditResourceType]struct{}{
 	VpcCloudAuditResType:          {},
 	SubnetAuditResType:            {},
 	DiskAuditResType:              {},
+	SnapshotAuditResType:          {},
 	CvmAuditResType:               {},
 	RouteTableAuditResType:        {},
+	NatGatewayAuditResType:        {},
+	VpcPeeringAuditResType:        {},
+	DatabaseInstanceAuditResType:  {},
+	BucketAuditResType:            {},
+	K8sClusterAuditResType:        {},
+	KeyPairAuditResType:           {},
+	PrivateDnsZoneAuditResType:    {},
 	EipAuditResType:               {},
 	GcpFirewallRuleAuditResType:   {},
 	NetworkInterfaceAuditResType:  {},
@@ -105,3 +105,111 @@ const (
 	// BillAdjustmentStateUnconfirmed 未确认
 	BillAdjustmentStateUnconfirmed BillAdjustmentState = "unconfirmed"
 )
+
+// BillExportFormat 账单导出文件格式
+type BillExportFormat string
+
+// Validate the BillExportFormat is valid or not
+func (b BillExportFormat) Validate() error {
+	switch b {
+	case BillExportCSV, BillExportParquet:
+	default:
+		return fmt.Errorf("unsupported bill export format: %s", b)
+	}
+	return nil
+}
+
+const (
+	// BillExportCSV csv格式
+	BillExportCSV BillExportFormat = "csv"
+	// BillExportParquet parquet格式
+	BillExportParquet BillExportFormat = "parquet"
+)
+
+// BillSplitType 分账规则的分摊方式
+type BillSplitType string
+
+// Validate the BillSplitType is valid or not
+func (b BillSplitType) Validate() error {
+	switch b {
+	case BillSplitFixedRatio, BillSplitCvmCount, BillSplitUsage:
+	default:
+		return fmt.Errorf("unsupported bill split type: %s", b)
+	}
+	return nil
+}
+
+const (
+	// BillSplitFixedRatio 按固定比例分摊
+	BillSplitFixedRatio BillSplitType = "fixed_ratio"
+	// BillSplitCvmCount 按各业务的主机数量分摊
+	BillSplitCvmCount BillSplitType = "cvm_count"
+	// BillSplitUsage 按各业务资源的用量分摊
+	BillSplitUsage BillSplitType = "usage"
+)
+
+// BillBudgetScope 预算的作用范围
+type BillBudgetScope string
+
+// Validate the BillBudgetScope is valid or not
+func (b BillBudgetScope) Validate() error {
+	switch b {
+	case BillBudgetScopeBiz, BillBudgetScopeMainAccount, BillBudgetScopeRootAccount, BillBudgetScopeProduct:
+	default:
+		return fmt.Errorf("unsupported bill budget scope: %s", b)
+	}
+	return nil
+}
+
+const (
+	// BillBudgetScopeBiz 业务
+	BillBudgetScopeBiz BillBudgetScope = "biz"
+	// BillBudgetScopeMainAccount 二级账号
+	BillBudgetScopeMainAccount BillBudgetScope = "main_account"
+	// BillBudgetScopeRootAccount 一级账号
+	BillBudgetScopeRootAccount BillBudgetScope = "root_account"
+	// BillBudgetScopeProduct 运营产品
+	BillBudgetScopeProduct BillBudgetScope = "product"
+)
+
+// BillBudgetAlertType 预算告警类型
+type BillBudgetAlertType string
+
+// Validate the BillBudgetAlertType is valid or not
+func (b BillBudgetAlertType) Validate() error {
+	switch b {
+	case BillBudgetAlertActual, BillBudgetAlertForecast:
+	default:
+		return fmt.Errorf("unsupported bill budget alert type: %s", b)
+	}
+	return nil
+}
+
+const (
+	// BillBudgetAlertActual 按本月实际费用告警
+	BillBudgetAlertActual BillBudgetAlertType = "actual"
+	// BillBudgetAlertForecast 按本月预测费用告警
+	BillBudgetAlertForecast BillBudgetAlertType = "forecast"
+)
+
+// BillBudgetNotifyState 预算告警通知状态
+type BillBudgetNotifyState string
+
+// Validate the BillBudgetNotifyState is valid or not
+func (b BillBudgetNotifyState) Validate() error {
+	switch b {
+	case BillBudgetNotifyPending, BillBudgetNotifySuccess, BillBudgetNotifyFailed:
+	default:
+		return fmt.Errorf("unsupported bill budget notify state: %s", b)
+	}
+	return nil
+}
+
+const (
+	// BillBudgetNotifyPending 待通知，包括通知失败但未达到最大重试次数
+	BillBudgetNotifyPending BillBudgetNotifyState = "pending"
+	// BillBudgetNotifySuccess 通知成功
+	BillBudgetNotifySuccess BillBudgetNotifyState = "success"
+	// BillBudgetNotifyFailed 达到最大重试次数后仍通知失败
+	BillBudgetNotifyFailed BillBudgetNotifyState = "failed"
+)
@@ -0,0 +1,50 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package enumor
+
+import "fmt"
+
+// BucketPublicAccess 存储桶公共访问权限
+type BucketPublicAccess string
+
+// Validate BucketPublicAccess.
+func (a BucketPublicAccess) Validate() error {
+	switch a {
+	case BucketPrivate, BucketPublicRead, BucketPublicReadWrite:
+	default:
+		return fmt.Errorf("unsupported bucket public access: %s", a)
+	}
+
+	return nil
+}
+
+// IsPublicReadable 是否允许公共读
+func (a BucketPublicAccess) IsPublicReadable() bool {
+	return a == BucketPublicRead || a == BucketPublicReadWrite
+}
+
+const (
+	// BucketPrivate 私有读写
+	BucketPrivate BucketPublicAccess = "private"
+	// BucketPublicRead 公有读私有写
+	BucketPublicRead BucketPublicAccess = "public_read"
+	// BucketPublicReadWrite 公有读写
+	BucketPublicReadWrite BucketPublicAccess = "public_read_write"
+)
@@ -37,8 +37,17 @@ var typeMapping = map[CloudResourceType]table.Name{
 	SubnetCloudResType:           table.SubnetTable,
 	EipCloudResType:              table.EipTable,
 	DiskCloudResType:             table.DiskTable,
+	SnapshotCloudResType:         table.SnapshotTable,
 	CvmCloudResType:              table.CvmTable,
 	RouteTableCloudResType:       table.RouteTableTable,
+	NatGatewayCloudResType:       table.NatGatewayTable,
+	VpcPeeringCloudResType:       table.VpcPeeringTable,
+	DatabaseInstanceCloudResType: table.DatabaseInstanceTable,
+	BucketCloudResType:           table.BucketTable,
+	K8sClusterCloudResType:       table.K8sClusterTable,
+	K8sNodePoolCloudResType:      table.K8sNodePoolTable,
+	KeyPairCloudResType:          table.KeyPairTable,
+	PrivateDnsZoneCloudResType:   table.PrivateDnsZoneTable,
 	NetworkInterfaceCloudResType: table.NetworkInterfaceTable,
 	ZoneCloudResType:             table.ZoneTable,
 	AzureResourceGroup:           table.AzureRGTable,
@@ -75,8 +84,17 @@ const (
 	EipCloudResType              CloudResourceType = "eip"
 	CvmCloudResType              CloudResourceType = "cvm"
 	DiskCloudResType             CloudResourceType = "disk"
+	SnapshotCloudResType         CloudResourceType = "snapshot"
 	RouteTableCloudResType       CloudResourceType = "route_table"
 	RouteCloudResType            CloudResourceType = "route"
+	NatGatewayCloudResType       CloudResourceType = "nat_gateway"
+	VpcPeeringCloudResType       CloudResourceType = "vpc_peering"
+	DatabaseInstanceCloudResType CloudResourceType = "database_instance"
+	BucketCloudResType           CloudResourceType = "bucket"
+	K8sClusterCloudResType       CloudResourceType = "k8s_cluster"
+	K8sNodePoolCloudResType      CloudResourceType = "k8s_node_pool"
+	KeyPairCloudResType          CloudResourceType = "key_pair"
+	PrivateDnsZoneCloudResType   CloudResourceType = "private_dns_zone"
 	NetworkInterfaceCloudResType CloudResourceType = "network_interface"
 	RegionCloudResType           CloudResourceType = "region"
 	ImageCloudResType            CloudResourceType = "image"
@@ -0,0 +1,53 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package enumor
+
+import "fmt"
+
+// PrivateDnsRecordType 私有域解析记录类型
+type PrivateDnsRecordType string
+
+// Validate PrivateDnsRecordType.
+func (t PrivateDnsRecordType) Validate() error {
+	switch t {
+	case PrivateDnsRecordA, PrivateDnsRecordAAAA, PrivateDnsRecordCNAME, PrivateDnsRecordMX, PrivateDnsRecordTXT,
+		PrivateDnsRecordPTR, PrivateDnsRecordSRV:
+	default:
+		return fmt.Errorf("unsupported private dns record type: %s", t)
+	}
+
+	return nil
+}
+
+const (
+	// PrivateDnsRecordA A记录
+	PrivateDnsRecordA PrivateDnsRecordType = "A"
+	// PrivateDnsRecordAAAA AAAA记录
+	PrivateDnsRecordAAAA PrivateDnsRecordType = "AAAA"
+	// PrivateDnsRecordCNAME CNAME记录
+	PrivateDnsRecordCNAME PrivateDnsRecordType = "CNAME"
+	// PrivateDnsRecordMX MX记录
+	PrivateDnsRecordMX PrivateDnsRecordType = "MX"
+	// PrivateDnsRecordTXT TXT记录
+	PrivateDnsRecordTXT PrivateDnsRecordType = "TXT"
+	// PrivateDnsRecordPTR PTR记录
+	PrivateDnsRecordPTR PrivateDnsRecordType = "PTR"
+	// PrivateDnsRecordSRV SRV记录
+	PrivateDnsRecordSRV PrivateDnsRecordType = "SRV"
+)
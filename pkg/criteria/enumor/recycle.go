@@ -42,8 +42,9 @@ const (
 
 // RecycleAuditResTypeMap recycle resource audit type to cloud resource type map.
 var RecycleAuditResTypeMap = map[AuditResourceType]CloudResourceType{
-	CvmAuditResType:  CvmCloudResType,
-	DiskAuditResType: DiskCloudResType,
+	CvmAuditResType:              CvmCloudResType,
+	DiskAuditResType:             DiskCloudResType,
+	DatabaseInstanceAuditResType: DatabaseInstanceCloudResType,
 }
 
 // RecycleType 回收类型
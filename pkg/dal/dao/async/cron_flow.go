@@ -0,0 +1,222 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package daoasync
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/errf"
+	idgenerator "hcm/pkg/dal/dao/id-generator"
+	"hcm/pkg/dal/dao/orm"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/dal/dao/types"
+	typesasync "hcm/pkg/dal/dao/types/async"
+	"hcm/pkg/dal/table"
+	tableasync "hcm/pkg/dal/table/async"
+	"hcm/pkg/dal/table/utils"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/runtime/filter"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// AsyncCronFlow only used async cron flow.
+type AsyncCronFlow interface {
+	Create(kt *kit.Kit, tx *sqlx.Tx, model *tableasync.AsyncCronFlowTable) (string, error)
+	UpdateByIDWithTx(kt *kit.Kit, tx *sqlx.Tx, id string, model *tableasync.AsyncCronFlowTable) error
+	UpdateNextRunByCAS(kt *kit.Kit, tx *sqlx.Tx, info *typesasync.UpdateCronNextRunInfo) error
+	List(kt *kit.Kit, opt *types.ListOption) (*typesasync.ListAsyncCronFlows, error)
+	DeleteWithTx(kt *kit.Kit, tx *sqlx.Tx, expr *filter.Expression) error
+}
+
+var _ AsyncCronFlow = new(AsyncCronFlowDao)
+
+// AsyncCronFlowDao async cron flow dao.
+type AsyncCronFlowDao struct {
+	Orm   orm.Interface
+	IDGen idgenerator.IDGenInterface
+}
+
+// Create async cron flow.
+func (dao *AsyncCronFlowDao) Create(kt *kit.Kit, tx *sqlx.Tx, model *tableasync.AsyncCronFlowTable) (string,
+	error) {
+
+	id, err := dao.IDGen.One(kt, table.AsyncCronFlowTable)
+	if err != nil {
+		return "", err
+	}
+	model.ID = id
+
+	if err = model.InsertValidate(); err != nil {
+		return "", err
+	}
+
+	sql := fmt.Sprintf(`INSERT INTO %s (%s)	VALUES(%s)`, table.AsyncCronFlowTable,
+		tableasync.AsyncCronFlowColumns.ColumnExpr(), tableasync.AsyncCronFlowColumns.ColonNameExpr())
+
+	if err = dao.Orm.Txn(tx).BulkInsert(kt.Ctx, sql, model); err != nil {
+		logs.Errorf("insert %s failed, err: %v, sql: %s, rid: %s", table.AsyncCronFlowTable, err, sql, kt.Rid)
+		return "", fmt.Errorf("insert %s failed, err: %v", table.AsyncCronFlowTable, err)
+	}
+
+	return id, nil
+}
+
+// UpdateByIDWithTx async cron flow.
+func (dao *AsyncCronFlowDao) UpdateByIDWithTx(kt *kit.Kit, tx *sqlx.Tx, id string,
+	model *tableasync.AsyncCronFlowTable) error {
+
+	if len(id) == 0 {
+		return errf.New(errf.InvalidParameter, "id is required")
+	}
+
+	if err := model.UpdateValidate(); err != nil {
+		return err
+	}
+
+	opts := utils.NewFieldOptions().AddIgnoredFields(types.DefaultIgnoredFields...)
+	setExpr, toUpdate, err := utils.RearrangeSQLDataWithOption(model, opts)
+	if err != nil {
+		return fmt.Errorf("prepare parsed sql set filter expr failed, err: %v", err)
+	}
+
+	sql := fmt.Sprintf(`UPDATE %s %s where id = :id`, model.TableName(), setExpr)
+
+	toUpdate["id"] = id
+	effected, err := dao.Orm.Txn(tx).Update(kt.Ctx, sql, toUpdate)
+	if err != nil {
+		logs.Errorf("update async cron flow failed, err: %v, id: %s, sql: %s, rid: %v", err, id, sql, kt.Rid)
+		return err
+	}
+
+	if effected == 0 {
+		return errf.New(errf.RecordNotUpdate, "record not update")
+	}
+
+	return nil
+}
+
+// UpdateNextRunByCAS update async cron flow next run time by CAS.
+func (dao *AsyncCronFlowDao) UpdateNextRunByCAS(kt *kit.Kit, tx *sqlx.Tx,
+	info *typesasync.UpdateCronNextRunInfo) error {
+
+	if err := info.Validate(); err != nil {
+		return err
+	}
+
+	setSql := "set next_run_at = :next_run_at"
+	if len(info.LastFlowID) != 0 {
+		setSql += ", last_flow_id = :last_flow_id"
+	}
+
+	sql := fmt.Sprintf(`update %s %s where id = :id and next_run_at = :pre_run_at%s`, table.AsyncCronFlowTable,
+		setSql, fencingTokenExpr(info.FencingToken))
+
+	whereValue := map[string]interface{}{
+		"id":            info.ID,
+		"pre_run_at":    info.PreRunAt,
+		"next_run_at":   info.NextRunAt,
+		"last_flow_id":  info.LastFlowID,
+		"fence_id":      tableasync.LeaderFenceTaskServer,
+		"fencing_token": info.FencingToken,
+	}
+	effected, err := dao.Orm.Txn(tx).Update(kt.Ctx, sql, whereValue)
+	if err != nil {
+		logs.Errorf("update async cron flow next run failed, err: %v, id: %s, sql: %s, rid: %v", err, info.ID,
+			sql, kt.Rid)
+		return err
+	}
+
+	if effected == 0 {
+		return errf.Newf(errf.RecordNotUpdate, "cron flow[%s] update next_run_at: `%s`->`%s` failed", info.ID,
+			info.PreRunAt, info.NextRunAt)
+	}
+
+	return nil
+}
+
+// List async cron flow.
+func (dao *AsyncCronFlowDao) List(kt *kit.Kit, opt *types.ListOption) (*typesasync.ListAsyncCronFlows, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "list async cron flow options is nil")
+	}
+
+	if err := opt.Validate(filter.NewExprOption(filter.RuleFields(tableasync.AsyncCronFlowColumns.ColumnTypes())),
+		core.NewDefaultPageOption()); err != nil {
+		return nil, err
+	}
+
+	whereExpr, whereValue, err := opt.Filter.SQLWhereExpr(tools.DefaultSqlWhereOption)
+	if err != nil {
+		return nil, err
+	}
+
+	if opt.Page.Count {
+		// this is dao count request, then do count operation only.
+		sql := fmt.Sprintf(`SELECT COUNT(*) FROM %s %s`, table.AsyncCronFlowTable, whereExpr)
+
+		count, err := dao.Orm.Do().Count(kt.Ctx, sql, whereValue)
+		if err != nil {
+			logs.ErrorJson("count async cron flow failed, err: %v, filter: %s, rid: %s", err, opt.Filter, kt.Rid)
+			return nil, err
+		}
+
+		return &typesasync.ListAsyncCronFlows{Count: count}, nil
+	}
+
+	pageExpr, err := types.PageSQLExpr(opt.Page, types.DefaultPageSQLOption)
+	if err != nil {
+		return nil, err
+	}
+
+	sql := fmt.Sprintf(`SELECT %s FROM %s %s %s`, tableasync.AsyncCronFlowColumns.FieldsNamedExpr(opt.Fields),
+		table.AsyncCronFlowTable, whereExpr, pageExpr)
+
+	details := make([]tableasync.AsyncCronFlowTable, 0)
+	if err = dao.Orm.Do().Select(kt.Ctx, &details, sql, whereValue); err != nil {
+		logs.ErrorJson("select async cron flow failed, err: %v, sql: %s, filter: %v, rid: %s", err, sql,
+			opt.Filter, kt.Rid)
+		return nil, err
+	}
+
+	return &typesasync.ListAsyncCronFlows{Count: 0, Details: details}, nil
+}
+
+// DeleteWithTx async cron flow with tx.
+func (dao *AsyncCronFlowDao) DeleteWithTx(kt *kit.Kit, tx *sqlx.Tx, filterExpr *filter.Expression) error {
+	if filterExpr == nil {
+		return errf.New(errf.InvalidParameter, "filter expr is required")
+	}
+
+	whereExpr, whereValue, err := filterExpr.SQLWhereExpr(tools.DefaultSqlWhereOption)
+	if err != nil {
+		return err
+	}
+
+	sql := fmt.Sprintf(`DELETE FROM %s %s`, table.AsyncCronFlowTable, whereExpr)
+	if _, err = dao.Orm.Txn(tx).Delete(kt.Ctx, sql, whereValue); err != nil {
+		logs.ErrorJson("delete async cron flow failed, err: %v, filter: %s, rid: %s", err, filterExpr, kt.Rid)
+		return err
+	}
+
+	return nil
+}
@@ -178,14 +178,17 @@ func (dao *AsyncFlowDao) UpdateStateByCAS(kt *kit.Kit, tx *sqlx.Tx, info *typesa
 		setSql += ", reason = :reason"
 	}
 
-	sql := fmt.Sprintf(`update %s %s where id = :id and state = :source`, table.AsyncFlowTable, setSql)
+	sql := fmt.Sprintf(`update %s %s where id = :id and state = :source%s`, table.AsyncFlowTable, setSql,
+		fencingTokenExpr(info.FencingToken))
 
 	whereValue := map[string]interface{}{
-		"id":     info.ID,
-		"source": info.Source,
-		"target": info.Target,
-		"worker": info.Worker,
-		"reason": info.Reason,
+		"id":            info.ID,
+		"source":        info.Source,
+		"target":        info.Target,
+		"worker":        info.Worker,
+		"reason":        info.Reason,
+		"fence_id":      tableasync.LeaderFenceTaskServer,
+		"fencing_token": info.FencingToken,
 	}
 	effected, err := dao.Orm.Txn(tx).Update(kt.Ctx, sql, whereValue)
 	if err != nil {
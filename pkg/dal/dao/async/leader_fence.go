@@ -0,0 +1,87 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package daoasync
+
+import (
+	"fmt"
+
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/orm"
+	"hcm/pkg/dal/table"
+	tableasync "hcm/pkg/dal/table/async"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+)
+
+// AsyncLeaderFence only used async leader fence.
+type AsyncLeaderFence interface {
+	Advance(kt *kit.Kit, token uint64) error
+}
+
+var _ AsyncLeaderFence = new(AsyncLeaderFenceDao)
+
+// AsyncLeaderFenceDao async leader fence dao.
+type AsyncLeaderFenceDao struct {
+	Orm orm.Interface
+}
+
+// Advance 注册主节点屏障令牌，只会增大已记录的令牌，已记录的令牌比当前令牌大时返回错误。
+func (dao *AsyncLeaderFenceDao) Advance(kt *kit.Kit, token uint64) error {
+	if token == 0 {
+		return errf.New(errf.InvalidParameter, "fencing token is required")
+	}
+
+	sql := fmt.Sprintf(`INSERT INTO %s (id, token) VALUES(:id, :token) ON DUPLICATE KEY UPDATE `+
+		`token = GREATEST(token, VALUES(token))`, table.AsyncLeaderFenceTable)
+	args := map[string]interface{}{
+		"id":    tableasync.LeaderFenceTaskServer,
+		"token": token,
+	}
+	if err := dao.Orm.Do().Insert(kt.Ctx, sql, args); err != nil {
+		logs.Errorf("advance async leader fence failed, err: %v, token: %d, rid: %s", err, token, kt.Rid)
+		return err
+	}
+
+	sql = fmt.Sprintf(`SELECT id, token, updated_at FROM %s WHERE id = :id`, table.AsyncLeaderFenceTable)
+	details := make([]tableasync.AsyncLeaderFenceTable, 0)
+	if err := dao.Orm.Do().Select(kt.Ctx, &details, sql, map[string]interface{}{"id": args["id"]}); err != nil {
+		logs.Errorf("select async leader fence failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	if len(details) == 0 {
+		return fmt.Errorf("async leader fence %s not found", tableasync.LeaderFenceTaskServer)
+	}
+
+	if details[0].Token > token {
+		return errf.Newf(errf.RecordNotUpdate, "fencing token %d is stale, current: %d", token, details[0].Token)
+	}
+
+	return nil
+}
+
+// fencingTokenExpr 返回主节点屏障令牌的CAS条件，令牌为0时不做限制。
+func fencingTokenExpr(token uint64) string {
+	if token == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(" and :fencing_token >= (select token from %s where id = :fence_id)",
+		table.AsyncLeaderFenceTable)
+}
@@ -0,0 +1,335 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bill
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/errf"
+	idgenerator "hcm/pkg/dal/dao/id-generator"
+	"hcm/pkg/dal/dao/orm"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/dal/dao/types"
+	typesbill "hcm/pkg/dal/dao/types/bill"
+	"hcm/pkg/dal/table"
+	tablebill "hcm/pkg/dal/table/bill"
+	"hcm/pkg/dal/table/utils"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/runtime/filter"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// AccountBillBudget only used for interface.
+type AccountBillBudget interface {
+	CreateWithTx(kt *kit.Kit, tx *sqlx.Tx, models []tablebill.AccountBillBudget) ([]string, error)
+	List(kt *kit.Kit, opt *types.ListOption) (*typesbill.ListAccountBillBudgetDetails, error)
+	UpdateByIDWithTx(kt *kit.Kit, tx *sqlx.Tx, id string, updateData *tablebill.AccountBillBudget) error
+	DeleteWithTx(kt *kit.Kit, tx *sqlx.Tx, filterExpr *filter.Expression) error
+}
+
+// AccountBillBudgetDao account bill budget dao
+type AccountBillBudgetDao struct {
+	Orm   orm.Interface
+	IDGen idgenerator.IDGenInterface
+}
+
+// CreateWithTx create account bill budget with tx.
+func (a AccountBillBudgetDao) CreateWithTx(kt *kit.Kit, tx *sqlx.Tx, models []tablebill.AccountBillBudget) (
+	[]string, error) {
+
+	if len(models) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "models to create cannot be empty")
+	}
+
+	ids, err := a.IDGen.Batch(kt, models[0].TableName(), len(models))
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range models {
+		models[index].ID = ids[index]
+
+		if err = models[index].InsertValidate(); err != nil {
+			return nil, err
+		}
+	}
+
+	sql := fmt.Sprintf(`INSERT INTO %s (%s)	VALUES(%s)`, models[0].TableName(),
+		tablebill.AccountBillBudgetColumns.ColumnExpr(), tablebill.AccountBillBudgetColumns.ColonNameExpr())
+
+	if err = a.Orm.Txn(tx).BulkInsert(kt.Ctx, sql, models); err != nil {
+		logs.Errorf("insert %s failed, err: %v, rid: %s", models[0].TableName(), err, kt.Rid)
+		return nil, fmt.Errorf("insert %s failed, err: %v", models[0].TableName(), err)
+	}
+
+	return ids, nil
+}
+
+// List get account bill budget list.
+func (a AccountBillBudgetDao) List(kt *kit.Kit, opt *types.ListOption) (
+	*typesbill.ListAccountBillBudgetDetails, error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "list account bill budget options is nil")
+	}
+
+	if err := opt.Validate(filter.NewExprOption(
+		filter.RuleFields(tablebill.AccountBillBudgetColumns.ColumnTypes())),
+		core.NewDefaultPageOption()); err != nil {
+		return nil, err
+	}
+
+	whereExpr, whereValue, err := opt.Filter.SQLWhereExpr(tools.DefaultSqlWhereOption)
+	if err != nil {
+		return nil, err
+	}
+
+	if opt.Page.Count {
+		sql := fmt.Sprintf(`SELECT COUNT(*) FROM %s %s`, table.AccountBillBudgetTable, whereExpr)
+		count, err := a.Orm.Do().Count(kt.Ctx, sql, whereValue)
+		if err != nil {
+			logs.ErrorJson("count account bill budget failed, err: %v, filter: %s, rid: %s",
+				err, opt.Filter, kt.Rid)
+			return nil, err
+		}
+
+		return &typesbill.ListAccountBillBudgetDetails{Count: count}, nil
+	}
+
+	pageExpr, err := types.PageSQLExpr(opt.Page, types.DefaultPageSQLOption)
+	if err != nil {
+		return nil, err
+	}
+
+	sql := fmt.Sprintf(`SELECT %s FROM %s %s %s`,
+		tablebill.AccountBillBudgetColumns.FieldsNamedExpr(opt.Fields),
+		table.AccountBillBudgetTable, whereExpr, pageExpr)
+
+	details := make([]tablebill.AccountBillBudget, 0)
+	if err = a.Orm.Do().Select(kt.Ctx, &details, sql, whereValue); err != nil {
+		logs.Errorf("fail to select bill budget, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	return &typesbill.ListAccountBillBudgetDetails{Details: details}, nil
+}
+
+// UpdateByIDWithTx update account bill budget.
+func (a AccountBillBudgetDao) UpdateByIDWithTx(kt *kit.Kit, tx *sqlx.Tx, id string,
+	updateData *tablebill.AccountBillBudget) error {
+
+	if err := updateData.UpdateValidate(); err != nil {
+		return err
+	}
+
+	opts := utils.NewFieldOptions().AddIgnoredFields(types.DefaultIgnoredFields...)
+	setExpr, toUpdate, err := utils.RearrangeSQLDataWithOption(updateData, opts)
+	if err != nil {
+		return fmt.Errorf("prepare parsed sql set filter expr failed, err: %v", err)
+	}
+
+	sql := fmt.Sprintf(`UPDATE %s %s where id = :id`, table.AccountBillBudgetTable, setExpr)
+
+	toUpdate["id"] = id
+	_, err = a.Orm.Txn(tx).Update(kt.Ctx, sql, toUpdate)
+	if err != nil {
+		logs.ErrorJson("update account bill budget failed, err: %v, id: %s, rid: %v", err, id, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteWithTx delete account bill budget with tx.
+func (a AccountBillBudgetDao) DeleteWithTx(kt *kit.Kit, tx *sqlx.Tx, expr *filter.Expression) error {
+	if expr == nil {
+		return errf.New(errf.InvalidParameter, "filter expr is required")
+	}
+
+	whereExpr, whereValue, err := expr.SQLWhereExpr(tools.DefaultSqlWhereOption)
+	if err != nil {
+		return err
+	}
+
+	sql := fmt.Sprintf(`DELETE FROM %s %s`, table.AccountBillBudgetTable, whereExpr)
+
+	if _, err = a.Orm.Txn(tx).Delete(kt.Ctx, sql, whereValue); err != nil {
+		logs.ErrorJson("delete account bill budget failed, err: %v, filter: %s, rid: %s", err, expr, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// AccountBillBudgetAlert only used for interface.
+type AccountBillBudgetAlert interface {
+	CreateWithTx(kt *kit.Kit, tx *sqlx.Tx, models []tablebill.AccountBillBudgetAlert) ([]string, error)
+	List(kt *kit.Kit, opt *types.ListOption) (*typesbill.ListAccountBillBudgetAlertDetails, error)
+	UpdateWithTx(kt *kit.Kit, tx *sqlx.Tx, expr *filter.Expression, updateData *tablebill.AccountBillBudgetAlert) error
+	DeleteWithTx(kt *kit.Kit, tx *sqlx.Tx, filterExpr *filter.Expression) error
+}
+
+// AccountBillBudgetAlertDao account bill budget alert dao
+type AccountBillBudgetAlertDao struct {
+	Orm   orm.Interface
+	IDGen idgenerator.IDGenInterface
+}
+
+// CreateWithTx create account bill budget alert with tx.
+func (a AccountBillBudgetAlertDao) CreateWithTx(kt *kit.Kit, tx *sqlx.Tx,
+	models []tablebill.AccountBillBudgetAlert) ([]string, error) {
+
+	if len(models) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "models to create cannot be empty")
+	}
+
+	ids, err := a.IDGen.Batch(kt, models[0].TableName(), len(models))
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range models {
+		models[index].ID = ids[index]
+
+		if err = models[index].InsertValidate(); err != nil {
+			return nil, err
+		}
+	}
+
+	sql := fmt.Sprintf(`INSERT INTO %s (%s)	VALUES(%s)`, models[0].TableName(),
+		tablebill.AccountBillBudgetAlertColumns.ColumnExpr(),
+		tablebill.AccountBillBudgetAlertColumns.ColonNameExpr())
+
+	if err = a.Orm.Txn(tx).BulkInsert(kt.Ctx, sql, models); err != nil {
+		logs.Errorf("insert %s failed, err: %v, rid: %s", models[0].TableName(), err, kt.Rid)
+		return nil, fmt.Errorf("insert %s failed, err: %v", models[0].TableName(), err)
+	}
+
+	return ids, nil
+}
+
+// List get account bill budget alert list.
+func (a AccountBillBudgetAlertDao) List(kt *kit.Kit, opt *types.ListOption) (
+	*typesbill.ListAccountBillBudgetAlertDetails, error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "list account bill budget alert options is nil")
+	}
+
+	if err := opt.Validate(filter.NewExprOption(
+		filter.RuleFields(tablebill.AccountBillBudgetAlertColumns.ColumnTypes())),
+		core.NewDefaultPageOption()); err != nil {
+		return nil, err
+	}
+
+	whereExpr, whereValue, err := opt.Filter.SQLWhereExpr(tools.DefaultSqlWhereOption)
+	if err != nil {
+		return nil, err
+	}
+
+	if opt.Page.Count {
+		sql := fmt.Sprintf(`SELECT COUNT(*) FROM %s %s`, table.AccountBillBudgetAlertTable, whereExpr)
+		count, err := a.Orm.Do().Count(kt.Ctx, sql, whereValue)
+		if err != nil {
+			logs.ErrorJson("count account bill budget alert failed, err: %v, filter: %s, rid: %s",
+				err, opt.Filter, kt.Rid)
+			return nil, err
+		}
+
+		return &typesbill.ListAccountBillBudgetAlertDetails{Count: count}, nil
+	}
+
+	pageExpr, err := types.PageSQLExpr(opt.Page, types.DefaultPageSQLOption)
+	if err != nil {
+		return nil, err
+	}
+
+	sql := fmt.Sprintf(`SELECT %s FROM %s %s %s`,
+		tablebill.AccountBillBudgetAlertColumns.FieldsNamedExpr(opt.Fields),
+		table.AccountBillBudgetAlertTable, whereExpr, pageExpr)
+
+	details := make([]tablebill.AccountBillBudgetAlert, 0)
+	if err = a.Orm.Do().Select(kt.Ctx, &details, sql, whereValue); err != nil {
+		logs.Errorf("fail to select bill budget alert, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	return &typesbill.ListAccountBillBudgetAlertDetails{Details: details}, nil
+}
+
+// UpdateWithTx update account bill budget alert, return record not found error if no alert matches the filter.
+func (a AccountBillBudgetAlertDao) UpdateWithTx(kt *kit.Kit, tx *sqlx.Tx, expr *filter.Expression,
+	updateData *tablebill.AccountBillBudgetAlert) error {
+
+	if expr == nil {
+		return errf.New(errf.InvalidParameter, "filter expr is nil")
+	}
+
+	if err := updateData.UpdateValidate(); err != nil {
+		return err
+	}
+
+	whereExpr, whereValue, err := expr.SQLWhereExpr(tools.DefaultSqlWhereOption)
+	if err != nil {
+		return err
+	}
+
+	opts := utils.NewFieldOptions().AddIgnoredFields(types.DefaultIgnoredFields...)
+	setExpr, toUpdate, err := utils.RearrangeSQLDataWithOption(updateData, opts)
+	if err != nil {
+		return fmt.Errorf("prepare parsed sql set filter expr failed, err: %v", err)
+	}
+
+	sql := fmt.Sprintf(`UPDATE %s %s %s`, table.AccountBillBudgetAlertTable, setExpr, whereExpr)
+
+	effected, err := a.Orm.Txn(tx).Update(kt.Ctx, sql, tools.MapMerge(toUpdate, whereValue))
+	if err != nil {
+		logs.ErrorJson("update account bill budget alert failed, err: %v, filter: %s, rid: %v", err, expr, kt.Rid)
+		return err
+	}
+
+	if effected == 0 {
+		return errf.New(errf.RecordNotFound, orm.ErrRecordNotFound.Error())
+	}
+
+	return nil
+}
+
+// DeleteWithTx delete account bill budget alert with tx.
+func (a AccountBillBudgetAlertDao) DeleteWithTx(kt *kit.Kit, tx *sqlx.Tx, expr *filter.Expression) error {
+	if expr == nil {
+		return errf.New(errf.InvalidParameter, "filter expr is required")
+	}
+
+	whereExpr, whereValue, err := expr.SQLWhereExpr(tools.DefaultSqlWhereOption)
+	if err != nil {
+		return err
+	}
+
+	sql := fmt.Sprintf(`DELETE FROM %s %s`, table.AccountBillBudgetAlertTable, whereExpr)
+
+	if _, err = a.Orm.Txn(tx).Delete(kt.Ctx, sql, whereValue); err != nil {
+		logs.ErrorJson("delete account bill budget alert failed, err: %v, filter: %s, rid: %s", err, expr, kt.Rid)
+		return err
+	}
+
+	return nil
+}
@@ -46,6 +46,7 @@ type AccountBillItem interface {
 	List(kt *kit.Kit, opt *types.ListOption) (*typesbill.ListAccountBillItemDetails, error)
 	UpdateByIDWithTx(kt *kit.Kit, tx *sqlx.Tx, billID string, updateData *tablebill.AccountBillItem) error
 	DeleteWithTx(kt *kit.Kit, tx *sqlx.Tx, filterExpr *filter.Expression) error
+	ListCostByResource(kt *kit.Kit, opt *types.ListOption) (*typesbill.ListBillItemResourceCostDetails, error)
 }
 
 // AccountBillItemDao account bill item dao
@@ -178,3 +179,70 @@ func (a AccountBillItemDao) DeleteWithTx(kt *kit.Kit, tx *sqlx.Tx, expr *filter.
 
 	return nil
 }
+
+// resourceCostSortFields 按云资源汇总费用时支持的排序字段
+var resourceCostSortFields = map[string]struct{}{"cost": {}, "item_count": {}}
+
+// ListCostByResource 按云资源汇总账单明细费用，只统计能识别出云资源ID的明细，默认按费用降序排列
+func (a AccountBillItemDao) ListCostByResource(kt *kit.Kit, opt *types.ListOption) (
+	*typesbill.ListBillItemResourceCostDetails, error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "list bill item cost by resource options is nil")
+	}
+
+	if err := opt.Validate(filter.NewExprOption(filter.RuleFields(tablebill.AccountBillItemColumns.ColumnTypes())),
+		core.NewDefaultPageOption()); err != nil {
+		return nil, err
+	}
+
+	expr, err := tools.And(opt.Filter,
+		&filter.AtomRule{Field: "cloud_res_id", Op: filter.NotEqual.Factory(), Value: ""})
+	if err != nil {
+		return nil, err
+	}
+	whereExpr, whereValue, err := expr.SQLWhereExpr(tools.DefaultSqlWhereOption)
+	if err != nil {
+		return nil, err
+	}
+
+	groupExpr := "vendor, cloud_res_id, res_type, res_id, res_bk_biz_id, currency"
+	if opt.Page.Count {
+		sql := fmt.Sprintf(`SELECT COUNT(*) FROM (SELECT 1 FROM %s %s GROUP BY %s) AS res_cost`,
+			table.AccountBillItemTable, whereExpr, groupExpr)
+		count, err := a.Orm.Do().Count(kt.Ctx, sql, whereValue)
+		if err != nil {
+			logs.ErrorJson("count bill item cost by resource failed, err: %v, filter: %s, rid: %s",
+				err, opt.Filter, kt.Rid)
+			return nil, err
+		}
+
+		return &typesbill.ListBillItemResourceCostDetails{Count: count}, nil
+	}
+
+	sort := "cost"
+	if len(opt.Page.Sort) != 0 {
+		if _, ok := resourceCostSortFields[opt.Page.Sort]; !ok {
+			return nil, errf.Newf(errf.InvalidParameter, "unsupported sort field: %s", opt.Page.Sort)
+		}
+		sort = opt.Page.Sort
+	}
+	if len(opt.Page.Order) == 0 {
+		opt.Page.Order = core.Descending
+	}
+	pageExpr, err := types.PageSQLExpr(opt.Page,
+		&types.PageSQLOption{Sort: types.SortOption{Sort: sort, ForceOverlap: true}})
+	if err != nil {
+		return nil, err
+	}
+
+	sql := fmt.Sprintf(`SELECT %s, SUM(cost) AS cost, COUNT(*) AS item_count FROM %s %s GROUP BY %s %s`,
+		groupExpr, table.AccountBillItemTable, whereExpr, groupExpr, pageExpr)
+
+	details := make([]typesbill.BillItemResourceCost, 0)
+	if err = a.Orm.Do().Select(kt.Ctx, &details, sql, whereValue); err != nil {
+		logs.ErrorJson("list bill item cost by resource failed, err: %v, filter: %s, rid: %s", err, opt.Filter, kt.Rid)
+		return nil, err
+	}
+	return &typesbill.ListBillItemResourceCostDetails{Details: details}, nil
+}
@@ -0,0 +1,192 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package ipamallocation ...
+package ipamallocation
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/errf"
+	idgenerator "hcm/pkg/dal/dao/id-generator"
+	"hcm/pkg/dal/dao/orm"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/dal/dao/types"
+	"hcm/pkg/dal/table"
+	tableipam "hcm/pkg/dal/table/cloud/ipam"
+	"hcm/pkg/dal/table/utils"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/runtime/filter"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// IpamAllocationInterface only used for ipam allocation.
+type IpamAllocationInterface interface {
+	BatchCreateWithTx(kt *kit.Kit, tx *sqlx.Tx, models []*tableipam.AllocationTable) ([]string, error)
+	UpdateByIDWithTx(kt *kit.Kit, tx *sqlx.Tx, id string, updateData *tableipam.AllocationTable) error
+	List(kt *kit.Kit, opt *types.ListOption) (*types.ListIpamAllocationDetails, error)
+	DeleteWithTx(kt *kit.Kit, tx *sqlx.Tx, expr *filter.Expression) error
+	ListCidrWithTx(kt *kit.Kit, tx *sqlx.Tx) ([]string, error)
+}
+
+var _ IpamAllocationInterface = new(IpamAllocationDao)
+
+// IpamAllocationDao ipam allocation dao.
+type IpamAllocationDao struct {
+	Orm   orm.Interface
+	IDGen idgenerator.IDGenInterface
+}
+
+// BatchCreateWithTx create ipam allocation.
+func (dao IpamAllocationDao) BatchCreateWithTx(kt *kit.Kit, tx *sqlx.Tx, models []*tableipam.AllocationTable) (
+	[]string, error) {
+
+	tableName := table.IpamAllocationTable
+	ids, err := dao.IDGen.Batch(kt, tableName, len(models))
+	if err != nil {
+		return nil, err
+	}
+
+	for index, model := range models {
+		if err = model.InsertValidate(); err != nil {
+			return nil, err
+		}
+
+		model.ID = ids[index]
+	}
+
+	sql := fmt.Sprintf(`INSERT INTO %s (%s)	VALUES(%s)`, tableName,
+		tableipam.AllocationColumns.ColumnExpr(), tableipam.AllocationColumns.ColonNameExpr())
+
+	if err = dao.Orm.Txn(tx).BulkInsert(kt.Ctx, sql, models); err != nil {
+		logs.Errorf("insert %s failed, err: %v, rid: %s", tableName, err, kt.Rid)
+		return nil, fmt.Errorf("insert %s failed, err: %v", tableName, err)
+	}
+
+	return ids, nil
+}
+
+// UpdateByIDWithTx update ipam allocation by id.
+func (dao IpamAllocationDao) UpdateByIDWithTx(kt *kit.Kit, tx *sqlx.Tx, id string,
+	updateData *tableipam.AllocationTable) error {
+
+	if err := updateData.UpdateValidate(); err != nil {
+		return err
+	}
+
+	opts := utils.NewFieldOptions().AddIgnoredFields(types.DefaultIgnoredFields...)
+	setExpr, toUpdate, err := utils.RearrangeSQLDataWithOption(updateData, opts)
+	if err != nil {
+		return fmt.Errorf("prepare parsed sql set filter expr failed, err: %v", err)
+	}
+
+	sql := fmt.Sprintf(`UPDATE %s %s where id = :id`, table.IpamAllocationTable, setExpr)
+
+	toUpdate["id"] = id
+	_, err = dao.Orm.Txn(tx).Update(kt.Ctx, sql, toUpdate)
+	if err != nil {
+		logs.Errorf("update ipam allocation db failed, id: %s, toUpdate: %+v, err: %v, rid: %v", id, toUpdate, err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// List list ipam allocation.
+func (dao IpamAllocationDao) List(kt *kit.Kit, opt *types.ListOption) (*types.ListIpamAllocationDetails, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "list options is nil")
+	}
+
+	columnTypes := tableipam.AllocationColumns.ColumnTypes()
+	if err := opt.Validate(filter.NewExprOption(filter.RuleFields(columnTypes)),
+		core.NewDefaultPageOption()); err != nil {
+		return nil, err
+	}
+
+	whereExpr, whereValue, err := opt.Filter.SQLWhereExpr(tools.DefaultSqlWhereOption)
+	if err != nil {
+		return nil, err
+	}
+
+	if opt.Page.Count {
+		// this is a count request, then do count operation only.
+		sql := fmt.Sprintf(`SELECT COUNT(*) FROM %s %s`, table.IpamAllocationTable, whereExpr)
+
+		count, err := dao.Orm.Do().Count(kt.Ctx, sql, whereValue)
+		if err != nil {
+			logs.ErrorJson("count ipam allocation failed, err: %v, filter: %s, rid: %s", err, opt.Filter, kt.Rid)
+			return nil, err
+		}
+
+		return &types.ListIpamAllocationDetails{Count: count}, nil
+	}
+
+	pageExpr, err := types.PageSQLExpr(opt.Page, types.DefaultPageSQLOption)
+	if err != nil {
+		return nil, err
+	}
+
+	sql := fmt.Sprintf(`SELECT %s FROM %s %s %s`, tableipam.AllocationColumns.FieldsNamedExpr(opt.Fields),
+		table.IpamAllocationTable, whereExpr, pageExpr)
+
+	details := make([]tableipam.AllocationTable, 0)
+	if err = dao.Orm.Do().Select(kt.Ctx, &details, sql, whereValue); err != nil {
+		return nil, err
+	}
+
+	return &types.ListIpamAllocationDetails{Details: details}, nil
+}
+
+// DeleteWithTx delete ipam allocation.
+func (dao IpamAllocationDao) DeleteWithTx(kt *kit.Kit, tx *sqlx.Tx, expr *filter.Expression) error {
+	if expr == nil {
+		return errf.New(errf.InvalidParameter, "filter expr is required")
+	}
+
+	whereExpr, whereValue, err := expr.SQLWhereExpr(tools.DefaultSqlWhereOption)
+	if err != nil {
+		return err
+	}
+
+	sql := fmt.Sprintf(`DELETE FROM %s %s`, table.IpamAllocationTable, whereExpr)
+	if _, err = dao.Orm.Txn(tx).Delete(kt.Ctx, sql, whereValue); err != nil {
+		logs.Errorf("delete ipam allocation failed, sql: %s, whereValue: %+v, err: %v, rid: %s",
+			sql, whereValue, err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// ListCidrWithTx 在事务中查询全部已分配网段，需要与地址池行锁配合使用，保证分配时读取到的已分配网段是最新的
+func (dao IpamAllocationDao) ListCidrWithTx(kt *kit.Kit, tx *sqlx.Tx) ([]string, error) {
+	sql := fmt.Sprintf(`SELECT cidr FROM %s`, table.IpamAllocationTable)
+
+	cidrs := make([]string, 0)
+	if err := dao.Orm.Txn(tx).Select(kt.Ctx, &cidrs, sql, map[string]interface{}{}); err != nil {
+		logs.Errorf("list ipam allocation cidr failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return cidrs, nil
+}
@@ -0,0 +1,201 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package ipampool ...
+package ipampool
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/errf"
+	idgenerator "hcm/pkg/dal/dao/id-generator"
+	"hcm/pkg/dal/dao/orm"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/dal/dao/types"
+	"hcm/pkg/dal/table"
+	tableipam "hcm/pkg/dal/table/cloud/ipam"
+	"hcm/pkg/dal/table/utils"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/runtime/filter"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// IpamPoolInterface only used for ipam pool.
+type IpamPoolInterface interface {
+	BatchCreateWithTx(kt *kit.Kit, tx *sqlx.Tx, models []*tableipam.PoolTable) ([]string, error)
+	UpdateByIDWithTx(kt *kit.Kit, tx *sqlx.Tx, id string, updateData *tableipam.PoolTable) error
+	List(kt *kit.Kit, opt *types.ListOption) (*types.ListIpamPoolDetails, error)
+	DeleteWithTx(kt *kit.Kit, tx *sqlx.Tx, expr *filter.Expression) error
+	LockByIDWithTx(kt *kit.Kit, tx *sqlx.Tx, id string) (*tableipam.PoolTable, error)
+}
+
+var _ IpamPoolInterface = new(IpamPoolDao)
+
+// IpamPoolDao ipam pool dao.
+type IpamPoolDao struct {
+	Orm   orm.Interface
+	IDGen idgenerator.IDGenInterface
+}
+
+// BatchCreateWithTx create ipam pool.
+func (dao IpamPoolDao) BatchCreateWithTx(kt *kit.Kit, tx *sqlx.Tx, models []*tableipam.PoolTable) (
+	[]string, error) {
+
+	tableName := table.IpamPoolTable
+	ids, err := dao.IDGen.Batch(kt, tableName, len(models))
+	if err != nil {
+		return nil, err
+	}
+
+	for index, model := range models {
+		if err = model.InsertValidate(); err != nil {
+			return nil, err
+		}
+
+		model.ID = ids[index]
+	}
+
+	sql := fmt.Sprintf(`INSERT INTO %s (%s)	VALUES(%s)`, tableName,
+		tableipam.PoolColumns.ColumnExpr(), tableipam.PoolColumns.ColonNameExpr())
+
+	if err = dao.Orm.Txn(tx).BulkInsert(kt.Ctx, sql, models); err != nil {
+		logs.Errorf("insert %s failed, err: %v, rid: %s", tableName, err, kt.Rid)
+		return nil, fmt.Errorf("insert %s failed, err: %v", tableName, err)
+	}
+
+	return ids, nil
+}
+
+// UpdateByIDWithTx update ipam pool by id.
+func (dao IpamPoolDao) UpdateByIDWithTx(kt *kit.Kit, tx *sqlx.Tx, id string,
+	updateData *tableipam.PoolTable) error {
+
+	if err := updateData.UpdateValidate(); err != nil {
+		return err
+	}
+
+	opts := utils.NewFieldOptions().AddIgnoredFields(types.DefaultIgnoredFields...)
+	setExpr, toUpdate, err := utils.RearrangeSQLDataWithOption(updateData, opts)
+	if err != nil {
+		return fmt.Errorf("prepare parsed sql set filter expr failed, err: %v", err)
+	}
+
+	sql := fmt.Sprintf(`UPDATE %s %s where id = :id`, table.IpamPoolTable, setExpr)
+
+	toUpdate["id"] = id
+	_, err = dao.Orm.Txn(tx).Update(kt.Ctx, sql, toUpdate)
+	if err != nil {
+		logs.Errorf("update ipam pool db failed, id: %s, toUpdate: %+v, err: %v, rid: %v", id, toUpdate, err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// List list ipam pool.
+func (dao IpamPoolDao) List(kt *kit.Kit, opt *types.ListOption) (*types.ListIpamPoolDetails, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "list options is nil")
+	}
+
+	columnTypes := tableipam.PoolColumns.ColumnTypes()
+	if err := opt.Validate(filter.NewExprOption(filter.RuleFields(columnTypes)),
+		core.NewDefaultPageOption()); err != nil {
+		return nil, err
+	}
+
+	whereExpr, whereValue, err := opt.Filter.SQLWhereExpr(tools.DefaultSqlWhereOption)
+	if err != nil {
+		return nil, err
+	}
+
+	if opt.Page.Count {
+		// this is a count request, then do count operation only.
+		sql := fmt.Sprintf(`SELECT COUNT(*) FROM %s %s`, table.IpamPoolTable, whereExpr)
+
+		count, err := dao.Orm.Do().Count(kt.Ctx, sql, whereValue)
+		if err != nil {
+			logs.ErrorJson("count ipam pool failed, err: %v, filter: %s, rid: %s", err, opt.Filter, kt.Rid)
+			return nil, err
+		}
+
+		return &types.ListIpamPoolDetails{Count: count}, nil
+	}
+
+	pageExpr, err := types.PageSQLExpr(opt.Page, types.DefaultPageSQLOption)
+	if err != nil {
+		return nil, err
+	}
+
+	sql := fmt.Sprintf(`SELECT %s FROM %s %s %s`, tableipam.PoolColumns.FieldsNamedExpr(opt.Fields),
+		table.IpamPoolTable, whereExpr, pageExpr)
+
+	details := make([]tableipam.PoolTable, 0)
+	if err = dao.Orm.Do().Select(kt.Ctx, &details, sql, whereValue); err != nil {
+		return nil, err
+	}
+
+	return &types.ListIpamPoolDetails{Details: details}, nil
+}
+
+// DeleteWithTx delete ipam pool.
+func (dao IpamPoolDao) DeleteWithTx(kt *kit.Kit, tx *sqlx.Tx, expr *filter.Expression) error {
+	if expr == nil {
+		return errf.New(errf.InvalidParameter, "filter expr is required")
+	}
+
+	whereExpr, whereValue, err := expr.SQLWhereExpr(tools.DefaultSqlWhereOption)
+	if err != nil {
+		return err
+	}
+
+	sql := fmt.Sprintf(`DELETE FROM %s %s`, table.IpamPoolTable, whereExpr)
+	if _, err = dao.Orm.Txn(tx).Delete(kt.Ctx, sql, whereValue); err != nil {
+		logs.Errorf("delete ipam pool failed, sql: %s, whereValue: %+v, err: %v, rid: %s",
+			sql, whereValue, err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// LockByIDWithTx 在事务中对地址池加行锁并返回地址池，用于串行化同一地址池的网段分配
+func (dao IpamPoolDao) LockByIDWithTx(kt *kit.Kit, tx *sqlx.Tx, id string) (*tableipam.PoolTable, error) {
+	if len(id) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "id is required")
+	}
+
+	sql := fmt.Sprintf(`SELECT %s FROM %s WHERE id = :id FOR UPDATE`, tableipam.PoolColumns.NamedExpr(),
+		table.IpamPoolTable)
+
+	details := make([]tableipam.PoolTable, 0)
+	if err := dao.Orm.Txn(tx).Select(kt.Ctx, &details, sql, map[string]interface{}{"id": id}); err != nil {
+		logs.Errorf("lock ipam pool failed, err: %v, id: %s, rid: %s", err, id, kt.Rid)
+		return nil, err
+	}
+
+	if len(details) == 0 {
+		return nil, errf.Newf(errf.RecordNotFound, "ipam pool: %s not found", id)
+	}
+
+	return &details[0], nil
+}
@@ -0,0 +1,138 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package k8snodepoolcvmrel ...
+package k8snodepoolcvmrel
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/errf"
+	"hcm/pkg/dal/dao/orm"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/dal/dao/types"
+	"hcm/pkg/dal/table"
+	tablek8s "hcm/pkg/dal/table/cloud/k8s"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/runtime/filter"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// K8sNodePoolCvmRelInterface only used for k8s node pool cvm rel.
+type K8sNodePoolCvmRelInterface interface {
+	BatchCreateWithTx(kt *kit.Kit, tx *sqlx.Tx, rels []*tablek8s.K8sNodePoolCvmRelTable) error
+	List(kt *kit.Kit, opt *types.ListOption) (*types.ListK8sNodePoolCvmRelDetails, error)
+	DeleteWithTx(kt *kit.Kit, tx *sqlx.Tx, expr *filter.Expression) error
+}
+
+var _ K8sNodePoolCvmRelInterface = new(K8sNodePoolCvmRelDao)
+
+// K8sNodePoolCvmRelDao k8s node pool cvm rel dao.
+type K8sNodePoolCvmRelDao struct {
+	Orm orm.Interface
+}
+
+// BatchCreateWithTx ...
+func (dao K8sNodePoolCvmRelDao) BatchCreateWithTx(kt *kit.Kit, tx *sqlx.Tx,
+	rels []*tablek8s.K8sNodePoolCvmRelTable) error {
+
+	for _, rel := range rels {
+		if err := rel.InsertValidate(); err != nil {
+			return err
+		}
+	}
+
+	sql := fmt.Sprintf(`INSERT INTO %s (%s) VALUES(%s)`, table.K8sNodePoolCvmRelTable,
+		tablek8s.K8sNodePoolCvmRelColumns.ColumnExpr(), tablek8s.K8sNodePoolCvmRelColumns.ColonNameExpr())
+	if err := dao.Orm.Txn(tx).BulkInsert(kt.Ctx, sql, rels); err != nil {
+		logs.Errorf("batch create k8s node pool cvm rels failed, err: %v, rid: %s", err, kt.Rid)
+		return fmt.Errorf("insert %s failed, err: %v", table.K8sNodePoolCvmRelTable, err)
+	}
+
+	return nil
+}
+
+// List ...
+func (dao K8sNodePoolCvmRelDao) List(kt *kit.Kit, opt *types.ListOption) (*types.ListK8sNodePoolCvmRelDetails,
+	error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "list k8s node pool cvm rel options is nil")
+	}
+
+	if err := opt.Validate(filter.NewExprOption(filter.RuleFields(tablek8s.K8sNodePoolCvmRelColumns.ColumnTypes())),
+		core.NewDefaultPageOption()); err != nil {
+		return nil, err
+	}
+
+	whereExpr, whereValue, err := opt.Filter.SQLWhereExpr(tools.DefaultSqlWhereOption)
+	if err != nil {
+		return nil, err
+	}
+
+	if opt.Page.Count {
+		sql := fmt.Sprintf(`SELECT COUNT(*) FROM %s %s`, table.K8sNodePoolCvmRelTable, whereExpr)
+		count, err := dao.Orm.Do().Count(kt.Ctx, sql, whereValue)
+		if err != nil {
+			logs.Errorf("count k8s node pool cvm rels failed, err: %v, filter: %s, rid: %s", err, opt.Filter,
+				kt.Rid)
+			return nil, err
+		}
+		return &types.ListK8sNodePoolCvmRelDetails{Count: count}, nil
+	}
+
+	pageExpr, err := types.PageSQLExpr(opt.Page, types.DefaultPageSQLOption)
+	if err != nil {
+		return nil, err
+	}
+
+	sql := fmt.Sprintf(`SELECT %s FROM %s %s %s`, tablek8s.K8sNodePoolCvmRelColumns.FieldsNamedExpr(opt.Fields),
+		table.K8sNodePoolCvmRelTable, whereExpr, pageExpr)
+
+	details := make([]tablek8s.K8sNodePoolCvmRelTable, 0)
+	if err = dao.Orm.Do().Select(kt.Ctx, &details, sql, whereValue); err != nil {
+		logs.Errorf("list k8s node pool cvm rels failed, err: %v, filter: %s, rid: %s", err, opt.Filter, kt.Rid)
+		return nil, err
+	}
+
+	return &types.ListK8sNodePoolCvmRelDetails{Details: details}, nil
+}
+
+// DeleteWithTx ...
+func (dao K8sNodePoolCvmRelDao) DeleteWithTx(kt *kit.Kit, tx *sqlx.Tx, expr *filter.Expression) error {
+	if expr == nil {
+		return errf.New(errf.InvalidParameter, "filter expr is required")
+	}
+
+	whereExpr, whereValue, err := expr.SQLWhereExpr(tools.DefaultSqlWhereOption)
+	if err != nil {
+		return err
+	}
+
+	sql := fmt.Sprintf(`DELETE FROM %s %s`, table.K8sNodePoolCvmRelTable, whereExpr)
+	if _, err = dao.Orm.Txn(tx).Delete(kt.Ctx, sql, whereValue); err != nil {
+		logs.Errorf("delete k8s node pool cvm rels failed, err: %v, filter: %s, rid: %s", err, expr, kt.Rid)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package region
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/errf"
+	idgenerator "hcm/pkg/dal/dao/id-generator"
+	"hcm/pkg/dal/dao/orm"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/dal/dao/types"
+	typesRegion "hcm/pkg/dal/dao/types/region"
+	"hcm/pkg/dal/table"
+	"hcm/pkg/dal/table/cloud/region"
+	"hcm/pkg/dal/table/utils"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/runtime/filter"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// KaopuRegion defines region dao operations.
+type KaopuRegion interface {
+	BatchCreateWithTx(kt *kit.Kit, tx *sqlx.Tx, models []region.KaopuRegionTable) ([]string, error)
+	Update(kt *kit.Kit, expr *filter.Expression, model *region.KaopuRegionTable) error
+	List(kt *kit.Kit, opt *types.ListOption, whereOpts ...*filter.SQLWhereOption) (*typesRegion.KaopuRegionListResult,
+		error)
+	BatchDeleteWithTx(kt *kit.Kit, tx *sqlx.Tx, filterExpr *filter.Expression) error
+}
+
+var _ KaopuRegion = new(kaopuRegionDao)
+
+// kaopuRegionDao region dao.
+type kaopuRegionDao struct {
+	orm   orm.Interface
+	idGen idgenerator.IDGenInterface
+}
+
+// NewKaopuRegionDao create a region dao.
+func NewKaopuRegionDao(orm orm.Interface, idGen idgenerator.IDGenInterface) KaopuRegion {
+	return &kaopuRegionDao{
+		orm:   orm,
+		idGen: idGen,
+	}
+}
+
+// BatchCreateWithTx create region with transaction.
+func (v *kaopuRegionDao) BatchCreateWithTx(kt *kit.Kit, tx *sqlx.Tx, models []region.KaopuRegionTable) (
+	[]string, error) {
+
+	if len(models) == 0 {
+		return nil, errf.New(errf.InvalidParameter, "models to create cannot be empty")
+	}
+
+	for _, model := range models {
+		if err := model.InsertValidate(); err != nil {
+			return nil, err
+		}
+	}
+
+	// generate region id
+	ids, err := v.idGen.Batch(kt, models[0].TableName(), len(models))
+	if err != nil {
+		return nil, err
+	}
+
+	for idx := range models {
+		models[idx].ID = ids[idx]
+	}
+
+	sql := fmt.Sprintf(`INSERT INTO %s (%s)	VALUES(%s)`, models[0].TableName(),
+		region.KaopuRegionColumns.ColumnExpr(), region.KaopuRegionColumns.ColonNameExpr())
+
+	err = v.orm.Txn(tx).BulkInsert(kt.Ctx, sql, models)
+	if err != nil {
+		return nil, fmt.Errorf("insert %s failed, err: %v", models[0].TableName(), err)
+	}
+
+	return ids, nil
+}
+
+// Update update region.
+func (v *kaopuRegionDao) Update(kt *kit.Kit, filterExpr *filter.Expression, model *region.KaopuRegionTable) error {
+	if filterExpr == nil {
+		return errf.New(errf.InvalidParameter, "filter expr is nil")
+	}
+
+	if err := model.UpdateValidate(); err != nil {
+		return err
+	}
+
+	whereExpr, whereValue, err := filterExpr.SQLWhereExpr(tools.DefaultSqlWhereOption)
+	if err != nil {
+		return err
+	}
+
+	opts := utils.NewFieldOptions().AddBlankedFields("name", "memo").
+		AddIgnoredFields(types.DefaultIgnoredFields...)
+	setExpr, toUpdate, err := utils.RearrangeSQLDataWithOption(model, opts)
+	if err != nil {
+		return fmt.Errorf("prepare parsed sql set filter expr failed, err: %v", err)
+	}
+
+	sql := fmt.Sprintf(`UPDATE %s %s %s`, model.TableName(), setExpr, whereExpr)
+
+	_, err = v.orm.AutoTxn(kt, func(txn *sqlx.Tx, opt *orm.TxnOption) (interface{}, error) {
+		effected, err := v.orm.Txn(txn).Update(kt.Ctx, sql, tools.MapMerge(toUpdate, whereValue))
+		if err != nil {
+			logs.ErrorJson("update region failed, err: %v, filter: %s, rid: %v", err, filterExpr, kt.Rid)
+			return nil, err
+		}
+
+		if effected == 0 {
+			logs.ErrorJson("update region, but record not found, filter: %v, rid: %v", filterExpr, kt.Rid)
+			return nil, errf.New(errf.RecordNotFound, orm.ErrRecordNotFound.Error())
+		}
+
+		return nil, nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// List get region list.
+func (v *kaopuRegionDao) List(kt *kit.Kit, opt *types.ListOption, whereOpts ...*filter.SQLWhereOption) (
+	*typesRegion.KaopuRegionListResult, error) {
+
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "list region options is nil")
+	}
+
+	if err := opt.Validate(filter.NewExprOption(filter.RuleFields(region.KaopuRegionColumns.ColumnTypes())),
+		core.NewDefaultPageOption()); err != nil {
+		return nil, err
+	}
+
+	whereOpt := tools.DefaultSqlWhereOption
+	if len(whereOpts) != 0 && whereOpts[0] != nil {
+		err := whereOpts[0].Validate()
+		if err != nil {
+			return nil, err
+		}
+		whereOpt = whereOpts[0]
+	}
+	whereExpr, whereValue, err := opt.Filter.SQLWhereExpr(whereOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	tableName := table.KaopuRegionTable
+	if opt.Page.Count {
+		// this is a count request, do count operation only.
+		sql := fmt.Sprintf(`SELECT COUNT(*) FROM %s %s`, tableName, whereExpr)
+
+		count, err := v.orm.Do().Count(kt.Ctx, sql, whereValue)
+		if err != nil {
+			logs.ErrorJson("count vpcs failed, err: %v, filter: %s, rid: %s", err, opt.Filter, kt.Rid)
+			return nil, err
+		}
+
+		return &typesRegion.KaopuRegionListResult{Count: count}, nil
+	}
+
+	pageExpr, err := types.PageSQLExpr(opt.Page, types.DefaultPageSQLOption)
+	if err != nil {
+		return nil, err
+	}
+
+	sql := fmt.Sprintf(`SELECT %s FROM %s %s %s`, region.KaopuRegionColumns.FieldsNamedExpr(opt.Fields),
+		tableName, whereExpr, pageExpr)
+
+	details := make([]region.KaopuRegionTable, 0)
+	if err = v.orm.Do().Select(kt.Ctx, &details, sql, whereValue); err != nil {
+		return nil, err
+	}
+
+	return &typesRegion.KaopuRegionListResult{Details: details}, nil
+}
+
+// BatchDeleteWithTx batch delete region with transaction.
+func (v *kaopuRegionDao) BatchDeleteWithTx(kt *kit.Kit, tx *sqlx.Tx, filterExpr *filter.Expression) error {
+	if filterExpr == nil {
+		return errf.New(errf.InvalidParameter, "filter expr is required")
+	}
+
+	whereExpr, whereValue, err := filterExpr.SQLWhereExpr(tools.DefaultSqlWhereOption)
+	if err != nil {
+		return err
+	}
+
+	sql := fmt.Sprintf(`DELETE FROM %s %s`, table.KaopuRegionTable, whereExpr)
+	if _, err = v.orm.Txn(tx).Delete(kt.Ctx, sql, whereValue); err != nil {
+		logs.ErrorJson("delete region failed, err: %v, filter: %s, rid: %s", err, filterExpr, kt.Rid)
+		return err
+	}
+
+	return nil
+}
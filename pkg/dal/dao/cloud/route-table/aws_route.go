@@ -180,7 +180,8 @@ func (r *awsRouteDao) Update(kt *kit.Kit, filterExpr *filter.Expression, model *
 		"cloud_destination_prefix_list_id", "cloud_carrier_gateway_id", "core_network_arn",
 		"cloud_egress_only_internet_gateway_id", "cloud_gateway_id", "cloud_instance_id", "cloud_instance_owner_id",
 		"cloud_local_gateway_id", "cloud_nat_gateway_id", "cloud_network_interface_id", "cloud_transit_gateway_id",
-		"cloud_vpc_peering_connection_id", "propagated").AddIgnoredFields(types.DefaultIgnoredFields...)
+		"cloud_vpc_peering_connection_id", "propagated", "next_hop_res_type", "next_hop_res_id").
+		AddIgnoredFields(types.DefaultIgnoredFields...)
 	setExpr, toUpdate, err := utils.RearrangeSQLDataWithOption(model, opts)
 	if err != nil {
 		return fmt.Errorf("prepare parsed sql set filter expr failed, err: %v", err)
@@ -176,7 +176,8 @@ func (r *tcloudRouteDao) Update(kt *kit.Kit, filterExpr *filter.Expression, mode
 		return err
 	}
 
-	blankFields := []string{"destination_ipv6_cidr_block", "enabled", "published_to_vbc", "memo"}
+	blankFields := []string{"destination_ipv6_cidr_block", "enabled", "published_to_vbc", "memo",
+		"next_hop_res_type", "next_hop_res_id"}
 	opts := utils.NewFieldOptions().AddBlankedFields(blankFields...).AddIgnoredFields(types.DefaultIgnoredFields...)
 	setExpr, toUpdate, err := utils.RearrangeSQLDataWithOption(model, opts)
 	if err != nil {
@@ -0,0 +1,128 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package daosync
+
+import (
+	"fmt"
+
+	"hcm/pkg/api/core"
+	"hcm/pkg/criteria/errf"
+	idgenerator "hcm/pkg/dal/dao/id-generator"
+	"hcm/pkg/dal/dao/orm"
+	"hcm/pkg/dal/dao/tools"
+	"hcm/pkg/dal/dao/types"
+	typessync "hcm/pkg/dal/dao/types/sync"
+	"hcm/pkg/dal/table"
+	tablessync "hcm/pkg/dal/table/cloud/sync"
+	"hcm/pkg/kit"
+	"hcm/pkg/logs"
+	"hcm/pkg/runtime/filter"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// SyncDriftReport only used sync drift report.
+type SyncDriftReport interface {
+	BatchCreateWithTx(kt *kit.Kit, tx *sqlx.Tx, models []tablessync.SyncDriftReportTable) ([]string, error)
+	List(kt *kit.Kit, opt *types.ListOption) (*typessync.ListSyncDriftReports, error)
+}
+
+var _ SyncDriftReport = new(SyncDriftReportDao)
+
+// SyncDriftReportDao sync drift report dao.
+type SyncDriftReportDao struct {
+	Orm   orm.Interface
+	IDGen idgenerator.IDGenInterface
+}
+
+// BatchCreateWithTx sync drift report with tx.
+func (dao *SyncDriftReportDao) BatchCreateWithTx(kt *kit.Kit, tx *sqlx.Tx,
+	models []tablessync.SyncDriftReportTable) ([]string, error) {
+
+	ids, err := dao.IDGen.Batch(kt, table.SyncDriftReportTable, len(models))
+	if err != nil {
+		return nil, err
+	}
+	for index := range models {
+		models[index].ID = ids[index]
+
+		if err = models[index].InsertValidate(); err != nil {
+			return nil, err
+		}
+	}
+
+	sql := fmt.Sprintf(`INSERT INTO %s (%s)	VALUES(%s)`, table.SyncDriftReportTable,
+		tablessync.SyncDriftReportColumns.ColumnExpr(), tablessync.SyncDriftReportColumns.ColonNameExpr())
+
+	err = dao.Orm.Txn(tx).BulkInsert(kt.Ctx, sql, models)
+	if err != nil {
+		logs.Errorf("insert %s failed, err: %v, sql: %s, rid: %s", table.SyncDriftReportTable, err, sql, kt.Rid)
+		return nil, fmt.Errorf("insert %s failed, err: %v", table.SyncDriftReportTable, err)
+	}
+
+	return ids, nil
+}
+
+// List sync drift report.
+func (dao *SyncDriftReportDao) List(kt *kit.Kit, opt *types.ListOption) (*typessync.ListSyncDriftReports, error) {
+	if opt == nil {
+		return nil, errf.New(errf.InvalidParameter, "list sync drift report options is nil")
+	}
+
+	if err := opt.Validate(filter.NewExprOption(filter.RuleFields(tablessync.SyncDriftReportColumns.ColumnTypes())),
+		core.NewDefaultPageOption()); err != nil {
+		return nil, err
+	}
+
+	whereExpr, whereValue, err := opt.Filter.SQLWhereExpr(tools.DefaultSqlWhereOption)
+	if err != nil {
+		return nil, err
+	}
+
+	if opt.Page.Count {
+		// this is dao count request, then do count operation only.
+		sql := fmt.Sprintf(`SELECT COUNT(*) FROM %s %s`, table.SyncDriftReportTable, whereExpr)
+
+		count, err := dao.Orm.Do().Count(kt.Ctx, sql, whereValue)
+		if err != nil {
+			logs.ErrorJson("count sync drift report failed, err: %v, filter: %s, rid: %s", err, opt.Filter, kt.Rid)
+			return nil, err
+		}
+
+		return &typessync.ListSyncDriftReports{Count: count}, nil
+	}
+
+	pageExpr, err := types.PageSQLExpr(opt.Page, types.DefaultPageSQLOption)
+	if err != nil {
+		return nil, err
+	}
+
+	sql := fmt.Sprintf(`SELECT %s FROM %s %s %s`, tablessync.SyncDriftReportColumns.FieldsNamedExpr(opt.Fields),
+		table.SyncDriftReportTable, whereExpr, pageExpr)
+
+	details := make([]tablessync.SyncDriftReportTable, 0)
+	if err = dao.Orm.Do().Select(kt.Ctx, &details, sql, whereValue); err != nil {
+		logs.ErrorJson("select sync drift report failed, err: %v, sql: %s, filter: %v, rid: %s", err, sql,
+			opt.Filter, kt.Rid)
+		return nil, err
+	}
+
+	return &typessync.ListSyncDriftReports{Count: 0, Details: details}, nil
+}
@@ -36,16 +36,28 @@ import (
 	daoselection "hcm/pkg/dal/dao/cloud-selection"
 	argstpl "hcm/pkg/dal/dao/cloud/argument-template"
 	cloudbill "hcm/pkg/dal/dao/cloud/bill"
+	"hcm/pkg/dal/dao/cloud/bucket"
 	"hcm/pkg/dal/dao/cloud/cert"
 	"hcm/pkg/dal/dao/cloud/cvm"
+	dbinstance "hcm/pkg/dal/dao/cloud/database-instance"
 	"hcm/pkg/dal/dao/cloud/disk"
 	diskcvmrel "hcm/pkg/dal/dao/cloud/disk-cvm-rel"
 	"hcm/pkg/dal/dao/cloud/eip"
 	eipcvmrel "hcm/pkg/dal/dao/cloud/eip-cvm-rel"
 	cimage "hcm/pkg/dal/dao/cloud/image"
+	ipamallocation "hcm/pkg/dal/dao/cloud/ipam-allocation"
+	ipampool "hcm/pkg/dal/dao/cloud/ipam-pool"
+	k8scluster "hcm/pkg/dal/dao/cloud/k8s-cluster"
+	k8snodepool "hcm/pkg/dal/dao/cloud/k8s-node-pool"
+	k8snodepoolcvmrel "hcm/pkg/dal/dao/cloud/k8s-node-pool-cvm-rel"
+	keypair "hcm/pkg/dal/dao/cloud/key-pair"
 	loadbalancer "hcm/pkg/dal/dao/cloud/load-balancer"
+	natgateway "hcm/pkg/dal/dao/cloud/nat-gateway"
 	networkinterface "hcm/pkg/dal/dao/cloud/network-interface"
 	nicvmrel "hcm/pkg/dal/dao/cloud/network-interface-cvm-rel"
+	privatednsrecord "hcm/pkg/dal/dao/cloud/private-dns-record"
+	privatednszone "hcm/pkg/dal/dao/cloud/private-dns-zone"
+	privatednszonevpcrel "hcm/pkg/dal/dao/cloud/private-dns-zone-vpc-rel"
 	"hcm/pkg/dal/dao/cloud/region"
 	resflow "hcm/pkg/dal/dao/cloud/resource-flow"
 	resourcegroup "hcm/pkg/dal/dao/cloud/resource-group"
@@ -53,8 +65,10 @@ import (
 	securitygroup "hcm/pkg/dal/dao/cloud/security-group"
 	sgcomrel "hcm/pkg/dal/dao/cloud/security-group-common-rel"
 	sgcvmrel "hcm/pkg/dal/dao/cloud/security-group-cvm-rel"
+	"hcm/pkg/dal/dao/cloud/snapshot"
 	daosubaccount "hcm/pkg/dal/dao/cloud/sub-account"
 	daosync "hcm/pkg/dal/dao/cloud/sync"
+	vpcpeering "hcm/pkg/dal/dao/cloud/vpc-peering"
 	"hcm/pkg/dal/dao/cloud/zone"
 	idgenerator "hcm/pkg/dal/dao/id-generator"
 	"hcm/pkg/dal/dao/orm"
@@ -89,9 +103,12 @@ type Set interface {
 	AzureRegion() region.AzureRegion
 	Zone() zone.Zone
 	AccountSyncDetail() daosync.AccountSyncDetail
+	SyncDriftReport() daosync.SyncDriftReport
 	TCloudRegion() region.TCloudRegion
 	AwsRegion() region.AwsRegion
 	GcpRegion() region.GcpRegion
+	ZenlayerRegion() region.ZenlayerRegion
+	KaopuRegion() region.KaopuRegion
 	Cvm() cvm.Interface
 	RouteTable() routetable.RouteTable
 	Route() routetable.Route
@@ -117,8 +134,14 @@ type Set interface {
 	RootAccountBillConfig() bill.RootAccountBillConfig
 	AccountBillExchangeRate() bill.AccountBillExchangeRate
 	AccountBillSyncRecord() bill.AccountBillSyncRecord
+	AccountBillSplitRule() bill.AccountBillSplitRule
+	AccountBillSplitSnapshot() bill.AccountBillSplitSnapshot
+	AccountBillBudget() bill.AccountBillBudget
+	AccountBillBudgetAlert() bill.AccountBillBudgetAlert
 	AsyncFlow() daoasync.AsyncFlow
 	AsyncFlowTask() daoasync.AsyncFlowTask
+	AsyncCronFlow() daoasync.AsyncCronFlow
+	AsyncLeaderFence() daoasync.AsyncLeaderFence
 	UserCollection() daouser.Interface
 	CloudSelectionScheme() daoselection.SchemeInterface
 	CloudSelectionBizType() daoselection.BizTypeInterface
@@ -130,6 +153,21 @@ type Set interface {
 	LoadBalancerTarget() loadbalancer.TargetInterface
 	LoadBalancerTargetGroup() loadbalancer.TargetGroupInterface
 	LoadBalancerTargetGroupListenerRuleRel() loadbalancer.TargetGroupListenerRuleRelInterface
+	Snapshot() snapshot.SnapshotInterface
+	SnapshotPolicy() snapshot.PolicyInterface
+	NatGateway() natgateway.NatGatewayInterface
+	VpcPeering() vpcpeering.VpcPeeringInterface
+	DatabaseInstance() dbinstance.DatabaseInstanceInterface
+	Bucket() bucket.BucketInterface
+	K8sCluster() k8scluster.K8sClusterInterface
+	K8sNodePool() k8snodepool.K8sNodePoolInterface
+	K8sNodePoolCvmRel() k8snodepoolcvmrel.K8sNodePoolCvmRelInterface
+	KeyPair() keypair.KeyPairInterface
+	PrivateDnsZone() privatednszone.PrivateDnsZoneInterface
+	PrivateDnsZoneVpcRel() privatednszonevpcrel.PrivateDnsZoneVpcRelInterface
+	PrivateDnsRecord() privatednsrecord.PrivateDnsRecordInterface
+	IpamPool() ipampool.IpamPoolInterface
+	IpamAllocation() ipamallocation.IpamAllocationInterface
 	LoadBalancerTCloudUrlRule() loadbalancer.LbTCloudUrlRuleInterface
 	ResourceFlowRel() resflow.ResourceFlowRelInterface
 	ResourceFlowLock() resflow.ResourceFlowLockInterface
@@ -268,6 +306,14 @@ func (s *set) AccountSyncDetail() daosync.AccountSyncDetail {
 	}
 }
 
+// SyncDriftReport return SyncDriftReport dao.
+func (s *set) SyncDriftReport() daosync.SyncDriftReport {
+	return &daosync.SyncDriftReportDao{
+		Orm:   s.orm,
+		IDGen: s.idGen,
+	}
+}
+
 // AzureRegion return AzureRegion dao.
 func (s *set) AzureRegion() region.AzureRegion {
 	return &region.AzureRegionDao{
@@ -416,6 +462,16 @@ func (s *set) TCloudRegion() region.TCloudRegion {
 	return region.NewTCloudRegionDao(s.orm, s.idGen)
 }
 
+// ZenlayerRegion returns zenlayer region dao.
+func (s *set) ZenlayerRegion() region.ZenlayerRegion {
+	return region.NewZenlayerRegionDao(s.orm, s.idGen)
+}
+
+// KaopuRegion returns kaopu region dao.
+func (s *set) KaopuRegion() region.KaopuRegion {
+	return region.NewKaopuRegionDao(s.orm, s.idGen)
+}
+
 // AwsRegion returns aws region dao.
 func (s *set) AwsRegion() region.AwsRegion {
 	return region.NewAwsRegionDao(s.orm, s.idGen)
@@ -586,6 +642,38 @@ func (s *set) AccountBillSyncRecord() bill.AccountBillSyncRecord {
 	}
 }
 
+// AccountBillSplitRule return bill.AccountBillSplitRule dao
+func (s *set) AccountBillSplitRule() bill.AccountBillSplitRule {
+	return &bill.AccountBillSplitRuleDao{
+		Orm:   s.orm,
+		IDGen: s.idGen,
+	}
+}
+
+// AccountBillSplitSnapshot return bill.AccountBillSplitSnapshot dao
+func (s *set) AccountBillSplitSnapshot() bill.AccountBillSplitSnapshot {
+	return &bill.AccountBillSplitSnapshotDao{
+		Orm:   s.orm,
+		IDGen: s.idGen,
+	}
+}
+
+// AccountBillBudget return bill.AccountBillBudget dao
+func (s *set) AccountBillBudget() bill.AccountBillBudget {
+	return &bill.AccountBillBudgetDao{
+		Orm:   s.orm,
+		IDGen: s.idGen,
+	}
+}
+
+// AccountBillBudgetAlert return bill.AccountBillBudgetAlert dao
+func (s *set) AccountBillBudgetAlert() bill.AccountBillBudgetAlert {
+	return &bill.AccountBillBudgetAlertDao{
+		Orm:   s.orm,
+		IDGen: s.idGen,
+	}
+}
+
 // UserCollection returns user collection dao.
 func (s *set) UserCollection() daouser.Interface {
 	return &daouser.Dao{
@@ -610,6 +698,21 @@ func (s *set) AsyncFlowTask() daoasync.AsyncFlowTask {
 	}
 }
 
+// AsyncCronFlow return AsyncCronFlow dao.
+func (s *set) AsyncCronFlow() daoasync.AsyncCronFlow {
+	return &daoasync.AsyncCronFlowDao{
+		Orm:   s.orm,
+		IDGen: s.idGen,
+	}
+}
+
+// AsyncLeaderFence return AsyncLeaderFence dao.
+func (s *set) AsyncLeaderFence() daoasync.AsyncLeaderFence {
+	return &daoasync.AsyncLeaderFenceDao{
+		Orm: s.orm,
+	}
+}
+
 // CloudSelectionScheme returns cloud selection scheme dao.
 func (s *set) CloudSelectionScheme() daoselection.SchemeInterface {
 	return &daoselection.SchemeDao{
@@ -748,3 +851,129 @@ func (s *set) RootAccount() accountset.RootAccount {
 		Audit: s.audit,
 	}
 }
+
+// Snapshot return snapshot dao.
+func (s *set) Snapshot() snapshot.SnapshotInterface {
+	return &snapshot.SnapshotDao{
+		Orm:   s.orm,
+		IDGen: s.idGen,
+		Audit: s.audit,
+	}
+}
+
+// SnapshotPolicy return snapshot policy dao.
+func (s *set) SnapshotPolicy() snapshot.PolicyInterface {
+	return &snapshot.PolicyDao{
+		Orm:   s.orm,
+		IDGen: s.idGen,
+	}
+}
+
+// NatGateway return nat gateway dao.
+func (s *set) NatGateway() natgateway.NatGatewayInterface {
+	return &natgateway.NatGatewayDao{
+		Orm:   s.orm,
+		IDGen: s.idGen,
+		Audit: s.audit,
+	}
+}
+
+// VpcPeering return vpc peering dao.
+func (s *set) VpcPeering() vpcpeering.VpcPeeringInterface {
+	return &vpcpeering.VpcPeeringDao{
+		Orm:   s.orm,
+		IDGen: s.idGen,
+		Audit: s.audit,
+	}
+}
+
+// DatabaseInstance return database instance dao.
+func (s *set) DatabaseInstance() dbinstance.DatabaseInstanceInterface {
+	return &dbinstance.DatabaseInstanceDao{
+		Orm:   s.orm,
+		IDGen: s.idGen,
+		Audit: s.audit,
+	}
+}
+
+// Bucket return bucket dao.
+func (s *set) Bucket() bucket.BucketInterface {
+	return &bucket.BucketDao{
+		Orm:   s.orm,
+		IDGen: s.idGen,
+		Audit: s.audit,
+	}
+}
+
+// K8sCluster return k8s cluster dao.
+func (s *set) K8sCluster() k8scluster.K8sClusterInterface {
+	return &k8scluster.K8sClusterDao{
+		Orm:   s.orm,
+		IDGen: s.idGen,
+		Audit: s.audit,
+	}
+}
+
+// K8sNodePool return k8s node pool dao.
+func (s *set) K8sNodePool() k8snodepool.K8sNodePoolInterface {
+	return &k8snodepool.K8sNodePoolDao{
+		Orm:   s.orm,
+		IDGen: s.idGen,
+	}
+}
+
+// K8sNodePoolCvmRel return k8s node pool cvm rel dao.
+func (s *set) K8sNodePoolCvmRel() k8snodepoolcvmrel.K8sNodePoolCvmRelInterface {
+	return &k8snodepoolcvmrel.K8sNodePoolCvmRelDao{
+		Orm: s.orm,
+	}
+}
+
+// KeyPair return key pair dao.
+func (s *set) KeyPair() keypair.KeyPairInterface {
+	return &keypair.KeyPairDao{
+		Orm:   s.orm,
+		IDGen: s.idGen,
+		Audit: s.audit,
+	}
+}
+
+// PrivateDnsZone return private dns zone dao.
+func (s *set) PrivateDnsZone() privatednszone.PrivateDnsZoneInterface {
+	return &privatednszone.PrivateDnsZoneDao{
+		Orm:   s.orm,
+		IDGen: s.idGen,
+		Audit: s.audit,
+	}
+}
+
+// PrivateDnsZoneVpcRel return private dns zone vpc rel dao.
+func (s *set) PrivateDnsZoneVpcRel() privatednszonevpcrel.PrivateDnsZoneVpcRelInterface {
+	return &privatednszonevpcrel.PrivateDnsZoneVpcRelDao{
+		Orm: s.orm,
+	}
+}
+
+// PrivateDnsRecord return private dns record dao.
+func (s *set) PrivateDnsRecord() privatednsrecord.PrivateDnsRecordInterface {
+	return &privatednsrecord.PrivateDnsRecordDao{
+		Orm:   s.orm,
+		IDGen: s.idGen,
+	}
+}
+
+// IpamPool return ipam pool dao.
+func (s *set) IpamPool() ipampool.IpamPoolInterface {
+	return &ipampool.IpamPoolDao{
+		Orm:   s.orm,
+		IDGen: s.idGen,
+	}
+}
+
+// IpamAllocation return ipam allocation dao.
+func (s *set) IpamAllocation() ipamallocation.IpamAllocationInterface {
+	return &ipamallocation.IpamAllocationDao{
+		Orm:   s.orm,
+		IDGen: s.idGen,
+	}
+}
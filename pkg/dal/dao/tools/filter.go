@@ -122,6 +122,11 @@ func RuleGreaterThan(fieldName string, values any) *filter.AtomRule {
 	return &filter.AtomRule{Field: fieldName, Op: filter.GreaterThan.Factory(), Value: values}
 }
 
+// RuleLessThanEqual 生成资源字段小于等于查询的AtomRule，即fieldName <= values
+func RuleLessThanEqual(fieldName string, values any) *filter.AtomRule {
+	return &filter.AtomRule{Field: fieldName, Op: filter.LessThanEqual.Factory(), Value: values}
+}
+
 // RuleJSONEqual 生成资源字段等于查询的AtomRule，即fieldName=value
 func RuleJSONEqual(fieldName string, value any) *filter.AtomRule {
 	return &filter.AtomRule{Field: fieldName, Op: filter.JSONEqual.Factory(), Value: value}
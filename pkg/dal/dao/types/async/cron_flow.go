@@ -0,0 +1,56 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package typesasync
+
+import (
+	"errors"
+	"time"
+
+	"hcm/pkg/criteria/validator"
+	tableasync "hcm/pkg/dal/table/async"
+)
+
+// ListAsyncCronFlows list async cron flows.
+type ListAsyncCronFlows struct {
+	Count   uint64                          `json:"count,omitempty"`
+	Details []tableasync.AsyncCronFlowTable `json:"details,omitempty"`
+}
+
+// UpdateCronNextRunInfo define update cron flow next run info.
+type UpdateCronNextRunInfo struct {
+	ID string `json:"id" validate:"required"`
+	// PreRunAt 当前的下次执行时间，用于CAS更新
+	PreRunAt time.Time `json:"pre_run_at"`
+	// NextRunAt 新的下次执行时间
+	NextRunAt time.Time `json:"next_run_at"`
+	// LastFlowID 本次触发创建的任务流ID，为空时不更新
+	LastFlowID string `json:"last_flow_id" validate:"omitempty"`
+	// FencingToken 主节点屏障令牌，不为0时只有令牌不小于已注册的最大令牌才能更新成功
+	FencingToken uint64 `json:"fencing_token" validate:"omitempty"`
+}
+
+// Validate UpdateCronNextRunInfo.
+func (info *UpdateCronNextRunInfo) Validate() error {
+	if info.PreRunAt.IsZero() || info.NextRunAt.IsZero() {
+		return errors.New("pre_run_at and next_run_at are required")
+	}
+
+	return validator.Validate.Struct(info)
+}
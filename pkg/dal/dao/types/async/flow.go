@@ -38,6 +38,8 @@ type UpdateFlowInfo struct {
 	Target enumor.FlowState   `json:"target" validate:"required"`
 	Reason *tableasync.Reason `json:"reason" validate:"omitempty"`
 	Worker *string            `json:"worker" validate:"omitempty"`
+	// FencingToken 主节点屏障令牌，不为0时只有令牌不小于已注册的最大令牌才能更新成功
+	FencingToken uint64 `json:"fencing_token" validate:"omitempty"`
 }
 
 // Validate UpdateFlowInfo.
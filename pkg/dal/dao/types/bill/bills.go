@@ -20,7 +20,9 @@
 package bill
 
 import (
+	"hcm/pkg/criteria/enumor"
 	tablebill "hcm/pkg/dal/table/bill"
+	"hcm/pkg/dal/table/types"
 )
 
 // ListAccountBillSummaryMainDetails list account bill config details.
@@ -88,3 +90,45 @@ type ListAccountBillSyncRecordDetails struct {
 	Count   uint64                            `json:"count,omitempty"`
 	Details []tablebill.AccountBillSyncRecord `json:"details,omitempty"`
 }
+
+// ListAccountBillSplitRuleDetails list account bill split rule details
+type ListAccountBillSplitRuleDetails struct {
+	Count   uint64                           `json:"count,omitempty"`
+	Details []tablebill.AccountBillSplitRule `json:"details,omitempty"`
+}
+
+// ListAccountBillSplitSnapshotDetails list account bill split snapshot details
+type ListAccountBillSplitSnapshotDetails struct {
+	Count   uint64                               `json:"count,omitempty"`
+	Details []tablebill.AccountBillSplitSnapshot `json:"details,omitempty"`
+}
+
+// BillItemResourceCost 按云资源汇总的账单明细费用
+type BillItemResourceCost struct {
+	Vendor     enumor.Vendor            `db:"vendor" json:"vendor"`
+	CloudResID string                   `db:"cloud_res_id" json:"cloud_res_id"`
+	ResType    enumor.CloudResourceType `db:"res_type" json:"res_type"`
+	ResID      string                   `db:"res_id" json:"res_id"`
+	ResBkBizID int64                    `db:"res_bk_biz_id" json:"res_bk_biz_id"`
+	Currency   enumor.CurrencyCode      `db:"currency" json:"currency"`
+	Cost       *types.Decimal           `db:"cost" json:"cost"`
+	ItemCount  uint64                   `db:"item_count" json:"item_count"`
+}
+
+// ListBillItemResourceCostDetails list bill item cost by resource details
+type ListBillItemResourceCostDetails struct {
+	Count   uint64                 `json:"count,omitempty"`
+	Details []BillItemResourceCost `json:"details,omitempty"`
+}
+
+// ListAccountBillBudgetDetails list account bill budget details
+type ListAccountBillBudgetDetails struct {
+	Count   uint64                        `json:"count,omitempty"`
+	Details []tablebill.AccountBillBudget `json:"details,omitempty"`
+}
+
+// ListAccountBillBudgetAlertDetails list account bill budget alert details
+type ListAccountBillBudgetAlertDetails struct {
+	Count   uint64                             `json:"count,omitempty"`
+	Details []tablebill.AccountBillBudgetAlert `json:"details,omitempty"`
+}
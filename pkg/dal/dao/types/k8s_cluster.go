@@ -0,0 +1,40 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package types
+
+import tablek8s "hcm/pkg/dal/table/cloud/k8s"
+
+// ListK8sClusterDetails list k8s cluster details.
+type ListK8sClusterDetails struct {
+	Count   uint64                     `json:"count,omitempty"`
+	Details []tablek8s.K8sClusterTable `json:"details,omitempty"`
+}
+
+// ListK8sNodePoolDetails list k8s node pool details.
+type ListK8sNodePoolDetails struct {
+	Count   uint64                      `json:"count,omitempty"`
+	Details []tablek8s.K8sNodePoolTable `json:"details,omitempty"`
+}
+
+// ListK8sNodePoolCvmRelDetails list k8s node pool cvm rel details.
+type ListK8sNodePoolCvmRelDetails struct {
+	Count   uint64                            `json:"count,omitempty"`
+	Details []tablek8s.K8sNodePoolCvmRelTable `json:"details,omitempty"`
+}
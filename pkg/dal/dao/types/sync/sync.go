@@ -28,3 +28,9 @@ type ListAccountSyncDetails struct {
 	Count   uint64                              `json:"count,omitempty"`
 	Details []tablessync.AccountSyncDetailTable `json:"details,omitempty"`
 }
+
+// ListSyncDriftReports list sync drift reports.
+type ListSyncDriftReports struct {
+	Count   uint64                            `json:"count,omitempty"`
+	Details []tablessync.SyncDriftReportTable `json:"details,omitempty"`
+}
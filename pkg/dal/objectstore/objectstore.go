@@ -23,6 +23,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"hcm/pkg/cc"
 	"hcm/pkg/criteria/enumor"
@@ -45,4 +46,5 @@ type Storage interface {
 	Upload(ctx context.Context, uploadPath string, r io.Reader) error
 	Download(ctx context.Context, downloadPath string, w io.Writer) error
 	ListItems(ctx context.Context, folderPath string) ([]string, error)
+	GetPresignedURL(ctx context.Context, objectPath string, expire time.Duration) (string, error)
 }
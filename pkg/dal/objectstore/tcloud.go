@@ -27,6 +27,7 @@ import (
 	"net/http"
 	"net/url"
 	"path/filepath"
+	"time"
 
 	cos "github.com/tencentyun/cos-go-sdk-v5"
 	"github.com/tencentyun/cos-go-sdk-v5/debug"
@@ -140,3 +141,13 @@ func (t *TCloudCOS) ListItems(ctx context.Context, folderPath string) ([]string,
 	}
 	return retList, nil
 }
+
+// GetPresignedURL get presigned download url of object, the url will be expired after given duration
+func (t *TCloudCOS) GetPresignedURL(ctx context.Context, objectPath string, expire time.Duration) (string, error) {
+	objectPath = filepath.Join(t.prefix, objectPath)
+	u, err := t.cli.Object.GetPresignedURL2(ctx, http.MethodGet, objectPath, expire, nil)
+	if err != nil {
+		return "", fmt.Errorf("get presigned url for path %s failed, err %s", objectPath, err.Error())
+	}
+	return u.String(), nil
+}
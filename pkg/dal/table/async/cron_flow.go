@@ -0,0 +1,161 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tableasync
+
+import (
+	"database/sql/driver"
+	"errors"
+	"time"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/table"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/dal/table/utils"
+)
+
+// AsyncCronFlowColumns defines all the async_cron_flow table's columns.
+var AsyncCronFlowColumns = utils.MergeColumns(nil, AsyncCronFlowTableColumnDescriptor)
+
+// AsyncCronFlowTableColumnDescriptor is async_cron_flow's column descriptors.
+var AsyncCronFlowTableColumnDescriptor = utils.ColumnDescriptors{
+	{Column: "id", NamedC: "id", Type: enumor.String},
+	{Column: "name", NamedC: "name", Type: enumor.String},
+	{Column: "spec", NamedC: "spec", Type: enumor.String},
+	{Column: "flow", NamedC: "flow", Type: enumor.Json},
+	{Column: "enabled", NamedC: "enabled", Type: enumor.Boolean},
+	{Column: "next_run_at", NamedC: "next_run_at", Type: enumor.Time},
+	{Column: "last_flow_id", NamedC: "last_flow_id", Type: enumor.String},
+	{Column: "memo", NamedC: "memo", Type: enumor.String},
+	{Column: "creator", NamedC: "creator", Type: enumor.String},
+	{Column: "reviser", NamedC: "reviser", Type: enumor.String},
+	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
+	{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
+}
+
+// AsyncCronFlowTable define async_cron_flow table. 周期任务流，到达执行时间后按照Flow定义创建任务流。
+type AsyncCronFlowTable struct {
+	ID         string          `db:"id" json:"id" validate:"lte=64"`
+	Name       string          `db:"name" json:"name" validate:"lte=64"`
+	Spec       string          `db:"spec" json:"spec" validate:"lte=64"`
+	Flow       *CronFlowDefine `db:"flow" json:"flow"`
+	Enabled    *bool           `db:"enabled" json:"enabled"`
+	NextRunAt  time.Time       `db:"next_run_at" json:"next_run_at"`
+	LastFlowID string          `db:"last_flow_id" json:"last_flow_id" validate:"lte=64"`
+	Memo       *string         `db:"memo" json:"memo" validate:"omitempty,lte=255"`
+	Creator    string          `db:"creator" json:"creator" validate:"lte=64"`
+	Reviser    string          `db:"reviser" json:"reviser" validate:"lte=64"`
+	CreatedAt  types.Time      `db:"created_at" json:"created_at" validate:"excluded_unless"`
+	UpdatedAt  types.Time      `db:"updated_at" json:"updated_at" validate:"excluded_unless"`
+}
+
+// TableName return async_cron_flow table name.
+func (a AsyncCronFlowTable) TableName() table.Name {
+	return table.AsyncCronFlowTable
+}
+
+// InsertValidate async_cron_flow table when insert.
+func (a AsyncCronFlowTable) InsertValidate() error {
+	// length validate.
+	if err := validator.Validate.Struct(a); err != nil {
+		return err
+	}
+
+	if len(a.ID) == 0 {
+		return errors.New("id is required")
+	}
+
+	if len(a.Name) == 0 {
+		return errors.New("name is required")
+	}
+
+	if len(a.Spec) == 0 {
+		return errors.New("spec is required")
+	}
+
+	if a.Flow == nil {
+		return errors.New("flow is required")
+	}
+
+	if a.NextRunAt.IsZero() {
+		return errors.New("next_run_at is required")
+	}
+
+	if len(a.Creator) == 0 {
+		return errors.New("creator is required")
+	}
+
+	if len(a.Reviser) == 0 {
+		return errors.New("reviser is required")
+	}
+
+	return nil
+}
+
+// UpdateValidate async_cron_flow table when update.
+func (a AsyncCronFlowTable) UpdateValidate() error {
+	// length validate.
+	if err := validator.Validate.Struct(a); err != nil {
+		return err
+	}
+
+	if len(a.Name) != 0 {
+		return errors.New("name can not update")
+	}
+
+	if a.Flow != nil {
+		return errors.New("flow can not update")
+	}
+
+	if len(a.Creator) != 0 {
+		return errors.New("creator can not update")
+	}
+
+	return nil
+}
+
+// CronFlowDefine 周期任务流每次触发时创建的任务流定义
+type CronFlowDefine struct {
+	Name       enumor.FlowName   `json:"name"`
+	ShareData  map[string]string `json:"share_data,omitempty"`
+	Memo       string            `json:"memo,omitempty"`
+	Compensate bool              `json:"compensate,omitempty"`
+	Tasks      []CronFlowTask    `json:"tasks"`
+}
+
+// CronFlowTask 周期任务流中的任务定义
+type CronFlowTask struct {
+	ActionID   string            `json:"action_id"`
+	ActionName enumor.ActionName `json:"action_name"`
+	Params     types.JsonField   `json:"params,omitempty"`
+	Retry      *Retry            `json:"retry,omitempty"`
+	TimeoutSec uint              `json:"timeout_sec,omitempty"`
+	DependOn   []string          `json:"depend_on,omitempty"`
+}
+
+// Scan is used to decode raw message which is read from db into CronFlowDefine.
+func (d *CronFlowDefine) Scan(raw interface{}) error {
+	return types.Scan(raw, d)
+}
+
+// Value encode the CronFlowDefine to a json raw, so that it can be stored to db with json raw.
+func (d CronFlowDefine) Value() (driver.Value, error) {
+	return types.Value(d)
+}
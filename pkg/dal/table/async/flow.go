@@ -21,6 +21,7 @@ package tableasync
 
 import (
 	"errors"
+	"time"
 
 	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/criteria/validator"
@@ -41,6 +42,8 @@ var AsyncFlowTableColumnDescriptor = utils.ColumnDescriptors{
 	{Column: "memo", NamedC: "memo", Type: enumor.String},
 	{Column: "share_data", NamedC: "share_data", Type: enumor.Json},
 	{Column: "worker", NamedC: "worker", Type: enumor.String},
+	{Column: "compensate", NamedC: "compensate", Type: enumor.Boolean},
+	{Column: "run_at", NamedC: "run_at", Type: enumor.Time},
 	{Column: "creator", NamedC: "creator", Type: enumor.String},
 	{Column: "reviser", NamedC: "reviser", Type: enumor.String},
 	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
@@ -49,17 +52,19 @@ var AsyncFlowTableColumnDescriptor = utils.ColumnDescriptors{
 
 // AsyncFlowTable define async_flow table.
 type AsyncFlowTable struct {
-	ID        string           `db:"id" json:"id" validate:"lte=64"`
-	Name      enumor.FlowName  `db:"name" json:"name"`
-	State     enumor.FlowState `db:"state" json:"state"`
-	Reason    *Reason          `db:"reason" json:"reason"`
-	ShareData *ShareData       `db:"share_data" json:"share_data"`
-	Memo      string           `db:"memo" json:"memo"`
-	Worker    *string          `db:"worker" json:"worker"`
-	Creator   string           `db:"creator" json:"creator" validate:"lte=64"`
-	Reviser   string           `db:"reviser" json:"reviser" validate:"lte=64"`
-	CreatedAt types.Time       `db:"created_at" json:"created_at" validate:"excluded_unless"`
-	UpdatedAt types.Time       `db:"updated_at" json:"updated_at" validate:"excluded_unless"`
+	ID         string           `db:"id" json:"id" validate:"lte=64"`
+	Name       enumor.FlowName  `db:"name" json:"name"`
+	State      enumor.FlowState `db:"state" json:"state"`
+	Reason     *Reason          `db:"reason" json:"reason"`
+	ShareData  *ShareData       `db:"share_data" json:"share_data"`
+	Memo       string           `db:"memo" json:"memo"`
+	Worker     *string          `db:"worker" json:"worker"`
+	Compensate *bool            `db:"compensate" json:"compensate"`
+	RunAt      time.Time        `db:"run_at" json:"run_at"`
+	Creator    string           `db:"creator" json:"creator" validate:"lte=64"`
+	Reviser    string           `db:"reviser" json:"reviser" validate:"lte=64"`
+	CreatedAt  types.Time       `db:"created_at" json:"created_at" validate:"excluded_unless"`
+	UpdatedAt  types.Time       `db:"updated_at" json:"updated_at" validate:"excluded_unless"`
 }
 
 // TableName return async_flow table name.
@@ -0,0 +1,40 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package tableasync
+
+import (
+	"hcm/pkg/dal/table"
+	"hcm/pkg/dal/table/types"
+)
+
+// LeaderFenceTaskServer 异步任务框架主节点的屏障记录ID
+const LeaderFenceTaskServer = "task_server"
+
+// AsyncLeaderFenceTable define async_leader_fence table. 记录已注册的最大主节点屏障令牌，
+// 主节点的CAS更新需要携带不小于该值的令牌，用于防止已失去主节点身份的旧主节点继续更新。
+type AsyncLeaderFenceTable struct {
+	ID        string     `db:"id" json:"id"`
+	Token     uint64     `db:"token" json:"token"`
+	UpdatedAt types.Time `db:"updated_at" json:"updated_at"`
+}
+
+// TableName is the async_leader_fence's database table name.
+func (t *AsyncLeaderFenceTable) TableName() table.Name {
+	return table.AsyncLeaderFenceTable
+}
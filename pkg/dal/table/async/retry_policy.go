@@ -23,10 +23,11 @@ import (
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"math/rand"
+	"time"
 
 	"hcm/pkg/criteria/validator"
 	"hcm/pkg/dal/table/types"
-	"hcm/pkg/tools/retry"
 )
 
 // Retry define retry relation setting.
@@ -42,38 +43,36 @@ func (r Retry) IsEnable() bool {
 	return r.Enable
 }
 
-// Run retry run func.
+// ErrRetryExhausted 自动重试次数耗尽
+var ErrRetryExhausted = errors.New("retry exceed the max number of retryable times")
+
+// Run retry run func. do 返回 stop 为 true 时停止重试，每次失败后按照重试策略等待后再重试。
 func (r Retry) Run(do func() (stop bool, result any, err error)) (result any, err error) {
 	if !r.IsEnable() {
 		return nil, errors.New("retry not enable")
 	}
 
-	rp := retry.NewRetryPolicy(r.Policy.Count, r.Policy.SleepRangeMS)
 	var lastErr error
 	var lastResult any
 	var stop bool
-	for {
-		if rp.RetryCount() >= uint32(r.Policy.Count) {
-			break
-		}
-
+	for retried := uint(0); retried < r.Policy.Count; retried++ {
 		stop, result, err = do()
 		if stop {
 			// 主动停止
 			return result, err
 		}
-		if err != nil {
-			lastErr = err
-			lastResult = result
-			rp.Sleep()
-			continue
+		if err == nil {
+			return result, nil
 		}
 
-		return result, nil
+		lastErr = err
+		lastResult = result
+		if retried+1 < r.Policy.Count {
+			time.Sleep(r.Policy.Backoff(retried, err))
+		}
 	}
 
-	return lastResult, fmt.Errorf("retry exceed the max number of retryable times: %d, lastErr: %v",
-		r.Policy.Count, lastErr)
+	return lastResult, fmt.Errorf("%w: %d, lastErr: %v", ErrRetryExhausted, r.Policy.Count, lastErr)
 }
 
 // Validate retry.
@@ -105,17 +104,95 @@ func (r Retry) Value() (driver.Value, error) {
 	return types.Value(r)
 }
 
+const (
+	// defaultThrottleSleepMS 被限流时重试等待时间的默认基数
+	defaultThrottleSleepMS = uint(10000)
+	// maxThrottleSleepMS 被限流时单次重试的最大等待时间
+	maxThrottleSleepMS = uint(300000)
+)
+
 // RetryPolicy define retry policy.
 type RetryPolicy struct {
 	// Count 重试次数
 	Count uint `json:"count" validate:"required"`
-	// SleepRangeMS 重试睡眠周期随机数范围
+	// SleepRangeMS 重试睡眠周期随机数范围，开启指数退避时，SleepRangeMS[0]为等待时间基数，SleepRangeMS[1]为最大等待时间
 	SleepRangeMS [2]uint `json:"sleep_range_ms" validate:"required,min=2"`
+	// Exponential 是否开启指数退避，不开启时每次重试前在 [SleepRangeMS[0], SleepRangeMS[1]] 毫秒范围内随机等待
+	Exponential bool `json:"exponential,omitempty"`
+	// ThrottleSleepMS 被限流时重试等待时间的基数，按照指数增长，不设置时默认为10s
+	ThrottleSleepMS uint `json:"throttle_sleep_ms,omitempty"`
 }
 
 // Validate RetryPolicy.
 func (rp RetryPolicy) Validate() error {
-	return validator.Validate.Struct(rp)
+	if err := validator.Validate.Struct(rp); err != nil {
+		return err
+	}
+
+	if rp.SleepRangeMS[0] > rp.SleepRangeMS[1] {
+		return errors.New("sleep_range_ms[0] should <= sleep_range_ms[1]")
+	}
+
+	return nil
+}
+
+// Backoff 返回第 retried 次（从0开始）执行失败后，下次重试前的等待时间。
+// 如果错误实现了 RetryAfter 接口（如被限流），按照限流退避策略等待更长的时间。
+func (rp RetryPolicy) Backoff(retried uint, err error) time.Duration {
+	var throttled interface{ RetryAfter() time.Duration }
+	if err != nil && errors.As(err, &throttled) {
+		base := rp.ThrottleSleepMS
+		if base == 0 {
+			base = defaultThrottleSleepMS
+		}
+
+		wait := withJitter(exponentialMS(base, retried, maxThrottleSleepMS))
+		maxWait := time.Duration(maxThrottleSleepMS) * time.Millisecond
+		if after := throttled.RetryAfter(); after > wait {
+			wait = min(after, maxWait)
+		}
+		return wait
+	}
+
+	if !rp.Exponential {
+		return randomRangeMS(rp.SleepRangeMS[0], rp.SleepRangeMS[1])
+	}
+
+	return withJitter(exponentialMS(rp.SleepRangeMS[0], retried, rp.SleepRangeMS[1]))
+}
+
+// MaxBackoff 返回单次重试等待时间的上限。
+func (rp RetryPolicy) MaxBackoff() time.Duration {
+	return time.Duration(max(rp.SleepRangeMS[1], maxThrottleSleepMS)) * time.Millisecond
+}
+
+// exponentialMS 返回 baseMS * 2^retried 毫秒，最大不超过 maxMS
+func exponentialMS(baseMS, retried, maxMS uint) time.Duration {
+	waitMS := maxMS
+	if retried < 32 && baseMS<<retried < maxMS {
+		waitMS = baseMS << retried
+	}
+
+	return time.Duration(waitMS) * time.Millisecond
+}
+
+// randomRangeMS 返回 [minMS, maxMS] 范围内随机的等待时间
+func randomRangeMS(minMS, maxMS uint) time.Duration {
+	if maxMS <= minMS {
+		return time.Duration(minMS) * time.Millisecond
+	}
+
+	return time.Duration(minMS+uint(rand.Int63n(int64(maxMS-minMS)+1))) * time.Millisecond
+}
+
+// withJitter 在 [wait/2, wait] 范围内随机，避免大量任务同时重试
+func withJitter(wait time.Duration) time.Duration {
+	half := wait / 2
+	if half <= 0 {
+		return wait
+	}
+
+	return half + time.Duration(rand.Int63n(int64(half)+1))
 }
 
 // NewRetryWithPolicy return retry with policy
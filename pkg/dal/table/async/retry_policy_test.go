@@ -0,0 +1,92 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tableasync
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	cases := []struct {
+		name   string
+		policy RetryPolicy
+		min    time.Duration
+		max    time.Duration
+	}{
+		{
+			name:   "random range",
+			policy: RetryPolicy{Count: 3, SleepRangeMS: [2]uint{100, 200}},
+			min:    100 * time.Millisecond,
+			max:    200 * time.Millisecond,
+		},
+		{
+			name:   "fixed range",
+			policy: RetryPolicy{Count: 3, SleepRangeMS: [2]uint{100, 100}},
+			min:    100 * time.Millisecond,
+			max:    100 * time.Millisecond,
+		},
+		{
+			name:   "exponential",
+			policy: RetryPolicy{Count: 3, SleepRangeMS: [2]uint{100, 1000}, Exponential: true},
+			min:    50 * time.Millisecond,
+			max:    1000 * time.Millisecond,
+		},
+	}
+
+	for _, c := range cases {
+		for retried := uint(0); retried < c.policy.Count; retried++ {
+			wait := c.policy.Backoff(retried, errors.New("failed"))
+			if wait < c.min || wait > c.max {
+				t.Errorf("%s: backoff of retry %d should in [%s, %s], got: %s", c.name, retried, c.min, c.max, wait)
+			}
+		}
+	}
+}
+
+func TestRetryRunWaitsBackoff(t *testing.T) {
+	retry := Retry{Enable: true, Policy: &RetryPolicy{Count: 3, SleepRangeMS: [2]uint{50, 80}}}
+
+	runTimes := make([]time.Time, 0, retry.Policy.Count)
+	_, err := retry.Run(func() (bool, any, error) {
+		runTimes = append(runTimes, time.Now())
+		return false, nil, errors.New("failed")
+	})
+	if !errors.Is(err, ErrRetryExhausted) {
+		t.Fatalf("run should return ErrRetryExhausted, got: %v", err)
+	}
+	if len(runTimes) != int(retry.Policy.Count) {
+		t.Fatalf("do should run %d times, got: %d", retry.Policy.Count, len(runTimes))
+	}
+
+	for i := 1; i < len(runTimes); i++ {
+		if wait := runTimes[i].Sub(runTimes[i-1]); wait < 50*time.Millisecond {
+			t.Errorf("retry %d should wait at least 50ms, got: %s", i, wait)
+		}
+	}
+}
+
+func TestRetryPolicyValidate(t *testing.T) {
+	policy := RetryPolicy{Count: 1, SleepRangeMS: [2]uint{200, 100}}
+	if err := policy.Validate(); err == nil {
+		t.Errorf("sleep_range_ms[0] > sleep_range_ms[1] should be invalid")
+	}
+}
@@ -21,6 +21,7 @@ package tableasync
 
 import (
 	"errors"
+	"time"
 
 	"hcm/pkg/criteria/enumor"
 	"hcm/pkg/criteria/validator"
@@ -30,7 +31,9 @@ import (
 )
 
 // AsyncFlowTaskColumns defines all the async_flow_task table's columns.
-var AsyncFlowTaskColumns = utils.MergeColumns(nil, AsyncFlowTaskTableColumnDescriptor)
+// started_at 为任务首次开始运行的时间，ended_at 为任务运行结束的时间，创建时均使用数据库默认值（创建时间）。
+var AsyncFlowTaskColumns = utils.MergeColumns(utils.InsertWithoutColumns("started_at", "ended_at"),
+	AsyncFlowTaskTableColumnDescriptor)
 
 // AsyncFlowTaskTableColumnDescriptor is async_flow_task's column descriptors.
 var AsyncFlowTaskTableColumnDescriptor = utils.ColumnDescriptors{
@@ -41,10 +44,13 @@ var AsyncFlowTaskTableColumnDescriptor = utils.ColumnDescriptors{
 	{Column: "action_name", NamedC: "action_name", Type: enumor.String},
 	{Column: "params", NamedC: "params", Type: enumor.Json},
 	{Column: "retry", NamedC: "retry", Type: enumor.Json},
+	{Column: "timeout_sec", NamedC: "timeout_sec", Type: enumor.Numeric},
 	{Column: "depend_on", NamedC: "depend_on", Type: enumor.Json},
 	{Column: "state", NamedC: "state", Type: enumor.String},
 	{Column: "reason", NamedC: "reason", Type: enumor.Json},
 	{Column: "result", NamedC: "result", Type: enumor.Json},
+	{Column: "started_at", NamedC: "started_at", Type: enumor.Time},
+	{Column: "ended_at", NamedC: "ended_at", Type: enumor.Time},
 	{Column: "creator", NamedC: "creator", Type: enumor.String},
 	{Column: "reviser", NamedC: "reviser", Type: enumor.String},
 	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
@@ -60,10 +66,13 @@ type AsyncFlowTaskTable struct {
 	ActionName enumor.ActionName `db:"action_name" json:"action_name"`
 	Params     types.JsonField   `db:"params" json:"params"`
 	Retry      *Retry            `db:"retry" json:"retry"`
+	TimeoutSec uint              `db:"timeout_sec" json:"timeout_sec"`
 	DependOn   types.StringArray `db:"depend_on" json:"depend_on"`
 	State      enumor.TaskState  `db:"state" json:"state"`
 	Reason     *Reason           `db:"reason" json:"reason"`
 	Result     types.JsonField   `db:"result" json:"result"`
+	StartedAt  time.Time         `db:"started_at" json:"started_at"`
+	EndedAt    time.Time         `db:"ended_at" json:"ended_at"`
 	Creator    string            `db:"creator" json:"creator" validate:"lte=64"`
 	Reviser    string            `db:"reviser" json:"reviser" validate:"lte=64"`
 	CreatedAt  types.Time        `db:"created_at" json:"created_at" validate:"excluded_unless"`
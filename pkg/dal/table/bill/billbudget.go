@@ -0,0 +1,216 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bill
+
+import (
+	"errors"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/table"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/dal/table/utils"
+)
+
+// AccountBillBudgetColumns defines account_bill_budget's columns.
+var AccountBillBudgetColumns = utils.MergeColumns(nil, AccountBillBudgetColumnDescriptor)
+
+// AccountBillBudgetColumnDescriptor is account_bill_budget's column descriptors.
+var AccountBillBudgetColumnDescriptor = utils.ColumnDescriptors{
+	{Column: "id", NamedC: "id", Type: enumor.String},
+	{Column: "name", NamedC: "name", Type: enumor.String},
+	{Column: "scope", NamedC: "scope", Type: enumor.String},
+	{Column: "scope_id", NamedC: "scope_id", Type: enumor.String},
+	{Column: "amount", NamedC: "amount", Type: enumor.Numeric},
+	{Column: "thresholds", NamedC: "thresholds", Type: enumor.Json},
+	{Column: "receivers", NamedC: "receivers", Type: enumor.Json},
+	{Column: "webhook_url", NamedC: "webhook_url", Type: enumor.String},
+	{Column: "memo", NamedC: "memo", Type: enumor.String},
+	{Column: "creator", NamedC: "creator", Type: enumor.String},
+	{Column: "reviser", NamedC: "reviser", Type: enumor.String},
+	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
+	{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
+}
+
+// AccountBillBudget 月度预算
+type AccountBillBudget struct {
+	// ID 自增ID
+	ID string `db:"id" validate:"lte=64" json:"id"`
+	// Name 预算名称
+	Name string `db:"name" validate:"max=128" json:"name"`
+	// Scope 预算作用范围
+	Scope enumor.BillBudgetScope `db:"scope" json:"scope"`
+	// ScopeID 作用范围对应的ID，业务ID、运营产品ID或账号ID
+	ScopeID string `db:"scope_id" validate:"max=64" json:"scope_id"`
+	// Amount 月度预算金额，单位人民币
+	Amount *types.Decimal `db:"amount" json:"amount"`
+	// Thresholds 告警阈值
+	Thresholds types.JsonField `db:"thresholds" json:"thresholds"`
+	// Receivers 告警邮件接收人
+	Receivers types.JsonField `db:"receivers" json:"receivers"`
+	// WebhookUrl 告警回调地址
+	WebhookUrl *string `db:"webhook_url" validate:"omitempty,max=512" json:"webhook_url"`
+	// Memo 备注
+	Memo *string `db:"memo" validate:"omitempty,max=255" json:"memo"`
+
+	// Creator 创建者
+	Creator string `db:"creator" validate:"max=64" json:"creator"`
+	// Reviser 更新者
+	Reviser string `db:"reviser" validate:"max=64" json:"reviser"`
+	// CreatedAt 创建时间
+	CreatedAt types.Time `db:"created_at" json:"created_at"`
+	// UpdatedAt 更新时间
+	UpdatedAt types.Time `db:"updated_at" json:"updated_at"`
+}
+
+// TableName 返回预算表名
+func (b *AccountBillBudget) TableName() table.Name {
+	return table.AccountBillBudgetTable
+}
+
+// InsertValidate validate account bill budget on insert
+func (b *AccountBillBudget) InsertValidate() error {
+	if len(b.ID) == 0 {
+		return errors.New("id is required")
+	}
+	if len(b.Name) == 0 {
+		return errors.New("name is required")
+	}
+	if err := b.Scope.Validate(); err != nil {
+		return err
+	}
+	if len(b.ScopeID) == 0 {
+		return errors.New("scope_id is required")
+	}
+	if b.Amount == nil {
+		return errors.New("amount is required")
+	}
+	if len(b.Thresholds) == 0 {
+		return errors.New("thresholds is required")
+	}
+	return validator.Validate.Struct(b)
+}
+
+// UpdateValidate validate account bill budget on update
+func (b *AccountBillBudget) UpdateValidate() error {
+	if len(b.ID) == 0 {
+		return errors.New("id is required")
+	}
+	if len(b.Scope) != 0 || len(b.ScopeID) != 0 {
+		return errors.New("scope can not be updated")
+	}
+	return validator.Validate.Struct(b)
+}
+
+// AccountBillBudgetAlertColumns defines account_bill_budget_alert's columns.
+var AccountBillBudgetAlertColumns = utils.MergeColumns(nil, AccountBillBudgetAlertColumnDescriptor)
+
+// AccountBillBudgetAlertColumnDescriptor is account_bill_budget_alert's column descriptors.
+var AccountBillBudgetAlertColumnDescriptor = utils.ColumnDescriptors{
+	{Column: "id", NamedC: "id", Type: enumor.String},
+	{Column: "budget_id", NamedC: "budget_id", Type: enumor.String},
+	{Column: "bill_year", NamedC: "bill_year", Type: enumor.Numeric},
+	{Column: "bill_month", NamedC: "bill_month", Type: enumor.Numeric},
+	{Column: "alert_type", NamedC: "alert_type", Type: enumor.String},
+	{Column: "threshold", NamedC: "threshold", Type: enumor.Numeric},
+	{Column: "amount", NamedC: "amount", Type: enumor.Numeric},
+	{Column: "cost", NamedC: "cost", Type: enumor.Numeric},
+	{Column: "notify_state", NamedC: "notify_state", Type: enumor.String},
+	{Column: "notify_count", NamedC: "notify_count", Type: enumor.Numeric},
+	{Column: "creator", NamedC: "creator", Type: enumor.String},
+	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
+	{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
+}
+
+// AccountBillBudgetAlert 预算告警记录，同一预算每月每个阈值只告警一次
+type AccountBillBudgetAlert struct {
+	// ID 自增ID
+	ID string `db:"id" validate:"lte=64" json:"id"`
+	// BudgetID 预算ID
+	BudgetID string `db:"budget_id" validate:"max=64" json:"budget_id"`
+	// BillYear 账单年份
+	BillYear int `db:"bill_year" json:"bill_year"`
+	// BillMonth 账单月份
+	BillMonth int `db:"bill_month" json:"bill_month"`
+	// AlertType 告警类型
+	AlertType enumor.BillBudgetAlertType `db:"alert_type" json:"alert_type"`
+	// Threshold 触发的阈值百分比
+	Threshold int `db:"threshold" json:"threshold"`
+	// Amount 告警时的预算金额
+	Amount *types.Decimal `db:"amount" json:"amount"`
+	// Cost 告警时的实际或预测费用，单位人民币
+	Cost *types.Decimal `db:"cost" json:"cost"`
+	// NotifyState 通知状态，通知失败的告警在下次评估时重试
+	NotifyState enumor.BillBudgetNotifyState `db:"notify_state" json:"notify_state"`
+	// NotifyCount 已尝试通知的次数
+	NotifyCount *int `db:"notify_count" json:"notify_count"`
+
+	// Creator 创建者
+	Creator string `db:"creator" validate:"max=64" json:"creator"`
+	// CreatedAt 创建时间
+	CreatedAt types.Time `db:"created_at" json:"created_at"`
+	// UpdatedAt 更新时间
+	UpdatedAt types.Time `db:"updated_at" json:"updated_at"`
+}
+
+// TableName 返回预算告警记录表名
+func (a *AccountBillBudgetAlert) TableName() table.Name {
+	return table.AccountBillBudgetAlertTable
+}
+
+// InsertValidate validate account bill budget alert on insert
+func (a *AccountBillBudgetAlert) InsertValidate() error {
+	if len(a.ID) == 0 {
+		return errors.New("id is required")
+	}
+	if len(a.BudgetID) == 0 {
+		return errors.New("budget_id is required")
+	}
+	if a.BillYear == 0 || a.BillMonth == 0 {
+		return errors.New("bill_year and bill_month is required")
+	}
+	if err := a.AlertType.Validate(); err != nil {
+		return err
+	}
+	if a.Threshold <= 0 {
+		return errors.New("threshold should be positive")
+	}
+	if a.Amount == nil || a.Cost == nil {
+		return errors.New("amount and cost is required")
+	}
+	if err := a.NotifyState.Validate(); err != nil {
+		return err
+	}
+	return validator.Validate.Struct(a)
+}
+
+// UpdateValidate validate account bill budget alert on update, only notify state and count can be updated
+func (a *AccountBillBudgetAlert) UpdateValidate() error {
+	if len(a.BudgetID) != 0 || a.BillYear != 0 || a.BillMonth != 0 || len(a.AlertType) != 0 || a.Threshold != 0 ||
+		a.Amount != nil || a.Cost != nil {
+		return errors.New("only notify_state and notify_count can be updated")
+	}
+	if len(a.NotifyState) != 0 {
+		if err := a.NotifyState.Validate(); err != nil {
+			return err
+		}
+	}
+	return validator.Validate.Struct(a)
+}
@@ -51,6 +51,10 @@ var AccountBillItemColumnDescriptor = utils.ColumnDescriptors{
 	{Column: "hc_product_name", NamedC: "hc_product_name", Type: enumor.String},
 	{Column: "res_amount", NamedC: "res_amount", Type: enumor.Numeric},
 	{Column: "res_amount_unit", NamedC: "res_amount_unit", Type: enumor.String},
+	{Column: "cloud_res_id", NamedC: "cloud_res_id", Type: enumor.String},
+	{Column: "res_type", NamedC: "res_type", Type: enumor.String},
+	{Column: "res_id", NamedC: "res_id", Type: enumor.String},
+	{Column: "res_bk_biz_id", NamedC: "res_bk_biz_id", Type: enumor.Numeric},
 	{Column: "extension", NamedC: "extension", Type: enumor.Json},
 	{Column: "creator", NamedC: "creator", Type: enumor.String},
 	{Column: "reviser", NamedC: "reviser", Type: enumor.String},
@@ -92,6 +96,14 @@ type AccountBillItem struct {
 	ResAmount *types.Decimal `db:"res_amount" json:"res_amount,omitempty"`
 	// ResAmountUnit 用量单位
 	ResAmountUnit string `db:"res_amount_unit" json:"res_amount_unit,omitempty"`
+	// CloudResID 账单明细对应的云资源ID
+	CloudResID string `db:"cloud_res_id" validate:"max=255" json:"cloud_res_id"`
+	// ResType 关联到的HCM资源类型
+	ResType enumor.CloudResourceType `db:"res_type" json:"res_type"`
+	// ResID 关联到的HCM资源ID
+	ResID string `db:"res_id" validate:"max=64" json:"res_id"`
+	// ResBkBizID 关联到的HCM资源所属业务，未关联到资源时为0
+	ResBkBizID int64 `db:"res_bk_biz_id" json:"res_bk_biz_id"`
 	// Extension 云原始字段
 	Extension types.JsonField `db:"extension" json:"extension"`
 	// Creator 创建者
@@ -0,0 +1,128 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bill
+
+import (
+	"errors"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/table"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/dal/table/utils"
+)
+
+// AccountBillSplitRuleColumns defines account_bill_split_rule's columns.
+var AccountBillSplitRuleColumns = utils.MergeColumns(nil, AccountBillSplitRuleColumnDescriptor)
+
+// AccountBillSplitRuleColumnDescriptor is account_bill_split_rule's column descriptors.
+var AccountBillSplitRuleColumnDescriptor = utils.ColumnDescriptors{
+	{Column: "id", NamedC: "id", Type: enumor.String},
+	{Column: "name", NamedC: "name", Type: enumor.String},
+	{Column: "vendor", NamedC: "vendor", Type: enumor.String},
+	{Column: "root_account_id", NamedC: "root_account_id", Type: enumor.String},
+	{Column: "main_account_id", NamedC: "main_account_id", Type: enumor.String},
+	{Column: "priority", NamedC: "priority", Type: enumor.Numeric},
+	{Column: "split_type", NamedC: "split_type", Type: enumor.String},
+	{Column: "match_rule", NamedC: "match_rule", Type: enumor.Json},
+	{Column: "targets", NamedC: "targets", Type: enumor.Json},
+	{Column: "memo", NamedC: "memo", Type: enumor.String},
+	{Column: "creator", NamedC: "creator", Type: enumor.String},
+	{Column: "reviser", NamedC: "reviser", Type: enumor.String},
+	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
+	{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
+}
+
+// AccountBillSplitRule 共享账号的分账规则
+type AccountBillSplitRule struct {
+	// ID 自增ID
+	ID string `db:"id" validate:"lte=64" json:"id"`
+	// Name 规则名称
+	Name string `db:"name" validate:"max=128" json:"name"`
+	// Vendor 云厂商
+	Vendor enumor.Vendor `db:"vendor" json:"vendor"`
+	// RootAccountID 一级账号ID
+	RootAccountID string `db:"root_account_id" validate:"max=64" json:"root_account_id"`
+	// MainAccountID 二级账号ID
+	MainAccountID string `db:"main_account_id" validate:"max=64" json:"main_account_id"`
+	// Priority 优先级，数值越小越优先匹配
+	Priority *int64 `db:"priority" json:"priority"`
+	// SplitType 分摊方式
+	SplitType enumor.BillSplitType `db:"split_type" json:"split_type"`
+	// MatchRule 匹配条件
+	MatchRule types.JsonField `db:"match_rule" json:"match_rule"`
+	// Targets 分摊目标
+	Targets types.JsonField `db:"targets" json:"targets"`
+	// Memo 备注
+	Memo *string `db:"memo" validate:"omitempty,max=255" json:"memo"`
+
+	// Creator 创建者
+	Creator string `db:"creator" validate:"max=64" json:"creator"`
+	// Reviser 更新者
+	Reviser string `db:"reviser" validate:"max=64" json:"reviser"`
+	// CreatedAt 创建时间
+	CreatedAt types.Time `db:"created_at" json:"created_at"`
+	// UpdatedAt 更新时间
+	UpdatedAt types.Time `db:"updated_at" json:"updated_at"`
+}
+
+// TableName 返回分账规则表名
+func (r *AccountBillSplitRule) TableName() table.Name {
+	return table.AccountBillSplitRuleTable
+}
+
+// InsertValidate validate account bill split rule on insert
+func (r *AccountBillSplitRule) InsertValidate() error {
+	if len(r.ID) == 0 {
+		return errors.New("id is required")
+	}
+	if len(r.Name) == 0 {
+		return errors.New("name is required")
+	}
+	if len(r.Vendor) == 0 {
+		return errors.New("vendor is required")
+	}
+	if len(r.MainAccountID) == 0 {
+		return errors.New("main_account_id is required")
+	}
+	if r.Priority == nil {
+		return errors.New("priority is required")
+	}
+	if err := r.SplitType.Validate(); err != nil {
+		return err
+	}
+	if len(r.Targets) == 0 {
+		return errors.New("targets is required")
+	}
+	return validator.Validate.Struct(r)
+}
+
+// UpdateValidate validate account bill split rule on update
+func (r *AccountBillSplitRule) UpdateValidate() error {
+	if len(r.ID) == 0 {
+		return errors.New("id is required")
+	}
+	if len(r.SplitType) != 0 {
+		if err := r.SplitType.Validate(); err != nil {
+			return err
+		}
+	}
+	return validator.Validate.Struct(r)
+}
@@ -0,0 +1,114 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package bill
+
+import (
+	"errors"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/table"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/dal/table/utils"
+)
+
+// AccountBillSplitSnapshotColumns defines account_bill_split_snapshot's columns.
+var AccountBillSplitSnapshotColumns = utils.MergeColumns(nil, AccountBillSplitSnapshotColumnDescriptor)
+
+// AccountBillSplitSnapshotColumnDescriptor is account_bill_split_snapshot's column descriptors.
+var AccountBillSplitSnapshotColumnDescriptor = utils.ColumnDescriptors{
+	{Column: "id", NamedC: "id", Type: enumor.String},
+	{Column: "root_account_id", NamedC: "root_account_id", Type: enumor.String},
+	{Column: "main_account_id", NamedC: "main_account_id", Type: enumor.String},
+	{Column: "vendor", NamedC: "vendor", Type: enumor.String},
+	{Column: "bill_year", NamedC: "bill_year", Type: enumor.Numeric},
+	{Column: "bill_month", NamedC: "bill_month", Type: enumor.Numeric},
+	{Column: "bill_day", NamedC: "bill_day", Type: enumor.Numeric},
+	{Column: "cvm_counts", NamedC: "cvm_counts", Type: enumor.Json},
+	{Column: "creator", NamedC: "creator", Type: enumor.String},
+	{Column: "reviser", NamedC: "reviser", Type: enumor.String},
+	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
+	{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
+}
+
+// AccountBillSplitSnapshot 共享账号按天分账时使用的权重快照，重新分账时复用，避免使用当前的资源数据核算历史账单
+type AccountBillSplitSnapshot struct {
+	// ID 自增ID
+	ID string `db:"id" validate:"lte=64" json:"id"`
+	// RootAccountID 一级账号ID
+	RootAccountID string `db:"root_account_id" validate:"max=64" json:"root_account_id"`
+	// MainAccountID 二级账号ID
+	MainAccountID string `db:"main_account_id" validate:"max=64" json:"main_account_id"`
+	// Vendor 云厂商
+	Vendor enumor.Vendor `db:"vendor" json:"vendor"`
+	// BillYear 账单年份
+	BillYear int `db:"bill_year" json:"bill_year"`
+	// BillMonth 账单月份
+	BillMonth int `db:"bill_month" json:"bill_month"`
+	// BillDay 账单天
+	BillDay int `db:"bill_day" json:"bill_day"`
+	// CvmCounts 按主机数量分摊时各业务、地域的主机数量
+	CvmCounts types.JsonField `db:"cvm_counts" json:"cvm_counts"`
+
+	// Creator 创建者
+	Creator string `db:"creator" validate:"max=64" json:"creator"`
+	// Reviser 更新者
+	Reviser string `db:"reviser" validate:"max=64" json:"reviser"`
+	// CreatedAt 创建时间
+	CreatedAt types.Time `db:"created_at" json:"created_at"`
+	// UpdatedAt 更新时间
+	UpdatedAt types.Time `db:"updated_at" json:"updated_at"`
+}
+
+// TableName 返回分账权重快照表名
+func (s *AccountBillSplitSnapshot) TableName() table.Name {
+	return table.AccountBillSplitSnapshotTable
+}
+
+// InsertValidate validate account bill split snapshot on insert
+func (s *AccountBillSplitSnapshot) InsertValidate() error {
+	if len(s.ID) == 0 {
+		return errors.New("id is required")
+	}
+	if len(s.MainAccountID) == 0 {
+		return errors.New("main_account_id is required")
+	}
+	if len(s.Vendor) == 0 {
+		return errors.New("vendor is required")
+	}
+	if s.BillYear == 0 {
+		return errors.New("bill_year is required")
+	}
+	if s.BillMonth == 0 {
+		return errors.New("bill_month is required")
+	}
+	if s.BillDay == 0 {
+		return errors.New("bill_day is required")
+	}
+	return validator.Validate.Struct(s)
+}
+
+// UpdateValidate validate account bill split snapshot on update
+func (s *AccountBillSplitSnapshot) UpdateValidate() error {
+	if len(s.ID) == 0 {
+		return errors.New("id is required")
+	}
+	return validator.Validate.Struct(s)
+}
@@ -47,6 +47,7 @@ var AccountBillSummaryColumnVersionDescriptor = utils.ColumnDescriptors{
 	{Column: "currency", NamedC: "currency", Type: enumor.String},
 	{Column: "cost", NamedC: "cost", Type: enumor.Numeric},
 	{Column: "rmb_cost", NamedC: "rmb_cost", Type: enumor.Numeric},
+	{Column: "split_rule_snapshot", NamedC: "split_rule_snapshot", Type: enumor.Json},
 	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
 	{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
 }
@@ -77,6 +78,8 @@ type AccountBillSummaryVersion struct {
 	Cost *types.Decimal `db:"cost" json:"cost"`
 	// RMBCost 费用
 	RMBCost *types.Decimal `db:"rmb_cost" json:"rmb_cost"`
+	// SplitRuleSnapshot 记录版本时生效的分账规则快照
+	SplitRuleSnapshot types.JsonField `db:"split_rule_snapshot" json:"split_rule_snapshot"`
 	// CreatedAt 创建时间
 	CreatedAt types.Time `db:"created_at" json:"created_at"`
 	// UpdatedAt 更新时间
@@ -0,0 +1,126 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package tablebucket ...
+package tablebucket
+
+import (
+	"errors"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/table"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/dal/table/utils"
+)
+
+// BucketColumns defines all the bucket table's columns.
+var BucketColumns = utils.MergeColumns(nil, BucketColumnDescriptor)
+
+// BucketColumnDescriptor is bucket's column descriptors.
+var BucketColumnDescriptor = utils.ColumnDescriptors{
+	{Column: "id", NamedC: "id", Type: enumor.String},
+	{Column: "vendor", NamedC: "vendor", Type: enumor.String},
+	{Column: "account_id", NamedC: "account_id", Type: enumor.String},
+	{Column: "cloud_id", NamedC: "cloud_id", Type: enumor.String},
+	{Column: "bk_biz_id", NamedC: "bk_biz_id", Type: enumor.Numeric},
+	{Column: "name", NamedC: "name", Type: enumor.String},
+	{Column: "region", NamedC: "region", Type: enumor.String},
+	{Column: "storage_class", NamedC: "storage_class", Type: enumor.String},
+	{Column: "public_access", NamedC: "public_access", Type: enumor.String},
+	{Column: "encryption", NamedC: "encryption", Type: enumor.String},
+	{Column: "cloud_created_time", NamedC: "cloud_created_time", Type: enumor.String},
+	{Column: "memo", NamedC: "memo", Type: enumor.String},
+	{Column: "extension", NamedC: "extension", Type: enumor.Json},
+	{Column: "creator", NamedC: "creator", Type: enumor.String},
+	{Column: "reviser", NamedC: "reviser", Type: enumor.String},
+	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
+	{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
+}
+
+// BucketTable define bucket table.
+type BucketTable struct {
+	ID        string        `db:"id" json:"id" validate:"lte=64"`
+	Vendor    enumor.Vendor `db:"vendor" json:"vendor" validate:"lte=16"`
+	AccountID string        `db:"account_id" json:"account_id" validate:"lte=64"`
+	CloudID   string        `db:"cloud_id" json:"cloud_id" validate:"lte=255"`
+	BkBizID   int64         `db:"bk_biz_id" json:"bk_biz_id"`
+	Name      string        `db:"name" json:"name" validate:"lte=255"`
+	Region    string        `db:"region" json:"region" validate:"lte=128"`
+	// StorageClass 默认存储类型，各云厂商命名不同，直接记录云上存储类型
+	StorageClass string `db:"storage_class" json:"storage_class" validate:"lte=64"`
+	// PublicAccess 公共访问权限，取值见 enumor.BucketPublicAccess
+	PublicAccess enumor.BucketPublicAccess `db:"public_access" json:"public_access" validate:"lte=32"`
+	// Encryption 服务端加密算法，为空表示未开启加密
+	Encryption       string          `db:"encryption" json:"encryption" validate:"lte=64"`
+	CloudCreatedTime string          `db:"cloud_created_time" json:"cloud_created_time" validate:"lte=64"`
+	Memo             *string         `db:"memo" json:"memo" validate:"omitempty,lte=255"`
+	Extension        types.JsonField `db:"extension" json:"extension"`
+	Creator          string          `db:"creator" json:"creator" validate:"lte=64"`
+	Reviser          string          `db:"reviser" json:"reviser" validate:"lte=64"`
+	CreatedAt        types.Time      `db:"created_at" json:"created_at" validate:"excluded_unless"`
+	UpdatedAt        types.Time      `db:"updated_at" json:"updated_at" validate:"excluded_unless"`
+}
+
+// TableName return bucket table name.
+func (d BucketTable) TableName() table.Name {
+	return table.BucketTable
+}
+
+// InsertValidate validate bucket table on insert.
+func (d BucketTable) InsertValidate() error {
+	if err := validator.Validate.Struct(d); err != nil {
+		return err
+	}
+
+	if len(d.Vendor) == 0 {
+		return errors.New("vendor can not be empty")
+	}
+
+	if len(d.AccountID) == 0 {
+		return errors.New("account_id can not be empty")
+	}
+
+	if len(d.CloudID) == 0 {
+		return errors.New("cloud_id can not be empty")
+	}
+
+	if len(d.Creator) == 0 {
+		return errors.New("creator can not be empty")
+	}
+
+	return nil
+}
+
+// UpdateValidate validate bucket table on update.
+func (d BucketTable) UpdateValidate() error {
+	if err := validator.Validate.Struct(d); err != nil {
+		return err
+	}
+
+	if len(d.Creator) != 0 {
+		return errors.New("creator can not update")
+	}
+
+	if len(d.Reviser) == 0 {
+		return errors.New("reviser can not be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package tabledbinstance ...
+package tabledbinstance
+
+import (
+	"errors"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/table"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/dal/table/utils"
+)
+
+// DatabaseInstanceColumns defines all the database instance table's columns.
+var DatabaseInstanceColumns = utils.MergeColumns(nil, DatabaseInstanceColumnDescriptor)
+
+// DatabaseInstanceColumnDescriptor is database instance's column descriptors.
+var DatabaseInstanceColumnDescriptor = utils.ColumnDescriptors{
+	{Column: "id", NamedC: "id", Type: enumor.String},
+	{Column: "vendor", NamedC: "vendor", Type: enumor.String},
+	{Column: "account_id", NamedC: "account_id", Type: enumor.String},
+	{Column: "cloud_id", NamedC: "cloud_id", Type: enumor.String},
+	{Column: "bk_biz_id", NamedC: "bk_biz_id", Type: enumor.Numeric},
+	{Column: "name", NamedC: "name", Type: enumor.String},
+	{Column: "region", NamedC: "region", Type: enumor.String},
+	{Column: "zone", NamedC: "zone", Type: enumor.String},
+	{Column: "status", NamedC: "status", Type: enumor.String},
+	{Column: "engine", NamedC: "engine", Type: enumor.String},
+	{Column: "engine_version", NamedC: "engine_version", Type: enumor.String},
+	{Column: "instance_class", NamedC: "instance_class", Type: enumor.String},
+	{Column: "storage_size", NamedC: "storage_size", Type: enumor.Numeric},
+	{Column: "charge_type", NamedC: "charge_type", Type: enumor.String},
+	{Column: "vpc_id", NamedC: "vpc_id", Type: enumor.String},
+	{Column: "cloud_vpc_id", NamedC: "cloud_vpc_id", Type: enumor.String},
+	{Column: "subnet_id", NamedC: "subnet_id", Type: enumor.String},
+	{Column: "cloud_subnet_id", NamedC: "cloud_subnet_id", Type: enumor.String},
+	{Column: "private_address", NamedC: "private_address", Type: enumor.String},
+	{Column: "public_address", NamedC: "public_address", Type: enumor.String},
+	{Column: "port", NamedC: "port", Type: enumor.Numeric},
+	{Column: "cloud_created_time", NamedC: "cloud_created_time", Type: enumor.String},
+	{Column: "cloud_expired_time", NamedC: "cloud_expired_time", Type: enumor.String},
+	{Column: "recycle_status", NamedC: "recycle_status", Type: enumor.String},
+	{Column: "memo", NamedC: "memo", Type: enumor.String},
+	{Column: "extension", NamedC: "extension", Type: enumor.Json},
+	{Column: "creator", NamedC: "creator", Type: enumor.String},
+	{Column: "reviser", NamedC: "reviser", Type: enumor.String},
+	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
+	{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
+}
+
+// DatabaseInstanceTable define database instance table.
+type DatabaseInstanceTable struct {
+	ID        string        `db:"id" json:"id" validate:"lte=64"`
+	Vendor    enumor.Vendor `db:"vendor" json:"vendor" validate:"lte=16"`
+	AccountID string        `db:"account_id" json:"account_id" validate:"lte=64"`
+	CloudID   string        `db:"cloud_id" json:"cloud_id" validate:"lte=255"`
+	BkBizID   int64         `db:"bk_biz_id" json:"bk_biz_id"`
+	Name      string        `db:"name" json:"name" validate:"lte=255"`
+	Region    string        `db:"region" json:"region" validate:"lte=128"`
+	Zone      string        `db:"zone" json:"zone" validate:"lte=128"`
+	Status    string        `db:"status" json:"status" validate:"lte=64"`
+	// Engine 数据库引擎，如 mysql、postgres、sqlserver
+	Engine        string `db:"engine" json:"engine" validate:"lte=64"`
+	EngineVersion string `db:"engine_version" json:"engine_version" validate:"lte=64"`
+	// InstanceClass 实例规格，各云厂商规格命名不同，直接记录云上规格名
+	InstanceClass string `db:"instance_class" json:"instance_class" validate:"lte=128"`
+	// StorageSize 存储容量，单位GB
+	StorageSize int64  `db:"storage_size" json:"storage_size"`
+	ChargeType  string `db:"charge_type" json:"charge_type" validate:"lte=64"`
+	// VpcID 所属VPC，VPC未同步到本地或实例未接入VPC时为空
+	VpcID            string          `db:"vpc_id" json:"vpc_id" validate:"lte=64"`
+	CloudVpcID       string          `db:"cloud_vpc_id" json:"cloud_vpc_id" validate:"lte=255"`
+	SubnetID         string          `db:"subnet_id" json:"subnet_id" validate:"lte=64"`
+	CloudSubnetID    string          `db:"cloud_subnet_id" json:"cloud_subnet_id" validate:"lte=255"`
+	PrivateAddress   string          `db:"private_address" json:"private_address" validate:"lte=255"`
+	PublicAddress    string          `db:"public_address" json:"public_address" validate:"lte=255"`
+	Port             int64           `db:"port" json:"port"`
+	CloudCreatedTime string          `db:"cloud_created_time" json:"cloud_created_time" validate:"lte=64"`
+	CloudExpiredTime string          `db:"cloud_expired_time" json:"cloud_expired_time" validate:"lte=64"`
+	RecycleStatus    string          `db:"recycle_status" json:"recycle_status" validate:"lte=32"`
+	Memo             *string         `db:"memo" json:"memo" validate:"omitempty,lte=255"`
+	Extension        types.JsonField `db:"extension" json:"extension"`
+	Creator          string          `db:"creator" json:"creator" validate:"lte=64"`
+	Reviser          string          `db:"reviser" json:"reviser" validate:"lte=64"`
+	CreatedAt        types.Time      `db:"created_at" json:"created_at" validate:"excluded_unless"`
+	UpdatedAt        types.Time      `db:"updated_at" json:"updated_at" validate:"excluded_unless"`
+}
+
+// TableName return database instance table name.
+func (d DatabaseInstanceTable) TableName() table.Name {
+	return table.DatabaseInstanceTable
+}
+
+// InsertValidate validate database instance table on insert.
+func (d DatabaseInstanceTable) InsertValidate() error {
+	if err := validator.Validate.Struct(d); err != nil {
+		return err
+	}
+
+	if len(d.Vendor) == 0 {
+		return errors.New("vendor can not be empty")
+	}
+
+	if len(d.AccountID) == 0 {
+		return errors.New("account_id can not be empty")
+	}
+
+	if len(d.CloudID) == 0 {
+		return errors.New("cloud_id can not be empty")
+	}
+
+	if len(d.Creator) == 0 {
+		return errors.New("creator can not be empty")
+	}
+
+	return nil
+}
+
+// UpdateValidate validate database instance table on update.
+func (d DatabaseInstanceTable) UpdateValidate() error {
+	if err := validator.Validate.Struct(d); err != nil {
+		return err
+	}
+
+	if len(d.Creator) != 0 {
+		return errors.New("creator can not update")
+	}
+
+	if len(d.Reviser) == 0 {
+		return errors.New("reviser can not be empty")
+	}
+
+	return nil
+}
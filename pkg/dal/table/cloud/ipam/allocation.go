@@ -0,0 +1,110 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tableipam
+
+import (
+	"errors"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/table"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/dal/table/utils"
+)
+
+// AllocationColumns defines all the ipam_allocation table's columns.
+var AllocationColumns = utils.MergeColumns(nil, AllocationColumnDescriptor)
+
+// AllocationColumnDescriptor is ipam_allocation's column descriptors.
+var AllocationColumnDescriptor = utils.ColumnDescriptors{
+	{Column: "id", NamedC: "id", Type: enumor.String},
+	{Column: "pool_id", NamedC: "pool_id", Type: enumor.String},
+	{Column: "cidr", NamedC: "cidr", Type: enumor.String},
+	{Column: "res_type", NamedC: "res_type", Type: enumor.String},
+	{Column: "res_id", NamedC: "res_id", Type: enumor.String},
+	{Column: "memo", NamedC: "memo", Type: enumor.String},
+	{Column: "creator", NamedC: "creator", Type: enumor.String},
+	{Column: "reviser", NamedC: "reviser", Type: enumor.String},
+	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
+	{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
+}
+
+// AllocationTable define ipam allocation table.
+type AllocationTable struct {
+	ID     string `db:"id" json:"id" validate:"lte=64"`
+	PoolID string `db:"pool_id" json:"pool_id" validate:"lte=64"`
+	Cidr   string `db:"cidr" json:"cidr" validate:"lte=64"`
+	// ResType 分配网段的资源类型，vpc 或 subnet
+	ResType enumor.CloudResourceType `db:"res_type" json:"res_type" validate:"lte=64"`
+	// ResID 使用该网段的资源ID，资源创建成功前为空，此时网段处于预留状态
+	ResID     string     `db:"res_id" json:"res_id" validate:"lte=64"`
+	Memo      *string    `db:"memo" json:"memo" validate:"omitempty,lte=255"`
+	Creator   string     `db:"creator" json:"creator" validate:"lte=64"`
+	Reviser   string     `db:"reviser" json:"reviser" validate:"lte=64"`
+	CreatedAt types.Time `db:"created_at" json:"created_at" validate:"excluded_unless"`
+	UpdatedAt types.Time `db:"updated_at" json:"updated_at" validate:"excluded_unless"`
+}
+
+// TableName return ipam allocation table name.
+func (a AllocationTable) TableName() table.Name {
+	return table.IpamAllocationTable
+}
+
+// InsertValidate validate ipam allocation table on insert.
+func (a AllocationTable) InsertValidate() error {
+	if err := validator.Validate.Struct(a); err != nil {
+		return err
+	}
+
+	if len(a.PoolID) == 0 {
+		return errors.New("pool_id can not be empty")
+	}
+
+	if len(a.Cidr) == 0 {
+		return errors.New("cidr can not be empty")
+	}
+
+	if len(a.ResType) == 0 {
+		return errors.New("res_type can not be empty")
+	}
+
+	if len(a.Creator) == 0 {
+		return errors.New("creator can not be empty")
+	}
+
+	return nil
+}
+
+// UpdateValidate validate ipam allocation table on update.
+func (a AllocationTable) UpdateValidate() error {
+	if err := validator.Validate.Struct(a); err != nil {
+		return err
+	}
+
+	if len(a.Creator) != 0 {
+		return errors.New("creator can not update")
+	}
+
+	if len(a.Reviser) == 0 {
+		return errors.New("reviser can not be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package tableipam ...
+package tableipam
+
+import (
+	"errors"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/table"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/dal/table/utils"
+)
+
+// PoolColumns defines all the ipam_pool table's columns.
+var PoolColumns = utils.MergeColumns(nil, PoolColumnDescriptor)
+
+// PoolColumnDescriptor is ipam_pool's column descriptors.
+var PoolColumnDescriptor = utils.ColumnDescriptors{
+	{Column: "id", NamedC: "id", Type: enumor.String},
+	{Column: "name", NamedC: "name", Type: enumor.String},
+	{Column: "vendor", NamedC: "vendor", Type: enumor.String},
+	{Column: "region", NamedC: "region", Type: enumor.String},
+	{Column: "bk_biz_id", NamedC: "bk_biz_id", Type: enumor.Numeric},
+	{Column: "cidr", NamedC: "cidr", Type: enumor.String},
+	{Column: "memo", NamedC: "memo", Type: enumor.String},
+	{Column: "creator", NamedC: "creator", Type: enumor.String},
+	{Column: "reviser", NamedC: "reviser", Type: enumor.String},
+	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
+	{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
+}
+
+// PoolTable define ipam pool table.
+type PoolTable struct {
+	ID     string        `db:"id" json:"id" validate:"lte=64"`
+	Name   string        `db:"name" json:"name" validate:"lte=255"`
+	Vendor enumor.Vendor `db:"vendor" json:"vendor" validate:"lte=16"`
+	// Region 地址池所属地域，zenlayer 等全局 vpc 的地址池地域为空
+	Region  string `db:"region" json:"region" validate:"lte=128"`
+	BkBizID int64  `db:"bk_biz_id" json:"bk_biz_id"`
+	// Cidr 地址池网段，vpc、子网从该网段中分配
+	Cidr      string     `db:"cidr" json:"cidr" validate:"lte=64"`
+	Memo      *string    `db:"memo" json:"memo" validate:"omitempty,lte=255"`
+	Creator   string     `db:"creator" json:"creator" validate:"lte=64"`
+	Reviser   string     `db:"reviser" json:"reviser" validate:"lte=64"`
+	CreatedAt types.Time `db:"created_at" json:"created_at" validate:"excluded_unless"`
+	UpdatedAt types.Time `db:"updated_at" json:"updated_at" validate:"excluded_unless"`
+}
+
+// TableName return ipam pool table name.
+func (p PoolTable) TableName() table.Name {
+	return table.IpamPoolTable
+}
+
+// InsertValidate validate ipam pool table on insert.
+func (p PoolTable) InsertValidate() error {
+	if err := validator.Validate.Struct(p); err != nil {
+		return err
+	}
+
+	if len(p.Name) == 0 {
+		return errors.New("name can not be empty")
+	}
+
+	if len(p.Vendor) == 0 {
+		return errors.New("vendor can not be empty")
+	}
+
+	if len(p.Cidr) == 0 {
+		return errors.New("cidr can not be empty")
+	}
+
+	if len(p.Creator) == 0 {
+		return errors.New("creator can not be empty")
+	}
+
+	return nil
+}
+
+// UpdateValidate validate ipam pool table on update.
+func (p PoolTable) UpdateValidate() error {
+	if err := validator.Validate.Struct(p); err != nil {
+		return err
+	}
+
+	if len(p.Creator) != 0 {
+		return errors.New("creator can not update")
+	}
+
+	if len(p.Reviser) == 0 {
+		return errors.New("reviser can not be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package tablek8s ...
+package tablek8s
+
+import (
+	"errors"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/table"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/dal/table/utils"
+)
+
+// K8sClusterColumns defines all the k8s cluster table's columns.
+var K8sClusterColumns = utils.MergeColumns(nil, K8sClusterColumnDescriptor)
+
+// K8sClusterColumnDescriptor is k8s cluster's column descriptors.
+var K8sClusterColumnDescriptor = utils.ColumnDescriptors{
+	{Column: "id", NamedC: "id", Type: enumor.String},
+	{Column: "vendor", NamedC: "vendor", Type: enumor.String},
+	{Column: "account_id", NamedC: "account_id", Type: enumor.String},
+	{Column: "cloud_id", NamedC: "cloud_id", Type: enumor.String},
+	{Column: "bk_biz_id", NamedC: "bk_biz_id", Type: enumor.Numeric},
+	{Column: "name", NamedC: "name", Type: enumor.String},
+	{Column: "region", NamedC: "region", Type: enumor.String},
+	{Column: "status", NamedC: "status", Type: enumor.String},
+	{Column: "version", NamedC: "version", Type: enumor.String},
+	{Column: "cloud_vpc_id", NamedC: "cloud_vpc_id", Type: enumor.String},
+	{Column: "cloud_created_time", NamedC: "cloud_created_time", Type: enumor.String},
+	{Column: "memo", NamedC: "memo", Type: enumor.String},
+	{Column: "extension", NamedC: "extension", Type: enumor.Json},
+	{Column: "creator", NamedC: "creator", Type: enumor.String},
+	{Column: "reviser", NamedC: "reviser", Type: enumor.String},
+	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
+	{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
+}
+
+// K8sClusterTable define k8s cluster table.
+type K8sClusterTable struct {
+	ID        string        `db:"id" json:"id" validate:"lte=64"`
+	Vendor    enumor.Vendor `db:"vendor" json:"vendor" validate:"lte=16"`
+	AccountID string        `db:"account_id" json:"account_id" validate:"lte=64"`
+	CloudID   string        `db:"cloud_id" json:"cloud_id" validate:"lte=255"`
+	BkBizID   int64         `db:"bk_biz_id" json:"bk_biz_id"`
+	Name      string        `db:"name" json:"name" validate:"lte=255"`
+	Region    string        `db:"region" json:"region" validate:"lte=128"`
+	// Status 集群状态，各云厂商取值不同，直接记录云上状态
+	Status string `db:"status" json:"status" validate:"lte=64"`
+	// Version Kubernetes 版本
+	Version          string          `db:"version" json:"version" validate:"lte=64"`
+	CloudVpcID       string          `db:"cloud_vpc_id" json:"cloud_vpc_id" validate:"lte=255"`
+	CloudCreatedTime string          `db:"cloud_created_time" json:"cloud_created_time" validate:"lte=64"`
+	Memo             *string         `db:"memo" json:"memo" validate:"omitempty,lte=255"`
+	Extension        types.JsonField `db:"extension" json:"extension"`
+	Creator          string          `db:"creator" json:"creator" validate:"lte=64"`
+	Reviser          string          `db:"reviser" json:"reviser" validate:"lte=64"`
+	CreatedAt        types.Time      `db:"created_at" json:"created_at" validate:"excluded_unless"`
+	UpdatedAt        types.Time      `db:"updated_at" json:"updated_at" validate:"excluded_unless"`
+}
+
+// TableName return k8s cluster table name.
+func (d K8sClusterTable) TableName() table.Name {
+	return table.K8sClusterTable
+}
+
+// InsertValidate validate k8s cluster table on insert.
+func (d K8sClusterTable) InsertValidate() error {
+	if err := validator.Validate.Struct(d); err != nil {
+		return err
+	}
+
+	if len(d.Vendor) == 0 {
+		return errors.New("vendor can not be empty")
+	}
+
+	if len(d.AccountID) == 0 {
+		return errors.New("account_id can not be empty")
+	}
+
+	if len(d.CloudID) == 0 {
+		return errors.New("cloud_id can not be empty")
+	}
+
+	if len(d.Creator) == 0 {
+		return errors.New("creator can not be empty")
+	}
+
+	return nil
+}
+
+// UpdateValidate validate k8s cluster table on update.
+func (d K8sClusterTable) UpdateValidate() error {
+	if err := validator.Validate.Struct(d); err != nil {
+		return err
+	}
+
+	if len(d.Creator) != 0 {
+		return errors.New("creator can not update")
+	}
+
+	if len(d.Reviser) == 0 {
+		return errors.New("reviser can not be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tablek8s
+
+import (
+	"errors"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/table"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/dal/table/utils"
+)
+
+// K8sNodePoolColumns defines all the k8s node pool table's columns.
+var K8sNodePoolColumns = utils.MergeColumns(nil, K8sNodePoolColumnDescriptor)
+
+// K8sNodePoolColumnDescriptor is k8s node pool's column descriptors.
+var K8sNodePoolColumnDescriptor = utils.ColumnDescriptors{
+	{Column: "id", NamedC: "id", Type: enumor.String},
+	{Column: "vendor", NamedC: "vendor", Type: enumor.String},
+	{Column: "account_id", NamedC: "account_id", Type: enumor.String},
+	{Column: "cluster_id", NamedC: "cluster_id", Type: enumor.String},
+	{Column: "cloud_cluster_id", NamedC: "cloud_cluster_id", Type: enumor.String},
+	{Column: "cloud_id", NamedC: "cloud_id", Type: enumor.String},
+	{Column: "name", NamedC: "name", Type: enumor.String},
+	{Column: "region", NamedC: "region", Type: enumor.String},
+	{Column: "status", NamedC: "status", Type: enumor.String},
+	{Column: "instance_type", NamedC: "instance_type", Type: enumor.String},
+	{Column: "node_count", NamedC: "node_count", Type: enumor.Numeric},
+	{Column: "min_size", NamedC: "min_size", Type: enumor.Numeric},
+	{Column: "max_size", NamedC: "max_size", Type: enumor.Numeric},
+	{Column: "creator", NamedC: "creator", Type: enumor.String},
+	{Column: "reviser", NamedC: "reviser", Type: enumor.String},
+	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
+	{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
+}
+
+// K8sNodePoolTable define k8s node pool table.
+type K8sNodePoolTable struct {
+	ID             string        `db:"id" json:"id" validate:"lte=64"`
+	Vendor         enumor.Vendor `db:"vendor" json:"vendor" validate:"lte=16"`
+	AccountID      string        `db:"account_id" json:"account_id" validate:"lte=64"`
+	ClusterID      string        `db:"cluster_id" json:"cluster_id" validate:"lte=64"`
+	CloudClusterID string        `db:"cloud_cluster_id" json:"cloud_cluster_id" validate:"lte=255"`
+	// CloudID 节点池ID，Aws、Azure、Gcp 的节点池没有独立ID，使用节点池名称，只在集群内唯一
+	CloudID      string `db:"cloud_id" json:"cloud_id" validate:"lte=255"`
+	Name         string `db:"name" json:"name" validate:"lte=255"`
+	Region       string `db:"region" json:"region" validate:"lte=128"`
+	Status       string `db:"status" json:"status" validate:"lte=64"`
+	InstanceType string `db:"instance_type" json:"instance_type" validate:"lte=128"`
+	// NodeCount 节点池当前节点数
+	NodeCount *int64     `db:"node_count" json:"node_count"`
+	MinSize   *int64     `db:"min_size" json:"min_size"`
+	MaxSize   *int64     `db:"max_size" json:"max_size"`
+	Creator   string     `db:"creator" json:"creator" validate:"lte=64"`
+	Reviser   string     `db:"reviser" json:"reviser" validate:"lte=64"`
+	CreatedAt types.Time `db:"created_at" json:"created_at" validate:"excluded_unless"`
+	UpdatedAt types.Time `db:"updated_at" json:"updated_at" validate:"excluded_unless"`
+}
+
+// TableName return k8s node pool table name.
+func (d K8sNodePoolTable) TableName() table.Name {
+	return table.K8sNodePoolTable
+}
+
+// InsertValidate validate k8s node pool table on insert.
+func (d K8sNodePoolTable) InsertValidate() error {
+	if err := validator.Validate.Struct(d); err != nil {
+		return err
+	}
+
+	if len(d.Vendor) == 0 {
+		return errors.New("vendor can not be empty")
+	}
+
+	if len(d.ClusterID) == 0 {
+		return errors.New("cluster_id can not be empty")
+	}
+
+	if len(d.CloudID) == 0 {
+		return errors.New("cloud_id can not be empty")
+	}
+
+	if len(d.Creator) == 0 {
+		return errors.New("creator can not be empty")
+	}
+
+	return nil
+}
+
+// UpdateValidate validate k8s node pool table on update.
+func (d K8sNodePoolTable) UpdateValidate() error {
+	if err := validator.Validate.Struct(d); err != nil {
+		return err
+	}
+
+	if len(d.Creator) != 0 {
+		return errors.New("creator can not update")
+	}
+
+	if len(d.Reviser) == 0 {
+		return errors.New("reviser can not be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tablek8s
+
+import (
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/table"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/dal/table/utils"
+)
+
+// K8sNodePoolCvmRelColumns ...
+var K8sNodePoolCvmRelColumns = utils.MergeColumns(utils.InsertWithoutPrimaryID, K8sNodePoolCvmRelColumnDescriptor)
+
+// K8sNodePoolCvmRelColumnDescriptor ...
+var K8sNodePoolCvmRelColumnDescriptor = utils.ColumnDescriptors{
+	{Column: "id", NamedC: "id", Type: enumor.Numeric},
+	{Column: "cluster_id", NamedC: "cluster_id", Type: enumor.String},
+	{Column: "node_pool_id", NamedC: "node_pool_id", Type: enumor.String},
+	{Column: "cvm_id", NamedC: "cvm_id", Type: enumor.String},
+	{Column: "creator", NamedC: "creator", Type: enumor.String},
+	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
+}
+
+// K8sNodePoolCvmRelTable 容器节点池主机关联数据模型
+type K8sNodePoolCvmRelTable struct {
+	ID        uint64 `db:"id" json:"id"`
+	ClusterID string `db:"cluster_id" validate:"required,lte=64" json:"cluster_id"`
+	// NodePoolID 节点池ID，TCloud、HuaWei 支持将已有主机直接加入集群而不属于任何节点池，此时为空
+	NodePoolID string     `db:"node_pool_id" validate:"lte=64" json:"node_pool_id"`
+	CvmID      string     `db:"cvm_id" validate:"required,lte=64" json:"cvm_id"`
+	Creator    string     `db:"creator" validate:"required,lte=64" json:"creator"`
+	CreatedAt  types.Time `db:"created_at" json:"created_at"`
+}
+
+// TableName return k8s node pool cvm rel table name.
+func (t K8sNodePoolCvmRelTable) TableName() table.Name {
+	return table.K8sNodePoolCvmRelTable
+}
+
+// InsertValidate ...
+func (t *K8sNodePoolCvmRelTable) InsertValidate() error {
+	return validator.Validate.Struct(t)
+}
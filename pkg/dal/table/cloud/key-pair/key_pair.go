@@ -0,0 +1,122 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package tablekeypair ...
+package tablekeypair
+
+import (
+	"errors"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/table"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/dal/table/utils"
+)
+
+// KeyPairColumns defines all the key_pair table's columns.
+var KeyPairColumns = utils.MergeColumns(nil, KeyPairColumnDescriptor)
+
+// KeyPairColumnDescriptor is key_pair's column descriptors.
+var KeyPairColumnDescriptor = utils.ColumnDescriptors{
+	{Column: "id", NamedC: "id", Type: enumor.String},
+	{Column: "vendor", NamedC: "vendor", Type: enumor.String},
+	{Column: "account_id", NamedC: "account_id", Type: enumor.String},
+	{Column: "cloud_id", NamedC: "cloud_id", Type: enumor.String},
+	{Column: "bk_biz_id", NamedC: "bk_biz_id", Type: enumor.Numeric},
+	{Column: "name", NamedC: "name", Type: enumor.String},
+	{Column: "region", NamedC: "region", Type: enumor.String},
+	{Column: "fingerprint", NamedC: "fingerprint", Type: enumor.String},
+	{Column: "public_key", NamedC: "public_key", Type: enumor.String},
+	{Column: "cloud_created_time", NamedC: "cloud_created_time", Type: enumor.String},
+	{Column: "memo", NamedC: "memo", Type: enumor.String},
+	{Column: "extension", NamedC: "extension", Type: enumor.Json},
+	{Column: "creator", NamedC: "creator", Type: enumor.String},
+	{Column: "reviser", NamedC: "reviser", Type: enumor.String},
+	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
+	{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
+}
+
+// KeyPairTable define key pair table.
+type KeyPairTable struct {
+	ID        string        `db:"id" json:"id" validate:"lte=64"`
+	Vendor    enumor.Vendor `db:"vendor" json:"vendor" validate:"lte=16"`
+	AccountID string        `db:"account_id" json:"account_id" validate:"lte=64"`
+	CloudID   string        `db:"cloud_id" json:"cloud_id" validate:"lte=255"`
+	BkBizID   int64         `db:"bk_biz_id" json:"bk_biz_id"`
+	Name      string        `db:"name" json:"name" validate:"lte=255"`
+	Region    string        `db:"region" json:"region" validate:"lte=128"`
+	// Fingerprint 公钥指纹，各厂商计算方式不同，仅用于展示
+	Fingerprint      string          `db:"fingerprint" json:"fingerprint" validate:"lte=255"`
+	PublicKey        string          `db:"public_key" json:"public_key"`
+	CloudCreatedTime string          `db:"cloud_created_time" json:"cloud_created_time" validate:"lte=64"`
+	Memo             *string         `db:"memo" json:"memo" validate:"omitempty,lte=255"`
+	Extension        types.JsonField `db:"extension" json:"extension"`
+	Creator          string          `db:"creator" json:"creator" validate:"lte=64"`
+	Reviser          string          `db:"reviser" json:"reviser" validate:"lte=64"`
+	CreatedAt        types.Time      `db:"created_at" json:"created_at" validate:"excluded_unless"`
+	UpdatedAt        types.Time      `db:"updated_at" json:"updated_at" validate:"excluded_unless"`
+}
+
+// TableName return key pair table name.
+func (s KeyPairTable) TableName() table.Name {
+	return table.KeyPairTable
+}
+
+// InsertValidate validate key pair table on insert.
+func (s KeyPairTable) InsertValidate() error {
+	if err := validator.Validate.Struct(s); err != nil {
+		return err
+	}
+
+	if len(s.Vendor) == 0 {
+		return errors.New("vendor can not be empty")
+	}
+
+	if len(s.AccountID) == 0 {
+		return errors.New("account_id can not be empty")
+	}
+
+	if len(s.CloudID) == 0 {
+		return errors.New("cloud_id can not be empty")
+	}
+
+	if len(s.Creator) == 0 {
+		return errors.New("creator can not be empty")
+	}
+
+	return nil
+}
+
+// UpdateValidate validate key pair table on update.
+func (s KeyPairTable) UpdateValidate() error {
+	if err := validator.Validate.Struct(s); err != nil {
+		return err
+	}
+
+	if len(s.Creator) != 0 {
+		return errors.New("creator can not update")
+	}
+
+	if len(s.Reviser) == 0 {
+		return errors.New("reviser can not be empty")
+	}
+
+	return nil
+}
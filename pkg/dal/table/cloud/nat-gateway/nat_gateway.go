@@ -0,0 +1,136 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package tablenatgateway ...
+package tablenatgateway
+
+import (
+	"errors"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/table"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/dal/table/utils"
+)
+
+// NatGatewayColumns defines all the nat gateway table's columns.
+var NatGatewayColumns = utils.MergeColumns(nil, NatGatewayColumnDescriptor)
+
+// NatGatewayColumnDescriptor is nat gateway's column descriptors.
+var NatGatewayColumnDescriptor = utils.ColumnDescriptors{
+	{Column: "id", NamedC: "id", Type: enumor.String},
+	{Column: "vendor", NamedC: "vendor", Type: enumor.String},
+	{Column: "account_id", NamedC: "account_id", Type: enumor.String},
+	{Column: "cloud_id", NamedC: "cloud_id", Type: enumor.String},
+	{Column: "bk_biz_id", NamedC: "bk_biz_id", Type: enumor.Numeric},
+	{Column: "name", NamedC: "name", Type: enumor.String},
+	{Column: "region", NamedC: "region", Type: enumor.String},
+	{Column: "zone", NamedC: "zone", Type: enumor.String},
+	{Column: "status", NamedC: "status", Type: enumor.String},
+	{Column: "vpc_id", NamedC: "vpc_id", Type: enumor.String},
+	{Column: "cloud_vpc_id", NamedC: "cloud_vpc_id", Type: enumor.String},
+	{Column: "subnet_id", NamedC: "subnet_id", Type: enumor.String},
+	{Column: "cloud_subnet_id", NamedC: "cloud_subnet_id", Type: enumor.String},
+	{Column: "public_ips", NamedC: "public_ips", Type: enumor.Json},
+	{Column: "private_ips", NamedC: "private_ips", Type: enumor.Json},
+	{Column: "cloud_created_time", NamedC: "cloud_created_time", Type: enumor.String},
+	{Column: "memo", NamedC: "memo", Type: enumor.String},
+	{Column: "extension", NamedC: "extension", Type: enumor.Json},
+	{Column: "creator", NamedC: "creator", Type: enumor.String},
+	{Column: "reviser", NamedC: "reviser", Type: enumor.String},
+	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
+	{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
+}
+
+// NatGatewayTable define nat gateway table.
+type NatGatewayTable struct {
+	ID        string        `db:"id" json:"id" validate:"lte=64"`
+	Vendor    enumor.Vendor `db:"vendor" json:"vendor" validate:"lte=16"`
+	AccountID string        `db:"account_id" json:"account_id" validate:"lte=64"`
+	CloudID   string        `db:"cloud_id" json:"cloud_id" validate:"lte=255"`
+	// BkBizID 分配到的业务 ID，跟随所属VPC
+	BkBizID int64  `db:"bk_biz_id" json:"bk_biz_id"`
+	Name    string `db:"name" json:"name" validate:"lte=255"`
+	Region  string `db:"region" json:"region" validate:"lte=128"`
+	Zone    string `db:"zone" json:"zone" validate:"lte=128"`
+	Status  string `db:"status" json:"status" validate:"lte=64"`
+	// VpcID 所属VPC，VPC未同步到本地时为空
+	VpcID      string `db:"vpc_id" json:"vpc_id" validate:"lte=64"`
+	CloudVpcID string `db:"cloud_vpc_id" json:"cloud_vpc_id" validate:"lte=255"`
+	// SubnetID 所在子网，部分云厂商的NAT网关不绑定子网
+	SubnetID         string            `db:"subnet_id" json:"subnet_id" validate:"lte=64"`
+	CloudSubnetID    string            `db:"cloud_subnet_id" json:"cloud_subnet_id" validate:"lte=255"`
+	PublicIPs        types.StringArray `db:"public_ips" json:"public_ips"`
+	PrivateIPs       types.StringArray `db:"private_ips" json:"private_ips"`
+	CloudCreatedTime string            `db:"cloud_created_time" json:"cloud_created_time" validate:"lte=64"`
+	Memo             *string           `db:"memo" json:"memo" validate:"omitempty,lte=255"`
+	Extension        types.JsonField   `db:"extension" json:"extension"`
+	Creator          string            `db:"creator" json:"creator" validate:"lte=64"`
+	Reviser          string            `db:"reviser" json:"reviser" validate:"lte=64"`
+	CreatedAt        types.Time        `db:"created_at" json:"created_at" validate:"excluded_unless"`
+	UpdatedAt        types.Time        `db:"updated_at" json:"updated_at" validate:"excluded_unless"`
+}
+
+// TableName return nat gateway table name.
+func (n NatGatewayTable) TableName() table.Name {
+	return table.NatGatewayTable
+}
+
+// InsertValidate validate nat gateway table on insert.
+func (n NatGatewayTable) InsertValidate() error {
+	if err := validator.Validate.Struct(n); err != nil {
+		return err
+	}
+
+	if len(n.Vendor) == 0 {
+		return errors.New("vendor can not be empty")
+	}
+
+	if len(n.AccountID) == 0 {
+		return errors.New("account_id can not be empty")
+	}
+
+	if len(n.CloudID) == 0 {
+		return errors.New("cloud_id can not be empty")
+	}
+
+	if len(n.Creator) == 0 {
+		return errors.New("creator can not be empty")
+	}
+
+	return nil
+}
+
+// UpdateValidate validate nat gateway table on update.
+func (n NatGatewayTable) UpdateValidate() error {
+	if err := validator.Validate.Struct(n); err != nil {
+		return err
+	}
+
+	if len(n.Creator) != 0 {
+		return errors.New("creator can not update")
+	}
+
+	if len(n.Reviser) == 0 {
+		return errors.New("reviser can not be empty")
+	}
+
+	return nil
+}
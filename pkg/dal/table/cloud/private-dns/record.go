@@ -0,0 +1,117 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+package tableprivatedns
+
+import (
+	"errors"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/table"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/dal/table/utils"
+)
+
+// RecordColumns defines all the private_dns_record table's columns.
+var RecordColumns = utils.MergeColumns(nil, RecordColumnDescriptor)
+
+// RecordColumnDescriptor is private_dns_record's column descriptors.
+var RecordColumnDescriptor = utils.ColumnDescriptors{
+	{Column: "id", NamedC: "id", Type: enumor.String},
+	{Column: "vendor", NamedC: "vendor", Type: enumor.String},
+	{Column: "account_id", NamedC: "account_id", Type: enumor.String},
+	{Column: "zone_id", NamedC: "zone_id", Type: enumor.String},
+	{Column: "cloud_zone_id", NamedC: "cloud_zone_id", Type: enumor.String},
+	{Column: "cloud_id", NamedC: "cloud_id", Type: enumor.String},
+	{Column: "name", NamedC: "name", Type: enumor.String},
+	{Column: "type", NamedC: "type", Type: enumor.String},
+	{Column: "value", NamedC: "value", Type: enumor.Json},
+	{Column: "ttl", NamedC: "ttl", Type: enumor.Numeric},
+	{Column: "creator", NamedC: "creator", Type: enumor.String},
+	{Column: "reviser", NamedC: "reviser", Type: enumor.String},
+	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
+	{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
+}
+
+// RecordTable define private dns record table.
+type RecordTable struct {
+	ID          string        `db:"id" json:"id" validate:"lte=64"`
+	Vendor      enumor.Vendor `db:"vendor" json:"vendor" validate:"lte=16"`
+	AccountID   string        `db:"account_id" json:"account_id" validate:"lte=64"`
+	ZoneID      string        `db:"zone_id" json:"zone_id" validate:"lte=64"`
+	CloudZoneID string        `db:"cloud_zone_id" json:"cloud_zone_id" validate:"lte=255"`
+	// CloudID 记录ID，Aws、Gcp 的记录集没有独立ID，使用 "记录名称:记录类型"，只在私有域内唯一
+	CloudID string `db:"cloud_id" json:"cloud_id" validate:"lte=255"`
+	// Name 主机记录，不包含私有域的域名，如 www
+	Name      string            `db:"name" json:"name" validate:"lte=255"`
+	Type      string            `db:"type" json:"type" validate:"lte=16"`
+	Value     types.StringArray `db:"value" json:"value"`
+	TTL       int64             `db:"ttl" json:"ttl"`
+	Creator   string            `db:"creator" json:"creator" validate:"lte=64"`
+	Reviser   string            `db:"reviser" json:"reviser" validate:"lte=64"`
+	CreatedAt types.Time        `db:"created_at" json:"created_at" validate:"excluded_unless"`
+	UpdatedAt types.Time        `db:"updated_at" json:"updated_at" validate:"excluded_unless"`
+}
+
+// TableName return private dns record table name.
+func (r RecordTable) TableName() table.Name {
+	return table.PrivateDnsRecordTable
+}
+
+// InsertValidate validate private dns record table on insert.
+func (r RecordTable) InsertValidate() error {
+	if err := validator.Validate.Struct(r); err != nil {
+		return err
+	}
+
+	if len(r.Vendor) == 0 {
+		return errors.New("vendor can not be empty")
+	}
+
+	if len(r.ZoneID) == 0 {
+		return errors.New("zone_id can not be empty")
+	}
+
+	if len(r.CloudID) == 0 {
+		return errors.New("cloud_id can not be empty")
+	}
+
+	if len(r.Creator) == 0 {
+		return errors.New("creator can not be empty")
+	}
+
+	return nil
+}
+
+// UpdateValidate validate private dns record table on update.
+func (r RecordTable) UpdateValidate() error {
+	if err := validator.Validate.Struct(r); err != nil {
+		return err
+	}
+
+	if len(r.Creator) != 0 {
+		return errors.New("creator can not update")
+	}
+
+	if len(r.Reviser) == 0 {
+		return errors.New("reviser can not be empty")
+	}
+
+	return nil
+}
@@ -52,6 +52,8 @@ var AwsRouteColumnDescriptor = utils.ColumnDescriptors{
 	{Column: "cloud_network_interface_id", NamedC: "cloud_network_interface_id", Type: enumor.String},
 	{Column: "cloud_transit_gateway_id", NamedC: "cloud_transit_gateway_id", Type: enumor.String},
 	{Column: "cloud_vpc_peering_connection_id", NamedC: "cloud_vpc_peering_connection_id", Type: enumor.String},
+	{Column: "next_hop_res_type", NamedC: "next_hop_res_type", Type: enumor.String},
+	{Column: "next_hop_res_id", NamedC: "next_hop_res_id", Type: enumor.String},
 	{Column: "state", NamedC: "state", Type: enumor.String},
 	{Column: "propagated", NamedC: "propagated", Type: enumor.Boolean},
 	{Column: "creator", NamedC: "creator", Type: enumor.String},
@@ -96,6 +98,10 @@ type AwsRouteTable struct {
 	CloudTransitGatewayID *string `db:"cloud_transit_gateway_id" validate:"omitempty,max=255" json:"cloud_transit_gateway_id,omitempty"`
 	// CloudVpcPeeringConnectionID VPC对等连接的云上ID
 	CloudVpcPeeringConnectionID *string `db:"cloud_vpc_peering_connection_id" validate:"omitempty,max=255" json:"cloud_vpc_peering_connection_id,omitempty"` // State 状态
+	// NextHopResType 下一跳对应的本地资源类型，下一跳资源未同步到本地时为空
+	NextHopResType enumor.CloudResourceType `db:"next_hop_res_type" validate:"max=64" json:"next_hop_res_type"`
+	// NextHopResID 下一跳对应的本地资源ID
+	NextHopResID string `db:"next_hop_res_id" validate:"max=64" json:"next_hop_res_id"`
 	// State 状态
 	State string `db:"state" validate:"max=32" json:"state"`
 	// Propagated 是否已传播
@@ -48,6 +48,8 @@ var GcpRouteColumnDescriptor = utils.ColumnDescriptors{
 	{Column: "next_hop_ip", NamedC: "next_hop_ip", Type: enumor.String},
 	{Column: "next_hop_network", NamedC: "next_hop_network", Type: enumor.String},
 	{Column: "next_hop_peering", NamedC: "next_hop_peering", Type: enumor.String},
+	{Column: "next_hop_res_type", NamedC: "next_hop_res_type", Type: enumor.String},
+	{Column: "next_hop_res_id", NamedC: "next_hop_res_id", Type: enumor.String},
 	{Column: "next_hop_vpn_tunnel", NamedC: "next_hop_vpn_tunnel", Type: enumor.String},
 	{Column: "priority", NamedC: "priority", Type: enumor.Numeric},
 	{Column: "route_status", NamedC: "route_status", Type: enumor.String},
@@ -90,6 +92,10 @@ type GcpRouteTable struct {
 	NextHopNetwork *string `db:"next_hop_network" validate:"omitempty,max=255" json:"next_hop_network,omitempty"`
 	// NextHopPeering 下一跳对等连接名称
 	NextHopPeering *string `db:"next_hop_peering" validate:"omitempty,max=255" json:"next_hop_peering,omitempty"`
+	// NextHopResType 下一跳对应的本地资源类型，下一跳资源未同步到本地时为空
+	NextHopResType enumor.CloudResourceType `db:"next_hop_res_type" validate:"max=64" json:"next_hop_res_type"`
+	// NextHopResID 下一跳对应的本地资源ID
+	NextHopResID string `db:"next_hop_res_id" validate:"max=64" json:"next_hop_res_id"`
 	// NextHopVpnTunnel 下一跳VPN通道的URL
 	NextHopVpnTunnel *string `db:"next_hop_vpn_tunnel" validate:"omitempty,max=255" json:"next_hop_vpn_tunnel,omitempty"`
 	// Priority 优先级
@@ -40,6 +40,8 @@ var HuaWeiRouteColumnDescriptor = utils.ColumnDescriptors{
 	{Column: "type", NamedC: "type", Type: enumor.String},
 	{Column: "destination", NamedC: "destination", Type: enumor.String},
 	{Column: "nexthop", NamedC: "nexthop", Type: enumor.String},
+	{Column: "next_hop_res_type", NamedC: "next_hop_res_type", Type: enumor.String},
+	{Column: "next_hop_res_id", NamedC: "next_hop_res_id", Type: enumor.String},
 	{Column: "memo", NamedC: "memo", Type: enumor.String},
 	{Column: "creator", NamedC: "creator", Type: enumor.String},
 	{Column: "reviser", NamedC: "reviser", Type: enumor.String},
@@ -61,6 +63,10 @@ type HuaWeiRouteTable struct {
 	Destination string `db:"destination" validate:"omitempty,cidr" json:"destination"`
 	// NextHop 下一跳对象的ID
 	NextHop string `db:"nexthop" validate:"max=255" json:"nexthop"`
+	// NextHopResType 下一跳对应的本地资源类型，下一跳资源未同步到本地时为空
+	NextHopResType enumor.CloudResourceType `db:"next_hop_res_type" validate:"max=64" json:"next_hop_res_type"`
+	// NextHopResID 下一跳对应的本地资源ID
+	NextHopResID string `db:"next_hop_res_id" validate:"max=64" json:"next_hop_res_id"`
 	// Memo 备注
 	Memo *string `db:"memo" validate:"omitempty,max=255" json:"memo"`
 	// Creator 创建者
@@ -42,6 +42,8 @@ var TCloudRouteColumnDescriptor = utils.ColumnDescriptors{
 	{Column: "destination_ipv6_cidr_block", NamedC: "destination_ipv6_cidr_block", Type: enumor.String},
 	{Column: "gateway_type", NamedC: "gateway_type", Type: enumor.String},
 	{Column: "cloud_gateway_id", NamedC: "cloud_gateway_id", Type: enumor.String},
+	{Column: "next_hop_res_type", NamedC: "next_hop_res_type", Type: enumor.String},
+	{Column: "next_hop_res_id", NamedC: "next_hop_res_id", Type: enumor.String},
 	{Column: "enabled", NamedC: "enabled", Type: enumor.Boolean},
 	{Column: "route_type", NamedC: "route_type", Type: enumor.String},
 	{Column: "published_to_vbc", NamedC: "published_to_vbc", Type: enumor.Boolean},
@@ -70,6 +72,10 @@ type TCloudRouteTable struct {
 	GatewayType string `db:"gateway_type" validate:"max=32" json:"gateway_type"`
 	// CloudGatewayID 下一跳地址
 	CloudGatewayID string `db:"cloud_gateway_id" validate:"max=255" json:"cloud_gateway_id"`
+	// NextHopResType 下一跳对应的本地资源类型，下一跳资源未同步到本地时为空
+	NextHopResType enumor.CloudResourceType `db:"next_hop_res_type" validate:"max=64" json:"next_hop_res_type"`
+	// NextHopResID 下一跳对应的本地资源ID
+	NextHopResID string `db:"next_hop_res_id" validate:"max=64" json:"next_hop_res_id"`
 	// Enabled 是否启用
 	Enabled *bool `db:"enabled" validate:"-" json:"enabled"`
 	// RouteType 路由类型
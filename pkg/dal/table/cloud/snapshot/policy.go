@@ -0,0 +1,126 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tablesnapshot
+
+import (
+	"errors"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/table"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/dal/table/utils"
+)
+
+// SnapshotPolicyColumns defines all the snapshot_policy table's columns.
+var SnapshotPolicyColumns = utils.MergeColumns(nil, SnapshotPolicyColumnDescriptor)
+
+// SnapshotPolicyColumnDescriptor is snapshot_policy's column descriptors.
+var SnapshotPolicyColumnDescriptor = utils.ColumnDescriptors{
+	{Column: "id", NamedC: "id", Type: enumor.String},
+	{Column: "vendor", NamedC: "vendor", Type: enumor.String},
+	{Column: "account_id", NamedC: "account_id", Type: enumor.String},
+	{Column: "bk_biz_id", NamedC: "bk_biz_id", Type: enumor.Numeric},
+	{Column: "name", NamedC: "name", Type: enumor.String},
+	{Column: "spec", NamedC: "spec", Type: enumor.String},
+	{Column: "retention_days", NamedC: "retention_days", Type: enumor.Numeric},
+	{Column: "disk_ids", NamedC: "disk_ids", Type: enumor.Json},
+	{Column: "enabled", NamedC: "enabled", Type: enumor.Boolean},
+	{Column: "cron_flow_id", NamedC: "cron_flow_id", Type: enumor.String},
+	{Column: "memo", NamedC: "memo", Type: enumor.String},
+	{Column: "creator", NamedC: "creator", Type: enumor.String},
+	{Column: "reviser", NamedC: "reviser", Type: enumor.String},
+	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
+	{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
+}
+
+// SnapshotPolicyTable define snapshot_policy table.
+type SnapshotPolicyTable struct {
+	ID        string        `db:"id" json:"id" validate:"lte=64"`
+	Vendor    enumor.Vendor `db:"vendor" json:"vendor" validate:"lte=16"`
+	AccountID string        `db:"account_id" json:"account_id" validate:"lte=64"`
+	BkBizID   int64         `db:"bk_biz_id" json:"bk_biz_id"`
+	Name      string        `db:"name" json:"name" validate:"lte=64"`
+	// Spec 快照创建周期，cron表达式
+	Spec string `db:"spec" json:"spec" validate:"lte=64"`
+	// RetentionDays 策略创建的快照保留天数，为0时不清理
+	RetentionDays *uint `db:"retention_days" json:"retention_days"`
+	// DiskIDs 策略关联的云盘ID列表
+	DiskIDs types.StringArray `db:"disk_ids" json:"disk_ids"`
+	Enabled *bool             `db:"enabled" json:"enabled"`
+	// CronFlowID 执行策略的周期任务流ID
+	CronFlowID string     `db:"cron_flow_id" json:"cron_flow_id" validate:"lte=64"`
+	Memo       *string    `db:"memo" json:"memo" validate:"omitempty,lte=255"`
+	Creator    string     `db:"creator" json:"creator" validate:"lte=64"`
+	Reviser    string     `db:"reviser" json:"reviser" validate:"lte=64"`
+	CreatedAt  types.Time `db:"created_at" json:"created_at" validate:"excluded_unless"`
+	UpdatedAt  types.Time `db:"updated_at" json:"updated_at" validate:"excluded_unless"`
+}
+
+// TableName return snapshot_policy table name.
+func (p SnapshotPolicyTable) TableName() table.Name {
+	return table.SnapshotPolicyTable
+}
+
+// InsertValidate validate snapshot_policy table on insert.
+func (p SnapshotPolicyTable) InsertValidate() error {
+	if err := validator.Validate.Struct(p); err != nil {
+		return err
+	}
+
+	if len(p.Vendor) == 0 {
+		return errors.New("vendor can not be empty")
+	}
+
+	if len(p.AccountID) == 0 {
+		return errors.New("account_id can not be empty")
+	}
+
+	if len(p.Name) == 0 {
+		return errors.New("name can not be empty")
+	}
+
+	if len(p.Spec) == 0 {
+		return errors.New("spec can not be empty")
+	}
+
+	if len(p.Creator) == 0 {
+		return errors.New("creator can not be empty")
+	}
+
+	return nil
+}
+
+// UpdateValidate validate snapshot_policy table on update.
+func (p SnapshotPolicyTable) UpdateValidate() error {
+	if err := validator.Validate.Struct(p); err != nil {
+		return err
+	}
+
+	if len(p.Creator) != 0 {
+		return errors.New("creator can not update")
+	}
+
+	if len(p.Reviser) == 0 {
+		return errors.New("reviser can not be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package tablesnapshot ...
+package tablesnapshot
+
+import (
+	"errors"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/table"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/dal/table/utils"
+)
+
+// SnapshotColumns defines all the snapshot table's columns.
+var SnapshotColumns = utils.MergeColumns(nil, SnapshotColumnDescriptor)
+
+// SnapshotColumnDescriptor is snapshot's column descriptors.
+var SnapshotColumnDescriptor = utils.ColumnDescriptors{
+	{Column: "id", NamedC: "id", Type: enumor.String},
+	{Column: "vendor", NamedC: "vendor", Type: enumor.String},
+	{Column: "account_id", NamedC: "account_id", Type: enumor.String},
+	{Column: "cloud_id", NamedC: "cloud_id", Type: enumor.String},
+	{Column: "bk_biz_id", NamedC: "bk_biz_id", Type: enumor.Numeric},
+	{Column: "name", NamedC: "name", Type: enumor.String},
+	{Column: "region", NamedC: "region", Type: enumor.String},
+	{Column: "zone", NamedC: "zone", Type: enumor.String},
+	{Column: "status", NamedC: "status", Type: enumor.String},
+	{Column: "size", NamedC: "size", Type: enumor.Numeric},
+	{Column: "disk_id", NamedC: "disk_id", Type: enumor.String},
+	{Column: "cloud_disk_id", NamedC: "cloud_disk_id", Type: enumor.String},
+	{Column: "cvm_id", NamedC: "cvm_id", Type: enumor.String},
+	{Column: "cloud_cvm_id", NamedC: "cloud_cvm_id", Type: enumor.String},
+	{Column: "policy_id", NamedC: "policy_id", Type: enumor.String},
+	{Column: "cloud_created_time", NamedC: "cloud_created_time", Type: enumor.String},
+	{Column: "memo", NamedC: "memo", Type: enumor.String},
+	{Column: "extension", NamedC: "extension", Type: enumor.Json},
+	{Column: "creator", NamedC: "creator", Type: enumor.String},
+	{Column: "reviser", NamedC: "reviser", Type: enumor.String},
+	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
+	{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
+}
+
+// SnapshotTable define snapshot table.
+type SnapshotTable struct {
+	ID        string        `db:"id" json:"id" validate:"lte=64"`
+	Vendor    enumor.Vendor `db:"vendor" json:"vendor" validate:"lte=16"`
+	AccountID string        `db:"account_id" json:"account_id" validate:"lte=64"`
+	CloudID   string        `db:"cloud_id" json:"cloud_id" validate:"lte=255"`
+	// BkBizID 分配到的业务 ID，跟随来源云盘
+	BkBizID int64  `db:"bk_biz_id" json:"bk_biz_id"`
+	Name    string `db:"name" json:"name" validate:"lte=255"`
+	Region  string `db:"region" json:"region" validate:"lte=128"`
+	Zone    string `db:"zone" json:"zone" validate:"lte=128"`
+	Status  string `db:"status" json:"status" validate:"lte=64"`
+	// Size 快照对应的云盘大小，单位GB
+	Size uint64 `db:"size" json:"size"`
+	// DiskID 来源云盘ID，云盘未同步到本地时为空
+	DiskID      string `db:"disk_id" json:"disk_id" validate:"lte=64"`
+	CloudDiskID string `db:"cloud_disk_id" json:"cloud_disk_id" validate:"lte=255"`
+	// CvmID 创建快照时来源云盘挂载的主机ID
+	CvmID      string `db:"cvm_id" json:"cvm_id" validate:"lte=64"`
+	CloudCvmID string `db:"cloud_cvm_id" json:"cloud_cvm_id" validate:"lte=255"`
+	// PolicyID 通过快照策略创建时对应的策略ID，用于按保留时间清理
+	PolicyID         string          `db:"policy_id" json:"policy_id" validate:"lte=64"`
+	CloudCreatedTime string          `db:"cloud_created_time" json:"cloud_created_time" validate:"lte=64"`
+	Memo             *string         `db:"memo" json:"memo" validate:"omitempty,lte=255"`
+	Extension        types.JsonField `db:"extension" json:"extension"`
+	Creator          string          `db:"creator" json:"creator" validate:"lte=64"`
+	Reviser          string          `db:"reviser" json:"reviser" validate:"lte=64"`
+	CreatedAt        types.Time      `db:"created_at" json:"created_at" validate:"excluded_unless"`
+	UpdatedAt        types.Time      `db:"updated_at" json:"updated_at" validate:"excluded_unless"`
+}
+
+// TableName return snapshot table name.
+func (s SnapshotTable) TableName() table.Name {
+	return table.SnapshotTable
+}
+
+// InsertValidate validate snapshot table on insert.
+func (s SnapshotTable) InsertValidate() error {
+	if err := validator.Validate.Struct(s); err != nil {
+		return err
+	}
+
+	if len(s.Vendor) == 0 {
+		return errors.New("vendor can not be empty")
+	}
+
+	if len(s.AccountID) == 0 {
+		return errors.New("account_id can not be empty")
+	}
+
+	if len(s.CloudID) == 0 {
+		return errors.New("cloud_id can not be empty")
+	}
+
+	if len(s.Creator) == 0 {
+		return errors.New("creator can not be empty")
+	}
+
+	return nil
+}
+
+// UpdateValidate validate snapshot table on update.
+func (s SnapshotTable) UpdateValidate() error {
+	if err := validator.Validate.Struct(s); err != nil {
+		return err
+	}
+
+	if len(s.Creator) != 0 {
+		return errors.New("creator can not update")
+	}
+
+	if len(s.Reviser) == 0 {
+		return errors.New("reviser can not be empty")
+	}
+
+	return nil
+}
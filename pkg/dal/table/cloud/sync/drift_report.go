@@ -0,0 +1,94 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package tablessync
+
+import (
+	"errors"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/table"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/dal/table/utils"
+)
+
+// SyncDriftReportColumns defines all the sync_drift_report table's columns.
+var SyncDriftReportColumns = utils.MergeColumns(nil, SyncDriftReportColumnDescriptor)
+
+// SyncDriftReportColumnDescriptor is sync_drift_report's column descriptors.
+var SyncDriftReportColumnDescriptor = utils.ColumnDescriptors{
+	{Column: "id", NamedC: "id", Type: enumor.String},
+	{Column: "vendor", NamedC: "vendor", Type: enumor.String},
+	{Column: "account_id", NamedC: "account_id", Type: enumor.String},
+	{Column: "region", NamedC: "region", Type: enumor.String},
+	{Column: "res_type", NamedC: "res_type", Type: enumor.String},
+	{Column: "summary", NamedC: "summary", Type: enumor.Json},
+	{Column: "changes", NamedC: "changes", Type: enumor.Json},
+	{Column: "creator", NamedC: "creator", Type: enumor.String},
+	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
+}
+
+// SyncDriftReportTable define sync_drift_report table.
+type SyncDriftReportTable struct {
+	ID        string                   `db:"id" json:"id" validate:"lte=64"`
+	Vendor    enumor.Vendor            `db:"vendor" json:"vendor"`
+	AccountID string                   `db:"account_id" json:"account_id" validate:"lte=64"`
+	Region    string                   `db:"region" json:"region" validate:"lte=64"`
+	ResType   enumor.CloudResourceType `db:"res_type" json:"res_type" validate:"lte=64"`
+	Summary   types.JsonField          `db:"summary" json:"summary"`
+	Changes   types.JsonField          `db:"changes" json:"changes"`
+	Creator   string                   `db:"creator" json:"creator" validate:"lte=64"`
+	CreatedAt types.Time               `db:"created_at" json:"created_at" validate:"excluded_unless"`
+}
+
+// TableName return sync_drift_report table name.
+func (r SyncDriftReportTable) TableName() table.Name {
+	return table.SyncDriftReportTable
+}
+
+// InsertValidate sync_drift_report table when insert.
+func (r SyncDriftReportTable) InsertValidate() error {
+	// length validate.
+	if err := validator.Validate.Struct(r); err != nil {
+		return err
+	}
+
+	if len(r.ID) == 0 {
+		return errors.New("id is required")
+	}
+
+	if len(r.Vendor) == 0 {
+		return errors.New("vendor is required")
+	}
+
+	if len(r.AccountID) == 0 {
+		return errors.New("account_id is required")
+	}
+
+	if len(r.ResType) == 0 {
+		return errors.New("res_type is required")
+	}
+
+	if len(r.Creator) == 0 {
+		return errors.New("creator is required")
+	}
+
+	return nil
+}
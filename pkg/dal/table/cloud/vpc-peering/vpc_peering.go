@@ -0,0 +1,135 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package tablevpcpeering ...
+package tablevpcpeering
+
+import (
+	"errors"
+
+	"hcm/pkg/criteria/enumor"
+	"hcm/pkg/criteria/validator"
+	"hcm/pkg/dal/table"
+	"hcm/pkg/dal/table/types"
+	"hcm/pkg/dal/table/utils"
+)
+
+// VpcPeeringColumns defines all the vpc peering table's columns.
+var VpcPeeringColumns = utils.MergeColumns(nil, VpcPeeringColumnDescriptor)
+
+// VpcPeeringColumnDescriptor is vpc peering's column descriptors.
+var VpcPeeringColumnDescriptor = utils.ColumnDescriptors{
+	{Column: "id", NamedC: "id", Type: enumor.String},
+	{Column: "vendor", NamedC: "vendor", Type: enumor.String},
+	{Column: "account_id", NamedC: "account_id", Type: enumor.String},
+	{Column: "cloud_id", NamedC: "cloud_id", Type: enumor.String},
+	{Column: "bk_biz_id", NamedC: "bk_biz_id", Type: enumor.Numeric},
+	{Column: "name", NamedC: "name", Type: enumor.String},
+	{Column: "region", NamedC: "region", Type: enumor.String},
+	{Column: "status", NamedC: "status", Type: enumor.String},
+	{Column: "vpc_id", NamedC: "vpc_id", Type: enumor.String},
+	{Column: "cloud_vpc_id", NamedC: "cloud_vpc_id", Type: enumor.String},
+	{Column: "peer_vpc_id", NamedC: "peer_vpc_id", Type: enumor.String},
+	{Column: "cloud_peer_vpc_id", NamedC: "cloud_peer_vpc_id", Type: enumor.String},
+	{Column: "peer_region", NamedC: "peer_region", Type: enumor.String},
+	{Column: "peer_account", NamedC: "peer_account", Type: enumor.String},
+	{Column: "cloud_created_time", NamedC: "cloud_created_time", Type: enumor.String},
+	{Column: "memo", NamedC: "memo", Type: enumor.String},
+	{Column: "extension", NamedC: "extension", Type: enumor.Json},
+	{Column: "creator", NamedC: "creator", Type: enumor.String},
+	{Column: "reviser", NamedC: "reviser", Type: enumor.String},
+	{Column: "created_at", NamedC: "created_at", Type: enumor.Time},
+	{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
+}
+
+// VpcPeeringTable define vpc peering table.
+type VpcPeeringTable struct {
+	ID        string        `db:"id" json:"id" validate:"lte=64"`
+	Vendor    enumor.Vendor `db:"vendor" json:"vendor" validate:"lte=16"`
+	AccountID string        `db:"account_id" json:"account_id" validate:"lte=64"`
+	CloudID   string        `db:"cloud_id" json:"cloud_id" validate:"lte=255"`
+	// BkBizID 分配到的业务 ID，跟随本端VPC
+	BkBizID int64  `db:"bk_biz_id" json:"bk_biz_id"`
+	Name    string `db:"name" json:"name" validate:"lte=255"`
+	Region  string `db:"region" json:"region" validate:"lte=128"`
+	Status  string `db:"status" json:"status" validate:"lte=64"`
+	// VpcID 本端VPC，VPC未同步到本地时为空
+	VpcID      string `db:"vpc_id" json:"vpc_id" validate:"lte=64"`
+	CloudVpcID string `db:"cloud_vpc_id" json:"cloud_vpc_id" validate:"lte=255"`
+	// PeerVpcID 对端VPC，对端不在本平台纳管范围时为空
+	PeerVpcID      string `db:"peer_vpc_id" json:"peer_vpc_id" validate:"lte=64"`
+	CloudPeerVpcID string `db:"cloud_peer_vpc_id" json:"cloud_peer_vpc_id" validate:"lte=255"`
+	PeerRegion     string `db:"peer_region" json:"peer_region" validate:"lte=128"`
+	// PeerAccount 对端云账号(主账号ID/订阅ID/项目ID)，非本平台账号ID
+	PeerAccount      string          `db:"peer_account" json:"peer_account" validate:"lte=255"`
+	CloudCreatedTime string          `db:"cloud_created_time" json:"cloud_created_time" validate:"lte=64"`
+	Memo             *string         `db:"memo" json:"memo" validate:"omitempty,lte=255"`
+	Extension        types.JsonField `db:"extension" json:"extension"`
+	Creator          string          `db:"creator" json:"creator" validate:"lte=64"`
+	Reviser          string          `db:"reviser" json:"reviser" validate:"lte=64"`
+	CreatedAt        types.Time      `db:"created_at" json:"created_at" validate:"excluded_unless"`
+	UpdatedAt        types.Time      `db:"updated_at" json:"updated_at" validate:"excluded_unless"`
+}
+
+// TableName return vpc peering table name.
+func (p VpcPeeringTable) TableName() table.Name {
+	return table.VpcPeeringTable
+}
+
+// InsertValidate validate vpc peering table on insert.
+func (p VpcPeeringTable) InsertValidate() error {
+	if err := validator.Validate.Struct(p); err != nil {
+		return err
+	}
+
+	if len(p.Vendor) == 0 {
+		return errors.New("vendor can not be empty")
+	}
+
+	if len(p.AccountID) == 0 {
+		return errors.New("account_id can not be empty")
+	}
+
+	if len(p.CloudID) == 0 {
+		return errors.New("cloud_id can not be empty")
+	}
+
+	if len(p.Creator) == 0 {
+		return errors.New("creator can not be empty")
+	}
+
+	return nil
+}
+
+// UpdateValidate validate vpc peering table on update.
+func (p VpcPeeringTable) UpdateValidate() error {
+	if err := validator.Validate.Struct(p); err != nil {
+		return err
+	}
+
+	if len(p.Creator) != 0 {
+		return errors.New("creator can not update")
+	}
+
+	if len(p.Reviser) == 0 {
+		return errors.New("reviser can not be empty")
+	}
+
+	return nil
+}
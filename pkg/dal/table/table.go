@@ -88,6 +88,10 @@ const (
 	AwsRegionTable Name = "aws_region"
 	// GcpRegionTable is gcp region table's name.
 	GcpRegionTable Name = "gcp_region"
+	// ZenlayerRegionTable is zenlayer region table's name.
+	ZenlayerRegionTable Name = "zenlayer_region"
+	// KaopuRegionTable is kaopu region table's name.
+	KaopuRegionTable Name = "kaopu_region"
 	// EipTable is eip table's name
 	EipTable Name = "eip"
 	// ImageTable is image table's name
@@ -108,13 +112,45 @@ const (
 	HuaWeiRouteTable Name = "huawei_route"
 	// GcpRouteTable is gcp route's table name.
 	GcpRouteTable Name = "gcp_route"
+	// NatGatewayTable is nat gateway's table name.
+	NatGatewayTable Name = "nat_gateway"
+	// VpcPeeringTable is vpc peering's table name.
+	VpcPeeringTable Name = "vpc_peering"
+	// DatabaseInstanceTable is database instance's table name.
+	DatabaseInstanceTable Name = "database_instance"
+	// BucketTable is bucket's table name.
+	BucketTable Name = "bucket"
+	// K8sClusterTable is k8s cluster's table name.
+	K8sClusterTable Name = "k8s_cluster"
+	// K8sNodePoolTable is k8s node pool's table name.
+	K8sNodePoolTable Name = "k8s_node_pool"
+	// K8sNodePoolCvmRelTable is k8s_node_pool_cvm_rel's table name.
+	K8sNodePoolCvmRelTable Name = "k8s_node_pool_cvm_rel"
+	// KeyPairTable is key_pair's table name.
+	KeyPairTable Name = "key_pair"
+	// PrivateDnsZoneTable is private_dns_zone's table name.
+	PrivateDnsZoneTable Name = "private_dns_zone"
+	// PrivateDnsZoneVpcRelTable is private_dns_zone_vpc_rel's table name.
+	PrivateDnsZoneVpcRelTable Name = "private_dns_zone_vpc_rel"
+	// PrivateDnsRecordTable is private_dns_record's table name.
+	PrivateDnsRecordTable Name = "private_dns_record"
+	// IpamPoolTable is ipam_pool's table name.
+	IpamPoolTable Name = "ipam_pool"
+	// IpamAllocationTable is ipam_allocation's table name.
+	IpamAllocationTable Name = "ipam_allocation"
 	// DiskCvmRelTableName is disk_cvm_rel's table name.
 	DiskCvmRelTableName Name = "disk_cvm_rel"
 	// EipCvmRelTableName is eip_cvm_rel's table name.
 	EipCvmRelTableName Name = "eip_cvm_rel"
+	// SnapshotTable is snapshot table's name.
+	SnapshotTable Name = "snapshot"
+	// SnapshotPolicyTable is snapshot_policy table's name.
+	SnapshotPolicyTable Name = "snapshot_policy"
 
 	// AccountSyncDetailTable is account_sync_detail table's name.
 	AccountSyncDetailTable Name = "account_sync_detail"
+	// SyncDriftReportTable is sync_drift_report table's name.
+	SyncDriftReportTable Name = "sync_drift_report"
 
 	// ApplicationTable is application table name
 	ApplicationTable Name = "application"
@@ -138,6 +174,10 @@ const (
 	AsyncFlowTable Name = "async_flow"
 	// AsyncFlowTaskTable is async flow task table's name.
 	AsyncFlowTaskTable Name = "async_flow_task"
+	// AsyncCronFlowTable is async cron flow table's name.
+	AsyncCronFlowTable Name = "async_cron_flow"
+	// AsyncLeaderFenceTable is async leader fence table's name.
+	AsyncLeaderFenceTable Name = "async_leader_fence"
 
 	// CloudSelectionSchemeTable is cloud selection scheme table's name.
 	CloudSelectionSchemeTable Name = "cloud_selection_scheme"
@@ -198,6 +238,14 @@ const (
 	AccountBillExchangeRateTable = "account_bill_exchange_rate"
 	// AccountBillSyncRecordTable 账单同步记录
 	AccountBillSyncRecordTable = "account_bill_sync_record"
+	// AccountBillSplitRuleTable 分账规则
+	AccountBillSplitRuleTable = "account_bill_split_rule"
+	// AccountBillSplitSnapshotTable 分账权重快照
+	AccountBillSplitSnapshotTable = "account_bill_split_snapshot"
+	// AccountBillBudgetTable 预算
+	AccountBillBudgetTable = "account_bill_budget"
+	// AccountBillBudgetAlertTable 预算告警记录
+	AccountBillBudgetAlertTable = "account_bill_budget_alert"
 )
 
 // Validate whether the table name is valid or not.
@@ -240,12 +288,27 @@ var TableMap = map[Name]struct{}{
 	GcpRegionTable:               {},
 	AwsRegionTable:               {},
 	TCloudRegionTable:            {},
+	ZenlayerRegionTable:          {},
+	KaopuRegionTable:             {},
 	RouteTableTable:              {},
 	TCloudRouteTable:             {},
 	AwsRouteTable:                {},
 	AzureRouteTable:              {},
 	HuaWeiRouteTable:             {},
 	GcpRouteTable:                {},
+	NatGatewayTable:              {},
+	VpcPeeringTable:              {},
+	DatabaseInstanceTable:        {},
+	BucketTable:                  {},
+	K8sClusterTable:              {},
+	K8sNodePoolTable:             {},
+	K8sNodePoolCvmRelTable:       {},
+	KeyPairTable:                 {},
+	PrivateDnsZoneTable:          {},
+	PrivateDnsZoneVpcRelTable:    {},
+	PrivateDnsRecordTable:        {},
+	IpamPoolTable:                {},
+	IpamAllocationTable:          {},
 	ZoneTable:                    {},
 	CvmTable:                     {},
 	ApplicationTable:             {},
@@ -258,9 +321,12 @@ var TableMap = map[Name]struct{}{
 	ImageTable:                   {},
 	DiskCvmRelTableName:          {},
 	EipCvmRelTableName:           {},
+	SnapshotTable:                {},
+	SnapshotPolicyTable:          {},
 	AccountBillConfigTable:       {},
 	UserCollectionTable:          {},
 	AccountSyncDetailTable:       {},
+	SyncDriftReportTable:         {},
 	CloudSelectionSchemeTable:    {},
 	CloudSelectionBizTypeTable:   {},
 	CloudSelectionIdcTable:       {},
@@ -269,8 +335,10 @@ var TableMap = map[Name]struct{}{
 	// TODO: 临时方案
 	RecycleRecordTableTaskID: {},
 
-	AsyncFlowTable:     {},
-	AsyncFlowTaskTable: {},
+	AsyncFlowTable:        {},
+	AsyncFlowTaskTable:    {},
+	AsyncCronFlowTable:    {},
+	AsyncLeaderFenceTable: {},
 
 	ArgumentTemplateTable: {},
 
@@ -285,6 +353,10 @@ var TableMap = map[Name]struct{}{
 	RootAccountBillConfigTable:      {},
 	AccountBillExchangeRateTable:    {},
 	AccountBillSyncRecordTable:      {},
+	AccountBillSplitRuleTable:       {},
+	AccountBillSplitSnapshotTable:   {},
+	AccountBillBudgetTable:          {},
+	AccountBillBudgetAlertTable:     {},
 	LoadBalancerTable:               {},
 	SecurityGroupCommonRelTable:     {},
 	LoadBalancerListenerTable:       {},
@@ -154,6 +154,13 @@ var InsertWithoutPrimaryID = &mergeColumnOption{
 	insertWithoutColumn: []string{"id"},
 }
 
+// InsertWithoutColumns 插入数据时不写入指定的列，由数据库默认值填充
+func InsertWithoutColumns(columns ...string) *mergeColumnOption {
+	return &mergeColumnOption{
+		insertWithoutColumn: columns,
+	}
+}
+
 // mergeColumnOption defines merge column option.
 type mergeColumnOption struct {
 	insertWithoutColumn []string
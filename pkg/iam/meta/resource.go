@@ -83,6 +83,18 @@ const (
 	ArgumentTemplate ResourceType = "argument_template"
 	// Cert defines cert hcm auth resource type
 	Cert ResourceType = "cert"
+	// Bucket defines object storage bucket hcm auth resource type
+	Bucket ResourceType = "bucket"
+	// DatabaseInstance defines database instance hcm auth resource type
+	DatabaseInstance ResourceType = "database_instance"
+	// K8sCluster defines k8s cluster hcm auth resource type
+	K8sCluster ResourceType = "k8s_cluster"
+	// KeyPair defines key pair hcm auth resource type
+	KeyPair ResourceType = "key_pair"
+	// PrivateDnsZone defines private dns zone hcm auth resource type, private dns records are authorized by their zone
+	PrivateDnsZone ResourceType = "private_dns_zone"
+	// IpamPool defines ipam pool hcm auth resource type
+	IpamPool ResourceType = "ipam_pool"
 	// LoadBalancer defines clb hcm auth resource type
 	LoadBalancer ResourceType = "load_balancer"
 	// Listener defines listener hcm auth resource type
@@ -22,6 +22,7 @@ package rest
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"strconv"
 
@@ -159,6 +160,23 @@ func (c *Contexts) respEntity(data interface{}) {
 	return
 }
 
+// respFile response request with file stream.
+func (c *Contexts) respFile(file *FileResp) {
+	c.resp.Header().Set(constant.RidKey, c.Kit.Rid)
+	c.resp.AddHeader(restful.HEADER_ContentType, file.ContentType)
+	c.resp.AddHeader("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.FileName))
+	if c.respStatusCode != 0 {
+		c.resp.WriteHeader(c.respStatusCode)
+	}
+
+	if err := file.WriteTo(c.resp.ResponseWriter); err != nil {
+		logs.ErrorDepthf(1, "write file %s to response failed, err: %v, rid: %s", file.FileName, err, c.Kit.Rid)
+		return
+	}
+
+	return
+}
+
 // respError response request with error response.
 func (c *Contexts) respError(err error) {
 	if c.respStatusCode > 0 {
@@ -188,7 +188,11 @@ func (r *Handler) wrapperAction(action *action) func(req *restful.Request, resp
 			return
 		}
 
-		cts.respEntity(reply)
+		if file, ok := reply.(*FileResp); ok {
+			cts.respFile(file)
+		} else {
+			cts.respEntity(reply)
+		}
 
 		restMetric.lagMS.With(prm.Labels{"alias": action.Alias, "biz": cts.bizID}).
 			Observe(float64(time.Since(start).Milliseconds()))
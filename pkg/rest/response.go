@@ -22,6 +22,7 @@ package rest
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"hcm/pkg/iam/meta"
@@ -42,6 +43,15 @@ type Response struct {
 	Data        interface{}         `json:"data"`
 }
 
+// FileResp is a file download response, when handler returns it, the content will be streamed
+// to client as a file rather than encoded as json.
+type FileResp struct {
+	FileName    string
+	ContentType string
+	// WriteTo write file content to response, error occurred after writing started can only be logged.
+	WriteTo func(w io.Writer) error
+}
+
 // NewBaseResp new BaseResp.
 func NewBaseResp(code int32, msg string) *BaseResp {
 	return &BaseResp{
@@ -109,6 +109,11 @@ func ServiceDiscoveryName(serviceName cc.Name) string {
 	return fmt.Sprintf("/hcm/services/%s", serviceName)
 }
 
+// ServiceElectionName return the service's leader election path in etcd.
+func ServiceElectionName(serviceName cc.Name) string {
+	return fmt.Sprintf("/hcm/election/%s", serviceName)
+}
+
 // key return service's register key in etcd.
 // e.g: /hcm/services/data-service/0fa709f2-8e35-11ec-83f6-acde48001122
 func key(path, uid string) string {
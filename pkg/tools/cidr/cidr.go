@@ -166,3 +166,86 @@ func NextAvailableNet(outer net.IPNet, used []net.IPNet, masklen int) (net.IPNet
 	return nextAvailable, nil
 
 }
+
+// Ipv4CidrRange 返回IPv4网段的首地址和末地址(整数形式)
+func Ipv4CidrRange(cidr string) (uint32, uint32, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	ip := ipNet.IP.To4()
+	if ip == nil {
+		return 0, 0, fmt.Errorf("cidr %s is not ipv4", cidr)
+	}
+
+	ones, bits := ipNet.Mask.Size()
+	start := binary.BigEndian.Uint32(ip)
+	end := start | (uint32(1)<<uint(bits-ones) - 1)
+	return start, end, nil
+}
+
+// IsCidrOverlap 判断两个IPv4网段是否存在重叠
+func IsCidrOverlap(a, b string) (bool, error) {
+	aStart, aEnd, err := Ipv4CidrRange(a)
+	if err != nil {
+		return false, err
+	}
+
+	bStart, bEnd, err := Ipv4CidrRange(b)
+	if err != nil {
+		return false, err
+	}
+
+	return aStart <= bEnd && bStart <= aEnd, nil
+}
+
+// FirstAvailableIpv4Net 在outer网段中按掩码长度查找第一个与used均不重叠的网段，与 NextAvailableNet 不同，
+// used 可以包含outer范围外或与outer部分重叠的网段，网段之间的空隙也会被重新分配
+func FirstAvailableIpv4Net(outer string, used []string, masklen int) (string, error) {
+	outerStart, outerEnd, err := Ipv4CidrRange(outer)
+	if err != nil {
+		return "", err
+	}
+
+	_, outerNet, _ := net.ParseCIDR(outer)
+	outerMasklen, _ := outerNet.Mask.Size()
+	if masklen < outerMasklen || masklen > 32 {
+		return "", fmt.Errorf("mask length %d is invalid for outer net %s", masklen, outer)
+	}
+
+	type ipRange struct{ start, end uint32 }
+	ranges := make([]ipRange, 0, len(used))
+	for _, one := range used {
+		start, end, err := Ipv4CidrRange(one)
+		if err != nil {
+			return "", err
+		}
+		if end < outerStart || start > outerEnd {
+			continue
+		}
+		ranges = append(ranges, ipRange{start: start, end: end})
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].start < ranges[j].start })
+
+	size := uint64(1) << uint(32-masklen)
+	candidate := uint64(outerStart)
+	for _, r := range ranges {
+		if uint64(r.end) < candidate {
+			continue
+		}
+		if uint64(r.start) > candidate+size-1 {
+			break
+		}
+		// 跳过已占用网段，并按掩码长度对齐
+		candidate = (uint64(r.end) + size) / size * size
+	}
+
+	if candidate+size-1 > uint64(outerEnd) {
+		return "", errors.New("out of range")
+	}
+
+	ip := make(net.IP, 4)
+	binary.BigEndian.PutUint32(ip, uint32(candidate))
+	return fmt.Sprintf("%s/%d", ip.String(), masklen), nil
+}
@@ -183,3 +183,106 @@ func TestNextAvailableNetByIPNum(t *testing.T) {
 
 	}
 }
+
+func TestIsCidrOverlap(t *testing.T) {
+	cases := []struct {
+		a, b    string
+		overlap bool
+		wantErr bool
+	}{
+		{"10.0.0.0/16", "10.0.1.0/24", true, false},
+		{"10.0.1.0/24", "10.0.0.0/16", true, false},
+		{"10.0.0.0/24", "10.0.1.0/24", false, false},
+		{"10.0.0.0/24", "10.0.0.255/32", true, false},
+		{"0.0.0.0/0", "192.168.0.0/16", true, false},
+		{"172.16.0.0/12", "172.32.0.0/16", false, false},
+		{"10.0.0.0/24", "fd00::/64", false, true},
+		{"10.0.0.0", "10.0.0.0/24", false, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.a+"-"+c.b, func(t *testing.T) {
+			overlap, err := IsCidrOverlap(c.a, c.b)
+			if (err != nil) != c.wantErr {
+				t.Fatalf("got err=%v, except err=%v", err, c.wantErr)
+			}
+			if overlap != c.overlap {
+				t.Errorf("got overlap=%v, except=%v", overlap, c.overlap)
+			}
+		})
+	}
+}
+
+func TestFirstAvailableIpv4Net(t *testing.T) {
+	cases := []struct {
+		outer   string
+		used    []string
+		masklen int
+		want    string
+		wantErr bool
+	}{
+		{"10.0.0.0/16", nil, 24, "10.0.0.0/24", false},
+		{"10.0.0.0/16", []string{"10.0.0.0/24", "10.0.2.0/24"}, 24, "10.0.1.0/24", false},
+		{"10.0.0.0/16", []string{"10.0.0.0/24", "10.0.1.0/25"}, 24, "10.0.2.0/24", false},
+		{"10.0.0.0/16", []string{"10.0.0.128/25"}, 25, "10.0.0.0/25", false},
+		{"10.0.0.0/16", []string{"10.0.0.0/28"}, 20, "10.0.16.0/20", false},
+		{"10.0.0.0/16", []string{"192.168.0.0/16", "10.0.0.0/17"}, 17, "10.0.128.0/17", false},
+		{"10.1.0.0/16", []string{"10.0.0.0/8"}, 24, "", true},
+		{"10.0.0.0/24", []string{"10.0.0.0/25", "10.0.0.128/25"}, 26, "", true},
+		{"10.0.0.0/24", nil, 16, "", true},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%s-%d", c.outer, c.masklen), func(t *testing.T) {
+			got, err := FirstAvailableIpv4Net(c.outer, c.used, c.masklen)
+			if (err != nil) != c.wantErr {
+				t.Fatalf("got err=%v, except err=%v", err, c.wantErr)
+			}
+			if got != c.want {
+				t.Errorf("got net=%s, except=%s", got, c.want)
+			}
+		})
+	}
+}
+
+func TestFirstAvailableIpv4NetUntilExhausted(t *testing.T) {
+	cases := []struct {
+		outer   string
+		used    []string
+		masklen int
+		expect  []string
+	}{
+		{"10.0.0.0/24", nil, 26, []string{"10.0.0.0/26", "10.0.0.64/26", "10.0.0.128/26", "10.0.0.192/26"}},
+		{"10.0.0.0/24", []string{"10.0.0.64/27", "10.0.0.200/32"}, 26, []string{"10.0.0.0/26", "10.0.0.128/26"}},
+		{"10.0.0.0/28", []string{"9.0.0.0/8", "11.0.0.0/8"}, 29, []string{"10.0.0.0/29", "10.0.0.8/29"}},
+		{"10.0.0.0/24", []string{"10.0.0.0/23"}, 26, nil},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%s-%d", c.outer, c.masklen), func(t *testing.T) {
+			used := append([]string(nil), c.used...)
+			got := make([]string, 0)
+			for i := 0; i <= len(c.expect); i++ {
+				next, err := FirstAvailableIpv4Net(c.outer, used, c.masklen)
+				if err != nil {
+					break
+				}
+				for _, one := range used {
+					overlap, _ := IsCidrOverlap(next, one)
+					if overlap {
+						t.Fatalf("allocated net %s overlaps with used %s", next, one)
+					}
+				}
+				got = append(got, next)
+				used = append(used, next)
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(c.expect) {
+				t.Errorf("got nets=%v, except=%v", got, c.expect)
+			}
+			if _, err := FirstAvailableIpv4Net(c.outer, used, c.masklen); err == nil {
+				t.Errorf("outer net %s should be exhausted after %v", c.outer, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package cron 标准cron表达式解析，用于计算周期任务的下次执行时间。
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule cron表达式解析结果，每个字段为允许取值的位图。
+type Schedule struct {
+	spec string
+
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+
+	// domStar/dowStar 日、周字段是否为*，两者都不为*时任一匹配即可，与标准cron行为一致
+	domStar bool
+	dowStar bool
+
+	// every 固定间隔执行，仅 @every 描述符使用
+	every time.Duration
+}
+
+type bounds struct {
+	min, max uint
+	names    map[string]uint
+}
+
+var (
+	minuteBounds = bounds{min: 0, max: 59}
+	hourBounds   = bounds{min: 0, max: 23}
+	domBounds    = bounds{min: 1, max: 31}
+	monthBounds  = bounds{min: 1, max: 12, names: map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dowBounds = bounds{min: 0, max: 6, names: map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Parse 解析cron表达式，支持标准5段格式（分 时 日 月 周），以及 @daily、@hourly、@every 1h30m 等描述符。
+func Parse(spec string) (*Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if len(spec) == 0 {
+		return nil, errors.New("cron spec is empty")
+	}
+
+	if strings.HasPrefix(spec, "@every ") {
+		every, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, "@every ")))
+		if err != nil {
+			return nil, fmt.Errorf("parse cron spec %s failed, err: %v", spec, err)
+		}
+		if every < time.Minute {
+			return nil, fmt.Errorf("cron spec %s interval should >= 1m", spec)
+		}
+		return &Schedule{spec: spec, every: every}, nil
+	}
+
+	expr := spec
+	if strings.HasPrefix(spec, "@") {
+		var exist bool
+		if expr, exist = descriptors[spec]; !exist {
+			return nil, fmt.Errorf("unsupported cron descriptor: %s", spec)
+		}
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron spec %s should have 5 fields, got: %d", spec, len(fields))
+	}
+
+	sch := &Schedule{spec: spec, domStar: fields[2] == "*", dowStar: fields[4] == "*"}
+	var err error
+	if sch.minute, err = parseField(fields[0], minuteBounds); err != nil {
+		return nil, fmt.Errorf("parse cron spec %s minute failed, err: %v", spec, err)
+	}
+	if sch.hour, err = parseField(fields[1], hourBounds); err != nil {
+		return nil, fmt.Errorf("parse cron spec %s hour failed, err: %v", spec, err)
+	}
+	if sch.dom, err = parseField(fields[2], domBounds); err != nil {
+		return nil, fmt.Errorf("parse cron spec %s day of month failed, err: %v", spec, err)
+	}
+	if sch.month, err = parseField(fields[3], monthBounds); err != nil {
+		return nil, fmt.Errorf("parse cron spec %s month failed, err: %v", spec, err)
+	}
+	// 周字段允许使用7表示周日
+	if sch.dow, err = parseField(fields[4], bounds{min: 0, max: 7, names: dowBounds.names}); err != nil {
+		return nil, fmt.Errorf("parse cron spec %s day of week failed, err: %v", spec, err)
+	}
+	if sch.dow&(1<<7) != 0 {
+		sch.dow = sch.dow&^(1<<7) | 1
+	}
+
+	return sch, nil
+}
+
+// parseField 解析单个字段，支持 *、a-b、*/n、a-b/n 以及逗号分隔的列表。
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangeExpr, step := part, uint(1)
+		if idx := strings.Index(part, "/"); idx >= 0 {
+			rangeExpr = part[:idx]
+			val, err := strconv.ParseUint(part[idx+1:], 10, 8)
+			if err != nil || val == 0 {
+				return 0, fmt.Errorf("invalid step: %s", part)
+			}
+			step = uint(val)
+		}
+
+		start, end := b.min, b.max
+		switch {
+		case rangeExpr == "*":
+		case strings.Contains(rangeExpr, "-"):
+			items := strings.SplitN(rangeExpr, "-", 2)
+			var err error
+			if start, err = parseValue(items[0], b); err != nil {
+				return 0, err
+			}
+			if end, err = parseValue(items[1], b); err != nil {
+				return 0, err
+			}
+			if start > end {
+				return 0, fmt.Errorf("invalid range: %s", rangeExpr)
+			}
+		default:
+			val, err := parseValue(rangeExpr, b)
+			if err != nil {
+				return 0, err
+			}
+			start = val
+			// 单个值带步长时，从该值开始直到最大值，如 5/15
+			end = val
+			if step > 1 {
+				end = b.max
+			}
+		}
+
+		for i := start; i <= end; i += step {
+			bits |= 1 << i
+		}
+	}
+
+	return bits, nil
+}
+
+func parseValue(value string, b bounds) (uint, error) {
+	if val, exist := b.names[strings.ToLower(value)]; exist {
+		return val, nil
+	}
+
+	val, err := strconv.ParseUint(value, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value: %s", value)
+	}
+	if uint(val) < b.min || uint(val) > b.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", val, b.min, b.max)
+	}
+
+	return uint(val), nil
+}
+
+// String 返回原始cron表达式
+func (s *Schedule) String() string {
+	return s.spec
+}
+
+// Next 返回严格晚于t的下一次执行时间，精度为分钟，时区与t一致。找不到时返回零值。
+func (s *Schedule) Next(t time.Time) time.Time {
+	if s.every > 0 {
+		return t.Truncate(time.Second).Add(s.every)
+	}
+
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	// 最多向后查找5年，用于处理 2月30日 这类永远无法匹配的表达式
+	yearLimit := t.Year() + 5
+
+	for t.Year() <= yearLimit {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+
+	return domMatch || dowMatch
+}
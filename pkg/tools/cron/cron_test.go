@@ -0,0 +1,77 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNext(t *testing.T) {
+	base := time.Date(2024, 10, 18, 14, 30, 20, 0, time.Local)
+
+	cases := []struct {
+		spec   string
+		expect time.Time
+	}{
+		{spec: "* * * * *", expect: time.Date(2024, 10, 18, 14, 31, 0, 0, time.Local)},
+		{spec: "*/15 * * * *", expect: time.Date(2024, 10, 18, 14, 45, 0, 0, time.Local)},
+		{spec: "0 2 * * *", expect: time.Date(2024, 10, 19, 2, 0, 0, 0, time.Local)},
+		{spec: "@daily", expect: time.Date(2024, 10, 19, 0, 0, 0, 0, time.Local)},
+		{spec: "@hourly", expect: time.Date(2024, 10, 18, 15, 0, 0, 0, time.Local)},
+		{spec: "0 0 1 * *", expect: time.Date(2024, 11, 1, 0, 0, 0, 0, time.Local)},
+		{spec: "30 9 * * mon-fri", expect: time.Date(2024, 10, 21, 9, 30, 0, 0, time.Local)},
+		{spec: "0 0 * * 7", expect: time.Date(2024, 10, 20, 0, 0, 0, 0, time.Local)},
+		{spec: "0 0 29 2 *", expect: time.Date(2028, 2, 29, 0, 0, 0, 0, time.Local)},
+		{spec: "0 8 1,15 * *", expect: time.Date(2024, 11, 1, 8, 0, 0, 0, time.Local)},
+		{spec: "@every 1h30m", expect: base.Add(90 * time.Minute)},
+	}
+
+	for _, c := range cases {
+		sch, err := Parse(c.spec)
+		if err != nil {
+			t.Errorf("parse %s failed, err: %v", c.spec, err)
+			continue
+		}
+
+		if next := sch.Next(base); !next.Equal(c.expect) {
+			t.Errorf("spec %s next should be %s, got: %s", c.spec, c.expect, next)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	specs := []string{"", "* * * *", "60 * * * *", "* 24 * * *", "5-1 * * * *", "*/0 * * * *", "@unknown",
+		"@every 10s", "0 0 30 2 x"}
+
+	for _, spec := range specs {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("parse %s should fail", spec)
+		}
+	}
+
+	sch, err := Parse("0 0 30 2 *")
+	if err != nil {
+		t.Fatalf("parse failed, err: %v", err)
+	}
+	if next := sch.Next(time.Now()); !next.IsZero() {
+		t.Errorf("never matched spec should return zero time, got: %s", next)
+	}
+}
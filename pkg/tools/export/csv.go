@@ -0,0 +1,61 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+// utf8BOM 写在csv文件头，避免excel打开中文乱码
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// CsvWriter csv格式的Writer
+type CsvWriter struct {
+	columns int
+	writer  *csv.Writer
+}
+
+// NewCsvWriter 创建csv Writer，并写入表头
+func NewCsvWriter(w io.Writer, header []string) (*CsvWriter, error) {
+	if _, err := w.Write(utf8BOM); err != nil {
+		return nil, fmt.Errorf("write csv bom failed, err: %v", err)
+	}
+	cw := &CsvWriter{columns: len(header), writer: csv.NewWriter(w)}
+	if err := cw.writer.Write(header); err != nil {
+		return nil, fmt.Errorf("write csv header failed, err: %v", err)
+	}
+	return cw, nil
+}
+
+// Write 写入一行，csv.Writer内部带缓冲，不会每行都落盘
+func (cw *CsvWriter) Write(row []string) error {
+	if len(row) != cw.columns {
+		return fmt.Errorf("csv row column count %d mismatch header %d", len(row), cw.columns)
+	}
+	return cw.writer.Write(row)
+}
+
+// Close 刷新缓冲区
+func (cw *CsvWriter) Close() error {
+	cw.writer.Flush()
+	return cw.writer.Error()
+}
@@ -0,0 +1,63 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package export provides streaming table writers for exporting data as files.
+package export
+
+import (
+	"fmt"
+	"io"
+
+	"hcm/pkg/criteria/enumor"
+)
+
+// Writer 以行为单位流式写出表格数据，写入完成后必须调用Close落盘
+type Writer interface {
+	// Write 写入一行数据，列数需要和表头一致
+	Write(row []string) error
+	// Close 刷新缓冲数据并写出文件尾，不会关闭底层的io.Writer
+	Close() error
+}
+
+// NewWriter 根据导出格式创建Writer，header为表头
+func NewWriter(format enumor.BillExportFormat, w io.Writer, header []string) (Writer, error) {
+	switch format {
+	case enumor.BillExportCSV:
+		return NewCsvWriter(w, header)
+	case enumor.BillExportParquet:
+		return NewParquetWriter(w, header)
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// FileExtension 返回导出格式对应的文件后缀
+func FileExtension(format enumor.BillExportFormat) string {
+	return "." + string(format)
+}
+
+// ContentType 返回导出格式对应的http content type
+func ContentType(format enumor.BillExportFormat) string {
+	switch format {
+	case enumor.BillExportParquet:
+		return "application/vnd.apache.parquet"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
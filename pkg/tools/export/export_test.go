@@ -0,0 +1,92 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"testing"
+
+	"hcm/pkg/criteria/enumor"
+
+	"github.com/apache/arrow/go/v14/parquet/file"
+)
+
+func TestCsvWriter(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(enumor.BillExportCSV, buf, []string{"a", "b.c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write([]string{"1", "x,y"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write([]string{"1"}); err == nil {
+		t.Error("row with mismatched column count should be rejected")
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.HasPrefix(buf.Bytes(), utf8BOM) {
+		t.Error("csv should start with utf8 bom")
+	}
+	records, err := csv.NewReader(bytes.NewReader(buf.Bytes()[len(utf8BOM):])).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || records[0][1] != "b.c" || records[1][1] != "x,y" {
+		t.Errorf("unexpected csv records: %v", records)
+	}
+}
+
+func TestParquetWriter(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(enumor.BillExportParquet, buf, []string{"a", "b.c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 跨越多个row group
+	total := parquetRowGroupSize + 10
+	for i := 0; i < total; i++ {
+		if err := w.Write([]string{fmt.Sprint(i), "v"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := file.NewParquetReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	if reader.NumRows() != int64(total) {
+		t.Errorf("parquet rows: %d, want: %d", reader.NumRows(), total)
+	}
+	if reader.NumRowGroups() != 2 {
+		t.Errorf("parquet row groups: %d, want: 2", reader.NumRowGroups())
+	}
+	if name := reader.MetaData().Schema.Column(1).Name(); name != "b_c" {
+		t.Errorf("parquet column name: %s, want: b_c", name)
+	}
+}
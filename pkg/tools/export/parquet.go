@@ -0,0 +1,127 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/apache/arrow/go/v14/parquet"
+	"github.com/apache/arrow/go/v14/parquet/compress"
+	"github.com/apache/arrow/go/v14/parquet/file"
+	"github.com/apache/arrow/go/v14/parquet/schema"
+)
+
+// parquetRowGroupSize 每个row group缓存的行数，达到后写出，控制内存占用
+const parquetRowGroupSize = 10000
+
+// ParquetWriter parquet格式的Writer，所有列均为字符串类型
+type ParquetWriter struct {
+	writer  *file.Writer
+	columns [][]parquet.ByteArray
+	rows    int
+}
+
+// NewParquetWriter 创建parquet Writer，表头作为列名
+func NewParquetWriter(w io.Writer, header []string) (*ParquetWriter, error) {
+	fields := make(schema.FieldList, 0, len(header))
+	for _, name := range header {
+		node, err := schema.NewPrimitiveNodeLogical(parquetColumnName(name), parquet.Repetitions.Required,
+			schema.StringLogicalType{}, parquet.Types.ByteArray, -1, -1)
+		if err != nil {
+			return nil, fmt.Errorf("create parquet column %s failed, err: %v", name, err)
+		}
+		fields = append(fields, node)
+	}
+	root, err := schema.NewGroupNode("schema", parquet.Repetitions.Required, fields, -1)
+	if err != nil {
+		return nil, fmt.Errorf("create parquet schema failed, err: %v", err)
+	}
+
+	props := parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Snappy))
+	// file.Writer关闭时会关闭底层的io.Writer，这里屏蔽掉Close，由调用方负责关闭
+	pw := &ParquetWriter{
+		writer:  file.NewParquetWriter(struct{ io.Writer }{w}, root, file.WithWriterProps(props)),
+		columns: make([][]parquet.ByteArray, len(header)),
+	}
+	pw.resetColumns()
+	return pw, nil
+}
+
+// parquetColumnName 列路径以点号分隔，列名中的点号替换为下划线
+func parquetColumnName(name string) string {
+	return strings.ReplaceAll(name, ".", "_")
+}
+
+// Write 写入一行，缓存满一个row group后写出
+func (pw *ParquetWriter) Write(row []string) error {
+	if len(row) != len(pw.columns) {
+		return fmt.Errorf("parquet row column count %d mismatch header %d", len(row), len(pw.columns))
+	}
+	for i, value := range row {
+		pw.columns[i] = append(pw.columns[i], parquet.ByteArray(value))
+	}
+	pw.rows++
+	if pw.rows >= parquetRowGroupSize {
+		return pw.flush()
+	}
+	return nil
+}
+
+// Close 写出剩余数据和文件尾
+func (pw *ParquetWriter) Close() error {
+	if err := pw.flush(); err != nil {
+		return err
+	}
+	return pw.writer.Close()
+}
+
+func (pw *ParquetWriter) flush() error {
+	if pw.rows == 0 {
+		return nil
+	}
+	rgw := pw.writer.AppendRowGroup()
+	for i := range pw.columns {
+		cw, err := rgw.NextColumn()
+		if err != nil {
+			return fmt.Errorf("get parquet column %d writer failed, err: %v", i, err)
+		}
+		byteArrayWriter, ok := cw.(*file.ByteArrayColumnChunkWriter)
+		if !ok {
+			return fmt.Errorf("parquet column %d is not byte array column", i)
+		}
+		if _, err := byteArrayWriter.WriteBatch(pw.columns[i], nil, nil); err != nil {
+			return fmt.Errorf("write parquet column %d failed, err: %v", i, err)
+		}
+	}
+	if err := rgw.Close(); err != nil {
+		return fmt.Errorf("close parquet row group failed, err: %v", err)
+	}
+	pw.resetColumns()
+	return nil
+}
+
+func (pw *ParquetWriter) resetColumns() {
+	for i := range pw.columns {
+		pw.columns[i] = make([]parquet.ByteArray, 0, parquetRowGroupSize)
+	}
+	pw.rows = 0
+}
@@ -0,0 +1,62 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2024 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+
+
+/*
+    SQLVER=9999,HCMVER=v9.9.9
+
+    Notes:
+    1. 修改`async_flow`表: 增加`run_at`字段，任务流计划执行时间，到达该时间后才会被派发执行
+    2. 添加`async_cron_flow`表: 周期任务流，按照cron表达式周期性创建任务流
+*/
+
+START TRANSACTION;
+
+alter table async_flow
+    add column run_at timestamp not null default current_timestamp after compensate;
+
+create table if not exists `async_cron_flow`
+(
+    `id`           varchar(64)  not null,
+    `name`         varchar(64)  not null,
+    `spec`         varchar(64)  not null,
+    `flow`         json         not null,
+    `enabled`      boolean      not null default true,
+    `next_run_at`  timestamp    not null default current_timestamp,
+    `last_flow_id` varchar(64)  not null default '',
+    `memo`         varchar(255) not null default '',
+    `creator`      varchar(64)  not null,
+    `reviser`      varchar(64)  not null,
+    `created_at`   timestamp    not null default current_timestamp,
+    `updated_at`   timestamp    not null default current_timestamp on update current_timestamp,
+    primary key (`id`),
+    unique key `idx_uk_name` (`name`),
+    key `idx_enabled_next_run_at` (`enabled`, `next_run_at`)
+) engine = innodb
+  default charset = utf8mb4
+  collate utf8mb4_bin;
+
+insert into id_generator(`resource`, `max_id`)
+values ('async_cron_flow', '0');
+
+CREATE OR REPLACE VIEW `hcm_version`(`hcm_ver`, `sql_ver`) AS
+SELECT 'v9.9.9' as `hcm_ver`, '9999' as `sql_ver`;
+
+COMMIT
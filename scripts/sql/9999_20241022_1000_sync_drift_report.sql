@@ -0,0 +1,52 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2024 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+/*
+    SQLVER=9999,HCMVER=v9.9.9
+
+    Notes:
+    1. 添加`sync_drift_report`表: 记录 dry-run 同步时对比出的db变更，用于在变更落库前进行审查
+*/
+
+START TRANSACTION;
+
+create table if not exists `sync_drift_report`
+(
+    `id`         varchar(64) not null,
+    `vendor`     varchar(16) not null,
+    `account_id` varchar(64) not null,
+    `region`     varchar(64) not null default '',
+    `res_type`   varchar(64) not null,
+    `summary`    json                 default null,
+    `changes`    json                 default null,
+    `creator`    varchar(64) not null,
+    `created_at` timestamp   not null default current_timestamp,
+    primary key (`id`),
+    key `idx_account_id_res_type` (`account_id`, `res_type`)
+) engine = innodb
+  default charset = utf8mb4
+  collate utf8mb4_bin;
+
+insert into id_generator(`resource`, `max_id`)
+values ('sync_drift_report', '0');
+
+CREATE OR REPLACE VIEW `hcm_version`(`hcm_ver`, `sql_ver`) AS
+SELECT 'v9.9.9' as `hcm_ver`, '9999' as `sql_ver`;
+
+COMMIT
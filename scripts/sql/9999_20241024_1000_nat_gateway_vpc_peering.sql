@@ -0,0 +1,118 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2024 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+
+/*
+    SQLVER=9999,HCMVER=v9.9.9
+
+    Notes:
+    1. 添加`nat_gateway`表: 记录NAT网关及其所属VPC、子网
+    2. 添加`vpc_peering`表: 记录对等连接及其两端VPC
+    3. `tcloud_route`、`aws_route`、`huawei_route`、`gcp_route`表添加`next_hop_res_type`、`next_hop_res_id`字段:
+       记录路由下一跳对应的本地资源
+*/
+
+START TRANSACTION;
+
+create table if not exists `nat_gateway`
+(
+    `id`                 varchar(64)  not null,
+    `vendor`             varchar(16)  not null,
+    `account_id`         varchar(64)  not null,
+    `cloud_id`           varchar(255) not null,
+    `bk_biz_id`          bigint       not null default -1,
+    `name`               varchar(255) not null default '',
+    `region`             varchar(128) not null default '',
+    `zone`               varchar(128) not null default '',
+    `status`             varchar(64)  not null default '',
+    `vpc_id`             varchar(64)  not null default '',
+    `cloud_vpc_id`       varchar(255) not null default '',
+    `subnet_id`          varchar(64)  not null default '',
+    `cloud_subnet_id`    varchar(255) not null default '',
+    `public_ips`         json                  default null,
+    `private_ips`        json                  default null,
+    `cloud_created_time` varchar(64)  not null default '',
+    `memo`               varchar(255)          default '',
+    `extension`          json                  default null,
+    `creator`            varchar(64)  not null,
+    `reviser`            varchar(64)  not null,
+    `created_at`         timestamp    not null default current_timestamp,
+    `updated_at`         timestamp    not null default current_timestamp on update current_timestamp,
+    primary key (`id`),
+    unique key `idx_uk_cloud_id_vendor` (`cloud_id`, `vendor`),
+    key `idx_vpc_id` (`vpc_id`)
+) engine = innodb
+  default charset = utf8mb4
+  collate utf8mb4_bin;
+
+create table if not exists `vpc_peering`
+(
+    `id`                 varchar(64)  not null,
+    `vendor`             varchar(16)  not null,
+    `account_id`         varchar(64)  not null,
+    `cloud_id`           varchar(255) not null,
+    `bk_biz_id`          bigint       not null default -1,
+    `name`               varchar(255) not null default '',
+    `region`             varchar(128) not null default '',
+    `status`             varchar(64)  not null default '',
+    `vpc_id`             varchar(64)  not null default '',
+    `cloud_vpc_id`       varchar(255) not null default '',
+    `peer_vpc_id`        varchar(64)  not null default '',
+    `cloud_peer_vpc_id`  varchar(255) not null default '',
+    `peer_region`        varchar(128) not null default '',
+    `peer_account`       varchar(255) not null default '',
+    `cloud_created_time` varchar(64)  not null default '',
+    `memo`               varchar(255)          default '',
+    `extension`          json                  default null,
+    `creator`            varchar(64)  not null,
+    `reviser`            varchar(64)  not null,
+    `created_at`         timestamp    not null default current_timestamp,
+    `updated_at`         timestamp    not null default current_timestamp on update current_timestamp,
+    primary key (`id`),
+    unique key `idx_uk_cloud_id_vendor` (`cloud_id`, `vendor`),
+    key `idx_vpc_id` (`vpc_id`),
+    key `idx_peer_vpc_id` (`peer_vpc_id`)
+) engine = innodb
+  default charset = utf8mb4
+  collate utf8mb4_bin;
+
+alter table tcloud_route
+    add column next_hop_res_type varchar(64) not null default '' after cloud_gateway_id,
+    add column next_hop_res_id   varchar(64) not null default '' after next_hop_res_type;
+
+alter table aws_route
+    add column next_hop_res_type varchar(64) not null default '' after cloud_vpc_peering_connection_id,
+    add column next_hop_res_id   varchar(64) not null default '' after next_hop_res_type;
+
+alter table huawei_route
+    add column next_hop_res_type varchar(64) not null default '' after nexthop,
+    add column next_hop_res_id   varchar(64) not null default '' after next_hop_res_type;
+
+alter table gcp_route
+    add column next_hop_res_type varchar(64) not null default '' after next_hop_peering,
+    add column next_hop_res_id   varchar(64) not null default '' after next_hop_res_type;
+
+insert into id_generator(`resource`, `max_id`)
+values ('nat_gateway', '0'),
+       ('vpc_peering', '0');
+
+CREATE OR REPLACE VIEW `hcm_version`(`hcm_ver`, `sql_ver`) AS
+SELECT 'v9.9.9' as `hcm_ver`, '9999' as `sql_ver`;
+
+COMMIT
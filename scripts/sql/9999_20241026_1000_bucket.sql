@@ -0,0 +1,62 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2024 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+
+/*
+    SQLVER=9999,HCMVER=v9.9.9
+
+    Notes:
+    1. 添加`bucket`表: 记录对象存储桶(TCloud COS、AWS S3、Azure Blob容器、GCP GCS、HuaWei OBS)
+*/
+
+START TRANSACTION;
+
+create table if not exists `bucket`
+(
+    `id`                 varchar(64)  not null,
+    `vendor`             varchar(16)  not null,
+    `account_id`         varchar(64)  not null,
+    `cloud_id`           varchar(255) not null,
+    `bk_biz_id`          bigint       not null default -1,
+    `name`               varchar(255) not null default '',
+    `region`             varchar(128) not null default '',
+    `storage_class`      varchar(64)  not null default '',
+    `public_access`      varchar(32)  not null default '',
+    `encryption`         varchar(64)  not null default '',
+    `cloud_created_time` varchar(64)  not null default '',
+    `memo`               varchar(255)          default '',
+    `extension`          json                  default null,
+    `creator`            varchar(64)  not null,
+    `reviser`            varchar(64)  not null,
+    `created_at`         timestamp    not null default current_timestamp,
+    `updated_at`         timestamp    not null default current_timestamp on update current_timestamp,
+    primary key (`id`),
+    unique key `idx_uk_cloud_id_vendor` (`cloud_id`, `vendor`),
+    key `idx_bk_biz_id` (`bk_biz_id`)
+) engine = innodb
+  default charset = utf8mb4
+  collate utf8mb4_bin;
+
+insert into id_generator(`resource`, `max_id`)
+values ('bucket', '0');
+
+CREATE OR REPLACE VIEW `hcm_version`(`hcm_ver`, `sql_ver`) AS
+SELECT 'v9.9.9' as `hcm_ver`, '9999' as `sql_ver`;
+
+COMMIT
@@ -0,0 +1,71 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2024 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+/*
+    SQLVER=9999,HCMVER=v9.9.9
+
+    Notes:
+    1. 添加`zenlayer_region`表: 记录Zenlayer支持的地区列表
+    2. 添加`kaopu_region`表: 记录靠谱云支持的地区列表
+*/
+
+START TRANSACTION;
+
+create table if not exists `zenlayer_region`
+(
+    `id`          varchar(64) not null,
+    `vendor`      varchar(16) not null,
+    `region_id`   varchar(32) not null,
+    `region_name` varchar(64) not null,
+    `status`      varchar(32)          default '',
+    `creator`     varchar(64)          default '',
+    `reviser`     varchar(64)          default '',
+    `created_at`  timestamp   not null default current_timestamp,
+    `updated_at`  timestamp   not null default current_timestamp on update current_timestamp,
+    primary key (`id`),
+    unique key `idx_uk_region_id_status` (`region_id`, `status`),
+    key `idx_uk_vendor` (`vendor`)
+) engine = innodb
+  default charset = utf8mb4 comment ='Zenlayer支持的地区列表';
+
+create table if not exists `kaopu_region`
+(
+    `id`          varchar(64) not null,
+    `vendor`      varchar(16) not null,
+    `region_id`   varchar(32) not null,
+    `region_name` varchar(64) not null,
+    `status`      varchar(32)          default '',
+    `creator`     varchar(64)          default '',
+    `reviser`     varchar(64)          default '',
+    `created_at`  timestamp   not null default current_timestamp,
+    `updated_at`  timestamp   not null default current_timestamp on update current_timestamp,
+    primary key (`id`),
+    unique key `idx_uk_region_id_status` (`region_id`, `status`),
+    key `idx_uk_vendor` (`vendor`)
+) engine = innodb
+  default charset = utf8mb4 comment ='靠谱云支持的地区列表';
+
+insert into id_generator(`resource`, `max_id`)
+values ('zenlayer_region', '0'),
+       ('kaopu_region', '0');
+
+CREATE OR REPLACE VIEW `hcm_version`(`hcm_ver`, `sql_ver`) AS
+SELECT 'v9.9.9' as `hcm_ver`, '9999' as `sql_ver`;
+
+COMMIT
@@ -0,0 +1,75 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2024 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+/*
+    SQLVER=9999,HCMVER=v9.9.9
+
+    Notes:
+    1. 添加`ipam_pool`表: 记录按业务、地域划分的IP地址池
+    2. 添加`ipam_allocation`表: 记录从地址池中分配出去的网段
+*/
+
+START TRANSACTION;
+
+create table if not exists `ipam_pool`
+(
+    `id`         varchar(64)  not null,
+    `name`       varchar(255) not null,
+    `vendor`     varchar(16)  not null,
+    `region`     varchar(128) not null default '',
+    `bk_biz_id`  bigint       not null default -1,
+    `cidr`       varchar(64)  not null,
+    `memo`       varchar(255)          default '',
+    `creator`    varchar(64)  not null,
+    `reviser`    varchar(64)  not null,
+    `created_at` timestamp    not null default current_timestamp,
+    `updated_at` timestamp    not null default current_timestamp on update current_timestamp,
+    primary key (`id`),
+    unique key `idx_uk_vendor_region_bk_biz_id_cidr` (`vendor`, `region`, `bk_biz_id`, `cidr`)
+) engine = innodb
+  default charset = utf8mb4
+  collate utf8mb4_bin;
+
+create table if not exists `ipam_allocation`
+(
+    `id`         varchar(64)  not null,
+    `pool_id`    varchar(64)  not null,
+    `cidr`       varchar(64)  not null,
+    `res_type`   varchar(64)  not null,
+    `res_id`     varchar(64)  not null default '',
+    `memo`       varchar(255)          default '',
+    `creator`    varchar(64)  not null,
+    `reviser`    varchar(64)  not null,
+    `created_at` timestamp    not null default current_timestamp,
+    `updated_at` timestamp    not null default current_timestamp on update current_timestamp,
+    primary key (`id`),
+    unique key `idx_uk_pool_id_cidr` (`pool_id`, `cidr`),
+    key `idx_res_id` (`res_id`)
+) engine = innodb
+  default charset = utf8mb4
+  collate utf8mb4_bin;
+
+insert into id_generator(`resource`, `max_id`)
+values ('ipam_pool', '0'),
+       ('ipam_allocation', '0');
+
+CREATE OR REPLACE VIEW `hcm_version`(`hcm_ver`, `sql_ver`) AS
+SELECT 'v9.9.9' as `hcm_ver`, '9999' as `sql_ver`;
+
+COMMIT
@@ -0,0 +1,85 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2024 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+/*
+    SQLVER=9999,HCMVER=v9.9.9
+
+    Notes:
+    1. 添加`account_bill_split_rule`表: 记录共享二级账号的分账规则
+    2. 添加`account_bill_split_snapshot`表: 记录共享二级账号按天分账时使用的主机数量，重新分账时复用
+    3. `account_bill_summary_version`表添加`split_rule_snapshot`字段: 记录版本对应的分账规则
+*/
+
+START TRANSACTION;
+
+create table if not exists `account_bill_split_rule`
+(
+    `id`              varchar(64)  not null,
+    `name`            varchar(128) not null,
+    `vendor`          varchar(16)  not null,
+    `root_account_id` varchar(64)  not null,
+    `main_account_id` varchar(64)  not null,
+    `priority`        bigint       not null default 0,
+    `split_type`      varchar(32)  not null,
+    `match_rule`      json         not null,
+    `targets`         json         not null,
+    `memo`            varchar(255)          default '',
+    `creator`         varchar(64)  not null,
+    `reviser`         varchar(64)  not null,
+    `created_at`      timestamp    not null default current_timestamp,
+    `updated_at`      timestamp    not null default current_timestamp on update current_timestamp,
+    primary key (`id`),
+    key `idx_main_account_id_priority` (`main_account_id`, `priority`)
+) engine = innodb
+  default charset = utf8mb4
+  collate utf8mb4_bin;
+
+insert into id_generator(`resource`, `max_id`)
+values ('account_bill_split_rule', '0');
+
+create table if not exists `account_bill_split_snapshot`
+(
+    `id`              varchar(64) not null,
+    `root_account_id` varchar(64) not null,
+    `main_account_id` varchar(64) not null,
+    `vendor`          varchar(16) not null,
+    `bill_year`       bigint(1)   not null,
+    `bill_month`      tinyint(1)  not null,
+    `bill_day`        tinyint(1)  not null,
+    `cvm_counts`      json        not null,
+    `creator`         varchar(64) not null,
+    `reviser`         varchar(64) not null,
+    `created_at`      timestamp   not null default current_timestamp,
+    `updated_at`      timestamp   not null default current_timestamp on update current_timestamp,
+    primary key (`id`),
+    unique key `idx_uk_main_account_id_bill_date` (`main_account_id`, `bill_year`, `bill_month`, `bill_day`)
+) engine = innodb
+  default charset = utf8mb4
+  collate utf8mb4_bin;
+
+insert into id_generator(`resource`, `max_id`)
+values ('account_bill_split_snapshot', '0');
+
+alter table `account_bill_summary_version`
+    add column `split_rule_snapshot` json default null after `rmb_cost`;
+
+CREATE OR REPLACE VIEW `hcm_version`(`hcm_ver`, `sql_ver`) AS
+SELECT 'v9.9.9' as `hcm_ver`, '9999' as `sql_ver`;
+
+COMMIT
@@ -0,0 +1,39 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2024 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+/*
+    SQLVER=9999,HCMVER=v9.9.9
+
+    Notes:
+    1. 修改`account_bill_item`表: 增加`cloud_res_id`、`res_type`、`res_id`、`res_bk_biz_id`字段，记录账单明细关联的云资源
+*/
+
+START TRANSACTION;
+
+alter table account_bill_item
+    add column cloud_res_id  varchar(255) not null default '' after res_amount_unit,
+    add column res_type      varchar(64)  not null default '' after cloud_res_id,
+    add column res_id        varchar(64)  not null default '' after res_type,
+    add column res_bk_biz_id bigint       not null default 0 after res_id,
+    add index `idx_bill_item_res_id` (`vendor`, `bill_year`, `bill_month`, `res_type`, `res_id`);
+
+CREATE OR REPLACE VIEW `hcm_version`(`hcm_ver`, `sql_ver`) AS
+SELECT 'v9.9.9' as `hcm_ver`, '9999' as `sql_ver`;
+
+COMMIT
@@ -0,0 +1,79 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2024 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+/*
+    SQLVER=9999,HCMVER=v9.9.9
+
+    Notes:
+    1. 添加`account_bill_budget`表: 记录业务、二级账号、一级账号或运营产品的月度预算，同一作用范围可设置多个预算
+    2. 添加`account_bill_budget_alert`表: 记录预算告警及通知状态，同一预算每月每个阈值只告警一次，通知失败时重试
+*/
+
+START TRANSACTION;
+
+create table if not exists `account_bill_budget`
+(
+    `id`          varchar(64)     not null,
+    `name`        varchar(128)    not null,
+    `scope`       varchar(32)     not null,
+    `scope_id`    varchar(64)     not null,
+    `amount`      decimal(38, 10) not null,
+    `thresholds`  json            not null,
+    `receivers`   json            not null,
+    `webhook_url` varchar(512)             default '',
+    `memo`        varchar(255)             default '',
+    `creator`     varchar(64)     not null,
+    `reviser`     varchar(64)     not null,
+    `created_at`  timestamp       not null default current_timestamp,
+    `updated_at`  timestamp       not null default current_timestamp on update current_timestamp,
+    primary key (`id`),
+    key `idx_scope_scope_id` (`scope`, `scope_id`)
+) engine = innodb
+  default charset = utf8mb4
+  collate utf8mb4_bin;
+
+create table if not exists `account_bill_budget_alert`
+(
+    `id`           varchar(64)     not null,
+    `budget_id`    varchar(64)     not null,
+    `bill_year`    int             not null,
+    `bill_month`   tinyint         not null,
+    `alert_type`   varchar(16)     not null,
+    `threshold`    int             not null,
+    `amount`       decimal(38, 10) not null,
+    `cost`         decimal(38, 10) not null,
+    `notify_state` varchar(16)     not null default 'pending',
+    `notify_count` int             not null default 0,
+    `creator`      varchar(64)     not null,
+    `created_at`   timestamp       not null default current_timestamp,
+    `updated_at`   timestamp       not null default current_timestamp on update current_timestamp,
+    primary key (`id`),
+    unique key `idx_uk_budget_month_threshold` (`budget_id`, `bill_year`, `bill_month`, `alert_type`, `threshold`)
+) engine = innodb
+  default charset = utf8mb4
+  collate utf8mb4_bin;
+
+insert into id_generator(`resource`, `max_id`)
+values ('account_bill_budget', '0'),
+       ('account_bill_budget_alert', '0');
+
+CREATE OR REPLACE VIEW `hcm_version`(`hcm_ver`, `sql_ver`) AS
+SELECT 'v9.9.9' as `hcm_ver`, '9999' as `sql_ver`;
+
+COMMIT
@@ -0,0 +1,50 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	faketcloud "hcm/test/fake-cloud/tcloud"
+)
+
+// addr fake cloud server listen address.
+var addr string
+
+// jobDelay async job finish delay.
+var jobDelay time.Duration
+
+func main() {
+	flag.StringVar(&addr, "addr", "127.0.0.1:9900", "fake cloud server listen address, hc-service "+
+		"should set cloudEndpoint.tcloud to http://{addr}")
+	flag.DurationVar(&jobDelay, "job-delay", 2*time.Second, "time cost for async jobs such as creating cvm "+
+		"or binding eip to finish")
+	flag.Parse()
+
+	server := faketcloud.NewServer(faketcloud.Option{JobDelay: jobDelay})
+
+	log.Printf("fake tcloud server listening on %s, job delay: %s\n", addr, jobDelay)
+	if err := http.ListenAndServe(addr, server); err != nil {
+		log.Fatalln(err)
+	}
+}
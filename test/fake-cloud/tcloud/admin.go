@@ -0,0 +1,85 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package faketcloud
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+const adminPathPrefix = "/fake/"
+
+// serveAdmin 管理接口:
+// POST /fake/faults 注入错误; POST /fake/throttles 设置限频; DELETE /fake/faults 清除错误和限频;
+// POST /fake/reset 清空所有资源; GET /fake/state 查看当前所有资源
+func (s *Server) serveAdmin(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == adminPathPrefix+"faults":
+		fault := Fault{}
+		if err := json.NewDecoder(r.Body).Decode(&fault); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.InjectFault(fault)
+
+	case r.Method == http.MethodDelete && r.URL.Path == adminPathPrefix+"faults":
+		s.ClearFaults()
+
+	case r.Method == http.MethodPost && r.URL.Path == adminPathPrefix+"throttles":
+		t := Throttle{}
+		if err := json.NewDecoder(r.Body).Decode(&t); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.SetThrottle(t)
+
+	case r.Method == http.MethodPost && r.URL.Path == adminPathPrefix+"reset":
+		s.Reset()
+
+	case r.Method == http.MethodGet && r.URL.Path == adminPathPrefix+"state":
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.runDueJobs(time.Now())
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(s.store); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Reset 清空所有资源、未完成的异步任务以及注入的错误
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.store = newStore()
+	s.jobs = nil
+	s.faults = nil
+	s.throttles = make(map[string]*throttle)
+}
@@ -0,0 +1,252 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package faketcloud
+
+import (
+	"hcm/pkg/tools/converter"
+
+	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
+)
+
+const serviceCbs = "cbs"
+
+// 云硬盘状态
+const (
+	diskUnattached = "UNATTACHED"
+	diskAttaching  = "ATTACHING"
+	diskAttached   = "ATTACHED"
+	diskDetaching  = "DETACHING"
+)
+
+func (s *Server) registerCbs() {
+	s.register(serviceCbs, "DescribeDisks", s.describeDisks)
+	s.register(serviceCbs, "CreateDisks", s.createDisks)
+	s.register(serviceCbs, "AttachDisks", s.attachDisks)
+	s.register(serviceCbs, "DetachDisks", s.detachDisks)
+	s.register(serviceCbs, "TerminateDisks", s.terminateDisks)
+}
+
+func (s *Server) describeDisks(req *request) (any, error) {
+	params := new(cbs.DescribeDisksRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	list, total, err := s.store.Disks.query(req, params.DiskIds, diskField)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cbs.DescribeDisksResponseParams{TotalCount: converter.ValToPtr(total), DiskSet: list}, nil
+}
+
+func diskField(v *cbs.Disk, name string) ([]string, bool) {
+	switch name {
+	case "disk-id":
+		return []string{converter.PtrToVal(v.DiskId)}, true
+	case "disk-name":
+		return []string{converter.PtrToVal(v.DiskName)}, true
+	case "disk-state":
+		return []string{converter.PtrToVal(v.DiskState)}, true
+	case "disk-usage":
+		return []string{converter.PtrToVal(v.DiskUsage)}, true
+	case "disk-type":
+		return []string{converter.PtrToVal(v.DiskType)}, true
+	case "instance-id":
+		return []string{converter.PtrToVal(v.InstanceId)}, true
+	case "zone":
+		return []string{converter.PtrToVal(v.Placement.Zone)}, true
+	default:
+		return nil, false
+	}
+}
+
+// createDisks 云硬盘在异步任务完成之后才能查询到
+func (s *Server) createDisks(req *request) (any, error) {
+	params := new(cbs.CreateDisksRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	if params.Placement == nil {
+		return nil, newError("MissingParameter", "Placement is required")
+	}
+
+	if err := validateZone(req.region, converter.PtrToVal(params.Placement.Zone)); err != nil {
+		return nil, err
+	}
+
+	if len(converter.PtrToVal(params.DiskType)) == 0 || len(converter.PtrToVal(params.DiskChargeType)) == 0 {
+		return nil, newError("MissingParameter", "DiskType and DiskChargeType are required")
+	}
+
+	count := converter.PtrToVal(params.DiskCount)
+	if count == 0 {
+		count = 1
+	}
+	if count > maxLimit {
+		return nil, newError(errInvalidParameterVal, "DiskCount should be in range [1, %d]", maxLimit)
+	}
+
+	tags := params.Tags
+	if tags == nil {
+		tags = make([]*cbs.Tag, 0)
+	}
+
+	ids := make([]*string, 0, count)
+	region := req.region
+	for i := uint64(0); i < count; i++ {
+		disk := &cbs.Disk{
+			DeleteWithInstance: converter.ValToPtr(false),
+			RenewFlag:          converter.ValToPtr("NOTIFY_AND_MANUAL_RENEW"),
+			DiskType:           params.DiskType,
+			DiskState:          converter.ValToPtr(diskUnattached),
+			DiskName:           params.DiskName,
+			Tags:               tags,
+			DiskId:             converter.ValToPtr(s.nextID("disk")),
+			Placement:          params.Placement,
+			Attached:           converter.ValToPtr(false),
+			DiskSize:           params.DiskSize,
+			DiskUsage:          converter.ValToPtr("DATA_DISK"),
+			DiskChargeType:     params.DiskChargeType,
+			Portable:           converter.ValToPtr(true),
+			Shareable:          converter.ValToPtr(converter.PtrToVal(params.Shareable)),
+			CreateTime:         converter.ValToPtr(stdTime()),
+			InstanceIdList:     make([]*string, 0),
+		}
+		ids = append(ids, disk.DiskId)
+
+		s.schedule(func() {
+			s.store.Disks.add(region, *disk.DiskId, disk)
+		})
+	}
+
+	return &cbs.CreateDisksResponseParams{DiskIdSet: ids}, nil
+}
+
+func (s *Server) getDisks(region string, ids []*string, state string) ([]*cbs.Disk, error) {
+	if len(ids) == 0 {
+		return nil, newError("MissingParameter", "DiskIds is required")
+	}
+
+	disks := make([]*cbs.Disk, 0, len(ids))
+	for _, id := range ids {
+		disk, exists := s.store.Disks.get(region, converter.PtrToVal(id))
+		if !exists {
+			return nil, newError("InvalidDiskId.NotFound", "disk %s not found", converter.PtrToVal(id))
+		}
+
+		if converter.PtrToVal(disk.DiskState) != state {
+			return nil, newError("InvalidDisk.Busy", "disk %s is %s, expect %s", *disk.DiskId,
+				converter.PtrToVal(disk.DiskState), state)
+		}
+		disks = append(disks, disk)
+	}
+
+	return disks, nil
+}
+
+func (s *Server) attachDisks(req *request) (any, error) {
+	params := new(cbs.AttachDisksRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	ins, exists := s.store.Instances.get(req.region, converter.PtrToVal(params.InstanceId))
+	if !exists {
+		return nil, newError("InvalidInstanceId.NotFound", "instance %s not found",
+			converter.PtrToVal(params.InstanceId))
+	}
+
+	disks, err := s.getDisks(req.region, params.DiskIds, diskUnattached)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, disk := range disks {
+		if converter.PtrToVal(disk.Placement.Zone) != converter.PtrToVal(ins.Placement.Zone) {
+			return nil, newError("InvalidParameter.DiskZoneNotMatch", "disk %s is not in the zone of instance %s",
+				*disk.DiskId, *ins.InstanceId)
+		}
+	}
+
+	for _, one := range disks {
+		disk := one
+		disk.DiskState = converter.ValToPtr(diskAttaching)
+		s.schedule(func() {
+			disk.DiskState = converter.ValToPtr(diskAttached)
+			disk.Attached = converter.ValToPtr(true)
+			disk.InstanceId = ins.InstanceId
+			disk.InstanceIdList = []*string{ins.InstanceId}
+			disk.DeleteWithInstance = converter.ValToPtr(converter.PtrToVal(params.DeleteWithInstance))
+			disk.InstanceType = converter.ValToPtr("CVM")
+		})
+	}
+
+	return nil, nil
+}
+
+func (s *Server) detachDisks(req *request) (any, error) {
+	params := new(cbs.DetachDisksRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	disks, err := s.getDisks(req.region, params.DiskIds, diskAttached)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, one := range disks {
+		disk := one
+		disk.DiskState = converter.ValToPtr(diskDetaching)
+		s.schedule(func() {
+			detachDisk(disk)
+		})
+	}
+
+	return nil, nil
+}
+
+func detachDisk(disk *cbs.Disk) {
+	disk.DiskState = converter.ValToPtr(diskUnattached)
+	disk.Attached = converter.ValToPtr(false)
+	disk.LastAttachInsId = disk.InstanceId
+	disk.InstanceId = nil
+	disk.InstanceIdList = make([]*string, 0)
+	disk.InstanceType = nil
+}
+
+func (s *Server) terminateDisks(req *request) (any, error) {
+	params := new(cbs.TerminateDisksRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getDisks(req.region, params.DiskIds, diskUnattached); err != nil {
+		return nil, err
+	}
+
+	for _, id := range params.DiskIds {
+		s.store.Disks.remove(*id)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,266 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package faketcloud
+
+import (
+	"strconv"
+
+	"hcm/pkg/tools/converter"
+
+	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
+)
+
+const serviceClb = "clb"
+
+// 负载均衡实例状态以及异步任务状态
+const (
+	clbCreating = 0
+	clbNormal   = 1
+
+	clbTaskSuccess = 0
+	clbTaskRunning = 2
+)
+
+func (s *Server) registerClb() {
+	s.register(serviceClb, "DescribeLoadBalancers", s.describeLoadBalancers)
+	s.register(serviceClb, "CreateLoadBalancer", s.createLoadBalancer)
+	s.register(serviceClb, "DeleteLoadBalancer", s.deleteLoadBalancer)
+	s.register(serviceClb, "DescribeTaskStatus", s.describeTaskStatus)
+}
+
+func (s *Server) describeLoadBalancers(req *request) (any, error) {
+	params := new(clb.DescribeLoadBalancersRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	req.extraFilters = make(map[string][]string)
+	if params.LoadBalancerType != nil {
+		req.extraFilters["load-balancer-type"] = []string{*params.LoadBalancerType}
+	}
+	if params.LoadBalancerName != nil {
+		req.extraFilters["load-balancer-name"] = []string{*params.LoadBalancerName}
+	}
+	if params.VpcId != nil {
+		req.extraFilters["vpc-id"] = []string{*params.VpcId}
+	}
+	if len(params.LoadBalancerVips) != 0 {
+		req.extraFilters["load-balancer-vip"] = converter.PtrToSlice(params.LoadBalancerVips)
+	}
+
+	list, total, err := s.store.LoadBalancers.query(req, params.LoadBalancerIds, loadBalancerField)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clb.DescribeLoadBalancersResponseParams{
+		TotalCount:      converter.ValToPtr(total),
+		LoadBalancerSet: list,
+	}, nil
+}
+
+func loadBalancerField(v *clb.LoadBalancer, name string) ([]string, bool) {
+	switch name {
+	case "load-balancer-id":
+		return []string{converter.PtrToVal(v.LoadBalancerId)}, true
+	case "load-balancer-name":
+		return []string{converter.PtrToVal(v.LoadBalancerName)}, true
+	case "load-balancer-type":
+		return []string{converter.PtrToVal(v.LoadBalancerType)}, true
+	case "load-balancer-vip":
+		return converter.PtrToSlice(v.LoadBalancerVips), true
+	case "vpc-id":
+		return []string{converter.PtrToVal(v.VpcId)}, true
+	case "status":
+		return []string{strconv.FormatUint(converter.PtrToVal(v.Status), 10)}, true
+	default:
+		return nil, false
+	}
+}
+
+// createLoadBalancer 以受理请求的RequestId作为异步任务ID，与云上一致
+func (s *Server) createLoadBalancer(req *request) (any, error) {
+	params := new(clb.CreateLoadBalancerRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateCreateLoadBalancer(req.region, params); err != nil {
+		return nil, err
+	}
+
+	number := converter.PtrToVal(params.Number)
+	if number == 0 {
+		number = 1
+	}
+
+	lbs := make([]*clb.LoadBalancer, 0, number)
+	for i := uint64(0); i < number; i++ {
+		lb, err := s.newLoadBalancer(req.region, params)
+		if err != nil {
+			return nil, err
+		}
+
+		s.store.LoadBalancers.add(req.region, *lb.LoadBalancerId, lb)
+		lbs = append(lbs, lb)
+	}
+
+	ids := make([]*string, 0, len(lbs))
+	for _, lb := range lbs {
+		ids = append(ids, lb.LoadBalancerId)
+	}
+
+	task := &clb.DescribeTaskStatusResponseParams{
+		Status:          converter.ValToPtr(int64(clbTaskRunning)),
+		LoadBalancerIds: ids,
+	}
+	s.store.ClbTasks[req.requestID] = task
+	s.schedule(func() {
+		for _, lb := range lbs {
+			lb.Status = converter.ValToPtr(uint64(clbNormal))
+		}
+		task.Status = converter.ValToPtr(int64(clbTaskSuccess))
+	})
+
+	return &clb.CreateLoadBalancerResponseParams{LoadBalancerIds: ids}, nil
+}
+
+func (s *Server) validateCreateLoadBalancer(region string, params *clb.CreateLoadBalancerRequestParams) error {
+	if _, err := regionZoneList(region); err != nil {
+		return err
+	}
+
+	lbType := converter.PtrToVal(params.LoadBalancerType)
+	if lbType != "OPEN" && lbType != "INTERNAL" {
+		return newError(errInvalidParameterVal, "LoadBalancerType should be OPEN or INTERNAL")
+	}
+
+	if number := converter.PtrToVal(params.Number); number > maxLimit {
+		return newError(errInvalidParameterVal, "Number should be in range [1, %d]", maxLimit)
+	}
+
+	if params.VpcId != nil {
+		if _, exists := s.store.Vpcs.get(region, *params.VpcId); !exists {
+			return newError(errResourceNotFound, "vpc %s not found", *params.VpcId)
+		}
+	}
+
+	if lbType == "INTERNAL" {
+		subnet, exists := s.store.Subnets.get(region, converter.PtrToVal(params.SubnetId))
+		if !exists {
+			return newError(errResourceNotFound, "subnet %s not found", converter.PtrToVal(params.SubnetId))
+		}
+
+		if converter.PtrToVal(subnet.VpcId) != converter.PtrToVal(params.VpcId) {
+			return newError(errInvalidParameterVal, "subnet %s not belongs to vpc %s", *subnet.SubnetId,
+				converter.PtrToVal(params.VpcId))
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) newLoadBalancer(region string, params *clb.CreateLoadBalancerRequestParams) (*clb.LoadBalancer,
+	error) {
+
+	forward := uint64(1)
+	if params.Forward != nil {
+		forward = uint64(*params.Forward)
+	}
+
+	addressIPVersion := params.AddressIPVersion
+	if addressIPVersion == nil {
+		addressIPVersion = converter.ValToPtr("IPV4")
+	}
+
+	lb := &clb.LoadBalancer{
+		LoadBalancerId:   converter.ValToPtr(s.nextID("lb")),
+		LoadBalancerName: params.LoadBalancerName,
+		LoadBalancerType: params.LoadBalancerType,
+		Forward:          converter.ValToPtr(forward),
+		Status:           converter.ValToPtr(uint64(clbCreating)),
+		CreateTime:       converter.ValToPtr(stdTime()),
+		ProjectId:        converter.ValToPtr(uint64(converter.PtrToVal(params.ProjectId))),
+		VpcId:            params.VpcId,
+		SubnetId:         params.SubnetId,
+		Tags:             params.Tags,
+		AddressIPVersion: addressIPVersion,
+		ChargeType:       converter.ValToPtr("POSTPAID_BY_HOUR"),
+		NetworkAttributes: &clb.InternetAccessible{
+			InternetChargeType: converter.ValToPtr("TRAFFIC_POSTPAID_BY_HOUR"),
+		},
+	}
+	if lb.LoadBalancerName == nil {
+		lb.LoadBalancerName = lb.LoadBalancerId
+	}
+	if lb.Tags == nil {
+		lb.Tags = make([]*clb.TagInfo, 0)
+	}
+
+	vip := s.store.allocatePublicIP()
+	if converter.PtrToVal(params.LoadBalancerType) == "INTERNAL" {
+		ip, err := s.store.allocatePrivateIP(region, converter.PtrToVal(params.SubnetId))
+		if err != nil {
+			return nil, err
+		}
+		vip = ip
+	}
+	lb.LoadBalancerVips = []*string{converter.ValToPtr(vip)}
+
+	return lb, nil
+}
+
+func (s *Server) deleteLoadBalancer(req *request) (any, error) {
+	params := new(clb.DeleteLoadBalancerRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	for _, id := range params.LoadBalancerIds {
+		if _, exists := s.store.LoadBalancers.get(req.region, converter.PtrToVal(id)); !exists {
+			return nil, newError(errResourceNotFound, "load balancer %s not found", converter.PtrToVal(id))
+		}
+	}
+
+	for _, id := range params.LoadBalancerIds {
+		s.store.LoadBalancers.remove(*id)
+	}
+
+	s.store.ClbTasks[req.requestID] = &clb.DescribeTaskStatusResponseParams{
+		Status:          converter.ValToPtr(int64(clbTaskSuccess)),
+		LoadBalancerIds: params.LoadBalancerIds,
+	}
+
+	return nil, nil
+}
+
+func (s *Server) describeTaskStatus(req *request) (any, error) {
+	params := new(clb.DescribeTaskStatusRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	task, exists := s.store.ClbTasks[converter.PtrToVal(params.TaskId)]
+	if !exists {
+		return nil, newError(errResourceNotFound, "task %s not found", converter.PtrToVal(params.TaskId))
+	}
+
+	return &clb.DescribeTaskStatusResponseParams{Status: task.Status, LoadBalancerIds: task.LoadBalancerIds}, nil
+}
@@ -0,0 +1,425 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package faketcloud
+
+import (
+	"fmt"
+	"strings"
+
+	"hcm/pkg/tools/converter"
+
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+)
+
+const serviceCvm = "cvm"
+
+// 实例状态
+const (
+	instancePending     = "PENDING"
+	instanceRunning     = "RUNNING"
+	instanceStarting    = "STARTING"
+	instanceStopping    = "STOPPING"
+	instanceStopped     = "STOPPED"
+	instanceRebooting   = "REBOOTING"
+	instanceTerminating = "TERMINATING"
+)
+
+// regionZones 模拟云上支持的地域及其可用区
+var regionZones = []struct {
+	region *cvm.RegionInfo
+	zones  []*cvm.ZoneInfo
+}{
+	{
+		region: newRegion("ap-guangzhou", "华南地区(广州)"),
+		zones: []*cvm.ZoneInfo{
+			newZone("ap-guangzhou-3", "100003", "广州三区"),
+			newZone("ap-guangzhou-4", "100004", "广州四区"),
+		},
+	},
+	{
+		region: newRegion("ap-shanghai", "华东地区(上海)"),
+		zones: []*cvm.ZoneInfo{
+			newZone("ap-shanghai-2", "200002", "上海二区"),
+			newZone("ap-shanghai-4", "200004", "上海四区"),
+		},
+	},
+}
+
+func newRegion(region, name string) *cvm.RegionInfo {
+	return &cvm.RegionInfo{
+		Region:      converter.ValToPtr(region),
+		RegionName:  converter.ValToPtr(name),
+		RegionState: converter.ValToPtr("AVAILABLE"),
+	}
+}
+
+func newZone(zone, id, name string) *cvm.ZoneInfo {
+	return &cvm.ZoneInfo{
+		Zone:      converter.ValToPtr(zone),
+		ZoneId:    converter.ValToPtr(id),
+		ZoneName:  converter.ValToPtr(name),
+		ZoneState: converter.ValToPtr("AVAILABLE"),
+	}
+}
+
+func regionZoneList(region string) ([]*cvm.ZoneInfo, error) {
+	for _, one := range regionZones {
+		if converter.PtrToVal(one.region.Region) == region {
+			return one.zones, nil
+		}
+	}
+
+	return nil, newError("InvalidParameterValue.InvalidRegion", "region %s is not supported", region)
+}
+
+func validateZone(region, zone string) error {
+	zones, err := regionZoneList(region)
+	if err != nil {
+		return err
+	}
+
+	for _, one := range zones {
+		if converter.PtrToVal(one.Zone) == zone {
+			return nil
+		}
+	}
+
+	return newError("InvalidZone.MismatchRegion", "zone %s is not in region %s", zone, region)
+}
+
+func (s *Server) registerCvm() {
+	s.register(serviceCvm, "DescribeRegions", s.describeRegions)
+	s.register(serviceCvm, "DescribeZones", s.describeZones)
+	s.register(serviceCvm, "DescribeInstances", s.describeInstances)
+	s.register(serviceCvm, "RunInstances", s.runInstances)
+	s.register(serviceCvm, "StartInstances", s.startInstances)
+	s.register(serviceCvm, "StopInstances", s.stopInstances)
+	s.register(serviceCvm, "RebootInstances", s.rebootInstances)
+	s.register(serviceCvm, "TerminateInstances", s.terminateInstances)
+}
+
+func (s *Server) describeRegions(_ *request) (any, error) {
+	regions := make([]*cvm.RegionInfo, 0, len(regionZones))
+	for _, one := range regionZones {
+		regions = append(regions, one.region)
+	}
+
+	return &cvm.DescribeRegionsResponseParams{
+		TotalCount: converter.ValToPtr(uint64(len(regions))),
+		RegionSet:  regions,
+	}, nil
+}
+
+func (s *Server) describeZones(req *request) (any, error) {
+	zones, err := regionZoneList(req.region)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cvm.DescribeZonesResponseParams{
+		TotalCount: converter.ValToPtr(uint64(len(zones))),
+		ZoneSet:    zones,
+	}, nil
+}
+
+func (s *Server) describeInstances(req *request) (any, error) {
+	params := new(cvm.DescribeInstancesRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	list, total, err := s.store.Instances.query(req, params.InstanceIds, instanceField)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cvm.DescribeInstancesResponseParams{
+		TotalCount:  converter.ValToPtr(int64(total)),
+		InstanceSet: list,
+	}, nil
+}
+
+func instanceField(ins *cvm.Instance, name string) ([]string, bool) {
+	switch name {
+	case "instance-id":
+		return []string{converter.PtrToVal(ins.InstanceId)}, true
+	case "instance-name":
+		return []string{converter.PtrToVal(ins.InstanceName)}, true
+	case "instance-state":
+		return []string{converter.PtrToVal(ins.InstanceState)}, true
+	case "zone":
+		return []string{converter.PtrToVal(ins.Placement.Zone)}, true
+	case "vpc-id":
+		return []string{converter.PtrToVal(ins.VirtualPrivateCloud.VpcId)}, true
+	case "subnet-id":
+		return []string{converter.PtrToVal(ins.VirtualPrivateCloud.SubnetId)}, true
+	case "private-ip-address":
+		return converter.PtrToSlice(ins.PrivateIpAddresses), true
+	case "security-group-id":
+		return converter.PtrToSlice(ins.SecurityGroupIds), true
+	default:
+		return nil, false
+	}
+}
+
+func (s *Server) runInstances(req *request) (any, error) {
+	params := new(cvm.RunInstancesRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateRunInstances(req.region, params); err != nil {
+		return nil, err
+	}
+
+	if converter.PtrToVal(params.DryRun) {
+		return nil, newError("DryRunOperation", "request would have succeeded, but DryRun flag is set")
+	}
+
+	count := converter.PtrToVal(params.InstanceCount)
+	if count == 0 {
+		count = 1
+	}
+
+	ids := make([]*string, 0, count)
+	for i := int64(0); i < count; i++ {
+		ins, err := s.newInstance(req.region, params)
+		if err != nil {
+			return nil, err
+		}
+
+		s.store.Instances.add(req.region, *ins.InstanceId, ins)
+		ids = append(ids, ins.InstanceId)
+
+		s.schedule(func() {
+			ins.InstanceState = converter.ValToPtr(instanceRunning)
+			ins.LatestOperationState = converter.ValToPtr("SUCCESS")
+		})
+	}
+
+	return &cvm.RunInstancesResponseParams{InstanceIdSet: ids}, nil
+}
+
+func (s *Server) validateRunInstances(region string, params *cvm.RunInstancesRequestParams) error {
+	if params.Placement == nil || params.Placement.Zone == nil {
+		return newError("MissingParameter", "Placement.Zone is required")
+	}
+
+	if err := validateZone(region, *params.Placement.Zone); err != nil {
+		return err
+	}
+
+	if len(converter.PtrToVal(params.InstanceType)) == 0 || len(converter.PtrToVal(params.ImageId)) == 0 {
+		return newError("MissingParameter", "InstanceType and ImageId are required")
+	}
+
+	if count := converter.PtrToVal(params.InstanceCount); count < 0 || count > maxLimit {
+		return newError(errInvalidParameterVal, "InstanceCount should be in range [1, %d]", maxLimit)
+	}
+
+	if params.VirtualPrivateCloud != nil {
+		subnet, exists := s.store.Subnets.get(region, converter.PtrToVal(params.VirtualPrivateCloud.SubnetId))
+		if !exists {
+			return newError("InvalidParameterValue.VpcIdNotExist", "subnet %s not found",
+				converter.PtrToVal(params.VirtualPrivateCloud.SubnetId))
+		}
+
+		if converter.PtrToVal(subnet.VpcId) != converter.PtrToVal(params.VirtualPrivateCloud.VpcId) {
+			return newError("InvalidParameterValue.VpcIdNotExist", "subnet %s not belongs to vpc %s",
+				converter.PtrToVal(subnet.SubnetId), converter.PtrToVal(params.VirtualPrivateCloud.VpcId))
+		}
+
+		if converter.PtrToVal(subnet.Zone) != *params.Placement.Zone {
+			return newError("InvalidParameterValue.ZoneNotMatchSubnet", "subnet %s not in zone %s",
+				converter.PtrToVal(subnet.SubnetId), *params.Placement.Zone)
+		}
+	}
+
+	for _, sgID := range params.SecurityGroupIds {
+		if _, exists := s.store.SecurityGroups.get(region, converter.PtrToVal(sgID)); !exists {
+			return newError("InvalidSecurityGroupId.NotFound", "security group %s not found",
+				converter.PtrToVal(sgID))
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) newInstance(region string, params *cvm.RunInstancesRequestParams) (*cvm.Instance, error) {
+	id := s.nextID("ins")
+	ins := &cvm.Instance{
+		Placement:            params.Placement,
+		InstanceId:           converter.ValToPtr(id),
+		InstanceType:         params.InstanceType,
+		CPU:                  converter.ValToPtr(int64(2)),
+		Memory:               converter.ValToPtr(int64(4)),
+		RestrictState:        converter.ValToPtr("NORMAL"),
+		InstanceName:         params.InstanceName,
+		InstanceChargeType:   params.InstanceChargeType,
+		SystemDisk:           params.SystemDisk,
+		DataDisks:            params.DataDisks,
+		InternetAccessible:   params.InternetAccessible,
+		ImageId:              params.ImageId,
+		RenewFlag:            converter.ValToPtr("NOTIFY_AND_MANUAL_RENEW"),
+		CreatedTime:          converter.ValToPtr(isoTime()),
+		OsName:               converter.ValToPtr("TencentOS Server 3.1 (TK4)"),
+		SecurityGroupIds:     params.SecurityGroupIds,
+		InstanceState:        converter.ValToPtr(instancePending),
+		Uuid:                 converter.ValToPtr(id),
+		LatestOperation:      converter.ValToPtr("RunInstances"),
+		LatestOperationState: converter.ValToPtr("OPERATING"),
+		PrivateIpAddresses:   make([]*string, 0),
+		PublicIpAddresses:    make([]*string, 0),
+		Tags:                 make([]*cvm.Tag, 0),
+	}
+
+	if ins.InstanceName == nil {
+		ins.InstanceName = converter.ValToPtr("未命名")
+	}
+
+	if ins.InstanceChargeType == nil {
+		ins.InstanceChargeType = converter.ValToPtr("POSTPAID_BY_HOUR")
+	}
+
+	if params.VirtualPrivateCloud != nil {
+		ip, err := s.store.allocatePrivateIP(region, converter.PtrToVal(params.VirtualPrivateCloud.SubnetId))
+		if err != nil {
+			return nil, err
+		}
+
+		ins.VirtualPrivateCloud = &cvm.VirtualPrivateCloud{
+			VpcId:    params.VirtualPrivateCloud.VpcId,
+			SubnetId: params.VirtualPrivateCloud.SubnetId,
+		}
+		ins.PrivateIpAddresses = append(ins.PrivateIpAddresses, converter.ValToPtr(ip))
+	}
+
+	if params.InternetAccessible != nil && converter.PtrToVal(params.InternetAccessible.PublicIpAssigned) {
+		ins.PublicIpAddresses = append(ins.PublicIpAddresses, converter.ValToPtr(s.store.allocatePublicIP()))
+	}
+
+	for _, spec := range params.TagSpecification {
+		if converter.PtrToVal(spec.ResourceType) != "instance" {
+			continue
+		}
+		for _, tag := range spec.Tags {
+			ins.Tags = append(ins.Tags, &cvm.Tag{Key: tag.Key, Value: tag.Value})
+		}
+	}
+
+	return ins, nil
+}
+
+// transitInstances 校验所有实例均处于from状态后切换到中间状态，异步任务完成后切换到最终状态
+func (s *Server) transitInstances(req *request, operation string, from, transient, to string) error {
+	params := new(cvm.StartInstancesRequestParams)
+	if err := req.decode(params); err != nil {
+		return err
+	}
+
+	if len(params.InstanceIds) == 0 {
+		return newError("MissingParameter", "InstanceIds is required")
+	}
+
+	instances := make([]*cvm.Instance, 0, len(params.InstanceIds))
+	for _, id := range params.InstanceIds {
+		ins, exists := s.store.Instances.get(req.region, converter.PtrToVal(id))
+		if !exists {
+			return newError("InvalidInstanceId.NotFound", "instance %s not found", converter.PtrToVal(id))
+		}
+
+		if state := converter.PtrToVal(ins.InstanceState); state != from {
+			return newError(instanceStateErrCode(state), "instance %s is %s, can not %s", *ins.InstanceId, state,
+				operation)
+		}
+		instances = append(instances, ins)
+	}
+
+	for _, one := range instances {
+		ins := one
+		ins.InstanceState = converter.ValToPtr(transient)
+		ins.LatestOperation = converter.ValToPtr(operation)
+		ins.LatestOperationState = converter.ValToPtr("OPERATING")
+		ins.LatestOperationRequestId = converter.ValToPtr(req.requestID)
+
+		s.schedule(func() {
+			ins.InstanceState = converter.ValToPtr(to)
+			ins.LatestOperationState = converter.ValToPtr("SUCCESS")
+		})
+	}
+
+	return nil
+}
+
+// instanceStateErrCode 如实例处于RUNNING状态时返回 UnsupportedOperation.InstanceStateRunning
+func instanceStateErrCode(state string) string {
+	if len(state) == 0 {
+		return errUnsupportedOperation
+	}
+	return fmt.Sprintf("%s.InstanceState%s%s", errUnsupportedOperation, state[:1], strings.ToLower(state[1:]))
+}
+
+func (s *Server) startInstances(req *request) (any, error) {
+	return nil, s.transitInstances(req, "StartInstances", instanceStopped, instanceStarting, instanceRunning)
+}
+
+func (s *Server) stopInstances(req *request) (any, error) {
+	return nil, s.transitInstances(req, "StopInstances", instanceRunning, instanceStopping, instanceStopped)
+}
+
+func (s *Server) rebootInstances(req *request) (any, error) {
+	return nil, s.transitInstances(req, "RebootInstances", instanceRunning, instanceRebooting, instanceRunning)
+}
+
+func (s *Server) terminateInstances(req *request) (any, error) {
+	params := new(cvm.TerminateInstancesRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	instances := make([]*cvm.Instance, 0, len(params.InstanceIds))
+	for _, id := range params.InstanceIds {
+		ins, exists := s.store.Instances.get(req.region, converter.PtrToVal(id))
+		if !exists {
+			return nil, newError("InvalidInstanceId.NotFound", "instance %s not found", converter.PtrToVal(id))
+		}
+
+		state := converter.PtrToVal(ins.InstanceState)
+		if state == instancePending || state == instanceTerminating {
+			return nil, newError(instanceStateErrCode(state), "instance %s is %s, can not terminate",
+				*ins.InstanceId, state)
+		}
+		instances = append(instances, ins)
+	}
+
+	for _, one := range instances {
+		ins := one
+		ins.InstanceState = converter.ValToPtr(instanceTerminating)
+		ins.LatestOperation = converter.ValToPtr("TerminateInstances")
+		ins.LatestOperationState = converter.ValToPtr("OPERATING")
+
+		region := req.region
+		s.schedule(func() {
+			s.store.releaseInstance(region, ins)
+		})
+	}
+
+	return nil, nil
+}
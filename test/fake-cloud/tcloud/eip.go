@@ -0,0 +1,239 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package faketcloud
+
+import (
+	"hcm/pkg/tools/converter"
+
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+)
+
+// 弹性IP状态
+const (
+	addressCreating  = "CREATING"
+	addressBinding   = "BINDING"
+	addressBind      = "BIND"
+	addressUnbinding = "UNBINDING"
+	addressUnbind    = "UNBIND"
+)
+
+func (s *Server) registerEip() {
+	s.register(serviceVpc, "DescribeAddresses", s.describeAddresses)
+	s.register(serviceVpc, "AllocateAddresses", s.allocateAddresses)
+	s.register(serviceVpc, "AssociateAddress", s.associateAddress)
+	s.register(serviceVpc, "DisassociateAddress", s.disassociateAddress)
+	s.register(serviceVpc, "ReleaseAddresses", s.releaseAddresses)
+	s.register(serviceVpc, "DescribeNetworkAccountType", s.describeNetworkAccountType)
+}
+
+func (s *Server) describeAddresses(req *request) (any, error) {
+	params := new(vpc.DescribeAddressesRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	list, total, err := s.store.Addresses.query(req, params.AddressIds, addressField)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vpc.DescribeAddressesResponseParams{TotalCount: converter.ValToPtr(int64(total)), AddressSet: list}, nil
+}
+
+func addressField(v *vpc.Address, name string) ([]string, bool) {
+	switch name {
+	case "address-id":
+		return []string{converter.PtrToVal(v.AddressId)}, true
+	case "address-name":
+		return []string{converter.PtrToVal(v.AddressName)}, true
+	case "address-ip":
+		return []string{converter.PtrToVal(v.AddressIp)}, true
+	case "address-status":
+		return []string{converter.PtrToVal(v.AddressStatus)}, true
+	case "instance-id":
+		return []string{converter.PtrToVal(v.InstanceId)}, true
+	case "address-type":
+		return []string{converter.PtrToVal(v.AddressType)}, true
+	default:
+		return nil, false
+	}
+}
+
+func (s *Server) allocateAddresses(req *request) (any, error) {
+	params := new(vpc.AllocateAddressesRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	if _, err := regionZoneList(req.region); err != nil {
+		return nil, err
+	}
+
+	count := converter.PtrToVal(params.AddressCount)
+	if count == 0 {
+		count = 1
+	}
+	if count < 0 || count > maxLimit {
+		return nil, newError(errInvalidParameterVal, "AddressCount should be in range [1, %d]", maxLimit)
+	}
+
+	addressType := params.AddressType
+	if addressType == nil {
+		addressType = converter.ValToPtr("EIP")
+	}
+
+	chargeType := params.InternetChargeType
+	if chargeType == nil {
+		chargeType = converter.ValToPtr("TRAFFIC_POSTPAID_BY_HOUR")
+	}
+
+	bandwidth := uint64(converter.PtrToVal(params.InternetMaxBandwidthOut))
+	if bandwidth == 0 {
+		bandwidth = 1
+	}
+
+	tags := params.Tags
+	if tags == nil {
+		tags = make([]*vpc.Tag, 0)
+	}
+
+	ids := make([]*string, 0, count)
+	for i := int64(0); i < count; i++ {
+		address := &vpc.Address{
+			AddressId:               converter.ValToPtr(s.nextID("eip")),
+			AddressName:             params.AddressName,
+			AddressStatus:           converter.ValToPtr(addressCreating),
+			AddressIp:               converter.ValToPtr(s.store.allocatePublicIP()),
+			CreatedTime:             converter.ValToPtr(isoTime()),
+			IsArrears:               converter.ValToPtr(false),
+			IsBlocked:               converter.ValToPtr(false),
+			IsEipDirectConnection:   converter.ValToPtr(false),
+			AddressType:             addressType,
+			CascadeRelease:          converter.ValToPtr(false),
+			InternetServiceProvider: params.InternetServiceProvider,
+			Bandwidth:               converter.ValToPtr(bandwidth),
+			InternetChargeType:      chargeType,
+			TagSet:                  tags,
+			Egress:                  params.Egress,
+		}
+		s.store.Addresses.add(req.region, *address.AddressId, address)
+		ids = append(ids, address.AddressId)
+
+		s.schedule(func() {
+			address.AddressStatus = converter.ValToPtr(addressUnbind)
+		})
+	}
+
+	return &vpc.AllocateAddressesResponseParams{AddressSet: ids, TaskId: converter.ValToPtr(req.requestID)}, nil
+}
+
+func (s *Server) getAddress(region, id string, status string) (*vpc.Address, error) {
+	address, exists := s.store.Addresses.get(region, id)
+	if !exists {
+		return nil, newError("InvalidAddressId.NotFound", "address %s not found", id)
+	}
+
+	if converter.PtrToVal(address.AddressStatus) != status {
+		return nil, newError("InvalidAddressIdStatus.NotPermit", "address %s is %s, expect %s", id,
+			converter.PtrToVal(address.AddressStatus), status)
+	}
+
+	return address, nil
+}
+
+func (s *Server) associateAddress(req *request) (any, error) {
+	params := new(vpc.AssociateAddressRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	address, err := s.getAddress(req.region, converter.PtrToVal(params.AddressId), addressUnbind)
+	if err != nil {
+		return nil, err
+	}
+
+	ins, exists := s.store.Instances.get(req.region, converter.PtrToVal(params.InstanceId))
+	if !exists {
+		return nil, newError("InvalidInstanceId.NotFound", "instance %s not found",
+			converter.PtrToVal(params.InstanceId))
+	}
+
+	address.AddressStatus = converter.ValToPtr(addressBinding)
+	s.schedule(func() {
+		address.AddressStatus = converter.ValToPtr(addressBind)
+		address.InstanceId = ins.InstanceId
+		address.InstanceType = converter.ValToPtr("CVM")
+		if len(ins.PrivateIpAddresses) != 0 {
+			address.PrivateAddressIp = ins.PrivateIpAddresses[0]
+		}
+		ins.PublicIpAddresses = []*string{address.AddressIp}
+	})
+
+	return &vpc.AssociateAddressResponseParams{TaskId: converter.ValToPtr(req.requestID)}, nil
+}
+
+func (s *Server) disassociateAddress(req *request) (any, error) {
+	params := new(vpc.DisassociateAddressRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	address, err := s.getAddress(req.region, converter.PtrToVal(params.AddressId), addressBind)
+	if err != nil {
+		return nil, err
+	}
+
+	address.AddressStatus = converter.ValToPtr(addressUnbinding)
+	region := req.region
+	s.schedule(func() {
+		if ins, exists := s.store.Instances.get(region, converter.PtrToVal(address.InstanceId)); exists {
+			ins.PublicIpAddresses = make([]*string, 0)
+		}
+		address.AddressStatus = converter.ValToPtr(addressUnbind)
+		address.InstanceId = nil
+		address.InstanceType = nil
+		address.PrivateAddressIp = nil
+	})
+
+	return &vpc.DisassociateAddressResponseParams{TaskId: converter.ValToPtr(req.requestID)}, nil
+}
+
+func (s *Server) releaseAddresses(req *request) (any, error) {
+	params := new(vpc.ReleaseAddressesRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	for _, id := range params.AddressIds {
+		if _, err := s.getAddress(req.region, converter.PtrToVal(id), addressUnbind); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, id := range params.AddressIds {
+		s.store.Addresses.remove(*id)
+	}
+
+	return &vpc.ReleaseAddressesResponseParams{TaskId: converter.ValToPtr(req.requestID)}, nil
+}
+
+func (s *Server) describeNetworkAccountType(_ *request) (any, error) {
+	return &vpc.DescribeNetworkAccountTypeResponseParams{NetworkAccountType: converter.ValToPtr("STANDARD")}, nil
+}
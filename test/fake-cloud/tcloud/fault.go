@@ -0,0 +1,148 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package faketcloud
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	errInternalError        = "InternalError"
+	errInvalidAction        = "InvalidAction"
+	errInvalidParameter     = "InvalidParameter"
+	errInvalidParameterVal  = "InvalidParameterValue"
+	errResourceNotFound     = "ResourceNotFound"
+	errResourceInUse        = "ResourceInUse"
+	errRequestLimitExceeded = "RequestLimitExceeded"
+	errUnsupportedOperation = "UnsupportedOperation"
+)
+
+// apiError 云API返回的错误，序列化后即为 Response.Error
+type apiError struct {
+	Code    string `json:"Code"`
+	Message string `json:"Message"`
+}
+
+// Error ...
+func (e *apiError) Error() string {
+	return fmt.Sprintf("[%s] %s", e.Code, e.Message)
+}
+
+func newError(code string, format string, args ...any) *apiError {
+	return &apiError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// Fault 注入的错误，命中的请求直接返回指定的错误码
+type Fault struct {
+	// Action 云API接口名，为空时匹配所有接口
+	Action  string `json:"action"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// Times 生效次数，为0时一直生效直到被清除
+	Times int `json:"times"`
+}
+
+// Throttle 接口限频，超过限制的请求返回 RequestLimitExceeded
+type Throttle struct {
+	// Action 云API接口名，为空时对所有接口生效
+	Action string `json:"action"`
+	// QPS 每秒允许的请求数，为0时取消该接口限频
+	QPS int `json:"qps"`
+}
+
+// throttle 按秒计数的固定窗口限频
+type throttle struct {
+	qps         int
+	windowStart time.Time
+	count       int
+}
+
+func (t *throttle) allow(now time.Time) bool {
+	if now.Sub(t.windowStart) >= time.Second {
+		t.windowStart = now
+		t.count = 0
+	}
+
+	t.count++
+	return t.count <= t.qps
+}
+
+// InjectFault 注入错误，后注入的优先匹配
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(f.Code) == 0 {
+		f.Code = errInternalError
+	}
+	if len(f.Message) == 0 {
+		f.Message = "injected by fake cloud"
+	}
+
+	s.faults = append([]*Fault{&f}, s.faults...)
+}
+
+// SetThrottle 设置接口限频
+func (s *Server) SetThrottle(t Throttle) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if t.QPS <= 0 {
+		delete(s.throttles, t.Action)
+		return
+	}
+
+	s.throttles[t.Action] = &throttle{qps: t.QPS}
+}
+
+// ClearFaults 清除所有注入的错误和限频
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+	s.throttles = make(map[string]*throttle)
+}
+
+func (s *Server) checkFaults(action string) error {
+	current := time.Now()
+	for _, name := range []string{action, ""} {
+		if t, exists := s.throttles[name]; exists && !t.allow(current) {
+			return newError(errRequestLimitExceeded, "request of %s exceeds the qps limit %d", action, t.qps)
+		}
+	}
+
+	for i, f := range s.faults {
+		if len(f.Action) != 0 && f.Action != action {
+			continue
+		}
+
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return newError(f.Code, f.Message)
+	}
+
+	return nil
+}
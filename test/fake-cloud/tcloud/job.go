@@ -0,0 +1,51 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package faketcloud
+
+import "time"
+
+// job 模拟云上的异步任务，到期后在处理下一个请求前执行，用于驱动资源状态变化
+type job struct {
+	due   time.Time
+	apply func()
+}
+
+// schedule 在JobDelay之后执行状态变更
+func (s *Server) schedule(apply func()) {
+	s.jobs = append(s.jobs, job{due: time.Now().Add(s.opt.JobDelay), apply: apply})
+}
+
+// runDueJobs 按受理顺序执行所有到期的任务
+func (s *Server) runDueJobs(now time.Time) {
+	pending := s.jobs[:0]
+	due := make([]job, 0)
+	for _, one := range s.jobs {
+		if one.due.After(now) {
+			pending = append(pending, one)
+			continue
+		}
+		due = append(due, one)
+	}
+	s.jobs = pending
+
+	for _, one := range due {
+		one.apply()
+	}
+}
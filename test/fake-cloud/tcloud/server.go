@@ -0,0 +1,204 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+// Package faketcloud 本地模拟的腾讯云API 3.0服务，状态保存在内存中，用于在没有真实账号的情况下对adaptor、
+// hc-service以及资源同步做端到端测试。请求签名不做校验，服务名从Authorization头的凭证范围中获取。
+package faketcloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Option fake tcloud server option.
+type Option struct {
+	// JobDelay 异步任务(创建、开关机、绑定等)从受理到完成所需的时间，为0时在下一次请求时即完成
+	JobDelay time.Duration
+}
+
+// Server fake tencent cloud api 3.0 server.
+type Server struct {
+	opt Option
+
+	mu        sync.Mutex
+	seq       uint64
+	store     *store
+	jobs      []job
+	faults    []*Fault
+	throttles map[string]*throttle
+	handlers  map[string]handlerFunc
+}
+
+// NewServer new fake tcloud server.
+func NewServer(opt Option) *Server {
+	s := &Server{
+		opt:       opt,
+		store:     newStore(),
+		throttles: make(map[string]*throttle),
+		handlers:  make(map[string]handlerFunc),
+	}
+
+	s.registerCvm()
+	s.registerVpc()
+	s.registerCbs()
+	s.registerClb()
+
+	return s
+}
+
+type handlerFunc func(req *request) (any, error)
+
+type request struct {
+	service   string
+	action    string
+	region    string
+	requestID string
+	body      []byte
+	// extraFilters 由接口的独立查询参数转换而来的过滤条件
+	extraFilters map[string][]string
+}
+
+// decode 解析请求参数到sdk中对应的RequestParams结构
+func (r *request) decode(v any) error {
+	if len(r.body) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(r.body, v); err != nil {
+		return newError(errInvalidParameter, "decode request body failed, err: %v", err)
+	}
+
+	return nil
+}
+
+func (s *Server) register(service, action string, h handlerFunc) {
+	s.handlers[service+"."+action] = h
+}
+
+// ServeHTTP 以/fake/开头的为管理接口，其余均按云API处理
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.URL.Path, adminPathPrefix) {
+		s.serveAdmin(w, r)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	req := &request{
+		service: parseService(r.Header.Get("Authorization")),
+		action:  r.Header.Get("X-TC-Action"),
+		region:  r.Header.Get("X-TC-Region"),
+		body:    body,
+	}
+
+	resp, err := s.handle(req)
+	writeResponse(w, req.requestID, resp, err)
+}
+
+func (s *Server) handle(req *request) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	req.requestID = s.nextID("req")
+	s.runDueJobs(time.Now())
+
+	if err := s.checkFaults(req.action); err != nil {
+		return nil, err
+	}
+
+	h, exists := s.handlers[req.service+"."+req.action]
+	if !exists {
+		return nil, newError(errInvalidAction, "action %s of service %s is not supported by fake cloud",
+			req.action, req.service)
+	}
+
+	return h(req)
+}
+
+// parseService 从TC3-HMAC-SHA256签名的凭证范围中获取服务名，如: Credential=AKID/2024-10-18/cvm/tc3_request
+func parseService(auth string) string {
+	idx := strings.Index(auth, "Credential=")
+	if idx < 0 {
+		return ""
+	}
+
+	credential := auth[idx+len("Credential="):]
+	if end := strings.Index(credential, ","); end >= 0 {
+		credential = credential[:end]
+	}
+
+	fields := strings.Split(credential, "/")
+	if len(fields) != 4 {
+		return ""
+	}
+
+	return fields[2]
+}
+
+func writeResponse(w http.ResponseWriter, requestID string, resp any, err error) {
+	body := make(map[string]any)
+	if err != nil {
+		apiErr, ok := err.(*apiError)
+		if !ok {
+			apiErr = &apiError{Code: errInternalError, Message: err.Error()}
+		}
+		body["Error"] = apiErr
+	} else if resp != nil {
+		raw, err := json.Marshal(resp)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if err = json.Unmarshal(raw, &body); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	body["RequestId"] = requestID
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(map[string]any{"Response": body}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// nextID 生成形如 ins-0000000a 的资源ID，同一服务实例内唯一
+func (s *Server) nextID(prefix string) string {
+	s.seq++
+	return fmt.Sprintf("%s-%08x", prefix, s.seq)
+}
+
+// isoTime cvm、cbs等接口使用的ISO8601时间格式
+func isoTime() string {
+	return time.Now().UTC().Format("2006-01-02T15:04:05Z")
+}
+
+// stdTime vpc、clb等接口使用的时间格式
+func stdTime() string {
+	return time.Now().Format("2006-01-02 15:04:05")
+}
@@ -0,0 +1,173 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package faketcloud
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"hcm/pkg/adaptor/tcloud"
+	"hcm/pkg/adaptor/types"
+	"hcm/pkg/adaptor/types/core"
+	typecvm "hcm/pkg/adaptor/types/cvm"
+	typelb "hcm/pkg/adaptor/types/load-balancer"
+	securitygroup "hcm/pkg/adaptor/types/security-group"
+	adtysubnet "hcm/pkg/adaptor/types/subnet"
+	"hcm/pkg/kit"
+	"hcm/pkg/tools/converter"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRegion = "ap-guangzhou"
+
+func newTestCloud(t *testing.T) (*Server, tcloud.TCloud) {
+	server := NewServer(Option{})
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	cli, err := tcloud.NewTCloud(&types.BaseSecret{
+		CloudSecretID:  "fake-id",
+		CloudSecretKey: "fake-key",
+		Endpoint:       httpServer.URL,
+	})
+	require.NoError(t, err)
+
+	return server, cli
+}
+
+func TestCreateCvmFlow(t *testing.T) {
+	_, cli := newTestCloud(t)
+	kt := kit.New()
+
+	regions, err := cli.ListRegion(kt)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(len(regionZones)), converter.PtrToVal(regions.Count))
+
+	vpc, err := cli.CreateVpc(kt, &types.TCloudVpcCreateOption{
+		AccountID: "account",
+		Name:      "vpc",
+		Extension: &types.TCloudVpcCreateExt{Region: testRegion, IPv4Cidr: "10.0.0.0/16"},
+	})
+	require.NoError(t, err)
+
+	subnet, err := cli.CreateSubnet(kt, &adtysubnet.TCloudSubnetCreateOption{
+		Name:       "subnet",
+		CloudVpcID: vpc.CloudID,
+		Extension: &adtysubnet.TCloudSubnetCreateExt{
+			Region: testRegion, Zone: "ap-guangzhou-3", IPv4Cidr: "10.0.1.0/24",
+		},
+	})
+	require.NoError(t, err)
+
+	// 子网不在vpc网段内
+	_, err = cli.CreateSubnet(kt, &adtysubnet.TCloudSubnetCreateOption{
+		Name:       "invalid",
+		CloudVpcID: vpc.CloudID,
+		Extension: &adtysubnet.TCloudSubnetCreateExt{
+			Region: testRegion, Zone: "ap-guangzhou-3", IPv4Cidr: "192.168.0.0/24",
+		},
+	})
+	assert.ErrorContains(t, err, "InvalidParameterValue.SubnetRange")
+
+	sg, err := cli.CreateSecurityGroup(kt, &securitygroup.TCloudCreateOption{Region: testRegion, Name: "sg"})
+	require.NoError(t, err)
+
+	result, err := cli.CreateCvm(kt, &typecvm.TCloudCreateOption{
+		Region:                testRegion,
+		Name:                  "cvm",
+		Zone:                  "ap-guangzhou-3",
+		InstanceType:          "S5.MEDIUM4",
+		CloudImageID:          "img-fake",
+		Password:              "Fake@Passw0rd",
+		RequiredCount:         2,
+		CloudSecurityGroupIDs: []string{converter.PtrToVal(sg.SecurityGroupId)},
+		CloudVpcID:            vpc.CloudID,
+		CloudSubnetID:         subnet.CloudID,
+		InstanceChargeType:    typecvm.PostpaidByHour,
+		SystemDisk:            &typecvm.TCloudSystemDisk{DiskType: typecvm.CloudBasic},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.SuccessCloudIDs, 2)
+
+	err = cli.StopCvm(kt, &typecvm.TCloudStopOption{
+		Region:      testRegion,
+		CloudIDs:    result.SuccessCloudIDs,
+		StopType:    typecvm.SoftFirst,
+		StoppedMode: typecvm.KeepCharging,
+	})
+	require.NoError(t, err)
+
+	cvms, err := cli.ListCvm(kt, &typecvm.TCloudListOption{Region: testRegion, CloudIDs: result.SuccessCloudIDs})
+	require.NoError(t, err)
+	require.Len(t, cvms, 2)
+	for _, one := range cvms {
+		assert.Equal(t, instanceStopped, converter.PtrToVal(one.InstanceState))
+		assert.Len(t, one.PrivateIpAddresses, 1)
+	}
+
+	// 子网中还有主机，不允许删除
+	err = cli.DeleteSubnet(kt, &core.BaseRegionalDeleteOption{BaseDeleteOption: core.BaseDeleteOption{
+		ResourceID: subnet.CloudID}, Region: testRegion})
+	assert.ErrorContains(t, err, errResourceInUse)
+}
+
+func TestCreateLoadBalancer(t *testing.T) {
+	_, cli := newTestCloud(t)
+	kt := kit.New()
+
+	result, err := cli.CreateLoadBalancer(kt, &typelb.TCloudCreateClbOption{
+		Region:           testRegion,
+		LoadBalancerType: typelb.OpenLoadBalancerType,
+		Number:           converter.ValToPtr(uint64(2)),
+	})
+	require.NoError(t, err)
+	require.Len(t, result.SuccessCloudIDs, 2)
+
+	lbs, err := cli.ListLoadBalancer(kt, &typelb.TCloudListOption{Region: testRegion,
+		CloudIDs: result.SuccessCloudIDs})
+	require.NoError(t, err)
+	require.Len(t, lbs, 2)
+	assert.Equal(t, uint64(clbNormal), converter.PtrToVal(lbs[0].Status))
+}
+
+func TestFaultAndThrottle(t *testing.T) {
+	server, cli := newTestCloud(t)
+	kt := kit.New()
+	listOpt := &core.TCloudListOption{Region: testRegion, Page: &core.TCloudPage{Limit: 10}}
+
+	server.InjectFault(Fault{Action: "DescribeVpcs", Code: "InternalError.Injected", Times: 1})
+	_, err := cli.ListVpc(kt, listOpt)
+	assert.ErrorContains(t, err, "InternalError.Injected")
+
+	_, err = cli.ListVpc(kt, listOpt)
+	assert.NoError(t, err)
+
+	server.SetThrottle(Throttle{Action: "DescribeVpcs", QPS: 1})
+	_, err = cli.ListVpc(kt, listOpt)
+	assert.NoError(t, err)
+	_, err = cli.ListVpc(kt, listOpt)
+	assert.ErrorContains(t, err, errRequestLimitExceeded)
+
+	server.ClearFaults()
+	_, err = cli.ListVpc(kt, listOpt)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,271 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package faketcloud
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
+	clb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// store 内存中的云资源，直接使用sdk中的结构保证返回的数据格式与云上一致
+type store struct {
+	Instances      *table[cvm.Instance]      `json:"instances"`
+	Vpcs           *table[vpc.Vpc]           `json:"vpcs"`
+	Subnets        *table[vpc.Subnet]        `json:"subnets"`
+	SecurityGroups *table[vpc.SecurityGroup] `json:"security_groups"`
+	Addresses      *table[vpc.Address]       `json:"addresses"`
+	Disks          *table[cbs.Disk]          `json:"disks"`
+	LoadBalancers  *table[clb.LoadBalancer]  `json:"load_balancers"`
+	// ClbTasks clb异步任务状态，key为受理请求的RequestId
+	ClbTasks map[string]*clb.DescribeTaskStatusResponseParams `json:"clb_tasks"`
+
+	privateIPSeq map[string]uint32
+	publicIPSeq  uint32
+}
+
+func newStore() *store {
+	return &store{
+		Instances:      newTable[cvm.Instance](),
+		Vpcs:           newTable[vpc.Vpc](),
+		Subnets:        newTable[vpc.Subnet](),
+		SecurityGroups: newTable[vpc.SecurityGroup](),
+		Addresses:      newTable[vpc.Address](),
+		Disks:          newTable[cbs.Disk](),
+		LoadBalancers:  newTable[clb.LoadBalancer](),
+		ClbTasks:       make(map[string]*clb.DescribeTaskStatusResponseParams),
+		privateIPSeq:   make(map[string]uint32),
+	}
+}
+
+type entry[T any] struct {
+	ID     string `json:"id"`
+	Region string `json:"region"`
+	Value  *T     `json:"value"`
+}
+
+// table 按插入顺序保存的某类资源，资源只在所属地域内可见
+type table[T any] struct {
+	ids   []string
+	items map[string]*entry[T]
+}
+
+func newTable[T any]() *table[T] {
+	return &table[T]{items: make(map[string]*entry[T])}
+}
+
+// MarshalJSON ...
+func (t *table[T]) MarshalJSON() ([]byte, error) {
+	list := make([]*entry[T], 0, len(t.ids))
+	for _, id := range t.ids {
+		list = append(list, t.items[id])
+	}
+	return json.Marshal(list)
+}
+
+func (t *table[T]) add(region, id string, v *T) {
+	if _, exists := t.items[id]; !exists {
+		t.ids = append(t.ids, id)
+	}
+	t.items[id] = &entry[T]{ID: id, Region: region, Value: v}
+}
+
+func (t *table[T]) get(region, id string) (*T, bool) {
+	e, exists := t.items[id]
+	if !exists || e.Region != region {
+		return nil, false
+	}
+	return e.Value, true
+}
+
+func (t *table[T]) remove(id string) {
+	if _, exists := t.items[id]; !exists {
+		return
+	}
+
+	delete(t.items, id)
+	for i, one := range t.ids {
+		if one == id {
+			t.ids = append(t.ids[:i], t.ids[i+1:]...)
+			break
+		}
+	}
+}
+
+func (t *table[T]) list(region string) []*T {
+	result := make([]*T, 0)
+	for _, id := range t.ids {
+		if e := t.items[id]; e.Region == region {
+			result = append(result, e.Value)
+		}
+	}
+	return result
+}
+
+// fieldFunc 返回资源在指定过滤条件下的取值，不支持该过滤条件时返回false
+type fieldFunc[T any] func(v *T, name string) ([]string, bool)
+
+// query 先按ID查询，未指定ID时按过滤条件查询，返回分页后的结果以及总数
+func (t *table[T]) query(req *request, ids []*string, field fieldFunc[T]) ([]*T, uint64, error) {
+	filters, err := req.filters()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	offset, limit, err := req.page()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	matched := make([]*T, 0)
+	if len(ids) != 0 {
+		for _, id := range ids {
+			if id == nil {
+				continue
+			}
+			if v, exists := t.get(req.region, *id); exists {
+				matched = append(matched, v)
+			}
+		}
+	} else {
+		for _, v := range t.list(req.region) {
+			ok, err := matchFilters(v, filters, field)
+			if err != nil {
+				return nil, 0, err
+			}
+			if ok {
+				matched = append(matched, v)
+			}
+		}
+	}
+
+	total := uint64(len(matched))
+	if offset >= total {
+		return make([]*T, 0), total, nil
+	}
+
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	return matched[offset:end], total, nil
+}
+
+func matchFilters[T any](v *T, filters map[string][]string, field fieldFunc[T]) (bool, error) {
+	for name, want := range filters {
+		values, supported := field(v, name)
+		if !supported {
+			return false, newError(errInvalidParameterVal, "filter %s is not supported by fake cloud", name)
+		}
+
+		if !containsAny(values, want) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func containsAny(values, want []string) bool {
+	for _, v := range values {
+		for _, w := range want {
+			if v == w {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type filterParam struct {
+	Name   *string   `json:"Name"`
+	Values []*string `json:"Values"`
+}
+
+// filters 各服务的Filter结构相同，统一从请求中解析
+func (r *request) filters() (map[string][]string, error) {
+	params := struct {
+		Filters []*filterParam `json:"Filters"`
+	}{}
+	if err := r.decode(&params); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]string)
+	for name, values := range r.extraFilters {
+		result[name] = values
+	}
+	for _, f := range params.Filters {
+		if f == nil || f.Name == nil {
+			continue
+		}
+		for _, value := range f.Values {
+			if value != nil {
+				result[*f.Name] = append(result[*f.Name], *value)
+			}
+		}
+	}
+	return result, nil
+}
+
+// page 不同接口的Offset、Limit分别有整数和字符串两种类型，统一解析
+func (r *request) page() (uint64, uint64, error) {
+	params := struct {
+		Offset json.RawMessage `json:"Offset"`
+		Limit  json.RawMessage `json:"Limit"`
+	}{}
+	if err := r.decode(&params); err != nil {
+		return 0, 0, err
+	}
+
+	offset, err := parseUint(params.Offset, 0)
+	if err != nil {
+		return 0, 0, newError(errInvalidParameterVal, "invalid Offset, err: %v", err)
+	}
+
+	limit, err := parseUint(params.Limit, defaultLimit)
+	if err != nil {
+		return 0, 0, newError(errInvalidParameterVal, "invalid Limit, err: %v", err)
+	}
+
+	if limit > maxLimit {
+		return 0, 0, newError(errInvalidParameterVal, "Limit should be in range [1, %d]", maxLimit)
+	}
+
+	return offset, limit, nil
+}
+
+func parseUint(raw json.RawMessage, def uint64) (uint64, error) {
+	value := strings.Trim(string(raw), `"`)
+	if len(value) == 0 || value == "null" {
+		return def, nil
+	}
+	return strconv.ParseUint(value, 10, 64)
+}
@@ -0,0 +1,496 @@
+/*
+ * TencentBlueKing is pleased to support the open source community by making
+ * 蓝鲸智云 - 混合云管理平台 (BlueKing - Hybrid Cloud Management System) available.
+ * Copyright (C) 2022 THL A29 Limited,
+ * a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on
+ * an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the
+ * specific language governing permissions and limitations under the License.
+ *
+ * We undertake not to change the open source license (MIT license) applicable
+ *
+ * to the current version of the project delivered to anyone in the future.
+ */
+
+package faketcloud
+
+import (
+	"encoding/binary"
+	"net"
+	"strconv"
+
+	"hcm/pkg/tools/converter"
+
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+)
+
+const serviceVpc = "vpc"
+
+func (s *Server) registerVpc() {
+	s.register(serviceVpc, "DescribeVpcs", s.describeVpcs)
+	s.register(serviceVpc, "CreateVpc", s.createVpc)
+	s.register(serviceVpc, "DeleteVpc", s.deleteVpc)
+	s.register(serviceVpc, "DescribeSubnets", s.describeSubnets)
+	s.register(serviceVpc, "CreateSubnet", s.createSubnet)
+	s.register(serviceVpc, "CreateSubnets", s.createSubnets)
+	s.register(serviceVpc, "DeleteSubnet", s.deleteSubnet)
+	s.register(serviceVpc, "DescribeSecurityGroups", s.describeSecurityGroups)
+	s.register(serviceVpc, "CreateSecurityGroup", s.createSecurityGroup)
+	s.register(serviceVpc, "DeleteSecurityGroup", s.deleteSecurityGroup)
+	s.register(serviceVpc, "ModifySecurityGroupAttribute", s.modifySecurityGroupAttribute)
+	s.registerEip()
+}
+
+func (s *Server) describeVpcs(req *request) (any, error) {
+	params := new(vpc.DescribeVpcsRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	list, total, err := s.store.Vpcs.query(req, params.VpcIds, vpcField)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vpc.DescribeVpcsResponseParams{TotalCount: converter.ValToPtr(total), VpcSet: list}, nil
+}
+
+func vpcField(v *vpc.Vpc, name string) ([]string, bool) {
+	switch name {
+	case "vpc-id":
+		return []string{converter.PtrToVal(v.VpcId)}, true
+	case "vpc-name":
+		return []string{converter.PtrToVal(v.VpcName)}, true
+	case "cidr-block":
+		return []string{converter.PtrToVal(v.CidrBlock)}, true
+	case "is-default":
+		return []string{strconv.FormatBool(converter.PtrToVal(v.IsDefault))}, true
+	default:
+		return nil, false
+	}
+}
+
+func (s *Server) createVpc(req *request) (any, error) {
+	params := new(vpc.CreateVpcRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	if _, err := regionZoneList(req.region); err != nil {
+		return nil, err
+	}
+
+	if len(converter.PtrToVal(params.VpcName)) == 0 {
+		return nil, newError("MissingParameter", "VpcName is required")
+	}
+
+	if _, _, err := net.ParseCIDR(converter.PtrToVal(params.CidrBlock)); err != nil {
+		return nil, newError("InvalidParameterValue.Malformed", "invalid CidrBlock %s",
+			converter.PtrToVal(params.CidrBlock))
+	}
+
+	dnsServers := params.DnsServers
+	if len(dnsServers) == 0 {
+		dnsServers = converter.SliceToPtr([]string{"183.60.83.19", "183.60.82.98"})
+	}
+
+	v := &vpc.Vpc{
+		VpcName:          params.VpcName,
+		VpcId:            converter.ValToPtr(s.nextID("vpc")),
+		CidrBlock:        params.CidrBlock,
+		IsDefault:        converter.ValToPtr(false),
+		EnableMulticast:  converter.ValToPtr(converter.PtrToVal(params.EnableMulticast) == "true"),
+		CreatedTime:      converter.ValToPtr(stdTime()),
+		DnsServerSet:     dnsServers,
+		DomainName:       params.DomainName,
+		EnableDhcp:       converter.ValToPtr(true),
+		TagSet:           params.Tags,
+		AssistantCidrSet: make([]*vpc.AssistantCidr, 0),
+	}
+	if v.TagSet == nil {
+		v.TagSet = make([]*vpc.Tag, 0)
+	}
+	s.store.Vpcs.add(req.region, *v.VpcId, v)
+
+	return &vpc.CreateVpcResponseParams{Vpc: v}, nil
+}
+
+func (s *Server) deleteVpc(req *request) (any, error) {
+	params := new(vpc.DeleteVpcRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	id := converter.PtrToVal(params.VpcId)
+	if _, exists := s.store.Vpcs.get(req.region, id); !exists {
+		return nil, newError(errResourceNotFound, "vpc %s not found", id)
+	}
+
+	for _, subnet := range s.store.Subnets.list(req.region) {
+		if converter.PtrToVal(subnet.VpcId) == id {
+			return nil, newError(errResourceInUse, "vpc %s still has subnet %s", id, *subnet.SubnetId)
+		}
+	}
+
+	s.store.Vpcs.remove(id)
+	return nil, nil
+}
+
+func (s *Server) describeSubnets(req *request) (any, error) {
+	params := new(vpc.DescribeSubnetsRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	list, total, err := s.store.Subnets.query(req, params.SubnetIds, subnetField)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vpc.DescribeSubnetsResponseParams{TotalCount: converter.ValToPtr(total), SubnetSet: list}, nil
+}
+
+func subnetField(v *vpc.Subnet, name string) ([]string, bool) {
+	switch name {
+	case "subnet-id":
+		return []string{converter.PtrToVal(v.SubnetId)}, true
+	case "subnet-name":
+		return []string{converter.PtrToVal(v.SubnetName)}, true
+	case "vpc-id":
+		return []string{converter.PtrToVal(v.VpcId)}, true
+	case "zone":
+		return []string{converter.PtrToVal(v.Zone)}, true
+	case "cidr-block":
+		return []string{converter.PtrToVal(v.CidrBlock)}, true
+	case "is-default":
+		return []string{strconv.FormatBool(converter.PtrToVal(v.IsDefault))}, true
+	default:
+		return nil, false
+	}
+}
+
+func (s *Server) createSubnet(req *request) (any, error) {
+	params := new(vpc.CreateSubnetRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	input := &vpc.SubnetInput{CidrBlock: params.CidrBlock, SubnetName: params.SubnetName, Zone: params.Zone}
+	subnets, err := s.addSubnets(req.region, converter.PtrToVal(params.VpcId), []*vpc.SubnetInput{input},
+		params.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vpc.CreateSubnetResponseParams{Subnet: subnets[0]}, nil
+}
+
+func (s *Server) createSubnets(req *request) (any, error) {
+	params := new(vpc.CreateSubnetsRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	subnets, err := s.addSubnets(req.region, converter.PtrToVal(params.VpcId), params.Subnets, params.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vpc.CreateSubnetsResponseParams{SubnetSet: subnets}, nil
+}
+
+// addSubnets 校验所有子网后再统一创建，子网必须在vpc网段内且互不重叠
+func (s *Server) addSubnets(region, vpcID string, inputs []*vpc.SubnetInput, tags []*vpc.Tag) ([]*vpc.Subnet,
+	error) {
+
+	v, exists := s.store.Vpcs.get(region, vpcID)
+	if !exists {
+		return nil, newError(errResourceNotFound, "vpc %s not found", vpcID)
+	}
+
+	if len(inputs) == 0 {
+		return nil, newError("MissingParameter", "Subnets is required")
+	}
+
+	_, vpcNet, err := net.ParseCIDR(converter.PtrToVal(v.CidrBlock))
+	if err != nil {
+		return nil, newError(errInternalError, "vpc %s cidr is invalid", vpcID)
+	}
+
+	existNets := make([]*net.IPNet, 0)
+	for _, one := range s.store.Subnets.list(region) {
+		if converter.PtrToVal(one.VpcId) != vpcID {
+			continue
+		}
+		if _, ipNet, err := net.ParseCIDR(converter.PtrToVal(one.CidrBlock)); err == nil {
+			existNets = append(existNets, ipNet)
+		}
+	}
+
+	for _, input := range inputs {
+		if err := validateZone(region, converter.PtrToVal(input.Zone)); err != nil {
+			return nil, err
+		}
+
+		_, ipNet, err := net.ParseCIDR(converter.PtrToVal(input.CidrBlock))
+		if err != nil {
+			return nil, newError("InvalidParameterValue.Malformed", "invalid CidrBlock %s",
+				converter.PtrToVal(input.CidrBlock))
+		}
+
+		vpcOnes, _ := vpcNet.Mask.Size()
+		ones, _ := ipNet.Mask.Size()
+		if !vpcNet.Contains(ipNet.IP) || ones < vpcOnes {
+			return nil, newError("InvalidParameterValue.SubnetRange", "subnet cidr %s is not in vpc cidr %s",
+				ipNet.String(), vpcNet.String())
+		}
+
+		for _, exist := range existNets {
+			if exist.Contains(ipNet.IP) || ipNet.Contains(exist.IP) {
+				return nil, newError("InvalidParameterValue.SubnetConflict", "subnet cidr %s conflicts with %s",
+					ipNet.String(), exist.String())
+			}
+		}
+		existNets = append(existNets, ipNet)
+	}
+
+	if tags == nil {
+		tags = make([]*vpc.Tag, 0)
+	}
+
+	subnets := make([]*vpc.Subnet, 0, len(inputs))
+	for _, input := range inputs {
+		_, ipNet, _ := net.ParseCIDR(*input.CidrBlock)
+		ones, bits := ipNet.Mask.Size()
+		// 网络地址、网关以及广播地址不可用
+		total := uint64(1)<<uint(bits-ones) - 3
+
+		subnet := &vpc.Subnet{
+			VpcId:                   v.VpcId,
+			SubnetId:                converter.ValToPtr(s.nextID("subnet")),
+			SubnetName:              input.SubnetName,
+			CidrBlock:               converter.ValToPtr(ipNet.String()),
+			IsDefault:               converter.ValToPtr(false),
+			EnableBroadcast:         converter.ValToPtr(false),
+			Zone:                    input.Zone,
+			RouteTableId:            input.RouteTableId,
+			CreatedTime:             converter.ValToPtr(stdTime()),
+			AvailableIpAddressCount: converter.ValToPtr(total),
+			TotalIpAddressCount:     converter.ValToPtr(total),
+			NetworkAclId:            converter.ValToPtr(""),
+			IsRemoteVpcSnat:         converter.ValToPtr(false),
+			TagSet:                  tags,
+		}
+		s.store.Subnets.add(region, *subnet.SubnetId, subnet)
+		subnets = append(subnets, subnet)
+	}
+
+	return subnets, nil
+}
+
+func (s *Server) deleteSubnet(req *request) (any, error) {
+	params := new(vpc.DeleteSubnetRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	id := converter.PtrToVal(params.SubnetId)
+	if _, exists := s.store.Subnets.get(req.region, id); !exists {
+		return nil, newError(errResourceNotFound, "subnet %s not found", id)
+	}
+
+	for _, ins := range s.store.Instances.list(req.region) {
+		if ins.VirtualPrivateCloud != nil && converter.PtrToVal(ins.VirtualPrivateCloud.SubnetId) == id {
+			return nil, newError(errResourceInUse, "subnet %s is used by instance %s", id, *ins.InstanceId)
+		}
+	}
+
+	s.store.Subnets.remove(id)
+	return nil, nil
+}
+
+func (s *Server) describeSecurityGroups(req *request) (any, error) {
+	params := new(vpc.DescribeSecurityGroupsRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	list, total, err := s.store.SecurityGroups.query(req, params.SecurityGroupIds, securityGroupField)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vpc.DescribeSecurityGroupsResponseParams{
+		TotalCount:       converter.ValToPtr(total),
+		SecurityGroupSet: list,
+	}, nil
+}
+
+func securityGroupField(v *vpc.SecurityGroup, name string) ([]string, bool) {
+	switch name {
+	case "security-group-id":
+		return []string{converter.PtrToVal(v.SecurityGroupId)}, true
+	case "security-group-name":
+		return []string{converter.PtrToVal(v.SecurityGroupName)}, true
+	case "project-id":
+		return []string{converter.PtrToVal(v.ProjectId)}, true
+	default:
+		return nil, false
+	}
+}
+
+func (s *Server) createSecurityGroup(req *request) (any, error) {
+	params := new(vpc.CreateSecurityGroupRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	if _, err := regionZoneList(req.region); err != nil {
+		return nil, err
+	}
+
+	if len(converter.PtrToVal(params.GroupName)) == 0 {
+		return nil, newError("MissingParameter", "GroupName is required")
+	}
+
+	projectID := params.ProjectId
+	if projectID == nil {
+		projectID = converter.ValToPtr("0")
+	}
+
+	tags := params.Tags
+	if tags == nil {
+		tags = make([]*vpc.Tag, 0)
+	}
+
+	sg := &vpc.SecurityGroup{
+		SecurityGroupId:   converter.ValToPtr(s.nextID("sg")),
+		SecurityGroupName: params.GroupName,
+		SecurityGroupDesc: params.GroupDescription,
+		ProjectId:         projectID,
+		IsDefault:         converter.ValToPtr(false),
+		CreatedTime:       converter.ValToPtr(stdTime()),
+		UpdateTime:        converter.ValToPtr(stdTime()),
+		TagSet:            tags,
+	}
+	s.store.SecurityGroups.add(req.region, *sg.SecurityGroupId, sg)
+
+	return &vpc.CreateSecurityGroupResponseParams{SecurityGroup: sg}, nil
+}
+
+func (s *Server) deleteSecurityGroup(req *request) (any, error) {
+	params := new(vpc.DeleteSecurityGroupRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	id := converter.PtrToVal(params.SecurityGroupId)
+	if _, exists := s.store.SecurityGroups.get(req.region, id); !exists {
+		return nil, newError(errResourceNotFound, "security group %s not found", id)
+	}
+
+	for _, ins := range s.store.Instances.list(req.region) {
+		if containsAny(converter.PtrToSlice(ins.SecurityGroupIds), []string{id}) {
+			return nil, newError(errResourceInUse, "security group %s is used by instance %s", id,
+				*ins.InstanceId)
+		}
+	}
+
+	s.store.SecurityGroups.remove(id)
+	return nil, nil
+}
+
+func (s *Server) modifySecurityGroupAttribute(req *request) (any, error) {
+	params := new(vpc.ModifySecurityGroupAttributeRequestParams)
+	if err := req.decode(params); err != nil {
+		return nil, err
+	}
+
+	id := converter.PtrToVal(params.SecurityGroupId)
+	sg, exists := s.store.SecurityGroups.get(req.region, id)
+	if !exists {
+		return nil, newError(errResourceNotFound, "security group %s not found", id)
+	}
+
+	if params.GroupName != nil {
+		sg.SecurityGroupName = params.GroupName
+	}
+	if params.GroupDescription != nil {
+		sg.SecurityGroupDesc = params.GroupDescription
+	}
+	sg.UpdateTime = converter.ValToPtr(stdTime())
+
+	return nil, nil
+}
+
+// allocatePrivateIP 从子网中顺序分配内网IP，跳过网络地址和网关地址，释放的IP不再复用
+func (st *store) allocatePrivateIP(region, subnetID string) (string, error) {
+	subnet, exists := st.Subnets.get(region, subnetID)
+	if !exists {
+		return "", newError(errResourceNotFound, "subnet %s not found", subnetID)
+	}
+
+	if converter.PtrToVal(subnet.AvailableIpAddressCount) == 0 {
+		return "", newError("InsufficientInstanceQuota", "subnet %s has no available ip", subnetID)
+	}
+
+	_, ipNet, err := net.ParseCIDR(converter.PtrToVal(subnet.CidrBlock))
+	if err != nil {
+		return "", newError(errInternalError, "subnet %s cidr is invalid", subnetID)
+	}
+
+	st.privateIPSeq[subnetID]++
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, binary.BigEndian.Uint32(ipNet.IP.To4())+1+st.privateIPSeq[subnetID])
+	if !ipNet.Contains(ip) {
+		return "", newError("InsufficientInstanceQuota", "subnet %s has no available ip", subnetID)
+	}
+
+	*subnet.AvailableIpAddressCount--
+	return ip.String(), nil
+}
+
+// allocatePublicIP 从文档保留网段 203.0.113.0/24 起顺序分配公网IP
+func (st *store) allocatePublicIP() string {
+	st.publicIPSeq++
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, binary.BigEndian.Uint32(net.ParseIP("203.0.113.0").To4())+st.publicIPSeq)
+	return ip.String()
+}
+
+// releaseInstance 删除实例，并解除其关联的子网IP、弹性IP以及云硬盘
+func (st *store) releaseInstance(region string, ins *cvm.Instance) {
+	st.Instances.remove(*ins.InstanceId)
+
+	if ins.VirtualPrivateCloud != nil {
+		if subnet, exists := st.Subnets.get(region, converter.PtrToVal(ins.VirtualPrivateCloud.SubnetId)); exists {
+			*subnet.AvailableIpAddressCount += uint64(len(ins.PrivateIpAddresses))
+		}
+	}
+
+	for _, address := range st.Addresses.list(region) {
+		if converter.PtrToVal(address.InstanceId) == *ins.InstanceId {
+			address.AddressStatus = converter.ValToPtr(addressUnbind)
+			address.InstanceId = nil
+			address.InstanceType = nil
+		}
+	}
+
+	for _, disk := range st.Disks.list(region) {
+		if converter.PtrToVal(disk.InstanceId) != *ins.InstanceId {
+			continue
+		}
+
+		if converter.PtrToVal(disk.DeleteWithInstance) {
+			st.Disks.remove(*disk.DiskId)
+			continue
+		}
+		detachDisk(disk)
+	}
+}